@@ -140,7 +140,8 @@ func (c *Conn) newContextWithQuery(ctx context.Context, query string) (*sql.Cont
 		sql.WithQuery(query),
 		sql.WithPid(c.dbConn.nextProcessID()),
 		sql.WithMemoryManager(c.dbConn.engine.MemoryManager),
-		sql.WithProcessList(c.dbConn.engine.ProcessList))
+		sql.WithProcessList(c.dbConn.engine.ProcessList),
+		sql.WithRowChangeFeed(c.dbConn.engine.RowChanges))
 }
 
 type fakeTransaction struct{}
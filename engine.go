@@ -25,6 +25,7 @@ import (
 	"github.com/dolthub/go-mysql-server/sql/analyzer"
 	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/expression/function"
+	"github.com/dolthub/go-mysql-server/sql/information_schema"
 	"github.com/dolthub/go-mysql-server/sql/parse"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 	"github.com/dolthub/go-mysql-server/sql/transform"
@@ -45,8 +46,19 @@ type Config struct {
 	// disabled, and including any users here will enable authentication. All users in this list will have full access.
 	// This field is only temporary, and will be removed as development on users and authentication continues.
 	TemporaryUsers []TemporaryUser
+	// RowChangeBufferSize is the number of row changes queued for the engine's row change listeners, which defaults
+	// to DefaultRowChangeBufferSize.
+	RowChangeBufferSize int
+	// RowChangeOverflow is what is done with row changes when the queue of the row change listeners is full.
+	RowChangeOverflow sql.RowChangeOverflowPolicy
+	// StatementRouter chooses the database provider each statement is analyzed and executed against. When nil, all
+	// statements use the provider of the engine's analyzer.
+	StatementRouter sql.StatementRouter
 }
 
+// DefaultRowChangeBufferSize is the default number of row changes queued for the row change listeners of an engine.
+const DefaultRowChangeBufferSize = 1024
+
 // TemporaryUser is a user that will be added to the engine. This is for temporary use while the remaining features
 // are implemented. Replaces the old "auth.New..." functions for adding a user.
 type TemporaryUser struct {
@@ -123,7 +135,16 @@ type Engine struct {
 	IsReadOnly        bool
 	IsServerLocked    bool
 	PreparedDataCache *PreparedDataCache
+	// RowChanges is the feed the row changes made by the engine's statements are reported to, for contexts created
+	// with it by sql.WithRowChangeFeed, as those of the server and driver packages are.
+	RowChanges *sql.RowChangeFeed
+	// StatementRouter chooses the database provider each statement is analyzed and executed against, if not nil.
+	StatementRouter sql.StatementRouter
+	// routes are the providers the transactions of sessions are pinned to by their statements that write, by session
+	routes            map[uint32]sql.DatabaseProvider
 	mu                *sync.Mutex
+	statsRecalc       *statsRecalculator
+	rowChangesStarted bool
 }
 
 type ColumnWithRawDefault struct {
@@ -152,6 +173,11 @@ func New(a *analyzer.Analyzer, cfg *Config) *Engine {
 	})
 	a.Catalog.RegisterFunction(emptyCtx, function.GetLockingFuncs(ls)...)
 
+	rowChangeBufferSize := cfg.RowChangeBufferSize
+	if rowChangeBufferSize <= 0 {
+		rowChangeBufferSize = DefaultRowChangeBufferSize
+	}
+
 	return &Engine{
 		Analyzer:          a,
 		MemoryManager:     sql.NewMemoryManager(sql.ProcessMemory),
@@ -161,7 +187,11 @@ func New(a *analyzer.Analyzer, cfg *Config) *Engine {
 		IsReadOnly:        cfg.IsReadOnly,
 		IsServerLocked:    cfg.IsServerLocked,
 		PreparedDataCache: NewPreparedDataCache(),
+		RowChanges:        sql.NewRowChangeFeed(rowChangeBufferSize, cfg.RowChangeOverflow),
+		StatementRouter:   cfg.StatementRouter,
+		routes:            make(map[uint32]sql.DatabaseProvider),
 		mu:                &sync.Mutex{},
+		statsRecalc:       newStatsRecalculator(),
 	}
 }
 
@@ -183,6 +213,27 @@ func (e *Engine) AnalyzeQuery(
 	return e.Analyzer.Analyze(ctx, parsed, nil)
 }
 
+// ValidateQuery parses and analyzes a query, including checking that the current user has the privileges it needs,
+// without executing it, and returns the schema of the rows it would return. Statements that don't return rows, such
+// as DDL statements, return the OK result schema.
+func (e *Engine) ValidateQuery(
+	ctx *sql.Context,
+	query string,
+) (sql.Schema, error) {
+	parsed, err := parse.Parse(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	if err = e.readOnlyCheck(parsed); err != nil {
+		return nil, err
+	}
+	analyzed, err := e.Analyzer.Analyze(ctx, parsed, nil)
+	if err != nil {
+		return nil, err
+	}
+	return analyzed.Schema(), nil
+}
+
 // PrepareQuery returns a partially analyzed query
 func (e *Engine) PrepareQuery(
 	ctx *sql.Context,
@@ -193,6 +244,10 @@ func (e *Engine) PrepareQuery(
 		return nil, err
 	}
 
+	if err = e.routeStatement(ctx, parsed); err != nil {
+		return nil, err
+	}
+
 	node, err := e.Analyzer.PrepareQuery(ctx, parsed, nil)
 	if err != nil {
 		return nil, err
@@ -233,6 +288,11 @@ func (e *Engine) QueryNodeWithBindings(
 		}
 	}
 
+	err = e.routeStatement(ctx, parsed)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	// Before we begin a transaction, we need to know if the database being operated on is not the one
 	// currently selected
 	transactionDatabase := analyzer.GetTransactionDatabase(ctx, parsed)
@@ -267,6 +327,8 @@ func (e *Engine) QueryNodeWithBindings(
 		return nil, nil, err
 	}
 
+	e.recalcStaleStats(ctx, analyzed)
+
 	iter, err = e.Analyzer.ExecBuilder.Build(ctx, analyzed, nil)
 	if err != nil {
 		err2 := clearAutocommitTransaction(ctx)
@@ -311,6 +373,7 @@ func (e *Engine) CloseSession(connID uint32) {
 	e.mu.Lock()
 	defer e.mu.Unlock()
 	e.PreparedDataCache.DeleteSessionData(connID)
+	delete(e.routes, connID)
 }
 
 // Count number of BindVars in given tree
@@ -365,37 +428,26 @@ func (e *Engine) analyzeQuery(ctx *sql.Context, query string, parsed sql.Node, b
 		return parsed, nil
 	case *plan.ExecuteQuery:
 		// replace execute query node with the one prepared
-		p, ok := e.PreparedDataCache.GetCachedStmt(ctx.Session.ID(), n.Name)
-		if !ok {
-			return nil, sql.ErrUnknownPreparedStatement.New(n.Name)
+		parsed, err = e.bindPreparedStatement(ctx, n)
+		if err != nil {
+			return nil, err
 		}
-
-		// number of BindVars provided must match number of BindVars expected
-		if countBindVars(p) != len(n.BindVars) {
-			return nil, sql.ErrInvalidArgument.New(n.Name)
+		analyzed, _, err = e.Analyzer.AnalyzePrepared(ctx, parsed, nil)
+		if err != nil {
+			return nil, err
 		}
-		parsed = p
-
-		bindings = map[string]sql.Expression{}
-		for i, binding := range n.BindVars {
-			varName := fmt.Sprintf("v%d", i+1)
-			bindings[varName] = binding
+		return analyzed, nil
+	case *plan.DescribeQuery:
+		// EXPLAIN EXECUTE describes the plan of the prepared statement for the values of its parameters
+		execute, ok := n.Query().(*plan.ExecuteQuery)
+		if !ok {
+			break
 		}
-
-		if len(bindings) > 0 {
-			var usedBindings map[string]bool
-			parsed, usedBindings, err = plan.ApplyBindings(parsed, bindings)
-			if err != nil {
-				return nil, err
-			}
-			for binding := range bindings {
-				if !usedBindings[binding] && !plan.HasEmptyTable(analyzed) {
-					return nil, fmt.Errorf("unused binding %s", binding)
-				}
-			}
+		bound, err := e.bindPreparedStatement(ctx, execute)
+		if err != nil {
+			return nil, err
 		}
-
-		analyzed, _, err = e.Analyzer.AnalyzePrepared(ctx, parsed, nil)
+		analyzed, _, err = e.Analyzer.AnalyzePrepared(ctx, n.WithQuery(bound), nil)
 		if err != nil {
 			return nil, err
 		}
@@ -429,6 +481,40 @@ func (e *Engine) analyzeQuery(ctx *sql.Context, query string, parsed sql.Node, b
 	return analyzed, nil
 }
 
+// bindPreparedStatement returns the plan of the prepared statement executed by the node given, with the values of its
+// parameters bound.
+func (e *Engine) bindPreparedStatement(ctx *sql.Context, n *plan.ExecuteQuery) (sql.Node, error) {
+	p, ok := e.PreparedDataCache.GetCachedStmt(ctx.Session.ID(), n.Name)
+	if !ok {
+		return nil, sql.ErrUnknownPreparedStatement.New(n.Name)
+	}
+
+	// number of BindVars provided must match number of BindVars expected
+	if countBindVars(p) != len(n.BindVars) {
+		return nil, sql.ErrInvalidArgument.New(n.Name)
+	}
+
+	bindings := map[string]sql.Expression{}
+	for i, binding := range n.BindVars {
+		varName := fmt.Sprintf("v%d", i+1)
+		bindings[varName] = binding
+	}
+
+	if len(bindings) > 0 {
+		bound, usedBindings, err := plan.ApplyBindings(p, bindings)
+		if err != nil {
+			return nil, err
+		}
+		for binding := range bindings {
+			if !usedBindings[binding] && !plan.HasEmptyTable(bound) {
+				return nil, fmt.Errorf("unused binding %s", binding)
+			}
+		}
+		p = bound
+	}
+	return p, nil
+}
+
 func (e *Engine) analyzePreparedQuery(ctx *sql.Context, query string, analyzed sql.Node, bindings map[string]sql.Expression) (sql.Node, error) {
 	ctx.GetLogger().Tracef("optimizing prepared plan for query: %s", query)
 
@@ -460,6 +546,50 @@ func (e *Engine) analyzePreparedQuery(ctx *sql.Context, query string, analyzed s
 	return analyzed, nil
 }
 
+// routeStatement sets the provider the statement of the parsed node given is analyzed and executed against on the
+// context given, as chosen by the engine's StatementRouter. Once a statement that writes data or changes schemas runs
+// in a transaction, the rest of the transaction's statements are routed to the same provider.
+func (e *Engine) routeStatement(ctx *sql.Context, parsed sql.Node) error {
+	if e.StatementRouter == nil {
+		return nil
+	}
+
+	class := plan.ClassifyStatement(parsed)
+	inTransaction, err := plan.InTransaction(ctx)
+	if err != nil {
+		return err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	provider, pinned := e.routes[ctx.Session.ID()]
+	if pinned && !inTransaction {
+		delete(e.routes, ctx.Session.ID())
+		pinned = false
+	}
+	if !pinned {
+		provider, err = e.StatementRouter.RouteStatement(ctx, class)
+		if err != nil {
+			return err
+		}
+	}
+	ctx.SetDatabaseProvider(provider)
+
+	switch parsed.(type) {
+	case *plan.StartTransaction, *plan.Commit, *plan.Rollback:
+		// These end the transaction, if there's one, so the statements after them are routed anew
+		delete(e.routes, ctx.Session.ID())
+	default:
+		if inTransaction && !pinned && (class == sql.StatementClassWrite || class == sql.StatementClassDDL) {
+			if e.routes == nil {
+				e.routes = make(map[uint32]sql.DatabaseProvider)
+			}
+			e.routes[ctx.Session.ID()] = provider
+		}
+	}
+	return nil
+}
+
 func (e *Engine) beginTransaction(ctx *sql.Context, transactionDatabase string) error {
 	beginNewTransaction := ctx.GetTransaction() == nil || plan.ReadCommitted(ctx)
 	if beginNewTransaction {
@@ -498,11 +628,34 @@ func (e *Engine) Close() error {
 	return e.BackgroundThreads.Shutdown()
 }
 
+// AddRowChangeListener adds a listener for the rows inserted, updated and deleted by the engine's statements, and the
+// commits and rollbacks of their transactions. Listeners are called by a background thread of the engine, which is
+// started with the first listener.
+func (e *Engine) AddRowChangeListener(listener sql.RowChangeListener) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if !e.rowChangesStarted {
+		if err := e.BackgroundThreads.Add("row change feed", e.RowChanges.Run); err != nil {
+			return err
+		}
+		e.rowChangesStarted = true
+	}
+	e.RowChanges.AddListener(listener)
+	return nil
+}
+
 func (e *Engine) WithBackgroundThreads(b *sql.BackgroundThreads) *Engine {
 	e.BackgroundThreads = b
 	return e
 }
 
+// RegisterInformationSchemaTable adds a table to information_schema with the name and schema given, whose rows are
+// returned by rowIter. It can't have the name of a table information_schema already has. rowIter must be safe for
+// concurrent use by the queries of different sessions.
+func (e *Engine) RegisterInformationSchemaTable(name string, schema sql.Schema, rowIter information_schema.RowIterFunc) error {
+	return e.Analyzer.Catalog.RegisterInformationSchemaTable(name, schema, rowIter)
+}
+
 // readOnlyCheck checks to see if the query is valid with the modification setting of the engine.
 func (e *Engine) readOnlyCheck(node sql.Node) error {
 	if plan.IsDDLNode(node) {
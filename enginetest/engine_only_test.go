@@ -16,14 +16,22 @@ package enginetest_test
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	sql2 "database/sql"
 	"fmt"
 	"io"
+	"math/big"
 	"net"
 	"strings"
 	"testing"
 	"time"
 
+	gosqldriver "github.com/go-sql-driver/mysql"
 	"github.com/pmezard/go-difflib/difflib"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -41,6 +49,8 @@ import (
 	"github.com/dolthub/go-mysql-server/sql/analyzer"
 	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/expression/function"
+	"github.com/dolthub/go-mysql-server/sql/information_schema"
+	"github.com/dolthub/go-mysql-server/sql/mysql_db"
 	"github.com/dolthub/go-mysql-server/sql/parse"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 	"github.com/dolthub/go-mysql-server/sql/rowexec"
@@ -245,7 +255,7 @@ func TestShowProcessList(t *testing.T) {
 	p.UpdateTableProgress(1, "b", 2)
 	p.UpdateTableProgress(2, "foo", 1)
 
-	n := plan.NewShowProcessList()
+	n := plan.NewShowProcessList(false)
 
 	iter, err := rowexec.DefaultBuilder.Build(ctx, n, nil)
 	require.NoError(err)
@@ -267,6 +277,180 @@ b (2/6 partitions)
 	require.ElementsMatch(expected, rows)
 }
 
+func TestShowProcessListFull(t *testing.T) {
+	require := require.New(t)
+
+	addr := "127.0.0.1:34567"
+	query := "SELECT " + strings.Repeat("a", 200) + " FROM foo"
+
+	p := sqle.NewProcessList()
+	p.AddConnection(1, addr)
+	sess := sql.NewBaseSessionWithClientServer("0.0.0.0:3306", sql.Client{Address: addr, User: "foo"}, 1)
+	p.ConnectionReady(sess)
+	ctx := sql.NewContext(context.Background(), sql.WithPid(1), sql.WithSession(sess), sql.WithProcessList(p))
+	ctx, err := p.BeginQuery(ctx, query)
+	require.NoError(err)
+
+	for _, full := range []bool{false, true} {
+		n := plan.NewShowProcessList(full)
+		iter, err := rowexec.DefaultBuilder.Build(ctx, n, nil)
+		require.NoError(err)
+		rows, err := sql.RowIterToRows(ctx, n.Schema(), iter)
+		require.NoError(err)
+		require.Len(rows, 1)
+
+		if full {
+			require.Equal(query, rows[0][7])
+		} else {
+			require.Equal(query[:plan.ShowProcessListInfoLength], rows[0][7])
+		}
+	}
+}
+
+func TestSysProcessList(t *testing.T) {
+	require := require.New(t)
+
+	addr1 := "127.0.0.1:34567"
+	addr2 := "127.0.0.1:34568"
+	username := "foo"
+
+	p := sqle.NewProcessList()
+	p.AddConnection(1, addr1)
+	p.AddConnection(2, addr2)
+
+	sess2 := sql.NewBaseSessionWithClientServer("0.0.0.0:3306", sql.Client{Address: addr2, User: username}, 2)
+	p.ConnectionReady(sess2)
+	ctx2 := sql.NewContext(context.Background(), sql.WithPid(2), sql.WithSession(sess2), sql.WithProcessList(p))
+	ctx2, err := p.BeginQuery(ctx2, "SELECT bar")
+	require.NoError(err)
+	p.AddTableProgress(ctx2.Pid(), "foo", 2)
+	p.UpdateTableProgress(ctx2.Pid(), "foo", 1)
+
+	sess1 := sql.NewBaseSessionWithClientServer("0.0.0.0:3306", sql.Client{Address: addr1, User: username}, 1)
+	p.ConnectionReady(sess1)
+	ctx := sql.NewContext(context.Background(), sql.WithPid(1), sql.WithSession(sess1), sql.WithProcessList(p))
+	ctx.SetCurrentDatabase("db")
+
+	e := sqle.New(analyzer.NewDefault(sql.NewDatabaseProvider(memory.NewDatabase("db"))), new(sqle.Config))
+	for _, view := range []string{"sys.processlist", "sys.session"} {
+		sch, iter, err := e.Query(ctx, "SELECT conn_id, user, db, command, current_statement, CAST(progress AS CHAR), "+
+			"trx_state, trx_autocommit FROM "+view+" ORDER BY conn_id")
+		require.NoError(err)
+		rows, err := sql.RowIterToRows(ctx, sch, iter)
+		require.NoError(err)
+
+		// Only the transaction columns of the connection running the query are known
+		expected := []sql.Row{
+			{uint64(1), username + "@" + addr1, "db", "Sleep", nil, nil, nil, "YES"},
+			{uint64(2), username + "@" + addr2, nil, "Query", "SELECT bar", "50.00", nil, nil},
+		}
+		require.Equal(expected, rows, view)
+	}
+}
+
+func TestSessionConnectAttrs(t *testing.T) {
+	require := require.New(t)
+
+	p := sqle.NewProcessList()
+	p.AddConnection(1, "127.0.0.1:34567")
+	p.AddConnection(2, "127.0.0.1:34568")
+	p.AddConnection(3, "127.0.0.1:34569")
+
+	sess2 := sql.NewBaseSessionWithClientServer("0.0.0.0:3306", sql.Client{
+		User:       "foo",
+		Address:    "127.0.0.1:34568",
+		Attributes: map[string]string{"_client_name": "libmysql", "program_name": "mysql", "_pid": "1234"},
+	}, 2)
+	p.ConnectionReady(sess2)
+	sess3 := sql.NewBaseSessionWithClientServer("0.0.0.0:3306", sql.Client{User: "foo", Address: "127.0.0.1:34569"}, 3)
+	p.ConnectionReady(sess3)
+	sess1 := sql.NewBaseSessionWithClientServer("0.0.0.0:3306", sql.Client{
+		User:       "foo",
+		Address:    "127.0.0.1:34567",
+		Attributes: map[string]string{"_client_name": "Go-MySQL-Driver"},
+	}, 1)
+	p.ConnectionReady(sess1)
+	ctx := sql.NewContext(context.Background(), sql.WithPid(1), sql.WithSession(sess1), sql.WithProcessList(p))
+
+	e := sqle.New(analyzer.NewDefault(sql.NewDatabaseProvider(memory.NewDatabase("db"))), new(sqle.Config))
+	sch, iter, err := e.Query(ctx, "SELECT * FROM performance_schema.session_connect_attrs")
+	require.NoError(err)
+	rows, err := sql.RowIterToRows(ctx, sch, iter)
+	require.NoError(err)
+
+	// Connections without attributes have no rows
+	expected := []sql.Row{
+		{uint32(1), "_client_name", "Go-MySQL-Driver", int32(0)},
+		{uint32(2), "_client_name", "libmysql", int32(0)},
+		{uint32(2), "_pid", "1234", int32(1)},
+		{uint32(2), "program_name", "mysql", int32(2)},
+	}
+	require.Equal(expected, rows)
+}
+
+func TestRegisterInformationSchemaTable(t *testing.T) {
+	require := require.New(t)
+
+	e := sqle.New(analyzer.NewDefault(sql.NewDatabaseProvider(memory.NewDatabase("mydb"))), new(sqle.Config))
+	ctx := sql.NewContext(context.Background())
+	ctx.SetCurrentDatabase("mydb")
+	query := func(q string) []sql.Row {
+		sch, iter, err := e.Query(ctx, q)
+		require.NoError(err, q)
+		rows, err := sql.RowIterToRows(ctx, sch, iter)
+		require.NoError(err, q)
+		return rows
+	}
+	query("CREATE TABLE t1 (a int primary key)")
+	query("CREATE TABLE t2 (a int primary key, b varchar(10), c int)")
+
+	// The column count of every table outside of the system databases
+	schema := sql.Schema{
+		{Name: "TABLE_SCHEMA", Type: types.LongText, Nullable: false},
+		{Name: "TABLE_NAME", Type: types.LongText, Nullable: false},
+		{Name: "COLUMN_COUNT", Type: types.Uint64, Nullable: false},
+	}
+	columnCounts := func(ctx *sql.Context, cat sql.Catalog) (sql.RowIter, error) {
+		var rows []sql.Row
+		for _, db := range cat.AllDatabases(ctx) {
+			if name := db.Name(); name == sql.InformationSchemaDatabaseName || name == sql.SysDatabaseName || name == sql.PerformanceSchemaDatabaseName {
+				continue
+			}
+			err := sql.DBTableIter(ctx, db, func(table sql.Table) (cont bool, err error) {
+				rows = append(rows, sql.Row{db.Name(), table.Name(), uint64(len(table.Schema()))})
+				return true, nil
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+		return sql.RowsToRowIter(rows...), nil
+	}
+	require.NoError(e.RegisterInformationSchemaTable("column_counts", schema, columnCounts))
+
+	// Tables can't have the name of a built-in or registered table
+	err := e.RegisterInformationSchemaTable("TABLES", schema, columnCounts)
+	require.True(information_schema.ErrInformationSchemaTableExists.Is(err), "%v", err)
+	err = e.RegisterInformationSchemaTable("Column_Counts", schema, columnCounts)
+	require.True(information_schema.ErrInformationSchemaTableExists.Is(err), "%v", err)
+
+	require.Equal([]sql.Row{{"t1", uint64(1)}, {"t2", uint64(3)}},
+		query("SELECT table_name, column_count FROM information_schema.column_counts WHERE table_schema = 'mydb' ORDER BY 1"))
+	require.Equal([]sql.Row{{"t2"}},
+		query("SELECT table_name FROM information_schema.column_counts WHERE column_count > 1"))
+	require.Equal([]sql.Row{{"t1", "BASE TABLE", uint64(1)}, {"t2", "BASE TABLE", uint64(3)}},
+		query("SELECT t.table_name, t.table_type, c.column_count FROM information_schema.tables t "+
+			"JOIN information_schema.column_counts c ON t.table_schema = c.table_schema AND t.table_name = c.table_name "+
+			"ORDER BY 1"))
+
+	// The table is listed with the built-in ones
+	require.Equal([]sql.Row{{"column_counts"}}, query("SHOW TABLES FROM information_schema LIKE 'column_counts'"))
+	require.Equal([]sql.Row{{"SYSTEM VIEW"}},
+		query("SELECT table_type FROM information_schema.tables WHERE table_schema = 'information_schema' AND table_name = 'column_counts'"))
+	require.Equal([]sql.Row{{"TABLE_SCHEMA"}, {"TABLE_NAME"}, {"COLUMN_COUNT"}},
+		query("SELECT column_name FROM information_schema.columns WHERE table_schema = 'information_schema' AND table_name = 'column_counts' ORDER BY ordinal_position"))
+}
+
 // TODO: this was an analyzer test, but we don't have a mock process list for it to use, so it has to be here
 func TestTrackProcess(t *testing.T) {
 	require := require.New(t)
@@ -333,6 +517,176 @@ func TestTrackProcess(t *testing.T) {
 	require.Error(ctx.Err())
 }
 
+func TestTrackProcessRowCounts(t *testing.T) {
+	require := require.New(t)
+
+	harness := enginetest.NewDefaultMemoryHarness()
+	harness.Setup(setup.MydbData)
+	e, err := harness.NewEngine(t)
+	require.NoError(err)
+	defer e.Close()
+
+	enginetest.RunQuery(t, e, harness, "create table t (i int primary key, v int)")
+	enginetest.RunQuery(t, e, harness, "insert into t with recursive cte (n) as (select 1 union all select n + 1 from cte where n < 250) select n, n % 10 from cte")
+
+	pl := sqle.NewProcessList()
+	ctx := enginetest.NewContext(harness)
+	ctx.ApplyOpts(sql.WithProcessList(pl))
+	pl.AddConnection(ctx.Session.ID(), "localhost")
+	pl.ConnectionReady(ctx.Session)
+	ctx, err = pl.BeginQuery(ctx, "select i from t where v = 0")
+	require.NoError(err)
+
+	_, iter, err := e.Query(ctx, "select i from t where v = 0")
+	require.NoError(err)
+	for {
+		_, err = iter.Next(ctx)
+		if err == io.EOF {
+			break
+		}
+		require.NoError(err)
+	}
+
+	processes := pl.Processes()
+	require.Len(processes, 1)
+	require.Equal(int64(250), processes[0].RowsExamined)
+	require.Equal(int64(25), processes[0].RowsSent)
+
+	require.NoError(iter.Close(ctx))
+	processes = pl.Processes()
+	require.Len(processes, 1)
+	require.Equal(sql.ProcessCommandSleep, processes[0].Command)
+	require.Equal(int64(250), processes[0].RowsExamined)
+	require.Equal(int64(25), processes[0].RowsSent)
+
+	for _, tt := range []struct {
+		query       string
+		examined    int64
+		sent        int64
+		parallelism int
+	}{
+		// The sort has to read all the rows before the limit stops reading them
+		{"select i from t where v = 0 order by v, i desc limit 5", 250, 5, 1},
+		// The limit stops the scan as soon as it has the rows it returns
+		{"select i from t where v = 0 limit 5", 50, 5, 1},
+		{"select i, v from t where i = 42", 1, 1, 1},
+		{"select i, v from t where i in (7, 42, 300)", 2, 2, 1},
+		{"select i, v from t where i between 10 and 19", 10, 10, 1},
+		{"select count(*) from t where i > 200", 50, 1, 1},
+		// Partitions read in parallel are all counted
+		{"select i from t where v = 0", 250, 25, 4},
+	} {
+		e.Analyzer.Parallelism = tt.parallelism
+
+		ctx, err := pl.BeginQuery(enginetest.NewContext(harness).WithQuery(tt.query), tt.query)
+		require.NoError(err)
+		ctx.ApplyOpts(sql.WithProcessList(pl))
+
+		_, iter, err := e.Query(ctx, tt.query)
+		require.NoError(err)
+		_, err = sql.RowIterToRows(ctx, nil, iter)
+		require.NoError(err)
+
+		processes := pl.Processes()
+		require.Len(processes, 1)
+		require.Equal(sql.ProcessCommandSleep, processes[0].Command)
+		require.Equal(tt.examined, processes[0].RowsExamined, tt.query)
+		require.Equal(tt.sent, processes[0].RowsSent, tt.query)
+	}
+	e.Analyzer.Parallelism = 1
+}
+
+func TestTrackAlterTableProgress(t *testing.T) {
+	require := require.New(t)
+
+	harness := enginetest.NewDefaultMemoryHarness()
+	harness.Setup(setup.MydbData)
+	e, err := harness.NewEngine(t)
+	require.NoError(err)
+	defer e.Close()
+
+	enginetest.RunQuery(t, e, harness, "create table t (i int primary key, v int)")
+	enginetest.RunQuery(t, e, harness, "insert into t with recursive cte (n) as (select 1 union all select n + 1 from cte where n < 250) select n, n % 10 from cte")
+
+	pl := sqle.NewProcessList()
+	ctx := enginetest.NewContext(harness)
+	ctx.ApplyOpts(sql.WithProcessList(pl))
+	pl.AddConnection(ctx.Session.ID(), "localhost")
+	pl.ConnectionReady(ctx.Session)
+
+	db, err := e.Analyzer.Catalog.Database(ctx, "mydb")
+	require.NoError(err)
+	tbl, ok, err := db.GetTableInsensitive(ctx, "t")
+	require.NoError(err)
+	require.True(ok)
+	partitionCount, err := tbl.(sql.PartitionCounter).PartitionCount(ctx)
+	require.NoError(err)
+
+	rwt := &rewritableTable{Table: tbl.(*memory.Table), processList: pl}
+	db.(mysql_db.PrivilegedDatabase).Unwrap().(*memory.HistoryDatabase).AddTable("t", rwt)
+
+	query := "alter table t modify column v bigint not null"
+	ctx, err = pl.BeginQuery(ctx, query)
+	require.NoError(err)
+	_, iter, err := e.Query(ctx, query)
+	require.NoError(err)
+	_, err = sql.RowIterToRows(ctx, nil, iter)
+	require.NoError(err)
+
+	require.Len(rwt.progress, 250)
+	first := rwt.progress[0]
+	require.Equal(sql.Progress{Name: "t", Done: 0, Total: partitionCount}, first.Progress)
+	require.Len(first.PartitionsProgress, 1)
+	last := rwt.progress[len(rwt.progress)-1]
+	require.Equal(sql.Progress{Name: "t", Done: partitionCount - 1, Total: partitionCount}, last.Progress)
+	require.Len(last.PartitionsProgress, 1)
+}
+
+// rewritableTable is a memory table that requests a rewrite for every schema change, and records the progress of the
+// running query in the process list for every row it's asked to rewrite.
+type rewritableTable struct {
+	*memory.Table
+	processList sql.ProcessList
+	progress    []sql.TableProgress
+}
+
+var _ sql.RewritableTable = (*rewritableTable)(nil)
+
+func (t *rewritableTable) ShouldRewriteTable(ctx *sql.Context, oldSchema, newSchema sql.PrimaryKeySchema, oldColumn, newColumn *sql.Column) bool {
+	return true
+}
+
+func (t *rewritableTable) RewriteInserter(ctx *sql.Context, oldSchema, newSchema sql.PrimaryKeySchema, oldColumn, newColumn *sql.Column, idxCols []sql.IndexColumn) (sql.RowInserter, error) {
+	return &progressRecordingInserter{table: t}, nil
+}
+
+type progressRecordingInserter struct {
+	table *rewritableTable
+}
+
+func (i *progressRecordingInserter) StatementBegin(ctx *sql.Context) {}
+
+func (i *progressRecordingInserter) DiscardChanges(ctx *sql.Context, errorEncountered error) error {
+	return nil
+}
+
+func (i *progressRecordingInserter) StatementComplete(ctx *sql.Context) error {
+	return nil
+}
+
+func (i *progressRecordingInserter) Insert(ctx *sql.Context, row sql.Row) error {
+	for _, p := range i.table.processList.Processes() {
+		if p.QueryPid == ctx.Pid() {
+			i.table.progress = append(i.table.progress, p.Progress["t"])
+		}
+	}
+	return nil
+}
+
+func (i *progressRecordingInserter) Close(ctx *sql.Context) error {
+	return nil
+}
+
 func getRuleFrom(rules []analyzer.Rule, id analyzer.RuleId) *analyzer.Rule {
 	for _, rule := range rules {
 		if rule.Id == id {
@@ -398,6 +752,151 @@ func TestUnlockTables(t *testing.T) {
 	require.Equal(0, t3.unlocks)
 }
 
+// logFlushingProvider is a database provider recording the logs it's asked to flush.
+type logFlushingProvider struct {
+	sql.DatabaseProvider
+	flushed []sql.LogKind
+}
+
+var _ sql.LogFlusher = (*logFlushingProvider)(nil)
+
+func (p *logFlushingProvider) FlushLogs(ctx *sql.Context, kind sql.LogKind) error {
+	p.flushed = append(p.flushed, kind)
+	return nil
+}
+
+func TestFlushLogs(t *testing.T) {
+	require := require.New(t)
+
+	provider := &logFlushingProvider{DatabaseProvider: sql.NewDatabaseProvider(memory.NewDatabase("mydb"))}
+	e := sqle.New(analyzer.NewDefault(provider), new(sqle.Config))
+	ctx := sql.NewEmptyContext()
+
+	for _, query := range []string{"FLUSH LOGS", "FLUSH SLOW LOGS", "FLUSH GENERAL LOGS", "FLUSH BINARY LOGS"} {
+		_, iter, err := e.Query(ctx, query)
+		require.NoError(err)
+		rows, err := sql.RowIterToRows(ctx, nil, iter)
+		require.NoError(err)
+		require.Equal([]sql.Row{{types.NewOkResult(0)}}, rows)
+	}
+	require.Equal([]sql.LogKind{sql.AllLogs, sql.SlowLogs, sql.GeneralLogs, sql.BinaryLogs}, provider.flushed)
+
+	// Logs of providers that don't write any are flushed by doing nothing
+	e = sqle.New(analyzer.NewDefault(sql.NewDatabaseProvider(memory.NewDatabase("mydb"))), new(sqle.Config))
+	_, iter, err := e.Query(ctx, "FLUSH LOGS")
+	require.NoError(err)
+	_, err = sql.RowIterToRows(ctx, nil, iter)
+	require.NoError(err)
+
+	_, _, err = e.Query(ctx, "FLUSH HOSTS")
+	require.True(sql.ErrUnsupportedFeature.Is(err))
+	require.EqualError(err, "unsupported feature: FLUSH HOSTS")
+}
+
+func TestFlushStatus(t *testing.T) {
+	require := require.New(t)
+
+	harness := enginetest.NewDefaultMemoryHarness()
+	harness.Setup(setup.MydbData)
+	e, err := harness.NewEngine(t)
+	require.NoError(err)
+	defer e.Close()
+
+	ctx := enginetest.NewContext(harness)
+	global := sql.SortMergePasses.GlobalValue()
+	sql.SortMergePasses.Increment(ctx, 3)
+
+	enginetest.TestQueryWithContext(t, ctx, e, harness, "SHOW SESSION STATUS LIKE 'Sort_merge_passes'", []sql.Row{{"Sort_merge_passes", uint64(3)}}, nil, nil)
+	enginetest.TestQueryWithContext(t, ctx, e, harness, "FLUSH STATUS", []sql.Row{{types.NewOkResult(0)}}, nil, nil)
+	enginetest.TestQueryWithContext(t, ctx, e, harness, "SHOW SESSION STATUS LIKE 'Sort_merge_passes'", []sql.Row{{"Sort_merge_passes", uint64(0)}}, nil, nil)
+	// The global values still count the events of the session
+	require.Equal(global+3, sql.SortMergePasses.GlobalValue())
+}
+
+// recordingRouter is a sqle.ReadWriteRouter that records the classes of the statements it routes.
+type recordingRouter struct {
+	*sqle.ReadWriteRouter
+	classes []sql.StatementClass
+}
+
+func (r *recordingRouter) RouteStatement(ctx *sql.Context, class sql.StatementClass) (sql.DatabaseProvider, error) {
+	r.classes = append(r.classes, class)
+	return r.ReadWriteRouter.RouteStatement(ctx, class)
+}
+
+func TestStatementRouter(t *testing.T) {
+	require := require.New(t)
+
+	// query returns the rows of the query given, run by the engine given in the mydb database
+	query := func(e *sqle.Engine, q string) []sql.Row {
+		ctx := sql.NewEmptyContext()
+		ctx.SetCurrentDatabase("mydb")
+		sch, iter, err := e.Query(ctx, q)
+		require.NoError(err, q)
+		rows, err := sql.RowIterToRows(ctx, sch, iter)
+		require.NoError(err, q)
+		return rows
+	}
+
+	read := memory.NewDBProvider(memory.NewDatabase("mydb"))
+	write := memory.NewDBProvider(memory.NewDatabase("mydb"))
+	for _, p := range []sql.DatabaseProvider{read, write} {
+		e := sqle.NewDefault(p)
+		query(e, "CREATE TABLE t (i int primary key, s varchar(10))")
+	}
+	query(sqle.NewDefault(read), "INSERT INTO t VALUES (1, 'replica')")
+	query(sqle.NewDefault(write), "INSERT INTO t VALUES (1, 'primary')")
+
+	router := &recordingRouter{ReadWriteRouter: sqle.NewReadWriteRouter(read, write)}
+	e := sqle.New(analyzer.NewDefault(write), &sqle.Config{StatementRouter: router})
+	ctx := sql.NewEmptyContext()
+	ctx.SetCurrentDatabase("mydb")
+	run := func(q string) []sql.Row {
+		sch, iter, err := e.Query(ctx, q)
+		require.NoError(err, q)
+		rows, err := sql.RowIterToRows(ctx, sch, iter)
+		require.NoError(err, q)
+		return rows
+	}
+
+	require.Equal([]sql.Row{{"replica"}}, run("SELECT s FROM t"))
+	run("INSERT INTO t VALUES (2, 'primary')")
+	require.Equal([]sql.Row{{"replica"}}, run("SELECT s FROM t"))
+	require.Equal([]sql.Row{{"primary"}, {"primary"}}, query(sqle.NewDefault(write), "SELECT s FROM t ORDER BY i"))
+	run("CREATE TABLE u (i int primary key)")
+	require.Equal([]sql.Row{{"t"}, {"u"}}, query(sqle.NewDefault(write), "SHOW TABLES"))
+	require.Equal([]sql.Row{{"t"}}, run("SHOW TABLES"))
+	require.Equal([]sql.StatementClass{
+		sql.StatementClassRead,
+		sql.StatementClassWrite,
+		sql.StatementClassRead,
+		sql.StatementClassDDL,
+		sql.StatementClassRead,
+	}, router.classes)
+
+	// The statements of a transaction are routed to the write provider once it writes, until it ends
+	router.classes = nil
+	run("START TRANSACTION")
+	require.Equal([]sql.Row{{"replica"}}, run("SELECT s FROM t"))
+	run("UPDATE t SET s = 'updated' WHERE i = 1")
+	require.Equal([]sql.Row{{"updated"}, {"primary"}}, run("SELECT s FROM t ORDER BY i"))
+	require.Equal([]sql.Row{{"t"}, {"u"}}, run("SHOW TABLES"))
+	run("COMMIT")
+	require.Equal([]sql.Row{{"replica"}}, run("SELECT s FROM t"))
+	require.Equal([]sql.StatementClass{
+		sql.StatementClassTransaction,
+		sql.StatementClassRead,
+		sql.StatementClassWrite,
+		sql.StatementClassRead,
+	}, router.classes)
+
+	// Statements that read and write are routed as writes
+	router.classes = nil
+	run("INSERT INTO u SELECT i FROM t")
+	require.Equal([]sql.Row{{int32(1)}, {int32(2)}}, query(sqle.NewDefault(write), "SELECT i FROM u ORDER BY i"))
+	require.Equal([]sql.StatementClass{sql.StatementClassWrite}, router.classes)
+}
+
 var _ sql.PartitionCounter = (*nonIndexableTable)(nil)
 
 func (t *nonIndexableTable) PartitionCount(ctx *sql.Context) (int64, error) {
@@ -1041,7 +1540,116 @@ func TestTimestampBindingsCanBeCompared(t *testing.T) {
 	require.Equal(t, 1, count)
 }
 
+func TestCallResultSets(t *testing.T) {
+	port, close := newTestServer(nil, false)
+	defer close()
+	open := func(params string) *sql2.DB {
+		db, err := sql2.Open("mysql", fmt.Sprintf("root:@tcp(localhost:%d)/mydb%s", port, params))
+		require.NoError(t, err)
+		// The statements run on a single connection, so that they see the same session
+		db.SetMaxOpenConns(1)
+		return db
+	}
+	db := open("")
+	defer db.Close()
+	multiDb := open("?multiStatements=true")
+	defer multiDb.Close()
+
+	_, err := db.Exec("CREATE TABLE t (i INT PRIMARY KEY)")
+	require.NoError(t, err)
+	_, err = db.Exec(`CREATE PROCEDURE p(n INT)
+BEGIN
+	SELECT 1 AS a, 'x' AS b;
+	INSERT INTO t VALUES (n);
+	IF n > 1 THEN
+		SELECT i FROM t WHERE i = n;
+	END IF;
+	BEGIN
+		DECLARE j INT DEFAULT 0;
+		WHILE j < 2 DO
+			SELECT j;
+			SET j = j + 1;
+		END WHILE;
+	END;
+END`)
+	require.NoError(t, err)
+
+	readResultSets := func(rows *sql2.Rows) [][]string {
+		var sets [][]string
+		for {
+			columns, err := rows.Columns()
+			require.NoError(t, err)
+			set := []string{strings.Join(columns, ",")}
+			for rows.Next() {
+				vals := make([]any, len(columns))
+				strs := make([]string, len(columns))
+				for i := range vals {
+					vals[i] = &strs[i]
+				}
+				require.NoError(t, rows.Scan(vals...))
+				set = append(set, strings.Join(strs, ","))
+			}
+			sets = append(sets, set)
+			if !rows.NextResultSet() {
+				break
+			}
+		}
+		require.NoError(t, rows.Err())
+		require.NoError(t, rows.Close())
+		return sets
+	}
+
+	t.Run("query", func(t *testing.T) {
+		rows, err := db.Query("CALL p(1)")
+		require.NoError(t, err)
+		require.Equal(t, [][]string{
+			{"a,b", "1,x"},
+			{"j", "0"},
+			{"j", "1"},
+		}, readResultSets(rows))
+	})
+
+	// The statements following the CALL statement are run once its result sets are sent
+	t.Run("multi statements", func(t *testing.T) {
+		rows, err := multiDb.Query("CALL p(2); SELECT COUNT(*) AS c FROM t")
+		require.NoError(t, err)
+		require.Equal(t, [][]string{
+			{"a,b", "1,x"},
+			{"i", "2"},
+			{"j", "0"},
+			{"j", "1"},
+			{"c", "2"},
+		}, readResultSets(rows))
+	})
+
+	// The driver prepares queries with arguments, and the rows are sent in the binary protocol
+	t.Run("prepared statement", func(t *testing.T) {
+		rows, err := db.Query("CALL p(?)", 3)
+		require.NoError(t, err)
+		require.Equal(t, [][]string{
+			{"a,b", "1,x"},
+			{"i", "3"},
+			{"j", "0"},
+			{"j", "1"},
+		}, readResultSets(rows))
+	})
+
+	var count int
+	require.NoError(t, db.QueryRow("SELECT COUNT(*) FROM t").Scan(&count))
+	require.Equal(t, 3, count)
+}
+
 func newDatabase() (*sql2.DB, func()) {
+	port, close := newTestServer(nil, false)
+	db, err := sql2.Open("mysql", fmt.Sprintf("root:@tcp(localhost:%d)/mydb", port))
+	if err != nil {
+		panic(err)
+	}
+	return db, close
+}
+
+// newTestServer starts a server of a database named mydb, with TLS if a TLS config is given, and returns its port.
+func newTestServer(tlsConfig *tls.Config, requireSecureTransport bool) (int, func()) {
 	// Grab an empty port so that tests do not fail if a specific port is already in use
 	listener, err := net.Listen("tcp", ":0")
 	if err != nil {
@@ -1059,8 +1667,10 @@ func newDatabase() (*sql2.DB, func()) {
 		IncludeRootAccount: true,
 	})
 	cfg := server.Config{
-		Protocol: "tcp",
-		Address:  fmt.Sprintf("localhost:%d", port),
+		Protocol:               "tcp",
+		Address:                fmt.Sprintf("localhost:%d", port),
+		TLSConfig:              tlsConfig,
+		RequireSecureTransport: requireSecureTransport,
 	}
 	srv, err := server.NewDefaultServer(cfg, engine)
 	if err != nil {
@@ -1068,9 +1678,138 @@ func newDatabase() (*sql2.DB, func()) {
 	}
 	go srv.Start()
 
-	db, err := sql2.Open("mysql", fmt.Sprintf("root:@tcp(localhost:%d)/mydb", port))
-	if err != nil {
-		panic(err)
+	return port, func() { srv.Close() }
+}
+
+// newTestCertificate returns the TLS config of a server with a self-signed certificate for localhost, and that of a
+// client trusting it.
+func newTestCertificate(t *testing.T) (serverConfig *tls.Config, clientConfig *tls.Config) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "localhost"},
+		DNSNames:              []string{"localhost"},
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1"), net.ParseIP("::1")},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(cert)
+	serverConfig = &tls.Config{Certificates: []tls.Certificate{{Certificate: [][]byte{der}, PrivateKey: key}}}
+	clientConfig = &tls.Config{RootCAs: roots, ServerName: "localhost"}
+	return serverConfig, clientConfig
+}
+
+func TestTLSConnections(t *testing.T) {
+	serverConfig, clientConfig := newTestCertificate(t)
+	require.NoError(t, gosqldriver.RegisterTLSConfig("gms-test", clientConfig))
+	defer gosqldriver.DeregisterTLSConfig("gms-test")
+
+	port, close := newTestServer(serverConfig, true)
+	defer close()
+
+	secure, err := sql2.Open("mysql", fmt.Sprintf("root:@tcp(localhost:%d)/mydb?tls=gms-test", port))
+	require.NoError(t, err)
+	defer secure.Close()
+
+	_, err = secure.Exec("CREATE TABLE t (pk INT PRIMARY KEY, v VARCHAR(20))")
+	require.NoError(t, err)
+	_, err = secure.Exec("INSERT INTO t VALUES (1, 'encrypted')")
+	require.NoError(t, err)
+	var v string
+	require.NoError(t, secure.QueryRow("SELECT v FROM t WHERE pk = 1").Scan(&v))
+	require.Equal(t, "encrypted", v)
+
+	// Cleartext connections are rejected, as the server requires secure transport
+	insecure, err := sql2.Open("mysql", fmt.Sprintf("root:@tcp(localhost:%d)/mydb", port))
+	require.NoError(t, err)
+	defer insecure.Close()
+	require.Error(t, insecure.Ping())
+}
+
+func TestRequireSecureTransportVariable(t *testing.T) {
+	serverConfig, clientConfig := newTestCertificate(t)
+	require.NoError(t, gosqldriver.RegisterTLSConfig("gms-test-var", clientConfig))
+	defer gosqldriver.DeregisterTLSConfig("gms-test-var")
+
+	port, close := newTestServer(serverConfig, false)
+	defer close()
+
+	secure, err := sql2.Open("mysql", fmt.Sprintf("root:@tcp(localhost:%d)/mydb?tls=gms-test-var", port))
+	require.NoError(t, err)
+	defer secure.Close()
+	insecure, err := sql2.Open("mysql", fmt.Sprintf("root:@tcp(localhost:%d)/mydb", port))
+	require.NoError(t, err)
+	defer insecure.Close()
+	insecure.SetMaxIdleConns(0)
+
+	// Cleartext connections are accepted until require_secure_transport is turned on
+	require.NoError(t, insecure.Ping())
+
+	_, err = secure.Exec("SET GLOBAL require_secure_transport = ON")
+	require.NoError(t, err)
+	defer sql.SystemVariables.SetGlobal("require_secure_transport", int8(0))
+
+	err = insecure.Ping()
+	require.Error(t, err)
+	var mysqlErr *gosqldriver.MySQLError
+	require.ErrorAs(t, err, &mysqlErr)
+	require.Equal(t, uint16(3159), mysqlErr.Number)
+
+	var one int
+	require.NoError(t, secure.QueryRow("SELECT 1").Scan(&one))
+	require.Equal(t, 1, one)
+}
+
+func TestGenerateAlter(t *testing.T) {
+	harness := enginetest.NewDefaultMemoryHarness()
+	harness.Setup(setup.MydbData)
+	e, err := harness.NewEngine(t)
+	require.NoError(t, err)
+	defer e.Close()
+	ctx := enginetest.NewContext(harness)
+
+	enginetest.RunQuery(t, e, harness, "CREATE TABLE t (id INT PRIMARY KEY, a INT, b VARCHAR(10), c TEXT) COLLATE utf8mb4_0900_bin")
+	enginetest.RunQuery(t, e, harness, "CREATE TABLE target (c TEXT COMMENT 'moved', id INT NOT NULL, B VARCHAR(20) NOT NULL DEFAULT 'b', d DOUBLE, PRIMARY KEY (id, B)) COLLATE utf8mb4_0900_bin")
+
+	db, err := e.Analyzer.Catalog.Database(ctx, "mydb")
+	require.NoError(t, err)
+	schemaOf := func(name string) sql.PrimaryKeySchema {
+		tbl, ok, err := db.GetTableInsensitive(ctx, name)
+		require.NoError(t, err)
+		require.True(t, ok)
+		return tbl.(sql.PrimaryKeyTable).PrimaryKeySchema()
+	}
+
+	from := schemaOf("t")
+	to := schemaOf("target")
+	to.Schema = to.Schema.Copy()
+	for _, col := range to.Schema {
+		col.Source = "t"
+	}
+	stmts, err := sql.GenerateAlter(from, to)
+	require.NoError(t, err)
+	for _, stmt := range stmts {
+		enginetest.RunQuery(t, e, harness, stmt)
+	}
+
+	showCreate := func(name string) string {
+		_, iter, err := e.Query(ctx, "SHOW CREATE TABLE "+name)
+		require.NoError(t, err)
+		rows, err := sql.RowIterToRows(ctx, nil, iter)
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+		return strings.Replace(rows[0][1].(string), "`"+name+"`", "`t`", 1)
 	}
-	return db, func() { srv.Close() }
+	require.Equal(t, showCreate("target"), showCreate("t"))
 }
@@ -16,10 +16,12 @@ package enginetest
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -370,7 +372,7 @@ func TestIndexQueryPlans(t *testing.T, harness Harness) {
 		RunQuery(t, e, harness, `CREATE INDEX idx1 ON otherdb.a (y);`)
 
 		TestQueryWithContext(t, ctx, e, harness, "SHOW INDEXES FROM otherdb.a", []sql.Row{
-			{"a", 1, "idx1", 1, "y", nil, 0, nil, nil, "YES", "BTREE", "", "", "YES", nil},
+			{"a", 1, "idx1", 1, "y", "A", 0, nil, nil, "YES", "BTREE", "", "", "YES", nil},
 		}, nil, nil)
 
 	})
@@ -445,13 +447,7 @@ func TestQueryPlan(t *testing.T, harness Harness, e *sqle.Engine, query, expecte
 			}
 		}
 
-		var cmp string
-		if verbose {
-			cmp = sql.DebugString(ExtractQueryNode(node))
-		} else {
-			cmp = ExtractQueryNode(node).String()
-		}
-		assert.Equal(t, expectedPlan, cmp, "Unexpected result for query: "+query)
+		assertQueryPlan(t, expectedPlan, ExtractQueryNode(node), verbose, query)
 	})
 
 }
@@ -471,14 +467,35 @@ func TestQueryPlanWithEngine(t *testing.T, harness Harness, e *sqle.Engine, tt q
 			}
 		}
 
-		var cmp string
+		assertQueryPlan(t, tt.ExpectedPlan, ExtractQueryNode(node), verbose, tt.Query)
+	})
+}
+
+// assertQueryPlan asserts that the plan of the query given prints as expected. Plans expected in the format nodes were
+// printed in before identifiers were quoted and tables qualified with their database fail with a message saying so,
+// rather than with a diff of every table in them.
+func assertQueryPlan(t *testing.T, expectedPlan string, node sql.Node, verbose bool, query string) {
+	printPlan := func() string {
 		if verbose {
-			cmp = sql.DebugString(ExtractQueryNode(node))
-		} else {
-			cmp = ExtractQueryNode(node).String()
+			return sql.DebugString(node)
 		}
-		assert.Equal(t, tt.ExpectedPlan, cmp, "Unexpected result for query: "+tt.Query)
-	})
+		return node.String()
+	}
+
+	cmp := printPlan()
+	if cmp != expectedPlan {
+		plan.LegacyNodeFormat = true
+		legacy := printPlan()
+		plan.LegacyNodeFormat = false
+		if legacy == expectedPlan {
+			assert.Fail(t, "Expected plan is in the legacy node format",
+				"The plan of query %s matches the expected one when printed with plan.LegacyNodeFormat. Plans now "+
+					"quote identifiers when needed, qualify tables with their database and print the names of the "+
+					"indexes of indexed table accesses. Regenerate the expected plan, which is now:\n%s", query, cmp)
+			return
+		}
+	}
+	assert.Equal(t, expectedPlan, cmp, "Unexpected result for query: "+query)
 }
 
 func TestOrderByGroupBy(t *testing.T, harness Harness) {
@@ -712,6 +729,11 @@ func TestReplaceInto(t *testing.T, harness Harness) {
 	for _, tt := range queries.ReplaceQueries {
 		RunWriteQueryTest(t, harness, tt)
 	}
+
+	harness.Setup(setup.MydbData)
+	for _, script := range queries.ReplaceScripts {
+		TestScript(t, harness, script)
+	}
 }
 
 func TestReplaceIntoErrors(t *testing.T, harness Harness) {
@@ -726,6 +748,10 @@ func TestUpdate(t *testing.T, harness Harness) {
 	for _, tt := range queries.UpdateTests {
 		RunWriteQueryTest(t, harness, tt)
 	}
+
+	for _, script := range queries.UpdateScripts {
+		TestScript(t, harness, script)
+	}
 }
 
 func TestUpdateIgnore(t *testing.T, harness Harness) {
@@ -769,6 +795,11 @@ func TestDelete(t *testing.T, harness Harness) {
 			RunWriteQueryTest(t, harness, tt)
 		}
 	})
+	t.Run("Delete scripts", func(t *testing.T) {
+		for _, script := range queries.DeleteScripts {
+			TestScript(t, harness, script)
+		}
+	})
 	t.Run("Delete from join", func(t *testing.T) {
 		// Run tests with each biased coster to get coverage over join types
 		for name, coster := range biasedCosters {
@@ -789,6 +820,10 @@ func TestUpdateQueriesPrepared(t *testing.T, harness Harness) {
 	for _, tt := range queries.UpdateTests {
 		runWriteQueryTestPrepared(t, harness, tt)
 	}
+
+	for _, script := range queries.UpdateScripts {
+		TestScriptPrepared(t, harness, script)
+	}
 }
 
 func TestDeleteQueriesPrepared(t *testing.T, harness Harness) {
@@ -798,6 +833,11 @@ func TestDeleteQueriesPrepared(t *testing.T, harness Harness) {
 			runWriteQueryTestPrepared(t, harness, tt)
 		}
 	})
+	t.Run("Delete scripts", func(t *testing.T) {
+		for _, script := range queries.DeleteScripts {
+			TestScriptPrepared(t, harness, script)
+		}
+	})
 	t.Run("Delete from join", func(t *testing.T) {
 		for _, tt := range queries.DeleteJoinTests {
 			runWriteQueryTestPrepared(t, harness, tt)
@@ -843,12 +883,12 @@ func TestTruncate(t *testing.T, harness Harness) {
 		RunQuery(t, e, harness, "CREATE TABLE t1 (pk BIGINT PRIMARY KEY, v1 BIGINT, INDEX(v1))")
 		RunQuery(t, e, harness, "INSERT INTO t1 VALUES (1,1), (2,2), (3,3)")
 		TestQueryWithContext(t, ctx, e, harness, "SELECT * FROM t1 ORDER BY 1", []sql.Row{{int64(1), int64(1)}, {int64(2), int64(2)}, {int64(3), int64(3)}}, nil, nil)
-		TestQueryWithContext(t, ctx, e, harness, "TRUNCATE t1", []sql.Row{{types.NewOkResult(3)}}, nil, nil)
+		TestQueryWithContext(t, ctx, e, harness, "TRUNCATE t1", []sql.Row{{types.NewOkResult(0)}}, nil, nil)
 		TestQueryWithContext(t, ctx, e, harness, "SELECT * FROM t1 ORDER BY 1", []sql.Row{}, nil, nil)
 
 		RunQuery(t, e, harness, "INSERT INTO t1 VALUES (4,4), (5,5)")
 		TestQueryWithContext(t, ctx, e, harness, "SELECT * FROM t1 WHERE v1 > 0 ORDER BY 1", []sql.Row{{int64(4), int64(4)}, {int64(5), int64(5)}}, nil, nil)
-		TestQueryWithContext(t, ctx, e, harness, "TRUNCATE TABLE t1", []sql.Row{{types.NewOkResult(2)}}, nil, nil)
+		TestQueryWithContext(t, ctx, e, harness, "TRUNCATE TABLE t1", []sql.Row{{types.NewOkResult(0)}}, nil, nil)
 		TestQueryWithContext(t, ctx, e, harness, "SELECT * FROM t1 ORDER BY 1", []sql.Row{}, nil, nil)
 	})
 
@@ -866,7 +906,7 @@ func TestTruncate(t *testing.T, harness Harness) {
 		RunQuery(t, e, harness, "CREATE TRIGGER trig_t3 BEFORE DELETE ON t3 FOR EACH ROW INSERT INTO t3i VALUES (old.pk, old.v1)")
 		RunQuery(t, e, harness, "INSERT INTO t3 VALUES (1,1), (3,3)")
 		TestQueryWithContext(t, ctx, e, harness, "SELECT * FROM t3 ORDER BY 1", []sql.Row{{int64(1), int64(1)}, {int64(3), int64(3)}}, nil, nil)
-		TestQueryWithContext(t, ctx, e, harness, "TRUNCATE t3", []sql.Row{{types.NewOkResult(2)}}, nil, nil)
+		TestQueryWithContext(t, ctx, e, harness, "TRUNCATE t3", []sql.Row{{types.NewOkResult(0)}}, nil, nil)
 		TestQueryWithContext(t, ctx, e, harness, "SELECT * FROM t3 ORDER BY 1", []sql.Row{}, nil, nil)
 		TestQueryWithContext(t, ctx, e, harness, "SELECT * FROM t3i ORDER BY 1", []sql.Row{}, nil, nil)
 	})
@@ -875,7 +915,7 @@ func TestTruncate(t *testing.T, harness Harness) {
 		RunQuery(t, e, harness, "CREATE TABLE t4 (pk BIGINT AUTO_INCREMENT PRIMARY KEY, v1 BIGINT)")
 		RunQuery(t, e, harness, "INSERT INTO t4(v1) VALUES (5), (6)")
 		TestQueryWithContext(t, ctx, e, harness, "SELECT * FROM t4 ORDER BY 1", []sql.Row{{int64(1), int64(5)}, {int64(2), int64(6)}}, nil, nil)
-		TestQueryWithContext(t, ctx, e, harness, "TRUNCATE t4", []sql.Row{{types.NewOkResult(2)}}, nil, nil)
+		TestQueryWithContext(t, ctx, e, harness, "TRUNCATE t4", []sql.Row{{types.NewOkResult(0)}}, nil, nil)
 		TestQueryWithContext(t, ctx, e, harness, "SELECT * FROM t4 ORDER BY 1", []sql.Row{}, nil, nil)
 		RunQuery(t, e, harness, "INSERT INTO t4(v1) VALUES (7)")
 		TestQueryWithContext(t, ctx, e, harness, "SELECT * FROM t4 ORDER BY 1", []sql.Row{{int64(1), int64(7)}}, nil, nil)
@@ -1847,6 +1887,21 @@ func getClient(query string) string {
 	return strings.TrimSpace(strings.TrimPrefix(query, "client"))
 }
 
+// TestLowerCaseTableNames runs the scripts of queries.LowerCaseTableNamesTests, each with the lower_case_table_names
+// system variable set to its mode.
+func TestLowerCaseTableNames(t *testing.T, harness Harness) {
+	_, mode, _ := sql.SystemVariables.GetGlobal("lower_case_table_names")
+	defer func() {
+		require.NoError(t, sql.SystemVariables.AssignValues(map[string]interface{}{"lower_case_table_names": mode}))
+	}()
+
+	for _, script := range queries.LowerCaseTableNamesTests {
+		require.NoError(t, sql.SystemVariables.AssignValues(map[string]interface{}{"lower_case_table_names": script.Mode}))
+		harness.Setup(setup.MydbData)
+		TestScript(t, harness, script.ScriptTest)
+	}
+}
+
 func TestViews(t *testing.T, harness Harness) {
 	harness.Setup(setup.MydbData, setup.MytableData)
 	e := mustNewEngine(t, harness)
@@ -2561,6 +2616,10 @@ func TestAddColumn(t *testing.T, harness Harness) {
 			{Name: "s10", Type: types.MustCreateStringWithDefaults(sqltypes.VarChar, 26), Source: "mytable", Nullable: true},
 		}, tbl.Schema())
 	})
+
+	for _, script := range queries.AddColumnScripts {
+		TestScript(t, harness, script)
+	}
 }
 
 // todo(max): convert to WriteQueryTest
@@ -2660,6 +2719,10 @@ func TestModifyColumn(t *testing.T, harness Harness) {
 			{Name: "i2", Type: types.Int64, Source: "mytable", Nullable: true},
 		}, tbl.Schema())
 	})
+
+	for _, script := range queries.ModifyColumnScripts {
+		TestScript(t, harness, script)
+	}
 }
 
 // todo(max): convert to WriteQueryTest
@@ -4673,6 +4736,9 @@ func TestShowTableStatus(t *testing.T, harness Harness) {
 	for _, tt := range queries.ShowTableStatusQueries {
 		TestQuery(t, harness, tt.Query, tt.Expected, nil, nil)
 	}
+	for _, script := range queries.ShowTableStatusScripts {
+		TestScript(t, harness, script)
+	}
 }
 
 func TestDateParse(t *testing.T, harness Harness) {
@@ -4842,11 +4908,13 @@ func TestUse(t *testing.T, harness Harness) {
 	require.Len(rows, 0)
 
 	require.Equal("foo", ctx.GetCurrentDatabase())
+	TestQueryWithContext(t, ctx, e, harness, "SELECT DATABASE(), SCHEMA()", []sql.Row{{"foo", "foo"}}, nil, nil)
 
 	_, _, err = e.Query(ctx, "USE MYDB")
 	require.NoError(err)
 
 	require.Equal("mydb", ctx.GetCurrentDatabase())
+	TestQueryWithContext(t, ctx, e, harness, "SELECT DATABASE(), SCHEMA()", []sql.Row{{"mydb", "mydb"}}, nil, nil)
 }
 
 // TestConcurrentTransactions tests that two concurrent processes/transactions can successfully execute without early
@@ -4907,9 +4975,13 @@ func TestNoDatabaseSelected(t *testing.T, harness Harness) {
 	AssertErrWithCtx(t, e, harness, ctx, "create table a (b int primary key)", sql.ErrNoDatabaseSelected)
 	AssertErrWithCtx(t, e, harness, ctx, "show tables", sql.ErrNoDatabaseSelected)
 	AssertErrWithCtx(t, e, harness, ctx, "show triggers", sql.ErrNoDatabaseSelected)
+	TestQueryWithContext(t, ctx, e, harness, "SELECT DATABASE(), SCHEMA()", []sql.Row{{nil, nil}}, nil, nil)
 
 	_, _, err := e.Query(ctx, "ROLLBACK")
 	require.NoError(t, err)
+
+	RunQueryWithContext(t, e, harness, ctx, "USE mydb")
+	TestQueryWithContext(t, ctx, e, harness, "SELECT DATABASE(), SCHEMA()", []sql.Row{{"mydb", "mydb"}}, nil, nil)
 }
 
 func TestSessionSelectLimit(t *testing.T, harness Harness) {
@@ -4954,11 +5026,98 @@ func TestSessionSelectLimit(t *testing.T, harness Harness) {
 			Query:    "select y from a where x < 1 union select y from a where x > 1",
 			Expected: []sql.Row{{1}, {2}},
 		},
+		{
+			Query:    "select x from a where x < 3 union all select x from a where x > 3",
+			Expected: []sql.Row{{0}, {1}},
+		},
+		{
+			Query:    "select x from a where x < 3 union all select x from a where x > 3 limit 5",
+			Expected: []sql.Row{{0}, {1}, {2}, {4}, {5}},
+		},
+		{
+			Query:    "with recursive t (n) as (select 1 union all select n + 1 from t where n < 5) select n from t",
+			Expected: []sql.Row{{1}, {2}},
+		},
+		{
+			Query:    "select x from a order by x limit 4",
+			Expected: []sql.Row{{0}, {1}, {2}, {3}},
+		},
+		{
+			Query:    "insert into b select x from a",
+			Expected: []sql.Row{{types.NewOkResult(7)}},
+		},
+		{
+			Query:    "select count(*) from b",
+			Expected: []sql.Row{{7}},
+		},
+		{
+			Query:    "create table c as select x from a",
+			Expected: []sql.Row{{types.NewOkResult(7)}},
+		},
+		{
+			Query:    "select count(*) from c",
+			Expected: []sql.Row{{7}},
+		},
+		{
+			Query:    "prepare s from 'select x from a order by x'",
+			Expected: []sql.Row{{types.OkResult{Info: plan.PrepareInfo{}}}},
+		},
+		{
+			Query:    "execute s",
+			Expected: []sql.Row{{0}, {1}},
+		},
+		{
+			Query:    "set sql_select_limit = 3",
+			Expected: []sql.Row{{}},
+		},
+		{
+			Query:    "execute s",
+			Expected: []sql.Row{{0}, {1}, {2}},
+		},
+		{
+			Query: "explain select x from a order by x",
+			Expected: []sql.Row{
+				{"Limit(3)"},
+				{" └─ IndexedTableAccess(mydb.a)"},
+				{"     ├─ index: `PRIMARY` [a.x]"},
+				{"     ├─ filters: [{[NULL, ∞)}]"},
+				{"     └─ columns: [x]"},
+			},
+		},
+		{
+			Query:    "set sql_select_limit = 18446744073709551615",
+			Expected: []sql.Row{{}},
+		},
+		{
+			Query:    "select count(*) from (select x from a) t where x < 5",
+			Expected: []sql.Row{{5}},
+		},
+		{
+			Query:    "select x from a where x < 5",
+			Expected: []sql.Row{{0}, {1}, {2}, {3}, {4}},
+		},
+		{
+			Query:    "set sql_select_limit = 1",
+			Expected: []sql.Row{{}},
+		},
+		{
+			Query:    "select x from a order by x",
+			Expected: []sql.Row{{0}},
+		},
+		{
+			Query:    "set sql_select_limit = default",
+			Expected: []sql.Row{{}},
+		},
+		{
+			Query:    "execute s",
+			Expected: []sql.Row{{0}, {1}, {2}, {3}, {4}, {5}, {6}},
+		},
 	}
 
 	customSetup := []setup.SetupScript{{
 		"Create table a (x int primary key, y int);",
 		"Insert into a values (0,1), (1,1), (2,2), (3,2), (4,2), (5,3),(6,3);",
+		"Create table b (x int primary key);",
 	}}
 	harness.Setup(setup.MydbData, setup.MytableData, customSetup)
 	e := mustNewEngine(t, harness)
@@ -5239,7 +5398,7 @@ func TestAddDropPks(t *testing.T, harness Harness) {
 
 		// Assert that the pk is not primary key
 		TestQueryWithContext(t, ctx, e, harness, `SHOW CREATE TABLE newdb.tab1`, []sql.Row{
-			{"tab1", "CREATE TABLE `tab1` (\n  `pk` int NOT NULL,\n  `c1` int,\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"},
+			{"tab1", "CREATE TABLE `tab1` (\n  `pk` int NOT NULL,\n  `c1` int DEFAULT NULL,\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"},
 		}, nil, nil)
 
 		// Drop all primary key from other database table
@@ -5247,7 +5406,7 @@ func TestAddDropPks(t *testing.T, harness Harness) {
 
 		// Assert that NOT NULL constraint is kept
 		TestQueryWithContext(t, ctx, e, harness, `SHOW CREATE TABLE newdb.tab1`, []sql.Row{
-			{"tab1", "CREATE TABLE `tab1` (\n  `pk` int NOT NULL,\n  `c1` int\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"},
+			{"tab1", "CREATE TABLE `tab1` (\n  `pk` int NOT NULL,\n  `c1` int DEFAULT NULL\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"},
 		}, nil, nil)
 	})
 
@@ -5320,7 +5479,7 @@ func TestAddAutoIncrementColumn(t *testing.T, harness Harness) {
 			t, ctx, e, harness,
 			"show create table t1;",
 			[]sql.Row{
-				{"t1", "CREATE TABLE `t1` (\n  `i` int,\n  `j` int,\n  `pk` int NOT NULL AUTO_INCREMENT,\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"},
+				{"t1", "CREATE TABLE `t1` (\n  `i` int DEFAULT NULL,\n  `j` int DEFAULT NULL,\n  `pk` int NOT NULL AUTO_INCREMENT,\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"},
 			},
 			nil, nil,
 		)
@@ -5352,7 +5511,7 @@ func TestAddAutoIncrementColumn(t *testing.T, harness Harness) {
 			t, ctx, e, harness,
 			"show create table t2;",
 			[]sql.Row{
-				{"t2", "CREATE TABLE `t2` (\n  `pk` int NOT NULL AUTO_INCREMENT,\n  `i` int,\n  `j` int,\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"},
+				{"t2", "CREATE TABLE `t2` (\n  `pk` int NOT NULL AUTO_INCREMENT,\n  `i` int DEFAULT NULL,\n  `j` int DEFAULT NULL,\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"},
 			},
 			nil, nil,
 		)
@@ -5511,7 +5670,7 @@ func TestAlterTable(t *testing.T, harness Harness) {
 			Query: "show create table t34",
 			Expected: []sql.Row{{"t34", "CREATE TABLE `t34` (\n" +
 				"  `i` bigint NOT NULL,\n" +
-				"  `s` varchar(20),\n" +
+				"  `s` varchar(20) DEFAULT NULL,\n" +
 				"  PRIMARY KEY (`i`)\n" +
 				") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
 		}
@@ -5528,7 +5687,7 @@ func TestAlterTable(t *testing.T, harness Harness) {
 			Query: "show create table t42",
 			Expected: []sql.Row{{"t42", "CREATE TABLE `t42` (\n" +
 				"  `i` bigint NOT NULL,\n" +
-				"  `s` varchar(20),\n" +
+				"  `s` varchar(20) DEFAULT NULL,\n" +
 				"  PRIMARY KEY (`i`)\n" +
 				") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
 		}
@@ -5546,8 +5705,8 @@ func TestAlterTable(t *testing.T, harness Harness) {
 			Query: "show create table t41",
 			Expected: []sql.Row{{"t41", "CREATE TABLE `t41` (\n" +
 				"  `i` bigint NOT NULL,\n" +
-				"  `s` varchar(20),\n" +
-				"  `k` int,\n" +
+				"  `s` varchar(20) DEFAULT NULL,\n" +
+				"  `k` int DEFAULT NULL,\n" +
 				"  PRIMARY KEY (`i`),\n" +
 				"  CONSTRAINT `k_check` CHECK ((`k` < 123))\n" +
 				") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
@@ -5565,9 +5724,9 @@ func TestAlterTable(t *testing.T, harness Harness) {
 			Query: "show create table t43",
 			Expected: []sql.Row{{"t43", "CREATE TABLE `t43` (\n" +
 				"  `i` bigint NOT NULL,\n" +
-				"  `s` varchar(20),\n" +
-				"  `j` int,\n" +
-				"  `k` int,\n" +
+				"  `s` varchar(20) DEFAULT NULL,\n" +
+				"  `j` int DEFAULT NULL,\n" +
+				"  `k` int DEFAULT NULL,\n" +
 				"  PRIMARY KEY (`i`),\n" +
 				"  CONSTRAINT `test_check` CHECK ((`j` < `k`))\n" +
 				") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
@@ -5584,7 +5743,7 @@ func TestAlterTable(t *testing.T, harness Harness) {
 		TestQueryWithContext(t, ctx, e, harness, "show create table t35",
 			[]sql.Row{{"t35", "CREATE TABLE `t35` (\n" +
 				"  `i` bigint NOT NULL,\n" +
-				"  `s` varchar(20),\n" +
+				"  `s` varchar(20) DEFAULT NULL,\n" +
 				"  PRIMARY KEY (`i`),\n" +
 				"  UNIQUE KEY `test_key` (`s`)\n" +
 				") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
@@ -5641,7 +5800,7 @@ func TestAlterTable(t *testing.T, harness Harness) {
 			Query: "show create table t38;",
 			Expected: []sql.Row{{"t38", "CREATE TABLE `t38` (\n" +
 				"  `pk` int NOT NULL,\n" +
-				"  `col1` int,\n" +
+				"  `col1` int DEFAULT NULL,\n" +
 				"  PRIMARY KEY (`pk`)\n" +
 				") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
 		}
@@ -5655,8 +5814,8 @@ func TestAlterTable(t *testing.T, harness Harness) {
 			Query: "show create table t39;",
 			Expected: []sql.Row{{"t39", "CREATE TABLE `t39` (\n" +
 				"  `pk` int NOT NULL,\n" +
-				"  `col1` int,\n" +
-				"  `col2` int,\n" +
+				"  `col1` int DEFAULT NULL,\n" +
+				"  `col2` int DEFAULT NULL,\n" +
 				"  PRIMARY KEY (`pk`)\n" +
 				") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
 		}
@@ -5951,7 +6110,7 @@ func TestColumnDefaults(t *testing.T, harness Harness) {
 		TestQueryWithContext(t, ctx, e, harness, "SELECT * FROM t29 ORDER BY 1", []sql.Row{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}}, nil, nil)
 		TestQueryWithContext(t, ctx, e, harness, "SHOW CREATE TABLE t29", []sql.Row{{"t29", "CREATE TABLE `t29` (\n" +
 			"  `pk` bigint NOT NULL,\n" +
-			"  `v1y` bigint,\n" +
+			"  `v1y` bigint DEFAULT NULL,\n" +
 			"  `v2` bigint DEFAULT ((v1y + 1)),\n" +
 			"  PRIMARY KEY (`pk`)\n" +
 			") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}}, nil, nil)
@@ -6098,10 +6257,12 @@ func TestColumnDefaults(t *testing.T, harness Harness) {
 func TestPersist(t *testing.T, harness Harness, newPersistableSess func(ctx *sql.Context) sql.PersistableSession) {
 	q := []struct {
 		Name            string
+		SetUpScript     []string
 		Query           string
 		Expected        []sql.Row
 		ExpectedGlobal  interface{}
 		ExpectedPersist interface{}
+		NotPersisted    bool
 	}{
 		{
 			Query:           "SET PERSIST max_connections = 1000;",
@@ -6118,6 +6279,28 @@ func TestPersist(t *testing.T, harness Harness, newPersistableSess func(ctx *sql
 			Expected:        []sql.Row{{}},
 			ExpectedGlobal:  int64(151),
 			ExpectedPersist: int64(1000),
+		}, {
+			SetUpScript:    []string{"SET PERSIST max_connections = 1000"},
+			Query:          "RESET PERSIST max_connections;",
+			Expected:       []sql.Row{{}},
+			ExpectedGlobal: int64(1000),
+			NotPersisted:   true,
+		}, {
+			SetUpScript:    []string{"SET PERSIST_ONLY max_connections = 1000"},
+			Query:          "RESET PERSIST IF EXISTS MAX_CONNECTIONS",
+			Expected:       []sql.Row{{}},
+			ExpectedGlobal: int64(151),
+			NotPersisted:   true,
+		}, {
+			SetUpScript:    []string{"SET PERSIST max_connections = 1000", "SET PERSIST_ONLY net_read_timeout = 100"},
+			Query:          "RESET PERSIST",
+			Expected:       []sql.Row{{}},
+			ExpectedGlobal: int64(1000),
+			NotPersisted:   true,
+		}, {
+			Query:        "RESET PERSIST max_connections",
+			Expected:     []sql.Row{{}},
+			NotPersisted: true,
 		},
 	}
 
@@ -6131,6 +6314,9 @@ func TestPersist(t *testing.T, harness Harness, newPersistableSess func(ctx *sql
 			ctx := NewContext(harness)
 			ctx.Session = newPersistableSess(ctx)
 
+			for _, q := range tt.SetUpScript {
+				RunQueryWithContext(t, e, harness, ctx, q)
+			}
 			TestQueryWithContext(t, ctx, e, harness, tt.Query, tt.Expected, nil, nil)
 
 			if tt.ExpectedGlobal != nil {
@@ -6147,8 +6333,469 @@ func TestPersist(t *testing.T, harness Harness, newPersistableSess func(ctx *sql
 				assert.Equal(t,
 					tt.ExpectedPersist, res)
 			}
+
+			if tt.NotPersisted {
+				res, err := ctx.Session.(sql.PersistableSession).GetPersistedValue("max_connections")
+				require.NoError(t, err)
+				assert.Nil(t, res)
+			}
+		})
+	}
+}
+
+func TestValidateQuery(t *testing.T, harness ClientHarness) {
+	harness.Setup(setup.MydbData, setup.MytableData)
+	e := mustNewEngine(t, harness)
+	defer e.Close()
+
+	ctx := NewContext(harness)
+	ctx.NewCtxWithClient(sql.Client{
+		User:    "root",
+		Address: "localhost",
+	})
+	e.Analyzer.Catalog.MySQLDb.AddRootAccount()
+	e.Analyzer.Catalog.MySQLDb.SetPersister(&mysql_db.NoopPersister{})
+	RunQueryWithContext(t, e, harness, ctx, "CREATE USER tester@localhost")
+	RunQueryWithContext(t, e, harness, ctx, "GRANT SELECT ON mydb.mytable TO tester@localhost")
+
+	t.Run("select", func(t *testing.T) {
+		sch, err := e.ValidateQuery(ctx, "SELECT i, s AS str FROM mytable")
+		require.NoError(t, err)
+		require.Len(t, sch, 2)
+		require.Equal(t, "i", sch[0].Name)
+		require.Equal(t, types.Int64, sch[0].Type)
+		require.Equal(t, "str", sch[1].Name)
+	})
+
+	t.Run("ddl isn't executed", func(t *testing.T) {
+		sch, err := e.ValidateQuery(ctx, "CREATE TABLE validated (a int primary key)")
+		require.NoError(t, err)
+		require.Equal(t, types.OkResultSchema, sch)
+		TestQueryWithContext(t, ctx, e, harness, "SHOW TABLES LIKE 'validated'", nil, nil, nil)
+	})
+
+	t.Run("dml isn't executed", func(t *testing.T) {
+		_, err := e.ValidateQuery(ctx, "DELETE FROM mytable")
+		require.NoError(t, err)
+		TestQueryWithContext(t, ctx, e, harness, "SELECT count(*) FROM mytable", []sql.Row{{3}}, nil, nil)
+	})
+
+	t.Run("syntax error", func(t *testing.T) {
+		_, err := e.ValidateQuery(ctx, "SELEC i FROM mytable")
+		require.True(t, sql.ErrSyntaxError.Is(err), "unexpected error %v", err)
+	})
+
+	t.Run("unknown table", func(t *testing.T) {
+		_, err := e.ValidateQuery(ctx, "SELECT i FROM nosuchtable")
+		require.True(t, sql.ErrTableNotFound.Is(err), "unexpected error %v", err)
+	})
+
+	t.Run("unknown column", func(t *testing.T) {
+		_, err := e.ValidateQuery(ctx, "SELECT nosuchcolumn FROM mytable")
+		require.True(t, sql.ErrColumnNotFound.Is(err), "unexpected error %v", err)
+	})
+
+	t.Run("missing privileges", func(t *testing.T) {
+		userCtx := NewContextWithClient(harness, sql.Client{
+			User:    "tester",
+			Address: "localhost",
 		})
+		_, err := e.ValidateQuery(userCtx, "SELECT i FROM mytable")
+		require.NoError(t, err)
+		_, err = e.ValidateQuery(userCtx, "DELETE FROM mytable")
+		require.True(t, sql.ErrPrivilegeCheckFailed.Is(err), "unexpected error %v", err)
+	})
+}
+
+// rowChangeRecorder is a sql.RowChangeListener recording the changes it's given as strings, numbering the
+// transactions in the order they're seen.
+type rowChangeRecorder struct {
+	mu      sync.Mutex
+	changes []string
+	txNums  map[uint64]int
+}
+
+func (r *rowChangeRecorder) txNum(txID uint64) int {
+	if _, ok := r.txNums[txID]; !ok {
+		r.txNums[txID] = len(r.txNums) + 1
 	}
+	return r.txNums[txID]
+}
+
+func (r *rowChangeRecorder) RowChanged(ctx *sql.Context, database, table string, op sql.RowChangeOperation, oldRow, newRow sql.Row, txID uint64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.changes = append(r.changes, fmt.Sprintf("tx%d %s %s.%s %v %v", r.txNum(txID), op, database, table, oldRow, newRow))
+}
+
+func (r *rowChangeRecorder) TransactionCommitted(ctx *sql.Context, txID uint64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.changes = append(r.changes, fmt.Sprintf("tx%d commit", r.txNum(txID)))
+}
+
+func (r *rowChangeRecorder) TransactionRolledBack(ctx *sql.Context, txID uint64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.changes = append(r.changes, fmt.Sprintf("tx%d rollback", r.txNum(txID)))
+}
+
+func (r *rowChangeRecorder) take() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	changes := r.changes
+	r.changes = nil
+	return changes
+}
+
+func TestRowChangeListener(t *testing.T, harness Harness) {
+	harness.Setup(setup.MydbData)
+	e := mustNewEngine(t, harness)
+	defer e.Close()
+
+	ctx := NewContext(harness)
+	for _, q := range []string{
+		"create table parent (id int primary key, name varchar(10))",
+		"create table child (id int primary key, pid int, foreign key (pid) references parent (id) on delete cascade on update cascade)",
+		"create table loadtable (pk int primary key, c1 longtext)",
+	} {
+		RunQueryWithContext(t, e, harness, ctx, q)
+	}
+
+	recorder := &rowChangeRecorder{txNums: make(map[uint64]int)}
+	require.NoError(t, e.AddRowChangeListener(recorder))
+	ctx.ApplyOpts(sql.WithRowChangeFeed(e.RowChanges))
+	run := func(queries ...string) []string {
+		for _, q := range queries {
+			RunQueryWithContext(t, e, harness, ctx, q)
+		}
+		e.RowChanges.Flush()
+		return recorder.take()
+	}
+
+	t.Run("multi-statement transaction", func(t *testing.T) {
+		require.Equal(t, []string{
+			"tx1 insert mydb.parent [] [1 a]",
+			"tx1 insert mydb.parent [] [2 b]",
+			"tx1 insert mydb.child [] [10 1]",
+			"tx1 insert mydb.child [] [20 2]",
+			"tx1 update mydb.parent [1 a] [1 aa]",
+			"tx1 update mydb.child [10 1] [10 2]",
+			"tx1 delete mydb.parent [2 b] []",
+			"tx1 insert mydb.parent [] [2 bb]",
+			"tx1 delete mydb.parent [2 bb] []",
+			"tx1 delete mydb.child [10 2] []",
+			"tx1 delete mydb.child [20 2] []",
+			"tx1 commit",
+		}, run(
+			"START TRANSACTION",
+			"INSERT INTO parent VALUES (1, 'a'), (2, 'b')",
+			"INSERT INTO child VALUES (10, 1), (20, 2)",
+			"UPDATE parent SET name = 'aa' WHERE id = 1",
+			"UPDATE child SET pid = 2 WHERE id = 10",
+			"SET foreign_key_checks = 0",
+			"REPLACE INTO parent VALUES (2, 'bb')",
+			"SET foreign_key_checks = 1",
+			"DELETE FROM parent WHERE id = 2",
+			"COMMIT",
+		))
+	})
+
+	t.Run("autocommit statements", func(t *testing.T) {
+		require.Equal(t, []string{
+			"tx2 insert mydb.parent [] [3 c]",
+			"tx2 commit",
+			"tx3 update mydb.parent [3 c] [4 c]",
+			"tx3 commit",
+		}, run(
+			"INSERT INTO parent VALUES (3, 'c')",
+			"UPDATE parent SET id = 4 WHERE id = 3",
+		))
+	})
+
+	t.Run("failed statements and statements changing nothing", func(t *testing.T) {
+		AssertErrWithCtx(t, e, harness, ctx, "INSERT INTO parent VALUES (5, 'e'), (1, 'dup')", sql.ErrPrimaryKeyViolation)
+		require.Empty(t, run(
+			"UPDATE parent SET name = 'x' WHERE id = 100",
+			"SELECT * FROM parent",
+		))
+	})
+
+	t.Run("cascading updates", func(t *testing.T) {
+		require.Equal(t, []string{
+			"tx4 insert mydb.child [] [30 4]",
+			"tx4 commit",
+			"tx5 update mydb.parent [4 c] [5 c]",
+			"tx5 update mydb.child [30 4] [30 5]",
+			"tx5 commit",
+		}, run(
+			"INSERT INTO child VALUES (30, 4)",
+			"UPDATE parent SET id = 5 WHERE id = 4",
+		))
+	})
+
+	t.Run("delete without a where clause", func(t *testing.T) {
+		require.Equal(t, []string{
+			"tx6 delete mydb.child [30 5] []",
+			"tx6 commit",
+		}, run("DELETE FROM child"))
+	})
+
+	t.Run("load data", func(t *testing.T) {
+		require.Equal(t, []string{
+			"tx7 insert mydb.loadtable [] [1 hi]",
+			"tx7 insert mydb.loadtable [] [2 hello]",
+			"tx7 commit",
+		}, run("LOAD DATA INFILE './testdata/test2.csv' INTO TABLE loadtable FIELDS TERMINATED BY ',' IGNORE 1 LINES"))
+	})
+}
+
+func TestQueryWithPagination(t *testing.T, harness Harness) {
+	harness.Setup(setup.MydbData)
+	e := mustNewEngine(t, harness)
+	defer e.Close()
+
+	ctx := NewContext(harness)
+	RunQueryWithContext(t, e, harness, ctx, "create table paged (pk int primary key, v varchar(10), u int not null, unique key (u))")
+	RunQueryWithContext(t, e, harness, ctx, "insert into paged values (10, 'a', 9), (20, 'b', 8), (30, 'c', 7), (40, 'd', 6), (50, 'e', 5), (60, 'f', 4), (70, 'g', 3), (80, 'h', 2), (90, 'i', 1)")
+
+	// pages returns the pages of the query given, calling |between| with the number of each page read before reading
+	// the next one
+	pages := func(t *testing.T, query string, pageSize int, between func(page int)) [][]sql.Row {
+		var pages [][]sql.Row
+		token := ""
+		for {
+			_, rows, next, err := e.QueryWithPagination(ctx, query, pageSize, token)
+			require.NoError(t, err)
+			pages = append(pages, rows)
+			if next == "" {
+				return pages
+			}
+			require.Len(t, rows, pageSize)
+			if between != nil {
+				between(len(pages))
+			}
+			token = next
+		}
+	}
+
+	t.Run("ordered by primary key", func(t *testing.T) {
+		require.Equal(t, [][]sql.Row{
+			{{int32(20), "b"}, {int32(30), "c"}, {int32(40), "d"}},
+			{{int32(50), "e"}, {int32(60), "f"}, {int32(70), "g"}},
+			{{int32(80), "h"}},
+		}, pages(t, "select pk, v from paged where pk > 10 and pk < 90 order by pk", 3, nil))
+	})
+
+	t.Run("descending with an alias", func(t *testing.T) {
+		require.Equal(t, [][]sql.Row{
+			{{"i", int32(90)}, {"h", int32(80)}, {"g", int32(70)}, {"f", int32(60)}},
+			{{"e", int32(50)}, {"d", int32(40)}, {"c", int32(30)}, {"b", int32(20)}},
+			{{"a", int32(10)}},
+		}, pages(t, "select p.v, p.pk from paged p order by p.pk desc", 4, nil))
+	})
+
+	t.Run("ordered by a unique key", func(t *testing.T) {
+		require.Equal(t, [][]sql.Row{
+			{{int32(1), int32(90)}, {int32(2), int32(80)}, {int32(3), int32(70)}, {int32(4), int32(60)}, {int32(5), int32(50)}},
+			{{int32(6), int32(40)}, {int32(7), int32(30)}, {int32(8), int32(20)}, {int32(9), int32(10)}},
+		}, pages(t, "select u, pk from paged order by u", 5, nil))
+	})
+
+	t.Run("inserts between pages", func(t *testing.T) {
+		writeCtx := NewContext(harness)
+		// Rows inserted before the position of the next page aren't returned, and those after it are
+		inserts := map[int]string{
+			1: "insert into paged values (25, 'x', 100), (45, 'y', 101)",
+			2: "insert into paged values (15, 'x', 102), (95, 'y', 103)",
+		}
+		result := pages(t, "select pk from paged order by pk", 3, func(page int) {
+			if insert, ok := inserts[page]; ok {
+				RunQueryWithContext(t, e, harness, writeCtx, insert)
+			}
+		})
+		require.Equal(t, [][]sql.Row{
+			{{int32(10)}, {int32(20)}, {int32(30)}},
+			{{int32(40)}, {int32(45)}, {int32(50)}},
+			{{int32(60)}, {int32(70)}, {int32(80)}},
+			{{int32(90)}, {int32(95)}},
+		}, result)
+		RunQueryWithContext(t, e, harness, writeCtx, "delete from paged where pk % 10 = 5")
+	})
+
+	t.Run("NULLs at page boundaries", func(t *testing.T) {
+		RunQueryWithContext(t, e, harness, ctx, "create table paged_nulls (pk int primary key, n int, s varchar(10))")
+		RunQueryWithContext(t, e, harness, ctx, "insert into paged_nulls values (1, NULL, NULL), (2, NULL, ''), (3, NULL, 'a'), (4, 1, NULL), (5, 2, 'b')")
+		// NULLs are ordered first
+		require.Equal(t, [][]sql.Row{
+			{{nil, int32(1)}, {nil, int32(2)}},
+			{{nil, int32(3)}, {int32(1), int32(4)}},
+			{{int32(2), int32(5)}},
+		}, pages(t, "select n, pk from paged_nulls order by n, pk", 2, nil))
+		// and last in a descending order
+		require.Equal(t, [][]sql.Row{
+			{{int32(2), int32(5)}, {int32(1), int32(4)}, {nil, int32(1)}},
+			{{nil, int32(2)}, {nil, int32(3)}},
+		}, pages(t, "select n, pk from paged_nulls order by n desc, pk", 3, nil))
+		// An empty string isn't NULL
+		require.Equal(t, [][]sql.Row{
+			{{nil, int32(1)}, {nil, int32(4)}, {"", int32(2)}},
+			{{"a", int32(3)}, {"b", int32(5)}},
+		}, pages(t, "select s, pk from paged_nulls order by s, pk", 3, nil))
+		require.Equal(t, [][]sql.Row{
+			{{nil, int32(1)}, {nil, int32(4)}},
+			{{"", int32(2)}, {"a", int32(3)}},
+			{{"b", int32(5)}},
+		}, pages(t, "select s, pk from paged_nulls order by s, pk", 2, nil))
+	})
+
+	t.Run("page size", func(t *testing.T) {
+		_, _, _, err := e.QueryWithPagination(ctx, "select * from paged order by pk", 0, "")
+		require.True(t, sql.ErrInvalidArgument.Is(err), "unexpected error %v", err)
+	})
+
+	t.Run("not resumable", func(t *testing.T) {
+		for _, query := range []string{
+			"select * from paged",
+			"select * from paged order by v",
+			"select * from paged order by pk limit 5",
+			"select v from paged order by pk",
+			"select pk + 1 from paged order by pk + 1",
+			"select count(*) from paged group by pk order by pk",
+			"select a.pk from paged a join paged b on a.pk = b.pk order by a.pk",
+			"insert into paged values (1, 'z', 0)",
+		} {
+			_, _, _, err := e.QueryWithPagination(ctx, query, 2, "")
+			require.True(t, sql.ErrQueryNotResumable.Is(err), "unexpected error %v for query %s", err, query)
+		}
+	})
+
+	t.Run("invalid tokens", func(t *testing.T) {
+		_, _, token, err := e.QueryWithPagination(ctx, "select * from paged order by pk", 2, "")
+		require.NoError(t, err)
+		require.NotEmpty(t, token)
+		_, _, _, err = e.QueryWithPagination(ctx, "select * from paged order by pk desc", 2, token)
+		require.True(t, sql.ErrInvalidPaginationToken.Is(err), "unexpected error %v", err)
+		_, _, _, err = e.QueryWithPagination(ctx, "select * from paged order by pk", 2, "not a token")
+		require.True(t, sql.ErrInvalidPaginationToken.Is(err), "unexpected error %v", err)
+	})
+}
+
+func TestQueryShow(t *testing.T, harness Harness) {
+	harness.Setup(setup.MydbData)
+	e := mustNewEngine(t, harness)
+	defer e.Close()
+
+	ctx := NewContext(harness)
+	RunQueryWithContext(t, e, harness, ctx, "create table shown (pk int primary key, v varchar(20) default 'x' comment 'value', u bigint not null, unique key u_idx (u), key v_idx (v, u))")
+
+	// rows returns the rows of the query given, as executed by Engine.Query
+	rows := func(t *testing.T, query string) []sql.Row {
+		_, iter, err := e.Query(ctx, query)
+		require.NoError(t, err)
+		rows, err := sql.RowIterToRows(ctx, nil, iter)
+		require.NoError(t, err)
+		return rows
+	}
+
+	t.Run("show create table", func(t *testing.T) {
+		res, err := e.QueryShow(ctx, "SHOW CREATE TABLE shown")
+		require.NoError(t, err)
+		expected := rows(t, "SHOW CREATE TABLE shown")
+		require.Equal(t, []string{"Table", "Create Table"}, res.Columns)
+		require.Equal(t, []map[string]interface{}{{"Table": expected[0][0], "Create Table": expected[0][1]}}, res.Rows)
+
+		def := "'x'"
+		require.Equal(t, &sqle.TableDefinition{
+			Name:            "shown",
+			CreateStatement: expected[0][1].(string),
+			Columns: []sqle.ColumnDefinition{
+				{Name: "pk", Type: "int", Nullable: false},
+				{Name: "v", Type: "varchar(20)", Nullable: true, Default: &def, Comment: "value"},
+				{Name: "u", Type: "bigint", Nullable: false},
+			},
+			PrimaryKey: []string{"pk"},
+			Indexes: []sqle.IndexDefinition{
+				{Name: "u_idx", Expressions: []string{"u"}, Unique: true},
+				{Name: "v_idx", Expressions: []string{"v", "u"}},
+			},
+		}, res.Table)
+		require.Nil(t, res.Processes)
+		require.Nil(t, res.Variables)
+
+		b, err := e.QueryShowJSON(ctx, "SHOW CREATE TABLE shown")
+		require.NoError(t, err)
+		var decoded sqle.ShowResult
+		require.NoError(t, json.Unmarshal(b, &decoded))
+		require.Equal(t, res.Table, decoded.Table)
+		require.Equal(t, res.Columns, decoded.Columns)
+		require.Equal(t, res.Rows, decoded.Rows)
+	})
+
+	t.Run("show create view", func(t *testing.T) {
+		RunQueryWithContext(t, e, harness, ctx, "create view shown_view as select pk from shown")
+		res, err := e.QueryShow(ctx, "SHOW CREATE VIEW shown_view")
+		require.NoError(t, err)
+		expected := rows(t, "SHOW CREATE VIEW shown_view")
+		require.Equal(t, &sqle.TableDefinition{Name: "shown_view", View: true, CreateStatement: expected[0][1].(string)}, res.Table)
+	})
+
+	t.Run("show processlist", func(t *testing.T) {
+		p := sqle.NewProcessList()
+		sess := sql.NewBaseSessionWithClientServer("0.0.0.0:3306", sql.Client{Address: "127.0.0.1:34567", User: "foo"}, 2)
+		p.AddConnection(2, "127.0.0.1:34567")
+		p.ConnectionReady(sess)
+		_, err := p.BeginQuery(sql.NewContext(context.Background(), sql.WithPid(2), sql.WithSession(sess), sql.WithProcessList(p)), "SELECT 1")
+		require.NoError(t, err)
+		ctx := ctx.WithContext(context.Background())
+		ctx.ProcessList = p
+
+		res, err := e.QueryShow(ctx, "SHOW PROCESSLIST")
+		require.NoError(t, err)
+		require.Equal(t, []sqle.ProcessInfo{{
+			Id:      2,
+			User:    "foo",
+			Host:    "127.0.0.1:34567",
+			Command: "Query",
+			State:   "running",
+			Info:    "SELECT 1",
+		}}, res.Processes)
+
+		_, iter, err := e.Query(ctx, "SHOW PROCESSLIST")
+		require.NoError(t, err)
+		expected, err := sql.RowIterToRows(ctx, nil, iter)
+		require.NoError(t, err)
+		require.Len(t, expected, len(res.Processes))
+		for i, proc := range res.Processes {
+			require.Equal(t, sql.Row{proc.Id, proc.User, proc.Host, nil, proc.Command, proc.Time, proc.State, proc.Info}, expected[i])
+		}
+	})
+
+	t.Run("show variables", func(t *testing.T) {
+		res, err := e.QueryShow(ctx, "SHOW VARIABLES LIKE 'auto%'")
+		require.NoError(t, err)
+		expected := rows(t, "SHOW VARIABLES LIKE 'auto%'")
+		require.NotEmpty(t, expected)
+		require.Len(t, res.Variables, len(expected))
+		for _, row := range expected {
+			require.Equal(t, row[1], res.Variables[row[0].(string)])
+		}
+		require.Contains(t, res.Variables, "autocommit")
+	})
+
+	t.Run("other show statements", func(t *testing.T) {
+		res, err := e.QueryShow(ctx, "SHOW TABLES LIKE 'shown%';")
+		require.NoError(t, err)
+		require.Equal(t, &sqle.ShowResult{
+			Columns: []string{"Tables_in_mydb"},
+			Rows:    []map[string]interface{}{{"Tables_in_mydb": "shown"}, {"Tables_in_mydb": "shown_view"}},
+		}, res)
+	})
+
+	t.Run("not a show statement", func(t *testing.T) {
+		_, err := e.QueryShow(ctx, "SELECT * FROM shown")
+		require.True(t, sql.ErrNotShowStatement.Is(err))
+	})
 }
 
 func TestValidateSession(t *testing.T, harness Harness, newSessFunc func(ctx *sql.Context) sql.PersistableSession, count *int) {
@@ -6481,6 +7128,35 @@ func TestCharsetCollationEngine(t *testing.T, harness Harness) {
 	}
 }
 
+// TestShowCreateTable checks that the output of SHOW CREATE TABLE matches MySQL's for a corpus of table definitions, and
+// that creating the tables again from that output results in the same output.
+func TestShowCreateTable(t *testing.T, harness Harness) {
+	harness.Setup(setup.MydbData)
+	for _, tt := range queries.ShowCreateTableTests {
+		t.Run(tt.Name, func(t *testing.T) {
+			if sh, ok := harness.(SkippingHarness); ok && sh.SkipQueryTest(tt.Create) {
+				t.Skip()
+			}
+			engine := mustNewEngine(t, harness)
+			defer engine.Close()
+			ctx := NewContext(harness)
+
+			for _, statement := range tt.SetUpScript {
+				RunQueryWithContext(t, engine, harness, ctx, statement)
+			}
+			RunQueryWithContext(t, engine, harness, ctx, tt.Create)
+			showCreate := "SHOW CREATE TABLE " + tt.Table
+			expected := []sql.Row{{strings.Trim(tt.Table, "`"), tt.Expected}}
+			TestQueryWithContext(t, ctx, engine, harness, showCreate, expected, nil, nil)
+
+			// A table created from the output of SHOW CREATE TABLE is the same table
+			RunQueryWithContext(t, engine, harness, ctx, "DROP TABLE "+tt.Table)
+			RunQueryWithContext(t, engine, harness, ctx, tt.Expected)
+			TestQueryWithContext(t, ctx, engine, harness, showCreate, expected, nil, nil)
+		})
+	}
+}
+
 func TestCharsetCollationWire(t *testing.T, h Harness, sessionBuilder server.SessionBuilder) {
 	testCharsetCollationWire(t, h, sessionBuilder, true, queries.CharsetCollationWireTests)
 }
@@ -6801,6 +7477,76 @@ func TestPrivilegePersistence(t *testing.T, h Harness) {
 	require.Error(t, err)
 }
 
+// loadingPersister is a mysql_db.MySQLDbLoader keeping the data persisted in memory.
+type loadingPersister struct {
+	buf []byte
+}
+
+var _ mysql_db.MySQLDbLoader = &loadingPersister{}
+
+func (p *loadingPersister) Persist(ctx *sql.Context, data []byte) error {
+	p.buf = data
+	return nil
+}
+
+func (p *loadingPersister) Load(ctx *sql.Context) ([]byte, error) {
+	return p.buf, nil
+}
+
+// TestFlushPrivilegesReload tests that FLUSH PRIVILEGES reloads the grant tables from a persister that can load them,
+// making the changes persisted outside the engine visible to its sessions.
+func TestFlushPrivilegesReload(t *testing.T, h Harness) {
+	harness, ok := h.(ClientHarness)
+	if !ok {
+		t.Skip("Cannot run TestFlushPrivilegesReload as the harness must implement ClientHarness")
+	}
+
+	engine := mustNewEngine(t, harness)
+	defer engine.Close()
+
+	persister := &loadingPersister{}
+	engine.Analyzer.Catalog.MySQLDb.AddRootAccount()
+	engine.Analyzer.Catalog.MySQLDb.SetPersister(persister)
+	ctx := NewContextWithClient(harness, sql.Client{
+		User:    "root",
+		Address: "localhost",
+	})
+	RunQueryWithContext(t, engine, harness, ctx, "CREATE TABLE mydb.reload_test (pk BIGINT PRIMARY KEY)")
+	RunQueryWithContext(t, engine, harness, ctx, "CREATE USER tester@localhost")
+	require.NotEmpty(t, persister.buf)
+
+	testerCtx := NewContextWithClient(harness, sql.Client{
+		User:    "tester",
+		Address: "localhost",
+	})
+	_, iter, err := engine.Query(testerCtx, "SELECT * FROM mydb.reload_test")
+	if err == nil {
+		_, err = sql.RowIterToRows(testerCtx, nil, iter)
+	}
+	require.Error(t, err)
+
+	// Grant the user a privilege in the persisted data, as another server sharing it would
+	other := mysql_db.CreateEmptyMySQLDb()
+	other.SetPersister(persister)
+	require.NoError(t, other.LoadData(ctx, persister.buf))
+	other.GetUser("tester", "localhost", false).PrivilegeSet.AddDatabase("mydb", sql.PrivilegeType_Select)
+	require.NoError(t, other.Persist(ctx))
+
+	// The change isn't visible until the privileges are flushed
+	_, iter, err = engine.Query(testerCtx, "SELECT * FROM mydb.reload_test")
+	if err == nil {
+		_, err = sql.RowIterToRows(testerCtx, nil, iter)
+	}
+	require.Error(t, err)
+
+	RunQueryWithContext(t, engine, harness, ctx, "FLUSH PRIVILEGES")
+	TestQueryWithContext(t, testerCtx, engine, harness, "SELECT * FROM mydb.reload_test", []sql.Row{}, nil, nil)
+	// The root account isn't persisted, and must survive the reload
+	TestQueryWithContext(t, ctx, engine, harness, "SELECT COUNT(*) FROM mydb.reload_test", []sql.Row{{0}}, nil, nil)
+	user := engine.Analyzer.Catalog.MySQLDb.GetUser("tester", "localhost", false)
+	require.True(t, user.PrivilegeSet.Database("mydb").Has(sql.PrivilegeType_Select))
+}
+
 // findUser returns *mysql_db.User corresponding to specific user and host names.
 // If not found, returns nil *mysql_db.User.
 func findUser(user string, host string, users []*mysql_db.User) *mysql_db.User {
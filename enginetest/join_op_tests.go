@@ -130,6 +130,44 @@ var joinOpTests = []struct {
 			},
 		},
 	},
+	{
+		name: "null-safe equality joins match NULL keys",
+		setup: [][]string{
+			setup.MydbData[0],
+			{
+				"create table xy (x int primary key, y int, key(y))",
+				"create table uv (u int primary key, v int, w int, key(v), key(v, w))",
+				"insert into xy values (1,1),(2,null),(3,3),(4,null),(5,4)",
+				"insert into uv values (10,1,1),(20,null,null),(30,4,null),(40,null,1),(50,0,0)",
+			},
+		},
+		tests: []JoinOpTests{
+			{
+				Query:    "select x,u from xy join uv on y <=> v order by 1,2",
+				Expected: []sql.Row{{1, 10}, {2, 20}, {2, 40}, {4, 20}, {4, 40}, {5, 30}},
+			},
+			{
+				Query:    "select x,u from xy left join uv on y <=> v order by 1,2",
+				Expected: []sql.Row{{1, 10}, {2, 20}, {2, 40}, {3, nil}, {4, 20}, {4, 40}, {5, 30}},
+			},
+			{
+				Query:    "select x,u from xy join uv on y = v order by 1,2",
+				Expected: []sql.Row{{1, 10}, {5, 30}},
+			},
+			{
+				Query:    "select x,u from xy join uv on y <=> v and y <=> w order by 1,2",
+				Expected: []sql.Row{{1, 10}, {2, 20}, {4, 20}},
+			},
+			{
+				Query:    "select x,u from xy join uv on y <=> v where x > 2 order by 1,2",
+				Expected: []sql.Row{{4, 20}, {4, 40}, {5, 30}},
+			},
+			{
+				Query:    "select x from xy where exists (select 1 from uv where y <=> v) order by 1",
+				Expected: []sql.Row{{1}, {2}, {4}, {5}},
+			},
+		},
+	},
 	{
 		name: "left join tests",
 		setup: [][]string{
@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -1073,3 +1074,44 @@ func evalJoinOrderPrepared(t *testing.T, harness Harness, e *sqle.Engine, q stri
 		require.Equal(t, exp, cmp, fmt.Sprintf("expected order '%s' found '%s'\ndetail:\n%s", strings.Join(exp, ","), strings.Join(cmp, ","), sql.DebugString(a)))
 	})
 }
+
+// TestStatsAutoRecalc tests that the statistics of a table are recalculated in the background once a query reads it
+// after enough of its rows changed, and that the plans of later queries use the new statistics.
+func TestStatsAutoRecalc(t *testing.T, harness Harness) {
+	harness.Setup(setup.MydbData)
+	e := mustNewEngine(t, harness)
+	defer e.Close()
+
+	for _, q := range []string{
+		"create table xy (x int primary key, y int)",
+		"create table uv (u int primary key, v int)",
+		"insert into xy with recursive r(n) as (select 1 union all select n+1 from r where n < 50) select n, n from r",
+		"insert into uv with recursive r(n) as (select 1 union all select n+1 from r where n < 45) select n, n from r",
+		"analyze table xy, uv",
+	} {
+		RunQuery(t, e, harness, q)
+	}
+
+	q := "select * from xy a join uv b on a.y = b.v"
+	joinOrder := func() []string {
+		ctx := NewContext(harness).WithQuery(q)
+		a, err := e.AnalyzeQuery(ctx, q)
+		require.NoError(t, err)
+		return collectJoinOrder(a)
+	}
+	require.Equal(t, []string{"a", "b"}, joinOrder())
+
+	// uv grows by more than 20%, so it's now bigger than xy, but its statistics don't know that yet
+	RunQuery(t, e, harness, "insert into uv with recursive r(n) as (select 46 union all select n+1 from r where n < 55) select n, n from r")
+	require.Equal(t, []string{"a", "b"}, joinOrder())
+	TestQueryWithContext(t, NewContext(harness), e, harness, "select table_name, n_rows, modified_counter from information_schema.table_stats order by 1",
+		[]sql.Row{{"uv", uint64(45), uint64(10)}, {"xy", uint64(50), uint64(0)}}, nil, nil)
+
+	// reading the table triggers the recalculation, without waiting for it
+	RunQuery(t, e, harness, q)
+	require.Eventually(t, func() bool {
+		return strings.Join(joinOrder(), ",") == "b,a"
+	}, 5*time.Second, 10*time.Millisecond)
+	TestQueryWithContext(t, NewContext(harness), e, harness, "select table_name, n_rows, modified_counter from information_schema.table_stats order by 1",
+		[]sql.Row{{"uv", uint64(55), uint64(0)}, {"xy", uint64(50), uint64(0)}}, nil, nil)
+}
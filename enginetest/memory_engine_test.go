@@ -19,6 +19,8 @@ import (
 	"log"
 	"testing"
 
+	"github.com/stretchr/testify/require"
+
 	_ "github.com/dolthub/go-mysql-server/inittime"
 
 	"github.com/dolthub/go-mysql-server/enginetest"
@@ -84,6 +86,118 @@ func TestQueries(t *testing.T) {
 	}
 }
 
+// TestOrderByPagination tests that rows tied on the keys of an ORDER BY are returned in the same order by every
+// execution, with or without parallelism, so that paging through them with LIMIT and OFFSET neither skips nor repeats
+// any row.
+func TestOrderByPagination(t *testing.T) {
+	// Enough rows for the partitions of parallel scans to be returned by several batches
+	const numPartitions = 8
+	const numRows = numPartitions * 3 * sql.RowBatchSize
+
+	for _, parallelism := range parallelVals {
+		t.Run(fmt.Sprintf("parallelism=%d", parallelism), func(t *testing.T) {
+			harness := enginetest.NewMemoryHarness("", parallelism, numPartitions, true, nil)
+			db := memory.NewDatabase("mydb")
+			table := memory.NewPartitionedTable("ties", sql.NewPrimaryKeySchema(sql.Schema{
+				{Name: "pk", Type: types.Int64, Source: "ties"},
+				{Name: "v", Type: types.Int64, Source: "ties"},
+				{Name: "w", Type: types.Text, Source: "ties"},
+			}), db.GetForeignKeyCollection(), numPartitions)
+			db.AddTable("ties", table)
+
+			ctx := enginetest.NewContext(harness)
+			inserter := table.Inserter(ctx)
+			for i := 0; i < numRows; i++ {
+				require.NoError(t, inserter.Insert(ctx, sql.NewRow(int64(i), int64(i%4), string(rune('a'+i%3)))))
+			}
+			require.NoError(t, inserter.Close(ctx))
+
+			e := enginetest.NewEngineWithProvider(t, harness, memory.NewDBProvider(db))
+			defer e.Close()
+			query := func(q string) []sql.Row {
+				ctx := enginetest.NewContext(harness)
+				ctx.SetCurrentDatabase("mydb")
+				sch, iter, err := e.Query(ctx, q)
+				require.NoError(t, err)
+				rows, err := sql.RowIterToRows(ctx, sch, iter)
+				require.NoError(t, err)
+				return rows
+			}
+
+			for _, orderBy := range []string{"v", "v DESC", "w, v"} {
+				q := fmt.Sprintf("SELECT pk, v, w FROM ties ORDER BY %s", orderBy)
+				all := query(q)
+				require.Len(t, all, numRows)
+				for i := 0; i < 10; i++ {
+					require.Equal(t, all, query(q), q)
+				}
+
+				for _, pageSize := range []int{5000, 10000} {
+					var pages []sql.Row
+					for offset := 0; offset < numRows; offset += pageSize {
+						pages = append(pages, query(fmt.Sprintf("%s LIMIT %d OFFSET %d", q, pageSize, offset))...)
+					}
+					require.Equal(t, all, pages, "%s, page size %d", q, pageSize)
+				}
+
+				for _, limit := range []int{1, 100, 5000} {
+					require.Equal(t, all[:limit], query(fmt.Sprintf("%s LIMIT %d", q, limit)), "%s, limit %d", q, limit)
+				}
+			}
+		})
+	}
+}
+
+// TestCheckTableCorruptIndex tests that CHECK TABLE reports a memory table index that no longer refers to the column it
+// was created on.
+func TestCheckTableCorruptIndex(t *testing.T) {
+	harness := enginetest.NewDefaultMemoryHarness()
+	db := memory.NewDatabase("mydb")
+	table := memory.NewTable("t", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "pk", Type: types.Int64, Source: "t", PrimaryKey: true},
+		{Name: "u", Type: types.Int64, Source: "t"},
+		{Name: "v", Type: types.Int64, Source: "t"},
+	}), db.GetForeignKeyCollection())
+	db.AddTable("t", table)
+
+	ctx := enginetest.NewContext(harness)
+	require.NoError(t, table.CreateIndex(ctx, sql.IndexDef{
+		Name:       "u",
+		Columns:    []sql.IndexColumn{{Name: "u"}},
+		Constraint: sql.IndexConstraint_Unique,
+	}))
+	for i := int64(1); i <= 3; i++ {
+		require.NoError(t, table.Insert(ctx, sql.NewRow(i, i*10, int64(1))))
+	}
+
+	e := enginetest.NewEngineWithProvider(t, harness, memory.NewDBProvider(db))
+	defer e.Close()
+	query := func(q string) []sql.Row {
+		ctx := enginetest.NewContext(harness)
+		ctx.SetCurrentDatabase("mydb")
+		sch, iter, err := e.Query(ctx, q)
+		require.NoError(t, err)
+		rows, err := sql.RowIterToRows(ctx, sch, iter)
+		require.NoError(t, err)
+		return rows
+	}
+
+	require.Equal(t, []sql.Row{{"mydb.t", "check", "status", "OK"}}, query("CHECK TABLE t"))
+
+	// Point the index at the position of column v, as if u had been moved without the index being updated
+	indexes, err := table.GetIndexes(ctx)
+	require.NoError(t, err)
+	for _, idx := range indexes {
+		if idx.ID() == "u" {
+			idx.(*memory.Index).Exprs = []sql.Expression{expression.NewGetFieldWithTable(2, types.Int64, "t", "u", false)}
+		}
+	}
+	require.Equal(t, []sql.Row{
+		{"mydb.t", "check", "error", "Index 'u' refers to column 'u' at position 3, which doesn't hold it"},
+		{"mydb.t", "check", "status", "Corrupt"},
+	}, query("CHECK TABLE t"))
+}
+
 // TestQueriesPrepared runs the canonical test queries against the gamut of thread, index and partition options
 // with prepared statement caching enabled.
 func TestQueriesPrepared(t *testing.T) {
@@ -134,6 +248,10 @@ func TestJoinPlanningPrepared(t *testing.T) {
 	enginetest.TestJoinPlanningPrepared(t, enginetest.NewMemoryHarness("simple", 1, testNumPartitions, true, nil))
 }
 
+func TestStatsAutoRecalc(t *testing.T) {
+	enginetest.TestStatsAutoRecalc(t, enginetest.NewDefaultMemoryHarness())
+}
+
 // TestJoinOps runs join-specific tests for merge
 func TestJoinOps(t *testing.T) {
 	enginetest.TestJoinOps(t, enginetest.NewMemoryHarness("simple", 1, testNumPartitions, true, nil))
@@ -616,6 +734,10 @@ func TestPrivilegePersistence(t *testing.T) {
 	enginetest.TestPrivilegePersistence(t, enginetest.NewMemoryHarness("default", 1, testNumPartitions, true, mergableIndexDriver))
 }
 
+func TestFlushPrivilegesReload(t *testing.T) {
+	enginetest.TestFlushPrivilegesReload(t, enginetest.NewMemoryHarness("default", 1, testNumPartitions, true, mergableIndexDriver))
+}
+
 func TestComplexIndexQueries(t *testing.T) {
 	harness := enginetest.NewMemoryHarness("default", 1, testNumPartitions, true, mergableIndexDriver)
 	enginetest.TestComplexIndexQueries(t, harness)
@@ -749,6 +871,10 @@ func TestReadOnly(t *testing.T) {
 	enginetest.TestReadOnly(t, enginetest.NewDefaultMemoryHarness())
 }
 
+func TestLowerCaseTableNames(t *testing.T) {
+	enginetest.TestLowerCaseTableNames(t, enginetest.NewDefaultMemoryHarness())
+}
+
 func TestViews(t *testing.T) {
 	enginetest.TestViews(t, enginetest.NewDefaultMemoryHarness())
 }
@@ -869,6 +995,22 @@ func TestValidateSession(t *testing.T) {
 	enginetest.TestValidateSession(t, enginetest.NewDefaultMemoryHarness(), newSess, &count)
 }
 
+func TestRowChangeListener(t *testing.T) {
+	enginetest.TestRowChangeListener(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestQueryWithPagination(t *testing.T) {
+	enginetest.TestQueryWithPagination(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestQueryShow(t *testing.T) {
+	enginetest.TestQueryShow(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestValidateQuery(t *testing.T) {
+	enginetest.TestValidateQuery(t, enginetest.NewDefaultMemoryHarness())
+}
+
 func TestPrepared(t *testing.T) {
 	enginetest.TestPrepared(t, enginetest.NewDefaultMemoryHarness())
 }
@@ -881,6 +1023,10 @@ func TestPreparedStatements(t *testing.T) {
 	enginetest.TestPreparedStatements(t, enginetest.NewDefaultMemoryHarness())
 }
 
+func TestShowCreateTable(t *testing.T) {
+	enginetest.TestShowCreateTable(t, enginetest.NewDefaultMemoryHarness())
+}
+
 func TestCharsetCollationEngine(t *testing.T) {
 	enginetest.TestCharsetCollationEngine(t, enginetest.NewDefaultMemoryHarness())
 }
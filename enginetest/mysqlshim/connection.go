@@ -133,7 +133,6 @@ func (m *MySQLShim) QueryRows(db string, query string) ([]sql.Row, error) {
 		return nil, err
 	}
 	iter := newMySQLIter(rows)
-	defer iter.Close(ctx)
 	allRows, err := sql.RowIterToRows(ctx, nil, iter)
 	if err != nil {
 		return nil, err
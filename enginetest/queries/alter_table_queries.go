@@ -0,0 +1,363 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queries
+
+import (
+	"github.com/dolthub/vitess/go/mysql"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// AddColumnScripts test that adding a column places the values of the existing rows of a table in the column's position,
+// whether it's added FIRST, AFTER another column or last.
+var AddColumnScripts = []ScriptTest{
+	{
+		Name: "add column with a default in the middle of a table",
+		SetUpScript: []string{
+			"create table t (pk int primary key, a varchar(10), b int)",
+			"insert into t values (1, 'one', 10), (2, 'two', 20), (3, 'three', 30)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "alter table t add column c int not null default 42 after a",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select * from t order by pk",
+				Expected: []sql.Row{{1, "one", 42, 10}, {2, "two", 42, 20}, {3, "three", 42, 30}},
+			},
+			{
+				Query:    "select pk, a, b from t where c = 42 order by pk",
+				Expected: []sql.Row{{1, "one", 10}, {2, "two", 20}, {3, "three", 30}},
+			},
+			{
+				Query:    "insert into t values (4, 'four', 43, 40)",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "select * from t order by pk",
+				Expected: []sql.Row{{1, "one", 42, 10}, {2, "two", 42, 20}, {3, "three", 42, 30}, {4, "four", 43, 40}},
+			},
+		},
+	},
+	{
+		Name: "add column first",
+		SetUpScript: []string{
+			"create table t (pk int primary key, a varchar(10))",
+			"insert into t values (1, 'one'), (2, 'two')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "alter table t add column z varchar(10) default 'zzz' first",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select * from t order by pk",
+				Expected: []sql.Row{{"zzz", 1, "one"}, {"zzz", 2, "two"}},
+			},
+			{
+				Query:    "select pk from t where z = 'zzz' and a = 'two'",
+				Expected: []sql.Row{{2}},
+			},
+		},
+	},
+	{
+		Name: "add several columns in different positions",
+		SetUpScript: []string{
+			"create table t (pk int primary key, a int, b int, c int)",
+			"insert into t values (1, 10, 20, 30), (2, 11, 21, 31)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "alter table t add column x int default 1 after pk",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "alter table t add column y int default 2 after B",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "alter table t add column w int default 3 first",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "alter table t add column v int default 4",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select * from t order by pk",
+				Expected: []sql.Row{{3, 1, 1, 10, 20, 2, 30, 4}, {3, 2, 1, 11, 21, 2, 31, 4}},
+			},
+			{
+				Query:    "select column_name from information_schema.columns where table_name = 't' order by ordinal_position",
+				Expected: []sql.Row{{"w"}, {"pk"}, {"x"}, {"a"}, {"b"}, {"y"}, {"c"}, {"v"}},
+			},
+		},
+	},
+	{
+		Name: "add column after a column with a default expression referencing another column",
+		SetUpScript: []string{
+			"create table t (pk int primary key, a int, b int default (a * 2))",
+			"insert into t (pk, a) values (1, 10), (2, 20)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "alter table t add column c int default (a + 1) after pk",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select * from t order by pk",
+				Expected: []sql.Row{{1, 11, 10, 20}, {2, 21, 20, 40}},
+			},
+			{
+				Query:    "insert into t (pk, a) values (3, 30)",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "select * from t order by pk",
+				Expected: []sql.Row{{1, 11, 10, 20}, {2, 21, 20, 40}, {3, 31, 30, 60}},
+			},
+		},
+	},
+	{
+		Name: "add column in the middle of a keyless table",
+		SetUpScript: []string{
+			"create table t (a int, b varchar(10))",
+			"insert into t values (1, 'one'), (2, 'two'), (2, 'two')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "alter table t add column c varchar(10) not null default 'c' after a",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select * from t order by a, b",
+				Expected: []sql.Row{{1, "c", "one"}, {2, "c", "two"}, {2, "c", "two"}},
+			},
+			{
+				Query:    "delete from t where b = 'two'",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:    "select * from t",
+				Expected: []sql.Row{{1, "c", "one"}},
+			},
+		},
+	},
+}
+
+// ModifyColumnScripts test the conversion of the existing data of a table when the type of one of its columns is
+// changed, under both strict and non-strict sql_modes.
+var ModifyColumnScripts = []ScriptTest{
+	{
+		Name: "modify column to a narrower string type in strict mode",
+		SetUpScript: []string{
+			"set sql_mode = 'STRICT_TRANS_TABLES'",
+			"create table t (pk int primary key, s varchar(20))",
+			"insert into t values (1, 'abc'), (2, 'abcdefgh')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "alter table t modify column s varchar(5)",
+				ExpectedErr: sql.ErrDataTruncatedForColumn,
+			},
+			{
+				Query:    "select * from t order by pk",
+				Expected: []sql.Row{{1, "abc"}, {2, "abcdefgh"}},
+			},
+			{
+				Query:    "alter table t modify column s varchar(8)",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select * from t order by pk",
+				Expected: []sql.Row{{1, "abc"}, {2, "abcdefgh"}},
+			},
+		},
+	},
+	{
+		Name: "modify column to a narrower string type in non-strict mode",
+		SetUpScript: []string{
+			"set sql_mode = ''",
+			"create table t (pk int primary key, s varchar(20))",
+			"insert into t values (1, 'abc'), (2, 'abcdefgh'), (3, 'ñandúes')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:           "alter table t modify column s varchar(5)",
+				Expected:        []sql.Row{{types.NewOkResult(0)}},
+				ExpectedWarning: mysql.ERWarnDataTruncated,
+			},
+			{
+				Query:    "alter table t modify column s varchar(5)",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select * from t order by pk",
+				Expected: []sql.Row{{1, "abc"}, {2, "abcde"}, {3, "ñandú"}},
+			},
+		},
+	},
+	{
+		Name: "modify column from a string type to a number type in strict mode",
+		SetUpScript: []string{
+			"set sql_mode = 'STRICT_TRANS_TABLES'",
+			"create table t (pk int primary key, v varchar(20))",
+			"insert into t values (1, '42'), (2, 'abc')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "alter table t modify column v int",
+				ExpectedErr: sql.ErrIncorrectValueForColumn,
+			},
+			{
+				Query:    "select * from t order by pk",
+				Expected: []sql.Row{{1, "42"}, {2, "abc"}},
+			},
+			{
+				Query:    "delete from t where pk = 2",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "alter table t modify column v int",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select * from t order by pk",
+				Expected: []sql.Row{{1, 42}},
+			},
+		},
+	},
+	{
+		Name: "modify column from a string type to a number type in non-strict mode",
+		SetUpScript: []string{
+			"set sql_mode = ''",
+			"create table t (pk int primary key, v varchar(20))",
+			"insert into t values (1, '42'), (2, 'abc')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:           "alter table t modify column v int",
+				Expected:        []sql.Row{{types.NewOkResult(0)}},
+				ExpectedWarning: mysql.ERTruncatedWrongValueForField,
+			},
+			{
+				Query:    "select * from t order by pk",
+				Expected: []sql.Row{{1, 42}, {2, 0}},
+			},
+		},
+	},
+	{
+		Name: "modify column to a narrower number type in strict mode",
+		SetUpScript: []string{
+			"set sql_mode = 'STRICT_TRANS_TABLES'",
+			"create table t (pk int primary key, i int)",
+			"insert into t values (1, 100), (2, 300), (3, -300)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "alter table t modify column i tinyint",
+				ExpectedErr: sql.ErrValueOutOfRangeForColumn,
+			},
+			{
+				Query:    "select * from t order by pk",
+				Expected: []sql.Row{{1, 100}, {2, 300}, {3, -300}},
+			},
+		},
+	},
+	{
+		Name: "modify column to a narrower number type in non-strict mode",
+		SetUpScript: []string{
+			"set sql_mode = ''",
+			"create table t (pk int primary key, i int)",
+			"insert into t values (1, 100), (2, 300), (3, -300)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:                 "alter table t modify column i tinyint",
+				Expected:              []sql.Row{{types.NewOkResult(0)}},
+				ExpectedWarning:       mysql.ERWarnDataOutOfRange,
+				ExpectedWarningsCount: 2,
+			},
+			{
+				Query:    "select * from t order by pk",
+				Expected: []sql.Row{{1, 100}, {2, 127}, {3, -128}},
+			},
+		},
+	},
+	{
+		Name: "modify column from a number type to a string type",
+		SetUpScript: []string{
+			"set sql_mode = 'STRICT_TRANS_TABLES'",
+			"create table t (pk int primary key, i int, j int)",
+			"insert into t values (1, 12345, 12345), (2, -7, -7)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "alter table t modify column i varchar(10)",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select * from t order by pk",
+				Expected: []sql.Row{{1, "12345", 12345}, {2, "-7", -7}},
+			},
+			{
+				Query:       "alter table t modify column j varchar(4)",
+				ExpectedErr: sql.ErrDataTruncatedForColumn,
+			},
+			{
+				Query:    "select * from t order by pk",
+				Expected: []sql.Row{{1, "12345", 12345}, {2, "-7", -7}},
+			},
+		},
+	},
+	{
+		Name: "modify column after a column named in a different case",
+		SetUpScript: []string{
+			"create table t (pk int primary key, a int, b int)",
+			"insert into t values (1, 10, 20)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "alter table t modify column b int after PK",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select * from t",
+				Expected: []sql.Row{{1, 20, 10}},
+			},
+		},
+	},
+	{
+		Name: "change column to a narrower type in non-strict mode",
+		SetUpScript: []string{
+			"set sql_mode = ''",
+			"create table t (pk int primary key, s varchar(20))",
+			"insert into t values (1, 'abcdefgh')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:           "alter table t change column s s2 char(3)",
+				Expected:        []sql.Row{{types.NewOkResult(0)}},
+				ExpectedWarning: mysql.ERWarnDataTruncated,
+			},
+			{
+				Query:    "select * from t order by pk",
+				Expected: []sql.Row{{1, "abc"}},
+			},
+		},
+	},
+}
@@ -310,25 +310,25 @@ var CharsetCollationEngineTests = []CharsetCollationEngineTest{
 			{
 				Query: "SHOW CREATE TABLE test1;",
 				Expected: []sql.Row{
-					{"test1", "CREATE TABLE `test1` (\n  `pk` bigint NOT NULL,\n  `v1` varchar(255) CHARACTER SET utf16 COLLATE utf16_unicode_ci,\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf16 COLLATE=utf16_unicode_ci"},
+					{"test1", "CREATE TABLE `test1` (\n  `pk` bigint NOT NULL,\n  `v1` varchar(255) DEFAULT NULL,\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf16 COLLATE=utf16_unicode_ci"},
 				},
 			},
 			{
 				Query: "SHOW CREATE TABLE test2;",
 				Expected: []sql.Row{
-					{"test2", "CREATE TABLE `test2` (\n  `pk` bigint NOT NULL,\n  `v1` varchar(100) COLLATE utf8mb4_unicode_ci,\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci"},
+					{"test2", "CREATE TABLE `test2` (\n  `pk` bigint NOT NULL,\n  `v1` varchar(100) DEFAULT NULL,\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci"},
 				},
 			},
 			{
 				Query: "SHOW CREATE TABLE test3;",
 				Expected: []sql.Row{
-					{"test3", "CREATE TABLE `test3` (\n  `pk` bigint NOT NULL,\n  `v1` varchar(255) COLLATE utf8mb4_unicode_ci,\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci"},
+					{"test3", "CREATE TABLE `test3` (\n  `pk` bigint NOT NULL,\n  `v1` varchar(255) DEFAULT NULL,\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci"},
 				},
 			},
 			{
 				Query: "SHOW CREATE TABLE test4;",
 				Expected: []sql.Row{
-					{"test4", "CREATE TABLE `test4` (\n  `pk` bigint NOT NULL,\n  `v1` varchar(255) COLLATE utf8mb4_unicode_ci\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"},
+					{"test4", "CREATE TABLE `test4` (\n  `pk` bigint NOT NULL,\n  `v1` varchar(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci DEFAULT NULL\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"},
 				},
 			},
 			{
@@ -340,7 +340,7 @@ var CharsetCollationEngineTests = []CharsetCollationEngineTest{
 			{
 				Query: "SHOW CREATE TABLE test3;",
 				Expected: []sql.Row{
-					{"test3", "CREATE TABLE `test3` (\n  `pk` bigint NOT NULL,\n  `v1` varchar(255) COLLATE utf8mb4_unicode_ci,\n  `v2` varchar(255) COLLATE utf8mb4_unicode_ci,\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci"},
+					{"test3", "CREATE TABLE `test3` (\n  `pk` bigint NOT NULL,\n  `v1` varchar(255) DEFAULT NULL,\n  `v2` varchar(255) DEFAULT NULL,\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci"},
 				},
 			},
 			{
@@ -352,7 +352,7 @@ var CharsetCollationEngineTests = []CharsetCollationEngineTest{
 			{
 				Query: "SHOW CREATE TABLE test2;",
 				Expected: []sql.Row{
-					{"test2", "CREATE TABLE `test2` (\n  `pk` bigint NOT NULL,\n  `v1` varchar(220) COLLATE utf8mb4_unicode_ci,\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci"},
+					{"test2", "CREATE TABLE `test2` (\n  `pk` bigint NOT NULL,\n  `v1` varchar(220) DEFAULT NULL,\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci"},
 				},
 			},
 			{
@@ -392,7 +392,7 @@ var CharsetCollationEngineTests = []CharsetCollationEngineTest{
 			{
 				Query: "SHOW CREATE TABLE test2;",
 				Expected: []sql.Row{
-					{"test2", "CREATE TABLE `test2` (\n  `pk` bigint NOT NULL,\n  `v1` varchar(220) COLLATE utf8mb4_unicode_ci,\n  `v2` varchar(255) COLLATE utf8mb4_bin,\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin"},
+					{"test2", "CREATE TABLE `test2` (\n  `pk` bigint NOT NULL,\n  `v1` varchar(220) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci DEFAULT NULL,\n  `v2` varchar(255) DEFAULT NULL,\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin"},
 				},
 			},
 		},
@@ -1103,7 +1103,156 @@ var CharsetCollationEngineTests = []CharsetCollationEngineTest{
 T.TABLE_SCHEMA AS 'database', T.TABLE_CATALOG AS 'catalog',
 0 AS isView FROM INFORMATION_SCHEMA.TABLES AS T WHERE T.TABLE_CATALOG = 'def' AND
                                                       UPPER(T.TABLE_TYPE) = 'BASE TABLE' ORDER BY T.TABLE_NAME;`,
-				Expected: []sql.Row(nil),
+				Expected: []sql.Row{{"session_connect_attrs", "connection.table", "performance_schema", "performance_schema", "def", int8(0)}},
+			},
+		},
+	},
+	{
+		Name: "ALTER TABLE CONVERT TO CHARACTER SET",
+		SetUpScript: []string{
+			"CREATE TABLE test (pk BIGINT PRIMARY KEY, v1 VARCHAR(20), v2 TEXT, v3 VARCHAR(20) CHARACTER SET utf16, v4 VARBINARY(10), v5 ENUM('a','b')) CHARACTER SET latin1;",
+			"INSERT INTO test VALUES (1, 'héllo', 'ça va', '日本', 'xx', 'a'), (2, 'Ünïcode', 'ÀÉÎ', 'x', 'yy', 'b');",
+		},
+		Queries: []CharsetCollationEngineTestQuery{
+			{
+				Query:    "ALTER TABLE test CONVERT TO CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query: "SHOW CREATE TABLE test;",
+				Expected: []sql.Row{
+					{"test", "CREATE TABLE `test` (\n  `pk` bigint NOT NULL,\n  `v1` varchar(20) DEFAULT NULL,\n  `v2` text,\n  `v3` varchar(20) CHARACTER SET utf16 COLLATE utf16_general_ci DEFAULT NULL,\n  `v4` varbinary(10) DEFAULT NULL,\n  `v5` enum('a','b') DEFAULT NULL,\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci"},
+				},
+			},
+			{
+				Query: "SELECT column_name, character_set_name, collation_name FROM information_schema.columns WHERE table_name = 'test' ORDER BY ordinal_position;",
+				Expected: []sql.Row{
+					{"pk", nil, nil},
+					{"v1", "utf8mb4", "utf8mb4_unicode_ci"},
+					{"v2", "utf8mb4", "utf8mb4_unicode_ci"},
+					{"v3", "utf16", "utf16_general_ci"},
+					{"v4", nil, nil},
+					{"v5", "utf8mb4", "utf8mb4_unicode_ci"},
+				},
+			},
+			{
+				Query: "SELECT * FROM test ORDER BY pk;",
+				Expected: []sql.Row{
+					{int64(1), "héllo", "ça va", "日本", []byte("xx"), uint16(1)},
+					{int64(2), "Ünïcode", "ÀÉÎ", "x", []byte("yy"), uint16(2)},
+				},
+			},
+			{
+				Query:    "SELECT pk FROM test WHERE v1 = 'HELLO';",
+				Expected: []sql.Row{{int64(1)}},
+			},
+			{
+				Query:    "INSERT INTO test VALUES (3, '日本', '日本語', 'z', 'zz', 'a');",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:   "ALTER TABLE test CONVERT TO CHARACTER SET latin1;",
+				ErrKind: sql.ErrIncorrectValueForColumn,
+			},
+			{
+				Query:    "DELETE FROM test WHERE pk = 3;",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "ALTER TABLE test CONVERT TO CHARSET latin1;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query: "SHOW CREATE TABLE test;",
+				Expected: []sql.Row{
+					{"test", "CREATE TABLE `test` (\n  `pk` bigint NOT NULL,\n  `v1` varchar(20) DEFAULT NULL,\n  `v2` text,\n  `v3` varchar(20) CHARACTER SET utf16 COLLATE utf16_general_ci DEFAULT NULL,\n  `v4` varbinary(10) DEFAULT NULL,\n  `v5` enum('a','b') DEFAULT NULL,\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=latin1"},
+				},
+			},
+			{
+				Query: "SELECT * FROM test ORDER BY pk;",
+				Expected: []sql.Row{
+					{int64(1), "héllo", "ça va", "日本", []byte("xx"), uint16(1)},
+					{int64(2), "Ünïcode", "ÀÉÎ", "x", []byte("yy"), uint16(2)},
+				},
+			},
+		},
+	},
+	{
+		Name: "ALTER TABLE character set changes convert stored data",
+		SetUpScript: []string{
+			"CREATE TABLE test (pk BIGINT PRIMARY KEY, v1 VARCHAR(20), v2 VARCHAR(20), INDEX (v1)) CHARACTER SET latin1;",
+			"INSERT INTO test VALUES (1, 'café', 'élan'), (2, 'naïve', 'über'), (3, 'plain', 'à la');",
+		},
+		Queries: []CharsetCollationEngineTestQuery{
+			{
+				Query:    "ALTER TABLE test MODIFY COLUMN v1 VARCHAR(50) CHARACTER SET utf8mb4;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "SELECT pk, v1, HEX(v1), LENGTH(v1), CHAR_LENGTH(v1) FROM test ORDER BY pk;",
+				Expected: []sql.Row{{int64(1), "café", "636166C3A9", int32(5), int32(4)}, {int64(2), "naïve", "6E61C3AF7665", int32(6), int32(5)}, {int64(3), "plain", "706C61696E", int32(5), int32(5)}},
+			},
+			{
+				Query:    "SELECT pk FROM test WHERE v1 = 'naïve';",
+				Expected: []sql.Row{{int64(2)}},
+			},
+			{
+				Query:    "INSERT INTO test VALUES (4, '日本', 'x');",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:   "ALTER TABLE test MODIFY COLUMN v1 VARCHAR(50) CHARACTER SET latin1;",
+				ErrKind: sql.ErrIncorrectValueForColumn,
+			},
+			{
+				Query:    "SELECT HEX(v1) FROM test WHERE pk = 4;",
+				Expected: []sql.Row{{"E697A5E69CAC"}},
+			},
+			{
+				Query:    "ALTER IGNORE TABLE test MODIFY COLUMN v1 VARCHAR(50) CHARACTER SET latin1;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "SHOW WARNINGS;",
+				Expected: []sql.Row{{"Warning", 1366, "Incorrect string value: '\\xE6\\x97\\xA5\\xE6\\x9C\\xAC' for column 'v1' at row 4"}},
+			},
+			{
+				Query:    "SELECT pk, v1, HEX(v1) FROM test ORDER BY pk;",
+				Expected: []sql.Row{{int64(1), "café", "636166E9"}, {int64(2), "naïve", "6E61EF7665"}, {int64(3), "plain", "706C61696E"}, {int64(4), "??", "3F3F"}},
+			},
+			{
+				Query:    "SELECT pk FROM test WHERE v1 = 'café';",
+				Expected: []sql.Row{{int64(1)}},
+			},
+			{
+				Query:    "ALTER TABLE test CONVERT TO CHARACTER SET utf8mb4;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "SELECT pk, v1, v2, HEX(v2) FROM test ORDER BY pk;",
+				Expected: []sql.Row{{int64(1), "café", "élan", "C3A96C616E"}, {int64(2), "naïve", "über", "C3BC626572"}, {int64(3), "plain", "à la", "C3A0206C61"}, {int64(4), "??", "x", "78"}},
+			},
+			{
+				Query:    "UPDATE test SET v2 = 'ünï 日本' WHERE pk = 4;",
+				Expected: []sql.Row{{newUpdateResult(1, 1)}},
+			},
+			{
+				Query:   "ALTER TABLE test CONVERT TO CHARACTER SET latin1;",
+				ErrKind: sql.ErrIncorrectValueForColumn,
+			},
+			{
+				Query:    "ALTER IGNORE TABLE test CONVERT TO CHARACTER SET latin1;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "SELECT pk, v2, HEX(v2) FROM test ORDER BY pk;",
+				Expected: []sql.Row{{int64(1), "élan", "E96C616E"}, {int64(2), "über", "FC626572"}, {int64(3), "à la", "E0206C61"}, {int64(4), "ünï ??", "FC6EEF203F3F"}},
+			},
+			{
+				Query: "SHOW CREATE TABLE test;",
+				Expected: []sql.Row{
+					{"test", "CREATE TABLE `test` (\n  `pk` bigint NOT NULL,\n  `v1` varchar(50) DEFAULT NULL,\n  `v2` varchar(20) DEFAULT NULL,\n  PRIMARY KEY (`pk`),\n  KEY `v1` (`v1`)\n) ENGINE=InnoDB DEFAULT CHARSET=latin1"},
+				},
 			},
 		},
 	},
@@ -149,6 +149,227 @@ var DeleteTests = []WriteQueryTest{
 	},
 }
 
+// DeleteScripts contains script tests for deletes that depend on the order of rows, such as those bounded with
+// ORDER BY ... LIMIT to delete a subset of the matching rows.
+var DeleteScripts = []ScriptTest{
+	{
+		Name: "truncate resets auto_increment and reports no affected rows",
+		SetUpScript: []string{
+			"create table t (id int auto_increment primary key, v int)",
+			"insert into t (v) values (1), (2), (3)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "truncate table t",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select count(*) from t",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "insert into t (v) values (4)",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 1, InsertID: 1}}},
+			},
+			{
+				Query:    "select * from t",
+				Expected: []sql.Row{{1, 4}},
+			},
+		},
+	},
+	{
+		Name: "truncate does not fire delete triggers",
+		SetUpScript: []string{
+			"create table t (id int primary key)",
+			"create table deleted (id int primary key)",
+			"create trigger before_delete_t before delete on t for each row insert into deleted values (old.id)",
+			"create trigger after_delete_t after delete on t for each row insert into deleted values (old.id + 10)",
+			"insert into t values (1), (2)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "truncate t",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select count(*) from t",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "select count(*) from deleted",
+				Expected: []sql.Row{{0}},
+			},
+		},
+	},
+	{
+		Name: "truncate is refused for tables referenced by foreign keys",
+		SetUpScript: []string{
+			"create table parent (id int primary key)",
+			"create table child (id int primary key, parent_id int, constraint fk_child foreign key (parent_id) references parent (id))",
+			"create table self_ref (id int primary key, parent_id int, constraint fk_self_ref foreign key (parent_id) references self_ref (id))",
+			"insert into parent values (1)",
+			"insert into self_ref values (1, null), (2, 1)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "truncate parent",
+				ExpectedErr: sql.ErrTruncateReferencedFromForeignKey,
+			},
+			{
+				Query:    "select * from parent",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "truncate child",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "truncate self_ref",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select count(*) from self_ref",
+				Expected: []sql.Row{{0}},
+			},
+		},
+	},
+	{
+		Name: "delete the oldest rows with ORDER BY and LIMIT",
+		SetUpScript: []string{
+			"create table events (id int primary key, ts datetime, note varchar(20), key (ts))",
+			`insert into events values
+				(1, '2023-01-05 00:00:00', 'e'),
+				(2, '2023-01-01 00:00:00', 'a'),
+				(3, '2023-01-04 00:00:00', 'd'),
+				(4, '2023-01-02 00:00:00', 'b'),
+				(5, '2023-01-06 00:00:00', 'f'),
+				(6, '2023-01-03 00:00:00', 'c')`,
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "delete from events order by ts limit 3",
+				Expected: []sql.Row{{types.NewOkResult(3)}},
+			},
+			{
+				Query:    "select id, note from events order by id",
+				Expected: []sql.Row{{1, "e"}, {3, "d"}, {5, "f"}},
+			},
+			{
+				Query:    "delete from events where note <> 'f' order by ts desc limit 1",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "select id, note from events order by id",
+				Expected: []sql.Row{{3, "d"}, {5, "f"}},
+			},
+			{
+				Query:    "delete from events order by ts limit 0",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "delete from events order by ts limit 10",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:    "select count(*) from events",
+				Expected: []sql.Row{{0}},
+			},
+		},
+	},
+	{
+		Name: "delete with ORDER BY and LIMIT in batches",
+		SetUpScript: []string{
+			"create table log (id int primary key auto_increment, ts datetime)",
+			`insert into log (ts) values
+				('2023-01-02'), ('2023-01-03'), ('2023-01-04'), ('2023-01-05'), ('2023-01-06'),
+				('2023-01-07'), ('2023-01-08'), ('2023-01-09'), ('2023-01-10'), ('2023-01-11')`,
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "delete from log where ts < '2023-01-09' order by ts limit 3",
+				Expected: []sql.Row{{types.NewOkResult(3)}},
+			},
+			{
+				Query:    "delete from log where ts < '2023-01-09' order by ts limit 3",
+				Expected: []sql.Row{{types.NewOkResult(3)}},
+			},
+			{
+				Query:    "delete from log where ts < '2023-01-09' order by ts limit 3",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "select id from log order by id",
+				Expected: []sql.Row{{8}, {9}, {10}},
+			},
+		},
+	},
+	{
+		Name: "delete with ORDER BY and LIMIT sorts nulls first and breaks ties in order",
+		SetUpScript: []string{
+			"create table t (id int primary key, v int)",
+			"insert into t values (1, 10), (2, null), (3, 10), (4, 5), (5, null)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "delete from t order by v, id desc limit 3",
+				Expected: []sql.Row{{types.NewOkResult(3)}},
+			},
+			{
+				Query:    "select id from t order by id",
+				Expected: []sql.Row{{1}, {3}},
+			},
+		},
+	},
+	{
+		Name: "delete with ORDER BY and LIMIT fires triggers only for deleted rows",
+		SetUpScript: []string{
+			"create table t (id int primary key, ts datetime)",
+			"create table deleted (id int primary key)",
+			"insert into t values (1, '2023-01-03'), (2, '2023-01-01'), (3, '2023-01-02')",
+			"create trigger t_del after delete on t for each row insert into deleted values (old.id)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "delete from t order by ts limit 2",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:    "select id from deleted order by id",
+				Expected: []sql.Row{{2}, {3}},
+			},
+			{
+				Query:    "select id from t",
+				Expected: []sql.Row{{1}},
+			},
+		},
+	},
+	{
+		Name: "delete with a range condition and ORDER BY DESC and LIMIT on an index",
+		SetUpScript: []string{
+			"create table t (id int primary key, ts int, key (ts))",
+			"insert into t values (1, 50), (2, 10), (3, 40), (4, 20), (5, 30), (6, 60)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "delete from t where id > 2 order by id desc limit 2",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:    "select id from t order by id",
+				Expected: []sql.Row{{1}, {2}, {3}, {4}},
+			},
+			{
+				Query:    "delete from t where ts < 45 order by ts desc limit 1",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "select id from t order by id",
+				Expected: []sql.Row{{1}, {2}, {4}},
+			},
+		},
+	},
+}
+
 // DeleteJoinTests contains tests for deletes that explicitly list the table from which
 // to delete, and whose source may contain joined table relations.
 var DeleteJoinTests = []WriteQueryTest{
@@ -2838,4 +2838,56 @@ var ComplexIndexQueries = []QueryTest{
 			{int64(3), []uint8("abcde"), []uint8("abcde")},
 		},
 	},
+	{
+		Query: `select * from desc_index_t0 order by v1 desc, v2 asc`,
+		Expected: []sql.Row{
+			{5, 3, 3},
+			{3, 2, 0},
+			{1, 1, 1},
+			{2, 1, 2},
+			{0, 0, 3},
+			{4, nil, 5},
+		},
+	},
+	{
+		Query: `select * from desc_index_t0 order by v1 asc, v2 desc`,
+		Expected: []sql.Row{
+			{4, nil, 5},
+			{0, 0, 3},
+			{2, 1, 2},
+			{1, 1, 1},
+			{3, 2, 0},
+			{5, 3, 3},
+		},
+	},
+	{
+		Query: `select * from desc_index_t0 order by v1 desc, v2 desc`,
+		Expected: []sql.Row{
+			{5, 3, 3},
+			{3, 2, 0},
+			{2, 1, 2},
+			{1, 1, 1},
+			{0, 0, 3},
+			{4, nil, 5},
+		},
+	},
+	{
+		Query: `select pk from desc_index_t0 order by pk desc`,
+		Expected: []sql.Row{
+			{5},
+			{4},
+			{3},
+			{2},
+			{1},
+			{0},
+		},
+	},
+	{
+		Query: `select * from desc_index_t0 where v1 between 1 and 2`,
+		Expected: []sql.Row{
+			{1, 1, 1},
+			{2, 1, 2},
+			{3, 2, 0},
+		},
+	},
 }
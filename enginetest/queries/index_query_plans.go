@@ -21,8 +21,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" ├─ Eq\n" +
 			" │   ├─ pref_index_t4.v1:1\n" +
 			" │   └─ a (longtext)\n" +
-			" └─ IndexedTableAccess(pref_index_t4)\n" +
-			"     ├─ index: [pref_index_t4.v1,pref_index_t4.v2]\n" +
+			" └─ IndexedTableAccess(mydb.pref_index_t4)\n" +
+			"     ├─ index: v1v2 [pref_index_t4.v1,pref_index_t4.v2]\n" +
 			"     ├─ static: [{[a, a], [NULL, ∞)}]\n" +
 			"     └─ columns: [i v1 v2]\n" +
 			"",
@@ -33,8 +33,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" ├─ Eq\n" +
 			" │   ├─ pref_index_t4.v1:1\n" +
 			" │   └─ abc (longtext)\n" +
-			" └─ IndexedTableAccess(pref_index_t4)\n" +
-			"     ├─ index: [pref_index_t4.v1,pref_index_t4.v2]\n" +
+			" └─ IndexedTableAccess(mydb.pref_index_t4)\n" +
+			"     ├─ index: v1v2 [pref_index_t4.v1,pref_index_t4.v2]\n" +
 			"     ├─ static: [{[abc, abc], [NULL, ∞)}]\n" +
 			"     └─ columns: [i v1 v2]\n" +
 			"",
@@ -45,8 +45,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" ├─ Eq\n" +
 			" │   ├─ pref_index_t4.v1:1\n" +
 			" │   └─ abcd (longtext)\n" +
-			" └─ IndexedTableAccess(pref_index_t4)\n" +
-			"     ├─ index: [pref_index_t4.v1,pref_index_t4.v2]\n" +
+			" └─ IndexedTableAccess(mydb.pref_index_t4)\n" +
+			"     ├─ index: v1v2 [pref_index_t4.v1,pref_index_t4.v2]\n" +
 			"     ├─ static: [{[abcd, abcd], [NULL, ∞)}]\n" +
 			"     └─ columns: [i v1 v2]\n" +
 			"",
@@ -61,8 +61,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ LessThan\n" +
 			" │       ├─ pref_index_t4.v1:1\n" +
 			" │       └─ abcde (longtext)\n" +
-			" └─ IndexedTableAccess(pref_index_t4)\n" +
-			"     ├─ index: [pref_index_t4.v1,pref_index_t4.v2]\n" +
+			" └─ IndexedTableAccess(mydb.pref_index_t4)\n" +
+			"     ├─ index: v1v2 [pref_index_t4.v1,pref_index_t4.v2]\n" +
 			"     ├─ static: [{(a, abcde), [NULL, ∞)}]\n" +
 			"     └─ columns: [i v1 v2]\n" +
 			"",
@@ -77,8 +77,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ LessThan\n" +
 			" │       ├─ pref_index_t4.v2:2\n" +
 			" │       └─ abcde (longtext)\n" +
-			" └─ IndexedTableAccess(pref_index_t4)\n" +
-			"     ├─ index: [pref_index_t4.v1,pref_index_t4.v2]\n" +
+			" └─ IndexedTableAccess(mydb.pref_index_t4)\n" +
+			"     ├─ index: v1v2 [pref_index_t4.v1,pref_index_t4.v2]\n" +
 			"     ├─ static: [{(a, ∞), (NULL, abcde)}]\n" +
 			"     └─ columns: [i v1 v2]\n" +
 			"",
@@ -92,8 +92,8 @@ var IndexPlanTests = []QueryPlanTest{
 			"             ├─ GreaterThanOrEqual\n" +
 			"             │   ├─ pref_index_t4.v1:1\n" +
 			"             │   └─ a (longtext)\n" +
-			"             └─ IndexedTableAccess(pref_index_t4)\n" +
-			"                 ├─ index: [pref_index_t4.v1,pref_index_t4.v2]\n" +
+			"             └─ IndexedTableAccess(mydb.pref_index_t4)\n" +
+			"                 ├─ index: v1v2 [pref_index_t4.v1,pref_index_t4.v2]\n" +
 			"                 ├─ static: [{[a, ∞), [NULL, ∞)}]\n" +
 			"                 └─ columns: [i v1 v2]\n" +
 			"",
@@ -106,8 +106,8 @@ var IndexPlanTests = []QueryPlanTest{
 			"         ├─ GreaterThanOrEqual\n" +
 			"         │   ├─ pref_index_t4.v1:1\n" +
 			"         │   └─ a (longtext)\n" +
-			"         └─ IndexedTableAccess(pref_index_t4)\n" +
-			"             ├─ index: [pref_index_t4.v1,pref_index_t4.v2]\n" +
+			"         └─ IndexedTableAccess(mydb.pref_index_t4)\n" +
+			"             ├─ index: v1v2 [pref_index_t4.v1,pref_index_t4.v2]\n" +
 			"             ├─ static: [{[a, ∞), [NULL, ∞)}]\n" +
 			"             └─ columns: [i v1 v2]\n" +
 			"",
@@ -118,8 +118,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" ├─ Eq\n" +
 			" │   ├─ pref_index_t3.v1:0\n" +
 			" │   └─ a (longtext)\n" +
-			" └─ IndexedTableAccess(pref_index_t3)\n" +
-			"     ├─ index: [pref_index_t3.v1,pref_index_t3.v2]\n" +
+			" └─ IndexedTableAccess(mydb.pref_index_t3)\n" +
+			"     ├─ index: v1v2 [pref_index_t3.v1,pref_index_t3.v2]\n" +
 			"     ├─ static: [{[a, a], [NULL, ∞)}]\n" +
 			"     └─ columns: [v1 v2]\n" +
 			"",
@@ -130,8 +130,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" ├─ Eq\n" +
 			" │   ├─ pref_index_t3.v1:0\n" +
 			" │   └─ abc (longtext)\n" +
-			" └─ IndexedTableAccess(pref_index_t3)\n" +
-			"     ├─ index: [pref_index_t3.v1,pref_index_t3.v2]\n" +
+			" └─ IndexedTableAccess(mydb.pref_index_t3)\n" +
+			"     ├─ index: v1v2 [pref_index_t3.v1,pref_index_t3.v2]\n" +
 			"     ├─ static: [{[abc, abc], [NULL, ∞)}]\n" +
 			"     └─ columns: [v1 v2]\n" +
 			"",
@@ -142,8 +142,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" ├─ Eq\n" +
 			" │   ├─ pref_index_t3.v1:0\n" +
 			" │   └─ abcd (longtext)\n" +
-			" └─ IndexedTableAccess(pref_index_t3)\n" +
-			"     ├─ index: [pref_index_t3.v1,pref_index_t3.v2]\n" +
+			" └─ IndexedTableAccess(mydb.pref_index_t3)\n" +
+			"     ├─ index: v1v2 [pref_index_t3.v1,pref_index_t3.v2]\n" +
 			"     ├─ static: [{[abcd, abcd], [NULL, ∞)}]\n" +
 			"     └─ columns: [v1 v2]\n" +
 			"",
@@ -158,8 +158,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ LessThan\n" +
 			" │       ├─ pref_index_t3.v1:0\n" +
 			" │       └─ abcde (longtext)\n" +
-			" └─ IndexedTableAccess(pref_index_t3)\n" +
-			"     ├─ index: [pref_index_t3.v1,pref_index_t3.v2]\n" +
+			" └─ IndexedTableAccess(mydb.pref_index_t3)\n" +
+			"     ├─ index: v1v2 [pref_index_t3.v1,pref_index_t3.v2]\n" +
 			"     ├─ static: [{(a, abcde), [NULL, ∞)}]\n" +
 			"     └─ columns: [v1 v2]\n" +
 			"",
@@ -174,8 +174,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ LessThan\n" +
 			" │       ├─ pref_index_t3.v2:1\n" +
 			" │       └─ abcde (longtext)\n" +
-			" └─ IndexedTableAccess(pref_index_t3)\n" +
-			"     ├─ index: [pref_index_t3.v1,pref_index_t3.v2]\n" +
+			" └─ IndexedTableAccess(mydb.pref_index_t3)\n" +
+			"     ├─ index: v1v2 [pref_index_t3.v1,pref_index_t3.v2]\n" +
 			"     ├─ static: [{(a, ∞), (NULL, abcde)}]\n" +
 			"     └─ columns: [v1 v2]\n" +
 			"",
@@ -189,8 +189,8 @@ var IndexPlanTests = []QueryPlanTest{
 			"             ├─ GreaterThanOrEqual\n" +
 			"             │   ├─ pref_index_t3.v1:0\n" +
 			"             │   └─ a (longtext)\n" +
-			"             └─ IndexedTableAccess(pref_index_t3)\n" +
-			"                 ├─ index: [pref_index_t3.v1,pref_index_t3.v2]\n" +
+			"             └─ IndexedTableAccess(mydb.pref_index_t3)\n" +
+			"                 ├─ index: v1v2 [pref_index_t3.v1,pref_index_t3.v2]\n" +
 			"                 ├─ static: [{[a, ∞), [NULL, ∞)}]\n" +
 			"                 └─ columns: [v1 v2]\n" +
 			"",
@@ -203,8 +203,8 @@ var IndexPlanTests = []QueryPlanTest{
 			"         ├─ GreaterThanOrEqual\n" +
 			"         │   ├─ pref_index_t3.v1:0\n" +
 			"         │   └─ a (longtext)\n" +
-			"         └─ IndexedTableAccess(pref_index_t3)\n" +
-			"             ├─ index: [pref_index_t3.v1,pref_index_t3.v2]\n" +
+			"         └─ IndexedTableAccess(mydb.pref_index_t3)\n" +
+			"             ├─ index: v1v2 [pref_index_t3.v1,pref_index_t3.v2]\n" +
 			"             ├─ static: [{[a, ∞), [NULL, ∞)}]\n" +
 			"             └─ columns: [v1 v2]\n" +
 			"",
@@ -215,8 +215,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" ├─ Eq\n" +
 			" │   ├─ pref_index_t2.v1:1\n" +
 			" │   └─ A (longtext)\n" +
-			" └─ IndexedTableAccess(pref_index_t2)\n" +
-			"     ├─ index: [pref_index_t2.v1,pref_index_t2.v2]\n" +
+			" └─ IndexedTableAccess(mydb.pref_index_t2)\n" +
+			"     ├─ index: v1v2 [pref_index_t2.v1,pref_index_t2.v2]\n" +
 			"     ├─ static: [{[A, A], [NULL, ∞)}]\n" +
 			"     └─ columns: [i v1 v2]\n" +
 			"",
@@ -227,8 +227,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" ├─ Eq\n" +
 			" │   ├─ pref_index_t2.v1:1\n" +
 			" │   └─ ABC (longtext)\n" +
-			" └─ IndexedTableAccess(pref_index_t2)\n" +
-			"     ├─ index: [pref_index_t2.v1,pref_index_t2.v2]\n" +
+			" └─ IndexedTableAccess(mydb.pref_index_t2)\n" +
+			"     ├─ index: v1v2 [pref_index_t2.v1,pref_index_t2.v2]\n" +
 			"     ├─ static: [{[ABC, ABC], [NULL, ∞)}]\n" +
 			"     └─ columns: [i v1 v2]\n" +
 			"",
@@ -239,8 +239,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" ├─ Eq\n" +
 			" │   ├─ pref_index_t2.v1:1\n" +
 			" │   └─ ABCD (longtext)\n" +
-			" └─ IndexedTableAccess(pref_index_t2)\n" +
-			"     ├─ index: [pref_index_t2.v1,pref_index_t2.v2]\n" +
+			" └─ IndexedTableAccess(mydb.pref_index_t2)\n" +
+			"     ├─ index: v1v2 [pref_index_t2.v1,pref_index_t2.v2]\n" +
 			"     ├─ static: [{[ABCD, ABCD], [NULL, ∞)}]\n" +
 			"     └─ columns: [i v1 v2]\n" +
 			"",
@@ -255,8 +255,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ LessThan\n" +
 			" │       ├─ pref_index_t2.v1:1\n" +
 			" │       └─ ABCDE (longtext)\n" +
-			" └─ IndexedTableAccess(pref_index_t2)\n" +
-			"     ├─ index: [pref_index_t2.v1,pref_index_t2.v2]\n" +
+			" └─ IndexedTableAccess(mydb.pref_index_t2)\n" +
+			"     ├─ index: v1v2 [pref_index_t2.v1,pref_index_t2.v2]\n" +
 			"     ├─ static: [{(A, ABCDE), [NULL, ∞)}]\n" +
 			"     └─ columns: [i v1 v2]\n" +
 			"",
@@ -271,8 +271,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ LessThan\n" +
 			" │       ├─ pref_index_t2.v2:2\n" +
 			" │       └─ ABCDE (longtext)\n" +
-			" └─ IndexedTableAccess(pref_index_t2)\n" +
-			"     ├─ index: [pref_index_t2.v1,pref_index_t2.v2]\n" +
+			" └─ IndexedTableAccess(mydb.pref_index_t2)\n" +
+			"     ├─ index: v1v2 [pref_index_t2.v1,pref_index_t2.v2]\n" +
 			"     ├─ static: [{(A, ∞), (NULL, ABCDE)}]\n" +
 			"     └─ columns: [i v1 v2]\n" +
 			"",
@@ -286,8 +286,8 @@ var IndexPlanTests = []QueryPlanTest{
 			"             ├─ GreaterThanOrEqual\n" +
 			"             │   ├─ pref_index_t2.v1:1\n" +
 			"             │   └─ A (longtext)\n" +
-			"             └─ IndexedTableAccess(pref_index_t2)\n" +
-			"                 ├─ index: [pref_index_t2.v1,pref_index_t2.v2]\n" +
+			"             └─ IndexedTableAccess(mydb.pref_index_t2)\n" +
+			"                 ├─ index: v1v2 [pref_index_t2.v1,pref_index_t2.v2]\n" +
 			"                 ├─ static: [{[A, ∞), [NULL, ∞)}]\n" +
 			"                 └─ columns: [i v1 v2]\n" +
 			"",
@@ -300,8 +300,8 @@ var IndexPlanTests = []QueryPlanTest{
 			"         ├─ GreaterThanOrEqual\n" +
 			"         │   ├─ pref_index_t2.v1:1\n" +
 			"         │   └─ A (longtext)\n" +
-			"         └─ IndexedTableAccess(pref_index_t2)\n" +
-			"             ├─ index: [pref_index_t2.v1,pref_index_t2.v2]\n" +
+			"         └─ IndexedTableAccess(mydb.pref_index_t2)\n" +
+			"             ├─ index: v1v2 [pref_index_t2.v1,pref_index_t2.v2]\n" +
 			"             ├─ static: [{[A, ∞), [NULL, ∞)}]\n" +
 			"             └─ columns: [i v1 v2]\n" +
 			"",
@@ -312,8 +312,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" ├─ Eq\n" +
 			" │   ├─ pref_index_t1.v1:1\n" +
 			" │   └─ a (longtext)\n" +
-			" └─ IndexedTableAccess(pref_index_t1)\n" +
-			"     ├─ index: [pref_index_t1.v1,pref_index_t1.v2]\n" +
+			" └─ IndexedTableAccess(mydb.pref_index_t1)\n" +
+			"     ├─ index: v1v2 [pref_index_t1.v1,pref_index_t1.v2]\n" +
 			"     ├─ static: [{[a, a], [NULL, ∞)}]\n" +
 			"     └─ columns: [i v1 v2]\n" +
 			"",
@@ -324,8 +324,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" ├─ Eq\n" +
 			" │   ├─ pref_index_t1.v1:1\n" +
 			" │   └─ abc (longtext)\n" +
-			" └─ IndexedTableAccess(pref_index_t1)\n" +
-			"     ├─ index: [pref_index_t1.v1,pref_index_t1.v2]\n" +
+			" └─ IndexedTableAccess(mydb.pref_index_t1)\n" +
+			"     ├─ index: v1v2 [pref_index_t1.v1,pref_index_t1.v2]\n" +
 			"     ├─ static: [{[abc, abc], [NULL, ∞)}]\n" +
 			"     └─ columns: [i v1 v2]\n" +
 			"",
@@ -336,8 +336,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" ├─ Eq\n" +
 			" │   ├─ pref_index_t1.v1:1\n" +
 			" │   └─ abcd (longtext)\n" +
-			" └─ IndexedTableAccess(pref_index_t1)\n" +
-			"     ├─ index: [pref_index_t1.v1,pref_index_t1.v2]\n" +
+			" └─ IndexedTableAccess(mydb.pref_index_t1)\n" +
+			"     ├─ index: v1v2 [pref_index_t1.v1,pref_index_t1.v2]\n" +
 			"     ├─ static: [{[abcd, abcd], [NULL, ∞)}]\n" +
 			"     └─ columns: [i v1 v2]\n" +
 			"",
@@ -352,8 +352,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ LessThan\n" +
 			" │       ├─ pref_index_t1.v1:1\n" +
 			" │       └─ abcde (longtext)\n" +
-			" └─ IndexedTableAccess(pref_index_t1)\n" +
-			"     ├─ index: [pref_index_t1.v1,pref_index_t1.v2]\n" +
+			" └─ IndexedTableAccess(mydb.pref_index_t1)\n" +
+			"     ├─ index: v1v2 [pref_index_t1.v1,pref_index_t1.v2]\n" +
 			"     ├─ static: [{(a, abcde), [NULL, ∞)}]\n" +
 			"     └─ columns: [i v1 v2]\n" +
 			"",
@@ -368,8 +368,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ LessThan\n" +
 			" │       ├─ pref_index_t1.v2:2\n" +
 			" │       └─ abcde (longtext)\n" +
-			" └─ IndexedTableAccess(pref_index_t1)\n" +
-			"     ├─ index: [pref_index_t1.v1,pref_index_t1.v2]\n" +
+			" └─ IndexedTableAccess(mydb.pref_index_t1)\n" +
+			"     ├─ index: v1v2 [pref_index_t1.v1,pref_index_t1.v2]\n" +
 			"     ├─ static: [{(a, ∞), (NULL, abcde)}]\n" +
 			"     └─ columns: [i v1 v2]\n" +
 			"",
@@ -382,8 +382,8 @@ var IndexPlanTests = []QueryPlanTest{
 			"         ├─ GreaterThanOrEqual\n" +
 			"         │   ├─ pref_index_t1.v1:1\n" +
 			"         │   └─ a (longtext)\n" +
-			"         └─ IndexedTableAccess(pref_index_t1)\n" +
-			"             ├─ index: [pref_index_t1.v1,pref_index_t1.v2]\n" +
+			"         └─ IndexedTableAccess(mydb.pref_index_t1)\n" +
+			"             ├─ index: v1v2 [pref_index_t1.v1,pref_index_t1.v2]\n" +
 			"             ├─ static: [{[a, ∞), [NULL, ∞)}]\n" +
 			"             └─ columns: [i v1 v2]\n" +
 			"",
@@ -397,48 +397,48 @@ var IndexPlanTests = []QueryPlanTest{
 			"             ├─ GreaterThanOrEqual\n" +
 			"             │   ├─ pref_index_t1.v1:1\n" +
 			"             │   └─ a (longtext)\n" +
-			"             └─ IndexedTableAccess(pref_index_t1)\n" +
-			"                 ├─ index: [pref_index_t1.v1,pref_index_t1.v2]\n" +
+			"             └─ IndexedTableAccess(mydb.pref_index_t1)\n" +
+			"                 ├─ index: v1v2 [pref_index_t1.v1,pref_index_t1.v2]\n" +
 			"                 ├─ static: [{[a, ∞), [NULL, ∞)}]\n" +
 			"                 └─ columns: [i v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1<25) OR (v1>24));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1>=99 AND v2<>83) OR (v1>=1));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{[1, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE (((v1<=38 AND v2<41) OR (v1>60)) OR (v1<22));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(NULL, 22), [NULL, ∞)}, {[22, 38], (NULL, 41)}, {(60, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1>92 AND v2>25) OR (v1 BETWEEN 6 AND 24 AND v2=80));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{[6, 24], [80, 80]}, {(92, ∞), (25, ∞)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1<=29) OR (v1=49 AND v2<48));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(NULL, 29], [NULL, ∞)}, {[49, 49], (NULL, 48)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
@@ -454,8 +454,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ LessThanOrEqual\n" +
 			" │       ├─ comp_index_t0.v1:1\n" +
 			" │       └─ 11 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t0)\n" +
-			"     ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t0)\n" +
+			"     ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			"     ├─ static: [{(NULL, 75), [NULL, ∞)}, {(75, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2]\n" +
 			"",
@@ -471,8 +471,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t0.v1:1\n" +
 			" │           └─ 9 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t0)\n" +
-			"     ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t0)\n" +
+			"     ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			"     ├─ static: [{[87, 87], (NULL, 45]}]\n" +
 			"     └─ columns: [pk v1 v2]\n" +
 			"",
@@ -492,24 +492,24 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t0.v1:1\n" +
 			" │           └─ 96 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t0)\n" +
-			"     ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t0)\n" +
+			"     ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			"     ├─ static: [{(NULL, 96), [NULL, ∞)}, {(96, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1<=97) OR (v1 BETWEEN 36 AND 98));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(NULL, 98], [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1=86 AND v2>41) OR (v1<>6 AND v2>16));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(NULL, 6), (16, ∞)}, {(6, ∞), (16, ∞)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
@@ -534,56 +534,56 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ LessThanOrEqual\n" +
 			" │       ├─ comp_index_t0.v1:1\n" +
 			" │       └─ 34 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t0)\n" +
-			"     ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t0)\n" +
+			"     ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			"     ├─ static: [{(NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1<11) OR (v1>=66 AND v2=22));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(NULL, 11), [NULL, ∞)}, {[66, ∞), [22, 22]}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1<>45 AND v2>37) OR (v1<98 AND v2<=35));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(NULL, 45), (37, ∞)}, {(NULL, 98), (NULL, 35]}, {(45, ∞), (37, ∞)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1>=16 AND v2>96) OR (v1<80));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(NULL, 80), [NULL, ∞)}, {[80, ∞), (96, ∞)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE (((v1<=98) OR (v1<85 AND v2>60)) OR (v1<>53 AND v2 BETWEEN 82 AND 89));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(NULL, 98], [NULL, ∞)}, {(98, ∞), [82, 89]}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE (((((v1<71 AND v2<7) OR (v1<=21 AND v2<=48)) OR (v1=44 AND v2 BETWEEN 21 AND 83)) OR (v1<=72 AND v2<>27)) OR (v1=35 AND v2 BETWEEN 78 AND 89));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(NULL, 21], (NULL, ∞)}, {(21, 44), (NULL, 27)}, {(21, 44), (27, ∞)}, {[44, 44], (NULL, ∞)}, {(44, 72], (NULL, 27)}, {(44, 72], (27, ∞)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE (((v1<=16) OR (v1>=77 AND v2>77)) OR (v1>19 AND v2>27));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(NULL, 16], [NULL, ∞)}, {(19, ∞), (27, ∞)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
@@ -612,48 +612,48 @@ var IndexPlanTests = []QueryPlanTest{
 			" │           └─ Eq\n" +
 			" │               ├─ comp_index_t0.v2:2\n" +
 			" │               └─ 39 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t0)\n" +
-			"     ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t0)\n" +
+			"     ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			"     ├─ static: [{[33, 46), (NULL, 39)}, {[33, 46), (39, ∞)}, {[46, ∞), (NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1<39 AND v2<10) OR (v1>64 AND v2<=15)) AND (v1>=41);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(64, ∞), (NULL, 15]}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE (((v1<=91) OR (v1<70 AND v2>=23)) OR (v1>23 AND v2<38));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(NULL, 91], [NULL, ∞)}, {(91, ∞), (NULL, 38)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((((v1<>45 AND v2=70) OR (v1 BETWEEN 40 AND 96 AND v2 BETWEEN 48 AND 96)) OR (v1<>87 AND v2<31)) OR (v1<>62 AND v2=51)) AND (v1>=47 AND v2<29);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{[47, 87), (NULL, 29)}, {(87, ∞), (NULL, 29)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1<71) OR (v1 BETWEEN 46 AND 79));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(NULL, 79], [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1>52) OR (v1<=14));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(NULL, 14], [NULL, ∞)}, {(52, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
@@ -674,24 +674,24 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThanOrEqual\n" +
 			" │           ├─ comp_index_t0.v2:2\n" +
 			" │           └─ 54 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t0)\n" +
-			"     ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t0)\n" +
+			"     ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			"     ├─ static: [{(NULL, 74), [NULL, ∞)}, {[74, 74], [54, ∞)}, {(74, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1<=69 AND v2<24) OR (v1<77 AND v2<=53));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(NULL, 77), (NULL, 53]}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1=78 AND v2=87) OR (v1 BETWEEN 37 AND 58 AND v2>=30)) AND (v1=86 AND v2 BETWEEN 0 AND 70);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(∞, ∞), (∞, ∞)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
@@ -707,48 +707,48 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ LessThanOrEqual\n" +
 			" │       ├─ comp_index_t0.v1:1\n" +
 			" │       └─ 52 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t0)\n" +
-			"     ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t0)\n" +
+			"     ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			"     ├─ static: [{(NULL, 94), [NULL, ∞)}, {(94, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE (((v1<>23 AND v2>64) OR (v1>73 AND v2<=66)) OR (v1 BETWEEN 39 AND 69 AND v2>84));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(NULL, 23), (64, ∞)}, {(23, 73], (64, ∞)}, {(73, ∞), (NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1>54 AND v2<16) OR (v1<74 AND v2>29)) AND (v1 BETWEEN 34 AND 48);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{[34, 48], (29, ∞)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1<>44 AND v2>12) OR (v1<=5 AND v2>27));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(NULL, 44), (12, ∞)}, {(44, ∞), (12, ∞)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1<=54 AND v2<>13) OR (v1>84));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(NULL, 54], (NULL, 13)}, {(NULL, 54], (13, ∞)}, {(84, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1>1 AND v2<>51) OR (v1=28));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(1, 28), (NULL, 51)}, {(1, 28), (51, ∞)}, {[28, 28], [NULL, ∞)}, {(28, ∞), (NULL, 51)}, {(28, ∞), (51, ∞)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
@@ -766,16 +766,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t0.v1:1\n" +
 			" │           └─ 98 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t0)\n" +
-			"     ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t0)\n" +
+			"     ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			"     ├─ static: [{(NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1=16 AND v2=57) OR (v1<46 AND v2 BETWEEN 78 AND 89));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(NULL, 46), [78, 89]}, {[16, 16], [57, 57]}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
@@ -799,8 +799,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ GreaterThan\n" +
 			" │       ├─ comp_index_t0.v1:1\n" +
 			" │       └─ 23 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t0)\n" +
-			"     ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t0)\n" +
+			"     ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			"     ├─ static: [{(NULL, 23], (NULL, 10)}, {(23, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2]\n" +
 			"",
@@ -835,24 +835,24 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ LessThan\n" +
 			" │       ├─ comp_index_t0.v1:1\n" +
 			" │       └─ 99 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t0)\n" +
-			"     ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t0)\n" +
+			"     ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			"     ├─ static: [{(NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1 BETWEEN 34 AND 34 AND v2 BETWEEN 0 AND 91) OR (v1 BETWEEN 54 AND 77 AND v2>92));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{[34, 34], [0, 91]}, {[54, 77], (92, ∞)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((((((v1<=55) OR (v1>=46 AND v2<=26)) OR (v1 BETWEEN 8 AND 54)) OR (v1>26 AND v2 BETWEEN 62 AND 89)) OR (v1<31 AND v2=11)) OR (v1>9 AND v2=60));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(NULL, 55], [NULL, ∞)}, {(55, ∞), (NULL, 26]}, {(55, ∞), [60, 60]}, {(55, ∞), [62, 89]}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
@@ -878,64 +878,64 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t0.v1:1\n" +
 			" │           └─ 50 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t0)\n" +
-			"     ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t0)\n" +
+			"     ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			"     ├─ static: [{(NULL, 50), [NULL, ∞)}, {(50, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1>39 AND v2>66) OR (v1=99));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(39, 99), (66, ∞)}, {[99, 99], [NULL, ∞)}, {(99, ∞), (66, ∞)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1 BETWEEN 24 AND 66) OR (v1<=81 AND v2<>29));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(NULL, 24), (NULL, 29)}, {(NULL, 24), (29, ∞)}, {[24, 66], [NULL, ∞)}, {(66, 81], (NULL, 29)}, {(66, 81], (29, ∞)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE (((v1<>18 AND v2<>8) OR (v1>=10 AND v2>3)) OR (v1=53));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(NULL, 10), (NULL, 8)}, {(NULL, 10), (8, ∞)}, {[10, 18), (NULL, ∞)}, {[18, 18], (3, ∞)}, {(18, 53), (NULL, ∞)}, {[53, 53], [NULL, ∞)}, {(53, ∞), (NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1>=42 AND v2>34) OR (v1<=40 AND v2<=49));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(NULL, 40], (NULL, 49]}, {[42, ∞), (34, ∞)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1 BETWEEN 8 AND 38) OR (v1>=23 AND v2 BETWEEN 36 AND 49));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{[8, 38], [NULL, ∞)}, {(38, ∞), [36, 49]}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1<>57 AND v2 BETWEEN 2 AND 93) OR (v1=52));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(NULL, 52), [2, 93]}, {[52, 52], [NULL, ∞)}, {(52, 57), [2, 93]}, {(57, ∞), [2, 93]}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((((v1<24) OR (v1<41)) OR (v1<12 AND v2=2)) OR (v1=3 AND v2<>66));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(NULL, 41), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
@@ -970,48 +970,48 @@ var IndexPlanTests = []QueryPlanTest{
 			" │           └─ Eq\n" +
 			" │               ├─ comp_index_t0.v2:2\n" +
 			" │               └─ 56 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t0)\n" +
-			"     ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t0)\n" +
+			"     ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			"     ├─ static: [{(NULL, 31), (NULL, 56)}, {(NULL, 31), (56, ∞)}, {[31, 31], [54, 54]}, {(31, ∞), (NULL, 56)}, {(31, ∞), (56, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1<>52 AND v2<90) OR (v1 BETWEEN 27 AND 77 AND v2 BETWEEN 49 AND 83));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(NULL, 52), (NULL, 90)}, {[52, 52], [49, 83]}, {(52, ∞), (NULL, 90)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1>2) OR (v1<72 AND v2>=21)) AND (v1=69 AND v2 BETWEEN 44 AND 48);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{[69, 69], [44, 48]}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((((v1>77) OR (v1=57)) OR (v1>9 AND v2>80)) OR (v1=22));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(9, 22), (80, ∞)}, {[22, 22], [NULL, ∞)}, {(22, 57), (80, ∞)}, {[57, 57], [NULL, ∞)}, {(57, 77], (80, ∞)}, {(77, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((((v1>28) OR (v1<=30 AND v2=30)) OR (v1<29)) OR (v1 BETWEEN 54 AND 74));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1<>30 AND v2 BETWEEN 20 AND 41) OR (v1>=69 AND v2=51));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(NULL, 30), [20, 41]}, {(30, ∞), [20, 41]}, {[69, ∞), [51, 51]}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
@@ -1027,24 +1027,24 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ Eq\n" +
 			" │       ├─ comp_index_t0.v1:1\n" +
 			" │       └─ 55 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t0)\n" +
-			"     ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t0)\n" +
+			"     ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			"     ├─ static: [{[67, 67], [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE (((v1<20 AND v2<=46) OR (v1<>4 AND v2=26)) OR (v1>36 AND v2<>13));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(NULL, 20), (NULL, 46]}, {[20, 36], [26, 26]}, {(36, ∞), (NULL, 13)}, {(36, ∞), (13, ∞)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE (((v1<=5 AND v2>66) OR (v1<=0)) OR (v1 BETWEEN 10 AND 87));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(NULL, 0], [NULL, ∞)}, {(0, 5], (66, ∞)}, {[10, 87], [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
@@ -1097,16 +1097,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ GreaterThan\n" +
 			" │       ├─ comp_index_t0.v1:1\n" +
 			" │       └─ 86 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t0)\n" +
-			"     ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t0)\n" +
+			"     ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			"     ├─ static: [{[15, 47], (NULL, 69)}, {[15, 47], (69, ∞)}, {(55, 86], (85, ∞)}, {(86, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE (((v1<37) OR (v1<=48 AND v2<=54)) OR (v1=88));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(NULL, 37), [NULL, ∞)}, {[37, 48], (NULL, 54]}, {[88, 88], [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
@@ -1131,8 +1131,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThan\n" +
 			" │           ├─ comp_index_t0.v2:2\n" +
 			" │           └─ 5 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t0)\n" +
-			"     ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t0)\n" +
+			"     ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			"     ├─ static: [{(NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2]\n" +
 			"",
@@ -1152,8 +1152,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ LessThan\n" +
 			" │       ├─ comp_index_t0.v1:1\n" +
 			" │       └─ 64 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t0)\n" +
-			"     ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t0)\n" +
+			"     ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			"     ├─ static: [{(NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2]\n" +
 			"",
@@ -1169,16 +1169,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ GreaterThan\n" +
 			" │       ├─ comp_index_t0.v1:1\n" +
 			" │       └─ 11 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t0)\n" +
-			"     ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t0)\n" +
+			"     ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			"     ├─ static: [{(NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1>40) OR (v1>=49 AND v2>=92));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(40, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
@@ -1205,16 +1205,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ GreaterThan\n" +
 			" │       ├─ comp_index_t0.v1:1\n" +
 			" │       └─ 9 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t0)\n" +
-			"     ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t0)\n" +
+			"     ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			"     ├─ static: [{(9, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1<=53 AND v2<=79) OR (v1>50 AND v2>26)) AND (v1>26) AND (v1>43 AND v2<7);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(43, 53], (NULL, 7)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
@@ -1236,40 +1236,40 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t0.v1:1\n" +
 			" │           └─ 30 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t0)\n" +
-			"     ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t0)\n" +
+			"     ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			"     ├─ static: [{(NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1=45) OR (v1=28));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{[28, 28], [NULL, ∞)}, {[45, 45], [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE (v1 BETWEEN 11 AND 18) AND (v1>31 AND v2 BETWEEN 38 AND 88);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(∞, ∞), (∞, ∞)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1>95 AND v2>5) OR (v1>16 AND v2>=38));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(16, 95], [38, ∞)}, {(95, ∞), (5, ∞)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1>=23) OR (v1=47 AND v2>23));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{[23, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
@@ -1285,40 +1285,40 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t0.v1:1\n" +
 			" │           └─ 67 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t0)\n" +
-			"     ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t0)\n" +
+			"     ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			"     ├─ static: [{(NULL, 67), [NULL, ∞)}, {(67, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1>=30 AND v2>=67) OR (v1<=52));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(NULL, 52], [NULL, ∞)}, {(52, ∞), [67, ∞)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE (((v1 BETWEEN 48 AND 86 AND v2>=29) OR (v1<>82 AND v2<=93)) OR (v1 BETWEEN 79 AND 87 AND v2 BETWEEN 13 AND 69));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(NULL, 48), (NULL, 93]}, {[48, 82), (NULL, ∞)}, {[82, 82], [13, ∞)}, {(82, 86], (NULL, ∞)}, {(86, ∞), (NULL, 93]}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE (((v1 BETWEEN 3 AND 95 AND v2>=36) OR (v1>=40 AND v2<13)) OR (v1 BETWEEN 4 AND 8 AND v2=50));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{[3, 95], [36, ∞)}, {[40, ∞), (NULL, 13)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE (((v1<11 AND v2<>32) OR (v1 BETWEEN 35 AND 41)) OR (v1>=76));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(NULL, 11), (NULL, 32)}, {(NULL, 11), (32, ∞)}, {[35, 41], [NULL, ∞)}, {[76, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
@@ -1339,16 +1339,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   │       ├─ comp_index_t0.v1:1\n" +
 			" │   │       └─ 2 (tinyint)\n" +
 			" │   └─ (comp_index_t0.v1:1 BETWEEN 50 (tinyint) AND 97 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t0)\n" +
-			"     ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t0)\n" +
+			"     ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			"     ├─ static: [{[15, 15], [8, 8]}, {[50, 97], [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1<67 AND v2<>39) OR (v1>36));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(NULL, 36], (NULL, 39)}, {(NULL, 36], (39, ∞)}, {(36, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
@@ -1364,40 +1364,40 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ LessThan\n" +
 			" │       ├─ comp_index_t0.v1:1\n" +
 			" │       └─ 50 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t0)\n" +
-			"     ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t0)\n" +
+			"     ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			"     ├─ static: [{(NULL, 66), [NULL, ∞)}, {(66, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE (((v1 BETWEEN 5 AND 19) OR (v1<>50 AND v2>=51)) OR (v1>55));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(NULL, 5), [51, ∞)}, {[5, 19], [NULL, ∞)}, {(19, 50), [51, ∞)}, {(50, 55], [51, ∞)}, {(55, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE (((v1 BETWEEN 16 AND 65) OR (v1<>18 AND v2>=81)) OR (v1 BETWEEN 6 AND 48));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(NULL, 6), [81, ∞)}, {[6, 65], [NULL, ∞)}, {(65, ∞), [81, ∞)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE (((v1>=31 AND v2>=55) OR (v1 BETWEEN 1 AND 28)) OR (v1 BETWEEN 26 AND 41 AND v2<=15));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{[1, 28], [NULL, ∞)}, {(28, 41], (NULL, 15]}, {[31, ∞), [55, ∞)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE (((v1<=77 AND v2 BETWEEN 4 AND 26) OR (v1<=1 AND v2<>20)) OR (v1>8 AND v2>40));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(NULL, 1], (NULL, ∞)}, {(1, 77], [4, 26]}, {(8, ∞), (40, ∞)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
@@ -1444,8 +1444,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThanOrEqual\n" +
 			" │           ├─ comp_index_t0.v2:2\n" +
 			" │           └─ 24 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t0)\n" +
-			"     ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t0)\n" +
+			"     ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			"     ├─ static: [{(NULL, 4), [NULL, ∞)}, {[4, 4], (NULL, ∞)}, {(4, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2]\n" +
 			"",
@@ -1467,152 +1467,152 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       │   ├─ comp_index_t0.v1:1\n" +
 			" │       │   └─ 28 (tinyint)\n" +
 			" │       └─ (comp_index_t0.v2:2 BETWEEN 30 (tinyint) AND 85 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t0)\n" +
-			"     ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t0)\n" +
+			"     ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			"     ├─ static: [{(NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1<=94) OR (v1<=87));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(NULL, 94], [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1<>56 AND v2<93) OR (v1<73 AND v2<=70));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(NULL, 56), (NULL, 93)}, {[56, 56], (NULL, 70]}, {(56, ∞), (NULL, 93)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((((v1>=85) OR (v1=91)) OR (v1<88 AND v2<42)) OR (v1<>42 AND v2<=10));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(NULL, 85), (NULL, 42)}, {[85, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1>42 AND v2<=13) OR (v1=7));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{[7, 7], [NULL, ∞)}, {(42, ∞), (NULL, 13]}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE (((v1=63) OR (v1 BETWEEN 55 AND 82 AND v2 BETWEEN 0 AND 6)) OR (v1=46));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{[46, 46], [NULL, ∞)}, {[55, 63), [0, 6]}, {[63, 63], [NULL, ∞)}, {(63, 82], [0, 6]}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1 BETWEEN 20 AND 77 AND v2>=49) OR (v1<13));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(NULL, 13), [NULL, ∞)}, {[20, 77], [49, ∞)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE (((v1>=72) OR (v1<49 AND v2<>36)) OR (v1>=10 AND v2<1));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(NULL, 49), (NULL, 36)}, {(NULL, 49), (36, ∞)}, {[49, 72), (NULL, 1)}, {[72, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE (((v1 BETWEEN 18 AND 87) OR (v1>=42 AND v2>44)) OR (v1<26 AND v2<=55)) AND (v1<=21);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(NULL, 18), (NULL, 55]}, {[18, 21], [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1>98 AND v2<75) OR (v1=47));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{[47, 47], [NULL, ∞)}, {(98, ∞), (NULL, 75)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1<=57 AND v2>=43) OR (v1<27 AND v2<>3));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(NULL, 27), (NULL, 3)}, {(NULL, 27), (3, ∞)}, {[27, 57], [43, ∞)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1 BETWEEN 16 AND 45 AND v2=22) OR (v1>=87 AND v2=48));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{[16, 45], [22, 22]}, {[87, ∞), [48, 48]}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1 BETWEEN 45 AND 74 AND v2<=74) OR (v1<>48 AND v2>58));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(NULL, 45), (58, ∞)}, {[45, 48), (NULL, ∞)}, {[48, 48], (NULL, 74]}, {(48, 74], (NULL, ∞)}, {(74, ∞), (58, ∞)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((((v1<32 AND v2>=79) OR (v1<=28)) OR (v1 BETWEEN 46 AND 72)) OR (v1>16));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1<10) OR (v1<89));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(NULL, 89), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1>=64 AND v2>=69) OR (v1>=2));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{[2, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1<=65) OR (v1<64));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(NULL, 65], [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1=46) OR (v1>9 AND v2>=22));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(9, 46), [22, ∞)}, {[46, 46], [NULL, ∞)}, {(46, ∞), [22, ∞)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1 BETWEEN 21 AND 33 AND v2>25) OR (v1<0));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
-			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t0)\n" +
+			" ├─ index: v_idx [comp_index_t0.v1,comp_index_t0.v2]\n" +
 			" ├─ static: [{(NULL, 0), [NULL, ∞)}, {[21, 33], (25, ∞)}]\n" +
 			" └─ columns: [pk v1 v2]\n" +
 			"",
@@ -1632,8 +1632,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThan\n" +
 			" │           ├─ comp_index_t1.v3:3\n" +
 			" │           └─ 4 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(NULL, 39), [8, 33], [NULL, ∞)}, {[39, 69], [NULL, ∞), [NULL, ∞)}, {(69, 87), [8, 33], [NULL, ∞)}, {(87, ∞), [8, 33], [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -1663,24 +1663,24 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ Eq\n" +
 			" │       ├─ comp_index_t1.v1:1\n" +
 			" │       └─ 15 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{[15, 15], [NULL, ∞), [NULL, ∞)}, {[55, ∞), [72, 80], [63, 63]}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1<93 AND v2<39 AND v3 BETWEEN 30 AND 97) OR (v1>54)) OR (v1<66));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1<>59 AND v2<=15) OR (v1 BETWEEN 2 AND 51)) OR (v1>15 AND v2 BETWEEN 31 AND 81));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 2), (NULL, 15], [NULL, ∞)}, {[2, 51], [NULL, ∞), [NULL, ∞)}, {(51, 59), (NULL, 15], [NULL, ∞)}, {(51, ∞), [31, 81], [NULL, ∞)}, {(59, ∞), (NULL, 15], [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -1706,8 +1706,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t1.v1:1\n" +
 			" │           └─ 49 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(NULL, 41], (40, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -1736,24 +1736,24 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThan\n" +
 			" │           ├─ comp_index_t1.v3:3\n" +
 			" │           └─ 48 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{[53, 75), [36, 53], (48, ∞)}, {[75, 85], [NULL, ∞), [NULL, ∞)}, {(85, ∞), [36, 53], (48, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1<6 AND v2<>44) OR (v1 BETWEEN 27 AND 96)) OR (v1>22 AND v2<>30 AND v3<49));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 6), (NULL, 44), [NULL, ∞)}, {(NULL, 6), (44, ∞), [NULL, ∞)}, {(22, 27), (NULL, 30), (NULL, 49)}, {(22, 27), (30, ∞), (NULL, 49)}, {[27, 96], [NULL, ∞), [NULL, ∞)}, {(96, ∞), (NULL, 30), (NULL, 49)}, {(96, ∞), (30, ∞), (NULL, 49)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1>29 AND v2=40) OR (v1<=74)) OR (v1<13 AND v2 BETWEEN 27 AND 82 AND v3<82));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 74], [NULL, ∞), [NULL, ∞)}, {(74, ∞), [40, 40], [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -1781,8 +1781,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │           └─ Eq\n" +
 			" │               ├─ comp_index_t1.v2:2\n" +
 			" │               └─ 10 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(NULL, 6), (NULL, 0), (NULL, 10)}, {(NULL, 6), [0, 97], [NULL, ∞)}, {(NULL, 6), (97, ∞), (NULL, 10)}, {[6, 6], (NULL, 10), (NULL, 10)}, {[6, 6], (10, ∞), (NULL, 10)}, {(6, 40), (NULL, 0), (NULL, 10)}, {(6, 40), (97, ∞), (NULL, 10)}, {(6, ∞), [0, 97], [NULL, ∞)}, {(40, ∞), (NULL, 0), (NULL, 10)}, {(40, ∞), (97, ∞), (NULL, 10)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -1810,8 +1810,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t1.v1:1\n" +
 			" │           └─ 28 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(NULL, 28), [NULL, ∞), [NULL, ∞)}, {(28, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -1846,8 +1846,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │           └─ Eq\n" +
 			" │               ├─ comp_index_t1.v2:2\n" +
 			" │               └─ 13 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(NULL, 41), [NULL, ∞), [NULL, ∞)}, {[41, 41], (NULL, 13), [14, 74]}, {[41, 41], (13, ∞), [14, 74]}, {(41, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -1883,8 +1883,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       │       ├─ comp_index_t1.v2:2\n" +
 			" │       │       └─ 32 (tinyint)\n" +
 			" │       └─ (comp_index_t1.v3:3 BETWEEN 3 (tinyint) AND 7 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(NULL, 1), (NULL, 32], [3, 7]}, {[1, 11], [NULL, ∞), [NULL, ∞)}, {(11, 34), [28, 84], (NULL, 93]}, {(11, 80), (NULL, 28), [3, 7]}, {[34, 52], [28, 73), (NULL, 93]}, {[34, 52], [73, 73], [NULL, ∞)}, {[34, 52], (73, 84], (NULL, 93]}, {(52, ∞), [28, 84], (NULL, 93]}, {(80, ∞), (NULL, 28), [3, 7]}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -1912,8 +1912,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThan\n" +
 			" │           ├─ comp_index_t1.v2:2\n" +
 			" │           └─ 59 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(NULL, 72), [NULL, ∞), [NULL, ∞)}, {[72, 72], (59, ∞), [NULL, ∞)}, {(72, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -1952,16 +1952,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThan\n" +
 			" │           ├─ comp_index_t1.v3:3\n" +
 			" │           └─ 54 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{[53, ∞), (69, ∞), (54, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1>9) OR (v1>14 AND v2>10));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(9, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -1990,8 +1990,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ GreaterThan\n" +
 			" │       ├─ comp_index_t1.v1:1\n" +
 			" │       └─ 97 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(NULL, 39], [17, 34], [NULL, ∞)}, {[89, 89], (58, ∞), (49, ∞)}, {(97, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -2020,32 +2020,32 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThan\n" +
 			" │           ├─ comp_index_t1.v2:2\n" +
 			" │           └─ 1 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(NULL, 7), (NULL, 43), [NULL, ∞)}, {(NULL, 7), (43, ∞), [NULL, ∞)}, {[7, ∞), (NULL, 1), (NULL, 0)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((((v1>30 AND v2 BETWEEN 23 AND 60 AND v3=58) OR (v1<=3 AND v2 BETWEEN 68 AND 72)) OR (v1<=17)) OR (v1>6 AND v2>=24)) AND (v1<89 AND v2=73);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 89), [73, 73], [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1>27) OR (v1>=22 AND v2>99 AND v3>=43));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{[22, 27], (99, ∞), [43, ∞)}, {(27, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1>25 AND v2 BETWEEN 1 AND 82) OR (v1>31 AND v2=86));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(25, ∞), [1, 82], [NULL, ∞)}, {(31, ∞), [86, 86], [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -2086,8 +2086,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThanOrEqual\n" +
 			" │           ├─ comp_index_t1.v3:3\n" +
 			" │           └─ 98 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(NULL, 12), (NULL, 60), [91, 91]}, {(12, 35], (NULL, 60), [91, 91]}, {(35, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -2110,16 +2110,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThan\n" +
 			" │           ├─ comp_index_t1.v2:2\n" +
 			" │           └─ 26 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{[62, ∞), (NULL, 96], (28, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1>30 AND v2=40 AND v3 BETWEEN 35 AND 35) OR (v1 BETWEEN 20 AND 77 AND v2>=56 AND v3>62));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{[20, 77], [56, ∞), (62, ∞)}, {(30, ∞), [40, 40], [35, 35]}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -2169,48 +2169,48 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThanOrEqual\n" +
 			" │           ├─ comp_index_t1.v3:3\n" +
 			" │           └─ 47 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(NULL, 6), [9, ∞), [0, 0]}, {[6, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1<=15 AND v2>28) OR (v1<=84 AND v2<>91));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 15], (NULL, ∞), [NULL, ∞)}, {(15, 84], (NULL, 91), [NULL, ∞)}, {(15, 84], (91, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1=49 AND v2<=52 AND v3 BETWEEN 23 AND 38) OR (v1 BETWEEN 30 AND 84 AND v2=94));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{[30, 84], [94, 94], [NULL, ∞)}, {[49, 49], (NULL, 52], [23, 38]}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1 BETWEEN 8 AND 18) OR (v1=27 AND v2<=4 AND v3<14));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{[8, 18], [NULL, ∞), [NULL, ∞)}, {[27, 27], (NULL, 4], (NULL, 14)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1>=4) OR (v1=0 AND v2<=63));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{[0, 0], (NULL, 63], [NULL, ∞)}, {[4, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (v1<=99 AND v2<>86) AND (v1>=21 AND v2>36);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{[21, 99], (86, ∞), [NULL, ∞)}, {[21, 99], (36, 86), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -2226,40 +2226,40 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ Eq\n" +
 			" │       ├─ comp_index_t1.v1:1\n" +
 			" │       └─ 14 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(NULL, 43), [NULL, ∞), [NULL, ∞)}, {(43, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (v1 BETWEEN 21 AND 44 AND v2 BETWEEN 18 AND 88 AND v3=42) AND (v1>=52 AND v2>37 AND v3 BETWEEN 26 AND 91);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1<>29 AND v2>93 AND v3<64) OR (v1<>54 AND v2>35));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 54), (35, ∞), [NULL, ∞)}, {[54, 54], (93, ∞), (NULL, 64)}, {(54, ∞), (35, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1<88) OR (v1<>45 AND v2<89)) AND (v1=98 AND v2<=81 AND v3 BETWEEN 34 AND 77);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{[98, 98], (NULL, 81], [34, 77]}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1>65 AND v2<>86 AND v3<=2) OR (v1<>37 AND v2<=96));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 37), (NULL, 96], [NULL, ∞)}, {(37, ∞), (NULL, 96], [NULL, ∞)}, {(65, ∞), (96, ∞), (NULL, 2]}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -2306,24 +2306,24 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThanOrEqual\n" +
 			" │           ├─ comp_index_t1.v3:3\n" +
 			" │           └─ 23 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(NULL, 12), (NULL, 17), (NULL, 23]}, {(NULL, 12), (17, ∞), (NULL, 23]}, {(NULL, 42), (NULL, 34), [25, ∞)}, {(12, ∞), (NULL, 17), (NULL, 23]}, {(12, ∞), (17, ∞), (NULL, 23]}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1<81 AND v2>=28) OR (v1=19 AND v2 BETWEEN 9 AND 57));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 19), [28, ∞), [NULL, ∞)}, {[19, 19], [9, ∞), [NULL, ∞)}, {(19, 81), [28, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1<32) OR (v1>=52)) OR (v1>=98));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 32), [NULL, ∞), [NULL, ∞)}, {[52, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -2339,16 +2339,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t1.v1:1\n" +
 			" │           └─ 25 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(NULL, 25), [NULL, ∞), [NULL, ∞)}, {(25, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (v1>27 AND v2<=80 AND v3 BETWEEN 11 AND 37) AND (v1=87 AND v2<54) AND (v1>29);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{[87, 87], (NULL, 54), [11, 37]}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -2380,24 +2380,24 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThanOrEqual\n" +
 			" │           ├─ comp_index_t1.v2:2\n" +
 			" │           └─ 36 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(NULL, 85], [NULL, ∞), [NULL, ∞)}, {(85, ∞), [52, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1>=12 AND v2>=65) OR (v1=11 AND v2<1));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{[11, 11], (NULL, 1), [NULL, ∞)}, {[12, ∞), [65, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1<=92 AND v2<=42) OR (v1>=58));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 58), (NULL, 42], [NULL, ∞)}, {[58, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -2421,8 +2421,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ GreaterThanOrEqual\n" +
 			" │       ├─ comp_index_t1.v1:1\n" +
 			" │       └─ 52 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(NULL, 0), [NULL, ∞), [NULL, ∞)}, {[0, 0], [70, ∞), [NULL, ∞)}, {(0, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -2443,24 +2443,24 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       │   ├─ (comp_index_t1.v1:1 BETWEEN 77 (tinyint) AND 85 (tinyint))\n" +
 			" │       │   └─ (comp_index_t1.v3:3 BETWEEN 16 (tinyint) AND 21 (tinyint))\n" +
 			" │       └─ (comp_index_t1.v2:2 BETWEEN 10 (tinyint) AND 42 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(5, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1<>43 AND v2<53 AND v3<=20) OR (v1<7 AND v2<>79));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 7), (NULL, 79), [NULL, ∞)}, {(NULL, 7), (79, ∞), [NULL, ∞)}, {[7, 43), (NULL, 53), (NULL, 20]}, {(43, ∞), (NULL, 53), (NULL, 20]}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (v1>=17 AND v2 BETWEEN 17 AND 78 AND v3=10) AND (v1<=67) AND (v1>=81 AND v2<=88 AND v3>=70);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -2515,8 +2515,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThanOrEqual\n" +
 			" │           ├─ comp_index_t1.v3:3\n" +
 			" │           └─ 45 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(NULL, 34), (NULL, 21], (NULL, 45]}, {[85, 85], (0, 81], (NULL, 23)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -2567,64 +2567,64 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t1.v2:2\n" +
 			" │           └─ 27 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(NULL, 75], [NULL, ∞), [NULL, ∞)}, {(75, ∞), (NULL, 10), [NULL, ∞)}, {(75, ∞), [27, 27], [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (v1<=76) AND (v1<=94);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 76], [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((((v1<>40 AND v2>1) OR (v1>3 AND v2<=42)) OR (v1=99 AND v2>62)) OR (v1<17 AND v2<>75 AND v3=6));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 3], (NULL, 1], [6, 6]}, {(NULL, 3], (1, ∞), [NULL, ∞)}, {(3, 40), (NULL, ∞), [NULL, ∞)}, {[40, 40], (NULL, 42], [NULL, ∞)}, {(40, ∞), (NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((((v1=39) OR (v1=40 AND v2<>49)) OR (v1<>35 AND v2>4 AND v3>26)) OR (v1=32 AND v2<>55));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 32), (4, ∞), (26, ∞)}, {[32, 32], (NULL, 55), [NULL, ∞)}, {[32, 32], [55, 55], (26, ∞)}, {[32, 32], (55, ∞), [NULL, ∞)}, {(32, 35), (4, ∞), (26, ∞)}, {(35, 39), (4, ∞), (26, ∞)}, {[39, 39], [NULL, ∞), [NULL, ∞)}, {(39, 40), (4, ∞), (26, ∞)}, {[40, 40], (NULL, 49), [NULL, ∞)}, {[40, 40], [49, 49], (26, ∞)}, {[40, 40], (49, ∞), [NULL, ∞)}, {(40, ∞), (4, ∞), (26, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1=16 AND v2<>25 AND v3<>3) OR (v1>=4 AND v2 BETWEEN 4 AND 93 AND v3>39));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{[4, 16), [4, 93], (39, ∞)}, {[16, 16], (NULL, 25), (NULL, 3)}, {[16, 16], (NULL, 25), (3, ∞)}, {[16, 16], [25, 25], (39, ∞)}, {[16, 16], (25, ∞), (NULL, 3)}, {[16, 16], (25, ∞), (3, ∞)}, {(16, ∞), [4, 93], (39, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((((v1>=51 AND v2<83) OR (v1>=15 AND v2>=3)) OR (v1<=49)) OR (v1<69));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 69), [NULL, ∞), [NULL, ∞)}, {[69, ∞), (NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (v1<>43 AND v2>10) AND (v1>30 AND v2 BETWEEN 18 AND 78 AND v3 BETWEEN 75 AND 81);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(30, 43), [18, 78], [75, 81]}, {(43, ∞), [18, 78], [75, 81]}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1>1) OR (v1<34 AND v2>=57 AND v3 BETWEEN 15 AND 67));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 1], [57, ∞), [15, 67]}, {(1, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -2651,24 +2651,24 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThanOrEqual\n" +
 			" │           ├─ comp_index_t1.v2:2\n" +
 			" │           └─ 5 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(NULL, 3], [5, ∞), [27, ∞)}, {(3, 26], [5, 32], [27, ∞)}, {(3, ∞), (32, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1>25 AND v2<>70 AND v3<=51) OR (v1<=71 AND v2>59));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 71], (59, ∞), [NULL, ∞)}, {(25, 71], (NULL, 59], (NULL, 51]}, {(71, ∞), (NULL, 70), (NULL, 51]}, {(71, ∞), (70, ∞), (NULL, 51]}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1 BETWEEN 0 AND 61 AND v2<0) OR (v1 BETWEEN 0 AND 38 AND v2>34)) OR (v1>=13 AND v2>=41));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{[0, 38], (34, ∞), [NULL, ∞)}, {[0, 61], (NULL, 0), [NULL, ∞)}, {(38, ∞), [41, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -2710,16 +2710,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ GreaterThanOrEqual\n" +
 			" │       ├─ comp_index_t1.v1:1\n" +
 			" │       └─ 44 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(NULL, 0], [28, 45], [24, 98]}, {(0, 44), (NULL, 28), [69, ∞)}, {(0, 44), [28, 45], [24, ∞)}, {(0, 44), (45, 47), [69, ∞)}, {(0, 44), (47, ∞), [69, ∞)}, {[44, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1<=48 AND v2 BETWEEN 33 AND 66) OR (v1>=91));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 48], [33, 66], [NULL, ∞)}, {[91, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -2757,64 +2757,64 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t1.v2:2\n" +
 			" │           └─ 5 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(NULL, 12], (NULL, 4), (53, ∞)}, {(NULL, 12], (4, 5), (53, ∞)}, {(NULL, 12], [5, 5], (NULL, ∞)}, {(NULL, 12], (5, ∞), (53, ∞)}, {(12, 17), [5, 5], (NULL, 94)}, {[17, 52], (NULL, 96), [NULL, ∞)}, {(52, 98), [5, 5], (NULL, 94)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1<>26 AND v2 BETWEEN 66 AND 79 AND v3<=94) OR (v1 BETWEEN 16 AND 55));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 16), [66, 79], (NULL, 94]}, {[16, 55], [NULL, ∞), [NULL, ∞)}, {(55, ∞), [66, 79], (NULL, 94]}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (v1 BETWEEN 36 AND 67 AND v3<74 AND v2=26) AND (v1 BETWEEN 9 AND 10 AND v2=96) AND (v1<=11 AND v2<>63 AND v3>=62);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1 BETWEEN 28 AND 49 AND v2<47) OR (v1>37 AND v2 BETWEEN 45 AND 61 AND v3<73));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{[28, 49], (NULL, 47), [NULL, ∞)}, {(37, 49], [47, 61], (NULL, 73)}, {(49, ∞), [45, 61], (NULL, 73)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1<37 AND v2>=26 AND v3<=14) OR (v1<64)) OR (v1 BETWEEN 31 AND 53 AND v2>55 AND v3<=55));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 64), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1>=77) OR (v1<50)) AND (v1<=53 AND v2>35 AND v3<>98);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 50), (35, ∞), (NULL, 98)}, {(NULL, 50), (35, ∞), (98, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((((v1=2 AND v2=40 AND v3 BETWEEN 18 AND 67) OR (v1=14 AND v2<=24 AND v3<=87)) OR (v1 BETWEEN 8 AND 31 AND v2>86)) OR (v1>30));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{[2, 2], [40, 40], [18, 67]}, {[8, 30], (86, ∞), [NULL, ∞)}, {[14, 14], (NULL, 24], (NULL, 87]}, {(30, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1>46 AND v2<>49 AND v3<=44) OR (v1 BETWEEN 64 AND 80 AND v2=41 AND v3<=68));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(46, 64), (NULL, 49), (NULL, 44]}, {(46, ∞), (49, ∞), (NULL, 44]}, {[64, 80], (NULL, 41), (NULL, 44]}, {[64, 80], [41, 41], (NULL, 68]}, {[64, 80], (41, 49), (NULL, 44]}, {(80, ∞), (NULL, 49), (NULL, 44]}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -2839,16 +2839,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThanOrEqual\n" +
 			" │           ├─ comp_index_t1.v2:2\n" +
 			" │           └─ 83 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{[11, 36], (NULL, 83], [NULL, ∞)}, {[95, 95], [97, ∞), (NULL, 47)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1>=65 AND v2=39 AND v3 BETWEEN 49 AND 67) OR (v1<57 AND v2>35));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 57), (35, ∞), [NULL, ∞)}, {[65, ∞), [39, 39], [49, 67]}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -2896,8 +2896,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │           └─ Eq\n" +
 			" │               ├─ comp_index_t1.v3:3\n" +
 			" │               └─ 80 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(NULL, 5), (NULL, 50), [34, 67]}, {(NULL, 16), (50, ∞), [34, 67]}, {[5, 16), (4, 50), [34, 67]}, {[5, 47], (NULL, 4), [34, 67]}, {[5, 47], [4, 4], [13, 76]}, {[16, 16], (4, 29), [34, 67]}, {[16, 16], [29, ∞), (NULL, 80)}, {[16, 16], [29, ∞), (80, ∞)}, {(16, 47], (4, 50), [34, 67]}, {(16, 85), (50, ∞), [34, 67]}, {(47, 71], (NULL, 50), [34, 67]}, {(71, 85), (NULL, 33), [34, 67]}, {(71, 85), (33, 50), [34, 67]}, {(71, ∞), [33, 33], [NULL, ∞)}, {(85, ∞), (NULL, 33), [34, 67]}, {(85, ∞), (33, 50), [34, 67]}, {(85, ∞), (50, ∞), [34, 67]}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -2921,16 +2921,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t1.v1:1\n" +
 			" │           └─ 38 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(NULL, 38), [NULL, ∞), [NULL, ∞)}, {(38, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1>=4 AND v2=26) OR (v1>21 AND v2 BETWEEN 14 AND 64));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{[4, 21], [26, 26], [NULL, ∞)}, {(21, ∞), [14, 64], [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -2954,80 +2954,80 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ Eq\n" +
 			" │       ├─ comp_index_t1.v1:1\n" +
 			" │       └─ 10 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(NULL, 50), [NULL, ∞), [NULL, ∞)}, {[50, 50], (NULL, 95], [NULL, ∞)}, {(50, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((((v1<=21 AND v2<>95) OR (v1<>23 AND v2 BETWEEN 15 AND 22)) OR (v1<=53 AND v2>=6)) OR (v1<=13 AND v2<>93 AND v3<15));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 21], (NULL, ∞), [NULL, ∞)}, {(21, 53], [6, ∞), [NULL, ∞)}, {(53, ∞), [15, 22], [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (v1<64 AND v2>=90 AND v3>41) AND (v1>=14 AND v2 BETWEEN 30 AND 70 AND v3>=25);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1<27 AND v2<=43) OR (v1<62 AND v2<=99)) OR (v1<>48 AND v2<29 AND v3<>69));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 62), (NULL, 99], [NULL, ∞)}, {[62, ∞), (NULL, 29), (NULL, 69)}, {[62, ∞), (NULL, 29), (69, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1<11 AND v2<70 AND v3>27) OR (v1>=80 AND v2<31 AND v3<65)) OR (v1>=98 AND v2 BETWEEN 30 AND 85 AND v3>=30));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 11), (NULL, 70), (27, ∞)}, {[80, 98), (NULL, 31), (NULL, 65)}, {[98, ∞), (NULL, 30), (NULL, 65)}, {[98, ∞), [30, 31), (NULL, ∞)}, {[98, ∞), [31, 85], [30, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (v1<>44 AND v2>=10) AND (v1=47 AND v2=14 AND v3<30);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{[47, 47], [14, 14], (NULL, 30)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1>6 AND v2=50) OR (v1>=16));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(6, 16), [50, 50], [NULL, ∞)}, {[16, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1>=31) OR (v1>53 AND v2<>11 AND v3<>94)) OR (v1>48 AND v2 BETWEEN 11 AND 29 AND v3 BETWEEN 68 AND 72));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{[31, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1 BETWEEN 55 AND 59) OR (v1<=10 AND v2>=24)) AND (v1>93 AND v3<70 AND v2 BETWEEN 44 AND 79) AND (v1>=22 AND v2=27);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1>=43 AND v2<28 AND v3<>24) OR (v1<36 AND v2=14 AND v3 BETWEEN 16 AND 55));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 36), [14, 14], [16, 55]}, {[43, ∞), (NULL, 28), (NULL, 24)}, {[43, ∞), (NULL, 28), (24, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -3052,24 +3052,24 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t1.v2:2\n" +
 			" │           └─ 98 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(48, ∞), (NULL, 80], [NULL, ∞)}, {[72, 72], [98, 98], [45, 52]}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (v1>=98 AND v2=51) AND (v1>34);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{[98, ∞), [51, 51], [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1>2) OR (v1<=30)) OR (v1<>35 AND v2 BETWEEN 6 AND 61 AND v3>=16));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -3086,40 +3086,40 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t1.v1:1\n" +
 			" │           └─ 48 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1 BETWEEN 12 AND 42 AND v2<=12) OR (v1<34 AND v2 BETWEEN 30 AND 47 AND v3<>50));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 34), [30, 47], (NULL, 50)}, {(NULL, 34), [30, 47], (50, ∞)}, {[12, 42], (NULL, 12], [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((((v1>=6) OR (v1>7)) OR (v1<88 AND v2<=34 AND v3<=47)) OR (v1>=10)) OR (v1=10));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 6), (NULL, 34], (NULL, 47]}, {[6, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1>=74) OR (v1>=1)) OR (v1=54 AND v2>=38 AND v3>2)) AND (v1>5);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(5, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1>=45 AND v2>18) OR (v1<64 AND v2=25 AND v3>97));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 45), [25, 25], (97, ∞)}, {[45, ∞), (18, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -3147,16 +3147,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t1.v2:2\n" +
 			" │           └─ 87 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(NULL, 37), [NULL, ∞), [NULL, ∞)}, {(38, ∞), [87, 87], (NULL, 57)}, {(38, ∞), [87, 87], (57, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((((v1<96 AND v2>11 AND v3<76) OR (v1<=14 AND v2=23)) OR (v1<=15 AND v2<21 AND v3<91)) OR (v1=45 AND v2<11 AND v3=1));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 14], [21, 23), (NULL, 76)}, {(NULL, 14], [23, 23], [NULL, ∞)}, {(NULL, 14], (23, ∞), (NULL, 76)}, {(NULL, 15], (NULL, 21), (NULL, 91)}, {(14, 15], [21, ∞), (NULL, 76)}, {(15, 96), (11, ∞), (NULL, 76)}, {[45, 45], (NULL, 11), [1, 1]}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -3181,32 +3181,32 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t1.v2:2\n" +
 			" │           └─ 25 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(NULL, 23), [NULL, ∞), [NULL, ∞)}, {[23, 23], [25, 25], [NULL, ∞)}, {(23, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (v1<=12 AND v2>=65) AND (v1<6 AND v2>=92);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 6), [92, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1=62 AND v2<>32) OR (v1>=55 AND v2=41 AND v3>73));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{[55, 62), [41, 41], (73, ∞)}, {[62, 62], (NULL, 32), [NULL, ∞)}, {[62, 62], (32, ∞), [NULL, ∞)}, {(62, ∞), [41, 41], (73, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1<>34 AND v2<=62) OR (v1>5 AND v2 BETWEEN 59 AND 98 AND v3<69)) OR (v1>34));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 34), (NULL, 62], [NULL, ∞)}, {(5, 34), (62, 98], (NULL, 69)}, {[34, 34], [59, 98], (NULL, 69)}, {(34, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -3237,32 +3237,32 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThan\n" +
 			" │           ├─ comp_index_t1.v2:2\n" +
 			" │           └─ 67 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(NULL, 61), (NULL, 67), [7, 63]}, {[61, 61], (NULL, 10), [7, 63]}, {[61, 61], [10, 22], (NULL, 63]}, {[61, 61], (22, 67), [7, 63]}, {(61, 68), (NULL, 67), [7, 63]}, {[68, 68], [NULL, ∞), [NULL, ∞)}, {(68, 97], (NULL, 67), [7, 63]}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1<=42) OR (v1 BETWEEN 13 AND 30 AND v2<50));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 42], [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1 BETWEEN 16 AND 49) OR (v1<=69 AND v2>9 AND v3<=8));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 16), (9, ∞), (NULL, 8]}, {[16, 49], [NULL, ∞), [NULL, ∞)}, {(49, 69], (9, ∞), (NULL, 8]}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1<>71 AND v2>44) OR (v1<76 AND v2>=10)) OR (v1>=44 AND v2=66));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 76), [10, ∞), [NULL, ∞)}, {[76, ∞), (44, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -3309,16 +3309,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t1.v1:1\n" +
 			" │           └─ 0 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(NULL, 0), [NULL, ∞), [NULL, ∞)}, {[0, 0], [0, 54], [NULL, ∞)}, {(0, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1>=99 AND v2<66) OR (v1 BETWEEN 1 AND 47)) OR (v1<>2 AND v2<30));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 1), (NULL, 30), [NULL, ∞)}, {[1, 47], [NULL, ∞), [NULL, ∞)}, {(47, 99), (NULL, 30), [NULL, ∞)}, {[99, ∞), (NULL, 66), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -3346,16 +3346,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ LessThan\n" +
 			" │       ├─ comp_index_t1.v1:1\n" +
 			" │       └─ 46 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(NULL, 46), [NULL, ∞), [NULL, ∞)}, {[46, 63], [18, 18], [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1<=20 AND v2<=62) OR (v1>45 AND v2=33 AND v3<=4)) OR (v1>29));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 20], (NULL, 62], [NULL, ∞)}, {(29, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -3398,8 +3398,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThanOrEqual\n" +
 			" │           ├─ comp_index_t1.v2:2\n" +
 			" │           └─ 73 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(NULL, 55], [82, 96], [13, ∞)}, {[7, 74], (NULL, 73], [NULL, ∞)}, {[89, 98), (NULL, 18), (NULL, 19)}, {[98, 98], [NULL, ∞), [NULL, ∞)}, {(98, ∞), (NULL, 18), (NULL, 19)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -3428,8 +3428,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ GreaterThan\n" +
 			" │       ├─ comp_index_t1.v1:1\n" +
 			" │       └─ 63 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{[47, 47], [6, 67), (NULL, 7)}, {(63, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -3445,8 +3445,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t1.v1:1\n" +
 			" │           └─ 33 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(NULL, 33), [NULL, ∞), [NULL, ∞)}, {(33, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -3494,32 +3494,32 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ AND\n" +
 			" │       ├─ (comp_index_t1.v1:1 BETWEEN 22 (tinyint) AND 23 (tinyint))\n" +
 			" │       └─ (comp_index_t1.v2:2 BETWEEN 14 (tinyint) AND 46 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(NULL, 94], [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1<9 AND v2=94 AND v3>8) OR (v1>=63));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 9), [94, 94], (8, ∞)}, {[63, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1<43) OR (v1 BETWEEN 40 AND 49 AND v2>26 AND v3 BETWEEN 22 AND 80));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 43), [NULL, ∞), [NULL, ∞)}, {[43, 49], (26, ∞), [22, 80]}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1 BETWEEN 4 AND 85 AND v2<>45 AND v3<=41) OR (v1>67 AND v2<25));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{[4, 67], (NULL, 45), (NULL, 41]}, {[4, 85], (45, ∞), (NULL, 41]}, {(67, 85], [25, 45), (NULL, 41]}, {(67, ∞), (NULL, 25), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -3555,16 +3555,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t1.v3:3\n" +
 			" │           └─ 6 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(NULL, 77), [NULL, ∞), [NULL, ∞)}, {[77, 77], (NULL, 30), [6, 6]}, {(77, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((((v1 BETWEEN 21 AND 53 AND v2=0 AND v3>32) OR (v1=93 AND v2>=94 AND v3<1)) OR (v1<26)) OR (v1<>11 AND v2<>32 AND v3=6)) AND (v1>=45);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{[45, 53], [0, 0], (32, ∞)}, {[45, ∞), (NULL, 32), [6, 6]}, {[45, ∞), (32, ∞), [6, 6]}, {[93, 93], [94, ∞), (NULL, 1)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -3580,16 +3580,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ LessThanOrEqual\n" +
 			" │       ├─ comp_index_t1.v1:1\n" +
 			" │       └─ 71 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1=41) OR (v1>29 AND v2<>31));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(29, 41), (NULL, 31), [NULL, ∞)}, {(29, 41), (31, ∞), [NULL, ∞)}, {[41, 41], [NULL, ∞), [NULL, ∞)}, {(41, ∞), (NULL, 31), [NULL, ∞)}, {(41, ∞), (31, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -3622,8 +3622,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ GreaterThanOrEqual\n" +
 			" │       ├─ comp_index_t1.v1:1\n" +
 			" │       └─ 40 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{[5, 40), [21, 29], (18, ∞)}, {[40, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -3648,8 +3648,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       │   ├─ comp_index_t1.v1:1\n" +
 			" │       │   └─ 12 (tinyint)\n" +
 			" │       └─ (comp_index_t1.v3:3 BETWEEN 25 (tinyint) AND 30 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(NULL, 12], [NULL, ∞), [NULL, ∞)}, {(12, ∞), (NULL, 76), (NULL, 35]}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -3691,40 +3691,40 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t1.v1:1\n" +
 			" │           └─ 48 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(NULL, 48), [NULL, ∞), [NULL, ∞)}, {[48, 48], (NULL, 94], [NULL, ∞)}, {(48, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1>=24) OR (v1>=47 AND v2<=75 AND v3<=52));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{[24, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1>=21 AND v2<>70) OR (v1<=77 AND v2>4)) OR (v1<28 AND v2<=3 AND v3<>21));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 21), (NULL, 3], (NULL, 21)}, {(NULL, 21), (NULL, 3], (21, ∞)}, {(NULL, 21), (4, ∞), [NULL, ∞)}, {[21, 77], (NULL, ∞), [NULL, ∞)}, {(77, ∞), (NULL, 70), [NULL, ∞)}, {(77, ∞), (70, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1>=60 AND v2>91) OR (v1<=10));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 10], [NULL, ∞), [NULL, ∞)}, {[60, ∞), (91, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1<>98 AND v2<52) OR (v1 BETWEEN 65 AND 67)) OR (v1 BETWEEN 18 AND 54)) AND (v1>=14 AND v2=27);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{[14, 98), [27, 27], [NULL, ∞)}, {(98, ∞), [27, 27], [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -3759,32 +3759,32 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       │       ├─ comp_index_t1.v1:1\n" +
 			" │       │       └─ 68 (tinyint)\n" +
 			" │       └─ (comp_index_t1.v2:2 BETWEEN 42 (tinyint) AND 46 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(NULL, 68), [42, 46], [NULL, ∞)}, {(68, ∞), [42, 46], [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (v1>=19 AND v2<2) AND (v1<4 AND v3>23 AND v2<>53);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1 BETWEEN 34 AND 40) OR (v1<=80 AND v2<>53)) AND (v1=81 AND v2=17 AND v3<>12);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1>34 AND v2 BETWEEN 18 AND 67 AND v3<67) OR (v1>21));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(21, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -3822,64 +3822,64 @@ var IndexPlanTests = []QueryPlanTest{
 			" │           └─ Eq\n" +
 			" │               ├─ comp_index_t1.v2:2\n" +
 			" │               └─ 45 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(NULL, 45), [NULL, ∞), [NULL, ∞)}, {[45, 45], (NULL, 45), (NULL, 32]}, {[45, 45], (45, ∞), (NULL, 32]}, {(45, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1<=48) OR (v1<38 AND v2>=26)) AND (v1<=45 AND v2>21) AND (v1=83 AND v2=20);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1>25) OR (v1<53));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1<95 AND v2>=12) OR (v1 BETWEEN 41 AND 55 AND v2<=81 AND v3<46));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 95), [12, ∞), [NULL, ∞)}, {[41, 55], (NULL, 12), (NULL, 46)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1>39 AND v2 BETWEEN 53 AND 73 AND v3<=11) OR (v1<=31 AND v2=68 AND v3>=71)) OR (v1<>18 AND v2<=51));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 18), (NULL, 51], [NULL, ∞)}, {(NULL, 31], [68, 68], [71, ∞)}, {(18, ∞), (NULL, 51], [NULL, ∞)}, {(39, ∞), [53, 73], (NULL, 11]}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (v1>4) AND (v1=3 AND v2 BETWEEN 4 AND 34 AND v3<=40);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1>36 AND v2>82) OR (v1 BETWEEN 22 AND 59));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{[22, 59], [NULL, ∞), [NULL, ∞)}, {(59, ∞), (82, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1<=0) OR (v1 BETWEEN 17 AND 45));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 0], [NULL, ∞), [NULL, ∞)}, {[17, 45], [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -3901,16 +3901,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │           └─ Eq\n" +
 			" │               ├─ comp_index_t1.v2:2\n" +
 			" │               └─ 70 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(NULL, 1), [NULL, ∞), [NULL, ∞)}, {[2, 57], (NULL, 70), [NULL, ∞)}, {[2, 57], (70, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (v1>4) AND (v1 BETWEEN 8 AND 35 AND v2>=94 AND v3=32) AND (v1>=12);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{[12, 35], [94, ∞), [32, 32]}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -3946,48 +3946,48 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThan\n" +
 			" │           ├─ comp_index_t1.v2:2\n" +
 			" │           └─ 33 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1>12) OR (v1>=26 AND v2 BETWEEN 77 AND 87 AND v3<19)) OR (v1<=89));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1=27 AND v2=16 AND v3>=8) OR (v1<20 AND v2>=1 AND v3 BETWEEN 28 AND 47)) OR (v1 BETWEEN 15 AND 43 AND v2>30));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 15), [1, ∞), [28, 47]}, {[15, 20), [1, 30], [28, 47]}, {[15, 43], (30, ∞), [NULL, ∞)}, {[27, 27], [16, 16], [8, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1=67 AND v2<>69) OR (v1<28 AND v2<62 AND v3>=99));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 28), (NULL, 62), [99, ∞)}, {[67, 67], (NULL, 69), [NULL, ∞)}, {[67, 67], (69, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1<45 AND v2>5 AND v3>20) OR (v1<17));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 17), [NULL, ∞), [NULL, ∞)}, {[17, 45), (5, ∞), (20, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1=40 AND v2<>18) OR (v1<>97 AND v2<>17 AND v3<>48));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 40), (NULL, 17), (NULL, 48)}, {(NULL, 40), (NULL, 17), (48, ∞)}, {(NULL, 40), (17, ∞), (NULL, 48)}, {(NULL, 40), (17, ∞), (48, ∞)}, {[40, 40], (NULL, 18), [NULL, ∞)}, {[40, 40], [18, 18], (NULL, 48)}, {[40, 40], [18, 18], (48, ∞)}, {[40, 40], (18, ∞), [NULL, ∞)}, {(40, 97), (NULL, 17), (NULL, 48)}, {(40, 97), (NULL, 17), (48, ∞)}, {(40, 97), (17, ∞), (NULL, 48)}, {(40, 97), (17, ∞), (48, ∞)}, {(97, ∞), (NULL, 17), (NULL, 48)}, {(97, ∞), (NULL, 17), (48, ∞)}, {(97, ∞), (17, ∞), (NULL, 48)}, {(97, ∞), (17, ∞), (48, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -4019,24 +4019,24 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThan\n" +
 			" │           ├─ comp_index_t1.v2:2\n" +
 			" │           └─ 45 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{[38, 38], (45, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1<=6) OR (v1>0 AND v2 BETWEEN 3 AND 50));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 6], [NULL, ∞), [NULL, ∞)}, {(6, ∞), [3, 50], [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1 BETWEEN 5 AND 35 AND v2<=3 AND v3<>14) OR (v1>11));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{[5, 11], (NULL, 3], (NULL, 14)}, {[5, 11], (NULL, 3], (14, ∞)}, {(11, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -4068,8 +4068,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │           └─ Eq\n" +
 			" │               ├─ comp_index_t1.v3:3\n" +
 			" │               └─ 65 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(NULL, 19), [10, ∞), [NULL, ∞)}, {[19, 36), (10, ∞), (NULL, 65)}, {[19, 36), (10, ∞), (65, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -4105,16 +4105,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ GreaterThan\n" +
 			" │       ├─ comp_index_t1.v1:1\n" +
 			" │       └─ 31 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{[10, 21), [62, ∞), [NULL, ∞)}, {[21, 31], (NULL, ∞), [NULL, ∞)}, {(31, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1<20 AND v2>=1 AND v3=26) OR (v1=12));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 12), [1, ∞), [26, 26]}, {[12, 12], [NULL, ∞), [NULL, ∞)}, {(12, 20), [1, ∞), [26, 26]}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -4147,48 +4147,48 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThan\n" +
 			" │           ├─ comp_index_t1.v3:3\n" +
 			" │           └─ 62 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(NULL, 4), (NULL, 47), [77, ∞)}, {(4, 41], (NULL, 47), [77, ∞)}, {(41, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1<35) OR (v1>=58 AND v2>=0));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 35), [NULL, ∞), [NULL, ∞)}, {[58, ∞), [0, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1>28 AND v2<95) OR (v1<91));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 91), [NULL, ∞), [NULL, ∞)}, {[91, ∞), (NULL, 95), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (v1=99 AND v2<=41 AND v3>=61) AND (v1=34 AND v2>68 AND v3<=42);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1>=74 AND v2<=18) OR (v1>=72)) AND (v1=95 AND v2=31 AND v3 BETWEEN 5 AND 19);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{[95, 95], [31, 31], [5, 19]}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1=64) OR (v1>=49 AND v2<9 AND v3<=49));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{[49, 64), (NULL, 9), (NULL, 49]}, {[64, 64], [NULL, ∞), [NULL, ∞)}, {(64, ∞), (NULL, 9), (NULL, 49]}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -4219,24 +4219,24 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       │   ├─ comp_index_t1.v1:1\n" +
 			" │       │   └─ 55 (tinyint)\n" +
 			" │       └─ (comp_index_t1.v2:2 BETWEEN 11 (tinyint) AND 84 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{[55, ∞), [11, 84], [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1<=7) OR (v1<54));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 54), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1<=95 AND v2=55 AND v3>34) OR (v1=19));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 19), [55, 55], (34, ∞)}, {[19, 19], [NULL, ∞), [NULL, ∞)}, {(19, 95], [55, 55], (34, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -4275,16 +4275,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t1.v3:3\n" +
 			" │           └─ 12 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(NULL, 50), [NULL, ∞), [NULL, ∞)}, {[50, 50], (56, ∞), [NULL, ∞)}, {(50, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((((v1<=90 AND v2<=17) OR (v1=2)) OR (v1<>70 AND v2>=84 AND v3<>42)) OR (v1<11 AND v2<>47 AND v3<55));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 2), (NULL, 17], [NULL, ∞)}, {(NULL, 2), (17, 47), (NULL, 55)}, {(NULL, 2), (47, 84), (NULL, 55)}, {(NULL, 2), [84, ∞), (NULL, ∞)}, {[2, 2], [NULL, ∞), [NULL, ∞)}, {(2, 11), (17, 47), (NULL, 55)}, {(2, 11), (47, 84), (NULL, 55)}, {(2, 11), [84, ∞), (NULL, ∞)}, {(2, 90], (NULL, 17], [NULL, ∞)}, {[11, 70), [84, ∞), (NULL, 42)}, {[11, 70), [84, ∞), (42, ∞)}, {(70, ∞), [84, ∞), (NULL, 42)}, {(70, ∞), [84, ∞), (42, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -4306,16 +4306,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │           └─ Eq\n" +
 			" │               ├─ comp_index_t1.v2:2\n" +
 			" │               └─ 46 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{[23, 50), (NULL, 46), [87, ∞)}, {[23, 50), (46, ∞), [87, ∞)}, {[50, 59], [NULL, ∞), [NULL, ∞)}, {(59, ∞), (NULL, 46), [87, ∞)}, {(59, ∞), (46, ∞), [87, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1<53) OR (v1<=3));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 53), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -4349,8 +4349,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThanOrEqual\n" +
 			" │           ├─ comp_index_t1.v2:2\n" +
 			" │           └─ 7 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{[16, 17), [66, 94], [NULL, ∞)}, {[17, 17], [7, ∞), [NULL, ∞)}, {(17, 91), [66, 94], [NULL, ∞)}, {(70, 91), (NULL, 3], [NULL, ∞)}, {(91, ∞), (NULL, 3], [NULL, ∞)}, {(91, ∞), [66, 94], [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -4373,8 +4373,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ LessThan\n" +
 			" │       ├─ comp_index_t1.v1:1\n" +
 			" │       └─ 59 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(NULL, 59), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -4401,8 +4401,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t1.v3:3\n" +
 			" │           └─ 41 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(19, 42), (84, ∞), (94, ∞)}, {[42, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -4430,8 +4430,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ LessThanOrEqual\n" +
 			" │       ├─ comp_index_t1.v1:1\n" +
 			" │       └─ 41 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -4452,32 +4452,32 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t1.v1:1\n" +
 			" │           └─ 68 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1<>15 AND v2>=22 AND v3<=51) OR (v1<>40 AND v2>26 AND v3<95));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 15), [22, 26], (NULL, 51]}, {(NULL, 40), (26, ∞), (NULL, 95)}, {(15, 40), [22, 26], (NULL, 51]}, {[40, 40], [22, ∞), (NULL, 51]}, {(40, ∞), [22, 26], (NULL, 51]}, {(40, ∞), (26, ∞), (NULL, 95)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1>6) OR (v1<=67 AND v2<>67 AND v3>=88));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 6], (NULL, 67), [88, ∞)}, {(NULL, 6], (67, ∞), [88, ∞)}, {(6, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1<=0) OR (v1<=53)) OR (v1<=38));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 53], [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -4502,24 +4502,24 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThan\n" +
 			" │           ├─ comp_index_t1.v2:2\n" +
 			" │           └─ 26 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{[1, 49], [NULL, ∞), [NULL, ∞)}, {[60, 60], [10, 69], [2, 13]}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1 BETWEEN 14 AND 20 AND v2<>70) OR (v1>78 AND v2 BETWEEN 31 AND 52 AND v3>16)) OR (v1 BETWEEN 77 AND 78));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{[14, 20], (NULL, 70), [NULL, ∞)}, {[14, 20], (70, ∞), [NULL, ∞)}, {[77, 78], [NULL, ∞), [NULL, ∞)}, {(78, ∞), [31, 52], (16, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1<80 AND v2 BETWEEN 41 AND 74) OR (v1>=36 AND v2=32));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 80), [41, 74], [NULL, ∞)}, {[36, ∞), [32, 32], [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -4551,8 +4551,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThanOrEqual\n" +
 			" │           ├─ comp_index_t1.v3:3\n" +
 			" │           └─ 90 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(NULL, 15), [12, 25], [51, 51]}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -4584,8 +4584,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t1.v2:2\n" +
 			" │           └─ 52 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(NULL, 90), [NULL, ∞), [NULL, ∞)}, {(90, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -4612,48 +4612,48 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ GreaterThan\n" +
 			" │       ├─ comp_index_t1.v1:1\n" +
 			" │       └─ 84 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{[6, 74], [52, 52], [NULL, ∞)}, {(44, 74], [17, 52), [15, ∞)}, {(44, 74], (52, 94], [15, ∞)}, {(74, 84], [17, 94], [15, ∞)}, {(84, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1>=38) OR (v1=13)) OR (v1=25 AND v2<=32 AND v3 BETWEEN 12 AND 92));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{[13, 13], [NULL, ∞), [NULL, ∞)}, {[25, 25], (NULL, 32], [12, 92]}, {[38, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1<=84) OR (v1=41)) OR (v1<83 AND v2=13 AND v3=58));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 84], [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1<36 AND v2<=79 AND v3>47) OR (v1 BETWEEN 24 AND 89 AND v2<29));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 24), (NULL, 79], (47, ∞)}, {[24, 36), [29, 79], (47, ∞)}, {[24, 89], (NULL, 29), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1 BETWEEN 3 AND 19 AND v2<=57 AND v3>61) OR (v1<=58 AND v2>=36 AND v3=31)) AND (v1>94);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1<78 AND v2 BETWEEN 55 AND 64 AND v3>=0) OR (v1<74));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 74), [NULL, ∞), [NULL, ∞)}, {[74, 78), [55, 64], [0, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -4690,24 +4690,24 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThanOrEqual\n" +
 			" │           ├─ comp_index_t1.v2:2\n" +
 			" │           └─ 9 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(NULL, 38], [NULL, ∞), [NULL, ∞)}, {(38, 74], [88, 88], (NULL, 33)}, {(74, ∞), [9, ∞), (NULL, 55)}, {(74, ∞), [9, ∞), (55, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1 BETWEEN 15 AND 96 AND v2<>73) OR (v1>=16));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{[15, 16), (NULL, 73), [NULL, ∞)}, {[15, 16), (73, ∞), [NULL, ∞)}, {[16, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1>=72 AND v2<>19 AND v3 BETWEEN 9 AND 12) OR (v1<=77 AND v2=30 AND v3<=10));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 72), [30, 30], (NULL, 10]}, {[72, 77], (19, 30), [9, 12]}, {[72, 77], [30, 30], (NULL, 12]}, {[72, 77], (30, ∞), [9, 12]}, {[72, ∞), (NULL, 19), [9, 12]}, {(77, ∞), (19, ∞), [9, 12]}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -4750,8 +4750,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThanOrEqual\n" +
 			" │           ├─ comp_index_t1.v3:3\n" +
 			" │           └─ 43 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(NULL, 36), [NULL, ∞), [NULL, ∞)}, {[47, 47], [0, 92], (NULL, 43]}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -4774,16 +4774,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ GreaterThan\n" +
 			" │       ├─ comp_index_t1.v1:1\n" +
 			" │       └─ 78 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(68, 78], [1, 79], [23, 44]}, {(78, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1=47 AND v2=7) OR (v1>=7 AND v2<>87)) OR (v1<>6 AND v2<=84));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 6), (NULL, 84], [NULL, ∞)}, {(6, 7), (NULL, 84], [NULL, ∞)}, {[7, ∞), (NULL, 87), [NULL, ∞)}, {[7, ∞), (87, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -4836,24 +4836,24 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThanOrEqual\n" +
 			" │           ├─ comp_index_t1.v2:2\n" +
 			" │           └─ 66 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(30, ∞), [66, ∞), [NULL, ∞)}, {[49, ∞), (53, 66), (NULL, 12)}, {[49, ∞), (53, 66), (12, ∞)}, {[62, 62], (NULL, 22], [37, ∞)}, {[95, 95], (NULL, 1), (NULL, 89)}, {[95, 95], (NULL, 1), (89, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1=24 AND v2<81) OR (v1<=22 AND v2>34 AND v3<55)) OR (v1=45 AND v2>=94 AND v3>17));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 22], (34, ∞), (NULL, 55)}, {[24, 24], (NULL, 81), [NULL, ∞)}, {[45, 45], [94, ∞), (17, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((((v1>38) OR (v1<51 AND v2>=28 AND v3=44)) OR (v1 BETWEEN 23 AND 61 AND v2 BETWEEN 54 AND 75 AND v3<>44)) OR (v1>72));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 38], [28, ∞), [44, 44]}, {[23, 38], [54, 75], (NULL, 44)}, {[23, 38], [54, 75], (44, ∞)}, {(38, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -4895,8 +4895,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThanOrEqual\n" +
 			" │           ├─ comp_index_t1.v3:3\n" +
 			" │           └─ 74 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(NULL, 57), [NULL, ∞), [NULL, ∞)}, {[57, 57], [26, 30], [NULL, ∞)}, {(57, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -4929,16 +4929,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       │       ├─ comp_index_t1.v1:1\n" +
 			" │       │       └─ 46 (tinyint)\n" +
 			" │       └─ (comp_index_t1.v2:2 BETWEEN 4 (tinyint) AND 26 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(NULL, 11), [4, 26], [NULL, ∞)}, {[11, 47), [NULL, ∞), [NULL, ∞)}, {[47, 47], [4, 26], [NULL, ∞)}, {(47, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1 BETWEEN 41 AND 98 AND v2>54) OR (v1<29)) OR (v1<32));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(NULL, 32), [NULL, ∞), [NULL, ∞)}, {[41, 98], (54, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -4960,8 +4960,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │           └─ Eq\n" +
 			" │               ├─ comp_index_t1.v3:3\n" +
 			" │               └─ 94 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{[50, 50], [16, 38], (NULL, 94)}, {[50, 50], [16, 38], (94, ∞)}, {[79, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -4997,24 +4997,24 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThan\n" +
 			" │           ├─ comp_index_t1.v2:2\n" +
 			" │           └─ 4 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(NULL, 79), [NULL, ∞), [NULL, ∞)}, {[79, 79], (NULL, 4), [NULL, ∞)}, {(79, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1>=2 AND v2 BETWEEN 32 AND 59 AND v3 BETWEEN 50 AND 52) OR (v1<26)) OR (v1<>2 AND v2>11)) AND (v1>32 AND v2<=92) AND (v1>45 AND v2<>5 AND v3<>49);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(45, ∞), (11, 92], (NULL, 49)}, {(45, ∞), (11, 92], (49, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1>=19) AND (v1<=73) OR (v1=9 AND v2=5 AND v3<=5));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{[9, 9], [5, 5], (NULL, 5]}, {[19, 73], [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -5052,56 +5052,56 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ GreaterThanOrEqual\n" +
 			" │       ├─ comp_index_t1.v1:1\n" +
 			" │       └─ 94 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t1)\n" +
+			"     ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			"     ├─ static: [{(NULL, 28), (51, ∞), [29, 30]}, {[28, 57], (62, ∞), [29, 30]}, {[28, 94), (NULL, 62], (NULL, 76)}, {[28, 94), (NULL, 62], (76, ∞)}, {[94, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1>21) OR (v1>=86 AND v2>2 AND v3>=67));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{(21, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1>=94) OR (v1>=57 AND v2<>53 AND v3>22));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
-			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t1)\n" +
+			" ├─ index: v_idx [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
 			" ├─ static: [{[57, 94), (NULL, 53), (22, ∞)}, {[57, 94), (53, ∞), (22, ∞)}, {[94, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<40 AND v2=9) OR (v1<11 AND v2=15 AND v3<>55 AND v4<>95));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 11), [15, 15], (NULL, 55), (NULL, 95)}, {(NULL, 11), [15, 15], (NULL, 55), (95, ∞)}, {(NULL, 11), [15, 15], (55, ∞), (NULL, 95)}, {(NULL, 11), [15, 15], (55, ∞), (95, ∞)}, {(NULL, 40), [9, 9], [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1<=82 AND v2=74 AND v3=98) OR (v1=27 AND v2 BETWEEN 16 AND 46 AND v3<>27)) OR (v1>=80 AND v2<>42 AND v3>=47));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 80), [74, 74], [98, 98], [NULL, ∞)}, {[27, 27], [16, 46], (NULL, 27), [NULL, ∞)}, {[27, 27], [16, 46], (27, ∞), [NULL, ∞)}, {[80, ∞), (NULL, 42), [47, ∞), [NULL, ∞)}, {[80, ∞), (42, ∞), [47, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1>=47 AND v2<=37 AND v3<90 AND v4=25) OR (v1<42 AND v2>=96 AND v3=38)) OR (v1>26)) OR (v1>=80));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 26], [96, ∞), [38, 38], [NULL, ∞)}, {(26, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>33 AND v2>=16) OR (v1>=24));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{[24, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -5140,8 +5140,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThanOrEqual\n" +
 			" │           ├─ comp_index_t2.v3:3\n" +
 			" │           └─ 94 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{[5, 60], (NULL, 1), [NULL, ∞), [NULL, ∞)}, {[51, 51], (62, ∞), (NULL, 43), [36, 55]}, {[51, 51], [98, ∞), [94, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -5178,24 +5178,24 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ LessThanOrEqual\n" +
 			" │       ├─ comp_index_t2.v1:1\n" +
 			" │       └─ 98 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 98], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(98, ∞), [6, 11], (NULL, 4], (44, 95)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1>=12 AND v2<=78 AND v3 BETWEEN 28 AND 63 AND v4 BETWEEN 46 AND 95) OR (v1=87 AND v2<=44)) OR (v1<14 AND v2<>37 AND v3 BETWEEN 6 AND 32));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 14), (NULL, 37), [6, 32], [NULL, ∞)}, {(NULL, 14), (37, ∞), [6, 32], [NULL, ∞)}, {[12, 14), (NULL, 37), (32, 63], [46, 95]}, {[12, 14), [37, 37], [28, 63], [46, 95]}, {[12, 14), (37, 78], (32, 63], [46, 95]}, {[14, 87), (NULL, 78], [28, 63], [46, 95]}, {[87, 87], (NULL, 44], [NULL, ∞), [NULL, ∞)}, {[87, 87], (44, 78], [28, 63], [46, 95]}, {(87, ∞), (NULL, 78], [28, 63], [46, 95]}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1<=80 AND v2=72 AND v3>19) OR (v1<>38 AND v2>=86 AND v3=7)) OR (v1<=52 AND v2=25 AND v3 BETWEEN 7 AND 32 AND v4<=31));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 38), [86, ∞), [7, 7], [NULL, ∞)}, {(NULL, 52], [25, 25], [7, 32], (NULL, 31]}, {(NULL, 80], [72, 72], (19, ∞), [NULL, ∞)}, {(38, ∞), [86, ∞), [7, 7], [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -5212,8 +5212,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       │   ├─ comp_index_t2.v1:1\n" +
 			" │       │   └─ 38 (tinyint)\n" +
 			" │       └─ (comp_index_t2.v3:3 BETWEEN 25 (tinyint) AND 30 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{[38, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -5237,24 +5237,24 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t2.v2:2\n" +
 			" │           └─ 38 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 33], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1>14 AND v2<51 AND v3 BETWEEN 67 AND 78 AND v4=8) OR (v1>=44 AND v2<>35 AND v3<35 AND v4>=12)) OR (v1>=63 AND v2<=3));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(14, 63), (NULL, 51), [67, 78], [8, 8]}, {[44, 63), (NULL, 35), (NULL, 35), [12, ∞)}, {[44, ∞), (35, ∞), (NULL, 35), [12, ∞)}, {[63, ∞), (NULL, 3], [NULL, ∞), [NULL, ∞)}, {[63, ∞), (3, 35), (NULL, 35), [12, ∞)}, {[63, ∞), (3, 51), [67, 78], [8, 8]}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1=6 AND v2<=25 AND v3>39) OR (v1 BETWEEN 17 AND 94 AND v2>96));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{[6, 6], (NULL, 25], (39, ∞), [NULL, ∞)}, {[17, 94], (96, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -5303,24 +5303,24 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ LessThanOrEqual\n" +
 			" │       ├─ comp_index_t2.v1:1\n" +
 			" │       └─ 44 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 44], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[72, 75), [48, 48], (NULL, 10], [NULL, ∞)}, {[75, 75], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(75, 88], [48, 48], (NULL, 10], [NULL, ∞)}, {[91, ∞), [43, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1=31) OR (v1<84 AND v2<=73 AND v3<>2 AND v4<=51));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 31), (NULL, 73], (NULL, 2), (NULL, 51]}, {(NULL, 31), (NULL, 73], (2, ∞), (NULL, 51]}, {[31, 31], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(31, 84), (NULL, 73], (NULL, 2), (NULL, 51]}, {(31, 84), (NULL, 73], (2, ∞), (NULL, 51]}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<=20 AND v2<=29 AND v3<52 AND v4<>34) OR (v1<>46 AND v2<>98));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 46), (NULL, 98), [NULL, ∞), [NULL, ∞)}, {(NULL, 46), (98, ∞), [NULL, ∞), [NULL, ∞)}, {(46, ∞), (NULL, 98), [NULL, ∞), [NULL, ∞)}, {(46, ∞), (98, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -5364,42 +5364,41 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThanOrEqual\n" +
 			" │           ├─ comp_index_t2.v3:3\n" +
 			" │           └─ 10 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 44], (NULL, 98), [NULL, ∞), [NULL, ∞)}, {(NULL, 44], [98, 99], [39, 57], [13, 13]}, {(44, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1=40) OR (v1=27)) OR (v1>90 AND v2>50 AND v3=66 AND v4<83));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{[27, 27], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[40, 40], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(90, ∞), (50, ∞), [66, 66], (NULL, 83)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (v1<=92 AND v4 BETWEEN 8 AND 90) AND (v1 BETWEEN 39 AND 42);`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ (comp_index_t2.v4:4 BETWEEN 8 (tinyint) AND 90 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[39, 42], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[39, 42], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ index condition: (comp_index_t2.v4:3 BETWEEN 8 (tinyint) AND 90 (tinyint))\n" +
+			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1 BETWEEN 23 AND 85 AND v2<=51 AND v3<>68) OR (v1 BETWEEN 30 AND 58 AND v2<>75));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{[23, 30), (NULL, 51], (NULL, 68), [NULL, ∞)}, {[23, 30), (NULL, 51], (68, ∞), [NULL, ∞)}, {[30, 58], (NULL, 75), [NULL, ∞), [NULL, ∞)}, {[30, 58], (75, ∞), [NULL, ∞), [NULL, ∞)}, {(58, 85], (NULL, 51], (NULL, 68), [NULL, ∞)}, {(58, 85], (NULL, 51], (68, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>=67 AND v2<=17 AND v3<>91 AND v4<82) OR (v1>28 AND v2 BETWEEN 17 AND 71 AND v3<12));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(28, ∞), [17, 71], (NULL, 12), [NULL, ∞)}, {[67, ∞), (NULL, 17), (NULL, 91), (NULL, 82)}, {[67, ∞), (NULL, 17], (91, ∞), (NULL, 82)}, {[67, ∞), [17, 17], [12, 91), (NULL, 82)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -5429,8 +5428,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThan\n" +
 			" │           ├─ comp_index_t2.v3:3\n" +
 			" │           └─ 60 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{[41, 77], (21, ∞), (60, ∞), [NULL, ∞)}, {[41, 80], (NULL, 21), (60, ∞), [NULL, ∞)}, {(77, 80], (21, 96), (60, ∞), [NULL, ∞)}, {(77, ∞), [96, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -5439,8 +5438,8 @@ var IndexPlanTests = []QueryPlanTest{
 		Query: `SELECT * FROM comp_index_t2 WHERE (v1=28 AND v4 BETWEEN 44 AND 50) AND (v1>=49);`,
 		ExpectedPlan: "Filter\n" +
 			" ├─ (comp_index_t2.v4:4 BETWEEN 44 (tinyint) AND 50 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -5483,8 +5482,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThan\n" +
 			" │           ├─ comp_index_t2.v2:2\n" +
 			" │           └─ 62 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 27), (NULL, 8), (35, ∞), [NULL, ∞)}, {(NULL, 27), (8, ∞), (35, ∞), [NULL, ∞)}, {(28, ∞), (NULL, 62), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -5522,8 +5521,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThanOrEqual\n" +
 			" │           ├─ comp_index_t2.v4:4\n" +
 			" │           └─ 73 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(65, 82), [64, 64], [NULL, ∞), [NULL, ∞)}, {[68, 82), [3, 3], [1, 51], (NULL, 73]}, {[82, 82], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(82, ∞), [3, 3], [1, 51], (NULL, 73]}, {(82, ∞), [64, 64], [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -5547,8 +5546,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │           └─ Eq\n" +
 			" │               ├─ comp_index_t2.v2:2\n" +
 			" │               └─ 43 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 27], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(27, 70), (NULL, 43), [NULL, ∞), [NULL, ∞)}, {(27, 70), (43, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -5588,24 +5587,24 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       │   │       └─ 23 (tinyint)\n" +
 			" │       │   └─ (comp_index_t2.v3:3 BETWEEN 17 (tinyint) AND 37 (tinyint))\n" +
 			" │       └─ (comp_index_t2.v4:4 BETWEEN 21 (tinyint) AND 38 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 42], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(42, 59), [23, 89), [17, 37], [21, 38]}, {(42, ∞), [89, ∞), [14, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1>=52 AND v2>=55) OR (v1<73 AND v2<=1 AND v3>75 AND v4<=36)) OR (v1>=45 AND v2>=49 AND v3<=26 AND v4 BETWEEN 40 AND 83));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 73), (NULL, 1], (75, ∞), (NULL, 36]}, {[45, 52), [49, ∞), (NULL, 26], [40, 83]}, {[52, ∞), [49, 55), (NULL, 26], [40, 83]}, {[52, ∞), [55, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>0 AND v2=94 AND v3<>0) OR (v1>=83 AND v2<69 AND v3<84));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(0, ∞), [94, 94], (NULL, 0), [NULL, ∞)}, {(0, ∞), [94, 94], (0, ∞), [NULL, ∞)}, {[83, ∞), (NULL, 69), (NULL, 84), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -5625,24 +5624,24 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t2.v1:1\n" +
 			" │           └─ 30 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<92) OR (v1 BETWEEN 6 AND 39 AND v2=47 AND v3>=63));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 92), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>=98) OR (v1<=2 AND v2<5));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 2], (NULL, 5), [NULL, ∞), [NULL, ∞)}, {[98, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -5691,16 +5690,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThanOrEqual\n" +
 			" │           ├─ comp_index_t2.v2:2\n" +
 			" │           └─ 22 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 4), (NULL, 70), (NULL, 20], [NULL, ∞)}, {[4, 29], (22, 70), (NULL, 20], [NULL, ∞)}, {[4, ∞), (NULL, 22], [NULL, ∞), [NULL, ∞)}, {[7, 29], [33, ∞), (78, ∞), [NULL, ∞)}, {(29, 61], [33, 63), (78, ∞), [NULL, ∞)}, {(29, 70), (22, 63), (NULL, 20], [NULL, ∞)}, {(29, ∞), [63, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<=12) OR (v1=28));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 12], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[28, 28], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -5739,16 +5738,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │           └─ Eq\n" +
 			" │               ├─ comp_index_t2.v3:3\n" +
 			" │               └─ 45 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{[52, 98], (NULL, 71), (NULL, 45), [NULL, ∞)}, {[52, 98], (NULL, 71), (45, ∞), [NULL, ∞)}, {[84, 84], [13, 71), [45, 45], (NULL, 36)}, {[84, 84], [13, 71), [45, 45], (36, ∞)}, {[84, 84], [71, ∞), (NULL, 46], (NULL, 36)}, {[84, 84], [71, ∞), (NULL, 46], (36, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>64) OR (v1<>55 AND v2=85 AND v3<=88));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 55), [85, 85], (NULL, 88], [NULL, ∞)}, {(55, 64], [85, 85], (NULL, 88], [NULL, ∞)}, {(64, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -5788,16 +5787,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThan\n" +
 			" │           ├─ comp_index_t2.v3:3\n" +
 			" │           └─ 59 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1 BETWEEN 2 AND 23) OR (v1 BETWEEN 7 AND 14 AND v2<=27 AND v3<=82)) OR (v1>61));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{[2, 23], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(61, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -5840,16 +5839,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t2.v4:4\n" +
 			" │           └─ 32 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (v1>=85 AND v2<12) AND (v1>=25);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{[85, ∞), (NULL, 12), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -5879,16 +5878,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t2.v3:3\n" +
 			" │           └─ 14 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 0), [27, 69], [14, 14], (9, ∞)}, {[0, 0], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(0, 31), [27, 69], [14, 14], (9, ∞)}, {[31, 31], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(31, 73), [27, 69], [14, 14], (9, ∞)}, {(73, ∞), [27, 69], [14, 14], (9, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1>=42 AND v2=41 AND v3 BETWEEN 29 AND 94 AND v4<71) OR (v1>=71 AND v2 BETWEEN 67 AND 87 AND v3>=9)) OR (v1<2 AND v2<=1 AND v3<36 AND v4>41));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 2), (NULL, 1], (NULL, 36), (41, ∞)}, {[42, ∞), [41, 41], [29, 94], (NULL, 71)}, {[71, ∞), [67, 87], [9, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -5929,8 +5928,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t2.v3:3\n" +
 			" │           └─ 58 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 3], (NULL, 16), (NULL, 74), [69, ∞)}, {(NULL, 3], (NULL, 16), (74, ∞), [69, ∞)}, {(NULL, 3], (16, 57), (NULL, 74), [69, ∞)}, {(NULL, 3], (16, 57), (74, ∞), [69, ∞)}, {(NULL, 44), [16, 16], [NULL, ∞), [NULL, ∞)}, {[44, 44], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(44, 66), [16, 16], [NULL, ∞), [NULL, ∞)}, {(66, ∞), [16, 16], [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -5969,8 +5968,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │           └─ Eq\n" +
 			" │               ├─ comp_index_t2.v3:3\n" +
 			" │               └─ 87 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 10), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[10, 10], (NULL, 41], [NULL, ∞), [NULL, ∞)}, {(10, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -6011,8 +6010,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ GreaterThan\n" +
 			" │       ├─ comp_index_t2.v1:1\n" +
 			" │       └─ 19 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 13], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(13, 18), [47, ∞), (NULL, 11), [NULL, ∞)}, {[18, 19], (11, ∞), (22, ∞), [NULL, ∞)}, {(19, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -6034,24 +6033,24 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThan\n" +
 			" │           ├─ comp_index_t2.v3:3\n" +
 			" │           └─ 32 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 68), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(68, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (v1 BETWEEN 20 AND 93) AND (v1=66 AND v2<>21 AND v3 BETWEEN 43 AND 94);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{[66, 66], (21, ∞), [43, 94], [NULL, ∞)}, {[66, 66], (NULL, 21), [43, 94], [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (v1>83 AND v2<>16 AND v3=22) AND (v1=34) AND (v1=79 AND v2<=45 AND v3=49);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -6083,8 +6082,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │           └─ Eq\n" +
 			" │               ├─ comp_index_t2.v3:3\n" +
 			" │               └─ 54 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 44), [1, 1], (NULL, 54), [NULL, ∞)}, {(NULL, 44), [1, 1], (54, ∞), [NULL, ∞)}, {[44, 44], (NULL, 98], [NULL, ∞), [NULL, ∞)}, {(44, 45], [1, 1], (NULL, 54), [NULL, ∞)}, {(44, 45], [1, 1], (54, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -6123,8 +6122,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThanOrEqual\n" +
 			" │           ├─ comp_index_t2.v3:3\n" +
 			" │           └─ 69 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 20), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[20, 31), [6, 6], (NULL, 69], [2, 16]}, {[20, 38), (24, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -6182,16 +6181,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   │           ├─ comp_index_t2.v4:4\n" +
 			" │   │           └─ 27 (tinyint)\n" +
 			" │   └─ (comp_index_t2.v1:1 BETWEEN 37 (tinyint) AND 62 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 62], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(62, 63), [35, ∞), [96, ∞), [NULL, ∞)}, {(62, ∞), [52, 52], [86, 86], (NULL, 27]}, {[63, 63], [35, 55), [96, ∞), [NULL, ∞)}, {[63, 63], [55, 55], (NULL, 46), [NULL, ∞)}, {[63, 63], [55, 55], (46, ∞), [NULL, ∞)}, {[63, 63], (55, ∞), [96, ∞), [NULL, ∞)}, {(63, 72], [35, ∞), [96, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1=52) OR (v1>=59 AND v2<=30 AND v3=98 AND v4 BETWEEN 43 AND 74));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{[52, 52], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[59, ∞), (NULL, 30], [98, 98], [43, 74]}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -6238,8 +6237,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThanOrEqual\n" +
 			" │           ├─ comp_index_t2.v2:2\n" +
 			" │           └─ 11 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{[59, 59], (NULL, 56], [NULL, ∞), [NULL, ∞)}, {[83, ∞), (NULL, 11], [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -6285,8 +6284,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThan\n" +
 			" │           ├─ comp_index_t2.v2:2\n" +
 			" │           └─ 50 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 9), (16, ∞), (NULL, 44), [3, 31]}, {[9, 9], (NULL, 50), [NULL, ∞), [NULL, ∞)}, {[9, 9], [50, ∞), (NULL, 44), [3, 31]}, {(9, 39), (16, ∞), (NULL, 44), [3, 31]}, {(39, 72], (16, ∞), (NULL, 44), [3, 31]}, {(72, ∞), (16, 73), (NULL, 44), [3, 31]}, {(72, ∞), [73, 73], (NULL, 37), (NULL, 43]}, {(72, ∞), [73, 73], [37, 44), [3, 31]}, {(72, ∞), (73, ∞), (NULL, 44), [3, 31]}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -6318,8 +6317,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t2.v1:1\n" +
 			" │           └─ 35 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -6341,8 +6340,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       │       ├─ comp_index_t2.v4:4\n" +
 			" │       │       └─ 42 (tinyint)\n" +
 			" │       └─ (comp_index_t2.v2:2 BETWEEN 89 (tinyint) AND 94 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 3), [89, 94], [NULL, ∞), [NULL, ∞)}, {(3, 13], [89, 94], [NULL, ∞), [NULL, ∞)}, {(13, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -6399,24 +6398,24 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThan\n" +
 			" │           ├─ comp_index_t2.v3:3\n" +
 			" │           └─ 24 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(27, ∞), [8, ∞), (NULL, 24), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1<77 AND v2 BETWEEN 5 AND 22 AND v3<>91 AND v4<34) OR (v1=68 AND v2=50)) OR (v1<44 AND v2>84 AND v3<37 AND v4>=67));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 44), (84, ∞), (NULL, 37), [67, ∞)}, {(NULL, 77), [5, 22], (NULL, 91), (NULL, 34)}, {(NULL, 77), [5, 22], (91, ∞), (NULL, 34)}, {[68, 68], [50, 50], [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<4 AND v2>=71) OR (v1<18 AND v2=57));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 4), [71, ∞), [NULL, ∞), [NULL, ∞)}, {(NULL, 18), [57, 57], [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -6444,8 +6443,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ LessThan\n" +
 			" │       ├─ comp_index_t2.v1:1\n" +
 			" │       └─ 97 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 97), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(97, ∞), [46, 51], [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -6471,8 +6470,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       │   ├─ comp_index_t2.v1:1\n" +
 			" │       │   └─ 59 (tinyint)\n" +
 			" │       └─ (comp_index_t2.v2:2 BETWEEN 25 (tinyint) AND 58 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{[4, 47), [19, 65], [NULL, ∞), [NULL, ∞)}, {(47, 71], [19, 65], [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -6515,8 +6514,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThanOrEqual\n" +
 			" │           ├─ comp_index_t2.v3:3\n" +
 			" │           └─ 39 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 76), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[76, 76], [53, 67], [39, ∞), [NULL, ∞)}, {(76, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -6574,16 +6573,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThan\n" +
 			" │           ├─ comp_index_t2.v4:4\n" +
 			" │           └─ 34 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 22], (76, ∞), [38, 42], (NULL, 40)}, {(NULL, 22], (76, ∞), [38, 42], (40, ∞)}, {(NULL, 33), (NULL, 93), [83, ∞), [NULL, ∞)}, {[8, 33), (NULL, 31], [30, 46], [28, ∞)}, {[33, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1 BETWEEN 13 AND 40 AND v2>=0) OR (v1<>3 AND v2>47 AND v3<44 AND v4>49)) OR (v1=23));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 3), (47, ∞), (NULL, 44), (49, ∞)}, {(3, 13), (47, ∞), (NULL, 44), (49, ∞)}, {[13, 23), [0, ∞), [NULL, ∞), [NULL, ∞)}, {[23, 23], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(23, 40], [0, ∞), [NULL, ∞), [NULL, ∞)}, {(40, ∞), (47, ∞), (NULL, 44), (49, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -6622,8 +6621,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ GreaterThan\n" +
 			" │       ├─ comp_index_t2.v1:1\n" +
 			" │       └─ 31 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 30], [6, 61], (NULL, 95], (5, ∞)}, {(31, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -6678,8 +6677,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThanOrEqual\n" +
 			" │           ├─ comp_index_t2.v4:4\n" +
 			" │           └─ 13 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 6], (NULL, 3], [6, 6], (NULL, 77]}, {(6, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -6713,24 +6712,24 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ Eq\n" +
 			" │       ├─ comp_index_t2.v1:1\n" +
 			" │       └─ 5 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{[5, 5], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[95, ∞), (NULL, 72), [22, 22], [28, 28]}, {[95, ∞), (72, ∞), [22, 22], [28, 28]}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1=95 AND v2<1 AND v3 BETWEEN 49 AND 61 AND v4=51) OR (v1>29 AND v2>=9 AND v3>=63 AND v4<=88));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(29, ∞), [9, ∞), [63, ∞), (NULL, 88]}, {[95, 95], (NULL, 1), [49, 61], [51, 51]}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (v1>30 AND v2 BETWEEN 20 AND 64) AND (v1<=29) AND (v1>=25 AND v2<>0);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -6761,24 +6760,24 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ AND\n" +
 			" │       ├─ (comp_index_t2.v1:1 BETWEEN 10 (tinyint) AND 46 (tinyint))\n" +
 			" │       └─ (comp_index_t2.v2:2 BETWEEN 18 (tinyint) AND 76 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{[10, 46], [18, 76], [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1=44 AND v2>=45 AND v3>=34 AND v4>1) OR (v1=33));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{[33, 33], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[44, 44], [45, ∞), [34, ∞), (1, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1<>12 AND v2<=6) OR (v1>99 AND v2<>51 AND v3=38)) OR (v1>60)) OR (v1 BETWEEN 69 AND 77 AND v2>=49 AND v3>=43));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 12), (NULL, 6], [NULL, ∞), [NULL, ∞)}, {(12, 60], (NULL, 6], [NULL, ∞), [NULL, ∞)}, {(60, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -6814,16 +6813,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThan\n" +
 			" │           ├─ comp_index_t2.v4:4\n" +
 			" │           └─ 22 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{[7, 45], (NULL, 11], [NULL, ∞), [NULL, ∞)}, {[16, 45], (11, 53), (NULL, 15), (22, ∞)}, {[16, 45], (11, 53), (15, ∞), (22, ∞)}, {(45, 65], (NULL, 53), (NULL, 15), (22, ∞)}, {(45, 65], (NULL, 53), (15, ∞), (22, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<11) OR (v1<=38 AND v2>=93 AND v3<=34 AND v4>7));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 11), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[11, 38], [93, ∞), (NULL, 34], (7, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -6850,8 +6849,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │           └─ Eq\n" +
 			" │               ├─ comp_index_t2.v3:3\n" +
 			" │               └─ 97 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 97], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -6878,16 +6877,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThan\n" +
 			" │           ├─ comp_index_t2.v2:2\n" +
 			" │           └─ 84 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 64], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(64, ∞), [0, 58], [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1 BETWEEN 24 AND 98 AND v2>0 AND v3>=87) OR (v1 BETWEEN 2 AND 3 AND v2 BETWEEN 15 AND 78));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{[2, 3], [15, 78], [NULL, ∞), [NULL, ∞)}, {[24, 98], (0, ∞), [87, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -6936,24 +6935,24 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThan\n" +
 			" │           ├─ comp_index_t2.v3:3\n" +
 			" │           └─ 46 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 37), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[37, 37], (NULL, 24), (NULL, 46), [NULL, ∞)}, {[37, 37], (24, 53), (NULL, 46), [NULL, ∞)}, {[37, 37], [53, 65], (NULL, ∞), [NULL, ∞)}, {[37, 37], (65, ∞), (NULL, 46), [NULL, ∞)}, {(37, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1<>21 AND v2>27 AND v3>=97 AND v4 BETWEEN 25 AND 67) OR (v1>=66 AND v2<=56)) OR (v1=37));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 21), (27, ∞), [97, ∞), [25, 67]}, {(21, 37), (27, ∞), [97, ∞), [25, 67]}, {[37, 37], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(37, 66), (27, ∞), [97, ∞), [25, 67]}, {[66, ∞), (NULL, 56], [NULL, ∞), [NULL, ∞)}, {[66, ∞), (56, ∞), [97, ∞), [25, 67]}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<=43 AND v2<48 AND v3<16 AND v4<=75) OR (v1<71));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 71), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -6984,8 +6983,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t2.v4:4\n" +
 			" │           └─ 62 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -7019,8 +7018,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │           └─ Eq\n" +
 			" │               ├─ comp_index_t2.v3:3\n" +
 			" │               └─ 97 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(8, ∞), (NULL, 43], (NULL, 97), [NULL, ∞)}, {(8, ∞), (NULL, 43], (97, ∞), [NULL, ∞)}, {[54, 54], [3, 8], [97, 97], (30, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -7055,32 +7054,32 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t2.v1:1\n" +
 			" │           └─ 23 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 23), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(23, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<20 AND v2<>84 AND v3<25 AND v4>=93) OR (v1<13));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 13), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[13, 20), (NULL, 84), (NULL, 25), [93, ∞)}, {[13, 20), (84, ∞), (NULL, 25), [93, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>=81 AND v2 BETWEEN 55 AND 77 AND v3=64) OR (v1=20 AND v2=21));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{[20, 20], [21, 21], [NULL, ∞), [NULL, ∞)}, {[81, ∞), [55, 77], [64, 64], [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1>30 AND v2 BETWEEN 58 AND 72 AND v3<=35) OR (v1 BETWEEN 28 AND 28 AND v2>=76)) OR (v1=74 AND v2<26));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{[28, 28], [76, ∞), [NULL, ∞), [NULL, ∞)}, {(30, ∞), [58, 72], (NULL, 35], [NULL, ∞)}, {[74, 74], (NULL, 26), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -7146,16 +7145,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThan\n" +
 			" │           ├─ comp_index_t2.v3:3\n" +
 			" │           └─ 46 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{[1, 1], [64, 81], (46, ∞), (NULL, 29)}, {[1, 1], [64, 81], (46, ∞), (29, ∞)}, {(5, 11], (8, 35), (NULL, 10], (NULL, 76)}, {[22, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1=49) OR (v1<43 AND v2>=34));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 43), [34, ∞), [NULL, ∞), [NULL, ∞)}, {[49, 49], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -7198,16 +7197,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       │       ├─ comp_index_t2.v3:3\n" +
 			" │       │       └─ 10 (tinyint)\n" +
 			" │       └─ (comp_index_t2.v4:4 BETWEEN 11 (tinyint) AND 93 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 17), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(17, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<2 AND v2<>94) OR (v1<>76 AND v2=27 AND v3<=31 AND v4<38));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 2), (NULL, 94), [NULL, ∞), [NULL, ∞)}, {(NULL, 2), (94, ∞), [NULL, ∞), [NULL, ∞)}, {[2, 76), [27, 27], (NULL, 31], (NULL, 38)}, {(76, ∞), [27, 27], (NULL, 31], (NULL, 38)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -7269,8 +7268,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │           └─ Eq\n" +
 			" │               ├─ comp_index_t2.v4:4\n" +
 			" │               └─ 30 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 62], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(62, ∞), (NULL, 96), [4, 29], [65, ∞)}, {(62, ∞), (47, ∞), [67, ∞), [29, 29]}, {(62, ∞), (96, ∞), [4, 29], [65, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -7305,8 +7304,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t2.v3:3\n" +
 			" │           └─ 50 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 17), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[17, 17], (NULL, 5), [38, ∞), (91, ∞)}, {(17, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -7340,8 +7339,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThanOrEqual\n" +
 			" │           ├─ comp_index_t2.v4:4\n" +
 			" │           └─ 70 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 86), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -7380,16 +7379,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   │           ├─ comp_index_t2.v3:3\n" +
 			" │   │           └─ 28 (tinyint)\n" +
 			" │   └─ (comp_index_t2.v1:1 BETWEEN 36 (tinyint) AND 40 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{[36, 40], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>=24 AND v2=61 AND v3<49 AND v4<82) OR (v1<4 AND v2>51 AND v3=9));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 4), (51, ∞), [9, 9], [NULL, ∞)}, {[24, ∞), [61, 61], (NULL, 49), (NULL, 82)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -7428,24 +7427,24 @@ var IndexPlanTests = []QueryPlanTest{
 			" │           └─ Eq\n" +
 			" │               ├─ comp_index_t2.v3:3\n" +
 			" │               └─ 44 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 1), (NULL, 11), (NULL, 44), (NULL, 66)}, {(NULL, 1), (NULL, 11), (44, ∞), (NULL, 66)}, {[0, 87], [44, ∞), (NULL, 68), [50, 50]}, {[0, 87], [44, ∞), (68, ∞), [50, 50]}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (v1<17 AND v2<54) AND (v1>=70 AND v2 BETWEEN 53 AND 53 AND v3>10 AND v4=17);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1=21 AND v2>25 AND v3>=7) OR (v1 BETWEEN 23 AND 88 AND v2<=26 AND v3>=87 AND v4 BETWEEN 42 AND 95)) OR (v1<4 AND v2>=66 AND v3<=24 AND v4=10)) OR (v1>69));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 4), [66, ∞), (NULL, 24], [10, 10]}, {[21, 21], (25, ∞), [7, ∞), [NULL, ∞)}, {[23, 69], (NULL, 26], [87, ∞), [42, 95]}, {(69, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -7462,8 +7461,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThanOrEqual\n" +
 			" │           ├─ comp_index_t2.v4:4\n" +
 			" │           └─ 90 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 39], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -7499,16 +7498,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t2.v1:1\n" +
 			" │           └─ 71 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{[2, 71), (NULL, 78), (NULL, 29), (NULL, 63)}, {[2, 71), (NULL, 78), (NULL, 29), (63, ∞)}, {[2, 71), (NULL, 78), (29, ∞), (NULL, 63)}, {[2, 71), (NULL, 78), (29, ∞), (63, ∞)}, {[2, 71), (78, ∞), (29, ∞), (NULL, 63)}, {[2, 71), (78, ∞), (29, ∞), (63, ∞)}, {[2, 86], (78, ∞), (NULL, 29), (NULL, 63)}, {[2, 86], (78, ∞), (NULL, 29), (63, ∞)}, {[71, 71], (1, 53), (29, 56], (NULL, 63)}, {[71, 71], (1, 53), (29, 56], (63, ∞)}, {[71, 71], (1, 78), (NULL, 29), (NULL, 63)}, {[71, 71], (1, 78), (NULL, 29), (63, ∞)}, {[71, 71], [53, 53], (29, ∞), (NULL, 63)}, {[71, 71], [53, 53], (29, ∞), (63, ∞)}, {[71, 71], (53, 78), (29, 56], (NULL, 63)}, {[71, 71], (53, 78), (29, 56], (63, ∞)}, {[71, 71], (78, ∞), (29, 56], (NULL, 63)}, {[71, 71], (78, ∞), (29, 56], (63, ∞)}, {(71, 86], (NULL, 78), (NULL, 29), (NULL, 63)}, {(71, 86], (NULL, 78), (NULL, 29), (63, ∞)}, {(71, 86], (NULL, 78), (29, ∞), (NULL, 63)}, {(71, 86], (NULL, 78), (29, ∞), (63, ∞)}, {(71, 86], (78, ∞), (29, ∞), (NULL, 63)}, {(71, 86], (78, ∞), (29, ∞), (63, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<=5) OR (v1=53));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 5], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[53, 53], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -7519,16 +7518,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" ├─ GreaterThan\n" +
 			" │   ├─ comp_index_t2.v3:3\n" +
 			" │   └─ 4 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(34, 88], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(88, ∞), [27, 46], [19, 27], [50, ∞)}, {(88, ∞), (84, ∞), [90, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>=82) OR (v1<=95 AND v2<>23 AND v3<18 AND v4<>50));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 82), (NULL, 23), (NULL, 18), (NULL, 50)}, {(NULL, 82), (NULL, 23), (NULL, 18), (50, ∞)}, {(NULL, 82), (23, ∞), (NULL, 18), (NULL, 50)}, {(NULL, 82), (23, ∞), (NULL, 18), (50, ∞)}, {[82, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -7559,8 +7558,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThan\n" +
 			" │           ├─ comp_index_t2.v2:2\n" +
 			" │           └─ 34 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(5, ∞), (NULL, 34), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -7598,8 +7597,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThanOrEqual\n" +
 			" │           ├─ comp_index_t2.v3:3\n" +
 			" │           └─ 5 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(4, 24), (NULL, 21], [15, ∞), [NULL, ∞)}, {[24, 86], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(86, 93), (NULL, 21], [15, ∞), [NULL, ∞)}, {[93, 93], (NULL, 1), [15, ∞), [NULL, ∞)}, {[93, 93], [1, 21], (NULL, ∞), [NULL, ∞)}, {[93, 93], (21, ∞), (NULL, 63), [NULL, ∞)}, {[93, 93], (21, ∞), (63, ∞), [NULL, ∞)}, {(93, ∞), (NULL, 21], [15, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -7637,8 +7636,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThanOrEqual\n" +
 			" │           ├─ comp_index_t2.v2:2\n" +
 			" │           └─ 21 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 18), (NULL, 21), [14, ∞), [NULL, ∞)}, {(NULL, 18), [21, ∞), [NULL, ∞), [NULL, ∞)}, {[18, 18], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(18, 23), (NULL, 21), [14, ∞), [NULL, ∞)}, {(18, 23), [21, ∞), [NULL, ∞), [NULL, ∞)}, {[23, 63), (NULL, 32), [14, ∞), [NULL, ∞)}, {[23, 63), (32, ∞), [14, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -7687,8 +7686,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │           └─ Eq\n" +
 			" │               ├─ comp_index_t2.v2:2\n" +
 			" │               └─ 17 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 36], (NULL, 17), [NULL, ∞), [NULL, ∞)}, {(NULL, 36], (17, ∞), [NULL, ∞), [NULL, ∞)}, {(36, 47), (42, 48), [27, 47], (NULL, 11]}, {(47, ∞), (42, 48), [27, 47], (NULL, 11]}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -7724,8 +7723,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │           └─ Eq\n" +
 			" │               ├─ comp_index_t2.v4:4\n" +
 			" │               └─ 31 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 56], [50, 50], [0, 5], (NULL, 31)}, {(NULL, 56], [50, 50], [0, 5], (31, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -7757,8 +7756,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThan\n" +
 			" │           ├─ comp_index_t2.v3:3\n" +
 			" │           └─ 99 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 93], (NULL, 5), [NULL, ∞), [NULL, ∞)}, {(NULL, 93], (5, ∞), [NULL, ∞), [NULL, ∞)}, {(93, ∞), (33, ∞), (NULL, 99), [9, 9]}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -7806,8 +7805,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThanOrEqual\n" +
 			" │           ├─ comp_index_t2.v3:3\n" +
 			" │           └─ 2 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{[1, 1], [43, 43], (NULL, 2], [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -7834,8 +7833,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThan\n" +
 			" │           ├─ comp_index_t2.v4:4\n" +
 			" │           └─ 86 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 4], [51, ∞), [NULL, ∞), [NULL, ∞)}, {[58, 58], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[82, 82], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -7886,8 +7885,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ Eq\n" +
 			" │       ├─ comp_index_t2.v1:1\n" +
 			" │       └─ 70 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 8), (NULL, 76), [36, 70], [NULL, ∞)}, {(NULL, 8), (76, ∞), [36, 70], [NULL, ∞)}, {[42, 70), (NULL, 8), (NULL, 3), (NULL, 85)}, {[70, 70], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(70, 78), (NULL, 8), (NULL, 3), (NULL, 85)}, {[78, ∞), (NULL, 28), (NULL, 52), [NULL, ∞)}, {[78, ∞), (28, ∞), (NULL, 52), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -7903,8 +7902,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ GreaterThanOrEqual\n" +
 			" │       ├─ comp_index_t2.v1:1\n" +
 			" │       └─ 43 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -7950,40 +7949,40 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t2.v4:4\n" +
 			" │           └─ 58 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 25), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[39, 50), (21, ∞), [27, 35), (18, ∞)}, {[39, 50), (21, ∞), (35, 90], (18, ∞)}, {(50, 76], (21, ∞), [27, 35), (18, ∞)}, {(50, 76], (21, ∞), (35, 90], (18, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1=62) OR (v1 BETWEEN 24 AND 36 AND v2>=94 AND v3 BETWEEN 10 AND 55 AND v4>=89));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{[24, 36], [94, ∞), [10, 55], [89, ∞)}, {[62, 62], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1=31) OR (v1<=95 AND v2<=26 AND v3 BETWEEN 40 AND 72)) OR (v1<51 AND v2=23));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 31), (NULL, 23), [40, 72], [NULL, ∞)}, {(NULL, 31), [23, 23], [NULL, ∞), [NULL, ∞)}, {(NULL, 31), (23, 26], [40, 72], [NULL, ∞)}, {[31, 31], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(31, 51), (NULL, 23), [40, 72], [NULL, ∞)}, {(31, 51), [23, 23], [NULL, ∞), [NULL, ∞)}, {(31, 51), (23, 26], [40, 72], [NULL, ∞)}, {[51, 95], (NULL, 26], [40, 72], [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>=3) OR (v1>40)) AND (v1>66 AND v2>33);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(66, ∞), (33, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1>=69 AND v2=61 AND v3=87 AND v4 BETWEEN 63 AND 87) OR (v1 BETWEEN 48 AND 62)) OR (v1<>81 AND v2<=67 AND v3<>43));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 48), (NULL, 67], (NULL, 43), [NULL, ∞)}, {(NULL, 48), (NULL, 67], (43, ∞), [NULL, ∞)}, {[48, 62], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(62, 81), (NULL, 67], (NULL, 43), [NULL, ∞)}, {(62, 81), (NULL, 67], (43, ∞), [NULL, ∞)}, {[81, 81], [61, 61], [87, 87], [63, 87]}, {(81, ∞), (NULL, 67], (NULL, 43), [NULL, ∞)}, {(81, ∞), (NULL, 67], (43, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -8016,8 +8015,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThan\n" +
 			" │           ├─ comp_index_t2.v3:3\n" +
 			" │           └─ 59 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{[12, 19), (NULL, 43), (NULL, 59), [1, ∞)}, {[19, 19], (NULL, 2), (NULL, 59), [1, ∞)}, {[19, 19], [2, ∞), [NULL, ∞), [NULL, ∞)}, {(19, 53], (NULL, 43), (NULL, 59), [1, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -8049,8 +8048,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThan\n" +
 			" │           ├─ comp_index_t2.v3:3\n" +
 			" │           └─ 62 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 14], (NULL, 1), (NULL, 62), [NULL, ∞)}, {(NULL, 14], (1, ∞), (NULL, 62), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -8058,30 +8057,29 @@ var IndexPlanTests = []QueryPlanTest{
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1<>5) OR (v1<96 AND v2>=14)) OR (v1<>96)) AND (v1<>51 AND v3>41);`,
 		ExpectedPlan: "Filter\n" +
-			" ├─ AND\n" +
+			" ├─ Or\n" +
 			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ NOT\n" +
-			" │   │   │   │   └─ Eq\n" +
-			" │   │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │       └─ 5 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ LessThan\n" +
-			" │   │   │       │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   └─ 96 (tinyint)\n" +
-			" │   │   │       └─ GreaterThanOrEqual\n" +
-			" │   │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │   │           └─ 14 (tinyint)\n" +
-			" │   │   └─ NOT\n" +
-			" │   │       └─ Eq\n" +
-			" │   │           ├─ comp_index_t2.v1:1\n" +
-			" │   │           └─ 96 (tinyint)\n" +
-			" │   └─ GreaterThan\n" +
-			" │       ├─ comp_index_t2.v3:3\n" +
-			" │       └─ 41 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" │   │   ├─ NOT\n" +
+			" │   │   │   └─ Eq\n" +
+			" │   │   │       ├─ comp_index_t2.v1:1\n" +
+			" │   │   │       └─ 5 (tinyint)\n" +
+			" │   │   └─ AND\n" +
+			" │   │       ├─ LessThan\n" +
+			" │   │       │   ├─ comp_index_t2.v1:1\n" +
+			" │   │       │   └─ 96 (tinyint)\n" +
+			" │   │       └─ GreaterThanOrEqual\n" +
+			" │   │           ├─ comp_index_t2.v2:2\n" +
+			" │   │           └─ 14 (tinyint)\n" +
+			" │   └─ NOT\n" +
+			" │       └─ Eq\n" +
+			" │           ├─ comp_index_t2.v1:1\n" +
+			" │           └─ 96 (tinyint)\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 51), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(51, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			"     ├─ index condition: GreaterThan\n" +
+			"     │   ├─ comp_index_t2.v3:2\n" +
+			"     │   └─ 41 (tinyint)\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
@@ -8121,8 +8119,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ Eq\n" +
 			" │       ├─ comp_index_t2.v1:1\n" +
 			" │       └─ 26 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{[26, 26], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[36, 36], (NULL, 77), (94, ∞), [NULL, ∞)}, {(97, ∞), (NULL, 45), (NULL, 77), [30, 30]}, {(97, ∞), (NULL, 45), (77, ∞), [30, 30]}, {(97, ∞), (45, ∞), (NULL, 77), [30, 30]}, {(97, ∞), (45, ∞), (77, ∞), [30, 30]}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -8149,24 +8147,24 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThanOrEqual\n" +
 			" │           ├─ comp_index_t2.v4:4\n" +
 			" │           └─ 72 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{[34, 37], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[43, 81], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>=17 AND v2<>19) OR (v1>45));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{[17, 45], (NULL, 19), [NULL, ∞), [NULL, ∞)}, {[17, 45], (19, ∞), [NULL, ∞), [NULL, ∞)}, {(45, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1=57) OR (v1>=1 AND v2<=5 AND v3>=10 AND v4<5)) OR (v1>55));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{[1, 55], (NULL, 5], [10, ∞), (NULL, 5)}, {(55, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -8213,8 +8211,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │           └─ Eq\n" +
 			" │               ├─ comp_index_t2.v2:2\n" +
 			" │               └─ 62 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{[23, 23], (NULL, 48], [NULL, ∞), [NULL, ∞)}, {(41, ∞), [46, ∞), [11, 29], [NULL, ∞)}, {[70, 70], (NULL, 46), (NULL, 54), (NULL, 47]}, {[70, 70], [46, 62), (NULL, 11), (NULL, 47]}, {[70, 70], [46, 62), (29, 54), (NULL, 47]}, {[70, 70], (62, ∞), (NULL, 11), (NULL, 47]}, {[70, 70], (62, ∞), (29, 54), (NULL, 47]}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -8276,8 +8274,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       │   ├─ comp_index_t2.v1:1\n" +
 			" │       │   └─ 41 (tinyint)\n" +
 			" │       └─ (comp_index_t2.v2:2 BETWEEN 56 (tinyint) AND 93 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 34), (11, 95), [17, 81], (48, ∞)}, {(5, 34), (11, 95), (81, 89], (48, 53]}, {(5, 34), [95, ∞), [43, 89], (48, 53]}, {[34, 41), (11, ∞), [43, 89], (48, 53]}, {[41, 41], (11, 56), [43, 89], (48, 53]}, {[41, 41], [56, 93], [NULL, ∞), [NULL, ∞)}, {[41, 41], (93, ∞), [43, 89], (48, 53]}, {(41, 68], (11, ∞), [43, 89], (48, 53]}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -8306,8 +8304,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThan\n" +
 			" │           ├─ comp_index_t2.v3:3\n" +
 			" │           └─ 8 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 3), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[3, 3], (NULL, 16), (NULL, 8), [NULL, ∞)}, {(3, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -8342,40 +8340,40 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t2.v3:3\n" +
 			" │           └─ 59 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{[5, 83], [34, ∞), [59, 59], [NULL, ∞)}, {(67, 88), (NULL, 5), (40, ∞), (NULL, 27]}, {[88, 97], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(97, ∞), (NULL, 5), (40, ∞), (NULL, 27]}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<>96 AND v2<=2 AND v3=17 AND v4<79) OR (v1=67 AND v2=30 AND v3=38 AND v4=53));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 96), (NULL, 2], [17, 17], (NULL, 79)}, {[67, 67], [30, 30], [38, 38], [53, 53]}, {(96, ∞), (NULL, 2], [17, 17], (NULL, 79)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<>45 AND v2>76) OR (v1=30 AND v2=53));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 45), (76, ∞), [NULL, ∞), [NULL, ∞)}, {[30, 30], [53, 53], [NULL, ∞), [NULL, ∞)}, {(45, ∞), (76, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1 BETWEEN 3 AND 34 AND v2>39) OR (v1>1 AND v2>=92 AND v3=99)) OR (v1>=36 AND v2<>65 AND v3=69));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(1, 3), [92, ∞), [99, 99], [NULL, ∞)}, {[3, 34], (39, ∞), [NULL, ∞), [NULL, ∞)}, {(34, ∞), [92, ∞), [99, 99], [NULL, ∞)}, {[36, ∞), (NULL, 65), [69, 69], [NULL, ∞)}, {[36, ∞), (65, ∞), [69, 69], [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1>=54 AND v2=38 AND v3>=64 AND v4>36) OR (v1<=48)) OR (v1<37 AND v2=13 AND v3<20));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 48], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[54, ∞), [38, 38], [64, ∞), (36, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -8406,8 +8404,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │           └─ Eq\n" +
 			" │               ├─ comp_index_t2.v4:4\n" +
 			" │               └─ 42 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 70), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[70, 70], (79, ∞), (NULL, 6), (NULL, 42)}, {[70, 70], (79, ∞), (NULL, 6), (42, ∞)}, {[70, 70], (79, ∞), (6, ∞), (NULL, 42)}, {[70, 70], (79, ∞), (6, ∞), (42, ∞)}, {(70, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -8439,8 +8437,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ LessThan\n" +
 			" │       ├─ comp_index_t2.v1:1\n" +
 			" │       └─ 61 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 22), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(22, 61), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -8490,32 +8488,32 @@ var IndexPlanTests = []QueryPlanTest{
 			" │           └─ Eq\n" +
 			" │               ├─ comp_index_t2.v4:4\n" +
 			" │               └─ 30 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 41), (0, 11), [95, 95], (NULL, 2]}, {(NULL, 41), [11, 35], [NULL, ∞), [NULL, ∞)}, {(NULL, 41), (35, ∞), [95, 95], (NULL, 2]}, {[11, 11], (NULL, 11), [51, 51], (NULL, 30)}, {[11, 11], (NULL, 11), [51, 51], (30, ∞)}, {[41, 53], (0, ∞), [95, 95], (NULL, 2]}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1<=32 AND v2>6 AND v3=55) OR (v1=87 AND v2<=80)) OR (v1=88 AND v2<=87 AND v3>=45));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 32], (6, ∞), [55, 55], [NULL, ∞)}, {[87, 87], (NULL, 80], [NULL, ∞), [NULL, ∞)}, {[88, 88], (NULL, 87], [45, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>8) OR (v1 BETWEEN 16 AND 25 AND v2<>79 AND v3>=55 AND v4<=5));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(8, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1=45 AND v2>55 AND v3<90) OR (v1>26 AND v2>=2 AND v3<>85 AND v4<=74));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(26, 45), [2, ∞), (NULL, 85), (NULL, 74]}, {(26, 45), [2, ∞), (85, ∞), (NULL, 74]}, {[45, 45], [2, 55], (NULL, 85), (NULL, 74]}, {[45, 45], [2, 55], (85, ∞), (NULL, 74]}, {[45, 45], (55, ∞), (NULL, 90), [NULL, ∞)}, {[45, 45], (55, ∞), [90, ∞), (NULL, 74]}, {(45, ∞), [2, ∞), (NULL, 85), (NULL, 74]}, {(45, ∞), [2, ∞), (85, ∞), (NULL, 74]}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -8537,8 +8535,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       │       ├─ comp_index_t2.v4:4\n" +
 			" │       │       └─ 6 (tinyint)\n" +
 			" │       └─ (comp_index_t2.v2:2 BETWEEN 14 (tinyint) AND 82 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 59), [14, 82], [NULL, ∞), [NULL, ∞)}, {[59, 59], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(59, 85), [14, 82], [NULL, ∞), [NULL, ∞)}, {(85, ∞), [14, 82], [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -8573,24 +8571,24 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ Eq\n" +
 			" │       ├─ comp_index_t2.v1:1\n" +
 			" │       └─ 39 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{[39, 39], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(51, ∞), [46, ∞), [NULL, ∞), [NULL, ∞)}, {[94, ∞), (32, 46), (61, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>=89) OR (v1<=28 AND v2=13));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 28], [13, 13], [NULL, ∞), [NULL, ∞)}, {[89, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<=5 AND v2<65 AND v3<64 AND v4=81) OR (v1<=75)) AND (v1=87);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -8631,8 +8629,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThanOrEqual\n" +
 			" │           ├─ comp_index_t2.v4:4\n" +
 			" │           └─ 33 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 35), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(35, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -8691,8 +8689,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │           └─ Eq\n" +
 			" │               ├─ comp_index_t2.v2:2\n" +
 			" │               └─ 67 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 37), (21, ∞), (NULL, 25], [32, 33]}, {[37, 48), (21, 42), (NULL, 25], [32, 33]}, {[37, 48), [42, 42], (14, 25], [32, 33]}, {[37, 48), (42, ∞), (NULL, 25], [32, 33]}, {[37, 51), [42, 42], (NULL, 14], [NULL, ∞)}, {(48, 51), (21, 42), (NULL, 25], [32, 33]}, {(48, 51), [42, 42], (14, 25], [32, 33]}, {(48, 51), (42, ∞), (NULL, 25], [32, 33]}, {[51, 88], (NULL, 67), [NULL, ∞), [NULL, ∞)}, {[51, 88], [67, 67], (NULL, 25], [32, 33]}, {[51, 88], (67, ∞), [NULL, ∞), [NULL, ∞)}, {(88, ∞), (21, 85), (NULL, 25], [32, 33]}, {(88, ∞), [85, 85], [NULL, ∞), [NULL, ∞)}, {(88, ∞), (85, 89), (NULL, 25], [32, 33]}, {(88, ∞), [89, 89], (NULL, 12], [32, 33]}, {(88, ∞), [89, 89], (12, ∞), [NULL, ∞)}, {(88, ∞), (89, ∞), (NULL, 25], [32, 33]}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -8727,24 +8725,24 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThan\n" +
 			" │           ├─ comp_index_t2.v4:4\n" +
 			" │           └─ 85 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(27, ∞), [7, 79], [9, 29], (NULL, 85)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (v1>=41 AND v2<13 AND v3 BETWEEN 62 AND 87) AND (v1<=67 AND v2>68 AND v3=56 AND v4>28);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1 BETWEEN 23 AND 34 AND v2 BETWEEN 4 AND 75 AND v3<91) OR (v1>=31));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{[23, 31), [4, 75], (NULL, 91), [NULL, ∞)}, {[31, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -8774,24 +8772,24 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t2.v3:3\n" +
 			" │           └─ 29 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 86], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(86, 90), [43, 43], [29, 29], [NULL, ∞)}, {(90, ∞), [43, 43], [29, 29], [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>=1 AND v2<34) OR (v1<78));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 78), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[78, ∞), (NULL, 34), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1=10 AND v2<>64 AND v3>25 AND v4<29) OR (v1>39));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{[10, 10], (NULL, 64), (25, ∞), (NULL, 29)}, {[10, 10], (64, ∞), (25, ∞), (NULL, 29)}, {(39, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -8843,8 +8841,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │           └─ Eq\n" +
 			" │               ├─ comp_index_t2.v4:4\n" +
 			" │               └─ 16 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 14), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[14, 14], [14, ∞), (NULL, 65), (NULL, 9)}, {[14, 14], [14, ∞), (NULL, 65), (9, ∞)}, {(14, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -8867,40 +8865,40 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThan\n" +
 			" │           ├─ comp_index_t2.v3:3\n" +
 			" │           └─ 37 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(12, 36), (NULL, 0), [NULL, ∞), [NULL, ∞)}, {[36, 36], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(36, ∞), (NULL, 0), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (v1=83 AND v3>=72 AND v4<=74) AND (v1>61 AND v2 BETWEEN 32 AND 44);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{[83, 83], [32, 44], [72, ∞), (NULL, 74]}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (v1=78 AND v2>28 AND v3<=47) AND (v1<35 AND v2=69 AND v3>16);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (v1 BETWEEN 31 AND 49 AND v2=20 AND v3 BETWEEN 8 AND 46) AND (v1<>57 AND v2<5);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<=39 AND v2<>3) OR (v1=97 AND v2<>37));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 39], (NULL, 3), [NULL, ∞), [NULL, ∞)}, {(NULL, 39], (3, ∞), [NULL, ∞), [NULL, ∞)}, {[97, 97], (NULL, 37), [NULL, ∞), [NULL, ∞)}, {[97, 97], (37, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -8934,16 +8932,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       │       ├─ comp_index_t2.v4:4\n" +
 			" │       │       └─ 23 (tinyint)\n" +
 			" │       └─ (comp_index_t2.v2:2 BETWEEN 6 (tinyint) AND 43 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{[19, 37), (NULL, 19), (NULL, 29), (NULL, 62)}, {[19, 37), (NULL, 19), (NULL, 29), (62, ∞)}, {[19, 37), (NULL, 19), (29, ∞), (NULL, 62)}, {[19, 37), (NULL, 19), (29, ∞), (62, ∞)}, {[19, 37), (19, ∞), (NULL, 29), (NULL, 62)}, {[19, 37), (19, ∞), (NULL, 29), (62, ∞)}, {[19, 37), (19, ∞), (29, ∞), (NULL, 62)}, {[19, 37), (19, ∞), (29, ∞), (62, ∞)}, {[37, 75], (NULL, 6), (NULL, 29), (NULL, 62)}, {[37, 75], (NULL, 6), (NULL, 29), (62, ∞)}, {[37, 75], (NULL, 6), (29, ∞), (NULL, 62)}, {[37, 75], (NULL, 6), (29, ∞), (62, ∞)}, {[37, 75], [6, 43], [NULL, ∞), [NULL, ∞)}, {[37, 75], (43, ∞), (NULL, 29), (NULL, 62)}, {[37, 75], (43, ∞), (NULL, 29), (62, ∞)}, {[37, 75], (43, ∞), (29, ∞), (NULL, 62)}, {[37, 75], (43, ∞), (29, ∞), (62, ∞)}, {(75, ∞), (NULL, 19), (NULL, 29), (NULL, 62)}, {(75, ∞), (NULL, 19), (NULL, 29), (62, ∞)}, {(75, ∞), (NULL, 19), (29, ∞), (NULL, 62)}, {(75, ∞), (NULL, 19), (29, ∞), (62, ∞)}, {(75, ∞), (19, ∞), (NULL, 29), (NULL, 62)}, {(75, ∞), (19, ∞), (NULL, 29), (62, ∞)}, {(75, ∞), (19, ∞), (29, ∞), (NULL, 62)}, {(75, ∞), (19, ∞), (29, ∞), (62, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<8 AND v2<=33 AND v3 BETWEEN 54 AND 85) OR (v1=46));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 8), (NULL, 33], [54, 85], [NULL, ∞)}, {[46, 46], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -8980,8 +8978,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThanOrEqual\n" +
 			" │           ├─ comp_index_t2.v2:2\n" +
 			" │           └─ 71 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{[0, 0], [71, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -9007,24 +9005,24 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   │           ├─ comp_index_t2.v3:3\n" +
 			" │   │           └─ 76 (tinyint)\n" +
 			" │   └─ (comp_index_t2.v1:1 BETWEEN 31 (tinyint) AND 71 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 71], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>37 AND v2<>5 AND v3=8 AND v4 BETWEEN 26 AND 50) OR (v1>=53)) AND (v1 BETWEEN 5 AND 80);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(37, 53), (NULL, 5), [8, 8], [26, 50]}, {(37, 53), (5, ∞), [8, 8], [26, 50]}, {[53, 80], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1=25) OR (v1<=87));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 87], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -9061,32 +9059,32 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t2.v4:4\n" +
 			" │           └─ 79 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 6], [36, 68], (62, ∞), [79, 79]}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (v1 BETWEEN 30 AND 32 AND v2<68 AND v3<24) AND (v1>=32);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{[32, 32], (NULL, 68), (NULL, 24), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>62 AND v2>0) OR (v1<>80 AND v2>55 AND v3=10 AND v4=91));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 62], (55, ∞), [10, 10], [91, 91]}, {(62, ∞), (0, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<=7 AND v2 BETWEEN 55 AND 81) OR (v1<>56 AND v2<=76 AND v3<>36)) AND (v1<56 AND v2<>69 AND v3=25);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 7], (69, 81], [25, 25], [NULL, ∞)}, {(NULL, 56), (NULL, 69), [25, 25], [NULL, ∞)}, {(7, 56), (69, 76], [25, 25], [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -9114,16 +9112,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t2.v4:4\n" +
 			" │           └─ 80 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 18), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(18, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<=27) OR (v1<23 AND v2>=41));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 27], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -9175,8 +9173,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │           └─ Eq\n" +
 			" │               ├─ comp_index_t2.v3:3\n" +
 			" │               └─ 71 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 31), [33, ∞), (58, ∞), [NULL, ∞)}, {(NULL, 31), [89, 89], [46, 58], (NULL, 32]}, {[31, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -9211,24 +9209,24 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThanOrEqual\n" +
 			" │           ├─ comp_index_t2.v4:4\n" +
 			" │           └─ 8 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{[9, 40], [43, ∞), (NULL, 43], [62, 62]}, {[61, 61], (12, ∞), [0, 13], [8, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1<58) OR (v1 BETWEEN 17 AND 20 AND v2<>99 AND v3<=76)) OR (v1 BETWEEN 48 AND 87)) OR (v1<39 AND v2 BETWEEN 48 AND 94 AND v3<>0));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 87], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1=33) OR (v1 BETWEEN 7 AND 41 AND v2<82 AND v3<53 AND v4<>3));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{[7, 33), (NULL, 82), (NULL, 53), (NULL, 3)}, {[7, 33), (NULL, 82), (NULL, 53), (3, ∞)}, {[33, 33], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(33, 41], (NULL, 82), (NULL, 53), (NULL, 3)}, {(33, 41], (NULL, 82), (NULL, 53), (3, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -9251,8 +9249,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ GreaterThan\n" +
 			" │       ├─ comp_index_t2.v1:1\n" +
 			" │       └─ 96 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 9], [95, ∞), [NULL, ∞), [NULL, ∞)}, {(96, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -9283,8 +9281,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThan\n" +
 			" │           ├─ comp_index_t2.v2:2\n" +
 			" │           └─ 48 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 56], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(56, 91), (20, ∞), [NULL, ∞), [NULL, ∞)}, {[91, 91], (NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(91, ∞), (20, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -9309,56 +9307,56 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThanOrEqual\n" +
 			" │           ├─ comp_index_t2.v3:3\n" +
 			" │           └─ 99 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 75], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(75, ∞), [16, 25], [99, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1 BETWEEN 2 AND 64) OR (v1>=23));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{[2, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<=26 AND v2<1 AND v3=82 AND v4<=42) OR (v1 BETWEEN 42 AND 73));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 26], (NULL, 1), [82, 82], (NULL, 42]}, {[42, 73], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (v1>=23 AND v2<=10) AND (v1>=75 AND v4 BETWEEN 24 AND 68) AND (v1>44 AND v2>8 AND v3<=16);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{[75, ∞), (8, 10], (NULL, 16], [24, 68]}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((((v1>6 AND v2>61 AND v3=0 AND v4>=76) OR (v1<23)) OR (v1<>46 AND v2=29 AND v3>4)) OR (v1>=59)) OR (v1=87 AND v2<=98 AND v3>=47));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 23), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[23, 46), [29, 29], (4, ∞), [NULL, ∞)}, {[23, 59), (61, ∞), [0, 0], [76, ∞)}, {(46, 59), [29, 29], (4, ∞), [NULL, ∞)}, {[59, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1=59 AND v2 BETWEEN 15 AND 53 AND v3<>17 AND v4>=10) OR (v1 BETWEEN 37 AND 95 AND v2<=32 AND v3>=81));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{[37, 95], (NULL, 32], [81, ∞), [NULL, ∞)}, {[59, 59], [15, 32], (17, 81), [10, ∞)}, {[59, 59], [15, 53], (NULL, 17), [10, ∞)}, {[59, 59], (32, 53], (17, ∞), [10, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1 BETWEEN 6 AND 92 AND v2=75 AND v3>79) OR (v1>=10)) OR (v1<=35 AND v2<=42)) AND (v1<>65);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 10), (NULL, 42], [NULL, ∞), [NULL, ∞)}, {[6, 10), [75, 75], (79, ∞), [NULL, ∞)}, {[10, 65), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(65, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -9398,8 +9396,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThanOrEqual\n" +
 			" │           ├─ comp_index_t2.v4:4\n" +
 			" │           └─ 76 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(78, ∞), (NULL, 1), [98, 98], [76, ∞)}, {(78, ∞), (1, ∞), [98, 98], [76, ∞)}, {(84, ∞), [77, 77], [40, ∞), (NULL, 53]}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -9422,16 +9420,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   │           ├─ comp_index_t2.v1:1\n" +
 			" │   │           └─ 13 (tinyint)\n" +
 			" │   └─ (comp_index_t2.v1:1 BETWEEN 4 (tinyint) AND 67 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1>34) OR (v1<35 AND v2>=93)) OR (v1>8));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 8], [93, ∞), [NULL, ∞), [NULL, ∞)}, {(8, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -9476,8 +9474,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThan\n" +
 			" │           ├─ comp_index_t2.v3:3\n" +
 			" │           └─ 28 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -9514,8 +9512,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThanOrEqual\n" +
 			" │           ├─ comp_index_t2.v2:2\n" +
 			" │           └─ 35 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -9547,8 +9545,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       │       ├─ comp_index_t2.v2:2\n" +
 			" │       │       └─ 93 (tinyint)\n" +
 			" │       └─ (comp_index_t2.v3:3 BETWEEN 34 (tinyint) AND 41 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 34), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[34, 62], [1, 41], [NULL, ∞), [NULL, ∞)}, {[65, ∞), [93, ∞), [34, 41], [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -9567,32 +9565,32 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThanOrEqual\n" +
 			" │           ├─ comp_index_t2.v4:4\n" +
 			" │           └─ 99 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(8, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>57) OR (v1<87 AND v2<>91 AND v3 BETWEEN 47 AND 98));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 57], (NULL, 91), [47, 98], [NULL, ∞)}, {(NULL, 57], (91, ∞), [47, 98], [NULL, ∞)}, {(57, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1=57) OR (v1=88 AND v2 BETWEEN 72 AND 93));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{[57, 57], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[88, 88], [72, 93], [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<>10 AND v2=20 AND v3<=21 AND v4<>88) OR (v1<28 AND v2 BETWEEN 38 AND 59 AND v3<>98 AND v4>=26));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 10), [20, 20], (NULL, 21], (NULL, 88)}, {(NULL, 10), [20, 20], (NULL, 21], (88, ∞)}, {(NULL, 28), [38, 59], (NULL, 98), [26, ∞)}, {(NULL, 28), [38, 59], (98, ∞), [26, ∞)}, {(10, ∞), [20, 20], (NULL, 21], (NULL, 88)}, {(10, ∞), [20, 20], (NULL, 21], (88, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -9621,16 +9619,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThan\n" +
 			" │           ├─ comp_index_t2.v2:2\n" +
 			" │           └─ 94 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 5), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[5, 5], (NULL, 94), [NULL, ∞), [NULL, ∞)}, {(5, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<52 AND v2 BETWEEN 33 AND 75 AND v3=32) OR (v1<=98 AND v2<=41 AND v3<>87 AND v4<>83));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 52), (NULL, 33), (NULL, 87), (NULL, 83)}, {(NULL, 52), (NULL, 33), (NULL, 87), (83, ∞)}, {(NULL, 52), [33, 41], (NULL, 32), (NULL, 83)}, {(NULL, 52), [33, 41], (NULL, 32), (83, ∞)}, {(NULL, 52), [33, 41], (32, 87), (NULL, 83)}, {(NULL, 52), [33, 41], (32, 87), (83, ∞)}, {(NULL, 52), [33, 75], [32, 32], [NULL, ∞)}, {(NULL, 98], (NULL, 41], (87, ∞), (NULL, 83)}, {(NULL, 98], (NULL, 41], (87, ∞), (83, ∞)}, {[52, 98], (NULL, 41], (NULL, 87), (NULL, 83)}, {[52, 98], (NULL, 41], (NULL, 87), (83, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -9676,16 +9674,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThan\n" +
 			" │           ├─ comp_index_t2.v2:2\n" +
 			" │           └─ 58 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 22], (58, ∞), [NULL, ∞), [NULL, ∞)}, {(28, 72), [13, 62), (29, 41], (57, ∞)}, {(72, ∞), [13, 62), (29, 41], (57, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<=13 AND v2<=52 AND v3=28 AND v4>88) OR (v1<>5 AND v2<=42));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 5), (NULL, 42], [NULL, ∞), [NULL, ∞)}, {(NULL, 5), (42, 52], [28, 28], (88, ∞)}, {[5, 5], (NULL, 52], [28, 28], (88, ∞)}, {(5, 13], (42, 52], [28, 28], (88, ∞)}, {(5, ∞), (NULL, 42], [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -9717,32 +9715,32 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t2.v3:3\n" +
 			" │           └─ 27 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(13, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>=26) OR (v1<59 AND v2 BETWEEN 2 AND 30 AND v3>=69));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 26), [2, 30], [69, ∞), [NULL, ∞)}, {[26, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<11) OR (v1<>9 AND v2 BETWEEN 51 AND 62 AND v3=98));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 11), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[11, ∞), [51, 62], [98, 98], [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1=92 AND v2>25) OR (v1=91 AND v2=21 AND v3<=18 AND v4<>15)) OR (v1=79 AND v2>67 AND v3<>48 AND v4<42));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{[79, 79], (67, ∞), (NULL, 48), (NULL, 42)}, {[79, 79], (67, ∞), (48, ∞), (NULL, 42)}, {[91, 91], [21, 21], (NULL, 18], (NULL, 15)}, {[91, 91], [21, 21], (NULL, 18], (15, ∞)}, {[92, 92], (25, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -9784,16 +9782,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │           └─ Eq\n" +
 			" │               ├─ comp_index_t2.v3:3\n" +
 			" │               └─ 80 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{[5, 80], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(80, ∞), (24, ∞), (NULL, 5), [NULL, ∞)}, {[86, 86], (NULL, 5), (NULL, 36), (NULL, 81)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>67) OR (v1>69 AND v2>11 AND v3=13 AND v4=20));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(67, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -9819,8 +9817,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t2.v4:4\n" +
 			" │           └─ 1 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 31), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[31, 31], [71, 71], [38, 38], [1, 1]}, {(31, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -9851,8 +9849,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t2.v3:3\n" +
 			" │           └─ 28 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 52], [71, ∞), [28, 28], [NULL, ∞)}, {(2, 52], [6, 23], [46, 52], [0, 0]}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -9863,8 +9861,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" ├─ GreaterThanOrEqual\n" +
 			" │   ├─ comp_index_t2.v4:4\n" +
 			" │   └─ 4 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -9911,24 +9909,24 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t2.v2:2\n" +
 			" │           └─ 39 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(17, 46), [34, ∞), (NULL, 68), (NULL, 13]}, {(17, 46), [34, ∞), (68, ∞), (NULL, 13]}, {[46, 46], (NULL, 12), [NULL, ∞), [NULL, ∞)}, {[46, 46], (12, ∞), [NULL, ∞), [NULL, ∞)}, {(46, 98), [34, ∞), (NULL, 68), (NULL, 13]}, {(46, 98), [34, ∞), (68, ∞), (NULL, 13]}, {[98, ∞), [34, 39), (NULL, 68), (NULL, 13]}, {[98, ∞), [34, 39), (68, ∞), (NULL, 13]}, {[98, ∞), [39, 39], [NULL, ∞), [NULL, ∞)}, {[98, ∞), (39, ∞), (NULL, 68), (NULL, 13]}, {[98, ∞), (39, ∞), (68, ∞), (NULL, 13]}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1<=12 AND v2<>4 AND v3 BETWEEN 18 AND 42) OR (v1>=73)) OR (v1<60 AND v2=93 AND v3>=79));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 12], (NULL, 4), [18, 42], [NULL, ∞)}, {(NULL, 12], (4, ∞), [18, 42], [NULL, ∞)}, {(NULL, 60), [93, 93], [79, ∞), [NULL, ∞)}, {[73, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1=55 AND v2>50) OR (v1<>51 AND v2>=37));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 51), [37, ∞), [NULL, ∞), [NULL, ∞)}, {(51, ∞), [37, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -9972,32 +9970,32 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThanOrEqual\n" +
 			" │           ├─ comp_index_t2.v4:4\n" +
 			" │           └─ 42 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 44), (NULL, 50], [36, 36], (NULL, 42]}, {[66, 76], [84, ∞), (1, ∞), [71, 95]}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<=21 AND v2=44 AND v3>=68) OR (v1>=38 AND v2>=15));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 21], [44, 44], [68, ∞), [NULL, ∞)}, {[38, ∞), [15, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (v1<>37 AND v2>67 AND v3>52) AND (v1<48 AND v2<>73 AND v3=25 AND v4=22);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1 BETWEEN 57 AND 62 AND v2>=99) OR (v1>31));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(31, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -10026,8 +10024,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThanOrEqual\n" +
 			" │           ├─ comp_index_t2.v3:3\n" +
 			" │           └─ 46 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 22), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(22, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -10054,32 +10052,32 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ LessThanOrEqual\n" +
 			" │       ├─ comp_index_t2.v1:1\n" +
 			" │       └─ 16 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 16], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(63, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1<=39 AND v2<>82 AND v3>=33 AND v4>=84) OR (v1=57 AND v2<25 AND v3<>55 AND v4<=82)) OR (v1>10 AND v2>28 AND v3>=65)) OR (v1<=13 AND v2=66));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 10], (NULL, 66), [33, ∞), [84, ∞)}, {(NULL, 10], (66, 82), [33, ∞), [84, ∞)}, {(NULL, 10], (82, ∞), [33, ∞), [84, ∞)}, {(NULL, 13], [66, 66], [NULL, ∞), [NULL, ∞)}, {(10, 13], (28, 66), [33, 65), [84, ∞)}, {(10, 13], (28, 66), [65, ∞), [NULL, ∞)}, {(10, 13], (66, 82), [33, 65), [84, ∞)}, {(10, 13], (66, ∞), [65, ∞), [NULL, ∞)}, {(10, 39], (NULL, 28], [33, ∞), [84, ∞)}, {(10, 39], (82, ∞), [33, 65), [84, ∞)}, {(13, 39], (28, 82), [33, 65), [84, ∞)}, {(13, ∞), (28, ∞), [65, ∞), [NULL, ∞)}, {[57, 57], (NULL, 25), (NULL, 55), (NULL, 82]}, {[57, 57], (NULL, 25), (55, ∞), (NULL, 82]}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<=60 AND v2<=25 AND v3<>9) OR (v1 BETWEEN 19 AND 92 AND v2>=33 AND v3<=40 AND v4=53));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 60], (NULL, 25], (NULL, 9), [NULL, ∞)}, {(NULL, 60], (NULL, 25], (9, ∞), [NULL, ∞)}, {[19, 92], [33, ∞), (NULL, 40], [53, 53]}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>=21 AND v2<=27 AND v3>=86 AND v4>99) OR (v1<76 AND v2<>97));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 76), (NULL, 97), [NULL, ∞), [NULL, ∞)}, {(NULL, 76), (97, ∞), [NULL, ∞), [NULL, ∞)}, {[76, ∞), (NULL, 27], [86, ∞), (99, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -10104,24 +10102,24 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t2.v3:3\n" +
 			" │           └─ 18 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{[4, 8], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[12, ∞), [0, ∞), [18, 18], [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1>65 AND v2<=52 AND v3>37) OR (v1>11)) OR (v1<=54 AND v2 BETWEEN 30 AND 85 AND v3 BETWEEN 14 AND 27 AND v4>=35)) OR (v1>44 AND v2<>76 AND v3>=52));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 11], [30, 85], [14, 27], [35, ∞)}, {(11, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>=54) OR (v1<17 AND v2=34 AND v3>=59));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 17), [34, 34], [59, ∞), [NULL, ∞)}, {[54, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -10152,32 +10150,32 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       │   ├─ comp_index_t2.v1:1\n" +
 			" │       │   └─ 2 (tinyint)\n" +
 			" │       └─ (comp_index_t2.v2:2 BETWEEN 3 (tinyint) AND 70 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 2), [3, 70], [NULL, ∞), [NULL, ∞)}, {(NULL, 9), [98, 98], (NULL, 1), (NULL, 61)}, {(NULL, 9), [98, 98], (NULL, 1), (61, ∞)}, {(9, ∞), [98, 98], (NULL, 1), (NULL, 61)}, {(9, ∞), [98, 98], (NULL, 1), (61, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<=6 AND v2>93) OR (v1 BETWEEN 38 AND 46));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 6], (93, ∞), [NULL, ∞), [NULL, ∞)}, {[38, 46], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1 BETWEEN 16 AND 72) OR (v1=20)) OR (v1>61 AND v2<>48 AND v3<>83 AND v4=46)) OR (v1=5 AND v2=59));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{[5, 5], [59, 59], [NULL, ∞), [NULL, ∞)}, {[16, 72], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(72, ∞), (NULL, 48), (NULL, 83), [46, 46]}, {(72, ∞), (NULL, 48), (83, ∞), [46, 46]}, {(72, ∞), (48, ∞), (NULL, 83), [46, 46]}, {(72, ∞), (48, ∞), (83, ∞), [46, 46]}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1>41 AND v2>74 AND v3>37 AND v4<38) OR (v1=58 AND v2>=1)) OR (v1<=4 AND v2>0 AND v3 BETWEEN 39 AND 72 AND v4>=29));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 4], (0, ∞), [39, 72], [29, ∞)}, {(41, 58), (74, ∞), (37, ∞), (NULL, 38)}, {[58, 58], [1, ∞), [NULL, ∞), [NULL, ∞)}, {(58, ∞), (74, ∞), (37, ∞), (NULL, 38)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -10211,8 +10209,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThanOrEqual\n" +
 			" │           ├─ comp_index_t2.v3:3\n" +
 			" │           └─ 71 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 53), (NULL, 31), [NULL, ∞), [NULL, ∞)}, {(NULL, 53), (31, ∞), [NULL, ∞), [NULL, ∞)}, {[53, 53], (70, ∞), [71, ∞), [NULL, ∞)}, {(53, ∞), (NULL, 31), [NULL, ∞), [NULL, ∞)}, {(53, ∞), (31, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -10237,16 +10235,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThanOrEqual\n" +
 			" │           ├─ comp_index_t2.v3:3\n" +
 			" │           └─ 27 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 1], [40, 74], [27, ∞), [NULL, ∞)}, {(1, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>=92 AND v2>=64 AND v3=39 AND v4 BETWEEN 16 AND 53) OR (v1<54 AND v2 BETWEEN 8 AND 17 AND v3=21 AND v4=86));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 54), [8, 17], [21, 21], [86, 86]}, {[92, ∞), [64, ∞), [39, 39], [16, 53]}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -10273,8 +10271,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThanOrEqual\n" +
 			" │           ├─ comp_index_t2.v4:4\n" +
 			" │           └─ 83 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{[16, 31], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[40, 40], (NULL, 35], [51, ∞), [83, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -10311,24 +10309,24 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ GreaterThan\n" +
 			" │       ├─ comp_index_t2.v1:1\n" +
 			" │       └─ 36 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(36, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (v1 BETWEEN 33 AND 71 AND v2<=61 AND v3<=32 AND v4 BETWEEN 18 AND 73) AND (v1<3) AND (v1<=59 AND v2=47 AND v3<49 AND v4>36);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1<77 AND v2=43 AND v3<92 AND v4=13) OR (v1=38 AND v2<=46)) OR (v1 BETWEEN 10 AND 79 AND v2>=11 AND v3 BETWEEN 14 AND 14));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 10), [43, 43], (NULL, 92), [13, 13]}, {[10, 38), [11, ∞), [14, 14], [NULL, ∞)}, {[10, 38), [43, 43], (NULL, 14), [13, 13]}, {[10, 38), [43, 43], (14, 92), [13, 13]}, {[38, 38], (NULL, 46], [NULL, ∞), [NULL, ∞)}, {[38, 38], (46, ∞), [14, 14], [NULL, ∞)}, {(38, 77), [43, 43], (NULL, 14), [13, 13]}, {(38, 77), [43, 43], (14, 92), [13, 13]}, {(38, 79], [11, ∞), [14, 14], [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -10369,8 +10367,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThan\n" +
 			" │           ├─ comp_index_t2.v3:3\n" +
 			" │           └─ 66 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{[40, ∞), [25, 25], (66, ∞), [98, 98]}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -10389,16 +10387,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t2.v2:2\n" +
 			" │           └─ 39 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 98), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>=75 AND v2 BETWEEN 45 AND 51 AND v3<15) OR (v1>=74 AND v2>=37 AND v3<76));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{[74, ∞), [37, ∞), (NULL, 76), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -10418,8 +10416,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThanOrEqual\n" +
 			" │           ├─ comp_index_t2.v4:4\n" +
 			" │           └─ 37 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 32), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(32, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -10444,32 +10442,32 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ GreaterThan\n" +
 			" │       ├─ comp_index_t2.v1:1\n" +
 			" │       └─ 80 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(63, 80], [89, ∞), [43, 50], (NULL, 29)}, {(80, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>=81) OR (v1>=27 AND v2>=21 AND v3 BETWEEN 1 AND 63 AND v4>=92));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{[27, 81), [21, ∞), [1, 63], [92, ∞)}, {[81, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1>13) OR (v1>72 AND v2=2 AND v3<=40)) OR (v1>77 AND v2<21));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(13, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1<>54 AND v2>23 AND v3 BETWEEN 28 AND 48 AND v4>=37) OR (v1>93 AND v2>=51 AND v3<9 AND v4<>49)) OR (v1>=71 AND v2<>33));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 54), (23, ∞), [28, 48], [37, ∞)}, {(54, 71), (23, ∞), [28, 48], [37, ∞)}, {[71, ∞), (NULL, 33), [NULL, ∞), [NULL, ∞)}, {[71, ∞), [33, 33], [28, 48], [37, ∞)}, {[71, ∞), (33, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -10509,8 +10507,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t2.v1:1\n" +
 			" │           └─ 37 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 37), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[37, 37], (NULL, 43], [NULL, ∞), [NULL, ∞)}, {(37, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -10537,8 +10535,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t2.v1:1\n" +
 			" │           └─ 91 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 91), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(91, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -10569,16 +10567,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t2.v2:2\n" +
 			" │           └─ 31 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 79], [31, 31], [NULL, ∞), [NULL, ∞)}, {[21, 21], (NULL, 31), [39, ∞), [NULL, ∞)}, {[21, 21], (31, 50), [39, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>78) OR (v1>=9 AND v2<>84));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{[9, 78], (NULL, 84), [NULL, ∞), [NULL, ∞)}, {[9, 78], (84, ∞), [NULL, ∞), [NULL, ∞)}, {(78, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -10602,16 +10600,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │           └─ Eq\n" +
 			" │               ├─ comp_index_t2.v2:2\n" +
 			" │               └─ 63 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(16, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1=16 AND v2>=9 AND v3<>48) OR (v1>=76 AND v2<>86)) OR (v1<28 AND v2=1 AND v3<=23 AND v4 BETWEEN 13 AND 55));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 28), [1, 1], (NULL, 23], [13, 55]}, {[16, 16], [9, ∞), (NULL, 48), [NULL, ∞)}, {[16, 16], [9, ∞), (48, ∞), [NULL, ∞)}, {[76, ∞), (NULL, 86), [NULL, ∞), [NULL, ∞)}, {[76, ∞), (86, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -10631,8 +10629,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t2.v1:1\n" +
 			" │           └─ 55 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 55), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(55, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -10658,48 +10656,48 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThan\n" +
 			" │           ├─ comp_index_t2.v3:3\n" +
 			" │           └─ 20 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 72), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[72, 72], (NULL, 5), [53, 61], [NULL, ∞)}, {(72, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1=58 AND v2<=89 AND v3=78 AND v4<=58) OR (v1>39)) AND (v1<>25 AND v2>1 AND v3<18);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(39, ∞), (1, ∞), (NULL, 18), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>94) OR (v1=33 AND v2 BETWEEN 53 AND 60 AND v3 BETWEEN 37 AND 73));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{[33, 33], [53, 60], [37, 73], [NULL, ∞)}, {(94, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1=40 AND v2<>8 AND v3<=69) OR (v1<=72)) OR (v1 BETWEEN 87 AND 89 AND v2 BETWEEN 52 AND 58));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 72], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[87, 89], [52, 58], [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1<9 AND v2=97 AND v3<>54 AND v4>71) OR (v1>48 AND v2 BETWEEN 7 AND 23 AND v3<>95 AND v4>86)) OR (v1 BETWEEN 36 AND 90));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 9), [97, 97], (NULL, 54), (71, ∞)}, {(NULL, 9), [97, 97], (54, ∞), (71, ∞)}, {[36, 90], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(90, ∞), [7, 23], (NULL, 95), (86, ∞)}, {(90, ∞), [7, 23], (95, ∞), (86, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>=38 AND v2<70) OR (v1>79));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{[38, 79], (NULL, 70), [NULL, ∞), [NULL, ∞)}, {(79, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -10720,8 +10718,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ LessThan\n" +
 			" │       ├─ comp_index_t2.v1:1\n" +
 			" │       └─ 42 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 42), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -10759,32 +10757,32 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThan\n" +
 			" │           ├─ comp_index_t2.v4:4\n" +
 			" │           └─ 6 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 29), (52, ∞), (NULL, 55), [NULL, ∞)}, {(NULL, 29), (52, ∞), (55, ∞), [NULL, ∞)}, {[16, 28], [9, 52], [43, 43], (NULL, 6)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<56 AND v2<=52) OR (v1>=30 AND v2<73 AND v3>40 AND v4>=13)) AND (v1<30 AND v4<>25 AND v2<>82 AND v3 BETWEEN 80 AND 88);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 30), (NULL, 52], [80, 88], (NULL, 25)}, {(NULL, 30), (NULL, 52], [80, 88], (25, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1 BETWEEN 9 AND 53 AND v2 BETWEEN 26 AND 56) OR (v1 BETWEEN 29 AND 72 AND v2<18 AND v3=73 AND v4<=12));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{[9, 53], [26, 56], [NULL, ∞), [NULL, ∞)}, {[29, 72], (NULL, 18), [73, 73], (NULL, 12]}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>96 AND v2<27) OR (v1<82)) AND (v1>=80 AND v2 BETWEEN 14 AND 53);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{[80, 82), [14, 53], [NULL, ∞), [NULL, ∞)}, {(96, ∞), [14, 27), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -10803,8 +10801,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThan\n" +
 			" │           ├─ comp_index_t2.v4:4\n" +
 			" │           └─ 9 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{[48, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -10835,16 +10833,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThan\n" +
 			" │           ├─ comp_index_t2.v4:4\n" +
 			" │           └─ 59 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 16), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[41, 41], [79, 79], (NULL, 16), [2, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (v1>=69 AND v2 BETWEEN 38 AND 45) AND (v1<>35 AND v2<28 AND v3>14);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -10904,8 +10902,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t2.v4:4\n" +
 			" │           └─ 98 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 69), (NULL, 48], (NULL, 65), [51, ∞)}, {(NULL, 69), (NULL, 48], (65, ∞), [51, ∞)}, {[37, 57], (48, 57], (NULL, 40), [98, 98]}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -10921,8 +10919,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t2.v1:1\n" +
 			" │           └─ 60 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 60), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(60, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -10956,16 +10954,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       │           ├─ comp_index_t2.v2:2\n" +
 			" │       │           └─ 44 (tinyint)\n" +
 			" │       └─ (comp_index_t2.v3:3 BETWEEN 4 (tinyint) AND 51 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 97), (NULL, 47], [91, 91], [NULL, ∞)}, {[74, 74], (NULL, 44), [4, 51], (72, ∞)}, {[74, 74], (44, ∞), [4, 51], (72, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1 BETWEEN 26 AND 60 AND v2>53 AND v3<=9 AND v4<8) OR (v1>0 AND v2<=69));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(0, ∞), (NULL, 69], [NULL, ∞), [NULL, ∞)}, {[26, 60], (69, ∞), (NULL, 9], (NULL, 8)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -11011,24 +11009,24 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThanOrEqual\n" +
 			" │           ├─ comp_index_t2.v3:3\n" +
 			" │           └─ 64 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{[20, 33), (NULL, 7), [95, 96], [34, 41]}, {[20, 95], (7, ∞), [95, 96], [34, 41]}, {[27, 33), (NULL, 43], (NULL, 64], (NULL, 28)}, {[27, 33), (NULL, 43], (NULL, 64], (28, ∞)}, {[33, 33], (NULL, 2), (NULL, 63), [NULL, ∞)}, {[33, 33], (NULL, 2), [63, 63], (NULL, 28)}, {[33, 33], (NULL, 2), [63, 63], (28, ∞)}, {[33, 33], (NULL, 2), (63, ∞), [NULL, ∞)}, {[33, 33], [2, 7), [95, 96], [34, 41]}, {[33, 33], [2, 43], (NULL, 64], (NULL, 28)}, {[33, 33], [2, 43], (NULL, 64], (28, ∞)}, {(33, 44], (NULL, 43], (NULL, 64], (NULL, 28)}, {(33, 44], (NULL, 43], (NULL, 64], (28, ∞)}, {(33, 95], (NULL, 7), [95, 96], [34, 41]}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1 BETWEEN 13 AND 36 AND v2>40) OR (v1<>28 AND v2<29)) OR (v1 BETWEEN 36 AND 89 AND v2>=92 AND v3>39 AND v4<16)) OR (v1<=1));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 1], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(1, 28), (NULL, 29), [NULL, ∞), [NULL, ∞)}, {[13, 36], (40, ∞), [NULL, ∞), [NULL, ∞)}, {(28, ∞), (NULL, 29), [NULL, ∞), [NULL, ∞)}, {(36, 89], [92, ∞), (39, ∞), (NULL, 16)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1=68 AND v2=49) OR (v1<=35 AND v2>=59 AND v3>=88 AND v4 BETWEEN 1 AND 62));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 35], [59, ∞), [88, ∞), [1, 62]}, {[68, 68], [49, 49], [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -11051,16 +11049,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThanOrEqual\n" +
 			" │           ├─ comp_index_t2.v2:2\n" +
 			" │           └─ 41 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 23), [41, ∞), [NULL, ∞), [NULL, ∞)}, {(33, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1>=78 AND v2=26 AND v3 BETWEEN 70 AND 89) OR (v1 BETWEEN 12 AND 78 AND v2>41 AND v3 BETWEEN 2 AND 11 AND v4 BETWEEN 12 AND 97)) OR (v1>16 AND v2=85 AND v3<56 AND v4<19));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{[12, 16], (41, ∞), [2, 11], [12, 97]}, {(16, 78], (41, 85), [2, 11], [12, 97]}, {(16, 78], [85, 85], (NULL, 2), (NULL, 19)}, {(16, 78], [85, 85], [2, 11], (NULL, 97]}, {(16, 78], [85, 85], (11, 56), (NULL, 19)}, {(16, 78], (85, ∞), [2, 11], [12, 97]}, {[78, ∞), [26, 26], [70, 89], [NULL, ∞)}, {(78, ∞), [85, 85], (NULL, 56), (NULL, 19)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -11091,8 +11089,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t2.v3:3\n" +
 			" │           └─ 37 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(25, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -11134,8 +11132,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThanOrEqual\n" +
 			" │           ├─ comp_index_t2.v2:2\n" +
 			" │           └─ 51 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 1), (NULL, 1), (NULL, 34], [NULL, ∞)}, {(NULL, 1), (1, 33), (NULL, 34], [NULL, ∞)}, {(NULL, 1), [33, 33], [NULL, ∞), [NULL, ∞)}, {(NULL, 1), (33, ∞), (NULL, 34], [NULL, ∞)}, {[1, 4), (51, ∞), (NULL, 34], [NULL, ∞)}, {[1, 80], (NULL, 51], [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -11143,35 +11141,34 @@ var IndexPlanTests = []QueryPlanTest{
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1 BETWEEN 33 AND 82 AND v2<26) OR (v1>=98 AND v4>30 AND v2 BETWEEN 47 AND 67 AND v3 BETWEEN 9 AND 54)) OR (v1>=5)) AND (v1<>85 AND v4<>31);`,
 		ExpectedPlan: "Filter\n" +
-			" ├─ AND\n" +
+			" ├─ Or\n" +
 			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ (comp_index_t2.v1:1 BETWEEN 33 (tinyint) AND 82 (tinyint))\n" +
-			" │   │   │   │   └─ LessThan\n" +
-			" │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │       └─ 26 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ AND\n" +
-			" │   │   │       │   │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   │   │   └─ 98 (tinyint)\n" +
-			" │   │   │       │   │   └─ GreaterThan\n" +
-			" │   │   │       │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │       │   │       └─ 30 (tinyint)\n" +
-			" │   │   │       │   └─ (comp_index_t2.v2:2 BETWEEN 47 (tinyint) AND 67 (tinyint))\n" +
-			" │   │   │       └─ (comp_index_t2.v3:3 BETWEEN 9 (tinyint) AND 54 (tinyint))\n" +
-			" │   │   └─ GreaterThanOrEqual\n" +
-			" │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │       └─ 5 (tinyint)\n" +
-			" │   └─ NOT\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t2.v4:4\n" +
-			" │           └─ 31 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" │   │   ├─ AND\n" +
+			" │   │   │   ├─ (comp_index_t2.v1:1 BETWEEN 33 (tinyint) AND 82 (tinyint))\n" +
+			" │   │   │   └─ LessThan\n" +
+			" │   │   │       ├─ comp_index_t2.v2:2\n" +
+			" │   │   │       └─ 26 (tinyint)\n" +
+			" │   │   └─ AND\n" +
+			" │   │       ├─ AND\n" +
+			" │   │       │   ├─ AND\n" +
+			" │   │       │   │   ├─ GreaterThanOrEqual\n" +
+			" │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
+			" │   │       │   │   │   └─ 98 (tinyint)\n" +
+			" │   │       │   │   └─ GreaterThan\n" +
+			" │   │       │   │       ├─ comp_index_t2.v4:4\n" +
+			" │   │       │   │       └─ 30 (tinyint)\n" +
+			" │   │       │   └─ (comp_index_t2.v2:2 BETWEEN 47 (tinyint) AND 67 (tinyint))\n" +
+			" │   │       └─ (comp_index_t2.v3:3 BETWEEN 9 (tinyint) AND 54 (tinyint))\n" +
+			" │   └─ GreaterThanOrEqual\n" +
+			" │       ├─ comp_index_t2.v1:1\n" +
+			" │       └─ 5 (tinyint)\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{[5, 85), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(85, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			"     ├─ index condition: NOT\n" +
+			"     │   └─ Eq\n" +
+			"     │       ├─ comp_index_t2.v4:3\n" +
+			"     │       └─ 31 (tinyint)\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
@@ -11200,24 +11197,24 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThanOrEqual\n" +
 			" │           ├─ comp_index_t2.v2:2\n" +
 			" │           └─ 17 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{[18, 35), [17, ∞), [NULL, ∞), [NULL, ∞)}, {[35, 35], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(35, 70], [17, ∞), [NULL, ∞), [NULL, ∞)}, {[82, 82], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<>45 AND v2<=55 AND v3>=2 AND v4<46) OR (v1>=0 AND v2<>6));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 0), (NULL, 55], [2, ∞), (NULL, 46)}, {[0, 45), [6, 6], [2, ∞), (NULL, 46)}, {[0, ∞), (NULL, 6), [NULL, ∞), [NULL, ∞)}, {[0, ∞), (6, ∞), [NULL, ∞), [NULL, ∞)}, {(45, ∞), [6, 6], [2, ∞), (NULL, 46)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<=85 AND v2>=46 AND v3=87 AND v4>3) OR (v1=52));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 52), [46, ∞), [87, 87], (3, ∞)}, {[52, 52], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(52, 85], [46, ∞), [87, 87], (3, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -11249,8 +11246,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ LessThan\n" +
 			" │       ├─ comp_index_t2.v1:1\n" +
 			" │       └─ 41 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 90), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[90, ∞), (NULL, 17], [68, 68], [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -11275,8 +11272,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   │           ├─ comp_index_t2.v3:3\n" +
 			" │   │           └─ 23 (tinyint)\n" +
 			" │   └─ (comp_index_t2.v1:1 BETWEEN 18 (tinyint) AND 57 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{[18, 57], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -11302,8 +11299,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThan\n" +
 			" │           ├─ comp_index_t2.v4:4\n" +
 			" │           └─ 80 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{[32, 50], (NULL, 89), [39, ∞), [NULL, ∞)}, {[32, 50], (89, ∞), [39, ∞), [NULL, ∞)}, {(50, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -11336,24 +11333,24 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t2.v1:1\n" +
 			" │           └─ 76 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 76), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[76, 76], (NULL, 12], (65, ∞), (NULL, 47)}, {(76, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1 BETWEEN 29 AND 37) OR (v1<>54 AND v2<=65 AND v3<=1 AND v4<>10)) OR (v1<>55 AND v2 BETWEEN 49 AND 56 AND v3>=25 AND v4<=8));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 29), (NULL, 65], (NULL, 1], (NULL, 10)}, {(NULL, 29), (NULL, 65], (NULL, 1], (10, ∞)}, {(NULL, 29), [49, 56], [25, ∞), (NULL, 8]}, {[29, 37], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(37, 54), (NULL, 65], (NULL, 1], (NULL, 10)}, {(37, 54), (NULL, 65], (NULL, 1], (10, ∞)}, {(37, 55), [49, 56], [25, ∞), (NULL, 8]}, {(54, ∞), (NULL, 65], (NULL, 1], (NULL, 10)}, {(54, ∞), (NULL, 65], (NULL, 1], (10, ∞)}, {(55, ∞), [49, 56], [25, ∞), (NULL, 8]}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1=80 AND v2<95 AND v3>6) OR (v1 BETWEEN 7 AND 14 AND v2 BETWEEN 27 AND 49 AND v3>57 AND v4 BETWEEN 28 AND 60));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{[7, 14], [27, 49], (57, ∞), [28, 60]}, {[80, 80], (NULL, 95), (6, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -11382,8 +11379,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │           └─ Eq\n" +
 			" │               ├─ comp_index_t2.v4:4\n" +
 			" │               └─ 22 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 71], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(71, ∞), (NULL, 43), (NULL, 15), [NULL, ∞)}, {(71, ∞), (NULL, 43), (15, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -11391,31 +11388,30 @@ var IndexPlanTests = []QueryPlanTest{
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1 BETWEEN 18 AND 36 AND v4<>87 AND v2>=13) OR (v1>=63 AND v3<=89)) AND (v1<76 AND v4<49 AND v2<=96);`,
 		ExpectedPlan: "Filter\n" +
-			" ├─ AND\n" +
-			" │   ├─ Or\n" +
+			" ├─ Or\n" +
+			" │   ├─ AND\n" +
 			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ (comp_index_t2.v1:1 BETWEEN 18 (tinyint) AND 36 (tinyint))\n" +
-			" │   │   │   │   └─ NOT\n" +
-			" │   │   │   │       └─ Eq\n" +
-			" │   │   │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │   │   │           └─ 87 (tinyint)\n" +
-			" │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       └─ 13 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ GreaterThanOrEqual\n" +
-			" │   │       │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   └─ 63 (tinyint)\n" +
-			" │   │       └─ LessThanOrEqual\n" +
-			" │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │           └─ 89 (tinyint)\n" +
-			" │   └─ LessThan\n" +
-			" │       ├─ comp_index_t2.v4:4\n" +
-			" │       └─ 49 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" │   │   │   ├─ (comp_index_t2.v1:1 BETWEEN 18 (tinyint) AND 36 (tinyint))\n" +
+			" │   │   │   └─ NOT\n" +
+			" │   │   │       └─ Eq\n" +
+			" │   │   │           ├─ comp_index_t2.v4:4\n" +
+			" │   │   │           └─ 87 (tinyint)\n" +
+			" │   │   └─ GreaterThanOrEqual\n" +
+			" │   │       ├─ comp_index_t2.v2:2\n" +
+			" │   │       └─ 13 (tinyint)\n" +
+			" │   └─ AND\n" +
+			" │       ├─ GreaterThanOrEqual\n" +
+			" │       │   ├─ comp_index_t2.v1:1\n" +
+			" │       │   └─ 63 (tinyint)\n" +
+			" │       └─ LessThanOrEqual\n" +
+			" │           ├─ comp_index_t2.v3:3\n" +
+			" │           └─ 89 (tinyint)\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{[18, 36], [13, 96], [NULL, ∞), [NULL, ∞)}, {[63, 76), (NULL, 96], [NULL, ∞), [NULL, ∞)}]\n" +
+			"     ├─ index condition: LessThan\n" +
+			"     │   ├─ comp_index_t2.v4:3\n" +
+			"     │   └─ 49 (tinyint)\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
@@ -11471,8 +11467,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThanOrEqual\n" +
 			" │           ├─ comp_index_t2.v2:2\n" +
 			" │           └─ 41 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 17], [41, ∞), [NULL, ∞), [NULL, ∞)}, {[22, 22], (NULL, 16), [NULL, ∞), [NULL, ∞)}, {[22, 22], [16, 16], (NULL, 46), (76, ∞)}, {[22, 22], [16, 16], (46, ∞), (76, ∞)}, {[22, 22], (16, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -11497,8 +11493,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ LessThan\n" +
 			" │       ├─ comp_index_t2.v1:1\n" +
 			" │       └─ 73 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 73), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[73, ∞), [11, 23], [23, 23], (50, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -11524,16 +11520,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │           └─ Eq\n" +
 			" │               ├─ comp_index_t2.v2:2\n" +
 			" │               └─ 43 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{[5, 41], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(84, ∞), (NULL, 43), [NULL, ∞), [NULL, ∞)}, {(84, ∞), (43, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1=24 AND v2 BETWEEN 43 AND 84) OR (v1>=90 AND v2>1 AND v3<>70)) OR (v1>=66 AND v2<95));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{[24, 24], [43, 84], [NULL, ∞), [NULL, ∞)}, {[66, ∞), (NULL, 95), [NULL, ∞), [NULL, ∞)}, {[90, ∞), [95, ∞), (NULL, 70), [NULL, ∞)}, {[90, ∞), [95, ∞), (70, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -11586,24 +11582,24 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThan\n" +
 			" │           ├─ comp_index_t2.v3:3\n" +
 			" │           └─ 97 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 18], (NULL, 70], [NULL, ∞), [NULL, ∞)}, {(18, 58), (NULL, 32), (97, ∞), [NULL, ∞)}, {(55, 58), (52, ∞), (NULL, 70), [NULL, ∞)}, {(55, 58), (52, ∞), (70, ∞), [NULL, ∞)}, {[58, 58], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(58, ∞), (NULL, 32), (97, ∞), [NULL, ∞)}, {(58, ∞), (52, ∞), (NULL, 70), [NULL, ∞)}, {(58, ∞), (52, ∞), (70, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (v1>=9 AND v2>69) AND (v1 BETWEEN 39 AND 73);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{[39, 73], (69, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<87 AND v2 BETWEEN 2 AND 34 AND v3=87 AND v4>=76) OR (v1<>77 AND v2<=44 AND v3>34));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 77), (NULL, 44], (34, ∞), [NULL, ∞)}, {[77, 77], [2, 34], [87, 87], [76, ∞)}, {(77, ∞), (NULL, 44], (34, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -11638,40 +11634,40 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThanOrEqual\n" +
 			" │           ├─ comp_index_t2.v4:4\n" +
 			" │           └─ 61 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{[5, 5], (NULL, 69), (NULL, 15], [61, ∞)}, {[9, 9], (21, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1=22) OR (v1>55));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{[22, 22], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(55, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1 BETWEEN 47 AND 57 AND v2>=83) OR (v1=91 AND v2>34));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{[47, 57], [83, ∞), [NULL, ∞), [NULL, ∞)}, {[91, 91], (34, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (v1 BETWEEN 23 AND 25) AND (v1<98 AND v2>=20 AND v3>37);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{[23, 25], [20, ∞), (37, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1=6) OR (v1>61 AND v2<=34)) OR (v1>10 AND v2<>50 AND v3<>62 AND v4<=84));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{[6, 6], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(10, 61], (NULL, 50), (NULL, 62), (NULL, 84]}, {(10, 61], (NULL, 50), (62, ∞), (NULL, 84]}, {(10, ∞), (50, ∞), (NULL, 62), (NULL, 84]}, {(10, ∞), (50, ∞), (62, ∞), (NULL, 84]}, {(61, ∞), (NULL, 34], [NULL, ∞), [NULL, ∞)}, {(61, ∞), (34, 50), (NULL, 62), (NULL, 84]}, {(61, ∞), (34, 50), (62, ∞), (NULL, 84]}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -11692,8 +11688,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThanOrEqual\n" +
 			" │           ├─ comp_index_t2.v2:2\n" +
 			" │           └─ 91 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{[8, 74), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[74, 74], (NULL, 91], [NULL, ∞), [NULL, ∞)}, {(74, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -11724,48 +11720,48 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThanOrEqual\n" +
 			" │           ├─ comp_index_t2.v4:4\n" +
 			" │           └─ 78 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1=25) OR (v1>40 AND v2 BETWEEN 26 AND 40 AND v3<76));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{[25, 25], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(40, ∞), [26, 40], (NULL, 76), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1=13 AND v2<85) OR (v1=23 AND v2<>68 AND v3=33));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{[13, 13], (NULL, 85), [NULL, ∞), [NULL, ∞)}, {[23, 23], (NULL, 68), [33, 33], [NULL, ∞)}, {[23, 23], (68, ∞), [33, 33], [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1<42 AND v2>95 AND v3>17 AND v4<>97) OR (v1>=13 AND v2<>10 AND v3 BETWEEN 73 AND 85 AND v4=48)) OR (v1>55 AND v2=85 AND v3>30));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 42), (95, ∞), (17, ∞), (NULL, 97)}, {(NULL, 42), (95, ∞), (17, ∞), (97, ∞)}, {[13, 42), (10, 95], [73, 85], [48, 48]}, {[13, ∞), (NULL, 10), [73, 85], [48, 48]}, {[42, 55], (10, ∞), [73, 85], [48, 48]}, {(55, ∞), (10, 85), [73, 85], [48, 48]}, {(55, ∞), [85, 85], (30, ∞), [NULL, ∞)}, {(55, ∞), (85, ∞), [73, 85], [48, 48]}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1 BETWEEN 5 AND 32) OR (v1>7)) OR (v1=34));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{[5, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (v1>=34 AND v2<>61 AND v3<>3) AND (v1 BETWEEN 69 AND 93) AND (v1=36 AND v2>14);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -11784,16 +11780,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       │       ├─ comp_index_t2.v1:1\n" +
 			" │       │       └─ 74 (tinyint)\n" +
 			" │       └─ (comp_index_t2.v3:3 BETWEEN 29 (tinyint) AND 73 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (v1<>91 AND v3=27 AND v4=22 AND v2<>68) AND (v1<=88);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 88], (NULL, 68), [27, 27], [22, 22]}, {(NULL, 88], (68, ∞), [27, 27], [22, 22]}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -11849,8 +11845,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThanOrEqual\n" +
 			" │           ├─ comp_index_t2.v4:4\n" +
 			" │           └─ 14 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 4), [37, ∞), (NULL, 26], (NULL, 67)}, {(NULL, 4), [37, ∞), (NULL, 26], (67, ∞)}, {(NULL, 18), (NULL, 90), (95, ∞), [NULL, ∞)}, {(NULL, 18), (90, ∞), (95, ∞), [NULL, ∞)}, {(NULL, 36), (NULL, 15], [25, 36], (NULL, 14]}, {(18, 44), (NULL, 90), (95, ∞), [NULL, ∞)}, {(18, 44), (90, ∞), (95, ∞), [NULL, ∞)}, {[44, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -11881,16 +11877,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThanOrEqual\n" +
 			" │           ├─ comp_index_t2.v2:2\n" +
 			" │           └─ 24 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 30), (NULL, 24], [NULL, ∞), [NULL, ∞)}, {[44, 87], (NULL, 52), (NULL, 52), (NULL, 1)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1>48 AND v2<=83) OR (v1>28 AND v2 BETWEEN 9 AND 87 AND v3<>73)) OR (v1>=53 AND v2>=91 AND v3 BETWEEN 33 AND 97));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(28, 48], [9, 87], (NULL, 73), [NULL, ∞)}, {(28, 48], [9, 87], (73, ∞), [NULL, ∞)}, {(48, ∞), (NULL, 83], [NULL, ∞), [NULL, ∞)}, {(48, ∞), (83, 87], (NULL, 73), [NULL, ∞)}, {(48, ∞), (83, 87], (73, ∞), [NULL, ∞)}, {[53, ∞), [91, ∞), [33, 97], [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -11909,32 +11905,32 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t2.v1:1\n" +
 			" │           └─ 54 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 54), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[54, 54], [34, 48], [NULL, ∞), [NULL, ∞)}, {(54, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<=78 AND v2<74 AND v3<42 AND v4>=34) OR (v1<=29 AND v2<=27 AND v3>31 AND v4 BETWEEN 35 AND 41));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 29], (NULL, 27], [42, ∞), [35, 41]}, {(NULL, 78], (NULL, 74), (NULL, 42), [34, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (v1 BETWEEN 9 AND 35 AND v4<=69 AND v2 BETWEEN 34 AND 53 AND v3<>28) AND (v1 BETWEEN 12 AND 48);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{[12, 35], [34, 53], (28, ∞), (NULL, 69]}, {[12, 35], [34, 53], (NULL, 28), (NULL, 69]}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (v1 BETWEEN 13 AND 77 AND v2>75 AND v3<73 AND v4>=6) AND (v1<=58 AND v2=48 AND v3 BETWEEN 33 AND 73);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -11971,8 +11967,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThanOrEqual\n" +
 			" │           ├─ comp_index_t2.v4:4\n" +
 			" │           └─ 46 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 82), (NULL, 17], (NULL, 17), [46, ∞)}, {(47, ∞), [26, 26], (47, ∞), [51, 86]}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -12016,40 +12012,40 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThan\n" +
 			" │           ├─ comp_index_t2.v3:3\n" +
 			" │           └─ 10 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 14], (NULL, 57), (NULL, 10), [NULL, ∞)}, {[52, 82], (NULL, 47), [37, 37], [NULL, ∞)}, {[52, 82], (47, ∞), [37, 37], [NULL, ∞)}, {(82, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (v1>=99 AND v3<=41) AND (v1<>38 AND v2<94 AND v3 BETWEEN 83 AND 95 AND v4>=86);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (v1>78) AND (v1>32 AND v2>11 AND v3>=78);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(78, ∞), (11, ∞), [78, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (v1<>3 AND v2=26 AND v3=22 AND v4<=76) AND (v1 BETWEEN 59 AND 92 AND v2 BETWEEN 36 AND 80);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>10) OR (v1=12));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(10, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -12096,16 +12092,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │           └─ Eq\n" +
 			" │               ├─ comp_index_t2.v2:2\n" +
 			" │               └─ 21 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<>10 AND v2<=75 AND v3>=70) OR (v1<89 AND v2<=32));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 10), (32, 75], [70, ∞), [NULL, ∞)}, {(NULL, 89), (NULL, 32], [NULL, ∞), [NULL, ∞)}, {(10, 89), (32, 75], [70, ∞), [NULL, ∞)}, {[89, ∞), (NULL, 75], [70, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -12134,16 +12130,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t2.v3:3\n" +
 			" │           └─ 95 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{[14, 15), (NULL, 53), [95, 95], (55, ∞)}, {[15, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (v1>48 AND v2 BETWEEN 4 AND 84 AND v3<=3 AND v4<>31) AND (v1 BETWEEN 2 AND 15 AND v3>75);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -12188,8 +12184,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ AND\n" +
 			" │       ├─ (comp_index_t2.v1:1 BETWEEN 45 (tinyint) AND 65 (tinyint))\n" +
 			" │       └─ (comp_index_t2.v4:4 BETWEEN 4 (tinyint) AND 68 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 33), (77, ∞), [41, 41], [9, 9]}, {[33, 75], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(75, ∞), [48, ∞), [13, 13], (61, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -12238,24 +12234,24 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       │       ├─ comp_index_t2.v3:3\n" +
 			" │       │       └─ 55 (tinyint)\n" +
 			" │       └─ (comp_index_t2.v4:4 BETWEEN 88 (tinyint) AND 97 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1=55 AND v2<13 AND v3<=96 AND v4>=49) OR (v1 BETWEEN 39 AND 98 AND v2=77 AND v3>85));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{[39, 98], [77, 77], (85, ∞), [NULL, ∞)}, {[55, 55], (NULL, 13), (NULL, 96], [49, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1=74 AND v2<>13 AND v3<67 AND v4 BETWEEN 1 AND 70) OR (v1 BETWEEN 30 AND 50 AND v2<27 AND v3>=35));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{[30, 50], (NULL, 27), [35, ∞), [NULL, ∞)}, {[74, 74], (NULL, 13), (NULL, 67), [1, 70]}, {[74, 74], (13, ∞), (NULL, 67), [1, 70]}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -12300,16 +12296,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThan\n" +
 			" │           ├─ comp_index_t2.v4:4\n" +
 			" │           └─ 97 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{[10, 10], [47, 47], [6, 21], (97, ∞)}, {(22, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1>38 AND v2>98) OR (v1<>29 AND v2=75)) OR (v1>58 AND v2<>49 AND v3 BETWEEN 25 AND 58));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 29), [75, 75], [NULL, ∞), [NULL, ∞)}, {(29, ∞), [75, 75], [NULL, ∞), [NULL, ∞)}, {(38, ∞), (98, ∞), [NULL, ∞), [NULL, ∞)}, {(58, ∞), (NULL, 49), [25, 58], [NULL, ∞)}, {(58, ∞), (49, 75), [25, 58], [NULL, ∞)}, {(58, ∞), (75, 98], [25, 58], [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -12347,24 +12343,24 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThanOrEqual\n" +
 			" │           ├─ comp_index_t2.v4:4\n" +
 			" │           └─ 0 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 82), [8, 8], [43, ∞), [74, 74]}, {[1, 1], [54, ∞), [41, 91], [0, ∞)}, {(82, ∞), [8, 8], [43, ∞), [74, 74]}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1=26 AND v2<=94 AND v3<=76) OR (v1<34 AND v2 BETWEEN 5 AND 20));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 34), [5, 20], [NULL, ∞), [NULL, ∞)}, {[26, 26], (NULL, 5), (NULL, 76], [NULL, ∞)}, {[26, 26], (20, 94], (NULL, 76], [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>74 AND v2<=3 AND v3>51 AND v4<1) OR (v1>=92 AND v2<=2));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(74, 92), (NULL, 3], (51, ∞), (NULL, 1)}, {[92, ∞), (NULL, 2], [NULL, ∞), [NULL, ∞)}, {[92, ∞), (2, 3], (51, ∞), (NULL, 1)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -12434,24 +12430,24 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThanOrEqual\n" +
 			" │           ├─ comp_index_t2.v3:3\n" +
 			" │           └─ 47 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 3], (NULL, 4), (NULL, 8), (NULL, 54)}, {(NULL, 7), [4, ∞), (NULL, 47], [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<35) OR (v1>=5 AND v2>=10 AND v3=65));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 35), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[35, ∞), [10, ∞), [65, 65], [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<>31 AND v2<=37 AND v3>56 AND v4 BETWEEN 10 AND 31) OR (v1>8)) AND (v1>=27 AND v2<>44);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{[27, ∞), (NULL, 44), [NULL, ∞), [NULL, ∞)}, {[27, ∞), (44, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -12482,8 +12478,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThan\n" +
 			" │           ├─ comp_index_t2.v3:3\n" +
 			" │           └─ 33 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 21), (NULL, 61), [13, 13], [NULL, ∞)}, {(52, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -12526,16 +12522,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ LessThan\n" +
 			" │       ├─ comp_index_t2.v1:1\n" +
 			" │       └─ 32 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 9), (NULL, 95), [54, 54], (NULL, 31)}, {(NULL, 9), (NULL, 95), [54, 54], (31, ∞)}, {(NULL, 9), (95, ∞), [54, 54], (NULL, 31)}, {(NULL, 9), (95, ∞), [54, 54], (31, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<=48) OR (v1 BETWEEN 2 AND 81));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 81], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -12572,8 +12568,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       │       ├─ comp_index_t2.v2:2\n" +
 			" │       │       └─ 3 (tinyint)\n" +
 			" │       └─ (comp_index_t2.v3:3 BETWEEN 1 (tinyint) AND 74 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 36), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[36, 36], (NULL, 3], [1, 74], [NULL, ∞)}, {[36, 36], [23, 39], [NULL, ∞), [NULL, ∞)}, {(36, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -12607,8 +12603,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ GreaterThanOrEqual\n" +
 			" │       ├─ comp_index_t2.v1:1\n" +
 			" │       └─ 42 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{[2, 23], [34, ∞), [NULL, ∞), [NULL, ∞)}, {(30, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -12660,48 +12656,48 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThanOrEqual\n" +
 			" │           ├─ comp_index_t2.v3:3\n" +
 			" │           └─ 15 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 27), (NULL, 81), (NULL, 34), (NULL, 33)}, {(NULL, 27), (NULL, 81), (NULL, 34), (33, ∞)}, {(NULL, 27), [79, 81), [34, ∞), [34, 99]}, {(NULL, 27), [81, ∞), [9, ∞), [34, 99]}, {[27, 27], (NULL, 79), [34, ∞), [20, 41]}, {[27, 27], (NULL, 81), (NULL, 15), (NULL, 33)}, {[27, 27], (NULL, 81), (NULL, 15), (33, ∞)}, {[27, 27], (NULL, 81), [15, 34), (NULL, ∞)}, {[27, 27], [79, 81), [34, ∞), [20, 99]}, {[27, 27], [81, 98), [9, 15), [34, 99]}, {[27, 27], [81, 98), [15, ∞), [20, 99]}, {[27, 27], [98, ∞), [9, ∞), [34, 99]}, {(27, 68), (NULL, 81), (NULL, 34), (NULL, 33)}, {(27, 68), (NULL, 81), (NULL, 34), (33, ∞)}, {(27, 68), [79, 81), [34, ∞), [34, 99]}, {(27, 68), [81, ∞), [9, ∞), [34, 99]}, {[68, 78], [79, ∞), [9, ∞), [34, 99]}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1<23 AND v2<=45 AND v3<0) OR (v1>=31)) OR (v1>=50));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 23), (NULL, 45], (NULL, 0), [NULL, ∞)}, {[31, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<16) OR (v1>=19 AND v2<25 AND v3>77));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 16), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[19, ∞), (NULL, 25), (77, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<29 AND v2 BETWEEN 81 AND 92) OR (v1>20 AND v2>=53 AND v3 BETWEEN 20 AND 68));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 29), [81, 92], [NULL, ∞), [NULL, ∞)}, {(20, 29), [53, 81), [20, 68], [NULL, ∞)}, {(20, 29), (92, ∞), [20, 68], [NULL, ∞)}, {[29, ∞), [53, ∞), [20, 68], [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1 BETWEEN 25 AND 59 AND v2=1 AND v3<93 AND v4<=16) OR (v1<40 AND v2 BETWEEN 14 AND 37 AND v3>62 AND v4<58)) OR (v1<>17 AND v2<>36)) OR (v1 BETWEEN 7 AND 99 AND v2<>6 AND v3=43 AND v4<89));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 17), (NULL, 36), [NULL, ∞), [NULL, ∞)}, {(NULL, 17), [36, 36], (62, ∞), (NULL, 58)}, {(NULL, 17), (36, ∞), [NULL, ∞), [NULL, ∞)}, {[7, 17), [36, 36], [43, 43], (NULL, 89)}, {[17, 17], (NULL, 6), [43, 43], (NULL, 89)}, {[17, 17], (6, ∞), [43, 43], (NULL, 89)}, {[17, 17], [14, 37], (62, ∞), (NULL, 58)}, {(17, 40), [36, 36], (62, ∞), (NULL, 58)}, {(17, 99], [36, 36], [43, 43], (NULL, 89)}, {(17, ∞), (NULL, 36), [NULL, ∞), [NULL, ∞)}, {(17, ∞), (36, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (v1=46) AND (v1>=93 AND v3<>51 AND v4=93 AND v2=8);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -12755,8 +12751,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ LessThan\n" +
 			" │       ├─ comp_index_t2.v1:1\n" +
 			" │       └─ 79 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 79), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[79, ∞), (NULL, 39), [70, ∞), (NULL, 24)}, {[79, ∞), (NULL, 39), [70, ∞), (24, ∞)}, {[79, ∞), (39, ∞), [70, ∞), (NULL, 24)}, {[79, ∞), (39, ∞), [70, ∞), (24, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -12802,16 +12798,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThan\n" +
 			" │           ├─ comp_index_t2.v3:3\n" +
 			" │           └─ 69 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 44], (NULL, 42], [NULL, ∞), [NULL, ∞)}, {(NULL, 44], (42, ∞), (NULL, 69), [NULL, ∞)}, {(44, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<=29 AND v2 BETWEEN 50 AND 86 AND v3<=6 AND v4 BETWEEN 8 AND 48) OR (v1>86 AND v2 BETWEEN 62 AND 70 AND v3=33));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 29], [50, 86], (NULL, 6], [8, 48]}, {(86, ∞), [62, 70], [33, 33], [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -12838,8 +12834,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       │       ├─ comp_index_t2.v3:3\n" +
 			" │       │       └─ 50 (tinyint)\n" +
 			" │       └─ (comp_index_t2.v4:4 BETWEEN 15 (tinyint) AND 54 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{[15, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -12898,32 +12894,32 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThanOrEqual\n" +
 			" │           ├─ comp_index_t2.v4:4\n" +
 			" │           └─ 18 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 88], [76, ∞), (NULL, 40), (NULL, 18]}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1=27) OR (v1>=11 AND v2<97 AND v3<97 AND v4<44));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{[11, 27), (NULL, 97), (NULL, 97), (NULL, 44)}, {[27, 27], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(27, ∞), (NULL, 97), (NULL, 97), (NULL, 44)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<=89 AND v2<=93) OR (v1<=54));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 54], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(54, 89], (NULL, 93], [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1=74 AND v2<=31) OR (v1<11)) OR (v1 BETWEEN 26 AND 38));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 11), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[26, 38], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[74, 74], (NULL, 31], [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -12954,16 +12950,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t2.v4:4\n" +
 			" │           └─ 37 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{[10, 99), (NULL, 12), [54, 54], (89, ∞)}, {[99, 99], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(99, ∞), (NULL, 12), [54, 54], (89, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1<=50 AND v2<50) OR (v1<19)) OR (v1=51));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 19), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[19, 50], (NULL, 50), [NULL, ∞), [NULL, ∞)}, {[51, 51], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -12994,16 +12990,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThan\n" +
 			" │           ├─ comp_index_t2.v2:2\n" +
 			" │           └─ 49 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 1], (49, ∞), [NULL, ∞), [NULL, ∞)}, {[62, 62], [19, 89), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (v1<=61 AND v2<=64) AND (v1>=0);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{[0, 61], (NULL, 64], [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -13028,24 +13024,24 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t2.v3:3\n" +
 			" │           └─ 63 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 5), [25, ∞), [63, 63], (NULL, 14)}, {[5, 69], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1=21 AND v2<>0 AND v3<49) OR (v1<=70 AND v2>16 AND v3<=89 AND v4>=27)) OR (v1>=14));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 14), (16, ∞), (NULL, 89], [27, ∞)}, {[14, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>14) OR (v1>=82));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(14, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -13072,8 +13068,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThan\n" +
 			" │           ├─ comp_index_t2.v2:2\n" +
 			" │           └─ 99 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 19), (99, ∞), [NULL, ∞), [NULL, ∞)}, {[19, 19], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(19, 36], (99, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -13097,8 +13093,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThanOrEqual\n" +
 			" │           ├─ comp_index_t2.v2:2\n" +
 			" │           └─ 66 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(43, ∞), [83, 97], [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -13140,16 +13136,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThanOrEqual\n" +
 			" │           ├─ comp_index_t2.v4:4\n" +
 			" │           └─ 14 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 31), (NULL, 96], (NULL, 20], (NULL, 14]}, {(31, ∞), (NULL, 96], (NULL, 20], (NULL, 14]}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1 BETWEEN 52 AND 55) OR (v1>1 AND v2>36 AND v3<=47)) OR (v1 BETWEEN 0 AND 38 AND v2<=49 AND v3>=8));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{[0, 1], (NULL, 49], [8, ∞), [NULL, ∞)}, {(1, 38], (NULL, 36], [8, ∞), [NULL, ∞)}, {(1, 38], (36, 49], (NULL, ∞), [NULL, ∞)}, {(1, 38], (49, ∞), (NULL, 47], [NULL, ∞)}, {(38, 52), (36, ∞), (NULL, 47], [NULL, ∞)}, {[52, 55], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(55, ∞), (36, ∞), (NULL, 47], [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -13190,16 +13186,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThan\n" +
 			" │           ├─ comp_index_t2.v4:4\n" +
 			" │           └─ 79 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 11], [41, ∞), [9, 9], (NULL, 24)}, {(48, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (v1=23 AND v4>=52 AND v2>=61) AND (v1<>85 AND v3>2 AND v4<15);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -13234,16 +13230,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThan\n" +
 			" │           ├─ comp_index_t2.v4:4\n" +
 			" │           └─ 55 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{[32, 51], [46, 46], [31, ∞), [5, 14]}, {[32, ∞), (NULL, 26], (52, ∞), (55, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>=16 AND v2<59 AND v3<=43) OR (v1=17 AND v2<=4 AND v3>71));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{[16, ∞), (NULL, 59), (NULL, 43], [NULL, ∞)}, {[17, 17], (NULL, 4], (71, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -13292,8 +13288,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       │       ├─ comp_index_t2.v3:3\n" +
 			" │       │       └─ 30 (tinyint)\n" +
 			" │       └─ (comp_index_t2.v4:4 BETWEEN 92 (tinyint) AND 93 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{[20, 28), (96, ∞), (NULL, 28), [NULL, ∞)}, {[20, 28), (96, ∞), (28, ∞), [NULL, ∞)}, {[28, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -13340,8 +13336,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t2.v2:2\n" +
 			" │           └─ 56 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 54), (48, ∞), [NULL, ∞), [NULL, ∞)}, {[54, ∞), [56, 56], [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -13372,16 +13368,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t2.v2:2\n" +
 			" │           └─ 39 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 76), [39, 39], (NULL, 59], (NULL, 36]}, {[79, 79], (24, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<=15 AND v2 BETWEEN 21 AND 76 AND v3=23) OR (v1 BETWEEN 2 AND 55));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 2), [21, 76], [23, 23], [NULL, ∞)}, {[2, 55], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -13414,8 +13410,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ GreaterThanOrEqual\n" +
 			" │       ├─ comp_index_t2.v1:1\n" +
 			" │       └─ 57 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(56, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -13469,8 +13465,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThan\n" +
 			" │           ├─ comp_index_t2.v4:4\n" +
 			" │           └─ 39 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 5], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(5, 11), [75, ∞), [NULL, ∞), [NULL, ∞)}, {[11, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -13521,31 +13517,30 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ Eq\n" +
 			" │           ├─ comp_index_t2.v1:1\n" +
 			" │           └─ 82 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 82), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[82, 82], [34, 39], [34, 71], [15, ∞)}, {[82, 82], [46, 46], [4, 4], [NULL, ∞)}, {(82, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (v1<=50 AND v3>=51 AND v4<>69) AND (v1>1 AND v3<24);`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ AND\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(1, 50], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ index condition: AND\n" +
 			" │   ├─ AND\n" +
 			" │   │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │   ├─ comp_index_t2.v3:3\n" +
+			" │   │   │   ├─ comp_index_t2.v3:2\n" +
 			" │   │   │   └─ 51 (tinyint)\n" +
 			" │   │   └─ NOT\n" +
 			" │   │       └─ Eq\n" +
-			" │   │           ├─ comp_index_t2.v4:4\n" +
+			" │   │           ├─ comp_index_t2.v4:3\n" +
 			" │   │           └─ 69 (tinyint)\n" +
 			" │   └─ LessThan\n" +
-			" │       ├─ comp_index_t2.v3:3\n" +
+			" │       ├─ comp_index_t2.v3:2\n" +
 			" │       └─ 24 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(1, 50], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
@@ -13580,56 +13575,56 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   └─ LessThan\n" +
 			" │       ├─ comp_index_t2.v1:1\n" +
 			" │       └─ 82 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{[66, 82), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[82, ∞), [72, 72], (NULL, 31), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1=84 AND v2<85 AND v3 BETWEEN 75 AND 86 AND v4<=34) OR (v1>=37 AND v2<59 AND v3 BETWEEN 2 AND 26 AND v4>6));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{[37, ∞), (NULL, 59), [2, 26], (6, ∞)}, {[84, 84], (NULL, 85), [75, 86], (NULL, 34]}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>10 AND v2=42) OR (v1>=85 AND v2<>6 AND v3=34 AND v4<=45));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(10, ∞), [42, 42], [NULL, ∞), [NULL, ∞)}, {[85, ∞), (NULL, 6), [34, 34], (NULL, 45]}, {[85, ∞), (6, 42), [34, 34], (NULL, 45]}, {[85, ∞), (42, ∞), [34, 34], (NULL, 45]}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1=24 AND v2<>33 AND v3=77 AND v4<>63) OR (v1<>22 AND v2<=58 AND v3>71 AND v4>=87)) OR (v1<=85 AND v2>18 AND v3<=40));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 22), (NULL, 58], (71, ∞), [87, ∞)}, {(NULL, 85], (18, ∞), (NULL, 40], [NULL, ∞)}, {(22, 24), (NULL, 58], (71, ∞), [87, ∞)}, {[24, 24], (NULL, 33), (71, 77), [87, ∞)}, {[24, 24], (NULL, 33), [77, 77], (NULL, 63)}, {[24, 24], (NULL, 33), [77, 77], (63, ∞)}, {[24, 24], (NULL, 33), (77, ∞), [87, ∞)}, {[24, 24], [33, 33], (71, ∞), [87, ∞)}, {[24, 24], (33, 58], (71, 77), [87, ∞)}, {[24, 24], (33, 58], (77, ∞), [87, ∞)}, {[24, 24], (33, ∞), [77, 77], (NULL, 63)}, {[24, 24], (33, ∞), [77, 77], (63, ∞)}, {(24, ∞), (NULL, 58], (71, ∞), [87, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<72 AND v2>=67) OR (v1<>88 AND v2<>23 AND v3=23));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 72), (23, 67), [23, 23], [NULL, ∞)}, {(NULL, 72), [67, ∞), [NULL, ∞), [NULL, ∞)}, {(NULL, 88), (NULL, 23), [23, 23], [NULL, ∞)}, {[72, 88), (23, ∞), [23, 23], [NULL, ∞)}, {(88, ∞), (NULL, 23), [23, 23], [NULL, ∞)}, {(88, ∞), (23, ∞), [23, 23], [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1=11 AND v2>=99) OR (v1<18 AND v2>=34 AND v3<53)) OR (v1>68));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 11), [34, ∞), (NULL, 53), [NULL, ∞)}, {[11, 11], [34, 99), (NULL, 53), [NULL, ∞)}, {[11, 11], [99, ∞), [NULL, ∞), [NULL, ∞)}, {(11, 18), [34, ∞), (NULL, 53), [NULL, ∞)}, {(68, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1<=40 AND v2<0) OR (v1>=35 AND v2<=95 AND v3<>61)) OR (v1>49));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 40], (NULL, 0), [NULL, ∞), [NULL, ∞)}, {[35, 40], [0, 95], (NULL, 61), [NULL, ∞)}, {[35, 40], [0, 95], (61, ∞), [NULL, ∞)}, {(40, 49], (NULL, 95], (NULL, 61), [NULL, ∞)}, {(40, 49], (NULL, 95], (61, ∞), [NULL, ∞)}, {(49, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -13660,8 +13655,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   │               ├─ comp_index_t2.v4:4\n" +
 			" │   │               └─ 43 (tinyint)\n" +
 			" │   └─ (comp_index_t2.v1:1 BETWEEN 15 (tinyint) AND 67 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{[37, 55], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -13703,8 +13698,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │           └─ Eq\n" +
 			" │               ├─ comp_index_t2.v3:3\n" +
 			" │               └─ 53 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{[52, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -13738,8 +13733,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │           └─ Eq\n" +
 			" │               ├─ comp_index_t2.v4:4\n" +
 			" │               └─ 91 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(5, ∞), [62, ∞), [NULL, ∞), [NULL, ∞)}, {[91, ∞), [28, 62), [83, ∞), (NULL, 91)}, {[91, ∞), [28, 62), [83, ∞), (91, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -13763,16 +13758,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThan\n" +
 			" │           ├─ comp_index_t2.v3:3\n" +
 			" │           └─ 74 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 87), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(87, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (v1 BETWEEN 1 AND 19 AND v2 BETWEEN 22 AND 48) AND (v1 BETWEEN 6 AND 47 AND v2>=25 AND v3<27);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{[6, 19], [25, 48], (NULL, 27), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -13835,16 +13830,16 @@ var IndexPlanTests = []QueryPlanTest{
 			" │           └─ Eq\n" +
 			" │               ├─ comp_index_t2.v4:4\n" +
 			" │               └─ 84 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 95], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>42 AND v2=44 AND v3<>73) OR (v1>24 AND v2>49 AND v3>=7));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(24, ∞), (49, ∞), [7, ∞), [NULL, ∞)}, {(42, ∞), [44, 44], (NULL, 73), [NULL, ∞)}, {(42, ∞), [44, 44], (73, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -13871,8 +13866,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThan\n" +
 			" │           ├─ comp_index_t2.v2:2\n" +
 			" │           └─ 66 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 63), (NULL, 66), [NULL, ∞), [NULL, ∞)}, {[79, 79], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -13905,8 +13900,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThan\n" +
 			" │           ├─ comp_index_t2.v4:4\n" +
 			" │           └─ 75 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 66), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[66, 66], (53, ∞), (NULL, 73), (NULL, 75)}, {(66, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -13931,8 +13926,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       │       ├─ comp_index_t2.v4:4\n" +
 			" │       │       └─ 98 (tinyint)\n" +
 			" │       └─ (comp_index_t2.v2:2 BETWEEN 70 (tinyint) AND 85 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{[15, 15], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(36, ∞), [70, 85], [13, 13], (NULL, 98]}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -13958,8 +13953,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   │       │           └─ 6 (tinyint)\n" +
 			" │   │       └─ (comp_index_t2.v3:3 BETWEEN 30 (tinyint) AND 53 (tinyint))\n" +
 			" │   └─ (comp_index_t2.v1:1 BETWEEN 41 (tinyint) AND 95 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{[23, 95], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(95, ∞), (NULL, 6), [30, 53], [NULL, ∞)}, {(95, ∞), (6, ∞), [30, 53], [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -13997,8 +13992,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ LessThanOrEqual\n" +
 			" │           ├─ comp_index_t2.v3:3\n" +
 			" │           └─ 3 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{(NULL, 21), [17, ∞), (NULL, 3], [NULL, ∞)}, {(6, ∞), (NULL, 77), [81, ∞), (NULL, 9)}, {(6, ∞), (NULL, 77), [81, ∞), (9, ∞)}, {(6, ∞), (77, ∞), [81, ∞), (NULL, 9)}, {(6, ∞), (77, ∞), [81, ∞), (9, ∞)}, {(21, ∞), [17, ∞), (NULL, 3], [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -14039,56 +14034,56 @@ var IndexPlanTests = []QueryPlanTest{
 			" │       └─ GreaterThan\n" +
 			" │           ├─ comp_index_t2.v3:3\n" +
 			" │           └─ 23 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t2)\n" +
+			"     ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			"     ├─ static: [{[50, 97], (NULL, 12), (23, ∞), [NULL, ∞)}, {[94, 97], (4, 12), (NULL, 23], (NULL, 59]}, {[94, 97], [12, ∞), (NULL, 94), (NULL, 59]}, {(97, 99], (4, ∞), (NULL, 94), (NULL, 59]}]\n" +
 			"     └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1<>19 AND v2>46 AND v3=26 AND v4>=47) OR (v1>18 AND v2<=79 AND v3=45 AND v4<=7)) OR (v1 BETWEEN 2 AND 21 AND v2>32));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 2), (46, ∞), [26, 26], [47, ∞)}, {[2, 21], (32, ∞), [NULL, ∞), [NULL, ∞)}, {(18, 21], (NULL, 32], [45, 45], (NULL, 7]}, {(21, ∞), (NULL, 79], [45, 45], (NULL, 7]}, {(21, ∞), (46, ∞), [26, 26], [47, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (v1>=5) AND (v1=50 AND v2<=50);`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{[50, 50], (NULL, 50], [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>=82 AND v2 BETWEEN 34 AND 50 AND v3<26 AND v4 BETWEEN 48 AND 76) OR (v1<=6));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 6], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[82, ∞), [34, 50], (NULL, 26), [48, 76]}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>29) OR (v1<>94 AND v2>=56 AND v3=14));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 29], [56, ∞), [14, 14], [NULL, ∞)}, {(29, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1>8 AND v2<97 AND v3=51 AND v4<=26) OR (v1>87)) OR (v1<10 AND v2<=45 AND v3>=73));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 10), (NULL, 45], [73, ∞), [NULL, ∞)}, {(8, 87], (NULL, 97), [51, 51], (NULL, 26]}, {(87, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1<>15 AND v2>1) OR (v1<46)) OR (v1>47 AND v2>=9 AND v3 BETWEEN 39 AND 87 AND v4>=10));`,
-		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
-			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.comp_index_t2)\n" +
+			" ├─ index: v_idx [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
 			" ├─ static: [{(NULL, 46), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[46, ∞), (1, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
@@ -14099,8 +14094,8 @@ var IndexPlanTests = []QueryPlanTest{
 			" ├─ Eq\n" +
 			" │   ├─ comp_index_t3.v1:1\n" +
 			" │   └─ a (longtext)\n" +
-			" └─ IndexedTableAccess(comp_index_t3)\n" +
-			"     ├─ index: [comp_index_t3.v1]\n" +
+			" └─ IndexedTableAccess(mydb.comp_index_t3)\n" +
+			"     ├─ index: v_idx [comp_index_t3.v1]\n" +
 			"     ├─ static: [{[a, a]}]\n" +
 			"     └─ columns: [pk v1 v2]\n" +
 			"",
@@ -14110,7 +14105,7 @@ var IndexPlanTests = []QueryPlanTest{
 		ExpectedPlan: "Filter\n" +
 			" ├─ comp_index_t3.v1 LIKE 'a%'\n" +
 			" └─ Table\n" +
-			"     ├─ name: comp_index_t3\n" +
+			"     ├─ name: mydb.comp_index_t3\n" +
 			"     └─ columns: [pk v1 v2]\n" +
 			"",
 	},
@@ -14121,7 +14116,7 @@ var IndexPlanTests = []QueryPlanTest{
 			" │   ├─ comp_index_t3.v2:2\n" +
 			" │   └─ a (longtext)\n" +
 			" └─ Table\n" +
-			"     ├─ name: comp_index_t3\n" +
+			"     ├─ name: mydb.comp_index_t3\n" +
 			"     └─ columns: [pk v1 v2]\n" +
 			"",
 	},
@@ -14130,8 +14125,50 @@ var IndexPlanTests = []QueryPlanTest{
 		ExpectedPlan: "Filter\n" +
 			" ├─ comp_index_t3.v2 LIKE 'a%'\n" +
 			" └─ Table\n" +
-			"     ├─ name: comp_index_t3\n" +
+			"     ├─ name: mydb.comp_index_t3\n" +
+			"     └─ columns: [pk v1 v2]\n" +
+			"",
+	},
+	{
+		Query: `select * from desc_index_t0 order by v1 desc, v2 asc`,
+		ExpectedPlan: "IndexedTableAccess(mydb.desc_index_t0)\n" +
+			" ├─ index: v1_desc [desc_index_t0.v1,desc_index_t0.v2]\n" +
+			" ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			" └─ columns: [pk v1 v2]\n" +
+			"",
+	},
+	{
+		Query: `select * from desc_index_t0 order by v1 asc, v2 desc`,
+		ExpectedPlan: "IndexedTableAccess(mydb.desc_index_t0)\n" +
+			" ├─ index: v1_desc [desc_index_t0.v1,desc_index_t0.v2]\n" +
+			" ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ reverse: true\n" +
+			" └─ columns: [pk v1 v2]\n" +
+			"",
+	},
+	{
+		Query: `select * from desc_index_t0 order by v1 desc`,
+		ExpectedPlan: "IndexedTableAccess(mydb.desc_index_t0)\n" +
+			" ├─ index: v1_desc [desc_index_t0.v1,desc_index_t0.v2]\n" +
+			" ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			" └─ columns: [pk v1 v2]\n" +
+			"",
+	},
+	{
+		Query: `select * from desc_index_t0 order by v1 desc, v2 desc`,
+		ExpectedPlan: "Sort(desc_index_t0.v1:1 DESC nullsFirst, desc_index_t0.v2:2 DESC nullsFirst)\n" +
+			" └─ Table\n" +
+			"     ├─ name: mydb.desc_index_t0\n" +
 			"     └─ columns: [pk v1 v2]\n" +
 			"",
 	},
+	{
+		Query: `select * from desc_index_t0 order by pk desc`,
+		ExpectedPlan: "IndexedTableAccess(mydb.desc_index_t0)\n" +
+			" ├─ index: `PRIMARY` [desc_index_t0.pk]\n" +
+			" ├─ static: [{[NULL, ∞)}]\n" +
+			" ├─ reverse: true\n" +
+			" └─ columns: [pk v1 v2]\n" +
+			"",
+	},
 }
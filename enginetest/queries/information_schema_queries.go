@@ -15,6 +15,9 @@
 package queries
 
 import (
+	"strings"
+	"time"
+
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/types"
 )
@@ -96,6 +99,7 @@ var InfoSchemaQueries = []QueryTest{
 			{"table_constraints"},
 			{"table_constraints_extensions"},
 			{"table_privileges"},
+			{"table_stats"},
 			{"triggers"},
 			{"user_attributes"},
 			{"user_privileges"},
@@ -168,8 +172,8 @@ var InfoSchemaQueries = []QueryTest{
 	{
 		Query: `SHOW FULL COLUMNS FROM mytable`,
 		Expected: []sql.Row{
-			{"i", "bigint", nil, "NO", "PRI", "NULL", "", "", ""},
-			{"s", "varchar(20)", "utf8mb4_0900_bin", "NO", "UNI", "NULL", "", "", "column s"},
+			{"i", "bigint", nil, "NO", "PRI", "NULL", "", "insert,references,select,update", ""},
+			{"s", "varchar(20)", "utf8mb4_0900_bin", "NO", "UNI", "NULL", "", "insert,references,select,update", "column s"},
 		},
 	},
 	{
@@ -259,23 +263,23 @@ var InfoSchemaQueries = []QueryTest{
 	{
 		Query: `SHOW INDEXES FROM mytaBLE`,
 		Expected: []sql.Row{
-			{"mytable", 0, "PRIMARY", 1, "i", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
-			{"mytable", 0, "mytable_s", 1, "s", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
-			{"mytable", 1, "mytable_i_s", 1, "i", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
-			{"mytable", 1, "mytable_i_s", 2, "s", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
-			{"mytable", 1, "idx_si", 1, "s", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
-			{"mytable", 1, "idx_si", 2, "i", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
+			{"mytable", 0, "PRIMARY", 1, "i", "A", 3, nil, nil, "", "BTREE", "", "", "YES", nil},
+			{"mytable", 0, "mytable_s", 1, "s", "A", 3, nil, nil, "", "BTREE", "", "", "YES", nil},
+			{"mytable", 1, "mytable_i_s", 1, "i", "A", nil, nil, nil, "", "BTREE", "", "", "YES", nil},
+			{"mytable", 1, "mytable_i_s", 2, "s", "A", nil, nil, nil, "", "BTREE", "", "", "YES", nil},
+			{"mytable", 1, "idx_si", 1, "s", "A", nil, nil, nil, "", "BTREE", "", "", "YES", nil},
+			{"mytable", 1, "idx_si", 2, "i", "A", nil, nil, nil, "", "BTREE", "", "", "YES", nil},
 		},
 	},
 	{
 		Query: `SHOW KEYS FROM mytaBLE`,
 		Expected: []sql.Row{
-			{"mytable", 0, "PRIMARY", 1, "i", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
-			{"mytable", 0, "mytable_s", 1, "s", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
-			{"mytable", 1, "mytable_i_s", 1, "i", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
-			{"mytable", 1, "mytable_i_s", 2, "s", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
-			{"mytable", 1, "idx_si", 1, "s", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
-			{"mytable", 1, "idx_si", 2, "i", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
+			{"mytable", 0, "PRIMARY", 1, "i", "A", 3, nil, nil, "", "BTREE", "", "", "YES", nil},
+			{"mytable", 0, "mytable_s", 1, "s", "A", 3, nil, nil, "", "BTREE", "", "", "YES", nil},
+			{"mytable", 1, "mytable_i_s", 1, "i", "A", nil, nil, nil, "", "BTREE", "", "", "YES", nil},
+			{"mytable", 1, "mytable_i_s", 2, "s", "A", nil, nil, nil, "", "BTREE", "", "", "YES", nil},
+			{"mytable", 1, "idx_si", 1, "s", "A", nil, nil, nil, "", "BTREE", "", "", "YES", nil},
+			{"mytable", 1, "idx_si", 2, "i", "A", nil, nil, nil, "", "BTREE", "", "", "YES", nil},
 		},
 	},
 	{
@@ -285,9 +289,9 @@ var InfoSchemaQueries = []QueryTest{
 				"  `i` bigint NOT NULL,\n" +
 				"  `s` varchar(20) NOT NULL COMMENT 'column s',\n" +
 				"  PRIMARY KEY (`i`),\n" +
+				"  UNIQUE KEY `mytable_s` (`s`),\n" +
 				"  KEY `idx_si` (`s`,`i`),\n" +
-				"  KEY `mytable_i_s` (`i`,`s`),\n" +
-				"  UNIQUE KEY `mytable_s` (`s`)\n" +
+				"  KEY `mytable_i_s` (`i`,`s`)\n" +
 				") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"},
 		},
 	},
@@ -296,8 +300,8 @@ var InfoSchemaQueries = []QueryTest{
 		Expected: []sql.Row{
 			{"fk_tbl", "CREATE TABLE `fk_tbl` (\n" +
 				"  `pk` bigint NOT NULL,\n" +
-				"  `a` bigint,\n" +
-				"  `b` varchar(20),\n" +
+				"  `a` bigint DEFAULT NULL,\n" +
+				"  `b` varchar(20) DEFAULT NULL,\n" +
 				"  PRIMARY KEY (`pk`),\n" +
 				"  KEY `ab` (`a`,`b`),\n" +
 				"  CONSTRAINT `fk1` FOREIGN KEY (`a`,`b`) REFERENCES `mytable` (`i`,`s`) ON DELETE CASCADE\n" +
@@ -616,7 +620,7 @@ FROM INFORMATION_SCHEMA.STATISTICS WHERE TABLE_SCHEMA = 'mydb' AND INDEX_NAME='P
 	},
 	{
 		Query:    "SELECT * FROM information_schema.schemata_extensions",
-		Expected: []sql.Row{{"def", "information_schema", ""}, {"def", "foo", ""}, {"def", "mydb", ""}},
+		Expected: []sql.Row{{"def", "information_schema", ""}, {"def", "foo", ""}, {"def", "mydb", ""}, {"def", "performance_schema", ""}, {"def", "sys", ""}},
 	},
 	{
 		Query:    `SELECT * FROM information_schema.columns_extensions where table_name = 'mytable'`,
@@ -809,8 +813,8 @@ FROM INFORMATION_SCHEMA.TRIGGERS WHERE trigger_schema = 'mydb'`,
 			{
 				Query: "SELECT * FROM information_schema.statistics where table_name='t'",
 				Expected: []sql.Row{
-					{"def", "mydb", "t", 1, "mydb", "myindex", 1, "test_score", "A", 0, nil, nil, "YES", "BTREE", "", "", "YES", nil},
-					{"def", "mydb", "t", 0, "mydb", "PRIMARY", 1, "pk", "A", 0, nil, nil, "", "BTREE", "", "", "YES", nil},
+					{"def", "mydb", "t", 1, "mydb", "myindex", 1, "test_score", "A", nil, nil, nil, "YES", "BTREE", "", "", "YES", nil},
+					{"def", "mydb", "t", 0, "mydb", "PRIMARY", 1, "pk", "A", 2, nil, nil, "", "BTREE", "", "", "YES", nil},
 				},
 			},
 		},
@@ -1058,10 +1062,14 @@ FROM INFORMATION_SCHEMA.TRIGGERS WHERE trigger_schema = 'mydb'`,
 				},
 			},
 			{
-				// TODO: cardinality not supported
-				Skip:     true,
-				Query:    `select index_name, seq_in_index, column_name, cardinality, sub_part from information_schema.statistics where table_schema = 'mydb' and table_name = 'ptable' ORDER BY INDEX_NAME`,
-				Expected: []sql.Row{{2}, {2}, {2}, {2}, {2}},
+				Query: `select index_name, seq_in_index, column_name, cardinality, sub_part from information_schema.statistics where table_schema = 'mydb' and table_name = 'ptable' ORDER BY INDEX_NAME`,
+				Expected: []sql.Row{
+					{"b", 1, "b", 3, 4},
+					{"b_and_c", 1, "b", nil, 5},
+					{"b_and_c", 2, "c", nil, 6},
+					{"c", 1, "c", 3, 3},
+					{"PRIMARY", 1, "i", 3, nil},
+				},
 			},
 			{
 				Query: `SELECT seq_in_index, sub_part, index_name, index_type, CASE non_unique WHEN 0 THEN 'TRUE' ELSE 'FALSE' END AS is_unique, column_name
@@ -1076,6 +1084,124 @@ FROM INFORMATION_SCHEMA.TRIGGERS WHERE trigger_schema = 'mydb'`,
 			},
 		},
 	},
+	{
+		Name: "SHOW INDEX and information_schema.statistics over every index flavor",
+		SetUpScript: []string{
+			`create table flavors (
+				pk int primary key,
+				u int,
+				a int,
+				b int,
+				s varchar(20),
+				d int,
+				unique key u_idx (u),
+				key ab_idx (a, b) comment 'covers a and b',
+				key s_prefix (s(3)),
+				key lower_s ((lower(s))),
+				key d_desc (d desc)
+			)`,
+			"insert into flavors values (1, 10, 1, 1, 'abc', 5), (2, 20, 1, 2, 'abd', 5), (3, 30, 2, 1, null, 6), (4, null, 2, 2, 'xyz', null)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "show index from flavors",
+				Expected: []sql.Row{
+					{"flavors", 0, "PRIMARY", 1, "pk", "A", 4, nil, nil, "", "BTREE", "", "", "YES", nil},
+					{"flavors", 0, "u_idx", 1, "u", "A", 4, nil, nil, "YES", "BTREE", "", "", "YES", nil},
+					{"flavors", 1, "ab_idx", 1, "a", "A", nil, nil, nil, "YES", "BTREE", "", "covers a and b", "YES", nil},
+					{"flavors", 1, "ab_idx", 2, "b", "A", nil, nil, nil, "YES", "BTREE", "", "covers a and b", "YES", nil},
+					{"flavors", 1, "s_prefix", 1, "s", "A", nil, int64(3), nil, "YES", "BTREE", "", "", "YES", nil},
+					{"flavors", 1, "lower_s", 1, nil, "A", nil, nil, nil, "", "BTREE", "", "", "YES", "lower(s)"},
+					{"flavors", 1, "d_desc", 1, "d", "D", nil, nil, nil, "YES", "BTREE", "", "", "YES", nil},
+				},
+			},
+			{
+				Query:    "analyze table flavors",
+				Expected: []sql.Row{{"flavors", "analyze", "status", "OK"}},
+			},
+			{
+				Query: "show index from flavors",
+				Expected: []sql.Row{
+					{"flavors", 0, "PRIMARY", 1, "pk", "A", 4, nil, nil, "", "BTREE", "", "", "YES", nil},
+					{"flavors", 0, "u_idx", 1, "u", "A", 4, nil, nil, "YES", "BTREE", "", "", "YES", nil},
+					{"flavors", 1, "ab_idx", 1, "a", "A", 2, nil, nil, "YES", "BTREE", "", "covers a and b", "YES", nil},
+					{"flavors", 1, "ab_idx", 2, "b", "A", 4, nil, nil, "YES", "BTREE", "", "covers a and b", "YES", nil},
+					{"flavors", 1, "s_prefix", 1, "s", "A", nil, int64(3), nil, "YES", "BTREE", "", "", "YES", nil},
+					{"flavors", 1, "lower_s", 1, nil, "A", nil, nil, nil, "", "BTREE", "", "", "YES", "lower(s)"},
+					{"flavors", 1, "d_desc", 1, "d", "D", 3, nil, nil, "YES", "BTREE", "", "", "YES", nil},
+				},
+			},
+			{
+				Query: "select * from information_schema.statistics where table_name = 'flavors' order by index_name, seq_in_index",
+				Expected: []sql.Row{
+					{"def", "mydb", "flavors", 1, "mydb", "ab_idx", 1, "a", "A", 2, nil, nil, "YES", "BTREE", "", "covers a and b", "YES", nil},
+					{"def", "mydb", "flavors", 1, "mydb", "ab_idx", 2, "b", "A", 4, nil, nil, "YES", "BTREE", "", "covers a and b", "YES", nil},
+					{"def", "mydb", "flavors", 1, "mydb", "d_desc", 1, "d", "D", 3, nil, nil, "YES", "BTREE", "", "", "YES", nil},
+					{"def", "mydb", "flavors", 1, "mydb", "lower_s", 1, nil, "A", nil, nil, nil, "", "BTREE", "", "", "YES", "lower(s)"},
+					{"def", "mydb", "flavors", 0, "mydb", "PRIMARY", 1, "pk", "A", 4, nil, nil, "", "BTREE", "", "", "YES", nil},
+					{"def", "mydb", "flavors", 1, "mydb", "s_prefix", 1, "s", "A", nil, 3, nil, "YES", "BTREE", "", "", "YES", nil},
+					{"def", "mydb", "flavors", 0, "mydb", "u_idx", 1, "u", "A", 4, nil, nil, "YES", "BTREE", "", "", "YES", nil},
+				},
+			},
+		},
+	},
+	{
+		Name: "SHOW INDEX over unique, non-unique and composite indexes",
+		SetUpScript: []string{
+			`create table keyed (
+				pk int primary key,
+				a int,
+				b varchar(20) not null,
+				c int not null,
+				key abc_idx (a, b, c),
+				unique key c_idx (c),
+				key b_idx (b),
+				unique key ab_idx (a, b)
+			)`,
+			"insert into keyed values (1, 1, 'a', 1), (2, 1, 'b', 2), (3, 2, 'a', 3)",
+			"create database otherdb",
+			"create table otherdb.keyed (x int, key x_idx (x))",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "show index from keyed",
+				Expected: []sql.Row{
+					{"keyed", 0, "PRIMARY", 1, "pk", "A", 3, nil, nil, "", "BTREE", "", "", "YES", nil},
+					{"keyed", 0, "c_idx", 1, "c", "A", 3, nil, nil, "", "BTREE", "", "", "YES", nil},
+					{"keyed", 0, "ab_idx", 1, "a", "A", nil, nil, nil, "YES", "BTREE", "", "", "YES", nil},
+					{"keyed", 0, "ab_idx", 2, "b", "A", 3, nil, nil, "", "BTREE", "", "", "YES", nil},
+					{"keyed", 1, "abc_idx", 1, "a", "A", nil, nil, nil, "YES", "BTREE", "", "", "YES", nil},
+					{"keyed", 1, "abc_idx", 2, "b", "A", nil, nil, nil, "", "BTREE", "", "", "YES", nil},
+					{"keyed", 1, "abc_idx", 3, "c", "A", nil, nil, nil, "", "BTREE", "", "", "YES", nil},
+					{"keyed", 1, "b_idx", 1, "b", "A", nil, nil, nil, "", "BTREE", "", "", "YES", nil},
+				},
+			},
+			{
+				Query: "show keys from keyed where key_name = 'abc_idx'",
+				Expected: []sql.Row{
+					{"keyed", 1, "abc_idx", 1, "a", "A", nil, nil, nil, "YES", "BTREE", "", "", "YES", nil},
+					{"keyed", 1, "abc_idx", 2, "b", "A", nil, nil, nil, "", "BTREE", "", "", "YES", nil},
+					{"keyed", 1, "abc_idx", 3, "c", "A", nil, nil, nil, "", "BTREE", "", "", "YES", nil},
+				},
+			},
+			{
+				Query: "show indexes in keyed where seq_in_index > 1 and non_unique = 0",
+				Expected: []sql.Row{
+					{"keyed", 0, "ab_idx", 2, "b", "A", 3, nil, nil, "", "BTREE", "", "", "YES", nil},
+				},
+			},
+			{
+				Query: "show index from keyed from otherdb",
+				Expected: []sql.Row{
+					{"keyed", 1, "x_idx", 1, "x", "A", 0, nil, nil, "YES", "BTREE", "", "", "YES", nil},
+				},
+			},
+			{
+				Query:    "show index from keyed in otherdb where column_name = 'pk'",
+				Expected: []sql.Row{},
+			},
+		},
+	},
 	{
 		Name: "column specific tests on information_schema.columns table",
 		SetUpScript: []string{
@@ -1385,6 +1511,46 @@ from information_schema.routines where routine_schema = 'mydb' and routine_type
 			},
 		},
 	},
+	{
+		Name: "table size estimates in SHOW TABLE STATUS and information_schema.tables",
+		SetUpScript: []string{
+			"create table sizes (id int primary key auto_increment, name varchar(100), key name_idx (name))",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "show table status like 'sizes'",
+				Expected: []sql.Row{
+					{"sizes", "InnoDB", "10", "Fixed", uint64(0), uint64(0), uint64(0), uint64(0), int64(0), int64(0), int64(1), time.Unix(0, 0).UTC(), nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
+				},
+			},
+			{
+				Query:    "select table_rows, avg_row_length, data_length, index_length, update_time is null from information_schema.tables where table_name = 'sizes'",
+				Expected: []sql.Row{{uint64(0), uint64(0), uint64(0), uint64(0), true}},
+			},
+			{
+				Query:    "insert into sizes (name) values ('a'), ('bcd')",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 2, InsertID: 1}}},
+			},
+			{
+				Query: "show table status like 'sizes'",
+				Expected: []sql.Row{
+					{"sizes", "InnoDB", "10", "Fixed", uint64(2), uint64(6), uint64(12), uint64(0), int64(4), int64(0), int64(3), time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC(), nil, "utf8mb4_0900_bin", nil, nil, nil},
+				},
+			},
+			{
+				Query:    "select table_rows, avg_row_length, data_length, index_length, auto_increment, update_time is null from information_schema.tables where table_name = 'sizes'",
+				Expected: []sql.Row{{uint64(2), uint64(6), uint64(12), uint64(4), uint64(3), false}},
+			},
+			{
+				Query:    "truncate table sizes",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select table_rows, avg_row_length, data_length, index_length, update_time is null from information_schema.tables where table_name = 'sizes'",
+				Expected: []sql.Row{{uint64(0), uint64(0), uint64(0), uint64(0), false}},
+			},
+		},
+	},
 	{
 		Name: "information_schema.views has definer and security information",
 		SetUpScript: []string{
@@ -1451,6 +1617,205 @@ from information_schema.routines where routine_schema = 'mydb' and routine_type
 			},
 		},
 	},
+	{
+		Name: "sys schema views",
+		SetUpScript: []string{
+			"CREATE TABLE sys_stats (i int primary key, j int)",
+			"INSERT INTO sys_stats VALUES (1, 1), (2, 2), (3, 3)",
+			"UPDATE sys_stats SET j = 10 WHERE i = 1",
+			"DELETE FROM sys_stats WHERE i = 3",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "SELECT table_name, table_type, engine FROM information_schema.tables WHERE table_schema = 'sys' ORDER BY 1",
+				Expected: []sql.Row{
+					{"processlist", "VIEW", nil},
+					{"schema_table_statistics", "VIEW", nil},
+					{"session", "VIEW", nil},
+					{"statements_with_errors_or_warnings", "VIEW", nil},
+				},
+			},
+			{
+				Query: "SELECT table_name, count(*) FROM information_schema.columns WHERE table_schema = 'sys' GROUP BY 1 ORDER BY 1",
+				Expected: []sql.Row{
+					{"processlist", 28},
+					{"schema_table_statistics", 19},
+					{"session", 28},
+					{"statements_with_errors_or_warnings", 10},
+				},
+			},
+			{
+				Query: "SELECT column_name, column_type FROM information_schema.columns WHERE table_schema = 'sys' AND table_name = 'processlist' AND ordinal_position <= 8 ORDER BY ordinal_position",
+				Expected: []sql.Row{
+					{"thd_id", "bigint unsigned"},
+					{"conn_id", "bigint unsigned"},
+					{"user", "varchar(288)"},
+					{"db", "varchar(64)"},
+					{"command", "varchar(16)"},
+					{"state", "varchar(64)"},
+					{"time", "bigint"},
+					{"current_statement", "longtext"},
+				},
+			},
+			{
+				// The test engine doesn't track its processes
+				Query:    "SELECT * FROM sys.processlist",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT * FROM sys.session",
+				Expected: []sql.Row{},
+			},
+			{
+				Query: "SELECT * FROM sys.schema_table_statistics WHERE table_schema = 'mydb' AND table_name = 'sys_stats'",
+				Expected: []sql.Row{
+					{"mydb", "sys_stats", nil, nil, nil, uint64(3), nil, uint64(1), nil, uint64(1), nil, nil, nil, nil, nil, nil, nil, nil, nil},
+				},
+			},
+			{
+				Query:    "SELECT * FROM sys.statements_with_errors_or_warnings",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT sys.format_bytes(512), format_bytes(1024), sys.format_bytes(5 * 1024 * 1024 * 1024), format_bytes(NULL)",
+				Expected: []sql.Row{{" 512 bytes", "1.00 KiB", "5.00 GiB", nil}},
+			},
+			{
+				Query:    "SELECT sys.format_time(500), sys.format_time(3501), sys.format_time(188732396662000), format_pico_time(188732396662000), sys.format_time(NULL)",
+				Expected: []sql.Row{{"500 ps", "3.50 ns", "3.15 m", "3.15 min", nil}},
+			},
+			{
+				Query:    "USE sys",
+				Expected: []sql.Row{},
+			},
+			{
+				Query: "SHOW TABLES",
+				Expected: []sql.Row{
+					{"processlist"},
+					{"schema_table_statistics"},
+					{"session"},
+					{"statements_with_errors_or_warnings"},
+				},
+			},
+		},
+	},
+	{
+		Name: "column comments",
+		SetUpScript: []string{
+			"create table commented (pk int primary key comment 'the key', v varchar(10) comment 'it''s a value', w int)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "SHOW FULL COLUMNS FROM commented",
+				Expected: []sql.Row{
+					{"pk", "int", nil, "NO", "PRI", "NULL", "", "insert,references,select,update", "the key"},
+					{"v", "varchar(10)", "utf8mb4_0900_bin", "YES", "", "NULL", "", "insert,references,select,update", "it's a value"},
+					{"w", "int", nil, "YES", "", "NULL", "", "insert,references,select,update", ""},
+				},
+			},
+			{
+				Query: "SELECT column_name, column_comment FROM information_schema.columns WHERE table_name = 'commented' ORDER BY ordinal_position",
+				Expected: []sql.Row{
+					{"pk", "the key"},
+					{"v", "it's a value"},
+					{"w", ""},
+				},
+			},
+			{
+				Query:    "ALTER TABLE commented ADD COLUMN x int COMMENT 'added'",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "ALTER TABLE commented MODIFY COLUMN w bigint COMMENT 'modified'",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "ALTER TABLE commented CHANGE COLUMN v vv varchar(10) COMMENT 'changed'",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "ALTER TABLE commented MODIFY COLUMN pk int",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query: "SHOW FULL COLUMNS FROM commented",
+				Expected: []sql.Row{
+					{"pk", "int", nil, "NO", "PRI", "NULL", "", "insert,references,select,update", ""},
+					{"vv", "varchar(10)", "utf8mb4_0900_bin", "YES", "", "NULL", "", "insert,references,select,update", "changed"},
+					{"w", "bigint", nil, "YES", "", "NULL", "", "insert,references,select,update", "modified"},
+					{"x", "int", nil, "YES", "", "NULL", "", "insert,references,select,update", "added"},
+				},
+			},
+			{
+				Query: "SELECT column_name, column_comment FROM information_schema.columns WHERE table_name = 'commented' ORDER BY ordinal_position",
+				Expected: []sql.Row{
+					{"pk", ""},
+					{"vv", "changed"},
+					{"w", "modified"},
+					{"x", "added"},
+				},
+			},
+			{
+				Query:       "ALTER TABLE commented ADD COLUMN y int COMMENT '" + strings.Repeat("a", 1025) + "'",
+				ExpectedErr: sql.ErrTooLongFieldComment,
+			},
+			{
+				Query:    "ALTER TABLE commented ADD COLUMN y int COMMENT '" + strings.Repeat("é", 1024) + "'",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+		},
+	},
+	{
+		Name: "SHOW COLUMNS filtered by a pattern and a condition",
+		SetUpScript: []string{
+			"CREATE TABLE filtered (id int primary key, user_id int, user_name varchar(20), created_at date, score bigint)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "SHOW COLUMNS FROM filtered LIKE 'user%'",
+				Expected: []sql.Row{
+					{"user_id", "int", "YES", "", "NULL", ""},
+					{"user_name", "varchar(20)", "YES", "", "NULL", ""},
+				},
+			},
+			{
+				Query: "SHOW COLUMNS FROM filtered LIKE 'USER\\_ID'",
+				Expected: []sql.Row{
+					{"user_id", "int", "YES", "", "NULL", ""},
+				},
+			},
+			{
+				Query:    "SHOW COLUMNS FROM filtered LIKE 'nothing%'",
+				Expected: []sql.Row{},
+			},
+			{
+				Query: "SHOW COLUMNS FROM filtered WHERE Type LIKE 'int%'",
+				Expected: []sql.Row{
+					{"id", "int", "NO", "PRI", "NULL", ""},
+					{"user_id", "int", "YES", "", "NULL", ""},
+				},
+			},
+			{
+				Query: "SHOW FIELDS FROM filtered WHERE `Null` = 'YES' AND Type NOT LIKE '%int'",
+				Expected: []sql.Row{
+					{"user_name", "varchar(20)", "YES", "", "NULL", ""},
+					{"created_at", "date", "YES", "", "NULL", ""},
+				},
+			},
+			{
+				Query: "SHOW FULL COLUMNS FROM filtered LIKE '%_at'",
+				Expected: []sql.Row{
+					{"created_at", "date", nil, "YES", "", "NULL", "", "insert,references,select,update", ""},
+				},
+			},
+			{
+				Query: "SHOW FULL COLUMNS FROM filtered WHERE Collation IS NOT NULL",
+				Expected: []sql.Row{
+					{"user_name", "varchar(20)", "utf8mb4_0900_bin", "YES", "", "NULL", "", "insert,references,select,update", ""},
+				},
+			},
+		},
+	},
 }
 
 var SkippedInfoSchemaScripts = []ScriptTest{
@@ -1553,6 +1918,50 @@ var StatisticsQueries = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "STATS_AUTO_RECALC and the freshness of statistics",
+		SetUpScript: []string{
+			"CREATE TABLE norecalc (i int primary key) STATS_AUTO_RECALC=0",
+			"CREATE TABLE recalc (i int primary key) STATS_AUTO_RECALC = 1",
+			"CREATE TABLE t (i int primary key)",
+			"INSERT INTO norecalc VALUES (1), (2)",
+			"INSERT INTO t VALUES (1), (2), (3), (4), (5), (6), (7), (8), (9), (10)",
+			"ANALYZE TABLE norecalc, t",
+			"INSERT INTO norecalc VALUES (3), (4), (5)",
+			"UPDATE t SET i = 11 WHERE i = 10",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SHOW CREATE TABLE norecalc",
+				Expected: []sql.Row{{"norecalc", "CREATE TABLE `norecalc` (\n  `i` int NOT NULL,\n  PRIMARY KEY (`i`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin STATS_AUTO_RECALC=0"}},
+			},
+			{
+				Query:    "SHOW CREATE TABLE recalc",
+				Expected: []sql.Row{{"recalc", "CREATE TABLE `recalc` (\n  `i` int NOT NULL,\n  PRIMARY KEY (`i`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin STATS_AUTO_RECALC=1"}},
+			},
+			{
+				Query:    "SHOW CREATE TABLE t",
+				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n  `i` int NOT NULL,\n  PRIMARY KEY (`i`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+			},
+			{
+				// reading the tables doesn't recalculate their statistics: norecalc has automatic recalculation disabled,
+				// and only 10% of the rows of t changed
+				Query:    "SELECT COUNT(*) FROM norecalc JOIN t ON norecalc.i = t.i",
+				Expected: []sql.Row{{5}},
+			},
+			{
+				Query: "SELECT table_name, n_rows, modified_counter, stats_auto_recalc FROM information_schema.table_stats ORDER BY 1",
+				Expected: []sql.Row{
+					{"norecalc", uint64(2), uint64(3), "0"},
+					{"t", uint64(10), uint64(1), "DEFAULT"},
+				},
+			},
+			{
+				Query:       "CREATE TABLE bad (i int) STATS_AUTO_RECALC=2",
+				ExpectedErr: sql.ErrInvalidArgumentDetails,
+			},
+		},
+	},
 	{
 		Query: `
 		SELECT
@@ -20,6 +20,7 @@ import (
 	"github.com/dolthub/vitess/go/mysql"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
 	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
@@ -846,6 +847,111 @@ var InsertScripts = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "INSERT with a row alias in ON DUPLICATE KEY UPDATE",
+		SetUpScript: []string{
+			"create table t (pk int primary key, a int, b varchar(10))",
+			"create table v (pk int primary key, a int, b varchar(10))",
+			"insert into t values (1, 1, 'one'), (2, 2, 'two')",
+			"insert into v values (1, 1, 'one'), (2, 2, 'two')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "insert into t (pk, a, b) values (1, 10, 'ten') as new on duplicate key update a = new.a + a, b = new.b",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:    "insert into v (pk, a, b) values (1, 10, 'ten') on duplicate key update a = values(a) + a, b = values(b)",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:    "insert into t (pk, a, b) values (2, 20, 'twenty'), (3, 30, 'thirty') as `new` on duplicate key update b = concat(t.b, '-', `new`.b)",
+				Expected: []sql.Row{{types.NewOkResult(3)}},
+			},
+			{
+				Query:    "insert into v (pk, a, b) values (2, 20, 'twenty'), (3, 30, 'thirty') on duplicate key update b = concat(v.b, '-', values(b))",
+				Expected: []sql.Row{{types.NewOkResult(3)}},
+			},
+			{
+				Query:    "select * from t order by pk",
+				Expected: []sql.Row{{1, 11, "ten"}, {2, 2, "two-twenty"}, {3, 30, "thirty"}},
+			},
+			{
+				Query:    "select * from v order by pk",
+				Expected: []sql.Row{{1, 11, "ten"}, {2, 2, "two-twenty"}, {3, 30, "thirty"}},
+			},
+		},
+	},
+	{
+		Name: "INSERT with a row alias and column aliases",
+		SetUpScript: []string{
+			"create table t (pk int primary key, a int, b int)",
+			"insert into t values (1, 1, 1)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "insert into t (pk, a, b) values (1, 5, 6) as new(p, x, y) on duplicate key update a = x, b = new.y + b",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:    "select * from t",
+				Expected: []sql.Row{{1, 5, 7}},
+			},
+			{
+				Query:    "insert into t (pk, a, b) values (2, 2, 2) as new",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "select * from t order by pk",
+				Expected: []sql.Row{{1, 5, 7}, {2, 2, 2}},
+			},
+			{
+				Query:    "insert into t values (1, 3, 4) as new(p, x, y) on duplicate key update a = x, b = new.y + t.b",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:    "insert into t values (3, 3, 3), (2, 4, 4) as new(p, x, y) on duplicate key update b = new.y * 10",
+				Expected: []sql.Row{{types.NewOkResult(3)}},
+			},
+			{
+				Query:    "select * from t order by pk",
+				Expected: []sql.Row{{1, 3, 11}, {2, 2, 40}, {3, 3, 3}},
+			},
+			{
+				Query:       "insert into t values (3, 3, 3) as new(p, x) on duplicate key update a = x",
+				ExpectedErr: sql.ErrSyntaxError,
+			},
+			{
+				Query:       "insert into t values (3, 3) as new(p, x) on duplicate key update a = x",
+				ExpectedErr: plan.ErrInsertIntoMismatchValueCount,
+			},
+		},
+	},
+	{
+		Name: "INSERT ... SET with a row alias",
+		SetUpScript: []string{
+			"create table t (pk int primary key, a int, b varchar(20))",
+			"insert into t values (1, 1, 'one')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "insert into t set pk = 1, a = 10, b = 'ten' as new on duplicate key update a = new.a + t.a, b = concat(t.b, new.b)",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:    "insert into t set pk = 1, a = (select 5 as five), b = 'five' as n(p, x, y) on duplicate key update a = x, b = n.y",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:    "insert into t set t.pk = 2, a = 2, b = 'AS two' AS n",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "select * from t order by pk",
+				Expected: []sql.Row{{1, 5, "five"}, {2, 2, "AS two"}},
+			},
+		},
+	},
 	{
 		Name: "INSERT zero date DATETIME NOT NULL is valid",
 		SetUpScript: []string{
@@ -1868,6 +1974,60 @@ var InsertScripts = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "insert on duplicate key update with a row conflicting on more than one unique key",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int PRIMARY KEY, u1 int, u2 int, v int, UNIQUE KEY k1 (u1), UNIQUE KEY k2 (u2));",
+			"INSERT INTO t VALUES (1, 10, 100, 0), (2, 20, 200, 0);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				// The primary key is checked first, so only the row with pk 1 is updated
+				Query:    "INSERT INTO t VALUES (1, 20, 300, 5) ON DUPLICATE KEY UPDATE v = v + 1;",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:    "SELECT * FROM t ORDER BY pk;",
+				Expected: []sql.Row{{1, 10, 100, 1}, {2, 20, 200, 0}},
+			},
+			{
+				// Then the unique keys, in order, so only the row conflicting on k1 is updated
+				Query:    "INSERT INTO t VALUES (3, 20, 100, 5) ON DUPLICATE KEY UPDATE v = v + 10;",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:    "SELECT * FROM t ORDER BY pk;",
+				Expected: []sql.Row{{1, 10, 100, 1}, {2, 20, 200, 10}},
+			},
+			{
+				// Updating the row conflicting on k1 with the value of another row's k2 is a duplicate
+				Query:       "INSERT INTO t VALUES (3, 10, 200, 5) ON DUPLICATE KEY UPDATE u2 = VALUES(u2);",
+				ExpectedErr: sql.ErrUniqueKeyViolation,
+			},
+			{
+				Query:    "SELECT * FROM t ORDER BY pk;",
+				Expected: []sql.Row{{1, 10, 100, 1}, {2, 20, 200, 10}},
+			},
+			{
+				// Each inserted row updates at most one existing row
+				Query:    "INSERT INTO t VALUES (1, 20, 0, 0), (2, 10, 0, 0), (4, 40, 400, 0) ON DUPLICATE KEY UPDATE v = v + 100;",
+				Expected: []sql.Row{{types.NewOkResult(5)}},
+			},
+			{
+				Query:    "SELECT * FROM t ORDER BY pk;",
+				Expected: []sql.Row{{1, 10, 100, 101}, {2, 20, 200, 110}, {4, 40, 400, 0}},
+			},
+			{
+				// A row inserted earlier in the statement can be updated by a later one
+				Query:    "INSERT INTO t VALUES (5, 50, 500, 0), (6, 50, 600, 0) ON DUPLICATE KEY UPDATE v = v + 1;",
+				Expected: []sql.Row{{types.NewOkResult(3)}},
+			},
+			{
+				Query:    "SELECT * FROM t ORDER BY pk;",
+				Expected: []sql.Row{{1, 10, 100, 101}, {2, 20, 200, 110}, {4, 40, 400, 0}, {5, 50, 500, 1}},
+			},
+		},
+	},
 }
 
 var InsertDuplicateKeyKeyless = []ScriptTest{
@@ -2378,6 +2538,62 @@ var InsertIgnoreScripts = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "INSERT IGNORE skips the violating rows of a batch and keeps the rest",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int PRIMARY KEY, u int UNIQUE, v int NOT NULL, CHECK (v < 100))",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:           "INSERT IGNORE INTO t VALUES (1, 10, 1), (2, 10, 2), (3, 30, 3)",
+				Expected:        []sql.Row{{types.NewOkResult(2)}},
+				ExpectedWarning: mysql.ERDupEntry,
+			},
+			{
+				Query:    "SHOW WARNINGS",
+				Expected: []sql.Row{{"Warning", 1062, "duplicate unique key given: [10]"}},
+			},
+			{
+				// the duplicate is an earlier row of the same statement
+				Query:           "INSERT IGNORE INTO t VALUES (4, 40, 4), (4, 41, 5), (5, 50, 6)",
+				Expected:        []sql.Row{{types.NewOkResult(2)}},
+				ExpectedWarning: mysql.ERDupEntry,
+			},
+			{
+				// NULL in a NOT NULL column is replaced by the column type's zero value rather than skipped
+				Query:           "INSERT IGNORE INTO t VALUES (6, 60, NULL)",
+				Expected:        []sql.Row{{types.NewOkResult(1)}},
+				ExpectedWarning: mysql.ERBadNullError,
+			},
+			{
+				Query:                 "INSERT IGNORE INTO t VALUES (7, 70, 200), (8, 80, 8), (9, 90, 300)",
+				Expected:              []sql.Row{{types.NewOkResult(1)}},
+				ExpectedWarning:       mysql.ERUnknownError,
+				ExpectedWarningsCount: 2,
+			},
+			{
+				Query:    "SELECT * FROM t ORDER BY pk",
+				Expected: []sql.Row{{1, 10, 1}, {3, 30, 3}, {4, 40, 4}, {5, 50, 6}, {6, 60, 0}, {8, 80, 8}},
+			},
+			{
+				Query:    "START TRANSACTION",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:           "INSERT IGNORE INTO t SELECT pk + 10, IF(pk % 2 = 0, u, u + 1), v FROM t",
+				Expected:        []sql.Row{{types.NewOkResult(3)}},
+				ExpectedWarning: mysql.ERDupEntry,
+			},
+			{
+				Query:    "COMMIT",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT pk, u FROM t WHERE pk > 10 ORDER BY pk",
+				Expected: []sql.Row{{11, 11}, {13, 31}, {15, 51}},
+			},
+		},
+	},
 }
 
 var IgnoreWithDuplicateUniqueKeyKeylessScripts = []ScriptTest{
@@ -0,0 +1,177 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queries
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// LowerCaseTableNamesTest is a script run with the lower_case_table_names system variable set to Mode.
+type LowerCaseTableNamesTest struct {
+	Mode int64
+	ScriptTest
+}
+
+var LowerCaseTableNamesTests = []LowerCaseTableNamesTest{
+	{
+		Mode: 0,
+		ScriptTest: ScriptTest{
+			Name: "lower_case_table_names = 0 stores and compares table names as given",
+			SetUpScript: []string{
+				"create table MyTable (i int primary key)",
+				"insert into MyTable values (1)",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "select * from MyTable",
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query:       "select * from mytable",
+					ExpectedErr: sql.ErrTableNotFound,
+				},
+				{
+					Query:    "show tables like '%table'",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "show tables like '%Table'",
+					Expected: []sql.Row{{"MyTable"}},
+				},
+				{
+					Query:    "create table mytable (j int primary key)",
+					Expected: []sql.Row{{types.NewOkResult(0)}},
+				},
+				{
+					Query:    "insert into mytable values (2)",
+					Expected: []sql.Row{{types.NewOkResult(1)}},
+				},
+				{
+					Query:    "select * from mytable",
+					Expected: []sql.Row{{2}},
+				},
+				{
+					Query:    "show tables like '%able'",
+					Expected: []sql.Row{{"MyTable"}, {"mytable"}},
+				},
+				{
+					Query:    "drop table mytable",
+					Expected: []sql.Row{{types.NewOkResult(0)}},
+				},
+				{
+					Query:       "drop table MYTABLE",
+					ExpectedErr: sql.ErrTableNotFound,
+				},
+				{
+					Query:    "drop table MyTable",
+					Expected: []sql.Row{{types.NewOkResult(0)}},
+				},
+				{
+					Query:    "show tables like '%able'",
+					Expected: []sql.Row{},
+				},
+			},
+		},
+	},
+	{
+		Mode: 1,
+		ScriptTest: ScriptTest{
+			Name: "lower_case_table_names = 1 stores table names in lower case and compares them case-insensitively",
+			SetUpScript: []string{
+				"create table MyTable (i int primary key)",
+				"insert into MyTable values (1)",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "select * from mytable",
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query:    "select * from MYTABLE",
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query:    "show tables like '%table'",
+					Expected: []sql.Row{{"mytable"}},
+				},
+				{
+					Query:       "create table mytable (j int primary key)",
+					ExpectedErr: sql.ErrTableAlreadyExists,
+				},
+				{
+					Query:    "rename table mytable to OtherTable",
+					Expected: []sql.Row{{types.NewOkResult(0)}},
+				},
+				{
+					Query:    "show tables like '%table'",
+					Expected: []sql.Row{{"othertable"}},
+				},
+				{
+					Query:    "drop table OTHERTABLE",
+					Expected: []sql.Row{{types.NewOkResult(0)}},
+				},
+				{
+					Query:    "show tables like '%table'",
+					Expected: []sql.Row{},
+				},
+			},
+		},
+	},
+	{
+		Mode: 2,
+		ScriptTest: ScriptTest{
+			Name: "lower_case_table_names = 2 stores table names as given and compares them case-insensitively",
+			SetUpScript: []string{
+				"create table MyTable (i int primary key)",
+				"insert into MyTable values (1)",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "select * from mytable",
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query:    "select * from MYTABLE",
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query:    "show tables like '%table'",
+					Expected: []sql.Row{{"MyTable"}},
+				},
+				{
+					Query:       "create table mytable (j int primary key)",
+					ExpectedErr: sql.ErrTableAlreadyExists,
+				},
+				{
+					Query:    "rename table mytable to OtherTable",
+					Expected: []sql.Row{{types.NewOkResult(0)}},
+				},
+				{
+					Query:    "show tables like '%table'",
+					Expected: []sql.Row{{"OtherTable"}},
+				},
+				{
+					Query:    "drop table othertable",
+					Expected: []sql.Row{{types.NewOkResult(0)}},
+				},
+				{
+					Query:    "show tables like '%table'",
+					Expected: []sql.Row{},
+				},
+			},
+		},
+	},
+}
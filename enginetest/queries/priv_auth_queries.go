@@ -1432,6 +1432,8 @@ var UserPrivTests = []UserPrivilegeTest{
 					{"information_schema"},
 					{"mydb"},
 					{"mysql"},
+					{"performance_schema"},
+					{"sys"},
 				},
 			},
 		},
@@ -1669,6 +1671,50 @@ var UserPrivTests = []UserPrivilegeTest{
 			},
 		},
 	},
+	{
+		Name: "SHOW FULL COLUMNS shows the privileges of the current user",
+		SetUpScript: []string{
+			"CREATE TABLE test (pk BIGINT PRIMARY KEY, s VARCHAR(20) COLLATE utf8mb4_unicode_ci COMMENT 'a string');",
+			"CREATE USER tester@localhost;",
+			"GRANT SELECT ON mydb.* TO tester@localhost;",
+			"GRANT INSERT ON mydb.test TO tester@localhost;",
+		},
+		Assertions: []UserPrivilegeTestAssertion{
+			{
+				User:  "tester",
+				Host:  "localhost",
+				Query: "SHOW FULL COLUMNS FROM mydb.test",
+				Expected: []sql.Row{
+					{"pk", "bigint", nil, "NO", "PRI", "NULL", "", "insert,select", ""},
+					{"s", "varchar(20) COLLATE utf8mb4_unicode_ci", "utf8mb4_unicode_ci", "YES", "", "NULL", "", "insert,select", "a string"},
+				},
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "GRANT UPDATE ON *.* TO tester@localhost;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				User:  "tester",
+				Host:  "localhost",
+				Query: "SHOW FULL COLUMNS FROM mydb.test",
+				Expected: []sql.Row{
+					{"pk", "bigint", nil, "NO", "PRI", "NULL", "", "insert,select,update", ""},
+					{"s", "varchar(20) COLLATE utf8mb4_unicode_ci", "utf8mb4_unicode_ci", "YES", "", "NULL", "", "insert,select,update", "a string"},
+				},
+			},
+			{
+				User:  "root",
+				Host:  "localhost",
+				Query: "SHOW FULL COLUMNS FROM mydb.test",
+				Expected: []sql.Row{
+					{"pk", "bigint", nil, "NO", "PRI", "NULL", "", "insert,references,select,update", ""},
+					{"s", "varchar(20) COLLATE utf8mb4_unicode_ci", "utf8mb4_unicode_ci", "YES", "", "NULL", "", "insert,references,select,update", "a string"},
+				},
+			},
+		},
+	},
 	{
 		Name: "information_schema.column_statistics shows columns with privileges only",
 		SetUpScript: []string{
@@ -1908,7 +1954,6 @@ var UserPrivTests = []UserPrivilegeTest{
 		Name: "basic tests on information_schema.USER_ATTRIBUTES table",
 		SetUpScript: []string{
 			"CREATE USER tester@localhost;",
-			// TODO: attributes info is ignored in sqlparser
 			`CREATE USER admin@localhost ATTRIBUTE '{"fname": "Josh", "lname": "Scott"}';`,
 			"GRANT UPDATE ON mysql.* TO admin@localhost;",
 		},
@@ -1917,7 +1962,7 @@ var UserPrivTests = []UserPrivilegeTest{
 				User:  "root",
 				Host:  "localhost",
 				Query: "select * from information_schema.user_attributes order by user;/*root*/",
-				Expected: []sql.Row{{"admin", "localhost", nil},
+				Expected: []sql.Row{{"admin", "localhost", `{"fname": "Josh", "lname": "Scott"}`},
 					{"root", "localhost", nil},
 					{"tester", "localhost", nil}},
 			},
@@ -1925,7 +1970,7 @@ var UserPrivTests = []UserPrivilegeTest{
 				User:  "admin",
 				Host:  "localhost",
 				Query: "select * from information_schema.user_attributes order by user;/*admin*/",
-				Expected: []sql.Row{{"admin", "localhost", nil},
+				Expected: []sql.Row{{"admin", "localhost", `{"fname": "Josh", "lname": "Scott"}`},
 					{"root", "localhost", nil},
 					{"tester", "localhost", nil}},
 			},
@@ -1935,6 +1980,12 @@ var UserPrivTests = []UserPrivilegeTest{
 				Query:    "select * from information_schema.user_attributes order by user;/*tester*/",
 				Expected: []sql.Row{{"tester", "localhost", nil}},
 			},
+			{
+				User:        "root",
+				Host:        "localhost",
+				Query:       "CREATE USER invalid@localhost ATTRIBUTE 'not json';",
+				ExpectedErr: sql.ErrInvalidJson,
+			},
 		},
 	},
 	{
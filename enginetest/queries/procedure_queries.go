@@ -656,15 +656,15 @@ END;`,
 			{
 				Query: "CALL p1(3, 4)",
 				Expected: []sql.Row{
-					{"4", "6"},
-					{"3", "4"},
+					{int64(4), int64(6)},
+					{int64(3), int64(4)},
 				},
 			},
 			{
 				Query: "CALL p2(5, 6)",
 				Expected: []sql.Row{
-					{"6", "8"},
-					{"5", "6"},
+					{int64(6), int64(8)},
+					{int64(5), int64(6)},
 				},
 			},
 		},
@@ -2476,7 +2476,7 @@ var ProcedureShowCreate = []ScriptTest{
 var NoDbProcedureTests = []ScriptTestAssertion{
 	{
 		Query:    "SHOW databases;",
-		Expected: []sql.Row{{"information_schema"}, {"mydb"}, {"mysql"}},
+		Expected: []sql.Row{{"information_schema"}, {"mydb"}, {"mysql"}, {"performance_schema"}, {"sys"}},
 	},
 	{
 		Query:    "SELECT database();",
@@ -700,6 +700,40 @@ var SpatialQueryTests = []QueryTest{
 			{nil},
 		},
 	},
+	{
+		Query: `SELECT i, st_pointn(l, 1), st_pointn(l, 3), st_pointn(l, 0) from line_table ORDER BY i`,
+		Expected: []sql.Row{
+			{0, types.Point{X: 1, Y: 2}, nil, nil},
+			{1, types.Point{X: 1, Y: 2}, types.Point{X: 5, Y: 6}, nil},
+		},
+	},
+	{
+		Query: `SELECT ST_ASWKT(st_pointn(st_startpoint(l), 1)), ST_ASWKT(st_pointn(l, 2)), ST_X(st_endpoint(l)), ST_ASWKT(ST_Y(st_endpoint(l), 10)) from line_table ORDER BY i`,
+		Expected: []sql.Row{
+			{nil, "POINT(3 4)", 3.0, "POINT(3 10)"},
+			{nil, "POINT(3 4)", 5.0, "POINT(5 10)"},
+		},
+	},
+	{
+		Query: `SELECT i, st_numpoints(l) from line_table ORDER BY i`,
+		Expected: []sql.Row{
+			{0, 2},
+			{1, 3},
+		},
+	},
+	{
+		Query: `SELECT i, st_numinteriorrings(p), st_numinteriorring(p), ST_ASWKT(st_exteriorring(p)), ST_ASWKT(st_interiorringn(p, 1)), st_interiorringn(p, 2) from polygon_table ORDER BY i`,
+		Expected: []sql.Row{
+			{0, 0, 0, "LINESTRING(0 0,0 1,1 1,0 0)", nil, nil},
+			{1, 1, 1, "LINESTRING(0 0,0 1,1 1,0 0)", "LINESTRING(0 0,0 1,1 1,0 0)", nil},
+		},
+	},
+	{
+		Query: `SELECT st_numinteriorrings(p), ST_ASWKT(st_interiorringn(p, 2)), st_numpoints(st_exteriorring(p)) from (select ST_GeomFromText('POLYGON((0 0,0 10,10 10,10 0,0 0),(1 1,1 2,2 2,1 1),(5 5,5 6,6 6,5 5))') as p) sq`,
+		Expected: []sql.Row{
+			{2, "LINESTRING(5 5,5 6,6 6,5 5)", 5},
+		},
+	},
 	{
 		Query: `SELECT st_isclosed(g) from geometry_table ORDER BY g`,
 		Expected: []sql.Row{
@@ -1177,6 +1211,30 @@ var QueryTests = []QueryTest{
 			{2.0},
 		},
 	},
+	{
+		Query:    `SELECT SQL_NO_CACHE i FROM mytable ORDER BY i`,
+		Expected: []sql.Row{{1}, {2}, {3}},
+	},
+	{
+		Query:    `SELECT SQL_CACHE i FROM mytable ORDER BY i`,
+		Expected: []sql.Row{{1}, {2}, {3}},
+	},
+	{
+		Query:    `SELECT /*!40001 SQL_NO_CACHE */ * FROM mytable ORDER BY i`,
+		Expected: []sql.Row{{1, "first row"}, {2, "second row"}, {3, "third row"}},
+	},
+	{
+		Query:    `SELECT DISTINCT SQL_NO_CACHE pk1 FROM two_pk ORDER BY pk1`,
+		Expected: []sql.Row{{0}, {1}},
+	},
+	{
+		Query:    `SELECT DISTINCT SQL_CALC_FOUND_ROWS SQL_CACHE pk1 FROM two_pk ORDER BY pk1 LIMIT 1`,
+		Expected: []sql.Row{{0}},
+	},
+	{
+		Query:    `SELECT i FROM mytable WHERE i IN (SELECT STRAIGHT_JOIN SQL_NO_CACHE pk FROM one_pk) ORDER BY i`,
+		Expected: []sql.Row{{1}, {2}, {3}},
+	},
 	{
 		Query:    `SELECT DISTINCT val FROM (values row(1), row(1.00), row(2), row(2)) a (val);`,
 		Expected: []sql.Row{{"1.00"}, {"2.00"}},
@@ -3429,6 +3487,26 @@ var QueryTests = []QueryTest{
 		Query:    "SELECT YEARWEEK('1987-01-01', 20), YEARWEEK('1987-01-01', 1), YEARWEEK('1987-01-01', 2), YEARWEEK('1987-01-01', 3), YEARWEEK('1987-01-01', 4), YEARWEEK('1987-01-01', 5), YEARWEEK('1987-01-01', 6), YEARWEEK('1987-01-01', 7)",
 		Expected: []sql.Row{{int32(198653), int32(198701), int32(198652), int32(198701), int32(198653), int32(198652), int32(198653), int32(198652)}},
 	},
+	{
+		Query:    "SELECT WEEK('2008-12-31'), WEEK('2008-12-31', 1), WEEK('2000-01-01', 2), WEEK('2018-12-31', 3), WEEK(NULL)",
+		Expected: []sql.Row{{int32(52), int32(53), int32(52), int32(1), nil}},
+	},
+	{
+		Query:    "SELECT EXTRACT(WEEK FROM '2008-12-31'), EXTRACT(YEAR_MONTH FROM '2019-07-02 01:02:03'), EXTRACT(DAY_MINUTE FROM '2019-07-02 01:02:03')",
+		Expected: []sql.Row{{52, 201907, 20102}},
+	},
+	{
+		Query:    "SELECT TO_DAYS('2007-10-07'), TO_DAYS(NULL), FROM_DAYS(733321), FROM_DAYS(365)",
+		Expected: []sql.Row{{int64(733321), nil, time.Date(2007, time.October, 7, 0, 0, 0, 0, time.UTC), "0000-00-00"}},
+	},
+	{
+		Query:    "SELECT TO_DAYS('0000-01-01'), TO_DAYS('0000-12-31'), TO_DAYS('0000-00-00'), TO_DAYS(FROM_DAYS(365)), FROM_DAYS(1), FROM_DAYS(366)",
+		Expected: []sql.Row{{int64(1), int64(365), nil, nil, "0000-00-00", time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC)}},
+	},
+	{
+		Query:    "SELECT CAST(FROM_DAYS(365) AS CHAR), FROM_DAYS(365) = '0000-00-00', YEAR(FROM_DAYS(365))",
+		Expected: []sql.Row{{"0000-00-00", true, int32(0)}},
+	},
 	{
 		Query:    `select 'a'+4;`,
 		Expected: []sql.Row{{4.0}},
@@ -4232,6 +4310,97 @@ var QueryTests = []QueryTest{
 			{uint64(18446744073709551613)},
 		},
 	},
+	{
+		Query:    "SELECT CAST(18446744073709551615 AS SIGNED), CAST('99999999999999999999' AS SIGNED), CAST(-1 AS UNSIGNED), CAST(1.5 AS SIGNED), CAST(CAST('2021-03-04' AS DATE) AS SIGNED), CAST(CAST('2021-03-04 05:06:07' AS DATETIME) AS UNSIGNED)",
+		Expected: []sql.Row{{int64(-1), int64(math.MaxInt64), uint64(math.MaxUint64), int64(2), int64(20210304), uint64(20210304050607)}},
+	},
+	{
+		Query:    "SELECT CAST(1.555 AS DECIMAL(4,2)), CAST('abcdef' AS CHAR(3)), CAST('ab' AS BINARY(4)), CAST(1.5 AS JSON)",
+		Expected: []sql.Row{{"1.56", "abc", []byte{'a', 'b', 0, 0}, types.MustJSON("1.5")}},
+		ExpectedColumns: sql.Schema{
+			{
+				Name: "CAST(1.555 AS DECIMAL(4,2))",
+				Type: types.MustCreateDecimalType(4, 2),
+			},
+			{
+				Name: "CAST('abcdef' AS CHAR(3))",
+				Type: types.MustCreateString(sqltypes.VarChar, 3, sql.Collation_Default),
+			},
+			{
+				Name: "CAST('ab' AS BINARY(4))",
+				Type: types.MustCreateBinary(sqltypes.VarBinary, 4),
+			},
+			{
+				Name: "CAST(1.5 AS JSON)",
+				Type: types.JSON,
+			},
+		},
+	},
+	{
+		Query:    "SELECT CONVERT('héllo' USING latin1), CONVERT('日本' USING latin1), CONVERT(X'616263' USING utf8mb4), CAST('日本' AS CHAR CHARACTER SET ascii)",
+		Expected: []sql.Row{{"héllo", "??", "abc", "??"}},
+		ExpectedColumns: sql.Schema{
+			{
+				Name: "CONVERT('héllo' USING latin1)",
+				Type: types.CreateLongText(sql.Collation_latin1_swedish_ci),
+			},
+			{
+				Name: "CONVERT('日本' USING latin1)",
+				Type: types.CreateLongText(sql.Collation_latin1_swedish_ci),
+			},
+			{
+				Name: "CONVERT(X'616263' USING utf8mb4)",
+				Type: types.CreateLongText(sql.Collation_utf8mb4_0900_ai_ci),
+			},
+			{
+				Name: "CAST('日本' AS CHAR CHARACTER SET ascii)",
+				Type: types.CreateLongText(sql.Collation_ascii_general_ci),
+			},
+		},
+	},
+	{
+		Query:    "SELECT CAST('2023' AS YEAR), CONVERT(99, YEAR), CAST(1.5 AS FLOAT), CAST('1.5' AS DOUBLE), CAST(2 AS REAL), CONVERT(1.1, FLOAT(30))",
+		Expected: []sql.Row{{2023, 1999, 1.5, 1.5, 2.0, 1.1}},
+		ExpectedColumns: sql.Schema{
+			{
+				Name: "CAST('2023' AS YEAR)",
+				Type: types.Year,
+			},
+			{
+				Name: "CONVERT(99, YEAR)",
+				Type: types.Year,
+			},
+			{
+				Name: "CAST(1.5 AS FLOAT)",
+				Type: types.Float32,
+			},
+			{
+				Name: "CAST('1.5' AS DOUBLE)",
+				Type: types.Float64,
+			},
+			{
+				Name: "CAST(2 AS REAL)",
+				Type: types.Float64,
+			},
+			{
+				Name: "CONVERT(1.1, FLOAT(30))",
+				Type: types.Float64,
+			},
+		},
+	},
+	{
+		Query:    "SELECT CAST(CAST(5 AS YEAR) AS CHAR), CONVERT(CONVERT('2000', YEAR), DOUBLE)",
+		Expected: []sql.Row{{"2005", 2000.0}},
+	},
+	{
+		Query: "SELECT ST_ASWKT(CAST(ST_GEOMFROMTEXT('MULTIPOINT(1 2)') AS POINT)), ST_ASWKT(CAST(POINT(1, 2) AS GEOMETRYCOLLECTION)), " +
+			"ST_ASWKT(CAST(ST_GEOMFROMTEXT('LINESTRING(0 0, 1 0, 1 1, 0 0)') AS POLYGON)), ST_ASWKT(CAST(ST_GEOMFROMTEXT('POLYGON((0 0, 1 0, 1 1, 0 0))') AS MULTILINESTRING))",
+		Expected: []sql.Row{{"POINT(1 2)", "GEOMETRYCOLLECTION(POINT(1 2))", "POLYGON((0 0,1 0,1 1,0 0))", "MULTILINESTRING((0 0,1 0,1 1,0 0))"}},
+	},
+	{
+		Query:    "SELECT ST_ASWKT(CAST(ST_GEOMFROMTEXT('LINESTRING(0 0, 1 1)') AS MULTIPOINT)), ST_ASWKT(CONVERT(ST_GEOMFROMTEXT('POLYGON((0 0, 1 0, 1 1, 0 0))'), MULTIPOLYGON)), ST_ASWKT(CAST(ST_GEOMFROMTEXT('MULTIPOINT(0 0, 1 1)') AS LINESTRING))",
+		Expected: []sql.Row{{"MULTIPOINT(0 0,1 1)", "MULTIPOLYGON(((0 0,1 0,1 1,0 0)))", "LINESTRING(0 0,1 1)"}},
+	},
 	{
 		Query: "SELECT '3' > 2 FROM tabletest",
 		Expected: []sql.Row{
@@ -4384,12 +4553,16 @@ var QueryTests = []QueryTest{
 	{
 		Query: `SELECT RAND(100)`,
 		Expected: []sql.Row{
-			{float64(0.8165026937796166)},
+			{float64(0.17353134804734155)},
 		},
 	},
 	{
 		Query:    `SELECT RAND(i) from mytable order by i`,
-		Expected: []sql.Row{{0.6046602879796196}, {0.16729663442585624}, {0.7199826688373036}},
+		Expected: []sql.Row{{0.40540353712197724}, {0.6555866465490187}, {0.9057697559760601}},
+	},
+	{
+		Query:    `SELECT i, RAND(3) from mytable order by i`,
+		Expected: []sql.Row{{1, 0.9057697559760601}, {2, 0.37307905813034536}, {3, 0.14808605345719125}},
 	},
 	{
 		Query: `SELECT RAND(100) = RAND(100)`,
@@ -4499,6 +4672,18 @@ var QueryTests = []QueryTest{
 			{"1"},
 		},
 	},
+	{
+		Query: "SELECT BENCHMARK(1000, CONCAT(s, i)) from mytable order by i limit 1",
+		Expected: []sql.Row{
+			{0},
+		},
+	},
+	{
+		Query: "SELECT BENCHMARK(NULL, 1)",
+		Expected: []sql.Row{
+			{nil},
+		},
+	},
 	{
 		Query: "SELECT BIT_LENGTH(i) from mytable order by i limit 1",
 		Expected: []sql.Row{
@@ -4636,7 +4821,7 @@ var QueryTests = []QueryTest{
 	},
 	{
 		Query:    `SHOW DATABASES`,
-		Expected: []sql.Row{{"mydb"}, {"foo"}, {"information_schema"}, {"mysql"}},
+		Expected: []sql.Row{{"mydb"}, {"foo"}, {"information_schema"}, {"mysql"}, {"performance_schema"}, {"sys"}},
 	},
 	{
 		Query:    `SHOW DATABASES LIKE 'information_schema'`,
@@ -4648,12 +4833,14 @@ var QueryTests = []QueryTest{
 	},
 	{
 		Query:    `SHOW SCHEMAS`,
-		Expected: []sql.Row{{"mydb"}, {"foo"}, {"information_schema"}, {"mysql"}},
+		Expected: []sql.Row{{"mydb"}, {"foo"}, {"information_schema"}, {"mysql"}, {"performance_schema"}, {"sys"}},
 	},
 	{
 		Query: `SELECT SCHEMA_NAME, DEFAULT_CHARACTER_SET_NAME, DEFAULT_COLLATION_NAME FROM information_schema.SCHEMATA`,
 		Expected: []sql.Row{
 			{"information_schema", "utf8mb4", "utf8mb4_0900_bin"},
+			{"sys", "utf8mb4", "utf8mb4_0900_bin"},
+			{"performance_schema", "utf8mb4", "utf8mb4_0900_bin"},
 			{"mydb", "utf8mb4", "utf8mb4_0900_bin"},
 			{"foo", "utf8mb4", "utf8mb4_0900_bin"},
 		},
@@ -4778,7 +4965,6 @@ var QueryTests = []QueryTest{
 			{"block_encryption_mode", "aes-128-ecb"},
 			{"gtid_mode", "OFF"},
 			{"offline_mode", int64(0)},
-			{"pseudo_slave_mode", int64(0)},
 			{"rbr_exec_mode", "STRICT"},
 			{"sql_mode", "STRICT_TRANS_TABLES,NO_ENGINE_SUBSTITUTION,ONLY_FULL_GROUP_BY"},
 			{"ssl_fips_mode", "OFF"},
@@ -5294,7 +5480,7 @@ var QueryTests = []QueryTest{
 	},
 	{
 		Query:    "SELECT DATE_ADD('2018-05-02', INTERVAL 1 day)",
-		Expected: []sql.Row{{time.Date(2018, time.May, 3, 0, 0, 0, 0, time.UTC)}},
+		Expected: []sql.Row{{"2018-05-03"}},
 	},
 	{
 		Query:    "SELECT DATE_ADD(DATE('2018-05-02'), INTERVAL 1 day)",
@@ -5306,7 +5492,7 @@ var QueryTests = []QueryTest{
 	},
 	{
 		Query:    "SELECT DATE_SUB('2018-05-02', INTERVAL 1 DAY)",
-		Expected: []sql.Row{{time.Date(2018, time.May, 1, 0, 0, 0, 0, time.UTC)}},
+		Expected: []sql.Row{{"2018-05-01"}},
 	},
 	{
 		Query:    "SELECT DATE_SUB(DATE('2018-05-02'), INTERVAL 1 DAY)",
@@ -5318,11 +5504,63 @@ var QueryTests = []QueryTest{
 	},
 	{
 		Query:    "SELECT '2018-05-02' + INTERVAL 1 DAY",
-		Expected: []sql.Row{{time.Date(2018, time.May, 3, 0, 0, 0, 0, time.UTC)}},
+		Expected: []sql.Row{{"2018-05-03"}},
 	},
 	{
 		Query:    "SELECT '2018-05-02' - INTERVAL 1 DAY",
-		Expected: []sql.Row{{time.Date(2018, time.May, 1, 0, 0, 0, 0, time.UTC)}},
+		Expected: []sql.Row{{"2018-05-01"}},
+	},
+	{
+		Query:    "SELECT INTERVAL 1 DAY + '2018-05-02'",
+		Expected: []sql.Row{{"2018-05-03"}},
+	},
+	{
+		Query:    "SELECT i FROM mytable WHERE '2018-05-01 10:00:00' - INTERVAL i HOUR > '2018-05-01 07:30:00' ORDER BY i",
+		Expected: []sql.Row{{1}, {2}},
+	},
+	{
+		Query:    "SELECT INTERVAL(23, 1, 15, 17, 30, 44, 200), interval (NULL, 1, 2), '2018-05-01' + INTERVAL 1 DAY",
+		Expected: []sql.Row{{3, -1, "2018-05-02"}},
+	},
+	{
+		Query:    "SELECT INTERVAL(DAY('2018-05-01' + INTERVAL 10 DAY), 5, 10, 15), `interval`(3, 1, 2)",
+		Expected: []sql.Row{{2, 2}},
+	},
+	{
+		Query:    "SELECT '2018-05-01' + INTERVAL (1 + 1) DAY, INTERVAL(2, 1) + 1",
+		Expected: []sql.Row{{"2018-05-03", 2}},
+		ExpectedColumns: sql.Schema{
+			{Name: "'2018-05-01' + INTERVAL (1 + 1) DAY", Type: types.LongText},
+			{Name: "INTERVAL(2, 1) + 1", Type: types.Int64},
+		},
+	},
+	{
+		Query:    "SELECT DATE_ADD('2018-05-02 10:00:00', INTERVAL '1:30' HOUR_MINUTE), DATE_SUB('2018-05-02', INTERVAL '1-2' YEAR_MONTH)",
+		Expected: []sql.Row{{"2018-05-02 11:30:00", "2017-03-02"}},
+		ExpectedColumns: sql.Schema{
+			{Name: "DATE_ADD('2018-05-02 10:00:00', INTERVAL '1:30' HOUR_MINUTE)", Type: types.LongText},
+			{Name: "DATE_SUB('2018-05-02', INTERVAL '1-2' YEAR_MONTH)", Type: types.LongText},
+		},
+	},
+	{
+		Query:    "SELECT '2018-05-02 10:00:00' - INTERVAL '1 2' day_hour, INTERVAL CONCAT('1', ':', '30') MINUTE_SECOND + '2018-05-02'",
+		Expected: []sql.Row{{"2018-05-01 08:00:00", "2018-05-02 00:01:30"}},
+	},
+	{
+		Query:    "SELECT DATE_ADD('2018-05-02 10:00:00', INTERVAL 1 HOUR)",
+		Expected: []sql.Row{{"2018-05-02 11:00:00"}},
+	},
+	{
+		Query:    "SELECT DATE_ADD('2018-05-02', INTERVAL 90 MINUTE)",
+		Expected: []sql.Row{{"2018-05-02 01:30:00"}},
+	},
+	{
+		Query:    "SELECT DATE_ADD(DATE('2018-05-02'), INTERVAL 1 HOUR)",
+		Expected: []sql.Row{{time.Date(2018, time.May, 2, 1, 0, 0, 0, time.UTC)}},
+	},
+	{
+		Query:    "SELECT TIMESTAMP('2018-05-02 10:00:00') - INTERVAL 1 SECOND",
+		Expected: []sql.Row{{time.Date(2018, time.May, 2, 9, 59, 59, 0, time.UTC)}},
 	},
 	{
 		Query:    `SELECT i AS i FROM mytable ORDER BY i`,
@@ -5555,6 +5793,53 @@ var QueryTests = []QueryTest{
 		Query:    `select locate(upper("roW"), upper(s), power(10, 0)) from mytable order by i`,
 		Expected: []sql.Row{{7}, {8}, {7}},
 	},
+	{
+		Query:    `select locate("row", s, 8), instr(s, "ROW") from mytable order by i`,
+		Expected: []sql.Row{{0, 7}, {8, 8}, {0, 7}},
+	},
+	{
+		Query:    `select locate("é", "aéé"), locate("é", "aéé", 3), instr("aéé", "É"), locate("x", "aéé")`,
+		Expected: []sql.Row{{2, 3, 2, 0}},
+	},
+	{
+		Query:    `select locate("", "aéé", 4), locate("", "aéé", 5), instr("aéé", "")`,
+		Expected: []sql.Row{{4, 0, 1}},
+	},
+	{
+		Query:    `select position("row" in s), position("x" IN s), position("É" in "aéé"), position("" in "aéé") from mytable order by i`,
+		Expected: []sql.Row{{7, 0, 2, 1}, {8, 0, 2, 1}, {7, 0, 2, 1}},
+		ExpectedColumns: sql.Schema{
+			{Name: `position("row" in s)`, Type: types.Int32},
+			{Name: `position("x" IN s)`, Type: types.Int32},
+			{Name: `position("É" in "aéé")`, Type: types.Int32},
+			{Name: `position("" in "aéé")`, Type: types.Int32},
+		},
+	},
+	{
+		Query:    `select soundex("Robert"), soundex("Rupert"), soundex("Quadratically"), soundex("Tymczak"), soundex(""), soundex(null)`,
+		Expected: []sql.Row{{"R163", "R163", "Q36324", "T520", "", nil}},
+	},
+	{
+		Query:    `select soundex("Smith") = soundex("Smythe"), soundex("Smith") = soundex("Jones")`,
+		Expected: []sql.Row{{true, false}},
+	},
+	{
+		Query:    `select s, soundex(s) from mytable where soundex(s) = soundex("thurd roe") order by i`,
+		Expected: []sql.Row{{"third row", "T636"}},
+	},
+	{
+		Query:    `select "Robert" sounds like "Rupert", "Smith" SOUNDS LIKE "Jones", null sounds like "a"`,
+		Expected: []sql.Row{{true, false, nil}},
+		ExpectedColumns: sql.Schema{
+			{Name: `"Robert" sounds like "Rupert"`, Type: types.Boolean},
+			{Name: `"Smith" SOUNDS LIKE "Jones"`, Type: types.Boolean},
+			{Name: `null sounds like "a"`, Type: types.Boolean},
+		},
+	},
+	{
+		Query:    `select s from mytable where s sounds like concat("thurd", " roe") and not "sounds like" sounds like s order by i`,
+		Expected: []sql.Row{{"third row"}},
+	},
 	{
 		Query:    "select log2(i) from mytable order by i",
 		Expected: []sql.Row{{0.0}, {1.0}, {1.5849625007211563}},
@@ -7576,14 +7861,14 @@ var KeylessQueries = []QueryTest{
 	{
 		Query: "SHOW FULL COLUMNS FROM keyless",
 		Expected: []sql.Row{
-			{"c0", "bigint", nil, "YES", "", "NULL", "", "", ""},
-			{"c1", "bigint", nil, "YES", "", "NULL", "", "", ""},
+			{"c0", "bigint", nil, "YES", "", "NULL", "", "insert,references,select,update", ""},
+			{"c1", "bigint", nil, "YES", "", "NULL", "", "insert,references,select,update", ""},
 		},
 	},
 	{
 		Query: "SHOW CREATE TABLE keyless",
 		Expected: []sql.Row{
-			{"keyless", "CREATE TABLE `keyless` (\n  `c0` bigint,\n  `c1` bigint\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"},
+			{"keyless", "CREATE TABLE `keyless` (\n  `c0` bigint DEFAULT NULL,\n  `c1` bigint DEFAULT NULL\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"},
 		},
 	},
 }
@@ -8144,6 +8429,10 @@ type QueryErrorTest struct {
 }
 
 var ErrorQueries = []QueryErrorTest{
+	{
+		Query:       "SELECT BENCHMARK(-1, 1)",
+		ExpectedErr: sql.ErrInvalidArgumentDetails,
+	},
 	{
 		Query:       "with a(j) as (select 1), b(i) as (select 2) (select j from a union select i from b order by 1 desc) union select j from a order by 1 asc;",
 		ExpectedErr: sql.ErrConflictingExternalQuery,
@@ -8890,40 +9179,72 @@ var ShowTableStatusQueries = []QueryTest{
 	{
 		Query: `SHOW TABLE STATUS FROM mydb`,
 		Expected: []sql.Row{
-			{"mytable", "InnoDB", "10", "Fixed", uint64(3), uint64(88), uint64(264), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"othertable", "InnoDB", "10", "Fixed", uint64(3), uint64(88), uint64(264), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
+			{"mytable", "InnoDB", "10", "Fixed", uint64(3), uint64(17), uint64(52), uint64(0), int64(132), int64(0), nil, time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC(), nil, "utf8mb4_0900_bin", nil, nil, nil},
+			{"othertable", "InnoDB", "10", "Fixed", uint64(3), uint64(13), uint64(40), uint64(0), int64(56), int64(0), nil, time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC(), nil, "utf8mb4_0900_bin", nil, nil, nil},
 		},
 	},
 	{
 		Query: `SHOW TABLE STATUS LIKE '%table'`,
 		Expected: []sql.Row{
-			{"mytable", "InnoDB", "10", "Fixed", uint64(3), uint64(88), uint64(264), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"othertable", "InnoDB", "10", "Fixed", uint64(3), uint64(88), uint64(264), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
+			{"mytable", "InnoDB", "10", "Fixed", uint64(3), uint64(17), uint64(52), uint64(0), int64(132), int64(0), nil, time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC(), nil, "utf8mb4_0900_bin", nil, nil, nil},
+			{"othertable", "InnoDB", "10", "Fixed", uint64(3), uint64(13), uint64(40), uint64(0), int64(56), int64(0), nil, time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC(), nil, "utf8mb4_0900_bin", nil, nil, nil},
 		},
 	},
 	{
 		Query: `SHOW TABLE STATUS FROM mydb LIKE 'othertable'`,
 		Expected: []sql.Row{
-			{"othertable", "InnoDB", "10", "Fixed", uint64(3), uint64(88), uint64(264), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
+			{"othertable", "InnoDB", "10", "Fixed", uint64(3), uint64(13), uint64(40), uint64(0), int64(56), int64(0), nil, time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC(), nil, "utf8mb4_0900_bin", nil, nil, nil},
 		},
 	},
 	{
 		Query: `SHOW TABLE STATUS WHERE Name = 'mytable'`,
 		Expected: []sql.Row{
-			{"mytable", "InnoDB", "10", "Fixed", uint64(3), uint64(88), uint64(264), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
+			{"mytable", "InnoDB", "10", "Fixed", uint64(3), uint64(17), uint64(52), uint64(0), int64(132), int64(0), nil, time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC(), nil, "utf8mb4_0900_bin", nil, nil, nil},
 		},
 	},
 	{
 		Query: `SHOW TABLE STATUS`,
 		Expected: []sql.Row{
-			{"mytable", "InnoDB", "10", "Fixed", uint64(3), uint64(88), uint64(264), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"othertable", "InnoDB", "10", "Fixed", uint64(3), uint64(88), uint64(264), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
+			{"mytable", "InnoDB", "10", "Fixed", uint64(3), uint64(17), uint64(52), uint64(0), int64(132), int64(0), nil, time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC(), nil, "utf8mb4_0900_bin", nil, nil, nil},
+			{"othertable", "InnoDB", "10", "Fixed", uint64(3), uint64(13), uint64(40), uint64(0), int64(56), int64(0), nil, time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC(), nil, "utf8mb4_0900_bin", nil, nil, nil},
 		},
 	},
 	{
 		Query: `SHOW TABLE STATUS FROM mydb LIKE 'othertable'`,
 		Expected: []sql.Row{
-			{"othertable", "InnoDB", "10", "Fixed", uint64(3), uint64(88), uint64(264), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
+			{"othertable", "InnoDB", "10", "Fixed", uint64(3), uint64(13), uint64(40), uint64(0), int64(56), int64(0), nil, time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC(), nil, "utf8mb4_0900_bin", nil, nil, nil},
+		},
+	},
+}
+
+var ShowTableStatusScripts = []ScriptTest{
+	{
+		Name: "show table status reports the rows and next auto increment value of tables",
+		SetUpScript: []string{
+			"create table t (i int primary key auto_increment, s varchar(20))",
+			"insert into t (s) values ('a'), ('b'), ('c'), ('d')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "show table status like 't'",
+				Expected: []sql.Row{{"t", "InnoDB", "10", "Fixed", uint64(4), uint64(5), uint64(20), uint64(0), int64(0), int64(0), int64(5), time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC(), nil, "utf8mb4_0900_bin", nil, nil, nil}},
+			},
+			{
+				Query:    "delete from t where i > 2",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:    "insert into t (s) values ('e')",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 1, InsertID: 5}}},
+			},
+			{
+				Query:    "show table status like 't'",
+				Expected: []sql.Row{{"t", "InnoDB", "10", "Fixed", uint64(3), uint64(5), uint64(15), uint64(0), int64(0), int64(0), int64(6), time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC(), nil, "utf8mb4_0900_bin", nil, nil, nil}},
+			},
+			{
+				Query:    "select table_rows, auto_increment, create_time <= now() from information_schema.tables where table_name = 't'",
+				Expected: []sql.Row{{uint64(3), uint64(6), true}},
+			},
 		},
 	},
 }
@@ -8944,7 +9265,7 @@ var IndexQueries = []ScriptTest{
 			{
 				Query: "show create table users",
 				Expected: []sql.Row{
-					{"users", "CREATE TABLE `users` (\n  `id` varchar(26) NOT NULL,\n  `namespace` varchar(50),\n  `name` varchar(50),\n  PRIMARY KEY (`id`),\n  UNIQUE KEY `namespace__name` (`namespace`,`name`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"},
+					{"users", "CREATE TABLE `users` (\n  `id` varchar(26) NOT NULL,\n  `namespace` varchar(50) DEFAULT NULL,\n  `name` varchar(50) DEFAULT NULL,\n  PRIMARY KEY (`id`),\n  UNIQUE KEY `namespace__name` (`namespace`,`name`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"},
 				},
 			},
 			{
@@ -9189,7 +9510,7 @@ var IndexPrefixQueries = []ScriptTest{
 			},
 			{
 				Query:    "show create table t",
-				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n  `i` int NOT NULL,\n  `v` varchar(10),\n  PRIMARY KEY (`i`),\n  UNIQUE KEY `v` (`v`(1))\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n  `i` int NOT NULL,\n  `v` varchar(10) DEFAULT NULL,\n  PRIMARY KEY (`i`),\n  UNIQUE KEY `v` (`v`(1))\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
 			},
 			{
 				Query:       "insert into t values (0, 'aa'), (1, 'ab')",
@@ -9215,7 +9536,7 @@ var IndexPrefixQueries = []ScriptTest{
 			},
 			{
 				Query:    "show create table v_tbl",
-				Expected: []sql.Row{{"v_tbl", "CREATE TABLE `v_tbl` (\n  `i` int NOT NULL,\n  `v` varchar(100),\n  PRIMARY KEY (`i`),\n  KEY `v` (`v`(10))\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+				Expected: []sql.Row{{"v_tbl", "CREATE TABLE `v_tbl` (\n  `i` int NOT NULL,\n  `v` varchar(100) DEFAULT NULL,\n  PRIMARY KEY (`i`),\n  KEY `v` (`v`(10))\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
 			},
 		},
 	},
@@ -9231,7 +9552,7 @@ var IndexPrefixQueries = []ScriptTest{
 			},
 			{
 				Query:    "show create table t",
-				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n  `v` varchar(10),\n  UNIQUE KEY `v` (`v`(1))\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n  `v` varchar(10) DEFAULT NULL,\n  UNIQUE KEY `v` (`v`(1))\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
 			},
 			{
 				Query:       "insert into t values ('aa'), ('ab')",
@@ -9243,7 +9564,7 @@ var IndexPrefixQueries = []ScriptTest{
 			},
 			{
 				Query:    "show create table v_tbl",
-				Expected: []sql.Row{{"v_tbl", "CREATE TABLE `v_tbl` (\n  `v` varchar(100),\n  KEY `v` (`v`(10))\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+				Expected: []sql.Row{{"v_tbl", "CREATE TABLE `v_tbl` (\n  `v` varchar(100) DEFAULT NULL,\n  KEY `v` (`v`(10))\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
 			},
 		},
 	},
@@ -9275,7 +9596,7 @@ var IndexPrefixQueries = []ScriptTest{
 			},
 			{
 				Query:    "show create table t",
-				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n  `i` int NOT NULL,\n  `c` char(10),\n  PRIMARY KEY (`i`),\n  UNIQUE KEY `c` (`c`(1))\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n  `i` int NOT NULL,\n  `c` char(10) DEFAULT NULL,\n  PRIMARY KEY (`i`),\n  UNIQUE KEY `c` (`c`(1))\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
 			},
 			{
 				Query:       "insert into t values (0, 'aa'), (1, 'ab')",
@@ -9287,7 +9608,7 @@ var IndexPrefixQueries = []ScriptTest{
 			},
 			{
 				Query:    "show create table c_tbl",
-				Expected: []sql.Row{{"c_tbl", "CREATE TABLE `c_tbl` (\n  `i` int NOT NULL,\n  `c` varchar(100),\n  PRIMARY KEY (`i`),\n  KEY `c` (`c`(10))\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+				Expected: []sql.Row{{"c_tbl", "CREATE TABLE `c_tbl` (\n  `i` int NOT NULL,\n  `c` varchar(100) DEFAULT NULL,\n  PRIMARY KEY (`i`),\n  KEY `c` (`c`(10))\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
 			},
 		},
 	},
@@ -9303,7 +9624,7 @@ var IndexPrefixQueries = []ScriptTest{
 			},
 			{
 				Query:    "show create table t",
-				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n  `c` char(10),\n  UNIQUE KEY `c` (`c`(1))\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n  `c` char(10) DEFAULT NULL,\n  UNIQUE KEY `c` (`c`(1))\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
 			},
 			{
 				Query:       "insert into t values ('aa'), ('ab')",
@@ -9315,7 +9636,7 @@ var IndexPrefixQueries = []ScriptTest{
 			},
 			{
 				Query:    "show create table c_tbl",
-				Expected: []sql.Row{{"c_tbl", "CREATE TABLE `c_tbl` (\n  `c` char(100),\n  KEY `c` (`c`(10))\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+				Expected: []sql.Row{{"c_tbl", "CREATE TABLE `c_tbl` (\n  `c` char(100) DEFAULT NULL,\n  KEY `c` (`c`(10))\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
 			},
 		},
 	},
@@ -9347,7 +9668,7 @@ var IndexPrefixQueries = []ScriptTest{
 			},
 			{
 				Query:    "show create table t",
-				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n  `i` int NOT NULL,\n  `v` varbinary(10),\n  PRIMARY KEY (`i`),\n  UNIQUE KEY `v` (`v`(1))\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n  `i` int NOT NULL,\n  `v` varbinary(10) DEFAULT NULL,\n  PRIMARY KEY (`i`),\n  UNIQUE KEY `v` (`v`(1))\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
 			},
 			{
 				Query:       "insert into t values (0, 'aa'), (1, 'ab')",
@@ -9359,7 +9680,7 @@ var IndexPrefixQueries = []ScriptTest{
 			},
 			{
 				Query:    "show create table v_tbl",
-				Expected: []sql.Row{{"v_tbl", "CREATE TABLE `v_tbl` (\n  `i` int NOT NULL,\n  `v` varbinary(100),\n  PRIMARY KEY (`i`),\n  KEY `v` (`v`(10))\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+				Expected: []sql.Row{{"v_tbl", "CREATE TABLE `v_tbl` (\n  `i` int NOT NULL,\n  `v` varbinary(100) DEFAULT NULL,\n  PRIMARY KEY (`i`),\n  KEY `v` (`v`(10))\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
 			},
 		},
 	},
@@ -9375,7 +9696,7 @@ var IndexPrefixQueries = []ScriptTest{
 			},
 			{
 				Query:    "show create table t",
-				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n  `v` varbinary(10),\n  UNIQUE KEY `v` (`v`(1))\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n  `v` varbinary(10) DEFAULT NULL,\n  UNIQUE KEY `v` (`v`(1))\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
 			},
 			{
 				Query:       "insert into t values ('aa'), ('ab')",
@@ -9387,7 +9708,7 @@ var IndexPrefixQueries = []ScriptTest{
 			},
 			{
 				Query:    "show create table v_tbl",
-				Expected: []sql.Row{{"v_tbl", "CREATE TABLE `v_tbl` (\n  `v` varbinary(100),\n  KEY `v` (`v`(10))\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+				Expected: []sql.Row{{"v_tbl", "CREATE TABLE `v_tbl` (\n  `v` varbinary(100) DEFAULT NULL,\n  KEY `v` (`v`(10))\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
 			},
 		},
 	},
@@ -9419,7 +9740,7 @@ var IndexPrefixQueries = []ScriptTest{
 			},
 			{
 				Query:    "show create table t",
-				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n  `i` int NOT NULL,\n  `b` binary(10),\n  PRIMARY KEY (`i`),\n  UNIQUE KEY `b` (`b`(1))\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n  `i` int NOT NULL,\n  `b` binary(10) DEFAULT NULL,\n  PRIMARY KEY (`i`),\n  UNIQUE KEY `b` (`b`(1))\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
 			},
 			{
 				Query:       "insert into t values (0, 'aa'), (1, 'ab')",
@@ -9431,7 +9752,7 @@ var IndexPrefixQueries = []ScriptTest{
 			},
 			{
 				Query:    "show create table b_tbl",
-				Expected: []sql.Row{{"b_tbl", "CREATE TABLE `b_tbl` (\n  `i` int NOT NULL,\n  `b` binary(100),\n  PRIMARY KEY (`i`),\n  KEY `b` (`b`(10))\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+				Expected: []sql.Row{{"b_tbl", "CREATE TABLE `b_tbl` (\n  `i` int NOT NULL,\n  `b` binary(100) DEFAULT NULL,\n  PRIMARY KEY (`i`),\n  KEY `b` (`b`(10))\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
 			},
 		},
 	},
@@ -9447,7 +9768,7 @@ var IndexPrefixQueries = []ScriptTest{
 			},
 			{
 				Query:    "show create table t",
-				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n  `b` binary(10),\n  UNIQUE KEY `b` (`b`(1))\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n  `b` binary(10) DEFAULT NULL,\n  UNIQUE KEY `b` (`b`(1))\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
 			},
 			{
 				Query:       "insert into t values ('aa'), ('ab')",
@@ -9459,7 +9780,7 @@ var IndexPrefixQueries = []ScriptTest{
 			},
 			{
 				Query:    "show create table b_tbl",
-				Expected: []sql.Row{{"b_tbl", "CREATE TABLE `b_tbl` (\n  `b` binary(100),\n  KEY `b` (`b`(10))\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+				Expected: []sql.Row{{"b_tbl", "CREATE TABLE `b_tbl` (\n  `b` binary(100) DEFAULT NULL,\n  KEY `b` (`b`(10))\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
 			},
 		},
 	},
@@ -9615,7 +9936,7 @@ var IndexPrefixQueries = []ScriptTest{
 		Assertions: []ScriptTestAssertion{
 			{
 				Query:    "show create table t",
-				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n  `i` int NOT NULL,\n  `v1` varchar(10),\n  `v2` varchar(10),\n  PRIMARY KEY (`i`),\n  UNIQUE KEY `v1v2` (`v1`(3),`v2`(5))\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n  `i` int NOT NULL,\n  `v1` varchar(10) DEFAULT NULL,\n  `v2` varchar(10) DEFAULT NULL,\n  PRIMARY KEY (`i`),\n  UNIQUE KEY `v1v2` (`v1`(3),`v2`(5))\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
 			},
 			{
 				Query:    "insert into t values (0, 'a', 'a'), (1, 'ab','ab'), (2, 'abc', 'abc'), (3, 'abcde', 'abcde')",
@@ -9694,7 +10015,7 @@ var IndexPrefixQueries = []ScriptTest{
 		Assertions: []ScriptTestAssertion{
 			{
 				Query:    "show create table t",
-				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n  `v1` varchar(10),\n  `v2` varchar(10),\n  UNIQUE KEY `v1v2` (`v1`(3),`v2`(5))\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n  `v1` varchar(10) DEFAULT NULL,\n  `v2` varchar(10) DEFAULT NULL,\n  UNIQUE KEY `v1v2` (`v1`(3),`v2`(5))\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
 			},
 			{
 				Query:    "insert into t values ('a', 'a'), ('ab','ab'), ('abc', 'abc'), ('abcde', 'abcde')",
@@ -9774,7 +10095,7 @@ var IndexPrefixQueries = []ScriptTest{
 		Assertions: []ScriptTestAssertion{
 			{
 				Query:    "show create table t",
-				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n  `i` int NOT NULL,\n  `v1` varchar(10) COLLATE utf8mb4_0900_ai_ci,\n  `v2` varchar(10) COLLATE utf8mb4_0900_ai_ci,\n  PRIMARY KEY (`i`),\n  UNIQUE KEY `v1v2` (`v1`(3),`v2`(5))\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci"}},
+				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n  `i` int NOT NULL,\n  `v1` varchar(10) DEFAULT NULL,\n  `v2` varchar(10) DEFAULT NULL,\n  PRIMARY KEY (`i`),\n  UNIQUE KEY `v1v2` (`v1`(3),`v2`(5))\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci"}},
 			},
 			{
 				Query:    "insert into t values (0, 'a', 'a'), (1, 'ab','ab'), (2, 'abc', 'abc'), (3, 'abcde', 'abcde')",
@@ -9968,6 +10289,89 @@ var IndexPrefixQueries = []ScriptTest{
 				Query:       "create table bad(c blob, index (c(3073)))",
 				ExpectedErr: sql.ErrKeyTooLong,
 			},
+			{
+				Query:    "create table latin1_limit(c varchar(10000) character set latin1, index (c(3072)))",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:       "create table bad(c varchar(10000) character set latin1, index (c(3073)))",
+				ExpectedErr: sql.ErrKeyTooLong,
+			},
+			{
+				Query:    "create table utf8mb3_limit(c varchar(10000) character set utf8mb3, index (c(1024)))",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:       "create table bad(c varchar(10000) character set utf8mb3, index (c(1025)))",
+				ExpectedErr: sql.ErrKeyTooLong,
+			},
+		},
+	},
+	{
+		Name: "prefix index lookups and uniqueness",
+		SetUpScript: []string{
+			"create table t (i int primary key, v varchar(20), unique key v_prefix (v(3)))",
+			"insert into t values (1, 'abcdef'), (2, 'abd'), (3, 'ééé1'), (4, 'zzz')",
+			"create table t2 (i int primary key, v varchar(20), key v_prefix (v(3)))",
+			"insert into t2 values (1, 'abcdef'), (2, 'abcxyz'), (3, 'abd'), (4, 'ab'), (5, 'zzz')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "insert into t values (5, 'abcxyz')",
+				ExpectedErr: sql.ErrUniqueKeyViolation,
+			},
+			{
+				// the prefix is in characters, not bytes
+				Query:       "insert into t values (5, 'ééé2')",
+				ExpectedErr: sql.ErrUniqueKeyViolation,
+			},
+			{
+				Query:    "insert into t values (5, 'éab'), (6, 'éac')",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:       "update t set v = 'zzzz' where i = 1",
+				ExpectedErr: sql.ErrUniqueKeyViolation,
+			},
+			{
+				Query:    "select i from t where v = 'abcdef'",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "select i from t where v = 'abc'",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "select i from t2 where v = 'abcxyz'",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    "select i from t2 where v > 'abcdzz' order by i",
+				Expected: []sql.Row{{2}, {3}, {5}},
+			},
+			{
+				Query:    "select i from t2 where v >= 'abcdef' and v < 'abcxyz' order by i",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "select i from t2 where v < 'abcdef' order by i",
+				Expected: []sql.Row{{4}},
+			},
+			{
+				Query:    "select i from t2 where v between 'ab' and 'abc' order by i",
+				Expected: []sql.Row{{4}},
+			},
+			{
+				Query:    "select i from t2 where v in ('abcdef', 'abd', 'abcd') order by i",
+				Expected: []sql.Row{{1}, {3}},
+			},
+			{
+				Query: "show index from t2",
+				Expected: []sql.Row{
+					{"t2", 0, "PRIMARY", 1, "i", "A", 5, nil, nil, "", "BTREE", "", "", "YES", nil},
+					{"t2", 1, "v_prefix", 1, "v", "A", nil, int64(3), nil, "YES", "BTREE", "", "", "YES", nil},
+				},
+			},
 		},
 	},
 }
@@ -31,12 +31,12 @@ var PlanTests = []QueryPlanTest{
 			" │   ├─ xy.x:1!null\n" +
 			" │   └─ scalarSubq0.a:0!null\n" +
 			" ├─ OrderedDistinct\n" +
-			" │   └─ TableAlias(scalarSubq0)\n" +
+			" │   └─ TableAlias(`scalarSubq0`)\n" +
 			" │       └─ Table\n" +
-			" │           ├─ name: ab\n" +
+			" │           ├─ name: mydb.ab\n" +
 			" │           └─ columns: [a]\n" +
-			" └─ IndexedTableAccess(xy)\n" +
-			"     ├─ index: [xy.x]\n" +
+			" └─ IndexedTableAccess(mydb.xy)\n" +
+			"     ├─ index: `PRIMARY` [xy.x]\n" +
 			"     └─ columns: [x y]\n" +
 			"",
 	},
@@ -53,16 +53,16 @@ var PlanTests = []QueryPlanTest{
 			" │           ├─ cmp: Eq\n" +
 			" │           │   ├─ ab.a:0!null\n" +
 			" │           │   └─ uv.u:1!null\n" +
-			" │           ├─ IndexedTableAccess(ab)\n" +
-			" │           │   ├─ index: [ab.a]\n" +
+			" │           ├─ IndexedTableAccess(mydb.ab)\n" +
+			" │           │   ├─ index: `PRIMARY` [ab.a]\n" +
 			" │           │   ├─ static: [{[NULL, ∞)}]\n" +
 			" │           │   └─ columns: [a]\n" +
-			" │           └─ IndexedTableAccess(uv)\n" +
-			" │               ├─ index: [uv.u]\n" +
+			" │           └─ IndexedTableAccess(mydb.uv)\n" +
+			" │               ├─ index: `PRIMARY` [uv.u]\n" +
 			" │               ├─ static: [{[NULL, ∞)}]\n" +
 			" │               └─ columns: [u]\n" +
-			" └─ IndexedTableAccess(xy)\n" +
-			"     ├─ index: [xy.x]\n" +
+			" └─ IndexedTableAccess(mydb.xy)\n" +
+			"     ├─ index: `PRIMARY` [xy.x]\n" +
 			"     └─ columns: [x y]\n" +
 			"",
 	},
@@ -74,21 +74,21 @@ var PlanTests = []QueryPlanTest{
 			" │       ├─ EXISTS Subquery\n" +
 			" │       │   ├─ cacheable: true\n" +
 			" │       │   └─ Table\n" +
-			" │       │       ├─ name: xy\n" +
+			" │       │       ├─ name: mydb.xy\n" +
 			" │       │       └─ columns: [x y]\n" +
 			" │       └─ NOT\n" +
 			" │           └─ AND\n" +
 			" │               ├─ EXISTS Subquery\n" +
 			" │               │   ├─ cacheable: true\n" +
 			" │               │   └─ Table\n" +
-			" │               │       ├─ name: xy\n" +
+			" │               │       ├─ name: mydb.xy\n" +
 			" │               │       └─ columns: [x y]\n" +
 			" │               └─ NOT\n" +
 			" │                   └─ Eq\n" +
 			" │                       ├─ uv.u:0!null\n" +
 			" │                       └─ 1 (tinyint)\n" +
 			" └─ Table\n" +
-			"     ├─ name: uv\n" +
+			"     ├─ name: mydb.uv\n" +
 			"     └─ columns: [u v]\n" +
 			"",
 	},
@@ -105,7 +105,7 @@ var PlanTests = []QueryPlanTest{
 			"     │   └─ scalarSubq0.(select u from uv where u = sq.p):0\n" +
 			"     ├─ Distinct\n" +
 			"     │   └─ SubqueryAlias\n" +
-			"     │       ├─ name: scalarSubq0\n" +
+			"     │       ├─ name: `scalarSubq0`\n" +
 			"     │       ├─ outerVisibility: false\n" +
 			"     │       ├─ cacheable: true\n" +
 			"     │       └─ Project\n" +
@@ -115,8 +115,8 @@ var PlanTests = []QueryPlanTest{
 			"     │           │       ├─ Eq\n" +
 			"     │           │       │   ├─ uv.u:1!null\n" +
 			"     │           │       │   └─ sq.p:0!null\n" +
-			"     │           │       └─ IndexedTableAccess(uv)\n" +
-			"     │           │           ├─ index: [uv.u]\n" +
+			"     │           │       └─ IndexedTableAccess(mydb.uv)\n" +
+			"     │           │           ├─ index: `PRIMARY` [uv.u]\n" +
 			"     │           │           └─ columns: [u]\n" +
 			"     │           │   as (select u from uv where u = sq.p)]\n" +
 			"     │           └─ SubqueryAlias\n" +
@@ -124,10 +124,10 @@ var PlanTests = []QueryPlanTest{
 			"     │               ├─ outerVisibility: true\n" +
 			"     │               ├─ cacheable: true\n" +
 			"     │               └─ Table\n" +
-			"     │                   ├─ name: pq\n" +
+			"     │                   ├─ name: mydb.pq\n" +
 			"     │                   └─ columns: [p]\n" +
-			"     └─ IndexedTableAccess(xy)\n" +
-			"         ├─ index: [xy.x]\n" +
+			"     └─ IndexedTableAccess(mydb.xy)\n" +
+			"         ├─ index: `PRIMARY` [xy.x]\n" +
 			"         └─ columns: [x y]\n" +
 			"",
 	},
@@ -147,12 +147,12 @@ var PlanTests = []QueryPlanTest{
 			"     │               ├─ Eq\n" +
 			"     │               │   ├─ scalarSubq0.s2:0!null\n" +
 			"     │               │   └─ second (longtext)\n" +
-			"     │               └─ TableAlias(scalarSubq0)\n" +
+			"     │               └─ TableAlias(`scalarSubq0`)\n" +
 			"     │                   └─ Table\n" +
-			"     │                       ├─ name: othertable\n" +
+			"     │                       ├─ name: mydb.othertable\n" +
 			"     │                       └─ columns: [s2 i2]\n" +
-			"     └─ IndexedTableAccess(mytable)\n" +
-			"         ├─ index: [mytable.i]\n" +
+			"     └─ IndexedTableAccess(mydb.mytable)\n" +
+			"         ├─ index: `PRIMARY` [mytable.i]\n" +
 			"         └─ columns: [i s]\n" +
 			"",
 	},
@@ -166,11 +166,11 @@ var PlanTests = []QueryPlanTest{
 			"         │   ├─ mytable.i:0!null\n" +
 			"         │   └─ scalarSubq0.i2:2!null\n" +
 			"         ├─ Table\n" +
-			"         │   ├─ name: mytable\n" +
+			"         │   ├─ name: mydb.mytable\n" +
 			"         │   └─ columns: [i s]\n" +
-			"         └─ TableAlias(scalarSubq0)\n" +
-			"             └─ IndexedTableAccess(othertable)\n" +
-			"                 ├─ index: [othertable.i2]\n" +
+			"         └─ TableAlias(`scalarSubq0`)\n" +
+			"             └─ IndexedTableAccess(mydb.othertable)\n" +
+			"                 ├─ index: `PRIMARY` [othertable.i2]\n" +
 			"                 └─ columns: [i2]\n" +
 			"",
 	},
@@ -181,12 +181,12 @@ var PlanTests = []QueryPlanTest{
 			"     ├─ cmp: Eq\n" +
 			"     │   ├─ rs.s:1\n" +
 			"     │   └─ xy.y:3\n" +
-			"     ├─ IndexedTableAccess(rs)\n" +
-			"     │   ├─ index: [rs.s]\n" +
+			"     ├─ IndexedTableAccess(mydb.rs)\n" +
+			"     │   ├─ index: s_idx [rs.s]\n" +
 			"     │   ├─ static: [{[NULL, ∞)}]\n" +
 			"     │   └─ columns: [r s]\n" +
-			"     └─ IndexedTableAccess(xy)\n" +
-			"         ├─ index: [xy.y]\n" +
+			"     └─ IndexedTableAccess(mydb.xy)\n" +
+			"         ├─ index: y_idx [xy.y]\n" +
 			"         ├─ static: [{[NULL, ∞)}]\n" +
 			"         └─ columns: [x y]\n" +
 			"",
@@ -207,12 +207,12 @@ var PlanTests = []QueryPlanTest{
 			"     │       ├─ cmp: Eq\n" +
 			"     │       │   ├─ ab.a:0!null\n" +
 			"     │       │   └─ xy.y:3\n" +
-			"     │       ├─ IndexedTableAccess(ab)\n" +
-			"     │       │   ├─ index: [ab.a]\n" +
+			"     │       ├─ IndexedTableAccess(mydb.ab)\n" +
+			"     │       │   ├─ index: `PRIMARY` [ab.a]\n" +
 			"     │       │   ├─ static: [{[NULL, ∞)}]\n" +
 			"     │       │   └─ columns: [a b]\n" +
-			"     │       └─ IndexedTableAccess(xy)\n" +
-			"     │           ├─ index: [xy.y]\n" +
+			"     │       └─ IndexedTableAccess(mydb.xy)\n" +
+			"     │           ├─ index: y_idx [xy.y]\n" +
 			"     │           ├─ static: [{[NULL, ∞)}]\n" +
 			"     │           └─ columns: [x y]\n" +
 			"     └─ HashLookup\n" +
@@ -220,7 +220,7 @@ var PlanTests = []QueryPlanTest{
 			"         ├─ target: TUPLE(uv.u:0!null)\n" +
 			"         └─ CachedResults\n" +
 			"             └─ Table\n" +
-			"                 ├─ name: uv\n" +
+			"                 ├─ name: mydb.uv\n" +
 			"                 └─ columns: [u v]\n" +
 			"",
 	},
@@ -230,12 +230,12 @@ var PlanTests = []QueryPlanTest{
 			" ├─ cmp: Eq\n" +
 			" │   ├─ ab.a:0!null\n" +
 			" │   └─ xy.y:3\n" +
-			" ├─ IndexedTableAccess(ab)\n" +
-			" │   ├─ index: [ab.a]\n" +
+			" ├─ IndexedTableAccess(mydb.ab)\n" +
+			" │   ├─ index: `PRIMARY` [ab.a]\n" +
 			" │   ├─ static: [{[NULL, ∞)}]\n" +
 			" │   └─ columns: [a b]\n" +
-			" └─ IndexedTableAccess(xy)\n" +
-			"     ├─ index: [xy.y]\n" +
+			" └─ IndexedTableAccess(mydb.xy)\n" +
+			"     ├─ index: y_idx [xy.y]\n" +
 			"     ├─ static: [{[NULL, ∞)}]\n" +
 			"     └─ columns: [x y]\n" +
 			"",
@@ -247,12 +247,12 @@ var PlanTests = []QueryPlanTest{
 			"     ├─ cmp: Eq\n" +
 			"     │   ├─ rs.s:1\n" +
 			"     │   └─ xy.y:3\n" +
-			"     ├─ IndexedTableAccess(rs)\n" +
-			"     │   ├─ index: [rs.s]\n" +
+			"     ├─ IndexedTableAccess(mydb.rs)\n" +
+			"     │   ├─ index: s_idx [rs.s]\n" +
 			"     │   ├─ static: [{[NULL, ∞)}]\n" +
 			"     │   └─ columns: [r s]\n" +
-			"     └─ IndexedTableAccess(xy)\n" +
-			"         ├─ index: [xy.y]\n" +
+			"     └─ IndexedTableAccess(mydb.xy)\n" +
+			"         ├─ index: y_idx [xy.y]\n" +
 			"         ├─ static: [{[NULL, ∞)}]\n" +
 			"         └─ columns: [x y]\n" +
 			"",
@@ -263,12 +263,12 @@ var PlanTests = []QueryPlanTest{
 			" ├─ cmp: Eq\n" +
 			" │   ├─ rs.s:1\n" +
 			" │   └─ xy.y:3\n" +
-			" ├─ IndexedTableAccess(rs)\n" +
-			" │   ├─ index: [rs.s]\n" +
+			" ├─ IndexedTableAccess(mydb.rs)\n" +
+			" │   ├─ index: s_idx [rs.s]\n" +
 			" │   ├─ static: [{[NULL, ∞)}]\n" +
 			" │   └─ columns: [r s]\n" +
-			" └─ IndexedTableAccess(xy)\n" +
-			"     ├─ index: [xy.y]\n" +
+			" └─ IndexedTableAccess(mydb.xy)\n" +
+			"     ├─ index: y_idx [xy.y]\n" +
 			"     ├─ static: [{[NULL, ∞)}]\n" +
 			"     └─ columns: [x y]\n" +
 			"",
@@ -279,12 +279,12 @@ var PlanTests = []QueryPlanTest{
 			" ├─ cmp: Eq\n" +
 			" │   ├─ rs.s:1\n" +
 			" │   └─ (xy.y:3 + 10 (tinyint))\n" +
-			" ├─ IndexedTableAccess(rs)\n" +
-			" │   ├─ index: [rs.s]\n" +
+			" ├─ IndexedTableAccess(mydb.rs)\n" +
+			" │   ├─ index: s_idx [rs.s]\n" +
 			" │   ├─ static: [{[NULL, ∞)}]\n" +
 			" │   └─ columns: [r s]\n" +
-			" └─ IndexedTableAccess(xy)\n" +
-			"     ├─ index: [xy.y]\n" +
+			" └─ IndexedTableAccess(mydb.xy)\n" +
+			"     ├─ index: y_idx [xy.y]\n" +
 			"     ├─ static: [{[NULL, ∞)}]\n" +
 			"     └─ columns: [x y]\n" +
 			"",
@@ -296,10 +296,10 @@ var PlanTests = []QueryPlanTest{
 			" │   ├─ 10 (tinyint)\n" +
 			" │   └─ (rs.s:1 + xy.y:3)\n" +
 			" ├─ Table\n" +
-			" │   ├─ name: rs\n" +
+			" │   ├─ name: mydb.rs\n" +
 			" │   └─ columns: [r s]\n" +
 			" └─ Table\n" +
-			"     ├─ name: xy\n" +
+			"     ├─ name: mydb.xy\n" +
 			"     └─ columns: [x y]\n" +
 			"",
 	},
@@ -321,14 +321,14 @@ var PlanTests = []QueryPlanTest{
 			" │               │           ├─ Eq\n" +
 			" │               │           │   ├─ uv.u:4!null\n" +
 			" │               │           │   └─ ab.a:0!null\n" +
-			" │               │           └─ IndexedTableAccess(uv)\n" +
-			" │               │               ├─ index: [uv.u]\n" +
+			" │               │           └─ IndexedTableAccess(mydb.uv)\n" +
+			" │               │               ├─ index: `PRIMARY` [uv.u]\n" +
 			" │               │               └─ columns: [u]\n" +
 			" │               └─ Table\n" +
-			" │                   ├─ name: xy\n" +
+			" │                   ├─ name: mydb.xy\n" +
 			" │                   └─ columns: [x y]\n" +
 			" └─ Table\n" +
-			"     ├─ name: ab\n" +
+			"     ├─ name: mydb.ab\n" +
 			"     └─ columns: [a b]\n" +
 			"",
 	},
@@ -351,13 +351,13 @@ var PlanTests = []QueryPlanTest{
 			" │               │           │   ├─ uv.v:4\n" +
 			" │               │           │   └─ ab.a:0!null\n" +
 			" │               │           └─ Table\n" +
-			" │               │               ├─ name: uv\n" +
+			" │               │               ├─ name: mydb.uv\n" +
 			" │               │               └─ columns: [v]\n" +
 			" │               └─ Table\n" +
-			" │                   ├─ name: xy\n" +
+			" │                   ├─ name: mydb.xy\n" +
 			" │                   └─ columns: [x y]\n" +
 			" └─ Table\n" +
-			"     ├─ name: ab\n" +
+			"     ├─ name: mydb.ab\n" +
 			"     └─ columns: [a b]\n" +
 			"",
 	},
@@ -380,13 +380,13 @@ var PlanTests = []QueryPlanTest{
 			" │               │           │   ├─ uv.v:4\n" +
 			" │               │           │   └─ ab.b:1\n" +
 			" │               │           └─ Table\n" +
-			" │               │               ├─ name: uv\n" +
+			" │               │               ├─ name: mydb.uv\n" +
 			" │               │               └─ columns: [v]\n" +
 			" │               └─ Table\n" +
-			" │                   ├─ name: xy\n" +
+			" │                   ├─ name: mydb.xy\n" +
 			" │                   └─ columns: [x y]\n" +
 			" └─ Table\n" +
-			"     ├─ name: ab\n" +
+			"     ├─ name: mydb.ab\n" +
 			"     └─ columns: [a b]\n" +
 			"",
 	},
@@ -412,20 +412,20 @@ var PlanTests = []QueryPlanTest{
 			"         │               │           │   ├─ uv.v:6\n" +
 			"         │               │           │   └─ ab.b:3\n" +
 			"         │               │           └─ Table\n" +
-			"         │               │               ├─ name: uv\n" +
+			"         │               │               ├─ name: mydb.uv\n" +
 			"         │               │               └─ columns: [v]\n" +
 			"         │               └─ Table\n" +
-			"         │                   ├─ name: xy\n" +
+			"         │                   ├─ name: mydb.xy\n" +
 			"         │                   └─ columns: [x y]\n" +
 			"         └─ LookupJoin\n" +
 			"             ├─ Eq\n" +
 			"             │   ├─ ab.a:2!null\n" +
 			"             │   └─ pq.p:0!null\n" +
 			"             ├─ Table\n" +
-			"             │   ├─ name: pq\n" +
+			"             │   ├─ name: mydb.pq\n" +
 			"             │   └─ columns: [p q]\n" +
-			"             └─ IndexedTableAccess(ab)\n" +
-			"                 ├─ index: [ab.a]\n" +
+			"             └─ IndexedTableAccess(mydb.ab)\n" +
+			"                 ├─ index: `PRIMARY` [ab.a]\n" +
 			"                 └─ columns: [a b]\n" +
 			"",
 	},
@@ -445,8 +445,8 @@ var PlanTests = []QueryPlanTest{
 			"     │           │   └─ Eq\n" +
 			"     │           │       ├─ uv.u:4!null\n" +
 			"     │           │       └─ xy.x:2!null\n" +
-			"     │           └─ IndexedTableAccess(uv)\n" +
-			"     │               ├─ index: [uv.u]\n" +
+			"     │           └─ IndexedTableAccess(mydb.uv)\n" +
+			"     │               ├─ index: `PRIMARY` [uv.u]\n" +
 			"     │               └─ columns: [u]\n" +
 			"     │   as is_one]\n" +
 			"     └─ LookupJoin\n" +
@@ -454,10 +454,10 @@ var PlanTests = []QueryPlanTest{
 			"         │   ├─ xy.x:2!null\n" +
 			"         │   └─ uv.v:1\n" +
 			"         ├─ Table\n" +
-			"         │   ├─ name: uv\n" +
+			"         │   ├─ name: mydb.uv\n" +
 			"         │   └─ columns: [u v]\n" +
-			"         └─ IndexedTableAccess(xy)\n" +
-			"             ├─ index: [xy.x]\n" +
+			"         └─ IndexedTableAccess(mydb.xy)\n" +
+			"             ├─ index: `PRIMARY` [xy.x]\n" +
 			"             └─ columns: [x y]\n" +
 			"",
 	},
@@ -486,10 +486,10 @@ var PlanTests = []QueryPlanTest{
 			"             │   ├─ xy.x:2!null\n" +
 			"             │   └─ uv.v:1\n" +
 			"             ├─ Table\n" +
-			"             │   ├─ name: uv\n" +
+			"             │   ├─ name: mydb.uv\n" +
 			"             │   └─ columns: [u v]\n" +
-			"             └─ IndexedTableAccess(xy)\n" +
-			"                 ├─ index: [xy.x]\n" +
+			"             └─ IndexedTableAccess(mydb.xy)\n" +
+			"                 ├─ index: `PRIMARY` [xy.x]\n" +
 			"                 └─ columns: [x y]\n" +
 			"",
 	},
@@ -513,10 +513,10 @@ var PlanTests = []QueryPlanTest{
 			"     │   ├─ xy.x:2!null\n" +
 			"     │   └─ uv.v:1\n" +
 			"     ├─ Table\n" +
-			"     │   ├─ name: uv\n" +
+			"     │   ├─ name: mydb.uv\n" +
 			"     │   └─ columns: [u v]\n" +
-			"     └─ IndexedTableAccess(xy)\n" +
-			"         ├─ index: [xy.x]\n" +
+			"     └─ IndexedTableAccess(mydb.xy)\n" +
+			"         ├─ index: `PRIMARY` [xy.x]\n" +
 			"         └─ columns: [x y]\n" +
 			"",
 	},
@@ -530,7 +530,7 @@ var PlanTests = []QueryPlanTest{
 			"     └─ Project\n" +
 			"         ├─ columns: [mytable.i:0!null]\n" +
 			"         └─ Table\n" +
-			"             ├─ name: mytable\n" +
+			"             ├─ name: mydb.mytable\n" +
 			"             └─ columns: [i s]\n" +
 			"",
 	},
@@ -556,8 +556,8 @@ var PlanTests = []QueryPlanTest{
 			"             │       ├─ Eq\n" +
 			"             │       │   ├─ bus_routes.origin:0!null\n" +
 			"             │       │   └─ New York (longtext)\n" +
-			"             │       └─ IndexedTableAccess(bus_routes)\n" +
-			"             │           ├─ index: [bus_routes.origin,bus_routes.dst]\n" +
+			"             │       └─ IndexedTableAccess(mydb.bus_routes)\n" +
+			"             │           ├─ index: `PRIMARY` [bus_routes.origin,bus_routes.dst]\n" +
 			"             │           ├─ static: [{[New York, New York], [NULL, ∞)}]\n" +
 			"             │           └─ columns: [origin]\n" +
 			"             └─ Project\n" +
@@ -572,7 +572,7 @@ var PlanTests = []QueryPlanTest{
 			"                         ├─ target: TUPLE(concat(bus_routes.origin:0!null,aa (longtext)))\n" +
 			"                         └─ CachedResults\n" +
 			"                             └─ Table\n" +
-			"                                 ├─ name: bus_routes\n" +
+			"                                 ├─ name: mydb.bus_routes\n" +
 			"                                 └─ columns: [origin dst]\n" +
 			"",
 	},
@@ -584,10 +584,10 @@ var PlanTests = []QueryPlanTest{
 			"     │   ├─ xy.x:0!null\n" +
 			"     │   └─ scalarSubq0.u:2!null\n" +
 			"     ├─ Table\n" +
-			"     │   ├─ name: xy\n" +
+			"     │   ├─ name: mydb.xy\n" +
 			"     │   └─ columns: [x y]\n" +
 			"     └─ SubqueryAlias\n" +
-			"         ├─ name: scalarSubq0\n" +
+			"         ├─ name: `scalarSubq0`\n" +
 			"         ├─ outerVisibility: false\n" +
 			"         ├─ cacheable: true\n" +
 			"         └─ Project\n" +
@@ -603,7 +603,7 @@ var PlanTests = []QueryPlanTest{
 			"                         │   ├─ cte2.u:1!null\n" +
 			"                         │   └─ ab.b:0\n" +
 			"                         ├─ Table\n" +
-			"                         │   ├─ name: ab\n" +
+			"                         │   ├─ name: mydb.ab\n" +
 			"                         │   └─ columns: [b]\n" +
 			"                         └─ HashLookup\n" +
 			"                             ├─ source: TUPLE(ab.b:0)\n" +
@@ -620,7 +620,7 @@ var PlanTests = []QueryPlanTest{
 			"                                             │   ├─ uv.u:1!null\n" +
 			"                                             │   └─ ab.b:0\n" +
 			"                                             ├─ Table\n" +
-			"                                             │   ├─ name: ab\n" +
+			"                                             │   ├─ name: mydb.ab\n" +
 			"                                             │   └─ columns: [b]\n" +
 			"                                             └─ HashLookup\n" +
 			"                                                 ├─ source: TUPLE(ab.b:0)\n" +
@@ -630,8 +630,8 @@ var PlanTests = []QueryPlanTest{
 			"                                                         ├─ HashIn\n" +
 			"                                                         │   ├─ uv.u:0!null\n" +
 			"                                                         │   └─ TUPLE(2 (tinyint), 3 (tinyint))\n" +
-			"                                                         └─ IndexedTableAccess(uv)\n" +
-			"                                                             ├─ index: [uv.u]\n" +
+			"                                                         └─ IndexedTableAccess(mydb.uv)\n" +
+			"                                                             ├─ index: `PRIMARY` [uv.u]\n" +
 			"                                                             ├─ static: [{[2, 2]}, {[3, 3]}]\n" +
 			"                                                             └─ columns: [u v]\n" +
 			"",
@@ -645,7 +645,7 @@ var PlanTests = []QueryPlanTest{
 			"         ├─ lag(mytable.i, 1) over ( order by mytable.s ASC)\n" +
 			"         ├─ mytable.i:0!null\n" +
 			"         └─ Table\n" +
-			"             ├─ name: mytable\n" +
+			"             ├─ name: mydb.mytable\n" +
 			"             └─ columns: [i s]\n" +
 			"",
 	},
@@ -659,7 +659,7 @@ var PlanTests = []QueryPlanTest{
 			"         ├─ lag(floattable.i, 1) over ( order by floattable.f64 ASC)\n" +
 			"         ├─ floattable.f32:1!null\n" +
 			"         └─ Table\n" +
-			"             ├─ name: floattable\n" +
+			"             ├─ name: mydb.floattable\n" +
 			"             └─ columns: [i f32 f64]\n" +
 			"",
 	},
@@ -672,7 +672,7 @@ var PlanTests = []QueryPlanTest{
 			"     │   ├─ xy.y:4\n" +
 			"     │   └─ ab.b:0\n" +
 			"     ├─ Table\n" +
-			"     │   ├─ name: ab\n" +
+			"     │   ├─ name: mydb.ab\n" +
 			"     │   └─ columns: [b]\n" +
 			"     └─ HashLookup\n" +
 			"         ├─ source: TUPLE(ab.b:0)\n" +
@@ -686,12 +686,12 @@ var PlanTests = []QueryPlanTest{
 			"                 │   ├─ Eq\n" +
 			"                 │   │   ├─ uv.u:0!null\n" +
 			"                 │   │   └─ -1 (tinyint)\n" +
-			"                 │   └─ IndexedTableAccess(uv)\n" +
-			"                 │       ├─ index: [uv.u]\n" +
+			"                 │   └─ IndexedTableAccess(mydb.uv)\n" +
+			"                 │       ├─ index: `PRIMARY` [uv.u]\n" +
 			"                 │       ├─ static: [{[-1, -1]}]\n" +
 			"                 │       └─ columns: [u v]\n" +
-			"                 └─ IndexedTableAccess(xy)\n" +
-			"                     ├─ index: [xy.y]\n" +
+			"                 └─ IndexedTableAccess(mydb.xy)\n" +
+			"                     ├─ index: y_idx [xy.y]\n" +
 			"                     └─ columns: [x y]\n" +
 			"",
 	},
@@ -714,10 +714,10 @@ var PlanTests = []QueryPlanTest{
 			"     │           │   ├─ xy.x:2!null\n" +
 			"     │           │   └─ pq.p:0!null\n" +
 			"     │           ├─ Table\n" +
-			"     │           │   ├─ name: pq\n" +
+			"     │           │   ├─ name: mydb.pq\n" +
 			"     │           │   └─ columns: [p q]\n" +
-			"     │           └─ IndexedTableAccess(xy)\n" +
-			"     │               ├─ index: [xy.x]\n" +
+			"     │           └─ IndexedTableAccess(mydb.xy)\n" +
+			"     │               ├─ index: `PRIMARY` [xy.x]\n" +
 			"     │               └─ columns: [x]\n" +
 			"     └─ HashLookup\n" +
 			"         ├─ source: TUPLE(xq.x:0!null)\n" +
@@ -734,10 +734,10 @@ var PlanTests = []QueryPlanTest{
 			"                         │   ├─ ab.a:2!null\n" +
 			"                         │   └─ uv.u:0!null\n" +
 			"                         ├─ Table\n" +
-			"                         │   ├─ name: uv\n" +
+			"                         │   ├─ name: mydb.uv\n" +
 			"                         │   └─ columns: [u v]\n" +
-			"                         └─ IndexedTableAccess(ab)\n" +
-			"                             ├─ index: [ab.a]\n" +
+			"                         └─ IndexedTableAccess(mydb.ab)\n" +
+			"                             ├─ index: `PRIMARY` [ab.a]\n" +
 			"                             └─ columns: [a]\n" +
 			"",
 	},
@@ -759,15 +759,15 @@ var PlanTests = []QueryPlanTest{
 			" │       │       └─ t2.s:3!null\n" +
 			" │       ├─ TableAlias(t1)\n" +
 			" │       │   └─ Table\n" +
-			" │       │       ├─ name: mytable\n" +
+			" │       │       ├─ name: mydb.mytable\n" +
 			" │       │       └─ columns: [i s]\n" +
 			" │       └─ TableAlias(t2)\n" +
 			" │           └─ Table\n" +
-			" │               ├─ name: mytable\n" +
+			" │               ├─ name: mydb.mytable\n" +
 			" │               └─ columns: [i s]\n" +
 			" └─ TableAlias(t3)\n" +
 			"     └─ Table\n" +
-			"         ├─ name: othertable\n" +
+			"         ├─ name: mydb.othertable\n" +
 			"         └─ columns: [s2 i2]\n" +
 			"",
 	},
@@ -788,18 +788,18 @@ var PlanTests = []QueryPlanTest{
 			"     │       ├─ (ab.a:0!null + 3 (tinyint))\n" +
 			"     │       └─ xy.x:1!null\n" +
 			"     ├─ Table\n" +
-			"     │   ├─ name: ab\n" +
+			"     │   ├─ name: mydb.ab\n" +
 			"     │   └─ columns: [a]\n" +
 			"     └─ Concat\n" +
-			"         ├─ IndexedTableAccess(xy)\n" +
-			"         │   ├─ index: [xy.x]\n" +
+			"         ├─ IndexedTableAccess(mydb.xy)\n" +
+			"         │   ├─ index: `PRIMARY` [xy.x]\n" +
 			"         │   └─ columns: [x]\n" +
 			"         └─ Concat\n" +
-			"             ├─ IndexedTableAccess(xy)\n" +
-			"             │   ├─ index: [xy.x]\n" +
+			"             ├─ IndexedTableAccess(mydb.xy)\n" +
+			"             │   ├─ index: `PRIMARY` [xy.x]\n" +
 			"             │   └─ columns: [x]\n" +
-			"             └─ IndexedTableAccess(xy)\n" +
-			"                 ├─ index: [xy.x]\n" +
+			"             └─ IndexedTableAccess(mydb.xy)\n" +
+			"                 ├─ index: `PRIMARY` [xy.x]\n" +
 			"                 └─ columns: [x]\n" +
 			"",
 	},
@@ -820,14 +820,14 @@ var PlanTests = []QueryPlanTest{
 			" │               │   └─ Project\n" +
 			" │               │       ├─ columns: [uv.u:2!null]\n" +
 			" │               │       └─ Table\n" +
-			" │               │           ├─ name: uv\n" +
+			" │               │           ├─ name: mydb.uv\n" +
 			" │               │           └─ columns: [u v]\n" +
-			" │               └─ IndexedTableAccess(ab)\n" +
-			" │                   ├─ index: [ab.a]\n" +
+			" │               └─ IndexedTableAccess(mydb.ab)\n" +
+			" │                   ├─ index: `PRIMARY` [ab.a]\n" +
 			" │                   └─ columns: [a b]\n" +
 			" │   as s]\n" +
 			" └─ Table\n" +
-			"     ├─ name: xy\n" +
+			"     ├─ name: mydb.xy\n" +
 			"     └─ columns: [x y]\n" +
 			"",
 	},
@@ -838,7 +838,7 @@ var PlanTests = []QueryPlanTest{
 			" ├─ outerVisibility: false\n" +
 			" ├─ cacheable: true\n" +
 			" └─ Table\n" +
-			"     ├─ name: ab\n" +
+			"     ├─ name: mydb.ab\n" +
 			"     └─ columns: [a b]\n" +
 			"",
 	},
@@ -851,11 +851,11 @@ var PlanTests = []QueryPlanTest{
 			" │   │   ├─ ab.a:0!null\n" +
 			" │   │   └─ 1 (tinyint)\n" +
 			" │   └─ Table\n" +
-			" │       ├─ name: ab\n" +
+			" │       ├─ name: mydb.ab\n" +
 			" │       └─ columns: [a b]\n" +
 			" └─ Limit(1)\n" +
 			"     └─ Table\n" +
-			"         ├─ name: uv\n" +
+			"         ├─ name: mydb.uv\n" +
 			"         └─ columns: [u v]\n" +
 			"",
 	},
@@ -864,7 +864,7 @@ var PlanTests = []QueryPlanTest{
 		ExpectedPlan: "SemiJoin\n" +
 			" ├─ true (tinyint)\n" +
 			" ├─ Table\n" +
-			" │   ├─ name: ab\n" +
+			" │   ├─ name: mydb.ab\n" +
 			" │   └─ columns: [a b]\n" +
 			" └─ Limit(1)\n" +
 			"     └─ Filter\n" +
@@ -873,7 +873,7 @@ var PlanTests = []QueryPlanTest{
 			"         │   └─ 1 (tinyint)\n" +
 			"         └─ TableAlias(ab_1)\n" +
 			"             └─ Table\n" +
-			"                 ├─ name: ab\n" +
+			"                 ├─ name: mydb.ab\n" +
 			"                 └─ columns: [a b]\n" +
 			"",
 	},
@@ -889,10 +889,10 @@ var PlanTests = []QueryPlanTest{
 			" │       └─ 1 (tinyint)\n" +
 			" ├─ TableAlias(s)\n" +
 			" │   └─ Table\n" +
-			" │       ├─ name: ab\n" +
+			" │       ├─ name: mydb.ab\n" +
 			" │       └─ columns: [a b]\n" +
 			" └─ Table\n" +
-			"     ├─ name: ab\n" +
+			"     ├─ name: mydb.ab\n" +
 			"     └─ columns: [a b]\n" +
 			"",
 	},
@@ -903,10 +903,10 @@ var PlanTests = []QueryPlanTest{
 			" │   ├─ uv.u:0!null\n" +
 			" │   └─ ab.a:2!null\n" +
 			" ├─ Table\n" +
-			" │   ├─ name: uv\n" +
+			" │   ├─ name: mydb.uv\n" +
 			" │   └─ columns: [u v]\n" +
-			" └─ IndexedTableAccess(ab)\n" +
-			"     ├─ index: [ab.a]\n" +
+			" └─ IndexedTableAccess(mydb.ab)\n" +
+			"     ├─ index: `PRIMARY` [ab.a]\n" +
 			"     └─ columns: [a]\n" +
 			"",
 	},
@@ -918,7 +918,7 @@ var PlanTests = []QueryPlanTest{
 			"     ├─ select: COUNT(1 (bigint)), emptytable.i:0!null, concat(emptytable.i:0!null,emptytable.i:0!null) as concat(i, i)\n" +
 			"     ├─ group: \n" +
 			"     └─ Table\n" +
-			"         ├─ name: emptytable\n" +
+			"         ├─ name: mydb.emptytable\n" +
 			"         └─ columns: [i]\n" +
 			"",
 	},
@@ -944,10 +944,10 @@ var PlanTests = []QueryPlanTest{
 			"         │   ├─ xy.x:2!null\n" +
 			"         │   └─ ab.a:0!null\n" +
 			"         ├─ Table\n" +
-			"         │   ├─ name: ab\n" +
+			"         │   ├─ name: mydb.ab\n" +
 			"         │   └─ columns: [a b]\n" +
-			"         └─ IndexedTableAccess(xy)\n" +
-			"             ├─ index: [xy.x]\n" +
+			"         └─ IndexedTableAccess(mydb.xy)\n" +
+			"             ├─ index: `PRIMARY` [xy.x]\n" +
 			"             └─ columns: [x y]\n" +
 			"",
 	},
@@ -969,14 +969,14 @@ var PlanTests = []QueryPlanTest{
 			"     │           ├─ select: COUNT(1 (bigint))\n" +
 			"     │           ├─ group: \n" +
 			"     │           └─ Table\n" +
-			"     │               ├─ name: emptytable\n" +
+			"     │               ├─ name: mydb.emptytable\n" +
 			"     │               └─ columns: []\n" +
 			"     └─ HashLookup\n" +
 			"         ├─ source: TUPLE(uv.u:0!null)\n" +
 			"         ├─ target: TUPLE(one_pk.pk:0!null)\n" +
 			"         └─ CachedResults\n" +
 			"             └─ Table\n" +
-			"                 ├─ name: one_pk\n" +
+			"                 ├─ name: mydb.one_pk\n" +
 			"                 └─ columns: [pk]\n" +
 			"",
 	},
@@ -1003,7 +1003,7 @@ var PlanTests = []QueryPlanTest{
 			"         ├─ target: TUPLE(one_pk.pk:0!null)\n" +
 			"         └─ CachedResults\n" +
 			"             └─ Table\n" +
-			"                 ├─ name: one_pk\n" +
+			"                 ├─ name: mydb.one_pk\n" +
 			"                 └─ columns: [pk]\n" +
 			"",
 	},
@@ -1016,10 +1016,10 @@ var PlanTests = []QueryPlanTest{
 			"     │   ├─ TUPLE(one_pk.pk:0!null, 123 (tinyint))\n" +
 			"     │   └─ TUPLE(scalarSubq0.u:6!null, scalarSubq0.v:7!null)\n" +
 			"     ├─ Table\n" +
-			"     │   ├─ name: one_pk\n" +
+			"     │   ├─ name: mydb.one_pk\n" +
 			"     │   └─ columns: [pk c1 c2 c3 c4 c5]\n" +
 			"     └─ SubqueryAlias\n" +
-			"         ├─ name: scalarSubq0\n" +
+			"         ├─ name: `scalarSubq0`\n" +
 			"         ├─ outerVisibility: false\n" +
 			"         ├─ cacheable: true\n" +
 			"         └─ Project\n" +
@@ -1028,7 +1028,7 @@ var PlanTests = []QueryPlanTest{
 			"                 ├─ select: COUNT(1 (bigint))\n" +
 			"                 ├─ group: \n" +
 			"                 └─ Table\n" +
-			"                     ├─ name: emptytable\n" +
+			"                     ├─ name: mydb.emptytable\n" +
 			"                     └─ columns: []\n" +
 			"",
 	},
@@ -1041,10 +1041,10 @@ var PlanTests = []QueryPlanTest{
 			"     │   ├─ TUPLE(one_pk.pk:0!null, 123 (tinyint))\n" +
 			"     │   └─ TUPLE(scalarSubq0.u:6!null, scalarSubq0.v:7!null)\n" +
 			"     ├─ Table\n" +
-			"     │   ├─ name: one_pk\n" +
+			"     │   ├─ name: mydb.one_pk\n" +
 			"     │   └─ columns: [pk c1 c2 c3 c4 c5]\n" +
 			"     └─ SubqueryAlias\n" +
-			"         ├─ name: scalarSubq0\n" +
+			"         ├─ name: `scalarSubq0`\n" +
 			"         ├─ outerVisibility: false\n" +
 			"         ├─ cacheable: true\n" +
 			"         └─ Project\n" +
@@ -1062,7 +1062,7 @@ var PlanTests = []QueryPlanTest{
 			" └─ SemiJoin\n" +
 			"     ├─ true (tinyint)\n" +
 			"     ├─ Table\n" +
-			"     │   ├─ name: mytable\n" +
+			"     │   ├─ name: mydb.mytable\n" +
 			"     │   └─ columns: [i s]\n" +
 			"     └─ Limit(1)\n" +
 			"         └─ SubqueryAlias\n" +
@@ -1075,7 +1075,7 @@ var PlanTests = []QueryPlanTest{
 			"                     ├─ select: COUNT(1 (bigint))\n" +
 			"                     ├─ group: \n" +
 			"                     └─ Table\n" +
-			"                         ├─ name: emptytable\n" +
+			"                         ├─ name: mydb.emptytable\n" +
 			"                         └─ columns: []\n" +
 			"",
 	},
@@ -1087,8 +1087,8 @@ var PlanTests = []QueryPlanTest{
 			" │   └─ GroupBy\n" +
 			" │       ├─ select: mytable.i:1!null\n" +
 			" │       ├─ group: mytable.i:1!null\n" +
-			" │       └─ IndexedTableAccess(mytable)\n" +
-			" │           ├─ index: [mytable.i]\n" +
+			" │       └─ IndexedTableAccess(mydb.mytable)\n" +
+			" │           ├─ index: `PRIMARY` [mytable.i]\n" +
 			" │           ├─ static: [{[1, 1]}]\n" +
 			" │           └─ columns: [i]\n" +
 			" │   as (SELECT i FROM mytable WHERE i = 1 group by i)]\n" +
@@ -1114,10 +1114,10 @@ var PlanTests = []QueryPlanTest{
 			" │           ├─ outerVisibility: true\n" +
 			" │           ├─ cacheable: true\n" +
 			" │           └─ Table\n" +
-			" │               ├─ name: ab\n" +
+			" │               ├─ name: mydb.ab\n" +
 			" │               └─ columns: [a b]\n" +
-			" └─ IndexedTableAccess(xy)\n" +
-			"     ├─ index: [xy.x]\n" +
+			" └─ IndexedTableAccess(mydb.xy)\n" +
+			"     ├─ index: `PRIMARY` [xy.x]\n" +
 			"     └─ columns: [x y]\n" +
 			"",
 	},
@@ -1129,10 +1129,10 @@ var PlanTests = []QueryPlanTest{
 			"     │   ├─ ab.a:2!null\n" +
 			"     │   └─ xy.x:0!null\n" +
 			"     ├─ Table\n" +
-			"     │   ├─ name: xy\n" +
+			"     │   ├─ name: mydb.xy\n" +
 			"     │   └─ columns: [x y]\n" +
-			"     └─ IndexedTableAccess(ab)\n" +
-			"         ├─ index: [ab.a]\n" +
+			"     └─ IndexedTableAccess(mydb.ab)\n" +
+			"         ├─ index: `PRIMARY` [ab.a]\n" +
 			"         └─ columns: [a b]\n" +
 			"",
 	},
@@ -1145,13 +1145,45 @@ var PlanTests = []QueryPlanTest{
 			"         │   ├─ ab.a:2!null\n" +
 			"         │   └─ xy.x:0!null\n" +
 			"         ├─ Table\n" +
-			"         │   ├─ name: xy\n" +
+			"         │   ├─ name: mydb.xy\n" +
 			"         │   └─ columns: [x y]\n" +
-			"         └─ IndexedTableAccess(ab)\n" +
-			"             ├─ index: [ab.a]\n" +
+			"         └─ IndexedTableAccess(mydb.ab)\n" +
+			"             ├─ index: `PRIMARY` [ab.a]\n" +
 			"             └─ columns: [a b]\n" +
 			"",
 	},
+	{
+		Query: `select * from xy order by rand() limit 3`,
+		ExpectedPlan: "Limit(3)\n" +
+			" └─ ReservoirSample(3 (tinyint))\n" +
+			"     └─ Table\n" +
+			"         ├─ name: mydb.xy\n" +
+			"         └─ columns: [x y]\n" +
+			"",
+	},
+	{
+		Query: `select * from xy order by rand(1) limit 3`,
+		ExpectedPlan: "Limit(3)\n" +
+			" └─ TopN(Limit: [3 (tinyint)]; rand(1) ASC nullsFirst)\n" +
+			"     └─ Table\n" +
+			"         ├─ name: mydb.xy\n" +
+			"         └─ columns: [x y]\n" +
+			"",
+	},
+	{
+		Query: `select x from xy tablesample bernoulli (10) repeatable (1) where y > 1`,
+		ExpectedPlan: "Project\n" +
+			" ├─ columns: [xy.x:0!null]\n" +
+			" └─ Filter\n" +
+			"     ├─ GreaterThan\n" +
+			"     │   ├─ xy.y:1\n" +
+			"     │   └─ 1 (tinyint)\n" +
+			"     └─ Table\n" +
+			"         ├─ name: mydb.xy\n" +
+			"         ├─ columns: [x y]\n" +
+			"         └─ sample: BERNOULLI (10) REPEATABLE (1)\n" +
+			"",
+	},
 	{
 		Query: `
 select * from
@@ -1181,12 +1213,12 @@ inner join xy on a = x;`,
 			" │               │   ├─ cmp: Eq\n" +
 			" │               │   │   ├─ ab.a:0!null\n" +
 			" │               │   │   └─ uv.u:2!null\n" +
-			" │               │   ├─ IndexedTableAccess(ab)\n" +
-			" │               │   │   ├─ index: [ab.a]\n" +
+			" │               │   ├─ IndexedTableAccess(mydb.ab)\n" +
+			" │               │   │   ├─ index: `PRIMARY` [ab.a]\n" +
 			" │               │   │   ├─ static: [{[NULL, ∞)}]\n" +
 			" │               │   │   └─ columns: [a b]\n" +
-			" │               │   └─ IndexedTableAccess(uv)\n" +
-			" │               │       ├─ index: [uv.u]\n" +
+			" │               │   └─ IndexedTableAccess(mydb.uv)\n" +
+			" │               │       ├─ index: `PRIMARY` [uv.u]\n" +
 			" │               │       ├─ static: [{[NULL, ∞)}]\n" +
 			" │               │       └─ columns: [u v]\n" +
 			" │               └─ HashLookup\n" +
@@ -1197,10 +1229,10 @@ inner join xy on a = x;`,
 			" │                           └─ Project\n" +
 			" │                               ├─ columns: [pq.p:0!null]\n" +
 			" │                               └─ Table\n" +
-			" │                                   ├─ name: pq\n" +
+			" │                                   ├─ name: mydb.pq\n" +
 			" │                                   └─ columns: [p q]\n" +
-			" └─ IndexedTableAccess(xy)\n" +
-			"     ├─ index: [xy.x]\n" +
+			" └─ IndexedTableAccess(mydb.xy)\n" +
+			"     ├─ index: `PRIMARY` [xy.x]\n" +
 			"     └─ columns: [x y]\n" +
 			"",
 	},
@@ -1224,16 +1256,16 @@ where exists
 			" │           ├─ cmp: Eq\n" +
 			" │           │   ├─ uv.u:0!null\n" +
 			" │           │   └─ pq.p:2!null\n" +
-			" │           ├─ IndexedTableAccess(uv)\n" +
-			" │           │   ├─ index: [uv.u]\n" +
+			" │           ├─ IndexedTableAccess(mydb.uv)\n" +
+			" │           │   ├─ index: `PRIMARY` [uv.u]\n" +
 			" │           │   ├─ static: [{[NULL, ∞)}]\n" +
 			" │           │   └─ columns: [u v]\n" +
-			" │           └─ IndexedTableAccess(pq)\n" +
-			" │               ├─ index: [pq.p]\n" +
+			" │           └─ IndexedTableAccess(mydb.pq)\n" +
+			" │               ├─ index: `PRIMARY` [pq.p]\n" +
 			" │               ├─ static: [{[NULL, ∞)}]\n" +
 			" │               └─ columns: [p q]\n" +
-			" └─ IndexedTableAccess(ab)\n" +
-			"     ├─ index: [ab.a]\n" +
+			" └─ IndexedTableAccess(mydb.ab)\n" +
+			"     ├─ index: `PRIMARY` [ab.a]\n" +
 			"     └─ columns: [a b]\n" +
 			"",
 	},
@@ -1261,10 +1293,10 @@ where exists (select * from pq where a = p)
 			"     │       │   ├─ ab.a:0!null\n" +
 			"     │       │   └─ uv.u:2!null\n" +
 			"     │       ├─ Table\n" +
-			"     │       │   ├─ name: ab\n" +
+			"     │       │   ├─ name: mydb.ab\n" +
 			"     │       │   └─ columns: [a b]\n" +
-			"     │       └─ IndexedTableAccess(uv)\n" +
-			"     │           ├─ index: [uv.u]\n" +
+			"     │       └─ IndexedTableAccess(mydb.uv)\n" +
+			"     │           ├─ index: `PRIMARY` [uv.u]\n" +
 			"     │           └─ columns: [u v]\n" +
 			"     └─ HashLookup\n" +
 			"         ├─ source: TUPLE(alias1.a:0!null)\n" +
@@ -1274,7 +1306,7 @@ where exists (select * from pq where a = p)
 			"                 └─ Project\n" +
 			"                     ├─ columns: [pq.p:0!null]\n" +
 			"                     └─ Table\n" +
-			"                         ├─ name: pq\n" +
+			"                         ├─ name: mydb.pq\n" +
 			"                         └─ columns: [p q]\n" +
 			"",
 	},
@@ -1295,13 +1327,13 @@ full join pq on a = p
 			"     │   │   ├─ ab.a:2!null\n" +
 			"     │   │   └─ uv.u:0!null\n" +
 			"     │   ├─ Table\n" +
-			"     │   │   ├─ name: uv\n" +
+			"     │   │   ├─ name: mydb.uv\n" +
 			"     │   │   └─ columns: [u v]\n" +
-			"     │   └─ IndexedTableAccess(ab)\n" +
-			"     │       ├─ index: [ab.a]\n" +
+			"     │   └─ IndexedTableAccess(mydb.ab)\n" +
+			"     │       ├─ index: `PRIMARY` [ab.a]\n" +
 			"     │       └─ columns: [a b]\n" +
 			"     └─ Table\n" +
-			"         ├─ name: pq\n" +
+			"         ├─ name: mydb.pq\n" +
 			"         └─ columns: [p q]\n" +
 			"",
 	},
@@ -1323,16 +1355,16 @@ inner join pq on true
 			" │   │   ├─ cacheable: true\n" +
 			" │   │   └─ CrossJoin\n" +
 			" │   │       ├─ Table\n" +
-			" │   │       │   ├─ name: ab\n" +
+			" │   │       │   ├─ name: mydb.ab\n" +
 			" │   │       │   └─ columns: [a b]\n" +
 			" │   │       └─ Table\n" +
-			" │   │           ├─ name: xy\n" +
+			" │   │           ├─ name: mydb.xy\n" +
 			" │   │           └─ columns: [x y]\n" +
 			" │   └─ Table\n" +
-			" │       ├─ name: uv\n" +
+			" │       ├─ name: mydb.uv\n" +
 			" │       └─ columns: [u v]\n" +
 			" └─ Table\n" +
-			"     ├─ name: pq\n" +
+			"     ├─ name: mydb.pq\n" +
 			"     └─ columns: [p q]\n" +
 			"",
 	},
@@ -1367,17 +1399,17 @@ inner join pq on true
 			"         │   │       │   ├─ ab.a:0!null\n" +
 			"         │   │       │   └─ xy.x:2!null\n" +
 			"         │   │       ├─ Table\n" +
-			"         │   │       │   ├─ name: ab\n" +
+			"         │   │       │   ├─ name: mydb.ab\n" +
 			"         │   │       │   └─ columns: [a b]\n" +
-			"         │   │       └─ IndexedTableAccess(xy)\n" +
-			"         │   │           ├─ index: [xy.x]\n" +
+			"         │   │       └─ IndexedTableAccess(mydb.xy)\n" +
+			"         │   │           ├─ index: `PRIMARY` [xy.x]\n" +
 			"         │   │           └─ columns: [x y]\n" +
 			"         │   └─ HashLookup\n" +
 			"         │       ├─ source: TUPLE(alias1.a:0!null)\n" +
 			"         │       ├─ target: TUPLE(pq.p:0!null)\n" +
 			"         │       └─ CachedResults\n" +
 			"         │           └─ Table\n" +
-			"         │               ├─ name: pq\n" +
+			"         │               ├─ name: mydb.pq\n" +
 			"         │               └─ columns: [p q]\n" +
 			"         └─ HashLookup\n" +
 			"             ├─ source: TUPLE(alias1.a:0!null)\n" +
@@ -1387,7 +1419,7 @@ inner join pq on true
 			"                     └─ Project\n" +
 			"                         ├─ columns: [uv.u:0!null]\n" +
 			"                         └─ Table\n" +
-			"                             ├─ name: uv\n" +
+			"                             ├─ name: mydb.uv\n" +
 			"                             └─ columns: [u v]\n" +
 			"",
 	},
@@ -1401,11 +1433,11 @@ inner join pq on true
 			"     │   └─ b.i:2!null\n" +
 			"     ├─ TableAlias(a)\n" +
 			"     │   └─ Table\n" +
-			"     │       ├─ name: mytable\n" +
+			"     │       ├─ name: mydb.mytable\n" +
 			"     │       └─ columns: [i s]\n" +
 			"     └─ TableAlias(b)\n" +
-			"         └─ IndexedTableAccess(mytable)\n" +
-			"             ├─ index: [mytable.i]\n" +
+			"         └─ IndexedTableAccess(mydb.mytable)\n" +
+			"             ├─ index: `PRIMARY` [mytable.i]\n" +
 			"             └─ columns: [i]\n" +
 			"",
 	},
@@ -1419,11 +1451,11 @@ inner join pq on true
 			"     │   └─ b.i:2!null\n" +
 			"     ├─ TableAlias(a)\n" +
 			"     │   └─ Table\n" +
-			"     │       ├─ name: mytable\n" +
+			"     │       ├─ name: mydb.mytable\n" +
 			"     │       └─ columns: [i s]\n" +
 			"     └─ TableAlias(b)\n" +
-			"         └─ IndexedTableAccess(mytable)\n" +
-			"             ├─ index: [mytable.i]\n" +
+			"         └─ IndexedTableAccess(mydb.mytable)\n" +
+			"             ├─ index: `PRIMARY` [mytable.i]\n" +
 			"             └─ columns: [i]\n" +
 			"",
 	},
@@ -1436,10 +1468,10 @@ inner join pq on true
 			"     │   ├─ mytable.i:0!null\n" +
 			"     │   └─ othertable.i2:1!null\n" +
 			"     ├─ Table\n" +
-			"     │   ├─ name: mytable\n" +
+			"     │   ├─ name: mydb.mytable\n" +
 			"     │   └─ columns: [i]\n" +
 			"     └─ Table\n" +
-			"         ├─ name: othertable\n" +
+			"         ├─ name: mydb.othertable\n" +
 			"         └─ columns: [i2]\n" +
 			"",
 	},
@@ -1456,40 +1488,40 @@ inner join pq on true
 			"         │   ├─ cmp: Eq\n" +
 			"         │   │   ├─ othertable.i2:0!null\n" +
 			"         │   │   └─ mytable.i:1!null\n" +
-			"         │   ├─ IndexedTableAccess(othertable)\n" +
-			"         │   │   ├─ index: [othertable.i2]\n" +
+			"         │   ├─ IndexedTableAccess(mydb.othertable)\n" +
+			"         │   │   ├─ index: `PRIMARY` [othertable.i2]\n" +
 			"         │   │   ├─ static: [{[NULL, ∞)}]\n" +
 			"         │   │   └─ columns: [i2]\n" +
-			"         │   └─ IndexedTableAccess(mytable)\n" +
-			"         │       ├─ index: [mytable.i]\n" +
+			"         │   └─ IndexedTableAccess(mydb.mytable)\n" +
+			"         │       ├─ index: `PRIMARY` [mytable.i]\n" +
 			"         │       ├─ static: [{[NULL, ∞)}]\n" +
 			"         │       └─ columns: [i]\n" +
-			"         └─ TableAlias(T4)\n" +
-			"             └─ IndexedTableAccess(othertable)\n" +
-			"                 ├─ index: [othertable.i2]\n" +
+			"         └─ TableAlias(`T4`)\n" +
+			"             └─ IndexedTableAccess(mydb.othertable)\n" +
+			"                 ├─ index: `PRIMARY` [othertable.i2]\n" +
 			"                 └─ columns: [s2 i2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM one_pk ORDER BY pk`,
-		ExpectedPlan: "IndexedTableAccess(one_pk)\n" +
-			" ├─ index: [one_pk.pk]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.one_pk)\n" +
+			" ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			" ├─ static: [{[NULL, ∞)}]\n" +
 			" └─ columns: [pk c1 c2 c3 c4 c5]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM two_pk ORDER BY pk1, pk2`,
-		ExpectedPlan: "IndexedTableAccess(two_pk)\n" +
-			" ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.two_pk)\n" +
+			" ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
 			" ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk1 pk2 c1 c2 c3 c4 c5]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM two_pk ORDER BY pk1`,
-		ExpectedPlan: "IndexedTableAccess(two_pk)\n" +
-			" ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.two_pk)\n" +
+			" ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
 			" ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk1 pk2 c1 c2 c3 c4 c5]\n" +
 			"",
@@ -1498,8 +1530,8 @@ inner join pq on true
 		Query: `SELECT pk1 AS one, pk2 AS two FROM two_pk ORDER BY pk1, pk2`,
 		ExpectedPlan: "Project\n" +
 			" ├─ columns: [two_pk.pk1:0!null as one, two_pk.pk2:1!null as two]\n" +
-			" └─ IndexedTableAccess(two_pk)\n" +
-			"     ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			" └─ IndexedTableAccess(mydb.two_pk)\n" +
+			"     ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
 			"     ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk1 pk2]\n" +
 			"",
@@ -1508,8 +1540,8 @@ inner join pq on true
 		Query: `SELECT pk1 AS one, pk2 AS two FROM two_pk ORDER BY one, two`,
 		ExpectedPlan: "Project\n" +
 			" ├─ columns: [two_pk.pk1:0!null as one, two_pk.pk2:1!null as two]\n" +
-			" └─ IndexedTableAccess(two_pk)\n" +
-			"     ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			" └─ IndexedTableAccess(mydb.two_pk)\n" +
+			"     ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
 			"     ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk1 pk2]\n" +
 			"",
@@ -1531,13 +1563,13 @@ inner join pq on true
 			"         │   ├─ (t2.i:0!null + 1 (tinyint))\n" +
 			"         │   └─ t1.i:1!null\n" +
 			"         ├─ TableAlias(t2)\n" +
-			"         │   └─ IndexedTableAccess(mytable)\n" +
-			"         │       ├─ index: [mytable.i]\n" +
+			"         │   └─ IndexedTableAccess(mydb.mytable)\n" +
+			"         │       ├─ index: `PRIMARY` [mytable.i]\n" +
 			"         │       ├─ static: [{[NULL, ∞)}]\n" +
 			"         │       └─ columns: [i]\n" +
 			"         └─ TableAlias(t1)\n" +
-			"             └─ IndexedTableAccess(mytable)\n" +
-			"                 ├─ index: [mytable.i]\n" +
+			"             └─ IndexedTableAccess(mydb.mytable)\n" +
+			"                 ├─ index: `PRIMARY` [mytable.i]\n" +
 			"                 ├─ static: [{[NULL, ∞)}]\n" +
 			"                 └─ columns: [i]\n" +
 			"",
@@ -1555,12 +1587,12 @@ inner join pq on true
 			"             ├─ cmp: Eq\n" +
 			"             │   ├─ othertable.i2:0!null\n" +
 			"             │   └─ mytable.i:1!null\n" +
-			"             ├─ IndexedTableAccess(othertable)\n" +
-			"             │   ├─ index: [othertable.i2]\n" +
+			"             ├─ IndexedTableAccess(mydb.othertable)\n" +
+			"             │   ├─ index: `PRIMARY` [othertable.i2]\n" +
 			"             │   ├─ static: [{[NULL, ∞)}]\n" +
 			"             │   └─ columns: [i2]\n" +
-			"             └─ IndexedTableAccess(mytable)\n" +
-			"                 ├─ index: [mytable.i]\n" +
+			"             └─ IndexedTableAccess(mydb.mytable)\n" +
+			"                 ├─ index: `PRIMARY` [mytable.i]\n" +
 			"                 ├─ static: [{[NULL, ∞)}]\n" +
 			"                 └─ columns: [i]\n" +
 			"",
@@ -1570,8 +1602,8 @@ inner join pq on true
 		ExpectedPlan: "Filter\n" +
 			" ├─ NOT\n" +
 			" │   └─ one_pk_two_idx.v2:2 IS NULL\n" +
-			" └─ IndexedTableAccess(one_pk_two_idx)\n" +
-			"     ├─ index: [one_pk_two_idx.v1,one_pk_two_idx.v2]\n" +
+			" └─ IndexedTableAccess(mydb.one_pk_two_idx)\n" +
+			"     ├─ index: one_pk_two_idx_2 [one_pk_two_idx.v1,one_pk_two_idx.v2]\n" +
 			"     ├─ static: [{(NULL, 2), (NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2]\n" +
 			"",
@@ -1582,29 +1614,197 @@ inner join pq on true
 			" ├─ HashIn\n" +
 			" │   ├─ one_pk_two_idx.v1:1\n" +
 			" │   └─ TUPLE(1 (tinyint), 2 (tinyint))\n" +
-			" └─ IndexedTableAccess(one_pk_two_idx)\n" +
-			"     ├─ index: [one_pk_two_idx.v1,one_pk_two_idx.v2]\n" +
+			" └─ IndexedTableAccess(mydb.one_pk_two_idx)\n" +
+			"     ├─ index: one_pk_two_idx_2 [one_pk_two_idx.v1,one_pk_two_idx.v2]\n" +
 			"     ├─ static: [{[2, 2], (NULL, 2]}, {[1, 1], (NULL, 2]}]\n" +
 			"     └─ columns: [pk v1 v2]\n" +
 			"",
 	},
+	{
+		Query: `SELECT * FROM one_pk_two_idx WHERE (v1, v2) IN ((1, 1), (2, 2))`,
+		ExpectedPlan: "Filter\n" +
+			" ├─ HashIn\n" +
+			" │   ├─ TUPLE(one_pk_two_idx.v1:1, one_pk_two_idx.v2:2)\n" +
+			" │   └─ TUPLE(TUPLE(1 (tinyint), 1 (tinyint)), TUPLE(2 (tinyint), 2 (tinyint)))\n" +
+			" └─ IndexedTableAccess(mydb.one_pk_two_idx)\n" +
+			"     ├─ index: one_pk_two_idx_2 [one_pk_two_idx.v1,one_pk_two_idx.v2]\n" +
+			"     ├─ static: [{[1, 1], [1, 1]}, {[2, 2], [2, 2]}]\n" +
+			"     └─ columns: [pk v1 v2]\n" +
+			"",
+	},
+	{
+		Query: `SELECT * FROM one_pk_two_idx WHERE (v1, v2) = (1, 1)`,
+		ExpectedPlan: "Filter\n" +
+			" ├─ Eq\n" +
+			" │   ├─ TUPLE(one_pk_two_idx.v1:1, one_pk_two_idx.v2:2)\n" +
+			" │   └─ TUPLE(1 (tinyint), 1 (tinyint))\n" +
+			" └─ IndexedTableAccess(mydb.one_pk_two_idx)\n" +
+			"     ├─ index: one_pk_two_idx_2 [one_pk_two_idx.v1,one_pk_two_idx.v2]\n" +
+			"     ├─ static: [{[1, 1], [1, 1]}]\n" +
+			"     └─ columns: [pk v1 v2]\n" +
+			"",
+	},
+	{
+		Query: `SELECT * FROM one_pk_two_idx WHERE (v1, v2) <=> (1, NULL)`,
+		ExpectedPlan: "Filter\n" +
+			" ├─ (TUPLE(one_pk_two_idx.v1:1, one_pk_two_idx.v2:2) <=> TUPLE(1 (tinyint), NULL (null)))\n" +
+			" └─ IndexedTableAccess(mydb.one_pk_two_idx)\n" +
+			"     ├─ index: one_pk_two_idx_2 [one_pk_two_idx.v1,one_pk_two_idx.v2]\n" +
+			"     ├─ static: [{[1, 1], [NULL, NULL]}]\n" +
+			"     └─ columns: [pk v1 v2]\n" +
+			"",
+	},
+	{
+		Query: `SELECT * FROM two_pk WHERE (pk1, pk2) > (0, 1)`,
+		ExpectedPlan: "Filter\n" +
+			" ├─ GreaterThan\n" +
+			" │   ├─ TUPLE(two_pk.pk1:0!null, two_pk.pk2:1!null)\n" +
+			" │   └─ TUPLE(0 (tinyint), 1 (tinyint))\n" +
+			" └─ IndexedTableAccess(mydb.two_pk)\n" +
+			"     ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
+			"     ├─ static: [{[0, 0], (1, ∞)}, {(0, ∞), [NULL, ∞)}]\n" +
+			"     └─ columns: [pk1 pk2 c1 c2 c3 c4 c5]\n" +
+			"",
+	},
+	{
+		Query: `SELECT * FROM two_pk WHERE (1, 0) >= (pk1, pk2)`,
+		ExpectedPlan: "Filter\n" +
+			" ├─ GreaterThanOrEqual\n" +
+			" │   ├─ TUPLE(1 (tinyint), 0 (tinyint))\n" +
+			" │   └─ TUPLE(two_pk.pk1:0!null, two_pk.pk2:1!null)\n" +
+			" └─ IndexedTableAccess(mydb.two_pk)\n" +
+			"     ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
+			"     ├─ static: [{(NULL, 1), [NULL, ∞)}, {[1, 1], (NULL, 0]}]\n" +
+			"     └─ columns: [pk1 pk2 c1 c2 c3 c4 c5]\n" +
+			"",
+	},
+	{
+		Query: `SELECT * FROM one_pk_three_idx WHERE (v1, v2, v3) < (1, 2, 3)`,
+		ExpectedPlan: "Filter\n" +
+			" ├─ LessThan\n" +
+			" │   ├─ TUPLE(one_pk_three_idx.v1:1, one_pk_three_idx.v2:2, one_pk_three_idx.v3:3)\n" +
+			" │   └─ TUPLE(1 (tinyint), 2 (tinyint), 3 (tinyint))\n" +
+			" └─ IndexedTableAccess(mydb.one_pk_three_idx)\n" +
+			"     ├─ index: one_pk_three_idx_idx [one_pk_three_idx.v1,one_pk_three_idx.v2,one_pk_three_idx.v3]\n" +
+			"     ├─ static: [{(NULL, 1), [NULL, ∞), [NULL, ∞)}, {[1, 1], (NULL, 2), [NULL, ∞)}, {[1, 1], [2, 2], (NULL, 3)}]\n" +
+			"     └─ columns: [pk v1 v2 v3]\n" +
+			"",
+	},
+	{
+		Query: `SELECT * FROM two_pk WHERE (pk1, pk2) IN ((0, 1), (1, 0)) AND c1 > 0`,
+		ExpectedPlan: "Filter\n" +
+			" ├─ AND\n" +
+			" │   ├─ HashIn\n" +
+			" │   │   ├─ TUPLE(two_pk.pk1:0!null, two_pk.pk2:1!null)\n" +
+			" │   │   └─ TUPLE(TUPLE(0 (tinyint), 1 (tinyint)), TUPLE(1 (tinyint), 0 (tinyint)))\n" +
+			" │   └─ GreaterThan\n" +
+			" │       ├─ two_pk.c1:2!null\n" +
+			" │       └─ 0 (tinyint)\n" +
+			" └─ IndexedTableAccess(mydb.two_pk)\n" +
+			"     ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
+			"     ├─ static: [{[0, 0], [1, 1]}, {[1, 1], [0, 0]}]\n" +
+			"     └─ columns: [pk1 pk2 c1 c2 c3 c4 c5]\n" +
+			"",
+	},
 	{
 		Query: `SELECT * FROM one_pk_three_idx WHERE v1 > 2 AND v2 = 3`,
-		ExpectedPlan: "IndexedTableAccess(one_pk_three_idx)\n" +
-			" ├─ index: [one_pk_three_idx.v1,one_pk_three_idx.v2,one_pk_three_idx.v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.one_pk_three_idx)\n" +
+			" ├─ index: one_pk_three_idx_idx [one_pk_three_idx.v1,one_pk_three_idx.v2,one_pk_three_idx.v3]\n" +
 			" ├─ static: [{(2, ∞), [3, 3], [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM one_pk_three_idx WHERE v1 > 2 AND v3 = 3`,
+		ExpectedPlan: "IndexedTableAccess(mydb.one_pk_three_idx)\n" +
+			" ├─ index: one_pk_three_idx_idx [one_pk_three_idx.v1,one_pk_three_idx.v2,one_pk_three_idx.v3]\n" +
+			" ├─ static: [{(2, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ index condition: Eq\n" +
+			" │   ├─ one_pk_three_idx.v3:2\n" +
+			" │   └─ 3 (tinyint)\n" +
+			" └─ columns: [pk v1 v2 v3]\n" +
+			"",
+	},
+	{
+		Query: `SELECT * FROM one_pk_three_idx WHERE v1 = 1`,
+		ExpectedPlan: "IndexedTableAccess(mydb.one_pk_three_idx)\n" +
+			" ├─ index: one_pk_three_idx_idx [one_pk_three_idx.v1,one_pk_three_idx.v2,one_pk_three_idx.v3]\n" +
+			" ├─ static: [{[1, 1], [NULL, ∞), [NULL, ∞)}]\n" +
+			" └─ columns: [pk v1 v2 v3]\n" +
+			"",
+	},
+	{
+		Query: `SELECT * FROM one_pk_three_idx WHERE v1 = 1 AND v2 = 2`,
+		ExpectedPlan: "IndexedTableAccess(mydb.one_pk_three_idx)\n" +
+			" ├─ index: one_pk_three_idx_idx [one_pk_three_idx.v1,one_pk_three_idx.v2,one_pk_three_idx.v3]\n" +
+			" ├─ static: [{[1, 1], [2, 2], [NULL, ∞)}]\n" +
+			" └─ columns: [pk v1 v2 v3]\n" +
+			"",
+	},
+	{
+		Query: `SELECT * FROM one_pk_three_idx WHERE v1 = 1 AND v2 < 2`,
+		ExpectedPlan: "IndexedTableAccess(mydb.one_pk_three_idx)\n" +
+			" ├─ index: one_pk_three_idx_idx [one_pk_three_idx.v1,one_pk_three_idx.v2,one_pk_three_idx.v3]\n" +
+			" ├─ static: [{[1, 1], (NULL, 2), [NULL, ∞)}]\n" +
+			" └─ columns: [pk v1 v2 v3]\n" +
+			"",
+	},
+	{
+		Query: `SELECT * FROM one_pk_three_idx WHERE v2 = 2`,
 		ExpectedPlan: "Filter\n" +
 			" ├─ Eq\n" +
-			" │   ├─ one_pk_three_idx.v3:3\n" +
-			" │   └─ 3 (tinyint)\n" +
-			" └─ IndexedTableAccess(one_pk_three_idx)\n" +
-			"     ├─ index: [one_pk_three_idx.v1,one_pk_three_idx.v2,one_pk_three_idx.v3]\n" +
-			"     ├─ static: [{(2, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" │   ├─ one_pk_three_idx.v2:2\n" +
+			" │   └─ 2 (tinyint)\n" +
+			" └─ Table\n" +
+			"     ├─ name: mydb.one_pk_three_idx\n" +
+			"     └─ columns: [pk v1 v2 v3]\n" +
+			"",
+	},
+	{
+		Query: `SELECT pk FROM one_pk_three_idx WHERE v1 = 1 AND v3 IS NULL AND pk + v2 > 0`,
+		ExpectedPlan: "Project\n" +
+			" ├─ columns: [one_pk_three_idx.pk:0!null]\n" +
+			" └─ Filter\n" +
+			"     ├─ GreaterThan\n" +
+			"     │   ├─ (one_pk_three_idx.pk:0!null + one_pk_three_idx.v2:2)\n" +
+			"     │   └─ 0 (tinyint)\n" +
+			"     └─ IndexedTableAccess(mydb.one_pk_three_idx)\n" +
+			"         ├─ index: one_pk_three_idx_idx [one_pk_three_idx.v1,one_pk_three_idx.v2,one_pk_three_idx.v3]\n" +
+			"         ├─ static: [{[1, 1], [NULL, ∞), [NULL, ∞)}]\n" +
+			"         ├─ index condition: one_pk_three_idx.v3:2 IS NULL\n" +
+			"         └─ columns: [pk v1 v2 v3]\n" +
+			"",
+	},
+	{
+		Query: `SELECT t.pk FROM one_pk_three_idx t WHERE t.v1 = 1 AND t.v3 + 1 > 2`,
+		ExpectedPlan: "Project\n" +
+			" ├─ columns: [t.pk:0!null]\n" +
+			" └─ Filter\n" +
+			"     ├─ Eq\n" +
+			"     │   ├─ t.v1:1\n" +
+			"     │   └─ 1 (tinyint)\n" +
+			"     └─ TableAlias(t)\n" +
+			"         └─ IndexedTableAccess(mydb.one_pk_three_idx)\n" +
+			"             ├─ index: one_pk_three_idx_idx [one_pk_three_idx.v1,one_pk_three_idx.v2,one_pk_three_idx.v3]\n" +
+			"             ├─ static: [{[1, 1], [NULL, ∞), [NULL, ∞)}]\n" +
+			"             ├─ index condition: GreaterThan\n" +
+			"             │   ├─ (t.v3:2 + 1 (tinyint))\n" +
+			"             │   └─ 2 (tinyint)\n" +
+			"             └─ columns: [pk v1 v3]\n" +
+			"",
+	},
+	{
+		Query: `SELECT * FROM one_pk_three_idx WHERE v2 = 2 AND v3 = 3`,
+		ExpectedPlan: "Filter\n" +
+			" ├─ AND\n" +
+			" │   ├─ Eq\n" +
+			" │   │   ├─ one_pk_three_idx.v2:2\n" +
+			" │   │   └─ 2 (tinyint)\n" +
+			" │   └─ Eq\n" +
+			" │       ├─ one_pk_three_idx.v3:3\n" +
+			" │       └─ 3 (tinyint)\n" +
+			" └─ Table\n" +
+			"     ├─ name: mydb.one_pk_three_idx\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
@@ -1627,12 +1827,12 @@ inner join pq on true
 			"                 ├─ cmp: Eq\n" +
 			"                 │   ├─ othertable.i2:0!null\n" +
 			"                 │   └─ mytable.i:1!null\n" +
-			"                 ├─ IndexedTableAccess(othertable)\n" +
-			"                 │   ├─ index: [othertable.i2]\n" +
+			"                 ├─ IndexedTableAccess(mydb.othertable)\n" +
+			"                 │   ├─ index: `PRIMARY` [othertable.i2]\n" +
 			"                 │   ├─ static: [{[NULL, ∞)}]\n" +
 			"                 │   └─ columns: [i2]\n" +
-			"                 └─ IndexedTableAccess(mytable)\n" +
-			"                     ├─ index: [mytable.i]\n" +
+			"                 └─ IndexedTableAccess(mydb.mytable)\n" +
+			"                     ├─ index: `PRIMARY` [mytable.i]\n" +
 			"                     ├─ static: [{[NULL, ∞)}]\n" +
 			"                     └─ columns: [i]\n" +
 			"",
@@ -1643,7 +1843,7 @@ inner join pq on true
 			" └─ Insert(i, s)\n" +
 			"     ├─ InsertDestination\n" +
 			"     │   └─ Table\n" +
-			"     │       ├─ name: mytable\n" +
+			"     │       ├─ name: mydb.mytable\n" +
 			"     │       └─ columns: [i s]\n" +
 			"     └─ Project\n" +
 			"         ├─ columns: [i:0!null, s:1!null]\n" +
@@ -1662,13 +1862,13 @@ inner join pq on true
 			"                     │   ├─ (t2.i:0!null + 1 (tinyint))\n" +
 			"                     │   └─ t1.i:1!null\n" +
 			"                     ├─ TableAlias(t2)\n" +
-			"                     │   └─ IndexedTableAccess(mytable)\n" +
-			"                     │       ├─ index: [mytable.i]\n" +
+			"                     │   └─ IndexedTableAccess(mydb.mytable)\n" +
+			"                     │       ├─ index: `PRIMARY` [mytable.i]\n" +
 			"                     │       ├─ static: [{[NULL, ∞)}]\n" +
 			"                     │       └─ columns: [i]\n" +
 			"                     └─ TableAlias(t1)\n" +
-			"                         └─ IndexedTableAccess(mytable)\n" +
-			"                             ├─ index: [mytable.i]\n" +
+			"                         └─ IndexedTableAccess(mydb.mytable)\n" +
+			"                             ├─ index: `PRIMARY` [mytable.i]\n" +
 			"                             ├─ static: [{[NULL, ∞)}]\n" +
 			"                             └─ columns: [i]\n" +
 			"",
@@ -1690,13 +1890,13 @@ inner join pq on true
 			"         │   ├─ t1.i:0!null\n" +
 			"         │   └─ (t2.i:1!null + 1 (tinyint))\n" +
 			"         ├─ TableAlias(t1)\n" +
-			"         │   └─ IndexedTableAccess(mytable)\n" +
-			"         │       ├─ index: [mytable.i]\n" +
+			"         │   └─ IndexedTableAccess(mydb.mytable)\n" +
+			"         │       ├─ index: `PRIMARY` [mytable.i]\n" +
 			"         │       ├─ static: [{[NULL, ∞)}]\n" +
 			"         │       └─ columns: [i]\n" +
 			"         └─ TableAlias(t2)\n" +
-			"             └─ IndexedTableAccess(mytable)\n" +
-			"                 ├─ index: [mytable.i]\n" +
+			"             └─ IndexedTableAccess(mydb.mytable)\n" +
+			"                 ├─ index: `PRIMARY` [mytable.i]\n" +
 			"                 ├─ static: [{[NULL, ∞)}]\n" +
 			"                 └─ columns: [i]\n" +
 			"",
@@ -1718,13 +1918,13 @@ inner join pq on true
 			"         │   ├─ (t2.i:0!null + 1 (tinyint))\n" +
 			"         │   └─ t1.i:1!null\n" +
 			"         ├─ TableAlias(t2)\n" +
-			"         │   └─ IndexedTableAccess(mytable)\n" +
-			"         │       ├─ index: [mytable.i]\n" +
+			"         │   └─ IndexedTableAccess(mydb.mytable)\n" +
+			"         │       ├─ index: `PRIMARY` [mytable.i]\n" +
 			"         │       ├─ static: [{[NULL, ∞)}]\n" +
 			"         │       └─ columns: [i]\n" +
 			"         └─ TableAlias(t1)\n" +
-			"             └─ IndexedTableAccess(mytable)\n" +
-			"                 ├─ index: [mytable.i]\n" +
+			"             └─ IndexedTableAccess(mydb.mytable)\n" +
+			"                 ├─ index: `PRIMARY` [mytable.i]\n" +
 			"                 ├─ static: [{[NULL, ∞)}]\n" +
 			"                 └─ columns: [i]\n" +
 			"",
@@ -1746,13 +1946,13 @@ inner join pq on true
 			"         │   ├─ (t2.i:0!null + 1 (tinyint))\n" +
 			"         │   └─ t1.i:1!null\n" +
 			"         ├─ TableAlias(t2)\n" +
-			"         │   └─ IndexedTableAccess(mytable)\n" +
-			"         │       ├─ index: [mytable.i]\n" +
+			"         │   └─ IndexedTableAccess(mydb.mytable)\n" +
+			"         │       ├─ index: `PRIMARY` [mytable.i]\n" +
 			"         │       ├─ static: [{[NULL, ∞)}]\n" +
 			"         │       └─ columns: [i]\n" +
 			"         └─ TableAlias(t1)\n" +
-			"             └─ IndexedTableAccess(mytable)\n" +
-			"                 ├─ index: [mytable.i]\n" +
+			"             └─ IndexedTableAccess(mydb.mytable)\n" +
+			"                 ├─ index: `PRIMARY` [mytable.i]\n" +
 			"                 ├─ static: [{[NULL, ∞)}]\n" +
 			"                 └─ columns: [i]\n" +
 			"",
@@ -1774,13 +1974,13 @@ inner join pq on true
 			"         │   ├─ (t2.i:0!null + 1 (tinyint))\n" +
 			"         │   └─ t1.i:1!null\n" +
 			"         ├─ TableAlias(t2)\n" +
-			"         │   └─ IndexedTableAccess(mytable)\n" +
-			"         │       ├─ index: [mytable.i]\n" +
+			"         │   └─ IndexedTableAccess(mydb.mytable)\n" +
+			"         │       ├─ index: `PRIMARY` [mytable.i]\n" +
 			"         │       ├─ static: [{[NULL, ∞)}]\n" +
 			"         │       └─ columns: [i]\n" +
 			"         └─ TableAlias(t1)\n" +
-			"             └─ IndexedTableAccess(mytable)\n" +
-			"                 ├─ index: [mytable.i]\n" +
+			"             └─ IndexedTableAccess(mydb.mytable)\n" +
+			"                 ├─ index: `PRIMARY` [mytable.i]\n" +
 			"                 ├─ static: [{[NULL, ∞)}]\n" +
 			"                 └─ columns: [i]\n" +
 			"",
@@ -1793,12 +1993,12 @@ inner join pq on true
 			"     ├─ cmp: Eq\n" +
 			"     │   ├─ othertable.i2:1!null\n" +
 			"     │   └─ mytable.i:2!null\n" +
-			"     ├─ IndexedTableAccess(othertable)\n" +
-			"     │   ├─ index: [othertable.i2]\n" +
+			"     ├─ IndexedTableAccess(mydb.othertable)\n" +
+			"     │   ├─ index: `PRIMARY` [othertable.i2]\n" +
 			"     │   ├─ static: [{[NULL, ∞)}]\n" +
 			"     │   └─ columns: [s2 i2]\n" +
-			"     └─ IndexedTableAccess(mytable)\n" +
-			"         ├─ index: [mytable.i]\n" +
+			"     └─ IndexedTableAccess(mydb.mytable)\n" +
+			"         ├─ index: `PRIMARY` [mytable.i]\n" +
 			"         ├─ static: [{[NULL, ∞)}]\n" +
 			"         └─ columns: [i]\n" +
 			"",
@@ -1816,14 +2016,14 @@ inner join pq on true
 			"     │       ├─ mytable.s:3!null\n" +
 			"     │       └─ othertable.s2:0!null\n" +
 			"     ├─ Table\n" +
-			"     │   ├─ name: othertable\n" +
+			"     │   ├─ name: mydb.othertable\n" +
 			"     │   └─ columns: [s2 i2]\n" +
 			"     └─ Concat\n" +
-			"         ├─ IndexedTableAccess(mytable)\n" +
-			"         │   ├─ index: [mytable.s,mytable.i]\n" +
+			"         ├─ IndexedTableAccess(mydb.mytable)\n" +
+			"         │   ├─ index: idx_si [mytable.s,mytable.i]\n" +
 			"         │   └─ columns: [i s]\n" +
-			"         └─ IndexedTableAccess(mytable)\n" +
-			"             ├─ index: [mytable.i]\n" +
+			"         └─ IndexedTableAccess(mydb.mytable)\n" +
+			"             ├─ index: `PRIMARY` [mytable.i]\n" +
 			"             └─ columns: [i s]\n" +
 			"",
 	},
@@ -1841,14 +2041,14 @@ inner join pq on true
 			"     │       └─ ot.s2:0!null\n" +
 			"     ├─ TableAlias(ot)\n" +
 			"     │   └─ Table\n" +
-			"     │       ├─ name: othertable\n" +
+			"     │       ├─ name: mydb.othertable\n" +
 			"     │       └─ columns: [s2 i2]\n" +
 			"     └─ Concat\n" +
-			"         ├─ IndexedTableAccess(mytable)\n" +
-			"         │   ├─ index: [mytable.s,mytable.i]\n" +
+			"         ├─ IndexedTableAccess(mydb.mytable)\n" +
+			"         │   ├─ index: idx_si [mytable.s,mytable.i]\n" +
 			"         │   └─ columns: [i s]\n" +
-			"         └─ IndexedTableAccess(mytable)\n" +
-			"             ├─ index: [mytable.i]\n" +
+			"         └─ IndexedTableAccess(mydb.mytable)\n" +
+			"             ├─ index: `PRIMARY` [mytable.i]\n" +
 			"             └─ columns: [i s]\n" +
 			"",
 	},
@@ -1865,14 +2065,14 @@ inner join pq on true
 			"     │       ├─ SUBSTRING_INDEX(mytable.s, ' ', 1)\n" +
 			"     │       └─ othertable.s2:2!null\n" +
 			"     ├─ Table\n" +
-			"     │   ├─ name: mytable\n" +
+			"     │   ├─ name: mydb.mytable\n" +
 			"     │   └─ columns: [i s]\n" +
 			"     └─ Concat\n" +
-			"         ├─ IndexedTableAccess(othertable)\n" +
-			"         │   ├─ index: [othertable.s2]\n" +
+			"         ├─ IndexedTableAccess(mydb.othertable)\n" +
+			"         │   ├─ index: othertable_s2 [othertable.s2]\n" +
 			"         │   └─ columns: [s2 i2]\n" +
-			"         └─ IndexedTableAccess(othertable)\n" +
-			"             ├─ index: [othertable.i2]\n" +
+			"         └─ IndexedTableAccess(mydb.othertable)\n" +
+			"             ├─ index: `PRIMARY` [othertable.i2]\n" +
 			"             └─ columns: [s2 i2]\n" +
 			"",
 	},
@@ -1893,10 +2093,10 @@ inner join pq on true
 			"     │       ├─ SUBSTRING_INDEX(mytable.s, ' ', 2)\n" +
 			"     │       └─ othertable.s2:2!null\n" +
 			"     ├─ Table\n" +
-			"     │   ├─ name: mytable\n" +
+			"     │   ├─ name: mydb.mytable\n" +
 			"     │   └─ columns: [i s]\n" +
 			"     └─ Table\n" +
-			"         ├─ name: othertable\n" +
+			"         ├─ name: mydb.othertable\n" +
 			"         └─ columns: [s2 i2]\n" +
 			"",
 	},
@@ -1909,12 +2109,12 @@ inner join pq on true
 			" │       ├─ cmp: Eq\n" +
 			" │       │   ├─ othertable.i2:1!null\n" +
 			" │       │   └─ mytable.i:2!null\n" +
-			" │       ├─ IndexedTableAccess(othertable)\n" +
-			" │       │   ├─ index: [othertable.i2]\n" +
+			" │       ├─ IndexedTableAccess(mydb.othertable)\n" +
+			" │       │   ├─ index: `PRIMARY` [othertable.i2]\n" +
 			" │       │   ├─ static: [{[NULL, ∞)}]\n" +
 			" │       │   └─ columns: [s2 i2]\n" +
-			" │       └─ IndexedTableAccess(mytable)\n" +
-			" │           ├─ index: [mytable.i]\n" +
+			" │       └─ IndexedTableAccess(mydb.mytable)\n" +
+			" │           ├─ index: `PRIMARY` [mytable.i]\n" +
 			" │           ├─ static: [{[NULL, ∞)}]\n" +
 			" │           └─ columns: [i]\n" +
 			" └─ Project\n" +
@@ -1923,12 +2123,12 @@ inner join pq on true
 			"         ├─ cmp: Eq\n" +
 			"         │   ├─ othertable.i2:1!null\n" +
 			"         │   └─ mytable.i:2!null\n" +
-			"         ├─ IndexedTableAccess(othertable)\n" +
-			"         │   ├─ index: [othertable.i2]\n" +
+			"         ├─ IndexedTableAccess(mydb.othertable)\n" +
+			"         │   ├─ index: `PRIMARY` [othertable.i2]\n" +
 			"         │   ├─ static: [{[NULL, ∞)}]\n" +
 			"         │   └─ columns: [s2 i2]\n" +
-			"         └─ IndexedTableAccess(mytable)\n" +
-			"             ├─ index: [mytable.i]\n" +
+			"         └─ IndexedTableAccess(mydb.mytable)\n" +
+			"             ├─ index: `PRIMARY` [mytable.i]\n" +
 			"             ├─ static: [{[NULL, ∞)}]\n" +
 			"             └─ columns: [i]\n" +
 			"",
@@ -1951,12 +2151,12 @@ inner join pq on true
 			"     │           ├─ cmp: Eq\n" +
 			"     │           │   ├─ othertable.i2:1!null\n" +
 			"     │           │   └─ mytable.i:2!null\n" +
-			"     │           ├─ IndexedTableAccess(othertable)\n" +
-			"     │           │   ├─ index: [othertable.i2]\n" +
+			"     │           ├─ IndexedTableAccess(mydb.othertable)\n" +
+			"     │           │   ├─ index: `PRIMARY` [othertable.i2]\n" +
 			"     │           │   ├─ static: [{[NULL, ∞)}]\n" +
 			"     │           │   └─ columns: [s2 i2]\n" +
-			"     │           └─ IndexedTableAccess(mytable)\n" +
-			"     │               ├─ index: [mytable.i]\n" +
+			"     │           └─ IndexedTableAccess(mydb.mytable)\n" +
+			"     │               ├─ index: `PRIMARY` [mytable.i]\n" +
 			"     │               ├─ static: [{[NULL, ∞)}]\n" +
 			"     │               └─ columns: [i]\n" +
 			"     └─ HashLookup\n" +
@@ -1965,7 +2165,7 @@ inner join pq on true
 			"         └─ CachedResults\n" +
 			"             └─ TableAlias(ot)\n" +
 			"                 └─ Table\n" +
-			"                     ├─ name: othertable\n" +
+			"                     ├─ name: mydb.othertable\n" +
 			"                     └─ columns: [s2 i2]\n" +
 			"",
 	},
@@ -1987,12 +2187,12 @@ inner join pq on true
 			"     │           ├─ cmp: Eq\n" +
 			"     │           │   ├─ othertable.i2:1!null\n" +
 			"     │           │   └─ mytable.i:2!null\n" +
-			"     │           ├─ IndexedTableAccess(othertable)\n" +
-			"     │           │   ├─ index: [othertable.i2]\n" +
+			"     │           ├─ IndexedTableAccess(mydb.othertable)\n" +
+			"     │           │   ├─ index: `PRIMARY` [othertable.i2]\n" +
 			"     │           │   ├─ static: [{[NULL, ∞)}]\n" +
 			"     │           │   └─ columns: [s2 i2]\n" +
-			"     │           └─ IndexedTableAccess(mytable)\n" +
-			"     │               ├─ index: [mytable.i]\n" +
+			"     │           └─ IndexedTableAccess(mydb.mytable)\n" +
+			"     │               ├─ index: `PRIMARY` [mytable.i]\n" +
 			"     │               ├─ static: [{[NULL, ∞)}]\n" +
 			"     │               └─ columns: [i]\n" +
 			"     └─ HashLookup\n" +
@@ -2001,7 +2201,7 @@ inner join pq on true
 			"         └─ CachedResults\n" +
 			"             └─ TableAlias(ot)\n" +
 			"                 └─ Table\n" +
-			"                     ├─ name: othertable\n" +
+			"                     ├─ name: mydb.othertable\n" +
 			"                     └─ columns: [s2 i2]\n" +
 			"",
 	},
@@ -2018,8 +2218,8 @@ inner join pq on true
 			"     │   │   ├─ ot.i2:1!null\n" +
 			"     │   │   └─ 0 (tinyint)\n" +
 			"     │   └─ TableAlias(ot)\n" +
-			"     │       └─ IndexedTableAccess(othertable)\n" +
-			"     │           ├─ index: [othertable.i2]\n" +
+			"     │       └─ IndexedTableAccess(mydb.othertable)\n" +
+			"     │           ├─ index: `PRIMARY` [othertable.i2]\n" +
 			"     │           ├─ static: [{(0, ∞)}]\n" +
 			"     │           └─ columns: [s2 i2]\n" +
 			"     └─ HashLookup\n" +
@@ -2043,12 +2243,12 @@ inner join pq on true
 			"                             ├─ cmp: Eq\n" +
 			"                             │   ├─ othertable.i2:1!null\n" +
 			"                             │   └─ mytable.i:2!null\n" +
-			"                             ├─ IndexedTableAccess(othertable)\n" +
-			"                             │   ├─ index: [othertable.i2]\n" +
+			"                             ├─ IndexedTableAccess(mydb.othertable)\n" +
+			"                             │   ├─ index: `PRIMARY` [othertable.i2]\n" +
 			"                             │   ├─ static: [{[NULL, ∞)}]\n" +
 			"                             │   └─ columns: [s2 i2]\n" +
-			"                             └─ IndexedTableAccess(mytable)\n" +
-			"                                 ├─ index: [mytable.i]\n" +
+			"                             └─ IndexedTableAccess(mydb.mytable)\n" +
+			"                                 ├─ index: `PRIMARY` [mytable.i]\n" +
 			"                                 ├─ static: [{[NULL, ∞)}]\n" +
 			"                                 └─ columns: [i]\n" +
 			"",
@@ -2064,13 +2264,13 @@ inner join pq on true
 			" │   │   ├─ i.pk:0!null\n" +
 			" │   │   └─ k.pk:6!null\n" +
 			" │   ├─ TableAlias(i)\n" +
-			" │   │   └─ IndexedTableAccess(one_pk)\n" +
-			" │   │       ├─ index: [one_pk.pk]\n" +
+			" │   │   └─ IndexedTableAccess(mydb.one_pk)\n" +
+			" │   │       ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			" │   │       ├─ static: [{[NULL, ∞)}]\n" +
 			" │   │       └─ columns: [pk c1 c2 c3 c4 c5]\n" +
 			" │   └─ TableAlias(k)\n" +
-			" │       └─ IndexedTableAccess(one_pk)\n" +
-			" │           ├─ index: [one_pk.pk]\n" +
+			" │       └─ IndexedTableAccess(mydb.one_pk)\n" +
+			" │           ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			" │           ├─ static: [{[NULL, ∞)}]\n" +
 			" │           └─ columns: [pk c1 c2 c3 c4 c5]\n" +
 			" └─ SubqueryAlias\n" +
@@ -2080,7 +2280,7 @@ inner join pq on true
 			"     └─ Project\n" +
 			"         ├─ columns: [one_pk.pk:0!null, rand() as r]\n" +
 			"         └─ Table\n" +
-			"             ├─ name: one_pk\n" +
+			"             ├─ name: mydb.one_pk\n" +
 			"             └─ columns: [pk]\n" +
 			"",
 	},
@@ -2095,13 +2295,13 @@ inner join pq on true
 			" │   │   ├─ i.pk:0!null\n" +
 			" │   │   └─ k.pk:6!null\n" +
 			" │   ├─ TableAlias(i)\n" +
-			" │   │   └─ IndexedTableAccess(one_pk)\n" +
-			" │   │       ├─ index: [one_pk.pk]\n" +
+			" │   │   └─ IndexedTableAccess(mydb.one_pk)\n" +
+			" │   │       ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			" │   │       ├─ static: [{[NULL, ∞)}]\n" +
 			" │   │       └─ columns: [pk c1 c2 c3 c4 c5]\n" +
 			" │   └─ TableAlias(k)\n" +
-			" │       └─ IndexedTableAccess(one_pk)\n" +
-			" │           ├─ index: [one_pk.pk]\n" +
+			" │       └─ IndexedTableAccess(mydb.one_pk)\n" +
+			" │           ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			" │           ├─ static: [{[NULL, ∞)}]\n" +
 			" │           └─ columns: [pk c1 c2 c3 c4 c5]\n" +
 			" └─ SubqueryAlias\n" +
@@ -2111,7 +2311,7 @@ inner join pq on true
 			"     └─ Project\n" +
 			"         ├─ columns: [one_pk.pk:0!null, rand() as r]\n" +
 			"         └─ Table\n" +
-			"             ├─ name: one_pk\n" +
+			"             ├─ name: mydb.one_pk\n" +
 			"             └─ columns: [pk]\n" +
 			"",
 	},
@@ -2121,7 +2321,7 @@ inner join pq on true
 			" └─ Insert(i, s)\n" +
 			"     ├─ InsertDestination\n" +
 			"     │   └─ Table\n" +
-			"     │       ├─ name: mytable\n" +
+			"     │       ├─ name: mydb.mytable\n" +
 			"     │       └─ columns: [i s]\n" +
 			"     └─ Project\n" +
 			"         ├─ columns: [i:0!null, s:1!null]\n" +
@@ -2141,12 +2341,12 @@ inner join pq on true
 			"                 │           ├─ cmp: Eq\n" +
 			"                 │           │   ├─ othertable.i2:1!null\n" +
 			"                 │           │   └─ mytable.i:2!null\n" +
-			"                 │           ├─ IndexedTableAccess(othertable)\n" +
-			"                 │           │   ├─ index: [othertable.i2]\n" +
+			"                 │           ├─ IndexedTableAccess(mydb.othertable)\n" +
+			"                 │           │   ├─ index: `PRIMARY` [othertable.i2]\n" +
 			"                 │           │   ├─ static: [{[NULL, ∞)}]\n" +
 			"                 │           │   └─ columns: [s2 i2]\n" +
-			"                 │           └─ IndexedTableAccess(mytable)\n" +
-			"                 │               ├─ index: [mytable.i]\n" +
+			"                 │           └─ IndexedTableAccess(mydb.mytable)\n" +
+			"                 │               ├─ index: `PRIMARY` [mytable.i]\n" +
 			"                 │               ├─ static: [{[NULL, ∞)}]\n" +
 			"                 │               └─ columns: [i]\n" +
 			"                 └─ HashLookup\n" +
@@ -2155,7 +2355,7 @@ inner join pq on true
 			"                     └─ CachedResults\n" +
 			"                         └─ TableAlias(ot)\n" +
 			"                             └─ Table\n" +
-			"                                 ├─ name: othertable\n" +
+			"                                 ├─ name: mydb.othertable\n" +
 			"                                 └─ columns: [s2 i2]\n" +
 			"",
 	},
@@ -2171,7 +2371,7 @@ inner join pq on true
 			"         │   └─ scalarSubq0.1:0!null\n" +
 			"         ├─ Distinct\n" +
 			"         │   └─ SubqueryAlias\n" +
-			"         │       ├─ name: scalarSubq0\n" +
+			"         │       ├─ name: `scalarSubq0`\n" +
 			"         │       ├─ outerVisibility: false\n" +
 			"         │       ├─ cacheable: true\n" +
 			"         │       └─ Project\n" +
@@ -2188,12 +2388,12 @@ inner join pq on true
 			"                     │   ├─ selfjoin.i:1!null\n" +
 			"                     │   └─ mytable.i:3!null\n" +
 			"                     ├─ TableAlias(selfjoin)\n" +
-			"                     │   └─ IndexedTableAccess(mytable)\n" +
-			"                     │       ├─ index: [mytable.i]\n" +
+			"                     │   └─ IndexedTableAccess(mydb.mytable)\n" +
+			"                     │       ├─ index: `PRIMARY` [mytable.i]\n" +
 			"                     │       ├─ static: [{[NULL, ∞)}]\n" +
 			"                     │       └─ columns: [i s]\n" +
-			"                     └─ IndexedTableAccess(mytable)\n" +
-			"                         ├─ index: [mytable.i]\n" +
+			"                     └─ IndexedTableAccess(mydb.mytable)\n" +
+			"                         ├─ index: `PRIMARY` [mytable.i]\n" +
 			"                         ├─ static: [{[NULL, ∞)}]\n" +
 			"                         └─ columns: [i s]\n" +
 			"",
@@ -2204,12 +2404,12 @@ inner join pq on true
 			" ├─ cmp: Eq\n" +
 			" │   ├─ othertable.i2:1!null\n" +
 			" │   └─ mytable.i:2!null\n" +
-			" ├─ IndexedTableAccess(othertable)\n" +
-			" │   ├─ index: [othertable.i2]\n" +
+			" ├─ IndexedTableAccess(mydb.othertable)\n" +
+			" │   ├─ index: `PRIMARY` [othertable.i2]\n" +
 			" │   ├─ static: [{[NULL, ∞)}]\n" +
 			" │   └─ columns: [s2 i2]\n" +
-			" └─ IndexedTableAccess(mytable)\n" +
-			"     ├─ index: [mytable.i]\n" +
+			" └─ IndexedTableAccess(mydb.mytable)\n" +
+			"     ├─ index: `PRIMARY` [mytable.i]\n" +
 			"     ├─ static: [{[NULL, ∞)}]\n" +
 			"     └─ columns: [i]\n" +
 			"",
@@ -2222,12 +2422,12 @@ inner join pq on true
 			"     ├─ cmp: Eq\n" +
 			"     │   ├─ mytable.i:0!null\n" +
 			"     │   └─ othertable.i2:2!null\n" +
-			"     ├─ IndexedTableAccess(mytable)\n" +
-			"     │   ├─ index: [mytable.i]\n" +
+			"     ├─ IndexedTableAccess(mydb.mytable)\n" +
+			"     │   ├─ index: `PRIMARY` [mytable.i]\n" +
 			"     │   ├─ static: [{[NULL, ∞)}]\n" +
 			"     │   └─ columns: [i]\n" +
-			"     └─ IndexedTableAccess(othertable)\n" +
-			"         ├─ index: [othertable.i2]\n" +
+			"     └─ IndexedTableAccess(mydb.othertable)\n" +
+			"         ├─ index: `PRIMARY` [othertable.i2]\n" +
 			"         ├─ static: [{[NULL, ∞)}]\n" +
 			"         └─ columns: [s2 i2]\n" +
 			"",
@@ -2240,12 +2440,12 @@ inner join pq on true
 			"     ├─ cmp: Eq\n" +
 			"     │   ├─ mytable.i:0!null\n" +
 			"     │   └─ othertable.i2:2!null\n" +
-			"     ├─ IndexedTableAccess(mytable)\n" +
-			"     │   ├─ index: [mytable.i]\n" +
+			"     ├─ IndexedTableAccess(mydb.mytable)\n" +
+			"     │   ├─ index: `PRIMARY` [mytable.i]\n" +
 			"     │   ├─ static: [{[NULL, ∞)}]\n" +
 			"     │   └─ columns: [i]\n" +
-			"     └─ IndexedTableAccess(othertable)\n" +
-			"         ├─ index: [othertable.i2]\n" +
+			"     └─ IndexedTableAccess(mydb.othertable)\n" +
+			"         ├─ index: `PRIMARY` [othertable.i2]\n" +
 			"         ├─ static: [{[NULL, ∞)}]\n" +
 			"         └─ columns: [s2 i2]\n" +
 			"",
@@ -2258,12 +2458,12 @@ inner join pq on true
 			"     ├─ cmp: Eq\n" +
 			"     │   ├─ mytable.i:0!null\n" +
 			"     │   └─ othertable.i2:2!null\n" +
-			"     ├─ IndexedTableAccess(mytable)\n" +
-			"     │   ├─ index: [mytable.i]\n" +
+			"     ├─ IndexedTableAccess(mydb.mytable)\n" +
+			"     │   ├─ index: `PRIMARY` [mytable.i]\n" +
 			"     │   ├─ static: [{[NULL, ∞)}]\n" +
 			"     │   └─ columns: [i]\n" +
-			"     └─ IndexedTableAccess(othertable)\n" +
-			"         ├─ index: [othertable.i2]\n" +
+			"     └─ IndexedTableAccess(mydb.othertable)\n" +
+			"         ├─ index: `PRIMARY` [othertable.i2]\n" +
 			"         ├─ static: [{[NULL, ∞)}]\n" +
 			"         └─ columns: [s2 i2]\n" +
 			"",
@@ -2277,12 +2477,12 @@ inner join pq on true
 			"         ├─ cmp: Eq\n" +
 			"         │   ├─ mytable.i:0!null\n" +
 			"         │   └─ othertable.i2:2!null\n" +
-			"         ├─ IndexedTableAccess(mytable)\n" +
-			"         │   ├─ index: [mytable.i]\n" +
+			"         ├─ IndexedTableAccess(mydb.mytable)\n" +
+			"         │   ├─ index: `PRIMARY` [mytable.i]\n" +
 			"         │   ├─ static: [{[NULL, ∞)}]\n" +
 			"         │   └─ columns: [i]\n" +
-			"         └─ IndexedTableAccess(othertable)\n" +
-			"             ├─ index: [othertable.i2]\n" +
+			"         └─ IndexedTableAccess(mydb.othertable)\n" +
+			"             ├─ index: `PRIMARY` [othertable.i2]\n" +
 			"             ├─ static: [{[NULL, ∞)}]\n" +
 			"             └─ columns: [s2 i2]\n" +
 			"",
@@ -2295,12 +2495,12 @@ inner join pq on true
 			"     ├─ cmp: Eq\n" +
 			"     │   ├─ othertable.i2:1!null\n" +
 			"     │   └─ mytable.i:2!null\n" +
-			"     ├─ IndexedTableAccess(othertable)\n" +
-			"     │   ├─ index: [othertable.i2]\n" +
+			"     ├─ IndexedTableAccess(mydb.othertable)\n" +
+			"     │   ├─ index: `PRIMARY` [othertable.i2]\n" +
 			"     │   ├─ static: [{[NULL, ∞)}]\n" +
 			"     │   └─ columns: [s2 i2]\n" +
-			"     └─ IndexedTableAccess(mytable)\n" +
-			"         ├─ index: [mytable.i]\n" +
+			"     └─ IndexedTableAccess(mydb.mytable)\n" +
+			"         ├─ index: `PRIMARY` [mytable.i]\n" +
 			"         ├─ static: [{[NULL, ∞)}]\n" +
 			"         └─ columns: [i]\n" +
 			"",
@@ -2311,12 +2511,12 @@ inner join pq on true
 			" ├─ cmp: Eq\n" +
 			" │   ├─ othertable.i2:1!null\n" +
 			" │   └─ mytable.i:2!null\n" +
-			" ├─ IndexedTableAccess(othertable)\n" +
-			" │   ├─ index: [othertable.i2]\n" +
+			" ├─ IndexedTableAccess(mydb.othertable)\n" +
+			" │   ├─ index: `PRIMARY` [othertable.i2]\n" +
 			" │   ├─ static: [{[NULL, ∞)}]\n" +
 			" │   └─ columns: [s2 i2]\n" +
-			" └─ IndexedTableAccess(mytable)\n" +
-			"     ├─ index: [mytable.i]\n" +
+			" └─ IndexedTableAccess(mydb.mytable)\n" +
+			"     ├─ index: `PRIMARY` [mytable.i]\n" +
 			"     ├─ static: [{[NULL, ∞)}]\n" +
 			"     └─ columns: [i]\n" +
 			"",
@@ -2331,12 +2531,12 @@ inner join pq on true
 			"     │   └─ mytable.i:2!null\n" +
 			"     ├─ sel: NOT\n" +
 			"     │   └─ (othertable.s2:0!null <=> mytable.s:3!null)\n" +
-			"     ├─ IndexedTableAccess(othertable)\n" +
-			"     │   ├─ index: [othertable.i2]\n" +
+			"     ├─ IndexedTableAccess(mydb.othertable)\n" +
+			"     │   ├─ index: `PRIMARY` [othertable.i2]\n" +
 			"     │   ├─ static: [{[NULL, ∞)}]\n" +
 			"     │   └─ columns: [s2 i2]\n" +
-			"     └─ IndexedTableAccess(mytable)\n" +
-			"         ├─ index: [mytable.i]\n" +
+			"     └─ IndexedTableAccess(mydb.mytable)\n" +
+			"         ├─ index: `PRIMARY` [mytable.i]\n" +
 			"         ├─ static: [{[NULL, ∞)}]\n" +
 			"         └─ columns: [i s]\n" +
 			"",
@@ -2353,12 +2553,12 @@ inner join pq on true
 			"     │   └─ Eq\n" +
 			"     │       ├─ othertable.s2:0!null\n" +
 			"     │       └─ mytable.s:3!null\n" +
-			"     ├─ IndexedTableAccess(othertable)\n" +
-			"     │   ├─ index: [othertable.i2]\n" +
+			"     ├─ IndexedTableAccess(mydb.othertable)\n" +
+			"     │   ├─ index: `PRIMARY` [othertable.i2]\n" +
 			"     │   ├─ static: [{[NULL, ∞)}]\n" +
 			"     │   └─ columns: [s2 i2]\n" +
-			"     └─ IndexedTableAccess(mytable)\n" +
-			"         ├─ index: [mytable.i]\n" +
+			"     └─ IndexedTableAccess(mydb.mytable)\n" +
+			"         ├─ index: `PRIMARY` [mytable.i]\n" +
 			"         ├─ static: [{[NULL, ∞)}]\n" +
 			"         └─ columns: [i s]\n" +
 			"",
@@ -2373,12 +2573,12 @@ inner join pq on true
 			"     │   └─ mytable.i:2!null\n" +
 			"     ├─ sel: NOT\n" +
 			"     │   └─ concat(mytable.s:3!null,othertable.s2:0!null) IS NULL\n" +
-			"     ├─ IndexedTableAccess(othertable)\n" +
-			"     │   ├─ index: [othertable.i2]\n" +
+			"     ├─ IndexedTableAccess(mydb.othertable)\n" +
+			"     │   ├─ index: `PRIMARY` [othertable.i2]\n" +
 			"     │   ├─ static: [{[NULL, ∞)}]\n" +
 			"     │   └─ columns: [s2 i2]\n" +
-			"     └─ IndexedTableAccess(mytable)\n" +
-			"         ├─ index: [mytable.i]\n" +
+			"     └─ IndexedTableAccess(mydb.mytable)\n" +
+			"         ├─ index: `PRIMARY` [mytable.i]\n" +
 			"         ├─ static: [{[NULL, ∞)}]\n" +
 			"         └─ columns: [i s]\n" +
 			"",
@@ -2394,12 +2594,12 @@ inner join pq on true
 			"     ├─ sel: GreaterThan\n" +
 			"     │   ├─ mytable.s:3!null\n" +
 			"     │   └─ othertable.s2:0!null\n" +
-			"     ├─ IndexedTableAccess(othertable)\n" +
-			"     │   ├─ index: [othertable.i2]\n" +
+			"     ├─ IndexedTableAccess(mydb.othertable)\n" +
+			"     │   ├─ index: `PRIMARY` [othertable.i2]\n" +
 			"     │   ├─ static: [{[NULL, ∞)}]\n" +
 			"     │   └─ columns: [s2 i2]\n" +
-			"     └─ IndexedTableAccess(mytable)\n" +
-			"         ├─ index: [mytable.i]\n" +
+			"     └─ IndexedTableAccess(mydb.mytable)\n" +
+			"         ├─ index: `PRIMARY` [mytable.i]\n" +
 			"         ├─ static: [{[NULL, ∞)}]\n" +
 			"         └─ columns: [i s]\n" +
 			"",
@@ -2416,12 +2616,12 @@ inner join pq on true
 			"     │   └─ GreaterThan\n" +
 			"     │       ├─ mytable.s:3!null\n" +
 			"     │       └─ othertable.s2:0!null\n" +
-			"     ├─ IndexedTableAccess(othertable)\n" +
-			"     │   ├─ index: [othertable.i2]\n" +
+			"     ├─ IndexedTableAccess(mydb.othertable)\n" +
+			"     │   ├─ index: `PRIMARY` [othertable.i2]\n" +
 			"     │   ├─ static: [{[NULL, ∞)}]\n" +
 			"     │   └─ columns: [s2 i2]\n" +
-			"     └─ IndexedTableAccess(mytable)\n" +
-			"         ├─ index: [mytable.i]\n" +
+			"     └─ IndexedTableAccess(mydb.mytable)\n" +
+			"         ├─ index: `PRIMARY` [mytable.i]\n" +
 			"         ├─ static: [{[NULL, ∞)}]\n" +
 			"         └─ columns: [i s]\n" +
 			"",
@@ -2435,7 +2635,7 @@ inner join pq on true
 			"     │   ├─ othertable.i2:2!null\n" +
 			"     │   └─ mytable.i:0!null\n" +
 			"     ├─ Table\n" +
-			"     │   ├─ name: mytable\n" +
+			"     │   ├─ name: mydb.mytable\n" +
 			"     │   └─ columns: [i]\n" +
 			"     └─ HashLookup\n" +
 			"         ├─ source: TUPLE(mytable.i:0!null)\n" +
@@ -2446,7 +2646,7 @@ inner join pq on true
 			"                 ├─ outerVisibility: false\n" +
 			"                 ├─ cacheable: true\n" +
 			"                 └─ Table\n" +
-			"                     ├─ name: othertable\n" +
+			"                     ├─ name: mydb.othertable\n" +
 			"                     └─ columns: [s2 i2]\n" +
 			"",
 	},
@@ -2459,7 +2659,7 @@ inner join pq on true
 			"     │   ├─ othertable.i2:2!null\n" +
 			"     │   └─ mytable.i:0!null\n" +
 			"     ├─ Table\n" +
-			"     │   ├─ name: mytable\n" +
+			"     │   ├─ name: mydb.mytable\n" +
 			"     │   └─ columns: [i]\n" +
 			"     └─ HashLookup\n" +
 			"         ├─ source: TUPLE(mytable.i:0!null)\n" +
@@ -2470,7 +2670,7 @@ inner join pq on true
 			"                 ├─ outerVisibility: false\n" +
 			"                 ├─ cacheable: true\n" +
 			"                 └─ Table\n" +
-			"                     ├─ name: othertable\n" +
+			"                     ├─ name: mydb.othertable\n" +
 			"                     └─ columns: [s2 i2]\n" +
 			"",
 	},
@@ -2487,7 +2687,7 @@ inner join pq on true
 			"     │   ├─ outerVisibility: false\n" +
 			"     │   ├─ cacheable: true\n" +
 			"     │   └─ Table\n" +
-			"     │       ├─ name: othertable\n" +
+			"     │       ├─ name: mydb.othertable\n" +
 			"     │       └─ columns: [s2 i2]\n" +
 			"     └─ HashLookup\n" +
 			"         ├─ source: TUPLE(othertable.i2:1!null)\n" +
@@ -2498,7 +2698,7 @@ inner join pq on true
 			"                 ├─ outerVisibility: false\n" +
 			"                 ├─ cacheable: true\n" +
 			"                 └─ Table\n" +
-			"                     ├─ name: mytable\n" +
+			"                     ├─ name: mydb.mytable\n" +
 			"                     └─ columns: [i s]\n" +
 			"",
 	},
@@ -2508,8 +2708,8 @@ inner join pq on true
 			" ├─ NOT\n" +
 			" │   └─ a.s:1!null IS NULL\n" +
 			" └─ TableAlias(a)\n" +
-			"     └─ IndexedTableAccess(mytable)\n" +
-			"         ├─ index: [mytable.s]\n" +
+			"     └─ IndexedTableAccess(mydb.mytable)\n" +
+			"         ├─ index: mytable_s [mytable.s]\n" +
 			"         ├─ static: [{(NULL, ∞)}]\n" +
 			"         └─ columns: [i s]\n" +
 			"",
@@ -2517,72 +2717,69 @@ inner join pq on true
 	{
 		Query: `SELECT a.* FROM mytable a inner join mytable b on (a.i = b.s) WHERE a.s is not null`,
 		ExpectedPlan: "Project\n" +
-			" ├─ columns: [a.i:1!null, a.s:2!null]\n" +
-			" └─ Filter\n" +
-			"     ├─ NOT\n" +
-			"     │   └─ a.s:2!null IS NULL\n" +
-			"     └─ MergeJoin\n" +
-			"         ├─ cmp: Eq\n" +
-			"         │   ├─ b.s:0!null\n" +
-			"         │   └─ a.i:1!null\n" +
-			"         ├─ TableAlias(b)\n" +
-			"         │   └─ IndexedTableAccess(mytable)\n" +
-			"         │       ├─ index: [mytable.s,mytable.i]\n" +
-			"         │       ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
-			"         │       └─ columns: [s]\n" +
-			"         └─ TableAlias(a)\n" +
-			"             └─ IndexedTableAccess(mytable)\n" +
-			"                 ├─ index: [mytable.i]\n" +
-			"                 ├─ static: [{[NULL, ∞)}]\n" +
-			"                 └─ columns: [i s]\n" +
+			" ├─ columns: [a.i:0!null, a.s:1!null]\n" +
+			" └─ InnerJoin\n" +
+			"     ├─ Eq\n" +
+			"     │   ├─ a.i:0!null\n" +
+			"     │   └─ b.s:2!null\n" +
+			"     ├─ Filter\n" +
+			"     │   ├─ NOT\n" +
+			"     │   │   └─ a.s:1!null IS NULL\n" +
+			"     │   └─ TableAlias(a)\n" +
+			"     │       └─ IndexedTableAccess(mydb.mytable)\n" +
+			"     │           ├─ index: mytable_s [mytable.s]\n" +
+			"     │           ├─ static: [{(NULL, ∞)}]\n" +
+			"     │           └─ columns: [i s]\n" +
+			"     └─ TableAlias(b)\n" +
+			"         └─ Table\n" +
+			"             ├─ name: mydb.mytable\n" +
+			"             └─ columns: [s]\n" +
 			"",
 	},
 	{
 		Query: `SELECT /*+ JOIN_ORDER(b, a) */ a.* FROM mytable a inner join mytable b on (a.i = b.s) WHERE a.s is not null`,
 		ExpectedPlan: "Project\n" +
 			" ├─ columns: [a.i:1!null, a.s:2!null]\n" +
-			" └─ Filter\n" +
-			"     ├─ NOT\n" +
-			"     │   └─ a.s:2!null IS NULL\n" +
-			"     └─ MergeJoin\n" +
-			"         ├─ cmp: Eq\n" +
-			"         │   ├─ b.s:0!null\n" +
-			"         │   └─ a.i:1!null\n" +
-			"         ├─ TableAlias(b)\n" +
-			"         │   └─ IndexedTableAccess(mytable)\n" +
-			"         │       ├─ index: [mytable.s,mytable.i]\n" +
-			"         │       ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
-			"         │       └─ columns: [s]\n" +
+			" └─ InnerJoin\n" +
+			"     ├─ Eq\n" +
+			"     │   ├─ a.i:1!null\n" +
+			"     │   └─ b.s:0!null\n" +
+			"     ├─ TableAlias(b)\n" +
+			"     │   └─ Table\n" +
+			"     │       ├─ name: mydb.mytable\n" +
+			"     │       └─ columns: [s]\n" +
+			"     └─ Filter\n" +
+			"         ├─ NOT\n" +
+			"         │   └─ a.s:1!null IS NULL\n" +
 			"         └─ TableAlias(a)\n" +
-			"             └─ IndexedTableAccess(mytable)\n" +
-			"                 ├─ index: [mytable.i]\n" +
-			"                 ├─ static: [{[NULL, ∞)}]\n" +
+			"             └─ IndexedTableAccess(mydb.mytable)\n" +
+			"                 ├─ index: mytable_s [mytable.s]\n" +
+			"                 ├─ static: [{(NULL, ∞)}]\n" +
 			"                 └─ columns: [i s]\n" +
 			"",
 	},
 	{
 		Query: `SELECT a.* FROM mytable a inner join mytable b on (a.i = b.s) WHERE a.s not in ('1', '2', '3', '4')`,
 		ExpectedPlan: "Project\n" +
-			" ├─ columns: [a.i:1!null, a.s:2!null]\n" +
-			" └─ Filter\n" +
-			"     ├─ NOT\n" +
-			"     │   └─ HashIn\n" +
-			"     │       ├─ a.s:2!null\n" +
-			"     │       └─ TUPLE(1 (longtext), 2 (longtext), 3 (longtext), 4 (longtext))\n" +
-			"     └─ MergeJoin\n" +
-			"         ├─ cmp: Eq\n" +
-			"         │   ├─ b.s:0!null\n" +
-			"         │   └─ a.i:1!null\n" +
-			"         ├─ TableAlias(b)\n" +
-			"         │   └─ IndexedTableAccess(mytable)\n" +
-			"         │       ├─ index: [mytable.s,mytable.i]\n" +
-			"         │       ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
-			"         │       └─ columns: [s]\n" +
-			"         └─ TableAlias(a)\n" +
-			"             └─ IndexedTableAccess(mytable)\n" +
-			"                 ├─ index: [mytable.i]\n" +
-			"                 ├─ static: [{[NULL, ∞)}]\n" +
-			"                 └─ columns: [i s]\n" +
+			" ├─ columns: [a.i:0!null, a.s:1!null]\n" +
+			" └─ InnerJoin\n" +
+			"     ├─ Eq\n" +
+			"     │   ├─ a.i:0!null\n" +
+			"     │   └─ b.s:2!null\n" +
+			"     ├─ Filter\n" +
+			"     │   ├─ NOT\n" +
+			"     │   │   └─ HashIn\n" +
+			"     │   │       ├─ a.s:1!null\n" +
+			"     │   │       └─ TUPLE(1 (longtext), 2 (longtext), 3 (longtext), 4 (longtext))\n" +
+			"     │   └─ TableAlias(a)\n" +
+			"     │       └─ IndexedTableAccess(mydb.mytable)\n" +
+			"     │           ├─ index: mytable_s [mytable.s]\n" +
+			"     │           ├─ static: [{(1, 2)}, {(2, 3)}, {(3, 4)}, {(4, ∞)}, {(NULL, 1)}]\n" +
+			"     │           └─ columns: [i s]\n" +
+			"     └─ TableAlias(b)\n" +
+			"         └─ Table\n" +
+			"             ├─ name: mydb.mytable\n" +
+			"             └─ columns: [s]\n" +
 			"",
 	},
 	{
@@ -2615,8 +2812,8 @@ inner join pq on true
 			" ├─ HashIn\n" +
 			" │   ├─ mytable.i:0!null\n" +
 			" │   └─ TUPLE(1 (tinyint), 2 (tinyint), 3 (tinyint), 4 (tinyint))\n" +
-			" └─ IndexedTableAccess(mytable)\n" +
-			"     ├─ index: [mytable.i]\n" +
+			" └─ IndexedTableAccess(mydb.mytable)\n" +
+			"     ├─ index: `PRIMARY` [mytable.i]\n" +
 			"     ├─ static: [{[1, 1]}, {[2, 2]}, {[3, 3]}, {[4, 4]}]\n" +
 			"     └─ columns: [i s]\n" +
 			"",
@@ -2627,8 +2824,8 @@ inner join pq on true
 			" ├─ HashIn\n" +
 			" │   ├─ mytable.i:0!null\n" +
 			" │   └─ TUPLE(1 (tinyint), 1 (tinyint))\n" +
-			" └─ IndexedTableAccess(mytable)\n" +
-			"     ├─ index: [mytable.i]\n" +
+			" └─ IndexedTableAccess(mydb.mytable)\n" +
+			"     ├─ index: `PRIMARY` [mytable.i]\n" +
 			"     ├─ static: [{[1, 1]}]\n" +
 			"     └─ columns: [i s]\n" +
 			"",
@@ -2639,16 +2836,16 @@ inner join pq on true
 			" ├─ HashIn\n" +
 			" │   ├─ mytable.i:0!null\n" +
 			" │   └─ TUPLE(NULL (bigint), 2 (tinyint), 3 (tinyint), 4 (tinyint))\n" +
-			" └─ IndexedTableAccess(mytable)\n" +
-			"     ├─ index: [mytable.i]\n" +
+			" └─ IndexedTableAccess(mydb.mytable)\n" +
+			"     ├─ index: `PRIMARY` [mytable.i]\n" +
 			"     ├─ static: [{[2, 2]}, {[3, 3]}, {[4, 4]}]\n" +
 			"     └─ columns: [i s]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM mytable WHERE i in (1+2)`,
-		ExpectedPlan: "IndexedTableAccess(mytable)\n" +
-			" ├─ index: [mytable.i]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.mytable)\n" +
+			" ├─ index: `PRIMARY` [mytable.i]\n" +
 			" ├─ static: [{[3, 3]}]\n" +
 			" └─ columns: [i s]\n" +
 			"",
@@ -2660,7 +2857,7 @@ inner join pq on true
 			" │   ├─ upper(mytable.s)\n" +
 			" │   └─ TUPLE(FIRST ROW (longtext), SECOND ROW (longtext))\n" +
 			" └─ Table\n" +
-			"     ├─ name: mytable\n" +
+			"     ├─ name: mydb.mytable\n" +
 			"     └─ columns: [i s]\n" +
 			"",
 	},
@@ -2673,7 +2870,7 @@ inner join pq on true
 			" │   │   └─ mytable.i:0!null\n" +
 			" │   └─ TUPLE(a (longtext), b (longtext))\n" +
 			" └─ Table\n" +
-			"     ├─ name: mytable\n" +
+			"     ├─ name: mydb.mytable\n" +
 			"     └─ columns: [i s]\n" +
 			"",
 	},
@@ -2686,7 +2883,7 @@ inner join pq on true
 			" │   │   └─ mytable.i:0!null\n" +
 			" │   └─ TUPLE(1 (longtext), 2 (longtext))\n" +
 			" └─ Table\n" +
-			"     ├─ name: mytable\n" +
+			"     ├─ name: mydb.mytable\n" +
 			"     └─ columns: [i s]\n" +
 			"",
 	},
@@ -2699,7 +2896,7 @@ inner join pq on true
 			" │   │   └─ 2 (tinyint)\n" +
 			" │   └─ TUPLE(true (tinyint))\n" +
 			" └─ Table\n" +
-			"     ├─ name: mytable\n" +
+			"     ├─ name: mydb.mytable\n" +
 			"     └─ columns: [i s]\n" +
 			"",
 	},
@@ -2710,7 +2907,7 @@ inner join pq on true
 			" │   ├─ (mytable.i:0!null + 6 (tinyint))\n" +
 			" │   └─ TUPLE(7 (tinyint), 8 (tinyint))\n" +
 			" └─ Table\n" +
-			"     ├─ name: mytable\n" +
+			"     ├─ name: mydb.mytable\n" +
 			"     └─ columns: [i s]\n" +
 			"",
 	},
@@ -2721,7 +2918,7 @@ inner join pq on true
 			" │   ├─ (mytable.i:0!null + 40 (tinyint))\n" +
 			" │   └─ TUPLE(7 (tinyint), 8 (tinyint))\n" +
 			" └─ Table\n" +
-			"     ├─ name: mytable\n" +
+			"     ├─ name: mydb.mytable\n" +
 			"     └─ columns: [i s]\n" +
 			"",
 	},
@@ -2734,7 +2931,7 @@ inner join pq on true
 			" │   │   └─ 1 (bigint)\n" +
 			" │   └─ TUPLE(true (tinyint))\n" +
 			" └─ Table\n" +
-			"     ├─ name: mytable\n" +
+			"     ├─ name: mydb.mytable\n" +
 			"     └─ columns: [i s]\n" +
 			"",
 	},
@@ -2747,7 +2944,7 @@ inner join pq on true
 			" │   │   └─ 0 (bigint)\n" +
 			" │   └─ TUPLE(true (tinyint))\n" +
 			" └─ Table\n" +
-			"     ├─ name: mytable\n" +
+			"     ├─ name: mydb.mytable\n" +
 			"     └─ columns: [i s]\n" +
 			"",
 	},
@@ -2758,7 +2955,7 @@ inner join pq on true
 			" │   ├─ left: mytable.i:0!null\n" +
 			" │   └─ right: TUPLE((2 (tinyint) * mytable.i:0!null))\n" +
 			" └─ Table\n" +
-			"     ├─ name: mytable\n" +
+			"     ├─ name: mydb.mytable\n" +
 			"     └─ columns: [i s]\n" +
 			"",
 	},
@@ -2769,7 +2966,7 @@ inner join pq on true
 			" │   ├─ left: mytable.i:0!null\n" +
 			" │   └─ right: TUPLE(mytable.i:0!null)\n" +
 			" └─ Table\n" +
-			"     ├─ name: mytable\n" +
+			"     ├─ name: mydb.mytable\n" +
 			"     └─ columns: [i s]\n" +
 			"",
 	},
@@ -2780,7 +2977,7 @@ inner join pq on true
 			" │   ├─ left: 4 (tinyint)\n" +
 			" │   └─ right: TUPLE((mytable.i:0!null + 2 (tinyint)))\n" +
 			" └─ Table\n" +
-			"     ├─ name: mytable\n" +
+			"     ├─ name: mydb.mytable\n" +
 			"     └─ columns: [i s]\n" +
 			"",
 	},
@@ -2790,8 +2987,8 @@ inner join pq on true
 			" ├─ HashIn\n" +
 			" │   ├─ mytable.s:1!null\n" +
 			" │   └─ TUPLE(first row (longtext))\n" +
-			" └─ IndexedTableAccess(mytable)\n" +
-			"     ├─ index: [mytable.s]\n" +
+			" └─ IndexedTableAccess(mydb.mytable)\n" +
+			"     ├─ index: mytable_s [mytable.s]\n" +
 			"     ├─ static: [{[first row, first row]}]\n" +
 			"     └─ columns: [i s]\n" +
 			"",
@@ -2802,8 +2999,8 @@ inner join pq on true
 			" ├─ HashIn\n" +
 			" │   ├─ mytable.s:1!null\n" +
 			" │   └─ TUPLE(second row (longtext), FIRST ROW (longtext))\n" +
-			" └─ IndexedTableAccess(mytable)\n" +
-			"     ├─ index: [mytable.s]\n" +
+			" └─ IndexedTableAccess(mydb.mytable)\n" +
+			"     ├─ index: mytable_s [mytable.s]\n" +
 			"     ├─ static: [{[FIRST ROW, FIRST ROW]}, {[second row, second row]}]\n" +
 			"     └─ columns: [i s]\n" +
 			"",
@@ -2818,7 +3015,7 @@ inner join pq on true
 			" │       └─ 3 (tinyint)\n" +
 			" │      )\n" +
 			" └─ Table\n" +
-			"     ├─ name: mytable\n" +
+			"     ├─ name: mydb.mytable\n" +
 			"     └─ columns: [i s]\n" +
 			"",
 	},
@@ -2831,13 +3028,13 @@ inner join pq on true
 			"     │   ├─ b.i:0!null\n" +
 			"     │   └─ a.i:1!null\n" +
 			"     ├─ TableAlias(b)\n" +
-			"     │   └─ IndexedTableAccess(mytable)\n" +
-			"     │       ├─ index: [mytable.i]\n" +
+			"     │   └─ IndexedTableAccess(mydb.mytable)\n" +
+			"     │       ├─ index: `PRIMARY` [mytable.i]\n" +
 			"     │       ├─ static: [{[NULL, ∞)}]\n" +
 			"     │       └─ columns: [i]\n" +
 			"     └─ TableAlias(a)\n" +
-			"         └─ IndexedTableAccess(mytable)\n" +
-			"             ├─ index: [mytable.i]\n" +
+			"         └─ IndexedTableAccess(mydb.mytable)\n" +
+			"             ├─ index: `PRIMARY` [mytable.i]\n" +
 			"             ├─ static: [{[NULL, ∞)}]\n" +
 			"             └─ columns: [i s]\n" +
 			"",
@@ -2856,11 +3053,11 @@ inner join pq on true
 			"     │       └─ 1 (tinyint)\n" +
 			"     ├─ TableAlias(a)\n" +
 			"     │   └─ Table\n" +
-			"     │       ├─ name: mytable\n" +
+			"     │       ├─ name: mydb.mytable\n" +
 			"     │       └─ columns: [i s]\n" +
 			"     └─ TableAlias(b)\n" +
 			"         └─ Table\n" +
-			"             ├─ name: mytable\n" +
+			"             ├─ name: mydb.mytable\n" +
 			"             └─ columns: [i]\n" +
 			"",
 	},
@@ -2879,11 +3076,11 @@ inner join pq on true
 			"     │           └─ b.i:2!null\n" +
 			"     ├─ TableAlias(a)\n" +
 			"     │   └─ Table\n" +
-			"     │       ├─ name: mytable\n" +
+			"     │       ├─ name: mydb.mytable\n" +
 			"     │       └─ columns: [i s]\n" +
 			"     └─ TableAlias(b)\n" +
 			"         └─ Table\n" +
-			"             ├─ name: mytable\n" +
+			"             ├─ name: mydb.mytable\n" +
 			"             └─ columns: [i s]\n" +
 			"",
 	},
@@ -2899,11 +3096,11 @@ inner join pq on true
 			"     │   └─ (a.s = b.i) IS FALSE\n" +
 			"     ├─ TableAlias(a)\n" +
 			"     │   └─ Table\n" +
-			"     │       ├─ name: mytable\n" +
+			"     │       ├─ name: mydb.mytable\n" +
 			"     │       └─ columns: [i s]\n" +
 			"     └─ TableAlias(b)\n" +
 			"         └─ Table\n" +
-			"             ├─ name: mytable\n" +
+			"             ├─ name: mydb.mytable\n" +
 			"             └─ columns: [i s]\n" +
 			"",
 	},
@@ -2917,11 +3114,11 @@ inner join pq on true
 			"     │   └─ b.i:2!null\n" +
 			"     ├─ TableAlias(a)\n" +
 			"     │   └─ Table\n" +
-			"     │       ├─ name: mytable\n" +
+			"     │       ├─ name: mydb.mytable\n" +
 			"     │       └─ columns: [i s]\n" +
 			"     └─ TableAlias(b)\n" +
 			"         └─ Table\n" +
-			"             ├─ name: mytable\n" +
+			"             ├─ name: mydb.mytable\n" +
 			"             └─ columns: [i]\n" +
 			"",
 	},
@@ -2934,11 +3131,11 @@ inner join pq on true
 			" │   │   └─ a.s:1!null\n" +
 			" │   └─ TableAlias(a)\n" +
 			" │       └─ Table\n" +
-			" │           ├─ name: mytable\n" +
+			" │           ├─ name: mydb.mytable\n" +
 			" │           └─ columns: [i s]\n" +
 			" └─ TableAlias(b)\n" +
 			"     └─ Table\n" +
-			"         ├─ name: mytable\n" +
+			"         ├─ name: mydb.mytable\n" +
 			"         └─ columns: []\n" +
 			"",
 	},
@@ -2950,13 +3147,13 @@ inner join pq on true
 			" │   │   ├─ a.i:0!null\n" +
 			" │   │   └─ TUPLE(2 (tinyint), 432 (smallint), 7 (tinyint))\n" +
 			" │   └─ TableAlias(a)\n" +
-			" │       └─ IndexedTableAccess(mytable)\n" +
-			" │           ├─ index: [mytable.i]\n" +
+			" │       └─ IndexedTableAccess(mydb.mytable)\n" +
+			" │           ├─ index: `PRIMARY` [mytable.i]\n" +
 			" │           ├─ static: [{[2, 2]}, {[7, 7]}, {[432, 432]}]\n" +
 			" │           └─ columns: [i s]\n" +
 			" └─ TableAlias(b)\n" +
 			"     └─ Table\n" +
-			"         ├─ name: mytable\n" +
+			"         ├─ name: mydb.mytable\n" +
 			"         └─ columns: []\n" +
 			"",
 	},
@@ -2981,22 +3178,22 @@ inner join pq on true
 			"         │   │   │   ├─ c.i:0!null\n" +
 			"         │   │   │   └─ b.i:1!null\n" +
 			"         │   │   ├─ TableAlias(c)\n" +
-			"         │   │   │   └─ IndexedTableAccess(mytable)\n" +
-			"         │   │   │       ├─ index: [mytable.i]\n" +
+			"         │   │   │   └─ IndexedTableAccess(mydb.mytable)\n" +
+			"         │   │   │       ├─ index: `PRIMARY` [mytable.i]\n" +
 			"         │   │   │       ├─ static: [{[NULL, ∞)}]\n" +
 			"         │   │   │       └─ columns: [i]\n" +
 			"         │   │   └─ TableAlias(b)\n" +
-			"         │   │       └─ IndexedTableAccess(mytable)\n" +
-			"         │   │           ├─ index: [mytable.i]\n" +
+			"         │   │       └─ IndexedTableAccess(mydb.mytable)\n" +
+			"         │   │           ├─ index: `PRIMARY` [mytable.i]\n" +
 			"         │   │           ├─ static: [{[NULL, ∞)}]\n" +
 			"         │   │           └─ columns: [i]\n" +
 			"         │   └─ TableAlias(d)\n" +
-			"         │       └─ IndexedTableAccess(mytable)\n" +
-			"         │           ├─ index: [mytable.i]\n" +
+			"         │       └─ IndexedTableAccess(mydb.mytable)\n" +
+			"         │           ├─ index: `PRIMARY` [mytable.i]\n" +
 			"         │           └─ columns: [i]\n" +
 			"         └─ TableAlias(a)\n" +
-			"             └─ IndexedTableAccess(mytable)\n" +
-			"                 ├─ index: [mytable.i]\n" +
+			"             └─ IndexedTableAccess(mydb.mytable)\n" +
+			"                 ├─ index: `PRIMARY` [mytable.i]\n" +
 			"                 └─ columns: [i s]\n" +
 			"",
 	},
@@ -3021,22 +3218,22 @@ inner join pq on true
 			"     │   │   │   ├─ c.i:0!null\n" +
 			"     │   │   │   └─ b.i:1!null\n" +
 			"     │   │   ├─ TableAlias(c)\n" +
-			"     │   │   │   └─ IndexedTableAccess(mytable)\n" +
-			"     │   │   │       ├─ index: [mytable.i]\n" +
+			"     │   │   │   └─ IndexedTableAccess(mydb.mytable)\n" +
+			"     │   │   │       ├─ index: `PRIMARY` [mytable.i]\n" +
 			"     │   │   │       ├─ static: [{[NULL, ∞)}]\n" +
 			"     │   │   │       └─ columns: [i]\n" +
 			"     │   │   └─ TableAlias(b)\n" +
-			"     │   │       └─ IndexedTableAccess(mytable)\n" +
-			"     │   │           ├─ index: [mytable.i]\n" +
+			"     │   │       └─ IndexedTableAccess(mydb.mytable)\n" +
+			"     │   │           ├─ index: `PRIMARY` [mytable.i]\n" +
 			"     │   │           ├─ static: [{[NULL, ∞)}]\n" +
 			"     │   │           └─ columns: [i]\n" +
 			"     │   └─ TableAlias(a)\n" +
-			"     │       └─ IndexedTableAccess(mytable)\n" +
-			"     │           ├─ index: [mytable.i]\n" +
+			"     │       └─ IndexedTableAccess(mydb.mytable)\n" +
+			"     │           ├─ index: `PRIMARY` [mytable.i]\n" +
 			"     │           └─ columns: [i s]\n" +
 			"     └─ TableAlias(d)\n" +
 			"         └─ Table\n" +
-			"             ├─ name: mytable\n" +
+			"             ├─ name: mydb.mytable\n" +
 			"             └─ columns: [s]\n" +
 			"",
 	},
@@ -3054,22 +3251,22 @@ inner join pq on true
 			"     │   │   │   ├─ c.i:0!null\n" +
 			"     │   │   │   └─ b.i:1!null\n" +
 			"     │   │   ├─ TableAlias(c)\n" +
-			"     │   │   │   └─ IndexedTableAccess(mytable)\n" +
-			"     │   │   │       ├─ index: [mytable.i]\n" +
+			"     │   │   │   └─ IndexedTableAccess(mydb.mytable)\n" +
+			"     │   │   │       ├─ index: `PRIMARY` [mytable.i]\n" +
 			"     │   │   │       ├─ static: [{[NULL, ∞)}]\n" +
 			"     │   │   │       └─ columns: [i]\n" +
 			"     │   │   └─ TableAlias(b)\n" +
-			"     │   │       └─ IndexedTableAccess(mytable)\n" +
-			"     │   │           ├─ index: [mytable.i]\n" +
+			"     │   │       └─ IndexedTableAccess(mydb.mytable)\n" +
+			"     │   │           ├─ index: `PRIMARY` [mytable.i]\n" +
 			"     │   │           ├─ static: [{[NULL, ∞)}]\n" +
 			"     │   │           └─ columns: [i]\n" +
 			"     │   └─ TableAlias(a)\n" +
-			"     │       └─ IndexedTableAccess(mytable)\n" +
-			"     │           ├─ index: [mytable.i]\n" +
+			"     │       └─ IndexedTableAccess(mydb.mytable)\n" +
+			"     │           ├─ index: `PRIMARY` [mytable.i]\n" +
 			"     │           └─ columns: [i s]\n" +
 			"     └─ TableAlias(d)\n" +
 			"         └─ Table\n" +
-			"             ├─ name: mytable\n" +
+			"             ├─ name: mydb.mytable\n" +
 			"             └─ columns: []\n" +
 			"",
 	},
@@ -3082,13 +3279,13 @@ inner join pq on true
 			"     │   ├─ b.i:0!null\n" +
 			"     │   └─ a.i:1!null\n" +
 			"     ├─ TableAlias(b)\n" +
-			"     │   └─ IndexedTableAccess(mytable)\n" +
-			"     │       ├─ index: [mytable.i]\n" +
+			"     │   └─ IndexedTableAccess(mydb.mytable)\n" +
+			"     │       ├─ index: `PRIMARY` [mytable.i]\n" +
 			"     │       ├─ static: [{[NULL, ∞)}]\n" +
 			"     │       └─ columns: [i]\n" +
 			"     └─ TableAlias(a)\n" +
-			"         └─ IndexedTableAccess(mytable)\n" +
-			"             ├─ index: [mytable.i]\n" +
+			"         └─ IndexedTableAccess(mydb.mytable)\n" +
+			"             ├─ index: `PRIMARY` [mytable.i]\n" +
 			"             ├─ static: [{[NULL, ∞)}]\n" +
 			"             └─ columns: [i s]\n" +
 			"",
@@ -3096,27 +3293,23 @@ inner join pq on true
 	{
 		Query: `SELECT a.* FROM mytable a CROSS JOIN mytable b where a.i = b.i OR a.i = b.s`,
 		ExpectedPlan: "Project\n" +
-			" ├─ columns: [a.i:2!null, a.s:3!null]\n" +
-			" └─ LookupJoin\n" +
+			" ├─ columns: [a.i:0!null, a.s:1!null]\n" +
+			" └─ InnerJoin\n" +
 			"     ├─ Or\n" +
 			"     │   ├─ Eq\n" +
-			"     │   │   ├─ a.i:2!null\n" +
-			"     │   │   └─ b.i:0!null\n" +
+			"     │   │   ├─ a.i:0!null\n" +
+			"     │   │   └─ b.i:2!null\n" +
 			"     │   └─ Eq\n" +
-			"     │       ├─ a.i:2!null\n" +
-			"     │       └─ b.s:1!null\n" +
-			"     ├─ TableAlias(b)\n" +
+			"     │       ├─ a.i:0!null\n" +
+			"     │       └─ b.s:3!null\n" +
+			"     ├─ TableAlias(a)\n" +
 			"     │   └─ Table\n" +
-			"     │       ├─ name: mytable\n" +
+			"     │       ├─ name: mydb.mytable\n" +
 			"     │       └─ columns: [i s]\n" +
-			"     └─ TableAlias(a)\n" +
-			"         └─ Concat\n" +
-			"             ├─ IndexedTableAccess(mytable)\n" +
-			"             │   ├─ index: [mytable.i]\n" +
-			"             │   └─ columns: [i s]\n" +
-			"             └─ IndexedTableAccess(mytable)\n" +
-			"                 ├─ index: [mytable.i]\n" +
-			"                 └─ columns: [i s]\n" +
+			"     └─ TableAlias(b)\n" +
+			"         └─ Table\n" +
+			"             ├─ name: mydb.mytable\n" +
+			"             └─ columns: [i s]\n" +
 			"",
 	},
 	{
@@ -3134,11 +3327,11 @@ inner join pq on true
 			"     │           └─ b.i:2!null\n" +
 			"     ├─ TableAlias(a)\n" +
 			"     │   └─ Table\n" +
-			"     │       ├─ name: mytable\n" +
+			"     │       ├─ name: mydb.mytable\n" +
 			"     │       └─ columns: [i s]\n" +
 			"     └─ TableAlias(b)\n" +
 			"         └─ Table\n" +
-			"             ├─ name: mytable\n" +
+			"             ├─ name: mydb.mytable\n" +
 			"             └─ columns: [i s]\n" +
 			"",
 	},
@@ -3154,11 +3347,11 @@ inner join pq on true
 			"     │   └─ (a.s = b.i) IS FALSE\n" +
 			"     ├─ TableAlias(a)\n" +
 			"     │   └─ Table\n" +
-			"     │       ├─ name: mytable\n" +
+			"     │       ├─ name: mydb.mytable\n" +
 			"     │       └─ columns: [i s]\n" +
 			"     └─ TableAlias(b)\n" +
 			"         └─ Table\n" +
-			"             ├─ name: mytable\n" +
+			"             ├─ name: mydb.mytable\n" +
 			"             └─ columns: [i s]\n" +
 			"",
 	},
@@ -3172,11 +3365,11 @@ inner join pq on true
 			"     │   └─ b.i:2!null\n" +
 			"     ├─ TableAlias(a)\n" +
 			"     │   └─ Table\n" +
-			"     │       ├─ name: mytable\n" +
+			"     │       ├─ name: mydb.mytable\n" +
 			"     │       └─ columns: [i s]\n" +
 			"     └─ TableAlias(b)\n" +
 			"         └─ Table\n" +
-			"             ├─ name: mytable\n" +
+			"             ├─ name: mydb.mytable\n" +
 			"             └─ columns: [i]\n" +
 			"",
 	},
@@ -3189,11 +3382,11 @@ inner join pq on true
 			" │   │   └─ a.i:0!null\n" +
 			" │   └─ TableAlias(a)\n" +
 			" │       └─ Table\n" +
-			" │           ├─ name: mytable\n" +
+			" │           ├─ name: mydb.mytable\n" +
 			" │           └─ columns: [i s]\n" +
 			" └─ TableAlias(b)\n" +
 			"     └─ Table\n" +
-			"         ├─ name: mytable\n" +
+			"         ├─ name: mydb.mytable\n" +
 			"         └─ columns: []\n" +
 			"",
 	},
@@ -3218,22 +3411,22 @@ inner join pq on true
 			"         │   │   │   ├─ c.i:0!null\n" +
 			"         │   │   │   └─ b.i:1!null\n" +
 			"         │   │   ├─ TableAlias(c)\n" +
-			"         │   │   │   └─ IndexedTableAccess(mytable)\n" +
-			"         │   │   │       ├─ index: [mytable.i]\n" +
+			"         │   │   │   └─ IndexedTableAccess(mydb.mytable)\n" +
+			"         │   │   │       ├─ index: `PRIMARY` [mytable.i]\n" +
 			"         │   │   │       ├─ static: [{[NULL, ∞)}]\n" +
 			"         │   │   │       └─ columns: [i]\n" +
 			"         │   │   └─ TableAlias(b)\n" +
-			"         │   │       └─ IndexedTableAccess(mytable)\n" +
-			"         │   │           ├─ index: [mytable.i]\n" +
+			"         │   │       └─ IndexedTableAccess(mydb.mytable)\n" +
+			"         │   │           ├─ index: `PRIMARY` [mytable.i]\n" +
 			"         │   │           ├─ static: [{[NULL, ∞)}]\n" +
 			"         │   │           └─ columns: [i]\n" +
 			"         │   └─ TableAlias(d)\n" +
-			"         │       └─ IndexedTableAccess(mytable)\n" +
-			"         │           ├─ index: [mytable.i]\n" +
+			"         │       └─ IndexedTableAccess(mydb.mytable)\n" +
+			"         │           ├─ index: `PRIMARY` [mytable.i]\n" +
 			"         │           └─ columns: [i]\n" +
 			"         └─ TableAlias(a)\n" +
-			"             └─ IndexedTableAccess(mytable)\n" +
-			"                 ├─ index: [mytable.i]\n" +
+			"             └─ IndexedTableAccess(mydb.mytable)\n" +
+			"                 ├─ index: `PRIMARY` [mytable.i]\n" +
 			"                 └─ columns: [i s]\n" +
 			"",
 	},
@@ -3258,22 +3451,22 @@ inner join pq on true
 			"     │   │   │   ├─ c.i:0!null\n" +
 			"     │   │   │   └─ b.i:1!null\n" +
 			"     │   │   ├─ TableAlias(c)\n" +
-			"     │   │   │   └─ IndexedTableAccess(mytable)\n" +
-			"     │   │   │       ├─ index: [mytable.i]\n" +
+			"     │   │   │   └─ IndexedTableAccess(mydb.mytable)\n" +
+			"     │   │   │       ├─ index: `PRIMARY` [mytable.i]\n" +
 			"     │   │   │       ├─ static: [{[NULL, ∞)}]\n" +
 			"     │   │   │       └─ columns: [i]\n" +
 			"     │   │   └─ TableAlias(b)\n" +
-			"     │   │       └─ IndexedTableAccess(mytable)\n" +
-			"     │   │           ├─ index: [mytable.i]\n" +
+			"     │   │       └─ IndexedTableAccess(mydb.mytable)\n" +
+			"     │   │           ├─ index: `PRIMARY` [mytable.i]\n" +
 			"     │   │           ├─ static: [{[NULL, ∞)}]\n" +
 			"     │   │           └─ columns: [i]\n" +
 			"     │   └─ TableAlias(a)\n" +
-			"     │       └─ IndexedTableAccess(mytable)\n" +
-			"     │           ├─ index: [mytable.i]\n" +
+			"     │       └─ IndexedTableAccess(mydb.mytable)\n" +
+			"     │           ├─ index: `PRIMARY` [mytable.i]\n" +
 			"     │           └─ columns: [i s]\n" +
 			"     └─ TableAlias(d)\n" +
 			"         └─ Table\n" +
-			"             ├─ name: mytable\n" +
+			"             ├─ name: mydb.mytable\n" +
 			"             └─ columns: [s]\n" +
 			"",
 	},
@@ -3291,45 +3484,44 @@ inner join pq on true
 			"     │   │   │   ├─ c.s:0!null\n" +
 			"     │   │   │   └─ b.s:2!null\n" +
 			"     │   │   ├─ TableAlias(c)\n" +
-			"     │   │   │   └─ IndexedTableAccess(mytable)\n" +
-			"     │   │   │       ├─ index: [mytable.s,mytable.i]\n" +
+			"     │   │   │   └─ IndexedTableAccess(mydb.mytable)\n" +
+			"     │   │   │       ├─ index: idx_si [mytable.s,mytable.i]\n" +
 			"     │   │   │       ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
 			"     │   │   │       └─ columns: [s]\n" +
 			"     │   │   └─ TableAlias(b)\n" +
-			"     │   │       └─ IndexedTableAccess(mytable)\n" +
-			"     │   │           ├─ index: [mytable.s,mytable.i]\n" +
+			"     │   │       └─ IndexedTableAccess(mydb.mytable)\n" +
+			"     │   │           ├─ index: idx_si [mytable.s,mytable.i]\n" +
 			"     │   │           ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
 			"     │   │           └─ columns: [i s]\n" +
 			"     │   └─ TableAlias(a)\n" +
-			"     │       └─ IndexedTableAccess(mytable)\n" +
-			"     │           ├─ index: [mytable.i]\n" +
+			"     │       └─ IndexedTableAccess(mydb.mytable)\n" +
+			"     │           ├─ index: `PRIMARY` [mytable.i]\n" +
 			"     │           └─ columns: [i s]\n" +
 			"     └─ TableAlias(d)\n" +
 			"         └─ Table\n" +
-			"             ├─ name: mytable\n" +
+			"             ├─ name: mydb.mytable\n" +
 			"             └─ columns: []\n" +
 			"",
 	},
 	{
 		Query: `SELECT a.* FROM mytable a inner join mytable b on (a.i = b.s) WHERE a.i BETWEEN 10 AND 20`,
 		ExpectedPlan: "Project\n" +
-			" ├─ columns: [a.i:1!null, a.s:2!null]\n" +
-			" └─ Filter\n" +
-			"     ├─ (a.i:1!null BETWEEN 10 (tinyint) AND 20 (tinyint))\n" +
-			"     └─ MergeJoin\n" +
-			"         ├─ cmp: Eq\n" +
-			"         │   ├─ b.s:0!null\n" +
-			"         │   └─ a.i:1!null\n" +
-			"         ├─ TableAlias(b)\n" +
-			"         │   └─ IndexedTableAccess(mytable)\n" +
-			"         │       ├─ index: [mytable.s,mytable.i]\n" +
-			"         │       ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
-			"         │       └─ columns: [s]\n" +
-			"         └─ TableAlias(a)\n" +
-			"             └─ IndexedTableAccess(mytable)\n" +
-			"                 ├─ index: [mytable.i]\n" +
-			"                 ├─ static: [{[NULL, ∞)}]\n" +
-			"                 └─ columns: [i s]\n" +
+			" ├─ columns: [a.i:0!null, a.s:1!null]\n" +
+			" └─ InnerJoin\n" +
+			"     ├─ Eq\n" +
+			"     │   ├─ a.i:0!null\n" +
+			"     │   └─ b.s:2!null\n" +
+			"     ├─ Filter\n" +
+			"     │   ├─ (a.i:0!null BETWEEN 10 (tinyint) AND 20 (tinyint))\n" +
+			"     │   └─ TableAlias(a)\n" +
+			"     │       └─ IndexedTableAccess(mydb.mytable)\n" +
+			"     │           ├─ index: `PRIMARY` [mytable.i]\n" +
+			"     │           ├─ static: [{[10, 20]}]\n" +
+			"     │           └─ columns: [i s]\n" +
+			"     └─ TableAlias(b)\n" +
+			"         └─ Table\n" +
+			"             ├─ name: mydb.mytable\n" +
+			"             └─ columns: [s]\n" +
 			"",
 	},
 	{
@@ -3354,7 +3546,7 @@ inner join pq on true
 			"         │   ├─ outerVisibility: false\n" +
 			"         │   ├─ cacheable: true\n" +
 			"         │   └─ Table\n" +
-			"         │       ├─ name: mytable\n" +
+			"         │       ├─ name: mydb.mytable\n" +
 			"         │       └─ columns: [i s]\n" +
 			"         └─ HashLookup\n" +
 			"             ├─ source: TUPLE(righttable.i:0!null, righttable.s:1!null)\n" +
@@ -3365,7 +3557,7 @@ inner join pq on true
 			"                     ├─ outerVisibility: false\n" +
 			"                     ├─ cacheable: true\n" +
 			"                     └─ Table\n" +
-			"                         ├─ name: mytable\n" +
+			"                         ├─ name: mydb.mytable\n" +
 			"                         └─ columns: [i s]\n" +
 			"",
 	},
@@ -3380,14 +3572,14 @@ inner join pq on true
 			" │   ├─ outerVisibility: false\n" +
 			" │   ├─ cacheable: true\n" +
 			" │   └─ Table\n" +
-			" │       ├─ name: othertable\n" +
+			" │       ├─ name: mydb.othertable\n" +
 			" │       └─ columns: [s2 i2]\n" +
 			" └─ HashLookup\n" +
 			"     ├─ source: TUPLE(othertable.i2:1!null)\n" +
 			"     ├─ target: TUPLE(mytable.i:0!null)\n" +
 			"     └─ CachedResults\n" +
 			"         └─ Table\n" +
-			"             ├─ name: mytable\n" +
+			"             ├─ name: mydb.mytable\n" +
 			"             └─ columns: [i]\n" +
 			"",
 	},
@@ -3402,14 +3594,14 @@ inner join pq on true
 			" │   ├─ outerVisibility: false\n" +
 			" │   ├─ cacheable: true\n" +
 			" │   └─ Table\n" +
-			" │       ├─ name: othertable\n" +
+			" │       ├─ name: mydb.othertable\n" +
 			" │       └─ columns: [s2 i2]\n" +
 			" └─ HashLookup\n" +
 			"     ├─ source: TUPLE(othertable.i2:1!null)\n" +
 			"     ├─ target: TUPLE(mytable.i:0!null)\n" +
 			"     └─ CachedResults\n" +
 			"         └─ Table\n" +
-			"             ├─ name: mytable\n" +
+			"             ├─ name: mydb.mytable\n" +
 			"             └─ columns: [i]\n" +
 			"",
 	},
@@ -3423,8 +3615,8 @@ inner join pq on true
 			"     ├─ Eq\n" +
 			"     │   ├─ othertable.s2:0!null\n" +
 			"     │   └─ a (longtext)\n" +
-			"     └─ IndexedTableAccess(othertable)\n" +
-			"         ├─ index: [othertable.s2]\n" +
+			"     └─ IndexedTableAccess(mydb.othertable)\n" +
+			"         ├─ index: othertable_s2 [othertable.s2]\n" +
 			"         ├─ static: [{[a, a]}]\n" +
 			"         └─ columns: [s2 i2]\n" +
 			"",
@@ -3447,8 +3639,8 @@ inner join pq on true
 			"             ├─ Eq\n" +
 			"             │   ├─ othertable.s2:0!null\n" +
 			"             │   └─ a (longtext)\n" +
-			"             └─ IndexedTableAccess(othertable)\n" +
-			"                 ├─ index: [othertable.s2]\n" +
+			"             └─ IndexedTableAccess(mydb.othertable)\n" +
+			"                 ├─ index: othertable_s2 [othertable.s2]\n" +
 			"                 ├─ static: [{[a, a]}]\n" +
 			"                 └─ columns: [s2 i2]\n" +
 			"",
@@ -3467,8 +3659,8 @@ inner join pq on true
 			" │       ├─ GreaterThan\n" +
 			" │       │   ├─ othertable.s2:0!null\n" +
 			" │       │   └─ a (longtext)\n" +
-			" │       └─ IndexedTableAccess(othertable)\n" +
-			" │           ├─ index: [othertable.s2]\n" +
+			" │       └─ IndexedTableAccess(mydb.othertable)\n" +
+			" │           ├─ index: othertable_s2 [othertable.s2]\n" +
 			" │           ├─ static: [{(a, ∞)}]\n" +
 			" │           └─ columns: [s2 i2]\n" +
 			" └─ HashLookup\n" +
@@ -3476,7 +3668,7 @@ inner join pq on true
 			"     ├─ target: TUPLE(mytable.i:0!null)\n" +
 			"     └─ CachedResults\n" +
 			"         └─ Table\n" +
-			"             ├─ name: mytable\n" +
+			"             ├─ name: mydb.mytable\n" +
 			"             └─ columns: [i]\n" +
 			"",
 	},
@@ -3489,15 +3681,15 @@ inner join pq on true
 			" ├─ OrderedDistinct\n" +
 			" │   └─ Max1Row\n" +
 			" │       └─ SubqueryAlias\n" +
-			" │           ├─ name: scalarSubq0\n" +
+			" │           ├─ name: `scalarSubq0`\n" +
 			" │           ├─ outerVisibility: false\n" +
 			" │           ├─ cacheable: true\n" +
 			" │           └─ Limit(1)\n" +
 			" │               └─ Table\n" +
-			" │                   ├─ name: othertable\n" +
+			" │                   ├─ name: mydb.othertable\n" +
 			" │                   └─ columns: [i2]\n" +
-			" └─ IndexedTableAccess(mytable)\n" +
-			"     ├─ index: [mytable.i]\n" +
+			" └─ IndexedTableAccess(mydb.mytable)\n" +
+			"     ├─ index: `PRIMARY` [mytable.i]\n" +
 			"     └─ columns: [i s]\n" +
 			"",
 	},
@@ -3508,11 +3700,11 @@ inner join pq on true
 			" │   ├─ mytable.i:0!null\n" +
 			" │   └─ scalarSubq0.i2:2!null\n" +
 			" ├─ Table\n" +
-			" │   ├─ name: mytable\n" +
+			" │   ├─ name: mydb.mytable\n" +
 			" │   └─ columns: [i s]\n" +
-			" └─ TableAlias(scalarSubq0)\n" +
-			"     └─ IndexedTableAccess(othertable)\n" +
-			"         ├─ index: [othertable.i2]\n" +
+			" └─ TableAlias(`scalarSubq0`)\n" +
+			"     └─ IndexedTableAccess(mydb.othertable)\n" +
+			"         ├─ index: `PRIMARY` [othertable.i2]\n" +
 			"         └─ columns: [i2]\n" +
 			"",
 	},
@@ -3527,11 +3719,11 @@ inner join pq on true
 			" │           ├─ Eq\n" +
 			" │           │   ├─ mytable.i:0!null\n" +
 			" │           │   └─ othertable.i2:2!null\n" +
-			" │           └─ IndexedTableAccess(othertable)\n" +
-			" │               ├─ index: [othertable.i2]\n" +
+			" │           └─ IndexedTableAccess(mydb.othertable)\n" +
+			" │               ├─ index: `PRIMARY` [othertable.i2]\n" +
 			" │               └─ columns: [i2]\n" +
 			" └─ Table\n" +
-			"     ├─ name: mytable\n" +
+			"     ├─ name: mydb.mytable\n" +
 			"     └─ columns: [i s]\n" +
 			"",
 	},
@@ -3548,13 +3740,13 @@ inner join pq on true
 			"         │   ├─ ot.i2:1!null\n" +
 			"         │   └─ mt.i:2!null\n" +
 			"         ├─ TableAlias(ot)\n" +
-			"         │   └─ IndexedTableAccess(othertable)\n" +
-			"         │       ├─ index: [othertable.i2]\n" +
+			"         │   └─ IndexedTableAccess(mydb.othertable)\n" +
+			"         │       ├─ index: `PRIMARY` [othertable.i2]\n" +
 			"         │       ├─ static: [{[NULL, ∞)}]\n" +
 			"         │       └─ columns: [s2 i2]\n" +
 			"         └─ TableAlias(mt)\n" +
-			"             └─ IndexedTableAccess(mytable)\n" +
-			"                 ├─ index: [mytable.i]\n" +
+			"             └─ IndexedTableAccess(mydb.mytable)\n" +
+			"                 ├─ index: `PRIMARY` [mytable.i]\n" +
 			"                 ├─ static: [{[NULL, ∞)}]\n" +
 			"                 └─ columns: [i s]\n" +
 			"",
@@ -3569,13 +3761,13 @@ inner join pq on true
 			" │   ├─ mt.s:1!null\n" +
 			" │   └─ o.c2:4\n" +
 			" ├─ TableAlias(mt)\n" +
-			" │   └─ IndexedTableAccess(mytable)\n" +
-			" │       ├─ index: [mytable.i]\n" +
+			" │   └─ IndexedTableAccess(mydb.mytable)\n" +
+			" │       ├─ index: `PRIMARY` [mytable.i]\n" +
 			" │       ├─ static: [{[NULL, ∞)}]\n" +
 			" │       └─ columns: [i s]\n" +
 			" └─ TableAlias(o)\n" +
-			"     └─ IndexedTableAccess(one_pk)\n" +
-			"         ├─ index: [one_pk.pk]\n" +
+			"     └─ IndexedTableAccess(mydb.one_pk)\n" +
+			"         ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"         ├─ static: [{[NULL, ∞)}]\n" +
 			"         └─ columns: [pk c1 c2 c3 c4 c5]\n" +
 			"",
@@ -3589,10 +3781,10 @@ inner join pq on true
 			"     │   ├─ mytable.i:2!null\n" +
 			"     │   └─ (othertable.i2:1!null - 1 (tinyint))\n" +
 			"     ├─ Table\n" +
-			"     │   ├─ name: othertable\n" +
+			"     │   ├─ name: mydb.othertable\n" +
 			"     │   └─ columns: [s2 i2]\n" +
-			"     └─ IndexedTableAccess(mytable)\n" +
-			"         ├─ index: [mytable.i]\n" +
+			"     └─ IndexedTableAccess(mydb.mytable)\n" +
+			"         ├─ index: `PRIMARY` [mytable.i]\n" +
 			"         └─ columns: [i]\n" +
 			"",
 	},
@@ -3602,20 +3794,20 @@ inner join pq on true
 			" ├─ columns: [tabletest.i:0!null, tabletest.s:1!null, mt.i:4!null, mt.s:5!null, ot.s2:2!null, ot.i2:3!null]\n" +
 			" └─ CrossJoin\n" +
 			"     ├─ Table\n" +
-			"     │   ├─ name: tabletest\n" +
+			"     │   ├─ name: mydb.tabletest\n" +
 			"     │   └─ columns: [i s]\n" +
 			"     └─ MergeJoin\n" +
 			"         ├─ cmp: Eq\n" +
 			"         │   ├─ ot.i2:3!null\n" +
 			"         │   └─ mt.i:4!null\n" +
 			"         ├─ TableAlias(ot)\n" +
-			"         │   └─ IndexedTableAccess(othertable)\n" +
-			"         │       ├─ index: [othertable.i2]\n" +
+			"         │   └─ IndexedTableAccess(mydb.othertable)\n" +
+			"         │       ├─ index: `PRIMARY` [othertable.i2]\n" +
 			"         │       ├─ static: [{[NULL, ∞)}]\n" +
 			"         │       └─ columns: [s2 i2]\n" +
 			"         └─ TableAlias(mt)\n" +
-			"             └─ IndexedTableAccess(mytable)\n" +
-			"                 ├─ index: [mytable.i]\n" +
+			"             └─ IndexedTableAccess(mydb.mytable)\n" +
+			"                 ├─ index: `PRIMARY` [mytable.i]\n" +
 			"                 ├─ static: [{[NULL, ∞)}]\n" +
 			"                 └─ columns: [i s]\n" +
 			"",
@@ -3630,11 +3822,11 @@ inner join pq on true
 			"     │   └─ t2.Timestamp:1!null\n" +
 			"     ├─ TableAlias(t1)\n" +
 			"     │   └─ Table\n" +
-			"     │       ├─ name: reservedWordsTable\n" +
+			"     │       ├─ name: mydb.`reservedWordsTable`\n" +
 			"     │       └─ columns: [timestamp]\n" +
 			"     └─ TableAlias(t2)\n" +
 			"         └─ Table\n" +
-			"             ├─ name: reservedWordsTable\n" +
+			"             ├─ name: mydb.`reservedWordsTable`\n" +
 			"             └─ columns: [timestamp]\n" +
 			"",
 	},
@@ -3649,12 +3841,12 @@ inner join pq on true
 			"     ├─ sel: Eq\n" +
 			"     │   ├─ one_pk.pk:2!null\n" +
 			"     │   └─ two_pk.pk2:1!null\n" +
-			"     ├─ IndexedTableAccess(two_pk)\n" +
-			"     │   ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"     ├─ IndexedTableAccess(mydb.two_pk)\n" +
+			"     │   ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
 			"     │   ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
 			"     │   └─ columns: [pk1 pk2]\n" +
-			"     └─ IndexedTableAccess(one_pk)\n" +
-			"         ├─ index: [one_pk.pk]\n" +
+			"     └─ IndexedTableAccess(mydb.one_pk)\n" +
+			"         ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"         ├─ static: [{[NULL, ∞)}]\n" +
 			"         └─ columns: [pk]\n" +
 			"",
@@ -3676,10 +3868,10 @@ inner join pq on true
 			"     │       ├─ one_pk.c2:1\n" +
 			"     │       └─ two_pk.c3:4!null\n" +
 			"     ├─ Table\n" +
-			"     │   ├─ name: one_pk\n" +
+			"     │   ├─ name: mydb.one_pk\n" +
 			"     │   └─ columns: [pk c2]\n" +
 			"     └─ Table\n" +
-			"         ├─ name: two_pk\n" +
+			"         ├─ name: mydb.two_pk\n" +
 			"         └─ columns: [pk1 pk2 c3]\n" +
 			"",
 	},
@@ -3695,13 +3887,13 @@ inner join pq on true
 			"     │   ├─ opk.pk:2!null\n" +
 			"     │   └─ tpk.pk2:1!null\n" +
 			"     ├─ TableAlias(tpk)\n" +
-			"     │   └─ IndexedTableAccess(two_pk)\n" +
-			"     │       ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"     │   └─ IndexedTableAccess(mydb.two_pk)\n" +
+			"     │       ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
 			"     │       ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
 			"     │       └─ columns: [pk1 pk2]\n" +
 			"     └─ TableAlias(opk)\n" +
-			"         └─ IndexedTableAccess(one_pk)\n" +
-			"             ├─ index: [one_pk.pk]\n" +
+			"         └─ IndexedTableAccess(mydb.one_pk)\n" +
+			"             ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"             ├─ static: [{[NULL, ∞)}]\n" +
 			"             └─ columns: [pk]\n" +
 			"",
@@ -3717,29 +3909,30 @@ inner join pq on true
 			"     ├─ sel: Eq\n" +
 			"     │   ├─ one_pk.pk:2!null\n" +
 			"     │   └─ two_pk.pk2:1!null\n" +
-			"     ├─ IndexedTableAccess(two_pk)\n" +
-			"     │   ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"     ├─ IndexedTableAccess(mydb.two_pk)\n" +
+			"     │   ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
 			"     │   ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
 			"     │   └─ columns: [pk1 pk2]\n" +
-			"     └─ IndexedTableAccess(one_pk)\n" +
-			"         ├─ index: [one_pk.pk]\n" +
+			"     └─ IndexedTableAccess(mydb.one_pk)\n" +
+			"         ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"         ├─ static: [{[NULL, ∞)}]\n" +
 			"         └─ columns: [pk]\n" +
 			"",
 	},
 	{
 		Query: `SELECT pk,pk1,pk2 FROM one_pk LEFT JOIN two_pk ON one_pk.pk <=> two_pk.pk1 AND one_pk.pk = two_pk.pk2`,
-		ExpectedPlan: "LeftOuterLookupJoin\n" +
-			" ├─ AND\n" +
-			" │   ├─ (one_pk.pk:0!null <=> two_pk.pk1:1!null)\n" +
-			" │   └─ Eq\n" +
-			" │       ├─ one_pk.pk:0!null\n" +
-			" │       └─ two_pk.pk2:2!null\n" +
-			" ├─ Table\n" +
-			" │   ├─ name: one_pk\n" +
+		ExpectedPlan: "LeftOuterMergeJoin\n" +
+			" ├─ cmp: (one_pk.pk:0!null <=> two_pk.pk1:1!null)\n" +
+			" ├─ sel: Eq\n" +
+			" │   ├─ one_pk.pk:0!null\n" +
+			" │   └─ two_pk.pk2:2!null\n" +
+			" ├─ IndexedTableAccess(mydb.one_pk)\n" +
+			" │   ├─ index: `PRIMARY` [one_pk.pk]\n" +
+			" │   ├─ static: [{[NULL, ∞)}]\n" +
 			" │   └─ columns: [pk]\n" +
-			" └─ IndexedTableAccess(two_pk)\n" +
-			"     ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			" └─ IndexedTableAccess(mydb.two_pk)\n" +
+			"     ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
+			"     ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk1 pk2]\n" +
 			"",
 	},
@@ -3750,27 +3943,28 @@ inner join pq on true
 			" │   ├─ one_pk.pk:0!null\n" +
 			" │   └─ two_pk.pk1:1!null\n" +
 			" ├─ sel: (one_pk.pk:0!null <=> two_pk.pk2:2!null)\n" +
-			" ├─ IndexedTableAccess(one_pk)\n" +
-			" │   ├─ index: [one_pk.pk]\n" +
+			" ├─ IndexedTableAccess(mydb.one_pk)\n" +
+			" │   ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			" │   ├─ static: [{[NULL, ∞)}]\n" +
 			" │   └─ columns: [pk]\n" +
-			" └─ IndexedTableAccess(two_pk)\n" +
-			"     ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			" └─ IndexedTableAccess(mydb.two_pk)\n" +
+			"     ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
 			"     ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk1 pk2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT pk,pk1,pk2 FROM one_pk LEFT JOIN two_pk ON one_pk.pk <=> two_pk.pk1 AND one_pk.pk <=> two_pk.pk2`,
-		ExpectedPlan: "LeftOuterLookupJoin\n" +
-			" ├─ AND\n" +
-			" │   ├─ (one_pk.pk:0!null <=> two_pk.pk1:1!null)\n" +
-			" │   └─ (one_pk.pk:0!null <=> two_pk.pk2:2!null)\n" +
-			" ├─ Table\n" +
-			" │   ├─ name: one_pk\n" +
+		ExpectedPlan: "LeftOuterMergeJoin\n" +
+			" ├─ cmp: (one_pk.pk:0!null <=> two_pk.pk1:1!null)\n" +
+			" ├─ sel: (one_pk.pk:0!null <=> two_pk.pk2:2!null)\n" +
+			" ├─ IndexedTableAccess(mydb.one_pk)\n" +
+			" │   ├─ index: `PRIMARY` [one_pk.pk]\n" +
+			" │   ├─ static: [{[NULL, ∞)}]\n" +
 			" │   └─ columns: [pk]\n" +
-			" └─ IndexedTableAccess(two_pk)\n" +
-			"     ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			" └─ IndexedTableAccess(mydb.two_pk)\n" +
+			"     ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
+			"     ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk1 pk2]\n" +
 			"",
 	},
@@ -3785,12 +3979,12 @@ inner join pq on true
 			"     ├─ sel: Eq\n" +
 			"     │   ├─ one_pk.pk:2!null\n" +
 			"     │   └─ two_pk.pk2:1!null\n" +
-			"     ├─ IndexedTableAccess(two_pk)\n" +
-			"     │   ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"     ├─ IndexedTableAccess(mydb.two_pk)\n" +
+			"     │   ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
 			"     │   ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
 			"     │   └─ columns: [pk1 pk2]\n" +
-			"     └─ IndexedTableAccess(one_pk)\n" +
-			"         ├─ index: [one_pk.pk]\n" +
+			"     └─ IndexedTableAccess(mydb.one_pk)\n" +
+			"         ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"         ├─ static: [{[NULL, ∞)}]\n" +
 			"         └─ columns: [pk]\n" +
 			"",
@@ -3801,8 +3995,8 @@ inner join pq on true
 			" ├─ name: othertable_alias\n" +
 			" ├─ outerVisibility: false\n" +
 			" ├─ cacheable: true\n" +
-			" └─ IndexedTableAccess(othertable)\n" +
-			"     ├─ index: [othertable.i2]\n" +
+			" └─ IndexedTableAccess(mydb.othertable)\n" +
+			"     ├─ index: `PRIMARY` [othertable.i2]\n" +
 			"     ├─ static: [{[1, 1]}]\n" +
 			"     └─ columns: [s2 i2]\n" +
 			"",
@@ -3813,37 +4007,37 @@ inner join pq on true
 			" ├─ name: othertable_alias\n" +
 			" ├─ outerVisibility: false\n" +
 			" ├─ cacheable: true\n" +
-			" └─ IndexedTableAccess(othertable)\n" +
-			"     ├─ index: [othertable.i2]\n" +
+			" └─ IndexedTableAccess(mydb.othertable)\n" +
+			"     ├─ index: `PRIMARY` [othertable.i2]\n" +
 			"     ├─ static: [{[1, 1]}]\n" +
 			"     └─ columns: [s2 i2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM datetime_table ORDER BY date_col ASC`,
-		ExpectedPlan: "Sort(datetime_table.date_col:1 ASC nullsFirst)\n" +
-			" └─ Table\n" +
-			"     ├─ name: datetime_table\n" +
-			"     └─ columns: [i date_col datetime_col timestamp_col time_col]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.datetime_table)\n" +
+			" ├─ index: datetime_table_d [datetime_table.date_col]\n" +
+			" ├─ static: [{[NULL, ∞)}]\n" +
+			" └─ columns: [i date_col datetime_col timestamp_col time_col]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM datetime_table ORDER BY date_col ASC LIMIT 100`,
 		ExpectedPlan: "Limit(100)\n" +
-			" └─ TopN(Limit: [100 (tinyint)]; datetime_table.date_col:1 ASC nullsFirst)\n" +
-			"     └─ Table\n" +
-			"         ├─ name: datetime_table\n" +
-			"         └─ columns: [i date_col datetime_col timestamp_col time_col]\n" +
+			" └─ IndexedTableAccess(mydb.datetime_table)\n" +
+			"     ├─ index: datetime_table_d [datetime_table.date_col]\n" +
+			"     ├─ static: [{[NULL, ∞)}]\n" +
+			"     └─ columns: [i date_col datetime_col timestamp_col time_col]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM datetime_table ORDER BY date_col ASC LIMIT 100 OFFSET 100`,
 		ExpectedPlan: "Limit(100)\n" +
 			" └─ Offset(100)\n" +
-			"     └─ TopN(Limit: [(100 + 100)]; datetime_table.date_col ASC)\n" +
-			"         └─ Table\n" +
-			"             ├─ name: datetime_table\n" +
-			"             └─ columns: [i date_col datetime_col timestamp_col time_col]\n" +
+			"     └─ IndexedTableAccess(mydb.datetime_table)\n" +
+			"         ├─ index: datetime_table_d [datetime_table.date_col]\n" +
+			"         ├─ filters: [{[NULL, ∞)}]\n" +
+			"         └─ columns: [i date_col datetime_col timestamp_col time_col]\n" +
 			"",
 	},
 	{
@@ -3852,8 +4046,8 @@ inner join pq on true
 			" ├─ Eq\n" +
 			" │   ├─ datetime_table.date_col:1\n" +
 			" │   └─ 2020-01-01 (longtext)\n" +
-			" └─ IndexedTableAccess(datetime_table)\n" +
-			"     ├─ index: [datetime_table.date_col]\n" +
+			" └─ IndexedTableAccess(mydb.datetime_table)\n" +
+			"     ├─ index: datetime_table_d [datetime_table.date_col]\n" +
 			"     ├─ static: [{[2020-01-01, 2020-01-01]}]\n" +
 			"     └─ columns: [i date_col datetime_col timestamp_col time_col]\n" +
 			"",
@@ -3864,8 +4058,8 @@ inner join pq on true
 			" ├─ GreaterThan\n" +
 			" │   ├─ datetime_table.date_col:1\n" +
 			" │   └─ 2020-01-01 (longtext)\n" +
-			" └─ IndexedTableAccess(datetime_table)\n" +
-			"     ├─ index: [datetime_table.date_col]\n" +
+			" └─ IndexedTableAccess(mydb.datetime_table)\n" +
+			"     ├─ index: datetime_table_d [datetime_table.date_col]\n" +
 			"     ├─ static: [{(2020-01-01, ∞)}]\n" +
 			"     └─ columns: [i date_col datetime_col timestamp_col time_col]\n" +
 			"",
@@ -3876,8 +4070,8 @@ inner join pq on true
 			" ├─ Eq\n" +
 			" │   ├─ datetime_table.datetime_col:2\n" +
 			" │   └─ 2020-01-01 (longtext)\n" +
-			" └─ IndexedTableAccess(datetime_table)\n" +
-			"     ├─ index: [datetime_table.datetime_col]\n" +
+			" └─ IndexedTableAccess(mydb.datetime_table)\n" +
+			"     ├─ index: datetime_table_dt [datetime_table.datetime_col]\n" +
 			"     ├─ static: [{[2020-01-01, 2020-01-01]}]\n" +
 			"     └─ columns: [i date_col datetime_col timestamp_col time_col]\n" +
 			"",
@@ -3888,8 +4082,8 @@ inner join pq on true
 			" ├─ GreaterThan\n" +
 			" │   ├─ datetime_table.datetime_col:2\n" +
 			" │   └─ 2020-01-01 (longtext)\n" +
-			" └─ IndexedTableAccess(datetime_table)\n" +
-			"     ├─ index: [datetime_table.datetime_col]\n" +
+			" └─ IndexedTableAccess(mydb.datetime_table)\n" +
+			"     ├─ index: datetime_table_dt [datetime_table.datetime_col]\n" +
 			"     ├─ static: [{(2020-01-01, ∞)}]\n" +
 			"     └─ columns: [i date_col datetime_col timestamp_col time_col]\n" +
 			"",
@@ -3900,8 +4094,8 @@ inner join pq on true
 			" ├─ Eq\n" +
 			" │   ├─ datetime_table.timestamp_col:3\n" +
 			" │   └─ 2020-01-01 (longtext)\n" +
-			" └─ IndexedTableAccess(datetime_table)\n" +
-			"     ├─ index: [datetime_table.timestamp_col]\n" +
+			" └─ IndexedTableAccess(mydb.datetime_table)\n" +
+			"     ├─ index: datetime_table_ts [datetime_table.timestamp_col]\n" +
 			"     ├─ static: [{[2020-01-01, 2020-01-01]}]\n" +
 			"     └─ columns: [i date_col datetime_col timestamp_col time_col]\n" +
 			"",
@@ -3912,8 +4106,8 @@ inner join pq on true
 			" ├─ GreaterThan\n" +
 			" │   ├─ datetime_table.timestamp_col:3\n" +
 			" │   └─ 2020-01-01 (longtext)\n" +
-			" └─ IndexedTableAccess(datetime_table)\n" +
-			"     ├─ index: [datetime_table.timestamp_col]\n" +
+			" └─ IndexedTableAccess(mydb.datetime_table)\n" +
+			"     ├─ index: datetime_table_ts [datetime_table.timestamp_col]\n" +
 			"     ├─ static: [{(2020-01-01, ∞)}]\n" +
 			"     └─ columns: [i date_col datetime_col timestamp_col time_col]\n" +
 			"",
@@ -3927,13 +4121,13 @@ inner join pq on true
 			"     │   ├─ dt2.timestamp_col:3\n" +
 			"     │   └─ dt1.timestamp_col:8\n" +
 			"     ├─ TableAlias(dt2)\n" +
-			"     │   └─ IndexedTableAccess(datetime_table)\n" +
-			"     │       ├─ index: [datetime_table.timestamp_col]\n" +
+			"     │   └─ IndexedTableAccess(mydb.datetime_table)\n" +
+			"     │       ├─ index: datetime_table_ts [datetime_table.timestamp_col]\n" +
 			"     │       ├─ static: [{[NULL, ∞)}]\n" +
 			"     │       └─ columns: [i date_col datetime_col timestamp_col time_col]\n" +
 			"     └─ TableAlias(dt1)\n" +
-			"         └─ IndexedTableAccess(datetime_table)\n" +
-			"             ├─ index: [datetime_table.timestamp_col]\n" +
+			"         └─ IndexedTableAccess(mydb.datetime_table)\n" +
+			"             ├─ index: datetime_table_ts [datetime_table.timestamp_col]\n" +
 			"             ├─ static: [{[NULL, ∞)}]\n" +
 			"             └─ columns: [i date_col datetime_col timestamp_col time_col]\n" +
 			"",
@@ -3947,13 +4141,13 @@ inner join pq on true
 			"     │   ├─ dt2.timestamp_col:3\n" +
 			"     │   └─ dt1.date_col:6\n" +
 			"     ├─ TableAlias(dt2)\n" +
-			"     │   └─ IndexedTableAccess(datetime_table)\n" +
-			"     │       ├─ index: [datetime_table.timestamp_col]\n" +
+			"     │   └─ IndexedTableAccess(mydb.datetime_table)\n" +
+			"     │       ├─ index: datetime_table_ts [datetime_table.timestamp_col]\n" +
 			"     │       ├─ static: [{[NULL, ∞)}]\n" +
 			"     │       └─ columns: [i date_col datetime_col timestamp_col time_col]\n" +
 			"     └─ TableAlias(dt1)\n" +
-			"         └─ IndexedTableAccess(datetime_table)\n" +
-			"             ├─ index: [datetime_table.date_col]\n" +
+			"         └─ IndexedTableAccess(mydb.datetime_table)\n" +
+			"             ├─ index: datetime_table_d [datetime_table.date_col]\n" +
 			"             ├─ static: [{[NULL, ∞)}]\n" +
 			"             └─ columns: [i date_col datetime_col timestamp_col time_col]\n" +
 			"",
@@ -3967,13 +4161,13 @@ inner join pq on true
 			"     │   ├─ dt2.timestamp_col:3\n" +
 			"     │   └─ dt1.datetime_col:7\n" +
 			"     ├─ TableAlias(dt2)\n" +
-			"     │   └─ IndexedTableAccess(datetime_table)\n" +
-			"     │       ├─ index: [datetime_table.timestamp_col]\n" +
+			"     │   └─ IndexedTableAccess(mydb.datetime_table)\n" +
+			"     │       ├─ index: datetime_table_ts [datetime_table.timestamp_col]\n" +
 			"     │       ├─ static: [{[NULL, ∞)}]\n" +
 			"     │       └─ columns: [i date_col datetime_col timestamp_col time_col]\n" +
 			"     └─ TableAlias(dt1)\n" +
-			"         └─ IndexedTableAccess(datetime_table)\n" +
-			"             ├─ index: [datetime_table.datetime_col]\n" +
+			"         └─ IndexedTableAccess(mydb.datetime_table)\n" +
+			"             ├─ index: datetime_table_dt [datetime_table.datetime_col]\n" +
 			"             ├─ static: [{[NULL, ∞)}]\n" +
 			"             └─ columns: [i date_col datetime_col timestamp_col time_col]\n" +
 			"",
@@ -3991,11 +4185,11 @@ inner join pq on true
 			"         │   └─ DATE(date_sub(dt2.timestamp_col,INTERVAL 2 DAY))\n" +
 			"         ├─ TableAlias(dt2)\n" +
 			"         │   └─ Table\n" +
-			"         │       ├─ name: datetime_table\n" +
+			"         │       ├─ name: mydb.datetime_table\n" +
 			"         │       └─ columns: [timestamp_col]\n" +
 			"         └─ TableAlias(dt1)\n" +
-			"             └─ IndexedTableAccess(datetime_table)\n" +
-			"                 ├─ index: [datetime_table.date_col]\n" +
+			"             └─ IndexedTableAccess(mydb.datetime_table)\n" +
+			"                 ├─ index: datetime_table_d [datetime_table.date_col]\n" +
 			"                 └─ columns: [i date_col]\n" +
 			"",
 	},
@@ -4012,11 +4206,11 @@ inner join pq on true
 			"                 ├─ (dt1.date_col = DATE(date_sub(dt2.timestamp_col,INTERVAL 2 DAY)))\n" +
 			"                 ├─ TableAlias(dt2)\n" +
 			"                 │   └─ Table\n" +
-			"                 │       ├─ name: datetime_table\n" +
+			"                 │       ├─ name: mydb.datetime_table\n" +
 			"                 │       └─ columns: [timestamp_col]\n" +
 			"                 └─ TableAlias(dt1)\n" +
-			"                     └─ IndexedTableAccess(datetime_table)\n" +
-			"                         ├─ index: [datetime_table.date_col]\n" +
+			"                     └─ IndexedTableAccess(mydb.datetime_table)\n" +
+			"                         ├─ index: datetime_table_d [datetime_table.date_col]\n" +
 			"                         └─ columns: [i date_col]\n" +
 			"",
 	},
@@ -4034,11 +4228,11 @@ inner join pq on true
 			"             │   └─ DATE(date_sub(dt2.timestamp_col,INTERVAL 2 DAY))\n" +
 			"             ├─ TableAlias(dt2)\n" +
 			"             │   └─ Table\n" +
-			"             │       ├─ name: datetime_table\n" +
+			"             │       ├─ name: mydb.datetime_table\n" +
 			"             │       └─ columns: [timestamp_col]\n" +
 			"             └─ TableAlias(dt1)\n" +
-			"                 └─ IndexedTableAccess(datetime_table)\n" +
-			"                     ├─ index: [datetime_table.date_col]\n" +
+			"                 └─ IndexedTableAccess(mydb.datetime_table)\n" +
+			"                     ├─ index: datetime_table_d [datetime_table.date_col]\n" +
 			"                     └─ columns: [i date_col]\n" +
 			"",
 	},
@@ -4064,17 +4258,17 @@ inner join pq on true
 			"     │   │   ├─ one_pk.pk:2!null\n" +
 			"     │   │   └─ tpk.pk2:1!null\n" +
 			"     │   ├─ TableAlias(tpk)\n" +
-			"     │   │   └─ IndexedTableAccess(two_pk)\n" +
-			"     │   │       ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"     │   │   └─ IndexedTableAccess(mydb.two_pk)\n" +
+			"     │   │       ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
 			"     │   │       ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
 			"     │   │       └─ columns: [pk1 pk2]\n" +
-			"     │   └─ IndexedTableAccess(one_pk)\n" +
-			"     │       ├─ index: [one_pk.pk]\n" +
+			"     │   └─ IndexedTableAccess(mydb.one_pk)\n" +
+			"     │       ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"     │       ├─ static: [{[NULL, ∞)}]\n" +
 			"     │       └─ columns: [pk]\n" +
 			"     └─ TableAlias(tpk2)\n" +
-			"         └─ IndexedTableAccess(two_pk)\n" +
-			"             ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"         └─ IndexedTableAccess(mydb.two_pk)\n" +
+			"             ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
 			"             └─ columns: [pk1 pk2]\n" +
 			"",
 	},
@@ -4101,17 +4295,17 @@ inner join pq on true
 			"     │   │   ├─ one_pk.pk:2!null\n" +
 			"     │   │   └─ tpk.pk2:1!null\n" +
 			"     │   ├─ TableAlias(tpk)\n" +
-			"     │   │   └─ IndexedTableAccess(two_pk)\n" +
-			"     │   │       ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"     │   │   └─ IndexedTableAccess(mydb.two_pk)\n" +
+			"     │   │       ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
 			"     │   │       ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
 			"     │   │       └─ columns: [pk1 pk2]\n" +
-			"     │   └─ IndexedTableAccess(one_pk)\n" +
-			"     │       ├─ index: [one_pk.pk]\n" +
+			"     │   └─ IndexedTableAccess(mydb.one_pk)\n" +
+			"     │       ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"     │       ├─ static: [{[NULL, ∞)}]\n" +
 			"     │       └─ columns: [pk]\n" +
 			"     └─ TableAlias(tpk2)\n" +
-			"         └─ IndexedTableAccess(two_pk)\n" +
-			"             ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"         └─ IndexedTableAccess(mydb.two_pk)\n" +
+			"             ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
 			"             └─ columns: [pk1 pk2]\n" +
 			"",
 	},
@@ -4138,17 +4332,17 @@ inner join pq on true
 			"     │   │   ├─ one_pk.pk:2!null\n" +
 			"     │   │   └─ tpk.pk2:1!null\n" +
 			"     │   ├─ TableAlias(tpk)\n" +
-			"     │   │   └─ IndexedTableAccess(two_pk)\n" +
-			"     │   │       ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"     │   │   └─ IndexedTableAccess(mydb.two_pk)\n" +
+			"     │   │       ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
 			"     │   │       ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
 			"     │   │       └─ columns: [pk1 pk2]\n" +
-			"     │   └─ IndexedTableAccess(one_pk)\n" +
-			"     │       ├─ index: [one_pk.pk]\n" +
+			"     │   └─ IndexedTableAccess(mydb.one_pk)\n" +
+			"     │       ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"     │       ├─ static: [{[NULL, ∞)}]\n" +
 			"     │       └─ columns: [pk]\n" +
 			"     └─ TableAlias(tpk2)\n" +
-			"         └─ IndexedTableAccess(two_pk)\n" +
-			"             ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"         └─ IndexedTableAccess(mydb.two_pk)\n" +
+			"             ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
 			"             └─ columns: [pk1 pk2]\n" +
 			"",
 	},
@@ -4176,17 +4370,17 @@ inner join pq on true
 			"         │   │   ├─ (one_pk.pk:2!null - 1 (tinyint))\n" +
 			"         │   │   └─ tpk.pk2:1!null\n" +
 			"         │   ├─ TableAlias(tpk)\n" +
-			"         │   │   └─ IndexedTableAccess(two_pk)\n" +
-			"         │   │       ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"         │   │   └─ IndexedTableAccess(mydb.two_pk)\n" +
+			"         │   │       ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
 			"         │   │       ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
 			"         │   │       └─ columns: [pk1 pk2]\n" +
-			"         │   └─ IndexedTableAccess(one_pk)\n" +
-			"         │       ├─ index: [one_pk.pk]\n" +
+			"         │   └─ IndexedTableAccess(mydb.one_pk)\n" +
+			"         │       ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"         │       ├─ static: [{[NULL, ∞)}]\n" +
 			"         │       └─ columns: [pk]\n" +
 			"         └─ TableAlias(tpk2)\n" +
-			"             └─ IndexedTableAccess(two_pk)\n" +
-			"                 ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"             └─ IndexedTableAccess(mydb.two_pk)\n" +
+			"                 ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
 			"                 └─ columns: [pk1 pk2]\n" +
 			"",
 	},
@@ -4211,18 +4405,18 @@ inner join pq on true
 			"     │   ├─ sel: Eq\n" +
 			"     │   │   ├─ one_pk.pk:0!null\n" +
 			"     │   │   └─ tpk.pk2:2!null\n" +
-			"     │   ├─ IndexedTableAccess(one_pk)\n" +
-			"     │   │   ├─ index: [one_pk.pk]\n" +
+			"     │   ├─ IndexedTableAccess(mydb.one_pk)\n" +
+			"     │   │   ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"     │   │   ├─ static: [{[NULL, ∞)}]\n" +
 			"     │   │   └─ columns: [pk]\n" +
 			"     │   └─ TableAlias(tpk)\n" +
-			"     │       └─ IndexedTableAccess(two_pk)\n" +
-			"     │           ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"     │       └─ IndexedTableAccess(mydb.two_pk)\n" +
+			"     │           ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
 			"     │           ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
 			"     │           └─ columns: [pk1 pk2]\n" +
 			"     └─ TableAlias(tpk2)\n" +
-			"         └─ IndexedTableAccess(two_pk)\n" +
-			"             ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"         └─ IndexedTableAccess(mydb.two_pk)\n" +
+			"             ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
 			"             └─ columns: [pk1 pk2]\n" +
 			"",
 	},
@@ -4247,18 +4441,18 @@ inner join pq on true
 			"     │   ├─ sel: Eq\n" +
 			"     │   │   ├─ one_pk.pk:0!null\n" +
 			"     │   │   └─ tpk.pk2:2!null\n" +
-			"     │   ├─ IndexedTableAccess(one_pk)\n" +
-			"     │   │   ├─ index: [one_pk.pk]\n" +
+			"     │   ├─ IndexedTableAccess(mydb.one_pk)\n" +
+			"     │   │   ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"     │   │   ├─ static: [{[NULL, ∞)}]\n" +
 			"     │   │   └─ columns: [pk]\n" +
 			"     │   └─ TableAlias(tpk)\n" +
-			"     │       └─ IndexedTableAccess(two_pk)\n" +
-			"     │           ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"     │       └─ IndexedTableAccess(mydb.two_pk)\n" +
+			"     │           ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
 			"     │           ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
 			"     │           └─ columns: [pk1 pk2]\n" +
 			"     └─ TableAlias(tpk2)\n" +
-			"         └─ IndexedTableAccess(two_pk)\n" +
-			"             ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"         └─ IndexedTableAccess(mydb.two_pk)\n" +
+			"             ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
 			"             └─ columns: [pk1 pk2]\n" +
 			"",
 	},
@@ -4284,17 +4478,17 @@ inner join pq on true
 			"     │   │   ├─ one_pk.pk:2!null\n" +
 			"     │   │   └─ tpk.pk2:1!null\n" +
 			"     │   ├─ TableAlias(tpk)\n" +
-			"     │   │   └─ IndexedTableAccess(two_pk)\n" +
-			"     │   │       ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"     │   │   └─ IndexedTableAccess(mydb.two_pk)\n" +
+			"     │   │       ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
 			"     │   │       ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
 			"     │   │       └─ columns: [pk1 pk2]\n" +
-			"     │   └─ IndexedTableAccess(one_pk)\n" +
-			"     │       ├─ index: [one_pk.pk]\n" +
+			"     │   └─ IndexedTableAccess(mydb.one_pk)\n" +
+			"     │       ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"     │       ├─ static: [{[NULL, ∞)}]\n" +
 			"     │       └─ columns: [pk]\n" +
 			"     └─ TableAlias(tpk2)\n" +
-			"         └─ IndexedTableAccess(two_pk)\n" +
-			"             ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"         └─ IndexedTableAccess(mydb.two_pk)\n" +
+			"             ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
 			"             └─ columns: [pk1 pk2]\n" +
 			"",
 	},
@@ -4314,7 +4508,7 @@ inner join pq on true
 			"     │       └─ tpk2.pk1:0!null\n" +
 			"     ├─ TableAlias(tpk2)\n" +
 			"     │   └─ Table\n" +
-			"     │       ├─ name: two_pk\n" +
+			"     │       ├─ name: mydb.two_pk\n" +
 			"     │       └─ columns: [pk1 pk2]\n" +
 			"     └─ HashLookup\n" +
 			"         ├─ source: TUPLE(tpk2.pk2:1!null, tpk2.pk1:0!null)\n" +
@@ -4328,12 +4522,12 @@ inner join pq on true
 			"                 │   ├─ one_pk.pk:4!null\n" +
 			"                 │   └─ tpk.pk2:3!null\n" +
 			"                 ├─ TableAlias(tpk)\n" +
-			"                 │   └─ IndexedTableAccess(two_pk)\n" +
-			"                 │       ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"                 │   └─ IndexedTableAccess(mydb.two_pk)\n" +
+			"                 │       ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
 			"                 │       ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
 			"                 │       └─ columns: [pk1 pk2]\n" +
-			"                 └─ IndexedTableAccess(one_pk)\n" +
-			"                     ├─ index: [one_pk.pk]\n" +
+			"                 └─ IndexedTableAccess(mydb.one_pk)\n" +
+			"                     ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"                     ├─ static: [{[NULL, ∞)}]\n" +
 			"                     └─ columns: [pk]\n" +
 			"",
@@ -4349,10 +4543,10 @@ inner join pq on true
 			" │       ├─ (mytable.i:0!null - 2 (tinyint))\n" +
 			" │       └─ two_pk.pk2:2!null\n" +
 			" ├─ Table\n" +
-			" │   ├─ name: mytable\n" +
+			" │   ├─ name: mydb.mytable\n" +
 			" │   └─ columns: [i]\n" +
-			" └─ IndexedTableAccess(two_pk)\n" +
-			"     ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			" └─ IndexedTableAccess(mydb.two_pk)\n" +
+			"     ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
 			"     └─ columns: [pk1 pk2]\n" +
 			"",
 	},
@@ -4362,12 +4556,12 @@ inner join pq on true
 			" ├─ cmp: Eq\n" +
 			" │   ├─ one_pk.pk:0!null\n" +
 			" │   └─ two_pk.pk1:1!null\n" +
-			" ├─ IndexedTableAccess(one_pk)\n" +
-			" │   ├─ index: [one_pk.pk]\n" +
+			" ├─ IndexedTableAccess(mydb.one_pk)\n" +
+			" │   ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			" │   ├─ static: [{[NULL, ∞)}]\n" +
 			" │   └─ columns: [pk]\n" +
-			" └─ IndexedTableAccess(two_pk)\n" +
-			"     ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			" └─ IndexedTableAccess(mydb.two_pk)\n" +
+			"     ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
 			"     ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
 			"     └─ columns: [pk1 pk2]\n" +
 			"",
@@ -4378,12 +4572,12 @@ inner join pq on true
 			" ├─ cmp: Eq\n" +
 			" │   ├─ one_pk.pk:0!null\n" +
 			" │   └─ niltable.i:1!null\n" +
-			" ├─ IndexedTableAccess(one_pk)\n" +
-			" │   ├─ index: [one_pk.pk]\n" +
+			" ├─ IndexedTableAccess(mydb.one_pk)\n" +
+			" │   ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			" │   ├─ static: [{[NULL, ∞)}]\n" +
 			" │   └─ columns: [pk]\n" +
-			" └─ IndexedTableAccess(niltable)\n" +
-			"     ├─ index: [niltable.i]\n" +
+			" └─ IndexedTableAccess(mydb.niltable)\n" +
+			"     ├─ index: `PRIMARY` [niltable.i]\n" +
 			"     ├─ static: [{[NULL, ∞)}]\n" +
 			"     └─ columns: [i f]\n" +
 			"",
@@ -4396,12 +4590,12 @@ inner join pq on true
 			"     ├─ cmp: Eq\n" +
 			"     │   ├─ niltable.i:0!null\n" +
 			"     │   └─ one_pk.pk:2!null\n" +
-			"     ├─ IndexedTableAccess(niltable)\n" +
-			"     │   ├─ index: [niltable.i]\n" +
+			"     ├─ IndexedTableAccess(mydb.niltable)\n" +
+			"     │   ├─ index: `PRIMARY` [niltable.i]\n" +
 			"     │   ├─ static: [{[NULL, ∞)}]\n" +
 			"     │   └─ columns: [i f]\n" +
-			"     └─ IndexedTableAccess(one_pk)\n" +
-			"         ├─ index: [one_pk.pk]\n" +
+			"     └─ IndexedTableAccess(mydb.one_pk)\n" +
+			"         ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"         ├─ static: [{[NULL, ∞)}]\n" +
 			"         └─ columns: [pk]\n" +
 			"",
@@ -4418,7 +4612,7 @@ inner join pq on true
 			"     │   └─ (nt2.i:0!null + 1 (tinyint))\n" +
 			"     ├─ TableAlias(nt2)\n" +
 			"     │   └─ Table\n" +
-			"     │       ├─ name: niltable\n" +
+			"     │       ├─ name: mydb.niltable\n" +
 			"     │       └─ columns: [i]\n" +
 			"     └─ HashLookup\n" +
 			"         ├─ source: TUPLE((nt2.i:0!null + 1 (tinyint)))\n" +
@@ -4429,16 +4623,98 @@ inner join pq on true
 			"                 │   ├─ nt.i:1!null\n" +
 			"                 │   └─ one_pk.pk:2!null\n" +
 			"                 ├─ TableAlias(nt)\n" +
-			"                 │   └─ IndexedTableAccess(niltable)\n" +
-			"                 │       ├─ index: [niltable.i]\n" +
+			"                 │   └─ IndexedTableAccess(mydb.niltable)\n" +
+			"                 │       ├─ index: `PRIMARY` [niltable.i]\n" +
 			"                 │       ├─ static: [{[NULL, ∞)}]\n" +
 			"                 │       └─ columns: [i]\n" +
-			"                 └─ IndexedTableAccess(one_pk)\n" +
-			"                     ├─ index: [one_pk.pk]\n" +
+			"                 └─ IndexedTableAccess(mydb.one_pk)\n" +
+			"                     ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"                     ├─ static: [{[NULL, ∞)}]\n" +
 			"                     └─ columns: [pk]\n" +
 			"",
 	},
+	{
+		Query: `SELECT /*+ HASH_JOIN(a,b) */ a.i, b.i FROM niltable a JOIN niltable b ON a.i2 <=> b.i2`,
+		ExpectedPlan: "Project\n" +
+			" ├─ columns: [a.i:2!null, b.i:0!null]\n" +
+			" └─ HashJoin\n" +
+			"     ├─ (a.i2:3 <=> b.i2:1)\n" +
+			"     ├─ TableAlias(b)\n" +
+			"     │   └─ Table\n" +
+			"     │       ├─ name: mydb.niltable\n" +
+			"     │       └─ columns: [i i2]\n" +
+			"     └─ HashLookup\n" +
+			"         ├─ source: TUPLE(b.i2:1)\n" +
+			"         ├─ target: TUPLE(a.i2:1)\n" +
+			"         └─ CachedResults\n" +
+			"             └─ TableAlias(a)\n" +
+			"                 └─ Table\n" +
+			"                     ├─ name: mydb.niltable\n" +
+			"                     └─ columns: [i i2]\n" +
+			"",
+	},
+	{
+		Query: `SELECT /*+ MERGE_JOIN(a,b) */ a.i, b.i FROM niltable a JOIN niltable b ON a.i2 <=> b.i2`,
+		ExpectedPlan: "Project\n" +
+			" ├─ columns: [a.i:2!null, b.i:0!null]\n" +
+			" └─ MergeJoin\n" +
+			"     ├─ cmp: (b.i2:1 <=> a.i2:3)\n" +
+			"     ├─ TableAlias(b)\n" +
+			"     │   └─ IndexedTableAccess(mydb.niltable)\n" +
+			"     │       ├─ index: niltable_i2 [niltable.i2]\n" +
+			"     │       ├─ static: [{[NULL, ∞)}]\n" +
+			"     │       └─ columns: [i i2]\n" +
+			"     └─ TableAlias(a)\n" +
+			"         └─ IndexedTableAccess(mydb.niltable)\n" +
+			"             ├─ index: niltable_i2 [niltable.i2]\n" +
+			"             ├─ static: [{[NULL, ∞)}]\n" +
+			"             └─ columns: [i i2]\n" +
+			"",
+	},
+	{
+		Query: `SELECT /*+ LOOKUP_JOIN(a,b) */ a.i, b.i FROM niltable a JOIN niltable b ON a.i2 <=> b.i2`,
+		ExpectedPlan: "Project\n" +
+			" ├─ columns: [a.i:2!null, b.i:0!null]\n" +
+			" └─ LookupJoin\n" +
+			"     ├─ (a.i2:3 <=> b.i2:1)\n" +
+			"     ├─ TableAlias(b)\n" +
+			"     │   └─ Table\n" +
+			"     │       ├─ name: mydb.niltable\n" +
+			"     │       └─ columns: [i i2]\n" +
+			"     └─ TableAlias(a)\n" +
+			"         └─ IndexedTableAccess(mydb.niltable)\n" +
+			"             ├─ index: niltable_i2 [niltable.i2]\n" +
+			"             └─ columns: [i i2]\n" +
+			"",
+	},
+	{
+		Query: `SELECT /*+ LOOKUP_JOIN(a,b) */ a.i, b.i FROM niltable a LEFT JOIN niltable b ON a.i2 <=> b.i2`,
+		ExpectedPlan: "Project\n" +
+			" ├─ columns: [a.i:0!null, b.i:2]\n" +
+			" └─ LeftOuterLookupJoin\n" +
+			"     ├─ (a.i2:1 <=> b.i2:3)\n" +
+			"     ├─ TableAlias(a)\n" +
+			"     │   └─ Table\n" +
+			"     │       ├─ name: mydb.niltable\n" +
+			"     │       └─ columns: [i i2]\n" +
+			"     └─ TableAlias(b)\n" +
+			"         └─ IndexedTableAccess(mydb.niltable)\n" +
+			"             ├─ index: niltable_i2 [niltable.i2]\n" +
+			"             └─ columns: [i i2]\n" +
+			"",
+	},
+	{
+		Query: `SELECT i FROM niltable WHERE i2 <=> NULL`,
+		ExpectedPlan: "Project\n" +
+			" ├─ columns: [niltable.i:0!null]\n" +
+			" └─ Filter\n" +
+			"     ├─ (niltable.i2:1 <=> NULL (null))\n" +
+			"     └─ IndexedTableAccess(mydb.niltable)\n" +
+			"         ├─ index: niltable_i2 [niltable.i2]\n" +
+			"         ├─ static: [{[NULL, NULL]}]\n" +
+			"         └─ columns: [i i2]\n" +
+			"",
+	},
 	{
 		Query: `SELECT pk,i,f FROM one_pk LEFT JOIN niltable ON pk=i AND f IS NOT NULL`,
 		ExpectedPlan: "LeftOuterMergeJoin\n" +
@@ -4447,12 +4723,12 @@ inner join pq on true
 			" │   └─ niltable.i:1!null\n" +
 			" ├─ sel: NOT\n" +
 			" │   └─ niltable.f:2 IS NULL\n" +
-			" ├─ IndexedTableAccess(one_pk)\n" +
-			" │   ├─ index: [one_pk.pk]\n" +
+			" ├─ IndexedTableAccess(mydb.one_pk)\n" +
+			" │   ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			" │   ├─ static: [{[NULL, ∞)}]\n" +
 			" │   └─ columns: [pk]\n" +
-			" └─ IndexedTableAccess(niltable)\n" +
-			"     ├─ index: [niltable.i]\n" +
+			" └─ IndexedTableAccess(mydb.niltable)\n" +
+			"     ├─ index: `PRIMARY` [niltable.i]\n" +
 			"     ├─ static: [{[NULL, ∞)}]\n" +
 			"     └─ columns: [i f]\n" +
 			"",
@@ -4468,12 +4744,12 @@ inner join pq on true
 			"     ├─ sel: GreaterThan\n" +
 			"     │   ├─ one_pk.pk:2!null\n" +
 			"     │   └─ 0 (tinyint)\n" +
-			"     ├─ IndexedTableAccess(niltable)\n" +
-			"     │   ├─ index: [niltable.i]\n" +
+			"     ├─ IndexedTableAccess(mydb.niltable)\n" +
+			"     │   ├─ index: `PRIMARY` [niltable.i]\n" +
 			"     │   ├─ static: [{[NULL, ∞)}]\n" +
 			"     │   └─ columns: [i f]\n" +
-			"     └─ IndexedTableAccess(one_pk)\n" +
-			"         ├─ index: [one_pk.pk]\n" +
+			"     └─ IndexedTableAccess(mydb.one_pk)\n" +
+			"         ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"         ├─ static: [{[NULL, ∞)}]\n" +
 			"         └─ columns: [pk]\n" +
 			"",
@@ -4487,12 +4763,12 @@ inner join pq on true
 			"     ├─ cmp: Eq\n" +
 			"     │   ├─ one_pk.pk:0!null\n" +
 			"     │   └─ niltable.i:1!null\n" +
-			"     ├─ IndexedTableAccess(one_pk)\n" +
-			"     │   ├─ index: [one_pk.pk]\n" +
+			"     ├─ IndexedTableAccess(mydb.one_pk)\n" +
+			"     │   ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"     │   ├─ static: [{[NULL, ∞)}]\n" +
 			"     │   └─ columns: [pk]\n" +
-			"     └─ IndexedTableAccess(niltable)\n" +
-			"         ├─ index: [niltable.i]\n" +
+			"     └─ IndexedTableAccess(mydb.niltable)\n" +
+			"         ├─ index: `PRIMARY` [niltable.i]\n" +
 			"         ├─ static: [{[NULL, ∞)}]\n" +
 			"         └─ columns: [i f]\n" +
 			"",
@@ -4509,12 +4785,12 @@ inner join pq on true
 			"         ├─ cmp: Eq\n" +
 			"         │   ├─ one_pk.pk:0!null\n" +
 			"         │   └─ niltable.i:1!null\n" +
-			"         ├─ IndexedTableAccess(one_pk)\n" +
-			"         │   ├─ index: [one_pk.pk]\n" +
+			"         ├─ IndexedTableAccess(mydb.one_pk)\n" +
+			"         │   ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"         │   ├─ static: [{[NULL, ∞)}]\n" +
 			"         │   └─ columns: [pk]\n" +
-			"         └─ IndexedTableAccess(niltable)\n" +
-			"             ├─ index: [niltable.i]\n" +
+			"         └─ IndexedTableAccess(mydb.niltable)\n" +
+			"             ├─ index: `PRIMARY` [niltable.i]\n" +
 			"             ├─ static: [{[NULL, ∞)}]\n" +
 			"             └─ columns: [i i2 f]\n" +
 			"",
@@ -4529,12 +4805,12 @@ inner join pq on true
 			"     ├─ cmp: Eq\n" +
 			"     │   ├─ one_pk.pk:0!null\n" +
 			"     │   └─ niltable.i:1!null\n" +
-			"     ├─ IndexedTableAccess(one_pk)\n" +
-			"     │   ├─ index: [one_pk.pk]\n" +
+			"     ├─ IndexedTableAccess(mydb.one_pk)\n" +
+			"     │   ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"     │   ├─ static: [{[NULL, ∞)}]\n" +
 			"     │   └─ columns: [pk]\n" +
-			"     └─ IndexedTableAccess(niltable)\n" +
-			"         ├─ index: [niltable.i]\n" +
+			"     └─ IndexedTableAccess(mydb.niltable)\n" +
+			"         ├─ index: `PRIMARY` [niltable.i]\n" +
 			"         ├─ static: [{[NULL, ∞)}]\n" +
 			"         └─ columns: [i f]\n" +
 			"",
@@ -4551,12 +4827,12 @@ inner join pq on true
 			"         ├─ cmp: Eq\n" +
 			"         │   ├─ one_pk.pk:0!null\n" +
 			"         │   └─ niltable.i:2!null\n" +
-			"         ├─ IndexedTableAccess(one_pk)\n" +
-			"         │   ├─ index: [one_pk.pk]\n" +
+			"         ├─ IndexedTableAccess(mydb.one_pk)\n" +
+			"         │   ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"         │   ├─ static: [{[NULL, ∞)}]\n" +
 			"         │   └─ columns: [pk c1]\n" +
-			"         └─ IndexedTableAccess(niltable)\n" +
-			"             ├─ index: [niltable.i]\n" +
+			"         └─ IndexedTableAccess(mydb.niltable)\n" +
+			"             ├─ index: `PRIMARY` [niltable.i]\n" +
 			"             ├─ static: [{[NULL, ∞)}]\n" +
 			"             └─ columns: [i f]\n" +
 			"",
@@ -4572,12 +4848,12 @@ inner join pq on true
 			"         ├─ cmp: Eq\n" +
 			"         │   ├─ niltable.i:0!null\n" +
 			"         │   └─ one_pk.pk:2!null\n" +
-			"         ├─ IndexedTableAccess(niltable)\n" +
-			"         │   ├─ index: [niltable.i]\n" +
+			"         ├─ IndexedTableAccess(mydb.niltable)\n" +
+			"         │   ├─ index: `PRIMARY` [niltable.i]\n" +
 			"         │   ├─ static: [{[NULL, ∞)}]\n" +
 			"         │   └─ columns: [i f]\n" +
-			"         └─ IndexedTableAccess(one_pk)\n" +
-			"             ├─ index: [one_pk.pk]\n" +
+			"         └─ IndexedTableAccess(mydb.one_pk)\n" +
+			"             ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"             ├─ static: [{[NULL, ∞)}]\n" +
 			"             └─ columns: [pk]\n" +
 			"",
@@ -4592,12 +4868,12 @@ inner join pq on true
 			"     ├─ cmp: Eq\n" +
 			"     │   ├─ one_pk.pk:0!null\n" +
 			"     │   └─ niltable.i:1!null\n" +
-			"     ├─ IndexedTableAccess(one_pk)\n" +
-			"     │   ├─ index: [one_pk.pk]\n" +
+			"     ├─ IndexedTableAccess(mydb.one_pk)\n" +
+			"     │   ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"     │   ├─ static: [{[NULL, ∞)}]\n" +
 			"     │   └─ columns: [pk]\n" +
-			"     └─ IndexedTableAccess(niltable)\n" +
-			"         ├─ index: [niltable.i]\n" +
+			"     └─ IndexedTableAccess(mydb.niltable)\n" +
+			"         ├─ index: `PRIMARY` [niltable.i]\n" +
 			"         ├─ static: [{[NULL, ∞)}]\n" +
 			"         └─ columns: [i f]\n" +
 			"",
@@ -4607,15 +4883,17 @@ inner join pq on true
 		ExpectedPlan: "Sort(l.i:0!null ASC nullsFirst)\n" +
 			" └─ Project\n" +
 			"     ├─ columns: [l.i:1!null, r.i2:0]\n" +
-			"     └─ LookupJoin\n" +
-			"         ├─ (l.i2:2 <=> r.i2:0)\n" +
+			"     └─ MergeJoin\n" +
+			"         ├─ cmp: (r.i2:0 <=> l.i2:2)\n" +
 			"         ├─ TableAlias(r)\n" +
-			"         │   └─ Table\n" +
-			"         │       ├─ name: niltable\n" +
+			"         │   └─ IndexedTableAccess(mydb.niltable)\n" +
+			"         │       ├─ index: niltable_i2 [niltable.i2]\n" +
+			"         │       ├─ static: [{[NULL, ∞)}]\n" +
 			"         │       └─ columns: [i2]\n" +
 			"         └─ TableAlias(l)\n" +
-			"             └─ IndexedTableAccess(niltable)\n" +
-			"                 ├─ index: [niltable.i2]\n" +
+			"             └─ IndexedTableAccess(mydb.niltable)\n" +
+			"                 ├─ index: niltable_i2 [niltable.i2]\n" +
+			"                 ├─ static: [{[NULL, ∞)}]\n" +
 			"                 └─ columns: [i i2]\n" +
 			"",
 	},
@@ -4631,12 +4909,12 @@ inner join pq on true
 			"         ├─ cmp: Eq\n" +
 			"         │   ├─ niltable.i:0!null\n" +
 			"         │   └─ one_pk.pk:2!null\n" +
-			"         ├─ IndexedTableAccess(niltable)\n" +
-			"         │   ├─ index: [niltable.i]\n" +
+			"         ├─ IndexedTableAccess(mydb.niltable)\n" +
+			"         │   ├─ index: `PRIMARY` [niltable.i]\n" +
 			"         │   ├─ static: [{[NULL, ∞)}]\n" +
 			"         │   └─ columns: [i f]\n" +
-			"         └─ IndexedTableAccess(one_pk)\n" +
-			"             ├─ index: [one_pk.pk]\n" +
+			"         └─ IndexedTableAccess(mydb.one_pk)\n" +
+			"             ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"             ├─ static: [{[NULL, ∞)}]\n" +
 			"             └─ columns: [pk]\n" +
 			"",
@@ -4649,12 +4927,12 @@ inner join pq on true
 			"     ├─ cmp: Eq\n" +
 			"     │   ├─ two_pk.pk1:0!null\n" +
 			"     │   └─ one_pk.pk:2!null\n" +
-			"     ├─ IndexedTableAccess(two_pk)\n" +
-			"     │   ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"     ├─ IndexedTableAccess(mydb.two_pk)\n" +
+			"     │   ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
 			"     │   ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
 			"     │   └─ columns: [pk1 pk2]\n" +
-			"     └─ IndexedTableAccess(one_pk)\n" +
-			"         ├─ index: [one_pk.pk]\n" +
+			"     └─ IndexedTableAccess(mydb.one_pk)\n" +
+			"         ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"         ├─ static: [{[NULL, ∞)}]\n" +
 			"         └─ columns: [pk]\n" +
 			"",
@@ -4667,12 +4945,12 @@ inner join pq on true
 			"     ├─ cmp: Eq\n" +
 			"     │   ├─ two_pk.pk1:0!null\n" +
 			"     │   └─ one_pk.pk:2!null\n" +
-			"     ├─ IndexedTableAccess(two_pk)\n" +
-			"     │   ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"     ├─ IndexedTableAccess(mydb.two_pk)\n" +
+			"     │   ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
 			"     │   ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
 			"     │   └─ columns: [pk1 pk2]\n" +
-			"     └─ IndexedTableAccess(one_pk)\n" +
-			"         ├─ index: [one_pk.pk]\n" +
+			"     └─ IndexedTableAccess(mydb.one_pk)\n" +
+			"         ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"         ├─ static: [{[NULL, ∞)}]\n" +
 			"         └─ columns: [pk]\n" +
 			"",
@@ -4690,13 +4968,13 @@ inner join pq on true
 			"         │   ├─ a.pk2:3!null\n" +
 			"         │   └─ b.pk2:1!null\n" +
 			"         ├─ TableAlias(b)\n" +
-			"         │   └─ IndexedTableAccess(two_pk)\n" +
-			"         │       ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"         │   └─ IndexedTableAccess(mydb.two_pk)\n" +
+			"         │       ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
 			"         │       ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
 			"         │       └─ columns: [pk1 pk2]\n" +
 			"         └─ TableAlias(a)\n" +
-			"             └─ IndexedTableAccess(two_pk)\n" +
-			"                 ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"             └─ IndexedTableAccess(mydb.two_pk)\n" +
+			"                 ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
 			"                 ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
 			"                 └─ columns: [pk1 pk2]\n" +
 			"",
@@ -4716,11 +4994,11 @@ inner join pq on true
 			"         │       └─ b.pk1:0!null\n" +
 			"         ├─ TableAlias(b)\n" +
 			"         │   └─ Table\n" +
-			"         │       ├─ name: two_pk\n" +
+			"         │       ├─ name: mydb.two_pk\n" +
 			"         │       └─ columns: [pk1 pk2]\n" +
 			"         └─ TableAlias(a)\n" +
-			"             └─ IndexedTableAccess(two_pk)\n" +
-			"                 ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"             └─ IndexedTableAccess(mydb.two_pk)\n" +
+			"                 ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
 			"                 └─ columns: [pk1 pk2]\n" +
 			"",
 	},
@@ -4737,13 +5015,13 @@ inner join pq on true
 			"         │   ├─ a.pk2:3!null\n" +
 			"         │   └─ b.pk2:1!null\n" +
 			"         ├─ TableAlias(b)\n" +
-			"         │   └─ IndexedTableAccess(two_pk)\n" +
-			"         │       ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"         │   └─ IndexedTableAccess(mydb.two_pk)\n" +
+			"         │       ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
 			"         │       ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
 			"         │       └─ columns: [pk1 pk2]\n" +
 			"         └─ TableAlias(a)\n" +
-			"             └─ IndexedTableAccess(two_pk)\n" +
-			"                 ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"             └─ IndexedTableAccess(mydb.two_pk)\n" +
+			"                 ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
 			"                 ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
 			"                 └─ columns: [pk1 pk2]\n" +
 			"",
@@ -4761,13 +5039,13 @@ inner join pq on true
 			"         │   ├─ (a.pk2:3!null + 1 (tinyint))\n" +
 			"         │   └─ b.pk2:1!null\n" +
 			"         ├─ TableAlias(b)\n" +
-			"         │   └─ IndexedTableAccess(two_pk)\n" +
-			"         │       ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"         │   └─ IndexedTableAccess(mydb.two_pk)\n" +
+			"         │       ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
 			"         │       ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
 			"         │       └─ columns: [pk1 pk2]\n" +
 			"         └─ TableAlias(a)\n" +
-			"             └─ IndexedTableAccess(two_pk)\n" +
-			"                 ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"             └─ IndexedTableAccess(mydb.two_pk)\n" +
+			"                 ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
 			"                 ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
 			"                 └─ columns: [pk1 pk2]\n" +
 			"",
@@ -4785,13 +5063,13 @@ inner join pq on true
 			"         │   ├─ a.pk2:3!null\n" +
 			"         │   └─ b.pk2:1!null\n" +
 			"         ├─ TableAlias(b)\n" +
-			"         │   └─ IndexedTableAccess(two_pk)\n" +
-			"         │       ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"         │   └─ IndexedTableAccess(mydb.two_pk)\n" +
+			"         │       ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
 			"         │       ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
 			"         │       └─ columns: [pk1 pk2]\n" +
 			"         └─ TableAlias(a)\n" +
-			"             └─ IndexedTableAccess(two_pk)\n" +
-			"                 ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"             └─ IndexedTableAccess(mydb.two_pk)\n" +
+			"                 ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
 			"                 ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
 			"                 └─ columns: [pk1 pk2]\n" +
 			"",
@@ -4811,11 +5089,11 @@ inner join pq on true
 			"         │       └─ b.pk1:0!null\n" +
 			"         ├─ TableAlias(b)\n" +
 			"         │   └─ Table\n" +
-			"         │       ├─ name: two_pk\n" +
+			"         │       ├─ name: mydb.two_pk\n" +
 			"         │       └─ columns: [pk1 pk2]\n" +
 			"         └─ TableAlias(a)\n" +
-			"             └─ IndexedTableAccess(two_pk)\n" +
-			"                 ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"             └─ IndexedTableAccess(mydb.two_pk)\n" +
+			"                 ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
 			"                 └─ columns: [pk1 pk2]\n" +
 			"",
 	},
@@ -4828,12 +5106,12 @@ inner join pq on true
 			"         ├─ cmp: Eq\n" +
 			"         │   ├─ two_pk.pk1:0!null\n" +
 			"         │   └─ one_pk.pk:2!null\n" +
-			"         ├─ IndexedTableAccess(two_pk)\n" +
-			"         │   ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"         ├─ IndexedTableAccess(mydb.two_pk)\n" +
+			"         │   ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
 			"         │   ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
 			"         │   └─ columns: [pk1 pk2]\n" +
-			"         └─ IndexedTableAccess(one_pk)\n" +
-			"             ├─ index: [one_pk.pk]\n" +
+			"         └─ IndexedTableAccess(mydb.one_pk)\n" +
+			"             ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"             ├─ static: [{[NULL, ∞)}]\n" +
 			"             └─ columns: [pk c5]\n" +
 			"",
@@ -4848,13 +5126,13 @@ inner join pq on true
 			"         │   ├─ tpk.pk1:0!null\n" +
 			"         │   └─ opk.pk:2!null\n" +
 			"         ├─ TableAlias(tpk)\n" +
-			"         │   └─ IndexedTableAccess(two_pk)\n" +
-			"         │       ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"         │   └─ IndexedTableAccess(mydb.two_pk)\n" +
+			"         │       ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
 			"         │       ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
 			"         │       └─ columns: [pk1 pk2]\n" +
 			"         └─ TableAlias(opk)\n" +
-			"             └─ IndexedTableAccess(one_pk)\n" +
-			"                 ├─ index: [one_pk.pk]\n" +
+			"             └─ IndexedTableAccess(mydb.one_pk)\n" +
+			"                 ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"                 ├─ static: [{[NULL, ∞)}]\n" +
 			"                 └─ columns: [pk c5]\n" +
 			"",
@@ -4869,13 +5147,13 @@ inner join pq on true
 			"         │   ├─ tpk.pk1:0!null\n" +
 			"         │   └─ opk.pk:2!null\n" +
 			"         ├─ TableAlias(tpk)\n" +
-			"         │   └─ IndexedTableAccess(two_pk)\n" +
-			"         │       ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"         │   └─ IndexedTableAccess(mydb.two_pk)\n" +
+			"         │       ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
 			"         │       ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
 			"         │       └─ columns: [pk1 pk2]\n" +
 			"         └─ TableAlias(opk)\n" +
-			"             └─ IndexedTableAccess(one_pk)\n" +
-			"                 ├─ index: [one_pk.pk]\n" +
+			"             └─ IndexedTableAccess(mydb.one_pk)\n" +
+			"                 ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"                 ├─ static: [{[NULL, ∞)}]\n" +
 			"                 └─ columns: [pk c5]\n" +
 			"",
@@ -4890,13 +5168,13 @@ inner join pq on true
 			"         │   ├─ tpk.pk1:0!null\n" +
 			"         │   └─ opk.pk:2!null\n" +
 			"         ├─ TableAlias(tpk)\n" +
-			"         │   └─ IndexedTableAccess(two_pk)\n" +
-			"         │       ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"         │   └─ IndexedTableAccess(mydb.two_pk)\n" +
+			"         │       ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
 			"         │       ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
 			"         │       └─ columns: [pk1 pk2]\n" +
 			"         └─ TableAlias(opk)\n" +
-			"             └─ IndexedTableAccess(one_pk)\n" +
-			"                 ├─ index: [one_pk.pk]\n" +
+			"             └─ IndexedTableAccess(mydb.one_pk)\n" +
+			"                 ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"                 ├─ static: [{[NULL, ∞)}]\n" +
 			"                 └─ columns: [pk c5]\n" +
 			"",
@@ -4910,12 +5188,12 @@ inner join pq on true
 			"         ├─ cmp: Eq\n" +
 			"         │   ├─ two_pk.pk1:0!null\n" +
 			"         │   └─ one_pk.pk:2!null\n" +
-			"         ├─ IndexedTableAccess(two_pk)\n" +
-			"         │   ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"         ├─ IndexedTableAccess(mydb.two_pk)\n" +
+			"         │   ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
 			"         │   ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
 			"         │   └─ columns: [pk1 pk2]\n" +
-			"         └─ IndexedTableAccess(one_pk)\n" +
-			"             ├─ index: [one_pk.pk]\n" +
+			"         └─ IndexedTableAccess(mydb.one_pk)\n" +
+			"             ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"             ├─ static: [{[NULL, ∞)}]\n" +
 			"             └─ columns: [pk c5]\n" +
 			"",
@@ -4926,8 +5204,8 @@ inner join pq on true
 			" ├─ Eq\n" +
 			" │   ├─ niltable.i2:1\n" +
 			" │   └─ NULL (null)\n" +
-			" └─ IndexedTableAccess(niltable)\n" +
-			"     ├─ index: [niltable.i2]\n" +
+			" └─ IndexedTableAccess(mydb.niltable)\n" +
+			"     ├─ index: niltable_i2 [niltable.i2]\n" +
 			"     ├─ static: [{(∞, ∞)}]\n" +
 			"     └─ columns: [i i2 b f]\n" +
 			"",
@@ -4939,8 +5217,8 @@ inner join pq on true
 			" │   └─ Eq\n" +
 			" │       ├─ niltable.i2:1\n" +
 			" │       └─ NULL (null)\n" +
-			" └─ IndexedTableAccess(niltable)\n" +
-			"     ├─ index: [niltable.i2]\n" +
+			" └─ IndexedTableAccess(mydb.niltable)\n" +
+			"     ├─ index: niltable_i2 [niltable.i2]\n" +
 			"     ├─ static: [{(∞, ∞)}]\n" +
 			"     └─ columns: [i i2 b f]\n" +
 			"",
@@ -4951,8 +5229,8 @@ inner join pq on true
 			" ├─ GreaterThan\n" +
 			" │   ├─ niltable.i2:1\n" +
 			" │   └─ NULL (null)\n" +
-			" └─ IndexedTableAccess(niltable)\n" +
-			"     ├─ index: [niltable.i2]\n" +
+			" └─ IndexedTableAccess(mydb.niltable)\n" +
+			"     ├─ index: niltable_i2 [niltable.i2]\n" +
 			"     ├─ static: [{(∞, ∞)}]\n" +
 			"     └─ columns: [i i2 b f]\n" +
 			"",
@@ -4961,8 +5239,8 @@ inner join pq on true
 		Query: `SELECT * FROM niltable WHERE i2 <=> NULL`,
 		ExpectedPlan: "Filter\n" +
 			" ├─ (niltable.i2:1 <=> NULL (null))\n" +
-			" └─ IndexedTableAccess(niltable)\n" +
-			"     ├─ index: [niltable.i2]\n" +
+			" └─ IndexedTableAccess(mydb.niltable)\n" +
+			"     ├─ index: niltable_i2 [niltable.i2]\n" +
 			"     ├─ static: [{[NULL, NULL]}]\n" +
 			"     └─ columns: [i i2 b f]\n" +
 			"",
@@ -4974,12 +5252,12 @@ inner join pq on true
 			"     ├─ cmp: Eq\n" +
 			"     │   ├─ one_pk.pk:0!null\n" +
 			"     │   └─ niltable.i:1!null\n" +
-			"     ├─ IndexedTableAccess(one_pk)\n" +
-			"     │   ├─ index: [one_pk.pk]\n" +
+			"     ├─ IndexedTableAccess(mydb.one_pk)\n" +
+			"     │   ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"     │   ├─ static: [{[NULL, ∞)}]\n" +
 			"     │   └─ columns: [pk]\n" +
-			"     └─ IndexedTableAccess(niltable)\n" +
-			"         ├─ index: [niltable.i]\n" +
+			"     └─ IndexedTableAccess(mydb.niltable)\n" +
+			"         ├─ index: `PRIMARY` [niltable.i]\n" +
 			"         ├─ static: [{[NULL, ∞)}]\n" +
 			"         └─ columns: [i f]\n" +
 			"",
@@ -4994,12 +5272,12 @@ inner join pq on true
 			"         ├─ cmp: Eq\n" +
 			"         │   ├─ one_pk.pk:0!null\n" +
 			"         │   └─ niltable.i:1!null\n" +
-			"         ├─ IndexedTableAccess(one_pk)\n" +
-			"         │   ├─ index: [one_pk.pk]\n" +
+			"         ├─ IndexedTableAccess(mydb.one_pk)\n" +
+			"         │   ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"         │   ├─ static: [{[NULL, ∞)}]\n" +
 			"         │   └─ columns: [pk]\n" +
-			"         └─ IndexedTableAccess(niltable)\n" +
-			"             ├─ index: [niltable.i]\n" +
+			"         └─ IndexedTableAccess(mydb.niltable)\n" +
+			"             ├─ index: `PRIMARY` [niltable.i]\n" +
 			"             ├─ static: [{[NULL, ∞)}]\n" +
 			"             └─ columns: [i f]\n" +
 			"",
@@ -5015,12 +5293,12 @@ inner join pq on true
 			"         ├─ cmp: Eq\n" +
 			"         │   ├─ one_pk.pk:0!null\n" +
 			"         │   └─ niltable.i:1!null\n" +
-			"         ├─ IndexedTableAccess(one_pk)\n" +
-			"         │   ├─ index: [one_pk.pk]\n" +
+			"         ├─ IndexedTableAccess(mydb.one_pk)\n" +
+			"         │   ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"         │   ├─ static: [{[NULL, ∞)}]\n" +
 			"         │   └─ columns: [pk]\n" +
-			"         └─ IndexedTableAccess(niltable)\n" +
-			"             ├─ index: [niltable.i]\n" +
+			"         └─ IndexedTableAccess(mydb.niltable)\n" +
+			"             ├─ index: `PRIMARY` [niltable.i]\n" +
 			"             ├─ static: [{[NULL, ∞)}]\n" +
 			"             └─ columns: [i f]\n" +
 			"",
@@ -5034,12 +5312,12 @@ inner join pq on true
 			"         ├─ cmp: Eq\n" +
 			"         │   ├─ niltable.i:0!null\n" +
 			"         │   └─ one_pk.pk:2!null\n" +
-			"         ├─ IndexedTableAccess(niltable)\n" +
-			"         │   ├─ index: [niltable.i]\n" +
+			"         ├─ IndexedTableAccess(mydb.niltable)\n" +
+			"         │   ├─ index: `PRIMARY` [niltable.i]\n" +
 			"         │   ├─ static: [{[NULL, ∞)}]\n" +
 			"         │   └─ columns: [i f]\n" +
-			"         └─ IndexedTableAccess(one_pk)\n" +
-			"             ├─ index: [one_pk.pk]\n" +
+			"         └─ IndexedTableAccess(mydb.one_pk)\n" +
+			"             ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"             ├─ static: [{[NULL, ∞)}]\n" +
 			"             └─ columns: [pk]\n" +
 			"",
@@ -5056,12 +5334,12 @@ inner join pq on true
 			"             ├─ cmp: Eq\n" +
 			"             │   ├─ niltable.i:0!null\n" +
 			"             │   └─ one_pk.pk:2!null\n" +
-			"             ├─ IndexedTableAccess(niltable)\n" +
-			"             │   ├─ index: [niltable.i]\n" +
+			"             ├─ IndexedTableAccess(mydb.niltable)\n" +
+			"             │   ├─ index: `PRIMARY` [niltable.i]\n" +
 			"             │   ├─ static: [{[NULL, ∞)}]\n" +
 			"             │   └─ columns: [i f]\n" +
-			"             └─ IndexedTableAccess(one_pk)\n" +
-			"                 ├─ index: [one_pk.pk]\n" +
+			"             └─ IndexedTableAccess(mydb.one_pk)\n" +
+			"                 ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"                 ├─ static: [{[NULL, ∞)}]\n" +
 			"                 └─ columns: [pk]\n" +
 			"",
@@ -5079,12 +5357,12 @@ inner join pq on true
 			"             ├─ cmp: Eq\n" +
 			"             │   ├─ niltable.i:0!null\n" +
 			"             │   └─ one_pk.pk:2!null\n" +
-			"             ├─ IndexedTableAccess(niltable)\n" +
-			"             │   ├─ index: [niltable.i]\n" +
+			"             ├─ IndexedTableAccess(mydb.niltable)\n" +
+			"             │   ├─ index: `PRIMARY` [niltable.i]\n" +
 			"             │   ├─ static: [{[NULL, ∞)}]\n" +
 			"             │   └─ columns: [i f]\n" +
-			"             └─ IndexedTableAccess(one_pk)\n" +
-			"                 ├─ index: [one_pk.pk]\n" +
+			"             └─ IndexedTableAccess(mydb.one_pk)\n" +
+			"                 ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"                 ├─ static: [{[NULL, ∞)}]\n" +
 			"                 └─ columns: [pk]\n" +
 			"",
@@ -5101,12 +5379,12 @@ inner join pq on true
 			"         ├─ sel: GreaterThan\n" +
 			"         │   ├─ one_pk.pk:2!null\n" +
 			"         │   └─ 0 (tinyint)\n" +
-			"         ├─ IndexedTableAccess(niltable)\n" +
-			"         │   ├─ index: [niltable.i]\n" +
+			"         ├─ IndexedTableAccess(mydb.niltable)\n" +
+			"         │   ├─ index: `PRIMARY` [niltable.i]\n" +
 			"         │   ├─ static: [{[NULL, ∞)}]\n" +
 			"         │   └─ columns: [i f]\n" +
-			"         └─ IndexedTableAccess(one_pk)\n" +
-			"             ├─ index: [one_pk.pk]\n" +
+			"         └─ IndexedTableAccess(mydb.one_pk)\n" +
+			"             ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"             ├─ static: [{[NULL, ∞)}]\n" +
 			"             └─ columns: [pk]\n" +
 			"",
@@ -5123,12 +5401,12 @@ inner join pq on true
 			"         ├─ sel: Eq\n" +
 			"         │   ├─ one_pk.pk:2!null\n" +
 			"         │   └─ two_pk.pk2:1!null\n" +
-			"         ├─ IndexedTableAccess(two_pk)\n" +
-			"         │   ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"         ├─ IndexedTableAccess(mydb.two_pk)\n" +
+			"         │   ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
 			"         │   ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
 			"         │   └─ columns: [pk1 pk2]\n" +
-			"         └─ IndexedTableAccess(one_pk)\n" +
-			"             ├─ index: [one_pk.pk]\n" +
+			"         └─ IndexedTableAccess(mydb.one_pk)\n" +
+			"             ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"             ├─ static: [{[NULL, ∞)}]\n" +
 			"             └─ columns: [pk]\n" +
 			"",
@@ -5140,14 +5418,14 @@ inner join pq on true
 			" │   ├─ (two_pk.pk1:1!null - one_pk.pk:0!null)\n" +
 			" │   └─ 0 (tinyint)\n" +
 			" ├─ Table\n" +
-			" │   ├─ name: one_pk\n" +
+			" │   ├─ name: mydb.one_pk\n" +
 			" │   └─ columns: [pk]\n" +
 			" └─ Filter\n" +
 			"     ├─ LessThan\n" +
 			"     │   ├─ two_pk.pk2:1!null\n" +
 			"     │   └─ 1 (tinyint)\n" +
 			"     └─ Table\n" +
-			"         ├─ name: two_pk\n" +
+			"         ├─ name: mydb.two_pk\n" +
 			"         └─ columns: [pk1 pk2]\n" +
 			"",
 	},
@@ -5156,10 +5434,10 @@ inner join pq on true
 		ExpectedPlan: "Sort(one_pk.pk:0!null ASC nullsFirst, two_pk.pk1:1!null ASC nullsFirst, two_pk.pk2:2!null ASC nullsFirst)\n" +
 			" └─ CrossJoin\n" +
 			"     ├─ Table\n" +
-			"     │   ├─ name: one_pk\n" +
+			"     │   ├─ name: mydb.one_pk\n" +
 			"     │   └─ columns: [pk]\n" +
 			"     └─ Table\n" +
-			"         ├─ name: two_pk\n" +
+			"         ├─ name: mydb.two_pk\n" +
 			"         └─ columns: [pk1 pk2]\n" +
 			"",
 	},
@@ -5173,12 +5451,12 @@ inner join pq on true
 			"     ├─ sel: Eq\n" +
 			"     │   ├─ one_pk.pk:0!null\n" +
 			"     │   └─ two_pk.pk2:2!null\n" +
-			"     ├─ IndexedTableAccess(one_pk)\n" +
-			"     │   ├─ index: [one_pk.pk]\n" +
+			"     ├─ IndexedTableAccess(mydb.one_pk)\n" +
+			"     │   ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"     │   ├─ static: [{[NULL, ∞)}]\n" +
 			"     │   └─ columns: [pk]\n" +
-			"     └─ IndexedTableAccess(two_pk)\n" +
-			"         ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"     └─ IndexedTableAccess(mydb.two_pk)\n" +
+			"         ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
 			"         ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
 			"         └─ columns: [pk1 pk2]\n" +
 			"",
@@ -5190,12 +5468,12 @@ inner join pq on true
 			"     ├─ cmp: Eq\n" +
 			"     │   ├─ one_pk.pk:0!null\n" +
 			"     │   └─ two_pk.pk1:1!null\n" +
-			"     ├─ IndexedTableAccess(one_pk)\n" +
-			"     │   ├─ index: [one_pk.pk]\n" +
+			"     ├─ IndexedTableAccess(mydb.one_pk)\n" +
+			"     │   ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"     │   ├─ static: [{[NULL, ∞)}]\n" +
 			"     │   └─ columns: [pk]\n" +
-			"     └─ IndexedTableAccess(two_pk)\n" +
-			"         ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"     └─ IndexedTableAccess(mydb.two_pk)\n" +
+			"         ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
 			"         ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
 			"         └─ columns: [pk1 pk2]\n" +
 			"",
@@ -5212,12 +5490,12 @@ inner join pq on true
 			"         ├─ sel: Eq\n" +
 			"         │   ├─ one_pk.pk:2!null\n" +
 			"         │   └─ two_pk.pk2:1!null\n" +
-			"         ├─ IndexedTableAccess(two_pk)\n" +
-			"         │   ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"         ├─ IndexedTableAccess(mydb.two_pk)\n" +
+			"         │   ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
 			"         │   ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
 			"         │   └─ columns: [pk1 pk2]\n" +
-			"         └─ IndexedTableAccess(one_pk)\n" +
-			"             ├─ index: [one_pk.pk]\n" +
+			"         └─ IndexedTableAccess(mydb.one_pk)\n" +
+			"             ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"             ├─ static: [{[NULL, ∞)}]\n" +
 			"             └─ columns: [pk]\n" +
 			"",
@@ -5235,13 +5513,13 @@ inner join pq on true
 			"         │   ├─ opk.pk:2!null\n" +
 			"         │   └─ tpk.pk2:1!null\n" +
 			"         ├─ TableAlias(tpk)\n" +
-			"         │   └─ IndexedTableAccess(two_pk)\n" +
-			"         │       ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"         │   └─ IndexedTableAccess(mydb.two_pk)\n" +
+			"         │       ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
 			"         │       ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
 			"         │       └─ columns: [pk1 pk2]\n" +
 			"         └─ TableAlias(opk)\n" +
-			"             └─ IndexedTableAccess(one_pk)\n" +
-			"                 ├─ index: [one_pk.pk]\n" +
+			"             └─ IndexedTableAccess(mydb.one_pk)\n" +
+			"                 ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"                 ├─ static: [{[NULL, ∞)}]\n" +
 			"                 └─ columns: [pk]\n" +
 			"",
@@ -5259,13 +5537,13 @@ inner join pq on true
 			"         │   ├─ opk.pk:2!null\n" +
 			"         │   └─ tpk.pk2:1!null\n" +
 			"         ├─ TableAlias(tpk)\n" +
-			"         │   └─ IndexedTableAccess(two_pk)\n" +
-			"         │       ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"         │   └─ IndexedTableAccess(mydb.two_pk)\n" +
+			"         │       ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
 			"         │       ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
 			"         │       └─ columns: [pk1 pk2]\n" +
 			"         └─ TableAlias(opk)\n" +
-			"             └─ IndexedTableAccess(one_pk)\n" +
-			"                 ├─ index: [one_pk.pk]\n" +
+			"             └─ IndexedTableAccess(mydb.one_pk)\n" +
+			"                 ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"                 ├─ static: [{[NULL, ∞)}]\n" +
 			"                 └─ columns: [pk]\n" +
 			"",
@@ -5280,14 +5558,14 @@ inner join pq on true
 			"         │   ├─ one_pk.c1:4\n" +
 			"         │   └─ two_pk.c1:2!null\n" +
 			"         ├─ Table\n" +
-			"         │   ├─ name: two_pk\n" +
+			"         │   ├─ name: mydb.two_pk\n" +
 			"         │   └─ columns: [pk1 pk2 c1]\n" +
 			"         └─ HashLookup\n" +
 			"             ├─ source: TUPLE(two_pk.c1:2!null)\n" +
 			"             ├─ target: TUPLE(one_pk.c1:1)\n" +
 			"             └─ CachedResults\n" +
 			"                 └─ Table\n" +
-			"                     ├─ name: one_pk\n" +
+			"                     ├─ name: mydb.one_pk\n" +
 			"                     └─ columns: [pk c1]\n" +
 			"",
 	},
@@ -5301,14 +5579,14 @@ inner join pq on true
 			"         │   ├─ one_pk.c1:4\n" +
 			"         │   └─ two_pk.c1:2!null\n" +
 			"         ├─ Table\n" +
-			"         │   ├─ name: two_pk\n" +
+			"         │   ├─ name: mydb.two_pk\n" +
 			"         │   └─ columns: [pk1 pk2 c1]\n" +
 			"         └─ HashLookup\n" +
 			"             ├─ source: TUPLE(two_pk.c1:2!null)\n" +
 			"             ├─ target: TUPLE(one_pk.c1:1)\n" +
 			"             └─ CachedResults\n" +
 			"                 └─ Table\n" +
-			"                     ├─ name: one_pk\n" +
+			"                     ├─ name: mydb.one_pk\n" +
 			"                     └─ columns: [pk c1]\n" +
 			"",
 	},
@@ -5321,7 +5599,7 @@ inner join pq on true
 			"     │   ├─ one_pk.c1:4\n" +
 			"     │   └─ two_pk.c1:2!null\n" +
 			"     ├─ Table\n" +
-			"     │   ├─ name: two_pk\n" +
+			"     │   ├─ name: mydb.two_pk\n" +
 			"     │   └─ columns: [pk1 pk2 c1]\n" +
 			"     └─ HashLookup\n" +
 			"         ├─ source: TUPLE(two_pk.c1:2!null)\n" +
@@ -5332,7 +5610,7 @@ inner join pq on true
 			"                 │   ├─ one_pk.c1:1\n" +
 			"                 │   └─ 10 (tinyint)\n" +
 			"                 └─ Table\n" +
-			"                     ├─ name: one_pk\n" +
+			"                     ├─ name: mydb.one_pk\n" +
 			"                     └─ columns: [pk c1]\n" +
 			"",
 	},
@@ -5345,8 +5623,8 @@ inner join pq on true
 			"     │   │   ├─ t1.pk:0!null\n" +
 			"     │   │   └─ 1 (tinyint)\n" +
 			"     │   └─ TableAlias(t1)\n" +
-			"     │       └─ IndexedTableAccess(one_pk)\n" +
-			"     │           ├─ index: [one_pk.pk]\n" +
+			"     │       └─ IndexedTableAccess(mydb.one_pk)\n" +
+			"     │           ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"     │           ├─ static: [{[1, 1]}]\n" +
 			"     │           └─ columns: [pk]\n" +
 			"     └─ Filter\n" +
@@ -5355,7 +5633,7 @@ inner join pq on true
 			"         │   └─ 1 (tinyint)\n" +
 			"         └─ TableAlias(t2)\n" +
 			"             └─ Table\n" +
-			"                 ├─ name: two_pk\n" +
+			"                 ├─ name: mydb.two_pk\n" +
 			"                 └─ columns: [pk2]\n" +
 			"",
 	},
@@ -5368,22 +5646,21 @@ inner join pq on true
 			"     │   │   ├─ t1.pk:0!null\n" +
 			"     │   │   └─ 1 (tinyint)\n" +
 			"     │   └─ TableAlias(t1)\n" +
-			"     │       └─ IndexedTableAccess(one_pk)\n" +
-			"     │           ├─ index: [one_pk.pk]\n" +
+			"     │       └─ IndexedTableAccess(mydb.one_pk)\n" +
+			"     │           ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"     │           ├─ static: [{[1, 1]}]\n" +
 			"     │           └─ columns: [pk]\n" +
 			"     └─ Filter\n" +
-			"         ├─ AND\n" +
-			"         │   ├─ Eq\n" +
-			"         │   │   ├─ t2.pk2:1!null\n" +
-			"         │   │   └─ 1 (tinyint)\n" +
-			"         │   └─ Eq\n" +
-			"         │       ├─ t2.pk1:0!null\n" +
-			"         │       └─ 1 (tinyint)\n" +
+			"         ├─ Eq\n" +
+			"         │   ├─ t2.pk1:0!null\n" +
+			"         │   └─ 1 (tinyint)\n" +
 			"         └─ TableAlias(t2)\n" +
-			"             └─ IndexedTableAccess(two_pk)\n" +
-			"                 ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"             └─ IndexedTableAccess(mydb.two_pk)\n" +
+			"                 ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
 			"                 ├─ static: [{[1, 1], [NULL, ∞)}]\n" +
+			"                 ├─ index condition: Eq\n" +
+			"                 │   ├─ t2.pk2:1!null\n" +
+			"                 │   └─ 1 (tinyint)\n" +
 			"                 └─ columns: [pk1 pk2]\n" +
 			"",
 	},
@@ -5402,8 +5679,8 @@ inner join pq on true
 			"     │   │           ├─ Eq\n" +
 			"     │   │           │   ├─ mytable.i:2!null\n" +
 			"     │   │           │   └─ mt.i:0!null\n" +
-			"     │   │           └─ IndexedTableAccess(mytable)\n" +
-			"     │   │               ├─ index: [mytable.i]\n" +
+			"     │   │           └─ IndexedTableAccess(mydb.mytable)\n" +
+			"     │   │               ├─ index: `PRIMARY` [mytable.i]\n" +
 			"     │   │               ├─ static: [{(2, ∞)}]\n" +
 			"     │   │               └─ columns: [i]\n" +
 			"     │   │       IS NULL\n" +
@@ -5414,13 +5691,13 @@ inner join pq on true
 			"     │               ├─ Eq\n" +
 			"     │               │   ├─ othertable.i2:2!null\n" +
 			"     │               │   └─ mt.i:0!null\n" +
-			"     │               └─ IndexedTableAccess(othertable)\n" +
-			"     │                   ├─ index: [othertable.i2]\n" +
+			"     │               └─ IndexedTableAccess(mydb.othertable)\n" +
+			"     │                   ├─ index: `PRIMARY` [othertable.i2]\n" +
 			"     │                   └─ columns: [i2]\n" +
 			"     │           IS NULL\n" +
 			"     └─ TableAlias(mt)\n" +
 			"         └─ Table\n" +
-			"             ├─ name: mytable\n" +
+			"             ├─ name: mydb.mytable\n" +
 			"             └─ columns: [i s]\n" +
 			"",
 	},
@@ -5439,8 +5716,8 @@ inner join pq on true
 			"     │   │           ├─ Eq\n" +
 			"     │   │           │   ├─ mytable.i:2!null\n" +
 			"     │   │           │   └─ mt.i:0!null\n" +
-			"     │   │           └─ IndexedTableAccess(mytable)\n" +
-			"     │   │               ├─ index: [mytable.i]\n" +
+			"     │   │           └─ IndexedTableAccess(mydb.mytable)\n" +
+			"     │   │               ├─ index: `PRIMARY` [mytable.i]\n" +
 			"     │   │               └─ columns: [i]\n" +
 			"     │   │       IS NULL\n" +
 			"     │   └─ NOT\n" +
@@ -5454,13 +5731,13 @@ inner join pq on true
 			"     │               │   └─ GreaterThan\n" +
 			"     │               │       ├─ mt.i:0!null\n" +
 			"     │               │       └─ 2 (tinyint)\n" +
-			"     │               └─ IndexedTableAccess(othertable)\n" +
-			"     │                   ├─ index: [othertable.i2]\n" +
+			"     │               └─ IndexedTableAccess(mydb.othertable)\n" +
+			"     │                   ├─ index: `PRIMARY` [othertable.i2]\n" +
 			"     │                   └─ columns: [i2]\n" +
 			"     │           IS NULL\n" +
 			"     └─ TableAlias(mt)\n" +
 			"         └─ Table\n" +
-			"             ├─ name: mytable\n" +
+			"             ├─ name: mydb.mytable\n" +
 			"             └─ columns: [i s]\n" +
 			"",
 	},
@@ -5471,8 +5748,8 @@ inner join pq on true
 			"     ├─ columns: [t1.pk:0!null, t2.pk2:7!null, Subquery\n" +
 			"     │   ├─ cacheable: true\n" +
 			"     │   └─ Limit(1)\n" +
-			"     │       └─ IndexedTableAccess(one_pk)\n" +
-			"     │           ├─ index: [one_pk.pk]\n" +
+			"     │       └─ IndexedTableAccess(mydb.one_pk)\n" +
+			"     │           ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"     │           ├─ static: [{[1, 1]}]\n" +
 			"     │           └─ columns: [pk]\n" +
 			"     │   as (SELECT pk from one_pk where pk = 1 limit 1)]\n" +
@@ -5482,8 +5759,8 @@ inner join pq on true
 			"         │   │   ├─ t1.pk:0!null\n" +
 			"         │   │   └─ 1 (tinyint)\n" +
 			"         │   └─ TableAlias(t1)\n" +
-			"         │       └─ IndexedTableAccess(one_pk)\n" +
-			"         │           ├─ index: [one_pk.pk]\n" +
+			"         │       └─ IndexedTableAccess(mydb.one_pk)\n" +
+			"         │           ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"         │           ├─ static: [{[1, 1]}]\n" +
 			"         │           └─ columns: [pk c1 c2 c3 c4 c5]\n" +
 			"         └─ Filter\n" +
@@ -5492,7 +5769,7 @@ inner join pq on true
 			"             │   └─ 1 (tinyint)\n" +
 			"             └─ TableAlias(t2)\n" +
 			"                 └─ Table\n" +
-			"                     ├─ name: two_pk\n" +
+			"                     ├─ name: mydb.two_pk\n" +
 			"                     └─ columns: [pk1 pk2 c1 c2 c3 c4 c5]\n" +
 			"",
 	},
@@ -5510,8 +5787,8 @@ inner join pq on true
 			"             │   └─ Eq\n" +
 			"             │       ├─ othertable.s2:0!null\n" +
 			"             │       └─ second (longtext)\n" +
-			"             └─ IndexedTableAccess(othertable)\n" +
-			"                 ├─ index: [othertable.s2]\n" +
+			"             └─ IndexedTableAccess(mydb.othertable)\n" +
+			"                 ├─ index: othertable_s2 [othertable.s2]\n" +
 			"                 ├─ static: [{(second, ∞)}, {(NULL, second)}]\n" +
 			"                 └─ columns: [s2 i2]\n" +
 			"",
@@ -5535,7 +5812,7 @@ inner join pq on true
 			"                 ├─ othertable.i2:1!null\n" +
 			"                 ├─ othertable.s2:0!null\n" +
 			"                 └─ Table\n" +
-			"                     ├─ name: othertable\n" +
+			"                     ├─ name: mydb.othertable\n" +
 			"                     └─ columns: [s2 i2]\n" +
 			"",
 	},
@@ -5548,8 +5825,8 @@ inner join pq on true
 			"         ├─ row_number() over ( order by othertable.s2 ASC)\n" +
 			"         ├─ othertable.i2:1!null\n" +
 			"         ├─ othertable.s2:0!null\n" +
-			"         └─ IndexedTableAccess(othertable)\n" +
-			"             ├─ index: [othertable.i2]\n" +
+			"         └─ IndexedTableAccess(mydb.othertable)\n" +
+			"             ├─ index: `PRIMARY` [othertable.i2]\n" +
 			"             ├─ static: [{(NULL, 2)}, {(2, ∞)}]\n" +
 			"             └─ columns: [s2 i2]\n" +
 			"",
@@ -5576,7 +5853,7 @@ inner join pq on true
 			"                 ├─ othertable.i2:1!null\n" +
 			"                 ├─ othertable.s2:0!null\n" +
 			"                 └─ Table\n" +
-			"                     ├─ name: othertable\n" +
+			"                     ├─ name: mydb.othertable\n" +
 			"                     └─ columns: [s2 i2]\n" +
 			"",
 	},
@@ -5589,7 +5866,7 @@ inner join pq on true
 			"     ├─ bigtable.n:1\n" +
 			"     ├─ lag(bigtable.t, 1, (bigtable.t + 1)) over ( partition by bigtable.n ROWS BETWEEN UNBOUNDED PRECEDING AND UNBOUNDED FOLLOWING)\n" +
 			"     └─ Table\n" +
-			"         ├─ name: bigtable\n" +
+			"         ├─ name: mydb.bigtable\n" +
 			"         └─ columns: [t n]\n" +
 			"",
 	},
@@ -5601,7 +5878,7 @@ inner join pq on true
 			"     ├─ mytable.i:0!null\n" +
 			"     ├─ row_number() over ( ROWS BETWEEN UNBOUNDED PRECEDING AND UNBOUNDED FOLLOWING)\n" +
 			"     └─ Table\n" +
-			"         ├─ name: mytable\n" +
+			"         ├─ name: mydb.mytable\n" +
 			"         └─ columns: [i]\n" +
 			"",
 	},
@@ -5613,7 +5890,7 @@ inner join pq on true
 			"     ├─ mytable.i:0!null\n" +
 			"     ├─ row_number() over ( partition by mytable.s order by mytable.i ASC ROWS BETWEEN UNBOUNDED PRECEDING AND UNBOUNDED FOLLOWING)\n" +
 			"     └─ Table\n" +
-			"         ├─ name: mytable\n" +
+			"         ├─ name: mydb.mytable\n" +
 			"         └─ columns: [i s]\n" +
 			"",
 	},
@@ -5626,7 +5903,7 @@ inner join pq on true
 			"         │   ├─ two_pk.c1:2!null\n" +
 			"         │   └─ 1 (tinyint)\n" +
 			"         └─ Table\n" +
-			"             ├─ name: two_pk\n" +
+			"             ├─ name: mydb.two_pk\n" +
 			"             └─ columns: [pk1 pk2 c1 c2 c3 c4 c5]\n" +
 			"",
 	},
@@ -5634,8 +5911,8 @@ inner join pq on true
 		Query: `DELETE FROM two_pk WHERE pk1 = 1 AND pk2 = 2`,
 		ExpectedPlan: "RowUpdateAccumulator\n" +
 			" └─ Delete\n" +
-			"     └─ IndexedTableAccess(two_pk)\n" +
-			"         ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"     └─ IndexedTableAccess(mydb.two_pk)\n" +
+			"         ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
 			"         ├─ static: [{[1, 1], [2, 2]}]\n" +
 			"         └─ columns: [pk1 pk2 c1 c2 c3 c4 c5]\n" +
 			"",
@@ -5650,7 +5927,7 @@ inner join pq on true
 			"             │   ├─ two_pk.c1:2!null\n" +
 			"             │   └─ 1 (tinyint)\n" +
 			"             └─ Table\n" +
-			"                 ├─ name: two_pk\n" +
+			"                 ├─ name: mydb.two_pk\n" +
 			"                 └─ columns: [pk1 pk2 c1 c2 c3 c4 c5]\n" +
 			"",
 	},
@@ -5659,12 +5936,74 @@ inner join pq on true
 		ExpectedPlan: "RowUpdateAccumulator\n" +
 			" └─ Update\n" +
 			"     └─ UpdateSource(SET two_pk.c1:2!null = 1 (tinyint))\n" +
-			"         └─ IndexedTableAccess(two_pk)\n" +
-			"             ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"         └─ IndexedTableAccess(mydb.two_pk)\n" +
+			"             ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
 			"             ├─ static: [{[1, 1], [2, 2]}]\n" +
 			"             └─ columns: [pk1 pk2 c1 c2 c3 c4 c5]\n" +
 			"",
 	},
+	{
+		Query: `DELETE FROM mytable ORDER BY s LIMIT 1`,
+		ExpectedPlan: "RowUpdateAccumulator\n" +
+			" └─ Delete\n" +
+			"     └─ Limit(1)\n" +
+			"         └─ IndexedTableAccess(mydb.mytable)\n" +
+			"             ├─ index: idx_si [mytable.s,mytable.i]\n" +
+			"             ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"             └─ columns: [i s]\n" +
+			"",
+	},
+	{
+		Query: `DELETE FROM mytable WHERE i > 1 ORDER BY i DESC LIMIT 2`,
+		ExpectedPlan: "RowUpdateAccumulator\n" +
+			" └─ Delete\n" +
+			"     └─ Limit(2)\n" +
+			"         └─ IndexedTableAccess(mydb.mytable)\n" +
+			"             ├─ index: `PRIMARY` [mytable.i]\n" +
+			"             ├─ static: [{(1, ∞)}]\n" +
+			"             ├─ reverse: true\n" +
+			"             └─ columns: [i s]\n" +
+			"",
+	},
+	{
+		Query: `UPDATE othertable SET i2 = i2 + 1 ORDER BY s2 DESC LIMIT 2`,
+		ExpectedPlan: "RowUpdateAccumulator\n" +
+			" └─ Update\n" +
+			"     └─ UpdateSource(SET othertable.i2:1!null = (othertable.i2:1!null + 1 (tinyint)))\n" +
+			"         └─ Limit(2)\n" +
+			"             └─ IndexedTableAccess(mydb.othertable)\n" +
+			"                 ├─ index: othertable_s2 [othertable.s2]\n" +
+			"                 ├─ static: [{[NULL, ∞)}]\n" +
+			"                 ├─ reverse: true\n" +
+			"                 └─ columns: [s2 i2]\n" +
+			"",
+	},
+	{
+		Query: `UPDATE othertable SET i2 = i2 + 1 WHERE s2 > 'a' ORDER BY s2 DESC LIMIT 1`,
+		ExpectedPlan: "RowUpdateAccumulator\n" +
+			" └─ Update\n" +
+			"     └─ UpdateSource(SET othertable.i2:1!null = (othertable.i2:1!null + 1 (tinyint)))\n" +
+			"         └─ Limit(1)\n" +
+			"             └─ Filter\n" +
+			"                 ├─ GreaterThan\n" +
+			"                 │   ├─ othertable.s2:0!null\n" +
+			"                 │   └─ a (longtext)\n" +
+			"                 └─ IndexedTableAccess(mydb.othertable)\n" +
+			"                     ├─ index: othertable_s2 [othertable.s2]\n" +
+			"                     ├─ static: [{(a, ∞)}]\n" +
+			"                     ├─ reverse: true\n" +
+			"                     └─ columns: [s2 i2]\n" +
+			"",
+	},
+	{
+		Query: `SELECT * FROM mytable WHERE i < 3 ORDER BY i DESC`,
+		ExpectedPlan: "IndexedTableAccess(mydb.mytable)\n" +
+			" ├─ index: `PRIMARY` [mytable.i]\n" +
+			" ├─ static: [{(NULL, 3)}]\n" +
+			" ├─ reverse: true\n" +
+			" └─ columns: [i s]\n" +
+			"",
+	},
 	{
 		Query: `UPDATE /*+ JOIN_ORDER(two_pk, one_pk) */ one_pk JOIN two_pk on one_pk.pk = two_pk.pk1 SET two_pk.c1 = two_pk.c1 + 1`,
 		ExpectedPlan: "RowUpdateAccumulator\n" +
@@ -5675,11 +6014,11 @@ inner join pq on true
 			"                 ├─ columns: [one_pk.pk, one_pk.c1, one_pk.c2, one_pk.c3, one_pk.c4, one_pk.c5, two_pk.pk1, two_pk.pk2, two_pk.c1, two_pk.c2, two_pk.c3, two_pk.c4, two_pk.c5]\n" +
 			"                 └─ MergeJoin\n" +
 			"                     ├─ cmp: (two_pk.pk1 = one_pk.pk)\n" +
-			"                     ├─ IndexedTableAccess(two_pk)\n" +
-			"                     │   ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"                     ├─ IndexedTableAccess(mydb.two_pk)\n" +
+			"                     │   ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
 			"                     │   └─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
-			"                     └─ IndexedTableAccess(one_pk)\n" +
-			"                         ├─ index: [one_pk.pk]\n" +
+			"                     └─ IndexedTableAccess(mydb.one_pk)\n" +
+			"                         ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"                         └─ filters: [{[NULL, ∞)}]\n" +
 			"",
 	},
@@ -5698,14 +6037,14 @@ inner join pq on true
 			"                     │   ├─ outerVisibility: false\n" +
 			"                     │   ├─ cacheable: true\n" +
 			"                     │   └─ Table\n" +
-			"                     │       ├─ name: two_pk\n" +
+			"                     │       ├─ name: mydb.two_pk\n" +
 			"                     │       └─ columns: [pk1 pk2 c1 c2 c3 c4 c5]\n" +
 			"                     └─ HashLookup\n" +
 			"                         ├─ outer: (t2.pk1)\n" +
 			"                         ├─ inner: (one_pk.pk)\n" +
 			"                         └─ CachedResults\n" +
 			"                             └─ Table\n" +
-			"                                 └─ name: one_pk\n" +
+			"                                 └─ name: mydb.one_pk\n" +
 			"",
 	},
 	{
@@ -5718,11 +6057,11 @@ inner join pq on true
 			"     │   └─ b.z:0!null\n" +
 			"     ├─ TableAlias(b)\n" +
 			"     │   └─ Table\n" +
-			"     │       ├─ name: invert_pk\n" +
+			"     │       ├─ name: mydb.invert_pk\n" +
 			"     │       └─ columns: [z]\n" +
 			"     └─ TableAlias(a)\n" +
-			"         └─ IndexedTableAccess(invert_pk)\n" +
-			"             ├─ index: [invert_pk.y,invert_pk.z,invert_pk.x]\n" +
+			"         └─ IndexedTableAccess(mydb.invert_pk)\n" +
+			"             ├─ index: `PRIMARY` [invert_pk.y,invert_pk.z,invert_pk.x]\n" +
 			"             └─ columns: [x y z]\n" +
 			"",
 	},
@@ -5736,46 +6075,46 @@ inner join pq on true
 			"     │   └─ b.z:0!null\n" +
 			"     ├─ TableAlias(b)\n" +
 			"     │   └─ Table\n" +
-			"     │       ├─ name: invert_pk\n" +
+			"     │       ├─ name: mydb.invert_pk\n" +
 			"     │       └─ columns: [z]\n" +
 			"     └─ Filter\n" +
 			"         ├─ Eq\n" +
 			"         │   ├─ a.z:2!null\n" +
 			"         │   └─ 2 (tinyint)\n" +
 			"         └─ TableAlias(a)\n" +
-			"             └─ IndexedTableAccess(invert_pk)\n" +
-			"                 ├─ index: [invert_pk.y,invert_pk.z,invert_pk.x]\n" +
+			"             └─ IndexedTableAccess(mydb.invert_pk)\n" +
+			"                 ├─ index: `PRIMARY` [invert_pk.y,invert_pk.z,invert_pk.x]\n" +
 			"                 └─ columns: [x y z]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM invert_pk WHERE y = 0`,
-		ExpectedPlan: "IndexedTableAccess(invert_pk)\n" +
-			" ├─ index: [invert_pk.y,invert_pk.z,invert_pk.x]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.invert_pk)\n" +
+			" ├─ index: `PRIMARY` [invert_pk.y,invert_pk.z,invert_pk.x]\n" +
 			" ├─ static: [{[0, 0], [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [x y z]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM invert_pk WHERE y >= 0`,
-		ExpectedPlan: "IndexedTableAccess(invert_pk)\n" +
-			" ├─ index: [invert_pk.y,invert_pk.z,invert_pk.x]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.invert_pk)\n" +
+			" ├─ index: `PRIMARY` [invert_pk.y,invert_pk.z,invert_pk.x]\n" +
 			" ├─ static: [{[0, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [x y z]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM invert_pk WHERE y >= 0 AND z < 1`,
-		ExpectedPlan: "IndexedTableAccess(invert_pk)\n" +
-			" ├─ index: [invert_pk.y,invert_pk.z,invert_pk.x]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.invert_pk)\n" +
+			" ├─ index: `PRIMARY` [invert_pk.y,invert_pk.z,invert_pk.x]\n" +
 			" ├─ static: [{[0, ∞), (NULL, 1), [NULL, ∞)}]\n" +
 			" └─ columns: [x y z]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM one_pk WHERE pk IN (1)`,
-		ExpectedPlan: "IndexedTableAccess(one_pk)\n" +
-			" ├─ index: [one_pk.pk]\n" +
+		ExpectedPlan: "IndexedTableAccess(mydb.one_pk)\n" +
+			" ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			" ├─ static: [{[1, 1]}]\n" +
 			" └─ columns: [pk c1 c2 c3 c4 c5]\n" +
 			"",
@@ -5795,15 +6134,15 @@ inner join pq on true
 			"     ├─ CrossJoin\n" +
 			"     │   ├─ TableAlias(a)\n" +
 			"     │   │   └─ Table\n" +
-			"     │   │       ├─ name: one_pk\n" +
+			"     │   │       ├─ name: mydb.one_pk\n" +
 			"     │   │       └─ columns: [pk c1 c2 c3 c4 c5]\n" +
 			"     │   └─ TableAlias(c)\n" +
 			"     │       └─ Table\n" +
-			"     │           ├─ name: one_pk\n" +
+			"     │           ├─ name: mydb.one_pk\n" +
 			"     │           └─ columns: [pk]\n" +
 			"     └─ TableAlias(b)\n" +
-			"         └─ IndexedTableAccess(one_pk)\n" +
-			"             ├─ index: [one_pk.pk]\n" +
+			"         └─ IndexedTableAccess(mydb.one_pk)\n" +
+			"             ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"             └─ columns: [pk]\n" +
 			"",
 	},
@@ -5821,7 +6160,7 @@ inner join pq on true
 			"     │       └─ a.pk:1!null\n" +
 			"     ├─ TableAlias(b)\n" +
 			"     │   └─ Table\n" +
-			"     │       ├─ name: one_pk\n" +
+			"     │       ├─ name: mydb.one_pk\n" +
 			"     │       └─ columns: [pk]\n" +
 			"     └─ HashLookup\n" +
 			"         ├─ source: TUPLE(b.pk:0!null, b.pk:0!null)\n" +
@@ -5830,11 +6169,11 @@ inner join pq on true
 			"             └─ CrossJoin\n" +
 			"                 ├─ TableAlias(a)\n" +
 			"                 │   └─ Table\n" +
-			"                 │       ├─ name: one_pk\n" +
+			"                 │       ├─ name: mydb.one_pk\n" +
 			"                 │       └─ columns: [pk c1 c2 c3 c4 c5]\n" +
 			"                 └─ TableAlias(c)\n" +
 			"                     └─ Table\n" +
-			"                         ├─ name: one_pk\n" +
+			"                         ├─ name: mydb.one_pk\n" +
 			"                         └─ columns: [pk]\n" +
 			"",
 	},
@@ -5851,18 +6190,18 @@ inner join pq on true
 			"     │   │   ├─ b.pk:0!null\n" +
 			"     │   │   └─ a.pk:1!null\n" +
 			"     │   ├─ TableAlias(b)\n" +
-			"     │   │   └─ IndexedTableAccess(one_pk)\n" +
-			"     │   │       ├─ index: [one_pk.pk]\n" +
+			"     │   │   └─ IndexedTableAccess(mydb.one_pk)\n" +
+			"     │   │       ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"     │   │       ├─ static: [{[NULL, ∞)}]\n" +
 			"     │   │       └─ columns: [pk]\n" +
 			"     │   └─ TableAlias(a)\n" +
-			"     │       └─ IndexedTableAccess(one_pk)\n" +
-			"     │           ├─ index: [one_pk.pk]\n" +
+			"     │       └─ IndexedTableAccess(mydb.one_pk)\n" +
+			"     │           ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"     │           ├─ static: [{[NULL, ∞)}]\n" +
 			"     │           └─ columns: [pk c1 c2 c3 c4 c5]\n" +
 			"     └─ TableAlias(c)\n" +
-			"         └─ IndexedTableAccess(one_pk)\n" +
-			"             ├─ index: [one_pk.pk]\n" +
+			"         └─ IndexedTableAccess(mydb.one_pk)\n" +
+			"             ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"             └─ columns: [pk]\n" +
 			"",
 	},
@@ -5877,25 +6216,25 @@ inner join pq on true
 			"     ├─ CrossJoin\n" +
 			"     │   ├─ TableAlias(a)\n" +
 			"     │   │   └─ Table\n" +
-			"     │   │       ├─ name: one_pk\n" +
+			"     │   │       ├─ name: mydb.one_pk\n" +
 			"     │   │       └─ columns: [pk c1 c2 c3 c4 c5]\n" +
 			"     │   └─ MergeJoin\n" +
 			"     │       ├─ cmp: Eq\n" +
 			"     │       │   ├─ c.pk:6!null\n" +
 			"     │       │   └─ b.pk:7!null\n" +
 			"     │       ├─ TableAlias(c)\n" +
-			"     │       │   └─ IndexedTableAccess(one_pk)\n" +
-			"     │       │       ├─ index: [one_pk.pk]\n" +
+			"     │       │   └─ IndexedTableAccess(mydb.one_pk)\n" +
+			"     │       │       ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"     │       │       ├─ static: [{[NULL, ∞)}]\n" +
 			"     │       │       └─ columns: [pk]\n" +
 			"     │       └─ TableAlias(b)\n" +
-			"     │           └─ IndexedTableAccess(one_pk)\n" +
-			"     │               ├─ index: [one_pk.pk]\n" +
+			"     │           └─ IndexedTableAccess(mydb.one_pk)\n" +
+			"     │               ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"     │               ├─ static: [{[NULL, ∞)}]\n" +
 			"     │               └─ columns: [pk]\n" +
 			"     └─ TableAlias(d)\n" +
-			"         └─ IndexedTableAccess(one_pk)\n" +
-			"             ├─ index: [one_pk.pk]\n" +
+			"         └─ IndexedTableAccess(mydb.one_pk)\n" +
+			"             ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"             └─ columns: [pk]\n" +
 			"",
 	},
@@ -5912,7 +6251,7 @@ inner join pq on true
 			"     │   ├─ outerVisibility: false\n" +
 			"     │   ├─ cacheable: true\n" +
 			"     │   └─ Table\n" +
-			"     │       ├─ name: one_pk\n" +
+			"     │       ├─ name: mydb.one_pk\n" +
 			"     │       └─ columns: [pk c1 c2 c3 c4 c5]\n" +
 			"     └─ HashLookup\n" +
 			"         ├─ source: TUPLE(b.pk:0!null)\n" +
@@ -5921,11 +6260,11 @@ inner join pq on true
 			"             └─ CrossJoin\n" +
 			"                 ├─ TableAlias(a)\n" +
 			"                 │   └─ Table\n" +
-			"                 │       ├─ name: one_pk\n" +
+			"                 │       ├─ name: mydb.one_pk\n" +
 			"                 │       └─ columns: [pk c1 c2 c3 c4 c5]\n" +
 			"                 └─ TableAlias(c)\n" +
 			"                     └─ Table\n" +
-			"                         ├─ name: one_pk\n" +
+			"                         ├─ name: mydb.one_pk\n" +
 			"                         └─ columns: [pk]\n" +
 			"",
 	},
@@ -5938,15 +6277,15 @@ inner join pq on true
 			"     │   └─ ot.i2:5!null\n" +
 			"     ├─ CrossJoin\n" +
 			"     │   ├─ Table\n" +
-			"     │   │   ├─ name: tabletest\n" +
+			"     │   │   ├─ name: mydb.tabletest\n" +
 			"     │   │   └─ columns: [i s]\n" +
 			"     │   └─ TableAlias(mt)\n" +
 			"     │       └─ Table\n" +
-			"     │           ├─ name: mytable\n" +
+			"     │           ├─ name: mydb.mytable\n" +
 			"     │           └─ columns: [i s]\n" +
 			"     └─ TableAlias(ot)\n" +
-			"         └─ IndexedTableAccess(othertable)\n" +
-			"             ├─ index: [othertable.i2]\n" +
+			"         └─ IndexedTableAccess(mydb.othertable)\n" +
+			"             ├─ index: `PRIMARY` [othertable.i2]\n" +
 			"             └─ columns: [s2 i2]\n" +
 			"",
 	},
@@ -5968,7 +6307,7 @@ inner join pq on true
 			"         │   │   └─ 0 (tinyint)\n" +
 			"         │   └─ TableAlias(c)\n" +
 			"         │       └─ Table\n" +
-			"         │           ├─ name: one_pk_three_idx\n" +
+			"         │           ├─ name: mydb.one_pk_three_idx\n" +
 			"         │           └─ columns: [v1 v2]\n" +
 			"         └─ HashLookup\n" +
 			"             ├─ source: TUPLE(c.v1:0)\n" +
@@ -5977,11 +6316,11 @@ inner join pq on true
 			"                 └─ CrossJoin\n" +
 			"                     ├─ TableAlias(a)\n" +
 			"                     │   └─ Table\n" +
-			"                     │       ├─ name: one_pk_three_idx\n" +
+			"                     │       ├─ name: mydb.one_pk_three_idx\n" +
 			"                     │       └─ columns: [pk]\n" +
 			"                     └─ TableAlias(b)\n" +
 			"                         └─ Table\n" +
-			"                             ├─ name: one_pk_three_idx\n" +
+			"                             ├─ name: mydb.one_pk_three_idx\n" +
 			"                             └─ columns: [pk]\n" +
 			"",
 	},
@@ -6000,20 +6339,20 @@ inner join pq on true
 			"     │   │   │   └─ 1 (tinyint)\n" +
 			"     │   │   └─ TableAlias(a)\n" +
 			"     │   │       └─ Table\n" +
-			"     │   │           ├─ name: one_pk_three_idx\n" +
+			"     │   │           ├─ name: mydb.one_pk_three_idx\n" +
 			"     │   │           └─ columns: [pk v2]\n" +
 			"     │   └─ Filter\n" +
 			"     │       ├─ Eq\n" +
 			"     │       │   ├─ b.pk:0!null\n" +
 			"     │       │   └─ 0 (tinyint)\n" +
 			"     │       └─ TableAlias(b)\n" +
-			"     │           └─ IndexedTableAccess(one_pk_three_idx)\n" +
-			"     │               ├─ index: [one_pk_three_idx.pk]\n" +
+			"     │           └─ IndexedTableAccess(mydb.one_pk_three_idx)\n" +
+			"     │               ├─ index: `PRIMARY` [one_pk_three_idx.pk]\n" +
 			"     │               ├─ static: [{[0, 0]}]\n" +
 			"     │               └─ columns: [pk]\n" +
 			"     └─ TableAlias(c)\n" +
-			"         └─ IndexedTableAccess(one_pk_three_idx)\n" +
-			"             ├─ index: [one_pk_three_idx.v1,one_pk_three_idx.v2,one_pk_three_idx.v3]\n" +
+			"         └─ IndexedTableAccess(mydb.one_pk_three_idx)\n" +
+			"             ├─ index: one_pk_three_idx_idx [one_pk_three_idx.v1,one_pk_three_idx.v2,one_pk_three_idx.v3]\n" +
 			"             └─ columns: [v1 v2]\n" +
 			"",
 	},
@@ -6027,7 +6366,7 @@ inner join pq on true
 			"     │   └─ (c.i:0!null - 1 (tinyint))\n" +
 			"     ├─ TableAlias(c)\n" +
 			"     │   └─ Table\n" +
-			"     │       ├─ name: mytable\n" +
+			"     │       ├─ name: mydb.mytable\n" +
 			"     │       └─ columns: [i s]\n" +
 			"     └─ HashLookup\n" +
 			"         ├─ source: TUPLE((c.i:0!null - 1 (tinyint)))\n" +
@@ -6040,11 +6379,11 @@ inner join pq on true
 			"                 └─ CrossJoin\n" +
 			"                     ├─ TableAlias(a)\n" +
 			"                     │   └─ Table\n" +
-			"                     │       ├─ name: mytable\n" +
+			"                     │       ├─ name: mydb.mytable\n" +
 			"                     │       └─ columns: [i s]\n" +
 			"                     └─ TableAlias(b)\n" +
 			"                         └─ Table\n" +
-			"                             ├─ name: mytable\n" +
+			"                             ├─ name: mydb.mytable\n" +
 			"                             └─ columns: []\n" +
 			"",
 	},
@@ -6058,7 +6397,7 @@ inner join pq on true
 			"     │   └─ d.i:0!null\n" +
 			"     ├─ TableAlias(d)\n" +
 			"     │   └─ Table\n" +
-			"     │       ├─ name: mytable\n" +
+			"     │       ├─ name: mydb.mytable\n" +
 			"     │       └─ columns: [i]\n" +
 			"     └─ LeftOuterJoin\n" +
 			"         ├─ Eq\n" +
@@ -6069,18 +6408,18 @@ inner join pq on true
 			"         │   │   ├─ (b.i:1!null + 1 (tinyint))\n" +
 			"         │   │   └─ a.i:2!null\n" +
 			"         │   ├─ TableAlias(b)\n" +
-			"         │   │   └─ IndexedTableAccess(mytable)\n" +
-			"         │   │       ├─ index: [mytable.i]\n" +
+			"         │   │   └─ IndexedTableAccess(mydb.mytable)\n" +
+			"         │   │       ├─ index: `PRIMARY` [mytable.i]\n" +
 			"         │   │       ├─ static: [{[NULL, ∞)}]\n" +
 			"         │   │       └─ columns: [i]\n" +
 			"         │   └─ TableAlias(a)\n" +
-			"         │       └─ IndexedTableAccess(mytable)\n" +
-			"         │           ├─ index: [mytable.i]\n" +
+			"         │       └─ IndexedTableAccess(mydb.mytable)\n" +
+			"         │           ├─ index: `PRIMARY` [mytable.i]\n" +
 			"         │           ├─ static: [{[NULL, ∞)}]\n" +
 			"         │           └─ columns: [i s]\n" +
 			"         └─ TableAlias(c)\n" +
 			"             └─ Table\n" +
-			"                 ├─ name: mytable\n" +
+			"                 ├─ name: mydb.mytable\n" +
 			"                 └─ columns: [i]\n" +
 			"",
 	},
@@ -6101,22 +6440,22 @@ inner join pq on true
 			"     │   │   │   ├─ (b.i2:1!null + 1 (tinyint))\n" +
 			"     │   │   │   └─ a.i:2!null\n" +
 			"     │   │   ├─ TableAlias(b)\n" +
-			"     │   │   │   └─ IndexedTableAccess(othertable)\n" +
-			"     │   │   │       ├─ index: [othertable.i2]\n" +
+			"     │   │   │   └─ IndexedTableAccess(mydb.othertable)\n" +
+			"     │   │   │       ├─ index: `PRIMARY` [othertable.i2]\n" +
 			"     │   │   │       ├─ static: [{[NULL, ∞)}]\n" +
 			"     │   │   │       └─ columns: [s2 i2]\n" +
 			"     │   │   └─ TableAlias(a)\n" +
-			"     │   │       └─ IndexedTableAccess(mytable)\n" +
-			"     │   │           ├─ index: [mytable.i]\n" +
+			"     │   │       └─ IndexedTableAccess(mydb.mytable)\n" +
+			"     │   │           ├─ index: `PRIMARY` [mytable.i]\n" +
 			"     │   │           ├─ static: [{[NULL, ∞)}]\n" +
 			"     │   │           └─ columns: [i s]\n" +
 			"     │   └─ TableAlias(c)\n" +
 			"     │       └─ Table\n" +
-			"     │           ├─ name: mytable\n" +
+			"     │           ├─ name: mydb.mytable\n" +
 			"     │           └─ columns: [i]\n" +
 			"     └─ TableAlias(d)\n" +
-			"         └─ IndexedTableAccess(othertable)\n" +
-			"             ├─ index: [othertable.i2]\n" +
+			"         └─ IndexedTableAccess(mydb.othertable)\n" +
+			"             ├─ index: `PRIMARY` [othertable.i2]\n" +
 			"             └─ columns: [i2]\n" +
 			"",
 	},
@@ -6134,25 +6473,25 @@ inner join pq on true
 			"     │   │   └─ (c.i:0!null - 1 (tinyint))\n" +
 			"     │   ├─ TableAlias(c)\n" +
 			"     │   │   └─ Table\n" +
-			"     │   │       ├─ name: mytable\n" +
+			"     │   │       ├─ name: mydb.mytable\n" +
 			"     │   │       └─ columns: [i]\n" +
 			"     │   └─ LeftOuterMergeJoin\n" +
 			"     │       ├─ cmp: Eq\n" +
 			"     │       │   ├─ (b.i2:2!null + 1 (tinyint))\n" +
 			"     │       │   └─ a.i:3!null\n" +
 			"     │       ├─ TableAlias(b)\n" +
-			"     │       │   └─ IndexedTableAccess(othertable)\n" +
-			"     │       │       ├─ index: [othertable.i2]\n" +
+			"     │       │   └─ IndexedTableAccess(mydb.othertable)\n" +
+			"     │       │       ├─ index: `PRIMARY` [othertable.i2]\n" +
 			"     │       │       ├─ static: [{[NULL, ∞)}]\n" +
 			"     │       │       └─ columns: [s2 i2]\n" +
 			"     │       └─ TableAlias(a)\n" +
-			"     │           └─ IndexedTableAccess(mytable)\n" +
-			"     │               ├─ index: [mytable.i]\n" +
+			"     │           └─ IndexedTableAccess(mydb.mytable)\n" +
+			"     │               ├─ index: `PRIMARY` [mytable.i]\n" +
 			"     │               ├─ static: [{[NULL, ∞)}]\n" +
 			"     │               └─ columns: [i s]\n" +
 			"     └─ TableAlias(d)\n" +
-			"         └─ IndexedTableAccess(othertable)\n" +
-			"             ├─ index: [othertable.i2]\n" +
+			"         └─ IndexedTableAccess(mydb.othertable)\n" +
+			"             ├─ index: `PRIMARY` [othertable.i2]\n" +
 			"             └─ columns: [i2]\n" +
 			"",
 	},
@@ -6165,13 +6504,13 @@ inner join pq on true
 			"     │   ├─ j.pk:0!null\n" +
 			"     │   └─ i.v1:3\n" +
 			"     ├─ TableAlias(j)\n" +
-			"     │   └─ IndexedTableAccess(one_pk_three_idx)\n" +
-			"     │       ├─ index: [one_pk_three_idx.pk]\n" +
+			"     │   └─ IndexedTableAccess(mydb.one_pk_three_idx)\n" +
+			"     │       ├─ index: `PRIMARY` [one_pk_three_idx.pk]\n" +
 			"     │       ├─ static: [{[NULL, ∞)}]\n" +
 			"     │       └─ columns: [pk v3]\n" +
 			"     └─ TableAlias(i)\n" +
-			"         └─ IndexedTableAccess(one_pk_two_idx)\n" +
-			"             ├─ index: [one_pk_two_idx.v1]\n" +
+			"         └─ IndexedTableAccess(mydb.one_pk_two_idx)\n" +
+			"             ├─ index: one_pk_two_idx_1 [one_pk_two_idx.v1]\n" +
 			"             ├─ static: [{[NULL, ∞)}]\n" +
 			"             └─ columns: [pk v1]\n" +
 			"",
@@ -6190,15 +6529,15 @@ inner join pq on true
 			"     │   │   └─ k.pk:2!null\n" +
 			"     │   ├─ TableAlias(j)\n" +
 			"     │   │   └─ Table\n" +
-			"     │   │       ├─ name: one_pk_three_idx\n" +
+			"     │   │       ├─ name: mydb.one_pk_three_idx\n" +
 			"     │   │       └─ columns: [pk v3]\n" +
 			"     │   └─ TableAlias(k)\n" +
-			"     │       └─ IndexedTableAccess(one_pk)\n" +
-			"     │           ├─ index: [one_pk.pk]\n" +
+			"     │       └─ IndexedTableAccess(mydb.one_pk)\n" +
+			"     │           ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"     │           └─ columns: [pk c1]\n" +
 			"     └─ TableAlias(i)\n" +
-			"         └─ IndexedTableAccess(one_pk_two_idx)\n" +
-			"             ├─ index: [one_pk_two_idx.v1]\n" +
+			"         └─ IndexedTableAccess(mydb.one_pk_two_idx)\n" +
+			"             ├─ index: one_pk_two_idx_1 [one_pk_two_idx.v1]\n" +
 			"             └─ columns: [pk v1]\n" +
 			"",
 	},
@@ -6211,13 +6550,13 @@ inner join pq on true
 			"     │   ├─ j.pk:0!null\n" +
 			"     │   └─ i.v1:3\n" +
 			"     ├─ TableAlias(j)\n" +
-			"     │   └─ IndexedTableAccess(one_pk_three_idx)\n" +
-			"     │       ├─ index: [one_pk_three_idx.pk]\n" +
+			"     │   └─ IndexedTableAccess(mydb.one_pk_three_idx)\n" +
+			"     │       ├─ index: `PRIMARY` [one_pk_three_idx.pk]\n" +
 			"     │       ├─ static: [{[NULL, ∞)}]\n" +
 			"     │       └─ columns: [pk v3]\n" +
 			"     └─ TableAlias(i)\n" +
-			"         └─ IndexedTableAccess(one_pk_two_idx)\n" +
-			"             ├─ index: [one_pk_two_idx.v1]\n" +
+			"         └─ IndexedTableAccess(mydb.one_pk_two_idx)\n" +
+			"             ├─ index: one_pk_two_idx_1 [one_pk_two_idx.v1]\n" +
 			"             ├─ static: [{[NULL, ∞)}]\n" +
 			"             └─ columns: [pk v1]\n" +
 			"",
@@ -6236,15 +6575,15 @@ inner join pq on true
 			"     │   │   └─ k.pk:2!null\n" +
 			"     │   ├─ TableAlias(j)\n" +
 			"     │   │   └─ Table\n" +
-			"     │   │       ├─ name: one_pk_three_idx\n" +
+			"     │   │       ├─ name: mydb.one_pk_three_idx\n" +
 			"     │   │       └─ columns: [pk v3]\n" +
 			"     │   └─ TableAlias(k)\n" +
-			"     │       └─ IndexedTableAccess(one_pk)\n" +
-			"     │           ├─ index: [one_pk.pk]\n" +
+			"     │       └─ IndexedTableAccess(mydb.one_pk)\n" +
+			"     │           ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"     │           └─ columns: [pk c1]\n" +
 			"     └─ TableAlias(i)\n" +
-			"         └─ IndexedTableAccess(one_pk_two_idx)\n" +
-			"             ├─ index: [one_pk_two_idx.v1]\n" +
+			"         └─ IndexedTableAccess(mydb.one_pk_two_idx)\n" +
+			"             ├─ index: one_pk_two_idx_1 [one_pk_two_idx.v1]\n" +
 			"             └─ columns: [pk v1]\n" +
 			"",
 	},
@@ -6262,15 +6601,15 @@ inner join pq on true
 			"     │   │   └─ k.pk:2!null\n" +
 			"     │   ├─ TableAlias(j)\n" +
 			"     │   │   └─ Table\n" +
-			"     │   │       ├─ name: one_pk_three_idx\n" +
+			"     │   │       ├─ name: mydb.one_pk_three_idx\n" +
 			"     │   │       └─ columns: [pk v3]\n" +
 			"     │   └─ TableAlias(k)\n" +
-			"     │       └─ IndexedTableAccess(one_pk)\n" +
-			"     │           ├─ index: [one_pk.pk]\n" +
+			"     │       └─ IndexedTableAccess(mydb.one_pk)\n" +
+			"     │           ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"     │           └─ columns: [pk c1]\n" +
 			"     └─ TableAlias(i)\n" +
-			"         └─ IndexedTableAccess(one_pk_two_idx)\n" +
-			"             ├─ index: [one_pk_two_idx.v1]\n" +
+			"         └─ IndexedTableAccess(mydb.one_pk_two_idx)\n" +
+			"             ├─ index: one_pk_two_idx_1 [one_pk_two_idx.v1]\n" +
 			"             └─ columns: [pk v1]\n" +
 			"",
 	},
@@ -6291,31 +6630,31 @@ inner join pq on true
 			"     │   │   │   ├─ j.pk:0!null\n" +
 			"     │   │   │   └─ i.v1:1\n" +
 			"     │   │   ├─ TableAlias(j)\n" +
-			"     │   │   │   └─ IndexedTableAccess(one_pk_three_idx)\n" +
-			"     │   │   │       ├─ index: [one_pk_three_idx.pk]\n" +
+			"     │   │   │   └─ IndexedTableAccess(mydb.one_pk_three_idx)\n" +
+			"     │   │   │       ├─ index: `PRIMARY` [one_pk_three_idx.pk]\n" +
 			"     │   │   │       ├─ static: [{[NULL, ∞)}]\n" +
 			"     │   │   │       └─ columns: [pk]\n" +
 			"     │   │   └─ TableAlias(i)\n" +
-			"     │   │       └─ IndexedTableAccess(one_pk_two_idx)\n" +
-			"     │   │           ├─ index: [one_pk_two_idx.v1]\n" +
+			"     │   │       └─ IndexedTableAccess(mydb.one_pk_two_idx)\n" +
+			"     │   │           ├─ index: one_pk_two_idx_1 [one_pk_two_idx.v1]\n" +
 			"     │   │           ├─ static: [{[NULL, ∞)}]\n" +
 			"     │   │           └─ columns: [v1]\n" +
 			"     │   └─ TableAlias(a)\n" +
-			"     │       └─ IndexedTableAccess(one_pk_two_idx)\n" +
-			"     │           ├─ index: [one_pk_two_idx.pk]\n" +
+			"     │       └─ IndexedTableAccess(mydb.one_pk_two_idx)\n" +
+			"     │           ├─ index: `PRIMARY` [one_pk_two_idx.pk]\n" +
 			"     │           └─ columns: [pk v1 v2]\n" +
 			"     └─ MergeJoin\n" +
 			"         ├─ cmp: Eq\n" +
 			"         │   ├─ l.pk:5!null\n" +
 			"         │   └─ k.v1:7\n" +
 			"         ├─ TableAlias(l)\n" +
-			"         │   └─ IndexedTableAccess(one_pk_three_idx)\n" +
-			"         │       ├─ index: [one_pk_three_idx.pk]\n" +
+			"         │   └─ IndexedTableAccess(mydb.one_pk_three_idx)\n" +
+			"         │       ├─ index: `PRIMARY` [one_pk_three_idx.pk]\n" +
 			"         │       ├─ static: [{[NULL, ∞)}]\n" +
 			"         │       └─ columns: [pk v2]\n" +
 			"         └─ TableAlias(k)\n" +
-			"             └─ IndexedTableAccess(one_pk_two_idx)\n" +
-			"                 ├─ index: [one_pk_two_idx.v1]\n" +
+			"             └─ IndexedTableAccess(mydb.one_pk_two_idx)\n" +
+			"                 ├─ index: one_pk_two_idx_1 [one_pk_two_idx.v1]\n" +
 			"                 ├─ static: [{[NULL, ∞)}]\n" +
 			"                 └─ columns: [v1]\n" +
 			"",
@@ -6334,7 +6673,7 @@ inner join pq on true
 			"     │   │   └─ l.v3:1\n" +
 			"     │   ├─ TableAlias(l)\n" +
 			"     │   │   └─ Table\n" +
-			"     │   │       ├─ name: one_pk_three_idx\n" +
+			"     │   │       ├─ name: mydb.one_pk_three_idx\n" +
 			"     │   │       └─ columns: [v2 v3]\n" +
 			"     │   └─ HashLookup\n" +
 			"     │       ├─ source: TUPLE(l.v3:1)\n" +
@@ -6342,7 +6681,7 @@ inner join pq on true
 			"     │       └─ CachedResults\n" +
 			"     │           └─ TableAlias(k)\n" +
 			"     │               └─ Table\n" +
-			"     │                   ├─ name: one_pk_two_idx\n" +
+			"     │                   ├─ name: mydb.one_pk_two_idx\n" +
 			"     │                   └─ columns: [v2]\n" +
 			"     └─ HashLookup\n" +
 			"         ├─ source: TUPLE(l.v2:0)\n" +
@@ -6354,7 +6693,7 @@ inner join pq on true
 			"                 │   └─ i.pk:7!null\n" +
 			"                 ├─ TableAlias(a)\n" +
 			"                 │   └─ Table\n" +
-			"                 │       ├─ name: one_pk_two_idx\n" +
+			"                 │       ├─ name: mydb.one_pk_two_idx\n" +
 			"                 │       └─ columns: [pk v1 v2]\n" +
 			"                 └─ HashLookup\n" +
 			"                     ├─ source: TUPLE(a.pk:3!null)\n" +
@@ -6366,11 +6705,11 @@ inner join pq on true
 			"                             │   └─ j.v3:6\n" +
 			"                             ├─ TableAlias(j)\n" +
 			"                             │   └─ Table\n" +
-			"                             │       ├─ name: one_pk_three_idx\n" +
+			"                             │       ├─ name: mydb.one_pk_three_idx\n" +
 			"                             │       └─ columns: [v3]\n" +
 			"                             └─ TableAlias(i)\n" +
-			"                                 └─ IndexedTableAccess(one_pk_two_idx)\n" +
-			"                                     ├─ index: [one_pk_two_idx.pk]\n" +
+			"                                 └─ IndexedTableAccess(mydb.one_pk_two_idx)\n" +
+			"                                     ├─ index: `PRIMARY` [one_pk_two_idx.pk]\n" +
 			"                                     └─ columns: [pk]\n" +
 			"",
 	},
@@ -6387,13 +6726,13 @@ inner join pq on true
 			"         │   ├─ b.i:0!null\n" +
 			"         │   └─ a.i:1!null\n" +
 			"         ├─ TableAlias(b)\n" +
-			"         │   └─ IndexedTableAccess(mytable)\n" +
-			"         │       ├─ index: [mytable.i]\n" +
+			"         │   └─ IndexedTableAccess(mydb.mytable)\n" +
+			"         │       ├─ index: `PRIMARY` [mytable.i]\n" +
 			"         │       ├─ static: [{[NULL, ∞)}]\n" +
 			"         │       └─ columns: [i]\n" +
 			"         └─ TableAlias(a)\n" +
-			"             └─ IndexedTableAccess(mytable)\n" +
-			"                 ├─ index: [mytable.i]\n" +
+			"             └─ IndexedTableAccess(mydb.mytable)\n" +
+			"                 ├─ index: `PRIMARY` [mytable.i]\n" +
 			"                 ├─ static: [{[NULL, ∞)}]\n" +
 			"                 └─ columns: [i s]\n" +
 			"",
@@ -6407,13 +6746,13 @@ inner join pq on true
 			"     │   ├─ b.i:0!null\n" +
 			"     │   └─ a.i:1!null\n" +
 			"     ├─ TableAlias(b)\n" +
-			"     │   └─ IndexedTableAccess(mytable)\n" +
-			"     │       ├─ index: [mytable.i]\n" +
+			"     │   └─ IndexedTableAccess(mydb.mytable)\n" +
+			"     │       ├─ index: `PRIMARY` [mytable.i]\n" +
 			"     │       ├─ static: [{[NULL, ∞)}]\n" +
 			"     │       └─ columns: [i]\n" +
 			"     └─ TableAlias(a)\n" +
-			"         └─ IndexedTableAccess(mytable)\n" +
-			"             ├─ index: [mytable.i]\n" +
+			"         └─ IndexedTableAccess(mydb.mytable)\n" +
+			"             ├─ index: `PRIMARY` [mytable.i]\n" +
 			"             ├─ static: [{[NULL, ∞)}]\n" +
 			"             └─ columns: [i s]\n" +
 			"",
@@ -6424,8 +6763,8 @@ inner join pq on true
 			" ├─ LessThan\n" +
 			" │   ├─ one_pk_three_idx.pk:0!null\n" +
 			" │   └─ 1 (tinyint)\n" +
-			" └─ IndexedTableAccess(one_pk_three_idx)\n" +
-			"     ├─ index: [one_pk_three_idx.v1,one_pk_three_idx.v2,one_pk_three_idx.v3]\n" +
+			" └─ IndexedTableAccess(mydb.one_pk_three_idx)\n" +
+			"     ├─ index: one_pk_three_idx_idx [one_pk_three_idx.v1,one_pk_three_idx.v2,one_pk_three_idx.v3]\n" +
 			"     ├─ static: [{[1, 1], [1, 1], [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -6436,8 +6775,8 @@ inner join pq on true
 			" ├─ Eq\n" +
 			" │   ├─ one_pk_three_idx.pk:0!null\n" +
 			" │   └─ 1 (tinyint)\n" +
-			" └─ IndexedTableAccess(one_pk_three_idx)\n" +
-			"     ├─ index: [one_pk_three_idx.v1,one_pk_three_idx.v2,one_pk_three_idx.v3]\n" +
+			" └─ IndexedTableAccess(mydb.one_pk_three_idx)\n" +
+			"     ├─ index: one_pk_three_idx_idx [one_pk_three_idx.v1,one_pk_three_idx.v2,one_pk_three_idx.v3]\n" +
 			"     ├─ static: [{[1, 1], [1, 1], [NULL, ∞)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
@@ -6451,13 +6790,13 @@ inner join pq on true
 			"     │   ├─ a.i:0!null\n" +
 			"     │   └─ b.i:2!null\n" +
 			"     ├─ TableAlias(a)\n" +
-			"     │   └─ IndexedTableAccess(mytable)\n" +
-			"     │       ├─ index: [mytable.i]\n" +
+			"     │   └─ IndexedTableAccess(mydb.mytable)\n" +
+			"     │       ├─ index: `PRIMARY` [mytable.i]\n" +
 			"     │       ├─ static: [{[NULL, ∞)}]\n" +
 			"     │       └─ columns: [i s]\n" +
 			"     └─ TableAlias(b)\n" +
-			"         └─ IndexedTableAccess(niltable)\n" +
-			"             ├─ index: [niltable.i]\n" +
+			"         └─ IndexedTableAccess(mydb.niltable)\n" +
+			"             ├─ index: `PRIMARY` [niltable.i]\n" +
 			"             ├─ static: [{[NULL, ∞)}]\n" +
 			"             └─ columns: [i i2 b f]\n" +
 			"",
@@ -6472,13 +6811,13 @@ inner join pq on true
 			"     │   ├─ a.i:0!null\n" +
 			"     │   └─ b.i:2!null\n" +
 			"     ├─ TableAlias(a)\n" +
-			"     │   └─ IndexedTableAccess(mytable)\n" +
-			"     │       ├─ index: [mytable.i]\n" +
+			"     │   └─ IndexedTableAccess(mydb.mytable)\n" +
+			"     │       ├─ index: `PRIMARY` [mytable.i]\n" +
 			"     │       ├─ static: [{[NULL, ∞)}]\n" +
 			"     │       └─ columns: [i s]\n" +
 			"     └─ TableAlias(b)\n" +
-			"         └─ IndexedTableAccess(niltable)\n" +
-			"             ├─ index: [niltable.i]\n" +
+			"         └─ IndexedTableAccess(mydb.niltable)\n" +
+			"             ├─ index: `PRIMARY` [niltable.i]\n" +
 			"             ├─ static: [{[NULL, ∞)}]\n" +
 			"             └─ columns: [i i2 b f]\n" +
 			"",
@@ -6495,13 +6834,13 @@ inner join pq on true
 			"     │   ├─ a.i:0!null\n" +
 			"     │   └─ b.i:2!null\n" +
 			"     ├─ TableAlias(a)\n" +
-			"     │   └─ IndexedTableAccess(mytable)\n" +
-			"     │       ├─ index: [mytable.i]\n" +
+			"     │   └─ IndexedTableAccess(mydb.mytable)\n" +
+			"     │       ├─ index: `PRIMARY` [mytable.i]\n" +
 			"     │       ├─ static: [{[NULL, ∞)}]\n" +
 			"     │       └─ columns: [i s]\n" +
 			"     └─ TableAlias(b)\n" +
-			"         └─ IndexedTableAccess(niltable)\n" +
-			"             ├─ index: [niltable.i]\n" +
+			"         └─ IndexedTableAccess(mydb.niltable)\n" +
+			"             ├─ index: `PRIMARY` [niltable.i]\n" +
 			"             ├─ static: [{[NULL, ∞)}]\n" +
 			"             └─ columns: [i i2 b f]\n" +
 			"",
@@ -6516,13 +6855,13 @@ inner join pq on true
 			"     │   ├─ a.i:0!null\n" +
 			"     │   └─ b.i:2!null\n" +
 			"     ├─ TableAlias(a)\n" +
-			"     │   └─ IndexedTableAccess(mytable)\n" +
-			"     │       ├─ index: [mytable.i]\n" +
+			"     │   └─ IndexedTableAccess(mydb.mytable)\n" +
+			"     │       ├─ index: `PRIMARY` [mytable.i]\n" +
 			"     │       ├─ static: [{[NULL, ∞)}]\n" +
 			"     │       └─ columns: [i s]\n" +
 			"     └─ TableAlias(b)\n" +
-			"         └─ IndexedTableAccess(niltable)\n" +
-			"             ├─ index: [niltable.i]\n" +
+			"         └─ IndexedTableAccess(mydb.niltable)\n" +
+			"             ├─ index: `PRIMARY` [niltable.i]\n" +
 			"             ├─ static: [{[NULL, ∞)}]\n" +
 			"             └─ columns: [i i2 b f]\n" +
 			"",
@@ -6536,7 +6875,7 @@ inner join pq on true
 			" │       └─ b.i:2!null\n" +
 			" ├─ TableAlias(a)\n" +
 			" │   └─ Table\n" +
-			" │       ├─ name: mytable\n" +
+			" │       ├─ name: mydb.mytable\n" +
 			" │       └─ columns: [i s]\n" +
 			" └─ Filter\n" +
 			"     ├─ NOT\n" +
@@ -6545,7 +6884,7 @@ inner join pq on true
 			"     │       └─ 0 (tinyint)\n" +
 			"     └─ TableAlias(b)\n" +
 			"         └─ Table\n" +
-			"             ├─ name: niltable\n" +
+			"             ├─ name: mydb.niltable\n" +
 			"             └─ columns: [i i2 b f]\n" +
 			"",
 	},
@@ -6558,11 +6897,11 @@ inner join pq on true
 			" │       └─ b.i:2!null\n" +
 			" ├─ TableAlias(a)\n" +
 			" │   └─ Table\n" +
-			" │       ├─ name: mytable\n" +
+			" │       ├─ name: mydb.mytable\n" +
 			" │       └─ columns: [i s]\n" +
 			" └─ TableAlias(b)\n" +
 			"     └─ Table\n" +
-			"         ├─ name: niltable\n" +
+			"         ├─ name: mydb.niltable\n" +
 			"         └─ columns: [i i2 b f]\n" +
 			"",
 	},
@@ -6582,7 +6921,7 @@ inner join pq on true
 			"             │   └─ scalarSubq0.1:0!null\n" +
 			"             ├─ Distinct\n" +
 			"             │   └─ SubqueryAlias\n" +
-			"             │       ├─ name: scalarSubq0\n" +
+			"             │       ├─ name: `scalarSubq0`\n" +
 			"             │       ├─ outerVisibility: false\n" +
 			"             │       ├─ cacheable: true\n" +
 			"             │       └─ SubqueryAlias\n" +
@@ -6618,22 +6957,21 @@ inner join pq on true
 			"     │   │   ├─ t1.pk:0!null\n" +
 			"     │   │   └─ 1 (tinyint)\n" +
 			"     │   └─ TableAlias(t1)\n" +
-			"     │       └─ IndexedTableAccess(one_pk)\n" +
-			"     │           ├─ index: [one_pk.pk]\n" +
+			"     │       └─ IndexedTableAccess(mydb.one_pk)\n" +
+			"     │           ├─ index: `PRIMARY` [one_pk.pk]\n" +
 			"     │           ├─ static: [{[1, 1]}]\n" +
 			"     │           └─ columns: [pk]\n" +
 			"     └─ Filter\n" +
-			"         ├─ AND\n" +
-			"         │   ├─ Eq\n" +
-			"         │   │   ├─ t2.pk2:1!null\n" +
-			"         │   │   └─ 1 (tinyint)\n" +
-			"         │   └─ Eq\n" +
-			"         │       ├─ t2.pk1:0!null\n" +
-			"         │       └─ 1 (tinyint)\n" +
+			"         ├─ Eq\n" +
+			"         │   ├─ t2.pk1:0!null\n" +
+			"         │   └─ 1 (tinyint)\n" +
 			"         └─ TableAlias(t2)\n" +
-			"             └─ IndexedTableAccess(two_pk)\n" +
-			"                 ├─ index: [two_pk.pk1,two_pk.pk2]\n" +
+			"             └─ IndexedTableAccess(mydb.two_pk)\n" +
+			"                 ├─ index: `PRIMARY` [two_pk.pk1,two_pk.pk2]\n" +
 			"                 ├─ static: [{[1, 1], [NULL, ∞)}]\n" +
+			"                 ├─ index condition: Eq\n" +
+			"                 │   ├─ t2.pk2:1!null\n" +
+			"                 │   └─ 1 (tinyint)\n" +
 			"                 └─ columns: [pk1 pk2]\n" +
 			"",
 	},
@@ -6800,7 +7138,7 @@ inner join pq on true
 	{
 		Query: `with recursive a(x) as (select 1 union select 2) select * from a union select * from a order by x desc;`,
 		ExpectedPlan: "Union distinct\n" +
-			" ├─ sortFields: [a.x]\n" +
+			" ├─ sortFields: [x]\n" +
 			" ├─ SubqueryAlias\n" +
 			" │   ├─ name: a\n" +
 			" │   ├─ outerVisibility: false\n" +
@@ -6971,7 +7309,7 @@ inner join pq on true
 			"             │   └─ scalarSubq0.1:0!null\n" +
 			"             ├─ Distinct\n" +
 			"             │   └─ SubqueryAlias\n" +
-			"             │       ├─ name: scalarSubq0\n" +
+			"             │       ├─ name: `scalarSubq0`\n" +
 			"             │       ├─ outerVisibility: false\n" +
 			"             │       ├─ cacheable: true\n" +
 			"             │       └─ SubqueryAlias\n" +
@@ -7296,7 +7634,7 @@ inner join pq on true
 	{
 		Query: `with a(j) as (select 1), b(i) as (select 2) (select j from a union select i from b order by j desc limit 1) union select j from a;`,
 		ExpectedPlan: "Union distinct\n" +
-			" ├─ sortFields: [a.j]\n" +
+			" ├─ sortFields: [j]\n" +
 			" ├─ limit: 1\n" +
 			" ├─ Union distinct\n" +
 			" │   ├─ SubqueryAlias\n" +
@@ -7331,7 +7669,7 @@ inner join pq on true
 	{
 		Query: `with a(j) as (select 1), b(i) as (select 2) (select j from a union select i from b order by 1 limit 1) union select j from a;`,
 		ExpectedPlan: "Union distinct\n" +
-			" ├─ sortFields: [1]\n" +
+			" ├─ sortFields: [j]\n" +
 			" ├─ limit: 1\n" +
 			" ├─ Union distinct\n" +
 			" │   ├─ SubqueryAlias\n" +
@@ -7439,8 +7777,8 @@ With c as (
 			"             │       │   ├─ t1.i:0!null\n" +
 			"             │       │   └─ TUPLE(2 (tinyint), 3 (tinyint))\n" +
 			"             │       └─ TableAlias(t1)\n" +
-			"             │           └─ IndexedTableAccess(mytable)\n" +
-			"             │               ├─ index: [mytable.i]\n" +
+			"             │           └─ IndexedTableAccess(mydb.mytable)\n" +
+			"             │               ├─ index: `PRIMARY` [mytable.i]\n" +
 			"             │               ├─ static: [{[2, 2]}, {[3, 3]}]\n" +
 			"             │               └─ columns: [i s]\n" +
 			"             └─ HashLookup\n" +
@@ -7460,8 +7798,8 @@ With c as (
 			"                         │       │   ├─ t2.i:0!null\n" +
 			"                         │       │   └─ TUPLE(1 (tinyint), 2 (tinyint))\n" +
 			"                         │       └─ TableAlias(t2)\n" +
-			"                         │           └─ IndexedTableAccess(mytable)\n" +
-			"                         │               ├─ index: [mytable.i]\n" +
+			"                         │           └─ IndexedTableAccess(mydb.mytable)\n" +
+			"                         │               ├─ index: `PRIMARY` [mytable.i]\n" +
 			"                         │               ├─ static: [{[1, 1]}, {[2, 2]}]\n" +
 			"                         │               └─ columns: [i s]\n" +
 			"                         └─ HashLookup\n" +
@@ -7470,7 +7808,7 @@ With c as (
 			"                             └─ CachedResults\n" +
 			"                                 └─ TableAlias(a)\n" +
 			"                                     └─ Table\n" +
-			"                                         ├─ name: mytable\n" +
+			"                                         ├─ name: mydb.mytable\n" +
 			"                                         └─ columns: [i s]\n" +
 			"",
 	},
@@ -7486,7 +7824,7 @@ With c as (
 			"     │   └─ 3 (tinyint)\n" +
 			"     └─ Limit(1)\n" +
 			"         └─ Table\n" +
-			"             ├─ name: mytable\n" +
+			"             ├─ name: mydb.mytable\n" +
 			"             └─ columns: [i]\n" +
 			"",
 	},
@@ -7506,7 +7844,7 @@ With c as (
 			"         │   └─ 3 (tinyint)\n" +
 			"         └─ Limit(1)\n" +
 			"             └─ Table\n" +
-			"                 ├─ name: mytable\n" +
+			"                 ├─ name: mydb.mytable\n" +
 			"                 └─ columns: [i]\n" +
 			"",
 	},
@@ -7521,10 +7859,11 @@ With c as (
 			"     │   ├─ mytable.i:0!null\n" +
 			"     │   └─ 3 (tinyint)\n" +
 			"     └─ Limit(1)\n" +
-			"         └─ TopN(Limit: [1 (tinyint)]; mytable.i:0!null DESC nullsFirst)\n" +
-			"             └─ Table\n" +
-			"                 ├─ name: mytable\n" +
-			"                 └─ columns: [i]\n" +
+			"         └─ IndexedTableAccess(mydb.mytable)\n" +
+			"             ├─ index: `PRIMARY` [mytable.i]\n" +
+			"             ├─ static: [{[NULL, ∞)}]\n" +
+			"             ├─ reverse: true\n" +
+			"             └─ columns: [i]\n" +
 			"",
 	},
 	{
@@ -7542,10 +7881,11 @@ With c as (
 			"         │   ├─ mytable.i:0!null\n" +
 			"         │   └─ 3 (tinyint)\n" +
 			"         └─ Limit(1)\n" +
-			"             └─ TopN(Limit: [1 (tinyint)]; mytable.i:0!null DESC nullsFirst)\n" +
-			"                 └─ Table\n" +
-			"                     ├─ name: mytable\n" +
-			"                     └─ columns: [i]\n" +
+			"             └─ IndexedTableAccess(mydb.mytable)\n" +
+			"                 ├─ index: `PRIMARY` [mytable.i]\n" +
+			"                 ├─ static: [{[NULL, ∞)}]\n" +
+			"                 ├─ reverse: true\n" +
+			"                 └─ columns: [i]\n" +
 			"",
 	},
 	{
@@ -7555,8 +7895,8 @@ With c as (
 			"     ├─ name: sq\n" +
 			"     ├─ outerVisibility: false\n" +
 			"     ├─ cacheable: true\n" +
-			"     └─ IndexedTableAccess(mytable)\n" +
-			"         ├─ index: [mytable.i]\n" +
+			"     └─ IndexedTableAccess(mydb.mytable)\n" +
+			"         ├─ index: `PRIMARY` [mytable.i]\n" +
 			"         ├─ static: [{(1, ∞)}]\n" +
 			"         └─ columns: [i]\n" +
 			"",
@@ -7572,8 +7912,8 @@ With c as (
 			"         ├─ name: sq1\n" +
 			"         ├─ outerVisibility: false\n" +
 			"         ├─ cacheable: true\n" +
-			"         └─ IndexedTableAccess(mytable)\n" +
-			"             ├─ index: [mytable.i]\n" +
+			"         └─ IndexedTableAccess(mydb.mytable)\n" +
+			"             ├─ index: `PRIMARY` [mytable.i]\n" +
 			"             ├─ static: [{(1, ∞)}]\n" +
 			"             └─ columns: [i]\n" +
 			"",
@@ -7589,8 +7929,8 @@ With c as (
 			"         ├─ name: sq1\n" +
 			"         ├─ outerVisibility: false\n" +
 			"         ├─ cacheable: true\n" +
-			"         └─ IndexedTableAccess(mytable)\n" +
-			"             ├─ index: [mytable.i]\n" +
+			"         └─ IndexedTableAccess(mydb.mytable)\n" +
+			"             ├─ index: `PRIMARY` [mytable.i]\n" +
 			"             ├─ static: [{(1, ∞)}]\n" +
 			"             └─ columns: [i]\n" +
 			"",
@@ -7612,7 +7952,7 @@ With c as (
 			"             │   └─ 1 (tinyint)\n" +
 			"             └─ Limit(1)\n" +
 			"                 └─ Table\n" +
-			"                     ├─ name: mytable\n" +
+			"                     ├─ name: mydb.mytable\n" +
 			"                     └─ columns: [i]\n" +
 			"",
 	},
@@ -7632,11 +7972,11 @@ With c as (
 			"             └─ CrossJoin\n" +
 			"                 ├─ TableAlias(a)\n" +
 			"                 │   └─ Table\n" +
-			"                 │       ├─ name: one_pk\n" +
+			"                 │       ├─ name: mydb.one_pk\n" +
 			"                 │       └─ columns: [pk]\n" +
 			"                 └─ TableAlias(b)\n" +
 			"                     └─ Table\n" +
-			"                         ├─ name: mytable\n" +
+			"                         ├─ name: mydb.mytable\n" +
 			"                         └─ columns: [i]\n" +
 			"",
 	},
@@ -7656,11 +7996,11 @@ With c as (
 			"             └─ CrossJoin\n" +
 			"                 ├─ TableAlias(a)\n" +
 			"                 │   └─ Table\n" +
-			"                 │       ├─ name: one_pk\n" +
+			"                 │       ├─ name: mydb.one_pk\n" +
 			"                 │       └─ columns: [pk]\n" +
 			"                 └─ TableAlias(b)\n" +
 			"                     └─ Table\n" +
-			"                         ├─ name: mytable\n" +
+			"                         ├─ name: mydb.mytable\n" +
 			"                         └─ columns: [i]\n" +
 			"",
 	},
@@ -7675,23 +8015,226 @@ With c as (
 			" │       ├─ GreaterThanOrEqual\n" +
 			" │       │   ├─ one_pk.pk:0!null\n" +
 			" │       │   └─ 2 (tinyint)\n" +
-			" │       └─ Limit(1)\n" +
-			" │           └─ Filter\n" +
-			" │               ├─ LessThan\n" +
-			" │               │   ├─ one_pk.pk:0!null\n" +
-			" │               │   └─ 2 (tinyint)\n" +
-			" │               └─ Table\n" +
-			" │                   ├─ name: one_pk\n" +
-			" │                   └─ columns: [pk]\n" +
-			" └─ SubqueryAlias\n" +
-			"     ├─ name: b\n" +
-			"     ├─ outerVisibility: false\n" +
-			"     ├─ cacheable: true\n" +
-			"     └─ Limit(1)\n" +
-			"         └─ IndexedTableAccess(mytable)\n" +
-			"             ├─ index: [mytable.i]\n" +
-			"             ├─ static: [{(1, ∞)}]\n" +
-			"             └─ columns: [i]\n" +
+			" │       └─ Limit(1)\n" +
+			" │           └─ Filter\n" +
+			" │               ├─ LessThan\n" +
+			" │               │   ├─ one_pk.pk:0!null\n" +
+			" │               │   └─ 2 (tinyint)\n" +
+			" │               └─ Table\n" +
+			" │                   ├─ name: mydb.one_pk\n" +
+			" │                   └─ columns: [pk]\n" +
+			" └─ SubqueryAlias\n" +
+			"     ├─ name: b\n" +
+			"     ├─ outerVisibility: false\n" +
+			"     ├─ cacheable: true\n" +
+			"     └─ Limit(1)\n" +
+			"         └─ IndexedTableAccess(mydb.mytable)\n" +
+			"             ├─ index: `PRIMARY` [mytable.i]\n" +
+			"             ├─ static: [{(1, ∞)}]\n" +
+			"             └─ columns: [i]\n" +
+			"",
+	},
+	{
+		Query: `select y, (select max(u) from uv where v = y) as m from xy group by y`,
+		ExpectedPlan: "GroupBy\n" +
+			" ├─ select: xy.y:1, scalarSubq0.MAX(uv.u):3 as m\n" +
+			" ├─ group: xy.y:1, scalarSubq0.MAX(uv.u):3\n" +
+			" └─ LeftOuterHashJoin\n" +
+			"     ├─ Eq\n" +
+			"     │   ├─ xy.y:1\n" +
+			"     │   └─ scalarSubq0.corr0:2\n" +
+			"     ├─ Table\n" +
+			"     │   ├─ name: mydb.xy\n" +
+			"     │   └─ columns: [x y]\n" +
+			"     └─ HashLookup\n" +
+			"         ├─ source: TUPLE(xy.y:1)\n" +
+			"         ├─ target: TUPLE(scalarSubq0.corr0:0)\n" +
+			"         └─ CachedResults\n" +
+			"             └─ SubqueryAlias\n" +
+			"                 ├─ name: `scalarSubq0`\n" +
+			"                 ├─ outerVisibility: false\n" +
+			"                 ├─ cacheable: true\n" +
+			"                 └─ GroupBy\n" +
+			"                     ├─ select: uv.v:1 as corr0, MAX(uv.u:0!null)\n" +
+			"                     ├─ group: uv.v:1\n" +
+			"                     └─ Table\n" +
+			"                         ├─ name: mydb.uv\n" +
+			"                         └─ columns: [u v]\n" +
+			"",
+	},
+	{
+		Query: `select y+0 g, (select max(u) from uv where v = y) m from xy group by y order by y`,
+		ExpectedPlan: "GroupBy\n" +
+			" ├─ select: (xy.y:1 + 0 (tinyint)) as g, scalarSubq0.MAX(uv.u):3 as m\n" +
+			" ├─ group: xy.y:1, scalarSubq0.MAX(uv.u):3\n" +
+			" └─ Sort(xy.y:1 ASC nullsFirst)\n" +
+			"     └─ LeftOuterHashJoin\n" +
+			"         ├─ Eq\n" +
+			"         │   ├─ xy.y:1\n" +
+			"         │   └─ scalarSubq0.corr0:2\n" +
+			"         ├─ Table\n" +
+			"         │   ├─ name: mydb.xy\n" +
+			"         │   └─ columns: [x y]\n" +
+			"         └─ HashLookup\n" +
+			"             ├─ source: TUPLE(xy.y:1)\n" +
+			"             ├─ target: TUPLE(scalarSubq0.corr0:0)\n" +
+			"             └─ CachedResults\n" +
+			"                 └─ SubqueryAlias\n" +
+			"                     ├─ name: `scalarSubq0`\n" +
+			"                     ├─ outerVisibility: false\n" +
+			"                     ├─ cacheable: true\n" +
+			"                     └─ GroupBy\n" +
+			"                         ├─ select: uv.v:1 as corr0, MAX(uv.u:0!null)\n" +
+			"                         ├─ group: uv.v:1\n" +
+			"                         └─ Table\n" +
+			"                             ├─ name: mydb.uv\n" +
+			"                             └─ columns: [u v]\n" +
+			"",
+	},
+	{
+		Query: `select x, (select count(*) from uv where v = x) as c from xy group by x`,
+		ExpectedPlan: "GroupBy\n" +
+			" ├─ select: xy.x:0!null, Subquery\n" +
+			" │   ├─ cacheable: false\n" +
+			" │   └─ Project\n" +
+			" │       ├─ columns: [COUNT(1):2!null as count(*)]\n" +
+			" │       └─ GroupBy\n" +
+			" │           ├─ select: COUNT(1 (bigint))\n" +
+			" │           ├─ group: \n" +
+			" │           └─ Filter\n" +
+			" │               ├─ Eq\n" +
+			" │               │   ├─ uv.v:2\n" +
+			" │               │   └─ xy.x:0!null\n" +
+			" │               └─ Table\n" +
+			" │                   ├─ name: mydb.uv\n" +
+			" │                   └─ columns: [v]\n" +
+			" │   as c\n" +
+			" ├─ group: xy.x:0!null\n" +
+			" └─ Table\n" +
+			"     ├─ name: mydb.xy\n" +
+			"     └─ columns: [x y]\n" +
+			"",
+	},
+	{
+		Query: `select * from mytable where i = 1 or s = 'third row'`,
+		ExpectedPlan: "Filter\n" +
+			" ├─ Or\n" +
+			" │   ├─ Eq\n" +
+			" │   │   ├─ mytable.i:0!null\n" +
+			" │   │   └─ 1 (tinyint)\n" +
+			" │   └─ Eq\n" +
+			" │       ├─ mytable.s:1!null\n" +
+			" │       └─ third row (longtext)\n" +
+			" └─ IndexMerge(union)\n" +
+			"     ├─ IndexedTableAccess(mydb.mytable)\n" +
+			"     │   ├─ index: `PRIMARY` [mytable.i]\n" +
+			"     │   ├─ static: [{[1, 1]}]\n" +
+			"     │   └─ columns: [i s]\n" +
+			"     └─ IndexedTableAccess(mydb.mytable)\n" +
+			"         ├─ index: mytable_s [mytable.s]\n" +
+			"         ├─ static: [{[third row, third row]}]\n" +
+			"         └─ columns: [i s]\n" +
+			"",
+	},
+	{
+		Query: `select a.* from mytable a where a.i = 2 or a.s = 'first row' order by a.i`,
+		ExpectedPlan: "Sort(a.i:0!null ASC nullsFirst)\n" +
+			" └─ Filter\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ Eq\n" +
+			"     │   │   ├─ a.i:0!null\n" +
+			"     │   │   └─ 2 (tinyint)\n" +
+			"     │   └─ Eq\n" +
+			"     │       ├─ a.s:1!null\n" +
+			"     │       └─ first row (longtext)\n" +
+			"     └─ TableAlias(a)\n" +
+			"         └─ IndexMerge(union)\n" +
+			"             ├─ IndexedTableAccess(mydb.mytable)\n" +
+			"             │   ├─ index: `PRIMARY` [mytable.i]\n" +
+			"             │   ├─ static: [{[2, 2]}]\n" +
+			"             │   └─ columns: [i s]\n" +
+			"             └─ IndexedTableAccess(mydb.mytable)\n" +
+			"                 ├─ index: mytable_s [mytable.s]\n" +
+			"                 ├─ static: [{[first row, first row]}]\n" +
+			"                 └─ columns: [i s]\n" +
+			"",
+	},
+	{
+		Query: `select * from othertable where i2 = 1 or s2 = 'first' or s2 = 'second'`,
+		ExpectedPlan: "Filter\n" +
+			" ├─ Or\n" +
+			" │   ├─ Or\n" +
+			" │   │   ├─ Eq\n" +
+			" │   │   │   ├─ othertable.i2:1!null\n" +
+			" │   │   │   └─ 1 (tinyint)\n" +
+			" │   │   └─ Eq\n" +
+			" │   │       ├─ othertable.s2:0!null\n" +
+			" │   │       └─ first (longtext)\n" +
+			" │   └─ Eq\n" +
+			" │       ├─ othertable.s2:0!null\n" +
+			" │       └─ second (longtext)\n" +
+			" └─ IndexMerge(union)\n" +
+			"     ├─ IndexedTableAccess(mydb.othertable)\n" +
+			"     │   ├─ index: `PRIMARY` [othertable.i2]\n" +
+			"     │   ├─ static: [{[1, 1]}]\n" +
+			"     │   └─ columns: [s2 i2]\n" +
+			"     ├─ IndexedTableAccess(mydb.othertable)\n" +
+			"     │   ├─ index: othertable_s2 [othertable.s2]\n" +
+			"     │   ├─ static: [{[first, first]}]\n" +
+			"     │   └─ columns: [s2 i2]\n" +
+			"     └─ IndexedTableAccess(mydb.othertable)\n" +
+			"         ├─ index: othertable_s2 [othertable.s2]\n" +
+			"         ├─ static: [{[second, second]}]\n" +
+			"         └─ columns: [s2 i2]\n" +
+			"",
+	},
+	{
+		Query: `select * from mytable where i > 0 and (i = 1 or s = 'third row')`,
+		ExpectedPlan: "Filter\n" +
+			" ├─ Or\n" +
+			" │   ├─ Eq\n" +
+			" │   │   ├─ mytable.i:0!null\n" +
+			" │   │   └─ 1 (tinyint)\n" +
+			" │   └─ Eq\n" +
+			" │       ├─ mytable.s:1!null\n" +
+			" │       └─ third row (longtext)\n" +
+			" └─ IndexedTableAccess(mydb.mytable)\n" +
+			"     ├─ index: `PRIMARY` [mytable.i]\n" +
+			"     ├─ static: [{(0, ∞)}]\n" +
+			"     └─ columns: [i s]\n" +
+			"",
+	},
+	{
+		Query: `select * from niltable where not (i2 <=> 2)`,
+		ExpectedPlan: "Filter\n" +
+			" ├─ NOT\n" +
+			" │   └─ (niltable.i2:1 <=> 2 (tinyint))\n" +
+			" └─ IndexedTableAccess(mydb.niltable)\n" +
+			"     ├─ index: niltable_i2 [niltable.i2]\n" +
+			"     ├─ static: [{(2, ∞)}, {[NULL, 2)}]\n" +
+			"     └─ columns: [i i2 b f]\n" +
+			"",
+	},
+	{
+		Query: `select * from mytable where not (i < 2 and s > 'first row')`,
+		ExpectedPlan: "Filter\n" +
+			" ├─ NOT\n" +
+			" │   └─ AND\n" +
+			" │       ├─ LessThan\n" +
+			" │       │   ├─ mytable.i:0!null\n" +
+			" │       │   └─ 2 (tinyint)\n" +
+			" │       └─ GreaterThan\n" +
+			" │           ├─ mytable.s:1!null\n" +
+			" │           └─ first row (longtext)\n" +
+			" └─ IndexMerge(union)\n" +
+			"     ├─ IndexedTableAccess(mydb.mytable)\n" +
+			"     │   ├─ index: `PRIMARY` [mytable.i]\n" +
+			"     │   ├─ static: [{[2, ∞)}]\n" +
+			"     │   └─ columns: [i s]\n" +
+			"     └─ IndexedTableAccess(mydb.mytable)\n" +
+			"         ├─ index: mytable_s [mytable.s]\n" +
+			"         ├─ static: [{(NULL, first row]}]\n" +
+			"         └─ columns: [i s]\n" +
 			"",
 	},
 }
@@ -7733,11 +8276,11 @@ WHERE
 			"     │   ├─ YK2GW.id:0!null\n" +
 			"     │   └─ scalarSubq0.IXUXU:30\n" +
 			"     ├─ Table\n" +
-			"     │   ├─ name: YK2GW\n" +
+			"     │   ├─ name: mydb.`YK2GW`\n" +
 			"     │   └─ columns: [id ftqlq tuxml paef5 rucy4 tpnj6 lbl53 nb3qs eo7iv muhjf fm34l ty5rf zhtlh npb7w sx3hh isbnf ya7yb c5ykb qk7kt ffge6 fiigj sh3nc ntena m4aub x5air sab6m g5qi5 zvqvd ykssu fhcyt]\n" +
-			"     └─ TableAlias(scalarSubq0)\n" +
-			"         └─ IndexedTableAccess(THNTS)\n" +
-			"             ├─ index: [THNTS.IXUXU]\n" +
+			"     └─ TableAlias(`scalarSubq0`)\n" +
+			"         └─ IndexedTableAccess(mydb.`THNTS`)\n" +
+			"             ├─ index: `IXUXU` [THNTS.IXUXU]\n" +
 			"             └─ columns: [ixuxu]\n" +
 			"",
 	},
@@ -7785,7 +8328,7 @@ WHERE
 			"     │   ├─ PBMRX.ZH72S:6\n" +
 			"     │   └─ CL3DT.ZH72S:0\n" +
 			"     ├─ SubqueryAlias\n" +
-			"     │   ├─ name: CL3DT\n" +
+			"     │   ├─ name: `CL3DT`\n" +
 			"     │   ├─ outerVisibility: false\n" +
 			"     │   ├─ cacheable: true\n" +
 			"     │   └─ Filter\n" +
@@ -7808,7 +8351,7 @@ WHERE
 			"     │                   └─ Project\n" +
 			"     │                       ├─ columns: [CCEFL.ZH72S:1 as ZH72S, CCEFL.WGBRL:2, CCEFL.ZH72S:1]\n" +
 			"     │                       └─ SubqueryAlias\n" +
-			"     │                           ├─ name: CCEFL\n" +
+			"     │                           ├─ name: `CCEFL`\n" +
 			"     │                           ├─ outerVisibility: false\n" +
 			"     │                           ├─ cacheable: true\n" +
 			"     │                           └─ Project\n" +
@@ -7824,23 +8367,23 @@ WHERE
 			"     │                               │               │   ├─ HDDVB.UJ6XY:17!null\n" +
 			"     │                               │               │   └─ nd.id:0!null\n" +
 			"     │                               │               └─ Table\n" +
-			"     │                               │                   ├─ name: HDDVB\n" +
+			"     │                               │                   ├─ name: mydb.`HDDVB`\n" +
 			"     │                               │                   └─ columns: [uj6xy]\n" +
 			"     │                               │   as WGBRL]\n" +
 			"     │                               └─ Filter\n" +
 			"     │                                   ├─ NOT\n" +
 			"     │                                   │   └─ nd.ZH72S:7 IS NULL\n" +
 			"     │                                   └─ TableAlias(nd)\n" +
-			"     │                                       └─ IndexedTableAccess(E2I7U)\n" +
-			"     │                                           ├─ index: [E2I7U.ZH72S]\n" +
+			"     │                                       └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			"     │                                           ├─ index: `URBSA` [E2I7U.ZH72S]\n" +
 			"     │                                           ├─ static: [{(NULL, ∞)}]\n" +
 			"     │                                           └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
 			"     └─ Filter\n" +
 			"         ├─ NOT\n" +
 			"         │   └─ PBMRX.ZH72S:2 IS NULL\n" +
-			"         └─ TableAlias(PBMRX)\n" +
-			"             └─ IndexedTableAccess(E2I7U)\n" +
-			"                 ├─ index: [E2I7U.ZH72S]\n" +
+			"         └─ TableAlias(`PBMRX`)\n" +
+			"             └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			"                 ├─ index: `URBSA` [E2I7U.ZH72S]\n" +
 			"                 └─ columns: [id tw55n zh72s]\n" +
 			"",
 	},
@@ -7920,9 +8463,9 @@ WHERE
 			" │   │       │   │           ├─ Eq\n" +
 			" │   │       │   │           │   ├─ NHMXW.id:9!null\n" +
 			" │   │       │   │           │   └─ ism.PRUV2:6\n" +
-			" │   │       │   │           └─ TableAlias(NHMXW)\n" +
+			" │   │       │   │           └─ TableAlias(`NHMXW`)\n" +
 			" │   │       │   │               └─ Table\n" +
-			" │   │       │   │                   ├─ name: WGSDC\n" +
+			" │   │       │   │                   ├─ name: mydb.`WGSDC`\n" +
 			" │   │       │   │                   └─ columns: [id swcqv]\n" +
 			" │   │       │   └─ 1 (tinyint)\n" +
 			" │   │       └─ Or\n" +
@@ -7946,13 +8489,13 @@ WHERE
 			" │   │           │           │           │               ├─ Eq\n" +
 			" │   │           │           │           │               │   ├─ NHMXW.id:26!null\n" +
 			" │   │           │           │           │               │   └─ ism.PRUV2:6\n" +
-			" │   │           │           │           │               └─ TableAlias(NHMXW)\n" +
+			" │   │           │           │           │               └─ TableAlias(`NHMXW`)\n" +
 			" │   │           │           │           │                   └─ Table\n" +
-			" │   │           │           │           │                       ├─ name: WGSDC\n" +
+			" │   │           │           │           │                       ├─ name: mydb.`WGSDC`\n" +
 			" │   │           │           │           │                       └─ columns: [id fzxv5]\n" +
 			" │   │           │           │           └─ TableAlias(nd)\n" +
 			" │   │           │           │               └─ Table\n" +
-			" │   │           │           │                   ├─ name: E2I7U\n" +
+			" │   │           │           │                   ├─ name: mydb.`E2I7U`\n" +
 			" │   │           │           │                   └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
 			" │   │           │           └─ ism.FV24E:1!null\n" +
 			" │   │           └─ AND\n" +
@@ -7975,13 +8518,13 @@ WHERE
 			" │   │                       │           │               ├─ Eq\n" +
 			" │   │                       │           │               │   ├─ NHMXW.id:26!null\n" +
 			" │   │                       │           │               │   └─ ism.PRUV2:6\n" +
-			" │   │                       │           │               └─ TableAlias(NHMXW)\n" +
+			" │   │                       │           │               └─ TableAlias(`NHMXW`)\n" +
 			" │   │                       │           │                   └─ Table\n" +
-			" │   │                       │           │                       ├─ name: WGSDC\n" +
+			" │   │                       │           │                       ├─ name: mydb.`WGSDC`\n" +
 			" │   │                       │           │                       └─ columns: [id dqygv]\n" +
 			" │   │                       │           └─ TableAlias(nd)\n" +
 			" │   │                       │               └─ Table\n" +
-			" │   │                       │                   ├─ name: E2I7U\n" +
+			" │   │                       │                   ├─ name: mydb.`E2I7U`\n" +
 			" │   │                       │                   └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
 			" │   │                       └─ ism.UJ6XY:2!null\n" +
 			" │   └─ AND\n" +
@@ -8002,8 +8545,8 @@ WHERE
 			" │                       │           └─ Filter\n" +
 			" │                       │               ├─ NOT\n" +
 			" │                       │               │   └─ HDDVB.PRUV2:29 IS NULL\n" +
-			" │                       │               └─ IndexedTableAccess(HDDVB)\n" +
-			" │                       │                   ├─ index: [HDDVB.PRUV2]\n" +
+			" │                       │               └─ IndexedTableAccess(mydb.`HDDVB`)\n" +
+			" │                       │                   ├─ index: `PRUV2` [HDDVB.PRUV2]\n" +
 			" │                       │                   ├─ static: [{(NULL, ∞)}]\n" +
 			" │                       │                   └─ columns: [pruv2]\n" +
 			" │                       └─ LookupJoin\n" +
@@ -8026,17 +8569,17 @@ WHERE
 			" │                           │   ├─ Eq\n" +
 			" │                           │   │   ├─ NHMXW.SWCQV:16!null\n" +
 			" │                           │   │   └─ 0 (tinyint)\n" +
-			" │                           │   └─ TableAlias(NHMXW)\n" +
+			" │                           │   └─ TableAlias(`NHMXW`)\n" +
 			" │                           │       └─ Table\n" +
-			" │                           │           ├─ name: WGSDC\n" +
+			" │                           │           ├─ name: mydb.`WGSDC`\n" +
 			" │                           │           └─ columns: [id nohhr avpyf sypkf idut2 fzxv5 dqygv swcqv ykssu fhcyt]\n" +
-			" │                           └─ TableAlias(TIZHK)\n" +
-			" │                               └─ IndexedTableAccess(WRZVO)\n" +
-			" │                                   ├─ index: [WRZVO.TVNW2]\n" +
+			" │                           └─ TableAlias(`TIZHK`)\n" +
+			" │                               └─ IndexedTableAccess(mydb.`WRZVO`)\n" +
+			" │                                   ├─ index: `GAG3S` [WRZVO.TVNW2]\n" +
 			" │                                   └─ columns: [id tvnw2 zhity sypkf idut2 o6qj3 no2ja ykssu fhcyt qz6vt]\n" +
 			" └─ TableAlias(ism)\n" +
 			"     └─ Table\n" +
-			"         ├─ name: HDDVB\n" +
+			"         ├─ name: mydb.`HDDVB`\n" +
 			"         └─ columns: [id fv24e uj6xy m22qn nz4mq etpqv pruv2 ykssu fhcyt]\n" +
 			"",
 	},
@@ -8080,7 +8623,7 @@ WHERE
 			" │   │   └─ scalarSubq0.id:0!null\n" +
 			" │   ├─ Distinct\n" +
 			" │   │   └─ SubqueryAlias\n" +
-			" │   │       ├─ name: scalarSubq0\n" +
+			" │   │       ├─ name: `scalarSubq0`\n" +
 			" │   │       ├─ outerVisibility: false\n" +
 			" │   │       ├─ cacheable: true\n" +
 			" │   │       └─ Distinct\n" +
@@ -8106,33 +8649,33 @@ WHERE
 			" │   │                       │   │   │   ├─ Eq\n" +
 			" │   │                       │   │   │   │   ├─ J4JYP.ZH72S:7\n" +
 			" │   │                       │   │   │   │   └─ TIZHK.TVNW2:18\n" +
-			" │   │                       │   │   │   ├─ TableAlias(J4JYP)\n" +
+			" │   │                       │   │   │   ├─ TableAlias(`J4JYP`)\n" +
 			" │   │                       │   │   │   │   └─ Table\n" +
-			" │   │                       │   │   │   │       ├─ name: E2I7U\n" +
+			" │   │                       │   │   │   │       ├─ name: mydb.`E2I7U`\n" +
 			" │   │                       │   │   │   │       └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
-			" │   │                       │   │   │   └─ TableAlias(TIZHK)\n" +
-			" │   │                       │   │   │       └─ IndexedTableAccess(WRZVO)\n" +
-			" │   │                       │   │   │           ├─ index: [WRZVO.TVNW2]\n" +
+			" │   │                       │   │   │   └─ TableAlias(`TIZHK`)\n" +
+			" │   │                       │   │   │       └─ IndexedTableAccess(mydb.`WRZVO`)\n" +
+			" │   │                       │   │   │           ├─ index: `GAG3S` [WRZVO.TVNW2]\n" +
 			" │   │                       │   │   │           └─ columns: [id tvnw2 zhity sypkf idut2 o6qj3 no2ja ykssu fhcyt qz6vt]\n" +
-			" │   │                       │   │   └─ TableAlias(RHUZN)\n" +
-			" │   │                       │   │       └─ IndexedTableAccess(E2I7U)\n" +
-			" │   │                       │   │           ├─ index: [E2I7U.ZH72S]\n" +
+			" │   │                       │   │   └─ TableAlias(`RHUZN`)\n" +
+			" │   │                       │   │       └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			" │   │                       │   │           ├─ index: `URBSA` [E2I7U.ZH72S]\n" +
 			" │   │                       │   │           └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
 			" │   │                       │   └─ TableAlias(mf)\n" +
-			" │   │                       │       └─ IndexedTableAccess(HGMQ6)\n" +
-			" │   │                       │           ├─ index: [HGMQ6.LUEVY]\n" +
+			" │   │                       │       └─ IndexedTableAccess(mydb.`HGMQ6`)\n" +
+			" │   │                       │           ├─ index: `LUEVY` [HGMQ6.LUEVY]\n" +
 			" │   │                       │           └─ columns: [id gxlub luevy m22qn tjpt7 arn5p xosd4 ide43 hmw4h zbt6r fsdy2 lt7k6 sppyd qcgts teuja qqv4m fhcyt]\n" +
 			" │   │                       └─ TableAlias(aac)\n" +
-			" │   │                           └─ IndexedTableAccess(TPXBU)\n" +
-			" │   │                               ├─ index: [TPXBU.id]\n" +
+			" │   │                           └─ IndexedTableAccess(mydb.`TPXBU`)\n" +
+			" │   │                               ├─ index: `PRIMARY` [TPXBU.id]\n" +
 			" │   │                               └─ columns: [id btxc5 fhcyt]\n" +
-			" │   └─ TableAlias(TIZHK)\n" +
-			" │       └─ IndexedTableAccess(WRZVO)\n" +
-			" │           ├─ index: [WRZVO.id]\n" +
+			" │   └─ TableAlias(`TIZHK`)\n" +
+			" │       └─ IndexedTableAccess(mydb.`WRZVO`)\n" +
+			" │           ├─ index: `PRIMARY` [WRZVO.id]\n" +
 			" │           └─ columns: [id tvnw2 zhity sypkf idut2 o6qj3 no2ja ykssu fhcyt qz6vt]\n" +
-			" └─ TableAlias(scalarSubq1)\n" +
-			"     └─ IndexedTableAccess(HDDVB)\n" +
-			"         ├─ index: [HDDVB.ETPQV]\n" +
+			" └─ TableAlias(`scalarSubq1`)\n" +
+			"     └─ IndexedTableAccess(mydb.`HDDVB`)\n" +
+			"         ├─ index: `ETPQV` [HDDVB.ETPQV]\n" +
 			"         └─ columns: [etpqv]\n" +
 			"",
 	},
@@ -8176,7 +8719,7 @@ WHERE
 			" │   │   └─ scalarSubq0.id:0!null\n" +
 			" │   ├─ Distinct\n" +
 			" │   │   └─ SubqueryAlias\n" +
-			" │   │       ├─ name: scalarSubq0\n" +
+			" │   │       ├─ name: `scalarSubq0`\n" +
 			" │   │       ├─ outerVisibility: false\n" +
 			" │   │       ├─ cacheable: true\n" +
 			" │   │       └─ Distinct\n" +
@@ -8202,33 +8745,33 @@ WHERE
 			" │   │                       │   │   │   ├─ Eq\n" +
 			" │   │                       │   │   │   │   ├─ mf.LUEVY:19!null\n" +
 			" │   │                       │   │   │   │   └─ J4JYP.id:0!null\n" +
-			" │   │                       │   │   │   ├─ TableAlias(J4JYP)\n" +
+			" │   │                       │   │   │   ├─ TableAlias(`J4JYP`)\n" +
 			" │   │                       │   │   │   │   └─ Table\n" +
-			" │   │                       │   │   │   │       ├─ name: E2I7U\n" +
+			" │   │                       │   │   │   │       ├─ name: mydb.`E2I7U`\n" +
 			" │   │                       │   │   │   │       └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
 			" │   │                       │   │   │   └─ TableAlias(mf)\n" +
-			" │   │                       │   │   │       └─ IndexedTableAccess(HGMQ6)\n" +
-			" │   │                       │   │   │           ├─ index: [HGMQ6.LUEVY]\n" +
+			" │   │                       │   │   │       └─ IndexedTableAccess(mydb.`HGMQ6`)\n" +
+			" │   │                       │   │   │           ├─ index: `LUEVY` [HGMQ6.LUEVY]\n" +
 			" │   │                       │   │   │           └─ columns: [id gxlub luevy m22qn tjpt7 arn5p xosd4 ide43 hmw4h zbt6r fsdy2 lt7k6 sppyd qcgts teuja qqv4m fhcyt]\n" +
 			" │   │                       │   │   └─ TableAlias(aac)\n" +
-			" │   │                       │   │       └─ IndexedTableAccess(TPXBU)\n" +
-			" │   │                       │   │           ├─ index: [TPXBU.id]\n" +
+			" │   │                       │   │       └─ IndexedTableAccess(mydb.`TPXBU`)\n" +
+			" │   │                       │   │           ├─ index: `PRIMARY` [TPXBU.id]\n" +
 			" │   │                       │   │           └─ columns: [id btxc5 fhcyt]\n" +
-			" │   │                       │   └─ TableAlias(TIZHK)\n" +
-			" │   │                       │       └─ IndexedTableAccess(WRZVO)\n" +
-			" │   │                       │           ├─ index: [WRZVO.TVNW2]\n" +
+			" │   │                       │   └─ TableAlias(`TIZHK`)\n" +
+			" │   │                       │       └─ IndexedTableAccess(mydb.`WRZVO`)\n" +
+			" │   │                       │           ├─ index: `GAG3S` [WRZVO.TVNW2]\n" +
 			" │   │                       │           └─ columns: [id tvnw2 zhity sypkf idut2 o6qj3 no2ja ykssu fhcyt qz6vt]\n" +
-			" │   │                       └─ TableAlias(RHUZN)\n" +
-			" │   │                           └─ IndexedTableAccess(E2I7U)\n" +
-			" │   │                               ├─ index: [E2I7U.ZH72S]\n" +
+			" │   │                       └─ TableAlias(`RHUZN`)\n" +
+			" │   │                           └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			" │   │                               ├─ index: `URBSA` [E2I7U.ZH72S]\n" +
 			" │   │                               └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
-			" │   └─ TableAlias(TIZHK)\n" +
-			" │       └─ IndexedTableAccess(WRZVO)\n" +
-			" │           ├─ index: [WRZVO.id]\n" +
+			" │   └─ TableAlias(`TIZHK`)\n" +
+			" │       └─ IndexedTableAccess(mydb.`WRZVO`)\n" +
+			" │           ├─ index: `PRIMARY` [WRZVO.id]\n" +
 			" │           └─ columns: [id tvnw2 zhity sypkf idut2 o6qj3 no2ja ykssu fhcyt qz6vt]\n" +
-			" └─ TableAlias(scalarSubq1)\n" +
-			"     └─ IndexedTableAccess(HDDVB)\n" +
-			"         ├─ index: [HDDVB.ETPQV]\n" +
+			" └─ TableAlias(`scalarSubq1`)\n" +
+			"     └─ IndexedTableAccess(mydb.`HDDVB`)\n" +
+			"         ├─ index: `ETPQV` [HDDVB.ETPQV]\n" +
 			"         └─ columns: [etpqv]\n" +
 			"",
 	},
@@ -8276,7 +8819,7 @@ WHERE
 			"     │   ├─ PBMRX.ZH72S:6\n" +
 			"     │   └─ CL3DT.ZH72S:0\n" +
 			"     ├─ SubqueryAlias\n" +
-			"     │   ├─ name: CL3DT\n" +
+			"     │   ├─ name: `CL3DT`\n" +
 			"     │   ├─ outerVisibility: false\n" +
 			"     │   ├─ cacheable: true\n" +
 			"     │   └─ Filter\n" +
@@ -8299,7 +8842,7 @@ WHERE
 			"     │                   └─ Project\n" +
 			"     │                       ├─ columns: [WOOJ5.ZH72S:1 as ZH72S, WOOJ5.LEA4J:2, WOOJ5.ZH72S:1]\n" +
 			"     │                       └─ SubqueryAlias\n" +
-			"     │                           ├─ name: WOOJ5\n" +
+			"     │                           ├─ name: `WOOJ5`\n" +
 			"     │                           ├─ outerVisibility: false\n" +
 			"     │                           ├─ cacheable: true\n" +
 			"     │                           └─ Project\n" +
@@ -8315,23 +8858,23 @@ WHERE
 			"     │                               │               │   ├─ FLQLP.LUEVY:17!null\n" +
 			"     │                               │               │   └─ nd.id:0!null\n" +
 			"     │                               │               └─ Table\n" +
-			"     │                               │                   ├─ name: FLQLP\n" +
+			"     │                               │                   ├─ name: mydb.`FLQLP`\n" +
 			"     │                               │                   └─ columns: [luevy]\n" +
 			"     │                               │   as LEA4J]\n" +
 			"     │                               └─ Filter\n" +
 			"     │                                   ├─ NOT\n" +
 			"     │                                   │   └─ nd.ZH72S:7 IS NULL\n" +
 			"     │                                   └─ TableAlias(nd)\n" +
-			"     │                                       └─ IndexedTableAccess(E2I7U)\n" +
-			"     │                                           ├─ index: [E2I7U.ZH72S]\n" +
+			"     │                                       └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			"     │                                           ├─ index: `URBSA` [E2I7U.ZH72S]\n" +
 			"     │                                           ├─ static: [{(NULL, ∞)}]\n" +
 			"     │                                           └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
 			"     └─ Filter\n" +
 			"         ├─ NOT\n" +
 			"         │   └─ PBMRX.ZH72S:2 IS NULL\n" +
-			"         └─ TableAlias(PBMRX)\n" +
-			"             └─ IndexedTableAccess(E2I7U)\n" +
-			"                 ├─ index: [E2I7U.ZH72S]\n" +
+			"         └─ TableAlias(`PBMRX`)\n" +
+			"             └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			"                 ├─ index: `URBSA` [E2I7U.ZH72S]\n" +
 			"                 └─ columns: [id tw55n zh72s]\n" +
 			"",
 	},
@@ -8414,9 +8957,9 @@ WHERE
 			"     │   │       │   │           ├─ Eq\n" +
 			"     │   │       │   │           │   ├─ I7HCR.id:37!null\n" +
 			"     │   │       │   │           │   └─ ct.OCA7E:11\n" +
-			"     │   │       │   │           └─ TableAlias(I7HCR)\n" +
+			"     │   │       │   │           └─ TableAlias(`I7HCR`)\n" +
 			"     │   │       │   │               └─ Table\n" +
-			"     │   │       │   │                   ├─ name: EPZU6\n" +
+			"     │   │       │   │                   ├─ name: mydb.`EPZU6`\n" +
 			"     │   │       │   │                   └─ columns: [id swcqv]\n" +
 			"     │   │       │   └─ 1 (tinyint)\n" +
 			"     │   │       └─ NOT\n" +
@@ -8436,13 +8979,13 @@ WHERE
 			"     │   │               │           │               ├─ Eq\n" +
 			"     │   │               │           │               │   ├─ I7HCR.id:54!null\n" +
 			"     │   │               │           │               │   └─ ct.OCA7E:11\n" +
-			"     │   │               │           │               └─ TableAlias(I7HCR)\n" +
+			"     │   │               │           │               └─ TableAlias(`I7HCR`)\n" +
 			"     │   │               │           │                   └─ Table\n" +
-			"     │   │               │           │                       ├─ name: EPZU6\n" +
+			"     │   │               │           │                       ├─ name: mydb.`EPZU6`\n" +
 			"     │   │               │           │                       └─ columns: [id fvucx]\n" +
 			"     │   │               │           └─ TableAlias(nd)\n" +
 			"     │   │               │               └─ Table\n" +
-			"     │   │               │                   ├─ name: E2I7U\n" +
+			"     │   │               │                   ├─ name: mydb.`E2I7U`\n" +
 			"     │   │               │                   └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
 			"     │   │               └─ ct.LUEVY:7!null\n" +
 			"     │   └─ AND\n" +
@@ -8463,8 +9006,8 @@ WHERE
 			"     │                       │           └─ Filter\n" +
 			"     │                       │               ├─ NOT\n" +
 			"     │                       │               │   └─ FLQLP.OCA7E:58 IS NULL\n" +
-			"     │                       │               └─ IndexedTableAccess(FLQLP)\n" +
-			"     │                       │                   ├─ index: [FLQLP.OCA7E]\n" +
+			"     │                       │               └─ IndexedTableAccess(mydb.`FLQLP`)\n" +
+			"     │                       │                   ├─ index: `OCA7E` [FLQLP.OCA7E]\n" +
 			"     │                       │                   ├─ static: [{(NULL, ∞)}]\n" +
 			"     │                       │                   └─ columns: [oca7e]\n" +
 			"     │                       └─ LookupJoin\n" +
@@ -8483,13 +9026,13 @@ WHERE
 			"     │                           │   ├─ Eq\n" +
 			"     │                           │   │   ├─ I7HCR.SWCQV:42!null\n" +
 			"     │                           │   │   └─ 0 (tinyint)\n" +
-			"     │                           │   └─ TableAlias(I7HCR)\n" +
+			"     │                           │   └─ TableAlias(`I7HCR`)\n" +
 			"     │                           │       └─ Table\n" +
-			"     │                           │           ├─ name: EPZU6\n" +
+			"     │                           │           ├─ name: mydb.`EPZU6`\n" +
 			"     │                           │           └─ columns: [id tofpn sjyn2 btxc5 fvucx swcqv ykssu fhcyt]\n" +
 			"     │                           └─ TableAlias(uct)\n" +
-			"     │                               └─ IndexedTableAccess(OUBDL)\n" +
-			"     │                                   ├─ index: [OUBDL.ZH72S]\n" +
+			"     │                               └─ IndexedTableAccess(mydb.`OUBDL`)\n" +
+			"     │                                   ├─ index: `JFRPY` [OUBDL.ZH72S]\n" +
 			"     │                                   └─ columns: [id ftqlq zh72s sfj6l v5dpx ljlum idpk7 no52d zrv3b vyo5e ykssu fhcyt qz6vt]\n" +
 			"     └─ LookupJoin\n" +
 			"         ├─ Eq\n" +
@@ -8505,19 +9048,19 @@ WHERE
 			"         │   │   │   └─ ct.FZ2R5:6!null\n" +
 			"         │   │   ├─ TableAlias(ci)\n" +
 			"         │   │   │   └─ Table\n" +
-			"         │   │   │       ├─ name: JDLNA\n" +
+			"         │   │   │       ├─ name: mydb.`JDLNA`\n" +
 			"         │   │   │       └─ columns: [id ftqlq fwwiq o3qxw fhcyt]\n" +
 			"         │   │   └─ TableAlias(ct)\n" +
-			"         │   │       └─ IndexedTableAccess(FLQLP)\n" +
-			"         │   │           ├─ index: [FLQLP.FZ2R5]\n" +
+			"         │   │       └─ IndexedTableAccess(mydb.`FLQLP`)\n" +
+			"         │   │           ├─ index: `FZ2R5` [FLQLP.FZ2R5]\n" +
 			"         │   │           └─ columns: [id fz2r5 luevy m22qn ove3e nrurt oca7e xmm6q v5dpx s3q3y zrv3b fhcyt]\n" +
 			"         │   └─ TableAlias(aac)\n" +
-			"         │       └─ IndexedTableAccess(TPXBU)\n" +
-			"         │           ├─ index: [TPXBU.id]\n" +
+			"         │       └─ IndexedTableAccess(mydb.`TPXBU`)\n" +
+			"         │           ├─ index: `PRIMARY` [TPXBU.id]\n" +
 			"         │           └─ columns: [id btxc5 fhcyt]\n" +
 			"         └─ TableAlias(nd)\n" +
-			"             └─ IndexedTableAccess(E2I7U)\n" +
-			"                 ├─ index: [E2I7U.id]\n" +
+			"             └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			"                 ├─ index: `PRIMARY` [E2I7U.id]\n" +
 			"                 └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
 			"",
 	},
@@ -8560,7 +9103,7 @@ WHERE
 			"     │   ├─ uct.id:1!null\n" +
 			"     │   └─ FZWBD.FDL23:0!null\n" +
 			"     ├─ SubqueryAlias\n" +
-			"     │   ├─ name: FZWBD\n" +
+			"     │   ├─ name: `FZWBD`\n" +
 			"     │   ├─ outerVisibility: false\n" +
 			"     │   ├─ cacheable: true\n" +
 			"     │   └─ Distinct\n" +
@@ -8584,33 +9127,33 @@ WHERE
 			"     │               │   │   │   │   └─ YLKSY.FTQLQ:6\n" +
 			"     │               │   │   │   ├─ TableAlias(ci)\n" +
 			"     │               │   │   │   │   └─ Table\n" +
-			"     │               │   │   │   │       ├─ name: JDLNA\n" +
+			"     │               │   │   │   │       ├─ name: mydb.`JDLNA`\n" +
 			"     │               │   │   │   │       └─ columns: [id ftqlq fwwiq o3qxw fhcyt]\n" +
 			"     │               │   │   │   └─ Filter\n" +
 			"     │               │   │   │       ├─ NOT\n" +
 			"     │               │   │   │       │   └─ YLKSY.LJLUM LIKE '%|%'\n" +
-			"     │               │   │   │       └─ TableAlias(YLKSY)\n" +
-			"     │               │   │   │           └─ IndexedTableAccess(OUBDL)\n" +
-			"     │               │   │   │               ├─ index: [OUBDL.FTQLQ]\n" +
+			"     │               │   │   │       └─ TableAlias(`YLKSY`)\n" +
+			"     │               │   │   │           └─ IndexedTableAccess(mydb.`OUBDL`)\n" +
+			"     │               │   │   │               ├─ index: `SVIT7` [OUBDL.FTQLQ]\n" +
 			"     │               │   │   │               └─ columns: [id ftqlq zh72s sfj6l v5dpx ljlum idpk7 no52d zrv3b vyo5e ykssu fhcyt qz6vt]\n" +
 			"     │               │   │   └─ TableAlias(aac)\n" +
-			"     │               │   │       └─ IndexedTableAccess(TPXBU)\n" +
-			"     │               │   │           ├─ index: [TPXBU.BTXC5]\n" +
+			"     │               │   │       └─ IndexedTableAccess(mydb.`TPXBU`)\n" +
+			"     │               │   │           ├─ index: `UZ6GK` [TPXBU.BTXC5]\n" +
 			"     │               │   │           └─ columns: [id btxc5 fhcyt]\n" +
 			"     │               │   └─ TableAlias(nd)\n" +
-			"     │               │       └─ IndexedTableAccess(E2I7U)\n" +
-			"     │               │           ├─ index: [E2I7U.ZH72S]\n" +
+			"     │               │       └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			"     │               │           ├─ index: `URBSA` [E2I7U.ZH72S]\n" +
 			"     │               │           └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
 			"     │               └─ Filter\n" +
 			"     │                   ├─ NOT\n" +
 			"     │                   │   └─ scalarSubq0.NRURT:0 IS NULL\n" +
-			"     │                   └─ TableAlias(scalarSubq0)\n" +
-			"     │                       └─ IndexedTableAccess(FLQLP)\n" +
-			"     │                           ├─ index: [FLQLP.NRURT]\n" +
+			"     │                   └─ TableAlias(`scalarSubq0`)\n" +
+			"     │                       └─ IndexedTableAccess(mydb.`FLQLP`)\n" +
+			"     │                           ├─ index: `NRURT` [FLQLP.NRURT]\n" +
 			"     │                           └─ columns: [nrurt]\n" +
 			"     └─ TableAlias(uct)\n" +
-			"         └─ IndexedTableAccess(OUBDL)\n" +
-			"             ├─ index: [OUBDL.id]\n" +
+			"         └─ IndexedTableAccess(mydb.`OUBDL`)\n" +
+			"             ├─ index: `PRIMARY` [OUBDL.id]\n" +
 			"             └─ columns: [id ftqlq zh72s sfj6l v5dpx ljlum idpk7 no52d zrv3b vyo5e ykssu fhcyt qz6vt]\n" +
 			"",
 	},
@@ -8667,25 +9210,25 @@ WHERE
 			"     │   │   │   │   ├─ Eq\n" +
 			"     │   │   │   │   │   ├─ TVTJS.SWCQV:1!null\n" +
 			"     │   │   │   │   │   └─ 1 (tinyint)\n" +
-			"     │   │   │   │   └─ TableAlias(TVTJS)\n" +
+			"     │   │   │   │   └─ TableAlias(`TVTJS`)\n" +
 			"     │   │   │   │       └─ Table\n" +
-			"     │   │   │   │           ├─ name: HU5A5\n" +
+			"     │   │   │   │           ├─ name: mydb.`HU5A5`\n" +
 			"     │   │   │   │           └─ columns: [id swcqv]\n" +
 			"     │   │   │   └─ TableAlias(ct)\n" +
-			"     │   │   │       └─ IndexedTableAccess(FLQLP)\n" +
-			"     │   │   │           ├─ index: [FLQLP.XMM6Q]\n" +
+			"     │   │   │       └─ IndexedTableAccess(mydb.`FLQLP`)\n" +
+			"     │   │   │           ├─ index: `XMM6Q` [FLQLP.XMM6Q]\n" +
 			"     │   │   │           └─ columns: [id fz2r5 luevy m22qn xmm6q v5dpx s3q3y zrv3b]\n" +
 			"     │   │   └─ TableAlias(aac)\n" +
-			"     │   │       └─ IndexedTableAccess(TPXBU)\n" +
-			"     │   │           ├─ index: [TPXBU.id]\n" +
+			"     │   │       └─ IndexedTableAccess(mydb.`TPXBU`)\n" +
+			"     │   │           ├─ index: `PRIMARY` [TPXBU.id]\n" +
 			"     │   │           └─ columns: [id btxc5]\n" +
 			"     │   └─ TableAlias(nd)\n" +
-			"     │       └─ IndexedTableAccess(E2I7U)\n" +
-			"     │           ├─ index: [E2I7U.id]\n" +
+			"     │       └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			"     │           ├─ index: `PRIMARY` [E2I7U.id]\n" +
 			"     │           └─ columns: [id tw55n]\n" +
 			"     └─ TableAlias(ci)\n" +
-			"         └─ IndexedTableAccess(JDLNA)\n" +
-			"             ├─ index: [JDLNA.id]\n" +
+			"         └─ IndexedTableAccess(mydb.`JDLNA`)\n" +
+			"             ├─ index: `PRIMARY` [JDLNA.id]\n" +
 			"             └─ columns: [id ftqlq]\n" +
 			"",
 	},
@@ -8716,14 +9259,14 @@ WHERE
 			" │   │   ├─ HU5A5.SWCQV:10!null\n" +
 			" │   │   └─ 0 (tinyint)\n" +
 			" │   └─ Table\n" +
-			" │       ├─ name: HU5A5\n" +
+			" │       ├─ name: mydb.`HU5A5`\n" +
 			" │       └─ columns: [id tofpn i3vta sfj6l v5dpx ljlum idpk7 no52d zrv3b vyo5e swcqv ykssu fhcyt]\n" +
 			" └─ Filter\n" +
 			"     ├─ NOT\n" +
 			"     │   └─ scalarSubq0.XMM6Q:0 IS NULL\n" +
-			"     └─ TableAlias(scalarSubq0)\n" +
-			"         └─ IndexedTableAccess(FLQLP)\n" +
-			"             ├─ index: [FLQLP.XMM6Q]\n" +
+			"     └─ TableAlias(`scalarSubq0`)\n" +
+			"         └─ IndexedTableAccess(mydb.`FLQLP`)\n" +
+			"             ├─ index: `XMM6Q` [FLQLP.XMM6Q]\n" +
 			"             └─ columns: [xmm6q]\n" +
 			"",
 	},
@@ -8801,33 +9344,33 @@ WHERE
 			"         │   │   │   │   │   ├─ Eq\n" +
 			"         │   │   │   │   │   │   ├─ NSPLT.id:0!null\n" +
 			"         │   │   │   │   │   │   └─ PV6R5.BRQP2:3!null\n" +
-			"         │   │   │   │   │   ├─ TableAlias(NSPLT)\n" +
+			"         │   │   │   │   │   ├─ TableAlias(`NSPLT`)\n" +
 			"         │   │   │   │   │   │   └─ Table\n" +
-			"         │   │   │   │   │   │       ├─ name: E2I7U\n" +
+			"         │   │   │   │   │   │       ├─ name: mydb.`E2I7U`\n" +
 			"         │   │   │   │   │   │       └─ columns: [id tw55n]\n" +
-			"         │   │   │   │   │   └─ TableAlias(PV6R5)\n" +
-			"         │   │   │   │   │       └─ IndexedTableAccess(NOXN3)\n" +
-			"         │   │   │   │   │           ├─ index: [NOXN3.BRQP2]\n" +
+			"         │   │   │   │   │   └─ TableAlias(`PV6R5`)\n" +
+			"         │   │   │   │   │       └─ IndexedTableAccess(mydb.`NOXN3`)\n" +
+			"         │   │   │   │   │           ├─ index: `BRQP2` [NOXN3.BRQP2]\n" +
 			"         │   │   │   │   │           └─ columns: [id brqp2 fftbj numk2]\n" +
-			"         │   │   │   │   └─ TableAlias(LQNCX)\n" +
-			"         │   │   │   │       └─ IndexedTableAccess(E2I7U)\n" +
-			"         │   │   │   │           ├─ index: [E2I7U.id]\n" +
+			"         │   │   │   │   └─ TableAlias(`LQNCX`)\n" +
+			"         │   │   │   │       └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			"         │   │   │   │           ├─ index: `PRIMARY` [E2I7U.id]\n" +
 			"         │   │   │   │           └─ columns: [id tw55n]\n" +
 			"         │   │   │   └─ TableAlias(rn)\n" +
-			"         │   │   │       └─ IndexedTableAccess(QYWQD)\n" +
-			"         │   │   │           ├─ index: [QYWQD.WNUNU]\n" +
+			"         │   │   │       └─ IndexedTableAccess(mydb.`QYWQD`)\n" +
+			"         │   │   │           ├─ index: `WNUNU` [QYWQD.WNUNU]\n" +
 			"         │   │   │           └─ columns: [id wnunu hhvlx hvhrz]\n" +
-			"         │   │   └─ TableAlias(ZYUTC)\n" +
-			"         │   │       └─ IndexedTableAccess(NOXN3)\n" +
-			"         │   │           ├─ index: [NOXN3.id]\n" +
+			"         │   │   └─ TableAlias(`ZYUTC`)\n" +
+			"         │   │       └─ IndexedTableAccess(mydb.`NOXN3`)\n" +
+			"         │   │           ├─ index: `PRIMARY` [NOXN3.id]\n" +
 			"         │   │           └─ columns: [id brqp2 fftbj]\n" +
-			"         │   └─ TableAlias(AFJMD)\n" +
-			"         │       └─ IndexedTableAccess(E2I7U)\n" +
-			"         │           ├─ index: [E2I7U.id]\n" +
+			"         │   └─ TableAlias(`AFJMD`)\n" +
+			"         │       └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			"         │           ├─ index: `PRIMARY` [E2I7U.id]\n" +
 			"         │           └─ columns: [id tw55n]\n" +
-			"         └─ TableAlias(XLZA5)\n" +
-			"             └─ IndexedTableAccess(E2I7U)\n" +
-			"                 ├─ index: [E2I7U.id]\n" +
+			"         └─ TableAlias(`XLZA5`)\n" +
+			"             └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			"                 ├─ index: `PRIMARY` [E2I7U.id]\n" +
 			"                 └─ columns: [id tw55n]\n" +
 			"",
 	},
@@ -8906,37 +9449,37 @@ WHERE
 			"         │   │   │   │   │   ├─ Eq\n" +
 			"         │   │   │   │   │   │   ├─ OE56M.id:0!null\n" +
 			"         │   │   │   │   │   │   └─ sn.BRQP2:3!null\n" +
-			"         │   │   │   │   │   ├─ TableAlias(OE56M)\n" +
+			"         │   │   │   │   │   ├─ TableAlias(`OE56M`)\n" +
 			"         │   │   │   │   │   │   └─ Table\n" +
-			"         │   │   │   │   │   │       ├─ name: E2I7U\n" +
+			"         │   │   │   │   │   │       ├─ name: mydb.`E2I7U`\n" +
 			"         │   │   │   │   │   │       └─ columns: [id tw55n]\n" +
 			"         │   │   │   │   │   └─ Filter\n" +
 			"         │   │   │   │   │       ├─ Eq\n" +
 			"         │   │   │   │   │       │   ├─ sn.NUMK2:3!null\n" +
 			"         │   │   │   │   │       │   └─ 1 (tinyint)\n" +
 			"         │   │   │   │   │       └─ TableAlias(sn)\n" +
-			"         │   │   │   │   │           └─ IndexedTableAccess(NOXN3)\n" +
-			"         │   │   │   │   │               ├─ index: [NOXN3.BRQP2]\n" +
+			"         │   │   │   │   │           └─ IndexedTableAccess(mydb.`NOXN3`)\n" +
+			"         │   │   │   │   │               ├─ index: `BRQP2` [NOXN3.BRQP2]\n" +
 			"         │   │   │   │   │               └─ columns: [id brqp2 fftbj numk2]\n" +
-			"         │   │   │   │   └─ TableAlias(CGFRZ)\n" +
-			"         │   │   │   │       └─ IndexedTableAccess(E2I7U)\n" +
-			"         │   │   │   │           ├─ index: [E2I7U.id]\n" +
+			"         │   │   │   │   └─ TableAlias(`CGFRZ`)\n" +
+			"         │   │   │   │       └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			"         │   │   │   │           ├─ index: `PRIMARY` [E2I7U.id]\n" +
 			"         │   │   │   │           └─ columns: [id tw55n]\n" +
-			"         │   │   │   └─ TableAlias(SKPM6)\n" +
-			"         │   │   │       └─ IndexedTableAccess(NOXN3)\n" +
-			"         │   │   │           ├─ index: [NOXN3.BRQP2]\n" +
+			"         │   │   │   └─ TableAlias(`SKPM6`)\n" +
+			"         │   │   │       └─ IndexedTableAccess(mydb.`NOXN3`)\n" +
+			"         │   │   │           ├─ index: `BRQP2` [NOXN3.BRQP2]\n" +
 			"         │   │   │           └─ columns: [id brqp2 fftbj]\n" +
-			"         │   │   └─ TableAlias(V5SAY)\n" +
-			"         │   │       └─ IndexedTableAccess(E2I7U)\n" +
-			"         │   │           ├─ index: [E2I7U.id]\n" +
+			"         │   │   └─ TableAlias(`V5SAY`)\n" +
+			"         │   │       └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			"         │   │           ├─ index: `PRIMARY` [E2I7U.id]\n" +
 			"         │   │           └─ columns: [id tw55n]\n" +
-			"         │   └─ TableAlias(FQTHF)\n" +
-			"         │       └─ IndexedTableAccess(E2I7U)\n" +
-			"         │           ├─ index: [E2I7U.id]\n" +
+			"         │   └─ TableAlias(`FQTHF`)\n" +
+			"         │       └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			"         │           ├─ index: `PRIMARY` [E2I7U.id]\n" +
 			"         │           └─ columns: [id tw55n]\n" +
 			"         └─ TableAlias(rn)\n" +
-			"             └─ IndexedTableAccess(QYWQD)\n" +
-			"                 ├─ index: [QYWQD.HHVLX]\n" +
+			"             └─ IndexedTableAccess(mydb.`QYWQD`)\n" +
+			"                 ├─ index: `HHVLX` [QYWQD.HHVLX]\n" +
 			"                 └─ columns: [wnunu hhvlx]\n" +
 			"",
 	},
@@ -8972,7 +9515,7 @@ WHERE
 			"     │   └─ scalarSubq0.id:0\n" +
 			"     ├─ Distinct\n" +
 			"     │   └─ SubqueryAlias\n" +
-			"     │       ├─ name: scalarSubq0\n" +
+			"     │       ├─ name: `scalarSubq0`\n" +
 			"     │       ├─ outerVisibility: false\n" +
 			"     │       ├─ cacheable: true\n" +
 			"     │       └─ Project\n" +
@@ -8987,7 +9530,7 @@ WHERE
 			"     │           │                   │   ├─ TDRVG.SSHPJ:3!null\n" +
 			"     │           │                   │   └─ S7BYT.SSHPJ:0!null\n" +
 			"     │           │                   └─ Table\n" +
-			"     │           │                       ├─ name: TDRVG\n" +
+			"     │           │                       ├─ name: mydb.`TDRVG`\n" +
 			"     │           │                       └─ columns: [id sshpj]\n" +
 			"     │           │   as id]\n" +
 			"     │           └─ AntiLookupJoin\n" +
@@ -8995,7 +9538,7 @@ WHERE
 			"     │               │   ├─ S7BYT.SSHPJ:0!null\n" +
 			"     │               │   └─ scalarSubq0.SSHPJ:2!null\n" +
 			"     │               ├─ SubqueryAlias\n" +
-			"     │               │   ├─ name: S7BYT\n" +
+			"     │               │   ├─ name: `S7BYT`\n" +
 			"     │               │   ├─ outerVisibility: true\n" +
 			"     │               │   ├─ cacheable: true\n" +
 			"     │               │   └─ Distinct\n" +
@@ -9007,18 +9550,18 @@ WHERE
 			"     │               │               │   └─ S5KBM.FGG57:18!null\n" +
 			"     │               │               ├─ TableAlias(nd)\n" +
 			"     │               │               │   └─ Table\n" +
-			"     │               │               │       ├─ name: E2I7U\n" +
+			"     │               │               │       ├─ name: mydb.`E2I7U`\n" +
 			"     │               │               │       └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
-			"     │               │               └─ TableAlias(S5KBM)\n" +
-			"     │               │                   └─ IndexedTableAccess(TDRVG)\n" +
-			"     │               │                       ├─ index: [TDRVG.FGG57]\n" +
+			"     │               │               └─ TableAlias(`S5KBM`)\n" +
+			"     │               │                   └─ IndexedTableAccess(mydb.`TDRVG`)\n" +
+			"     │               │                       ├─ index: `TK2R2` [TDRVG.FGG57]\n" +
 			"     │               │                       └─ columns: [id fgg57 sshpj sfj6l zh72s]\n" +
-			"     │               └─ TableAlias(scalarSubq0)\n" +
-			"     │                   └─ IndexedTableAccess(WE72E)\n" +
-			"     │                       ├─ index: [WE72E.SSHPJ]\n" +
+			"     │               └─ TableAlias(`scalarSubq0`)\n" +
+			"     │                   └─ IndexedTableAccess(mydb.`WE72E`)\n" +
+			"     │                       ├─ index: `SOCSM` [WE72E.SSHPJ]\n" +
 			"     │                       └─ columns: [sshpj]\n" +
-			"     └─ IndexedTableAccess(TDRVG)\n" +
-			"         ├─ index: [TDRVG.id]\n" +
+			"     └─ IndexedTableAccess(mydb.`TDRVG`)\n" +
+			"         ├─ index: `PRIMARY` [TDRVG.id]\n" +
 			"         └─ columns: [id fgg57 sshpj sfj6l zh72s]\n" +
 			"",
 	},
@@ -9066,7 +9609,7 @@ WHERE
 			"     │   ├─ PBMRX.ZH72S:6\n" +
 			"     │   └─ CL3DT.ZH72S:0\n" +
 			"     ├─ SubqueryAlias\n" +
-			"     │   ├─ name: CL3DT\n" +
+			"     │   ├─ name: `CL3DT`\n" +
 			"     │   ├─ outerVisibility: false\n" +
 			"     │   ├─ cacheable: true\n" +
 			"     │   └─ Filter\n" +
@@ -9089,7 +9632,7 @@ WHERE
 			"     │                   └─ Project\n" +
 			"     │                       ├─ columns: [TQ57W.ZH72S:1 as ZH72S, TQ57W.TJ66D:2, TQ57W.ZH72S:1]\n" +
 			"     │                       └─ SubqueryAlias\n" +
-			"     │                           ├─ name: TQ57W\n" +
+			"     │                           ├─ name: `TQ57W`\n" +
 			"     │                           ├─ outerVisibility: false\n" +
 			"     │                           ├─ cacheable: true\n" +
 			"     │                           └─ Project\n" +
@@ -9105,23 +9648,23 @@ WHERE
 			"     │                               │               │   ├─ AMYXQ.LUEVY:17!null\n" +
 			"     │                               │               │   └─ nd.id:0!null\n" +
 			"     │                               │               └─ Table\n" +
-			"     │                               │                   ├─ name: AMYXQ\n" +
+			"     │                               │                   ├─ name: mydb.`AMYXQ`\n" +
 			"     │                               │                   └─ columns: [luevy]\n" +
 			"     │                               │   as TJ66D]\n" +
 			"     │                               └─ Filter\n" +
 			"     │                                   ├─ NOT\n" +
 			"     │                                   │   └─ nd.ZH72S:7 IS NULL\n" +
 			"     │                                   └─ TableAlias(nd)\n" +
-			"     │                                       └─ IndexedTableAccess(E2I7U)\n" +
-			"     │                                           ├─ index: [E2I7U.ZH72S]\n" +
+			"     │                                       └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			"     │                                           ├─ index: `URBSA` [E2I7U.ZH72S]\n" +
 			"     │                                           ├─ static: [{(NULL, ∞)}]\n" +
 			"     │                                           └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
 			"     └─ Filter\n" +
 			"         ├─ NOT\n" +
 			"         │   └─ PBMRX.ZH72S:2 IS NULL\n" +
-			"         └─ TableAlias(PBMRX)\n" +
-			"             └─ IndexedTableAccess(E2I7U)\n" +
-			"                 ├─ index: [E2I7U.ZH72S]\n" +
+			"         └─ TableAlias(`PBMRX`)\n" +
+			"             └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			"                 ├─ index: `URBSA` [E2I7U.ZH72S]\n" +
 			"                 └─ columns: [id tw55n zh72s]\n" +
 			"",
 	},
@@ -9161,22 +9704,22 @@ WHERE
 			"         │   │   │   └─ ufc.T4IBQ:31\n" +
 			"         │   │   ├─ TableAlias(cla)\n" +
 			"         │   │   │   └─ Table\n" +
-			"         │   │   │       ├─ name: YK2GW\n" +
+			"         │   │   │       ├─ name: mydb.`YK2GW`\n" +
 			"         │   │   │       └─ columns: [id ftqlq tuxml paef5 rucy4 tpnj6 lbl53 nb3qs eo7iv muhjf fm34l ty5rf zhtlh npb7w sx3hh isbnf ya7yb c5ykb qk7kt ffge6 fiigj sh3nc ntena m4aub x5air sab6m g5qi5 zvqvd ykssu fhcyt]\n" +
 			"         │   │   └─ TableAlias(ufc)\n" +
-			"         │   │       └─ IndexedTableAccess(SISUT)\n" +
-			"         │   │           ├─ index: [SISUT.T4IBQ]\n" +
+			"         │   │       └─ IndexedTableAccess(mydb.`SISUT`)\n" +
+			"         │   │           ├─ index: `TTQYJ` [SISUT.T4IBQ]\n" +
 			"         │   │           └─ columns: [id t4ibq zh72s amyxq ktnz2 hiid2 dn3oq vvknb sh7tp srzzo qz6vt]\n" +
-			"         │   └─ TableAlias(scalarSubq0)\n" +
-			"         │       └─ IndexedTableAccess(AMYXQ)\n" +
-			"         │           ├─ index: [AMYXQ.KKGN5]\n" +
+			"         │   └─ TableAlias(`scalarSubq0`)\n" +
+			"         │       └─ IndexedTableAccess(mydb.`AMYXQ`)\n" +
+			"         │           ├─ index: `KKGN5` [AMYXQ.KKGN5]\n" +
 			"         │           └─ columns: [kkgn5]\n" +
 			"         └─ Filter\n" +
 			"             ├─ NOT\n" +
 			"             │   └─ nd.ZH72S:7 IS NULL\n" +
 			"             └─ TableAlias(nd)\n" +
-			"                 └─ IndexedTableAccess(E2I7U)\n" +
-			"                     ├─ index: [E2I7U.ZH72S]\n" +
+			"                 └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			"                     ├─ index: `URBSA` [E2I7U.ZH72S]\n" +
 			"                     └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
 			"",
 	},
@@ -9216,22 +9759,22 @@ WHERE
 			"         │   │   │   └─ ufc.T4IBQ:31\n" +
 			"         │   │   ├─ TableAlias(cla)\n" +
 			"         │   │   │   └─ Table\n" +
-			"         │   │   │       ├─ name: YK2GW\n" +
+			"         │   │   │       ├─ name: mydb.`YK2GW`\n" +
 			"         │   │   │       └─ columns: [id ftqlq tuxml paef5 rucy4 tpnj6 lbl53 nb3qs eo7iv muhjf fm34l ty5rf zhtlh npb7w sx3hh isbnf ya7yb c5ykb qk7kt ffge6 fiigj sh3nc ntena m4aub x5air sab6m g5qi5 zvqvd ykssu fhcyt]\n" +
 			"         │   │   └─ TableAlias(ufc)\n" +
-			"         │   │       └─ IndexedTableAccess(SISUT)\n" +
-			"         │   │           ├─ index: [SISUT.T4IBQ]\n" +
+			"         │   │       └─ IndexedTableAccess(mydb.`SISUT`)\n" +
+			"         │   │           ├─ index: `TTQYJ` [SISUT.T4IBQ]\n" +
 			"         │   │           └─ columns: [id t4ibq zh72s amyxq ktnz2 hiid2 dn3oq vvknb sh7tp srzzo qz6vt]\n" +
-			"         │   └─ TableAlias(scalarSubq0)\n" +
-			"         │       └─ IndexedTableAccess(AMYXQ)\n" +
-			"         │           ├─ index: [AMYXQ.KKGN5]\n" +
+			"         │   └─ TableAlias(`scalarSubq0`)\n" +
+			"         │       └─ IndexedTableAccess(mydb.`AMYXQ`)\n" +
+			"         │           ├─ index: `KKGN5` [AMYXQ.KKGN5]\n" +
 			"         │           └─ columns: [kkgn5]\n" +
 			"         └─ Filter\n" +
 			"             ├─ NOT\n" +
 			"             │   └─ nd.ZH72S:7 IS NULL\n" +
 			"             └─ TableAlias(nd)\n" +
-			"                 └─ IndexedTableAccess(E2I7U)\n" +
-			"                     ├─ index: [E2I7U.ZH72S]\n" +
+			"                 └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			"                     ├─ index: `URBSA` [E2I7U.ZH72S]\n" +
 			"                     └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
 			"",
 	},
@@ -9260,15 +9803,15 @@ WHERE
 			"     │   │   └─ ums.T4IBQ:1\n" +
 			"     │   ├─ TableAlias(ums)\n" +
 			"     │   │   └─ Table\n" +
-			"     │   │       ├─ name: FG26Y\n" +
+			"     │   │       ├─ name: mydb.`FG26Y`\n" +
 			"     │   │       └─ columns: [id t4ibq ner ber hr mmr qz6vt]\n" +
 			"     │   └─ TableAlias(cla)\n" +
-			"     │       └─ IndexedTableAccess(YK2GW)\n" +
-			"     │           ├─ index: [YK2GW.FTQLQ]\n" +
+			"     │       └─ IndexedTableAccess(mydb.`YK2GW`)\n" +
+			"     │           ├─ index: `EZCTT` [YK2GW.FTQLQ]\n" +
 			"     │           └─ columns: [id ftqlq tuxml paef5 rucy4 tpnj6 lbl53 nb3qs eo7iv muhjf fm34l ty5rf zhtlh npb7w sx3hh isbnf ya7yb c5ykb qk7kt ffge6 fiigj sh3nc ntena m4aub x5air sab6m g5qi5 zvqvd ykssu fhcyt]\n" +
-			"     └─ TableAlias(scalarSubq0)\n" +
-			"         └─ IndexedTableAccess(SZQWJ)\n" +
-			"             ├─ index: [SZQWJ.JOGI6]\n" +
+			"     └─ TableAlias(`scalarSubq0`)\n" +
+			"         └─ IndexedTableAccess(mydb.`SZQWJ`)\n" +
+			"             ├─ index: `JOGI6` [SZQWJ.JOGI6]\n" +
 			"             └─ columns: [jogi6]\n" +
 			"",
 	},
@@ -9353,9 +9896,9 @@ WHERE
 			"     │   │       │   │           ├─ Eq\n" +
 			"     │   │       │   │           │   ├─ TJ5D2.id:71!null\n" +
 			"     │   │       │   │           │   └─ mf.QQV4M:19\n" +
-			"     │   │       │   │           └─ TableAlias(TJ5D2)\n" +
+			"     │   │       │   │           └─ TableAlias(`TJ5D2`)\n" +
 			"     │   │       │   │               └─ Table\n" +
-			"     │   │       │   │                   ├─ name: SZW6V\n" +
+			"     │   │       │   │                   ├─ name: mydb.`SZW6V`\n" +
 			"     │   │       │   │                   └─ columns: [id swcqv]\n" +
 			"     │   │       │   └─ 1 (tinyint)\n" +
 			"     │   │       └─ NOT\n" +
@@ -9375,13 +9918,13 @@ WHERE
 			"     │   │               │           │               ├─ Eq\n" +
 			"     │   │               │           │               │   ├─ TJ5D2.id:88!null\n" +
 			"     │   │               │           │               │   └─ mf.QQV4M:19\n" +
-			"     │   │               │           │               └─ TableAlias(TJ5D2)\n" +
+			"     │   │               │           │               └─ TableAlias(`TJ5D2`)\n" +
 			"     │   │               │           │                   └─ Table\n" +
-			"     │   │               │           │                       ├─ name: SZW6V\n" +
+			"     │   │               │           │                       ├─ name: mydb.`SZW6V`\n" +
 			"     │   │               │           │                       └─ columns: [id h4dmt]\n" +
 			"     │   │               │           └─ TableAlias(nd)\n" +
 			"     │   │               │               └─ Table\n" +
-			"     │   │               │                   ├─ name: E2I7U\n" +
+			"     │   │               │                   ├─ name: mydb.`E2I7U`\n" +
 			"     │   │               │                   └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
 			"     │   │               └─ mf.LUEVY:6!null\n" +
 			"     │   └─ AND\n" +
@@ -9402,8 +9945,8 @@ WHERE
 			"     │                       │           └─ Filter\n" +
 			"     │                       │               ├─ NOT\n" +
 			"     │                       │               │   └─ HGMQ6.QQV4M:104 IS NULL\n" +
-			"     │                       │               └─ IndexedTableAccess(HGMQ6)\n" +
-			"     │                       │                   ├─ index: [HGMQ6.QQV4M]\n" +
+			"     │                       │               └─ IndexedTableAccess(mydb.`HGMQ6`)\n" +
+			"     │                       │                   ├─ index: `QQV4M` [HGMQ6.QQV4M]\n" +
 			"     │                       │                   ├─ static: [{(NULL, ∞)}]\n" +
 			"     │                       │                   └─ columns: [qqv4m]\n" +
 			"     │                       └─ LookupJoin\n" +
@@ -9422,13 +9965,13 @@ WHERE
 			"     │                           │   ├─ Eq\n" +
 			"     │                           │   │   ├─ TJ5D2.SWCQV:76!null\n" +
 			"     │                           │   │   └─ 0 (tinyint)\n" +
-			"     │                           │   └─ TableAlias(TJ5D2)\n" +
+			"     │                           │   └─ TableAlias(`TJ5D2`)\n" +
 			"     │                           │       └─ Table\n" +
-			"     │                           │           ├─ name: SZW6V\n" +
+			"     │                           │           ├─ name: mydb.`SZW6V`\n" +
 			"     │                           │           └─ columns: [id t4ibq v7ufh sypkf h4dmt swcqv ykssu fhcyt]\n" +
 			"     │                           └─ TableAlias(umf)\n" +
-			"     │                               └─ IndexedTableAccess(NZKPM)\n" +
-			"     │                                   ├─ index: [NZKPM.FGG57]\n" +
+			"     │                               └─ IndexedTableAccess(mydb.`NZKPM`)\n" +
+			"     │                                   ├─ index: `FXQHW` [NZKPM.FGG57]\n" +
 			"     │                                   └─ columns: [id t4ibq fgg57 sshpj nla6o sfj6l tjpt7 arn5p sypkf ivfmk ide43 az6sp fsdy2 xosd4 hmw4h s76om vaf zroh6 qcgts lnfm6 tvawl hdlcl bhhw6 fhcyt qz6vt]\n" +
 			"     └─ LookupJoin\n" +
 			"         ├─ Eq\n" +
@@ -9448,23 +9991,23 @@ WHERE
 			"         │   │   │   │   └─ mf.GXLUB:5!null\n" +
 			"         │   │   │   ├─ TableAlias(bs)\n" +
 			"         │   │   │   │   └─ Table\n" +
-			"         │   │   │   │       ├─ name: THNTS\n" +
+			"         │   │   │   │       ├─ name: mydb.`THNTS`\n" +
 			"         │   │   │   │       └─ columns: [id nfryn ixuxu fhcyt]\n" +
 			"         │   │   │   └─ TableAlias(mf)\n" +
-			"         │   │   │       └─ IndexedTableAccess(HGMQ6)\n" +
-			"         │   │   │           ├─ index: [HGMQ6.GXLUB]\n" +
+			"         │   │   │       └─ IndexedTableAccess(mydb.`HGMQ6`)\n" +
+			"         │   │   │           ├─ index: `GXLUB` [HGMQ6.GXLUB]\n" +
 			"         │   │   │           └─ columns: [id gxlub luevy m22qn tjpt7 arn5p xosd4 ide43 hmw4h zbt6r fsdy2 lt7k6 sppyd qcgts teuja qqv4m fhcyt]\n" +
 			"         │   │   └─ TableAlias(nd)\n" +
-			"         │   │       └─ IndexedTableAccess(E2I7U)\n" +
-			"         │   │           ├─ index: [E2I7U.id]\n" +
+			"         │   │       └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			"         │   │           ├─ index: `PRIMARY` [E2I7U.id]\n" +
 			"         │   │           └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
 			"         │   └─ TableAlias(cla)\n" +
-			"         │       └─ IndexedTableAccess(YK2GW)\n" +
-			"         │           ├─ index: [YK2GW.id]\n" +
+			"         │       └─ IndexedTableAccess(mydb.`YK2GW`)\n" +
+			"         │           ├─ index: `PRIMARY` [YK2GW.id]\n" +
 			"         │           └─ columns: [id ftqlq tuxml paef5 rucy4 tpnj6 lbl53 nb3qs eo7iv muhjf fm34l ty5rf zhtlh npb7w sx3hh isbnf ya7yb c5ykb qk7kt ffge6 fiigj sh3nc ntena m4aub x5air sab6m g5qi5 zvqvd ykssu fhcyt]\n" +
 			"         └─ TableAlias(aac)\n" +
-			"             └─ IndexedTableAccess(TPXBU)\n" +
-			"                 ├─ index: [TPXBU.id]\n" +
+			"             └─ IndexedTableAccess(mydb.`TPXBU`)\n" +
+			"                 ├─ index: `PRIMARY` [TPXBU.id]\n" +
 			"                 └─ columns: [id btxc5 fhcyt]\n" +
 			"",
 	},
@@ -9505,7 +10048,7 @@ WHERE
 			"     │   │   │   └─ umf.T4IBQ:31\n" +
 			"     │   │   ├─ TableAlias(cla)\n" +
 			"     │   │   │   └─ Table\n" +
-			"     │   │   │       ├─ name: YK2GW\n" +
+			"     │   │   │       ├─ name: mydb.`YK2GW`\n" +
 			"     │   │   │       └─ columns: [id ftqlq tuxml paef5 rucy4 tpnj6 lbl53 nb3qs eo7iv muhjf fm34l ty5rf zhtlh npb7w sx3hh isbnf ya7yb c5ykb qk7kt ffge6 fiigj sh3nc ntena m4aub x5air sab6m g5qi5 zvqvd ykssu fhcyt]\n" +
 			"     │   │   └─ Filter\n" +
 			"     │   │       ├─ NOT\n" +
@@ -9513,19 +10056,19 @@ WHERE
 			"     │   │       │       ├─ umf.ARN5P:7\n" +
 			"     │   │       │       └─ N/A (longtext)\n" +
 			"     │   │       └─ TableAlias(umf)\n" +
-			"     │   │           └─ IndexedTableAccess(NZKPM)\n" +
-			"     │   │               ├─ index: [NZKPM.T4IBQ]\n" +
+			"     │   │           └─ IndexedTableAccess(mydb.`NZKPM`)\n" +
+			"     │   │               ├─ index: `UFF3F` [NZKPM.T4IBQ]\n" +
 			"     │   │               └─ columns: [id t4ibq fgg57 sshpj nla6o sfj6l tjpt7 arn5p sypkf ivfmk ide43 az6sp fsdy2 xosd4 hmw4h s76om vaf zroh6 qcgts lnfm6 tvawl hdlcl bhhw6 fhcyt qz6vt]\n" +
 			"     │   └─ Filter\n" +
 			"     │       ├─ NOT\n" +
 			"     │       │   └─ nd.FGG57:6 IS NULL\n" +
 			"     │       └─ TableAlias(nd)\n" +
-			"     │           └─ IndexedTableAccess(E2I7U)\n" +
-			"     │               ├─ index: [E2I7U.FGG57]\n" +
+			"     │           └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			"     │               ├─ index: `TKKOQ` [E2I7U.FGG57]\n" +
 			"     │               └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
-			"     └─ TableAlias(scalarSubq0)\n" +
-			"         └─ IndexedTableAccess(HGMQ6)\n" +
-			"             ├─ index: [HGMQ6.TEUJA]\n" +
+			"     └─ TableAlias(`scalarSubq0`)\n" +
+			"         └─ IndexedTableAccess(mydb.`HGMQ6`)\n" +
+			"             ├─ index: `TEUJA` [HGMQ6.TEUJA]\n" +
 			"             └─ columns: [teuja]\n" +
 			"",
 	},
@@ -9537,8 +10080,8 @@ WHERE
 	ORDER BY id ASC`,
 		ExpectedPlan: "Project\n" +
 			" ├─ columns: [QYWQD.HVHRZ:1!null]\n" +
-			" └─ IndexedTableAccess(QYWQD)\n" +
-			"     ├─ index: [QYWQD.id]\n" +
+			" └─ IndexedTableAccess(mydb.`QYWQD`)\n" +
+			"     ├─ index: `PRIMARY` [QYWQD.id]\n" +
 			"     ├─ static: [{[NULL, ∞)}]\n" +
 			"     └─ columns: [id hvhrz]\n" +
 			"",
@@ -9646,7 +10189,7 @@ WHERE
 		ExpectedPlan: "Union all\n" +
 			" ├─ Project\n" +
 			" │   ├─ columns: [convert\n" +
-			" │   │   ├─ type: char\n" +
+			" │   │   ├─ type: longtext\n" +
 			" │   │   └─ T4IBQ:0!null\n" +
 			" │   │   as T4IBQ, DL754:1!null, BDNYB:2!null, ADURZ:3!null, TPXBU:4, NO52D:5!null, IDPK7:6!null]\n" +
 			" │   └─ Project\n" +
@@ -9659,8 +10202,8 @@ WHERE
 			" │       │           │   ├─ aac.id:67!null\n" +
 			" │       │           │   └─ SL3S5.M22QN:2!null\n" +
 			" │       │           └─ TableAlias(aac)\n" +
-			" │       │               └─ IndexedTableAccess(TPXBU)\n" +
-			" │       │                   ├─ index: [TPXBU.id]\n" +
+			" │       │               └─ IndexedTableAccess(mydb.`TPXBU`)\n" +
+			" │       │                   ├─ index: `PRIMARY` [TPXBU.id]\n" +
 			" │       │                   └─ columns: [id btxc5]\n" +
 			" │       │   as TPXBU, SL3S5.NO52D:4!null as NO52D, SL3S5.IDPK7:5!null as IDPK7]\n" +
 			" │       └─ LookupJoin\n" +
@@ -9684,7 +10227,7 @@ WHERE
 			" │           │   │   │   │   ├─ SL3S5.BDNYB:0!null\n" +
 			" │           │   │   │   │   └─ sn.id:6!null\n" +
 			" │           │   │   │   ├─ SubqueryAlias\n" +
-			" │           │   │   │   │   ├─ name: SL3S5\n" +
+			" │           │   │   │   │   ├─ name: `SL3S5`\n" +
 			" │           │   │   │   │   ├─ outerVisibility: false\n" +
 			" │           │   │   │   │   ├─ cacheable: true\n" +
 			" │           │   │   │   │   └─ Project\n" +
@@ -9710,8 +10253,8 @@ WHERE
 			" │           │   │   │   │           │   │   │   │   ├─ ci.FTQLQ:1!null\n" +
 			" │           │   │   │   │           │   │   │   │   └─ TUPLE(SQ1 (longtext))\n" +
 			" │           │   │   │   │           │   │   │   └─ TableAlias(ci)\n" +
-			" │           │   │   │   │           │   │   │       └─ IndexedTableAccess(JDLNA)\n" +
-			" │           │   │   │   │           │   │   │           ├─ index: [JDLNA.FTQLQ]\n" +
+			" │           │   │   │   │           │   │   │       └─ IndexedTableAccess(mydb.`JDLNA`)\n" +
+			" │           │   │   │   │           │   │   │           ├─ index: `XDW7Z` [JDLNA.FTQLQ]\n" +
 			" │           │   │   │   │           │   │   │           ├─ static: [{[SQ1, SQ1]}]\n" +
 			" │           │   │   │   │           │   │   │           └─ columns: [id ftqlq]\n" +
 			" │           │   │   │   │           │   │   └─ Filter\n" +
@@ -9719,19 +10262,19 @@ WHERE
 			" │           │   │   │   │           │   │       │   ├─ ct.ZRV3B:5!null\n" +
 			" │           │   │   │   │           │   │       │   └─ = (longtext)\n" +
 			" │           │   │   │   │           │   │       └─ TableAlias(ct)\n" +
-			" │           │   │   │   │           │   │           └─ IndexedTableAccess(FLQLP)\n" +
-			" │           │   │   │   │           │   │               ├─ index: [FLQLP.FZ2R5]\n" +
+			" │           │   │   │   │           │   │           └─ IndexedTableAccess(mydb.`FLQLP`)\n" +
+			" │           │   │   │   │           │   │               ├─ index: `FZ2R5` [FLQLP.FZ2R5]\n" +
 			" │           │   │   │   │           │   │               └─ columns: [fz2r5 luevy m22qn ove3e s3q3y zrv3b]\n" +
 			" │           │   │   │   │           │   └─ TableAlias(cec)\n" +
-			" │           │   │   │   │           │       └─ IndexedTableAccess(SFEGG)\n" +
-			" │           │   │   │   │           │           ├─ index: [SFEGG.id]\n" +
+			" │           │   │   │   │           │       └─ IndexedTableAccess(mydb.`SFEGG`)\n" +
+			" │           │   │   │   │           │           ├─ index: `PRIMARY` [SFEGG.id]\n" +
 			" │           │   │   │   │           │           └─ columns: [id no52d adurz]\n" +
 			" │           │   │   │   │           └─ HashLookup\n" +
 			" │           │   │   │   │               ├─ source: TUPLE(ct.M22QN:4!null, ct.LUEVY:3!null)\n" +
 			" │           │   │   │   │               ├─ target: TUPLE(KHJJO.M22QN:0!null, KHJJO.LUEVY:2!null)\n" +
 			" │           │   │   │   │               └─ CachedResults\n" +
 			" │           │   │   │   │                   └─ SubqueryAlias\n" +
-			" │           │   │   │   │                       ├─ name: KHJJO\n" +
+			" │           │   │   │   │                       ├─ name: `KHJJO`\n" +
 			" │           │   │   │   │                       ├─ outerVisibility: false\n" +
 			" │           │   │   │   │                       ├─ cacheable: true\n" +
 			" │           │   │   │   │                       └─ Distinct\n" +
@@ -9743,136 +10286,134 @@ WHERE
 			" │           │   │   │   │                                   │   └─ mf.LUEVY:12!null\n" +
 			" │           │   │   │   │                                   ├─ TableAlias(sn)\n" +
 			" │           │   │   │   │                                   │   └─ Table\n" +
-			" │           │   │   │   │                                   │       ├─ name: NOXN3\n" +
+			" │           │   │   │   │                                   │       ├─ name: mydb.`NOXN3`\n" +
 			" │           │   │   │   │                                   │       └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
 			" │           │   │   │   │                                   └─ TableAlias(mf)\n" +
-			" │           │   │   │   │                                       └─ IndexedTableAccess(HGMQ6)\n" +
-			" │           │   │   │   │                                           ├─ index: [HGMQ6.LUEVY]\n" +
+			" │           │   │   │   │                                       └─ IndexedTableAccess(mydb.`HGMQ6`)\n" +
+			" │           │   │   │   │                                           ├─ index: `LUEVY` [HGMQ6.LUEVY]\n" +
 			" │           │   │   │   │                                           └─ columns: [id gxlub luevy m22qn tjpt7 arn5p xosd4 ide43 hmw4h zbt6r fsdy2 lt7k6 sppyd qcgts teuja qqv4m fhcyt]\n" +
 			" │           │   │   │   └─ TableAlias(sn)\n" +
-			" │           │   │   │       └─ IndexedTableAccess(NOXN3)\n" +
-			" │           │   │   │           ├─ index: [NOXN3.id]\n" +
+			" │           │   │   │       └─ IndexedTableAccess(mydb.`NOXN3`)\n" +
+			" │           │   │   │           ├─ index: `PRIMARY` [NOXN3.id]\n" +
 			" │           │   │   │           └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
 			" │           │   │   └─ TableAlias(mf)\n" +
-			" │           │   │       └─ IndexedTableAccess(HGMQ6)\n" +
-			" │           │   │           ├─ index: [HGMQ6.LUEVY]\n" +
+			" │           │   │       └─ IndexedTableAccess(mydb.`HGMQ6`)\n" +
+			" │           │   │           ├─ index: `LUEVY` [HGMQ6.LUEVY]\n" +
 			" │           │   │           └─ columns: [id gxlub luevy m22qn tjpt7 arn5p xosd4 ide43 hmw4h zbt6r fsdy2 lt7k6 sppyd qcgts teuja qqv4m fhcyt]\n" +
 			" │           │   └─ TableAlias(bs)\n" +
-			" │           │       └─ IndexedTableAccess(THNTS)\n" +
-			" │           │           ├─ index: [THNTS.id]\n" +
+			" │           │       └─ IndexedTableAccess(mydb.`THNTS`)\n" +
+			" │           │           ├─ index: `PRIMARY` [THNTS.id]\n" +
 			" │           │           └─ columns: [id nfryn ixuxu fhcyt]\n" +
 			" │           └─ Filter\n" +
 			" │               ├─ HashIn\n" +
 			" │               │   ├─ cla.FTQLQ:1!null\n" +
 			" │               │   └─ TUPLE(SQ1 (longtext))\n" +
 			" │               └─ TableAlias(cla)\n" +
-			" │                   └─ IndexedTableAccess(YK2GW)\n" +
-			" │                       ├─ index: [YK2GW.id]\n" +
+			" │                   └─ IndexedTableAccess(mydb.`YK2GW`)\n" +
+			" │                       ├─ index: `PRIMARY` [YK2GW.id]\n" +
 			" │                       └─ columns: [id ftqlq tuxml paef5 rucy4 tpnj6 lbl53 nb3qs eo7iv muhjf fm34l ty5rf zhtlh npb7w sx3hh isbnf ya7yb c5ykb qk7kt ffge6 fiigj sh3nc ntena m4aub x5air sab6m g5qi5 zvqvd ykssu fhcyt]\n" +
 			" └─ Project\n" +
-			"     ├─ columns: [AOEV5.T4IBQ:0!null as T4IBQ, VUMUY.DL754:1!null, VUMUY.BDNYB:2!null, VUMUY.ADURZ:3!null, VUMUY.TPXBU:4, VUMUY.NO52D:5!null, VUMUY.IDPK7:6!null]\n" +
-			"     └─ Project\n" +
-			"         ├─ columns: [AOEV5.T4IBQ:6!null, VUMUY.DL754:0!null, VUMUY.BDNYB:1!null, VUMUY.ADURZ:2!null, VUMUY.TPXBU:3, VUMUY.NO52D:4!null, VUMUY.IDPK7:5!null]\n" +
-			"         └─ CrossJoin\n" +
-			"             ├─ SubqueryAlias\n" +
-			"             │   ├─ name: VUMUY\n" +
-			"             │   ├─ outerVisibility: false\n" +
-			"             │   ├─ cacheable: true\n" +
-			"             │   └─ Project\n" +
-			"             │       ├─ columns: [SL3S5.TOFPN:1!null as DL754, sn.id:6!null as BDNYB, SL3S5.ADURZ:3!null as ADURZ, Subquery\n" +
-			"             │       │   ├─ cacheable: false\n" +
-			"             │       │   └─ Project\n" +
-			"             │       │       ├─ columns: [aac.BTXC5:17]\n" +
-			"             │       │       └─ Filter\n" +
-			"             │       │           ├─ Eq\n" +
-			"             │       │           │   ├─ aac.id:16!null\n" +
-			"             │       │           │   └─ SL3S5.M22QN:2!null\n" +
-			"             │       │           └─ TableAlias(aac)\n" +
-			"             │       │               └─ IndexedTableAccess(TPXBU)\n" +
-			"             │       │                   ├─ index: [TPXBU.id]\n" +
-			"             │       │                   └─ columns: [id btxc5]\n" +
-			"             │       │   as TPXBU, SL3S5.NO52D:4!null as NO52D, SL3S5.IDPK7:5!null as IDPK7]\n" +
-			"             │       └─ LookupJoin\n" +
-			"             │           ├─ Eq\n" +
-			"             │           │   ├─ SL3S5.BDNYB:0!null\n" +
-			"             │           │   └─ sn.id:6!null\n" +
-			"             │           ├─ SubqueryAlias\n" +
-			"             │           │   ├─ name: SL3S5\n" +
-			"             │           │   ├─ outerVisibility: false\n" +
-			"             │           │   ├─ cacheable: true\n" +
-			"             │           │   └─ Project\n" +
-			"             │           │       ├─ columns: [sn.id:17!null as BDNYB, ci.FTQLQ:16!null as TOFPN, ct.M22QN:6!null as M22QN, cec.ADURZ:2!null as ADURZ, cec.NO52D:1!null as NO52D, ct.S3Q3Y:12!null as IDPK7]\n" +
-			"             │           │       └─ Filter\n" +
-			"             │           │           ├─ Eq\n" +
-			"             │           │           │   ├─ ct.M22QN:6!null\n" +
-			"             │           │           │   └─ Subquery\n" +
-			"             │           │           │       ├─ cacheable: true\n" +
-			"             │           │           │       └─ Project\n" +
-			"             │           │           │           ├─ columns: [aac.id:27!null]\n" +
-			"             │           │           │           └─ Filter\n" +
-			"             │           │           │               ├─ Eq\n" +
-			"             │           │           │               │   ├─ aac.BTXC5:28\n" +
-			"             │           │           │               │   └─ WT (longtext)\n" +
-			"             │           │           │               └─ TableAlias(aac)\n" +
-			"             │           │           │                   └─ IndexedTableAccess(TPXBU)\n" +
-			"             │           │           │                       ├─ index: [TPXBU.BTXC5]\n" +
-			"             │           │           │                       ├─ static: [{[WT, WT]}]\n" +
-			"             │           │           │                       └─ columns: [id btxc5]\n" +
-			"             │           │           └─ LookupJoin\n" +
-			"             │           │               ├─ Eq\n" +
-			"             │           │               │   ├─ ct.LUEVY:5!null\n" +
-			"             │           │               │   └─ sn.BRQP2:18!null\n" +
-			"             │           │               ├─ LookupJoin\n" +
-			"             │           │               │   ├─ Eq\n" +
-			"             │           │               │   │   ├─ ci.id:15!null\n" +
-			"             │           │               │   │   └─ ct.FZ2R5:4!null\n" +
-			"             │           │               │   ├─ LookupJoin\n" +
-			"             │           │               │   │   ├─ Eq\n" +
-			"             │           │               │   │   │   ├─ cec.id:0!null\n" +
-			"             │           │               │   │   │   └─ ct.OVE3E:7!null\n" +
-			"             │           │               │   │   ├─ TableAlias(cec)\n" +
-			"             │           │               │   │   │   └─ Table\n" +
-			"             │           │               │   │   │       ├─ name: SFEGG\n" +
-			"             │           │               │   │   │       └─ columns: [id no52d adurz]\n" +
-			"             │           │               │   │   └─ Filter\n" +
-			"             │           │               │   │       ├─ Eq\n" +
-			"             │           │               │   │       │   ├─ ct.ZRV3B:10!null\n" +
-			"             │           │               │   │       │   └─ = (longtext)\n" +
-			"             │           │               │   │       └─ TableAlias(ct)\n" +
-			"             │           │               │   │           └─ IndexedTableAccess(FLQLP)\n" +
-			"             │           │               │   │               ├─ index: [FLQLP.OVE3E]\n" +
-			"             │           │               │   │               └─ columns: [id fz2r5 luevy m22qn ove3e nrurt oca7e xmm6q v5dpx s3q3y zrv3b fhcyt]\n" +
-			"             │           │               │   └─ Filter\n" +
-			"             │           │               │       ├─ HashIn\n" +
-			"             │           │               │       │   ├─ ci.FTQLQ:1!null\n" +
-			"             │           │               │       │   └─ TUPLE(SQ1 (longtext))\n" +
-			"             │           │               │       └─ TableAlias(ci)\n" +
-			"             │           │               │           └─ IndexedTableAccess(JDLNA)\n" +
-			"             │           │               │               ├─ index: [JDLNA.id]\n" +
-			"             │           │               │               └─ columns: [id ftqlq]\n" +
-			"             │           │               └─ TableAlias(sn)\n" +
-			"             │           │                   └─ IndexedTableAccess(NOXN3)\n" +
-			"             │           │                       ├─ index: [NOXN3.BRQP2]\n" +
-			"             │           │                       └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
-			"             │           └─ TableAlias(sn)\n" +
-			"             │               └─ IndexedTableAccess(NOXN3)\n" +
-			"             │                   ├─ index: [NOXN3.id]\n" +
-			"             │                   └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
-			"             └─ SubqueryAlias\n" +
-			"                 ├─ name: AOEV5\n" +
-			"                 ├─ outerVisibility: false\n" +
-			"                 ├─ cacheable: true\n" +
-			"                 └─ Values() as temp_AOEV5\n" +
-			"                     ├─ Row(\n" +
-			"                     │  1 (longtext))\n" +
-			"                     ├─ Row(\n" +
-			"                     │  2 (longtext))\n" +
-			"                     ├─ Row(\n" +
-			"                     │  3 (longtext))\n" +
-			"                     ├─ Row(\n" +
-			"                     │  4 (longtext))\n" +
-			"                     └─ Row(\n" +
-			"                        5 (longtext))\n" +
+			"     ├─ columns: [AOEV5.T4IBQ:6!null, VUMUY.DL754:0!null, VUMUY.BDNYB:1!null, VUMUY.ADURZ:2!null, VUMUY.TPXBU:3, VUMUY.NO52D:4!null, VUMUY.IDPK7:5!null]\n" +
+			"     └─ CrossJoin\n" +
+			"         ├─ SubqueryAlias\n" +
+			"         │   ├─ name: `VUMUY`\n" +
+			"         │   ├─ outerVisibility: false\n" +
+			"         │   ├─ cacheable: true\n" +
+			"         │   └─ Project\n" +
+			"         │       ├─ columns: [SL3S5.TOFPN:1!null as DL754, sn.id:6!null as BDNYB, SL3S5.ADURZ:3!null as ADURZ, Subquery\n" +
+			"         │       │   ├─ cacheable: false\n" +
+			"         │       │   └─ Project\n" +
+			"         │       │       ├─ columns: [aac.BTXC5:17]\n" +
+			"         │       │       └─ Filter\n" +
+			"         │       │           ├─ Eq\n" +
+			"         │       │           │   ├─ aac.id:16!null\n" +
+			"         │       │           │   └─ SL3S5.M22QN:2!null\n" +
+			"         │       │           └─ TableAlias(aac)\n" +
+			"         │       │               └─ IndexedTableAccess(mydb.`TPXBU`)\n" +
+			"         │       │                   ├─ index: `PRIMARY` [TPXBU.id]\n" +
+			"         │       │                   └─ columns: [id btxc5]\n" +
+			"         │       │   as TPXBU, SL3S5.NO52D:4!null as NO52D, SL3S5.IDPK7:5!null as IDPK7]\n" +
+			"         │       └─ LookupJoin\n" +
+			"         │           ├─ Eq\n" +
+			"         │           │   ├─ SL3S5.BDNYB:0!null\n" +
+			"         │           │   └─ sn.id:6!null\n" +
+			"         │           ├─ SubqueryAlias\n" +
+			"         │           │   ├─ name: `SL3S5`\n" +
+			"         │           │   ├─ outerVisibility: false\n" +
+			"         │           │   ├─ cacheable: true\n" +
+			"         │           │   └─ Project\n" +
+			"         │           │       ├─ columns: [sn.id:17!null as BDNYB, ci.FTQLQ:16!null as TOFPN, ct.M22QN:6!null as M22QN, cec.ADURZ:2!null as ADURZ, cec.NO52D:1!null as NO52D, ct.S3Q3Y:12!null as IDPK7]\n" +
+			"         │           │       └─ Filter\n" +
+			"         │           │           ├─ Eq\n" +
+			"         │           │           │   ├─ ct.M22QN:6!null\n" +
+			"         │           │           │   └─ Subquery\n" +
+			"         │           │           │       ├─ cacheable: true\n" +
+			"         │           │           │       └─ Project\n" +
+			"         │           │           │           ├─ columns: [aac.id:27!null]\n" +
+			"         │           │           │           └─ Filter\n" +
+			"         │           │           │               ├─ Eq\n" +
+			"         │           │           │               │   ├─ aac.BTXC5:28\n" +
+			"         │           │           │               │   └─ WT (longtext)\n" +
+			"         │           │           │               └─ TableAlias(aac)\n" +
+			"         │           │           │                   └─ IndexedTableAccess(mydb.`TPXBU`)\n" +
+			"         │           │           │                       ├─ index: `UZ6GK` [TPXBU.BTXC5]\n" +
+			"         │           │           │                       ├─ static: [{[WT, WT]}]\n" +
+			"         │           │           │                       └─ columns: [id btxc5]\n" +
+			"         │           │           └─ LookupJoin\n" +
+			"         │           │               ├─ Eq\n" +
+			"         │           │               │   ├─ ct.LUEVY:5!null\n" +
+			"         │           │               │   └─ sn.BRQP2:18!null\n" +
+			"         │           │               ├─ LookupJoin\n" +
+			"         │           │               │   ├─ Eq\n" +
+			"         │           │               │   │   ├─ ci.id:15!null\n" +
+			"         │           │               │   │   └─ ct.FZ2R5:4!null\n" +
+			"         │           │               │   ├─ LookupJoin\n" +
+			"         │           │               │   │   ├─ Eq\n" +
+			"         │           │               │   │   │   ├─ cec.id:0!null\n" +
+			"         │           │               │   │   │   └─ ct.OVE3E:7!null\n" +
+			"         │           │               │   │   ├─ TableAlias(cec)\n" +
+			"         │           │               │   │   │   └─ Table\n" +
+			"         │           │               │   │   │       ├─ name: mydb.`SFEGG`\n" +
+			"         │           │               │   │   │       └─ columns: [id no52d adurz]\n" +
+			"         │           │               │   │   └─ Filter\n" +
+			"         │           │               │   │       ├─ Eq\n" +
+			"         │           │               │   │       │   ├─ ct.ZRV3B:10!null\n" +
+			"         │           │               │   │       │   └─ = (longtext)\n" +
+			"         │           │               │   │       └─ TableAlias(ct)\n" +
+			"         │           │               │   │           └─ IndexedTableAccess(mydb.`FLQLP`)\n" +
+			"         │           │               │   │               ├─ index: `OVE3E` [FLQLP.OVE3E]\n" +
+			"         │           │               │   │               └─ columns: [id fz2r5 luevy m22qn ove3e nrurt oca7e xmm6q v5dpx s3q3y zrv3b fhcyt]\n" +
+			"         │           │               │   └─ Filter\n" +
+			"         │           │               │       ├─ HashIn\n" +
+			"         │           │               │       │   ├─ ci.FTQLQ:1!null\n" +
+			"         │           │               │       │   └─ TUPLE(SQ1 (longtext))\n" +
+			"         │           │               │       └─ TableAlias(ci)\n" +
+			"         │           │               │           └─ IndexedTableAccess(mydb.`JDLNA`)\n" +
+			"         │           │               │               ├─ index: `PRIMARY` [JDLNA.id]\n" +
+			"         │           │               │               └─ columns: [id ftqlq]\n" +
+			"         │           │               └─ TableAlias(sn)\n" +
+			"         │           │                   └─ IndexedTableAccess(mydb.`NOXN3`)\n" +
+			"         │           │                       ├─ index: `BRQP2` [NOXN3.BRQP2]\n" +
+			"         │           │                       └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			"         │           └─ TableAlias(sn)\n" +
+			"         │               └─ IndexedTableAccess(mydb.`NOXN3`)\n" +
+			"         │                   ├─ index: `PRIMARY` [NOXN3.id]\n" +
+			"         │                   └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			"         └─ SubqueryAlias\n" +
+			"             ├─ name: `AOEV5`\n" +
+			"             ├─ outerVisibility: false\n" +
+			"             ├─ cacheable: true\n" +
+			"             └─ Values() as temp_AOEV5\n" +
+			"                 ├─ Row(\n" +
+			"                 │  1 (longtext))\n" +
+			"                 ├─ Row(\n" +
+			"                 │  2 (longtext))\n" +
+			"                 ├─ Row(\n" +
+			"                 │  3 (longtext))\n" +
+			"                 ├─ Row(\n" +
+			"                 │  4 (longtext))\n" +
+			"                 └─ Row(\n" +
+			"                    5 (longtext))\n" +
 			"",
 	},
 	{
@@ -9978,7 +10519,7 @@ WHERE
 		ExpectedPlan: "Union all\n" +
 			" ├─ Project\n" +
 			" │   ├─ columns: [convert\n" +
-			" │   │   ├─ type: char\n" +
+			" │   │   ├─ type: longtext\n" +
 			" │   │   └─ T4IBQ:0!null\n" +
 			" │   │   as T4IBQ, DL754:1!null, BDNYB:2!null, ADURZ:3!null, TPXBU:4, NO52D:5!null, IDPK7:6!null]\n" +
 			" │   └─ Project\n" +
@@ -9991,8 +10532,8 @@ WHERE
 			" │       │           │   ├─ aac.id:67!null\n" +
 			" │       │           │   └─ SL3S5.M22QN:2!null\n" +
 			" │       │           └─ TableAlias(aac)\n" +
-			" │       │               └─ IndexedTableAccess(TPXBU)\n" +
-			" │       │                   ├─ index: [TPXBU.id]\n" +
+			" │       │               └─ IndexedTableAccess(mydb.`TPXBU`)\n" +
+			" │       │                   ├─ index: `PRIMARY` [TPXBU.id]\n" +
 			" │       │                   └─ columns: [id btxc5]\n" +
 			" │       │   as TPXBU, SL3S5.NO52D:4!null as NO52D, SL3S5.IDPK7:5!null as IDPK7]\n" +
 			" │       └─ LookupJoin\n" +
@@ -10016,7 +10557,7 @@ WHERE
 			" │           │   │   │   │   ├─ SL3S5.BDNYB:0!null\n" +
 			" │           │   │   │   │   └─ sn.id:6!null\n" +
 			" │           │   │   │   ├─ SubqueryAlias\n" +
-			" │           │   │   │   │   ├─ name: SL3S5\n" +
+			" │           │   │   │   │   ├─ name: `SL3S5`\n" +
 			" │           │   │   │   │   ├─ outerVisibility: false\n" +
 			" │           │   │   │   │   ├─ cacheable: true\n" +
 			" │           │   │   │   │   └─ Project\n" +
@@ -10030,7 +10571,7 @@ WHERE
 			" │           │   │   │   │           │       ├─ ct.LUEVY:7!null\n" +
 			" │           │   │   │   │           │       └─ KHJJO.LUEVY:2!null\n" +
 			" │           │   │   │   │           ├─ SubqueryAlias\n" +
-			" │           │   │   │   │           │   ├─ name: KHJJO\n" +
+			" │           │   │   │   │           │   ├─ name: `KHJJO`\n" +
 			" │           │   │   │   │           │   ├─ outerVisibility: false\n" +
 			" │           │   │   │   │           │   ├─ cacheable: true\n" +
 			" │           │   │   │   │           │   └─ Distinct\n" +
@@ -10042,11 +10583,11 @@ WHERE
 			" │           │   │   │   │           │               │   └─ mf.LUEVY:12!null\n" +
 			" │           │   │   │   │           │               ├─ TableAlias(sn)\n" +
 			" │           │   │   │   │           │               │   └─ Table\n" +
-			" │           │   │   │   │           │               │       ├─ name: NOXN3\n" +
+			" │           │   │   │   │           │               │       ├─ name: mydb.`NOXN3`\n" +
 			" │           │   │   │   │           │               │       └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
 			" │           │   │   │   │           │               └─ TableAlias(mf)\n" +
-			" │           │   │   │   │           │                   └─ IndexedTableAccess(HGMQ6)\n" +
-			" │           │   │   │   │           │                       ├─ index: [HGMQ6.LUEVY]\n" +
+			" │           │   │   │   │           │                   └─ IndexedTableAccess(mydb.`HGMQ6`)\n" +
+			" │           │   │   │   │           │                       ├─ index: `LUEVY` [HGMQ6.LUEVY]\n" +
 			" │           │   │   │   │           │                       └─ columns: [id gxlub luevy m22qn tjpt7 arn5p xosd4 ide43 hmw4h zbt6r fsdy2 lt7k6 sppyd qcgts teuja qqv4m fhcyt]\n" +
 			" │           │   │   │   │           └─ HashLookup\n" +
 			" │           │   │   │   │               ├─ source: TUPLE(KHJJO.M22QN:0!null, KHJJO.LUEVY:2!null)\n" +
@@ -10062,148 +10603,146 @@ WHERE
 			" │           │   │   │   │                       │   │   └─ ct.OVE3E:9!null\n" +
 			" │           │   │   │   │                       │   ├─ TableAlias(cec)\n" +
 			" │           │   │   │   │                       │   │   └─ Table\n" +
-			" │           │   │   │   │                       │   │       ├─ name: SFEGG\n" +
+			" │           │   │   │   │                       │   │       ├─ name: mydb.`SFEGG`\n" +
 			" │           │   │   │   │                       │   │       └─ columns: [id no52d adurz]\n" +
 			" │           │   │   │   │                       │   └─ Filter\n" +
 			" │           │   │   │   │                       │       ├─ Eq\n" +
 			" │           │   │   │   │                       │       │   ├─ ct.ZRV3B:5!null\n" +
 			" │           │   │   │   │                       │       │   └─ = (longtext)\n" +
 			" │           │   │   │   │                       │       └─ TableAlias(ct)\n" +
-			" │           │   │   │   │                       │           └─ IndexedTableAccess(FLQLP)\n" +
-			" │           │   │   │   │                       │               ├─ index: [FLQLP.OVE3E]\n" +
+			" │           │   │   │   │                       │           └─ IndexedTableAccess(mydb.`FLQLP`)\n" +
+			" │           │   │   │   │                       │               ├─ index: `OVE3E` [FLQLP.OVE3E]\n" +
 			" │           │   │   │   │                       │               └─ columns: [fz2r5 luevy m22qn ove3e s3q3y zrv3b]\n" +
 			" │           │   │   │   │                       └─ Filter\n" +
 			" │           │   │   │   │                           ├─ HashIn\n" +
 			" │           │   │   │   │                           │   ├─ ci.FTQLQ:1!null\n" +
 			" │           │   │   │   │                           │   └─ TUPLE(SQ1 (longtext))\n" +
 			" │           │   │   │   │                           └─ TableAlias(ci)\n" +
-			" │           │   │   │   │                               └─ IndexedTableAccess(JDLNA)\n" +
-			" │           │   │   │   │                                   ├─ index: [JDLNA.id]\n" +
+			" │           │   │   │   │                               └─ IndexedTableAccess(mydb.`JDLNA`)\n" +
+			" │           │   │   │   │                                   ├─ index: `PRIMARY` [JDLNA.id]\n" +
 			" │           │   │   │   │                                   └─ columns: [id ftqlq]\n" +
 			" │           │   │   │   └─ TableAlias(sn)\n" +
-			" │           │   │   │       └─ IndexedTableAccess(NOXN3)\n" +
-			" │           │   │   │           ├─ index: [NOXN3.id]\n" +
+			" │           │   │   │       └─ IndexedTableAccess(mydb.`NOXN3`)\n" +
+			" │           │   │   │           ├─ index: `PRIMARY` [NOXN3.id]\n" +
 			" │           │   │   │           └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
 			" │           │   │   └─ TableAlias(mf)\n" +
-			" │           │   │       └─ IndexedTableAccess(HGMQ6)\n" +
-			" │           │   │           ├─ index: [HGMQ6.LUEVY]\n" +
+			" │           │   │       └─ IndexedTableAccess(mydb.`HGMQ6`)\n" +
+			" │           │   │           ├─ index: `LUEVY` [HGMQ6.LUEVY]\n" +
 			" │           │   │           └─ columns: [id gxlub luevy m22qn tjpt7 arn5p xosd4 ide43 hmw4h zbt6r fsdy2 lt7k6 sppyd qcgts teuja qqv4m fhcyt]\n" +
 			" │           │   └─ TableAlias(bs)\n" +
-			" │           │       └─ IndexedTableAccess(THNTS)\n" +
-			" │           │           ├─ index: [THNTS.id]\n" +
+			" │           │       └─ IndexedTableAccess(mydb.`THNTS`)\n" +
+			" │           │           ├─ index: `PRIMARY` [THNTS.id]\n" +
 			" │           │           └─ columns: [id nfryn ixuxu fhcyt]\n" +
 			" │           └─ Filter\n" +
 			" │               ├─ HashIn\n" +
 			" │               │   ├─ cla.FTQLQ:1!null\n" +
 			" │               │   └─ TUPLE(SQ1 (longtext))\n" +
 			" │               └─ TableAlias(cla)\n" +
-			" │                   └─ IndexedTableAccess(YK2GW)\n" +
-			" │                       ├─ index: [YK2GW.id]\n" +
+			" │                   └─ IndexedTableAccess(mydb.`YK2GW`)\n" +
+			" │                       ├─ index: `PRIMARY` [YK2GW.id]\n" +
 			" │                       └─ columns: [id ftqlq tuxml paef5 rucy4 tpnj6 lbl53 nb3qs eo7iv muhjf fm34l ty5rf zhtlh npb7w sx3hh isbnf ya7yb c5ykb qk7kt ffge6 fiigj sh3nc ntena m4aub x5air sab6m g5qi5 zvqvd ykssu fhcyt]\n" +
 			" └─ Project\n" +
-			"     ├─ columns: [AOEV5.T4IBQ:0!null as T4IBQ, VUMUY.DL754:1!null, VUMUY.BDNYB:2!null, VUMUY.ADURZ:3!null, VUMUY.TPXBU:4, VUMUY.NO52D:5!null, VUMUY.IDPK7:6!null]\n" +
-			"     └─ Project\n" +
-			"         ├─ columns: [AOEV5.T4IBQ:6!null, VUMUY.DL754:0!null, VUMUY.BDNYB:1!null, VUMUY.ADURZ:2!null, VUMUY.TPXBU:3, VUMUY.NO52D:4!null, VUMUY.IDPK7:5!null]\n" +
-			"         └─ CrossJoin\n" +
-			"             ├─ SubqueryAlias\n" +
-			"             │   ├─ name: VUMUY\n" +
-			"             │   ├─ outerVisibility: false\n" +
-			"             │   ├─ cacheable: true\n" +
-			"             │   └─ Project\n" +
-			"             │       ├─ columns: [SL3S5.TOFPN:1!null as DL754, sn.id:6!null as BDNYB, SL3S5.ADURZ:3!null as ADURZ, Subquery\n" +
-			"             │       │   ├─ cacheable: false\n" +
-			"             │       │   └─ Project\n" +
-			"             │       │       ├─ columns: [aac.BTXC5:17]\n" +
-			"             │       │       └─ Filter\n" +
-			"             │       │           ├─ Eq\n" +
-			"             │       │           │   ├─ aac.id:16!null\n" +
-			"             │       │           │   └─ SL3S5.M22QN:2!null\n" +
-			"             │       │           └─ TableAlias(aac)\n" +
-			"             │       │               └─ IndexedTableAccess(TPXBU)\n" +
-			"             │       │                   ├─ index: [TPXBU.id]\n" +
-			"             │       │                   └─ columns: [id btxc5]\n" +
-			"             │       │   as TPXBU, SL3S5.NO52D:4!null as NO52D, SL3S5.IDPK7:5!null as IDPK7]\n" +
-			"             │       └─ LookupJoin\n" +
-			"             │           ├─ Eq\n" +
-			"             │           │   ├─ SL3S5.BDNYB:0!null\n" +
-			"             │           │   └─ sn.id:6!null\n" +
-			"             │           ├─ SubqueryAlias\n" +
-			"             │           │   ├─ name: SL3S5\n" +
-			"             │           │   ├─ outerVisibility: false\n" +
-			"             │           │   ├─ cacheable: true\n" +
-			"             │           │   └─ Project\n" +
-			"             │           │       ├─ columns: [sn.id:17!null as BDNYB, ci.FTQLQ:16!null as TOFPN, ct.M22QN:6!null as M22QN, cec.ADURZ:2!null as ADURZ, cec.NO52D:1!null as NO52D, ct.S3Q3Y:12!null as IDPK7]\n" +
-			"             │           │       └─ Filter\n" +
-			"             │           │           ├─ Eq\n" +
-			"             │           │           │   ├─ ct.M22QN:6!null\n" +
-			"             │           │           │   └─ Subquery\n" +
-			"             │           │           │       ├─ cacheable: true\n" +
-			"             │           │           │       └─ Project\n" +
-			"             │           │           │           ├─ columns: [aac.id:27!null]\n" +
-			"             │           │           │           └─ Filter\n" +
-			"             │           │           │               ├─ Eq\n" +
-			"             │           │           │               │   ├─ aac.BTXC5:28\n" +
-			"             │           │           │               │   └─ WT (longtext)\n" +
-			"             │           │           │               └─ TableAlias(aac)\n" +
-			"             │           │           │                   └─ IndexedTableAccess(TPXBU)\n" +
-			"             │           │           │                       ├─ index: [TPXBU.BTXC5]\n" +
-			"             │           │           │                       ├─ static: [{[WT, WT]}]\n" +
-			"             │           │           │                       └─ columns: [id btxc5]\n" +
-			"             │           │           └─ LookupJoin\n" +
-			"             │           │               ├─ Eq\n" +
-			"             │           │               │   ├─ ct.LUEVY:5!null\n" +
-			"             │           │               │   └─ sn.BRQP2:18!null\n" +
-			"             │           │               ├─ LookupJoin\n" +
-			"             │           │               │   ├─ Eq\n" +
-			"             │           │               │   │   ├─ ci.id:15!null\n" +
-			"             │           │               │   │   └─ ct.FZ2R5:4!null\n" +
-			"             │           │               │   ├─ LookupJoin\n" +
-			"             │           │               │   │   ├─ Eq\n" +
-			"             │           │               │   │   │   ├─ cec.id:0!null\n" +
-			"             │           │               │   │   │   └─ ct.OVE3E:7!null\n" +
-			"             │           │               │   │   ├─ TableAlias(cec)\n" +
-			"             │           │               │   │   │   └─ Table\n" +
-			"             │           │               │   │   │       ├─ name: SFEGG\n" +
-			"             │           │               │   │   │       └─ columns: [id no52d adurz]\n" +
-			"             │           │               │   │   └─ Filter\n" +
-			"             │           │               │   │       ├─ Eq\n" +
-			"             │           │               │   │       │   ├─ ct.ZRV3B:10!null\n" +
-			"             │           │               │   │       │   └─ = (longtext)\n" +
-			"             │           │               │   │       └─ TableAlias(ct)\n" +
-			"             │           │               │   │           └─ IndexedTableAccess(FLQLP)\n" +
-			"             │           │               │   │               ├─ index: [FLQLP.OVE3E]\n" +
-			"             │           │               │   │               └─ columns: [id fz2r5 luevy m22qn ove3e nrurt oca7e xmm6q v5dpx s3q3y zrv3b fhcyt]\n" +
-			"             │           │               │   └─ Filter\n" +
-			"             │           │               │       ├─ HashIn\n" +
-			"             │           │               │       │   ├─ ci.FTQLQ:1!null\n" +
-			"             │           │               │       │   └─ TUPLE(SQ1 (longtext))\n" +
-			"             │           │               │       └─ TableAlias(ci)\n" +
-			"             │           │               │           └─ IndexedTableAccess(JDLNA)\n" +
-			"             │           │               │               ├─ index: [JDLNA.id]\n" +
-			"             │           │               │               └─ columns: [id ftqlq]\n" +
-			"             │           │               └─ TableAlias(sn)\n" +
-			"             │           │                   └─ IndexedTableAccess(NOXN3)\n" +
-			"             │           │                       ├─ index: [NOXN3.BRQP2]\n" +
-			"             │           │                       └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
-			"             │           └─ TableAlias(sn)\n" +
-			"             │               └─ IndexedTableAccess(NOXN3)\n" +
-			"             │                   ├─ index: [NOXN3.id]\n" +
-			"             │                   └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
-			"             └─ SubqueryAlias\n" +
-			"                 ├─ name: AOEV5\n" +
-			"                 ├─ outerVisibility: false\n" +
-			"                 ├─ cacheable: true\n" +
-			"                 └─ Values() as temp_AOEV5\n" +
-			"                     ├─ Row(\n" +
-			"                     │  1 (longtext))\n" +
-			"                     ├─ Row(\n" +
-			"                     │  2 (longtext))\n" +
-			"                     ├─ Row(\n" +
-			"                     │  3 (longtext))\n" +
-			"                     ├─ Row(\n" +
-			"                     │  4 (longtext))\n" +
-			"                     └─ Row(\n" +
-			"                        5 (longtext))\n" +
+			"     ├─ columns: [AOEV5.T4IBQ:6!null, VUMUY.DL754:0!null, VUMUY.BDNYB:1!null, VUMUY.ADURZ:2!null, VUMUY.TPXBU:3, VUMUY.NO52D:4!null, VUMUY.IDPK7:5!null]\n" +
+			"     └─ CrossJoin\n" +
+			"         ├─ SubqueryAlias\n" +
+			"         │   ├─ name: `VUMUY`\n" +
+			"         │   ├─ outerVisibility: false\n" +
+			"         │   ├─ cacheable: true\n" +
+			"         │   └─ Project\n" +
+			"         │       ├─ columns: [SL3S5.TOFPN:1!null as DL754, sn.id:6!null as BDNYB, SL3S5.ADURZ:3!null as ADURZ, Subquery\n" +
+			"         │       │   ├─ cacheable: false\n" +
+			"         │       │   └─ Project\n" +
+			"         │       │       ├─ columns: [aac.BTXC5:17]\n" +
+			"         │       │       └─ Filter\n" +
+			"         │       │           ├─ Eq\n" +
+			"         │       │           │   ├─ aac.id:16!null\n" +
+			"         │       │           │   └─ SL3S5.M22QN:2!null\n" +
+			"         │       │           └─ TableAlias(aac)\n" +
+			"         │       │               └─ IndexedTableAccess(mydb.`TPXBU`)\n" +
+			"         │       │                   ├─ index: `PRIMARY` [TPXBU.id]\n" +
+			"         │       │                   └─ columns: [id btxc5]\n" +
+			"         │       │   as TPXBU, SL3S5.NO52D:4!null as NO52D, SL3S5.IDPK7:5!null as IDPK7]\n" +
+			"         │       └─ LookupJoin\n" +
+			"         │           ├─ Eq\n" +
+			"         │           │   ├─ SL3S5.BDNYB:0!null\n" +
+			"         │           │   └─ sn.id:6!null\n" +
+			"         │           ├─ SubqueryAlias\n" +
+			"         │           │   ├─ name: `SL3S5`\n" +
+			"         │           │   ├─ outerVisibility: false\n" +
+			"         │           │   ├─ cacheable: true\n" +
+			"         │           │   └─ Project\n" +
+			"         │           │       ├─ columns: [sn.id:17!null as BDNYB, ci.FTQLQ:16!null as TOFPN, ct.M22QN:6!null as M22QN, cec.ADURZ:2!null as ADURZ, cec.NO52D:1!null as NO52D, ct.S3Q3Y:12!null as IDPK7]\n" +
+			"         │           │       └─ Filter\n" +
+			"         │           │           ├─ Eq\n" +
+			"         │           │           │   ├─ ct.M22QN:6!null\n" +
+			"         │           │           │   └─ Subquery\n" +
+			"         │           │           │       ├─ cacheable: true\n" +
+			"         │           │           │       └─ Project\n" +
+			"         │           │           │           ├─ columns: [aac.id:27!null]\n" +
+			"         │           │           │           └─ Filter\n" +
+			"         │           │           │               ├─ Eq\n" +
+			"         │           │           │               │   ├─ aac.BTXC5:28\n" +
+			"         │           │           │               │   └─ WT (longtext)\n" +
+			"         │           │           │               └─ TableAlias(aac)\n" +
+			"         │           │           │                   └─ IndexedTableAccess(mydb.`TPXBU`)\n" +
+			"         │           │           │                       ├─ index: `UZ6GK` [TPXBU.BTXC5]\n" +
+			"         │           │           │                       ├─ static: [{[WT, WT]}]\n" +
+			"         │           │           │                       └─ columns: [id btxc5]\n" +
+			"         │           │           └─ LookupJoin\n" +
+			"         │           │               ├─ Eq\n" +
+			"         │           │               │   ├─ ct.LUEVY:5!null\n" +
+			"         │           │               │   └─ sn.BRQP2:18!null\n" +
+			"         │           │               ├─ LookupJoin\n" +
+			"         │           │               │   ├─ Eq\n" +
+			"         │           │               │   │   ├─ ci.id:15!null\n" +
+			"         │           │               │   │   └─ ct.FZ2R5:4!null\n" +
+			"         │           │               │   ├─ LookupJoin\n" +
+			"         │           │               │   │   ├─ Eq\n" +
+			"         │           │               │   │   │   ├─ cec.id:0!null\n" +
+			"         │           │               │   │   │   └─ ct.OVE3E:7!null\n" +
+			"         │           │               │   │   ├─ TableAlias(cec)\n" +
+			"         │           │               │   │   │   └─ Table\n" +
+			"         │           │               │   │   │       ├─ name: mydb.`SFEGG`\n" +
+			"         │           │               │   │   │       └─ columns: [id no52d adurz]\n" +
+			"         │           │               │   │   └─ Filter\n" +
+			"         │           │               │   │       ├─ Eq\n" +
+			"         │           │               │   │       │   ├─ ct.ZRV3B:10!null\n" +
+			"         │           │               │   │       │   └─ = (longtext)\n" +
+			"         │           │               │   │       └─ TableAlias(ct)\n" +
+			"         │           │               │   │           └─ IndexedTableAccess(mydb.`FLQLP`)\n" +
+			"         │           │               │   │               ├─ index: `OVE3E` [FLQLP.OVE3E]\n" +
+			"         │           │               │   │               └─ columns: [id fz2r5 luevy m22qn ove3e nrurt oca7e xmm6q v5dpx s3q3y zrv3b fhcyt]\n" +
+			"         │           │               │   └─ Filter\n" +
+			"         │           │               │       ├─ HashIn\n" +
+			"         │           │               │       │   ├─ ci.FTQLQ:1!null\n" +
+			"         │           │               │       │   └─ TUPLE(SQ1 (longtext))\n" +
+			"         │           │               │       └─ TableAlias(ci)\n" +
+			"         │           │               │           └─ IndexedTableAccess(mydb.`JDLNA`)\n" +
+			"         │           │               │               ├─ index: `PRIMARY` [JDLNA.id]\n" +
+			"         │           │               │               └─ columns: [id ftqlq]\n" +
+			"         │           │               └─ TableAlias(sn)\n" +
+			"         │           │                   └─ IndexedTableAccess(mydb.`NOXN3`)\n" +
+			"         │           │                       ├─ index: `BRQP2` [NOXN3.BRQP2]\n" +
+			"         │           │                       └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			"         │           └─ TableAlias(sn)\n" +
+			"         │               └─ IndexedTableAccess(mydb.`NOXN3`)\n" +
+			"         │                   ├─ index: `PRIMARY` [NOXN3.id]\n" +
+			"         │                   └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			"         └─ SubqueryAlias\n" +
+			"             ├─ name: `AOEV5`\n" +
+			"             ├─ outerVisibility: false\n" +
+			"             ├─ cacheable: true\n" +
+			"             └─ Values() as temp_AOEV5\n" +
+			"                 ├─ Row(\n" +
+			"                 │  1 (longtext))\n" +
+			"                 ├─ Row(\n" +
+			"                 │  2 (longtext))\n" +
+			"                 ├─ Row(\n" +
+			"                 │  3 (longtext))\n" +
+			"                 ├─ Row(\n" +
+			"                 │  4 (longtext))\n" +
+			"                 └─ Row(\n" +
+			"                    5 (longtext))\n" +
 			"",
 	},
 	{
@@ -10215,7 +10754,7 @@ WHERE
 			"     ├─ select: COUNT(1 (bigint))\n" +
 			"     ├─ group: \n" +
 			"     └─ Table\n" +
-			"         ├─ name: NOXN3\n" +
+			"         ├─ name: mydb.`NOXN3`\n" +
 			"         └─ columns: []\n" +
 			"",
 	},
@@ -10259,7 +10798,7 @@ WHERE
 			"         │   │   ├─ TYMVL.id:6!null\n" +
 			"         │   │   └─ NB6PJ.FFTBJ:3!null\n" +
 			"         │   ├─ SubqueryAlias\n" +
-			"         │   │   ├─ name: NB6PJ\n" +
+			"         │   │   ├─ name: `NB6PJ`\n" +
 			"         │   │   ├─ outerVisibility: false\n" +
 			"         │   │   ├─ cacheable: true\n" +
 			"         │   │   └─ Sort(NOXN3.id:1!null ASC nullsFirst)\n" +
@@ -10273,15 +10812,15 @@ WHERE
 			"         │   │               ├─ NOXN3.NUMK2:3!null\n" +
 			"         │   │               ├─ NOXN3.LETOE:4!null\n" +
 			"         │   │               └─ Table\n" +
-			"         │   │                   ├─ name: NOXN3\n" +
+			"         │   │                   ├─ name: mydb.`NOXN3`\n" +
 			"         │   │                   └─ columns: [id brqp2 fftbj numk2 letoe]\n" +
-			"         │   └─ TableAlias(TYMVL)\n" +
-			"         │       └─ IndexedTableAccess(E2I7U)\n" +
-			"         │           ├─ index: [E2I7U.id]\n" +
+			"         │   └─ TableAlias(`TYMVL`)\n" +
+			"         │       └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			"         │           ├─ index: `PRIMARY` [E2I7U.id]\n" +
 			"         │           └─ columns: [id tw55n]\n" +
-			"         └─ TableAlias(S7EGW)\n" +
-			"             └─ IndexedTableAccess(E2I7U)\n" +
-			"                 ├─ index: [E2I7U.id]\n" +
+			"         └─ TableAlias(`S7EGW`)\n" +
+			"             └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			"                 ├─ index: `PRIMARY` [E2I7U.id]\n" +
 			"                 └─ columns: [id tw55n]\n" +
 			"",
 	},
@@ -10314,7 +10853,7 @@ WHERE
 			"         │   ├─ nd.id:6!null\n" +
 			"         │   └─ NB6PJ.BRQP2:2!null\n" +
 			"         ├─ SubqueryAlias\n" +
-			"         │   ├─ name: NB6PJ\n" +
+			"         │   ├─ name: `NB6PJ`\n" +
 			"         │   ├─ outerVisibility: false\n" +
 			"         │   ├─ cacheable: true\n" +
 			"         │   └─ Sort(NOXN3.id:1!null ASC nullsFirst)\n" +
@@ -10328,11 +10867,11 @@ WHERE
 			"         │               ├─ NOXN3.NUMK2:3!null\n" +
 			"         │               ├─ NOXN3.LETOE:4!null\n" +
 			"         │               └─ Table\n" +
-			"         │                   ├─ name: NOXN3\n" +
+			"         │                   ├─ name: mydb.`NOXN3`\n" +
 			"         │                   └─ columns: [id brqp2 fftbj numk2 letoe]\n" +
 			"         └─ TableAlias(nd)\n" +
-			"             └─ IndexedTableAccess(E2I7U)\n" +
-			"                 ├─ index: [E2I7U.id]\n" +
+			"             └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			"                 ├─ index: `PRIMARY` [E2I7U.id]\n" +
 			"                 └─ columns: [id tw55n]\n" +
 			"",
 	},
@@ -10370,17 +10909,17 @@ WHERE
 			"             │   ├─ Eq\n" +
 			"             │   │   ├─ sn.FFTBJ:4!null\n" +
 			"             │   │   └─ TYMVL.id:0!null\n" +
-			"             │   ├─ TableAlias(TYMVL)\n" +
+			"             │   ├─ TableAlias(`TYMVL`)\n" +
 			"             │   │   └─ Table\n" +
-			"             │   │       ├─ name: E2I7U\n" +
+			"             │   │       ├─ name: mydb.`E2I7U`\n" +
 			"             │   │       └─ columns: [id tw55n]\n" +
 			"             │   └─ TableAlias(sn)\n" +
-			"             │       └─ IndexedTableAccess(NOXN3)\n" +
-			"             │           ├─ index: [NOXN3.FFTBJ]\n" +
+			"             │       └─ IndexedTableAccess(mydb.`NOXN3`)\n" +
+			"             │           ├─ index: `FFTBJ` [NOXN3.FFTBJ]\n" +
 			"             │           └─ columns: [id brqp2 fftbj numk2 letoe]\n" +
-			"             └─ TableAlias(S7EGW)\n" +
-			"                 └─ IndexedTableAccess(E2I7U)\n" +
-			"                     ├─ index: [E2I7U.id]\n" +
+			"             └─ TableAlias(`S7EGW`)\n" +
+			"                 └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			"                     ├─ index: `PRIMARY` [E2I7U.id]\n" +
 			"                     └─ columns: [id tw55n]\n" +
 			"",
 	},
@@ -10394,7 +10933,7 @@ WHERE
 			"     ├─ row_number() over ( order by sn.id ASC)\n" +
 			"     └─ TableAlias(sn)\n" +
 			"         └─ Table\n" +
-			"             ├─ name: NOXN3\n" +
+			"             ├─ name: mydb.`NOXN3`\n" +
 			"             └─ columns: [id]\n" +
 			"",
 	},
@@ -10431,17 +10970,17 @@ WHERE
 			"         │   │   │       ├─ nt.DZLIM:1!null\n" +
 			"         │   │   │       └─ SUZTA (longtext)\n" +
 			"         │   │   └─ TableAlias(nt)\n" +
-			"         │   │       └─ IndexedTableAccess(F35MI)\n" +
-			"         │   │           ├─ index: [F35MI.DZLIM]\n" +
+			"         │   │       └─ IndexedTableAccess(mydb.`F35MI`)\n" +
+			"         │   │           ├─ index: `FTWOK` [F35MI.DZLIM]\n" +
 			"         │   │           ├─ static: [{(SUZTA, ∞)}, {(NULL, SUZTA)}]\n" +
 			"         │   │           └─ columns: [id dzlim]\n" +
 			"         │   └─ TableAlias(nd)\n" +
-			"         │       └─ IndexedTableAccess(E2I7U)\n" +
-			"         │           ├─ index: [E2I7U.DKCAJ]\n" +
+			"         │       └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			"         │           ├─ index: `DKCAJ` [E2I7U.DKCAJ]\n" +
 			"         │           └─ columns: [id dkcaj tw55n]\n" +
 			"         └─ TableAlias(il)\n" +
-			"             └─ IndexedTableAccess(RLOHD)\n" +
-			"                 ├─ index: [RLOHD.LUEVY]\n" +
+			"             └─ IndexedTableAccess(mydb.`RLOHD`)\n" +
+			"                 ├─ index: `HVHB5` [RLOHD.LUEVY]\n" +
 			"                 └─ columns: [luevy liilr ksfxh klmau ecm]\n" +
 			"",
 	},
@@ -10455,8 +10994,8 @@ WHERE
 			" ├─ HashIn\n" +
 			" │   ├─ YK2GW.FTQLQ:0!null\n" +
 			" │   └─ TUPLE(SQ1 (longtext))\n" +
-			" └─ IndexedTableAccess(YK2GW)\n" +
-			"     ├─ index: [YK2GW.FTQLQ]\n" +
+			" └─ IndexedTableAccess(mydb.`YK2GW`)\n" +
+			"     ├─ index: `EZCTT` [YK2GW.FTQLQ]\n" +
 			"     ├─ static: [{[SQ1, SQ1]}]\n" +
 			"     └─ columns: [ftqlq tpnj6]\n" +
 			"",
@@ -10532,7 +11071,7 @@ WHERE
 			"         │       ├─ fc.GXLUB:6!null\n" +
 			"         │       └─ ATHCU.B2TX3:0!null\n" +
 			"         ├─ SubqueryAlias\n" +
-			"         │   ├─ name: ATHCU\n" +
+			"         │   ├─ name: `ATHCU`\n" +
 			"         │   ├─ outerVisibility: false\n" +
 			"         │   ├─ cacheable: true\n" +
 			"         │   └─ Project\n" +
@@ -10545,13 +11084,13 @@ WHERE
 			"         │       │           │   ├─ nt.id:19!null\n" +
 			"         │       │           │   └─ nd.DKCAJ:3!null\n" +
 			"         │       │           └─ TableAlias(nt)\n" +
-			"         │       │               └─ IndexedTableAccess(F35MI)\n" +
-			"         │       │                   ├─ index: [F35MI.id]\n" +
+			"         │       │               └─ IndexedTableAccess(mydb.`F35MI`)\n" +
+			"         │       │                   ├─ index: `PRIMARY` [F35MI.id]\n" +
 			"         │       │                   └─ columns: [id dzlim]\n" +
 			"         │       │   as SJ5DU]\n" +
 			"         │       └─ CrossJoin\n" +
 			"         │           ├─ SubqueryAlias\n" +
-			"         │           │   ├─ name: TMDTP\n" +
+			"         │           │   ├─ name: `TMDTP`\n" +
 			"         │           │   ├─ outerVisibility: false\n" +
 			"         │           │   ├─ cacheable: true\n" +
 			"         │           │   └─ Project\n" +
@@ -10565,22 +11104,22 @@ WHERE
 			"         │           │               │   ├─ bs.IXUXU:1\n" +
 			"         │           │               │   └─ cla.id:2!null\n" +
 			"         │           │               ├─ TableAlias(bs)\n" +
-			"         │           │               │   └─ IndexedTableAccess(THNTS)\n" +
-			"         │           │               │       ├─ index: [THNTS.IXUXU]\n" +
+			"         │           │               │   └─ IndexedTableAccess(mydb.`THNTS`)\n" +
+			"         │           │               │       ├─ index: `IXUXU` [THNTS.IXUXU]\n" +
 			"         │           │               │       ├─ static: [{[NULL, ∞)}]\n" +
 			"         │           │               │       └─ columns: [id ixuxu]\n" +
 			"         │           │               └─ TableAlias(cla)\n" +
-			"         │           │                   └─ IndexedTableAccess(YK2GW)\n" +
-			"         │           │                       ├─ index: [YK2GW.id]\n" +
+			"         │           │                   └─ IndexedTableAccess(mydb.`YK2GW`)\n" +
+			"         │           │                       ├─ index: `PRIMARY` [YK2GW.id]\n" +
 			"         │           │                       ├─ static: [{[NULL, ∞)}]\n" +
 			"         │           │                       └─ columns: [id ftqlq]\n" +
 			"         │           └─ TableAlias(nd)\n" +
 			"         │               └─ Table\n" +
-			"         │                   ├─ name: E2I7U\n" +
+			"         │                   ├─ name: mydb.`E2I7U`\n" +
 			"         │                   └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
 			"         └─ TableAlias(fc)\n" +
-			"             └─ IndexedTableAccess(AMYXQ)\n" +
-			"                 ├─ index: [AMYXQ.GXLUB,AMYXQ.LUEVY]\n" +
+			"             └─ IndexedTableAccess(mydb.`AMYXQ`)\n" +
+			"                 ├─ index: `VN3QP` [AMYXQ.GXLUB,AMYXQ.LUEVY]\n" +
 			"                 └─ columns: [gxlub luevy oztqf]\n" +
 			"",
 	},
@@ -10705,7 +11244,7 @@ WHERE
 			"     └─ Project\n" +
 			"         ├─ columns: [XPRW6.T4IBQ:0!null as T4IBQ, XPRW6.ECUWU:1 as ECUWU, XPRW6.B5OUF:3, XPRW6.SP4SI:4!null]\n" +
 			"         └─ SubqueryAlias\n" +
-			"             ├─ name: XPRW6\n" +
+			"             ├─ name: `XPRW6`\n" +
 			"             ├─ outerVisibility: false\n" +
 			"             ├─ cacheable: true\n" +
 			"             └─ Project\n" +
@@ -10723,14 +11262,14 @@ WHERE
 			"                     └─ Project\n" +
 			"                         ├─ columns: [NRFJ3.T4IBQ:0!null as T4IBQ, NRFJ3.ECUWU:1 as ECUWU, NRFJ3.GSTQA:2 as GSTQA, NRFJ3.B5OUF:3, NRFJ3.OZTQF:5, NRFJ3.YHYLK:6]\n" +
 			"                         └─ SubqueryAlias\n" +
-			"                             ├─ name: NRFJ3\n" +
+			"                             ├─ name: `NRFJ3`\n" +
 			"                             ├─ outerVisibility: false\n" +
 			"                             ├─ cacheable: true\n" +
 			"                             └─ Distinct\n" +
 			"                                 └─ Project\n" +
 			"                                     ├─ columns: [AX7FV.T4IBQ:0!null, AX7FV.ECUWU:1, AX7FV.GSTQA:2, AX7FV.B5OUF:3, AX7FV.TW55N:6, AX7FV.OZTQF:4, AX7FV.YHYLK:5]\n" +
 			"                                     └─ SubqueryAlias\n" +
-			"                                         ├─ name: AX7FV\n" +
+			"                                         ├─ name: `AX7FV`\n" +
 			"                                         ├─ outerVisibility: false\n" +
 			"                                         ├─ cacheable: true\n" +
 			"                                         └─ Project\n" +
@@ -10777,12 +11316,12 @@ WHERE
 			"                                                     │   │   │   │               ├─ cmp: Eq\n" +
 			"                                                     │   │   │   │               │   ├─ YK2GW.id:0!null\n" +
 			"                                                     │   │   │   │               │   └─ THNTS.IXUXU:3\n" +
-			"                                                     │   │   │   │               ├─ IndexedTableAccess(YK2GW)\n" +
-			"                                                     │   │   │   │               │   ├─ index: [YK2GW.id]\n" +
+			"                                                     │   │   │   │               ├─ IndexedTableAccess(mydb.`YK2GW`)\n" +
+			"                                                     │   │   │   │               │   ├─ index: `PRIMARY` [YK2GW.id]\n" +
 			"                                                     │   │   │   │               │   ├─ static: [{[NULL, ∞)}]\n" +
 			"                                                     │   │   │   │               │   └─ columns: [id ftqlq]\n" +
-			"                                                     │   │   │   │               └─ IndexedTableAccess(THNTS)\n" +
-			"                                                     │   │   │   │                   ├─ index: [THNTS.IXUXU]\n" +
+			"                                                     │   │   │   │               └─ IndexedTableAccess(mydb.`THNTS`)\n" +
+			"                                                     │   │   │   │                   ├─ index: `IXUXU` [THNTS.IXUXU]\n" +
 			"                                                     │   │   │   │                   ├─ static: [{[NULL, ∞)}]\n" +
 			"                                                     │   │   │   │                   └─ columns: [id ixuxu]\n" +
 			"                                                     │   │   │   └─ LookupJoin\n" +
@@ -10807,34 +11346,34 @@ WHERE
 			"                                                     │   │   │       │   │   │   │   │   └─ pa.id:2!null\n" +
 			"                                                     │   │   │       │   │   │   │   ├─ TableAlias(pa)\n" +
 			"                                                     │   │   │       │   │   │   │   │   └─ Table\n" +
-			"                                                     │   │   │       │   │   │   │   │       ├─ name: XOAOP\n" +
+			"                                                     │   │   │       │   │   │   │   │       ├─ name: mydb.`XOAOP`\n" +
 			"                                                     │   │   │       │   │   │   │   │       └─ columns: [id dzlim]\n" +
 			"                                                     │   │   │       │   │   │   │   └─ TableAlias(ms)\n" +
-			"                                                     │   │   │       │   │   │   │       └─ IndexedTableAccess(SZQWJ)\n" +
-			"                                                     │   │   │       │   │   │   │           ├─ index: [SZQWJ.CH3FR]\n" +
+			"                                                     │   │   │       │   │   │   │       └─ IndexedTableAccess(mydb.`SZQWJ`)\n" +
+			"                                                     │   │   │       │   │   │   │           ├─ index: `CH3FR` [SZQWJ.CH3FR]\n" +
 			"                                                     │   │   │       │   │   │   │           └─ columns: [gxlub ch3fr d237e]\n" +
 			"                                                     │   │   │       │   │   │   └─ TableAlias(pog)\n" +
-			"                                                     │   │   │       │   │   │       └─ IndexedTableAccess(NPCYY)\n" +
-			"                                                     │   │   │       │   │   │           ├─ index: [NPCYY.CH3FR,NPCYY.XVSBH]\n" +
+			"                                                     │   │   │       │   │   │       └─ IndexedTableAccess(mydb.`NPCYY`)\n" +
+			"                                                     │   │   │       │   │   │           ├─ index: `S2DGA` [NPCYY.CH3FR,NPCYY.XVSBH]\n" +
 			"                                                     │   │   │       │   │   │           └─ columns: [id ch3fr xvsbh b5ouf]\n" +
 			"                                                     │   │   │       │   │   └─ TableAlias(pga)\n" +
-			"                                                     │   │   │       │   │       └─ IndexedTableAccess(PG27A)\n" +
-			"                                                     │   │   │       │   │           ├─ index: [PG27A.id]\n" +
+			"                                                     │   │   │       │   │       └─ IndexedTableAccess(mydb.`PG27A`)\n" +
+			"                                                     │   │   │       │   │           ├─ index: `PRIMARY` [PG27A.id]\n" +
 			"                                                     │   │   │       │   │           └─ columns: [id dzlim]\n" +
-			"                                                     │   │   │       │   └─ TableAlias(GZ7Z4)\n" +
-			"                                                     │   │   │       │       └─ IndexedTableAccess(FEIOE)\n" +
-			"                                                     │   │   │       │           ├─ index: [FEIOE.GMSGA]\n" +
+			"                                                     │   │   │       │   └─ TableAlias(`GZ7Z4`)\n" +
+			"                                                     │   │   │       │       └─ IndexedTableAccess(mydb.`FEIOE`)\n" +
+			"                                                     │   │   │       │           ├─ index: `GMSGA` [FEIOE.GMSGA]\n" +
 			"                                                     │   │   │       │           └─ columns: [luevy gmsga]\n" +
 			"                                                     │   │   │       └─ TableAlias(nd)\n" +
-			"                                                     │   │   │           └─ IndexedTableAccess(E2I7U)\n" +
-			"                                                     │   │   │               ├─ index: [E2I7U.id]\n" +
+			"                                                     │   │   │           └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			"                                                     │   │   │               ├─ index: `PRIMARY` [E2I7U.id]\n" +
 			"                                                     │   │   │               └─ columns: [id tw55n hpcms]\n" +
 			"                                                     │   │   └─ TableAlias(fc)\n" +
-			"                                                     │   │       └─ IndexedTableAccess(AMYXQ)\n" +
-			"                                                     │   │           ├─ index: [AMYXQ.GXLUB,AMYXQ.LUEVY]\n" +
+			"                                                     │   │       └─ IndexedTableAccess(mydb.`AMYXQ`)\n" +
+			"                                                     │   │           ├─ index: `VN3QP` [AMYXQ.GXLUB,AMYXQ.LUEVY]\n" +
 			"                                                     │   │           └─ columns: [gxlub luevy oztqf]\n" +
 			"                                                     │   └─ SubqueryAlias\n" +
-			"                                                     │       ├─ name: F26ZW\n" +
+			"                                                     │       ├─ name: `F26ZW`\n" +
 			"                                                     │       ├─ outerVisibility: false\n" +
 			"                                                     │       ├─ cacheable: true\n" +
 			"                                                     │       └─ Project\n" +
@@ -10931,8 +11470,8 @@ WHERE
 			"                                                     │               │   │           │   │   ├─ cla.FTQLQ:1!null\n" +
 			"                                                     │               │   │           │   │   └─ TUPLE(SQ1 (longtext))\n" +
 			"                                                     │               │   │           │   └─ TableAlias(cla)\n" +
-			"                                                     │               │   │           │       └─ IndexedTableAccess(YK2GW)\n" +
-			"                                                     │               │   │           │           ├─ index: [YK2GW.FTQLQ]\n" +
+			"                                                     │               │   │           │       └─ IndexedTableAccess(mydb.`YK2GW`)\n" +
+			"                                                     │               │   │           │           ├─ index: `EZCTT` [YK2GW.FTQLQ]\n" +
 			"                                                     │               │   │           │           ├─ static: [{[SQ1, SQ1]}]\n" +
 			"                                                     │               │   │           │           └─ columns: [id ftqlq]\n" +
 			"                                                     │               │   │           └─ HashLookup\n" +
@@ -10957,15 +11496,15 @@ WHERE
 			"                                                     │               │   │                       │   │   │   │   └─ bs.id:2!null\n" +
 			"                                                     │               │   │                       │   │   │   ├─ TableAlias(bs)\n" +
 			"                                                     │               │   │                       │   │   │   │   └─ Table\n" +
-			"                                                     │               │   │                       │   │   │   │       ├─ name: THNTS\n" +
+			"                                                     │               │   │                       │   │   │   │       ├─ name: mydb.`THNTS`\n" +
 			"                                                     │               │   │                       │   │   │   │       └─ columns: [id ixuxu]\n" +
 			"                                                     │               │   │                       │   │   │   └─ TableAlias(mf)\n" +
-			"                                                     │               │   │                       │   │   │       └─ IndexedTableAccess(HGMQ6)\n" +
-			"                                                     │               │   │                       │   │   │           ├─ index: [HGMQ6.GXLUB]\n" +
+			"                                                     │               │   │                       │   │   │       └─ IndexedTableAccess(mydb.`HGMQ6`)\n" +
+			"                                                     │               │   │                       │   │   │           ├─ index: `GXLUB` [HGMQ6.GXLUB]\n" +
 			"                                                     │               │   │                       │   │   │           └─ columns: [id gxlub luevy fsdy2]\n" +
 			"                                                     │               │   │                       │   │   └─ TableAlias(nd)\n" +
-			"                                                     │               │   │                       │   │       └─ IndexedTableAccess(E2I7U)\n" +
-			"                                                     │               │   │                       │   │           ├─ index: [E2I7U.id]\n" +
+			"                                                     │               │   │                       │   │       └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			"                                                     │               │   │                       │   │           ├─ index: `PRIMARY` [E2I7U.id]\n" +
 			"                                                     │               │   │                       │   │           └─ columns: [id hpcms]\n" +
 			"                                                     │               │   │                       │   └─ HashLookup\n" +
 			"                                                     │               │   │                       │       ├─ source: TUPLE(nd.HPCMS:9!null)\n" +
@@ -10973,15 +11512,15 @@ WHERE
 			"                                                     │               │   │                       │       └─ CachedResults\n" +
 			"                                                     │               │   │                       │           └─ TableAlias(nma)\n" +
 			"                                                     │               │   │                       │               └─ Table\n" +
-			"                                                     │               │   │                       │                   ├─ name: TNMXI\n" +
+			"                                                     │               │   │                       │                   ├─ name: mydb.`TNMXI`\n" +
 			"                                                     │               │   │                       │                   └─ columns: [id dzlim]\n" +
 			"                                                     │               │   │                       └─ TableAlias(sn)\n" +
-			"                                                     │               │   │                           └─ IndexedTableAccess(NOXN3)\n" +
-			"                                                     │               │   │                               ├─ index: [NOXN3.BRQP2]\n" +
+			"                                                     │               │   │                           └─ IndexedTableAccess(mydb.`NOXN3`)\n" +
+			"                                                     │               │   │                               ├─ index: `BRQP2` [NOXN3.BRQP2]\n" +
 			"                                                     │               │   │                               └─ columns: [brqp2]\n" +
-			"                                                     │               │   └─ TableAlias(W2MAO)\n" +
-			"                                                     │               │       └─ IndexedTableAccess(SEQS3)\n" +
-			"                                                     │               │           ├─ index: [SEQS3.Z7CP5,SEQS3.YH4XB]\n" +
+			"                                                     │               │   └─ TableAlias(`W2MAO`)\n" +
+			"                                                     │               │       └─ IndexedTableAccess(mydb.`SEQS3`)\n" +
+			"                                                     │               │           ├─ index: `SQKGT` [SEQS3.Z7CP5,SEQS3.YH4XB]\n" +
 			"                                                     │               │           └─ columns: [z7cp5 yh4xb]\n" +
 			"                                                     │               └─ HashLookup\n" +
 			"                                                     │                   ├─ source: TUPLE(W2MAO.YH4XB:6)\n" +
@@ -10989,11 +11528,11 @@ WHERE
 			"                                                     │                   └─ CachedResults\n" +
 			"                                                     │                       └─ TableAlias(vc)\n" +
 			"                                                     │                           └─ Table\n" +
-			"                                                     │                               ├─ name: D34QP\n" +
+			"                                                     │                               ├─ name: mydb.`D34QP`\n" +
 			"                                                     │                               └─ columns: [id znp4p]\n" +
 			"                                                     └─ TableAlias(nma)\n" +
-			"                                                         └─ IndexedTableAccess(TNMXI)\n" +
-			"                                                             ├─ index: [TNMXI.id]\n" +
+			"                                                         └─ IndexedTableAccess(mydb.`TNMXI`)\n" +
+			"                                                             ├─ index: `PRIMARY` [TNMXI.id]\n" +
 			"                                                             └─ columns: [id]\n" +
 			"",
 	},
@@ -11118,7 +11657,7 @@ WHERE
 			"     └─ Project\n" +
 			"         ├─ columns: [XPRW6.T4IBQ:0!null as T4IBQ, XPRW6.ECUWU:1 as ECUWU, XPRW6.B5OUF:3, XPRW6.SP4SI:4!null]\n" +
 			"         └─ SubqueryAlias\n" +
-			"             ├─ name: XPRW6\n" +
+			"             ├─ name: `XPRW6`\n" +
 			"             ├─ outerVisibility: false\n" +
 			"             ├─ cacheable: true\n" +
 			"             └─ Project\n" +
@@ -11136,14 +11675,14 @@ WHERE
 			"                     └─ Project\n" +
 			"                         ├─ columns: [NRFJ3.T4IBQ:0!null as T4IBQ, NRFJ3.ECUWU:1 as ECUWU, NRFJ3.GSTQA:2 as GSTQA, NRFJ3.B5OUF:3, NRFJ3.OZTQF:5, NRFJ3.YHYLK:6]\n" +
 			"                         └─ SubqueryAlias\n" +
-			"                             ├─ name: NRFJ3\n" +
+			"                             ├─ name: `NRFJ3`\n" +
 			"                             ├─ outerVisibility: false\n" +
 			"                             ├─ cacheable: true\n" +
 			"                             └─ Distinct\n" +
 			"                                 └─ Project\n" +
 			"                                     ├─ columns: [AX7FV.T4IBQ:0!null, AX7FV.ECUWU:1, AX7FV.GSTQA:2, AX7FV.B5OUF:3, AX7FV.TW55N:6, AX7FV.OZTQF:4, AX7FV.YHYLK:5]\n" +
 			"                                     └─ SubqueryAlias\n" +
-			"                                         ├─ name: AX7FV\n" +
+			"                                         ├─ name: `AX7FV`\n" +
 			"                                         ├─ outerVisibility: false\n" +
 			"                                         ├─ cacheable: true\n" +
 			"                                         └─ Project\n" +
@@ -11190,12 +11729,12 @@ WHERE
 			"                                                     │   │   │   │               ├─ cmp: Eq\n" +
 			"                                                     │   │   │   │               │   ├─ YK2GW.id:0!null\n" +
 			"                                                     │   │   │   │               │   └─ THNTS.IXUXU:3\n" +
-			"                                                     │   │   │   │               ├─ IndexedTableAccess(YK2GW)\n" +
-			"                                                     │   │   │   │               │   ├─ index: [YK2GW.id]\n" +
+			"                                                     │   │   │   │               ├─ IndexedTableAccess(mydb.`YK2GW`)\n" +
+			"                                                     │   │   │   │               │   ├─ index: `PRIMARY` [YK2GW.id]\n" +
 			"                                                     │   │   │   │               │   ├─ static: [{[NULL, ∞)}]\n" +
 			"                                                     │   │   │   │               │   └─ columns: [id ftqlq]\n" +
-			"                                                     │   │   │   │               └─ IndexedTableAccess(THNTS)\n" +
-			"                                                     │   │   │   │                   ├─ index: [THNTS.IXUXU]\n" +
+			"                                                     │   │   │   │               └─ IndexedTableAccess(mydb.`THNTS`)\n" +
+			"                                                     │   │   │   │                   ├─ index: `IXUXU` [THNTS.IXUXU]\n" +
 			"                                                     │   │   │   │                   ├─ static: [{[NULL, ∞)}]\n" +
 			"                                                     │   │   │   │                   └─ columns: [id ixuxu]\n" +
 			"                                                     │   │   │   └─ LookupJoin\n" +
@@ -11220,34 +11759,34 @@ WHERE
 			"                                                     │   │   │       │   │   │   │   │   └─ pa.id:2!null\n" +
 			"                                                     │   │   │       │   │   │   │   ├─ TableAlias(pa)\n" +
 			"                                                     │   │   │       │   │   │   │   │   └─ Table\n" +
-			"                                                     │   │   │       │   │   │   │   │       ├─ name: XOAOP\n" +
+			"                                                     │   │   │       │   │   │   │   │       ├─ name: mydb.`XOAOP`\n" +
 			"                                                     │   │   │       │   │   │   │   │       └─ columns: [id dzlim]\n" +
 			"                                                     │   │   │       │   │   │   │   └─ TableAlias(ms)\n" +
-			"                                                     │   │   │       │   │   │   │       └─ IndexedTableAccess(SZQWJ)\n" +
-			"                                                     │   │   │       │   │   │   │           ├─ index: [SZQWJ.CH3FR]\n" +
+			"                                                     │   │   │       │   │   │   │       └─ IndexedTableAccess(mydb.`SZQWJ`)\n" +
+			"                                                     │   │   │       │   │   │   │           ├─ index: `CH3FR` [SZQWJ.CH3FR]\n" +
 			"                                                     │   │   │       │   │   │   │           └─ columns: [gxlub ch3fr d237e]\n" +
 			"                                                     │   │   │       │   │   │   └─ TableAlias(pog)\n" +
-			"                                                     │   │   │       │   │   │       └─ IndexedTableAccess(NPCYY)\n" +
-			"                                                     │   │   │       │   │   │           ├─ index: [NPCYY.CH3FR,NPCYY.XVSBH]\n" +
+			"                                                     │   │   │       │   │   │       └─ IndexedTableAccess(mydb.`NPCYY`)\n" +
+			"                                                     │   │   │       │   │   │           ├─ index: `S2DGA` [NPCYY.CH3FR,NPCYY.XVSBH]\n" +
 			"                                                     │   │   │       │   │   │           └─ columns: [id ch3fr xvsbh b5ouf]\n" +
 			"                                                     │   │   │       │   │   └─ TableAlias(pga)\n" +
-			"                                                     │   │   │       │   │       └─ IndexedTableAccess(PG27A)\n" +
-			"                                                     │   │   │       │   │           ├─ index: [PG27A.id]\n" +
+			"                                                     │   │   │       │   │       └─ IndexedTableAccess(mydb.`PG27A`)\n" +
+			"                                                     │   │   │       │   │           ├─ index: `PRIMARY` [PG27A.id]\n" +
 			"                                                     │   │   │       │   │           └─ columns: [id dzlim]\n" +
-			"                                                     │   │   │       │   └─ TableAlias(GZ7Z4)\n" +
-			"                                                     │   │   │       │       └─ IndexedTableAccess(FEIOE)\n" +
-			"                                                     │   │   │       │           ├─ index: [FEIOE.GMSGA]\n" +
+			"                                                     │   │   │       │   └─ TableAlias(`GZ7Z4`)\n" +
+			"                                                     │   │   │       │       └─ IndexedTableAccess(mydb.`FEIOE`)\n" +
+			"                                                     │   │   │       │           ├─ index: `GMSGA` [FEIOE.GMSGA]\n" +
 			"                                                     │   │   │       │           └─ columns: [luevy gmsga]\n" +
 			"                                                     │   │   │       └─ TableAlias(nd)\n" +
-			"                                                     │   │   │           └─ IndexedTableAccess(E2I7U)\n" +
-			"                                                     │   │   │               ├─ index: [E2I7U.id]\n" +
+			"                                                     │   │   │           └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			"                                                     │   │   │               ├─ index: `PRIMARY` [E2I7U.id]\n" +
 			"                                                     │   │   │               └─ columns: [id tw55n hpcms]\n" +
 			"                                                     │   │   └─ TableAlias(fc)\n" +
-			"                                                     │   │       └─ IndexedTableAccess(AMYXQ)\n" +
-			"                                                     │   │           ├─ index: [AMYXQ.GXLUB,AMYXQ.LUEVY]\n" +
+			"                                                     │   │       └─ IndexedTableAccess(mydb.`AMYXQ`)\n" +
+			"                                                     │   │           ├─ index: `VN3QP` [AMYXQ.GXLUB,AMYXQ.LUEVY]\n" +
 			"                                                     │   │           └─ columns: [gxlub luevy oztqf]\n" +
 			"                                                     │   └─ SubqueryAlias\n" +
-			"                                                     │       ├─ name: F26ZW\n" +
+			"                                                     │       ├─ name: `F26ZW`\n" +
 			"                                                     │       ├─ outerVisibility: false\n" +
 			"                                                     │       ├─ cacheable: true\n" +
 			"                                                     │       └─ Project\n" +
@@ -11357,35 +11896,35 @@ WHERE
 			"                                                     │               │   │           │   │   │   │   │   └─ nma.id:0!null\n" +
 			"                                                     │               │   │           │   │   │   │   ├─ TableAlias(nma)\n" +
 			"                                                     │               │   │           │   │   │   │   │   └─ Table\n" +
-			"                                                     │               │   │           │   │   │   │   │       ├─ name: TNMXI\n" +
+			"                                                     │               │   │           │   │   │   │   │       ├─ name: mydb.`TNMXI`\n" +
 			"                                                     │               │   │           │   │   │   │   │       └─ columns: [id dzlim]\n" +
 			"                                                     │               │   │           │   │   │   │   └─ TableAlias(nd)\n" +
-			"                                                     │               │   │           │   │   │   │       └─ IndexedTableAccess(E2I7U)\n" +
-			"                                                     │               │   │           │   │   │   │           ├─ index: [E2I7U.HPCMS]\n" +
+			"                                                     │               │   │           │   │   │   │       └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			"                                                     │               │   │           │   │   │   │           ├─ index: `HPCMS` [E2I7U.HPCMS]\n" +
 			"                                                     │               │   │           │   │   │   │           └─ columns: [id hpcms]\n" +
 			"                                                     │               │   │           │   │   │   └─ TableAlias(mf)\n" +
-			"                                                     │               │   │           │   │   │       └─ IndexedTableAccess(HGMQ6)\n" +
-			"                                                     │               │   │           │   │   │           ├─ index: [HGMQ6.LUEVY]\n" +
+			"                                                     │               │   │           │   │   │       └─ IndexedTableAccess(mydb.`HGMQ6`)\n" +
+			"                                                     │               │   │           │   │   │           ├─ index: `LUEVY` [HGMQ6.LUEVY]\n" +
 			"                                                     │               │   │           │   │   │           └─ columns: [id gxlub luevy fsdy2]\n" +
 			"                                                     │               │   │           │   │   └─ TableAlias(bs)\n" +
-			"                                                     │               │   │           │   │       └─ IndexedTableAccess(THNTS)\n" +
-			"                                                     │               │   │           │   │           ├─ index: [THNTS.id]\n" +
+			"                                                     │               │   │           │   │       └─ IndexedTableAccess(mydb.`THNTS`)\n" +
+			"                                                     │               │   │           │   │           ├─ index: `PRIMARY` [THNTS.id]\n" +
 			"                                                     │               │   │           │   │           └─ columns: [id ixuxu]\n" +
 			"                                                     │               │   │           │   └─ Filter\n" +
 			"                                                     │               │   │           │       ├─ HashIn\n" +
 			"                                                     │               │   │           │       │   ├─ cla.FTQLQ:1!null\n" +
 			"                                                     │               │   │           │       │   └─ TUPLE(SQ1 (longtext))\n" +
 			"                                                     │               │   │           │       └─ TableAlias(cla)\n" +
-			"                                                     │               │   │           │           └─ IndexedTableAccess(YK2GW)\n" +
-			"                                                     │               │   │           │               ├─ index: [YK2GW.id]\n" +
+			"                                                     │               │   │           │           └─ IndexedTableAccess(mydb.`YK2GW`)\n" +
+			"                                                     │               │   │           │               ├─ index: `PRIMARY` [YK2GW.id]\n" +
 			"                                                     │               │   │           │               └─ columns: [id ftqlq]\n" +
 			"                                                     │               │   │           └─ TableAlias(sn)\n" +
-			"                                                     │               │   │               └─ IndexedTableAccess(NOXN3)\n" +
-			"                                                     │               │   │                   ├─ index: [NOXN3.BRQP2]\n" +
+			"                                                     │               │   │               └─ IndexedTableAccess(mydb.`NOXN3`)\n" +
+			"                                                     │               │   │                   ├─ index: `BRQP2` [NOXN3.BRQP2]\n" +
 			"                                                     │               │   │                   └─ columns: [brqp2]\n" +
-			"                                                     │               │   └─ TableAlias(W2MAO)\n" +
-			"                                                     │               │       └─ IndexedTableAccess(SEQS3)\n" +
-			"                                                     │               │           ├─ index: [SEQS3.Z7CP5,SEQS3.YH4XB]\n" +
+			"                                                     │               │   └─ TableAlias(`W2MAO`)\n" +
+			"                                                     │               │       └─ IndexedTableAccess(mydb.`SEQS3`)\n" +
+			"                                                     │               │           ├─ index: `SQKGT` [SEQS3.Z7CP5,SEQS3.YH4XB]\n" +
 			"                                                     │               │           └─ columns: [z7cp5 yh4xb]\n" +
 			"                                                     │               └─ HashLookup\n" +
 			"                                                     │                   ├─ source: TUPLE(W2MAO.YH4XB:6)\n" +
@@ -11393,11 +11932,11 @@ WHERE
 			"                                                     │                   └─ CachedResults\n" +
 			"                                                     │                       └─ TableAlias(vc)\n" +
 			"                                                     │                           └─ Table\n" +
-			"                                                     │                               ├─ name: D34QP\n" +
+			"                                                     │                               ├─ name: mydb.`D34QP`\n" +
 			"                                                     │                               └─ columns: [id znp4p]\n" +
 			"                                                     └─ TableAlias(nma)\n" +
-			"                                                         └─ IndexedTableAccess(TNMXI)\n" +
-			"                                                             ├─ index: [TNMXI.id]\n" +
+			"                                                         └─ IndexedTableAccess(mydb.`TNMXI`)\n" +
+			"                                                             ├─ index: `PRIMARY` [TNMXI.id]\n" +
 			"                                                             └─ columns: [id]\n" +
 			"",
 	},
@@ -11429,7 +11968,7 @@ WHERE
 			"         │   ├─ XJ2RD.WNUNU:3!null\n" +
 			"         │   └─ TUSAY.XLFIA:1!null\n" +
 			"         ├─ SubqueryAlias\n" +
-			"         │   ├─ name: TUSAY\n" +
+			"         │   ├─ name: `TUSAY`\n" +
 			"         │   ├─ outerVisibility: false\n" +
 			"         │   ├─ cacheable: true\n" +
 			"         │   └─ Project\n" +
@@ -11438,20 +11977,20 @@ WHERE
 			"         │           ├─ row_number() over ( order by NOXN3.id ASC)\n" +
 			"         │           ├─ NOXN3.id:0!null as XLFIA\n" +
 			"         │           └─ Table\n" +
-			"         │               ├─ name: NOXN3\n" +
+			"         │               ├─ name: mydb.`NOXN3`\n" +
 			"         │               └─ columns: [id]\n" +
 			"         └─ HashLookup\n" +
 			"             ├─ source: TUPLE(TUSAY.XLFIA:1!null)\n" +
 			"             ├─ target: TUPLE(XJ2RD.WNUNU:1!null)\n" +
 			"             └─ CachedResults\n" +
 			"                 └─ SubqueryAlias\n" +
-			"                     ├─ name: XJ2RD\n" +
+			"                     ├─ name: `XJ2RD`\n" +
 			"                     ├─ outerVisibility: false\n" +
 			"                     ├─ cacheable: true\n" +
 			"                     └─ Project\n" +
 			"                         ├─ columns: [QYWQD.id:0!null as Y46B2, QYWQD.WNUNU:1!null as WNUNU, QYWQD.HVHRZ:2!null as HVHRZ]\n" +
 			"                         └─ Table\n" +
-			"                             ├─ name: QYWQD\n" +
+			"                             ├─ name: mydb.`QYWQD`\n" +
 			"                             └─ columns: [id wnunu hvhrz]\n" +
 			"",
 	},
@@ -11464,8 +12003,8 @@ WHERE
 	ORDER BY id ASC`,
 		ExpectedPlan: "Project\n" +
 			" ├─ columns: [E2I7U.ECXAJ:1!null]\n" +
-			" └─ IndexedTableAccess(E2I7U)\n" +
-			"     ├─ index: [E2I7U.id]\n" +
+			" └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			"     ├─ index: `PRIMARY` [E2I7U.id]\n" +
 			"     ├─ static: [{[NULL, ∞)}]\n" +
 			"     └─ columns: [id ecxaj]\n" +
 			"",
@@ -11499,7 +12038,7 @@ WHERE
 			" │   └─ YZXYP.Z35GY:1 IS NULL\n" +
 			" │   THEN YZXYP.Z35GY:1 ELSE -1 (tinyint) END as FMSOH]\n" +
 			" └─ SubqueryAlias\n" +
-			"     ├─ name: YZXYP\n" +
+			"     ├─ name: `YZXYP`\n" +
 			"     ├─ outerVisibility: false\n" +
 			"     ├─ cacheable: true\n" +
 			"     └─ Sort(nd.T722E:0!null ASC nullsFirst)\n" +
@@ -11516,7 +12055,7 @@ WHERE
 			"                 │   └─ Project\n" +
 			"                 │       ├─ columns: [E2I7U.id:0!null as T722E]\n" +
 			"                 │       └─ Table\n" +
-			"                 │           ├─ name: E2I7U\n" +
+			"                 │           ├─ name: mydb.`E2I7U`\n" +
 			"                 │           └─ columns: [id]\n" +
 			"                 └─ HashLookup\n" +
 			"                     ├─ source: TUPLE(nd.T722E:0!null)\n" +
@@ -11532,7 +12071,7 @@ WHERE
 			"                                     ├─ select: AMYXQ.LUEVY:0!null as ZPAIK, MAX(AMYXQ.Z35GY:1!null)\n" +
 			"                                     ├─ group: AMYXQ.LUEVY:0!null\n" +
 			"                                     └─ Table\n" +
-			"                                         ├─ name: AMYXQ\n" +
+			"                                         ├─ name: mydb.`AMYXQ`\n" +
 			"                                         └─ columns: [luevy z35gy]\n" +
 			"",
 	},
@@ -11568,11 +12107,11 @@ WHERE
 			" │               │   ├─ E2I7U.id:17!null\n" +
 			" │               │   └─ scalarSubq0.LUEVY:34!null\n" +
 			" │               ├─ Table\n" +
-			" │               │   ├─ name: E2I7U\n" +
+			" │               │   ├─ name: mydb.`E2I7U`\n" +
 			" │               │   └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
-			" │               └─ TableAlias(scalarSubq0)\n" +
-			" │                   └─ IndexedTableAccess(AMYXQ)\n" +
-			" │                       ├─ index: [AMYXQ.LUEVY]\n" +
+			" │               └─ TableAlias(`scalarSubq0`)\n" +
+			" │                   └─ IndexedTableAccess(mydb.`AMYXQ`)\n" +
+			" │                       ├─ index: `LUEVY` [AMYXQ.LUEVY]\n" +
 			" │                       └─ columns: [luevy]\n" +
 			" │   THEN 1 (tinyint) WHEN Eq\n" +
 			" │   ├─ E2I7U.FSK67:8!null\n" +
@@ -11581,8 +12120,8 @@ WHERE
 			" │   ├─ E2I7U.FSK67:8!null\n" +
 			" │   └─ CRZ2X (longtext)\n" +
 			" │   THEN 0 (tinyint) ELSE 3 (tinyint) END as SZ6KK]\n" +
-			" └─ IndexedTableAccess(E2I7U)\n" +
-			"     ├─ index: [E2I7U.id]\n" +
+			" └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			"     ├─ index: `PRIMARY` [E2I7U.id]\n" +
 			"     ├─ static: [{[NULL, ∞)}]\n" +
 			"     └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
 			"",
@@ -11666,7 +12205,7 @@ WHERE
 			"             │   │   ├─ CKELE.LWQ6O:0!null\n" +
 			"             │   │   └─ OXXEI.BDNYB:3!null\n" +
 			"             │   ├─ SubqueryAlias\n" +
-			"             │   │   ├─ name: CKELE\n" +
+			"             │   │   ├─ name: `CKELE`\n" +
 			"             │   │   ├─ outerVisibility: false\n" +
 			"             │   │   ├─ cacheable: true\n" +
 			"             │   │   └─ Project\n" +
@@ -11675,14 +12214,14 @@ WHERE
 			"             │   │           ├─ NOXN3.id:0!null as LWQ6O\n" +
 			"             │   │           ├─ row_number() over ( order by NOXN3.id ASC)\n" +
 			"             │   │           └─ Table\n" +
-			"             │   │               ├─ name: NOXN3\n" +
+			"             │   │               ├─ name: mydb.`NOXN3`\n" +
 			"             │   │               └─ columns: [id]\n" +
 			"             │   └─ HashLookup\n" +
 			"             │       ├─ source: TUPLE(CKELE.LWQ6O:0!null)\n" +
 			"             │       ├─ target: TUPLE(OXXEI.BDNYB:1!null)\n" +
 			"             │       └─ CachedResults\n" +
 			"             │           └─ SubqueryAlias\n" +
-			"             │               ├─ name: OXXEI\n" +
+			"             │               ├─ name: `OXXEI`\n" +
 			"             │               ├─ outerVisibility: false\n" +
 			"             │               ├─ cacheable: true\n" +
 			"             │               └─ Project\n" +
@@ -11703,8 +12242,8 @@ WHERE
 			"             │                       │   │   ├─ cla.FTQLQ:1!null\n" +
 			"             │                       │   │   └─ TUPLE(SQ1 (longtext))\n" +
 			"             │                       │   └─ TableAlias(cla)\n" +
-			"             │                       │       └─ IndexedTableAccess(YK2GW)\n" +
-			"             │                       │           ├─ index: [YK2GW.FTQLQ]\n" +
+			"             │                       │       └─ IndexedTableAccess(mydb.`YK2GW`)\n" +
+			"             │                       │           ├─ index: `EZCTT` [YK2GW.FTQLQ]\n" +
 			"             │                       │           ├─ static: [{[SQ1, SQ1]}]\n" +
 			"             │                       │           └─ columns: [id ftqlq]\n" +
 			"             │                       └─ HashLookup\n" +
@@ -11733,38 +12272,38 @@ WHERE
 			"             │                                   │   │   │   │   │   └─ bs.id:2!null\n" +
 			"             │                                   │   │   │   │   ├─ TableAlias(bs)\n" +
 			"             │                                   │   │   │   │   │   └─ Table\n" +
-			"             │                                   │   │   │   │   │       ├─ name: THNTS\n" +
+			"             │                                   │   │   │   │   │       ├─ name: mydb.`THNTS`\n" +
 			"             │                                   │   │   │   │   │       └─ columns: [id ixuxu]\n" +
 			"             │                                   │   │   │   │   └─ Filter\n" +
 			"             │                                   │   │   │   │       ├─ HashIn\n" +
 			"             │                                   │   │   │   │       │   ├─ mf.FSDY2:4!null\n" +
 			"             │                                   │   │   │   │       │   └─ TUPLE(SRARY (longtext), UBQWG (longtext))\n" +
 			"             │                                   │   │   │   │       └─ TableAlias(mf)\n" +
-			"             │                                   │   │   │   │           └─ IndexedTableAccess(HGMQ6)\n" +
-			"             │                                   │   │   │   │               ├─ index: [HGMQ6.GXLUB]\n" +
+			"             │                                   │   │   │   │           └─ IndexedTableAccess(mydb.`HGMQ6`)\n" +
+			"             │                                   │   │   │   │               ├─ index: `GXLUB` [HGMQ6.GXLUB]\n" +
 			"             │                                   │   │   │   │               └─ columns: [id gxlub luevy m22qn fsdy2 lt7k6 sppyd qcgts]\n" +
 			"             │                                   │   │   │   └─ TableAlias(sn)\n" +
-			"             │                                   │   │   │       └─ IndexedTableAccess(NOXN3)\n" +
-			"             │                                   │   │   │           ├─ index: [NOXN3.BRQP2]\n" +
+			"             │                                   │   │   │       └─ IndexedTableAccess(mydb.`NOXN3`)\n" +
+			"             │                                   │   │   │           ├─ index: `BRQP2` [NOXN3.BRQP2]\n" +
 			"             │                                   │   │   │           └─ columns: [id brqp2]\n" +
 			"             │                                   │   │   └─ TableAlias(aac)\n" +
-			"             │                                   │   │       └─ IndexedTableAccess(TPXBU)\n" +
-			"             │                                   │   │           ├─ index: [TPXBU.id]\n" +
+			"             │                                   │   │       └─ IndexedTableAccess(mydb.`TPXBU`)\n" +
+			"             │                                   │   │           ├─ index: `PRIMARY` [TPXBU.id]\n" +
 			"             │                                   │   │           └─ columns: [id btxc5]\n" +
-			"             │                                   │   └─ TableAlias(W2MAO)\n" +
-			"             │                                   │       └─ IndexedTableAccess(SEQS3)\n" +
-			"             │                                   │           ├─ index: [SEQS3.Z7CP5,SEQS3.YH4XB]\n" +
+			"             │                                   │   └─ TableAlias(`W2MAO`)\n" +
+			"             │                                   │       └─ IndexedTableAccess(mydb.`SEQS3`)\n" +
+			"             │                                   │           ├─ index: `SQKGT` [SEQS3.Z7CP5,SEQS3.YH4XB]\n" +
 			"             │                                   │           └─ columns: [z7cp5 yh4xb]\n" +
 			"             │                                   └─ TableAlias(vc)\n" +
-			"             │                                       └─ IndexedTableAccess(D34QP)\n" +
-			"             │                                           ├─ index: [D34QP.id]\n" +
+			"             │                                       └─ IndexedTableAccess(mydb.`D34QP`)\n" +
+			"             │                                           ├─ index: `PRIMARY` [D34QP.id]\n" +
 			"             │                                           └─ columns: [id znp4p]\n" +
 			"             └─ HashLookup\n" +
 			"                 ├─ source: TUPLE(OXXEI.BDNYB:3!null)\n" +
 			"                 ├─ target: TUPLE(E52AP.BDNYB:1!null)\n" +
 			"                 └─ CachedResults\n" +
 			"                     └─ SubqueryAlias\n" +
-			"                         ├─ name: E52AP\n" +
+			"                         ├─ name: `E52AP`\n" +
 			"                         ├─ outerVisibility: false\n" +
 			"                         ├─ cacheable: true\n" +
 			"                         └─ Sort(BDNYB:1!null ASC nullsFirst)\n" +
@@ -11787,13 +12326,13 @@ WHERE
 			"                                         │   │   ├─ sn.BRQP2:1!null\n" +
 			"                                         │   │   └─ nd.id:2!null\n" +
 			"                                         │   ├─ TableAlias(sn)\n" +
-			"                                         │   │   └─ IndexedTableAccess(NOXN3)\n" +
-			"                                         │   │       ├─ index: [NOXN3.BRQP2]\n" +
+			"                                         │   │   └─ IndexedTableAccess(mydb.`NOXN3`)\n" +
+			"                                         │   │       ├─ index: `BRQP2` [NOXN3.BRQP2]\n" +
 			"                                         │   │       ├─ static: [{[NULL, ∞)}]\n" +
 			"                                         │   │       └─ columns: [id brqp2]\n" +
 			"                                         │   └─ TableAlias(nd)\n" +
-			"                                         │       └─ IndexedTableAccess(E2I7U)\n" +
-			"                                         │           ├─ index: [E2I7U.id]\n" +
+			"                                         │       └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			"                                         │           ├─ index: `PRIMARY` [E2I7U.id]\n" +
 			"                                         │           ├─ static: [{[NULL, ∞)}]\n" +
 			"                                         │           └─ columns: [id tw55n tce7a hpcms]\n" +
 			"                                         └─ HashLookup\n" +
@@ -11802,7 +12341,7 @@ WHERE
 			"                                             └─ CachedResults\n" +
 			"                                                 └─ TableAlias(nma)\n" +
 			"                                                     └─ Table\n" +
-			"                                                         ├─ name: TNMXI\n" +
+			"                                                         ├─ name: mydb.`TNMXI`\n" +
 			"                                                         └─ columns: [id dzlim]\n" +
 			"",
 	},
@@ -11885,7 +12424,7 @@ WHERE
 			"             │   │   ├─ CKELE.LWQ6O:0!null\n" +
 			"             │   │   └─ OXXEI.BDNYB:3!null\n" +
 			"             │   ├─ SubqueryAlias\n" +
-			"             │   │   ├─ name: CKELE\n" +
+			"             │   │   ├─ name: `CKELE`\n" +
 			"             │   │   ├─ outerVisibility: false\n" +
 			"             │   │   ├─ cacheable: true\n" +
 			"             │   │   └─ Project\n" +
@@ -11894,14 +12433,14 @@ WHERE
 			"             │   │           ├─ NOXN3.id:0!null as LWQ6O\n" +
 			"             │   │           ├─ row_number() over ( order by NOXN3.id ASC)\n" +
 			"             │   │           └─ Table\n" +
-			"             │   │               ├─ name: NOXN3\n" +
+			"             │   │               ├─ name: mydb.`NOXN3`\n" +
 			"             │   │               └─ columns: [id]\n" +
 			"             │   └─ HashLookup\n" +
 			"             │       ├─ source: TUPLE(CKELE.LWQ6O:0!null)\n" +
 			"             │       ├─ target: TUPLE(OXXEI.BDNYB:1!null)\n" +
 			"             │       └─ CachedResults\n" +
 			"             │           └─ SubqueryAlias\n" +
-			"             │               ├─ name: OXXEI\n" +
+			"             │               ├─ name: `OXXEI`\n" +
 			"             │               ├─ outerVisibility: false\n" +
 			"             │               ├─ cacheable: true\n" +
 			"             │               └─ Project\n" +
@@ -11939,46 +12478,46 @@ WHERE
 			"             │                       │   │   │   │   │   │   └─ W2MAO.YH4XB:3!null\n" +
 			"             │                       │   │   │   │   │   ├─ TableAlias(vc)\n" +
 			"             │                       │   │   │   │   │   │   └─ Table\n" +
-			"             │                       │   │   │   │   │   │       ├─ name: D34QP\n" +
+			"             │                       │   │   │   │   │   │       ├─ name: mydb.`D34QP`\n" +
 			"             │                       │   │   │   │   │   │       └─ columns: [id znp4p]\n" +
-			"             │                       │   │   │   │   │   └─ TableAlias(W2MAO)\n" +
-			"             │                       │   │   │   │   │       └─ IndexedTableAccess(SEQS3)\n" +
-			"             │                       │   │   │   │   │           ├─ index: [SEQS3.YH4XB]\n" +
+			"             │                       │   │   │   │   │   └─ TableAlias(`W2MAO`)\n" +
+			"             │                       │   │   │   │   │       └─ IndexedTableAccess(mydb.`SEQS3`)\n" +
+			"             │                       │   │   │   │   │           ├─ index: `YH4XB` [SEQS3.YH4XB]\n" +
 			"             │                       │   │   │   │   │           └─ columns: [z7cp5 yh4xb]\n" +
 			"             │                       │   │   │   │   └─ Filter\n" +
 			"             │                       │   │   │   │       ├─ HashIn\n" +
 			"             │                       │   │   │   │       │   ├─ mf.FSDY2:4!null\n" +
 			"             │                       │   │   │   │       │   └─ TUPLE(SRARY (longtext), UBQWG (longtext))\n" +
 			"             │                       │   │   │   │       └─ TableAlias(mf)\n" +
-			"             │                       │   │   │   │           └─ IndexedTableAccess(HGMQ6)\n" +
-			"             │                       │   │   │   │               ├─ index: [HGMQ6.id]\n" +
+			"             │                       │   │   │   │           └─ IndexedTableAccess(mydb.`HGMQ6`)\n" +
+			"             │                       │   │   │   │               ├─ index: `PRIMARY` [HGMQ6.id]\n" +
 			"             │                       │   │   │   │               └─ columns: [id gxlub luevy m22qn fsdy2 lt7k6 sppyd qcgts]\n" +
 			"             │                       │   │   │   └─ TableAlias(bs)\n" +
-			"             │                       │   │   │       └─ IndexedTableAccess(THNTS)\n" +
-			"             │                       │   │   │           ├─ index: [THNTS.id]\n" +
+			"             │                       │   │   │       └─ IndexedTableAccess(mydb.`THNTS`)\n" +
+			"             │                       │   │   │           ├─ index: `PRIMARY` [THNTS.id]\n" +
 			"             │                       │   │   │           └─ columns: [id ixuxu]\n" +
 			"             │                       │   │   └─ Filter\n" +
 			"             │                       │   │       ├─ HashIn\n" +
 			"             │                       │   │       │   ├─ cla.FTQLQ:1!null\n" +
 			"             │                       │   │       │   └─ TUPLE(SQ1 (longtext))\n" +
 			"             │                       │   │       └─ TableAlias(cla)\n" +
-			"             │                       │   │           └─ IndexedTableAccess(YK2GW)\n" +
-			"             │                       │   │               ├─ index: [YK2GW.id]\n" +
+			"             │                       │   │           └─ IndexedTableAccess(mydb.`YK2GW`)\n" +
+			"             │                       │   │               ├─ index: `PRIMARY` [YK2GW.id]\n" +
 			"             │                       │   │               └─ columns: [id ftqlq]\n" +
 			"             │                       │   └─ TableAlias(aac)\n" +
-			"             │                       │       └─ IndexedTableAccess(TPXBU)\n" +
-			"             │                       │           ├─ index: [TPXBU.id]\n" +
+			"             │                       │       └─ IndexedTableAccess(mydb.`TPXBU`)\n" +
+			"             │                       │           ├─ index: `PRIMARY` [TPXBU.id]\n" +
 			"             │                       │           └─ columns: [id btxc5]\n" +
 			"             │                       └─ TableAlias(sn)\n" +
-			"             │                           └─ IndexedTableAccess(NOXN3)\n" +
-			"             │                               ├─ index: [NOXN3.BRQP2]\n" +
+			"             │                           └─ IndexedTableAccess(mydb.`NOXN3`)\n" +
+			"             │                               ├─ index: `BRQP2` [NOXN3.BRQP2]\n" +
 			"             │                               └─ columns: [id brqp2]\n" +
 			"             └─ HashLookup\n" +
 			"                 ├─ source: TUPLE(OXXEI.BDNYB:3!null)\n" +
 			"                 ├─ target: TUPLE(E52AP.BDNYB:1!null)\n" +
 			"                 └─ CachedResults\n" +
 			"                     └─ SubqueryAlias\n" +
-			"                         ├─ name: E52AP\n" +
+			"                         ├─ name: `E52AP`\n" +
 			"                         ├─ outerVisibility: false\n" +
 			"                         ├─ cacheable: true\n" +
 			"                         └─ Sort(BDNYB:1!null ASC nullsFirst)\n" +
@@ -12001,13 +12540,13 @@ WHERE
 			"                                         │   │   ├─ sn.BRQP2:1!null\n" +
 			"                                         │   │   └─ nd.id:2!null\n" +
 			"                                         │   ├─ TableAlias(sn)\n" +
-			"                                         │   │   └─ IndexedTableAccess(NOXN3)\n" +
-			"                                         │   │       ├─ index: [NOXN3.BRQP2]\n" +
+			"                                         │   │   └─ IndexedTableAccess(mydb.`NOXN3`)\n" +
+			"                                         │   │       ├─ index: `BRQP2` [NOXN3.BRQP2]\n" +
 			"                                         │   │       ├─ static: [{[NULL, ∞)}]\n" +
 			"                                         │   │       └─ columns: [id brqp2]\n" +
 			"                                         │   └─ TableAlias(nd)\n" +
-			"                                         │       └─ IndexedTableAccess(E2I7U)\n" +
-			"                                         │           ├─ index: [E2I7U.id]\n" +
+			"                                         │       └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			"                                         │           ├─ index: `PRIMARY` [E2I7U.id]\n" +
 			"                                         │           ├─ static: [{[NULL, ∞)}]\n" +
 			"                                         │           └─ columns: [id tw55n tce7a hpcms]\n" +
 			"                                         └─ HashLookup\n" +
@@ -12016,7 +12555,7 @@ WHERE
 			"                                             └─ CachedResults\n" +
 			"                                                 └─ TableAlias(nma)\n" +
 			"                                                     └─ Table\n" +
-			"                                                         ├─ name: TNMXI\n" +
+			"                                                         ├─ name: mydb.`TNMXI`\n" +
 			"                                                         └─ columns: [id dzlim]\n" +
 			"",
 	},
@@ -12211,7 +12750,7 @@ WHERE
 			" │                       ├─ NOXN3.id:27!null\n" +
 			" │                       ├─ row_number() over ( order by NOXN3.id ASC)\n" +
 			" │                       └─ Table\n" +
-			" │                           ├─ name: NOXN3\n" +
+			" │                           ├─ name: mydb.`NOXN3`\n" +
 			" │                           └─ columns: [id]\n" +
 			" │   WHEN NOT\n" +
 			" │   └─ MJR3D.TDEIU:10 IS NULL\n" +
@@ -12233,7 +12772,7 @@ WHERE
 			" │                       ├─ NOXN3.id:27!null\n" +
 			" │                       ├─ row_number() over ( order by NOXN3.id ASC)\n" +
 			" │                       └─ Table\n" +
-			" │                           ├─ name: NOXN3\n" +
+			" │                           ├─ name: mydb.`NOXN3`\n" +
 			" │                           └─ columns: [id]\n" +
 			" │   END as M6T2N, MJR3D.GE5EL:4 as GE5EL, MJR3D.F7A4Q:5 as F7A4Q, MJR3D.CC4AX:7 as CC4AX, MJR3D.SL76B:8!null as SL76B, aac.BTXC5:22 as YEBDJ, MJR3D.PSMU6:2]\n" +
 			" └─ HashJoin\n" +
@@ -12276,9 +12815,9 @@ WHERE
 			"     │   │   │   │   │                       ├─ Eq\n" +
 			"     │   │   │   │   │                       │   ├─ JTEHG.BRQP2:22!null\n" +
 			"     │   │   │   │   │                       │   └─ MJR3D.BJUF2:1\n" +
-			"     │   │   │   │   │                       └─ TableAlias(JTEHG)\n" +
+			"     │   │   │   │   │                       └─ TableAlias(`JTEHG`)\n" +
 			"     │   │   │   │   │                           └─ Table\n" +
-			"     │   │   │   │   │                               ├─ name: NOXN3\n" +
+			"     │   │   │   │   │                               ├─ name: mydb.`NOXN3`\n" +
 			"     │   │   │   │   │                               └─ columns: [id brqp2]\n" +
 			"     │   │   │   │   └─ AND\n" +
 			"     │   │   │   │       ├─ AND\n" +
@@ -12295,9 +12834,9 @@ WHERE
 			"     │   │   │   │                       ├─ Eq\n" +
 			"     │   │   │   │                       │   ├─ XMAFZ.BRQP2:22!null\n" +
 			"     │   │   │   │                       │   └─ MJR3D.FJDP5:0!null\n" +
-			"     │   │   │   │                       └─ TableAlias(XMAFZ)\n" +
+			"     │   │   │   │                       └─ TableAlias(`XMAFZ`)\n" +
 			"     │   │   │   │                           └─ Table\n" +
-			"     │   │   │   │                               ├─ name: NOXN3\n" +
+			"     │   │   │   │                               ├─ name: mydb.`NOXN3`\n" +
 			"     │   │   │   │                               └─ columns: [id brqp2]\n" +
 			"     │   │   │   └─ AND\n" +
 			"     │   │   │       ├─ AND\n" +
@@ -12315,311 +12854,288 @@ WHERE
 			"     │   │   │                       ├─ Eq\n" +
 			"     │   │   │                       │   ├─ XMAFZ.BRQP2:22!null\n" +
 			"     │   │   │                       │   └─ MJR3D.BJUF2:1\n" +
-			"     │   │   │                       └─ TableAlias(XMAFZ)\n" +
+			"     │   │   │                       └─ TableAlias(`XMAFZ`)\n" +
 			"     │   │   │                           └─ Table\n" +
-			"     │   │   │                               ├─ name: NOXN3\n" +
+			"     │   │   │                               ├─ name: mydb.`NOXN3`\n" +
 			"     │   │   │                               └─ columns: [id brqp2]\n" +
 			"     │   │   ├─ SubqueryAlias\n" +
-			"     │   │   │   ├─ name: MJR3D\n" +
+			"     │   │   │   ├─ name: `MJR3D`\n" +
 			"     │   │   │   ├─ outerVisibility: false\n" +
 			"     │   │   │   ├─ cacheable: true\n" +
 			"     │   │   │   └─ Union distinct\n" +
-			"     │   │   │       ├─ Project\n" +
-			"     │   │   │       │   ├─ columns: [JCHIR.FJDP5:0!null, JCHIR.BJUF2:1, JCHIR.PSMU6:2, JCHIR.M22QN:3!null, JCHIR.GE5EL:4, JCHIR.F7A4Q:5, JCHIR.ESFVY:6!null, JCHIR.CC4AX:7, JCHIR.SL76B:8!null, convert\n" +
-			"     │   │   │       │   │   ├─ type: char\n" +
-			"     │   │   │       │   │   └─ JCHIR.QNI57:9\n" +
-			"     │   │   │       │   │   as QNI57, TDEIU:10 as TDEIU]\n" +
-			"     │   │   │       │   └─ Union distinct\n" +
-			"     │   │   │       │       ├─ Project\n" +
-			"     │   │   │       │       │   ├─ columns: [JCHIR.FJDP5:0!null, JCHIR.BJUF2:1, JCHIR.PSMU6:2, JCHIR.M22QN:3!null, JCHIR.GE5EL:4, JCHIR.F7A4Q:5, JCHIR.ESFVY:6!null, JCHIR.CC4AX:7, JCHIR.SL76B:8!null, JCHIR.QNI57:9, convert\n" +
-			"     │   │   │       │       │   │   ├─ type: char\n" +
-			"     │   │   │       │       │   │   └─ JCHIR.TDEIU:10\n" +
-			"     │   │   │       │       │   │   as TDEIU]\n" +
-			"     │   │   │       │       │   └─ SubqueryAlias\n" +
-			"     │   │   │       │       │       ├─ name: JCHIR\n" +
-			"     │   │   │       │       │       ├─ outerVisibility: false\n" +
-			"     │   │   │       │       │       ├─ cacheable: true\n" +
-			"     │   │   │       │       │       └─ Filter\n" +
-			"     │   │   │       │       │           ├─ Or\n" +
-			"     │   │   │       │       │           │   ├─ AND\n" +
-			"     │   │   │       │       │           │   │   ├─ NOT\n" +
-			"     │   │   │       │       │           │   │   │   └─ QNI57:9 IS NULL\n" +
-			"     │   │   │       │       │           │   │   └─ TDEIU:10 IS NULL\n" +
-			"     │   │   │       │       │           │   └─ AND\n" +
-			"     │   │   │       │       │           │       ├─ QNI57:9 IS NULL\n" +
-			"     │   │   │       │       │           │       └─ NOT\n" +
-			"     │   │   │       │       │           │           └─ TDEIU:10 IS NULL\n" +
-			"     │   │   │       │       │           └─ Project\n" +
-			"     │   │   │       │       │               ├─ columns: [ism.FV24E:0!null as FJDP5, CPMFE.id:12 as BJUF2, CPMFE.TW55N:13 as PSMU6, ism.M22QN:2!null as M22QN, G3YXS.GE5EL:8, G3YXS.F7A4Q:9, G3YXS.ESFVY:6!null, CASE  WHEN IN\n" +
-			"     │   │   │       │       │               │   ├─ left: G3YXS.SL76B:7!null\n" +
-			"     │   │   │       │       │               │   └─ right: TUPLE(FO422 (longtext), SJ53H (longtext))\n" +
-			"     │   │   │       │       │               │   THEN 0 (tinyint) WHEN IN\n" +
-			"     │   │   │       │       │               │   ├─ left: G3YXS.SL76B:7!null\n" +
-			"     │   │   │       │       │               │   └─ right: TUPLE(DCV4Z (longtext), UOSM4 (longtext), FUGIP (longtext), H5MCC (longtext), YKEQE (longtext), D3AKL (longtext))\n" +
-			"     │   │   │       │       │               │   THEN 1 (tinyint) WHEN IN\n" +
-			"     │   │   │       │       │               │   ├─ left: G3YXS.SL76B:7!null\n" +
-			"     │   │   │       │       │               │   └─ right: TUPLE(QJEXM (longtext), J6S7P (longtext), VT7FI (longtext))\n" +
-			"     │   │   │       │       │               │   THEN 2 (tinyint) WHEN IN\n" +
-			"     │   │   │       │       │               │   ├─ left: G3YXS.SL76B:7!null\n" +
-			"     │   │   │       │       │               │   └─ right: TUPLE(Y62X7 (longtext))\n" +
-			"     │   │   │       │       │               │   THEN 3 (tinyint) END as CC4AX, G3YXS.SL76B:7!null as SL76B, YQIF4.id:15 as QNI57, YVHJZ.id:18 as TDEIU]\n" +
-			"     │   │   │       │       │               └─ Filter\n" +
-			"     │   │   │       │       │                   ├─ Or\n" +
-			"     │   │   │       │       │                   │   ├─ NOT\n" +
-			"     │   │   │       │       │                   │   │   └─ YQIF4.id:15 IS NULL\n" +
-			"     │   │   │       │       │                   │   └─ NOT\n" +
-			"     │   │   │       │       │                   │       └─ YVHJZ.id:18 IS NULL\n" +
-			"     │   │   │       │       │                   └─ LeftOuterJoin\n" +
-			"     │   │   │       │       │                       ├─ AND\n" +
-			"     │   │   │       │       │                       │   ├─ Eq\n" +
-			"     │   │   │       │       │                       │   │   ├─ YVHJZ.BRQP2:19!null\n" +
-			"     │   │   │       │       │                       │   │   └─ ism.UJ6XY:1!null\n" +
-			"     │   │   │       │       │                       │   └─ Eq\n" +
-			"     │   │   │       │       │                       │       ├─ YVHJZ.FFTBJ:20!null\n" +
-			"     │   │   │       │       │                       │       └─ ism.FV24E:0!null\n" +
-			"     │   │   │       │       │                       ├─ LeftOuterJoin\n" +
-			"     │   │   │       │       │                       │   ├─ AND\n" +
-			"     │   │   │       │       │                       │   │   ├─ Eq\n" +
-			"     │   │   │       │       │                       │   │   │   ├─ YQIF4.BRQP2:16!null\n" +
-			"     │   │   │       │       │                       │   │   │   └─ ism.FV24E:0!null\n" +
-			"     │   │   │       │       │                       │   │   └─ Eq\n" +
-			"     │   │   │       │       │                       │   │       ├─ YQIF4.FFTBJ:17!null\n" +
-			"     │   │   │       │       │                       │   │       └─ ism.UJ6XY:1!null\n" +
-			"     │   │   │       │       │                       │   ├─ LeftOuterJoin\n" +
-			"     │   │   │       │       │                       │   │   ├─ AND\n" +
-			"     │   │   │       │       │                       │   │   │   ├─ Eq\n" +
-			"     │   │   │       │       │                       │   │   │   │   ├─ CPMFE.ZH72S:14\n" +
-			"     │   │   │       │       │                       │   │   │   │   └─ NHMXW.NOHHR:11\n" +
-			"     │   │   │       │       │                       │   │   │   └─ NOT\n" +
-			"     │   │   │       │       │                       │   │   │       └─ Eq\n" +
-			"     │   │   │       │       │                       │   │   │           ├─ CPMFE.id:12!null\n" +
-			"     │   │   │       │       │                       │   │   │           └─ ism.FV24E:0!null\n" +
-			"     │   │   │       │       │                       │   │   ├─ LeftOuterJoin\n" +
-			"     │   │   │       │       │                       │   │   │   ├─ Eq\n" +
-			"     │   │   │       │       │                       │   │   │   │   ├─ NHMXW.id:10!null\n" +
-			"     │   │   │       │       │                       │   │   │   │   └─ ism.PRUV2:4\n" +
-			"     │   │   │       │       │                       │   │   │   ├─ InnerJoin\n" +
-			"     │   │   │       │       │                       │   │   │   │   ├─ Eq\n" +
-			"     │   │   │       │       │                       │   │   │   │   │   ├─ G3YXS.id:5!null\n" +
-			"     │   │   │       │       │                       │   │   │   │   │   └─ ism.NZ4MQ:3!null\n" +
-			"     │   │   │       │       │                       │   │   │   │   ├─ TableAlias(ism)\n" +
-			"     │   │   │       │       │                       │   │   │   │   │   └─ Table\n" +
-			"     │   │   │       │       │                       │   │   │   │   │       ├─ name: HDDVB\n" +
-			"     │   │   │       │       │                       │   │   │   │   │       └─ columns: [fv24e uj6xy m22qn nz4mq pruv2]\n" +
-			"     │   │   │       │       │                       │   │   │   │   └─ TableAlias(G3YXS)\n" +
-			"     │   │   │       │       │                       │   │   │   │       └─ Table\n" +
-			"     │   │   │       │       │                       │   │   │   │           ├─ name: YYBCX\n" +
-			"     │   │   │       │       │                       │   │   │   │           └─ columns: [id esfvy sl76b ge5el f7a4q]\n" +
-			"     │   │   │       │       │                       │   │   │   └─ TableAlias(NHMXW)\n" +
-			"     │   │   │       │       │                       │   │   │       └─ Table\n" +
-			"     │   │   │       │       │                       │   │   │           ├─ name: WGSDC\n" +
-			"     │   │   │       │       │                       │   │   │           └─ columns: [id nohhr]\n" +
-			"     │   │   │       │       │                       │   │   └─ TableAlias(CPMFE)\n" +
-			"     │   │   │       │       │                       │   │       └─ Table\n" +
-			"     │   │   │       │       │                       │   │           ├─ name: E2I7U\n" +
-			"     │   │   │       │       │                       │   │           └─ columns: [id tw55n zh72s]\n" +
-			"     │   │   │       │       │                       │   └─ TableAlias(YQIF4)\n" +
-			"     │   │   │       │       │                       │       └─ Table\n" +
-			"     │   │   │       │       │                       │           ├─ name: NOXN3\n" +
-			"     │   │   │       │       │                       │           └─ columns: [id brqp2 fftbj]\n" +
-			"     │   │   │       │       │                       └─ TableAlias(YVHJZ)\n" +
-			"     │   │   │       │       │                           └─ Table\n" +
-			"     │   │   │       │       │                               ├─ name: NOXN3\n" +
-			"     │   │   │       │       │                               └─ columns: [id brqp2 fftbj]\n" +
-			"     │   │   │       │       └─ Project\n" +
-			"     │   │   │       │           ├─ columns: [JCHIR.FJDP5:0!null, JCHIR.BJUF2:1, JCHIR.PSMU6:2, JCHIR.M22QN:3!null, JCHIR.GE5EL:4, JCHIR.F7A4Q:5, JCHIR.ESFVY:6!null, JCHIR.CC4AX:7, JCHIR.SL76B:8!null, JCHIR.QNI57:9, convert\n" +
-			"     │   │   │       │           │   ├─ type: char\n" +
-			"     │   │   │       │           │   └─ TDEIU:10\n" +
-			"     │   │   │       │           │   as TDEIU]\n" +
-			"     │   │   │       │           └─ Project\n" +
-			"     │   │   │       │               ├─ columns: [JCHIR.FJDP5:0!null, JCHIR.BJUF2:1, JCHIR.PSMU6:2, JCHIR.M22QN:3!null, JCHIR.GE5EL:4, JCHIR.F7A4Q:5, JCHIR.ESFVY:6!null, JCHIR.CC4AX:7, JCHIR.SL76B:8!null, JCHIR.QNI57:9, NULL (null) as TDEIU]\n" +
-			"     │   │   │       │               └─ SubqueryAlias\n" +
-			"     │   │   │       │                   ├─ name: JCHIR\n" +
-			"     │   │   │       │                   ├─ outerVisibility: false\n" +
-			"     │   │   │       │                   ├─ cacheable: true\n" +
+			"     │   │   │       ├─ Union distinct\n" +
+			"     │   │   │       │   ├─ SubqueryAlias\n" +
+			"     │   │   │       │   │   ├─ name: `JCHIR`\n" +
+			"     │   │   │       │   │   ├─ outerVisibility: false\n" +
+			"     │   │   │       │   │   ├─ cacheable: true\n" +
+			"     │   │   │       │   │   └─ Filter\n" +
+			"     │   │   │       │   │       ├─ Or\n" +
+			"     │   │   │       │   │       │   ├─ AND\n" +
+			"     │   │   │       │   │       │   │   ├─ NOT\n" +
+			"     │   │   │       │   │       │   │   │   └─ QNI57:9 IS NULL\n" +
+			"     │   │   │       │   │       │   │   └─ TDEIU:10 IS NULL\n" +
+			"     │   │   │       │   │       │   └─ AND\n" +
+			"     │   │   │       │   │       │       ├─ QNI57:9 IS NULL\n" +
+			"     │   │   │       │   │       │       └─ NOT\n" +
+			"     │   │   │       │   │       │           └─ TDEIU:10 IS NULL\n" +
+			"     │   │   │       │   │       └─ Project\n" +
+			"     │   │   │       │   │           ├─ columns: [ism.FV24E:0!null as FJDP5, CPMFE.id:12 as BJUF2, CPMFE.TW55N:13 as PSMU6, ism.M22QN:2!null as M22QN, G3YXS.GE5EL:8, G3YXS.F7A4Q:9, G3YXS.ESFVY:6!null, CASE  WHEN IN\n" +
+			"     │   │   │       │   │           │   ├─ left: G3YXS.SL76B:7!null\n" +
+			"     │   │   │       │   │           │   └─ right: TUPLE(FO422 (longtext), SJ53H (longtext))\n" +
+			"     │   │   │       │   │           │   THEN 0 (tinyint) WHEN IN\n" +
+			"     │   │   │       │   │           │   ├─ left: G3YXS.SL76B:7!null\n" +
+			"     │   │   │       │   │           │   └─ right: TUPLE(DCV4Z (longtext), UOSM4 (longtext), FUGIP (longtext), H5MCC (longtext), YKEQE (longtext), D3AKL (longtext))\n" +
+			"     │   │   │       │   │           │   THEN 1 (tinyint) WHEN IN\n" +
+			"     │   │   │       │   │           │   ├─ left: G3YXS.SL76B:7!null\n" +
+			"     │   │   │       │   │           │   └─ right: TUPLE(QJEXM (longtext), J6S7P (longtext), VT7FI (longtext))\n" +
+			"     │   │   │       │   │           │   THEN 2 (tinyint) WHEN IN\n" +
+			"     │   │   │       │   │           │   ├─ left: G3YXS.SL76B:7!null\n" +
+			"     │   │   │       │   │           │   └─ right: TUPLE(Y62X7 (longtext))\n" +
+			"     │   │   │       │   │           │   THEN 3 (tinyint) END as CC4AX, G3YXS.SL76B:7!null as SL76B, YQIF4.id:15 as QNI57, YVHJZ.id:18 as TDEIU]\n" +
+			"     │   │   │       │   │           └─ Filter\n" +
+			"     │   │   │       │   │               ├─ Or\n" +
+			"     │   │   │       │   │               │   ├─ NOT\n" +
+			"     │   │   │       │   │               │   │   └─ YQIF4.id:15 IS NULL\n" +
+			"     │   │   │       │   │               │   └─ NOT\n" +
+			"     │   │   │       │   │               │       └─ YVHJZ.id:18 IS NULL\n" +
+			"     │   │   │       │   │               └─ LeftOuterJoin\n" +
+			"     │   │   │       │   │                   ├─ AND\n" +
+			"     │   │   │       │   │                   │   ├─ Eq\n" +
+			"     │   │   │       │   │                   │   │   ├─ YVHJZ.BRQP2:19!null\n" +
+			"     │   │   │       │   │                   │   │   └─ ism.UJ6XY:1!null\n" +
+			"     │   │   │       │   │                   │   └─ Eq\n" +
+			"     │   │   │       │   │                   │       ├─ YVHJZ.FFTBJ:20!null\n" +
+			"     │   │   │       │   │                   │       └─ ism.FV24E:0!null\n" +
+			"     │   │   │       │   │                   ├─ LeftOuterJoin\n" +
+			"     │   │   │       │   │                   │   ├─ AND\n" +
+			"     │   │   │       │   │                   │   │   ├─ Eq\n" +
+			"     │   │   │       │   │                   │   │   │   ├─ YQIF4.BRQP2:16!null\n" +
+			"     │   │   │       │   │                   │   │   │   └─ ism.FV24E:0!null\n" +
+			"     │   │   │       │   │                   │   │   └─ Eq\n" +
+			"     │   │   │       │   │                   │   │       ├─ YQIF4.FFTBJ:17!null\n" +
+			"     │   │   │       │   │                   │   │       └─ ism.UJ6XY:1!null\n" +
+			"     │   │   │       │   │                   │   ├─ LeftOuterJoin\n" +
+			"     │   │   │       │   │                   │   │   ├─ AND\n" +
+			"     │   │   │       │   │                   │   │   │   ├─ Eq\n" +
+			"     │   │   │       │   │                   │   │   │   │   ├─ CPMFE.ZH72S:14\n" +
+			"     │   │   │       │   │                   │   │   │   │   └─ NHMXW.NOHHR:11\n" +
+			"     │   │   │       │   │                   │   │   │   └─ NOT\n" +
+			"     │   │   │       │   │                   │   │   │       └─ Eq\n" +
+			"     │   │   │       │   │                   │   │   │           ├─ CPMFE.id:12!null\n" +
+			"     │   │   │       │   │                   │   │   │           └─ ism.FV24E:0!null\n" +
+			"     │   │   │       │   │                   │   │   ├─ LeftOuterJoin\n" +
+			"     │   │   │       │   │                   │   │   │   ├─ Eq\n" +
+			"     │   │   │       │   │                   │   │   │   │   ├─ NHMXW.id:10!null\n" +
+			"     │   │   │       │   │                   │   │   │   │   └─ ism.PRUV2:4\n" +
+			"     │   │   │       │   │                   │   │   │   ├─ InnerJoin\n" +
+			"     │   │   │       │   │                   │   │   │   │   ├─ Eq\n" +
+			"     │   │   │       │   │                   │   │   │   │   │   ├─ G3YXS.id:5!null\n" +
+			"     │   │   │       │   │                   │   │   │   │   │   └─ ism.NZ4MQ:3!null\n" +
+			"     │   │   │       │   │                   │   │   │   │   ├─ TableAlias(ism)\n" +
+			"     │   │   │       │   │                   │   │   │   │   │   └─ Table\n" +
+			"     │   │   │       │   │                   │   │   │   │   │       ├─ name: mydb.`HDDVB`\n" +
+			"     │   │   │       │   │                   │   │   │   │   │       └─ columns: [fv24e uj6xy m22qn nz4mq pruv2]\n" +
+			"     │   │   │       │   │                   │   │   │   │   └─ TableAlias(`G3YXS`)\n" +
+			"     │   │   │       │   │                   │   │   │   │       └─ Table\n" +
+			"     │   │   │       │   │                   │   │   │   │           ├─ name: mydb.`YYBCX`\n" +
+			"     │   │   │       │   │                   │   │   │   │           └─ columns: [id esfvy sl76b ge5el f7a4q]\n" +
+			"     │   │   │       │   │                   │   │   │   └─ TableAlias(`NHMXW`)\n" +
+			"     │   │   │       │   │                   │   │   │       └─ Table\n" +
+			"     │   │   │       │   │                   │   │   │           ├─ name: mydb.`WGSDC`\n" +
+			"     │   │   │       │   │                   │   │   │           └─ columns: [id nohhr]\n" +
+			"     │   │   │       │   │                   │   │   └─ TableAlias(`CPMFE`)\n" +
+			"     │   │   │       │   │                   │   │       └─ Table\n" +
+			"     │   │   │       │   │                   │   │           ├─ name: mydb.`E2I7U`\n" +
+			"     │   │   │       │   │                   │   │           └─ columns: [id tw55n zh72s]\n" +
+			"     │   │   │       │   │                   │   └─ TableAlias(`YQIF4`)\n" +
+			"     │   │   │       │   │                   │       └─ Table\n" +
+			"     │   │   │       │   │                   │           ├─ name: mydb.`NOXN3`\n" +
+			"     │   │   │       │   │                   │           └─ columns: [id brqp2 fftbj]\n" +
+			"     │   │   │       │   │                   └─ TableAlias(`YVHJZ`)\n" +
+			"     │   │   │       │   │                       └─ Table\n" +
+			"     │   │   │       │   │                           ├─ name: mydb.`NOXN3`\n" +
+			"     │   │   │       │   │                           └─ columns: [id brqp2 fftbj]\n" +
+			"     │   │   │       │   └─ Project\n" +
+			"     │   │   │       │       ├─ columns: [JCHIR.FJDP5:0!null, JCHIR.BJUF2:1, JCHIR.PSMU6:2, JCHIR.M22QN:3!null, JCHIR.GE5EL:4, JCHIR.F7A4Q:5, JCHIR.ESFVY:6!null, JCHIR.CC4AX:7, JCHIR.SL76B:8!null, JCHIR.QNI57:9, NULL (null) as TDEIU]\n" +
+			"     │   │   │       │       └─ SubqueryAlias\n" +
+			"     │   │   │       │           ├─ name: `JCHIR`\n" +
+			"     │   │   │       │           ├─ outerVisibility: false\n" +
+			"     │   │   │       │           ├─ cacheable: true\n" +
+			"     │   │   │       │           └─ Filter\n" +
+			"     │   │   │       │               ├─ AND\n" +
+			"     │   │   │       │               │   ├─ NOT\n" +
+			"     │   │   │       │               │   │   └─ QNI57:9 IS NULL\n" +
+			"     │   │   │       │               │   └─ NOT\n" +
+			"     │   │   │       │               │       └─ TDEIU:10 IS NULL\n" +
+			"     │   │   │       │               └─ Project\n" +
+			"     │   │   │       │                   ├─ columns: [ism.FV24E:0!null as FJDP5, CPMFE.id:12 as BJUF2, CPMFE.TW55N:13 as PSMU6, ism.M22QN:2!null as M22QN, G3YXS.GE5EL:8, G3YXS.F7A4Q:9, G3YXS.ESFVY:6!null, CASE  WHEN IN\n" +
+			"     │   │   │       │                   │   ├─ left: G3YXS.SL76B:7!null\n" +
+			"     │   │   │       │                   │   └─ right: TUPLE(FO422 (longtext), SJ53H (longtext))\n" +
+			"     │   │   │       │                   │   THEN 0 (tinyint) WHEN IN\n" +
+			"     │   │   │       │                   │   ├─ left: G3YXS.SL76B:7!null\n" +
+			"     │   │   │       │                   │   └─ right: TUPLE(DCV4Z (longtext), UOSM4 (longtext), FUGIP (longtext), H5MCC (longtext), YKEQE (longtext), D3AKL (longtext))\n" +
+			"     │   │   │       │                   │   THEN 1 (tinyint) WHEN IN\n" +
+			"     │   │   │       │                   │   ├─ left: G3YXS.SL76B:7!null\n" +
+			"     │   │   │       │                   │   └─ right: TUPLE(QJEXM (longtext), J6S7P (longtext), VT7FI (longtext))\n" +
+			"     │   │   │       │                   │   THEN 2 (tinyint) WHEN IN\n" +
+			"     │   │   │       │                   │   ├─ left: G3YXS.SL76B:7!null\n" +
+			"     │   │   │       │                   │   └─ right: TUPLE(Y62X7 (longtext))\n" +
+			"     │   │   │       │                   │   THEN 3 (tinyint) END as CC4AX, G3YXS.SL76B:7!null as SL76B, YQIF4.id:15 as QNI57, YVHJZ.id:18 as TDEIU]\n" +
 			"     │   │   │       │                   └─ Filter\n" +
-			"     │   │   │       │                       ├─ AND\n" +
+			"     │   │   │       │                       ├─ Or\n" +
 			"     │   │   │       │                       │   ├─ NOT\n" +
-			"     │   │   │       │                       │   │   └─ QNI57:9 IS NULL\n" +
+			"     │   │   │       │                       │   │   └─ YQIF4.id:15 IS NULL\n" +
 			"     │   │   │       │                       │   └─ NOT\n" +
-			"     │   │   │       │                       │       └─ TDEIU:10 IS NULL\n" +
-			"     │   │   │       │                       └─ Project\n" +
-			"     │   │   │       │                           ├─ columns: [ism.FV24E:0!null as FJDP5, CPMFE.id:12 as BJUF2, CPMFE.TW55N:13 as PSMU6, ism.M22QN:2!null as M22QN, G3YXS.GE5EL:8, G3YXS.F7A4Q:9, G3YXS.ESFVY:6!null, CASE  WHEN IN\n" +
-			"     │   │   │       │                           │   ├─ left: G3YXS.SL76B:7!null\n" +
-			"     │   │   │       │                           │   └─ right: TUPLE(FO422 (longtext), SJ53H (longtext))\n" +
-			"     │   │   │       │                           │   THEN 0 (tinyint) WHEN IN\n" +
-			"     │   │   │       │                           │   ├─ left: G3YXS.SL76B:7!null\n" +
-			"     │   │   │       │                           │   └─ right: TUPLE(DCV4Z (longtext), UOSM4 (longtext), FUGIP (longtext), H5MCC (longtext), YKEQE (longtext), D3AKL (longtext))\n" +
-			"     │   │   │       │                           │   THEN 1 (tinyint) WHEN IN\n" +
-			"     │   │   │       │                           │   ├─ left: G3YXS.SL76B:7!null\n" +
-			"     │   │   │       │                           │   └─ right: TUPLE(QJEXM (longtext), J6S7P (longtext), VT7FI (longtext))\n" +
-			"     │   │   │       │                           │   THEN 2 (tinyint) WHEN IN\n" +
-			"     │   │   │       │                           │   ├─ left: G3YXS.SL76B:7!null\n" +
-			"     │   │   │       │                           │   └─ right: TUPLE(Y62X7 (longtext))\n" +
-			"     │   │   │       │                           │   THEN 3 (tinyint) END as CC4AX, G3YXS.SL76B:7!null as SL76B, YQIF4.id:15 as QNI57, YVHJZ.id:18 as TDEIU]\n" +
-			"     │   │   │       │                           └─ Filter\n" +
-			"     │   │   │       │                               ├─ Or\n" +
-			"     │   │   │       │                               │   ├─ NOT\n" +
-			"     │   │   │       │                               │   │   └─ YQIF4.id:15 IS NULL\n" +
-			"     │   │   │       │                               │   └─ NOT\n" +
-			"     │   │   │       │                               │       └─ YVHJZ.id:18 IS NULL\n" +
-			"     │   │   │       │                               └─ LeftOuterJoin\n" +
-			"     │   │   │       │                                   ├─ AND\n" +
-			"     │   │   │       │                                   │   ├─ Eq\n" +
-			"     │   │   │       │                                   │   │   ├─ YVHJZ.BRQP2:19!null\n" +
-			"     │   │   │       │                                   │   │   └─ ism.UJ6XY:1!null\n" +
-			"     │   │   │       │                                   │   └─ Eq\n" +
-			"     │   │   │       │                                   │       ├─ YVHJZ.FFTBJ:20!null\n" +
-			"     │   │   │       │                                   │       └─ ism.FV24E:0!null\n" +
-			"     │   │   │       │                                   ├─ LeftOuterJoin\n" +
-			"     │   │   │       │                                   │   ├─ AND\n" +
-			"     │   │   │       │                                   │   │   ├─ Eq\n" +
-			"     │   │   │       │                                   │   │   │   ├─ YQIF4.BRQP2:16!null\n" +
-			"     │   │   │       │                                   │   │   │   └─ ism.FV24E:0!null\n" +
-			"     │   │   │       │                                   │   │   └─ Eq\n" +
-			"     │   │   │       │                                   │   │       ├─ YQIF4.FFTBJ:17!null\n" +
-			"     │   │   │       │                                   │   │       └─ ism.UJ6XY:1!null\n" +
-			"     │   │   │       │                                   │   ├─ LeftOuterJoin\n" +
-			"     │   │   │       │                                   │   │   ├─ AND\n" +
-			"     │   │   │       │                                   │   │   │   ├─ Eq\n" +
-			"     │   │   │       │                                   │   │   │   │   ├─ CPMFE.ZH72S:14\n" +
-			"     │   │   │       │                                   │   │   │   │   └─ NHMXW.NOHHR:11\n" +
-			"     │   │   │       │                                   │   │   │   └─ NOT\n" +
-			"     │   │   │       │                                   │   │   │       └─ Eq\n" +
-			"     │   │   │       │                                   │   │   │           ├─ CPMFE.id:12!null\n" +
-			"     │   │   │       │                                   │   │   │           └─ ism.FV24E:0!null\n" +
-			"     │   │   │       │                                   │   │   ├─ LeftOuterJoin\n" +
-			"     │   │   │       │                                   │   │   │   ├─ Eq\n" +
-			"     │   │   │       │                                   │   │   │   │   ├─ NHMXW.id:10!null\n" +
-			"     │   │   │       │                                   │   │   │   │   └─ ism.PRUV2:4\n" +
-			"     │   │   │       │                                   │   │   │   ├─ InnerJoin\n" +
-			"     │   │   │       │                                   │   │   │   │   ├─ Eq\n" +
-			"     │   │   │       │                                   │   │   │   │   │   ├─ G3YXS.id:5!null\n" +
-			"     │   │   │       │                                   │   │   │   │   │   └─ ism.NZ4MQ:3!null\n" +
-			"     │   │   │       │                                   │   │   │   │   ├─ TableAlias(ism)\n" +
-			"     │   │   │       │                                   │   │   │   │   │   └─ Table\n" +
-			"     │   │   │       │                                   │   │   │   │   │       ├─ name: HDDVB\n" +
-			"     │   │   │       │                                   │   │   │   │   │       └─ columns: [fv24e uj6xy m22qn nz4mq pruv2]\n" +
-			"     │   │   │       │                                   │   │   │   │   └─ TableAlias(G3YXS)\n" +
-			"     │   │   │       │                                   │   │   │   │       └─ Table\n" +
-			"     │   │   │       │                                   │   │   │   │           ├─ name: YYBCX\n" +
-			"     │   │   │       │                                   │   │   │   │           └─ columns: [id esfvy sl76b ge5el f7a4q]\n" +
-			"     │   │   │       │                                   │   │   │   └─ TableAlias(NHMXW)\n" +
-			"     │   │   │       │                                   │   │   │       └─ Table\n" +
-			"     │   │   │       │                                   │   │   │           ├─ name: WGSDC\n" +
-			"     │   │   │       │                                   │   │   │           └─ columns: [id nohhr]\n" +
-			"     │   │   │       │                                   │   │   └─ TableAlias(CPMFE)\n" +
-			"     │   │   │       │                                   │   │       └─ Table\n" +
-			"     │   │   │       │                                   │   │           ├─ name: E2I7U\n" +
-			"     │   │   │       │                                   │   │           └─ columns: [id tw55n zh72s]\n" +
-			"     │   │   │       │                                   │   └─ TableAlias(YQIF4)\n" +
-			"     │   │   │       │                                   │       └─ Table\n" +
-			"     │   │   │       │                                   │           ├─ name: NOXN3\n" +
-			"     │   │   │       │                                   │           └─ columns: [id brqp2 fftbj]\n" +
-			"     │   │   │       │                                   └─ TableAlias(YVHJZ)\n" +
-			"     │   │   │       │                                       └─ Table\n" +
-			"     │   │   │       │                                           ├─ name: NOXN3\n" +
-			"     │   │   │       │                                           └─ columns: [id brqp2 fftbj]\n" +
+			"     │   │   │       │                       │       └─ YVHJZ.id:18 IS NULL\n" +
+			"     │   │   │       │                       └─ LeftOuterJoin\n" +
+			"     │   │   │       │                           ├─ AND\n" +
+			"     │   │   │       │                           │   ├─ Eq\n" +
+			"     │   │   │       │                           │   │   ├─ YVHJZ.BRQP2:19!null\n" +
+			"     │   │   │       │                           │   │   └─ ism.UJ6XY:1!null\n" +
+			"     │   │   │       │                           │   └─ Eq\n" +
+			"     │   │   │       │                           │       ├─ YVHJZ.FFTBJ:20!null\n" +
+			"     │   │   │       │                           │       └─ ism.FV24E:0!null\n" +
+			"     │   │   │       │                           ├─ LeftOuterJoin\n" +
+			"     │   │   │       │                           │   ├─ AND\n" +
+			"     │   │   │       │                           │   │   ├─ Eq\n" +
+			"     │   │   │       │                           │   │   │   ├─ YQIF4.BRQP2:16!null\n" +
+			"     │   │   │       │                           │   │   │   └─ ism.FV24E:0!null\n" +
+			"     │   │   │       │                           │   │   └─ Eq\n" +
+			"     │   │   │       │                           │   │       ├─ YQIF4.FFTBJ:17!null\n" +
+			"     │   │   │       │                           │   │       └─ ism.UJ6XY:1!null\n" +
+			"     │   │   │       │                           │   ├─ LeftOuterJoin\n" +
+			"     │   │   │       │                           │   │   ├─ AND\n" +
+			"     │   │   │       │                           │   │   │   ├─ Eq\n" +
+			"     │   │   │       │                           │   │   │   │   ├─ CPMFE.ZH72S:14\n" +
+			"     │   │   │       │                           │   │   │   │   └─ NHMXW.NOHHR:11\n" +
+			"     │   │   │       │                           │   │   │   └─ NOT\n" +
+			"     │   │   │       │                           │   │   │       └─ Eq\n" +
+			"     │   │   │       │                           │   │   │           ├─ CPMFE.id:12!null\n" +
+			"     │   │   │       │                           │   │   │           └─ ism.FV24E:0!null\n" +
+			"     │   │   │       │                           │   │   ├─ LeftOuterJoin\n" +
+			"     │   │   │       │                           │   │   │   ├─ Eq\n" +
+			"     │   │   │       │                           │   │   │   │   ├─ NHMXW.id:10!null\n" +
+			"     │   │   │       │                           │   │   │   │   └─ ism.PRUV2:4\n" +
+			"     │   │   │       │                           │   │   │   ├─ InnerJoin\n" +
+			"     │   │   │       │                           │   │   │   │   ├─ Eq\n" +
+			"     │   │   │       │                           │   │   │   │   │   ├─ G3YXS.id:5!null\n" +
+			"     │   │   │       │                           │   │   │   │   │   └─ ism.NZ4MQ:3!null\n" +
+			"     │   │   │       │                           │   │   │   │   ├─ TableAlias(ism)\n" +
+			"     │   │   │       │                           │   │   │   │   │   └─ Table\n" +
+			"     │   │   │       │                           │   │   │   │   │       ├─ name: mydb.`HDDVB`\n" +
+			"     │   │   │       │                           │   │   │   │   │       └─ columns: [fv24e uj6xy m22qn nz4mq pruv2]\n" +
+			"     │   │   │       │                           │   │   │   │   └─ TableAlias(`G3YXS`)\n" +
+			"     │   │   │       │                           │   │   │   │       └─ Table\n" +
+			"     │   │   │       │                           │   │   │   │           ├─ name: mydb.`YYBCX`\n" +
+			"     │   │   │       │                           │   │   │   │           └─ columns: [id esfvy sl76b ge5el f7a4q]\n" +
+			"     │   │   │       │                           │   │   │   └─ TableAlias(`NHMXW`)\n" +
+			"     │   │   │       │                           │   │   │       └─ Table\n" +
+			"     │   │   │       │                           │   │   │           ├─ name: mydb.`WGSDC`\n" +
+			"     │   │   │       │                           │   │   │           └─ columns: [id nohhr]\n" +
+			"     │   │   │       │                           │   │   └─ TableAlias(`CPMFE`)\n" +
+			"     │   │   │       │                           │   │       └─ Table\n" +
+			"     │   │   │       │                           │   │           ├─ name: mydb.`E2I7U`\n" +
+			"     │   │   │       │                           │   │           └─ columns: [id tw55n zh72s]\n" +
+			"     │   │   │       │                           │   └─ TableAlias(`YQIF4`)\n" +
+			"     │   │   │       │                           │       └─ Table\n" +
+			"     │   │   │       │                           │           ├─ name: mydb.`NOXN3`\n" +
+			"     │   │   │       │                           │           └─ columns: [id brqp2 fftbj]\n" +
+			"     │   │   │       │                           └─ TableAlias(`YVHJZ`)\n" +
+			"     │   │   │       │                               └─ Table\n" +
+			"     │   │   │       │                                   ├─ name: mydb.`NOXN3`\n" +
+			"     │   │   │       │                                   └─ columns: [id brqp2 fftbj]\n" +
 			"     │   │   │       └─ Project\n" +
-			"     │   │   │           ├─ columns: [JCHIR.FJDP5:0!null, JCHIR.BJUF2:1, JCHIR.PSMU6:2, JCHIR.M22QN:3!null, JCHIR.GE5EL:4, JCHIR.F7A4Q:5, JCHIR.ESFVY:6!null, JCHIR.CC4AX:7, JCHIR.SL76B:8!null, convert\n" +
-			"     │   │   │           │   ├─ type: char\n" +
-			"     │   │   │           │   └─ QNI57:9\n" +
-			"     │   │   │           │   as QNI57, convert\n" +
-			"     │   │   │           │   ├─ type: char\n" +
-			"     │   │   │           │   └─ JCHIR.TDEIU:10\n" +
-			"     │   │   │           │   as TDEIU]\n" +
-			"     │   │   │           └─ Project\n" +
-			"     │   │   │               ├─ columns: [JCHIR.FJDP5:0!null, JCHIR.BJUF2:1, JCHIR.PSMU6:2, JCHIR.M22QN:3!null, JCHIR.GE5EL:4, JCHIR.F7A4Q:5, JCHIR.ESFVY:6!null, JCHIR.CC4AX:7, JCHIR.SL76B:8!null, NULL (null) as QNI57, JCHIR.TDEIU:10]\n" +
-			"     │   │   │               └─ SubqueryAlias\n" +
-			"     │   │   │                   ├─ name: JCHIR\n" +
-			"     │   │   │                   ├─ outerVisibility: false\n" +
-			"     │   │   │                   ├─ cacheable: true\n" +
-			"     │   │   │                   └─ Filter\n" +
-			"     │   │   │                       ├─ AND\n" +
-			"     │   │   │                       │   ├─ NOT\n" +
-			"     │   │   │                       │   │   └─ QNI57:9 IS NULL\n" +
-			"     │   │   │                       │   └─ NOT\n" +
-			"     │   │   │                       │       └─ TDEIU:10 IS NULL\n" +
-			"     │   │   │                       └─ Project\n" +
-			"     │   │   │                           ├─ columns: [ism.FV24E:0!null as FJDP5, CPMFE.id:12 as BJUF2, CPMFE.TW55N:13 as PSMU6, ism.M22QN:2!null as M22QN, G3YXS.GE5EL:8, G3YXS.F7A4Q:9, G3YXS.ESFVY:6!null, CASE  WHEN IN\n" +
-			"     │   │   │                           │   ├─ left: G3YXS.SL76B:7!null\n" +
-			"     │   │   │                           │   └─ right: TUPLE(FO422 (longtext), SJ53H (longtext))\n" +
-			"     │   │   │                           │   THEN 0 (tinyint) WHEN IN\n" +
-			"     │   │   │                           │   ├─ left: G3YXS.SL76B:7!null\n" +
-			"     │   │   │                           │   └─ right: TUPLE(DCV4Z (longtext), UOSM4 (longtext), FUGIP (longtext), H5MCC (longtext), YKEQE (longtext), D3AKL (longtext))\n" +
-			"     │   │   │                           │   THEN 1 (tinyint) WHEN IN\n" +
-			"     │   │   │                           │   ├─ left: G3YXS.SL76B:7!null\n" +
-			"     │   │   │                           │   └─ right: TUPLE(QJEXM (longtext), J6S7P (longtext), VT7FI (longtext))\n" +
-			"     │   │   │                           │   THEN 2 (tinyint) WHEN IN\n" +
-			"     │   │   │                           │   ├─ left: G3YXS.SL76B:7!null\n" +
-			"     │   │   │                           │   └─ right: TUPLE(Y62X7 (longtext))\n" +
-			"     │   │   │                           │   THEN 3 (tinyint) END as CC4AX, G3YXS.SL76B:7!null as SL76B, YQIF4.id:15 as QNI57, YVHJZ.id:18 as TDEIU]\n" +
-			"     │   │   │                           └─ Filter\n" +
-			"     │   │   │                               ├─ Or\n" +
-			"     │   │   │                               │   ├─ NOT\n" +
-			"     │   │   │                               │   │   └─ YQIF4.id:15 IS NULL\n" +
-			"     │   │   │                               │   └─ NOT\n" +
-			"     │   │   │                               │       └─ YVHJZ.id:18 IS NULL\n" +
-			"     │   │   │                               └─ LeftOuterJoin\n" +
-			"     │   │   │                                   ├─ AND\n" +
-			"     │   │   │                                   │   ├─ Eq\n" +
-			"     │   │   │                                   │   │   ├─ YVHJZ.BRQP2:19!null\n" +
-			"     │   │   │                                   │   │   └─ ism.UJ6XY:1!null\n" +
-			"     │   │   │                                   │   └─ Eq\n" +
-			"     │   │   │                                   │       ├─ YVHJZ.FFTBJ:20!null\n" +
-			"     │   │   │                                   │       └─ ism.FV24E:0!null\n" +
-			"     │   │   │                                   ├─ LeftOuterJoin\n" +
-			"     │   │   │                                   │   ├─ AND\n" +
-			"     │   │   │                                   │   │   ├─ Eq\n" +
-			"     │   │   │                                   │   │   │   ├─ YQIF4.BRQP2:16!null\n" +
-			"     │   │   │                                   │   │   │   └─ ism.FV24E:0!null\n" +
-			"     │   │   │                                   │   │   └─ Eq\n" +
-			"     │   │   │                                   │   │       ├─ YQIF4.FFTBJ:17!null\n" +
-			"     │   │   │                                   │   │       └─ ism.UJ6XY:1!null\n" +
-			"     │   │   │                                   │   ├─ LeftOuterJoin\n" +
-			"     │   │   │                                   │   │   ├─ AND\n" +
-			"     │   │   │                                   │   │   │   ├─ Eq\n" +
-			"     │   │   │                                   │   │   │   │   ├─ CPMFE.ZH72S:14\n" +
-			"     │   │   │                                   │   │   │   │   └─ NHMXW.NOHHR:11\n" +
-			"     │   │   │                                   │   │   │   └─ NOT\n" +
-			"     │   │   │                                   │   │   │       └─ Eq\n" +
-			"     │   │   │                                   │   │   │           ├─ CPMFE.id:12!null\n" +
-			"     │   │   │                                   │   │   │           └─ ism.FV24E:0!null\n" +
-			"     │   │   │                                   │   │   ├─ LeftOuterJoin\n" +
-			"     │   │   │                                   │   │   │   ├─ Eq\n" +
-			"     │   │   │                                   │   │   │   │   ├─ NHMXW.id:10!null\n" +
-			"     │   │   │                                   │   │   │   │   └─ ism.PRUV2:4\n" +
-			"     │   │   │                                   │   │   │   ├─ InnerJoin\n" +
-			"     │   │   │                                   │   │   │   │   ├─ Eq\n" +
-			"     │   │   │                                   │   │   │   │   │   ├─ G3YXS.id:5!null\n" +
-			"     │   │   │                                   │   │   │   │   │   └─ ism.NZ4MQ:3!null\n" +
-			"     │   │   │                                   │   │   │   │   ├─ TableAlias(ism)\n" +
-			"     │   │   │                                   │   │   │   │   │   └─ Table\n" +
-			"     │   │   │                                   │   │   │   │   │       ├─ name: HDDVB\n" +
-			"     │   │   │                                   │   │   │   │   │       └─ columns: [fv24e uj6xy m22qn nz4mq pruv2]\n" +
-			"     │   │   │                                   │   │   │   │   └─ TableAlias(G3YXS)\n" +
-			"     │   │   │                                   │   │   │   │       └─ Table\n" +
-			"     │   │   │                                   │   │   │   │           ├─ name: YYBCX\n" +
-			"     │   │   │                                   │   │   │   │           └─ columns: [id esfvy sl76b ge5el f7a4q]\n" +
-			"     │   │   │                                   │   │   │   └─ TableAlias(NHMXW)\n" +
-			"     │   │   │                                   │   │   │       └─ Table\n" +
-			"     │   │   │                                   │   │   │           ├─ name: WGSDC\n" +
-			"     │   │   │                                   │   │   │           └─ columns: [id nohhr]\n" +
-			"     │   │   │                                   │   │   └─ TableAlias(CPMFE)\n" +
-			"     │   │   │                                   │   │       └─ Table\n" +
-			"     │   │   │                                   │   │           ├─ name: E2I7U\n" +
-			"     │   │   │                                   │   │           └─ columns: [id tw55n zh72s]\n" +
-			"     │   │   │                                   │   └─ TableAlias(YQIF4)\n" +
-			"     │   │   │                                   │       └─ Table\n" +
-			"     │   │   │                                   │           ├─ name: NOXN3\n" +
-			"     │   │   │                                   │           └─ columns: [id brqp2 fftbj]\n" +
-			"     │   │   │                                   └─ TableAlias(YVHJZ)\n" +
-			"     │   │   │                                       └─ Table\n" +
-			"     │   │   │                                           ├─ name: NOXN3\n" +
-			"     │   │   │                                           └─ columns: [id brqp2 fftbj]\n" +
+			"     │   │   │           ├─ columns: [JCHIR.FJDP5:0!null, JCHIR.BJUF2:1, JCHIR.PSMU6:2, JCHIR.M22QN:3!null, JCHIR.GE5EL:4, JCHIR.F7A4Q:5, JCHIR.ESFVY:6!null, JCHIR.CC4AX:7, JCHIR.SL76B:8!null, NULL (null) as QNI57, JCHIR.TDEIU:10]\n" +
+			"     │   │   │           └─ SubqueryAlias\n" +
+			"     │   │   │               ├─ name: `JCHIR`\n" +
+			"     │   │   │               ├─ outerVisibility: false\n" +
+			"     │   │   │               ├─ cacheable: true\n" +
+			"     │   │   │               └─ Filter\n" +
+			"     │   │   │                   ├─ AND\n" +
+			"     │   │   │                   │   ├─ NOT\n" +
+			"     │   │   │                   │   │   └─ QNI57:9 IS NULL\n" +
+			"     │   │   │                   │   └─ NOT\n" +
+			"     │   │   │                   │       └─ TDEIU:10 IS NULL\n" +
+			"     │   │   │                   └─ Project\n" +
+			"     │   │   │                       ├─ columns: [ism.FV24E:0!null as FJDP5, CPMFE.id:12 as BJUF2, CPMFE.TW55N:13 as PSMU6, ism.M22QN:2!null as M22QN, G3YXS.GE5EL:8, G3YXS.F7A4Q:9, G3YXS.ESFVY:6!null, CASE  WHEN IN\n" +
+			"     │   │   │                       │   ├─ left: G3YXS.SL76B:7!null\n" +
+			"     │   │   │                       │   └─ right: TUPLE(FO422 (longtext), SJ53H (longtext))\n" +
+			"     │   │   │                       │   THEN 0 (tinyint) WHEN IN\n" +
+			"     │   │   │                       │   ├─ left: G3YXS.SL76B:7!null\n" +
+			"     │   │   │                       │   └─ right: TUPLE(DCV4Z (longtext), UOSM4 (longtext), FUGIP (longtext), H5MCC (longtext), YKEQE (longtext), D3AKL (longtext))\n" +
+			"     │   │   │                       │   THEN 1 (tinyint) WHEN IN\n" +
+			"     │   │   │                       │   ├─ left: G3YXS.SL76B:7!null\n" +
+			"     │   │   │                       │   └─ right: TUPLE(QJEXM (longtext), J6S7P (longtext), VT7FI (longtext))\n" +
+			"     │   │   │                       │   THEN 2 (tinyint) WHEN IN\n" +
+			"     │   │   │                       │   ├─ left: G3YXS.SL76B:7!null\n" +
+			"     │   │   │                       │   └─ right: TUPLE(Y62X7 (longtext))\n" +
+			"     │   │   │                       │   THEN 3 (tinyint) END as CC4AX, G3YXS.SL76B:7!null as SL76B, YQIF4.id:15 as QNI57, YVHJZ.id:18 as TDEIU]\n" +
+			"     │   │   │                       └─ Filter\n" +
+			"     │   │   │                           ├─ Or\n" +
+			"     │   │   │                           │   ├─ NOT\n" +
+			"     │   │   │                           │   │   └─ YQIF4.id:15 IS NULL\n" +
+			"     │   │   │                           │   └─ NOT\n" +
+			"     │   │   │                           │       └─ YVHJZ.id:18 IS NULL\n" +
+			"     │   │   │                           └─ LeftOuterJoin\n" +
+			"     │   │   │                               ├─ AND\n" +
+			"     │   │   │                               │   ├─ Eq\n" +
+			"     │   │   │                               │   │   ├─ YVHJZ.BRQP2:19!null\n" +
+			"     │   │   │                               │   │   └─ ism.UJ6XY:1!null\n" +
+			"     │   │   │                               │   └─ Eq\n" +
+			"     │   │   │                               │       ├─ YVHJZ.FFTBJ:20!null\n" +
+			"     │   │   │                               │       └─ ism.FV24E:0!null\n" +
+			"     │   │   │                               ├─ LeftOuterJoin\n" +
+			"     │   │   │                               │   ├─ AND\n" +
+			"     │   │   │                               │   │   ├─ Eq\n" +
+			"     │   │   │                               │   │   │   ├─ YQIF4.BRQP2:16!null\n" +
+			"     │   │   │                               │   │   │   └─ ism.FV24E:0!null\n" +
+			"     │   │   │                               │   │   └─ Eq\n" +
+			"     │   │   │                               │   │       ├─ YQIF4.FFTBJ:17!null\n" +
+			"     │   │   │                               │   │       └─ ism.UJ6XY:1!null\n" +
+			"     │   │   │                               │   ├─ LeftOuterJoin\n" +
+			"     │   │   │                               │   │   ├─ AND\n" +
+			"     │   │   │                               │   │   │   ├─ Eq\n" +
+			"     │   │   │                               │   │   │   │   ├─ CPMFE.ZH72S:14\n" +
+			"     │   │   │                               │   │   │   │   └─ NHMXW.NOHHR:11\n" +
+			"     │   │   │                               │   │   │   └─ NOT\n" +
+			"     │   │   │                               │   │   │       └─ Eq\n" +
+			"     │   │   │                               │   │   │           ├─ CPMFE.id:12!null\n" +
+			"     │   │   │                               │   │   │           └─ ism.FV24E:0!null\n" +
+			"     │   │   │                               │   │   ├─ LeftOuterJoin\n" +
+			"     │   │   │                               │   │   │   ├─ Eq\n" +
+			"     │   │   │                               │   │   │   │   ├─ NHMXW.id:10!null\n" +
+			"     │   │   │                               │   │   │   │   └─ ism.PRUV2:4\n" +
+			"     │   │   │                               │   │   │   ├─ InnerJoin\n" +
+			"     │   │   │                               │   │   │   │   ├─ Eq\n" +
+			"     │   │   │                               │   │   │   │   │   ├─ G3YXS.id:5!null\n" +
+			"     │   │   │                               │   │   │   │   │   └─ ism.NZ4MQ:3!null\n" +
+			"     │   │   │                               │   │   │   │   ├─ TableAlias(ism)\n" +
+			"     │   │   │                               │   │   │   │   │   └─ Table\n" +
+			"     │   │   │                               │   │   │   │   │       ├─ name: mydb.`HDDVB`\n" +
+			"     │   │   │                               │   │   │   │   │       └─ columns: [fv24e uj6xy m22qn nz4mq pruv2]\n" +
+			"     │   │   │                               │   │   │   │   └─ TableAlias(`G3YXS`)\n" +
+			"     │   │   │                               │   │   │   │       └─ Table\n" +
+			"     │   │   │                               │   │   │   │           ├─ name: mydb.`YYBCX`\n" +
+			"     │   │   │                               │   │   │   │           └─ columns: [id esfvy sl76b ge5el f7a4q]\n" +
+			"     │   │   │                               │   │   │   └─ TableAlias(`NHMXW`)\n" +
+			"     │   │   │                               │   │   │       └─ Table\n" +
+			"     │   │   │                               │   │   │           ├─ name: mydb.`WGSDC`\n" +
+			"     │   │   │                               │   │   │           └─ columns: [id nohhr]\n" +
+			"     │   │   │                               │   │   └─ TableAlias(`CPMFE`)\n" +
+			"     │   │   │                               │   │       └─ Table\n" +
+			"     │   │   │                               │   │           ├─ name: mydb.`E2I7U`\n" +
+			"     │   │   │                               │   │           └─ columns: [id tw55n zh72s]\n" +
+			"     │   │   │                               │   └─ TableAlias(`YQIF4`)\n" +
+			"     │   │   │                               │       └─ Table\n" +
+			"     │   │   │                               │           ├─ name: mydb.`NOXN3`\n" +
+			"     │   │   │                               │           └─ columns: [id brqp2 fftbj]\n" +
+			"     │   │   │                               └─ TableAlias(`YVHJZ`)\n" +
+			"     │   │   │                                   └─ Table\n" +
+			"     │   │   │                                       ├─ name: mydb.`NOXN3`\n" +
+			"     │   │   │                                       └─ columns: [id brqp2 fftbj]\n" +
 			"     │   │   └─ TableAlias(sn)\n" +
 			"     │   │       └─ Table\n" +
-			"     │   │           ├─ name: NOXN3\n" +
+			"     │   │           ├─ name: mydb.`NOXN3`\n" +
 			"     │   │           └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
 			"     │   └─ HashLookup\n" +
 			"     │       ├─ source: TUPLE(MJR3D.M22QN:3!null)\n" +
@@ -12630,7 +13146,7 @@ WHERE
 			"     │               ├─ outerVisibility: false\n" +
 			"     │               ├─ cacheable: true\n" +
 			"     │               └─ Table\n" +
-			"     │                   ├─ name: TPXBU\n" +
+			"     │                   ├─ name: mydb.`TPXBU`\n" +
 			"     │                   └─ columns: [id btxc5 fhcyt]\n" +
 			"     └─ HashLookup\n" +
 			"         ├─ source: TUPLE(sn.BRQP2:12, MJR3D.M22QN:3!null)\n" +
@@ -12652,19 +13168,19 @@ WHERE
 			"                         │   │   └─ mf.GXLUB:2!null\n" +
 			"                         │   ├─ TableAlias(bs)\n" +
 			"                         │   │   └─ Table\n" +
-			"                         │   │       ├─ name: THNTS\n" +
+			"                         │   │       ├─ name: mydb.`THNTS`\n" +
 			"                         │   │       └─ columns: [id ixuxu]\n" +
 			"                         │   └─ TableAlias(mf)\n" +
-			"                         │       └─ IndexedTableAccess(HGMQ6)\n" +
-			"                         │           ├─ index: [HGMQ6.GXLUB]\n" +
+			"                         │       └─ IndexedTableAccess(mydb.`HGMQ6`)\n" +
+			"                         │           ├─ index: `GXLUB` [HGMQ6.GXLUB]\n" +
 			"                         │           └─ columns: [gxlub luevy m22qn]\n" +
 			"                         └─ Filter\n" +
 			"                             ├─ HashIn\n" +
 			"                             │   ├─ cla.FTQLQ:1!null\n" +
 			"                             │   └─ TUPLE(SQ1 (longtext))\n" +
 			"                             └─ TableAlias(cla)\n" +
-			"                                 └─ IndexedTableAccess(YK2GW)\n" +
-			"                                     ├─ index: [YK2GW.id]\n" +
+			"                                 └─ IndexedTableAccess(mydb.`YK2GW`)\n" +
+			"                                     ├─ index: `PRIMARY` [YK2GW.id]\n" +
 			"                                     └─ columns: [id ftqlq]\n" +
 			"",
 	},
@@ -12823,555 +13339,553 @@ WHERE
     )`,
 		ExpectedPlan: "Project\n" +
 			" ├─ columns: [fs.T4IBQ:0!null as T4IBQ, fs.M6T2N:1 as M6T2N, fs.TUV25:3 as TUV25, fs.BTXC5:2 as YEBDJ]\n" +
-			" └─ AntiJoin\n" +
-			"     ├─ Eq\n" +
-			"     │   ├─ TUPLE(fs.T4IBQ:0!null, fs.M6T2N:1, fs.BTXC5:2, fs.TUV25:3)\n" +
-			"     │   └─ TUPLE(scalarSubq0.T4IBQ:4!null, scalarSubq0.M6T2N:5, scalarSubq0.BTXC5:6, scalarSubq0.TUV25:7)\n" +
-			"     ├─ SubqueryAlias\n" +
-			"     │   ├─ name: fs\n" +
-			"     │   ├─ outerVisibility: false\n" +
-			"     │   ├─ cacheable: true\n" +
-			"     │   └─ Project\n" +
-			"     │       ├─ columns: [RSA3Y.T4IBQ:3!null as T4IBQ, JMHIE.M6T2N:0 as M6T2N, JMHIE.BTXC5:1 as BTXC5, JMHIE.TUV25:2 as TUV25]\n" +
-			"     │       └─ CrossJoin\n" +
-			"     │           ├─ SubqueryAlias\n" +
-			"     │           │   ├─ name: JMHIE\n" +
-			"     │           │   ├─ outerVisibility: false\n" +
-			"     │           │   ├─ cacheable: true\n" +
-			"     │           │   └─ Distinct\n" +
-			"     │           │       └─ Project\n" +
-			"     │           │           ├─ columns: [JQHRG.M6T2N:0, JQHRG.BTXC5:1, JQHRG.TUV25:4]\n" +
-			"     │           │           └─ SubqueryAlias\n" +
-			"     │           │               ├─ name: JQHRG\n" +
-			"     │           │               ├─ outerVisibility: false\n" +
-			"     │           │               ├─ cacheable: true\n" +
-			"     │           │               └─ Project\n" +
-			"     │           │                   ├─ columns: [CASE  WHEN NOT\n" +
-			"     │           │                   │   └─ MJR3D.QNI57:5 IS NULL\n" +
-			"     │           │                   │   THEN Subquery\n" +
-			"     │           │                   │   ├─ cacheable: false\n" +
-			"     │           │                   │   └─ Project\n" +
-			"     │           │                   │       ├─ columns: [ei.M6T2N:21!null]\n" +
-			"     │           │                   │       └─ Filter\n" +
-			"     │           │                   │           ├─ Eq\n" +
-			"     │           │                   │           │   ├─ ei.id:20!null\n" +
-			"     │           │                   │           │   └─ MJR3D.QNI57:5\n" +
-			"     │           │                   │           └─ SubqueryAlias\n" +
-			"     │           │                   │               ├─ name: ei\n" +
-			"     │           │                   │               ├─ outerVisibility: true\n" +
-			"     │           │                   │               ├─ cacheable: true\n" +
-			"     │           │                   │               └─ Project\n" +
-			"     │           │                   │                   ├─ columns: [NOXN3.id:20!null, (row_number() over ( order by NOXN3.id ASC):21!null - 1 (tinyint)) as M6T2N]\n" +
-			"     │           │                   │                   └─ Window\n" +
-			"     │           │                   │                       ├─ NOXN3.id:20!null\n" +
-			"     │           │                   │                       ├─ row_number() over ( order by NOXN3.id ASC)\n" +
-			"     │           │                   │                       └─ Table\n" +
-			"     │           │                   │                           ├─ name: NOXN3\n" +
-			"     │           │                   │                           └─ columns: [id]\n" +
-			"     │           │                   │   WHEN NOT\n" +
-			"     │           │                   │   └─ MJR3D.TDEIU:6 IS NULL\n" +
-			"     │           │                   │   THEN Subquery\n" +
-			"     │           │                   │   ├─ cacheable: false\n" +
-			"     │           │                   │   └─ Project\n" +
-			"     │           │                   │       ├─ columns: [ei.M6T2N:21!null]\n" +
-			"     │           │                   │       └─ Filter\n" +
-			"     │           │                   │           ├─ Eq\n" +
-			"     │           │                   │           │   ├─ ei.id:20!null\n" +
-			"     │           │                   │           │   └─ MJR3D.TDEIU:6\n" +
-			"     │           │                   │           └─ SubqueryAlias\n" +
-			"     │           │                   │               ├─ name: ei\n" +
-			"     │           │                   │               ├─ outerVisibility: true\n" +
-			"     │           │                   │               ├─ cacheable: true\n" +
-			"     │           │                   │               └─ Project\n" +
-			"     │           │                   │                   ├─ columns: [NOXN3.id:20!null, (row_number() over ( order by NOXN3.id ASC):21!null - 1 (tinyint)) as M6T2N]\n" +
-			"     │           │                   │                   └─ Window\n" +
-			"     │           │                   │                       ├─ NOXN3.id:20!null\n" +
-			"     │           │                   │                       ├─ row_number() over ( order by NOXN3.id ASC)\n" +
-			"     │           │                   │                       └─ Table\n" +
-			"     │           │                   │                           ├─ name: NOXN3\n" +
-			"     │           │                   │                           └─ columns: [id]\n" +
-			"     │           │                   │   END as M6T2N, aac.BTXC5:8 as BTXC5, aac.id:7!null as NTOFG, sn.id:10 as LWQ6O, MJR3D.TUV25:3 as TUV25]\n" +
-			"     │           │                   └─ LeftOuterJoin\n" +
-			"     │           │                       ├─ Or\n" +
-			"     │           │                       │   ├─ Or\n" +
-			"     │           │                       │   │   ├─ Or\n" +
-			"     │           │                       │   │   │   ├─ AND\n" +
-			"     │           │                       │   │   │   │   ├─ AND\n" +
-			"     │           │                       │   │   │   │   │   ├─ NOT\n" +
-			"     │           │                       │   │   │   │   │   │   └─ MJR3D.QNI57:5 IS NULL\n" +
-			"     │           │                       │   │   │   │   │   └─ Eq\n" +
-			"     │           │                       │   │   │   │   │       ├─ sn.id:10!null\n" +
-			"     │           │                       │   │   │   │   │       └─ MJR3D.QNI57:5\n" +
-			"     │           │                       │   │   │   │   └─ MJR3D.BJUF2:1 IS NULL\n" +
-			"     │           │                       │   │   │   └─ AND\n" +
-			"     │           │                       │   │   │       ├─ AND\n" +
-			"     │           │                       │   │   │       │   ├─ NOT\n" +
-			"     │           │                       │   │   │       │   │   └─ MJR3D.QNI57:5 IS NULL\n" +
-			"     │           │                       │   │   │       │   └─ InSubquery\n" +
-			"     │           │                       │   │   │       │       ├─ left: sn.id:10!null\n" +
-			"     │           │                       │   │   │       │       └─ right: Subquery\n" +
-			"     │           │                       │   │   │       │           ├─ cacheable: false\n" +
-			"     │           │                       │   │   │       │           └─ Project\n" +
-			"     │           │                       │   │   │       │               ├─ columns: [JTEHG.id:20!null]\n" +
-			"     │           │                       │   │   │       │               └─ Filter\n" +
-			"     │           │                       │   │   │       │                   ├─ Eq\n" +
-			"     │           │                       │   │   │       │                   │   ├─ JTEHG.BRQP2:21!null\n" +
-			"     │           │                       │   │   │       │                   │   └─ MJR3D.BJUF2:1\n" +
-			"     │           │                       │   │   │       │                   └─ TableAlias(JTEHG)\n" +
-			"     │           │                       │   │   │       │                       └─ Table\n" +
-			"     │           │                       │   │   │       │                           ├─ name: NOXN3\n" +
-			"     │           │                       │   │   │       │                           └─ columns: [id brqp2]\n" +
-			"     │           │                       │   │   │       └─ NOT\n" +
-			"     │           │                       │   │   │           └─ MJR3D.BJUF2:1 IS NULL\n" +
-			"     │           │                       │   │   └─ AND\n" +
-			"     │           │                       │   │       ├─ AND\n" +
-			"     │           │                       │   │       │   ├─ NOT\n" +
-			"     │           │                       │   │       │   │   └─ MJR3D.TDEIU:6 IS NULL\n" +
-			"     │           │                       │   │       │   └─ InSubquery\n" +
-			"     │           │                       │   │       │       ├─ left: sn.id:10!null\n" +
-			"     │           │                       │   │       │       └─ right: Subquery\n" +
-			"     │           │                       │   │       │           ├─ cacheable: false\n" +
-			"     │           │                       │   │       │           └─ Project\n" +
-			"     │           │                       │   │       │               ├─ columns: [XMAFZ.id:20!null]\n" +
-			"     │           │                       │   │       │               └─ Filter\n" +
-			"     │           │                       │   │       │                   ├─ Eq\n" +
-			"     │           │                       │   │       │                   │   ├─ XMAFZ.BRQP2:21!null\n" +
-			"     │           │                       │   │       │                   │   └─ MJR3D.FJDP5:0!null\n" +
-			"     │           │                       │   │       │                   └─ TableAlias(XMAFZ)\n" +
-			"     │           │                       │   │       │                       └─ Table\n" +
-			"     │           │                       │   │       │                           ├─ name: NOXN3\n" +
-			"     │           │                       │   │       │                           └─ columns: [id brqp2]\n" +
-			"     │           │                       │   │       └─ MJR3D.BJUF2:1 IS NULL\n" +
-			"     │           │                       │   └─ AND\n" +
-			"     │           │                       │       ├─ AND\n" +
-			"     │           │                       │       │   ├─ NOT\n" +
-			"     │           │                       │       │   │   └─ MJR3D.TDEIU:6 IS NULL\n" +
-			"     │           │                       │       │   └─ InSubquery\n" +
-			"     │           │                       │       │       ├─ left: sn.id:10!null\n" +
-			"     │           │                       │       │       └─ right: Subquery\n" +
-			"     │           │                       │       │           ├─ cacheable: false\n" +
-			"     │           │                       │       │           └─ Project\n" +
-			"     │           │                       │       │               ├─ columns: [XMAFZ.id:20!null]\n" +
-			"     │           │                       │       │               └─ Filter\n" +
-			"     │           │                       │       │                   ├─ Eq\n" +
-			"     │           │                       │       │                   │   ├─ XMAFZ.BRQP2:21!null\n" +
-			"     │           │                       │       │                   │   └─ MJR3D.BJUF2:1\n" +
-			"     │           │                       │       │                   └─ TableAlias(XMAFZ)\n" +
-			"     │           │                       │       │                       └─ Table\n" +
-			"     │           │                       │       │                           ├─ name: NOXN3\n" +
-			"     │           │                       │       │                           └─ columns: [id brqp2]\n" +
-			"     │           │                       │       └─ NOT\n" +
-			"     │           │                       │           └─ MJR3D.BJUF2:1 IS NULL\n" +
-			"     │           │                       ├─ LookupJoin\n" +
-			"     │           │                       │   ├─ Eq\n" +
-			"     │           │                       │   │   ├─ aac.id:7!null\n" +
-			"     │           │                       │   │   └─ MJR3D.M22QN:2!null\n" +
-			"     │           │                       │   ├─ SubqueryAlias\n" +
-			"     │           │                       │   │   ├─ name: MJR3D\n" +
-			"     │           │                       │   │   ├─ outerVisibility: false\n" +
-			"     │           │                       │   │   ├─ cacheable: true\n" +
-			"     │           │                       │   │   └─ Distinct\n" +
-			"     │           │                       │   │       └─ Project\n" +
-			"     │           │                       │   │           ├─ columns: [ism.FV24E:9!null as FJDP5, CPMFE.id:27 as BJUF2, ism.M22QN:11!null as M22QN, G3YXS.TUV25:5 as TUV25, G3YXS.ESFVY:1!null as ESFVY, YQIF4.id:44 as QNI57, YVHJZ.id:54 as TDEIU]\n" +
-			"     │           │                       │   │           └─ Filter\n" +
-			"     │           │                       │   │               ├─ Or\n" +
-			"     │           │                       │   │               │   ├─ NOT\n" +
-			"     │           │                       │   │               │   │   └─ YQIF4.id:44 IS NULL\n" +
-			"     │           │                       │   │               │   └─ NOT\n" +
-			"     │           │                       │   │               │       └─ YVHJZ.id:54 IS NULL\n" +
-			"     │           │                       │   │               └─ LeftOuterLookupJoin\n" +
-			"     │           │                       │   │                   ├─ AND\n" +
-			"     │           │                       │   │                   │   ├─ Eq\n" +
-			"     │           │                       │   │                   │   │   ├─ YVHJZ.BRQP2:55!null\n" +
-			"     │           │                       │   │                   │   │   └─ ism.UJ6XY:10!null\n" +
-			"     │           │                       │   │                   │   └─ Eq\n" +
-			"     │           │                       │   │                   │       ├─ YVHJZ.FFTBJ:56!null\n" +
-			"     │           │                       │   │                   │       └─ ism.FV24E:9!null\n" +
-			"     │           │                       │   │                   ├─ LeftOuterLookupJoin\n" +
-			"     │           │                       │   │                   │   ├─ AND\n" +
-			"     │           │                       │   │                   │   │   ├─ Eq\n" +
-			"     │           │                       │   │                   │   │   │   ├─ YQIF4.BRQP2:45!null\n" +
-			"     │           │                       │   │                   │   │   │   └─ ism.FV24E:9!null\n" +
-			"     │           │                       │   │                   │   │   └─ Eq\n" +
-			"     │           │                       │   │                   │   │       ├─ YQIF4.FFTBJ:46!null\n" +
-			"     │           │                       │   │                   │   │       └─ ism.UJ6XY:10!null\n" +
-			"     │           │                       │   │                   │   ├─ LeftOuterLookupJoin\n" +
-			"     │           │                       │   │                   │   │   ├─ AND\n" +
-			"     │           │                       │   │                   │   │   │   ├─ Eq\n" +
-			"     │           │                       │   │                   │   │   │   │   ├─ CPMFE.ZH72S:34\n" +
-			"     │           │                       │   │                   │   │   │   │   └─ NHMXW.NOHHR:18\n" +
-			"     │           │                       │   │                   │   │   │   └─ NOT\n" +
-			"     │           │                       │   │                   │   │   │       └─ Eq\n" +
-			"     │           │                       │   │                   │   │   │           ├─ CPMFE.id:27!null\n" +
-			"     │           │                       │   │                   │   │   │           └─ ism.FV24E:9!null\n" +
-			"     │           │                       │   │                   │   │   ├─ LeftOuterLookupJoin\n" +
-			"     │           │                       │   │                   │   │   │   ├─ Eq\n" +
-			"     │           │                       │   │                   │   │   │   │   ├─ NHMXW.id:17!null\n" +
-			"     │           │                       │   │                   │   │   │   │   └─ ism.PRUV2:14\n" +
-			"     │           │                       │   │                   │   │   │   ├─ LookupJoin\n" +
-			"     │           │                       │   │                   │   │   │   │   ├─ Eq\n" +
-			"     │           │                       │   │                   │   │   │   │   │   ├─ G3YXS.id:0!null\n" +
-			"     │           │                       │   │                   │   │   │   │   │   └─ ism.NZ4MQ:12!null\n" +
-			"     │           │                       │   │                   │   │   │   │   ├─ Filter\n" +
-			"     │           │                       │   │                   │   │   │   │   │   ├─ NOT\n" +
-			"     │           │                       │   │                   │   │   │   │   │   │   └─ G3YXS.TUV25:5 IS NULL\n" +
-			"     │           │                       │   │                   │   │   │   │   │   └─ TableAlias(G3YXS)\n" +
-			"     │           │                       │   │                   │   │   │   │   │       └─ Table\n" +
-			"     │           │                       │   │                   │   │   │   │   │           ├─ name: YYBCX\n" +
-			"     │           │                       │   │                   │   │   │   │   │           └─ columns: [id esfvy sl76b ge5el f7a4q tuv25 ykssu fhcyt]\n" +
-			"     │           │                       │   │                   │   │   │   │   └─ TableAlias(ism)\n" +
-			"     │           │                       │   │                   │   │   │   │       └─ IndexedTableAccess(HDDVB)\n" +
-			"     │           │                       │   │                   │   │   │   │           ├─ index: [HDDVB.NZ4MQ]\n" +
-			"     │           │                       │   │                   │   │   │   │           └─ columns: [id fv24e uj6xy m22qn nz4mq etpqv pruv2 ykssu fhcyt]\n" +
-			"     │           │                       │   │                   │   │   │   └─ TableAlias(NHMXW)\n" +
-			"     │           │                       │   │                   │   │   │       └─ IndexedTableAccess(WGSDC)\n" +
-			"     │           │                       │   │                   │   │   │           ├─ index: [WGSDC.id]\n" +
-			"     │           │                       │   │                   │   │   │           └─ columns: [id nohhr avpyf sypkf idut2 fzxv5 dqygv swcqv ykssu fhcyt]\n" +
-			"     │           │                       │   │                   │   │   └─ TableAlias(CPMFE)\n" +
-			"     │           │                       │   │                   │   │       └─ IndexedTableAccess(E2I7U)\n" +
-			"     │           │                       │   │                   │   │           ├─ index: [E2I7U.ZH72S]\n" +
-			"     │           │                       │   │                   │   │           └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
-			"     │           │                       │   │                   │   └─ TableAlias(YQIF4)\n" +
-			"     │           │                       │   │                   │       └─ IndexedTableAccess(NOXN3)\n" +
-			"     │           │                       │   │                   │           ├─ index: [NOXN3.BRQP2]\n" +
-			"     │           │                       │   │                   │           └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
-			"     │           │                       │   │                   └─ TableAlias(YVHJZ)\n" +
-			"     │           │                       │   │                       └─ IndexedTableAccess(NOXN3)\n" +
-			"     │           │                       │   │                           ├─ index: [NOXN3.BRQP2]\n" +
-			"     │           │                       │   │                           └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
-			"     │           │                       │   └─ TableAlias(aac)\n" +
-			"     │           │                       │       └─ IndexedTableAccess(TPXBU)\n" +
-			"     │           │                       │           ├─ index: [TPXBU.id]\n" +
-			"     │           │                       │           └─ columns: [id btxc5 fhcyt]\n" +
-			"     │           │                       └─ TableAlias(sn)\n" +
-			"     │           │                           └─ Table\n" +
-			"     │           │                               ├─ name: NOXN3\n" +
-			"     │           │                               └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			" └─ Filter\n" +
+			"     ├─ NOT\n" +
+			"     │   └─ InSubquery\n" +
+			"     │       ├─ left: TUPLE(fs.T4IBQ:0!null, fs.M6T2N:1, fs.BTXC5:2, fs.TUV25:3)\n" +
+			"     │       └─ right: Subquery\n" +
+			"     │           ├─ cacheable: true\n" +
 			"     │           └─ SubqueryAlias\n" +
-			"     │               ├─ name: RSA3Y\n" +
-			"     │               ├─ outerVisibility: false\n" +
+			"     │               ├─ name: `ZMSPR`\n" +
+			"     │               ├─ outerVisibility: true\n" +
 			"     │               ├─ cacheable: true\n" +
 			"     │               └─ Distinct\n" +
 			"     │                   └─ Project\n" +
-			"     │                       ├─ columns: [HTKBS.T4IBQ:0!null]\n" +
-			"     │                       └─ SubqueryAlias\n" +
-			"     │                           ├─ name: HTKBS\n" +
-			"     │                           ├─ outerVisibility: false\n" +
-			"     │                           ├─ cacheable: true\n" +
-			"     │                           └─ Project\n" +
-			"     │                               ├─ columns: [cla.FTQLQ:1!null as T4IBQ, sn.id:7!null as BDNYB, mf.M22QN:6!null as M22QN]\n" +
-			"     │                               └─ HashJoin\n" +
-			"     │                                   ├─ Eq\n" +
-			"     │                                   │   ├─ cla.id:0!null\n" +
-			"     │                                   │   └─ bs.IXUXU:3\n" +
-			"     │                                   ├─ Filter\n" +
-			"     │                                   │   ├─ HashIn\n" +
-			"     │                                   │   │   ├─ cla.FTQLQ:1!null\n" +
-			"     │                                   │   │   └─ TUPLE(SQ1 (longtext))\n" +
-			"     │                                   │   └─ TableAlias(cla)\n" +
-			"     │                                   │       └─ IndexedTableAccess(YK2GW)\n" +
-			"     │                                   │           ├─ index: [YK2GW.FTQLQ]\n" +
-			"     │                                   │           ├─ static: [{[SQ1, SQ1]}]\n" +
-			"     │                                   │           └─ columns: [id ftqlq]\n" +
-			"     │                                   └─ HashLookup\n" +
-			"     │                                       ├─ source: TUPLE(cla.id:0!null)\n" +
-			"     │                                       ├─ target: TUPLE(bs.IXUXU:1)\n" +
-			"     │                                       └─ CachedResults\n" +
-			"     │                                           └─ LookupJoin\n" +
-			"     │                                               ├─ Eq\n" +
-			"     │                                               │   ├─ sn.BRQP2:8!null\n" +
-			"     │                                               │   └─ mf.LUEVY:5!null\n" +
-			"     │                                               ├─ LookupJoin\n" +
-			"     │                                               │   ├─ Eq\n" +
-			"     │                                               │   │   ├─ bs.id:2!null\n" +
-			"     │                                               │   │   └─ mf.GXLUB:4!null\n" +
-			"     │                                               │   ├─ TableAlias(bs)\n" +
-			"     │                                               │   │   └─ Table\n" +
-			"     │                                               │   │       ├─ name: THNTS\n" +
-			"     │                                               │   │       └─ columns: [id ixuxu]\n" +
-			"     │                                               │   └─ TableAlias(mf)\n" +
-			"     │                                               │       └─ IndexedTableAccess(HGMQ6)\n" +
-			"     │                                               │           ├─ index: [HGMQ6.GXLUB]\n" +
-			"     │                                               │           └─ columns: [gxlub luevy m22qn]\n" +
-			"     │                                               └─ TableAlias(sn)\n" +
-			"     │                                                   └─ IndexedTableAccess(NOXN3)\n" +
-			"     │                                                       ├─ index: [NOXN3.BRQP2]\n" +
-			"     │                                                       └─ columns: [id brqp2]\n" +
+			"     │                       ├─ columns: [cld.T4IBQ:4!null as T4IBQ, P4PJZ.M6T2N:7 as M6T2N, P4PJZ.BTXC5:8 as BTXC5, P4PJZ.TUV25:11 as TUV25]\n" +
+			"     │                       └─ Filter\n" +
+			"     │                           ├─ NOT\n" +
+			"     │                           │   └─ P4PJZ.M6T2N:7 IS NULL\n" +
+			"     │                           └─ LeftOuterHashJoin\n" +
+			"     │                               ├─ AND\n" +
+			"     │                               │   ├─ Eq\n" +
+			"     │                               │   │   ├─ P4PJZ.LWQ6O:10\n" +
+			"     │                               │   │   └─ cld.BDNYB:5!null\n" +
+			"     │                               │   └─ Eq\n" +
+			"     │                               │       ├─ P4PJZ.NTOFG:9!null\n" +
+			"     │                               │       └─ cld.M22QN:6!null\n" +
+			"     │                               ├─ SubqueryAlias\n" +
+			"     │                               │   ├─ name: cld\n" +
+			"     │                               │   ├─ outerVisibility: false\n" +
+			"     │                               │   ├─ cacheable: true\n" +
+			"     │                               │   └─ Project\n" +
+			"     │                               │       ├─ columns: [cla.FTQLQ:1!null as T4IBQ, sn.id:7!null as BDNYB, mf.M22QN:6!null as M22QN]\n" +
+			"     │                               │       └─ HashJoin\n" +
+			"     │                               │           ├─ Eq\n" +
+			"     │                               │           │   ├─ cla.id:0!null\n" +
+			"     │                               │           │   └─ bs.IXUXU:3\n" +
+			"     │                               │           ├─ Filter\n" +
+			"     │                               │           │   ├─ HashIn\n" +
+			"     │                               │           │   │   ├─ cla.FTQLQ:1!null\n" +
+			"     │                               │           │   │   └─ TUPLE(SQ1 (longtext))\n" +
+			"     │                               │           │   └─ TableAlias(cla)\n" +
+			"     │                               │           │       └─ IndexedTableAccess(mydb.`YK2GW`)\n" +
+			"     │                               │           │           ├─ index: `EZCTT` [YK2GW.FTQLQ]\n" +
+			"     │                               │           │           ├─ static: [{[SQ1, SQ1]}]\n" +
+			"     │                               │           │           └─ columns: [id ftqlq]\n" +
+			"     │                               │           └─ HashLookup\n" +
+			"     │                               │               ├─ source: TUPLE(cla.id:0!null)\n" +
+			"     │                               │               ├─ target: TUPLE(bs.IXUXU:1)\n" +
+			"     │                               │               └─ CachedResults\n" +
+			"     │                               │                   └─ LookupJoin\n" +
+			"     │                               │                       ├─ Eq\n" +
+			"     │                               │                       │   ├─ sn.BRQP2:8!null\n" +
+			"     │                               │                       │   └─ mf.LUEVY:5!null\n" +
+			"     │                               │                       ├─ LookupJoin\n" +
+			"     │                               │                       │   ├─ Eq\n" +
+			"     │                               │                       │   │   ├─ bs.id:2!null\n" +
+			"     │                               │                       │   │   └─ mf.GXLUB:4!null\n" +
+			"     │                               │                       │   ├─ TableAlias(bs)\n" +
+			"     │                               │                       │   │   └─ Table\n" +
+			"     │                               │                       │   │       ├─ name: mydb.`THNTS`\n" +
+			"     │                               │                       │   │       └─ columns: [id ixuxu]\n" +
+			"     │                               │                       │   └─ TableAlias(mf)\n" +
+			"     │                               │                       │       └─ IndexedTableAccess(mydb.`HGMQ6`)\n" +
+			"     │                               │                       │           ├─ index: `GXLUB` [HGMQ6.GXLUB]\n" +
+			"     │                               │                       │           └─ columns: [gxlub luevy m22qn]\n" +
+			"     │                               │                       └─ TableAlias(sn)\n" +
+			"     │                               │                           └─ IndexedTableAccess(mydb.`NOXN3`)\n" +
+			"     │                               │                               ├─ index: `BRQP2` [NOXN3.BRQP2]\n" +
+			"     │                               │                               └─ columns: [id brqp2]\n" +
+			"     │                               └─ HashLookup\n" +
+			"     │                                   ├─ source: TUPLE(cld.BDNYB:5!null, cld.M22QN:6!null)\n" +
+			"     │                                   ├─ target: TUPLE(P4PJZ.LWQ6O:7, P4PJZ.NTOFG:6!null)\n" +
+			"     │                                   └─ CachedResults\n" +
+			"     │                                       └─ SubqueryAlias\n" +
+			"     │                                           ├─ name: `P4PJZ`\n" +
+			"     │                                           ├─ outerVisibility: false\n" +
+			"     │                                           ├─ cacheable: true\n" +
+			"     │                                           └─ Project\n" +
+			"     │                                               ├─ columns: [CASE  WHEN NOT\n" +
+			"     │                                               │   └─ MJR3D.QNI57:5 IS NULL\n" +
+			"     │                                               │   THEN Subquery\n" +
+			"     │                                               │   ├─ cacheable: false\n" +
+			"     │                                               │   └─ Project\n" +
+			"     │                                               │       ├─ columns: [ei.M6T2N:21!null]\n" +
+			"     │                                               │       └─ Filter\n" +
+			"     │                                               │           ├─ Eq\n" +
+			"     │                                               │           │   ├─ ei.id:20!null\n" +
+			"     │                                               │           │   └─ MJR3D.QNI57:5\n" +
+			"     │                                               │           └─ SubqueryAlias\n" +
+			"     │                                               │               ├─ name: ei\n" +
+			"     │                                               │               ├─ outerVisibility: true\n" +
+			"     │                                               │               ├─ cacheable: true\n" +
+			"     │                                               │               └─ Project\n" +
+			"     │                                               │                   ├─ columns: [NOXN3.id:20!null, (row_number() over ( order by NOXN3.id ASC):21!null - 1 (tinyint)) as M6T2N]\n" +
+			"     │                                               │                   └─ Window\n" +
+			"     │                                               │                       ├─ NOXN3.id:20!null\n" +
+			"     │                                               │                       ├─ row_number() over ( order by NOXN3.id ASC)\n" +
+			"     │                                               │                       └─ Table\n" +
+			"     │                                               │                           ├─ name: mydb.`NOXN3`\n" +
+			"     │                                               │                           └─ columns: [id]\n" +
+			"     │                                               │   WHEN NOT\n" +
+			"     │                                               │   └─ MJR3D.TDEIU:6 IS NULL\n" +
+			"     │                                               │   THEN Subquery\n" +
+			"     │                                               │   ├─ cacheable: false\n" +
+			"     │                                               │   └─ Project\n" +
+			"     │                                               │       ├─ columns: [ei.M6T2N:21!null]\n" +
+			"     │                                               │       └─ Filter\n" +
+			"     │                                               │           ├─ Eq\n" +
+			"     │                                               │           │   ├─ ei.id:20!null\n" +
+			"     │                                               │           │   └─ MJR3D.TDEIU:6\n" +
+			"     │                                               │           └─ SubqueryAlias\n" +
+			"     │                                               │               ├─ name: ei\n" +
+			"     │                                               │               ├─ outerVisibility: true\n" +
+			"     │                                               │               ├─ cacheable: true\n" +
+			"     │                                               │               └─ Project\n" +
+			"     │                                               │                   ├─ columns: [NOXN3.id:20!null, (row_number() over ( order by NOXN3.id ASC):21!null - 1 (tinyint)) as M6T2N]\n" +
+			"     │                                               │                   └─ Window\n" +
+			"     │                                               │                       ├─ NOXN3.id:20!null\n" +
+			"     │                                               │                       ├─ row_number() over ( order by NOXN3.id ASC)\n" +
+			"     │                                               │                       └─ Table\n" +
+			"     │                                               │                           ├─ name: mydb.`NOXN3`\n" +
+			"     │                                               │                           └─ columns: [id]\n" +
+			"     │                                               │   END as M6T2N, aac.BTXC5:8 as BTXC5, aac.id:7!null as NTOFG, sn.id:10 as LWQ6O, MJR3D.TUV25:3 as TUV25]\n" +
+			"     │                                               └─ LeftOuterJoin\n" +
+			"     │                                                   ├─ Or\n" +
+			"     │                                                   │   ├─ Or\n" +
+			"     │                                                   │   │   ├─ Or\n" +
+			"     │                                                   │   │   │   ├─ AND\n" +
+			"     │                                                   │   │   │   │   ├─ AND\n" +
+			"     │                                                   │   │   │   │   │   ├─ NOT\n" +
+			"     │                                                   │   │   │   │   │   │   └─ MJR3D.QNI57:5 IS NULL\n" +
+			"     │                                                   │   │   │   │   │   └─ Eq\n" +
+			"     │                                                   │   │   │   │   │       ├─ sn.id:10!null\n" +
+			"     │                                                   │   │   │   │   │       └─ MJR3D.QNI57:5\n" +
+			"     │                                                   │   │   │   │   └─ MJR3D.BJUF2:1 IS NULL\n" +
+			"     │                                                   │   │   │   └─ AND\n" +
+			"     │                                                   │   │   │       ├─ AND\n" +
+			"     │                                                   │   │   │       │   ├─ NOT\n" +
+			"     │                                                   │   │   │       │   │   └─ MJR3D.QNI57:5 IS NULL\n" +
+			"     │                                                   │   │   │       │   └─ InSubquery\n" +
+			"     │                                                   │   │   │       │       ├─ left: sn.id:10!null\n" +
+			"     │                                                   │   │   │       │       └─ right: Subquery\n" +
+			"     │                                                   │   │   │       │           ├─ cacheable: false\n" +
+			"     │                                                   │   │   │       │           └─ Project\n" +
+			"     │                                                   │   │   │       │               ├─ columns: [JTEHG.id:20!null]\n" +
+			"     │                                                   │   │   │       │               └─ Filter\n" +
+			"     │                                                   │   │   │       │                   ├─ Eq\n" +
+			"     │                                                   │   │   │       │                   │   ├─ JTEHG.BRQP2:21!null\n" +
+			"     │                                                   │   │   │       │                   │   └─ MJR3D.BJUF2:1\n" +
+			"     │                                                   │   │   │       │                   └─ TableAlias(`JTEHG`)\n" +
+			"     │                                                   │   │   │       │                       └─ Table\n" +
+			"     │                                                   │   │   │       │                           ├─ name: mydb.`NOXN3`\n" +
+			"     │                                                   │   │   │       │                           └─ columns: [id brqp2]\n" +
+			"     │                                                   │   │   │       └─ NOT\n" +
+			"     │                                                   │   │   │           └─ MJR3D.BJUF2:1 IS NULL\n" +
+			"     │                                                   │   │   └─ AND\n" +
+			"     │                                                   │   │       ├─ AND\n" +
+			"     │                                                   │   │       │   ├─ NOT\n" +
+			"     │                                                   │   │       │   │   └─ MJR3D.TDEIU:6 IS NULL\n" +
+			"     │                                                   │   │       │   └─ InSubquery\n" +
+			"     │                                                   │   │       │       ├─ left: sn.id:10!null\n" +
+			"     │                                                   │   │       │       └─ right: Subquery\n" +
+			"     │                                                   │   │       │           ├─ cacheable: false\n" +
+			"     │                                                   │   │       │           └─ Project\n" +
+			"     │                                                   │   │       │               ├─ columns: [XMAFZ.id:20!null]\n" +
+			"     │                                                   │   │       │               └─ Filter\n" +
+			"     │                                                   │   │       │                   ├─ Eq\n" +
+			"     │                                                   │   │       │                   │   ├─ XMAFZ.BRQP2:21!null\n" +
+			"     │                                                   │   │       │                   │   └─ MJR3D.FJDP5:0!null\n" +
+			"     │                                                   │   │       │                   └─ TableAlias(`XMAFZ`)\n" +
+			"     │                                                   │   │       │                       └─ Table\n" +
+			"     │                                                   │   │       │                           ├─ name: mydb.`NOXN3`\n" +
+			"     │                                                   │   │       │                           └─ columns: [id brqp2]\n" +
+			"     │                                                   │   │       └─ MJR3D.BJUF2:1 IS NULL\n" +
+			"     │                                                   │   └─ AND\n" +
+			"     │                                                   │       ├─ AND\n" +
+			"     │                                                   │       │   ├─ NOT\n" +
+			"     │                                                   │       │   │   └─ MJR3D.TDEIU:6 IS NULL\n" +
+			"     │                                                   │       │   └─ InSubquery\n" +
+			"     │                                                   │       │       ├─ left: sn.id:10!null\n" +
+			"     │                                                   │       │       └─ right: Subquery\n" +
+			"     │                                                   │       │           ├─ cacheable: false\n" +
+			"     │                                                   │       │           └─ Project\n" +
+			"     │                                                   │       │               ├─ columns: [XMAFZ.id:20!null]\n" +
+			"     │                                                   │       │               └─ Filter\n" +
+			"     │                                                   │       │                   ├─ Eq\n" +
+			"     │                                                   │       │                   │   ├─ XMAFZ.BRQP2:21!null\n" +
+			"     │                                                   │       │                   │   └─ MJR3D.BJUF2:1\n" +
+			"     │                                                   │       │                   └─ TableAlias(`XMAFZ`)\n" +
+			"     │                                                   │       │                       └─ Table\n" +
+			"     │                                                   │       │                           ├─ name: mydb.`NOXN3`\n" +
+			"     │                                                   │       │                           └─ columns: [id brqp2]\n" +
+			"     │                                                   │       └─ NOT\n" +
+			"     │                                                   │           └─ MJR3D.BJUF2:1 IS NULL\n" +
+			"     │                                                   ├─ LookupJoin\n" +
+			"     │                                                   │   ├─ Eq\n" +
+			"     │                                                   │   │   ├─ aac.id:7!null\n" +
+			"     │                                                   │   │   └─ MJR3D.M22QN:2!null\n" +
+			"     │                                                   │   ├─ SubqueryAlias\n" +
+			"     │                                                   │   │   ├─ name: `MJR3D`\n" +
+			"     │                                                   │   │   ├─ outerVisibility: false\n" +
+			"     │                                                   │   │   ├─ cacheable: true\n" +
+			"     │                                                   │   │   └─ Distinct\n" +
+			"     │                                                   │   │       └─ Project\n" +
+			"     │                                                   │   │           ├─ columns: [ism.FV24E:9!null as FJDP5, CPMFE.id:27 as BJUF2, ism.M22QN:11!null as M22QN, G3YXS.TUV25:5 as TUV25, G3YXS.ESFVY:1!null as ESFVY, YQIF4.id:44 as QNI57, YVHJZ.id:54 as TDEIU]\n" +
+			"     │                                                   │   │           └─ Filter\n" +
+			"     │                                                   │   │               ├─ Or\n" +
+			"     │                                                   │   │               │   ├─ NOT\n" +
+			"     │                                                   │   │               │   │   └─ YQIF4.id:44 IS NULL\n" +
+			"     │                                                   │   │               │   └─ NOT\n" +
+			"     │                                                   │   │               │       └─ YVHJZ.id:54 IS NULL\n" +
+			"     │                                                   │   │               └─ LeftOuterLookupJoin\n" +
+			"     │                                                   │   │                   ├─ AND\n" +
+			"     │                                                   │   │                   │   ├─ Eq\n" +
+			"     │                                                   │   │                   │   │   ├─ YVHJZ.BRQP2:55!null\n" +
+			"     │                                                   │   │                   │   │   └─ ism.UJ6XY:10!null\n" +
+			"     │                                                   │   │                   │   └─ Eq\n" +
+			"     │                                                   │   │                   │       ├─ YVHJZ.FFTBJ:56!null\n" +
+			"     │                                                   │   │                   │       └─ ism.FV24E:9!null\n" +
+			"     │                                                   │   │                   ├─ LeftOuterLookupJoin\n" +
+			"     │                                                   │   │                   │   ├─ AND\n" +
+			"     │                                                   │   │                   │   │   ├─ Eq\n" +
+			"     │                                                   │   │                   │   │   │   ├─ YQIF4.BRQP2:45!null\n" +
+			"     │                                                   │   │                   │   │   │   └─ ism.FV24E:9!null\n" +
+			"     │                                                   │   │                   │   │   └─ Eq\n" +
+			"     │                                                   │   │                   │   │       ├─ YQIF4.FFTBJ:46!null\n" +
+			"     │                                                   │   │                   │   │       └─ ism.UJ6XY:10!null\n" +
+			"     │                                                   │   │                   │   ├─ LeftOuterLookupJoin\n" +
+			"     │                                                   │   │                   │   │   ├─ AND\n" +
+			"     │                                                   │   │                   │   │   │   ├─ Eq\n" +
+			"     │                                                   │   │                   │   │   │   │   ├─ CPMFE.ZH72S:34\n" +
+			"     │                                                   │   │                   │   │   │   │   └─ NHMXW.NOHHR:18\n" +
+			"     │                                                   │   │                   │   │   │   └─ NOT\n" +
+			"     │                                                   │   │                   │   │   │       └─ Eq\n" +
+			"     │                                                   │   │                   │   │   │           ├─ CPMFE.id:27!null\n" +
+			"     │                                                   │   │                   │   │   │           └─ ism.FV24E:9!null\n" +
+			"     │                                                   │   │                   │   │   ├─ LeftOuterLookupJoin\n" +
+			"     │                                                   │   │                   │   │   │   ├─ Eq\n" +
+			"     │                                                   │   │                   │   │   │   │   ├─ NHMXW.id:17!null\n" +
+			"     │                                                   │   │                   │   │   │   │   └─ ism.PRUV2:14\n" +
+			"     │                                                   │   │                   │   │   │   ├─ LookupJoin\n" +
+			"     │                                                   │   │                   │   │   │   │   ├─ Eq\n" +
+			"     │                                                   │   │                   │   │   │   │   │   ├─ G3YXS.id:0!null\n" +
+			"     │                                                   │   │                   │   │   │   │   │   └─ ism.NZ4MQ:12!null\n" +
+			"     │                                                   │   │                   │   │   │   │   ├─ Filter\n" +
+			"     │                                                   │   │                   │   │   │   │   │   ├─ NOT\n" +
+			"     │                                                   │   │                   │   │   │   │   │   │   └─ G3YXS.TUV25:5 IS NULL\n" +
+			"     │                                                   │   │                   │   │   │   │   │   └─ TableAlias(`G3YXS`)\n" +
+			"     │                                                   │   │                   │   │   │   │   │       └─ Table\n" +
+			"     │                                                   │   │                   │   │   │   │   │           ├─ name: mydb.`YYBCX`\n" +
+			"     │                                                   │   │                   │   │   │   │   │           └─ columns: [id esfvy sl76b ge5el f7a4q tuv25 ykssu fhcyt]\n" +
+			"     │                                                   │   │                   │   │   │   │   └─ TableAlias(ism)\n" +
+			"     │                                                   │   │                   │   │   │   │       └─ IndexedTableAccess(mydb.`HDDVB`)\n" +
+			"     │                                                   │   │                   │   │   │   │           ├─ index: `NZ4MQ` [HDDVB.NZ4MQ]\n" +
+			"     │                                                   │   │                   │   │   │   │           └─ columns: [id fv24e uj6xy m22qn nz4mq etpqv pruv2 ykssu fhcyt]\n" +
+			"     │                                                   │   │                   │   │   │   └─ TableAlias(`NHMXW`)\n" +
+			"     │                                                   │   │                   │   │   │       └─ IndexedTableAccess(mydb.`WGSDC`)\n" +
+			"     │                                                   │   │                   │   │   │           ├─ index: `PRIMARY` [WGSDC.id]\n" +
+			"     │                                                   │   │                   │   │   │           └─ columns: [id nohhr avpyf sypkf idut2 fzxv5 dqygv swcqv ykssu fhcyt]\n" +
+			"     │                                                   │   │                   │   │   └─ TableAlias(`CPMFE`)\n" +
+			"     │                                                   │   │                   │   │       └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			"     │                                                   │   │                   │   │           ├─ index: `URBSA` [E2I7U.ZH72S]\n" +
+			"     │                                                   │   │                   │   │           └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
+			"     │                                                   │   │                   │   └─ TableAlias(`YQIF4`)\n" +
+			"     │                                                   │   │                   │       └─ IndexedTableAccess(mydb.`NOXN3`)\n" +
+			"     │                                                   │   │                   │           ├─ index: `BRQP2` [NOXN3.BRQP2]\n" +
+			"     │                                                   │   │                   │           └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			"     │                                                   │   │                   └─ TableAlias(`YVHJZ`)\n" +
+			"     │                                                   │   │                       └─ IndexedTableAccess(mydb.`NOXN3`)\n" +
+			"     │                                                   │   │                           ├─ index: `BRQP2` [NOXN3.BRQP2]\n" +
+			"     │                                                   │   │                           └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			"     │                                                   │   └─ TableAlias(aac)\n" +
+			"     │                                                   │       └─ IndexedTableAccess(mydb.`TPXBU`)\n" +
+			"     │                                                   │           ├─ index: `PRIMARY` [TPXBU.id]\n" +
+			"     │                                                   │           └─ columns: [id btxc5 fhcyt]\n" +
+			"     │                                                   └─ TableAlias(sn)\n" +
+			"     │                                                       └─ Table\n" +
+			"     │                                                           ├─ name: mydb.`NOXN3`\n" +
+			"     │                                                           └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
 			"     └─ SubqueryAlias\n" +
-			"         ├─ name: scalarSubq0\n" +
+			"         ├─ name: fs\n" +
 			"         ├─ outerVisibility: false\n" +
 			"         ├─ cacheable: true\n" +
-			"         └─ SubqueryAlias\n" +
-			"             ├─ name: ZMSPR\n" +
-			"             ├─ outerVisibility: true\n" +
-			"             ├─ cacheable: true\n" +
-			"             └─ Distinct\n" +
-			"                 └─ Project\n" +
-			"                     ├─ columns: [cld.T4IBQ:0!null as T4IBQ, P4PJZ.M6T2N:3 as M6T2N, P4PJZ.BTXC5:4 as BTXC5, P4PJZ.TUV25:7 as TUV25]\n" +
-			"                     └─ Filter\n" +
-			"                         ├─ NOT\n" +
-			"                         │   └─ P4PJZ.M6T2N:3 IS NULL\n" +
-			"                         └─ LeftOuterHashJoin\n" +
-			"                             ├─ AND\n" +
-			"                             │   ├─ Eq\n" +
-			"                             │   │   ├─ P4PJZ.LWQ6O:6\n" +
-			"                             │   │   └─ cld.BDNYB:1!null\n" +
-			"                             │   └─ Eq\n" +
-			"                             │       ├─ P4PJZ.NTOFG:5!null\n" +
-			"                             │       └─ cld.M22QN:2!null\n" +
-			"                             ├─ SubqueryAlias\n" +
-			"                             │   ├─ name: cld\n" +
-			"                             │   ├─ outerVisibility: false\n" +
-			"                             │   ├─ cacheable: true\n" +
-			"                             │   └─ Project\n" +
-			"                             │       ├─ columns: [cla.FTQLQ:1!null as T4IBQ, sn.id:7!null as BDNYB, mf.M22QN:6!null as M22QN]\n" +
-			"                             │       └─ HashJoin\n" +
-			"                             │           ├─ Eq\n" +
-			"                             │           │   ├─ cla.id:0!null\n" +
-			"                             │           │   └─ bs.IXUXU:3\n" +
-			"                             │           ├─ Filter\n" +
-			"                             │           │   ├─ HashIn\n" +
-			"                             │           │   │   ├─ cla.FTQLQ:1!null\n" +
-			"                             │           │   │   └─ TUPLE(SQ1 (longtext))\n" +
-			"                             │           │   └─ TableAlias(cla)\n" +
-			"                             │           │       └─ IndexedTableAccess(YK2GW)\n" +
-			"                             │           │           ├─ index: [YK2GW.FTQLQ]\n" +
-			"                             │           │           ├─ static: [{[SQ1, SQ1]}]\n" +
-			"                             │           │           └─ columns: [id ftqlq]\n" +
-			"                             │           └─ HashLookup\n" +
-			"                             │               ├─ source: TUPLE(cla.id:0!null)\n" +
-			"                             │               ├─ target: TUPLE(bs.IXUXU:1)\n" +
-			"                             │               └─ CachedResults\n" +
-			"                             │                   └─ LookupJoin\n" +
-			"                             │                       ├─ Eq\n" +
-			"                             │                       │   ├─ sn.BRQP2:8!null\n" +
-			"                             │                       │   └─ mf.LUEVY:5!null\n" +
-			"                             │                       ├─ LookupJoin\n" +
-			"                             │                       │   ├─ Eq\n" +
-			"                             │                       │   │   ├─ bs.id:2!null\n" +
-			"                             │                       │   │   └─ mf.GXLUB:4!null\n" +
-			"                             │                       │   ├─ TableAlias(bs)\n" +
-			"                             │                       │   │   └─ Table\n" +
-			"                             │                       │   │       ├─ name: THNTS\n" +
-			"                             │                       │   │       └─ columns: [id ixuxu]\n" +
-			"                             │                       │   └─ TableAlias(mf)\n" +
-			"                             │                       │       └─ IndexedTableAccess(HGMQ6)\n" +
-			"                             │                       │           ├─ index: [HGMQ6.GXLUB]\n" +
-			"                             │                       │           └─ columns: [gxlub luevy m22qn]\n" +
-			"                             │                       └─ TableAlias(sn)\n" +
-			"                             │                           └─ IndexedTableAccess(NOXN3)\n" +
-			"                             │                               ├─ index: [NOXN3.BRQP2]\n" +
-			"                             │                               └─ columns: [id brqp2]\n" +
-			"                             └─ HashLookup\n" +
-			"                                 ├─ source: TUPLE(cld.BDNYB:1!null, cld.M22QN:2!null)\n" +
-			"                                 ├─ target: TUPLE(P4PJZ.LWQ6O:3, P4PJZ.NTOFG:2!null)\n" +
-			"                                 └─ CachedResults\n" +
-			"                                     └─ SubqueryAlias\n" +
-			"                                         ├─ name: P4PJZ\n" +
-			"                                         ├─ outerVisibility: false\n" +
-			"                                         ├─ cacheable: true\n" +
-			"                                         └─ Project\n" +
-			"                                             ├─ columns: [CASE  WHEN NOT\n" +
-			"                                             │   └─ MJR3D.QNI57:5 IS NULL\n" +
-			"                                             │   THEN Subquery\n" +
-			"                                             │   ├─ cacheable: false\n" +
-			"                                             │   └─ Project\n" +
-			"                                             │       ├─ columns: [ei.M6T2N:21!null]\n" +
-			"                                             │       └─ Filter\n" +
-			"                                             │           ├─ Eq\n" +
-			"                                             │           │   ├─ ei.id:20!null\n" +
-			"                                             │           │   └─ MJR3D.QNI57:5\n" +
-			"                                             │           └─ SubqueryAlias\n" +
-			"                                             │               ├─ name: ei\n" +
-			"                                             │               ├─ outerVisibility: true\n" +
-			"                                             │               ├─ cacheable: true\n" +
-			"                                             │               └─ Project\n" +
-			"                                             │                   ├─ columns: [NOXN3.id:20!null, (row_number() over ( order by NOXN3.id ASC):21!null - 1 (tinyint)) as M6T2N]\n" +
-			"                                             │                   └─ Window\n" +
-			"                                             │                       ├─ NOXN3.id:20!null\n" +
-			"                                             │                       ├─ row_number() over ( order by NOXN3.id ASC)\n" +
-			"                                             │                       └─ Table\n" +
-			"                                             │                           ├─ name: NOXN3\n" +
-			"                                             │                           └─ columns: [id]\n" +
-			"                                             │   WHEN NOT\n" +
-			"                                             │   └─ MJR3D.TDEIU:6 IS NULL\n" +
-			"                                             │   THEN Subquery\n" +
-			"                                             │   ├─ cacheable: false\n" +
-			"                                             │   └─ Project\n" +
-			"                                             │       ├─ columns: [ei.M6T2N:21!null]\n" +
-			"                                             │       └─ Filter\n" +
-			"                                             │           ├─ Eq\n" +
-			"                                             │           │   ├─ ei.id:20!null\n" +
-			"                                             │           │   └─ MJR3D.TDEIU:6\n" +
-			"                                             │           └─ SubqueryAlias\n" +
-			"                                             │               ├─ name: ei\n" +
-			"                                             │               ├─ outerVisibility: true\n" +
-			"                                             │               ├─ cacheable: true\n" +
-			"                                             │               └─ Project\n" +
-			"                                             │                   ├─ columns: [NOXN3.id:20!null, (row_number() over ( order by NOXN3.id ASC):21!null - 1 (tinyint)) as M6T2N]\n" +
-			"                                             │                   └─ Window\n" +
-			"                                             │                       ├─ NOXN3.id:20!null\n" +
-			"                                             │                       ├─ row_number() over ( order by NOXN3.id ASC)\n" +
-			"                                             │                       └─ Table\n" +
-			"                                             │                           ├─ name: NOXN3\n" +
-			"                                             │                           └─ columns: [id]\n" +
-			"                                             │   END as M6T2N, aac.BTXC5:8 as BTXC5, aac.id:7!null as NTOFG, sn.id:10 as LWQ6O, MJR3D.TUV25:3 as TUV25]\n" +
-			"                                             └─ LeftOuterJoin\n" +
-			"                                                 ├─ Or\n" +
-			"                                                 │   ├─ Or\n" +
-			"                                                 │   │   ├─ Or\n" +
-			"                                                 │   │   │   ├─ AND\n" +
-			"                                                 │   │   │   │   ├─ AND\n" +
-			"                                                 │   │   │   │   │   ├─ NOT\n" +
-			"                                                 │   │   │   │   │   │   └─ MJR3D.QNI57:5 IS NULL\n" +
-			"                                                 │   │   │   │   │   └─ Eq\n" +
-			"                                                 │   │   │   │   │       ├─ sn.id:10!null\n" +
-			"                                                 │   │   │   │   │       └─ MJR3D.QNI57:5\n" +
-			"                                                 │   │   │   │   └─ MJR3D.BJUF2:1 IS NULL\n" +
-			"                                                 │   │   │   └─ AND\n" +
-			"                                                 │   │   │       ├─ AND\n" +
-			"                                                 │   │   │       │   ├─ NOT\n" +
-			"                                                 │   │   │       │   │   └─ MJR3D.QNI57:5 IS NULL\n" +
-			"                                                 │   │   │       │   └─ InSubquery\n" +
-			"                                                 │   │   │       │       ├─ left: sn.id:10!null\n" +
-			"                                                 │   │   │       │       └─ right: Subquery\n" +
-			"                                                 │   │   │       │           ├─ cacheable: false\n" +
-			"                                                 │   │   │       │           └─ Project\n" +
-			"                                                 │   │   │       │               ├─ columns: [JTEHG.id:20!null]\n" +
-			"                                                 │   │   │       │               └─ Filter\n" +
-			"                                                 │   │   │       │                   ├─ Eq\n" +
-			"                                                 │   │   │       │                   │   ├─ JTEHG.BRQP2:21!null\n" +
-			"                                                 │   │   │       │                   │   └─ MJR3D.BJUF2:1\n" +
-			"                                                 │   │   │       │                   └─ TableAlias(JTEHG)\n" +
-			"                                                 │   │   │       │                       └─ Table\n" +
-			"                                                 │   │   │       │                           ├─ name: NOXN3\n" +
-			"                                                 │   │   │       │                           └─ columns: [id brqp2]\n" +
-			"                                                 │   │   │       └─ NOT\n" +
-			"                                                 │   │   │           └─ MJR3D.BJUF2:1 IS NULL\n" +
-			"                                                 │   │   └─ AND\n" +
-			"                                                 │   │       ├─ AND\n" +
-			"                                                 │   │       │   ├─ NOT\n" +
-			"                                                 │   │       │   │   └─ MJR3D.TDEIU:6 IS NULL\n" +
-			"                                                 │   │       │   └─ InSubquery\n" +
-			"                                                 │   │       │       ├─ left: sn.id:10!null\n" +
-			"                                                 │   │       │       └─ right: Subquery\n" +
-			"                                                 │   │       │           ├─ cacheable: false\n" +
-			"                                                 │   │       │           └─ Project\n" +
-			"                                                 │   │       │               ├─ columns: [XMAFZ.id:20!null]\n" +
-			"                                                 │   │       │               └─ Filter\n" +
-			"                                                 │   │       │                   ├─ Eq\n" +
-			"                                                 │   │       │                   │   ├─ XMAFZ.BRQP2:21!null\n" +
-			"                                                 │   │       │                   │   └─ MJR3D.FJDP5:0!null\n" +
-			"                                                 │   │       │                   └─ TableAlias(XMAFZ)\n" +
-			"                                                 │   │       │                       └─ Table\n" +
-			"                                                 │   │       │                           ├─ name: NOXN3\n" +
-			"                                                 │   │       │                           └─ columns: [id brqp2]\n" +
-			"                                                 │   │       └─ MJR3D.BJUF2:1 IS NULL\n" +
-			"                                                 │   └─ AND\n" +
-			"                                                 │       ├─ AND\n" +
-			"                                                 │       │   ├─ NOT\n" +
-			"                                                 │       │   │   └─ MJR3D.TDEIU:6 IS NULL\n" +
-			"                                                 │       │   └─ InSubquery\n" +
-			"                                                 │       │       ├─ left: sn.id:10!null\n" +
-			"                                                 │       │       └─ right: Subquery\n" +
-			"                                                 │       │           ├─ cacheable: false\n" +
-			"                                                 │       │           └─ Project\n" +
-			"                                                 │       │               ├─ columns: [XMAFZ.id:20!null]\n" +
-			"                                                 │       │               └─ Filter\n" +
-			"                                                 │       │                   ├─ Eq\n" +
-			"                                                 │       │                   │   ├─ XMAFZ.BRQP2:21!null\n" +
-			"                                                 │       │                   │   └─ MJR3D.BJUF2:1\n" +
-			"                                                 │       │                   └─ TableAlias(XMAFZ)\n" +
-			"                                                 │       │                       └─ Table\n" +
-			"                                                 │       │                           ├─ name: NOXN3\n" +
-			"                                                 │       │                           └─ columns: [id brqp2]\n" +
-			"                                                 │       └─ NOT\n" +
-			"                                                 │           └─ MJR3D.BJUF2:1 IS NULL\n" +
-			"                                                 ├─ LookupJoin\n" +
-			"                                                 │   ├─ Eq\n" +
-			"                                                 │   │   ├─ aac.id:7!null\n" +
-			"                                                 │   │   └─ MJR3D.M22QN:2!null\n" +
-			"                                                 │   ├─ SubqueryAlias\n" +
-			"                                                 │   │   ├─ name: MJR3D\n" +
-			"                                                 │   │   ├─ outerVisibility: false\n" +
-			"                                                 │   │   ├─ cacheable: true\n" +
-			"                                                 │   │   └─ Distinct\n" +
-			"                                                 │   │       └─ Project\n" +
-			"                                                 │   │           ├─ columns: [ism.FV24E:9!null as FJDP5, CPMFE.id:27 as BJUF2, ism.M22QN:11!null as M22QN, G3YXS.TUV25:5 as TUV25, G3YXS.ESFVY:1!null as ESFVY, YQIF4.id:44 as QNI57, YVHJZ.id:54 as TDEIU]\n" +
-			"                                                 │   │           └─ Filter\n" +
-			"                                                 │   │               ├─ Or\n" +
-			"                                                 │   │               │   ├─ NOT\n" +
-			"                                                 │   │               │   │   └─ YQIF4.id:44 IS NULL\n" +
-			"                                                 │   │               │   └─ NOT\n" +
-			"                                                 │   │               │       └─ YVHJZ.id:54 IS NULL\n" +
-			"                                                 │   │               └─ LeftOuterLookupJoin\n" +
-			"                                                 │   │                   ├─ AND\n" +
-			"                                                 │   │                   │   ├─ Eq\n" +
-			"                                                 │   │                   │   │   ├─ YVHJZ.BRQP2:55!null\n" +
-			"                                                 │   │                   │   │   └─ ism.UJ6XY:10!null\n" +
-			"                                                 │   │                   │   └─ Eq\n" +
-			"                                                 │   │                   │       ├─ YVHJZ.FFTBJ:56!null\n" +
-			"                                                 │   │                   │       └─ ism.FV24E:9!null\n" +
-			"                                                 │   │                   ├─ LeftOuterLookupJoin\n" +
-			"                                                 │   │                   │   ├─ AND\n" +
-			"                                                 │   │                   │   │   ├─ Eq\n" +
-			"                                                 │   │                   │   │   │   ├─ YQIF4.BRQP2:45!null\n" +
-			"                                                 │   │                   │   │   │   └─ ism.FV24E:9!null\n" +
-			"                                                 │   │                   │   │   └─ Eq\n" +
-			"                                                 │   │                   │   │       ├─ YQIF4.FFTBJ:46!null\n" +
-			"                                                 │   │                   │   │       └─ ism.UJ6XY:10!null\n" +
-			"                                                 │   │                   │   ├─ LeftOuterLookupJoin\n" +
-			"                                                 │   │                   │   │   ├─ AND\n" +
-			"                                                 │   │                   │   │   │   ├─ Eq\n" +
-			"                                                 │   │                   │   │   │   │   ├─ CPMFE.ZH72S:34\n" +
-			"                                                 │   │                   │   │   │   │   └─ NHMXW.NOHHR:18\n" +
-			"                                                 │   │                   │   │   │   └─ NOT\n" +
-			"                                                 │   │                   │   │   │       └─ Eq\n" +
-			"                                                 │   │                   │   │   │           ├─ CPMFE.id:27!null\n" +
-			"                                                 │   │                   │   │   │           └─ ism.FV24E:9!null\n" +
-			"                                                 │   │                   │   │   ├─ LeftOuterLookupJoin\n" +
-			"                                                 │   │                   │   │   │   ├─ Eq\n" +
-			"                                                 │   │                   │   │   │   │   ├─ NHMXW.id:17!null\n" +
-			"                                                 │   │                   │   │   │   │   └─ ism.PRUV2:14\n" +
-			"                                                 │   │                   │   │   │   ├─ LookupJoin\n" +
-			"                                                 │   │                   │   │   │   │   ├─ Eq\n" +
-			"                                                 │   │                   │   │   │   │   │   ├─ G3YXS.id:0!null\n" +
-			"                                                 │   │                   │   │   │   │   │   └─ ism.NZ4MQ:12!null\n" +
-			"                                                 │   │                   │   │   │   │   ├─ Filter\n" +
-			"                                                 │   │                   │   │   │   │   │   ├─ NOT\n" +
-			"                                                 │   │                   │   │   │   │   │   │   └─ G3YXS.TUV25:5 IS NULL\n" +
-			"                                                 │   │                   │   │   │   │   │   └─ TableAlias(G3YXS)\n" +
-			"                                                 │   │                   │   │   │   │   │       └─ Table\n" +
-			"                                                 │   │                   │   │   │   │   │           ├─ name: YYBCX\n" +
-			"                                                 │   │                   │   │   │   │   │           └─ columns: [id esfvy sl76b ge5el f7a4q tuv25 ykssu fhcyt]\n" +
-			"                                                 │   │                   │   │   │   │   └─ TableAlias(ism)\n" +
-			"                                                 │   │                   │   │   │   │       └─ IndexedTableAccess(HDDVB)\n" +
-			"                                                 │   │                   │   │   │   │           ├─ index: [HDDVB.NZ4MQ]\n" +
-			"                                                 │   │                   │   │   │   │           └─ columns: [id fv24e uj6xy m22qn nz4mq etpqv pruv2 ykssu fhcyt]\n" +
-			"                                                 │   │                   │   │   │   └─ TableAlias(NHMXW)\n" +
-			"                                                 │   │                   │   │   │       └─ IndexedTableAccess(WGSDC)\n" +
-			"                                                 │   │                   │   │   │           ├─ index: [WGSDC.id]\n" +
-			"                                                 │   │                   │   │   │           └─ columns: [id nohhr avpyf sypkf idut2 fzxv5 dqygv swcqv ykssu fhcyt]\n" +
-			"                                                 │   │                   │   │   └─ TableAlias(CPMFE)\n" +
-			"                                                 │   │                   │   │       └─ IndexedTableAccess(E2I7U)\n" +
-			"                                                 │   │                   │   │           ├─ index: [E2I7U.ZH72S]\n" +
-			"                                                 │   │                   │   │           └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
-			"                                                 │   │                   │   └─ TableAlias(YQIF4)\n" +
-			"                                                 │   │                   │       └─ IndexedTableAccess(NOXN3)\n" +
-			"                                                 │   │                   │           ├─ index: [NOXN3.BRQP2]\n" +
-			"                                                 │   │                   │           └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
-			"                                                 │   │                   └─ TableAlias(YVHJZ)\n" +
-			"                                                 │   │                       └─ IndexedTableAccess(NOXN3)\n" +
-			"                                                 │   │                           ├─ index: [NOXN3.BRQP2]\n" +
-			"                                                 │   │                           └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
-			"                                                 │   └─ TableAlias(aac)\n" +
-			"                                                 │       └─ IndexedTableAccess(TPXBU)\n" +
-			"                                                 │           ├─ index: [TPXBU.id]\n" +
-			"                                                 │           └─ columns: [id btxc5 fhcyt]\n" +
-			"                                                 └─ TableAlias(sn)\n" +
-			"                                                     └─ Table\n" +
-			"                                                         ├─ name: NOXN3\n" +
-			"                                                         └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			"         └─ Project\n" +
+			"             ├─ columns: [RSA3Y.T4IBQ:3!null as T4IBQ, JMHIE.M6T2N:0 as M6T2N, JMHIE.BTXC5:1 as BTXC5, JMHIE.TUV25:2 as TUV25]\n" +
+			"             └─ CrossJoin\n" +
+			"                 ├─ SubqueryAlias\n" +
+			"                 │   ├─ name: `JMHIE`\n" +
+			"                 │   ├─ outerVisibility: false\n" +
+			"                 │   ├─ cacheable: true\n" +
+			"                 │   └─ Distinct\n" +
+			"                 │       └─ Project\n" +
+			"                 │           ├─ columns: [JQHRG.M6T2N:0, JQHRG.BTXC5:1, JQHRG.TUV25:4]\n" +
+			"                 │           └─ SubqueryAlias\n" +
+			"                 │               ├─ name: `JQHRG`\n" +
+			"                 │               ├─ outerVisibility: false\n" +
+			"                 │               ├─ cacheable: true\n" +
+			"                 │               └─ Project\n" +
+			"                 │                   ├─ columns: [CASE  WHEN NOT\n" +
+			"                 │                   │   └─ MJR3D.QNI57:5 IS NULL\n" +
+			"                 │                   │   THEN Subquery\n" +
+			"                 │                   │   ├─ cacheable: false\n" +
+			"                 │                   │   └─ Project\n" +
+			"                 │                   │       ├─ columns: [ei.M6T2N:21!null]\n" +
+			"                 │                   │       └─ Filter\n" +
+			"                 │                   │           ├─ Eq\n" +
+			"                 │                   │           │   ├─ ei.id:20!null\n" +
+			"                 │                   │           │   └─ MJR3D.QNI57:5\n" +
+			"                 │                   │           └─ SubqueryAlias\n" +
+			"                 │                   │               ├─ name: ei\n" +
+			"                 │                   │               ├─ outerVisibility: true\n" +
+			"                 │                   │               ├─ cacheable: true\n" +
+			"                 │                   │               └─ Project\n" +
+			"                 │                   │                   ├─ columns: [NOXN3.id:20!null, (row_number() over ( order by NOXN3.id ASC):21!null - 1 (tinyint)) as M6T2N]\n" +
+			"                 │                   │                   └─ Window\n" +
+			"                 │                   │                       ├─ NOXN3.id:20!null\n" +
+			"                 │                   │                       ├─ row_number() over ( order by NOXN3.id ASC)\n" +
+			"                 │                   │                       └─ Table\n" +
+			"                 │                   │                           ├─ name: mydb.`NOXN3`\n" +
+			"                 │                   │                           └─ columns: [id]\n" +
+			"                 │                   │   WHEN NOT\n" +
+			"                 │                   │   └─ MJR3D.TDEIU:6 IS NULL\n" +
+			"                 │                   │   THEN Subquery\n" +
+			"                 │                   │   ├─ cacheable: false\n" +
+			"                 │                   │   └─ Project\n" +
+			"                 │                   │       ├─ columns: [ei.M6T2N:21!null]\n" +
+			"                 │                   │       └─ Filter\n" +
+			"                 │                   │           ├─ Eq\n" +
+			"                 │                   │           │   ├─ ei.id:20!null\n" +
+			"                 │                   │           │   └─ MJR3D.TDEIU:6\n" +
+			"                 │                   │           └─ SubqueryAlias\n" +
+			"                 │                   │               ├─ name: ei\n" +
+			"                 │                   │               ├─ outerVisibility: true\n" +
+			"                 │                   │               ├─ cacheable: true\n" +
+			"                 │                   │               └─ Project\n" +
+			"                 │                   │                   ├─ columns: [NOXN3.id:20!null, (row_number() over ( order by NOXN3.id ASC):21!null - 1 (tinyint)) as M6T2N]\n" +
+			"                 │                   │                   └─ Window\n" +
+			"                 │                   │                       ├─ NOXN3.id:20!null\n" +
+			"                 │                   │                       ├─ row_number() over ( order by NOXN3.id ASC)\n" +
+			"                 │                   │                       └─ Table\n" +
+			"                 │                   │                           ├─ name: mydb.`NOXN3`\n" +
+			"                 │                   │                           └─ columns: [id]\n" +
+			"                 │                   │   END as M6T2N, aac.BTXC5:8 as BTXC5, aac.id:7!null as NTOFG, sn.id:10 as LWQ6O, MJR3D.TUV25:3 as TUV25]\n" +
+			"                 │                   └─ LeftOuterJoin\n" +
+			"                 │                       ├─ Or\n" +
+			"                 │                       │   ├─ Or\n" +
+			"                 │                       │   │   ├─ Or\n" +
+			"                 │                       │   │   │   ├─ AND\n" +
+			"                 │                       │   │   │   │   ├─ AND\n" +
+			"                 │                       │   │   │   │   │   ├─ NOT\n" +
+			"                 │                       │   │   │   │   │   │   └─ MJR3D.QNI57:5 IS NULL\n" +
+			"                 │                       │   │   │   │   │   └─ Eq\n" +
+			"                 │                       │   │   │   │   │       ├─ sn.id:10!null\n" +
+			"                 │                       │   │   │   │   │       └─ MJR3D.QNI57:5\n" +
+			"                 │                       │   │   │   │   └─ MJR3D.BJUF2:1 IS NULL\n" +
+			"                 │                       │   │   │   └─ AND\n" +
+			"                 │                       │   │   │       ├─ AND\n" +
+			"                 │                       │   │   │       │   ├─ NOT\n" +
+			"                 │                       │   │   │       │   │   └─ MJR3D.QNI57:5 IS NULL\n" +
+			"                 │                       │   │   │       │   └─ InSubquery\n" +
+			"                 │                       │   │   │       │       ├─ left: sn.id:10!null\n" +
+			"                 │                       │   │   │       │       └─ right: Subquery\n" +
+			"                 │                       │   │   │       │           ├─ cacheable: false\n" +
+			"                 │                       │   │   │       │           └─ Project\n" +
+			"                 │                       │   │   │       │               ├─ columns: [JTEHG.id:20!null]\n" +
+			"                 │                       │   │   │       │               └─ Filter\n" +
+			"                 │                       │   │   │       │                   ├─ Eq\n" +
+			"                 │                       │   │   │       │                   │   ├─ JTEHG.BRQP2:21!null\n" +
+			"                 │                       │   │   │       │                   │   └─ MJR3D.BJUF2:1\n" +
+			"                 │                       │   │   │       │                   └─ TableAlias(`JTEHG`)\n" +
+			"                 │                       │   │   │       │                       └─ Table\n" +
+			"                 │                       │   │   │       │                           ├─ name: mydb.`NOXN3`\n" +
+			"                 │                       │   │   │       │                           └─ columns: [id brqp2]\n" +
+			"                 │                       │   │   │       └─ NOT\n" +
+			"                 │                       │   │   │           └─ MJR3D.BJUF2:1 IS NULL\n" +
+			"                 │                       │   │   └─ AND\n" +
+			"                 │                       │   │       ├─ AND\n" +
+			"                 │                       │   │       │   ├─ NOT\n" +
+			"                 │                       │   │       │   │   └─ MJR3D.TDEIU:6 IS NULL\n" +
+			"                 │                       │   │       │   └─ InSubquery\n" +
+			"                 │                       │   │       │       ├─ left: sn.id:10!null\n" +
+			"                 │                       │   │       │       └─ right: Subquery\n" +
+			"                 │                       │   │       │           ├─ cacheable: false\n" +
+			"                 │                       │   │       │           └─ Project\n" +
+			"                 │                       │   │       │               ├─ columns: [XMAFZ.id:20!null]\n" +
+			"                 │                       │   │       │               └─ Filter\n" +
+			"                 │                       │   │       │                   ├─ Eq\n" +
+			"                 │                       │   │       │                   │   ├─ XMAFZ.BRQP2:21!null\n" +
+			"                 │                       │   │       │                   │   └─ MJR3D.FJDP5:0!null\n" +
+			"                 │                       │   │       │                   └─ TableAlias(`XMAFZ`)\n" +
+			"                 │                       │   │       │                       └─ Table\n" +
+			"                 │                       │   │       │                           ├─ name: mydb.`NOXN3`\n" +
+			"                 │                       │   │       │                           └─ columns: [id brqp2]\n" +
+			"                 │                       │   │       └─ MJR3D.BJUF2:1 IS NULL\n" +
+			"                 │                       │   └─ AND\n" +
+			"                 │                       │       ├─ AND\n" +
+			"                 │                       │       │   ├─ NOT\n" +
+			"                 │                       │       │   │   └─ MJR3D.TDEIU:6 IS NULL\n" +
+			"                 │                       │       │   └─ InSubquery\n" +
+			"                 │                       │       │       ├─ left: sn.id:10!null\n" +
+			"                 │                       │       │       └─ right: Subquery\n" +
+			"                 │                       │       │           ├─ cacheable: false\n" +
+			"                 │                       │       │           └─ Project\n" +
+			"                 │                       │       │               ├─ columns: [XMAFZ.id:20!null]\n" +
+			"                 │                       │       │               └─ Filter\n" +
+			"                 │                       │       │                   ├─ Eq\n" +
+			"                 │                       │       │                   │   ├─ XMAFZ.BRQP2:21!null\n" +
+			"                 │                       │       │                   │   └─ MJR3D.BJUF2:1\n" +
+			"                 │                       │       │                   └─ TableAlias(`XMAFZ`)\n" +
+			"                 │                       │       │                       └─ Table\n" +
+			"                 │                       │       │                           ├─ name: mydb.`NOXN3`\n" +
+			"                 │                       │       │                           └─ columns: [id brqp2]\n" +
+			"                 │                       │       └─ NOT\n" +
+			"                 │                       │           └─ MJR3D.BJUF2:1 IS NULL\n" +
+			"                 │                       ├─ LookupJoin\n" +
+			"                 │                       │   ├─ Eq\n" +
+			"                 │                       │   │   ├─ aac.id:7!null\n" +
+			"                 │                       │   │   └─ MJR3D.M22QN:2!null\n" +
+			"                 │                       │   ├─ SubqueryAlias\n" +
+			"                 │                       │   │   ├─ name: `MJR3D`\n" +
+			"                 │                       │   │   ├─ outerVisibility: false\n" +
+			"                 │                       │   │   ├─ cacheable: true\n" +
+			"                 │                       │   │   └─ Distinct\n" +
+			"                 │                       │   │       └─ Project\n" +
+			"                 │                       │   │           ├─ columns: [ism.FV24E:9!null as FJDP5, CPMFE.id:27 as BJUF2, ism.M22QN:11!null as M22QN, G3YXS.TUV25:5 as TUV25, G3YXS.ESFVY:1!null as ESFVY, YQIF4.id:44 as QNI57, YVHJZ.id:54 as TDEIU]\n" +
+			"                 │                       │   │           └─ Filter\n" +
+			"                 │                       │   │               ├─ Or\n" +
+			"                 │                       │   │               │   ├─ NOT\n" +
+			"                 │                       │   │               │   │   └─ YQIF4.id:44 IS NULL\n" +
+			"                 │                       │   │               │   └─ NOT\n" +
+			"                 │                       │   │               │       └─ YVHJZ.id:54 IS NULL\n" +
+			"                 │                       │   │               └─ LeftOuterLookupJoin\n" +
+			"                 │                       │   │                   ├─ AND\n" +
+			"                 │                       │   │                   │   ├─ Eq\n" +
+			"                 │                       │   │                   │   │   ├─ YVHJZ.BRQP2:55!null\n" +
+			"                 │                       │   │                   │   │   └─ ism.UJ6XY:10!null\n" +
+			"                 │                       │   │                   │   └─ Eq\n" +
+			"                 │                       │   │                   │       ├─ YVHJZ.FFTBJ:56!null\n" +
+			"                 │                       │   │                   │       └─ ism.FV24E:9!null\n" +
+			"                 │                       │   │                   ├─ LeftOuterLookupJoin\n" +
+			"                 │                       │   │                   │   ├─ AND\n" +
+			"                 │                       │   │                   │   │   ├─ Eq\n" +
+			"                 │                       │   │                   │   │   │   ├─ YQIF4.BRQP2:45!null\n" +
+			"                 │                       │   │                   │   │   │   └─ ism.FV24E:9!null\n" +
+			"                 │                       │   │                   │   │   └─ Eq\n" +
+			"                 │                       │   │                   │   │       ├─ YQIF4.FFTBJ:46!null\n" +
+			"                 │                       │   │                   │   │       └─ ism.UJ6XY:10!null\n" +
+			"                 │                       │   │                   │   ├─ LeftOuterLookupJoin\n" +
+			"                 │                       │   │                   │   │   ├─ AND\n" +
+			"                 │                       │   │                   │   │   │   ├─ Eq\n" +
+			"                 │                       │   │                   │   │   │   │   ├─ CPMFE.ZH72S:34\n" +
+			"                 │                       │   │                   │   │   │   │   └─ NHMXW.NOHHR:18\n" +
+			"                 │                       │   │                   │   │   │   └─ NOT\n" +
+			"                 │                       │   │                   │   │   │       └─ Eq\n" +
+			"                 │                       │   │                   │   │   │           ├─ CPMFE.id:27!null\n" +
+			"                 │                       │   │                   │   │   │           └─ ism.FV24E:9!null\n" +
+			"                 │                       │   │                   │   │   ├─ LeftOuterLookupJoin\n" +
+			"                 │                       │   │                   │   │   │   ├─ Eq\n" +
+			"                 │                       │   │                   │   │   │   │   ├─ NHMXW.id:17!null\n" +
+			"                 │                       │   │                   │   │   │   │   └─ ism.PRUV2:14\n" +
+			"                 │                       │   │                   │   │   │   ├─ LookupJoin\n" +
+			"                 │                       │   │                   │   │   │   │   ├─ Eq\n" +
+			"                 │                       │   │                   │   │   │   │   │   ├─ G3YXS.id:0!null\n" +
+			"                 │                       │   │                   │   │   │   │   │   └─ ism.NZ4MQ:12!null\n" +
+			"                 │                       │   │                   │   │   │   │   ├─ Filter\n" +
+			"                 │                       │   │                   │   │   │   │   │   ├─ NOT\n" +
+			"                 │                       │   │                   │   │   │   │   │   │   └─ G3YXS.TUV25:5 IS NULL\n" +
+			"                 │                       │   │                   │   │   │   │   │   └─ TableAlias(`G3YXS`)\n" +
+			"                 │                       │   │                   │   │   │   │   │       └─ Table\n" +
+			"                 │                       │   │                   │   │   │   │   │           ├─ name: mydb.`YYBCX`\n" +
+			"                 │                       │   │                   │   │   │   │   │           └─ columns: [id esfvy sl76b ge5el f7a4q tuv25 ykssu fhcyt]\n" +
+			"                 │                       │   │                   │   │   │   │   └─ TableAlias(ism)\n" +
+			"                 │                       │   │                   │   │   │   │       └─ IndexedTableAccess(mydb.`HDDVB`)\n" +
+			"                 │                       │   │                   │   │   │   │           ├─ index: `NZ4MQ` [HDDVB.NZ4MQ]\n" +
+			"                 │                       │   │                   │   │   │   │           └─ columns: [id fv24e uj6xy m22qn nz4mq etpqv pruv2 ykssu fhcyt]\n" +
+			"                 │                       │   │                   │   │   │   └─ TableAlias(`NHMXW`)\n" +
+			"                 │                       │   │                   │   │   │       └─ IndexedTableAccess(mydb.`WGSDC`)\n" +
+			"                 │                       │   │                   │   │   │           ├─ index: `PRIMARY` [WGSDC.id]\n" +
+			"                 │                       │   │                   │   │   │           └─ columns: [id nohhr avpyf sypkf idut2 fzxv5 dqygv swcqv ykssu fhcyt]\n" +
+			"                 │                       │   │                   │   │   └─ TableAlias(`CPMFE`)\n" +
+			"                 │                       │   │                   │   │       └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			"                 │                       │   │                   │   │           ├─ index: `URBSA` [E2I7U.ZH72S]\n" +
+			"                 │                       │   │                   │   │           └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
+			"                 │                       │   │                   │   └─ TableAlias(`YQIF4`)\n" +
+			"                 │                       │   │                   │       └─ IndexedTableAccess(mydb.`NOXN3`)\n" +
+			"                 │                       │   │                   │           ├─ index: `BRQP2` [NOXN3.BRQP2]\n" +
+			"                 │                       │   │                   │           └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			"                 │                       │   │                   └─ TableAlias(`YVHJZ`)\n" +
+			"                 │                       │   │                       └─ IndexedTableAccess(mydb.`NOXN3`)\n" +
+			"                 │                       │   │                           ├─ index: `BRQP2` [NOXN3.BRQP2]\n" +
+			"                 │                       │   │                           └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			"                 │                       │   └─ TableAlias(aac)\n" +
+			"                 │                       │       └─ IndexedTableAccess(mydb.`TPXBU`)\n" +
+			"                 │                       │           ├─ index: `PRIMARY` [TPXBU.id]\n" +
+			"                 │                       │           └─ columns: [id btxc5 fhcyt]\n" +
+			"                 │                       └─ TableAlias(sn)\n" +
+			"                 │                           └─ Table\n" +
+			"                 │                               ├─ name: mydb.`NOXN3`\n" +
+			"                 │                               └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			"                 └─ SubqueryAlias\n" +
+			"                     ├─ name: `RSA3Y`\n" +
+			"                     ├─ outerVisibility: false\n" +
+			"                     ├─ cacheable: true\n" +
+			"                     └─ Distinct\n" +
+			"                         └─ Project\n" +
+			"                             ├─ columns: [HTKBS.T4IBQ:0!null]\n" +
+			"                             └─ SubqueryAlias\n" +
+			"                                 ├─ name: `HTKBS`\n" +
+			"                                 ├─ outerVisibility: false\n" +
+			"                                 ├─ cacheable: true\n" +
+			"                                 └─ Project\n" +
+			"                                     ├─ columns: [cla.FTQLQ:1!null as T4IBQ, sn.id:7!null as BDNYB, mf.M22QN:6!null as M22QN]\n" +
+			"                                     └─ HashJoin\n" +
+			"                                         ├─ Eq\n" +
+			"                                         │   ├─ cla.id:0!null\n" +
+			"                                         │   └─ bs.IXUXU:3\n" +
+			"                                         ├─ Filter\n" +
+			"                                         │   ├─ HashIn\n" +
+			"                                         │   │   ├─ cla.FTQLQ:1!null\n" +
+			"                                         │   │   └─ TUPLE(SQ1 (longtext))\n" +
+			"                                         │   └─ TableAlias(cla)\n" +
+			"                                         │       └─ IndexedTableAccess(mydb.`YK2GW`)\n" +
+			"                                         │           ├─ index: `EZCTT` [YK2GW.FTQLQ]\n" +
+			"                                         │           ├─ static: [{[SQ1, SQ1]}]\n" +
+			"                                         │           └─ columns: [id ftqlq]\n" +
+			"                                         └─ HashLookup\n" +
+			"                                             ├─ source: TUPLE(cla.id:0!null)\n" +
+			"                                             ├─ target: TUPLE(bs.IXUXU:1)\n" +
+			"                                             └─ CachedResults\n" +
+			"                                                 └─ LookupJoin\n" +
+			"                                                     ├─ Eq\n" +
+			"                                                     │   ├─ sn.BRQP2:8!null\n" +
+			"                                                     │   └─ mf.LUEVY:5!null\n" +
+			"                                                     ├─ LookupJoin\n" +
+			"                                                     │   ├─ Eq\n" +
+			"                                                     │   │   ├─ bs.id:2!null\n" +
+			"                                                     │   │   └─ mf.GXLUB:4!null\n" +
+			"                                                     │   ├─ TableAlias(bs)\n" +
+			"                                                     │   │   └─ Table\n" +
+			"                                                     │   │       ├─ name: mydb.`THNTS`\n" +
+			"                                                     │   │       └─ columns: [id ixuxu]\n" +
+			"                                                     │   └─ TableAlias(mf)\n" +
+			"                                                     │       └─ IndexedTableAccess(mydb.`HGMQ6`)\n" +
+			"                                                     │           ├─ index: `GXLUB` [HGMQ6.GXLUB]\n" +
+			"                                                     │           └─ columns: [gxlub luevy m22qn]\n" +
+			"                                                     └─ TableAlias(sn)\n" +
+			"                                                         └─ IndexedTableAccess(mydb.`NOXN3`)\n" +
+			"                                                             ├─ index: `BRQP2` [NOXN3.BRQP2]\n" +
+			"                                                             └─ columns: [id brqp2]\n" +
 			"",
 	},
 	{
@@ -13530,545 +14044,543 @@ WHERE
     )`,
 		ExpectedPlan: "Project\n" +
 			" ├─ columns: [fs.T4IBQ:0!null as T4IBQ, fs.M6T2N:1 as M6T2N, fs.TUV25:3 as TUV25, fs.BTXC5:2 as YEBDJ]\n" +
-			" └─ AntiJoin\n" +
-			"     ├─ Eq\n" +
-			"     │   ├─ TUPLE(fs.T4IBQ:0!null, fs.M6T2N:1, fs.BTXC5:2, fs.TUV25:3)\n" +
-			"     │   └─ TUPLE(scalarSubq0.T4IBQ:4!null, scalarSubq0.M6T2N:5, scalarSubq0.BTXC5:6, scalarSubq0.TUV25:7)\n" +
-			"     ├─ SubqueryAlias\n" +
-			"     │   ├─ name: fs\n" +
-			"     │   ├─ outerVisibility: false\n" +
-			"     │   ├─ cacheable: true\n" +
-			"     │   └─ Project\n" +
-			"     │       ├─ columns: [RSA3Y.T4IBQ:3!null as T4IBQ, JMHIE.M6T2N:0 as M6T2N, JMHIE.BTXC5:1 as BTXC5, JMHIE.TUV25:2 as TUV25]\n" +
-			"     │       └─ CrossJoin\n" +
-			"     │           ├─ SubqueryAlias\n" +
-			"     │           │   ├─ name: JMHIE\n" +
-			"     │           │   ├─ outerVisibility: false\n" +
-			"     │           │   ├─ cacheable: true\n" +
-			"     │           │   └─ Distinct\n" +
-			"     │           │       └─ Project\n" +
-			"     │           │           ├─ columns: [JQHRG.M6T2N:0, JQHRG.BTXC5:1, JQHRG.TUV25:4]\n" +
-			"     │           │           └─ SubqueryAlias\n" +
-			"     │           │               ├─ name: JQHRG\n" +
-			"     │           │               ├─ outerVisibility: false\n" +
-			"     │           │               ├─ cacheable: true\n" +
-			"     │           │               └─ Project\n" +
-			"     │           │                   ├─ columns: [CASE  WHEN NOT\n" +
-			"     │           │                   │   └─ MJR3D.QNI57:5 IS NULL\n" +
-			"     │           │                   │   THEN Subquery\n" +
-			"     │           │                   │   ├─ cacheable: false\n" +
-			"     │           │                   │   └─ Project\n" +
-			"     │           │                   │       ├─ columns: [ei.M6T2N:21!null]\n" +
-			"     │           │                   │       └─ Filter\n" +
-			"     │           │                   │           ├─ Eq\n" +
-			"     │           │                   │           │   ├─ ei.id:20!null\n" +
-			"     │           │                   │           │   └─ MJR3D.QNI57:5\n" +
-			"     │           │                   │           └─ SubqueryAlias\n" +
-			"     │           │                   │               ├─ name: ei\n" +
-			"     │           │                   │               ├─ outerVisibility: true\n" +
-			"     │           │                   │               ├─ cacheable: true\n" +
-			"     │           │                   │               └─ Project\n" +
-			"     │           │                   │                   ├─ columns: [NOXN3.id:20!null, (row_number() over ( order by NOXN3.id ASC):21!null - 1 (tinyint)) as M6T2N]\n" +
-			"     │           │                   │                   └─ Window\n" +
-			"     │           │                   │                       ├─ NOXN3.id:20!null\n" +
-			"     │           │                   │                       ├─ row_number() over ( order by NOXN3.id ASC)\n" +
-			"     │           │                   │                       └─ Table\n" +
-			"     │           │                   │                           ├─ name: NOXN3\n" +
-			"     │           │                   │                           └─ columns: [id]\n" +
-			"     │           │                   │   WHEN NOT\n" +
-			"     │           │                   │   └─ MJR3D.TDEIU:6 IS NULL\n" +
-			"     │           │                   │   THEN Subquery\n" +
-			"     │           │                   │   ├─ cacheable: false\n" +
-			"     │           │                   │   └─ Project\n" +
-			"     │           │                   │       ├─ columns: [ei.M6T2N:21!null]\n" +
-			"     │           │                   │       └─ Filter\n" +
-			"     │           │                   │           ├─ Eq\n" +
-			"     │           │                   │           │   ├─ ei.id:20!null\n" +
-			"     │           │                   │           │   └─ MJR3D.TDEIU:6\n" +
-			"     │           │                   │           └─ SubqueryAlias\n" +
-			"     │           │                   │               ├─ name: ei\n" +
-			"     │           │                   │               ├─ outerVisibility: true\n" +
-			"     │           │                   │               ├─ cacheable: true\n" +
-			"     │           │                   │               └─ Project\n" +
-			"     │           │                   │                   ├─ columns: [NOXN3.id:20!null, (row_number() over ( order by NOXN3.id ASC):21!null - 1 (tinyint)) as M6T2N]\n" +
-			"     │           │                   │                   └─ Window\n" +
-			"     │           │                   │                       ├─ NOXN3.id:20!null\n" +
-			"     │           │                   │                       ├─ row_number() over ( order by NOXN3.id ASC)\n" +
-			"     │           │                   │                       └─ Table\n" +
-			"     │           │                   │                           ├─ name: NOXN3\n" +
-			"     │           │                   │                           └─ columns: [id]\n" +
-			"     │           │                   │   END as M6T2N, aac.BTXC5:8 as BTXC5, aac.id:7!null as NTOFG, sn.id:10 as LWQ6O, MJR3D.TUV25:3 as TUV25]\n" +
-			"     │           │                   └─ LeftOuterJoin\n" +
-			"     │           │                       ├─ Or\n" +
-			"     │           │                       │   ├─ Or\n" +
-			"     │           │                       │   │   ├─ Or\n" +
-			"     │           │                       │   │   │   ├─ AND\n" +
-			"     │           │                       │   │   │   │   ├─ AND\n" +
-			"     │           │                       │   │   │   │   │   ├─ NOT\n" +
-			"     │           │                       │   │   │   │   │   │   └─ MJR3D.QNI57:5 IS NULL\n" +
-			"     │           │                       │   │   │   │   │   └─ Eq\n" +
-			"     │           │                       │   │   │   │   │       ├─ sn.id:10!null\n" +
-			"     │           │                       │   │   │   │   │       └─ MJR3D.QNI57:5\n" +
-			"     │           │                       │   │   │   │   └─ MJR3D.BJUF2:1 IS NULL\n" +
-			"     │           │                       │   │   │   └─ AND\n" +
-			"     │           │                       │   │   │       ├─ AND\n" +
-			"     │           │                       │   │   │       │   ├─ NOT\n" +
-			"     │           │                       │   │   │       │   │   └─ MJR3D.QNI57:5 IS NULL\n" +
-			"     │           │                       │   │   │       │   └─ InSubquery\n" +
-			"     │           │                       │   │   │       │       ├─ left: sn.id:10!null\n" +
-			"     │           │                       │   │   │       │       └─ right: Subquery\n" +
-			"     │           │                       │   │   │       │           ├─ cacheable: false\n" +
-			"     │           │                       │   │   │       │           └─ Project\n" +
-			"     │           │                       │   │   │       │               ├─ columns: [JTEHG.id:20!null]\n" +
-			"     │           │                       │   │   │       │               └─ Filter\n" +
-			"     │           │                       │   │   │       │                   ├─ Eq\n" +
-			"     │           │                       │   │   │       │                   │   ├─ JTEHG.BRQP2:21!null\n" +
-			"     │           │                       │   │   │       │                   │   └─ MJR3D.BJUF2:1\n" +
-			"     │           │                       │   │   │       │                   └─ TableAlias(JTEHG)\n" +
-			"     │           │                       │   │   │       │                       └─ Table\n" +
-			"     │           │                       │   │   │       │                           ├─ name: NOXN3\n" +
-			"     │           │                       │   │   │       │                           └─ columns: [id brqp2]\n" +
-			"     │           │                       │   │   │       └─ NOT\n" +
-			"     │           │                       │   │   │           └─ MJR3D.BJUF2:1 IS NULL\n" +
-			"     │           │                       │   │   └─ AND\n" +
-			"     │           │                       │   │       ├─ AND\n" +
-			"     │           │                       │   │       │   ├─ NOT\n" +
-			"     │           │                       │   │       │   │   └─ MJR3D.TDEIU:6 IS NULL\n" +
-			"     │           │                       │   │       │   └─ InSubquery\n" +
-			"     │           │                       │   │       │       ├─ left: sn.id:10!null\n" +
-			"     │           │                       │   │       │       └─ right: Subquery\n" +
-			"     │           │                       │   │       │           ├─ cacheable: false\n" +
-			"     │           │                       │   │       │           └─ Project\n" +
-			"     │           │                       │   │       │               ├─ columns: [XMAFZ.id:20!null]\n" +
-			"     │           │                       │   │       │               └─ Filter\n" +
-			"     │           │                       │   │       │                   ├─ Eq\n" +
-			"     │           │                       │   │       │                   │   ├─ XMAFZ.BRQP2:21!null\n" +
-			"     │           │                       │   │       │                   │   └─ MJR3D.FJDP5:0!null\n" +
-			"     │           │                       │   │       │                   └─ TableAlias(XMAFZ)\n" +
-			"     │           │                       │   │       │                       └─ Table\n" +
-			"     │           │                       │   │       │                           ├─ name: NOXN3\n" +
-			"     │           │                       │   │       │                           └─ columns: [id brqp2]\n" +
-			"     │           │                       │   │       └─ MJR3D.BJUF2:1 IS NULL\n" +
-			"     │           │                       │   └─ AND\n" +
-			"     │           │                       │       ├─ AND\n" +
-			"     │           │                       │       │   ├─ NOT\n" +
-			"     │           │                       │       │   │   └─ MJR3D.TDEIU:6 IS NULL\n" +
-			"     │           │                       │       │   └─ InSubquery\n" +
-			"     │           │                       │       │       ├─ left: sn.id:10!null\n" +
-			"     │           │                       │       │       └─ right: Subquery\n" +
-			"     │           │                       │       │           ├─ cacheable: false\n" +
-			"     │           │                       │       │           └─ Project\n" +
-			"     │           │                       │       │               ├─ columns: [XMAFZ.id:20!null]\n" +
-			"     │           │                       │       │               └─ Filter\n" +
-			"     │           │                       │       │                   ├─ Eq\n" +
-			"     │           │                       │       │                   │   ├─ XMAFZ.BRQP2:21!null\n" +
-			"     │           │                       │       │                   │   └─ MJR3D.BJUF2:1\n" +
-			"     │           │                       │       │                   └─ TableAlias(XMAFZ)\n" +
-			"     │           │                       │       │                       └─ Table\n" +
-			"     │           │                       │       │                           ├─ name: NOXN3\n" +
-			"     │           │                       │       │                           └─ columns: [id brqp2]\n" +
-			"     │           │                       │       └─ NOT\n" +
-			"     │           │                       │           └─ MJR3D.BJUF2:1 IS NULL\n" +
-			"     │           │                       ├─ LookupJoin\n" +
-			"     │           │                       │   ├─ Eq\n" +
-			"     │           │                       │   │   ├─ aac.id:7!null\n" +
-			"     │           │                       │   │   └─ MJR3D.M22QN:2!null\n" +
-			"     │           │                       │   ├─ SubqueryAlias\n" +
-			"     │           │                       │   │   ├─ name: MJR3D\n" +
-			"     │           │                       │   │   ├─ outerVisibility: false\n" +
-			"     │           │                       │   │   ├─ cacheable: true\n" +
-			"     │           │                       │   │   └─ Distinct\n" +
-			"     │           │                       │   │       └─ Project\n" +
-			"     │           │                       │   │           ├─ columns: [ism.FV24E:9!null as FJDP5, CPMFE.id:27 as BJUF2, ism.M22QN:11!null as M22QN, G3YXS.TUV25:5 as TUV25, G3YXS.ESFVY:1!null as ESFVY, YQIF4.id:44 as QNI57, YVHJZ.id:54 as TDEIU]\n" +
-			"     │           │                       │   │           └─ Filter\n" +
-			"     │           │                       │   │               ├─ Or\n" +
-			"     │           │                       │   │               │   ├─ NOT\n" +
-			"     │           │                       │   │               │   │   └─ YQIF4.id:44 IS NULL\n" +
-			"     │           │                       │   │               │   └─ NOT\n" +
-			"     │           │                       │   │               │       └─ YVHJZ.id:54 IS NULL\n" +
-			"     │           │                       │   │               └─ LeftOuterLookupJoin\n" +
-			"     │           │                       │   │                   ├─ AND\n" +
-			"     │           │                       │   │                   │   ├─ Eq\n" +
-			"     │           │                       │   │                   │   │   ├─ YVHJZ.BRQP2:55!null\n" +
-			"     │           │                       │   │                   │   │   └─ ism.UJ6XY:10!null\n" +
-			"     │           │                       │   │                   │   └─ Eq\n" +
-			"     │           │                       │   │                   │       ├─ YVHJZ.FFTBJ:56!null\n" +
-			"     │           │                       │   │                   │       └─ ism.FV24E:9!null\n" +
-			"     │           │                       │   │                   ├─ LeftOuterLookupJoin\n" +
-			"     │           │                       │   │                   │   ├─ AND\n" +
-			"     │           │                       │   │                   │   │   ├─ Eq\n" +
-			"     │           │                       │   │                   │   │   │   ├─ YQIF4.BRQP2:45!null\n" +
-			"     │           │                       │   │                   │   │   │   └─ ism.FV24E:9!null\n" +
-			"     │           │                       │   │                   │   │   └─ Eq\n" +
-			"     │           │                       │   │                   │   │       ├─ YQIF4.FFTBJ:46!null\n" +
-			"     │           │                       │   │                   │   │       └─ ism.UJ6XY:10!null\n" +
-			"     │           │                       │   │                   │   ├─ LeftOuterLookupJoin\n" +
-			"     │           │                       │   │                   │   │   ├─ AND\n" +
-			"     │           │                       │   │                   │   │   │   ├─ Eq\n" +
-			"     │           │                       │   │                   │   │   │   │   ├─ CPMFE.ZH72S:34\n" +
-			"     │           │                       │   │                   │   │   │   │   └─ NHMXW.NOHHR:18\n" +
-			"     │           │                       │   │                   │   │   │   └─ NOT\n" +
-			"     │           │                       │   │                   │   │   │       └─ Eq\n" +
-			"     │           │                       │   │                   │   │   │           ├─ CPMFE.id:27!null\n" +
-			"     │           │                       │   │                   │   │   │           └─ ism.FV24E:9!null\n" +
-			"     │           │                       │   │                   │   │   ├─ LeftOuterLookupJoin\n" +
-			"     │           │                       │   │                   │   │   │   ├─ Eq\n" +
-			"     │           │                       │   │                   │   │   │   │   ├─ NHMXW.id:17!null\n" +
-			"     │           │                       │   │                   │   │   │   │   └─ ism.PRUV2:14\n" +
-			"     │           │                       │   │                   │   │   │   ├─ LookupJoin\n" +
-			"     │           │                       │   │                   │   │   │   │   ├─ Eq\n" +
-			"     │           │                       │   │                   │   │   │   │   │   ├─ G3YXS.id:0!null\n" +
-			"     │           │                       │   │                   │   │   │   │   │   └─ ism.NZ4MQ:12!null\n" +
-			"     │           │                       │   │                   │   │   │   │   ├─ Filter\n" +
-			"     │           │                       │   │                   │   │   │   │   │   ├─ NOT\n" +
-			"     │           │                       │   │                   │   │   │   │   │   │   └─ G3YXS.TUV25:5 IS NULL\n" +
-			"     │           │                       │   │                   │   │   │   │   │   └─ TableAlias(G3YXS)\n" +
-			"     │           │                       │   │                   │   │   │   │   │       └─ Table\n" +
-			"     │           │                       │   │                   │   │   │   │   │           ├─ name: YYBCX\n" +
-			"     │           │                       │   │                   │   │   │   │   │           └─ columns: [id esfvy sl76b ge5el f7a4q tuv25 ykssu fhcyt]\n" +
-			"     │           │                       │   │                   │   │   │   │   └─ TableAlias(ism)\n" +
-			"     │           │                       │   │                   │   │   │   │       └─ IndexedTableAccess(HDDVB)\n" +
-			"     │           │                       │   │                   │   │   │   │           ├─ index: [HDDVB.NZ4MQ]\n" +
-			"     │           │                       │   │                   │   │   │   │           └─ columns: [id fv24e uj6xy m22qn nz4mq etpqv pruv2 ykssu fhcyt]\n" +
-			"     │           │                       │   │                   │   │   │   └─ TableAlias(NHMXW)\n" +
-			"     │           │                       │   │                   │   │   │       └─ IndexedTableAccess(WGSDC)\n" +
-			"     │           │                       │   │                   │   │   │           ├─ index: [WGSDC.id]\n" +
-			"     │           │                       │   │                   │   │   │           └─ columns: [id nohhr avpyf sypkf idut2 fzxv5 dqygv swcqv ykssu fhcyt]\n" +
-			"     │           │                       │   │                   │   │   └─ TableAlias(CPMFE)\n" +
-			"     │           │                       │   │                   │   │       └─ IndexedTableAccess(E2I7U)\n" +
-			"     │           │                       │   │                   │   │           ├─ index: [E2I7U.ZH72S]\n" +
-			"     │           │                       │   │                   │   │           └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
-			"     │           │                       │   │                   │   └─ TableAlias(YQIF4)\n" +
-			"     │           │                       │   │                   │       └─ IndexedTableAccess(NOXN3)\n" +
-			"     │           │                       │   │                   │           ├─ index: [NOXN3.BRQP2]\n" +
-			"     │           │                       │   │                   │           └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
-			"     │           │                       │   │                   └─ TableAlias(YVHJZ)\n" +
-			"     │           │                       │   │                       └─ IndexedTableAccess(NOXN3)\n" +
-			"     │           │                       │   │                           ├─ index: [NOXN3.BRQP2]\n" +
-			"     │           │                       │   │                           └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
-			"     │           │                       │   └─ TableAlias(aac)\n" +
-			"     │           │                       │       └─ IndexedTableAccess(TPXBU)\n" +
-			"     │           │                       │           ├─ index: [TPXBU.id]\n" +
-			"     │           │                       │           └─ columns: [id btxc5 fhcyt]\n" +
-			"     │           │                       └─ TableAlias(sn)\n" +
-			"     │           │                           └─ Table\n" +
-			"     │           │                               ├─ name: NOXN3\n" +
-			"     │           │                               └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			" └─ Filter\n" +
+			"     ├─ NOT\n" +
+			"     │   └─ InSubquery\n" +
+			"     │       ├─ left: TUPLE(fs.T4IBQ:0!null, fs.M6T2N:1, fs.BTXC5:2, fs.TUV25:3)\n" +
+			"     │       └─ right: Subquery\n" +
+			"     │           ├─ cacheable: true\n" +
 			"     │           └─ SubqueryAlias\n" +
-			"     │               ├─ name: RSA3Y\n" +
-			"     │               ├─ outerVisibility: false\n" +
+			"     │               ├─ name: `ZMSPR`\n" +
+			"     │               ├─ outerVisibility: true\n" +
 			"     │               ├─ cacheable: true\n" +
 			"     │               └─ Distinct\n" +
 			"     │                   └─ Project\n" +
-			"     │                       ├─ columns: [HTKBS.T4IBQ:0!null]\n" +
-			"     │                       └─ SubqueryAlias\n" +
-			"     │                           ├─ name: HTKBS\n" +
-			"     │                           ├─ outerVisibility: false\n" +
-			"     │                           ├─ cacheable: true\n" +
-			"     │                           └─ Project\n" +
-			"     │                               ├─ columns: [cla.FTQLQ:6!null as T4IBQ, sn.id:7!null as BDNYB, mf.M22QN:4!null as M22QN]\n" +
-			"     │                               └─ LookupJoin\n" +
-			"     │                                   ├─ Eq\n" +
-			"     │                                   │   ├─ sn.BRQP2:8!null\n" +
-			"     │                                   │   └─ mf.LUEVY:3!null\n" +
-			"     │                                   ├─ LookupJoin\n" +
-			"     │                                   │   ├─ Eq\n" +
-			"     │                                   │   │   ├─ cla.id:5!null\n" +
-			"     │                                   │   │   └─ bs.IXUXU:1\n" +
-			"     │                                   │   ├─ LookupJoin\n" +
-			"     │                                   │   │   ├─ Eq\n" +
-			"     │                                   │   │   │   ├─ bs.id:0!null\n" +
-			"     │                                   │   │   │   └─ mf.GXLUB:2!null\n" +
-			"     │                                   │   │   ├─ TableAlias(bs)\n" +
-			"     │                                   │   │   │   └─ Table\n" +
-			"     │                                   │   │   │       ├─ name: THNTS\n" +
-			"     │                                   │   │   │       └─ columns: [id ixuxu]\n" +
-			"     │                                   │   │   └─ TableAlias(mf)\n" +
-			"     │                                   │   │       └─ IndexedTableAccess(HGMQ6)\n" +
-			"     │                                   │   │           ├─ index: [HGMQ6.GXLUB]\n" +
-			"     │                                   │   │           └─ columns: [gxlub luevy m22qn]\n" +
-			"     │                                   │   └─ Filter\n" +
-			"     │                                   │       ├─ HashIn\n" +
-			"     │                                   │       │   ├─ cla.FTQLQ:1!null\n" +
-			"     │                                   │       │   └─ TUPLE(SQ1 (longtext))\n" +
-			"     │                                   │       └─ TableAlias(cla)\n" +
-			"     │                                   │           └─ IndexedTableAccess(YK2GW)\n" +
-			"     │                                   │               ├─ index: [YK2GW.id]\n" +
-			"     │                                   │               └─ columns: [id ftqlq]\n" +
-			"     │                                   └─ TableAlias(sn)\n" +
-			"     │                                       └─ IndexedTableAccess(NOXN3)\n" +
-			"     │                                           ├─ index: [NOXN3.BRQP2]\n" +
-			"     │                                           └─ columns: [id brqp2]\n" +
+			"     │                       ├─ columns: [cld.T4IBQ:4!null as T4IBQ, P4PJZ.M6T2N:7 as M6T2N, P4PJZ.BTXC5:8 as BTXC5, P4PJZ.TUV25:11 as TUV25]\n" +
+			"     │                       └─ Filter\n" +
+			"     │                           ├─ NOT\n" +
+			"     │                           │   └─ P4PJZ.M6T2N:7 IS NULL\n" +
+			"     │                           └─ LeftOuterHashJoin\n" +
+			"     │                               ├─ AND\n" +
+			"     │                               │   ├─ Eq\n" +
+			"     │                               │   │   ├─ P4PJZ.LWQ6O:10\n" +
+			"     │                               │   │   └─ cld.BDNYB:5!null\n" +
+			"     │                               │   └─ Eq\n" +
+			"     │                               │       ├─ P4PJZ.NTOFG:9!null\n" +
+			"     │                               │       └─ cld.M22QN:6!null\n" +
+			"     │                               ├─ SubqueryAlias\n" +
+			"     │                               │   ├─ name: cld\n" +
+			"     │                               │   ├─ outerVisibility: false\n" +
+			"     │                               │   ├─ cacheable: true\n" +
+			"     │                               │   └─ Project\n" +
+			"     │                               │       ├─ columns: [cla.FTQLQ:6!null as T4IBQ, sn.id:7!null as BDNYB, mf.M22QN:4!null as M22QN]\n" +
+			"     │                               │       └─ LookupJoin\n" +
+			"     │                               │           ├─ Eq\n" +
+			"     │                               │           │   ├─ sn.BRQP2:8!null\n" +
+			"     │                               │           │   └─ mf.LUEVY:3!null\n" +
+			"     │                               │           ├─ LookupJoin\n" +
+			"     │                               │           │   ├─ Eq\n" +
+			"     │                               │           │   │   ├─ cla.id:5!null\n" +
+			"     │                               │           │   │   └─ bs.IXUXU:1\n" +
+			"     │                               │           │   ├─ LookupJoin\n" +
+			"     │                               │           │   │   ├─ Eq\n" +
+			"     │                               │           │   │   │   ├─ bs.id:0!null\n" +
+			"     │                               │           │   │   │   └─ mf.GXLUB:2!null\n" +
+			"     │                               │           │   │   ├─ TableAlias(bs)\n" +
+			"     │                               │           │   │   │   └─ Table\n" +
+			"     │                               │           │   │   │       ├─ name: mydb.`THNTS`\n" +
+			"     │                               │           │   │   │       └─ columns: [id ixuxu]\n" +
+			"     │                               │           │   │   └─ TableAlias(mf)\n" +
+			"     │                               │           │   │       └─ IndexedTableAccess(mydb.`HGMQ6`)\n" +
+			"     │                               │           │   │           ├─ index: `GXLUB` [HGMQ6.GXLUB]\n" +
+			"     │                               │           │   │           └─ columns: [gxlub luevy m22qn]\n" +
+			"     │                               │           │   └─ Filter\n" +
+			"     │                               │           │       ├─ HashIn\n" +
+			"     │                               │           │       │   ├─ cla.FTQLQ:1!null\n" +
+			"     │                               │           │       │   └─ TUPLE(SQ1 (longtext))\n" +
+			"     │                               │           │       └─ TableAlias(cla)\n" +
+			"     │                               │           │           └─ IndexedTableAccess(mydb.`YK2GW`)\n" +
+			"     │                               │           │               ├─ index: `PRIMARY` [YK2GW.id]\n" +
+			"     │                               │           │               └─ columns: [id ftqlq]\n" +
+			"     │                               │           └─ TableAlias(sn)\n" +
+			"     │                               │               └─ IndexedTableAccess(mydb.`NOXN3`)\n" +
+			"     │                               │                   ├─ index: `BRQP2` [NOXN3.BRQP2]\n" +
+			"     │                               │                   └─ columns: [id brqp2]\n" +
+			"     │                               └─ HashLookup\n" +
+			"     │                                   ├─ source: TUPLE(cld.BDNYB:5!null, cld.M22QN:6!null)\n" +
+			"     │                                   ├─ target: TUPLE(P4PJZ.LWQ6O:7, P4PJZ.NTOFG:6!null)\n" +
+			"     │                                   └─ CachedResults\n" +
+			"     │                                       └─ SubqueryAlias\n" +
+			"     │                                           ├─ name: `P4PJZ`\n" +
+			"     │                                           ├─ outerVisibility: false\n" +
+			"     │                                           ├─ cacheable: true\n" +
+			"     │                                           └─ Project\n" +
+			"     │                                               ├─ columns: [CASE  WHEN NOT\n" +
+			"     │                                               │   └─ MJR3D.QNI57:5 IS NULL\n" +
+			"     │                                               │   THEN Subquery\n" +
+			"     │                                               │   ├─ cacheable: false\n" +
+			"     │                                               │   └─ Project\n" +
+			"     │                                               │       ├─ columns: [ei.M6T2N:21!null]\n" +
+			"     │                                               │       └─ Filter\n" +
+			"     │                                               │           ├─ Eq\n" +
+			"     │                                               │           │   ├─ ei.id:20!null\n" +
+			"     │                                               │           │   └─ MJR3D.QNI57:5\n" +
+			"     │                                               │           └─ SubqueryAlias\n" +
+			"     │                                               │               ├─ name: ei\n" +
+			"     │                                               │               ├─ outerVisibility: true\n" +
+			"     │                                               │               ├─ cacheable: true\n" +
+			"     │                                               │               └─ Project\n" +
+			"     │                                               │                   ├─ columns: [NOXN3.id:20!null, (row_number() over ( order by NOXN3.id ASC):21!null - 1 (tinyint)) as M6T2N]\n" +
+			"     │                                               │                   └─ Window\n" +
+			"     │                                               │                       ├─ NOXN3.id:20!null\n" +
+			"     │                                               │                       ├─ row_number() over ( order by NOXN3.id ASC)\n" +
+			"     │                                               │                       └─ Table\n" +
+			"     │                                               │                           ├─ name: mydb.`NOXN3`\n" +
+			"     │                                               │                           └─ columns: [id]\n" +
+			"     │                                               │   WHEN NOT\n" +
+			"     │                                               │   └─ MJR3D.TDEIU:6 IS NULL\n" +
+			"     │                                               │   THEN Subquery\n" +
+			"     │                                               │   ├─ cacheable: false\n" +
+			"     │                                               │   └─ Project\n" +
+			"     │                                               │       ├─ columns: [ei.M6T2N:21!null]\n" +
+			"     │                                               │       └─ Filter\n" +
+			"     │                                               │           ├─ Eq\n" +
+			"     │                                               │           │   ├─ ei.id:20!null\n" +
+			"     │                                               │           │   └─ MJR3D.TDEIU:6\n" +
+			"     │                                               │           └─ SubqueryAlias\n" +
+			"     │                                               │               ├─ name: ei\n" +
+			"     │                                               │               ├─ outerVisibility: true\n" +
+			"     │                                               │               ├─ cacheable: true\n" +
+			"     │                                               │               └─ Project\n" +
+			"     │                                               │                   ├─ columns: [NOXN3.id:20!null, (row_number() over ( order by NOXN3.id ASC):21!null - 1 (tinyint)) as M6T2N]\n" +
+			"     │                                               │                   └─ Window\n" +
+			"     │                                               │                       ├─ NOXN3.id:20!null\n" +
+			"     │                                               │                       ├─ row_number() over ( order by NOXN3.id ASC)\n" +
+			"     │                                               │                       └─ Table\n" +
+			"     │                                               │                           ├─ name: mydb.`NOXN3`\n" +
+			"     │                                               │                           └─ columns: [id]\n" +
+			"     │                                               │   END as M6T2N, aac.BTXC5:8 as BTXC5, aac.id:7!null as NTOFG, sn.id:10 as LWQ6O, MJR3D.TUV25:3 as TUV25]\n" +
+			"     │                                               └─ LeftOuterJoin\n" +
+			"     │                                                   ├─ Or\n" +
+			"     │                                                   │   ├─ Or\n" +
+			"     │                                                   │   │   ├─ Or\n" +
+			"     │                                                   │   │   │   ├─ AND\n" +
+			"     │                                                   │   │   │   │   ├─ AND\n" +
+			"     │                                                   │   │   │   │   │   ├─ NOT\n" +
+			"     │                                                   │   │   │   │   │   │   └─ MJR3D.QNI57:5 IS NULL\n" +
+			"     │                                                   │   │   │   │   │   └─ Eq\n" +
+			"     │                                                   │   │   │   │   │       ├─ sn.id:10!null\n" +
+			"     │                                                   │   │   │   │   │       └─ MJR3D.QNI57:5\n" +
+			"     │                                                   │   │   │   │   └─ MJR3D.BJUF2:1 IS NULL\n" +
+			"     │                                                   │   │   │   └─ AND\n" +
+			"     │                                                   │   │   │       ├─ AND\n" +
+			"     │                                                   │   │   │       │   ├─ NOT\n" +
+			"     │                                                   │   │   │       │   │   └─ MJR3D.QNI57:5 IS NULL\n" +
+			"     │                                                   │   │   │       │   └─ InSubquery\n" +
+			"     │                                                   │   │   │       │       ├─ left: sn.id:10!null\n" +
+			"     │                                                   │   │   │       │       └─ right: Subquery\n" +
+			"     │                                                   │   │   │       │           ├─ cacheable: false\n" +
+			"     │                                                   │   │   │       │           └─ Project\n" +
+			"     │                                                   │   │   │       │               ├─ columns: [JTEHG.id:20!null]\n" +
+			"     │                                                   │   │   │       │               └─ Filter\n" +
+			"     │                                                   │   │   │       │                   ├─ Eq\n" +
+			"     │                                                   │   │   │       │                   │   ├─ JTEHG.BRQP2:21!null\n" +
+			"     │                                                   │   │   │       │                   │   └─ MJR3D.BJUF2:1\n" +
+			"     │                                                   │   │   │       │                   └─ TableAlias(`JTEHG`)\n" +
+			"     │                                                   │   │   │       │                       └─ Table\n" +
+			"     │                                                   │   │   │       │                           ├─ name: mydb.`NOXN3`\n" +
+			"     │                                                   │   │   │       │                           └─ columns: [id brqp2]\n" +
+			"     │                                                   │   │   │       └─ NOT\n" +
+			"     │                                                   │   │   │           └─ MJR3D.BJUF2:1 IS NULL\n" +
+			"     │                                                   │   │   └─ AND\n" +
+			"     │                                                   │   │       ├─ AND\n" +
+			"     │                                                   │   │       │   ├─ NOT\n" +
+			"     │                                                   │   │       │   │   └─ MJR3D.TDEIU:6 IS NULL\n" +
+			"     │                                                   │   │       │   └─ InSubquery\n" +
+			"     │                                                   │   │       │       ├─ left: sn.id:10!null\n" +
+			"     │                                                   │   │       │       └─ right: Subquery\n" +
+			"     │                                                   │   │       │           ├─ cacheable: false\n" +
+			"     │                                                   │   │       │           └─ Project\n" +
+			"     │                                                   │   │       │               ├─ columns: [XMAFZ.id:20!null]\n" +
+			"     │                                                   │   │       │               └─ Filter\n" +
+			"     │                                                   │   │       │                   ├─ Eq\n" +
+			"     │                                                   │   │       │                   │   ├─ XMAFZ.BRQP2:21!null\n" +
+			"     │                                                   │   │       │                   │   └─ MJR3D.FJDP5:0!null\n" +
+			"     │                                                   │   │       │                   └─ TableAlias(`XMAFZ`)\n" +
+			"     │                                                   │   │       │                       └─ Table\n" +
+			"     │                                                   │   │       │                           ├─ name: mydb.`NOXN3`\n" +
+			"     │                                                   │   │       │                           └─ columns: [id brqp2]\n" +
+			"     │                                                   │   │       └─ MJR3D.BJUF2:1 IS NULL\n" +
+			"     │                                                   │   └─ AND\n" +
+			"     │                                                   │       ├─ AND\n" +
+			"     │                                                   │       │   ├─ NOT\n" +
+			"     │                                                   │       │   │   └─ MJR3D.TDEIU:6 IS NULL\n" +
+			"     │                                                   │       │   └─ InSubquery\n" +
+			"     │                                                   │       │       ├─ left: sn.id:10!null\n" +
+			"     │                                                   │       │       └─ right: Subquery\n" +
+			"     │                                                   │       │           ├─ cacheable: false\n" +
+			"     │                                                   │       │           └─ Project\n" +
+			"     │                                                   │       │               ├─ columns: [XMAFZ.id:20!null]\n" +
+			"     │                                                   │       │               └─ Filter\n" +
+			"     │                                                   │       │                   ├─ Eq\n" +
+			"     │                                                   │       │                   │   ├─ XMAFZ.BRQP2:21!null\n" +
+			"     │                                                   │       │                   │   └─ MJR3D.BJUF2:1\n" +
+			"     │                                                   │       │                   └─ TableAlias(`XMAFZ`)\n" +
+			"     │                                                   │       │                       └─ Table\n" +
+			"     │                                                   │       │                           ├─ name: mydb.`NOXN3`\n" +
+			"     │                                                   │       │                           └─ columns: [id brqp2]\n" +
+			"     │                                                   │       └─ NOT\n" +
+			"     │                                                   │           └─ MJR3D.BJUF2:1 IS NULL\n" +
+			"     │                                                   ├─ LookupJoin\n" +
+			"     │                                                   │   ├─ Eq\n" +
+			"     │                                                   │   │   ├─ aac.id:7!null\n" +
+			"     │                                                   │   │   └─ MJR3D.M22QN:2!null\n" +
+			"     │                                                   │   ├─ SubqueryAlias\n" +
+			"     │                                                   │   │   ├─ name: `MJR3D`\n" +
+			"     │                                                   │   │   ├─ outerVisibility: false\n" +
+			"     │                                                   │   │   ├─ cacheable: true\n" +
+			"     │                                                   │   │   └─ Distinct\n" +
+			"     │                                                   │   │       └─ Project\n" +
+			"     │                                                   │   │           ├─ columns: [ism.FV24E:9!null as FJDP5, CPMFE.id:27 as BJUF2, ism.M22QN:11!null as M22QN, G3YXS.TUV25:5 as TUV25, G3YXS.ESFVY:1!null as ESFVY, YQIF4.id:44 as QNI57, YVHJZ.id:54 as TDEIU]\n" +
+			"     │                                                   │   │           └─ Filter\n" +
+			"     │                                                   │   │               ├─ Or\n" +
+			"     │                                                   │   │               │   ├─ NOT\n" +
+			"     │                                                   │   │               │   │   └─ YQIF4.id:44 IS NULL\n" +
+			"     │                                                   │   │               │   └─ NOT\n" +
+			"     │                                                   │   │               │       └─ YVHJZ.id:54 IS NULL\n" +
+			"     │                                                   │   │               └─ LeftOuterLookupJoin\n" +
+			"     │                                                   │   │                   ├─ AND\n" +
+			"     │                                                   │   │                   │   ├─ Eq\n" +
+			"     │                                                   │   │                   │   │   ├─ YVHJZ.BRQP2:55!null\n" +
+			"     │                                                   │   │                   │   │   └─ ism.UJ6XY:10!null\n" +
+			"     │                                                   │   │                   │   └─ Eq\n" +
+			"     │                                                   │   │                   │       ├─ YVHJZ.FFTBJ:56!null\n" +
+			"     │                                                   │   │                   │       └─ ism.FV24E:9!null\n" +
+			"     │                                                   │   │                   ├─ LeftOuterLookupJoin\n" +
+			"     │                                                   │   │                   │   ├─ AND\n" +
+			"     │                                                   │   │                   │   │   ├─ Eq\n" +
+			"     │                                                   │   │                   │   │   │   ├─ YQIF4.BRQP2:45!null\n" +
+			"     │                                                   │   │                   │   │   │   └─ ism.FV24E:9!null\n" +
+			"     │                                                   │   │                   │   │   └─ Eq\n" +
+			"     │                                                   │   │                   │   │       ├─ YQIF4.FFTBJ:46!null\n" +
+			"     │                                                   │   │                   │   │       └─ ism.UJ6XY:10!null\n" +
+			"     │                                                   │   │                   │   ├─ LeftOuterLookupJoin\n" +
+			"     │                                                   │   │                   │   │   ├─ AND\n" +
+			"     │                                                   │   │                   │   │   │   ├─ Eq\n" +
+			"     │                                                   │   │                   │   │   │   │   ├─ CPMFE.ZH72S:34\n" +
+			"     │                                                   │   │                   │   │   │   │   └─ NHMXW.NOHHR:18\n" +
+			"     │                                                   │   │                   │   │   │   └─ NOT\n" +
+			"     │                                                   │   │                   │   │   │       └─ Eq\n" +
+			"     │                                                   │   │                   │   │   │           ├─ CPMFE.id:27!null\n" +
+			"     │                                                   │   │                   │   │   │           └─ ism.FV24E:9!null\n" +
+			"     │                                                   │   │                   │   │   ├─ LeftOuterLookupJoin\n" +
+			"     │                                                   │   │                   │   │   │   ├─ Eq\n" +
+			"     │                                                   │   │                   │   │   │   │   ├─ NHMXW.id:17!null\n" +
+			"     │                                                   │   │                   │   │   │   │   └─ ism.PRUV2:14\n" +
+			"     │                                                   │   │                   │   │   │   ├─ LookupJoin\n" +
+			"     │                                                   │   │                   │   │   │   │   ├─ Eq\n" +
+			"     │                                                   │   │                   │   │   │   │   │   ├─ G3YXS.id:0!null\n" +
+			"     │                                                   │   │                   │   │   │   │   │   └─ ism.NZ4MQ:12!null\n" +
+			"     │                                                   │   │                   │   │   │   │   ├─ Filter\n" +
+			"     │                                                   │   │                   │   │   │   │   │   ├─ NOT\n" +
+			"     │                                                   │   │                   │   │   │   │   │   │   └─ G3YXS.TUV25:5 IS NULL\n" +
+			"     │                                                   │   │                   │   │   │   │   │   └─ TableAlias(`G3YXS`)\n" +
+			"     │                                                   │   │                   │   │   │   │   │       └─ Table\n" +
+			"     │                                                   │   │                   │   │   │   │   │           ├─ name: mydb.`YYBCX`\n" +
+			"     │                                                   │   │                   │   │   │   │   │           └─ columns: [id esfvy sl76b ge5el f7a4q tuv25 ykssu fhcyt]\n" +
+			"     │                                                   │   │                   │   │   │   │   └─ TableAlias(ism)\n" +
+			"     │                                                   │   │                   │   │   │   │       └─ IndexedTableAccess(mydb.`HDDVB`)\n" +
+			"     │                                                   │   │                   │   │   │   │           ├─ index: `NZ4MQ` [HDDVB.NZ4MQ]\n" +
+			"     │                                                   │   │                   │   │   │   │           └─ columns: [id fv24e uj6xy m22qn nz4mq etpqv pruv2 ykssu fhcyt]\n" +
+			"     │                                                   │   │                   │   │   │   └─ TableAlias(`NHMXW`)\n" +
+			"     │                                                   │   │                   │   │   │       └─ IndexedTableAccess(mydb.`WGSDC`)\n" +
+			"     │                                                   │   │                   │   │   │           ├─ index: `PRIMARY` [WGSDC.id]\n" +
+			"     │                                                   │   │                   │   │   │           └─ columns: [id nohhr avpyf sypkf idut2 fzxv5 dqygv swcqv ykssu fhcyt]\n" +
+			"     │                                                   │   │                   │   │   └─ TableAlias(`CPMFE`)\n" +
+			"     │                                                   │   │                   │   │       └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			"     │                                                   │   │                   │   │           ├─ index: `URBSA` [E2I7U.ZH72S]\n" +
+			"     │                                                   │   │                   │   │           └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
+			"     │                                                   │   │                   │   └─ TableAlias(`YQIF4`)\n" +
+			"     │                                                   │   │                   │       └─ IndexedTableAccess(mydb.`NOXN3`)\n" +
+			"     │                                                   │   │                   │           ├─ index: `BRQP2` [NOXN3.BRQP2]\n" +
+			"     │                                                   │   │                   │           └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			"     │                                                   │   │                   └─ TableAlias(`YVHJZ`)\n" +
+			"     │                                                   │   │                       └─ IndexedTableAccess(mydb.`NOXN3`)\n" +
+			"     │                                                   │   │                           ├─ index: `BRQP2` [NOXN3.BRQP2]\n" +
+			"     │                                                   │   │                           └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			"     │                                                   │   └─ TableAlias(aac)\n" +
+			"     │                                                   │       └─ IndexedTableAccess(mydb.`TPXBU`)\n" +
+			"     │                                                   │           ├─ index: `PRIMARY` [TPXBU.id]\n" +
+			"     │                                                   │           └─ columns: [id btxc5 fhcyt]\n" +
+			"     │                                                   └─ TableAlias(sn)\n" +
+			"     │                                                       └─ Table\n" +
+			"     │                                                           ├─ name: mydb.`NOXN3`\n" +
+			"     │                                                           └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
 			"     └─ SubqueryAlias\n" +
-			"         ├─ name: scalarSubq0\n" +
+			"         ├─ name: fs\n" +
 			"         ├─ outerVisibility: false\n" +
 			"         ├─ cacheable: true\n" +
-			"         └─ SubqueryAlias\n" +
-			"             ├─ name: ZMSPR\n" +
-			"             ├─ outerVisibility: true\n" +
-			"             ├─ cacheable: true\n" +
-			"             └─ Distinct\n" +
-			"                 └─ Project\n" +
-			"                     ├─ columns: [cld.T4IBQ:0!null as T4IBQ, P4PJZ.M6T2N:3 as M6T2N, P4PJZ.BTXC5:4 as BTXC5, P4PJZ.TUV25:7 as TUV25]\n" +
-			"                     └─ Filter\n" +
-			"                         ├─ NOT\n" +
-			"                         │   └─ P4PJZ.M6T2N:3 IS NULL\n" +
-			"                         └─ LeftOuterHashJoin\n" +
-			"                             ├─ AND\n" +
-			"                             │   ├─ Eq\n" +
-			"                             │   │   ├─ P4PJZ.LWQ6O:6\n" +
-			"                             │   │   └─ cld.BDNYB:1!null\n" +
-			"                             │   └─ Eq\n" +
-			"                             │       ├─ P4PJZ.NTOFG:5!null\n" +
-			"                             │       └─ cld.M22QN:2!null\n" +
-			"                             ├─ SubqueryAlias\n" +
-			"                             │   ├─ name: cld\n" +
-			"                             │   ├─ outerVisibility: false\n" +
-			"                             │   ├─ cacheable: true\n" +
-			"                             │   └─ Project\n" +
-			"                             │       ├─ columns: [cla.FTQLQ:6!null as T4IBQ, sn.id:7!null as BDNYB, mf.M22QN:4!null as M22QN]\n" +
-			"                             │       └─ LookupJoin\n" +
-			"                             │           ├─ Eq\n" +
-			"                             │           │   ├─ sn.BRQP2:8!null\n" +
-			"                             │           │   └─ mf.LUEVY:3!null\n" +
-			"                             │           ├─ LookupJoin\n" +
-			"                             │           │   ├─ Eq\n" +
-			"                             │           │   │   ├─ cla.id:5!null\n" +
-			"                             │           │   │   └─ bs.IXUXU:1\n" +
-			"                             │           │   ├─ LookupJoin\n" +
-			"                             │           │   │   ├─ Eq\n" +
-			"                             │           │   │   │   ├─ bs.id:0!null\n" +
-			"                             │           │   │   │   └─ mf.GXLUB:2!null\n" +
-			"                             │           │   │   ├─ TableAlias(bs)\n" +
-			"                             │           │   │   │   └─ Table\n" +
-			"                             │           │   │   │       ├─ name: THNTS\n" +
-			"                             │           │   │   │       └─ columns: [id ixuxu]\n" +
-			"                             │           │   │   └─ TableAlias(mf)\n" +
-			"                             │           │   │       └─ IndexedTableAccess(HGMQ6)\n" +
-			"                             │           │   │           ├─ index: [HGMQ6.GXLUB]\n" +
-			"                             │           │   │           └─ columns: [gxlub luevy m22qn]\n" +
-			"                             │           │   └─ Filter\n" +
-			"                             │           │       ├─ HashIn\n" +
-			"                             │           │       │   ├─ cla.FTQLQ:1!null\n" +
-			"                             │           │       │   └─ TUPLE(SQ1 (longtext))\n" +
-			"                             │           │       └─ TableAlias(cla)\n" +
-			"                             │           │           └─ IndexedTableAccess(YK2GW)\n" +
-			"                             │           │               ├─ index: [YK2GW.id]\n" +
-			"                             │           │               └─ columns: [id ftqlq]\n" +
-			"                             │           └─ TableAlias(sn)\n" +
-			"                             │               └─ IndexedTableAccess(NOXN3)\n" +
-			"                             │                   ├─ index: [NOXN3.BRQP2]\n" +
-			"                             │                   └─ columns: [id brqp2]\n" +
-			"                             └─ HashLookup\n" +
-			"                                 ├─ source: TUPLE(cld.BDNYB:1!null, cld.M22QN:2!null)\n" +
-			"                                 ├─ target: TUPLE(P4PJZ.LWQ6O:3, P4PJZ.NTOFG:2!null)\n" +
-			"                                 └─ CachedResults\n" +
-			"                                     └─ SubqueryAlias\n" +
-			"                                         ├─ name: P4PJZ\n" +
-			"                                         ├─ outerVisibility: false\n" +
-			"                                         ├─ cacheable: true\n" +
-			"                                         └─ Project\n" +
-			"                                             ├─ columns: [CASE  WHEN NOT\n" +
-			"                                             │   └─ MJR3D.QNI57:5 IS NULL\n" +
-			"                                             │   THEN Subquery\n" +
-			"                                             │   ├─ cacheable: false\n" +
-			"                                             │   └─ Project\n" +
-			"                                             │       ├─ columns: [ei.M6T2N:21!null]\n" +
-			"                                             │       └─ Filter\n" +
-			"                                             │           ├─ Eq\n" +
-			"                                             │           │   ├─ ei.id:20!null\n" +
-			"                                             │           │   └─ MJR3D.QNI57:5\n" +
-			"                                             │           └─ SubqueryAlias\n" +
-			"                                             │               ├─ name: ei\n" +
-			"                                             │               ├─ outerVisibility: true\n" +
-			"                                             │               ├─ cacheable: true\n" +
-			"                                             │               └─ Project\n" +
-			"                                             │                   ├─ columns: [NOXN3.id:20!null, (row_number() over ( order by NOXN3.id ASC):21!null - 1 (tinyint)) as M6T2N]\n" +
-			"                                             │                   └─ Window\n" +
-			"                                             │                       ├─ NOXN3.id:20!null\n" +
-			"                                             │                       ├─ row_number() over ( order by NOXN3.id ASC)\n" +
-			"                                             │                       └─ Table\n" +
-			"                                             │                           ├─ name: NOXN3\n" +
-			"                                             │                           └─ columns: [id]\n" +
-			"                                             │   WHEN NOT\n" +
-			"                                             │   └─ MJR3D.TDEIU:6 IS NULL\n" +
-			"                                             │   THEN Subquery\n" +
-			"                                             │   ├─ cacheable: false\n" +
-			"                                             │   └─ Project\n" +
-			"                                             │       ├─ columns: [ei.M6T2N:21!null]\n" +
-			"                                             │       └─ Filter\n" +
-			"                                             │           ├─ Eq\n" +
-			"                                             │           │   ├─ ei.id:20!null\n" +
-			"                                             │           │   └─ MJR3D.TDEIU:6\n" +
-			"                                             │           └─ SubqueryAlias\n" +
-			"                                             │               ├─ name: ei\n" +
-			"                                             │               ├─ outerVisibility: true\n" +
-			"                                             │               ├─ cacheable: true\n" +
-			"                                             │               └─ Project\n" +
-			"                                             │                   ├─ columns: [NOXN3.id:20!null, (row_number() over ( order by NOXN3.id ASC):21!null - 1 (tinyint)) as M6T2N]\n" +
-			"                                             │                   └─ Window\n" +
-			"                                             │                       ├─ NOXN3.id:20!null\n" +
-			"                                             │                       ├─ row_number() over ( order by NOXN3.id ASC)\n" +
-			"                                             │                       └─ Table\n" +
-			"                                             │                           ├─ name: NOXN3\n" +
-			"                                             │                           └─ columns: [id]\n" +
-			"                                             │   END as M6T2N, aac.BTXC5:8 as BTXC5, aac.id:7!null as NTOFG, sn.id:10 as LWQ6O, MJR3D.TUV25:3 as TUV25]\n" +
-			"                                             └─ LeftOuterJoin\n" +
-			"                                                 ├─ Or\n" +
-			"                                                 │   ├─ Or\n" +
-			"                                                 │   │   ├─ Or\n" +
-			"                                                 │   │   │   ├─ AND\n" +
-			"                                                 │   │   │   │   ├─ AND\n" +
-			"                                                 │   │   │   │   │   ├─ NOT\n" +
-			"                                                 │   │   │   │   │   │   └─ MJR3D.QNI57:5 IS NULL\n" +
-			"                                                 │   │   │   │   │   └─ Eq\n" +
-			"                                                 │   │   │   │   │       ├─ sn.id:10!null\n" +
-			"                                                 │   │   │   │   │       └─ MJR3D.QNI57:5\n" +
-			"                                                 │   │   │   │   └─ MJR3D.BJUF2:1 IS NULL\n" +
-			"                                                 │   │   │   └─ AND\n" +
-			"                                                 │   │   │       ├─ AND\n" +
-			"                                                 │   │   │       │   ├─ NOT\n" +
-			"                                                 │   │   │       │   │   └─ MJR3D.QNI57:5 IS NULL\n" +
-			"                                                 │   │   │       │   └─ InSubquery\n" +
-			"                                                 │   │   │       │       ├─ left: sn.id:10!null\n" +
-			"                                                 │   │   │       │       └─ right: Subquery\n" +
-			"                                                 │   │   │       │           ├─ cacheable: false\n" +
-			"                                                 │   │   │       │           └─ Project\n" +
-			"                                                 │   │   │       │               ├─ columns: [JTEHG.id:20!null]\n" +
-			"                                                 │   │   │       │               └─ Filter\n" +
-			"                                                 │   │   │       │                   ├─ Eq\n" +
-			"                                                 │   │   │       │                   │   ├─ JTEHG.BRQP2:21!null\n" +
-			"                                                 │   │   │       │                   │   └─ MJR3D.BJUF2:1\n" +
-			"                                                 │   │   │       │                   └─ TableAlias(JTEHG)\n" +
-			"                                                 │   │   │       │                       └─ Table\n" +
-			"                                                 │   │   │       │                           ├─ name: NOXN3\n" +
-			"                                                 │   │   │       │                           └─ columns: [id brqp2]\n" +
-			"                                                 │   │   │       └─ NOT\n" +
-			"                                                 │   │   │           └─ MJR3D.BJUF2:1 IS NULL\n" +
-			"                                                 │   │   └─ AND\n" +
-			"                                                 │   │       ├─ AND\n" +
-			"                                                 │   │       │   ├─ NOT\n" +
-			"                                                 │   │       │   │   └─ MJR3D.TDEIU:6 IS NULL\n" +
-			"                                                 │   │       │   └─ InSubquery\n" +
-			"                                                 │   │       │       ├─ left: sn.id:10!null\n" +
-			"                                                 │   │       │       └─ right: Subquery\n" +
-			"                                                 │   │       │           ├─ cacheable: false\n" +
-			"                                                 │   │       │           └─ Project\n" +
-			"                                                 │   │       │               ├─ columns: [XMAFZ.id:20!null]\n" +
-			"                                                 │   │       │               └─ Filter\n" +
-			"                                                 │   │       │                   ├─ Eq\n" +
-			"                                                 │   │       │                   │   ├─ XMAFZ.BRQP2:21!null\n" +
-			"                                                 │   │       │                   │   └─ MJR3D.FJDP5:0!null\n" +
-			"                                                 │   │       │                   └─ TableAlias(XMAFZ)\n" +
-			"                                                 │   │       │                       └─ Table\n" +
-			"                                                 │   │       │                           ├─ name: NOXN3\n" +
-			"                                                 │   │       │                           └─ columns: [id brqp2]\n" +
-			"                                                 │   │       └─ MJR3D.BJUF2:1 IS NULL\n" +
-			"                                                 │   └─ AND\n" +
-			"                                                 │       ├─ AND\n" +
-			"                                                 │       │   ├─ NOT\n" +
-			"                                                 │       │   │   └─ MJR3D.TDEIU:6 IS NULL\n" +
-			"                                                 │       │   └─ InSubquery\n" +
-			"                                                 │       │       ├─ left: sn.id:10!null\n" +
-			"                                                 │       │       └─ right: Subquery\n" +
-			"                                                 │       │           ├─ cacheable: false\n" +
-			"                                                 │       │           └─ Project\n" +
-			"                                                 │       │               ├─ columns: [XMAFZ.id:20!null]\n" +
-			"                                                 │       │               └─ Filter\n" +
-			"                                                 │       │                   ├─ Eq\n" +
-			"                                                 │       │                   │   ├─ XMAFZ.BRQP2:21!null\n" +
-			"                                                 │       │                   │   └─ MJR3D.BJUF2:1\n" +
-			"                                                 │       │                   └─ TableAlias(XMAFZ)\n" +
-			"                                                 │       │                       └─ Table\n" +
-			"                                                 │       │                           ├─ name: NOXN3\n" +
-			"                                                 │       │                           └─ columns: [id brqp2]\n" +
-			"                                                 │       └─ NOT\n" +
-			"                                                 │           └─ MJR3D.BJUF2:1 IS NULL\n" +
-			"                                                 ├─ LookupJoin\n" +
-			"                                                 │   ├─ Eq\n" +
-			"                                                 │   │   ├─ aac.id:7!null\n" +
-			"                                                 │   │   └─ MJR3D.M22QN:2!null\n" +
-			"                                                 │   ├─ SubqueryAlias\n" +
-			"                                                 │   │   ├─ name: MJR3D\n" +
-			"                                                 │   │   ├─ outerVisibility: false\n" +
-			"                                                 │   │   ├─ cacheable: true\n" +
-			"                                                 │   │   └─ Distinct\n" +
-			"                                                 │   │       └─ Project\n" +
-			"                                                 │   │           ├─ columns: [ism.FV24E:9!null as FJDP5, CPMFE.id:27 as BJUF2, ism.M22QN:11!null as M22QN, G3YXS.TUV25:5 as TUV25, G3YXS.ESFVY:1!null as ESFVY, YQIF4.id:44 as QNI57, YVHJZ.id:54 as TDEIU]\n" +
-			"                                                 │   │           └─ Filter\n" +
-			"                                                 │   │               ├─ Or\n" +
-			"                                                 │   │               │   ├─ NOT\n" +
-			"                                                 │   │               │   │   └─ YQIF4.id:44 IS NULL\n" +
-			"                                                 │   │               │   └─ NOT\n" +
-			"                                                 │   │               │       └─ YVHJZ.id:54 IS NULL\n" +
-			"                                                 │   │               └─ LeftOuterLookupJoin\n" +
-			"                                                 │   │                   ├─ AND\n" +
-			"                                                 │   │                   │   ├─ Eq\n" +
-			"                                                 │   │                   │   │   ├─ YVHJZ.BRQP2:55!null\n" +
-			"                                                 │   │                   │   │   └─ ism.UJ6XY:10!null\n" +
-			"                                                 │   │                   │   └─ Eq\n" +
-			"                                                 │   │                   │       ├─ YVHJZ.FFTBJ:56!null\n" +
-			"                                                 │   │                   │       └─ ism.FV24E:9!null\n" +
-			"                                                 │   │                   ├─ LeftOuterLookupJoin\n" +
-			"                                                 │   │                   │   ├─ AND\n" +
-			"                                                 │   │                   │   │   ├─ Eq\n" +
-			"                                                 │   │                   │   │   │   ├─ YQIF4.BRQP2:45!null\n" +
-			"                                                 │   │                   │   │   │   └─ ism.FV24E:9!null\n" +
-			"                                                 │   │                   │   │   └─ Eq\n" +
-			"                                                 │   │                   │   │       ├─ YQIF4.FFTBJ:46!null\n" +
-			"                                                 │   │                   │   │       └─ ism.UJ6XY:10!null\n" +
-			"                                                 │   │                   │   ├─ LeftOuterLookupJoin\n" +
-			"                                                 │   │                   │   │   ├─ AND\n" +
-			"                                                 │   │                   │   │   │   ├─ Eq\n" +
-			"                                                 │   │                   │   │   │   │   ├─ CPMFE.ZH72S:34\n" +
-			"                                                 │   │                   │   │   │   │   └─ NHMXW.NOHHR:18\n" +
-			"                                                 │   │                   │   │   │   └─ NOT\n" +
-			"                                                 │   │                   │   │   │       └─ Eq\n" +
-			"                                                 │   │                   │   │   │           ├─ CPMFE.id:27!null\n" +
-			"                                                 │   │                   │   │   │           └─ ism.FV24E:9!null\n" +
-			"                                                 │   │                   │   │   ├─ LeftOuterLookupJoin\n" +
-			"                                                 │   │                   │   │   │   ├─ Eq\n" +
-			"                                                 │   │                   │   │   │   │   ├─ NHMXW.id:17!null\n" +
-			"                                                 │   │                   │   │   │   │   └─ ism.PRUV2:14\n" +
-			"                                                 │   │                   │   │   │   ├─ LookupJoin\n" +
-			"                                                 │   │                   │   │   │   │   ├─ Eq\n" +
-			"                                                 │   │                   │   │   │   │   │   ├─ G3YXS.id:0!null\n" +
-			"                                                 │   │                   │   │   │   │   │   └─ ism.NZ4MQ:12!null\n" +
-			"                                                 │   │                   │   │   │   │   ├─ Filter\n" +
-			"                                                 │   │                   │   │   │   │   │   ├─ NOT\n" +
-			"                                                 │   │                   │   │   │   │   │   │   └─ G3YXS.TUV25:5 IS NULL\n" +
-			"                                                 │   │                   │   │   │   │   │   └─ TableAlias(G3YXS)\n" +
-			"                                                 │   │                   │   │   │   │   │       └─ Table\n" +
-			"                                                 │   │                   │   │   │   │   │           ├─ name: YYBCX\n" +
-			"                                                 │   │                   │   │   │   │   │           └─ columns: [id esfvy sl76b ge5el f7a4q tuv25 ykssu fhcyt]\n" +
-			"                                                 │   │                   │   │   │   │   └─ TableAlias(ism)\n" +
-			"                                                 │   │                   │   │   │   │       └─ IndexedTableAccess(HDDVB)\n" +
-			"                                                 │   │                   │   │   │   │           ├─ index: [HDDVB.NZ4MQ]\n" +
-			"                                                 │   │                   │   │   │   │           └─ columns: [id fv24e uj6xy m22qn nz4mq etpqv pruv2 ykssu fhcyt]\n" +
-			"                                                 │   │                   │   │   │   └─ TableAlias(NHMXW)\n" +
-			"                                                 │   │                   │   │   │       └─ IndexedTableAccess(WGSDC)\n" +
-			"                                                 │   │                   │   │   │           ├─ index: [WGSDC.id]\n" +
-			"                                                 │   │                   │   │   │           └─ columns: [id nohhr avpyf sypkf idut2 fzxv5 dqygv swcqv ykssu fhcyt]\n" +
-			"                                                 │   │                   │   │   └─ TableAlias(CPMFE)\n" +
-			"                                                 │   │                   │   │       └─ IndexedTableAccess(E2I7U)\n" +
-			"                                                 │   │                   │   │           ├─ index: [E2I7U.ZH72S]\n" +
-			"                                                 │   │                   │   │           └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
-			"                                                 │   │                   │   └─ TableAlias(YQIF4)\n" +
-			"                                                 │   │                   │       └─ IndexedTableAccess(NOXN3)\n" +
-			"                                                 │   │                   │           ├─ index: [NOXN3.BRQP2]\n" +
-			"                                                 │   │                   │           └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
-			"                                                 │   │                   └─ TableAlias(YVHJZ)\n" +
-			"                                                 │   │                       └─ IndexedTableAccess(NOXN3)\n" +
-			"                                                 │   │                           ├─ index: [NOXN3.BRQP2]\n" +
-			"                                                 │   │                           └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
-			"                                                 │   └─ TableAlias(aac)\n" +
-			"                                                 │       └─ IndexedTableAccess(TPXBU)\n" +
-			"                                                 │           ├─ index: [TPXBU.id]\n" +
-			"                                                 │           └─ columns: [id btxc5 fhcyt]\n" +
-			"                                                 └─ TableAlias(sn)\n" +
-			"                                                     └─ Table\n" +
-			"                                                         ├─ name: NOXN3\n" +
-			"                                                         └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			"         └─ Project\n" +
+			"             ├─ columns: [RSA3Y.T4IBQ:3!null as T4IBQ, JMHIE.M6T2N:0 as M6T2N, JMHIE.BTXC5:1 as BTXC5, JMHIE.TUV25:2 as TUV25]\n" +
+			"             └─ CrossJoin\n" +
+			"                 ├─ SubqueryAlias\n" +
+			"                 │   ├─ name: `JMHIE`\n" +
+			"                 │   ├─ outerVisibility: false\n" +
+			"                 │   ├─ cacheable: true\n" +
+			"                 │   └─ Distinct\n" +
+			"                 │       └─ Project\n" +
+			"                 │           ├─ columns: [JQHRG.M6T2N:0, JQHRG.BTXC5:1, JQHRG.TUV25:4]\n" +
+			"                 │           └─ SubqueryAlias\n" +
+			"                 │               ├─ name: `JQHRG`\n" +
+			"                 │               ├─ outerVisibility: false\n" +
+			"                 │               ├─ cacheable: true\n" +
+			"                 │               └─ Project\n" +
+			"                 │                   ├─ columns: [CASE  WHEN NOT\n" +
+			"                 │                   │   └─ MJR3D.QNI57:5 IS NULL\n" +
+			"                 │                   │   THEN Subquery\n" +
+			"                 │                   │   ├─ cacheable: false\n" +
+			"                 │                   │   └─ Project\n" +
+			"                 │                   │       ├─ columns: [ei.M6T2N:21!null]\n" +
+			"                 │                   │       └─ Filter\n" +
+			"                 │                   │           ├─ Eq\n" +
+			"                 │                   │           │   ├─ ei.id:20!null\n" +
+			"                 │                   │           │   └─ MJR3D.QNI57:5\n" +
+			"                 │                   │           └─ SubqueryAlias\n" +
+			"                 │                   │               ├─ name: ei\n" +
+			"                 │                   │               ├─ outerVisibility: true\n" +
+			"                 │                   │               ├─ cacheable: true\n" +
+			"                 │                   │               └─ Project\n" +
+			"                 │                   │                   ├─ columns: [NOXN3.id:20!null, (row_number() over ( order by NOXN3.id ASC):21!null - 1 (tinyint)) as M6T2N]\n" +
+			"                 │                   │                   └─ Window\n" +
+			"                 │                   │                       ├─ NOXN3.id:20!null\n" +
+			"                 │                   │                       ├─ row_number() over ( order by NOXN3.id ASC)\n" +
+			"                 │                   │                       └─ Table\n" +
+			"                 │                   │                           ├─ name: mydb.`NOXN3`\n" +
+			"                 │                   │                           └─ columns: [id]\n" +
+			"                 │                   │   WHEN NOT\n" +
+			"                 │                   │   └─ MJR3D.TDEIU:6 IS NULL\n" +
+			"                 │                   │   THEN Subquery\n" +
+			"                 │                   │   ├─ cacheable: false\n" +
+			"                 │                   │   └─ Project\n" +
+			"                 │                   │       ├─ columns: [ei.M6T2N:21!null]\n" +
+			"                 │                   │       └─ Filter\n" +
+			"                 │                   │           ├─ Eq\n" +
+			"                 │                   │           │   ├─ ei.id:20!null\n" +
+			"                 │                   │           │   └─ MJR3D.TDEIU:6\n" +
+			"                 │                   │           └─ SubqueryAlias\n" +
+			"                 │                   │               ├─ name: ei\n" +
+			"                 │                   │               ├─ outerVisibility: true\n" +
+			"                 │                   │               ├─ cacheable: true\n" +
+			"                 │                   │               └─ Project\n" +
+			"                 │                   │                   ├─ columns: [NOXN3.id:20!null, (row_number() over ( order by NOXN3.id ASC):21!null - 1 (tinyint)) as M6T2N]\n" +
+			"                 │                   │                   └─ Window\n" +
+			"                 │                   │                       ├─ NOXN3.id:20!null\n" +
+			"                 │                   │                       ├─ row_number() over ( order by NOXN3.id ASC)\n" +
+			"                 │                   │                       └─ Table\n" +
+			"                 │                   │                           ├─ name: mydb.`NOXN3`\n" +
+			"                 │                   │                           └─ columns: [id]\n" +
+			"                 │                   │   END as M6T2N, aac.BTXC5:8 as BTXC5, aac.id:7!null as NTOFG, sn.id:10 as LWQ6O, MJR3D.TUV25:3 as TUV25]\n" +
+			"                 │                   └─ LeftOuterJoin\n" +
+			"                 │                       ├─ Or\n" +
+			"                 │                       │   ├─ Or\n" +
+			"                 │                       │   │   ├─ Or\n" +
+			"                 │                       │   │   │   ├─ AND\n" +
+			"                 │                       │   │   │   │   ├─ AND\n" +
+			"                 │                       │   │   │   │   │   ├─ NOT\n" +
+			"                 │                       │   │   │   │   │   │   └─ MJR3D.QNI57:5 IS NULL\n" +
+			"                 │                       │   │   │   │   │   └─ Eq\n" +
+			"                 │                       │   │   │   │   │       ├─ sn.id:10!null\n" +
+			"                 │                       │   │   │   │   │       └─ MJR3D.QNI57:5\n" +
+			"                 │                       │   │   │   │   └─ MJR3D.BJUF2:1 IS NULL\n" +
+			"                 │                       │   │   │   └─ AND\n" +
+			"                 │                       │   │   │       ├─ AND\n" +
+			"                 │                       │   │   │       │   ├─ NOT\n" +
+			"                 │                       │   │   │       │   │   └─ MJR3D.QNI57:5 IS NULL\n" +
+			"                 │                       │   │   │       │   └─ InSubquery\n" +
+			"                 │                       │   │   │       │       ├─ left: sn.id:10!null\n" +
+			"                 │                       │   │   │       │       └─ right: Subquery\n" +
+			"                 │                       │   │   │       │           ├─ cacheable: false\n" +
+			"                 │                       │   │   │       │           └─ Project\n" +
+			"                 │                       │   │   │       │               ├─ columns: [JTEHG.id:20!null]\n" +
+			"                 │                       │   │   │       │               └─ Filter\n" +
+			"                 │                       │   │   │       │                   ├─ Eq\n" +
+			"                 │                       │   │   │       │                   │   ├─ JTEHG.BRQP2:21!null\n" +
+			"                 │                       │   │   │       │                   │   └─ MJR3D.BJUF2:1\n" +
+			"                 │                       │   │   │       │                   └─ TableAlias(`JTEHG`)\n" +
+			"                 │                       │   │   │       │                       └─ Table\n" +
+			"                 │                       │   │   │       │                           ├─ name: mydb.`NOXN3`\n" +
+			"                 │                       │   │   │       │                           └─ columns: [id brqp2]\n" +
+			"                 │                       │   │   │       └─ NOT\n" +
+			"                 │                       │   │   │           └─ MJR3D.BJUF2:1 IS NULL\n" +
+			"                 │                       │   │   └─ AND\n" +
+			"                 │                       │   │       ├─ AND\n" +
+			"                 │                       │   │       │   ├─ NOT\n" +
+			"                 │                       │   │       │   │   └─ MJR3D.TDEIU:6 IS NULL\n" +
+			"                 │                       │   │       │   └─ InSubquery\n" +
+			"                 │                       │   │       │       ├─ left: sn.id:10!null\n" +
+			"                 │                       │   │       │       └─ right: Subquery\n" +
+			"                 │                       │   │       │           ├─ cacheable: false\n" +
+			"                 │                       │   │       │           └─ Project\n" +
+			"                 │                       │   │       │               ├─ columns: [XMAFZ.id:20!null]\n" +
+			"                 │                       │   │       │               └─ Filter\n" +
+			"                 │                       │   │       │                   ├─ Eq\n" +
+			"                 │                       │   │       │                   │   ├─ XMAFZ.BRQP2:21!null\n" +
+			"                 │                       │   │       │                   │   └─ MJR3D.FJDP5:0!null\n" +
+			"                 │                       │   │       │                   └─ TableAlias(`XMAFZ`)\n" +
+			"                 │                       │   │       │                       └─ Table\n" +
+			"                 │                       │   │       │                           ├─ name: mydb.`NOXN3`\n" +
+			"                 │                       │   │       │                           └─ columns: [id brqp2]\n" +
+			"                 │                       │   │       └─ MJR3D.BJUF2:1 IS NULL\n" +
+			"                 │                       │   └─ AND\n" +
+			"                 │                       │       ├─ AND\n" +
+			"                 │                       │       │   ├─ NOT\n" +
+			"                 │                       │       │   │   └─ MJR3D.TDEIU:6 IS NULL\n" +
+			"                 │                       │       │   └─ InSubquery\n" +
+			"                 │                       │       │       ├─ left: sn.id:10!null\n" +
+			"                 │                       │       │       └─ right: Subquery\n" +
+			"                 │                       │       │           ├─ cacheable: false\n" +
+			"                 │                       │       │           └─ Project\n" +
+			"                 │                       │       │               ├─ columns: [XMAFZ.id:20!null]\n" +
+			"                 │                       │       │               └─ Filter\n" +
+			"                 │                       │       │                   ├─ Eq\n" +
+			"                 │                       │       │                   │   ├─ XMAFZ.BRQP2:21!null\n" +
+			"                 │                       │       │                   │   └─ MJR3D.BJUF2:1\n" +
+			"                 │                       │       │                   └─ TableAlias(`XMAFZ`)\n" +
+			"                 │                       │       │                       └─ Table\n" +
+			"                 │                       │       │                           ├─ name: mydb.`NOXN3`\n" +
+			"                 │                       │       │                           └─ columns: [id brqp2]\n" +
+			"                 │                       │       └─ NOT\n" +
+			"                 │                       │           └─ MJR3D.BJUF2:1 IS NULL\n" +
+			"                 │                       ├─ LookupJoin\n" +
+			"                 │                       │   ├─ Eq\n" +
+			"                 │                       │   │   ├─ aac.id:7!null\n" +
+			"                 │                       │   │   └─ MJR3D.M22QN:2!null\n" +
+			"                 │                       │   ├─ SubqueryAlias\n" +
+			"                 │                       │   │   ├─ name: `MJR3D`\n" +
+			"                 │                       │   │   ├─ outerVisibility: false\n" +
+			"                 │                       │   │   ├─ cacheable: true\n" +
+			"                 │                       │   │   └─ Distinct\n" +
+			"                 │                       │   │       └─ Project\n" +
+			"                 │                       │   │           ├─ columns: [ism.FV24E:9!null as FJDP5, CPMFE.id:27 as BJUF2, ism.M22QN:11!null as M22QN, G3YXS.TUV25:5 as TUV25, G3YXS.ESFVY:1!null as ESFVY, YQIF4.id:44 as QNI57, YVHJZ.id:54 as TDEIU]\n" +
+			"                 │                       │   │           └─ Filter\n" +
+			"                 │                       │   │               ├─ Or\n" +
+			"                 │                       │   │               │   ├─ NOT\n" +
+			"                 │                       │   │               │   │   └─ YQIF4.id:44 IS NULL\n" +
+			"                 │                       │   │               │   └─ NOT\n" +
+			"                 │                       │   │               │       └─ YVHJZ.id:54 IS NULL\n" +
+			"                 │                       │   │               └─ LeftOuterLookupJoin\n" +
+			"                 │                       │   │                   ├─ AND\n" +
+			"                 │                       │   │                   │   ├─ Eq\n" +
+			"                 │                       │   │                   │   │   ├─ YVHJZ.BRQP2:55!null\n" +
+			"                 │                       │   │                   │   │   └─ ism.UJ6XY:10!null\n" +
+			"                 │                       │   │                   │   └─ Eq\n" +
+			"                 │                       │   │                   │       ├─ YVHJZ.FFTBJ:56!null\n" +
+			"                 │                       │   │                   │       └─ ism.FV24E:9!null\n" +
+			"                 │                       │   │                   ├─ LeftOuterLookupJoin\n" +
+			"                 │                       │   │                   │   ├─ AND\n" +
+			"                 │                       │   │                   │   │   ├─ Eq\n" +
+			"                 │                       │   │                   │   │   │   ├─ YQIF4.BRQP2:45!null\n" +
+			"                 │                       │   │                   │   │   │   └─ ism.FV24E:9!null\n" +
+			"                 │                       │   │                   │   │   └─ Eq\n" +
+			"                 │                       │   │                   │   │       ├─ YQIF4.FFTBJ:46!null\n" +
+			"                 │                       │   │                   │   │       └─ ism.UJ6XY:10!null\n" +
+			"                 │                       │   │                   │   ├─ LeftOuterLookupJoin\n" +
+			"                 │                       │   │                   │   │   ├─ AND\n" +
+			"                 │                       │   │                   │   │   │   ├─ Eq\n" +
+			"                 │                       │   │                   │   │   │   │   ├─ CPMFE.ZH72S:34\n" +
+			"                 │                       │   │                   │   │   │   │   └─ NHMXW.NOHHR:18\n" +
+			"                 │                       │   │                   │   │   │   └─ NOT\n" +
+			"                 │                       │   │                   │   │   │       └─ Eq\n" +
+			"                 │                       │   │                   │   │   │           ├─ CPMFE.id:27!null\n" +
+			"                 │                       │   │                   │   │   │           └─ ism.FV24E:9!null\n" +
+			"                 │                       │   │                   │   │   ├─ LeftOuterLookupJoin\n" +
+			"                 │                       │   │                   │   │   │   ├─ Eq\n" +
+			"                 │                       │   │                   │   │   │   │   ├─ NHMXW.id:17!null\n" +
+			"                 │                       │   │                   │   │   │   │   └─ ism.PRUV2:14\n" +
+			"                 │                       │   │                   │   │   │   ├─ LookupJoin\n" +
+			"                 │                       │   │                   │   │   │   │   ├─ Eq\n" +
+			"                 │                       │   │                   │   │   │   │   │   ├─ G3YXS.id:0!null\n" +
+			"                 │                       │   │                   │   │   │   │   │   └─ ism.NZ4MQ:12!null\n" +
+			"                 │                       │   │                   │   │   │   │   ├─ Filter\n" +
+			"                 │                       │   │                   │   │   │   │   │   ├─ NOT\n" +
+			"                 │                       │   │                   │   │   │   │   │   │   └─ G3YXS.TUV25:5 IS NULL\n" +
+			"                 │                       │   │                   │   │   │   │   │   └─ TableAlias(`G3YXS`)\n" +
+			"                 │                       │   │                   │   │   │   │   │       └─ Table\n" +
+			"                 │                       │   │                   │   │   │   │   │           ├─ name: mydb.`YYBCX`\n" +
+			"                 │                       │   │                   │   │   │   │   │           └─ columns: [id esfvy sl76b ge5el f7a4q tuv25 ykssu fhcyt]\n" +
+			"                 │                       │   │                   │   │   │   │   └─ TableAlias(ism)\n" +
+			"                 │                       │   │                   │   │   │   │       └─ IndexedTableAccess(mydb.`HDDVB`)\n" +
+			"                 │                       │   │                   │   │   │   │           ├─ index: `NZ4MQ` [HDDVB.NZ4MQ]\n" +
+			"                 │                       │   │                   │   │   │   │           └─ columns: [id fv24e uj6xy m22qn nz4mq etpqv pruv2 ykssu fhcyt]\n" +
+			"                 │                       │   │                   │   │   │   └─ TableAlias(`NHMXW`)\n" +
+			"                 │                       │   │                   │   │   │       └─ IndexedTableAccess(mydb.`WGSDC`)\n" +
+			"                 │                       │   │                   │   │   │           ├─ index: `PRIMARY` [WGSDC.id]\n" +
+			"                 │                       │   │                   │   │   │           └─ columns: [id nohhr avpyf sypkf idut2 fzxv5 dqygv swcqv ykssu fhcyt]\n" +
+			"                 │                       │   │                   │   │   └─ TableAlias(`CPMFE`)\n" +
+			"                 │                       │   │                   │   │       └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			"                 │                       │   │                   │   │           ├─ index: `URBSA` [E2I7U.ZH72S]\n" +
+			"                 │                       │   │                   │   │           └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
+			"                 │                       │   │                   │   └─ TableAlias(`YQIF4`)\n" +
+			"                 │                       │   │                   │       └─ IndexedTableAccess(mydb.`NOXN3`)\n" +
+			"                 │                       │   │                   │           ├─ index: `BRQP2` [NOXN3.BRQP2]\n" +
+			"                 │                       │   │                   │           └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			"                 │                       │   │                   └─ TableAlias(`YVHJZ`)\n" +
+			"                 │                       │   │                       └─ IndexedTableAccess(mydb.`NOXN3`)\n" +
+			"                 │                       │   │                           ├─ index: `BRQP2` [NOXN3.BRQP2]\n" +
+			"                 │                       │   │                           └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			"                 │                       │   └─ TableAlias(aac)\n" +
+			"                 │                       │       └─ IndexedTableAccess(mydb.`TPXBU`)\n" +
+			"                 │                       │           ├─ index: `PRIMARY` [TPXBU.id]\n" +
+			"                 │                       │           └─ columns: [id btxc5 fhcyt]\n" +
+			"                 │                       └─ TableAlias(sn)\n" +
+			"                 │                           └─ Table\n" +
+			"                 │                               ├─ name: mydb.`NOXN3`\n" +
+			"                 │                               └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			"                 └─ SubqueryAlias\n" +
+			"                     ├─ name: `RSA3Y`\n" +
+			"                     ├─ outerVisibility: false\n" +
+			"                     ├─ cacheable: true\n" +
+			"                     └─ Distinct\n" +
+			"                         └─ Project\n" +
+			"                             ├─ columns: [HTKBS.T4IBQ:0!null]\n" +
+			"                             └─ SubqueryAlias\n" +
+			"                                 ├─ name: `HTKBS`\n" +
+			"                                 ├─ outerVisibility: false\n" +
+			"                                 ├─ cacheable: true\n" +
+			"                                 └─ Project\n" +
+			"                                     ├─ columns: [cla.FTQLQ:6!null as T4IBQ, sn.id:7!null as BDNYB, mf.M22QN:4!null as M22QN]\n" +
+			"                                     └─ LookupJoin\n" +
+			"                                         ├─ Eq\n" +
+			"                                         │   ├─ sn.BRQP2:8!null\n" +
+			"                                         │   └─ mf.LUEVY:3!null\n" +
+			"                                         ├─ LookupJoin\n" +
+			"                                         │   ├─ Eq\n" +
+			"                                         │   │   ├─ cla.id:5!null\n" +
+			"                                         │   │   └─ bs.IXUXU:1\n" +
+			"                                         │   ├─ LookupJoin\n" +
+			"                                         │   │   ├─ Eq\n" +
+			"                                         │   │   │   ├─ bs.id:0!null\n" +
+			"                                         │   │   │   └─ mf.GXLUB:2!null\n" +
+			"                                         │   │   ├─ TableAlias(bs)\n" +
+			"                                         │   │   │   └─ Table\n" +
+			"                                         │   │   │       ├─ name: mydb.`THNTS`\n" +
+			"                                         │   │   │       └─ columns: [id ixuxu]\n" +
+			"                                         │   │   └─ TableAlias(mf)\n" +
+			"                                         │   │       └─ IndexedTableAccess(mydb.`HGMQ6`)\n" +
+			"                                         │   │           ├─ index: `GXLUB` [HGMQ6.GXLUB]\n" +
+			"                                         │   │           └─ columns: [gxlub luevy m22qn]\n" +
+			"                                         │   └─ Filter\n" +
+			"                                         │       ├─ HashIn\n" +
+			"                                         │       │   ├─ cla.FTQLQ:1!null\n" +
+			"                                         │       │   └─ TUPLE(SQ1 (longtext))\n" +
+			"                                         │       └─ TableAlias(cla)\n" +
+			"                                         │           └─ IndexedTableAccess(mydb.`YK2GW`)\n" +
+			"                                         │               ├─ index: `PRIMARY` [YK2GW.id]\n" +
+			"                                         │               └─ columns: [id ftqlq]\n" +
+			"                                         └─ TableAlias(sn)\n" +
+			"                                             └─ IndexedTableAccess(mydb.`NOXN3`)\n" +
+			"                                                 ├─ index: `BRQP2` [NOXN3.BRQP2]\n" +
+			"                                                 └─ columns: [id brqp2]\n" +
 			"",
 	},
 	{
@@ -14080,8 +14592,8 @@ FROM
 ORDER BY id ASC`,
 		ExpectedPlan: "Project\n" +
 			" ├─ columns: [E2I7U.TW55N:1!null]\n" +
-			" └─ IndexedTableAccess(E2I7U)\n" +
-			"     ├─ index: [E2I7U.id]\n" +
+			" └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			"     ├─ index: `PRIMARY` [E2I7U.id]\n" +
 			"     ├─ static: [{[NULL, ∞)}]\n" +
 			"     └─ columns: [id tw55n]\n" +
 			"",
@@ -14095,8 +14607,8 @@ FROM
 ORDER BY id ASC`,
 		ExpectedPlan: "Project\n" +
 			" ├─ columns: [E2I7U.TW55N:1!null, E2I7U.FGG57:2]\n" +
-			" └─ IndexedTableAccess(E2I7U)\n" +
-			"     ├─ index: [E2I7U.id]\n" +
+			" └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			"     ├─ index: `PRIMARY` [E2I7U.id]\n" +
 			"     ├─ static: [{[NULL, ∞)}]\n" +
 			"     └─ columns: [id tw55n fgg57]\n" +
 			"",
@@ -14110,7 +14622,7 @@ SELECT COUNT(*) FROM E2I7U`,
 			"     ├─ select: COUNT(1 (bigint))\n" +
 			"     ├─ group: \n" +
 			"     └─ Table\n" +
-			"         ├─ name: E2I7U\n" +
+			"         ├─ name: mydb.`E2I7U`\n" +
 			"         └─ columns: []\n" +
 			"",
 	},
@@ -14127,7 +14639,7 @@ FROM
 			"     ├─ row_number() over ( order by E2I7U.id ASC)\n" +
 			"     ├─ E2I7U.TW55N:1!null\n" +
 			"     └─ Table\n" +
-			"         ├─ name: E2I7U\n" +
+			"         ├─ name: mydb.`E2I7U`\n" +
 			"         └─ columns: [id tw55n]\n" +
 			"",
 	},
@@ -14159,7 +14671,7 @@ ORDER BY Y46B2 ASC`,
 			"         │   ├─ XJ2RD.HHVLX:3!null\n" +
 			"         │   └─ TUSAY.XLFIA:1!null\n" +
 			"         ├─ SubqueryAlias\n" +
-			"         │   ├─ name: TUSAY\n" +
+			"         │   ├─ name: `TUSAY`\n" +
 			"         │   ├─ outerVisibility: false\n" +
 			"         │   ├─ cacheable: true\n" +
 			"         │   └─ Project\n" +
@@ -14168,20 +14680,20 @@ ORDER BY Y46B2 ASC`,
 			"         │           ├─ row_number() over ( order by NOXN3.id ASC)\n" +
 			"         │           ├─ NOXN3.id:0!null as XLFIA\n" +
 			"         │           └─ Table\n" +
-			"         │               ├─ name: NOXN3\n" +
+			"         │               ├─ name: mydb.`NOXN3`\n" +
 			"         │               └─ columns: [id]\n" +
 			"         └─ HashLookup\n" +
 			"             ├─ source: TUPLE(TUSAY.XLFIA:1!null)\n" +
 			"             ├─ target: TUPLE(XJ2RD.HHVLX:1!null)\n" +
 			"             └─ CachedResults\n" +
 			"                 └─ SubqueryAlias\n" +
-			"                     ├─ name: XJ2RD\n" +
+			"                     ├─ name: `XJ2RD`\n" +
 			"                     ├─ outerVisibility: false\n" +
 			"                     ├─ cacheable: true\n" +
 			"                     └─ Project\n" +
 			"                         ├─ columns: [QYWQD.id:0!null as Y46B2, QYWQD.HHVLX:1!null as HHVLX, QYWQD.HVHRZ:2!null as HVHRZ]\n" +
 			"                         └─ Table\n" +
-			"                             ├─ name: QYWQD\n" +
+			"                             ├─ name: mydb.`QYWQD`\n" +
 			"                             └─ columns: [id hhvlx hvhrz]\n" +
 			"",
 	},
@@ -14231,8 +14743,8 @@ ORDER BY sn.XLFIA ASC`,
 			"         │   ├─ cacheable: true\n" +
 			"         │   └─ Project\n" +
 			"         │       ├─ columns: [NOXN3.id:0!null as XLFIA, NOXN3.BRQP2:1!null]\n" +
-			"         │       └─ IndexedTableAccess(NOXN3)\n" +
-			"         │           ├─ index: [NOXN3.id]\n" +
+			"         │       └─ IndexedTableAccess(mydb.`NOXN3`)\n" +
+			"         │           ├─ index: `PRIMARY` [NOXN3.id]\n" +
 			"         │           ├─ static: [{[NULL, ∞)}]\n" +
 			"         │           └─ columns: [id brqp2]\n" +
 			"         └─ HashLookup\n" +
@@ -14240,7 +14752,7 @@ ORDER BY sn.XLFIA ASC`,
 			"             ├─ target: TUPLE(I2GJ5.LUEVY:0!null)\n" +
 			"             └─ CachedResults\n" +
 			"                 └─ SubqueryAlias\n" +
-			"                     ├─ name: I2GJ5\n" +
+			"                     ├─ name: `I2GJ5`\n" +
 			"                     ├─ outerVisibility: false\n" +
 			"                     ├─ cacheable: true\n" +
 			"                     └─ Project\n" +
@@ -14259,7 +14771,7 @@ ORDER BY sn.XLFIA ASC`,
 			"                             │   └─ Project\n" +
 			"                             │       ├─ columns: [E2I7U.id:0!null as LUEVY, E2I7U.HPCMS:1!null as HPCMS]\n" +
 			"                             │       └─ Table\n" +
-			"                             │           ├─ name: E2I7U\n" +
+			"                             │           ├─ name: mydb.`E2I7U`\n" +
 			"                             │           └─ columns: [id hpcms]\n" +
 			"                             └─ HashLookup\n" +
 			"                                 ├─ source: TUPLE(nd.HPCMS:1!null)\n" +
@@ -14272,7 +14784,7 @@ ORDER BY sn.XLFIA ASC`,
 			"                                         └─ Project\n" +
 			"                                             ├─ columns: [TNMXI.id:0!null as MLECF, TNMXI.DZLIM:1!null]\n" +
 			"                                             └─ Table\n" +
-			"                                                 ├─ name: TNMXI\n" +
+			"                                                 ├─ name: mydb.`TNMXI`\n" +
 			"                                                 └─ columns: [id dzlim]\n" +
 			"",
 	},
@@ -14302,20 +14814,20 @@ ORDER BY GRRB6.XLFIA ASC`,
 			"         │   ├─ QI2IE.VIBZI:3!null\n" +
 			"         │   └─ GRRB6.AHMDT:1!null\n" +
 			"         ├─ SubqueryAlias\n" +
-			"         │   ├─ name: GRRB6\n" +
+			"         │   ├─ name: `GRRB6`\n" +
 			"         │   ├─ outerVisibility: false\n" +
 			"         │   ├─ cacheable: true\n" +
 			"         │   └─ Project\n" +
 			"         │       ├─ columns: [NOXN3.id:0!null as XLFIA, NOXN3.BRQP2:1!null as AHMDT]\n" +
 			"         │       └─ Table\n" +
-			"         │           ├─ name: NOXN3\n" +
+			"         │           ├─ name: mydb.`NOXN3`\n" +
 			"         │           └─ columns: [id brqp2]\n" +
 			"         └─ HashLookup\n" +
 			"             ├─ source: TUPLE(GRRB6.AHMDT:1!null)\n" +
 			"             ├─ target: TUPLE(QI2IE.VIBZI:1!null)\n" +
 			"             └─ CachedResults\n" +
 			"                 └─ SubqueryAlias\n" +
-			"                     ├─ name: QI2IE\n" +
+			"                     ├─ name: `QI2IE`\n" +
 			"                     ├─ outerVisibility: false\n" +
 			"                     ├─ cacheable: true\n" +
 			"                     └─ Project\n" +
@@ -14324,7 +14836,7 @@ ORDER BY GRRB6.XLFIA ASC`,
 			"                             ├─ row_number() over ( order by E2I7U.id ASC)\n" +
 			"                             ├─ E2I7U.id:0!null as VIBZI\n" +
 			"                             └─ Table\n" +
-			"                                 ├─ name: E2I7U\n" +
+			"                                 ├─ name: mydb.`E2I7U`\n" +
 			"                                 └─ columns: [id]\n" +
 			"",
 	},
@@ -14362,21 +14874,21 @@ ORDER BY cla.FTQLQ ASC`,
 			"             │           │   ├─ Eq\n" +
 			"             │           │   │   ├─ scalarSubq0.id:0!null\n" +
 			"             │           │   │   └─ scalarSubq2.GXLUB:4!null\n" +
-			"             │           │   ├─ TableAlias(scalarSubq0)\n" +
+			"             │           │   ├─ TableAlias(`scalarSubq0`)\n" +
 			"             │           │   │   └─ Table\n" +
-			"             │           │   │       ├─ name: THNTS\n" +
+			"             │           │   │       ├─ name: mydb.`THNTS`\n" +
 			"             │           │   │       └─ columns: [id nfryn ixuxu fhcyt]\n" +
-			"             │           │   └─ TableAlias(scalarSubq2)\n" +
-			"             │           │       └─ IndexedTableAccess(AMYXQ)\n" +
-			"             │           │           ├─ index: [AMYXQ.GXLUB,AMYXQ.LUEVY]\n" +
+			"             │           │   └─ TableAlias(`scalarSubq2`)\n" +
+			"             │           │       └─ IndexedTableAccess(mydb.`AMYXQ`)\n" +
+			"             │           │           ├─ index: `VN3QP` [AMYXQ.GXLUB,AMYXQ.LUEVY]\n" +
 			"             │           │           └─ columns: [gxlub]\n" +
-			"             │           └─ TableAlias(scalarSubq1)\n" +
-			"             │               └─ IndexedTableAccess(HGMQ6)\n" +
-			"             │                   ├─ index: [HGMQ6.GXLUB]\n" +
+			"             │           └─ TableAlias(`scalarSubq1`)\n" +
+			"             │               └─ IndexedTableAccess(mydb.`HGMQ6`)\n" +
+			"             │                   ├─ index: `GXLUB` [HGMQ6.GXLUB]\n" +
 			"             │                   └─ columns: [gxlub]\n" +
 			"             └─ TableAlias(cla)\n" +
-			"                 └─ IndexedTableAccess(YK2GW)\n" +
-			"                     ├─ index: [YK2GW.id]\n" +
+			"                 └─ IndexedTableAccess(mydb.`YK2GW`)\n" +
+			"                     ├─ index: `PRIMARY` [YK2GW.id]\n" +
 			"                     └─ columns: [id ftqlq tuxml paef5 rucy4 tpnj6 lbl53 nb3qs eo7iv muhjf fm34l ty5rf zhtlh npb7w sx3hh isbnf ya7yb c5ykb qk7kt ffge6 fiigj sh3nc ntena m4aub x5air sab6m g5qi5 zvqvd ykssu fhcyt]\n" +
 			"",
 	},
@@ -14404,15 +14916,15 @@ ORDER BY cla.FTQLQ ASC`,
 			"             │   │   └─ bs.id:0!null\n" +
 			"             │   ├─ TableAlias(bs)\n" +
 			"             │   │   └─ Table\n" +
-			"             │   │       ├─ name: THNTS\n" +
+			"             │   │       ├─ name: mydb.`THNTS`\n" +
 			"             │   │       └─ columns: [id nfryn ixuxu fhcyt]\n" +
 			"             │   └─ TableAlias(mf)\n" +
-			"             │       └─ IndexedTableAccess(HGMQ6)\n" +
-			"             │           ├─ index: [HGMQ6.GXLUB]\n" +
+			"             │       └─ IndexedTableAccess(mydb.`HGMQ6`)\n" +
+			"             │           ├─ index: `GXLUB` [HGMQ6.GXLUB]\n" +
 			"             │           └─ columns: [id gxlub luevy m22qn tjpt7 arn5p xosd4 ide43 hmw4h zbt6r fsdy2 lt7k6 sppyd qcgts teuja qqv4m fhcyt]\n" +
 			"             └─ TableAlias(cla)\n" +
-			"                 └─ IndexedTableAccess(YK2GW)\n" +
-			"                     ├─ index: [YK2GW.id]\n" +
+			"                 └─ IndexedTableAccess(mydb.`YK2GW`)\n" +
+			"                     ├─ index: `PRIMARY` [YK2GW.id]\n" +
 			"                     └─ columns: [id ftqlq tuxml paef5 rucy4 tpnj6 lbl53 nb3qs eo7iv muhjf fm34l ty5rf zhtlh npb7w sx3hh isbnf ya7yb c5ykb qk7kt ffge6 fiigj sh3nc ntena m4aub x5air sab6m g5qi5 zvqvd ykssu fhcyt]\n" +
 			"",
 	},
@@ -14440,17 +14952,17 @@ ORDER BY cla.FTQLQ ASC`,
 			"             │           ├─ Eq\n" +
 			"             │           │   ├─ scalarSubq0.id:0!null\n" +
 			"             │           │   └─ scalarSubq1.GXLUB:4!null\n" +
-			"             │           ├─ TableAlias(scalarSubq0)\n" +
+			"             │           ├─ TableAlias(`scalarSubq0`)\n" +
 			"             │           │   └─ Table\n" +
-			"             │           │       ├─ name: THNTS\n" +
+			"             │           │       ├─ name: mydb.`THNTS`\n" +
 			"             │           │       └─ columns: [id nfryn ixuxu fhcyt]\n" +
-			"             │           └─ TableAlias(scalarSubq1)\n" +
-			"             │               └─ IndexedTableAccess(AMYXQ)\n" +
-			"             │                   ├─ index: [AMYXQ.GXLUB,AMYXQ.LUEVY]\n" +
+			"             │           └─ TableAlias(`scalarSubq1`)\n" +
+			"             │               └─ IndexedTableAccess(mydb.`AMYXQ`)\n" +
+			"             │                   ├─ index: `VN3QP` [AMYXQ.GXLUB,AMYXQ.LUEVY]\n" +
 			"             │                   └─ columns: [gxlub]\n" +
 			"             └─ TableAlias(cla)\n" +
-			"                 └─ IndexedTableAccess(YK2GW)\n" +
-			"                     ├─ index: [YK2GW.id]\n" +
+			"                 └─ IndexedTableAccess(mydb.`YK2GW`)\n" +
+			"                     ├─ index: `PRIMARY` [YK2GW.id]\n" +
 			"                     └─ columns: [id ftqlq tuxml paef5 rucy4 tpnj6 lbl53 nb3qs eo7iv muhjf fm34l ty5rf zhtlh npb7w sx3hh isbnf ya7yb c5ykb qk7kt ffge6 fiigj sh3nc ntena m4aub x5air sab6m g5qi5 zvqvd ykssu fhcyt]\n" +
 			"",
 	},
@@ -14472,11 +14984,11 @@ ORDER BY ci.FTQLQ`,
 			"             │   └─ ci.id:0!null\n" +
 			"             ├─ TableAlias(ci)\n" +
 			"             │   └─ Table\n" +
-			"             │       ├─ name: JDLNA\n" +
+			"             │       ├─ name: mydb.`JDLNA`\n" +
 			"             │       └─ columns: [id ftqlq fwwiq o3qxw fhcyt]\n" +
 			"             └─ TableAlias(ct)\n" +
-			"                 └─ IndexedTableAccess(FLQLP)\n" +
-			"                     ├─ index: [FLQLP.FZ2R5]\n" +
+			"                 └─ IndexedTableAccess(mydb.`FLQLP`)\n" +
+			"                     ├─ index: `FZ2R5` [FLQLP.FZ2R5]\n" +
 			"                     └─ columns: [id fz2r5 luevy m22qn ove3e nrurt oca7e xmm6q v5dpx s3q3y zrv3b fhcyt]\n" +
 			"",
 	},
@@ -14553,7 +15065,7 @@ ORDER BY LUEVY`,
 			"         │   ├─ YPGDA.I3L5A:8\n" +
 			"         │   └─ YBBG5.id:12!null\n" +
 			"         ├─ SubqueryAlias\n" +
-			"         │   ├─ name: YPGDA\n" +
+			"         │   ├─ name: `YPGDA`\n" +
 			"         │   ├─ outerVisibility: false\n" +
 			"         │   ├─ cacheable: true\n" +
 			"         │   └─ Project\n" +
@@ -14567,7 +15079,7 @@ ORDER BY LUEVY`,
 			"         │       │               │   ├─ AMYXQ.LUEVY:20!null\n" +
 			"         │       │               │   └─ nd.id:0!null\n" +
 			"         │       │               └─ Table\n" +
-			"         │       │                   ├─ name: AMYXQ\n" +
+			"         │       │                   ├─ name: mydb.`AMYXQ`\n" +
 			"         │       │                   └─ columns: [luevy xqdyt]\n" +
 			"         │       │   as I3L5A, nd.ETAQ7:15 as FUG6J, nd.A75X7:16 as NF5AM, nd.FSK67:8!null as FRCVC]\n" +
 			"         │       └─ LeftOuterMergeJoin\n" +
@@ -14575,22 +15087,22 @@ ORDER BY LUEVY`,
 			"         │           │   ├─ nd.HPCMS:12!null\n" +
 			"         │           │   └─ nma.id:17!null\n" +
 			"         │           ├─ TableAlias(nd)\n" +
-			"         │           │   └─ IndexedTableAccess(E2I7U)\n" +
-			"         │           │       ├─ index: [E2I7U.HPCMS]\n" +
+			"         │           │   └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			"         │           │       ├─ index: `HPCMS` [E2I7U.HPCMS]\n" +
 			"         │           │       ├─ static: [{[NULL, ∞)}]\n" +
 			"         │           │       └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
 			"         │           └─ TableAlias(nma)\n" +
-			"         │               └─ IndexedTableAccess(TNMXI)\n" +
-			"         │                   ├─ index: [TNMXI.id]\n" +
+			"         │               └─ IndexedTableAccess(mydb.`TNMXI`)\n" +
+			"         │                   ├─ index: `PRIMARY` [TNMXI.id]\n" +
 			"         │                   ├─ static: [{[NULL, ∞)}]\n" +
 			"         │                   └─ columns: [id dzlim f3yue]\n" +
 			"         └─ HashLookup\n" +
 			"             ├─ source: TUPLE(YPGDA.I3L5A:8)\n" +
 			"             ├─ target: TUPLE(YBBG5.id:0!null)\n" +
 			"             └─ CachedResults\n" +
-			"                 └─ TableAlias(YBBG5)\n" +
+			"                 └─ TableAlias(`YBBG5`)\n" +
 			"                     └─ Table\n" +
-			"                         ├─ name: XGSJM\n" +
+			"                         ├─ name: mydb.`XGSJM`\n" +
 			"                         └─ columns: [id dzlim]\n" +
 			"",
 	},
@@ -14598,7 +15110,7 @@ ORDER BY LUEVY`,
 		Query: `
 SELECT LUEVY, F6NSZ FROM ARLV5`,
 		ExpectedPlan: "Table\n" +
-			" ├─ name: ARLV5\n" +
+			" ├─ name: mydb.`ARLV5`\n" +
 			" └─ columns: [luevy f6nsz]\n" +
 			"",
 	},
@@ -14606,7 +15118,7 @@ SELECT LUEVY, F6NSZ FROM ARLV5`,
 		Query: `
 SELECT id, DZLIM FROM IIISV`,
 		ExpectedPlan: "Table\n" +
-			" ├─ name: IIISV\n" +
+			" ├─ name: mydb.`IIISV`\n" +
 			" └─ columns: [id dzlim]\n" +
 			"",
 	},
@@ -14671,22 +15183,22 @@ ORDER BY sn.id ASC`,
 			"         │   │   │   ├─ sn.BRQP2:1!null\n" +
 			"         │   │   │   └─ TVQG4.id:10!null\n" +
 			"         │   │   ├─ TableAlias(sn)\n" +
-			"         │   │   │   └─ IndexedTableAccess(NOXN3)\n" +
-			"         │   │   │       ├─ index: [NOXN3.BRQP2]\n" +
+			"         │   │   │   └─ IndexedTableAccess(mydb.`NOXN3`)\n" +
+			"         │   │   │       ├─ index: `BRQP2` [NOXN3.BRQP2]\n" +
 			"         │   │   │       ├─ static: [{[NULL, ∞)}]\n" +
 			"         │   │   │       └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
-			"         │   │   └─ TableAlias(TVQG4)\n" +
-			"         │   │       └─ IndexedTableAccess(E2I7U)\n" +
-			"         │   │           ├─ index: [E2I7U.id]\n" +
+			"         │   │   └─ TableAlias(`TVQG4`)\n" +
+			"         │   │       └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			"         │   │           ├─ index: `PRIMARY` [E2I7U.id]\n" +
 			"         │   │           ├─ static: [{[NULL, ∞)}]\n" +
 			"         │   │           └─ columns: [id tw55n]\n" +
 			"         │   └─ HashLookup\n" +
 			"         │       ├─ source: TUPLE(sn.FFTBJ:2!null)\n" +
 			"         │       ├─ target: TUPLE(LSM32.id:0!null)\n" +
 			"         │       └─ CachedResults\n" +
-			"         │           └─ TableAlias(LSM32)\n" +
+			"         │           └─ TableAlias(`LSM32`)\n" +
 			"         │               └─ Table\n" +
-			"         │                   ├─ name: E2I7U\n" +
+			"         │                   ├─ name: mydb.`E2I7U`\n" +
 			"         │                   └─ columns: [id tw55n]\n" +
 			"         └─ HashLookup\n" +
 			"             ├─ source: TUPLE(sn.A7XO2:3)\n" +
@@ -14694,7 +15206,7 @@ ORDER BY sn.id ASC`,
 			"             └─ CachedResults\n" +
 			"                 └─ TableAlias(it)\n" +
 			"                     └─ Table\n" +
-			"                         ├─ name: FEVH4\n" +
+			"                         ├─ name: mydb.`FEVH4`\n" +
 			"                         └─ columns: [id dzlim]\n" +
 			"",
 	},
@@ -14707,8 +15219,8 @@ FROM
 ORDER BY id ASC`,
 		ExpectedPlan: "Project\n" +
 			" ├─ columns: [NOXN3.KBO7R:1!null]\n" +
-			" └─ IndexedTableAccess(NOXN3)\n" +
-			"     ├─ index: [NOXN3.id]\n" +
+			" └─ IndexedTableAccess(mydb.`NOXN3`)\n" +
+			"     ├─ index: `PRIMARY` [NOXN3.id]\n" +
 			"     ├─ static: [{[NULL, ∞)}]\n" +
 			"     └─ columns: [id kbo7r]\n" +
 			"",
@@ -14784,46 +15296,46 @@ ORDER BY rn.id ASC`,
 			"         │   │   │   │   │   ├─ rn.WNUNU:1!null\n" +
 			"         │   │   │   │   │   └─ JGT2H.id:6!null\n" +
 			"         │   │   │   │   ├─ TableAlias(rn)\n" +
-			"         │   │   │   │   │   └─ IndexedTableAccess(QYWQD)\n" +
-			"         │   │   │   │   │       ├─ index: [QYWQD.WNUNU]\n" +
+			"         │   │   │   │   │   └─ IndexedTableAccess(mydb.`QYWQD`)\n" +
+			"         │   │   │   │   │       ├─ index: `WNUNU` [QYWQD.WNUNU]\n" +
 			"         │   │   │   │   │       ├─ static: [{[NULL, ∞)}]\n" +
 			"         │   │   │   │   │       └─ columns: [id wnunu hhvlx hvhrz ykssu fhcyt]\n" +
-			"         │   │   │   │   └─ TableAlias(JGT2H)\n" +
-			"         │   │   │   │       └─ IndexedTableAccess(NOXN3)\n" +
-			"         │   │   │   │           ├─ index: [NOXN3.id]\n" +
+			"         │   │   │   │   └─ TableAlias(`JGT2H`)\n" +
+			"         │   │   │   │       └─ IndexedTableAccess(mydb.`NOXN3`)\n" +
+			"         │   │   │   │           ├─ index: `PRIMARY` [NOXN3.id]\n" +
 			"         │   │   │   │           ├─ static: [{[NULL, ∞)}]\n" +
 			"         │   │   │   │           └─ columns: [id brqp2 fftbj letoe]\n" +
 			"         │   │   │   └─ HashLookup\n" +
 			"         │   │   │       ├─ source: TUPLE(rn.HHVLX:2!null)\n" +
 			"         │   │   │       ├─ target: TUPLE(AYFCD.id:0!null)\n" +
 			"         │   │   │       └─ CachedResults\n" +
-			"         │   │   │           └─ TableAlias(AYFCD)\n" +
+			"         │   │   │           └─ TableAlias(`AYFCD`)\n" +
 			"         │   │   │               └─ Table\n" +
-			"         │   │   │                   ├─ name: NOXN3\n" +
+			"         │   │   │                   ├─ name: mydb.`NOXN3`\n" +
 			"         │   │   │                   └─ columns: [id fftbj numk2 letoe]\n" +
 			"         │   │   └─ HashLookup\n" +
 			"         │   │       ├─ source: TUPLE(JGT2H.BRQP2:7)\n" +
 			"         │   │       ├─ target: TUPLE(SDLLR.id:0!null)\n" +
 			"         │   │       └─ CachedResults\n" +
-			"         │   │           └─ TableAlias(SDLLR)\n" +
+			"         │   │           └─ TableAlias(`SDLLR`)\n" +
 			"         │   │               └─ Table\n" +
-			"         │   │                   ├─ name: E2I7U\n" +
+			"         │   │                   ├─ name: mydb.`E2I7U`\n" +
 			"         │   │                   └─ columns: [id tw55n]\n" +
 			"         │   └─ HashLookup\n" +
 			"         │       ├─ source: TUPLE(JGT2H.FFTBJ:8)\n" +
 			"         │       ├─ target: TUPLE(RIIW6.id:0!null)\n" +
 			"         │       └─ CachedResults\n" +
-			"         │           └─ TableAlias(RIIW6)\n" +
+			"         │           └─ TableAlias(`RIIW6`)\n" +
 			"         │               └─ Table\n" +
-			"         │                   ├─ name: E2I7U\n" +
+			"         │                   ├─ name: mydb.`E2I7U`\n" +
 			"         │                   └─ columns: [id tw55n]\n" +
 			"         └─ HashLookup\n" +
 			"             ├─ source: TUPLE(AYFCD.FFTBJ:11)\n" +
 			"             ├─ target: TUPLE(FA75Y.id:0!null)\n" +
 			"             └─ CachedResults\n" +
-			"                 └─ TableAlias(FA75Y)\n" +
+			"                 └─ TableAlias(`FA75Y`)\n" +
 			"                     └─ Table\n" +
-			"                         ├─ name: E2I7U\n" +
+			"                         ├─ name: mydb.`E2I7U`\n" +
 			"                         └─ columns: [id tw55n]\n" +
 			"",
 	},
@@ -14836,8 +15348,8 @@ FROM
 ORDER BY id ASC`,
 		ExpectedPlan: "Project\n" +
 			" ├─ columns: [E2I7U.QRQXW:1!null]\n" +
-			" └─ IndexedTableAccess(E2I7U)\n" +
-			"     ├─ index: [E2I7U.id]\n" +
+			" └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			"     ├─ index: `PRIMARY` [E2I7U.id]\n" +
 			"     ├─ static: [{[NULL, ∞)}]\n" +
 			"     └─ columns: [id qrqxw]\n" +
 			"",
@@ -14876,7 +15388,7 @@ ORDER BY id ASC`,
 			"                     ├─ NOXN3.NUMK2:2!null\n" +
 			"                     ├─ NOXN3.ECDKM:1\n" +
 			"                     └─ Table\n" +
-			"                         ├─ name: NOXN3\n" +
+			"                         ├─ name: mydb.`NOXN3`\n" +
 			"                         └─ columns: [id ecdkm numk2]\n" +
 			"",
 	},
@@ -14887,8 +15399,8 @@ FROM NOXN3
 ORDER BY id ASC`,
 		ExpectedPlan: "Project\n" +
 			" ├─ columns: [NOXN3.id:0!null, NOXN3.NUMK2:2!null, NOXN3.ECDKM:1]\n" +
-			" └─ IndexedTableAccess(NOXN3)\n" +
-			"     ├─ index: [NOXN3.id]\n" +
+			" └─ IndexedTableAccess(mydb.`NOXN3`)\n" +
+			"     ├─ index: `PRIMARY` [NOXN3.id]\n" +
 			"     ├─ static: [{[NULL, ∞)}]\n" +
 			"     └─ columns: [id ecdkm numk2]\n" +
 			"",
@@ -14907,8 +15419,8 @@ SELECT
 			" │   ├─ NOXN3.NUMK2:2!null\n" +
 			" │   └─ 2 (tinyint)\n" +
 			" │   THEN NOXN3.ECDKM:1 ELSE 0 (tinyint) END as RGXLL]\n" +
-			" └─ IndexedTableAccess(NOXN3)\n" +
-			"     ├─ index: [NOXN3.id]\n" +
+			" └─ IndexedTableAccess(mydb.`NOXN3`)\n" +
+			"     ├─ index: `PRIMARY` [NOXN3.id]\n" +
 			"     ├─ static: [{[NULL, ∞)}]\n" +
 			"     └─ columns: [id ecdkm numk2]\n" +
 			"",
@@ -14933,17 +15445,17 @@ INNER JOIN XOAOP pa
 			"     │   ├─ Eq\n" +
 			"     │   │   ├─ QNRBH.LUEVY:1!null\n" +
 			"     │   │   └─ nd.id:2!null\n" +
-			"     │   ├─ TableAlias(QNRBH)\n" +
+			"     │   ├─ TableAlias(`QNRBH`)\n" +
 			"     │   │   └─ Table\n" +
-			"     │   │       ├─ name: JJGQT\n" +
+			"     │   │       ├─ name: mydb.`JJGQT`\n" +
 			"     │   │       └─ columns: [ch3fr luevy]\n" +
 			"     │   └─ TableAlias(nd)\n" +
-			"     │       └─ IndexedTableAccess(E2I7U)\n" +
-			"     │           ├─ index: [E2I7U.id]\n" +
+			"     │       └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			"     │           ├─ index: `PRIMARY` [E2I7U.id]\n" +
 			"     │           └─ columns: [id tw55n]\n" +
 			"     └─ TableAlias(pa)\n" +
-			"         └─ IndexedTableAccess(XOAOP)\n" +
-			"             ├─ index: [XOAOP.id]\n" +
+			"         └─ IndexedTableAccess(mydb.`XOAOP`)\n" +
+			"             ├─ index: `PRIMARY` [XOAOP.id]\n" +
 			"             └─ columns: [id dzlim]\n" +
 			"",
 	},
@@ -14957,8 +15469,8 @@ WHERE id IN ('1','2','3')`,
 			"         ├─ HashIn\n" +
 			"         │   ├─ QYWQD.id:0!null\n" +
 			"         │   └─ TUPLE(1 (longtext), 2 (longtext), 3 (longtext))\n" +
-			"         └─ IndexedTableAccess(QYWQD)\n" +
-			"             ├─ index: [QYWQD.id]\n" +
+			"         └─ IndexedTableAccess(mydb.`QYWQD`)\n" +
+			"             ├─ index: `PRIMARY` [QYWQD.id]\n" +
 			"             ├─ static: [{[1, 1]}, {[2, 2]}, {[3, 3]}]\n" +
 			"             └─ columns: [id wnunu hhvlx hvhrz ykssu fhcyt]\n" +
 			"",
@@ -14980,7 +15492,7 @@ WHERE
 			"         │       ├─ HDDVB.UJ6XY:2!null\n" +
 			"         │       └─ TUPLE(1 (longtext), 2 (longtext), 3 (longtext))\n" +
 			"         └─ Table\n" +
-			"             ├─ name: HDDVB\n" +
+			"             ├─ name: mydb.`HDDVB`\n" +
 			"             └─ columns: [id fv24e uj6xy m22qn nz4mq etpqv pruv2 ykssu fhcyt]\n" +
 			"",
 	},
@@ -14994,8 +15506,8 @@ WHERE id IN ('1', '2', '3')`,
 			"         ├─ HashIn\n" +
 			"         │   ├─ QYWQD.id:0!null\n" +
 			"         │   └─ TUPLE(1 (longtext), 2 (longtext), 3 (longtext))\n" +
-			"         └─ IndexedTableAccess(QYWQD)\n" +
-			"             ├─ index: [QYWQD.id]\n" +
+			"         └─ IndexedTableAccess(mydb.`QYWQD`)\n" +
+			"             ├─ index: `PRIMARY` [QYWQD.id]\n" +
 			"             ├─ static: [{[1, 1]}, {[2, 2]}, {[3, 3]}]\n" +
 			"             └─ columns: [id wnunu hhvlx hvhrz ykssu fhcyt]\n" +
 			"",
@@ -15010,8 +15522,8 @@ WHERE LUEVY IN ('1', '2', '3')`,
 			"         ├─ HashIn\n" +
 			"         │   ├─ AMYXQ.LUEVY:2!null\n" +
 			"         │   └─ TUPLE(1 (longtext), 2 (longtext), 3 (longtext))\n" +
-			"         └─ IndexedTableAccess(AMYXQ)\n" +
-			"             ├─ index: [AMYXQ.LUEVY]\n" +
+			"         └─ IndexedTableAccess(mydb.`AMYXQ`)\n" +
+			"             ├─ index: `LUEVY` [AMYXQ.LUEVY]\n" +
 			"             ├─ static: [{[1, 1]}, {[2, 2]}, {[3, 3]}]\n" +
 			"             └─ columns: [id gxlub luevy xqdyt amyxq oztqf z35gy kkgn5]\n" +
 			"",
@@ -15026,8 +15538,8 @@ WHERE id IN ('1', '2', '3')`,
 			"         ├─ HashIn\n" +
 			"         │   ├─ HGMQ6.id:0!null\n" +
 			"         │   └─ TUPLE(1 (longtext), 2 (longtext), 3 (longtext))\n" +
-			"         └─ IndexedTableAccess(HGMQ6)\n" +
-			"             ├─ index: [HGMQ6.id]\n" +
+			"         └─ IndexedTableAccess(mydb.`HGMQ6`)\n" +
+			"             ├─ index: `PRIMARY` [HGMQ6.id]\n" +
 			"             ├─ static: [{[1, 1]}, {[2, 2]}, {[3, 3]}]\n" +
 			"             └─ columns: [id gxlub luevy m22qn tjpt7 arn5p xosd4 ide43 hmw4h zbt6r fsdy2 lt7k6 sppyd qcgts teuja qqv4m fhcyt]\n" +
 			"",
@@ -15042,8 +15554,8 @@ WHERE id IN ('1', '2', '3')`,
 			"         ├─ HashIn\n" +
 			"         │   ├─ HDDVB.id:0!null\n" +
 			"         │   └─ TUPLE(1 (longtext), 2 (longtext), 3 (longtext))\n" +
-			"         └─ IndexedTableAccess(HDDVB)\n" +
-			"             ├─ index: [HDDVB.id]\n" +
+			"         └─ IndexedTableAccess(mydb.`HDDVB`)\n" +
+			"             ├─ index: `PRIMARY` [HDDVB.id]\n" +
 			"             ├─ static: [{[1, 1]}, {[2, 2]}, {[3, 3]}]\n" +
 			"             └─ columns: [id fv24e uj6xy m22qn nz4mq etpqv pruv2 ykssu fhcyt]\n" +
 			"",
@@ -15058,8 +15570,8 @@ WHERE LUEVY IN ('1', '2', '3')`,
 			"         ├─ HashIn\n" +
 			"         │   ├─ FLQLP.LUEVY:2!null\n" +
 			"         │   └─ TUPLE(1 (longtext), 2 (longtext), 3 (longtext))\n" +
-			"         └─ IndexedTableAccess(FLQLP)\n" +
-			"             ├─ index: [FLQLP.LUEVY]\n" +
+			"         └─ IndexedTableAccess(mydb.`FLQLP`)\n" +
+			"             ├─ index: `LUEVY` [FLQLP.LUEVY]\n" +
 			"             ├─ static: [{[1, 1]}, {[2, 2]}, {[3, 3]}]\n" +
 			"             └─ columns: [id fz2r5 luevy m22qn ove3e nrurt oca7e xmm6q v5dpx s3q3y zrv3b fhcyt]\n" +
 			"",
@@ -15074,8 +15586,8 @@ WHERE id IN ('1', '2', '3')`,
 			"         ├─ HashIn\n" +
 			"         │   ├─ FLQLP.id:0!null\n" +
 			"         │   └─ TUPLE(1 (longtext), 2 (longtext), 3 (longtext))\n" +
-			"         └─ IndexedTableAccess(FLQLP)\n" +
-			"             ├─ index: [FLQLP.id]\n" +
+			"         └─ IndexedTableAccess(mydb.`FLQLP`)\n" +
+			"             ├─ index: `PRIMARY` [FLQLP.id]\n" +
 			"             ├─ static: [{[1, 1]}, {[2, 2]}, {[3, 3]}]\n" +
 			"             └─ columns: [id fz2r5 luevy m22qn ove3e nrurt oca7e xmm6q v5dpx s3q3y zrv3b fhcyt]\n" +
 			"",
@@ -15090,8 +15602,8 @@ WHERE id IN ('1', '2', '3')`,
 			"         ├─ HashIn\n" +
 			"         │   ├─ FLQLP.id:0!null\n" +
 			"         │   └─ TUPLE(1 (longtext), 2 (longtext), 3 (longtext))\n" +
-			"         └─ IndexedTableAccess(FLQLP)\n" +
-			"             ├─ index: [FLQLP.id]\n" +
+			"         └─ IndexedTableAccess(mydb.`FLQLP`)\n" +
+			"             ├─ index: `PRIMARY` [FLQLP.id]\n" +
 			"             ├─ static: [{[1, 1]}, {[2, 2]}, {[3, 3]}]\n" +
 			"             └─ columns: [id fz2r5 luevy m22qn ove3e nrurt oca7e xmm6q v5dpx s3q3y zrv3b fhcyt]\n" +
 			"",
@@ -15118,11 +15630,11 @@ WHERE nd.FGG57 IS NOT NULL AND nd.KNG7T IS NULL`,
 			"                     │   └─ gn.SSHPJ:18!null\n" +
 			"                     ├─ TableAlias(gn)\n" +
 			"                     │   └─ Table\n" +
-			"                     │       ├─ name: WE72E\n" +
+			"                     │       ├─ name: mydb.`WE72E`\n" +
 			"                     │       └─ columns: [id sshpj]\n" +
 			"                     └─ TableAlias(ltnm)\n" +
-			"                         └─ IndexedTableAccess(TDRVG)\n" +
-			"                             ├─ index: [TDRVG.SSHPJ]\n" +
+			"                         └─ IndexedTableAccess(mydb.`TDRVG`)\n" +
+			"                             ├─ index: `P62K5` [TDRVG.SSHPJ]\n" +
 			"                             └─ columns: [fgg57 sshpj]\n" +
 			"        )\n" +
 			"         └─ Filter\n" +
@@ -15131,8 +15643,8 @@ WHERE nd.FGG57 IS NOT NULL AND nd.KNG7T IS NULL`,
 			"             │   │   └─ nd.FGG57:6 IS NULL\n" +
 			"             │   └─ nd.KNG7T:2 IS NULL\n" +
 			"             └─ TableAlias(nd)\n" +
-			"                 └─ IndexedTableAccess(E2I7U)\n" +
-			"                     ├─ index: [E2I7U.FGG57]\n" +
+			"                 └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			"                     ├─ index: `TKKOQ` [E2I7U.FGG57]\n" +
 			"                     ├─ static: [{(NULL, ∞)}]\n" +
 			"                     └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
 			"",
@@ -15162,7 +15674,7 @@ UPDATE S3FQX SET ADWYM = 0, FPUYA = 0`,
 			"            END//)\n" +
 			"             ├─ UpdateSource(SET S3FQX.ADWYM:1!null = 0 (tinyint),SET S3FQX.FPUYA:2!null = 0 (tinyint))\n" +
 			"             │   └─ Table\n" +
-			"             │       ├─ name: S3FQX\n" +
+			"             │       ├─ name: mydb.`S3FQX`\n" +
 			"             │       └─ columns: [id adwym fpuya]\n" +
 			"             └─ BEGIN .. END\n" +
 			"                 ├─ IF BLOCK\n" +
@@ -15202,7 +15714,7 @@ WHERE
 			"     └─ Insert(id, NFRYN, IXUXU, FHCYT)\n" +
 			"         ├─ InsertDestination\n" +
 			"         │   └─ Table\n" +
-			"         │       ├─ name: THNTS\n" +
+			"         │       ├─ name: mydb.`THNTS`\n" +
 			"         │       └─ columns: [id nfryn ixuxu fhcyt]\n" +
 			"         └─ Trigger(CREATE TRIGGER THNTS_on_insert BEFORE INSERT ON THNTS\n" +
 			"            FOR EACH ROW\n" +
@@ -15224,8 +15736,8 @@ WHERE
 			"             │       │           ├─ Eq\n" +
 			"             │       │           │   ├─ JMRQL.DZLIM:31!null\n" +
 			"             │       │           │   └─ T4IBQ (longtext)\n" +
-			"             │       │           └─ IndexedTableAccess(JMRQL)\n" +
-			"             │       │               ├─ index: [JMRQL.DZLIM]\n" +
+			"             │       │           └─ IndexedTableAccess(mydb.`JMRQL`)\n" +
+			"             │       │               ├─ index: `LYX3G` [JMRQL.DZLIM]\n" +
 			"             │       │               ├─ static: [{[T4IBQ, T4IBQ]}]\n" +
 			"             │       │               └─ columns: [id dzlim]\n" +
 			"             │       │   as NFRYN, YK2GW.id:0!null as IXUXU, NULL (null) as FHCYT]\n" +
@@ -15233,8 +15745,8 @@ WHERE
 			"             │           ├─ HashIn\n" +
 			"             │           │   ├─ YK2GW.id:0!null\n" +
 			"             │           │   └─ TUPLE(1 (longtext), 2 (longtext), 3 (longtext))\n" +
-			"             │           └─ IndexedTableAccess(YK2GW)\n" +
-			"             │               ├─ index: [YK2GW.id]\n" +
+			"             │           └─ IndexedTableAccess(mydb.`YK2GW`)\n" +
+			"             │               ├─ index: `PRIMARY` [YK2GW.id]\n" +
 			"             │               ├─ static: [{[1, 1]}, {[2, 2]}, {[3, 3]}]\n" +
 			"             │               └─ columns: [id ftqlq tuxml paef5 rucy4 tpnj6 lbl53 nb3qs eo7iv muhjf fm34l ty5rf zhtlh npb7w sx3hh isbnf ya7yb c5ykb qk7kt ffge6 fiigj sh3nc ntena m4aub x5air sab6m g5qi5 zvqvd ykssu fhcyt]\n" +
 			"             └─ BEGIN .. END\n" +
@@ -15283,7 +15795,7 @@ FROM
 			"     └─ Insert(id, WNUNU, HHVLX, HVHRZ, YKSSU, FHCYT)\n" +
 			"         ├─ InsertDestination\n" +
 			"         │   └─ Table\n" +
-			"         │       ├─ name: QYWQD\n" +
+			"         │       ├─ name: mydb.`QYWQD`\n" +
 			"         │       └─ columns: [id wnunu hhvlx hvhrz ykssu fhcyt]\n" +
 			"         └─ Trigger(CREATE TRIGGER QYWQD_on_insert BEFORE INSERT ON QYWQD\n" +
 			"            FOR EACH ROW\n" +
@@ -15301,7 +15813,7 @@ FROM
 			"             │   └─ Project\n" +
 			"             │       ├─ columns: [lpad(lower(concat(concat(hex((rand() * 4294967296)),lower(hex((rand() * 4294967296))),lower(hex((rand() * 4294967296)))))), 24, '0') as id, ITWML.DRIWM:0!null as WNUNU, ITWML.JIEVY:1!null as HHVLX, 1 (decimal(2,1)) as HVHRZ, NULL (null) as YKSSU, NULL (null) as FHCYT]\n" +
 			"             │       └─ SubqueryAlias\n" +
-			"             │           ├─ name: ITWML\n" +
+			"             │           ├─ name: `ITWML`\n" +
 			"             │           ├─ outerVisibility: false\n" +
 			"             │           ├─ cacheable: true\n" +
 			"             │           └─ Project\n" +
@@ -15326,14 +15838,14 @@ FROM
 			"             │                       │   ├─ cmp: Eq\n" +
 			"             │                       │   │   ├─ SKPM6.BRQP2:1!null\n" +
 			"             │                       │   │   └─ sn.FFTBJ:3!null\n" +
-			"             │                       │   ├─ TableAlias(SKPM6)\n" +
-			"             │                       │   │   └─ IndexedTableAccess(NOXN3)\n" +
-			"             │                       │   │       ├─ index: [NOXN3.BRQP2]\n" +
+			"             │                       │   ├─ TableAlias(`SKPM6`)\n" +
+			"             │                       │   │   └─ IndexedTableAccess(mydb.`NOXN3`)\n" +
+			"             │                       │   │       ├─ index: `BRQP2` [NOXN3.BRQP2]\n" +
 			"             │                       │   │       ├─ static: [{[NULL, ∞)}]\n" +
 			"             │                       │   │       └─ columns: [id brqp2]\n" +
 			"             │                       │   └─ TableAlias(sn)\n" +
-			"             │                       │       └─ IndexedTableAccess(NOXN3)\n" +
-			"             │                       │           ├─ index: [NOXN3.FFTBJ]\n" +
+			"             │                       │       └─ IndexedTableAccess(mydb.`NOXN3`)\n" +
+			"             │                       │           ├─ index: `FFTBJ` [NOXN3.FFTBJ]\n" +
 			"             │                       │           ├─ static: [{[NULL, ∞)}]\n" +
 			"             │                       │           └─ columns: [id fftbj ecdkm numk2]\n" +
 			"             │                       └─ HashLookup\n" +
@@ -15342,7 +15854,7 @@ FROM
 			"             │                           └─ CachedResults\n" +
 			"             │                               └─ TableAlias(rn)\n" +
 			"             │                                   └─ Table\n" +
-			"             │                                       ├─ name: QYWQD\n" +
+			"             │                                       ├─ name: mydb.`QYWQD`\n" +
 			"             │                                       └─ columns: [wnunu hhvlx]\n" +
 			"             └─ BEGIN .. END\n" +
 			"                 └─ IF BLOCK\n" +
@@ -15357,7 +15869,7 @@ FROM
 			"                             │           │   ├─ NOXN3.id:6!null\n" +
 			"                             │           │   └─ new.WNUNU:1!null\n" +
 			"                             │           └─ Table\n" +
-			"                             │               ├─ name: NOXN3\n" +
+			"                             │               ├─ name: mydb.`NOXN3`\n" +
 			"                             │               └─ columns: [id fftbj]\n" +
 			"                             └─ Subquery\n" +
 			"                                 ├─ cacheable: false\n" +
@@ -15368,7 +15880,7 @@ FROM
 			"                                         │   ├─ NOXN3.id:6!null\n" +
 			"                                         │   └─ new.HHVLX:2!null\n" +
 			"                                         └─ Table\n" +
-			"                                             ├─ name: NOXN3\n" +
+			"                                             ├─ name: mydb.`NOXN3`\n" +
 			"                                             └─ columns: [id brqp2]\n" +
 			"                        )\n" +
 			"                         └─ BLOCK\n" +
@@ -15393,7 +15905,7 @@ WHERE
 			"     └─ Insert(id, QZ7E7, SSHPJ, FHCYT)\n" +
 			"         ├─ InsertDestination\n" +
 			"         │   └─ Table\n" +
-			"         │       ├─ name: WE72E\n" +
+			"         │       ├─ name: mydb.`WE72E`\n" +
 			"         │       └─ columns: [id qz7e7 sshpj fhcyt]\n" +
 			"         └─ Trigger(CREATE TRIGGER WE72E_on_insert BEFORE INSERT ON WE72E\n" +
 			"            FOR EACH ROW\n" +
@@ -15416,8 +15928,8 @@ WHERE
 			"             │           ├─ HashIn\n" +
 			"             │           │   ├─ TDRVG.id:0!null\n" +
 			"             │           │   └─ TUPLE(1 (longtext), 2 (longtext), 3 (longtext))\n" +
-			"             │           └─ IndexedTableAccess(TDRVG)\n" +
-			"             │               ├─ index: [TDRVG.id]\n" +
+			"             │           └─ IndexedTableAccess(mydb.`TDRVG`)\n" +
+			"             │               ├─ index: `PRIMARY` [TDRVG.id]\n" +
 			"             │               ├─ static: [{[1, 1]}, {[2, 2]}, {[3, 3]}]\n" +
 			"             │               └─ columns: [id sshpj sfj6l]\n" +
 			"             └─ BEGIN .. END\n" +
@@ -15428,14 +15940,14 @@ WHERE
 			"                         │   └─ right: Subquery\n" +
 			"                         │       ├─ cacheable: false\n" +
 			"                         │       └─ Table\n" +
-			"                         │           ├─ name: TPXHZ\n" +
+			"                         │           ├─ name: mydb.`TPXHZ`\n" +
 			"                         │           └─ columns: [svaz4]\n" +
 			"                         └─ InSubquery\n" +
 			"                             ├─ left: new.SSHPJ:2!null\n" +
 			"                             └─ right: Subquery\n" +
 			"                                 ├─ cacheable: false\n" +
 			"                                 └─ Table\n" +
-			"                                     ├─ name: TPXHZ\n" +
+			"                                     ├─ name: mydb.`TPXHZ`\n" +
 			"                                     └─ columns: [svaz4]\n" +
 			"                        )\n" +
 			"                         └─ BLOCK\n" +
@@ -15489,7 +16001,7 @@ WHERE
 			"     └─ Insert(id, GXLUB, LUEVY, XQDYT, AMYXQ, OZTQF, Z35GY, KKGN5)\n" +
 			"         ├─ InsertDestination\n" +
 			"         │   └─ Table\n" +
-			"         │       ├─ name: AMYXQ\n" +
+			"         │       ├─ name: mydb.`AMYXQ`\n" +
 			"         │       └─ columns: [id gxlub luevy xqdyt amyxq oztqf z35gy kkgn5]\n" +
 			"         └─ Trigger(CREATE TRIGGER AMYXQ_on_insert BEFORE INSERT ON AMYXQ\n" +
 			"            FOR EACH ROW\n" +
@@ -15525,13 +16037,13 @@ WHERE
 			"             │       │               │   ├─ cla.id:31!null\n" +
 			"             │       │               │   └─ bs.IXUXU:34\n" +
 			"             │       │               ├─ TableAlias(cla)\n" +
-			"             │       │               │   └─ IndexedTableAccess(YK2GW)\n" +
-			"             │       │               │       ├─ index: [YK2GW.id]\n" +
+			"             │       │               │   └─ IndexedTableAccess(mydb.`YK2GW`)\n" +
+			"             │       │               │       ├─ index: `PRIMARY` [YK2GW.id]\n" +
 			"             │       │               │       ├─ static: [{[NULL, ∞)}]\n" +
 			"             │       │               │       └─ columns: [id ftqlq]\n" +
 			"             │       │               └─ TableAlias(bs)\n" +
-			"             │       │                   └─ IndexedTableAccess(THNTS)\n" +
-			"             │       │                       ├─ index: [THNTS.IXUXU]\n" +
+			"             │       │                   └─ IndexedTableAccess(mydb.`THNTS`)\n" +
+			"             │       │                       ├─ index: `IXUXU` [THNTS.IXUXU]\n" +
 			"             │       │                       ├─ static: [{[NULL, ∞)}]\n" +
 			"             │       │                       └─ columns: [id ixuxu]\n" +
 			"             │       │   as GXLUB, nd.id:11!null as LUEVY, nd.XQDYT:20!null as XQDYT, (ufc.AMYXQ:3 + 0 (decimal(2,1))) as AMYXQ, CASE  WHEN Eq\n" +
@@ -15559,8 +16071,8 @@ WHERE
 			"             │           │   │   ├─ ufc.id:0!null\n" +
 			"             │           │   │   └─ TUPLE(1 (longtext), 2 (longtext), 3 (longtext))\n" +
 			"             │           │   └─ TableAlias(ufc)\n" +
-			"             │           │       └─ IndexedTableAccess(SISUT)\n" +
-			"             │           │           ├─ index: [SISUT.id]\n" +
+			"             │           │       └─ IndexedTableAccess(mydb.`SISUT`)\n" +
+			"             │           │           ├─ index: `PRIMARY` [SISUT.id]\n" +
 			"             │           │           ├─ static: [{[1, 1]}, {[2, 2]}, {[3, 3]}]\n" +
 			"             │           │           └─ columns: [id t4ibq zh72s amyxq ktnz2 hiid2 dn3oq vvknb sh7tp srzzo qz6vt]\n" +
 			"             │           └─ HashLookup\n" +
@@ -15572,13 +16084,13 @@ WHERE
 			"             │                       │   ├─ nd.XQDYT:20!null\n" +
 			"             │                       │   └─ YBBG5.id:28!null\n" +
 			"             │                       ├─ TableAlias(nd)\n" +
-			"             │                       │   └─ IndexedTableAccess(E2I7U)\n" +
-			"             │                       │       ├─ index: [E2I7U.XQDYT]\n" +
+			"             │                       │   └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			"             │                       │       ├─ index: `XQDYT` [E2I7U.XQDYT]\n" +
 			"             │                       │       ├─ static: [{[NULL, ∞)}]\n" +
 			"             │                       │       └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
-			"             │                       └─ TableAlias(YBBG5)\n" +
-			"             │                           └─ IndexedTableAccess(XGSJM)\n" +
-			"             │                               ├─ index: [XGSJM.id]\n" +
+			"             │                       └─ TableAlias(`YBBG5`)\n" +
+			"             │                           └─ IndexedTableAccess(mydb.`XGSJM`)\n" +
+			"             │                               ├─ index: `PRIMARY` [XGSJM.id]\n" +
 			"             │                               ├─ static: [{[NULL, ∞)}]\n" +
 			"             │                               └─ columns: [id dzlim f3yue]\n" +
 			"             └─ BEGIN .. END\n" +
@@ -15592,7 +16104,7 @@ WHERE
 			"                 │               │   ├─ E2I7U.id:8!null\n" +
 			"                 │               │   └─ new.LUEVY:2!null\n" +
 			"                 │               └─ Table\n" +
-			"                 │                   ├─ name: E2I7U\n" +
+			"                 │                   ├─ name: mydb.`E2I7U`\n" +
 			"                 │                   └─ columns: [id fgg57]\n" +
 			"                 │       IS NULL)\n" +
 			"                 │       └─ BLOCK\n" +
@@ -15637,7 +16149,7 @@ WHERE
 			"     └─ Insert(id, GXLUB, CH3FR, D237E, JOGI6)\n" +
 			"         ├─ InsertDestination\n" +
 			"         │   └─ Table\n" +
-			"         │       ├─ name: SZQWJ\n" +
+			"         │       ├─ name: mydb.`SZQWJ`\n" +
 			"         │       └─ columns: [id gxlub ch3fr d237e jogi6]\n" +
 			"         └─ Trigger(CREATE TRIGGER SZQWJ_on_insert BEFORE INSERT ON SZQWJ\n" +
 			"            FOR EACH ROW\n" +
@@ -15673,13 +16185,13 @@ WHERE
 			"             │       │               │   ├─ cla.id:7!null\n" +
 			"             │       │               │   └─ bs.IXUXU:10\n" +
 			"             │       │               ├─ TableAlias(cla)\n" +
-			"             │       │               │   └─ IndexedTableAccess(YK2GW)\n" +
-			"             │       │               │       ├─ index: [YK2GW.id]\n" +
+			"             │       │               │   └─ IndexedTableAccess(mydb.`YK2GW`)\n" +
+			"             │       │               │       ├─ index: `PRIMARY` [YK2GW.id]\n" +
 			"             │       │               │       ├─ static: [{[NULL, ∞)}]\n" +
 			"             │       │               │       └─ columns: [id ftqlq]\n" +
 			"             │       │               └─ TableAlias(bs)\n" +
-			"             │       │                   └─ IndexedTableAccess(THNTS)\n" +
-			"             │       │                       ├─ index: [THNTS.IXUXU]\n" +
+			"             │       │                   └─ IndexedTableAccess(mydb.`THNTS`)\n" +
+			"             │       │                       ├─ index: `IXUXU` [THNTS.IXUXU]\n" +
 			"             │       │                       ├─ static: [{[NULL, ∞)}]\n" +
 			"             │       │                       └─ columns: [id ixuxu]\n" +
 			"             │       │   as GXLUB, Subquery\n" +
@@ -15690,8 +16202,8 @@ WHERE
 			"             │       │           ├─ Eq\n" +
 			"             │       │           │   ├─ XOAOP.DZLIM:8!null\n" +
 			"             │       │           │   └─ NER (longtext)\n" +
-			"             │       │           └─ IndexedTableAccess(XOAOP)\n" +
-			"             │       │               ├─ index: [XOAOP.DZLIM]\n" +
+			"             │       │           └─ IndexedTableAccess(mydb.`XOAOP`)\n" +
+			"             │       │               ├─ index: `THCUL` [XOAOP.DZLIM]\n" +
 			"             │       │               ├─ static: [{[NER, NER]}]\n" +
 			"             │       │               └─ columns: [id dzlim]\n" +
 			"             │       │   as CH3FR, CASE  WHEN GreaterThan\n" +
@@ -15706,8 +16218,8 @@ WHERE
 			"             │           │   ├─ ums.id:0!null\n" +
 			"             │           │   └─ TUPLE(1 (longtext), 2 (longtext), 3 (longtext))\n" +
 			"             │           └─ TableAlias(ums)\n" +
-			"             │               └─ IndexedTableAccess(FG26Y)\n" +
-			"             │                   ├─ index: [FG26Y.id]\n" +
+			"             │               └─ IndexedTableAccess(mydb.`FG26Y`)\n" +
+			"             │                   ├─ index: `PRIMARY` [FG26Y.id]\n" +
 			"             │                   ├─ static: [{[1, 1]}, {[2, 2]}, {[3, 3]}]\n" +
 			"             │                   └─ columns: [id t4ibq ner ber hr mmr qz6vt]\n" +
 			"             └─ BEGIN .. END\n" +
@@ -15723,7 +16235,7 @@ WHERE
 			"                 │           │           │   ├─ XOAOP.id:5!null\n" +
 			"                 │           │           │   └─ new.CH3FR:2!null\n" +
 			"                 │           │           └─ Table\n" +
-			"                 │           │               ├─ name: XOAOP\n" +
+			"                 │           │               ├─ name: mydb.`XOAOP`\n" +
 			"                 │           │               └─ columns: [id dzlim]\n" +
 			"                 │           └─ right: TUPLE(NER (longtext), BER (longtext), HR (longtext), MMR (longtext))\n" +
 			"                 │      )\n" +
@@ -15762,7 +16274,7 @@ WHERE
 			"     └─ Insert(id, GXLUB, CH3FR, D237E, JOGI6)\n" +
 			"         ├─ InsertDestination\n" +
 			"         │   └─ Table\n" +
-			"         │       ├─ name: SZQWJ\n" +
+			"         │       ├─ name: mydb.`SZQWJ`\n" +
 			"         │       └─ columns: [id gxlub ch3fr d237e jogi6]\n" +
 			"         └─ Trigger(CREATE TRIGGER SZQWJ_on_insert BEFORE INSERT ON SZQWJ\n" +
 			"            FOR EACH ROW\n" +
@@ -15798,13 +16310,13 @@ WHERE
 			"             │       │               │   ├─ cla.id:7!null\n" +
 			"             │       │               │   └─ bs.IXUXU:10\n" +
 			"             │       │               ├─ TableAlias(cla)\n" +
-			"             │       │               │   └─ IndexedTableAccess(YK2GW)\n" +
-			"             │       │               │       ├─ index: [YK2GW.id]\n" +
+			"             │       │               │   └─ IndexedTableAccess(mydb.`YK2GW`)\n" +
+			"             │       │               │       ├─ index: `PRIMARY` [YK2GW.id]\n" +
 			"             │       │               │       ├─ static: [{[NULL, ∞)}]\n" +
 			"             │       │               │       └─ columns: [id ftqlq]\n" +
 			"             │       │               └─ TableAlias(bs)\n" +
-			"             │       │                   └─ IndexedTableAccess(THNTS)\n" +
-			"             │       │                       ├─ index: [THNTS.IXUXU]\n" +
+			"             │       │                   └─ IndexedTableAccess(mydb.`THNTS`)\n" +
+			"             │       │                       ├─ index: `IXUXU` [THNTS.IXUXU]\n" +
 			"             │       │                       ├─ static: [{[NULL, ∞)}]\n" +
 			"             │       │                       └─ columns: [id ixuxu]\n" +
 			"             │       │   as GXLUB, Subquery\n" +
@@ -15815,8 +16327,8 @@ WHERE
 			"             │       │           ├─ Eq\n" +
 			"             │       │           │   ├─ XOAOP.DZLIM:8!null\n" +
 			"             │       │           │   └─ BER (longtext)\n" +
-			"             │       │           └─ IndexedTableAccess(XOAOP)\n" +
-			"             │       │               ├─ index: [XOAOP.DZLIM]\n" +
+			"             │       │           └─ IndexedTableAccess(mydb.`XOAOP`)\n" +
+			"             │       │               ├─ index: `THCUL` [XOAOP.DZLIM]\n" +
 			"             │       │               ├─ static: [{[BER, BER]}]\n" +
 			"             │       │               └─ columns: [id dzlim]\n" +
 			"             │       │   as CH3FR, CASE  WHEN GreaterThan\n" +
@@ -15831,8 +16343,8 @@ WHERE
 			"             │           │   ├─ ums.id:0!null\n" +
 			"             │           │   └─ TUPLE(1 (longtext), 2 (longtext), 3 (longtext))\n" +
 			"             │           └─ TableAlias(ums)\n" +
-			"             │               └─ IndexedTableAccess(FG26Y)\n" +
-			"             │                   ├─ index: [FG26Y.id]\n" +
+			"             │               └─ IndexedTableAccess(mydb.`FG26Y`)\n" +
+			"             │                   ├─ index: `PRIMARY` [FG26Y.id]\n" +
 			"             │                   ├─ static: [{[1, 1]}, {[2, 2]}, {[3, 3]}]\n" +
 			"             │                   └─ columns: [id t4ibq ner ber hr mmr qz6vt]\n" +
 			"             └─ BEGIN .. END\n" +
@@ -15848,7 +16360,7 @@ WHERE
 			"                 │           │           │   ├─ XOAOP.id:5!null\n" +
 			"                 │           │           │   └─ new.CH3FR:2!null\n" +
 			"                 │           │           └─ Table\n" +
-			"                 │           │               ├─ name: XOAOP\n" +
+			"                 │           │               ├─ name: mydb.`XOAOP`\n" +
 			"                 │           │               └─ columns: [id dzlim]\n" +
 			"                 │           └─ right: TUPLE(NER (longtext), BER (longtext), HR (longtext), MMR (longtext))\n" +
 			"                 │      )\n" +
@@ -15887,7 +16399,7 @@ WHERE
 			"     └─ Insert(id, GXLUB, CH3FR, D237E, JOGI6)\n" +
 			"         ├─ InsertDestination\n" +
 			"         │   └─ Table\n" +
-			"         │       ├─ name: SZQWJ\n" +
+			"         │       ├─ name: mydb.`SZQWJ`\n" +
 			"         │       └─ columns: [id gxlub ch3fr d237e jogi6]\n" +
 			"         └─ Trigger(CREATE TRIGGER SZQWJ_on_insert BEFORE INSERT ON SZQWJ\n" +
 			"            FOR EACH ROW\n" +
@@ -15923,13 +16435,13 @@ WHERE
 			"             │       │               │   ├─ cla.id:7!null\n" +
 			"             │       │               │   └─ bs.IXUXU:10\n" +
 			"             │       │               ├─ TableAlias(cla)\n" +
-			"             │       │               │   └─ IndexedTableAccess(YK2GW)\n" +
-			"             │       │               │       ├─ index: [YK2GW.id]\n" +
+			"             │       │               │   └─ IndexedTableAccess(mydb.`YK2GW`)\n" +
+			"             │       │               │       ├─ index: `PRIMARY` [YK2GW.id]\n" +
 			"             │       │               │       ├─ static: [{[NULL, ∞)}]\n" +
 			"             │       │               │       └─ columns: [id ftqlq]\n" +
 			"             │       │               └─ TableAlias(bs)\n" +
-			"             │       │                   └─ IndexedTableAccess(THNTS)\n" +
-			"             │       │                       ├─ index: [THNTS.IXUXU]\n" +
+			"             │       │                   └─ IndexedTableAccess(mydb.`THNTS`)\n" +
+			"             │       │                       ├─ index: `IXUXU` [THNTS.IXUXU]\n" +
 			"             │       │                       ├─ static: [{[NULL, ∞)}]\n" +
 			"             │       │                       └─ columns: [id ixuxu]\n" +
 			"             │       │   as GXLUB, Subquery\n" +
@@ -15940,8 +16452,8 @@ WHERE
 			"             │       │           ├─ Eq\n" +
 			"             │       │           │   ├─ XOAOP.DZLIM:8!null\n" +
 			"             │       │           │   └─ HR (longtext)\n" +
-			"             │       │           └─ IndexedTableAccess(XOAOP)\n" +
-			"             │       │               ├─ index: [XOAOP.DZLIM]\n" +
+			"             │       │           └─ IndexedTableAccess(mydb.`XOAOP`)\n" +
+			"             │       │               ├─ index: `THCUL` [XOAOP.DZLIM]\n" +
 			"             │       │               ├─ static: [{[HR, HR]}]\n" +
 			"             │       │               └─ columns: [id dzlim]\n" +
 			"             │       │   as CH3FR, CASE  WHEN GreaterThan\n" +
@@ -15956,8 +16468,8 @@ WHERE
 			"             │           │   ├─ ums.id:0!null\n" +
 			"             │           │   └─ TUPLE(1 (longtext), 2 (longtext), 3 (longtext))\n" +
 			"             │           └─ TableAlias(ums)\n" +
-			"             │               └─ IndexedTableAccess(FG26Y)\n" +
-			"             │                   ├─ index: [FG26Y.id]\n" +
+			"             │               └─ IndexedTableAccess(mydb.`FG26Y`)\n" +
+			"             │                   ├─ index: `PRIMARY` [FG26Y.id]\n" +
 			"             │                   ├─ static: [{[1, 1]}, {[2, 2]}, {[3, 3]}]\n" +
 			"             │                   └─ columns: [id t4ibq ner ber hr mmr qz6vt]\n" +
 			"             └─ BEGIN .. END\n" +
@@ -15973,7 +16485,7 @@ WHERE
 			"                 │           │           │   ├─ XOAOP.id:5!null\n" +
 			"                 │           │           │   └─ new.CH3FR:2!null\n" +
 			"                 │           │           └─ Table\n" +
-			"                 │           │               ├─ name: XOAOP\n" +
+			"                 │           │               ├─ name: mydb.`XOAOP`\n" +
 			"                 │           │               └─ columns: [id dzlim]\n" +
 			"                 │           └─ right: TUPLE(NER (longtext), BER (longtext), HR (longtext), MMR (longtext))\n" +
 			"                 │      )\n" +
@@ -16012,7 +16524,7 @@ WHERE
 			"     └─ Insert(id, GXLUB, CH3FR, D237E, JOGI6)\n" +
 			"         ├─ InsertDestination\n" +
 			"         │   └─ Table\n" +
-			"         │       ├─ name: SZQWJ\n" +
+			"         │       ├─ name: mydb.`SZQWJ`\n" +
 			"         │       └─ columns: [id gxlub ch3fr d237e jogi6]\n" +
 			"         └─ Trigger(CREATE TRIGGER SZQWJ_on_insert BEFORE INSERT ON SZQWJ\n" +
 			"            FOR EACH ROW\n" +
@@ -16048,13 +16560,13 @@ WHERE
 			"             │       │               │   ├─ cla.id:7!null\n" +
 			"             │       │               │   └─ bs.IXUXU:10\n" +
 			"             │       │               ├─ TableAlias(cla)\n" +
-			"             │       │               │   └─ IndexedTableAccess(YK2GW)\n" +
-			"             │       │               │       ├─ index: [YK2GW.id]\n" +
+			"             │       │               │   └─ IndexedTableAccess(mydb.`YK2GW`)\n" +
+			"             │       │               │       ├─ index: `PRIMARY` [YK2GW.id]\n" +
 			"             │       │               │       ├─ static: [{[NULL, ∞)}]\n" +
 			"             │       │               │       └─ columns: [id ftqlq]\n" +
 			"             │       │               └─ TableAlias(bs)\n" +
-			"             │       │                   └─ IndexedTableAccess(THNTS)\n" +
-			"             │       │                       ├─ index: [THNTS.IXUXU]\n" +
+			"             │       │                   └─ IndexedTableAccess(mydb.`THNTS`)\n" +
+			"             │       │                       ├─ index: `IXUXU` [THNTS.IXUXU]\n" +
 			"             │       │                       ├─ static: [{[NULL, ∞)}]\n" +
 			"             │       │                       └─ columns: [id ixuxu]\n" +
 			"             │       │   as GXLUB, Subquery\n" +
@@ -16065,8 +16577,8 @@ WHERE
 			"             │       │           ├─ Eq\n" +
 			"             │       │           │   ├─ XOAOP.DZLIM:8!null\n" +
 			"             │       │           │   └─ MMR (longtext)\n" +
-			"             │       │           └─ IndexedTableAccess(XOAOP)\n" +
-			"             │       │               ├─ index: [XOAOP.DZLIM]\n" +
+			"             │       │           └─ IndexedTableAccess(mydb.`XOAOP`)\n" +
+			"             │       │               ├─ index: `THCUL` [XOAOP.DZLIM]\n" +
 			"             │       │               ├─ static: [{[MMR, MMR]}]\n" +
 			"             │       │               └─ columns: [id dzlim]\n" +
 			"             │       │   as CH3FR, CASE  WHEN GreaterThan\n" +
@@ -16081,8 +16593,8 @@ WHERE
 			"             │           │   ├─ ums.id:0!null\n" +
 			"             │           │   └─ TUPLE(1 (longtext), 2 (longtext), 3 (longtext))\n" +
 			"             │           └─ TableAlias(ums)\n" +
-			"             │               └─ IndexedTableAccess(FG26Y)\n" +
-			"             │                   ├─ index: [FG26Y.id]\n" +
+			"             │               └─ IndexedTableAccess(mydb.`FG26Y`)\n" +
+			"             │                   ├─ index: `PRIMARY` [FG26Y.id]\n" +
 			"             │                   ├─ static: [{[1, 1]}, {[2, 2]}, {[3, 3]}]\n" +
 			"             │                   └─ columns: [id t4ibq ner ber hr mmr qz6vt]\n" +
 			"             └─ BEGIN .. END\n" +
@@ -16098,7 +16610,7 @@ WHERE
 			"                 │           │           │   ├─ XOAOP.id:5!null\n" +
 			"                 │           │           │   └─ new.CH3FR:2!null\n" +
 			"                 │           │           └─ Table\n" +
-			"                 │           │               ├─ name: XOAOP\n" +
+			"                 │           │               ├─ name: mydb.`XOAOP`\n" +
 			"                 │           │               └─ columns: [id dzlim]\n" +
 			"                 │           └─ right: TUPLE(NER (longtext), BER (longtext), HR (longtext), MMR (longtext))\n" +
 			"                 │      )\n" +
@@ -16142,7 +16654,7 @@ WHERE
 			"     └─ Insert(id, BTXC5, FHCYT)\n" +
 			"         ├─ InsertDestination\n" +
 			"         │   └─ Table\n" +
-			"         │       ├─ name: TPXBU\n" +
+			"         │       ├─ name: mydb.`TPXBU`\n" +
 			"         │       └─ columns: [id btxc5 fhcyt]\n" +
 			"         └─ Trigger(CREATE TRIGGER TPXBU_on_insert BEFORE INSERT ON TPXBU\n" +
 			"            FOR EACH ROW\n" +
@@ -16160,7 +16672,7 @@ WHERE
 			"             │   └─ Project\n" +
 			"             │       ├─ columns: [lpad(lower(concat(concat(hex((rand() * 4294967296)),lower(hex((rand() * 4294967296))),lower(hex((rand() * 4294967296)))))), 24, '0') as id, NCVD2.BTXC5:0 as BTXC5, NULL (null) as FHCYT]\n" +
 			"             │       └─ SubqueryAlias\n" +
-			"             │           ├─ name: NCVD2\n" +
+			"             │           ├─ name: `NCVD2`\n" +
 			"             │           ├─ outerVisibility: false\n" +
 			"             │           ├─ cacheable: true\n" +
 			"             │           └─ Distinct\n" +
@@ -16178,8 +16690,8 @@ WHERE
 			"             │                       │   │   │   │           └─ Filter\n" +
 			"             │                       │   │   │   │               ├─ NOT\n" +
 			"             │                       │   │   │   │               │   └─ TPXBU.BTXC5:25 IS NULL\n" +
-			"             │                       │   │   │   │               └─ IndexedTableAccess(TPXBU)\n" +
-			"             │                       │   │   │   │                   ├─ index: [TPXBU.BTXC5]\n" +
+			"             │                       │   │   │   │               └─ IndexedTableAccess(mydb.`TPXBU`)\n" +
+			"             │                       │   │   │   │                   ├─ index: `UZ6GK` [TPXBU.BTXC5]\n" +
 			"             │                       │   │   │   │                   ├─ static: [{(NULL, ∞)}]\n" +
 			"             │                       │   │   │   │                   └─ columns: [btxc5]\n" +
 			"             │                       │   │   │   └─ NOT\n" +
@@ -16192,8 +16704,8 @@ WHERE
 			"             │                       │       ├─ umf.id:0!null\n" +
 			"             │                       │       └─ TUPLE(1 (longtext), 2 (longtext), 3 (longtext))\n" +
 			"             │                       └─ TableAlias(umf)\n" +
-			"             │                           └─ IndexedTableAccess(NZKPM)\n" +
-			"             │                               ├─ index: [NZKPM.id]\n" +
+			"             │                           └─ IndexedTableAccess(mydb.`NZKPM`)\n" +
+			"             │                               ├─ index: `PRIMARY` [NZKPM.id]\n" +
 			"             │                               ├─ static: [{[1, 1]}, {[2, 2]}, {[3, 3]}]\n" +
 			"             │                               └─ columns: [id t4ibq fgg57 sshpj nla6o sfj6l tjpt7 arn5p sypkf ivfmk ide43 az6sp fsdy2 xosd4 hmw4h s76om vaf zroh6 qcgts lnfm6 tvawl hdlcl bhhw6 fhcyt qz6vt]\n" +
 			"             └─ BEGIN .. END\n" +
@@ -16203,7 +16715,7 @@ WHERE
 			"                         └─ right: Subquery\n" +
 			"                             ├─ cacheable: false\n" +
 			"                             └─ Table\n" +
-			"                                 ├─ name: TPXHZ\n" +
+			"                                 ├─ name: mydb.`TPXHZ`\n" +
 			"                                 └─ columns: [svaz4]\n" +
 			"                        )\n" +
 			"                         └─ BLOCK\n" +
@@ -16284,7 +16796,7 @@ INNER JOIN THNTS bs ON cla.id = bs.IXUXU`,
 			"     └─ Insert(id, GXLUB, LUEVY, M22QN, TJPT7, ARN5P, XOSD4, IDE43, HMW4H, ZBT6R, FSDY2, LT7K6, SPPYD, QCGTS, TEUJA, QQV4M, FHCYT)\n" +
 			"         ├─ InsertDestination\n" +
 			"         │   └─ Table\n" +
-			"         │       ├─ name: HGMQ6\n" +
+			"         │       ├─ name: mydb.`HGMQ6`\n" +
 			"         │       └─ columns: [id gxlub luevy m22qn tjpt7 arn5p xosd4 ide43 hmw4h zbt6r fsdy2 lt7k6 sppyd qcgts teuja qqv4m fhcyt]\n" +
 			"         └─ Trigger(CREATE TRIGGER HGMQ6_on_insert BEFORE INSERT ON HGMQ6\n" +
 			"            FOR EACH ROW\n" +
@@ -16338,8 +16850,8 @@ INNER JOIN THNTS bs ON cla.id = bs.IXUXU`,
 			"             │       │           │   ├─ nd_for_id_overridden.TW55N:68!null\n" +
 			"             │       │           │   └─ TJ5D2.H4DMT:29\n" +
 			"             │       │           └─ TableAlias(nd_for_id_overridden)\n" +
-			"             │       │               └─ IndexedTableAccess(E2I7U)\n" +
-			"             │       │                   ├─ index: [E2I7U.TW55N]\n" +
+			"             │       │               └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			"             │       │                   ├─ index: `SFMWS` [E2I7U.TW55N]\n" +
 			"             │       │                   └─ columns: [id tw55n]\n" +
 			"             │       │   ELSE Subquery\n" +
 			"             │       │   ├─ cacheable: false\n" +
@@ -16353,8 +16865,8 @@ INNER JOIN THNTS bs ON cla.id = bs.IXUXU`,
 			"             │       │           │       ├─ nd_for_id.FGG57:68\n" +
 			"             │       │           │       └─ umf.FGG57:2\n" +
 			"             │       │           └─ TableAlias(nd_for_id)\n" +
-			"             │       │               └─ IndexedTableAccess(E2I7U)\n" +
-			"             │       │                   ├─ index: [E2I7U.FGG57]\n" +
+			"             │       │               └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			"             │       │                   ├─ index: `TKKOQ` [E2I7U.FGG57]\n" +
 			"             │       │                   ├─ static: [{(NULL, ∞)}]\n" +
 			"             │       │                   └─ columns: [id fgg57]\n" +
 			"             │       │   END as LUEVY, CASE  WHEN Eq\n" +
@@ -16366,8 +16878,8 @@ INNER JOIN THNTS bs ON cla.id = bs.IXUXU`,
 			"             │       │       ├─ columns: [TPXBU.id:67!null]\n" +
 			"             │       │       └─ Filter\n" +
 			"             │       │           ├─ TPXBU.BTXC5:68 IS NULL\n" +
-			"             │       │           └─ IndexedTableAccess(TPXBU)\n" +
-			"             │       │               ├─ index: [TPXBU.BTXC5]\n" +
+			"             │       │           └─ IndexedTableAccess(mydb.`TPXBU`)\n" +
+			"             │       │               ├─ index: `UZ6GK` [TPXBU.BTXC5]\n" +
 			"             │       │               ├─ static: [{[NULL, NULL]}]\n" +
 			"             │       │               └─ columns: [id btxc5]\n" +
 			"             │       │   ELSE Subquery\n" +
@@ -16379,8 +16891,8 @@ INNER JOIN THNTS bs ON cla.id = bs.IXUXU`,
 			"             │       │           │   ├─ aac.BTXC5:68\n" +
 			"             │       │           │   └─ umf.SYPKF:8\n" +
 			"             │       │           └─ TableAlias(aac)\n" +
-			"             │       │               └─ IndexedTableAccess(TPXBU)\n" +
-			"             │       │                   ├─ index: [TPXBU.BTXC5]\n" +
+			"             │       │               └─ IndexedTableAccess(mydb.`TPXBU`)\n" +
+			"             │       │                   ├─ index: `UZ6GK` [TPXBU.BTXC5]\n" +
 			"             │       │                   └─ columns: [id btxc5]\n" +
 			"             │       │   END as M22QN, umf.TJPT7:6 as TJPT7, umf.ARN5P:7 as ARN5P, umf.XOSD4:13 as XOSD4, umf.IDE43:10 as IDE43, CASE  WHEN NOT\n" +
 			"             │       │   └─ Eq\n" +
@@ -16451,7 +16963,7 @@ INNER JOIN THNTS bs ON cla.id = bs.IXUXU`,
 			"             │           │   │   │       │   │       └─ right: Subquery\n" +
 			"             │           │   │   │       │   │           ├─ cacheable: true\n" +
 			"             │           │   │   │       │   │           └─ Table\n" +
-			"             │           │   │   │       │   │               ├─ name: YK2GW\n" +
+			"             │           │   │   │       │   │               ├─ name: mydb.`YK2GW`\n" +
 			"             │           │   │   │       │   │               └─ columns: [ftqlq]\n" +
 			"             │           │   │   │       │   └─ InSubquery\n" +
 			"             │           │   │   │       │       ├─ left: NZKPM.FGG57:2\n" +
@@ -16460,25 +16972,25 @@ INNER JOIN THNTS bs ON cla.id = bs.IXUXU`,
 			"             │           │   │   │       │           └─ Filter\n" +
 			"             │           │   │   │       │               ├─ NOT\n" +
 			"             │           │   │   │       │               │   └─ E2I7U.FGG57:25 IS NULL\n" +
-			"             │           │   │   │       │               └─ IndexedTableAccess(E2I7U)\n" +
-			"             │           │   │   │       │                   ├─ index: [E2I7U.FGG57]\n" +
+			"             │           │   │   │       │               └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			"             │           │   │   │       │                   ├─ index: `TKKOQ` [E2I7U.FGG57]\n" +
 			"             │           │   │   │       │                   ├─ static: [{(NULL, ∞)}]\n" +
 			"             │           │   │   │       │                   └─ columns: [fgg57]\n" +
-			"             │           │   │   │       └─ IndexedTableAccess(NZKPM)\n" +
-			"             │           │   │   │           ├─ index: [NZKPM.id]\n" +
+			"             │           │   │   │       └─ IndexedTableAccess(mydb.`NZKPM`)\n" +
+			"             │           │   │   │           ├─ index: `PRIMARY` [NZKPM.id]\n" +
 			"             │           │   │   │           ├─ static: [{[2, 2]}, {[3, 3]}, {[1, 1]}]\n" +
 			"             │           │   │   │           └─ columns: [id t4ibq fgg57 sshpj nla6o sfj6l tjpt7 arn5p sypkf ivfmk ide43 az6sp fsdy2 xosd4 hmw4h s76om vaf zroh6 qcgts lnfm6 tvawl hdlcl bhhw6 fhcyt qz6vt]\n" +
-			"             │           │   │   └─ TableAlias(TJ5D2)\n" +
+			"             │           │   │   └─ TableAlias(`TJ5D2`)\n" +
 			"             │           │   │       └─ Table\n" +
-			"             │           │   │           ├─ name: SZW6V\n" +
+			"             │           │   │           ├─ name: mydb.`SZW6V`\n" +
 			"             │           │   │           └─ columns: [id t4ibq v7ufh sypkf h4dmt swcqv ykssu fhcyt]\n" +
 			"             │           │   └─ TableAlias(cla)\n" +
-			"             │           │       └─ IndexedTableAccess(YK2GW)\n" +
-			"             │           │           ├─ index: [YK2GW.FTQLQ]\n" +
+			"             │           │       └─ IndexedTableAccess(mydb.`YK2GW`)\n" +
+			"             │           │           ├─ index: `EZCTT` [YK2GW.FTQLQ]\n" +
 			"             │           │           └─ columns: [id ftqlq tuxml paef5 rucy4 tpnj6 lbl53 nb3qs eo7iv muhjf fm34l ty5rf zhtlh npb7w sx3hh isbnf ya7yb c5ykb qk7kt ffge6 fiigj sh3nc ntena m4aub x5air sab6m g5qi5 zvqvd ykssu fhcyt]\n" +
 			"             │           └─ TableAlias(bs)\n" +
-			"             │               └─ IndexedTableAccess(THNTS)\n" +
-			"             │                   ├─ index: [THNTS.IXUXU]\n" +
+			"             │               └─ IndexedTableAccess(mydb.`THNTS`)\n" +
+			"             │                   ├─ index: `IXUXU` [THNTS.IXUXU]\n" +
 			"             │                   └─ columns: [id nfryn ixuxu fhcyt]\n" +
 			"             └─ BEGIN .. END\n" +
 			"                 ├─ IF BLOCK\n" +
@@ -16491,35 +17003,35 @@ INNER JOIN THNTS bs ON cla.id = bs.IXUXU`,
 			"                 │       │   │   │   │   └─ right: Subquery\n" +
 			"                 │       │   │   │   │       ├─ cacheable: false\n" +
 			"                 │       │   │   │   │       └─ Table\n" +
-			"                 │       │   │   │   │           ├─ name: TPXHZ\n" +
+			"                 │       │   │   │   │           ├─ name: mydb.`TPXHZ`\n" +
 			"                 │       │   │   │   │           └─ columns: [svaz4]\n" +
 			"                 │       │   │   │   └─ InSubquery\n" +
 			"                 │       │   │   │       ├─ left: new.ARN5P:5!null\n" +
 			"                 │       │   │   │       └─ right: Subquery\n" +
 			"                 │       │   │   │           ├─ cacheable: false\n" +
 			"                 │       │   │   │           └─ Table\n" +
-			"                 │       │   │   │               ├─ name: TPXHZ\n" +
+			"                 │       │   │   │               ├─ name: mydb.`TPXHZ`\n" +
 			"                 │       │   │   │               └─ columns: [svaz4]\n" +
 			"                 │       │   │   └─ InSubquery\n" +
 			"                 │       │   │       ├─ left: new.XOSD4:6!null\n" +
 			"                 │       │   │       └─ right: Subquery\n" +
 			"                 │       │   │           ├─ cacheable: false\n" +
 			"                 │       │   │           └─ Table\n" +
-			"                 │       │   │               ├─ name: TPXHZ\n" +
+			"                 │       │   │               ├─ name: mydb.`TPXHZ`\n" +
 			"                 │       │   │               └─ columns: [svaz4]\n" +
 			"                 │       │   └─ InSubquery\n" +
 			"                 │       │       ├─ left: new.IDE43:7\n" +
 			"                 │       │       └─ right: Subquery\n" +
 			"                 │       │           ├─ cacheable: false\n" +
 			"                 │       │           └─ Table\n" +
-			"                 │       │               ├─ name: TPXHZ\n" +
+			"                 │       │               ├─ name: mydb.`TPXHZ`\n" +
 			"                 │       │               └─ columns: [svaz4]\n" +
 			"                 │       └─ InSubquery\n" +
 			"                 │           ├─ left: new.HMW4H:8\n" +
 			"                 │           └─ right: Subquery\n" +
 			"                 │               ├─ cacheable: false\n" +
 			"                 │               └─ Table\n" +
-			"                 │                   ├─ name: TPXHZ\n" +
+			"                 │                   ├─ name: mydb.`TPXHZ`\n" +
 			"                 │                   └─ columns: [svaz4]\n" +
 			"                 │      )\n" +
 			"                 │       └─ BLOCK\n" +
@@ -16578,7 +17090,7 @@ INNER JOIN D34QP vc ON C6PUD.AZ6SP LIKE CONCAT(CONCAT('%', vc.TWMSR), '%')`,
 			" └─ Insert(id, Z7CP5, YH4XB)\n" +
 			"     ├─ InsertDestination\n" +
 			"     │   └─ Table\n" +
-			"     │       ├─ name: SEQS3\n" +
+			"     │       ├─ name: mydb.`SEQS3`\n" +
 			"     │       └─ columns: [id z7cp5 yh4xb]\n" +
 			"     └─ Project\n" +
 			"         ├─ columns: [id:0!null, Z7CP5:1!null, YH4XB:2!null]\n" +
@@ -16587,7 +17099,7 @@ INNER JOIN D34QP vc ON C6PUD.AZ6SP LIKE CONCAT(CONCAT('%', vc.TWMSR), '%')`,
 			"             └─ InnerJoin\n" +
 			"                 ├─ C6PUD.AZ6SP LIKE concat(concat('%',vc.TWMSR),'%')\n" +
 			"                 ├─ SubqueryAlias\n" +
-			"                 │   ├─ name: C6PUD\n" +
+			"                 │   ├─ name: `C6PUD`\n" +
 			"                 │   ├─ outerVisibility: false\n" +
 			"                 │   ├─ cacheable: true\n" +
 			"                 │   └─ Project\n" +
@@ -16598,19 +17110,19 @@ INNER JOIN D34QP vc ON C6PUD.AZ6SP LIKE CONCAT(CONCAT('%', vc.TWMSR), '%')`,
 			"                 │           │   └─ mf.TEUJA:1\n" +
 			"                 │           ├─ TableAlias(mf)\n" +
 			"                 │           │   └─ Table\n" +
-			"                 │           │       ├─ name: HGMQ6\n" +
+			"                 │           │       ├─ name: mydb.`HGMQ6`\n" +
 			"                 │           │       └─ columns: [id teuja]\n" +
 			"                 │           └─ Filter\n" +
 			"                 │               ├─ HashIn\n" +
 			"                 │               │   ├─ umf.id:0!null\n" +
 			"                 │               │   └─ TUPLE(1 (longtext), 2 (longtext), 3 (longtext))\n" +
 			"                 │               └─ TableAlias(umf)\n" +
-			"                 │                   └─ IndexedTableAccess(NZKPM)\n" +
-			"                 │                       ├─ index: [NZKPM.id]\n" +
+			"                 │                   └─ IndexedTableAccess(mydb.`NZKPM`)\n" +
+			"                 │                       ├─ index: `PRIMARY` [NZKPM.id]\n" +
 			"                 │                       └─ columns: [id az6sp]\n" +
 			"                 └─ TableAlias(vc)\n" +
 			"                     └─ Table\n" +
-			"                         ├─ name: D34QP\n" +
+			"                         ├─ name: mydb.`D34QP`\n" +
 			"                         └─ columns: [id twmsr]\n" +
 			"",
 	},
@@ -16725,239 +17237,223 @@ FROM
 			" └─ Insert(id, FV24E, UJ6XY, M22QN, NZ4MQ, ETPQV, PRUV2, YKSSU, FHCYT)\n" +
 			"     ├─ InsertDestination\n" +
 			"     │   └─ Table\n" +
-			"     │       ├─ name: HDDVB\n" +
+			"     │       ├─ name: mydb.`HDDVB`\n" +
 			"     │       └─ columns: [id fv24e uj6xy m22qn nz4mq etpqv pruv2 ykssu fhcyt]\n" +
 			"     └─ Project\n" +
 			"         ├─ columns: [id:0!null, FV24E:1!null, UJ6XY:2!null, M22QN:3!null, NZ4MQ:4!null, ETPQV:5, PRUV2:6, YKSSU:7, FHCYT:8]\n" +
 			"         └─ Union distinct\n" +
 			"             ├─ Project\n" +
-			"             │   ├─ columns: [id:0!null, convert\n" +
-			"             │   │   ├─ type: char\n" +
-			"             │   │   └─ FV24E:1!null\n" +
-			"             │   │   as FV24E, convert\n" +
-			"             │   │   ├─ type: char\n" +
-			"             │   │   └─ UJ6XY:2!null\n" +
-			"             │   │   as UJ6XY, M22QN:3!null, NZ4MQ:4, ETPQV:5!null, convert\n" +
-			"             │   │   ├─ type: char\n" +
-			"             │   │   └─ PRUV2:6\n" +
-			"             │   │   as PRUV2, YKSSU:7, FHCYT:8]\n" +
-			"             │   └─ Project\n" +
-			"             │       ├─ columns: [lpad(lower(concat(concat(hex((rand() * 4294967296)),lower(hex((rand() * 4294967296))),lower(hex((rand() * 4294967296)))))), 24, '0') as id, BPNW2.FV24E:1!null as FV24E, BPNW2.UJ6XY:2!null as UJ6XY, BPNW2.M22QN:3!null as M22QN, BPNW2.NZ4MQ:4 as NZ4MQ, BPNW2.MU3KG:0!null as ETPQV, NULL (null) as PRUV2, BPNW2.YKSSU:6 as YKSSU, BPNW2.FHCYT:5 as FHCYT]\n" +
-			"             │       └─ SubqueryAlias\n" +
-			"             │           ├─ name: BPNW2\n" +
-			"             │           ├─ outerVisibility: false\n" +
-			"             │           ├─ cacheable: true\n" +
-			"             │           └─ Distinct\n" +
-			"             │               └─ Project\n" +
-			"             │                   ├─ columns: [TIZHK.id:37!null as MU3KG, J4JYP.id:0!null as FV24E, RHUZN.id:47!null as UJ6XY, aac.id:34!null as M22QN, Subquery\n" +
-			"             │                   │   ├─ cacheable: false\n" +
-			"             │                   │   └─ Project\n" +
-			"             │                   │       ├─ columns: [G3YXS.id:74!null]\n" +
-			"             │                   │       └─ Filter\n" +
-			"             │                   │           ├─ Eq\n" +
-			"             │                   │           │   ├─ concat(G3YXS.ESFVY:75!null,(MI: (longtext),G3YXS.SL76B:76!null,) (longtext))\n" +
-			"             │                   │           │   └─ TIZHK.IDUT2:41\n" +
-			"             │                   │           └─ TableAlias(G3YXS)\n" +
-			"             │                   │               └─ Table\n" +
-			"             │                   │                   ├─ name: YYBCX\n" +
-			"             │                   │                   └─ columns: [id esfvy sl76b]\n" +
-			"             │                   │   as NZ4MQ, NULL (null) as FHCYT, NULL (null) as YKSSU]\n" +
-			"             │                   └─ Filter\n" +
+			"             │   ├─ columns: [lpad(lower(concat(concat(hex((rand() * 4294967296)),lower(hex((rand() * 4294967296))),lower(hex((rand() * 4294967296)))))), 24, '0') as id, BPNW2.FV24E:1!null as FV24E, BPNW2.UJ6XY:2!null as UJ6XY, BPNW2.M22QN:3!null as M22QN, BPNW2.NZ4MQ:4 as NZ4MQ, BPNW2.MU3KG:0!null as ETPQV, NULL (null) as PRUV2, BPNW2.YKSSU:6 as YKSSU, BPNW2.FHCYT:5 as FHCYT]\n" +
+			"             │   └─ SubqueryAlias\n" +
+			"             │       ├─ name: `BPNW2`\n" +
+			"             │       ├─ outerVisibility: false\n" +
+			"             │       ├─ cacheable: true\n" +
+			"             │       └─ Distinct\n" +
+			"             │           └─ Project\n" +
+			"             │               ├─ columns: [TIZHK.id:37!null as MU3KG, J4JYP.id:0!null as FV24E, RHUZN.id:47!null as UJ6XY, aac.id:34!null as M22QN, Subquery\n" +
+			"             │               │   ├─ cacheable: false\n" +
+			"             │               │   └─ Project\n" +
+			"             │               │       ├─ columns: [G3YXS.id:74!null]\n" +
+			"             │               │       └─ Filter\n" +
+			"             │               │           ├─ Eq\n" +
+			"             │               │           │   ├─ concat(G3YXS.ESFVY:75!null,(MI: (longtext),G3YXS.SL76B:76!null,) (longtext))\n" +
+			"             │               │           │   └─ TIZHK.IDUT2:41\n" +
+			"             │               │           └─ TableAlias(`G3YXS`)\n" +
+			"             │               │               └─ Table\n" +
+			"             │               │                   ├─ name: mydb.`YYBCX`\n" +
+			"             │               │                   └─ columns: [id esfvy sl76b]\n" +
+			"             │               │   as NZ4MQ, NULL (null) as FHCYT, NULL (null) as YKSSU]\n" +
+			"             │               └─ Filter\n" +
+			"             │                   ├─ AND\n" +
+			"             │                   │   ├─ Eq\n" +
+			"             │                   │   │   ├─ aac.BTXC5:35\n" +
+			"             │                   │   │   └─ TIZHK.SYPKF:40\n" +
+			"             │                   │   └─ NHMXW.id:64 IS NULL\n" +
+			"             │                   └─ LeftOuterLookupJoin\n" +
 			"             │                       ├─ AND\n" +
+			"             │                       │   ├─ AND\n" +
+			"             │                       │   │   ├─ AND\n" +
+			"             │                       │   │   │   ├─ AND\n" +
+			"             │                       │   │   │   │   ├─ Eq\n" +
+			"             │                       │   │   │   │   │   ├─ NHMXW.SWCQV:71!null\n" +
+			"             │                       │   │   │   │   │   └─ 0 (tinyint)\n" +
+			"             │                       │   │   │   │   └─ Eq\n" +
+			"             │                       │   │   │   │       ├─ NHMXW.NOHHR:65!null\n" +
+			"             │                       │   │   │   │       └─ TIZHK.TVNW2:38\n" +
+			"             │                       │   │   │   └─ Eq\n" +
+			"             │                       │   │   │       ├─ NHMXW.AVPYF:66!null\n" +
+			"             │                       │   │   │       └─ TIZHK.ZHITY:39\n" +
+			"             │                       │   │   └─ Eq\n" +
+			"             │                       │   │       ├─ NHMXW.SYPKF:67!null\n" +
+			"             │                       │   │       └─ TIZHK.SYPKF:40\n" +
+			"             │                       │   └─ Eq\n" +
+			"             │                       │       ├─ NHMXW.IDUT2:68!null\n" +
+			"             │                       │       └─ TIZHK.IDUT2:41\n" +
+			"             │                       ├─ LookupJoin\n" +
 			"             │                       │   ├─ Eq\n" +
-			"             │                       │   │   ├─ aac.BTXC5:35\n" +
-			"             │                       │   │   └─ TIZHK.SYPKF:40\n" +
-			"             │                       │   └─ NHMXW.id:64 IS NULL\n" +
-			"             │                       └─ LeftOuterLookupJoin\n" +
-			"             │                           ├─ AND\n" +
-			"             │                           │   ├─ AND\n" +
-			"             │                           │   │   ├─ AND\n" +
-			"             │                           │   │   │   ├─ AND\n" +
-			"             │                           │   │   │   │   ├─ Eq\n" +
-			"             │                           │   │   │   │   │   ├─ NHMXW.SWCQV:71!null\n" +
-			"             │                           │   │   │   │   │   └─ 0 (tinyint)\n" +
-			"             │                           │   │   │   │   └─ Eq\n" +
-			"             │                           │   │   │   │       ├─ NHMXW.NOHHR:65!null\n" +
-			"             │                           │   │   │   │       └─ TIZHK.TVNW2:38\n" +
-			"             │                           │   │   │   └─ Eq\n" +
-			"             │                           │   │   │       ├─ NHMXW.AVPYF:66!null\n" +
-			"             │                           │   │   │       └─ TIZHK.ZHITY:39\n" +
-			"             │                           │   │   └─ Eq\n" +
-			"             │                           │   │       ├─ NHMXW.SYPKF:67!null\n" +
-			"             │                           │   │       └─ TIZHK.SYPKF:40\n" +
-			"             │                           │   └─ Eq\n" +
-			"             │                           │       ├─ NHMXW.IDUT2:68!null\n" +
-			"             │                           │       └─ TIZHK.IDUT2:41\n" +
-			"             │                           ├─ LookupJoin\n" +
-			"             │                           │   ├─ Eq\n" +
-			"             │                           │   │   ├─ RHUZN.ZH72S:54\n" +
-			"             │                           │   │   └─ TIZHK.ZHITY:39\n" +
-			"             │                           │   ├─ LookupJoin\n" +
-			"             │                           │   │   ├─ Eq\n" +
-			"             │                           │   │   │   ├─ J4JYP.ZH72S:7\n" +
-			"             │                           │   │   │   └─ TIZHK.TVNW2:38\n" +
-			"             │                           │   │   ├─ LookupJoin\n" +
-			"             │                           │   │   │   ├─ Eq\n" +
-			"             │                           │   │   │   │   ├─ aac.id:34!null\n" +
-			"             │                           │   │   │   │   └─ mf.M22QN:20!null\n" +
-			"             │                           │   │   │   ├─ LookupJoin\n" +
-			"             │                           │   │   │   │   ├─ Eq\n" +
-			"             │                           │   │   │   │   │   ├─ mf.LUEVY:19!null\n" +
-			"             │                           │   │   │   │   │   └─ J4JYP.id:0!null\n" +
-			"             │                           │   │   │   │   ├─ TableAlias(J4JYP)\n" +
-			"             │                           │   │   │   │   │   └─ Table\n" +
-			"             │                           │   │   │   │   │       ├─ name: E2I7U\n" +
-			"             │                           │   │   │   │   │       └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
-			"             │                           │   │   │   │   └─ TableAlias(mf)\n" +
-			"             │                           │   │   │   │       └─ IndexedTableAccess(HGMQ6)\n" +
-			"             │                           │   │   │   │           ├─ index: [HGMQ6.LUEVY]\n" +
-			"             │                           │   │   │   │           └─ columns: [id gxlub luevy m22qn tjpt7 arn5p xosd4 ide43 hmw4h zbt6r fsdy2 lt7k6 sppyd qcgts teuja qqv4m fhcyt]\n" +
-			"             │                           │   │   │   └─ TableAlias(aac)\n" +
-			"             │                           │   │   │       └─ IndexedTableAccess(TPXBU)\n" +
-			"             │                           │   │   │           ├─ index: [TPXBU.id]\n" +
-			"             │                           │   │   │           └─ columns: [id btxc5 fhcyt]\n" +
-			"             │                           │   │   └─ Filter\n" +
-			"             │                           │   │       ├─ HashIn\n" +
-			"             │                           │   │       │   ├─ TIZHK.id:0!null\n" +
-			"             │                           │   │       │   └─ TUPLE(1 (longtext), 2 (longtext), 3 (longtext))\n" +
-			"             │                           │   │       └─ TableAlias(TIZHK)\n" +
-			"             │                           │   │           └─ IndexedTableAccess(WRZVO)\n" +
-			"             │                           │   │               ├─ index: [WRZVO.TVNW2]\n" +
-			"             │                           │   │               └─ columns: [id tvnw2 zhity sypkf idut2 o6qj3 no2ja ykssu fhcyt qz6vt]\n" +
-			"             │                           │   └─ TableAlias(RHUZN)\n" +
-			"             │                           │       └─ IndexedTableAccess(E2I7U)\n" +
-			"             │                           │           ├─ index: [E2I7U.ZH72S]\n" +
-			"             │                           │           └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
-			"             │                           └─ TableAlias(NHMXW)\n" +
-			"             │                               └─ IndexedTableAccess(WGSDC)\n" +
-			"             │                                   ├─ index: [WGSDC.AVPYF]\n" +
-			"             │                                   └─ columns: [id nohhr avpyf sypkf idut2 fzxv5 dqygv swcqv ykssu fhcyt]\n" +
+			"             │                       │   │   ├─ RHUZN.ZH72S:54\n" +
+			"             │                       │   │   └─ TIZHK.ZHITY:39\n" +
+			"             │                       │   ├─ LookupJoin\n" +
+			"             │                       │   │   ├─ Eq\n" +
+			"             │                       │   │   │   ├─ J4JYP.ZH72S:7\n" +
+			"             │                       │   │   │   └─ TIZHK.TVNW2:38\n" +
+			"             │                       │   │   ├─ LookupJoin\n" +
+			"             │                       │   │   │   ├─ Eq\n" +
+			"             │                       │   │   │   │   ├─ aac.id:34!null\n" +
+			"             │                       │   │   │   │   └─ mf.M22QN:20!null\n" +
+			"             │                       │   │   │   ├─ LookupJoin\n" +
+			"             │                       │   │   │   │   ├─ Eq\n" +
+			"             │                       │   │   │   │   │   ├─ mf.LUEVY:19!null\n" +
+			"             │                       │   │   │   │   │   └─ J4JYP.id:0!null\n" +
+			"             │                       │   │   │   │   ├─ TableAlias(`J4JYP`)\n" +
+			"             │                       │   │   │   │   │   └─ Table\n" +
+			"             │                       │   │   │   │   │       ├─ name: mydb.`E2I7U`\n" +
+			"             │                       │   │   │   │   │       └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
+			"             │                       │   │   │   │   └─ TableAlias(mf)\n" +
+			"             │                       │   │   │   │       └─ IndexedTableAccess(mydb.`HGMQ6`)\n" +
+			"             │                       │   │   │   │           ├─ index: `LUEVY` [HGMQ6.LUEVY]\n" +
+			"             │                       │   │   │   │           └─ columns: [id gxlub luevy m22qn tjpt7 arn5p xosd4 ide43 hmw4h zbt6r fsdy2 lt7k6 sppyd qcgts teuja qqv4m fhcyt]\n" +
+			"             │                       │   │   │   └─ TableAlias(aac)\n" +
+			"             │                       │   │   │       └─ IndexedTableAccess(mydb.`TPXBU`)\n" +
+			"             │                       │   │   │           ├─ index: `PRIMARY` [TPXBU.id]\n" +
+			"             │                       │   │   │           └─ columns: [id btxc5 fhcyt]\n" +
+			"             │                       │   │   └─ Filter\n" +
+			"             │                       │   │       ├─ HashIn\n" +
+			"             │                       │   │       │   ├─ TIZHK.id:0!null\n" +
+			"             │                       │   │       │   └─ TUPLE(1 (longtext), 2 (longtext), 3 (longtext))\n" +
+			"             │                       │   │       └─ TableAlias(`TIZHK`)\n" +
+			"             │                       │   │           └─ IndexedTableAccess(mydb.`WRZVO`)\n" +
+			"             │                       │   │               ├─ index: `GAG3S` [WRZVO.TVNW2]\n" +
+			"             │                       │   │               └─ columns: [id tvnw2 zhity sypkf idut2 o6qj3 no2ja ykssu fhcyt qz6vt]\n" +
+			"             │                       │   └─ TableAlias(`RHUZN`)\n" +
+			"             │                       │       └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			"             │                       │           ├─ index: `URBSA` [E2I7U.ZH72S]\n" +
+			"             │                       │           └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
+			"             │                       └─ TableAlias(`NHMXW`)\n" +
+			"             │                           └─ IndexedTableAccess(mydb.`WGSDC`)\n" +
+			"             │                               ├─ index: `F7H3Y` [WGSDC.AVPYF]\n" +
+			"             │                               └─ columns: [id nohhr avpyf sypkf idut2 fzxv5 dqygv swcqv ykssu fhcyt]\n" +
 			"             └─ Project\n" +
-			"                 ├─ columns: [id:0!null, FV24E:1 as FV24E, UJ6XY:2 as UJ6XY, M22QN:3, NZ4MQ:4, ETPQV:5!null, convert\n" +
-			"                 │   ├─ type: char\n" +
-			"                 │   └─ PRUV2:6\n" +
-			"                 │   as PRUV2, YKSSU:7, FHCYT:8]\n" +
-			"                 └─ Project\n" +
-			"                     ├─ columns: [lpad(lower(concat(concat(hex((rand() * 4294967296)),lower(hex((rand() * 4294967296))),lower(hex((rand() * 4294967296)))))), 24, '0') as id, BPNW2.FV24E:1 as FV24E, BPNW2.UJ6XY:2 as UJ6XY, Subquery\n" +
-			"                     │   ├─ cacheable: false\n" +
-			"                     │   └─ Project\n" +
-			"                     │       ├─ columns: [aac.id:8!null]\n" +
-			"                     │       └─ Filter\n" +
-			"                     │           ├─ Eq\n" +
-			"                     │           │   ├─ aac.BTXC5:9\n" +
-			"                     │           │   └─ BPNW2.SYPKF:3\n" +
-			"                     │           └─ TableAlias(aac)\n" +
-			"                     │               └─ IndexedTableAccess(TPXBU)\n" +
-			"                     │                   ├─ index: [TPXBU.BTXC5]\n" +
-			"                     │                   └─ columns: [id btxc5]\n" +
-			"                     │   as M22QN, BPNW2.NZ4MQ:4 as NZ4MQ, BPNW2.MU3KG:0!null as ETPQV, BPNW2.I4NDZ:7 as PRUV2, BPNW2.YKSSU:6 as YKSSU, BPNW2.FHCYT:5 as FHCYT]\n" +
-			"                     └─ SubqueryAlias\n" +
-			"                         ├─ name: BPNW2\n" +
-			"                         ├─ outerVisibility: false\n" +
-			"                         ├─ cacheable: true\n" +
-			"                         └─ Distinct\n" +
-			"                             └─ Project\n" +
-			"                                 ├─ columns: [TIZHK.id:0!null as MU3KG, CASE  WHEN NOT\n" +
-			"                                 │   └─ NHMXW.FZXV5:15 IS NULL\n" +
-			"                                 │   THEN Subquery\n" +
-			"                                 │   ├─ cacheable: false\n" +
-			"                                 │   └─ Project\n" +
-			"                                 │       ├─ columns: [overridden_nd_mutant.id:54!null]\n" +
-			"                                 │       └─ Filter\n" +
-			"                                 │           ├─ Eq\n" +
-			"                                 │           │   ├─ overridden_nd_mutant.TW55N:55!null\n" +
-			"                                 │           │   └─ NHMXW.FZXV5:15\n" +
-			"                                 │           └─ TableAlias(overridden_nd_mutant)\n" +
-			"                                 │               └─ IndexedTableAccess(E2I7U)\n" +
-			"                                 │                   ├─ index: [E2I7U.TW55N]\n" +
-			"                                 │                   └─ columns: [id tw55n]\n" +
-			"                                 │   ELSE J4JYP.id:20 END as FV24E, CASE  WHEN NOT\n" +
-			"                                 │   └─ NHMXW.DQYGV:16 IS NULL\n" +
-			"                                 │   THEN Subquery\n" +
-			"                                 │   ├─ cacheable: false\n" +
-			"                                 │   └─ Project\n" +
-			"                                 │       ├─ columns: [overridden_QI2IEner.id:54!null]\n" +
-			"                                 │       └─ Filter\n" +
-			"                                 │           ├─ Eq\n" +
-			"                                 │           │   ├─ overridden_QI2IEner.TW55N:55!null\n" +
-			"                                 │           │   └─ NHMXW.DQYGV:16\n" +
-			"                                 │           └─ TableAlias(overridden_QI2IEner)\n" +
-			"                                 │               └─ Table\n" +
-			"                                 │                   ├─ name: E2I7U\n" +
-			"                                 │                   └─ columns: [id tw55n]\n" +
-			"                                 │   ELSE RHUZN.id:37 END as UJ6XY, TIZHK.SYPKF:3 as SYPKF, Subquery\n" +
-			"                                 │   ├─ cacheable: false\n" +
-			"                                 │   └─ Project\n" +
-			"                                 │       ├─ columns: [G3YXS.id:54!null]\n" +
-			"                                 │       └─ Filter\n" +
-			"                                 │           ├─ Eq\n" +
-			"                                 │           │   ├─ concat(G3YXS.ESFVY:55!null,(MI: (longtext),G3YXS.SL76B:56!null,) (longtext))\n" +
-			"                                 │           │   └─ TIZHK.IDUT2:4\n" +
-			"                                 │           └─ TableAlias(G3YXS)\n" +
-			"                                 │               └─ Table\n" +
-			"                                 │                   ├─ name: YYBCX\n" +
-			"                                 │                   └─ columns: [id esfvy sl76b]\n" +
-			"                                 │   as NZ4MQ, NULL (null) as FHCYT, NULL (null) as YKSSU, NHMXW.id:10 as I4NDZ]\n" +
-			"                                 └─ Filter\n" +
-			"                                     ├─ AND\n" +
-			"                                     │   ├─ HashIn\n" +
-			"                                     │   │   ├─ TIZHK.id:0!null\n" +
-			"                                     │   │   └─ TUPLE(1 (longtext), 2 (longtext), 3 (longtext))\n" +
-			"                                     │   └─ NOT\n" +
-			"                                     │       └─ NHMXW.id:10 IS NULL\n" +
-			"                                     └─ LeftOuterHashJoin\n" +
-			"                                         ├─ Eq\n" +
-			"                                         │   ├─ RHUZN.ZH72S:44\n" +
-			"                                         │   └─ TIZHK.ZHITY:2\n" +
-			"                                         ├─ LeftOuterHashJoin\n" +
-			"                                         │   ├─ Eq\n" +
-			"                                         │   │   ├─ J4JYP.ZH72S:27\n" +
-			"                                         │   │   └─ TIZHK.TVNW2:1\n" +
-			"                                         │   ├─ LeftOuterMergeJoin\n" +
-			"                                         │   │   ├─ cmp: Eq\n" +
-			"                                         │   │   │   ├─ TIZHK.TVNW2:1\n" +
-			"                                         │   │   │   └─ NHMXW.NOHHR:11!null\n" +
-			"                                         │   │   ├─ sel: AND\n" +
-			"                                         │   │   │   ├─ AND\n" +
-			"                                         │   │   │   │   ├─ AND\n" +
-			"                                         │   │   │   │   │   ├─ Eq\n" +
-			"                                         │   │   │   │   │   │   ├─ NHMXW.SWCQV:17!null\n" +
-			"                                         │   │   │   │   │   │   └─ 0 (tinyint)\n" +
-			"                                         │   │   │   │   │   └─ Eq\n" +
-			"                                         │   │   │   │   │       ├─ NHMXW.AVPYF:12!null\n" +
-			"                                         │   │   │   │   │       └─ TIZHK.ZHITY:2\n" +
-			"                                         │   │   │   │   └─ Eq\n" +
-			"                                         │   │   │   │       ├─ NHMXW.SYPKF:13!null\n" +
-			"                                         │   │   │   │       └─ TIZHK.SYPKF:3\n" +
-			"                                         │   │   │   └─ Eq\n" +
-			"                                         │   │   │       ├─ NHMXW.IDUT2:14!null\n" +
-			"                                         │   │   │       └─ TIZHK.IDUT2:4\n" +
-			"                                         │   │   ├─ TableAlias(TIZHK)\n" +
-			"                                         │   │   │   └─ IndexedTableAccess(WRZVO)\n" +
-			"                                         │   │   │       ├─ index: [WRZVO.TVNW2]\n" +
-			"                                         │   │   │       ├─ static: [{[NULL, ∞)}]\n" +
-			"                                         │   │   │       └─ columns: [id tvnw2 zhity sypkf idut2 o6qj3 no2ja ykssu fhcyt qz6vt]\n" +
-			"                                         │   │   └─ TableAlias(NHMXW)\n" +
-			"                                         │   │       └─ IndexedTableAccess(WGSDC)\n" +
-			"                                         │   │           ├─ index: [WGSDC.NOHHR]\n" +
-			"                                         │   │           ├─ static: [{[NULL, ∞)}]\n" +
-			"                                         │   │           └─ columns: [id nohhr avpyf sypkf idut2 fzxv5 dqygv swcqv ykssu fhcyt]\n" +
-			"                                         │   └─ HashLookup\n" +
-			"                                         │       ├─ source: TUPLE(TIZHK.TVNW2:1)\n" +
-			"                                         │       ├─ target: TUPLE(J4JYP.ZH72S:7)\n" +
-			"                                         │       └─ CachedResults\n" +
-			"                                         │           └─ TableAlias(J4JYP)\n" +
-			"                                         │               └─ Table\n" +
-			"                                         │                   ├─ name: E2I7U\n" +
-			"                                         │                   └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
-			"                                         └─ HashLookup\n" +
-			"                                             ├─ source: TUPLE(TIZHK.ZHITY:2)\n" +
-			"                                             ├─ target: TUPLE(RHUZN.ZH72S:7)\n" +
-			"                                             └─ CachedResults\n" +
-			"                                                 └─ TableAlias(RHUZN)\n" +
-			"                                                     └─ Table\n" +
-			"                                                         ├─ name: E2I7U\n" +
-			"                                                         └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
+			"                 ├─ columns: [lpad(lower(concat(concat(hex((rand() * 4294967296)),lower(hex((rand() * 4294967296))),lower(hex((rand() * 4294967296)))))), 24, '0') as id, BPNW2.FV24E:1 as FV24E, BPNW2.UJ6XY:2 as UJ6XY, Subquery\n" +
+			"                 │   ├─ cacheable: false\n" +
+			"                 │   └─ Project\n" +
+			"                 │       ├─ columns: [aac.id:8!null]\n" +
+			"                 │       └─ Filter\n" +
+			"                 │           ├─ Eq\n" +
+			"                 │           │   ├─ aac.BTXC5:9\n" +
+			"                 │           │   └─ BPNW2.SYPKF:3\n" +
+			"                 │           └─ TableAlias(aac)\n" +
+			"                 │               └─ IndexedTableAccess(mydb.`TPXBU`)\n" +
+			"                 │                   ├─ index: `UZ6GK` [TPXBU.BTXC5]\n" +
+			"                 │                   └─ columns: [id btxc5]\n" +
+			"                 │   as M22QN, BPNW2.NZ4MQ:4 as NZ4MQ, BPNW2.MU3KG:0!null as ETPQV, BPNW2.I4NDZ:7 as PRUV2, BPNW2.YKSSU:6 as YKSSU, BPNW2.FHCYT:5 as FHCYT]\n" +
+			"                 └─ SubqueryAlias\n" +
+			"                     ├─ name: `BPNW2`\n" +
+			"                     ├─ outerVisibility: false\n" +
+			"                     ├─ cacheable: true\n" +
+			"                     └─ Distinct\n" +
+			"                         └─ Project\n" +
+			"                             ├─ columns: [TIZHK.id:0!null as MU3KG, CASE  WHEN NOT\n" +
+			"                             │   └─ NHMXW.FZXV5:15 IS NULL\n" +
+			"                             │   THEN Subquery\n" +
+			"                             │   ├─ cacheable: false\n" +
+			"                             │   └─ Project\n" +
+			"                             │       ├─ columns: [overridden_nd_mutant.id:54!null]\n" +
+			"                             │       └─ Filter\n" +
+			"                             │           ├─ Eq\n" +
+			"                             │           │   ├─ overridden_nd_mutant.TW55N:55!null\n" +
+			"                             │           │   └─ NHMXW.FZXV5:15\n" +
+			"                             │           └─ TableAlias(overridden_nd_mutant)\n" +
+			"                             │               └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			"                             │                   ├─ index: `SFMWS` [E2I7U.TW55N]\n" +
+			"                             │                   └─ columns: [id tw55n]\n" +
+			"                             │   ELSE J4JYP.id:20 END as FV24E, CASE  WHEN NOT\n" +
+			"                             │   └─ NHMXW.DQYGV:16 IS NULL\n" +
+			"                             │   THEN Subquery\n" +
+			"                             │   ├─ cacheable: false\n" +
+			"                             │   └─ Project\n" +
+			"                             │       ├─ columns: [overridden_QI2IEner.id:54!null]\n" +
+			"                             │       └─ Filter\n" +
+			"                             │           ├─ Eq\n" +
+			"                             │           │   ├─ overridden_QI2IEner.TW55N:55!null\n" +
+			"                             │           │   └─ NHMXW.DQYGV:16\n" +
+			"                             │           └─ TableAlias(`overridden_QI2IEner`)\n" +
+			"                             │               └─ Table\n" +
+			"                             │                   ├─ name: mydb.`E2I7U`\n" +
+			"                             │                   └─ columns: [id tw55n]\n" +
+			"                             │   ELSE RHUZN.id:37 END as UJ6XY, TIZHK.SYPKF:3 as SYPKF, Subquery\n" +
+			"                             │   ├─ cacheable: false\n" +
+			"                             │   └─ Project\n" +
+			"                             │       ├─ columns: [G3YXS.id:54!null]\n" +
+			"                             │       └─ Filter\n" +
+			"                             │           ├─ Eq\n" +
+			"                             │           │   ├─ concat(G3YXS.ESFVY:55!null,(MI: (longtext),G3YXS.SL76B:56!null,) (longtext))\n" +
+			"                             │           │   └─ TIZHK.IDUT2:4\n" +
+			"                             │           └─ TableAlias(`G3YXS`)\n" +
+			"                             │               └─ Table\n" +
+			"                             │                   ├─ name: mydb.`YYBCX`\n" +
+			"                             │                   └─ columns: [id esfvy sl76b]\n" +
+			"                             │   as NZ4MQ, NULL (null) as FHCYT, NULL (null) as YKSSU, NHMXW.id:10 as I4NDZ]\n" +
+			"                             └─ Filter\n" +
+			"                                 ├─ AND\n" +
+			"                                 │   ├─ HashIn\n" +
+			"                                 │   │   ├─ TIZHK.id:0!null\n" +
+			"                                 │   │   └─ TUPLE(1 (longtext), 2 (longtext), 3 (longtext))\n" +
+			"                                 │   └─ NOT\n" +
+			"                                 │       └─ NHMXW.id:10 IS NULL\n" +
+			"                                 └─ LeftOuterHashJoin\n" +
+			"                                     ├─ Eq\n" +
+			"                                     │   ├─ RHUZN.ZH72S:44\n" +
+			"                                     │   └─ TIZHK.ZHITY:2\n" +
+			"                                     ├─ LeftOuterHashJoin\n" +
+			"                                     │   ├─ Eq\n" +
+			"                                     │   │   ├─ J4JYP.ZH72S:27\n" +
+			"                                     │   │   └─ TIZHK.TVNW2:1\n" +
+			"                                     │   ├─ LeftOuterMergeJoin\n" +
+			"                                     │   │   ├─ cmp: Eq\n" +
+			"                                     │   │   │   ├─ TIZHK.TVNW2:1\n" +
+			"                                     │   │   │   └─ NHMXW.NOHHR:11!null\n" +
+			"                                     │   │   ├─ sel: AND\n" +
+			"                                     │   │   │   ├─ AND\n" +
+			"                                     │   │   │   │   ├─ AND\n" +
+			"                                     │   │   │   │   │   ├─ Eq\n" +
+			"                                     │   │   │   │   │   │   ├─ NHMXW.SWCQV:17!null\n" +
+			"                                     │   │   │   │   │   │   └─ 0 (tinyint)\n" +
+			"                                     │   │   │   │   │   └─ Eq\n" +
+			"                                     │   │   │   │   │       ├─ NHMXW.AVPYF:12!null\n" +
+			"                                     │   │   │   │   │       └─ TIZHK.ZHITY:2\n" +
+			"                                     │   │   │   │   └─ Eq\n" +
+			"                                     │   │   │   │       ├─ NHMXW.SYPKF:13!null\n" +
+			"                                     │   │   │   │       └─ TIZHK.SYPKF:3\n" +
+			"                                     │   │   │   └─ Eq\n" +
+			"                                     │   │   │       ├─ NHMXW.IDUT2:14!null\n" +
+			"                                     │   │   │       └─ TIZHK.IDUT2:4\n" +
+			"                                     │   │   ├─ TableAlias(`TIZHK`)\n" +
+			"                                     │   │   │   └─ IndexedTableAccess(mydb.`WRZVO`)\n" +
+			"                                     │   │   │       ├─ index: `GAG3S` [WRZVO.TVNW2]\n" +
+			"                                     │   │   │       ├─ static: [{[NULL, ∞)}]\n" +
+			"                                     │   │   │       └─ columns: [id tvnw2 zhity sypkf idut2 o6qj3 no2ja ykssu fhcyt qz6vt]\n" +
+			"                                     │   │   └─ TableAlias(`NHMXW`)\n" +
+			"                                     │   │       └─ IndexedTableAccess(mydb.`WGSDC`)\n" +
+			"                                     │   │           ├─ index: `XW5IP` [WGSDC.NOHHR]\n" +
+			"                                     │   │           ├─ static: [{[NULL, ∞)}]\n" +
+			"                                     │   │           └─ columns: [id nohhr avpyf sypkf idut2 fzxv5 dqygv swcqv ykssu fhcyt]\n" +
+			"                                     │   └─ HashLookup\n" +
+			"                                     │       ├─ source: TUPLE(TIZHK.TVNW2:1)\n" +
+			"                                     │       ├─ target: TUPLE(J4JYP.ZH72S:7)\n" +
+			"                                     │       └─ CachedResults\n" +
+			"                                     │           └─ TableAlias(`J4JYP`)\n" +
+			"                                     │               └─ Table\n" +
+			"                                     │                   ├─ name: mydb.`E2I7U`\n" +
+			"                                     │                   └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
+			"                                     └─ HashLookup\n" +
+			"                                         ├─ source: TUPLE(TIZHK.ZHITY:2)\n" +
+			"                                         ├─ target: TUPLE(RHUZN.ZH72S:7)\n" +
+			"                                         └─ CachedResults\n" +
+			"                                             └─ TableAlias(`RHUZN`)\n" +
+			"                                                 └─ Table\n" +
+			"                                                     ├─ name: mydb.`E2I7U`\n" +
+			"                                                     └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
 			"",
 	},
 	{
@@ -17047,7 +17543,7 @@ WHERE
 			"     └─ Insert(id, NO52D, VYO5E, DKCAJ, ADURZ, FHCYT)\n" +
 			"         ├─ InsertDestination\n" +
 			"         │   └─ Table\n" +
-			"         │       ├─ name: SFEGG\n" +
+			"         │       ├─ name: mydb.`SFEGG`\n" +
 			"         │       └─ columns: [id no52d vyo5e dkcaj adurz fhcyt]\n" +
 			"         └─ Trigger(CREATE TRIGGER SFEGG_on_insert BEFORE INSERT ON SFEGG\n" +
 			"            FOR EACH ROW\n" +
@@ -17130,7 +17626,7 @@ WHERE
 			"             │           │   │                           ├─ NOT\n" +
 			"             │           │   │                           │   └─ SFEGG.VYO5E:6 IS NULL\n" +
 			"             │           │   │                           └─ Table\n" +
-			"             │           │   │                               ├─ name: SFEGG\n" +
+			"             │           │   │                               ├─ name: mydb.`SFEGG`\n" +
 			"             │           │   │                               └─ columns: [id no52d vyo5e dkcaj adurz fhcyt]\n" +
 			"             │           │   └─ AND\n" +
 			"             │           │       ├─ rs.VYO5E:1 IS NULL\n" +
@@ -17145,7 +17641,7 @@ WHERE
 			"             │           │                           └─ Filter\n" +
 			"             │           │                               ├─ SFEGG.VYO5E:6 IS NULL\n" +
 			"             │           │                               └─ Table\n" +
-			"             │           │                                   ├─ name: SFEGG\n" +
+			"             │           │                                   ├─ name: mydb.`SFEGG`\n" +
 			"             │           │                                   └─ columns: [id no52d vyo5e dkcaj adurz fhcyt]\n" +
 			"             │           └─ SubqueryAlias\n" +
 			"             │               ├─ name: rs\n" +
@@ -17171,7 +17667,7 @@ WHERE
 			"             │                           │           ├─ nd.TW55N:10!null\n" +
 			"             │                           │           └─ NK7FP.FVUCX:3\n" +
 			"             │                           ├─ SubqueryAlias\n" +
-			"             │                           │   ├─ name: NK7FP\n" +
+			"             │                           │   ├─ name: `NK7FP`\n" +
 			"             │                           │   ├─ outerVisibility: false\n" +
 			"             │                           │   ├─ cacheable: true\n" +
 			"             │                           │   └─ Distinct\n" +
@@ -17197,13 +17693,13 @@ WHERE
 			"             │                           │                   │       ├─ I7HCR.BTXC5:16!null\n" +
 			"             │                           │                   │       └─ uct.LJLUM:5\n" +
 			"             │                           │                   ├─ TableAlias(uct)\n" +
-			"             │                           │                   │   └─ IndexedTableAccess(OUBDL)\n" +
-			"             │                           │                   │       ├─ index: [OUBDL.FTQLQ]\n" +
+			"             │                           │                   │   └─ IndexedTableAccess(mydb.`OUBDL`)\n" +
+			"             │                           │                   │       ├─ index: `SVIT7` [OUBDL.FTQLQ]\n" +
 			"             │                           │                   │       ├─ static: [{[NULL, ∞)}]\n" +
 			"             │                           │                   │       └─ columns: [id ftqlq zh72s sfj6l v5dpx ljlum idpk7 no52d zrv3b vyo5e ykssu fhcyt qz6vt]\n" +
-			"             │                           │                   └─ TableAlias(I7HCR)\n" +
-			"             │                           │                       └─ IndexedTableAccess(EPZU6)\n" +
-			"             │                           │                           ├─ index: [EPZU6.TOFPN]\n" +
+			"             │                           │                   └─ TableAlias(`I7HCR`)\n" +
+			"             │                           │                       └─ IndexedTableAccess(mydb.`EPZU6`)\n" +
+			"             │                           │                           ├─ index: `SZ5IY` [EPZU6.TOFPN]\n" +
 			"             │                           │                           ├─ static: [{[NULL, ∞)}]\n" +
 			"             │                           │                           └─ columns: [id tofpn sjyn2 btxc5 fvucx swcqv ykssu fhcyt]\n" +
 			"             │                           └─ LookupJoin\n" +
@@ -17212,11 +17708,11 @@ WHERE
 			"             │                               │   └─ nd.DKCAJ:8!null\n" +
 			"             │                               ├─ TableAlias(nt)\n" +
 			"             │                               │   └─ Table\n" +
-			"             │                               │       ├─ name: F35MI\n" +
+			"             │                               │       ├─ name: mydb.`F35MI`\n" +
 			"             │                               │       └─ columns: [id dzlim f3yue]\n" +
 			"             │                               └─ TableAlias(nd)\n" +
-			"             │                                   └─ IndexedTableAccess(E2I7U)\n" +
-			"             │                                       ├─ index: [E2I7U.DKCAJ]\n" +
+			"             │                                   └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			"             │                                       ├─ index: `DKCAJ` [E2I7U.DKCAJ]\n" +
 			"             │                                       └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
 			"             └─ BEGIN .. END\n" +
 			"                 ├─ IF BLOCK\n" +
@@ -17226,14 +17722,14 @@ WHERE
 			"                 │       │   └─ right: Subquery\n" +
 			"                 │       │       ├─ cacheable: false\n" +
 			"                 │       │       └─ Table\n" +
-			"                 │       │           ├─ name: TPXHZ\n" +
+			"                 │       │           ├─ name: mydb.`TPXHZ`\n" +
 			"                 │       │           └─ columns: [svaz4]\n" +
 			"                 │       └─ InSubquery\n" +
 			"                 │           ├─ left: new.VYO5E:2\n" +
 			"                 │           └─ right: Subquery\n" +
 			"                 │               ├─ cacheable: false\n" +
 			"                 │               └─ Table\n" +
-			"                 │                   ├─ name: TPXHZ\n" +
+			"                 │                   ├─ name: mydb.`TPXHZ`\n" +
 			"                 │                   └─ columns: [svaz4]\n" +
 			"                 │      )\n" +
 			"                 │       └─ BLOCK\n" +
@@ -17336,7 +17832,7 @@ WHERE
 			"     └─ Insert(id, FZ2R5, LUEVY, M22QN, OVE3E, NRURT, OCA7E, XMM6Q, V5DPX, S3Q3Y, ZRV3B, FHCYT)\n" +
 			"         ├─ InsertDestination\n" +
 			"         │   └─ Table\n" +
-			"         │       ├─ name: FLQLP\n" +
+			"         │       ├─ name: mydb.`FLQLP`\n" +
 			"         │       └─ columns: [id fz2r5 luevy m22qn ove3e nrurt oca7e xmm6q v5dpx s3q3y zrv3b fhcyt]\n" +
 			"         └─ Trigger(CREATE TRIGGER FLQLP_on_insert BEFORE INSERT ON FLQLP\n" +
 			"            FOR EACH ROW\n" +
@@ -17368,8 +17864,8 @@ WHERE
 			"             │       │           │   ├─ aac.BTXC5:30\n" +
 			"             │       │           │   └─ PQSXB.BTXC5:10\n" +
 			"             │       │           └─ TableAlias(aac)\n" +
-			"             │       │               └─ IndexedTableAccess(TPXBU)\n" +
-			"             │       │                   ├─ index: [TPXBU.BTXC5]\n" +
+			"             │       │               └─ IndexedTableAccess(mydb.`TPXBU`)\n" +
+			"             │       │                   ├─ index: `UZ6GK` [TPXBU.BTXC5]\n" +
 			"             │       │                   └─ columns: [id btxc5]\n" +
 			"             │       │   as M22QN, PQSXB.OVE3E:1 as OVE3E, PQSXB.NRURT:2!null as NRURT, PQSXB.OCA7E:3 as OCA7E, PQSXB.XMM6Q:4 as XMM6Q, PQSXB.V5DPX:5 as V5DPX, PQSXB.S3Q3Y:6 as S3Q3Y, PQSXB.ZRV3B:7 as ZRV3B, PQSXB.FHCYT:8 as FHCYT]\n" +
 			"             │       └─ InnerJoin\n" +
@@ -17386,7 +17882,7 @@ WHERE
 			"             │           │           ├─ nd.ZH72S:19\n" +
 			"             │           │           └─ PQSXB.K3B6V:9\n" +
 			"             │           ├─ SubqueryAlias\n" +
-			"             │           │   ├─ name: PQSXB\n" +
+			"             │           │   ├─ name: `PQSXB`\n" +
 			"             │           │   ├─ outerVisibility: false\n" +
 			"             │           │   ├─ cacheable: true\n" +
 			"             │           │   └─ Filter\n" +
@@ -17402,7 +17898,7 @@ WHERE
 			"             │           │           │           │   ├─ JDLNA.FTQLQ:22!null\n" +
 			"             │           │           │           │   └─ uct.FTQLQ:1\n" +
 			"             │           │           │           └─ Table\n" +
-			"             │           │           │               ├─ name: JDLNA\n" +
+			"             │           │           │               ├─ name: mydb.`JDLNA`\n" +
 			"             │           │           │               └─ columns: [id ftqlq]\n" +
 			"             │           │           │   as FZ2R5, Subquery\n" +
 			"             │           │           │   ├─ cacheable: false\n" +
@@ -17444,8 +17940,8 @@ WHERE
 			"             │           │           │           │               │               │   ├─ nd.ZH72S:29\n" +
 			"             │           │           │           │               │               │   └─ uct.ZH72S:2\n" +
 			"             │           │           │           │               │               └─ TableAlias(nd)\n" +
-			"             │           │           │           │               │                   └─ IndexedTableAccess(E2I7U)\n" +
-			"             │           │           │           │               │                       ├─ index: [E2I7U.ZH72S]\n" +
+			"             │           │           │           │               │                   └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			"             │           │           │           │               │                       ├─ index: `URBSA` [E2I7U.ZH72S]\n" +
 			"             │           │           │           │               │                       └─ columns: [dkcaj zh72s]\n" +
 			"             │           │           │           │               │   ELSE Subquery\n" +
 			"             │           │           │           │               │   ├─ cacheable: false\n" +
@@ -17456,15 +17952,15 @@ WHERE
 			"             │           │           │           │               │           │   ├─ nd.TW55N:29!null\n" +
 			"             │           │           │           │               │           │   └─ I7HCR.FVUCX:17\n" +
 			"             │           │           │           │               │           └─ TableAlias(nd)\n" +
-			"             │           │           │           │               │               └─ IndexedTableAccess(E2I7U)\n" +
-			"             │           │           │           │               │                   ├─ index: [E2I7U.TW55N]\n" +
+			"             │           │           │           │               │               └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			"             │           │           │           │               │                   ├─ index: `SFMWS` [E2I7U.TW55N]\n" +
 			"             │           │           │           │               │                   └─ columns: [dkcaj tw55n]\n" +
 			"             │           │           │           │               │   END]\n" +
 			"             │           │           │           │               └─ Table\n" +
 			"             │           │           │           │                   ├─ name: \n" +
 			"             │           │           │           │                   └─ columns: []\n" +
 			"             │           │           │           └─ Table\n" +
-			"             │           │           │               ├─ name: SFEGG\n" +
+			"             │           │           │               ├─ name: mydb.`SFEGG`\n" +
 			"             │           │           │               └─ columns: [id no52d vyo5e dkcaj adurz fhcyt]\n" +
 			"             │           │           │   as OVE3E, uct.id:0!null as NRURT, I7HCR.id:13 as OCA7E, NULL (null) as XMM6Q, uct.V5DPX:4 as V5DPX, (uct.IDPK7:6 + 0 (decimal(2,1))) as S3Q3Y, uct.ZRV3B:8 as ZRV3B, CASE  WHEN NOT\n" +
 			"             │           │           │   └─ Eq\n" +
@@ -17491,18 +17987,18 @@ WHERE
 			"             │           │                   │       ├─ I7HCR.BTXC5:16!null\n" +
 			"             │           │                   │       └─ uct.LJLUM:5\n" +
 			"             │           │                   ├─ TableAlias(uct)\n" +
-			"             │           │                   │   └─ IndexedTableAccess(OUBDL)\n" +
-			"             │           │                   │       ├─ index: [OUBDL.FTQLQ]\n" +
+			"             │           │                   │   └─ IndexedTableAccess(mydb.`OUBDL`)\n" +
+			"             │           │                   │       ├─ index: `SVIT7` [OUBDL.FTQLQ]\n" +
 			"             │           │                   │       ├─ static: [{[NULL, ∞)}]\n" +
 			"             │           │                   │       └─ columns: [id ftqlq zh72s sfj6l v5dpx ljlum idpk7 no52d zrv3b vyo5e ykssu fhcyt qz6vt]\n" +
-			"             │           │                   └─ TableAlias(I7HCR)\n" +
-			"             │           │                       └─ IndexedTableAccess(EPZU6)\n" +
-			"             │           │                           ├─ index: [EPZU6.TOFPN]\n" +
+			"             │           │                   └─ TableAlias(`I7HCR`)\n" +
+			"             │           │                       └─ IndexedTableAccess(mydb.`EPZU6`)\n" +
+			"             │           │                           ├─ index: `SZ5IY` [EPZU6.TOFPN]\n" +
 			"             │           │                           ├─ static: [{[NULL, ∞)}]\n" +
 			"             │           │                           └─ columns: [id tofpn sjyn2 btxc5 fvucx swcqv ykssu fhcyt]\n" +
 			"             │           └─ TableAlias(nd)\n" +
 			"             │               └─ Table\n" +
-			"             │                   ├─ name: E2I7U\n" +
+			"             │                   ├─ name: mydb.`E2I7U`\n" +
 			"             │                   └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
 			"             └─ BEGIN .. END\n" +
 			"                 ├─ IF BLOCK\n" +
@@ -17511,7 +18007,7 @@ WHERE
 			"                 │       └─ right: Subquery\n" +
 			"                 │           ├─ cacheable: false\n" +
 			"                 │           └─ Table\n" +
-			"                 │               ├─ name: TPXHZ\n" +
+			"                 │               ├─ name: mydb.`TPXHZ`\n" +
 			"                 │               └─ columns: [svaz4]\n" +
 			"                 │      )\n" +
 			"                 │       └─ BLOCK\n" +
@@ -17580,7 +18076,7 @@ WHERE
 			"     └─ Insert(id, NO52D, VYO5E, DKCAJ, ADURZ, FHCYT)\n" +
 			"         ├─ InsertDestination\n" +
 			"         │   └─ Table\n" +
-			"         │       ├─ name: SFEGG\n" +
+			"         │       ├─ name: mydb.`SFEGG`\n" +
 			"         │       └─ columns: [id no52d vyo5e dkcaj adurz fhcyt]\n" +
 			"         └─ Trigger(CREATE TRIGGER SFEGG_on_insert BEFORE INSERT ON SFEGG\n" +
 			"            FOR EACH ROW\n" +
@@ -17663,7 +18159,7 @@ WHERE
 			"             │           │   │                           ├─ NOT\n" +
 			"             │           │   │                           │   └─ SFEGG.VYO5E:6 IS NULL\n" +
 			"             │           │   │                           └─ Table\n" +
-			"             │           │   │                               ├─ name: SFEGG\n" +
+			"             │           │   │                               ├─ name: mydb.`SFEGG`\n" +
 			"             │           │   │                               └─ columns: [id no52d vyo5e dkcaj adurz fhcyt]\n" +
 			"             │           │   └─ AND\n" +
 			"             │           │       ├─ rs.VYO5E:1 IS NULL\n" +
@@ -17678,7 +18174,7 @@ WHERE
 			"             │           │                           └─ Filter\n" +
 			"             │           │                               ├─ SFEGG.VYO5E:6 IS NULL\n" +
 			"             │           │                               └─ Table\n" +
-			"             │           │                                   ├─ name: SFEGG\n" +
+			"             │           │                                   ├─ name: mydb.`SFEGG`\n" +
 			"             │           │                                   └─ columns: [id no52d vyo5e dkcaj adurz fhcyt]\n" +
 			"             │           └─ SubqueryAlias\n" +
 			"             │               ├─ name: rs\n" +
@@ -17697,19 +18193,19 @@ WHERE
 			"             │                           │   │   └─ nd.DKCAJ:4!null\n" +
 			"             │                           │   ├─ TableAlias(nt)\n" +
 			"             │                           │   │   └─ Table\n" +
-			"             │                           │   │       ├─ name: F35MI\n" +
+			"             │                           │   │       ├─ name: mydb.`F35MI`\n" +
 			"             │                           │   │       └─ columns: [id dzlim f3yue]\n" +
 			"             │                           │   └─ TableAlias(nd)\n" +
-			"             │                           │       └─ IndexedTableAccess(E2I7U)\n" +
-			"             │                           │           ├─ index: [E2I7U.DKCAJ]\n" +
+			"             │                           │       └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			"             │                           │           ├─ index: `DKCAJ` [E2I7U.DKCAJ]\n" +
 			"             │                           │           └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
 			"             │                           └─ Filter\n" +
 			"             │                               ├─ HashIn\n" +
 			"             │                               │   ├─ TVTJS.id:0!null\n" +
 			"             │                               │   └─ TUPLE(1 (longtext), 2 (longtext), 3 (longtext))\n" +
-			"             │                               └─ TableAlias(TVTJS)\n" +
-			"             │                                   └─ IndexedTableAccess(HU5A5)\n" +
-			"             │                                       ├─ index: [HU5A5.I3VTA]\n" +
+			"             │                               └─ TableAlias(`TVTJS`)\n" +
+			"             │                                   └─ IndexedTableAccess(mydb.`HU5A5`)\n" +
+			"             │                                       ├─ index: `Z2WXS` [HU5A5.I3VTA]\n" +
 			"             │                                       └─ columns: [id tofpn i3vta sfj6l v5dpx ljlum idpk7 no52d zrv3b vyo5e swcqv ykssu fhcyt]\n" +
 			"             └─ BEGIN .. END\n" +
 			"                 ├─ IF BLOCK\n" +
@@ -17719,14 +18215,14 @@ WHERE
 			"                 │       │   └─ right: Subquery\n" +
 			"                 │       │       ├─ cacheable: false\n" +
 			"                 │       │       └─ Table\n" +
-			"                 │       │           ├─ name: TPXHZ\n" +
+			"                 │       │           ├─ name: mydb.`TPXHZ`\n" +
 			"                 │       │           └─ columns: [svaz4]\n" +
 			"                 │       └─ InSubquery\n" +
 			"                 │           ├─ left: new.VYO5E:2\n" +
 			"                 │           └─ right: Subquery\n" +
 			"                 │               ├─ cacheable: false\n" +
 			"                 │               └─ Table\n" +
-			"                 │                   ├─ name: TPXHZ\n" +
+			"                 │                   ├─ name: mydb.`TPXHZ`\n" +
 			"                 │                   └─ columns: [svaz4]\n" +
 			"                 │      )\n" +
 			"                 │       └─ BLOCK\n" +
@@ -17775,7 +18271,7 @@ WHERE
 			"     └─ Insert(id, FZ2R5, LUEVY, M22QN, OVE3E, NRURT, OCA7E, XMM6Q, V5DPX, S3Q3Y, ZRV3B, FHCYT)\n" +
 			"         ├─ InsertDestination\n" +
 			"         │   └─ Table\n" +
-			"         │       ├─ name: FLQLP\n" +
+			"         │       ├─ name: mydb.`FLQLP`\n" +
 			"         │       └─ columns: [id fz2r5 luevy m22qn ove3e nrurt oca7e xmm6q v5dpx s3q3y zrv3b fhcyt]\n" +
 			"         └─ Trigger(CREATE TRIGGER FLQLP_on_insert BEFORE INSERT ON FLQLP\n" +
 			"            FOR EACH ROW\n" +
@@ -17807,7 +18303,7 @@ WHERE
 			"             │       │           │   ├─ JDLNA.FTQLQ:14!null\n" +
 			"             │       │           │   └─ TVTJS.TOFPN:1!null\n" +
 			"             │       │           └─ Table\n" +
-			"             │       │               ├─ name: JDLNA\n" +
+			"             │       │               ├─ name: mydb.`JDLNA`\n" +
 			"             │       │               └─ columns: [id ftqlq]\n" +
 			"             │       │   as FZ2R5, Subquery\n" +
 			"             │       │   ├─ cacheable: false\n" +
@@ -17818,7 +18314,7 @@ WHERE
 			"             │       │           │   ├─ E2I7U.TW55N:14!null\n" +
 			"             │       │           │   └─ TVTJS.I3VTA:2!null\n" +
 			"             │       │           └─ Table\n" +
-			"             │       │               ├─ name: E2I7U\n" +
+			"             │       │               ├─ name: mydb.`E2I7U`\n" +
 			"             │       │               └─ columns: [id tw55n]\n" +
 			"             │       │   as LUEVY, Subquery\n" +
 			"             │       │   ├─ cacheable: false\n" +
@@ -17829,7 +18325,7 @@ WHERE
 			"             │       │           │   ├─ TPXBU.BTXC5:14\n" +
 			"             │       │           │   └─ TVTJS.LJLUM:5!null\n" +
 			"             │       │           └─ Table\n" +
-			"             │       │               ├─ name: TPXBU\n" +
+			"             │       │               ├─ name: mydb.`TPXBU`\n" +
 			"             │       │               └─ columns: [id btxc5]\n" +
 			"             │       │   as M22QN, Subquery\n" +
 			"             │       │   ├─ cacheable: false\n" +
@@ -17867,20 +18363,20 @@ WHERE
 			"             │       │           │                   │   ├─ nd.TW55N:20!null\n" +
 			"             │       │           │                   │   └─ TVTJS.I3VTA:2!null\n" +
 			"             │       │           │                   └─ TableAlias(nd)\n" +
-			"             │       │           │                       └─ IndexedTableAccess(E2I7U)\n" +
-			"             │       │           │                           ├─ index: [E2I7U.TW55N]\n" +
+			"             │       │           │                       └─ IndexedTableAccess(mydb.`E2I7U`)\n" +
+			"             │       │           │                           ├─ index: `SFMWS` [E2I7U.TW55N]\n" +
 			"             │       │           │                           └─ columns: [dkcaj tw55n]\n" +
 			"             │       │           └─ Table\n" +
-			"             │       │               ├─ name: SFEGG\n" +
+			"             │       │               ├─ name: mydb.`SFEGG`\n" +
 			"             │       │               └─ columns: [id no52d vyo5e dkcaj adurz fhcyt]\n" +
 			"             │       │   as OVE3E, NULL (null) as NRURT, NULL (null) as OCA7E, TVTJS.id:0!null as XMM6Q, TVTJS.V5DPX:4!null as V5DPX, (TVTJS.IDPK7:6!null + 0 (decimal(2,1))) as S3Q3Y, TVTJS.ZRV3B:8!null as ZRV3B, TVTJS.FHCYT:12 as FHCYT]\n" +
 			"             │       └─ Filter\n" +
 			"             │           ├─ HashIn\n" +
 			"             │           │   ├─ TVTJS.id:0!null\n" +
 			"             │           │   └─ TUPLE(1 (longtext), 2 (longtext), 3 (longtext))\n" +
-			"             │           └─ TableAlias(TVTJS)\n" +
-			"             │               └─ IndexedTableAccess(HU5A5)\n" +
-			"             │                   ├─ index: [HU5A5.id]\n" +
+			"             │           └─ TableAlias(`TVTJS`)\n" +
+			"             │               └─ IndexedTableAccess(mydb.`HU5A5`)\n" +
+			"             │                   ├─ index: `PRIMARY` [HU5A5.id]\n" +
 			"             │                   ├─ static: [{[1, 1]}, {[2, 2]}, {[3, 3]}]\n" +
 			"             │                   └─ columns: [id tofpn i3vta sfj6l v5dpx ljlum idpk7 no52d zrv3b vyo5e swcqv ykssu fhcyt]\n" +
 			"             └─ BEGIN .. END\n" +
@@ -17890,7 +18386,7 @@ WHERE
 			"                 │       └─ right: Subquery\n" +
 			"                 │           ├─ cacheable: false\n" +
 			"                 │           └─ Table\n" +
-			"                 │               ├─ name: TPXHZ\n" +
+			"                 │               ├─ name: mydb.`TPXHZ`\n" +
 			"                 │               └─ columns: [svaz4]\n" +
 			"                 │      )\n" +
 			"                 │       └─ BLOCK\n" +
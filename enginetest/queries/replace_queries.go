@@ -206,3 +206,178 @@ var ReplaceErrorTests = []GenericErrorQueryTest{
 		Query: "INSERT INTO mytable SET i = null, s = 'y';",
 	},
 }
+
+var ReplaceScripts = []ScriptTest{
+	{
+		Name: "REPLACE deletes a row conflicting on a secondary unique key",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int PRIMARY KEY, u int UNIQUE, v varchar(10));",
+			"INSERT INTO t VALUES (1, 10, 'a'), (2, 20, 'b');",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "REPLACE INTO t VALUES (3, 10, 'c');",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:    "SELECT * FROM t ORDER BY pk;",
+				Expected: []sql.Row{{2, 20, "b"}, {3, 10, "c"}},
+			},
+			{
+				Query:    "REPLACE INTO t VALUES (4, 40, 'd');",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "SELECT * FROM t ORDER BY pk;",
+				Expected: []sql.Row{{2, 20, "b"}, {3, 10, "c"}, {4, 40, "d"}},
+			},
+		},
+	},
+	{
+		Name: "REPLACE deletes every row conflicting on any unique key",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int PRIMARY KEY, u1 int UNIQUE, u2 int, UNIQUE KEY (u2));",
+			"INSERT INTO t VALUES (1, 10, 100), (2, 20, 200), (3, 30, 300), (4, 40, 400);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "REPLACE INTO t VALUES (1, 20, 300);",
+				Expected: []sql.Row{{types.NewOkResult(4)}},
+			},
+			{
+				Query:    "SELECT * FROM t ORDER BY pk;",
+				Expected: []sql.Row{{1, 20, 300}, {4, 40, 400}},
+			},
+			{
+				Query:    "REPLACE INTO t VALUES (5, 40, 500), (6, 60, 500);",
+				Expected: []sql.Row{{types.NewOkResult(4)}},
+			},
+			{
+				Query:    "SELECT * FROM t ORDER BY pk;",
+				Expected: []sql.Row{{1, 20, 300}, {6, 60, 500}},
+			},
+		},
+	},
+	{
+		Name: "REPLACE cascades the deletes of conflicting rows to child tables",
+		SetUpScript: []string{
+			"CREATE TABLE parent (id int PRIMARY KEY, u int UNIQUE);",
+			"CREATE TABLE child (id int PRIMARY KEY, parent_u int, CONSTRAINT fk_child_u FOREIGN KEY (parent_u) REFERENCES parent (u) ON DELETE CASCADE);",
+			"CREATE TABLE other_child (id int PRIMARY KEY, parent_id int, CONSTRAINT fk_other_child_id FOREIGN KEY (parent_id) REFERENCES parent (id) ON DELETE SET NULL);",
+			"INSERT INTO parent VALUES (1, 10), (2, 20);",
+			"INSERT INTO child VALUES (1, 10), (2, 20);",
+			"INSERT INTO other_child VALUES (1, 1), (2, 2);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "REPLACE INTO parent VALUES (3, 10);",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:    "SELECT * FROM parent ORDER BY id;",
+				Expected: []sql.Row{{2, 20}, {3, 10}},
+			},
+			{
+				Query:    "SELECT * FROM child ORDER BY id;",
+				Expected: []sql.Row{{2, 20}},
+			},
+			{
+				Query:    "SELECT * FROM other_child ORDER BY id;",
+				Expected: []sql.Row{{1, nil}, {2, 2}},
+			},
+		},
+	},
+	{
+		Name: "REPLACE fires delete triggers for conflicting rows",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int PRIMARY KEY, u int UNIQUE);",
+			"CREATE TABLE log (msg varchar(50));",
+			"INSERT INTO t VALUES (1, 10), (2, 20);",
+			"CREATE TRIGGER t_bi BEFORE INSERT ON t FOR EACH ROW INSERT INTO log VALUES (concat('before insert ', new.pk));",
+			"CREATE TRIGGER t_ai AFTER INSERT ON t FOR EACH ROW INSERT INTO log VALUES (concat('after insert ', new.pk));",
+			"CREATE TRIGGER t_bd BEFORE DELETE ON t FOR EACH ROW INSERT INTO log VALUES (concat('before delete ', old.pk));",
+			"CREATE TRIGGER t_ad AFTER DELETE ON t FOR EACH ROW INSERT INTO log VALUES (concat('after delete ', old.pk));",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "REPLACE INTO t VALUES (3, 10);",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:    "SELECT * FROM t ORDER BY pk;",
+				Expected: []sql.Row{{2, 20}, {3, 10}},
+			},
+			{
+				Query: "SELECT * FROM log;",
+				Expected: []sql.Row{
+					{"before insert 3"},
+					{"before delete 1"},
+					{"after delete 1"},
+					{"after insert 3"},
+				},
+			},
+		},
+	},
+	{
+		Name: "REPLACE fires delete triggers for every conflicting row before inserting",
+		SetUpScript: []string{
+			"CREATE TABLE t (id int PRIMARY KEY, a int UNIQUE, b int UNIQUE);",
+			"CREATE TABLE log (msg varchar(50));",
+			"INSERT INTO t VALUES (1, 10, 100), (2, 20, 200);",
+			"CREATE TRIGGER t_bi BEFORE INSERT ON t FOR EACH ROW INSERT INTO log VALUES (concat('before insert ', new.id));",
+			"CREATE TRIGGER t_ai AFTER INSERT ON t FOR EACH ROW INSERT INTO log VALUES (concat('after insert ', new.id));",
+			"CREATE TRIGGER t_bd BEFORE DELETE ON t FOR EACH ROW INSERT INTO log VALUES (concat('before delete ', old.id));",
+			"CREATE TRIGGER t_ad AFTER DELETE ON t FOR EACH ROW INSERT INTO log VALUES (concat('after delete ', old.id));",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "REPLACE INTO t VALUES (4, 10, 200);",
+				Expected: []sql.Row{{types.NewOkResult(3)}},
+			},
+			{
+				Query:    "SELECT * FROM t ORDER BY id;",
+				Expected: []sql.Row{{4, 10, 200}},
+			},
+			{
+				Query: "SELECT * FROM log;",
+				Expected: []sql.Row{
+					{"before insert 4"},
+					{"before delete 1"},
+					{"after delete 1"},
+					{"before delete 2"},
+					{"after delete 2"},
+					{"after insert 4"},
+				},
+			},
+			{
+				Query:    "CREATE TABLE src (id int PRIMARY KEY, a int, b int);",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "CREATE TRIGGER src_ai AFTER INSERT ON src FOR EACH ROW REPLACE INTO t VALUES (new.id, new.a, new.b);",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "DELETE FROM log;",
+				Expected: []sql.Row{{types.NewOkResult(6)}},
+			},
+			{
+				Query:    "INSERT INTO src VALUES (5, 10, 500);",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "SELECT * FROM t ORDER BY id;",
+				Expected: []sql.Row{{5, 10, 500}},
+			},
+			{
+				Query: "SELECT * FROM log;",
+				Expected: []sql.Row{
+					{"before insert 5"},
+					{"before delete 4"},
+					{"after delete 4"},
+					{"after insert 5"},
+				},
+			},
+		},
+	},
+}
@@ -21,6 +21,8 @@ import (
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/analyzer/analyzererrors"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/parse"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 	"github.com/dolthub/go-mysql-server/sql/types"
 )
@@ -90,7 +92,7 @@ var ScriptTests = []ScriptTest{
 		Assertions: []ScriptTestAssertion{
 			{
 				Query:       `alter table t modify column i2 int unsigned`,
-				ExpectedErr: sql.ErrValueOutOfRange,
+				ExpectedErr: sql.ErrValueOutOfRangeForColumn,
 			},
 		},
 	},
@@ -152,14 +154,14 @@ var ScriptTests = []ScriptTest{
 				Query: "SHOW CREATE TABLE enumtest1;",
 				Expected: []sql.Row{{
 					"enumtest1",
-					"CREATE TABLE `enumtest1` (\n  `pk` int NOT NULL,\n  `e` enum('abc','XYZ'),\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+					"CREATE TABLE `enumtest1` (\n  `pk` int NOT NULL,\n  `e` enum('abc','XYZ') DEFAULT NULL,\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
 			},
 			{
 				// Trailing whitespace should be removed from enum values, except when using the "binary" charset and collation
 				Query: "SHOW CREATE TABLE enumtest2;",
 				Expected: []sql.Row{{
 					"enumtest2",
-					"CREATE TABLE `enumtest2` (\n  `pk` int NOT NULL,\n  `e` enum('x','X','y','Y'),\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+					"CREATE TABLE `enumtest2` (\n  `pk` int NOT NULL,\n  `e` enum('x','X','y','Y') DEFAULT NULL,\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
 			},
 			{
 				Query: "DESCRIBE enumtest1;",
@@ -197,7 +199,7 @@ var ScriptTests = []ScriptTest{
 				Query: "SHOW CREATE TABLE enumtest1;",
 				Expected: []sql.Row{{
 					"enumtest1",
-					"CREATE TABLE `enumtest1` (\n  `pk` int NOT NULL,\n  `e` enum('abc','XYZ') COLLATE utf8mb4_0900_ai_ci,\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+					"CREATE TABLE `enumtest1` (\n  `pk` int NOT NULL,\n  `e` enum('abc','XYZ') CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci DEFAULT NULL,\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
 			},
 			{
 				Query: "DESCRIBE enumtest1;",
@@ -1368,7 +1370,7 @@ var ScriptTests = []ScriptTest{
 			},
 			{
 				Query:    "SELECT rand(10) FROM tab1 GROUP BY tab1.col1",
-				Expected: []sql.Row{{0.5660920659323543}, {0.5660920659323543}, {0.5660920659323543}},
+				Expected: []sql.Row{{0.6570515219653505}, {0.6570515219653505}, {0.6570515219653505}},
 			},
 			{
 				Query:    "SELECT ALL - cor0.col0 * + cor0.col0 AS col2 FROM tab1 AS cor0 GROUP BY cor0.col0",
@@ -1796,7 +1798,7 @@ var ScriptTests = []ScriptTest{
 				Expected: []sql.Row{
 					{"t2", "CREATE TABLE `t2` (\n" +
 						"  `c` int NOT NULL,\n" +
-						"  `d` varchar(10),\n" +
+						"  `d` varchar(10) DEFAULT NULL,\n" +
 						"  PRIMARY KEY (`c`),\n" +
 						"  UNIQUE KEY `t2du` (`d`),\n" +
 						"  CONSTRAINT `fk1` FOREIGN KEY (`d`) REFERENCES `t1` (`b`)\n" +
@@ -1809,7 +1811,7 @@ var ScriptTests = []ScriptTest{
 					{"t3", "CREATE TABLE `t3` (\n" +
 						"  `a` int NOT NULL,\n" +
 						"  `b` varchar(100) NOT NULL,\n" +
-						"  `c` datetime(6),\n" +
+						"  `c` datetime(6) DEFAULT NULL,\n" +
 						"  PRIMARY KEY (`b`,`a`)\n" +
 						") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"},
 				},
@@ -2224,6 +2226,1020 @@ var ScriptTests = []ScriptTest{
 				ExpectedWarningMessageSubstring: "Incorrect date value: this is not a date",
 				SkipResultsCheck:                true,
 			},
+			{
+				Query:                           "SELECT CAST('abc' AS SIGNED)",
+				Expected:                        []sql.Row{{0}},
+				ExpectedWarning:                 1292,
+				ExpectedWarningsCount:           1,
+				ExpectedWarningMessageSubstring: "Truncated incorrect INTEGER value: 'abc'",
+			},
+			{
+				Query:                           "SELECT CAST('18446744073709551616' AS UNSIGNED)",
+				Expected:                        []sql.Row{{uint64(18446744073709551615)}},
+				ExpectedWarning:                 1292,
+				ExpectedWarningsCount:           1,
+				ExpectedWarningMessageSubstring: "Truncated incorrect INTEGER value: '18446744073709551616'",
+			},
+			{
+				Query:                           "SELECT CAST(12345 AS DECIMAL(3,1))",
+				Expected:                        []sql.Row{{"99.9"}},
+				ExpectedWarning:                 1264,
+				ExpectedWarningsCount:           1,
+				ExpectedWarningMessageSubstring: "Out of range value",
+			},
+			{
+				Query:                           "SELECT CAST('abcdef' AS CHAR(2))",
+				Expected:                        []sql.Row{{"ab"}},
+				ExpectedWarning:                 1292,
+				ExpectedWarningsCount:           1,
+				ExpectedWarningMessageSubstring: "Truncated incorrect CHAR(2) value: 'abcdef'",
+			},
+			{
+				Query:                           "SELECT CONVERT(X'FF' USING utf8mb4)",
+				Expected:                        []sql.Row{{nil}},
+				ExpectedWarning:                 1300,
+				ExpectedWarningsCount:           1,
+				ExpectedWarningMessageSubstring: "Invalid utf8mb4 character string: 'FF'",
+			},
+			{
+				Query:       "SELECT CAST('[1,' AS JSON)",
+				ExpectedErr: expression.ErrConvertExpression,
+			},
+			{
+				Query:       "SELECT CONVERT('abc' USING big5)",
+				ExpectedErr: sql.ErrCharSetNotYetImplementedTemp,
+			},
+			{
+				Query:       "SELECT CAST(POINT(1, 2) AS LINESTRING)",
+				ExpectedErr: expression.ErrInvalidCastToGeometry,
+			},
+			{
+				Query:       "SELECT CAST('POINT(1 2)' AS POINT)",
+				ExpectedErr: expression.ErrInvalidCastToGeometry,
+			},
+			{
+				Query:          "SELECT CAST(1 AS FLOAT(54))",
+				ExpectedErrStr: "Too-big precision 54 specified. Maximum is 53.",
+			},
+			{
+				Query:    "CREATE VIEW years AS SELECT CAST('2023' AS YEAR) AS y, CONVERT(1.5, DOUBLE) AS d",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT * FROM years",
+				Expected: []sql.Row{{2023, 1.5}},
+			},
+			{
+				Query:    "SHOW CREATE VIEW years",
+				Expected: []sql.Row{{"years", "CREATE VIEW `years` AS SELECT CAST('2023' AS YEAR) AS y, CONVERT(1.5, DOUBLE) AS d", "utf8mb4", "utf8mb4_0900_bin"}},
+			},
+		},
+	},
+	{
+		Name: "temporal values in numeric and comparison contexts",
+		SetUpScript: []string{
+			"create table events (id int primary key, d date, dt datetime, t time)",
+			"insert into events values (1, '2020-01-15', '2020-01-15 10:00:00', '10:30:00'), (2, '2020-02-01', '2020-02-01 23:59:59', '-01:00:00.5')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select d + 0, dt + 0, t + 0 from events order by id",
+				Expected: []sql.Row{{int64(20200115), int64(20200115100000), int64(103000)}, {int64(20200201), int64(20200201235959), int64(-10001)}},
+			},
+			{
+				Query:    "select dt - d, d - 1, t * 2 from events where id = 1",
+				Expected: []sql.Row{{int64(20200115100000 - 20200115), int64(20200114), int64(206000)}},
+			},
+			{
+				Query:    "select id from events where d between '2020-1-1' and '2020-01-31'",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "select id from events where '2020-1-15 12:00' between d and dt order by id",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "select id from events where '2020-1-15 09:00' between d and dt order by id",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "select id from events where d > '2020-1-2' and d < '2020-1-16'",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "select id from events where t between '9:00' and '11:00'",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "select greatest(d, '2020-1-20'), least(dt, '2020-1-20') from events where id = 1",
+				Expected: []sql.Row{{time.Date(2020, 1, 20, 0, 0, 0, 0, time.UTC), time.Date(2020, 1, 15, 10, 0, 0, 0, time.UTC)}},
+			},
+			{
+				Query:    "select time_to_sec(t), sec_to_time(time_to_sec(t)) from events order by id",
+				Expected: []sql.Row{{float64(37800), types.Timespan(37800000000)}, {-3600.5, types.Timespan(-3600500000)}},
+			},
+			{
+				Query:    "select sec_to_time(3600.25), time_to_sec(sec_to_time(3600.25))",
+				Expected: []sql.Row{{types.Timespan(3600250000), 3600.25}},
+			},
+			{
+				Query:    "select now() - interval 0 second + 0 = now() + 0, curdate() + 0 = date_format(now(), '%Y%m%d')",
+				Expected: []sql.Row{{true, true}},
+			},
+			{
+				// strings are only read as numbers up to the first invalid character, even if they look like times
+				Query:    "select '10:30:00' + 0",
+				Expected: []sql.Row{{float64(10)}},
+			},
+		},
+	},
+	{
+		Name: "strings compared with numbers match the same rows with and without indexes",
+		SetUpScript: []string{
+			"create table str_noidx (id int primary key, v varchar(10))",
+			"create table str_idx (id int primary key, v varchar(10), key (v))",
+			"insert into str_noidx values (1, 'abc'), (2, '0'), (3, '1'), (4, '1abc'), (5, ' 1'), (6, '1.0'), (7, '0.0'), (8, ''), (9, '2'), (10, NULL)",
+			"insert into str_idx select * from str_noidx",
+			"create table num_noidx (id int primary key, n int)",
+			"create table num_idx (id int primary key, n int, key (n))",
+			"insert into num_noidx values (1, 0), (2, 1), (3, 2)",
+			"insert into num_idx select * from num_noidx",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:                 "select id from str_noidx where v = 0 order by id",
+				Expected:              []sql.Row{{1}, {2}, {7}, {8}},
+				ExpectedWarning:       1292,
+				ExpectedWarningsCount: 3,
+			},
+			{
+				Query:                 "select id from str_idx where v = 0 order by id",
+				Expected:              []sql.Row{{1}, {2}, {7}, {8}},
+				ExpectedWarning:       1292,
+				ExpectedWarningsCount: 3,
+			},
+			{
+				Query:    "select id from str_noidx where v = 1 order by id",
+				Expected: []sql.Row{{3}, {4}, {5}, {6}},
+			},
+			{
+				Query:    "select id from str_idx where v = 1 order by id",
+				Expected: []sql.Row{{3}, {4}, {5}, {6}},
+			},
+			{
+				Query:    "select id from str_noidx where v = '1' order by id",
+				Expected: []sql.Row{{3}},
+			},
+			{
+				Query:    "select id from str_idx where v = '1' order by id",
+				Expected: []sql.Row{{3}},
+			},
+			{
+				Query:    "select id from str_noidx where v > 0 order by id",
+				Expected: []sql.Row{{3}, {4}, {5}, {6}, {9}},
+			},
+			{
+				Query:    "select id from str_idx where v > 0 order by id",
+				Expected: []sql.Row{{3}, {4}, {5}, {6}, {9}},
+			},
+			{
+				Query:    "select id from str_noidx where v <> 0 order by id",
+				Expected: []sql.Row{{3}, {4}, {5}, {6}, {9}},
+			},
+			{
+				Query:    "select id from str_idx where v <> 0 order by id",
+				Expected: []sql.Row{{3}, {4}, {5}, {6}, {9}},
+			},
+			{
+				Query:    "select id from str_noidx where v in (0, 2) order by id",
+				Expected: []sql.Row{{1}, {2}, {7}, {8}, {9}},
+			},
+			{
+				Query:    "select id from str_idx where v in (0, 2) order by id",
+				Expected: []sql.Row{{1}, {2}, {7}, {8}, {9}},
+			},
+			{
+				Query:    "select id from str_noidx where v not in (0, 1) order by id",
+				Expected: []sql.Row{{9}},
+			},
+			{
+				Query:    "select id from str_idx where v not in (0, 1) order by id",
+				Expected: []sql.Row{{9}},
+			},
+			{
+				Query:    "select id from str_noidx where v between 1 and 2 order by id",
+				Expected: []sql.Row{{3}, {4}, {5}, {6}, {9}},
+			},
+			{
+				Query:    "select id from str_idx where v between 1 and 2 order by id",
+				Expected: []sql.Row{{3}, {4}, {5}, {6}, {9}},
+			},
+			{
+				Query:    "select id from num_noidx where n = '1.5' order by id",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "select id from num_idx where n = '1.5' order by id",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "select id from num_noidx where n = '1abc' order by id",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    "select id from num_idx where n = '1abc' order by id",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    "select id from num_noidx where n in ('1', '2x') order by id",
+				Expected: []sql.Row{{2}, {3}},
+			},
+			{
+				Query:    "select id from num_idx where n in ('1', '2x') order by id",
+				Expected: []sql.Row{{2}, {3}},
+			},
+			{
+				Query:    "select id from num_noidx where n > '0.5' order by id",
+				Expected: []sql.Row{{2}, {3}},
+			},
+			{
+				Query:    "select id from num_idx where n > '0.5' order by id",
+				Expected: []sql.Row{{2}, {3}},
+			},
+			{
+				Query:    "select s.id, n.id from str_noidx s join num_noidx n on s.v = n.n order by s.id",
+				Expected: []sql.Row{{1, 1}, {2, 1}, {3, 2}, {4, 2}, {5, 2}, {6, 2}, {7, 1}, {8, 1}, {9, 3}},
+			},
+			{
+				Query:    "select s.id, n.id from str_idx s join num_idx n on s.v = n.n order by s.id",
+				Expected: []sql.Row{{1, 1}, {2, 1}, {3, 2}, {4, 2}, {5, 2}, {6, 2}, {7, 1}, {8, 1}, {9, 3}},
+			},
+		},
+	},
+	{
+		Name: "HANDLER reads a table forward and backward",
+		SetUpScript: []string{
+			"create table h (pk int primary key, v int, w varchar(10), key vw (v, w))",
+			"insert into h values (1, 30, 'a'), (2, 10, 'b'), (3, 20, 'c'), (4, 20, 'a'), (5, null, 'z')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "handler h open",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "handler h read first",
+				Expected: []sql.Row{{1, 30, "a"}},
+			},
+			{
+				Query:    "handler h read next",
+				Expected: []sql.Row{{2, 10, "b"}},
+			},
+			{
+				Query:    "handler h read next limit 10",
+				Expected: []sql.Row{{3, 20, "c"}, {4, 20, "a"}, {5, nil, "z"}},
+			},
+			{
+				Query:    "handler h read next",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "handler h read prev",
+				Expected: []sql.Row{{5, nil, "z"}},
+			},
+			{
+				Query:    "handler h read `PRIMARY` last",
+				Expected: []sql.Row{{5, nil, "z"}},
+			},
+			{
+				Query:    "handler h read `PRIMARY` prev limit 2",
+				Expected: []sql.Row{{4, 20, "a"}, {3, 20, "c"}},
+			},
+			{
+				Query:    "handler h read `PRIMARY` next",
+				Expected: []sql.Row{{4, 20, "a"}},
+			},
+			{
+				Query:    "handler h read vw first limit 4",
+				Expected: []sql.Row{{5, nil, "z"}, {2, 10, "b"}, {4, 20, "a"}, {3, 20, "c"}},
+			},
+			{
+				Query:    "handler h read vw prev",
+				Expected: []sql.Row{{4, 20, "a"}},
+			},
+			{
+				Query:    "handler h read vw next limit 10",
+				Expected: []sql.Row{{3, 20, "c"}, {1, 30, "a"}},
+			},
+			{
+				Query:    "handler h read vw prev",
+				Expected: []sql.Row{{1, 30, "a"}},
+			},
+			{
+				Query:    "handler h read vw last",
+				Expected: []sql.Row{{1, 30, "a"}},
+			},
+			{
+				Query:    "handler h close",
+				Expected: []sql.Row{},
+			},
+		},
+	},
+	{
+		Name: "HANDLER reads from index keys",
+		SetUpScript: []string{
+			"create table h (pk int primary key, v int, w varchar(10), key vw (v, w))",
+			"insert into h values (1, 30, 'a'), (2, 10, 'b'), (3, 20, 'c'), (4, 20, 'a'), (5, null, 'z')",
+			"handler h open as hh",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "handler hh read vw = (20)",
+				Expected: []sql.Row{{4, 20, "a"}},
+			},
+			{
+				Query:    "handler hh read vw next",
+				Expected: []sql.Row{{3, 20, "c"}},
+			},
+			{
+				Query:    "handler hh read vw = (20) limit 5",
+				Expected: []sql.Row{{4, 20, "a"}, {3, 20, "c"}},
+			},
+			{
+				Query:    "handler hh read vw = (20, 'c')",
+				Expected: []sql.Row{{3, 20, "c"}},
+			},
+			{
+				Query:    "handler hh read vw = (25)",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "handler hh read vw >= (20, 'b') limit 5",
+				Expected: []sql.Row{{3, 20, "c"}, {1, 30, "a"}},
+			},
+			{
+				Query:    "handler hh read vw > (20) limit 5",
+				Expected: []sql.Row{{1, 30, "a"}},
+			},
+			{
+				Query:    "handler hh read vw <= (20) limit 2",
+				Expected: []sql.Row{{3, 20, "c"}, {4, 20, "a"}},
+			},
+			{
+				Query:    "handler hh read vw prev",
+				Expected: []sql.Row{{2, 10, "b"}},
+			},
+			{
+				Query:    "handler hh read vw < (20) limit 5",
+				Expected: []sql.Row{{2, 10, "b"}, {5, nil, "z"}},
+			},
+			{
+				Query:    "handler hh read `primary` = (3)",
+				Expected: []sql.Row{{3, 20, "c"}},
+			},
+			{
+				Query:    "handler hh read vw first where pk > 2 limit 2",
+				Expected: []sql.Row{{5, nil, "z"}, {4, 20, "a"}},
+			},
+			{
+				Query:    "handler hh read vw next where hh.pk < 5 limit 1, 5",
+				Expected: []sql.Row{{1, 30, "a"}},
+			},
+			{
+				Query:          "handler hh read vw = (20, 'a', 1)",
+				ExpectedErrStr: "Too many key parts specified; max 2 parts allowed",
+			},
+			{
+				Query:       "handler hh read nosuch first",
+				ExpectedErr: sql.ErrHandlerKeyDoesNotExist,
+			},
+			{
+				Query:       "handler h read first",
+				ExpectedErr: sql.ErrUnknownHandler,
+			},
+			{
+				Query:       "handler h open as hh",
+				ExpectedErr: sql.ErrDuplicateAliasOrTable,
+			},
+			{
+				Query:    "handler hh close",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:       "handler hh read first",
+				ExpectedErr: sql.ErrUnknownHandler,
+			},
+			{
+				Query:       "handler hh close",
+				ExpectedErr: sql.ErrUnknownHandler,
+			},
+			{
+				Query:       "handler nosuch open",
+				ExpectedErr: sql.ErrTableNotFound,
+			},
+		},
+	},
+	{
+		Name: "check table",
+		SetUpScript: []string{
+			"create table ct1 (pk int primary key, u int, unique key (u))",
+			"create table ct2 (pk int primary key, v varchar(10), key (v))",
+			"insert into ct1 values (1, 1), (2, null), (3, null)",
+			"insert into ct2 values (1, 'a'), (2, 'a')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "check table ct1",
+				Expected: []sql.Row{{"mydb.ct1", "check", "status", "OK"}},
+			},
+			{
+				Query: "CHECK TABLE ct1, mydb.ct2 QUICK FOR UPGRADE",
+				Expected: []sql.Row{
+					{"mydb.ct1", "check", "status", "OK"},
+					{"mydb.ct2", "check", "status", "OK"},
+				},
+			},
+			{
+				Query:       "check table nosuch",
+				ExpectedErr: sql.ErrTableNotFound,
+			},
+			{
+				Query:       "check table ct1 sideways",
+				ExpectedErr: sql.ErrSyntaxError,
+			},
+		},
+	},
+	{
+		Name: "DUAL and selects without FROM",
+		SetUpScript: []string{
+			"create table accounts (id int primary key, name varchar(20) unique)",
+			"insert into accounts values (1, 'alice'), (2, 'bob')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				// existence checks generated by ORMs
+				Query:    "select 1 from dual where exists (select 1 from accounts where name = 'alice')",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "select 1 from dual where exists (select 1 from accounts where name = 'carol')",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "select 1 from dual where exists (select 1 from accounts where id = 1) and not exists (select 1 from accounts where id = 3) limit 1",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "select case when exists (select 1 from accounts where id = 2) then 1 else 0 end from dual",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "select 1 from dual where false",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "select 1 from dual where null",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "select 1 where 1 = 1",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "select 1 where 1 = 0",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "select 1 as a having a > 1",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "select 1 as a from dual having a = 1 order by a limit 1",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "select 1 limit 1 offset 1",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "select 1 from dual limit 0",
+				Expected: []sql.Row{},
+			},
+			{
+				// aggregates over no FROM aggregate the single empty row, or no rows when it's filtered out
+				Query:    "select count(*), sum(1), max(2) from dual",
+				Expected: []sql.Row{{1, float64(1), 2}},
+			},
+			{
+				Query:    "select count(*)",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "select count(*), sum(1) where false",
+				Expected: []sql.Row{{0, nil}},
+			},
+			{
+				Query:    "select count(*) having count(*) > 1",
+				Expected: []sql.Row{},
+			},
+			{
+				// insert-if-absent
+				Query:    "insert into accounts select 3, 'carol' from dual where not exists (select 1 from accounts where name = 'carol')",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "insert into accounts select 4, 'carol' from dual where not exists (select 1 from accounts where name = 'carol')",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select id from accounts where exists (select 1 from dual where accounts.id > 1) order by id",
+				Expected: []sql.Row{{2}, {3}},
+			},
+			{
+				Query:       "select * from dual",
+				ExpectedErr: sql.ErrNoTablesUsed,
+			},
+		},
+	},
+	{
+		Name: "row constructor comparisons with NULL elements",
+		SetUpScript: []string{
+			"create table rc (a int, b int, c int, primary key (a, b))",
+			"insert into rc values (1, 1, 1), (1, 2, 2), (2, 1, 3), (2, 2, 4), (3, 3, 5)",
+			"create table rn (x int, y int)",
+			"insert into rn values (1, null), (2, 2)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select (1, null) = (1, 2), (1, null) = (2, 2), (1, 2) = (1, 2), (1, null) <=> (1, null), (1, null) <=> (1, 2)",
+				Expected: []sql.Row{{nil, false, true, true, false}},
+			},
+			{
+				Query:    "select (1, 2) < (1, 3), (1, null) < (2, 0), (1, null) < (1, 3), (2, null) > (1, 3), (1, 2) >= (1, 2)",
+				Expected: []sql.Row{{true, true, nil, true, true}},
+			},
+			{
+				Query:    "select (1, 2) in ((1, 2), (3, 4)), (1, null) in ((1, 2)), (1, null) in ((2, 2)), (1, 2) in ((1, null), (3, 4))",
+				Expected: []sql.Row{{true, nil, false, nil}},
+			},
+			{
+				Query:    "select (1, 2) not in ((1, null), (3, 4)), (1, 2) not in ((2, null), (3, 4))",
+				Expected: []sql.Row{{nil, true}},
+			},
+			{
+				Query:    "select * from rc where (a, b) in ((1, 2), (2, 1), (3, null)) order by a, b",
+				Expected: []sql.Row{{1, 2, 2}, {2, 1, 3}},
+			},
+			{
+				Query:    "select * from rc where (a, b) = (2, 1)",
+				Expected: []sql.Row{{2, 1, 3}},
+			},
+			{
+				Query:    "select * from rc where (a, b) > (1, 2) order by a, b",
+				Expected: []sql.Row{{2, 1, 3}, {2, 2, 4}, {3, 3, 5}},
+			},
+			{
+				Query:    "select * from rc where (2, 1) >= (a, b) order by a, b",
+				Expected: []sql.Row{{1, 1, 1}, {1, 2, 2}, {2, 1, 3}},
+			},
+			{
+				Query:    "select * from rc where (a, b) > (1, null) order by a, b",
+				Expected: []sql.Row{{2, 1, 3}, {2, 2, 4}, {3, 3, 5}},
+			},
+			{
+				Query:    "select * from rc where (a, b) <=> (1, 2)",
+				Expected: []sql.Row{{1, 2, 2}},
+			},
+			{
+				Query:    "select x, y from rn where (x, y) in ((1, null), (2, 2))",
+				Expected: []sql.Row{{2, 2}},
+			},
+			{
+				Query:    "select x, y from rn where (x, y) <=> (1, null)",
+				Expected: []sql.Row{{1, nil}},
+			},
+			{
+				Query:    "select (1, 2) in (select x, y from rn), (2, 2) in (select x, y from rn), (3, 3) in (select x, y from rn)",
+				Expected: []sql.Row{{nil, true, false}},
+			},
+			{
+				Query:    "select * from rc where (a, b) in (select x, y from rn) order by a, b",
+				Expected: []sql.Row{{2, 2, 4}},
+			},
+			{
+				Query:    "select * from rc where (a, b) not in (select x, y from rn) order by a, b",
+				Expected: []sql.Row{{2, 1, 3}, {3, 3, 5}},
+			},
+			{
+				Query:    "select * from rc where (a, b) = (select 2, 1)",
+				Expected: []sql.Row{{2, 1, 3}},
+			},
+			{
+				Query:    "select * from rc where (a, b) = (select x, y from rn where x = 1)",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:       "select * from rc where (a, b) = (select x, y from rn)",
+				ExpectedErr: sql.ErrExpectedSingleRow,
+			},
+			{
+				Query:       "select * from rc where (a, b) = (select x from rn)",
+				ExpectedErr: sql.ErrInvalidOperandColumns,
+			},
+			{
+				Query:       "select * from rc where (a, b) in (select x from rn)",
+				ExpectedErr: sql.ErrInvalidOperandColumns,
+			},
+		},
+	},
+	{
+		Name: "result types of CASE, IF, IFNULL and COALESCE aggregate their arguments' types",
+		SetUpScript: []string{
+			"create table src (i int, d decimal(10,2), s varchar(20) collate utf8mb4_general_ci, dt date)",
+			"insert into src values (1, 2.50, 'abc', '2020-04-07')",
+			`create table t as select
+				case when i = 1 then i else d end a,
+				case when i = 1 then s else null end b,
+				if(i = 1, dt, s) c,
+				ifnull(s, i) e,
+				coalesce(null, d, i) f,
+				case when i = 2 then s else 'x' end g
+			from src`,
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "select column_name, column_type, collation_name from information_schema.columns where table_name = 't' order by ordinal_position",
+				Expected: []sql.Row{
+					{"a", "decimal(12,2)", nil},
+					{"b", "varchar(20)", "utf8mb4_general_ci"},
+					{"c", "varchar(20)", "utf8mb4_general_ci"},
+					{"e", "varchar(20)", "utf8mb4_general_ci"},
+					{"f", "decimal(12,2)", nil},
+					{"g", "longtext", "utf8mb4_general_ci"},
+				},
+			},
+			{
+				Query:    "select a = 1, b, c, e, f = 2.5, g from t",
+				Expected: []sql.Row{{true, "abc", "2020-04-07", "abc", true, "x"}},
+			},
+			{
+				Query:    "select if(i = 1, dt, s), ifnull(null, dt), coalesce(null, i, 'a') from src",
+				Expected: []sql.Row{{"2020-04-07", time.Date(2020, 4, 7, 0, 0, 0, 0, time.UTC), "1"}},
+			},
+		},
+	},
+	{
+		Name: "DISTINCT in SUM, AVG and COUNT ignores duplicates and NULLs",
+		SetUpScript: []string{
+			"create table dx (g int, x int)",
+			"insert into dx values (1, 1), (1, 1), (1, 2), (1, null), (2, 1), (2, 4), (2, 4), (2, null), (3, null), (3, null)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select sum(distinct x), avg(distinct x), count(distinct x), count(x) from dx",
+				Expected: []sql.Row{{float64(7), float64(7) / 3, int64(3), int64(6)}},
+			},
+			{
+				Query: "select g, sum(distinct x), avg(distinct x), count(distinct x), sum(x) from dx group by g order by g",
+				Expected: []sql.Row{
+					{1, float64(3), float64(1.5), int64(2), float64(4)},
+					{2, float64(5), float64(2.5), int64(2), float64(9)},
+					{3, nil, nil, int64(0), nil},
+				},
+			},
+			{
+				Query:    "select count(distinct g, x) from dx",
+				Expected: []sql.Row{{int64(4)}},
+			},
+			{
+				Query:    "select g, count(distinct x) c, sum(distinct x) s from dx group by g having c = 2 and s > 3",
+				Expected: []sql.Row{{2, int64(2), float64(5)}},
+			},
+		},
+	},
+	{
+		Name: "UNION aggregates the column types and collations of its selects",
+		SetUpScript: []string{
+			"create table u1 (a int, s varchar(3), d decimal(5,2), n int unsigned)",
+			"create table u2 (b bigint, t varchar(10), e decimal(10,4), m int)",
+			"insert into u1 values (1, 'abc', 1.25, 4), (3, 'c', 2.5, 5)",
+			"insert into u2 values (2, 'abcdefghij', 10.1234, -1)",
+			"create table w as select * from (select a, s, d, n from u1 union select b, t, e, m from u2) sq",
+			"create table c1 (s varchar(10) collate utf8mb4_general_ci)",
+			"create table c2 (s varchar(10) collate utf8mb4_0900_ai_ci)",
+			"create table c3 (s varchar(10) collate utf8mb4_bin)",
+			"insert into c1 values ('a')",
+			"insert into c2 values ('B')",
+			"insert into c3 values ('C')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "select column_name, column_type from information_schema.columns where table_name = 'w' order by ordinal_position",
+				Expected: []sql.Row{
+					{"a", "bigint"},
+					{"s", "varchar(10)"},
+					{"d", "decimal(10,4)"},
+					{"n", "bigint"},
+				},
+			},
+			{
+				Query:    "select a, s, n from w order by a",
+				Expected: []sql.Row{{1, "abc", 4}, {2, "abcdefghij", -1}, {3, "c", 5}},
+			},
+			{
+				Query:    "select s from u1 union select t from u2 order by s desc",
+				Expected: []sql.Row{{"c"}, {"abcdefghij"}, {"abc"}},
+			},
+			{
+				Query:    "select null union select 1",
+				Expected: []sql.Row{{nil}, {1}},
+			},
+			{
+				Query:       "select s from c1 union select s from c2",
+				ExpectedErr: sql.ErrCollationIllegalMix,
+			},
+			{
+				Query:    "select s from c1 union select s collate utf8mb4_general_ci from c2 order by s",
+				Expected: []sql.Row{{"a"}, {"B"}},
+			},
+			{
+				Query:    "select s from c1 union select s from c3 order by s",
+				Expected: []sql.Row{{"C"}, {"a"}},
+			},
+		},
+	},
+	{
+		Name: "UNION ORDER BY and LIMIT bind to the union's result columns",
+		SetUpScript: []string{
+			"create table u1 (a int, s varchar(3))",
+			"create table u2 (b bigint, t varchar(10))",
+			"insert into u1 values (1, 'abc'), (3, 'c')",
+			"insert into u2 values (2, 'abcdefghij')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select a from u1 union select b from u2 order by a desc",
+				Expected: []sql.Row{{3}, {2}, {1}},
+			},
+			{
+				Query:    "select a as x, s from u1 union select b, t from u2 order by s desc, x",
+				Expected: []sql.Row{{3, "c"}, {2, "abcdefghij"}, {1, "abc"}},
+			},
+			{
+				Query:    "select a, s from u1 union select b, t from u2 order by length(s), a",
+				Expected: []sql.Row{{3, "c"}, {1, "abc"}, {2, "abcdefghij"}},
+			},
+			{
+				Query:    "select a from u1 union select b from u2 order by 1 desc",
+				Expected: []sql.Row{{3}, {2}, {1}},
+			},
+			{
+				Query:    "select a from u1 union select b from u2 order by a limit 1 offset 1",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    "select a from u1 union all select b from u2 order by a desc limit 1, 2",
+				Expected: []sql.Row{{2}, {1}},
+			},
+			{
+				Query:       "select a from u1 union select b from u2 order by u1.a",
+				ExpectedErr: sql.ErrTableNameNotAllowedInUnionOrderBy,
+			},
+			{
+				Query:       "select a from u1 union select b from u2 order by b",
+				ExpectedErr: sql.ErrColumnNotFound,
+			},
+		},
+	},
+	{
+		Name: "index merge returns the same rows as a table scan",
+		SetUpScript: []string{
+			"create table im (id int primary key, a int, b int, c varchar(10), key (a), key (b), key (c))",
+			"insert into im values (1, 1, 1, 'x'), (2, 1, 2, 'y'), (3, 2, 2, 'x'), (4, 3, 3, 'z'), (5, null, 2, null), (6, null, null, 'y')",
+			"create table im_keyless (a int, b int, key (a), key (b))",
+			"insert into im_keyless values (1, 2), (1, 2), (1, 1), (3, 2), (null, 2), (4, 4), (null, null)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select * from im where a = 1 or b = 2 order by id",
+				Expected: []sql.Row{{1, 1, 1, "x"}, {2, 1, 2, "y"}, {3, 2, 2, "x"}, {5, nil, 2, nil}},
+			},
+			{
+				Query:    "select * from im where a is null or b = 3 or c = 'x' order by id",
+				Expected: []sql.Row{{1, 1, 1, "x"}, {3, 2, 2, "x"}, {4, 3, 3, "z"}, {5, nil, 2, nil}, {6, nil, nil, "y"}},
+			},
+			{
+				Query:    "select id from im where (a = 1 or b = 2) and c = 'x' order by id",
+				Expected: []sql.Row{{1}, {3}},
+			},
+			{
+				Query:    "select count(*) from im where a > 1 or b < 2 or c in ('y', 'z')",
+				Expected: []sql.Row{{5}},
+			},
+			{
+				Query:    "select * from im_keyless where a = 1 or b = 2 order by a, b",
+				Expected: []sql.Row{{nil, 2}, {1, 1}, {1, 2}, {1, 2}, {3, 2}},
+			},
+			{
+				Query:    "set optimizer_switch = 'index_merge=off'",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "select @@optimizer_switch",
+				Expected: []sql.Row{{"index_merge=off"}},
+			},
+			{
+				Query:    "select * from im where a = 1 or b = 2 order by id",
+				Expected: []sql.Row{{1, 1, 1, "x"}, {2, 1, 2, "y"}, {3, 2, 2, "x"}, {5, nil, 2, nil}},
+			},
+			{
+				Query:    "select * from im where a is null or b = 3 or c = 'x' order by id",
+				Expected: []sql.Row{{1, 1, 1, "x"}, {3, 2, 2, "x"}, {4, 3, 3, "z"}, {5, nil, 2, nil}, {6, nil, nil, "y"}},
+			},
+			{
+				Query:    "select id from im where (a = 1 or b = 2) and c = 'x' order by id",
+				Expected: []sql.Row{{1}, {3}},
+			},
+			{
+				Query:    "select count(*) from im where a > 1 or b < 2 or c in ('y', 'z')",
+				Expected: []sql.Row{{5}},
+			},
+			{
+				Query:    "select * from im_keyless where a = 1 or b = 2 order by a, b",
+				Expected: []sql.Row{{nil, 2}, {1, 1}, {1, 2}, {1, 2}, {3, 2}},
+			},
+		},
+	},
+	{
+		Name: "index condition pushdown returns the same rows as a filter",
+		SetUpScript: []string{
+			"create table icp (id int primary key, a int, b int, c varchar(10), d int, key abc (a, b, c))",
+			"insert into icp values (1, 1, 1, 'x', 10), (2, 1, 2, 'y', 20), (3, 2, null, 'x', 30), (4, 2, 3, 'z', 40), (5, 3, 2, null, 50), (6, null, 2, 'x', 60)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select id from icp where a > 0 and c = 'x' order by id",
+				Expected: []sql.Row{{1}, {3}},
+			},
+			{
+				Query:    "select id from icp where a >= 1 and b is null order by id",
+				Expected: []sql.Row{{3}},
+			},
+			{
+				Query:    "select id from icp where a < 3 and c = 'x' and d > 10 order by id",
+				Expected: []sql.Row{{3}},
+			},
+			{
+				Query:    "select i.id from icp i where i.a in (1, 2) and i.b + 1 > 2 order by i.id",
+				Expected: []sql.Row{{2}, {4}},
+			},
+			{
+				Query:    "select id from icp where a > 1 and (b = 2 or c = 'z') order by id",
+				Expected: []sql.Row{{4}, {5}},
+			},
+			{
+				Query:    "select id from icp where exists (select 1 from icp i where i.a = 1 and i.c = icp.c) order by id",
+				Expected: []sql.Row{{1}, {2}, {3}, {6}},
+			},
+			{
+				Query:    "set optimizer_switch = 'index_condition_pushdown=off'",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "select id from icp where a > 0 and c = 'x' order by id",
+				Expected: []sql.Row{{1}, {3}},
+			},
+			{
+				Query:    "select id from icp where a >= 1 and b is null order by id",
+				Expected: []sql.Row{{3}},
+			},
+			{
+				Query:    "select id from icp where a < 3 and c = 'x' and d > 10 order by id",
+				Expected: []sql.Row{{3}},
+			},
+			{
+				Query:    "select i.id from icp i where i.a in (1, 2) and i.b + 1 > 2 order by i.id",
+				Expected: []sql.Row{{2}, {4}},
+			},
+			{
+				Query:    "select id from icp where a > 1 and (b = 2 or c = 'z') order by id",
+				Expected: []sql.Row{{4}, {5}},
+			},
+			{
+				Query:    "select id from icp where exists (select 1 from icp i where i.a = 1 and i.c = icp.c) order by id",
+				Expected: []sql.Row{{1}, {2}, {3}, {6}},
+			},
+		},
+	},
+	{
+		Name: "index condition pushdown in updates and deletes",
+		SetUpScript: []string{
+			"create table icp (id int primary key, a int, b int, c varchar(10), d int, key abc (a, b, c))",
+			"insert into icp values (1, 1, 1, 'x', 10), (2, 1, 2, 'y', 20), (3, 2, null, 'x', 30), (4, 2, 3, 'z', 40), (5, 3, 2, null, 50), (6, null, 2, 'x', 60)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "update icp set d = d + 1 where a > 1 and c = 'x'",
+				Expected: []sql.Row{{newUpdateResult(1, 1)}},
+			},
+			{
+				Query:    "delete from icp where a = 1 and c = 'y'",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "select * from icp order by id",
+				Expected: []sql.Row{{1, 1, 1, "x", 10}, {3, 2, nil, "x", 31}, {4, 2, 3, "z", 40}, {5, 3, 2, nil, 50}, {6, nil, 2, "x", 60}},
+			},
+		},
+	},
+	{
+		Name: "negated index lookups return the complement of the lookup",
+		SetUpScript: []string{
+			"create table nl (id int primary key, a int, b int, key (a), key (b), key (a, b))",
+			"insert into nl values (1, 1, 1), (2, 1, 2), (3, 2, 2), (4, 3, 3), (5, null, 2), (6, null, null)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select id from nl where not (a <=> 1) order by id",
+				Expected: []sql.Row{{3}, {4}, {5}, {6}},
+			},
+			{
+				Query:    "select id from nl where not (a <=> null) order by id",
+				Expected: []sql.Row{{1}, {2}, {3}, {4}},
+			},
+			{
+				Query:    "select id from nl where a != 1 order by id",
+				Expected: []sql.Row{{3}, {4}},
+			},
+			{
+				Query:    "select id from nl where not (a <=> 1) and not (b <=> 2) order by id",
+				Expected: []sql.Row{{4}, {6}},
+			},
+			{
+				Query:    "select id from nl where not (a <=> 1) or b <=> 1 order by id",
+				Expected: []sql.Row{{1}, {3}, {4}, {5}, {6}},
+			},
+			{
+				Query:    "select id from nl where not (a <=> 1 and b <=> 2) order by id",
+				Expected: []sql.Row{{1}, {3}, {4}, {5}, {6}},
+			},
+			{
+				Query:    "select id from nl where not (a >= 1 and b <= 2) order by id",
+				Expected: []sql.Row{{4}},
+			},
+			{
+				Query:    "select count(*) from nl where not (a <=> 2)",
+				Expected: []sql.Row{{5}},
+			},
+		},
+	},
+	{
+		Name: "CTE scoping, ordering and CTEs in DML statements",
+		SetUpScript: []string{
+			"create table ct (x int primary key, y int);",
+			"insert into ct values (1, 10), (2, 20), (3, 30);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "with top as (select * from ct order by x limit 2) select * from top where x > 1",
+				Expected: []sql.Row{{2, 20}},
+			},
+			{
+				Query:    "with top as (select * from ct order by x desc limit 1) select * from top where y = 10",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "with ct as (select 5 as x) select * from ct",
+				Expected: []sql.Row{{5}},
+			},
+			{
+				Query:    "with ct as (select * from ct where x > 1) select x from ct order by x",
+				Expected: []sql.Row{{2}, {3}},
+			},
+			{
+				Query:    "select x from ct where x in (with ct as (select 2 as x) select x from ct)",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    "with a as (select 1 as x), b as (with a as (select 2 as x) select * from a) select * from a, b",
+				Expected: []sql.Row{{1, 2}},
+			},
+			{
+				Query:    "with w as (select 2 as x) update ct set y = y + 1 where exists (select 1 from w where w.x = ct.x)",
+				Expected: []sql.Row{{newUpdateResult(1, 1)}},
+			},
+			{
+				Query:    "with w as (select 3 as x) delete from ct where exists (select 1 from w where w.x = ct.x)",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "with w as (select 1 as x) delete from ct where x in (select x from w)",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "select * from ct",
+				Expected: []sql.Row{{2, 21}},
+			},
 		},
 	},
 	{
@@ -2249,7 +3265,7 @@ var ScriptTests = []ScriptTest{
 		Assertions: []ScriptTestAssertion{
 			{
 				Query:    "SHOW CREATE TABLE test;",
-				Expected: []sql.Row{{"test", "CREATE TABLE `test` (\n  `pk` bigint NOT NULL,\n  `v1` varchar(255),\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+				Expected: []sql.Row{{"test", "CREATE TABLE `test` (\n  `pk` bigint NOT NULL,\n  `v1` varchar(255) DEFAULT NULL,\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
 			},
 			{
 				Query:    "ALTER TABLE test CHANGE v1 v2 VARCHAR(255) CHARACTER SET utf8mb4 BINARY NOT NULL;",
@@ -2257,7 +3273,7 @@ var ScriptTests = []ScriptTest{
 			},
 			{
 				Query:    "SHOW CREATE TABLE test;",
-				Expected: []sql.Row{{"test", "CREATE TABLE `test` (\n  `pk` bigint NOT NULL,\n  `v2` varchar(255) COLLATE utf8mb4_bin NOT NULL,\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+				Expected: []sql.Row{{"test", "CREATE TABLE `test` (\n  `pk` bigint NOT NULL,\n  `v2` varchar(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin NOT NULL,\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
 			},
 			{
 				Query:    "CREATE TABLE test2 (pk BIGINT PRIMARY KEY, v1 VARCHAR(255) CHARACTER SET utf8mb4 BINARY);",
@@ -2265,7 +3281,7 @@ var ScriptTests = []ScriptTest{
 			},
 			{
 				Query:    "SHOW CREATE TABLE test2;",
-				Expected: []sql.Row{{"test2", "CREATE TABLE `test2` (\n  `pk` bigint NOT NULL,\n  `v1` varchar(255) COLLATE utf8mb4_bin,\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+				Expected: []sql.Row{{"test2", "CREATE TABLE `test2` (\n  `pk` bigint NOT NULL,\n  `v1` varchar(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin DEFAULT NULL,\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
 			},
 		},
 	},
@@ -2309,39 +3325,80 @@ var ScriptTests = []ScriptTest{
 				Query:    "SELECT avg(id), avg(val1), avg(val2) FROM float_table ORDER BY id;;",
 				Expected: []sql.Row{{float64(2), -3.107558765167293e-16, 3.333333412806193}},
 			},
-		},
-	},
-	{
-		Name: "compare DECIMAL type columns with different precision and scale",
-		SetUpScript: []string{
-			"create table t (id int primary key, val1 decimal(2, 1), val2 decimal(3, 1));",
-			"insert into t values (1, 1.2, 1.1), (2, 1.2, 10.1);",
-		},
-		Assertions: []ScriptTestAssertion{
+		},
+	},
+	{
+		Name: "compare DECIMAL type columns with different precision and scale",
+		SetUpScript: []string{
+			"create table t (id int primary key, val1 decimal(2, 1), val2 decimal(3, 1));",
+			"insert into t values (1, 1.2, 1.1), (2, 1.2, 10.1);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select if(val1 < val2, 'YES', 'NO') from t order by id;",
+				Expected: []sql.Row{{"NO"}, {"YES"}},
+			},
+		},
+	},
+	{
+		Name: "basic test on tables dual and `dual`",
+		SetUpScript: []string{
+			"CREATE TABLE `dual` (id int)",
+			"INSERT INTO `dual` VALUES (2)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT * from `dual`;",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    "SELECT 3 from dual;",
+				Expected: []sql.Row{{3}},
+			},
+			{
+				Query:       "SELECT * from dual;",
+				ExpectedErr: sql.ErrNoTablesUsed,
+			},
+		},
+	},
+	{
+		Name: "FROM DUAL and FROM-less selects return the same single row",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT 1 FROM DUAL",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "SELECT 1",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "SELECT 1 FROM DUAL WHERE 1 = 0",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT 1 WHERE 1 = 0",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT 1 FROM DUAL WHERE 1 = 1 HAVING 1 = 0",
+				Expected: []sql.Row{},
+			},
 			{
-				Query:    "select if(val1 < val2, 'YES', 'NO') from t order by id;",
-				Expected: []sql.Row{{"NO"}, {"YES"}},
+				Query:    "SELECT count(*), sum(1), max(2)",
+				Expected: []sql.Row{{1, float64(1), 2}},
 			},
-		},
-	},
-	{
-		Name: "basic test on tables dual and `dual`",
-		SetUpScript: []string{
-			"CREATE TABLE `dual` (id int)",
-			"INSERT INTO `dual` VALUES (2)",
-		},
-		Assertions: []ScriptTestAssertion{
 			{
-				Query:    "SELECT * from `dual`;",
-				Expected: []sql.Row{{2}},
+				Query:    "SELECT count(*), sum(1), max(2) FROM DUAL",
+				Expected: []sql.Row{{1, float64(1), 2}},
 			},
 			{
-				Query:    "SELECT 3 from dual;",
-				Expected: []sql.Row{{3}},
+				Query:    "SELECT count(*), sum(1), max(2) FROM DUAL WHERE 1 = 0",
+				Expected: []sql.Row{{0, nil, nil}},
 			},
 			{
-				Query:       "SELECT * from dual;",
-				ExpectedErr: sql.ErrNoTablesUsed,
+				Query:    "SELECT count(*) WHERE 1 = 0",
+				Expected: []sql.Row{{0}},
 			},
 		},
 	},
@@ -2966,6 +4023,308 @@ var ScriptTests = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "correlated scalar subqueries in the select list of grouped queries",
+		SetUpScript: []string{
+			"create table t (id int primary key, a int, b int)",
+			"create table s (id int primary key, a int, x int)",
+			"insert into t values (1, 1, 10), (2, 1, 20), (3, 2, 30), (4, 3, 40)",
+			"insert into s values (1, 1, 100), (2, 1, 200), (3, 2, 300)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select a, (select max(x) from s where s.a = t.a) from t group by a order by a",
+				Expected: []sql.Row{{1, 200}, {2, 300}, {3, nil}},
+			},
+			{
+				Query:    "select a, sum(b), (select min(x) from s where s.a = t.a and s.x > 100) as m from t group by a order by a",
+				Expected: []sql.Row{{1, float64(30), 200}, {2, float64(30), 300}, {3, float64(40), nil}},
+			},
+			{
+				Query:    "select a, (select max(x) from s where s.a = t.a) as m from t group by a having m > 200",
+				Expected: []sql.Row{{2, 300}},
+			},
+			{
+				Query:    "select a, (select max(x) from s where s.a = t.a) as mx, (select min(x) from s where t.a = s.a) as mn from t group by a order by mx desc",
+				Expected: []sql.Row{{2, 300, 300}, {1, 200, 100}, {3, nil, nil}},
+			},
+			{
+				Query:    "select a, (select count(*) from s where s.a = t.a) as c from t group by a order by a",
+				Expected: []sql.Row{{1, 2}, {2, 1}, {3, 0}},
+			},
+			{
+				Query:    "SELECT a+0 g, (SELECT MAX(x) FROM s WHERE s.a = t.a) m FROM t GROUP BY a ORDER BY a",
+				Expected: []sql.Row{{1, 200}, {2, 300}, {3, nil}},
+			},
+			{
+				Query:    "SELECT a+0 g, (SELECT MAX(x) FROM s WHERE s.a = t.a) m FROM t GROUP BY a ORDER BY a DESC",
+				Expected: []sql.Row{{3, nil}, {2, 300}, {1, 200}},
+			},
+			{
+				Query:    "select id, (select max(x) from s where s.a = t.a) from t order by id",
+				Expected: []sql.Row{{1, 200}, {2, 200}, {3, 300}, {4, nil}},
+			},
+			{
+				Query:       "select a, (select x from s where s.id = t.id) from t group by a",
+				ExpectedErr: analyzererrors.ErrValidationGroupBy,
+			},
+		},
+	},
+	{
+		Name: "functional key parts",
+		SetUpScript: []string{
+			"create table t (id int primary key, email varchar(50), a int, b int)",
+			"insert into t values (1, 'Foo@x.com', 1, 2), (2, 'bar@x.com', 2, 3), (3, 'BAZ@x.com', 3, 4)",
+			"create index idx_lower on t ((lower(email)))",
+			"alter table t add unique index ((a + b))",
+			"create table t2 (id int primary key, s varchar(10), key k1 (id, (upper(s))))",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "show create table t",
+				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n  `id` int NOT NULL,\n  `email` varchar(50) DEFAULT NULL,\n  `a` int DEFAULT NULL,\n  `b` int DEFAULT NULL,\n  PRIMARY KEY (`id`),\n  UNIQUE KEY `functional_index` (((a + b))),\n  KEY `idx_lower` ((lower(email)))\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+			},
+			{
+				Query:    "show create table t2",
+				Expected: []sql.Row{{"t2", "CREATE TABLE `t2` (\n  `id` int NOT NULL,\n  `s` varchar(10) DEFAULT NULL,\n  PRIMARY KEY (`id`),\n  KEY `k1` (`id`,(upper(s)))\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+			},
+			{
+				Query: "select index_name, seq_in_index, column_name, expression from information_schema.statistics where table_name = 't2' order by 1, 2",
+				Expected: []sql.Row{
+					{"k1", 1, "id", nil},
+					{"k1", 2, nil, "upper(s)"},
+					{"PRIMARY", 1, "id", nil},
+				},
+			},
+			{
+				Query:    "select id from t where lower(email) = 'foo@x.com'",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "select id from t where a + b = 5",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:       "insert into t values (4, 'qux@x.com', 4, 1)",
+				ExpectedErr: sql.ErrUniqueKeyViolation,
+			},
+			{
+				Query:    "insert into t values (4, 'qux@x.com', 4, 2)",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:       "update t set b = 1 where id = 4",
+				ExpectedErr: sql.ErrUniqueKeyViolation,
+			},
+			{
+				Query:    "select id from t where a + b = 6",
+				Expected: []sql.Row{{4}},
+			},
+			{
+				Query:       "create index idx on t ((a))",
+				ExpectedErr: sql.ErrFunctionalIndexOnColumn,
+			},
+			{
+				Query:       "create index idx on t ((rand()))",
+				ExpectedErr: sql.ErrFunctionalIndexDisallowedFunction,
+			},
+			{
+				Query:       "create table t3 (i int, primary key ((i + 1)))",
+				ExpectedErr: sql.ErrFunctionalIndexPrimaryKey,
+			},
+			{
+				Query:       "create index idx on t ((lower(email))(5))",
+				ExpectedErr: sql.ErrFunctionalIndexPrefix,
+			},
+			{
+				Query:    "create table t4 (gms_key_part_1 int primary key, k int, key ((gms_key_part_1 + k)), key kk (k, gms_key_part_1))",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "show create table t4",
+				Expected: []sql.Row{{"t4", "CREATE TABLE `t4` (\n  `gms_key_part_1` int NOT NULL,\n  `k` int DEFAULT NULL,\n  PRIMARY KEY (`gms_key_part_1`),\n  KEY `functional_index` (((gms_key_part_1 + k))),\n  KEY `kk` (`k`,`gms_key_part_1`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+			},
+		},
+	},
+	{
+		Name: "descending index key parts",
+		SetUpScript: []string{
+			"create table t (pk int primary key, a int, b int, index ab (a desc, b asc))",
+			"insert into t values (1, 1, 1), (2, 1, 2), (3, 2, 1), (4, null, 1), (5, 3, 3)",
+			"create table t2 (pk int primary key, s varchar(10))",
+			"create index s_desc on t2 ((upper(s)) desc, pk)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "show create table t",
+				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n  `pk` int NOT NULL,\n  `a` int DEFAULT NULL,\n  `b` int DEFAULT NULL,\n  PRIMARY KEY (`pk`),\n  KEY `ab` (`a` DESC,`b`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+			},
+			{
+				Query:    "show create table t2",
+				Expected: []sql.Row{{"t2", "CREATE TABLE `t2` (\n  `pk` int NOT NULL,\n  `s` varchar(10) DEFAULT NULL,\n  PRIMARY KEY (`pk`),\n  KEY `s_desc` ((upper(s)) DESC,`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+			},
+			{
+				Query: "select table_name, index_name, seq_in_index, collation from information_schema.statistics where table_name in ('t', 't2') order by 1, 2, 3",
+				Expected: []sql.Row{
+					{"t", "ab", 1, "D"},
+					{"t", "ab", 2, "A"},
+					{"t", "PRIMARY", 1, "A"},
+					{"t2", "PRIMARY", 1, "A"},
+					{"t2", "s_desc", 1, "D"},
+					{"t2", "s_desc", 2, "A"},
+				},
+			},
+			{
+				Query:    "select pk from t order by a desc, b",
+				Expected: []sql.Row{{5}, {3}, {1}, {2}, {4}},
+			},
+			{
+				Query:    "select pk from t order by a, b desc",
+				Expected: []sql.Row{{4}, {2}, {1}, {3}, {5}},
+			},
+			{
+				Query:    "select pk from t order by a desc limit 2",
+				Expected: []sql.Row{{5}, {3}},
+			},
+			{
+				Query:    "select pk from t order by pk desc",
+				Expected: []sql.Row{{5}, {4}, {3}, {2}, {1}},
+			},
+			{
+				Query:    "select pk from t where a > 1 order by pk",
+				Expected: []sql.Row{{3}, {5}},
+			},
+		},
+	},
+	{
+		Name: "TABLESAMPLE and ORDER BY RAND() LIMIT",
+		SetUpScript: []string{
+			"create table t (i int primary key, j int, index jdx (j))",
+			"insert into t with recursive c(n) as (select 1 union all select n + 1 from c where n < 1000) select n, n % 10 from c",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select count(*) from t tablesample system (100)",
+				Expected: []sql.Row{{1000}},
+			},
+			{
+				Query:    "select count(*) from t tablesample bernoulli (100) where j = 0",
+				Expected: []sql.Row{{100}},
+			},
+			{
+				Query:    "select count(*) from t as x tablesample system (0)",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "select count(*) from t x tablesample bernoulli (0) repeatable (1)",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				// about 13 standard deviations either way
+				Query:    "select count(*) between 100 and 500 from t tablesample bernoulli (30)",
+				Expected: []sql.Row{{true}},
+			},
+			{
+				Query:    "select (select sum(i) from t tablesample bernoulli (30) repeatable (5)) = (select sum(i) from t tablesample bernoulli (30) repeatable (5))",
+				Expected: []sql.Row{{true}},
+			},
+			{
+				Query:    "select count(*) from t a tablesample bernoulli (100) join t b tablesample system (100) on a.i = b.i",
+				Expected: []sql.Row{{1000}},
+			},
+			{
+				Query:    "select count(*) from t as x tablesample system (100) use index (jdx) where i > 0",
+				Expected: []sql.Row{{1000}},
+			},
+			{
+				Query:    "select count(*) from t x use index (jdx) join t y tablesample system (0) on x.i = y.i",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:       "select count(*) from t tablesample system (10) join t as t2 on t.i = t2.i join t as t3 tablesample system (10) on t.i = t3.i where t.i in (select i from t)",
+				ExpectedErr: parse.ErrTableSampleNotApplicable,
+			},
+			{
+				Query:       "select * from t tablesample bernoulli (101)",
+				ExpectedErr: sql.ErrInvalidArgumentDetails,
+			},
+			{
+				Query:       "select * from t tablesample system (10) repeatable (seed)",
+				ExpectedErr: sql.ErrInvalidArgumentDetails,
+			},
+			{
+				Query:    "select count(*), count(distinct i) from (select * from t order by rand() limit 10) sq",
+				Expected: []sql.Row{{10, 10}},
+			},
+			{
+				Query:    "select count(*) from (select * from t where j = 1 order by rand() limit 1000) sq",
+				Expected: []sql.Row{{100}},
+			},
+		},
+	},
+	{
+		Name: "TABLESAMPLE in views and triggers",
+		SetUpScript: []string{
+			"create table t (i int primary key)",
+			"insert into t values (1), (2), (3)",
+			"create table log (n int)",
+			"create table u (i int primary key)",
+			"create view v as select count(*) as n from t tablesample system (0)",
+			"create trigger trg after insert on u for each row insert into log select count(*) from t tablesample bernoulli (0)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select * from v",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "show create view v",
+				Expected: []sql.Row{{"v", "CREATE VIEW `v` AS select count(*) as n from t tablesample system (0)", "utf8mb4", "utf8mb4_0900_bin"}},
+			},
+			{
+				Query:    "insert into u values (1)",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "select * from log",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "select action_statement from information_schema.triggers where trigger_name = 'trg'",
+				Expected: []sql.Row{{"insert into log select count(*) from t tablesample bernoulli (0)"}},
+			},
+		},
+	},
+	{
+		Name: "explain format=tree annotates joins with estimated costs and rows",
+		SetUpScript: []string{
+			"create table customers (id int primary key, name varchar(20))",
+			"create table orders (id int primary key, customer_id int, total int)",
+			"insert into customers values (1, 'alice'), (2, 'bob')",
+			"insert into orders values (1, 1, 10), (2, 1, 20), (3, 2, 30), (4, 2, 40)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "explain format=tree select c.name, o.total from orders o join customers c on o.customer_id = c.id where o.total > 15",
+				Expected: []sql.Row{
+					{"-> Project: c.name, o.total (cost=5.64 rows=0.4)"},
+					{"    -> Nested loop inner join (o.customer_id = c.id) (cost=5.64 rows=0.4)"},
+					{"        -> Filter: (o.total > 15) (cost=4.04 rows=0.4)"},
+					{"            -> Table scan on o (cost=4.00 rows=4)"},
+					{"        -> Index lookup on c using `PRIMARY` (cost=2.00 rows=1)"},
+				},
+			},
+			{
+				Query: "explain format=tree select c.name, count(*) from customers c join orders o on o.total > c.id * 10 group by c.name",
+				Expected: []sql.Row{
+					{"-> Group aggregate: c.name (cost=10.09 rows=0.8)"},
+					{"    -> Nested loop inner join (o.total > (c.id * 10)) (cost=10.08 rows=0.8)"},
+					{"        -> Table scan on c (cost=2.00 rows=2)"},
+					{"        -> Table scan on o (cost=4.00 rows=4)"},
+				},
+			},
+		},
+	},
 }
 
 var SpatialScriptTests = []ScriptTest{
@@ -3116,7 +4475,7 @@ var SpatialScriptTests = []ScriptTest{
 		Assertions: []ScriptTestAssertion{
 			{
 				Query:    "show create table tab0",
-				Expected: []sql.Row{{"tab0", "CREATE TABLE `tab0` (\n  `i` int NOT NULL,\n  `g` geometry SRID 4326 DEFAULT (point(1,1)),\n  PRIMARY KEY (`i`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+				Expected: []sql.Row{{"tab0", "CREATE TABLE `tab0` (\n  `i` int NOT NULL,\n  `g` geometry /*!80003 SRID 4326 */ DEFAULT (point(1,1)),\n  PRIMARY KEY (`i`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
 			},
 			{
 				Query:    "INSERT INTO tab0 VALUES (1, ST_GEOMFROMTEXT(ST_ASWKT(POINT(1,2)), 4326))",
@@ -3148,7 +4507,7 @@ var SpatialScriptTests = []ScriptTest{
 		Assertions: []ScriptTestAssertion{
 			{
 				Query:    "show create table tab1",
-				Expected: []sql.Row{{"tab1", "CREATE TABLE `tab1` (\n  `i` int NOT NULL,\n  `l` linestring SRID 0,\n  PRIMARY KEY (`i`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+				Expected: []sql.Row{{"tab1", "CREATE TABLE `tab1` (\n  `i` int NOT NULL,\n  `l` linestring /*!80003 SRID 0 */,\n  PRIMARY KEY (`i`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
 			},
 			{
 				Query:    "INSERT INTO tab1 VALUES (1, LINESTRING(POINT(0, 0),POINT(2, 2)))",
@@ -3180,7 +4539,7 @@ var SpatialScriptTests = []ScriptTest{
 			},
 			{
 				Query:    "show create table tab2",
-				Expected: []sql.Row{{"tab2", "CREATE TABLE `tab2` (\n  `i` int NOT NULL,\n  `p` point NOT NULL SRID 0,\n  PRIMARY KEY (`i`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+				Expected: []sql.Row{{"tab2", "CREATE TABLE `tab2` (\n  `i` int NOT NULL,\n  `p` point NOT NULL /*!80003 SRID 0 */,\n  PRIMARY KEY (`i`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
 			},
 			{
 				Query:    "INSERT INTO tab2 VALUES (1, POINT(2, 2))",
@@ -3224,7 +4583,7 @@ var SpatialScriptTests = []ScriptTest{
 			},
 			{
 				Query:    "show create table tab2",
-				Expected: []sql.Row{{"tab2", "CREATE TABLE `tab2` (\n  `i` int NOT NULL,\n  `p` point NOT NULL SRID 4326,\n  PRIMARY KEY (`i`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+				Expected: []sql.Row{{"tab2", "CREATE TABLE `tab2` (\n  `i` int NOT NULL,\n  `p` point NOT NULL /*!80003 SRID 4326 */,\n  PRIMARY KEY (`i`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
 			},
 		},
 	},
@@ -3268,6 +4627,76 @@ var SpatialScriptTests = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "geometries round-trip through WKT and WKB",
+		SetUpScript: []string{
+			"CREATE TABLE geoms (i int primary key, g geometry)",
+			`INSERT INTO geoms VALUES
+				(1, ST_GEOMFROMTEXT('POINT(1 2)')),
+				(2, ST_GEOMFROMTEXT('LINESTRING(0 0,1.5 2,-3 4)')),
+				(3, ST_GEOMFROMTEXT('POLYGON((0 0,4 0,4 4,0 0),(1 1,2 1,2 2,1 1))')),
+				(4, ST_GEOMFROMTEXT('MULTIPOINT(1 2,3 4)')),
+				(5, ST_GEOMFROMTEXT('MULTILINESTRING((0 0,1 1),(2 2,3 3))')),
+				(6, ST_GEOMFROMTEXT('MULTIPOLYGON(((0 0,1 0,1 1,0 0)),((2 2,3 2,3 3,2 2)))')),
+				(7, ST_GEOMFROMTEXT('GEOMETRYCOLLECTION(POINT(1 2),LINESTRING(0 0,1 1))')),
+				(8, ST_GEOMFROMTEXT('POINT(45 -120)', 4326))`,
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "SELECT i, ST_ASTEXT(g), ST_SRID(g) FROM geoms ORDER BY i",
+				Expected: []sql.Row{
+					{1, "POINT(1 2)", uint32(0)},
+					{2, "LINESTRING(0 0,1.5 2,-3 4)", uint32(0)},
+					{3, "POLYGON((0 0,4 0,4 4,0 0),(1 1,2 1,2 2,1 1))", uint32(0)},
+					{4, "MULTIPOINT(1 2,3 4)", uint32(0)},
+					{5, "MULTILINESTRING((0 0,1 1),(2 2,3 3))", uint32(0)},
+					{6, "MULTIPOLYGON(((0 0,1 0,1 1,0 0)),((2 2,3 2,3 3,2 2)))", uint32(0)},
+					{7, "GEOMETRYCOLLECTION(POINT(1 2),LINESTRING(0 0,1 1))", uint32(0)},
+					{8, "POINT(45 -120)", uint32(4326)},
+				},
+			},
+			{
+				Query:    "SELECT i FROM geoms WHERE ST_GEOMFROMTEXT(ST_ASTEXT(g), ST_SRID(g)) <> g",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT i FROM geoms WHERE ST_GEOMFROMWKB(ST_ASWKB(g), ST_SRID(g)) <> g",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT i FROM geoms WHERE ST_GEOMFROMWKB(ST_ASBINARY(g), ST_SRID(g)) <> g",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT ST_ASTEXT(ST_GEOMFROMWKB(ST_ASWKB(g), ST_SRID(g))) FROM geoms WHERE i = 6",
+				Expected: []sql.Row{{"MULTIPOLYGON(((0 0,1 0,1 1,0 0)),((2 2,3 2,3 3,2 2)))"}},
+			},
+			{
+				Query:    "SELECT HEX(ST_ASWKB(g)) FROM geoms WHERE i = 1",
+				Expected: []sql.Row{{"0101000000000000000000F03F0000000000000040"}},
+			},
+			{
+				Query:       "SELECT ST_GEOMFROMTEXT('POINT(1 2')",
+				ExpectedErr: sql.ErrInvalidGISData,
+			},
+			{
+				Query:       "SELECT ST_GEOMFROMTEXT('LINESTRING(0 0,1)')",
+				ExpectedErr: sql.ErrInvalidGISData,
+			},
+			{
+				Query:       "SELECT ST_GEOMFROMTEXT('POLYGON((0 0,1 1,1 0))')",
+				ExpectedErr: sql.ErrInvalidGISData,
+			},
+			{
+				Query:       "SELECT ST_GEOMFROMTEXT('CIRCLE(0 0,1)')",
+				ExpectedErr: sql.ErrInvalidGISData,
+			},
+			{
+				Query:       "SELECT ST_GEOMFROMWKB(UNHEX('0101000000'))",
+				ExpectedErr: sql.ErrInvalidGISData,
+			},
+		},
+	},
 	{
 		Name: "invalid cases of SRID value",
 		SetUpScript: []string{
@@ -3306,7 +4735,7 @@ var SpatialScriptTests = []ScriptTest{
 			},
 			{
 				Query:    "show create table table1",
-				Expected: []sql.Row{{"table1", "CREATE TABLE `table1` (\n  `i` int NOT NULL,\n  `p` geometry SRID 4326,\n  PRIMARY KEY (`i`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+				Expected: []sql.Row{{"table1", "CREATE TABLE `table1` (\n  `i` int NOT NULL,\n  `p` geometry /*!80003 SRID 4326 */,\n  PRIMARY KEY (`i`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
 			},
 			{
 				Query:    "INSERT INTO table1 VALUES (2, ST_SRID(LINESTRING(POINT(0, 0),POINT(2, 2)),4326))",
@@ -3391,14 +4820,14 @@ var SpatialIndexScriptTests = []ScriptTest{
 					{
 						"geom",
 						"CREATE TABLE `geom` (\n" +
-							"  `p` point NOT NULL SRID 0,\n" +
-							"  `l` linestring NOT NULL SRID 0,\n" +
-							"  `py` polygon NOT NULL SRID 0,\n" +
-							"  `mp` multipoint NOT NULL SRID 0,\n" +
-							"  `ml` multilinestring NOT NULL SRID 0,\n" +
-							"  `mpy` multipolygon NOT NULL SRID 0,\n" +
-							"  `gc` geometrycollection NOT NULL SRID 0,\n" +
-							"  `g` geometry NOT NULL SRID 0,\n" +
+							"  `p` point NOT NULL /*!80003 SRID 0 */,\n" +
+							"  `l` linestring NOT NULL /*!80003 SRID 0 */,\n" +
+							"  `py` polygon NOT NULL /*!80003 SRID 0 */,\n" +
+							"  `mp` multipoint NOT NULL /*!80003 SRID 0 */,\n" +
+							"  `ml` multilinestring NOT NULL /*!80003 SRID 0 */,\n" +
+							"  `mpy` multipolygon NOT NULL /*!80003 SRID 0 */,\n" +
+							"  `gc` geometrycollection NOT NULL /*!80003 SRID 0 */,\n" +
+							"  `g` geometry NOT NULL /*!80003 SRID 0 */,\n" +
 							"  SPATIAL KEY `g` (`g`),\n" +
 							"  SPATIAL KEY `gc` (`gc`),\n" +
 							"  SPATIAL KEY `l` (`l`),\n" +
@@ -3429,7 +4858,7 @@ var SpatialIndexScriptTests = []ScriptTest{
 			{
 				Query: "show create table geom_tbl",
 				Expected: []sql.Row{
-					{"geom_tbl", "CREATE TABLE `geom_tbl` (\n  `g` geometry NOT NULL SRID 0,\n  SPATIAL KEY `g` (`g`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"},
+					{"geom_tbl", "CREATE TABLE `geom_tbl` (\n  `g` geometry NOT NULL /*!80003 SRID 0 */,\n  SPATIAL KEY `g` (`g`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"},
 				},
 			},
 			{
@@ -3456,7 +4885,7 @@ var SpatialIndexScriptTests = []ScriptTest{
 			{
 				Query: "show create table geom_tbl",
 				Expected: []sql.Row{
-					{"geom_tbl", "CREATE TABLE `geom_tbl` (\n  `i` int NOT NULL,\n  `j` int NOT NULL,\n  `g` geometry NOT NULL SRID 0,\n  PRIMARY KEY (`i`,`j`),\n  SPATIAL KEY `g` (`g`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"},
+					{"geom_tbl", "CREATE TABLE `geom_tbl` (\n  `i` int NOT NULL,\n  `j` int NOT NULL,\n  `g` geometry NOT NULL /*!80003 SRID 0 */,\n  PRIMARY KEY (`i`,`j`),\n  SPATIAL KEY `g` (`g`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"},
 				},
 			},
 			{
@@ -3524,7 +4953,7 @@ var CreateCheckConstraintsScripts = []ScriptTest{
 					{
 						"mytable2",
 						"CREATE TABLE `mytable2` (\n  `pk` int NOT NULL,\n" +
-							"  `v` int,\n" +
+							"  `v` int DEFAULT NULL,\n" +
 							"  PRIMARY KEY (`pk`),\n" +
 							"  CONSTRAINT `check2` CHECK ((`v` < 5)),\n" +
 							"  CONSTRAINT `check12` CHECK (((`pk` + `v`) = 6))\n" +
@@ -3538,7 +4967,7 @@ var CreateCheckConstraintsScripts = []ScriptTest{
 					{
 						"mytable3",
 						"CREATE TABLE `mytable3` (\n  `pk` int NOT NULL,\n" +
-							"  `v` int,\n" +
+							"  `v` int DEFAULT NULL,\n" +
 							"  PRIMARY KEY (`pk`),\n" +
 							"  CONSTRAINT `check3` CHECK (((`pk` > 2) AND (`v` < 5))),\n" +
 							"  CONSTRAINT `check13` CHECK ((`pk` BETWEEN 2 AND 100))\n" +
@@ -3552,7 +4981,7 @@ var CreateCheckConstraintsScripts = []ScriptTest{
 					{
 						"mytable4",
 						"CREATE TABLE `mytable4` (\n  `pk` int NOT NULL,\n" +
-							"  `v` int,\n" +
+							"  `v` int DEFAULT NULL,\n" +
 							"  PRIMARY KEY (`pk`),\n" +
 							"  CONSTRAINT `check4` CHECK ((((`pk` > 2) AND (`v` < 5)) AND (`pk` < 9)))\n" +
 							") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin",
@@ -3565,7 +4994,7 @@ var CreateCheckConstraintsScripts = []ScriptTest{
 					{
 						"mytable5",
 						"CREATE TABLE `mytable5` (\n  `pk` int NOT NULL,\n" +
-							"  `v` int,\n" +
+							"  `v` int DEFAULT NULL,\n" +
 							"  PRIMARY KEY (`pk`),\n" +
 							"  CONSTRAINT `check5` CHECK (((`pk` > 2) OR ((`v` < 5) AND (`pk` < 9))))\n" +
 							") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin",
@@ -3578,7 +5007,7 @@ var CreateCheckConstraintsScripts = []ScriptTest{
 					{
 						"mytable6",
 						"CREATE TABLE `mytable6` (\n  `pk` int NOT NULL,\n" +
-							"  `v` int,\n" +
+							"  `v` int DEFAULT NULL,\n" +
 							"  PRIMARY KEY (`pk`),\n" +
 							"  CONSTRAINT `check6` CHECK ((NOT(`pk`)))\n" +
 							") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin",
@@ -3591,7 +5020,7 @@ var CreateCheckConstraintsScripts = []ScriptTest{
 					{
 						"mytable7",
 						"CREATE TABLE `mytable7` (\n  `pk` int NOT NULL,\n" +
-							"  `v` int,\n" +
+							"  `v` int DEFAULT NULL,\n" +
 							"  PRIMARY KEY (`pk`),\n" +
 							"  CONSTRAINT `check7` CHECK ((NOT((`pk` = `v`))))\n" +
 							") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin",
@@ -3604,7 +5033,7 @@ var CreateCheckConstraintsScripts = []ScriptTest{
 					{
 						"mytable8",
 						"CREATE TABLE `mytable8` (\n  `pk` int NOT NULL,\n" +
-							"  `v` int,\n" +
+							"  `v` int DEFAULT NULL,\n" +
 							"  PRIMARY KEY (`pk`),\n" +
 							"  CONSTRAINT `check8` CHECK ((((`pk` > 2) OR (`v` < 5)) OR (`pk` < 10)))\n" +
 							") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin",
@@ -3617,7 +5046,7 @@ var CreateCheckConstraintsScripts = []ScriptTest{
 					{
 						"mytable9",
 						"CREATE TABLE `mytable9` (\n  `pk` int NOT NULL,\n" +
-							"  `v` int,\n" +
+							"  `v` int DEFAULT NULL,\n" +
 							"  PRIMARY KEY (`pk`),\n" +
 							"  CONSTRAINT `check9` CHECK ((((`pk` + `v`) / 2) >= 1))\n" +
 							") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin",
@@ -3630,7 +5059,7 @@ var CreateCheckConstraintsScripts = []ScriptTest{
 					{
 						"mytable10",
 						"CREATE TABLE `mytable10` (\n  `pk` int NOT NULL,\n" +
-							"  `v` int,\n" +
+							"  `v` int DEFAULT NULL,\n" +
 							"  PRIMARY KEY (`pk`),\n" +
 							"  CONSTRAINT `check10` CHECK ((`v` < 5)) /*!80016 NOT ENFORCED */\n" +
 							") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin",
@@ -4025,6 +5454,74 @@ var PreparedScriptTests = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "explain execute describes the plan for the bound parameters",
+		SetUpScript: []string{
+			"create table t (id int primary key, x int, key (x));",
+			"insert into t values (1, 1), (2, 2), (3, 3), (4, 4), (5, 5);",
+			"prepare s from 'select * from t where x > ? and x < ?';",
+			"set @narrow_lo = 2, @narrow_hi = 4, @wide_lo = 0, @wide_hi = 100, @empty_lo = 5, @empty_hi = 1;",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "explain execute s using @narrow_lo, @narrow_hi",
+				Expected: []sql.Row{
+					{"Filter"},
+					{" ├─ ((t.x > @narrow_lo) AND (t.x < @narrow_hi))"},
+					{" └─ IndexedTableAccess(mydb.t)"},
+					{"     ├─ index: x [t.x]"},
+					{"     ├─ filters: [{(2, 4)}]"},
+					{"     └─ columns: [id x]"},
+				},
+			},
+			{
+				Query: "explain execute s using @wide_lo, @wide_hi",
+				Expected: []sql.Row{
+					{"Filter"},
+					{" ├─ ((t.x > @wide_lo) AND (t.x < @wide_hi))"},
+					{" └─ IndexedTableAccess(mydb.t)"},
+					{"     ├─ index: x [t.x]"},
+					{"     ├─ filters: [{(0, 100)}]"},
+					{"     └─ columns: [id x]"},
+				},
+			},
+			{
+				Query: "explain execute s using @empty_lo, @empty_hi",
+				Expected: []sql.Row{
+					{"Filter"},
+					{" ├─ ((t.x > @empty_lo) AND (t.x < @empty_hi))"},
+					{" └─ IndexedTableAccess(mydb.t)"},
+					{"     ├─ index: x [t.x]"},
+					{"     ├─ filters: [{(∞, ∞)}]"},
+					{"     └─ columns: [id x]"},
+				},
+			},
+			{
+				Query: "explain format=tree execute s using @narrow_lo, @narrow_hi",
+				Expected: []sql.Row{
+					{"-> Filter: ((t.x > @narrow_lo) AND (t.x < @narrow_hi)) (cost=2.01 rows=0.1)"},
+					{"    -> Index lookup on t using x (cost=2.00 rows=1)"},
+				},
+			},
+			{
+				// Explaining the statement doesn't change the plan it executes with other values
+				Query:    "execute s using @narrow_lo, @narrow_hi",
+				Expected: []sql.Row{{3, 3}},
+			},
+			{
+				Query:    "execute s using @wide_lo, @wide_hi",
+				Expected: []sql.Row{{1, 1}, {2, 2}, {3, 3}, {4, 4}, {5, 5}},
+			},
+			{
+				Query:       "explain execute s using @narrow_lo",
+				ExpectedErr: sql.ErrInvalidArgument,
+			},
+			{
+				Query:       "explain execute idontexist",
+				ExpectedErr: sql.ErrUnknownPreparedStatement,
+			},
+		},
+	},
 }
 
 var BrokenScriptTests = []ScriptTest{
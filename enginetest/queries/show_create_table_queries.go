@@ -0,0 +1,237 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queries
+
+// ShowCreateTableTest is a table definition and the output of SHOW CREATE TABLE for the table in MySQL 8.0. Tools
+// that compare schemas across servers, such as skeema and gh-ost, diff this output, so it must match byte for byte.
+type ShowCreateTableTest struct {
+	// Name of the test
+	Name string
+	// SetUpScript creates the tables the table depends on, such as the parents of its foreign keys
+	SetUpScript []string
+	// Create is the statement creating the table
+	Create string
+	// Table is the name of the table
+	Table string
+	// Expected is the output of SHOW CREATE TABLE for the table in MySQL 8.0. Creating the table with it must result
+	// in the same output.
+	Expected string
+}
+
+// ShowCreateTableTests is a corpus of table definitions whose SHOW CREATE TABLE output matches MySQL 8.0. Temporal
+// columns are given a precision of 6, and tables are given a collation, as the defaults of those differ from MySQL's.
+var ShowCreateTableTests = []ShowCreateTableTest{
+	{
+		Name:   "nullable columns have an implicit NULL default",
+		Create: "CREATE TABLE t (id INT PRIMARY KEY, a INT, b BIGINT UNSIGNED, c SMALLINT, d DECIMAL(10,2), e FLOAT, f DOUBLE, g VARCHAR(20), h CHAR(3), i DATE, j TIME(6), k YEAR, l DATETIME(6), m VARBINARY(16), n BINARY(4)) COLLATE utf8mb4_0900_ai_ci",
+		Table:  "t",
+		Expected: "CREATE TABLE `t` (\n" +
+			"  `id` int NOT NULL,\n" +
+			"  `a` int DEFAULT NULL,\n" +
+			"  `b` bigint unsigned DEFAULT NULL,\n" +
+			"  `c` smallint DEFAULT NULL,\n" +
+			"  `d` decimal(10,2) DEFAULT NULL,\n" +
+			"  `e` float DEFAULT NULL,\n" +
+			"  `f` double DEFAULT NULL,\n" +
+			"  `g` varchar(20) DEFAULT NULL,\n" +
+			"  `h` char(3) DEFAULT NULL,\n" +
+			"  `i` date DEFAULT NULL,\n" +
+			"  `j` time(6) DEFAULT NULL,\n" +
+			"  `k` year DEFAULT NULL,\n" +
+			"  `l` datetime(6) DEFAULT NULL,\n" +
+			"  `m` varbinary(16) DEFAULT NULL,\n" +
+			"  `n` binary(4) DEFAULT NULL,\n" +
+			"  PRIMARY KEY (`id`)\n" +
+			") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci",
+	},
+	{
+		Name:   "blob, text and json columns have no implicit default",
+		Create: "CREATE TABLE t (id INT PRIMARY KEY, a TEXT, b BLOB, c JSON, d MEDIUMTEXT NOT NULL, e LONGBLOB) COLLATE utf8mb4_0900_ai_ci",
+		Table:  "t",
+		Expected: "CREATE TABLE `t` (\n" +
+			"  `id` int NOT NULL,\n" +
+			"  `a` text,\n" +
+			"  `b` blob,\n" +
+			"  `c` json,\n" +
+			"  `d` mediumtext NOT NULL,\n" +
+			"  `e` longblob,\n" +
+			"  PRIMARY KEY (`id`)\n" +
+			") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci",
+	},
+	{
+		Name:   "literal defaults are quoted as strings of the column type",
+		Create: "CREATE TABLE t (id INT PRIMARY KEY, a INT NOT NULL DEFAULT 0, b DECIMAL(10,2) DEFAULT 1.5, c DOUBLE DEFAULT 2.25, d VARCHAR(10) NOT NULL DEFAULT '', e ENUM('x','y','z') DEFAULT 'y', f SET('p','q') DEFAULT 'p,q', g BIT(3) DEFAULT b'101', h DATE DEFAULT '2020-01-02', i BIGINT DEFAULT -7) COLLATE utf8mb4_0900_ai_ci",
+		Table:  "t",
+		Expected: "CREATE TABLE `t` (\n" +
+			"  `id` int NOT NULL,\n" +
+			"  `a` int NOT NULL DEFAULT '0',\n" +
+			"  `b` decimal(10,2) DEFAULT '1.50',\n" +
+			"  `c` double DEFAULT '2.25',\n" +
+			"  `d` varchar(10) NOT NULL DEFAULT '',\n" +
+			"  `e` enum('x','y','z') DEFAULT 'y',\n" +
+			"  `f` set('p','q') DEFAULT 'p,q',\n" +
+			"  `g` bit(3) DEFAULT b'101',\n" +
+			"  `h` date DEFAULT '2020-01-02',\n" +
+			"  `i` bigint DEFAULT '-7',\n" +
+			"  PRIMARY KEY (`id`)\n" +
+			") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci",
+	},
+	{
+		Name:   "string defaults and comments are escaped",
+		Create: `CREATE TABLE t (id INT PRIMARY KEY COMMENT 'the ''id''', a VARCHAR(20) DEFAULT 'it''s' COMMENT 'back\\slash') COLLATE utf8mb4_0900_ai_ci`,
+		Table:  "t",
+		Expected: "CREATE TABLE `t` (\n" +
+			"  `id` int NOT NULL COMMENT 'the ''id''',\n" +
+			"  `a` varchar(20) DEFAULT 'it''s' COMMENT 'back\\\\slash',\n" +
+			"  PRIMARY KEY (`id`)\n" +
+			") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci",
+	},
+	{
+		Name:   "current timestamp defaults",
+		Create: "CREATE TABLE t (id INT PRIMARY KEY, a TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6), b TIMESTAMP(6) NULL DEFAULT NULL, c DATETIME(6) DEFAULT NOW(6), d TIMESTAMP(6) NULL DEFAULT CURRENT_TIMESTAMP(6)) COLLATE utf8mb4_0900_ai_ci",
+		Table:  "t",
+		Expected: "CREATE TABLE `t` (\n" +
+			"  `id` int NOT NULL,\n" +
+			"  `a` timestamp(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),\n" +
+			"  `b` timestamp(6) NULL DEFAULT NULL,\n" +
+			"  `c` datetime(6) DEFAULT CURRENT_TIMESTAMP(6),\n" +
+			"  `d` timestamp(6) NULL DEFAULT CURRENT_TIMESTAMP(6),\n" +
+			"  PRIMARY KEY (`id`)\n" +
+			") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci",
+	},
+	{
+		Name:   "expression defaults",
+		Create: "CREATE TABLE t (id INT PRIMARY KEY, a DOUBLE DEFAULT (rand()), b VARCHAR(36) DEFAULT (uuid()), c JSON DEFAULT (json_array())) COLLATE utf8mb4_0900_ai_ci",
+		Table:  "t",
+		Expected: "CREATE TABLE `t` (\n" +
+			"  `id` int NOT NULL,\n" +
+			"  `a` double DEFAULT (rand()),\n" +
+			"  `b` varchar(36) DEFAULT (uuid()),\n" +
+			"  `c` json DEFAULT (json_array()),\n" +
+			"  PRIMARY KEY (`id`)\n" +
+			") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci",
+	},
+	{
+		Name:   "auto increment",
+		Create: "CREATE TABLE t (id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT, a INT, PRIMARY KEY (id)) COLLATE utf8mb4_0900_ai_ci",
+		Table:  "t",
+		Expected: "CREATE TABLE `t` (\n" +
+			"  `id` bigint unsigned NOT NULL AUTO_INCREMENT,\n" +
+			"  `a` int DEFAULT NULL,\n" +
+			"  PRIMARY KEY (`id`)\n" +
+			") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci",
+	},
+	{
+		Name: "keys are listed primary first, then unique keys without nullable columns, then other unique keys, then other keys",
+		Create: "CREATE TABLE t (a INT NOT NULL, b INT NOT NULL, c VARCHAR(20), d VARCHAR(100) NOT NULL, " +
+			"KEY k1 (c), UNIQUE KEY k2 (c), KEY k3 (a, c), UNIQUE KEY k4 (b), PRIMARY KEY (a, b), KEY k5 (d(10)) COMMENT 'prefix', UNIQUE KEY k6 (d(20), a)) COLLATE utf8mb4_0900_ai_ci",
+		Table: "t",
+		Expected: "CREATE TABLE `t` (\n" +
+			"  `a` int NOT NULL,\n" +
+			"  `b` int NOT NULL,\n" +
+			"  `c` varchar(20) DEFAULT NULL,\n" +
+			"  `d` varchar(100) NOT NULL,\n" +
+			"  PRIMARY KEY (`a`,`b`),\n" +
+			"  UNIQUE KEY `k4` (`b`),\n" +
+			"  UNIQUE KEY `k6` (`d`(20),`a`),\n" +
+			"  UNIQUE KEY `k2` (`c`),\n" +
+			"  KEY `k1` (`c`),\n" +
+			"  KEY `k3` (`a`,`c`),\n" +
+			"  KEY `k5` (`d`(10)) COMMENT 'prefix'\n" +
+			") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci",
+	},
+	{
+		Name: "foreign keys and check constraints",
+		SetUpScript: []string{
+			"CREATE TABLE parent (id INT PRIMARY KEY, code VARCHAR(10), UNIQUE KEY code (code)) COLLATE utf8mb4_0900_ai_ci",
+		},
+		Create: "CREATE TABLE child (id INT PRIMARY KEY, parent_id INT, code VARCHAR(10), qty INT NOT NULL DEFAULT 1, " +
+			"KEY parent_id (parent_id), KEY code (code), " +
+			"CONSTRAINT fk_parent FOREIGN KEY (parent_id) REFERENCES parent (id) ON DELETE CASCADE, " +
+			"CONSTRAINT fk_code FOREIGN KEY (code) REFERENCES parent (code) ON DELETE SET NULL ON UPDATE CASCADE, " +
+			"CONSTRAINT qty_positive CHECK (qty > 0), CONSTRAINT qty_small CHECK (qty < 100) NOT ENFORCED) COLLATE utf8mb4_0900_ai_ci",
+		Table: "child",
+		Expected: "CREATE TABLE `child` (\n" +
+			"  `id` int NOT NULL,\n" +
+			"  `parent_id` int DEFAULT NULL,\n" +
+			"  `code` varchar(10) DEFAULT NULL,\n" +
+			"  `qty` int NOT NULL DEFAULT '1',\n" +
+			"  PRIMARY KEY (`id`),\n" +
+			"  KEY `code` (`code`),\n" +
+			"  KEY `parent_id` (`parent_id`),\n" +
+			"  CONSTRAINT `fk_code` FOREIGN KEY (`code`) REFERENCES `parent` (`code`) ON DELETE SET NULL ON UPDATE CASCADE,\n" +
+			"  CONSTRAINT `fk_parent` FOREIGN KEY (`parent_id`) REFERENCES `parent` (`id`) ON DELETE CASCADE,\n" +
+			"  CONSTRAINT `qty_positive` CHECK ((`qty` > 0)),\n" +
+			"  CONSTRAINT `qty_small` CHECK ((`qty` < 100)) /*!80016 NOT ENFORCED */\n" +
+			") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci",
+	},
+	{
+		Name:   "column character sets and collations are given when they differ from the table's",
+		Create: "CREATE TABLE t (id INT PRIMARY KEY, a VARCHAR(10), b VARCHAR(10) COLLATE utf8mb4_bin, c TEXT, d ENUM('a','b') CHARACTER SET latin1 COLLATE latin1_bin) COLLATE utf8mb4_0900_ai_ci",
+		Table:  "t",
+		Expected: "CREATE TABLE `t` (\n" +
+			"  `id` int NOT NULL,\n" +
+			"  `a` varchar(10) DEFAULT NULL,\n" +
+			"  `b` varchar(10) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin DEFAULT NULL,\n" +
+			"  `c` text,\n" +
+			"  `d` enum('a','b') CHARACTER SET latin1 COLLATE latin1_bin DEFAULT NULL,\n" +
+			"  PRIMARY KEY (`id`)\n" +
+			") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci",
+	},
+	{
+		Name:   "the collation of a table is left out when it's the default of its character set",
+		Create: "CREATE TABLE t (id INT PRIMARY KEY, a VARCHAR(10), b VARCHAR(10) CHARACTER SET utf8mb4) CHARACTER SET latin1",
+		Table:  "t",
+		Expected: "CREATE TABLE `t` (\n" +
+			"  `id` int NOT NULL,\n" +
+			"  `a` varchar(10) DEFAULT NULL,\n" +
+			"  `b` varchar(10) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci DEFAULT NULL,\n" +
+			"  PRIMARY KEY (`id`)\n" +
+			") ENGINE=InnoDB DEFAULT CHARSET=latin1",
+	},
+	{
+		Name:   "the DEFAULT CHARSET option is read from the table options only",
+		Create: "CREATE TABLE t (id INT PRIMARY KEY COMMENT 'DEFAULT CHARSET utf16', a VARCHAR(30) DEFAULT 'DEFAULT CHARSET=utf16') DEFAULT CHARSET=latin1 /* DEFAULT CHARSET=utf16 */ COMMENT 'DEFAULT CHARSET utf8mb4'",
+		Table:  "t",
+		Expected: "CREATE TABLE `t` (\n" +
+			"  `id` int NOT NULL COMMENT 'DEFAULT CHARSET utf16',\n" +
+			"  `a` varchar(30) DEFAULT 'DEFAULT CHARSET=utf16',\n" +
+			"  PRIMARY KEY (`id`)\n" +
+			") ENGINE=InnoDB DEFAULT CHARSET=latin1",
+	},
+	{
+		Name:   "spatial columns and keys",
+		Create: "CREATE TABLE t (id INT PRIMARY KEY, g POINT NOT NULL SRID 4326, h GEOMETRY, SPATIAL KEY g (g)) COLLATE utf8mb4_0900_ai_ci",
+		Table:  "t",
+		Expected: "CREATE TABLE `t` (\n" +
+			"  `id` int NOT NULL,\n" +
+			"  `g` point NOT NULL /*!80003 SRID 4326 */,\n" +
+			"  `h` geometry,\n" +
+			"  PRIMARY KEY (`id`),\n" +
+			"  SPATIAL KEY `g` (`g`)\n" +
+			") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci",
+	},
+	{
+		Name:   "identifiers are quoted",
+		Create: "CREATE TABLE `my table` (`select` INT NOT NULL, `we``ird` VARCHAR(5), PRIMARY KEY (`select`), KEY `the key` (`we``ird`)) COLLATE utf8mb4_0900_ai_ci",
+		Table:  "`my table`",
+		Expected: "CREATE TABLE `my table` (\n" +
+			"  `select` int NOT NULL,\n" +
+			"  `we``ird` varchar(5) DEFAULT NULL,\n" +
+			"  PRIMARY KEY (`select`),\n" +
+			"  KEY `the key` (`we``ird`)\n" +
+			") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci",
+	},
+}
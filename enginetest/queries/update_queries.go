@@ -448,6 +448,123 @@ var UpdateTests = []WriteQueryTest{
 	},
 }
 
+var UpdateScripts = []ScriptTest{
+	{
+		Name: "update the first rows with ORDER BY and LIMIT in batches",
+		SetUpScript: []string{
+			"create table jobs (id int primary key, priority int, migrated bool not null default false)",
+			"insert into jobs (id, priority) values (1, 30), (2, 10), (3, 20), (4, 40), (5, 50)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "update jobs set migrated = true where migrated = false order by priority limit 2",
+				Expected: []sql.Row{{newUpdateResult(2, 2)}},
+			},
+			{
+				Query:    "select id, migrated from jobs order by id",
+				Expected: []sql.Row{{1, 0}, {2, 1}, {3, 1}, {4, 0}, {5, 0}},
+			},
+			{
+				Query:    "update jobs set migrated = true where migrated = false order by priority limit 2",
+				Expected: []sql.Row{{newUpdateResult(2, 2)}},
+			},
+			{
+				Query:    "select id, migrated from jobs order by id",
+				Expected: []sql.Row{{1, 1}, {2, 1}, {3, 1}, {4, 1}, {5, 0}},
+			},
+			{
+				Query:    "update jobs set migrated = true where migrated = false order by priority limit 2",
+				Expected: []sql.Row{{newUpdateResult(1, 1)}},
+			},
+			{
+				Query:    "update jobs set migrated = true where migrated = false order by priority limit 2",
+				Expected: []sql.Row{{newUpdateResult(0, 0)}},
+			},
+		},
+	},
+	{
+		Name: "update with ORDER BY DESC and LIMIT",
+		SetUpScript: []string{
+			"create table scores (id int primary key, score int, rank_label varchar(10))",
+			"insert into scores values (1, 70, ''), (2, 90, ''), (3, 80, ''), (4, 90, ''), (5, 60, '')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "update scores set rank_label = 'top' order by score desc, id limit 1",
+				Expected: []sql.Row{{newUpdateResult(1, 1)}},
+			},
+			{
+				Query:    "select id from scores where rank_label = 'top'",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    "update scores set rank_label = 'podium' where rank_label = '' order by score desc, id desc limit 2",
+				Expected: []sql.Row{{newUpdateResult(2, 2)}},
+			},
+			{
+				Query:    "select id, rank_label from scores order by id",
+				Expected: []sql.Row{{1, ""}, {2, "top"}, {3, "podium"}, {4, "podium"}, {5, ""}},
+			},
+			{
+				Query:    "update scores set rank_label = 'none' order by score limit 0",
+				Expected: []sql.Row{{newUpdateResult(0, 0)}},
+			},
+			{
+				Query:    "update scores set rank_label = rank_label order by score limit 2",
+				Expected: []sql.Row{{newUpdateResult(2, 0)}},
+			},
+		},
+	},
+	{
+		Name: "update the ORDER BY column with an index and LIMIT",
+		SetUpScript: []string{
+			"create table t (id int primary key, v int, key (v))",
+			"insert into t values (1, 30), (2, 10), (3, 20), (4, 40), (5, 5)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "update t set v = v + 1000 order by v limit 3",
+				Expected: []sql.Row{{newUpdateResult(3, 3)}},
+			},
+			{
+				Query:    "select id, v from t order by id",
+				Expected: []sql.Row{{1, 30}, {2, 1010}, {3, 1020}, {4, 40}, {5, 1005}},
+			},
+			{
+				Query:    "update t set v = v - 1 where v > 100 order by v desc limit 2",
+				Expected: []sql.Row{{newUpdateResult(2, 2)}},
+			},
+			{
+				Query:    "select id, v from t order by id",
+				Expected: []sql.Row{{1, 30}, {2, 1009}, {3, 1019}, {4, 40}, {5, 1005}},
+			},
+		},
+	},
+	{
+		Name: "update with ORDER BY and LIMIT fires triggers only for updated rows",
+		SetUpScript: []string{
+			"create table t (id int primary key, v int, key (v))",
+			"create table updated (id int primary key, old_v int, new_v int)",
+			"insert into t values (1, 30), (2, 10), (3, 20), (4, 40)",
+			"create trigger t_upd after update on t for each row insert into updated values (new.id, old.v, new.v)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "update t set v = v + 1 where v > 10 order by v desc limit 2",
+				Expected: []sql.Row{{newUpdateResult(2, 2)}},
+			},
+			{
+				Query:    "select id, old_v, new_v from updated order by id",
+				Expected: []sql.Row{{1, 30, 31}, {4, 40, 41}},
+			},
+			{
+				Query:    "select id, v from t order by id",
+				Expected: []sql.Row{{1, 31}, {2, 10}, {3, 20}, {4, 41}},
+			},
+		},
+	},
+}
+
 var SpatialUpdateTests = []WriteQueryTest{
 	{
 		WriteQuery:          "UPDATE point_table SET p = point(123.456,789);",
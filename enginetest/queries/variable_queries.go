@@ -28,7 +28,7 @@ var VariableQueries = []ScriptTest{
 		},
 		Query: "SELECT @@auto_increment_increment, @@sql_select_limit",
 		Expected: []sql.Row{
-			{100, 1},
+			{100, uint64(1)},
 		},
 	},
 	{
@@ -88,7 +88,7 @@ var VariableQueries = []ScriptTest{
 		},
 		Query: "SELECT @@auto_increment_increment, @@sql_select_limit",
 		Expected: []sql.Row{
-			{100, 1},
+			{100, uint64(1)},
 		},
 	},
 	{
@@ -99,7 +99,7 @@ var VariableQueries = []ScriptTest{
 		},
 		Query: "SELECT @@auto_increment_increment, @@sql_select_limit",
 		Expected: []sql.Row{
-			{1, math.MaxInt32},
+			{1, uint64(math.MaxUint64)},
 		},
 	},
 	{
@@ -171,7 +171,7 @@ var VariableQueries = []ScriptTest{
 		},
 		Query: "SELECT @@sql_select_limit",
 		Expected: []sql.Row{
-			{123},
+			{uint64(123)},
 		},
 	},
 	{
@@ -451,6 +451,228 @@ var VariableQueries = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "show variables scope",
+		SetUpScript: []string{
+			"set session div_precision_increment = 9",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "show variables like 'div_precision_increment'",
+				Expected: []sql.Row{{"div_precision_increment", int64(9)}},
+			},
+			{
+				Query:    "show session variables like 'div_precision_increment'",
+				Expected: []sql.Row{{"div_precision_increment", int64(9)}},
+			},
+			{
+				Query:    "show global variables like 'div_precision_increment'",
+				Expected: []sql.Row{{"div_precision_increment", int64(4)}},
+			},
+			{
+				Query:    "show global variables where variable_name = 'div_precision_increment'",
+				Expected: []sql.Row{{"div_precision_increment", int64(4)}},
+			},
+			{
+				Query:    "set global max_connect_errors = 200",
+				Expected: []sql.Row{{}},
+			},
+			{
+				// variables that only exist globally show their global value in sessions
+				Query:    "show session variables like 'max_connect_errors'",
+				Expected: []sql.Row{{"max_connect_errors", uint64(200)}},
+			},
+			{
+				Query:    "set global max_connect_errors = default",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "show global variables like 'last_insert_id'",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "show session variables like 'last_insert_id'",
+				Expected: []sql.Row{{"last_insert_id", int64(0)}},
+			},
+		},
+	},
+	{
+		Name: "show variables filtering",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "show variables like 'GTID_M%'",
+				Expected: []sql.Row{{"gtid_mode", "OFF"}},
+			},
+			{
+				Query:    "show variables where variable_name = 'Gtid_Mode'",
+				Expected: []sql.Row{{"gtid_mode", "OFF"}},
+			},
+			{
+				Query:    "show variables where variable_name like 'gtid\\_m%' and variable_name <> 'autocommit'",
+				Expected: []sql.Row{{"gtid_mode", "OFF"}},
+			},
+			{
+				Query:    "show variables where variable_name = null",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "show variables like 'no_such_variable%'",
+				Expected: []sql.Row{},
+			},
+		},
+	},
+	{
+		Name: "system variables in expressions",
+		SetUpScript: []string{
+			"set @@session.net_read_timeout = 40",
+			"create table t (pk int primary key, a int default (@@net_read_timeout), b int default (@@global.net_read_timeout + 1), c int default (@@session.net_read_timeout * 2))",
+			"insert into t (pk) values (1)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select @@max_connections, @@global.net_read_timeout, @@session.net_read_timeout, @@local.net_read_timeout, @@net_read_timeout",
+				Expected: []sql.Row{{int64(151), int64(30), int64(40), int64(40), int64(40)}},
+			},
+			{
+				Query:    "select @@session.sql_mode = @@sql_mode, @@global.sql_mode = @@global.sql_mode",
+				Expected: []sql.Row{{true, true}},
+			},
+			{
+				Query:    "select * from t",
+				Expected: []sql.Row{{1, 40, 31, 80}},
+			},
+			{
+				Query:    "select pk from t where a = @@session.net_read_timeout and b = @@global.net_read_timeout + 1",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "select pk, @@net_read_timeout, count(*) from t group by pk",
+				Expected: []sql.Row{{1, int64(40), 1}},
+			},
+			{
+				Query:    "select (select @@global.net_read_timeout)",
+				Expected: []sql.Row{{int64(30)}},
+			},
+			{
+				Query:          "select @@session.max_connections",
+				ExpectedErrStr: "Variable 'max_connections' is a GLOBAL variable",
+			},
+			{
+				Query:          "select @@global.last_insert_id",
+				ExpectedErrStr: "Variable 'last_insert_id' is a SESSION variable",
+			},
+			{
+				Query:          "select pk from t where a = @@no_such_variable",
+				ExpectedErrStr: "Unknown system variable 'no_such_variable'",
+			},
+			{
+				Query:          "create table t2 (pk int primary key, a int default (@@global.no_such_variable))",
+				ExpectedErrStr: "Unknown system variable 'no_such_variable'",
+			},
+		},
+	},
+	{
+		Name: "system variable assignments are validated and coerced to the variable type",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:          "set sort_buffer_size = 'abc'",
+				ExpectedErrStr: "Variable 'sort_buffer_size' can't be set to the value of 'abc'",
+			},
+			{
+				Query:          "set sort_buffer_size = 1024",
+				ExpectedErrStr: "Variable 'sort_buffer_size' can't be set to the value of '1024'",
+			},
+			{
+				Query:          "set sort_buffer_size = -5",
+				ExpectedErrStr: "Variable 'sort_buffer_size' can't be set to the value of '-5'",
+			},
+			{
+				Query:          "set global max_connections = 100001",
+				ExpectedErrStr: "Variable 'max_connections' can't be set to the value of '100001'",
+			},
+			{
+				Query:    "set sort_buffer_size = 65536",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "select @@sort_buffer_size",
+				Expected: []sql.Row{{uint64(65536)}},
+			},
+			{
+				Query:          "set sql_mode = 'BOGUS'",
+				ExpectedErrStr: "value BOGUS was not found in the set",
+			},
+			{
+				Query:          "set sql_mode = 'ANSI_QUOTES,BOGUS'",
+				ExpectedErrStr: "value BOGUS was not found in the set",
+			},
+			{
+				Query:    "set sql_mode = 'ansi_quotes,No_Zero_Date'",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "select @@sql_mode",
+				Expected: []sql.Row{{"ANSI_QUOTES,NO_ZERO_DATE"}},
+			},
+			{
+				Query:          "set transaction_isolation = 'BOGUS'",
+				ExpectedErrStr: "Variable 'transaction_isolation' can't be set to the value of 'BOGUS'",
+			},
+			{
+				Query:    "set transaction_isolation = 'read-committed'",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "select @@transaction_isolation",
+				Expected: []sql.Row{{"READ-COMMITTED"}},
+			},
+		},
+	},
+	{
+		Name: "read only system variables",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:          "set version = '1.0'",
+				ExpectedErrStr: "Variable 'version' is a read only variable",
+			},
+			{
+				Query:          "set @@session.version_compile_os = 'os'",
+				ExpectedErrStr: "Variable 'version_compile_os' is a read only variable",
+			},
+			{
+				Query:          "set global version = '1.0'",
+				ExpectedErrStr: "Variable 'version' is a read only variable",
+			},
+			{
+				Query:          "set session gtid_owned = ''",
+				ExpectedErrStr: "Variable 'gtid_owned' is a read only variable",
+			},
+			{
+				Query:          "set global gtid_owned = ''",
+				ExpectedErrStr: "Variable 'gtid_owned' is a read only variable",
+			},
+			{
+				Query:    "select @@version = '1.0', @@global.gtid_owned",
+				Expected: []sql.Row{{false, ""}},
+			},
+			{
+				Query:    "set session net_read_timeout = 40",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "set global net_read_timeout = 50",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "select @@session.net_read_timeout, @@global.net_read_timeout",
+				Expected: []sql.Row{{int64(40), int64(50)}},
+			},
+			{
+				Query:    "set global net_read_timeout = default",
+				Expected: []sql.Row{{}},
+			},
+		},
+	},
 	//TODO: do not override tables with user-var-like names...but why would you do this??
 	//{
 	//	Name: "user var table name no conflict",
@@ -483,10 +705,42 @@ var VariableErrorTests = []QueryErrorTest{
 		Query:       `set @@sql_mode = "NOT_AN_OPTION"`,
 		ExpectedErr: sql.ErrInvalidSetValue,
 	},
+	{
+		Query:       `set @@sql_mode = "ansi_quotes,NOT_AN_OPTION"`,
+		ExpectedErr: sql.ErrInvalidSetValue,
+	},
+	{
+		Query:       `set @@sort_buffer_size = 'abc'`,
+		ExpectedErr: sql.ErrInvalidSystemVariableValue,
+	},
+	{
+		Query:       `set global max_connections = 0`,
+		ExpectedErr: sql.ErrInvalidSystemVariableValue,
+	},
 	{
 		Query:       `set global core_file = true`,
 		ExpectedErr: sql.ErrSystemVariableReadOnly,
 	},
+	{
+		Query:       `select @@session.max_connections`,
+		ExpectedErr: sql.ErrSystemVariableScope,
+	},
+	{
+		Query:       `select @@global.last_insert_id`,
+		ExpectedErr: sql.ErrSystemVariableScope,
+	},
+	{
+		Query:       `set version = '1.0'`,
+		ExpectedErr: sql.ErrSystemVariableReadOnly,
+	},
+	{
+		Query:       `set global version_compile_os = 'os'`,
+		ExpectedErr: sql.ErrSystemVariableReadOnly,
+	},
+	{
+		Query:       `set session proxy_user = 'root'`,
+		ExpectedErr: sql.ErrSystemVariableReadOnly,
+	},
 	{
 		Query:       `set global require_row_format = on`,
 		ExpectedErr: sql.ErrSystemVariableSessionOnly,
@@ -14,7 +14,10 @@
 
 package queries
 
-import "github.com/dolthub/go-mysql-server/sql"
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
 
 var ViewScripts = []ScriptTest{
 	{
@@ -47,4 +50,171 @@ var ViewScripts = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "alter view and create or replace view",
+		SetUpScript: []string{
+			"create table vt (a int primary key, b int, c int)",
+			"insert into vt values (1, 2, 3), (4, 5, 6)",
+			"create view v1 as select a, b from vt",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "create or replace view v1 as select a, c from vt",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "select * from v1 order by a",
+				Expected: []sql.Row{{1, 3}, {4, 6}},
+			},
+			{
+				Query:    "alter view v1 as select b from vt",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "select * from v1 order by b",
+				Expected: []sql.Row{{2}, {5}},
+			},
+			{
+				Query:    "alter view v1 (x, `y y`) as select a, a + b from vt",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "select x, `y y` from v1 order by x",
+				Expected: []sql.Row{{1, 3}, {4, 9}},
+			},
+			{
+				Query:       "select a from v1",
+				ExpectedErr: sql.ErrColumnNotFound,
+			},
+			{
+				Query:       "alter view v2 as select a from vt",
+				ExpectedErr: sql.ErrTableNotFound,
+			},
+			{
+				Query:          "alter view vt as select 1",
+				ExpectedErrStr: "'mydb.vt' is not VIEW",
+			},
+			{
+				Query:          "create or replace view vt as select 1",
+				ExpectedErrStr: "'mydb.vt' is not VIEW",
+			},
+			{
+				Query:       "create view v2 (x) as select a, b from vt",
+				ExpectedErr: sql.ErrColumnCountMismatch,
+			},
+			{
+				Query:    "show full tables like 'v%'",
+				Expected: []sql.Row{{"v1", "VIEW"}, {"vt", "BASE TABLE"}},
+			},
+		},
+	},
+	{
+		Name: "view security, definer and check option",
+		SetUpScript: []string{
+			"create table vt (a int primary key, b int)",
+			"create algorithm = merge definer = root@localhost sql security invoker view v1 as select a, b from vt with cascaded check option",
+			"create view v2 as select a from vt where a > 1 with check option",
+			"create view v3 as select b from vt with local check option",
+			"create view v4 as select * from vt",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "select table_name, view_definition, check_option, security_type, definer from information_schema.views where table_schema = 'mydb' and table_name like 'v%' order by 1",
+				Expected: []sql.Row{
+					{"v1", "select a, b from vt", "CASCADED", "INVOKER", "root@localhost"},
+					{"v2", "select a from vt where a > 1", "CASCADED", "DEFINER", "root@localhost"},
+					{"v3", "select b from vt", "LOCAL", "DEFINER", "root@localhost"},
+					{"v4", "select * from vt", "NONE", "DEFINER", "root@localhost"},
+				},
+			},
+			{
+				Query:    "alter view v3 as select a, b from vt",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "select view_definition, check_option from information_schema.views where table_schema = 'mydb' and table_name = 'v3'",
+				Expected: []sql.Row{{"select a, b from vt", "NONE"}},
+			},
+		},
+	},
+	{
+		Name: "views whose base table is altered",
+		SetUpScript: []string{
+			"create table vt (a int primary key, b int, c int)",
+			"insert into vt values (1, 2, 3)",
+			"create view v1 as select a, c from vt",
+			"create view v2 (x, y, z) as select * from vt",
+			"create view v3 as select * from v1",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "alter table vt drop column c",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:       "select * from v1",
+				ExpectedErr: sql.ErrInvalidView,
+			},
+			{
+				Query:          "select * from v1",
+				ExpectedErrStr: "View 'mydb.v1' references invalid table(s) or column(s) or function(s) or definer/invoker of view lack rights to use them",
+			},
+			{
+				Query:       "select * from v2",
+				ExpectedErr: sql.ErrInvalidView,
+			},
+			{
+				Query:       "select * from v3",
+				ExpectedErr: sql.ErrInvalidView,
+			},
+			{
+				Query:    "show full tables like 'v%'",
+				Expected: []sql.Row{{"v1", "VIEW"}, {"v2", "VIEW"}, {"v3", "VIEW"}, {"vt", "BASE TABLE"}},
+			},
+			{
+				Query:    "alter table vt add column c int",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select * from v1",
+				Expected: []sql.Row{{1, nil}},
+			},
+			{
+				Query:    "select * from v2",
+				Expected: []sql.Row{{1, 2, nil}},
+			},
+			{
+				Query:    "select * from v3",
+				Expected: []sql.Row{{1, nil}},
+			},
+			{
+				Query:    "drop table vt",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:       "select * from v2",
+				ExpectedErr: sql.ErrInvalidView,
+			},
+			{
+				Query:    "create table vt (a int primary key, c int)",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:       "select * from v2",
+				ExpectedErr: sql.ErrInvalidView,
+			},
+			{
+				Query:    "alter view v2 (x, y) as select * from vt",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "select * from v2",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "select * from v1",
+				Expected: []sql.Row{},
+			},
+		},
+	},
 }
@@ -103,6 +103,8 @@ var Comp_index_tablesData = []SetupScript{{
 	`create table pref_index_t1 (i int primary key, v1 text, v2 text, unique index (v1(3),v2(5)));`,
 	`create table pref_index_t3 (v1 varchar(10), v2 varchar(10), unique index (v1(3),v2(5)));`,
 	`create table pref_index_t4 (i int primary key, v1 varchar(10), v2 varchar(10), unique index (v1(3),v2(5)));`,
+	`create table desc_index_t0 (pk bigint primary key, v1 bigint, v2 bigint, index v1_desc (v1 desc, v2 asc));`,
+	`insert into desc_index_t0 values (0,0,3),(1,1,1),(2,1,2),(3,2,0),(4,null,5),(5,3,3);`,
 }}
 
 var DatetimetableData = []SetupScript{{
@@ -111,7 +111,7 @@ var SpatialIndexTests = []SpatialIndexPlanTest{
 				},
 			},
 			{
-				noIdx: true,
+				noIdx: false, // index merge of the primary key and spatial index
 				q:     "select pk, st_aswkt(p) from point_tbl_pk where pk = 0 or st_intersects(p, point(1,1)) order by pk",
 				exp: []sql.Row{
 					{0, "POINT(0 0)"},
@@ -16,8 +16,11 @@ package memory
 
 import (
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/transform"
 	"github.com/dolthub/go-mysql-server/sql/types"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -44,6 +47,7 @@ var _ sql.StoredProcedureDatabase = (*Database)(nil)
 var _ sql.EventDatabase = (*Database)(nil)
 var _ sql.ViewDatabase = (*Database)(nil)
 var _ sql.CollatedDatabase = (*Database)(nil)
+var _ sql.StatisticsDatabase = (*Database)(nil)
 
 // BaseDatabase is an in-memory database that can't store views, only for testing the engine
 type BaseDatabase struct {
@@ -55,6 +59,9 @@ type BaseDatabase struct {
 	events            []sql.EventDefinition
 	primaryKeyIndexes bool
 	collation         sql.CollationID
+	// statistics of the tables of this database, keyed by lower case table name
+	tableStats   map[string]*sql.TableStatistics
+	tableStatsMu *sync.Mutex
 }
 
 var _ MemoryDatabase = (*Database)(nil)
@@ -71,9 +78,11 @@ func NewDatabase(name string) *Database {
 // NewViewlessDatabase creates a new database that doesn't persist views. Used only for testing. Use NewDatabase.
 func NewViewlessDatabase(name string) *BaseDatabase {
 	return &BaseDatabase{
-		name:   name,
-		tables: map[string]sql.Table{},
-		fkColl: newForeignKeyCollection(),
+		name:         name,
+		tables:       map[string]sql.Table{},
+		fkColl:       newForeignKeyCollection(),
+		tableStats:   map[string]*sql.TableStatistics{},
+		tableStatsMu: &sync.Mutex{},
 	}
 }
 
@@ -93,8 +102,44 @@ func (d *BaseDatabase) Tables() map[string]sql.Table {
 }
 
 func (d *BaseDatabase) GetTableInsensitive(ctx *sql.Context, tblName string) (sql.Table, bool, error) {
-	tbl, ok := sql.GetTableInsensitive(tblName, d.tables)
-	return tbl, ok, nil
+	name, ok := d.tableName(tblName)
+	if !ok {
+		return nil, false, nil
+	}
+	return d.tables[name], true, nil
+}
+
+// tableName returns the name the table with the name given is stored under, if there's such a table, comparing the
+// names of tables as set by the lower_case_table_names system variable.
+func (d *BaseDatabase) tableName(name string) (string, bool) {
+	switch sql.LowerCaseTableNames() {
+	case 0:
+		_, ok := d.tables[name]
+		return name, ok
+	case 1:
+		name = strings.ToLower(name)
+		_, ok := d.tables[name]
+		return name, ok
+	default:
+		if _, ok := d.tables[name]; ok {
+			return name, true
+		}
+		for k := range d.tables {
+			if strings.EqualFold(k, name) {
+				return k, true
+			}
+		}
+		return "", false
+	}
+}
+
+// storedTableName returns the name given as the name of a new table is stored, which is in lower case when the
+// lower_case_table_names system variable is 1.
+func storedTableName(name string) string {
+	if sql.LowerCaseTableNames() == 1 {
+		return strings.ToLower(name)
+	}
+	return name
 }
 
 func (d *BaseDatabase) GetTableNames(ctx *sql.Context) ([]string, error) {
@@ -163,17 +208,19 @@ func (db *HistoryDatabase) AddTableAsOf(name string, t sql.Table, asOf interface
 
 // AddTable adds a new table to the database.
 func (d *BaseDatabase) AddTable(name string, t sql.Table) {
-	d.tables[name] = t
+	d.tables[storedTableName(name)] = t
 }
 
 // CreateTable creates a table with the given name and schema
 func (d *BaseDatabase) CreateTable(ctx *sql.Context, name string, schema sql.PrimaryKeySchema, collation sql.CollationID) error {
-	_, ok := d.tables[name]
+	_, ok := d.tableName(name)
 	if ok {
 		return sql.ErrTableAlreadyExists.New(name)
 	}
+	name = storedTableName(name)
 
 	table := NewTableWithCollation(name, schema, d.fkColl, collation)
+	table.createTime = time.Now()
 	if d.primaryKeyIndexes {
 		table.EnablePrimaryKeyIndexes()
 	}
@@ -183,12 +230,14 @@ func (d *BaseDatabase) CreateTable(ctx *sql.Context, name string, schema sql.Pri
 
 // CreateIndexedTable creates a table with the given name and schema
 func (d *BaseDatabase) CreateIndexedTable(ctx *sql.Context, name string, sch sql.PrimaryKeySchema, idxDef sql.IndexDef, collation sql.CollationID) error {
-	_, ok := d.tables[name]
+	_, ok := d.tableName(name)
 	if ok {
 		return sql.ErrTableAlreadyExists.New(name)
 	}
+	name = storedTableName(name)
 
 	table := NewTableWithCollation(name, sch, d.fkColl, collation)
+	table.createTime = time.Now()
 	if d.primaryKeyIndexes {
 		table.EnablePrimaryKeyIndexes()
 	}
@@ -206,26 +255,31 @@ func (d *BaseDatabase) CreateIndexedTable(ctx *sql.Context, name string, sch sql
 
 // DropTable drops the table with the given name
 func (d *BaseDatabase) DropTable(ctx *sql.Context, name string) error {
-	_, ok := d.tables[name]
+	stored, ok := d.tableName(name)
 	if !ok {
 		return sql.ErrTableNotFound.New(name)
 	}
+	name = stored
 
 	delete(d.tables, name)
+	d.moveTableStatistics(name, "")
 	return nil
 }
 
 func (d *BaseDatabase) RenameTable(ctx *sql.Context, oldName, newName string) error {
-	tbl, ok := d.tables[oldName]
+	stored, ok := d.tableName(oldName)
 	if !ok {
 		// Should be impossible (engine already checks this condition)
 		return sql.ErrTableNotFound.New(oldName)
 	}
+	oldName = stored
+	tbl := d.tables[oldName]
 
-	_, ok = d.tables[newName]
-	if ok {
+	// Renaming a table to a name that only differs in case is allowed when names are compared case-insensitively
+	if existing, ok := d.tableName(newName); ok && existing != oldName {
 		return sql.ErrTableAlreadyExists.New(newName)
 	}
+	newName = storedTableName(newName)
 
 	memTbl := tbl.(*Table)
 	memTbl.name = newName
@@ -235,16 +289,56 @@ func (d *BaseDatabase) RenameTable(ctx *sql.Context, oldName, newName string) er
 	for _, index := range memTbl.indexes {
 		memIndex := index.(*Index)
 		for i, expr := range memIndex.Exprs {
-			getField := expr.(*expression.GetField)
-			memIndex.Exprs[i] = expression.NewGetFieldWithTable(i, getField.Type(), newName, getField.Name(), getField.IsNullable())
+			if getField, ok := expr.(*expression.GetField); ok {
+				memIndex.Exprs[i] = expression.NewGetFieldWithTable(i, getField.Type(), newName, getField.Name(), getField.IsNullable())
+				continue
+			}
+			memIndex.Exprs[i], _, _ = transform.Expr(expr, func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+				if getField, ok := e.(*expression.GetField); ok {
+					return expression.NewGetFieldWithTable(getField.Index(), getField.Type(), newName, getField.Name(), getField.IsNullable()), transform.NewTree, nil
+				}
+				return e, transform.SameTree, nil
+			})
 		}
 	}
 	d.tables[newName] = tbl
 	delete(d.tables, oldName)
+	d.moveTableStatistics(oldName, newName)
+
+	return nil
+}
 
+// GetTableStatistics implements sql.StatisticsDatabase
+func (d *BaseDatabase) GetTableStatistics(ctx *sql.Context, table string) (*sql.TableStatistics, bool, error) {
+	d.tableStatsMu.Lock()
+	defer d.tableStatsMu.Unlock()
+	stats, ok := d.tableStats[strings.ToLower(table)]
+	return stats, ok, nil
+}
+
+// SetTableStatistics implements sql.StatisticsDatabase
+func (d *BaseDatabase) SetTableStatistics(ctx *sql.Context, table string, stats *sql.TableStatistics) error {
+	d.tableStatsMu.Lock()
+	defer d.tableStatsMu.Unlock()
+	d.tableStats[strings.ToLower(table)] = stats
 	return nil
 }
 
+// moveTableStatistics moves the statistics of the table named to the new name given, or drops them if the new name is
+// empty.
+func (d *BaseDatabase) moveTableStatistics(oldName, newName string) {
+	d.tableStatsMu.Lock()
+	defer d.tableStatsMu.Unlock()
+	stats, ok := d.tableStats[strings.ToLower(oldName)]
+	if !ok {
+		return
+	}
+	delete(d.tableStats, strings.ToLower(oldName))
+	if newName != "" {
+		d.tableStats[strings.ToLower(newName)] = stats
+	}
+}
+
 func (d *BaseDatabase) GetTriggers(ctx *sql.Context) ([]sql.TriggerDefinition, error) {
 	var triggers []sql.TriggerDefinition
 	for _, def := range d.triggers {
@@ -37,11 +37,14 @@ type Index struct {
 	Spatial    bool
 	CommentStr string
 	PrefixLens []uint16
+	// Descending holds whether each expression of the index is sorted in descending order. Nil if none are.
+	Descending []bool
 }
 
 var _ sql.Index = (*Index)(nil)
 var _ sql.FilteredIndex = (*Index)(nil)
 var _ sql.OrderedIndex = (*Index)(nil)
+var _ sql.ColumnOrderedIndex = (*Index)(nil)
 
 func (idx *Index) Database() string                    { return idx.DB }
 func (idx *Index) Driver() string                      { return idx.DriverName }
@@ -49,6 +52,16 @@ func (idx *Index) MemTable() *Table                    { return idx.Tbl }
 func (idx *Index) ColumnExpressions() []sql.Expression { return idx.Exprs }
 func (idx *Index) IsGenerated() bool                   { return false }
 
+// isFunctional returns whether any key part of this index is an expression rather than a column.
+func (idx *Index) isFunctional() bool {
+	for _, e := range idx.Exprs {
+		if _, ok := e.(*expression.GetField); !ok {
+			return true
+		}
+	}
+	return false
+}
+
 func (idx *Index) Expressions() []string {
 	var exprs []string
 	for _, e := range idx.Exprs {
@@ -88,9 +101,80 @@ func (idx *Index) rangeFilterExpr(ranges ...sql.Range) (sql.Expression, error) {
 	if idx.CommentStr == CommentPreventingIndexBuilding {
 		return nil, nil
 	}
+	if idx.hasPrefixLengths() {
+		return expression.NewRangeFilterExpr(idx.prefixExpressions(), idx.prefixRanges(ranges))
+	}
 	return expression.NewRangeFilterExpr(idx.Exprs, ranges)
 }
 
+// entry returns the entry of this index for the row given, which has the value of each of its expressions.
+func (idx *Index) entry(ctx *sql.Context, row sql.Row) (sql.Row, error) {
+	entry := make(sql.Row, len(idx.Exprs))
+	for i, e := range idx.Exprs {
+		v, err := e.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		entry[i] = v
+	}
+	return entry, nil
+}
+
+// hasPrefixLengths returns whether any column of this index is indexed by a prefix of its values.
+func (idx *Index) hasPrefixLengths() bool {
+	for _, l := range idx.PrefixLens {
+		if l > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// prefixExpressions returns the expressions of this index, with the columns that have a prefix length truncated to
+// that prefix. These are the keys the index stores.
+func (idx *Index) prefixExpressions() []sql.Expression {
+	exprs := make([]sql.Expression, len(idx.Exprs))
+	for i, e := range idx.Exprs {
+		exprs[i] = e
+		if i < len(idx.PrefixLens) && idx.PrefixLens[i] > 0 {
+			exprs[i] = &prefixExpression{UnaryExpression: expression.UnaryExpression{Child: e}, length: idx.PrefixLens[i]}
+		}
+	}
+	return exprs
+}
+
+// prefixRanges returns the ranges given converted to ranges over the keys of this index, as returned by
+// prefixExpressions. The bounds of columns with a prefix length are truncated to the prefix and made inclusive, so the
+// resulting ranges may match more rows than the ranges given. Filters on those columns must be evaluated against the
+// full column values, which is why HandledFilters doesn't handle any filters for indexes with prefix lengths.
+func (idx *Index) prefixRanges(ranges []sql.Range) []sql.Range {
+	newRanges := make([]sql.Range, len(ranges))
+	for i, rang := range ranges {
+		newRange := make(sql.Range, len(rang))
+		for j, rce := range rang {
+			newRange[j] = rce
+			if j >= len(idx.PrefixLens) || idx.PrefixLens[j] == 0 {
+				continue
+			}
+			prefixLength := idx.PrefixLens[j]
+			switch cut := rce.LowerBound.(type) {
+			case sql.Below:
+				newRange[j].LowerBound = sql.Below{Key: truncateToPrefix(cut.Key, prefixLength)}
+			case sql.Above:
+				newRange[j].LowerBound = sql.Below{Key: truncateToPrefix(cut.Key, prefixLength)}
+			}
+			switch cut := rce.UpperBound.(type) {
+			case sql.Below:
+				newRange[j].UpperBound = sql.Above{Key: truncateToPrefix(cut.Key, prefixLength)}
+			case sql.Above:
+				newRange[j].UpperBound = sql.Above{Key: truncateToPrefix(cut.Key, prefixLength)}
+			}
+		}
+		newRanges[i] = newRange
+	}
+	return newRanges
+}
+
 // ColumnExpressionTypes implements the interface sql.Index.
 func (idx *Index) ColumnExpressionTypes() []sql.ColumnExpressionType {
 	cets := make([]sql.ColumnExpressionType, len(idx.Exprs))
@@ -123,7 +207,7 @@ func (idx *Index) Table() string { return idx.TableName }
 
 func (idx *Index) HandledFilters(filters []sql.Expression) []sql.Expression {
 	var handled []sql.Expression
-	if idx.Spatial {
+	if idx.Spatial || idx.hasPrefixLengths() {
 		return handled
 	}
 	for _, expr := range filters {
@@ -144,6 +228,54 @@ func (idx *Index) validateIndexType(valType sql.Type, rangeType sql.Type) sql.Ty
 	return valType
 }
 
+// truncateToPrefix returns the prefix of the value given used as the key of an index column with the prefix length
+// given. Strings are truncated to a number of characters, and byte slices to a number of bytes. Any other value is
+// returned unchanged.
+func truncateToPrefix(v interface{}, prefixLength uint16) interface{} {
+	switch v := v.(type) {
+	case string:
+		if runes := []rune(v); len(runes) > int(prefixLength) {
+			return string(runes[:prefixLength])
+		}
+	case []byte:
+		if len(v) > int(prefixLength) {
+			return v[:prefixLength]
+		}
+	}
+	return v
+}
+
+// prefixExpression is the key of an index column with a prefix length: the prefix of the column's value.
+type prefixExpression struct {
+	expression.UnaryExpression
+	length uint16
+}
+
+var _ sql.Expression = (*prefixExpression)(nil)
+
+func (p *prefixExpression) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	v, err := p.Child.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	return truncateToPrefix(v, p.length), nil
+}
+
+func (p *prefixExpression) Type() sql.Type {
+	return p.Child.Type()
+}
+
+func (p *prefixExpression) String() string {
+	return fmt.Sprintf("%s(%d)", p.Child, p.length)
+}
+
+func (p *prefixExpression) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(p, len(children), 1)
+	}
+	return &prefixExpression{UnaryExpression: expression.UnaryExpression{Child: children[0]}, length: p.length}, nil
+}
+
 // ExpressionsIndex is an index made out of one or more expressions (usually field expressions), linked to a Table.
 type ExpressionsIndex interface {
 	sql.Index
@@ -191,3 +323,29 @@ func getType(val interface{}) (interface{}, sql.Type) {
 func (idx *Index) Order() sql.IndexOrder {
 	return sql.IndexOrderAsc
 }
+
+// ColumnOrders implements sql.ColumnOrderedIndex
+func (idx *Index) ColumnOrders() []sql.IndexOrder {
+	orders := make([]sql.IndexOrder, len(idx.Exprs))
+	for i := range orders {
+		orders[i] = sql.IndexOrderAsc
+		if i < len(idx.Descending) && idx.Descending[i] {
+			orders[i] = sql.IndexOrderDesc
+		}
+	}
+	return orders
+}
+
+// sortFields returns the sort fields that order rows in the order of this index, or in the reverse of it.
+func (idx *Index) sortFields(reverse bool) sql.SortFields {
+	sf := make(sql.SortFields, len(idx.Exprs))
+	for i, e := range idx.Exprs {
+		descending := i < len(idx.Descending) && idx.Descending[i]
+		order := sql.Ascending
+		if descending != reverse {
+			order = sql.Descending
+		}
+		sf[i] = sql.SortField{Column: e, Order: order}
+	}
+	return sf
+}
@@ -35,6 +35,12 @@ func NewInMemoryPersistedSessionWithValidationCallback(sess sql.Session, validat
 	return &InMemoryPersistedSession{Session: sess, validateCallback: validateCb}
 }
 
+// LoadPersistedGlobals assigns the persisted values given to the global system variables, like a server does with its
+// persisted globals on startup. Sessions created afterwards begin with these values.
+func LoadPersistedGlobals(persistedGlobals GlobalsMap) error {
+	return sql.SystemVariables.AssignValues(persistedGlobals)
+}
+
 // PersistGlobal implements sql.PersistableSession
 func (s *InMemoryPersistedSession) PersistGlobal(sysVarName string, value interface{}) error {
 	sysVar, _, ok := sql.SystemVariables.GetGlobal(sysVarName)
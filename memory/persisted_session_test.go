@@ -23,7 +23,7 @@ import (
 	"gopkg.in/src-d/go-errors.v1"
 
 	"github.com/dolthub/go-mysql-server/sql"
-	_ "github.com/dolthub/go-mysql-server/sql/variables"
+	"github.com/dolthub/go-mysql-server/sql/variables"
 )
 
 func newPersistedSqlContext() *sql.Context {
@@ -92,3 +92,28 @@ func TestRemoveAllGlobals(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, 0, len(sess.persistedGlobals))
 }
+
+func TestLoadPersistedGlobals(t *testing.T) {
+	defer variables.InitSystemVariables()
+
+	sqlCtx := newPersistedSqlContext()
+	sess := sqlCtx.Session.(*InMemoryPersistedSession)
+	err := sess.PersistGlobal("max_connections", int64(555))
+	require.NoError(t, err)
+
+	variables.InitSystemVariables()
+	err = LoadPersistedGlobals(sess.persistedGlobals)
+	require.NoError(t, err)
+
+	_, global, ok := sql.SystemVariables.GetGlobal("max_connections")
+	require.True(t, ok)
+	assert.Equal(t, int64(555), global)
+
+	freshSess := NewInMemoryPersistedSession(sql.NewBaseSession(), sess.persistedGlobals)
+	res, err := freshSess.GetSessionVariable(sqlCtx, "max_connections")
+	require.NoError(t, err)
+	assert.Equal(t, int64(555), res)
+	res, err = freshSess.GetSessionVariable(sqlCtx, "auto_increment_increment")
+	require.NoError(t, err)
+	assert.Equal(t, int64(123), res)
+}
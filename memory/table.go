@@ -68,6 +68,17 @@ type Table struct {
 	autoColIdx int
 
 	tableStats *sql.TableStatistics
+
+	// the time the table was created with CREATE TABLE, zero for tables created otherwise
+	createTime time.Time
+	// the time of the last change to the table's data, zero if it was never changed
+	updateTime time.Time
+	// the number of rows inserted, updated or deleted since the table was created
+	rowsModified uint64
+	// the same rows, counted by the kind of change
+	rowCounts sql.TableRowCounts
+	// the STATS_AUTO_RECALC table option
+	statsAutoRecalc sql.StatsAutoRecalc
 }
 
 var _ sql.Table = (*Table)(nil)
@@ -86,6 +97,11 @@ var _ sql.CheckAlterableTable = (*Table)(nil)
 var _ sql.CheckTable = (*Table)(nil)
 var _ sql.AutoIncrementTable = (*Table)(nil)
 var _ sql.StatisticsTable = (*Table)(nil)
+var _ sql.TableSizeStatisticsTable = (*Table)(nil)
+var _ sql.CreateTimeTable = (*Table)(nil)
+var _ sql.ModificationCountingTable = (*Table)(nil)
+var _ sql.RowCountingTable = (*Table)(nil)
+var _ sql.StatsAutoRecalcTable = (*Table)(nil)
 var _ sql.ProjectedTable = (*Table)(nil)
 var _ sql.PrimaryKeyAlterableTable = (*Table)(nil)
 var _ sql.PrimaryKeyTable = (*Table)(nil)
@@ -182,32 +198,39 @@ func (t *Table) Partitions(ctx *sql.Context) (sql.PartitionIter, error) {
 	return &partitionIter{keys: keys}, nil
 }
 
-// rangePartitionIter returns a partition that has range and table data access
+// rangePartitionIter returns a single partition that has range and table data access over every partition of the
+// table, so that the rows of a lookup can be returned in index order
 type rangePartitionIter struct {
-	child  *partitionIter
-	ranges sql.Expression
+	keys    [][]byte
+	ranges  sql.Expression
+	reverse bool
+	done    bool
 }
 
 var _ sql.PartitionIter = (*rangePartitionIter)(nil)
 
-func (i rangePartitionIter) Close(ctx *sql.Context) error {
-	return i.child.Close(ctx)
+func (i *rangePartitionIter) Close(ctx *sql.Context) error {
+	return nil
 }
 
-func (i rangePartitionIter) Next(ctx *sql.Context) (sql.Partition, error) {
-	part, err := i.child.Next(ctx)
-	if err != nil {
-		return nil, err
+func (i *rangePartitionIter) Next(ctx *sql.Context) (sql.Partition, error) {
+	if i.done {
+		return nil, io.EOF
 	}
+	i.done = true
 	return &rangePartition{
-		Partition: part.(*Partition),
+		Partition: &Partition{key: []byte("range")},
+		keys:      i.keys,
 		rang:      i.ranges,
+		reverse:   i.reverse,
 	}, nil
 }
 
 type rangePartition struct {
 	*Partition
-	rang sql.Expression
+	keys    [][]byte
+	rang    sql.Expression
+	reverse bool
 }
 
 // spatialRangePartitionIter returns a partition that has range and table data access
@@ -251,10 +274,19 @@ func (t *Table) PartitionCount(ctx *sql.Context) (int64, error) {
 
 // PartitionRows implements the sql.PartitionRows interface.
 func (t *Table) PartitionRows(ctx *sql.Context, partition sql.Partition) (sql.RowIter, error) {
-	filters := t.filters
 	if r, ok := partition.(*rangePartition); ok {
 		// index lookup is currently a single filter applied to a full table scan
-		filters = append(t.filters, r.rang)
+		var rows []sql.Row
+		for _, k := range r.keys {
+			rows = append(rows, t.partitions[string(k)]...)
+		}
+		filters := make([]sql.Expression, len(t.filters), len(t.filters)+1)
+		copy(filters, t.filters)
+		return &tableIter{
+			rows:    rows,
+			columns: t.columns,
+			filters: append(filters, r.rang),
+		}, nil
 	}
 
 	rows, ok := t.partitions[string(partition.Key())]
@@ -281,7 +313,7 @@ func (t *Table) PartitionRows(ctx *sql.Context, partition sql.Partition) (sql.Ro
 	return &tableIter{
 		rows:    rowsCopy,
 		columns: t.columns,
-		filters: filters,
+		filters: t.filters,
 	}, nil
 }
 
@@ -294,41 +326,98 @@ func (t *Table) numRows(ctx *sql.Context) (uint64, error) {
 	return count, nil
 }
 
+// DataLength implements the sql.StatisticsTable interface. The length is estimated from the sizes of the values stored
+// in the table.
 func (t *Table) DataLength(ctx *sql.Context) (uint64, error) {
-	var numBytesPerRow uint64
-	for _, col := range t.schema.Schema {
-		switch n := col.Type.(type) {
-		case sql.NumberType:
-			numBytesPerRow += 8
-		case sql.StringType:
-			numBytesPerRow += uint64(n.MaxByteLength())
-		case types.BitType:
-			numBytesPerRow += 1
-		case sql.DatetimeType:
-			numBytesPerRow += 8
-		case sql.DecimalType:
-			numBytesPerRow += uint64(n.MaximumScale())
-		case sql.EnumType:
-			numBytesPerRow += 2
-		case types.JsonType:
-			numBytesPerRow += 20
-		case sql.NullType:
-			numBytesPerRow += 1
-		case types.TimeType:
-			numBytesPerRow += 16
-		case sql.YearType:
-			numBytesPerRow += 8
-		default:
-			numBytesPerRow += 0
-		}
-	}
-
-	numRows, err := t.numRows(ctx)
-	if err != nil {
-		return 0, err
+	var length uint64
+	for _, rows := range t.partitions {
+		for _, row := range rows {
+			for _, v := range row {
+				length += estimateValueSize(v)
+			}
+		}
 	}
+	return length, nil
+}
 
-	return numBytesPerRow * numRows, nil
+// IndexLength implements the sql.TableSizeStatisticsTable interface. The length is estimated from the sizes of the keys
+// stored for each row by the secondary indexes of the table.
+func (t *Table) IndexLength(ctx *sql.Context) (uint64, error) {
+	var length uint64
+	for _, idx := range t.indexes {
+		memIdx, ok := idx.(*Index)
+		if !ok {
+			continue
+		}
+		exprs := memIdx.prefixExpressions()
+		for _, rows := range t.partitions {
+			for _, row := range rows {
+				for _, e := range exprs {
+					v, err := e.Eval(ctx, row)
+					if err != nil {
+						return 0, err
+					}
+					length += estimateValueSize(v)
+				}
+			}
+		}
+	}
+	return length, nil
+}
+
+// UpdateTime implements the sql.TableSizeStatisticsTable interface.
+func (t *Table) UpdateTime(ctx *sql.Context) (time.Time, bool, error) {
+	return t.updateTime, !t.updateTime.IsZero(), nil
+}
+
+// CreateTime implements the sql.CreateTimeTable interface.
+func (t *Table) CreateTime(ctx *sql.Context) (time.Time, bool, error) {
+	return t.createTime, !t.createTime.IsZero(), nil
+}
+
+// RowsModified implements the sql.ModificationCountingTable interface.
+func (t *Table) RowsModified(ctx *sql.Context) (uint64, error) {
+	return t.rowsModified, nil
+}
+
+// RowCounts implements the sql.RowCountingTable interface.
+func (t *Table) RowCounts(ctx *sql.Context) (sql.TableRowCounts, error) {
+	return t.rowCounts, nil
+}
+
+// StatsAutoRecalc implements the sql.StatsAutoRecalcTable interface.
+func (t *Table) StatsAutoRecalc() sql.StatsAutoRecalc {
+	return t.statsAutoRecalc
+}
+
+// SetStatsAutoRecalc implements the sql.StatsAutoRecalcTable interface.
+func (t *Table) SetStatsAutoRecalc(ctx *sql.Context, recalc sql.StatsAutoRecalc) error {
+	t.statsAutoRecalc = recalc
+	return nil
+}
+
+// estimateValueSize returns an estimate of the number of bytes needed to store the value given.
+func estimateValueSize(v interface{}) uint64 {
+	switch v := v.(type) {
+	case nil:
+		return 0
+	case bool, int8, uint8:
+		return 1
+	case int16, uint16:
+		return 2
+	case int32, uint32, float32:
+		return 4
+	case int, uint, int64, uint64, float64, time.Time:
+		return 8
+	case string:
+		return uint64(len(v))
+	case []byte:
+		return uint64(len(v))
+	case fmt.Stringer:
+		return uint64(len(v.String()))
+	default:
+		return 8
+	}
 }
 
 // AnalyzeTable implements the sql.StatisticsTable interface.
@@ -386,20 +475,73 @@ func (p *partitionIter) Close(*sql.Context) error { return nil }
 type tableIter struct {
 	columns []int
 	filters []sql.Expression
+	// index and indexCond are the index of an index scan and the condition pushed down to it, which is evaluated on
+	// the index entry of each row before its other filters
+	index     *Index
+	indexCond sql.Expression
 
 	rows        []sql.Row
 	indexValues sql.IndexValueIter
 	pos         int
+	// buf is the row projected rows are returned in once the iterator is opted into reusing its rows.
+	buf   *sql.RowBuffer
+	batch []sql.Row
+	// eof is set once a batch has read the last row, so that the rows aren't asked for again.
+	eof bool
 }
 
-var _ sql.RowIter = (*tableIter)(nil)
+var _ sql.RowReuser = (*tableIter)(nil)
+var _ sql.BatchRowIter = (*tableIter)(nil)
 
 func (i *tableIter) Next(ctx *sql.Context) (sql.Row, error) {
+	return i.next(ctx, i.buf)
+}
+
+// NextBatch implements the sql.BatchRowIter interface. Projected rows are never reused in batches.
+func (i *tableIter) NextBatch(ctx *sql.Context) ([]sql.Row, error) {
+	if i.eof {
+		return nil, io.EOF
+	}
+
+	i.batch = i.batch[:0]
+	for len(i.batch) < sql.RowBatchSize {
+		row, err := i.next(ctx, nil)
+		if err == io.EOF {
+			i.eof = true
+			if len(i.batch) > 0 {
+				break
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		i.batch = append(i.batch, row)
+	}
+	return i.batch, nil
+}
+
+// next returns the next row matching the iterator's filters, projected into the buffer given if it's not nil.
+func (i *tableIter) next(ctx *sql.Context, buf *sql.RowBuffer) (sql.Row, error) {
 	row, err := i.getRow(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	if i.indexCond != nil {
+		entry, err := i.index.entry(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		result, err := i.indexCond.Eval(ctx, entry)
+		if err != nil {
+			return nil, err
+		}
+		result, _ = types.ConvertToBool(result)
+		if result != true {
+			return i.next(ctx, buf)
+		}
+	}
+
 	for _, f := range i.filters {
 		result, err := f.Eval(ctx, row)
 		if err != nil {
@@ -407,12 +549,17 @@ func (i *tableIter) Next(ctx *sql.Context) (sql.Row, error) {
 		}
 		result, _ = types.ConvertToBool(result)
 		if result != true {
-			return i.Next(ctx)
+			return i.next(ctx, buf)
 		}
 	}
 
 	if i.columns != nil {
-		resultRow := make(sql.Row, len(i.columns))
+		var resultRow sql.Row
+		if buf != nil {
+			resultRow = buf.Get(len(i.columns))
+		} else {
+			resultRow = make(sql.Row, len(i.columns))
+		}
 		for i, j := range i.columns {
 			resultRow[i] = row[j]
 		}
@@ -431,7 +578,18 @@ func (i *tableIter) colIsProjected(idx int) bool {
 	return false
 }
 
+// ReuseRows implements the sql.RowReuser interface. Only projected rows are reused, the table's own rows are
+// returned as they are stored.
+func (i *tableIter) ReuseRows() {
+	if i.buf == nil {
+		i.buf = &sql.RowBuffer{}
+	}
+}
+
 func (i *tableIter) Close(ctx *sql.Context) error {
+	if i.buf != nil {
+		i.buf.Release()
+	}
 	if i.indexValues == nil {
 		return nil
 	}
@@ -590,12 +748,25 @@ func (t *Table) AutoIncrementSetter(*sql.Context) sql.AutoIncrementSetter {
 func (t *Table) getTableEditor() *tableEditor {
 	var uniqIdxCols [][]int
 	var prefixLengths [][]uint16
-	for _, idx := range t.indexes {
+	var uniqIdxExprs [][]sql.Expression
+	// Unique keys are checked in the same order every time, so that a row conflicting on more than one of them always
+	// conflicts with the same existing row first
+	idxNames := make([]string, 0, len(t.indexes))
+	for name := range t.indexes {
+		idxNames = append(idxNames, name)
+	}
+	sort.Strings(idxNames)
+	for _, name := range idxNames {
+		idx := t.indexes[name]
 		if !idx.IsUnique() {
 			continue
 		}
 		var colNames []string
 		expressions := idx.(*Index).Exprs
+		if idx.(*Index).isFunctional() {
+			uniqIdxExprs = append(uniqIdxExprs, expressions)
+			continue
+		}
 		for _, exp := range expressions {
 			colNames = append(colNames, exp.(*expression.GetField).Name())
 		}
@@ -619,6 +790,7 @@ func (t *Table) getTableEditor() *tableEditor {
 		initialInsert:     0,
 		uniqueIdxCols:     uniqIdxCols,
 		prefixLengths:     prefixLengths,
+		uniqueIdxExprs:    uniqIdxExprs,
 	}
 }
 
@@ -628,9 +800,190 @@ func (t *Table) Truncate(ctx *sql.Context) (int, error) {
 		count += len(t.partitions[key])
 		t.partitions[key] = nil
 	}
+	t.updateTime = time.Now()
+	t.rowsModified += uint64(count)
+	t.rowCounts.Deleted += uint64(count)
 	return count, nil
 }
 
+// Verify implements the sql.VerifiableTable interface. Memory tables keep no index structures apart from their rows, so
+// this verifies what index lookups on the rows depend on: that every partition is listed, that every row fits the
+// schema, that every index refers to the columns it was created on, and that no two rows have the same key in the
+// primary key or in a unique index.
+func (t *Table) Verify(ctx *sql.Context) ([]string, error) {
+	var problems []string
+
+	listed := make(map[string]bool, len(t.partitionKeys))
+	for _, key := range t.partitionKeys {
+		if _, ok := t.partitions[string(key)]; !ok {
+			problems = append(problems, fmt.Sprintf("Partition '%s' is listed but has no rows", key))
+		}
+		listed[string(key)] = true
+	}
+	var unlisted []string
+	for key := range t.partitions {
+		if !listed[key] {
+			unlisted = append(unlisted, key)
+		}
+	}
+	sort.Strings(unlisted)
+	for _, key := range unlisted {
+		problems = append(problems, fmt.Sprintf("Partition '%s' isn't listed, so its rows can't be read", key))
+	}
+
+	sch := t.schema.Schema
+	var rows []sql.Row
+	for _, key := range t.partitionKeys {
+		for _, row := range t.partitions[string(key)] {
+			rowNum := len(rows) + 1
+			rows = append(rows, row)
+			if len(row) != len(sch) {
+				problems = append(problems, fmt.Sprintf("Row %d has %d values, but the table has %d columns", rowNum, len(row), len(sch)))
+				continue
+			}
+			for i, col := range sch {
+				if row[i] == nil && !col.Nullable {
+					problems = append(problems, fmt.Sprintf("Row %d has NULL in NOT NULL column '%s'", rowNum, col.Name))
+				}
+			}
+		}
+	}
+	if len(problems) > 0 {
+		// The keys of malformed rows can't be evaluated
+		return problems, nil
+	}
+
+	if len(t.schema.PkOrdinals) > 0 {
+		pkExprs := make([]sql.Expression, len(t.schema.PkOrdinals))
+		for i, ord := range t.schema.PkOrdinals {
+			pkExprs[i] = expression.NewGetFieldWithTable(ord, sch[ord].Type, t.name, sch[ord].Name, sch[ord].Nullable)
+		}
+		dups, err := duplicateKeys(ctx, rows, pkExprs, nil, true)
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range dups {
+			problems = append(problems, fmt.Sprintf("Duplicate entry '%s' for key 'PRIMARY'", key))
+		}
+	}
+
+	names := make([]string, 0, len(t.indexes))
+	for name := range t.indexes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		idx, ok := t.indexes[name].(*Index)
+		if !ok {
+			continue
+		}
+		valid := true
+		for _, expr := range idx.Exprs {
+			sql.Inspect(expr, func(e sql.Expression) bool {
+				gf, ok := e.(*expression.GetField)
+				if !ok {
+					return true
+				}
+				if gf.Index() < 0 || gf.Index() >= len(sch) || !strings.EqualFold(sch[gf.Index()].Name, gf.Name()) {
+					problems = append(problems, fmt.Sprintf("Index '%s' refers to column '%s' at position %d, which doesn't hold it", idx.ID(), gf.Name(), gf.Index()+1))
+					valid = false
+				}
+				return true
+			})
+		}
+		if !valid || !idx.Unique || idx.Spatial {
+			continue
+		}
+		dups, err := duplicateKeys(ctx, rows, idx.Exprs, idx.PrefixLens, false)
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range dups {
+			problems = append(problems, fmt.Sprintf("Duplicate entry '%s' for key '%s'", key, idx.ID()))
+		}
+	}
+
+	if t.autoColIdx >= 0 && t.autoColIdx < len(sch) {
+		autoCol := sch[t.autoColIdx]
+		for _, row := range rows {
+			if row[t.autoColIdx] == nil {
+				continue
+			}
+			cmp, err := autoCol.Type.Compare(row[t.autoColIdx], t.autoIncVal)
+			if err != nil {
+				return nil, err
+			}
+			if cmp >= 0 {
+				problems = append(problems, fmt.Sprintf("AUTO_INCREMENT value %d isn't greater than value %v of column '%s'", t.autoIncVal, row[t.autoColIdx], autoCol.Name))
+				break
+			}
+		}
+	}
+
+	return problems, nil
+}
+
+// duplicateKeys returns the keys that more than one of the rows given have for the index expressions given, formatted
+// as in duplicate entry errors. Keys with NULLs are only compared when |nullsEqual| is set, as they're never duplicates
+// in unique indexes.
+func duplicateKeys(ctx *sql.Context, rows []sql.Row, exprs []sql.Expression, prefixLengths []uint16, nullsEqual bool) ([]string, error) {
+	keys := make([]sql.Row, 0, len(rows))
+	for _, row := range rows {
+		key, err := evalIndexKey(ctx, exprs, row)
+		if err != nil {
+			return nil, err
+		}
+		for i := range key {
+			if i < len(prefixLengths) && prefixLengths[i] > 0 {
+				key[i] = truncateToPrefix(key[i], prefixLengths[i])
+			}
+		}
+		if !nullsEqual && hasNulls(key) {
+			continue
+		}
+		keys = append(keys, key)
+	}
+
+	compare := func(a, b sql.Row) (int, error) {
+		for i, e := range exprs {
+			cmp, err := e.Type().Compare(a[i], b[i])
+			if err != nil || cmp != 0 {
+				return cmp, err
+			}
+		}
+		return 0, nil
+	}
+	var sortErr error
+	sort.SliceStable(keys, func(i, j int) bool {
+		cmp, err := compare(keys[i], keys[j])
+		if err != nil && sortErr == nil {
+			sortErr = err
+		}
+		return cmp < 0
+	})
+	if sortErr != nil {
+		return nil, sortErr
+	}
+
+	var dups []string
+	inRun := false
+	for i := 1; i < len(keys); i++ {
+		cmp, err := compare(keys[i-1], keys[i])
+		if err != nil {
+			return nil, err
+		}
+		if cmp == 0 && !inRun {
+			idxs := make([]int, len(keys[i]))
+			for j := range idxs {
+				idxs[j] = j
+			}
+			dups = append(dups, formatRow(keys[i], idxs))
+		}
+		inRun = cmp == 0
+	}
+	return dups, nil
+}
+
 // Convenience method to avoid having to create an inserter in test setup
 func (t *Table) Insert(ctx *sql.Context, row sql.Row) error {
 	inserter := t.Inserter(ctx)
@@ -744,7 +1097,7 @@ func (t *Table) addColumnToSchema(ctx *sql.Context, newCol *sql.Column, order *s
 	for _, col := range t.schema.Schema {
 		newSch[i] = col
 		i++
-		if (order != nil && order.AfterColumn == col.Name) || (order == nil && i == len(t.schema.Schema)) {
+		if (order != nil && strings.EqualFold(order.AfterColumn, col.Name)) || (order == nil && i == len(t.schema.Schema)) {
 			newSch[i] = newCol
 			newColIdx = i
 			i++
@@ -904,37 +1257,38 @@ func (t *Table) ModifyColumn(ctx *sql.Context, columnName string, column *sql.Co
 		oldSchemaWithoutCol = append(oldSchemaWithoutCol, t.schema.Schema[:oldIdx]...)
 		oldSchemaWithoutCol = append(oldSchemaWithoutCol, t.schema.Schema[oldIdx+1:]...)
 		for i, col := range oldSchemaWithoutCol {
-			if col.Name == order.AfterColumn {
+			if strings.EqualFold(col.Name, order.AfterColumn) {
 				newIdx = i + 1
 				break
 			}
 		}
 	}
 
-	for k, p := range t.partitions {
+	// Convert every row before changing any of them, so that a row that can't be converted leaves the table unchanged
+	newPartitions := make(map[string][]sql.Row, len(t.partitions))
+	rowNum := 0
+	for _, key := range t.partitionKeys {
+		k := string(key)
+		p := t.partitions[k]
 		newP := make([]sql.Row, len(p))
 		for i, row := range p {
 			var oldRowWithoutVal sql.Row
 			oldRowWithoutVal = append(oldRowWithoutVal, row[:oldIdx]...)
 			oldRowWithoutVal = append(oldRowWithoutVal, row[oldIdx+1:]...)
-			newVal, inRange, err := column.Type.Convert(row[oldIdx])
+			rowNum++
+			newVal, err := types.ConvertForColumnChange(ctx, column, row[oldIdx], rowNum)
 			if err != nil {
-				if sql.ErrNotMatchingSRID.Is(err) {
-					err = sql.ErrNotMatchingSRIDWithColName.New(columnName, err)
-				}
 				return err
 			}
-			if !inRange {
-				return sql.ErrValueOutOfRange.New(row[oldIdx], column.Type)
-			}
 			var newRow sql.Row
 			newRow = append(newRow, oldRowWithoutVal[:newIdx]...)
 			newRow = append(newRow, newVal)
 			newRow = append(newRow, oldRowWithoutVal[newIdx:]...)
 			newP[i] = newRow
 		}
-		t.partitions[k] = newP
+		newPartitions[k] = newP
 	}
+	t.partitions = newPartitions
 
 	pkNameToOrdIdx := make(map[string]int)
 	for i, ord := range t.schema.PkOrdinals {
@@ -958,10 +1312,13 @@ func (t *Table) ModifyColumn(ctx *sql.Context, columnName string, column *sql.Co
 		memIndex := index.(*Index)
 		nameLowercase := strings.ToLower(columnName)
 		for i, expr := range memIndex.Exprs {
-			getField := expr.(*expression.GetField)
-			if strings.ToLower(getField.Name()) == nameLowercase {
-				memIndex.Exprs[i] = expression.NewGetFieldWithTable(newIdx, column.Type, getField.Table(), column.Name, column.Nullable)
-			}
+			memIndex.Exprs[i], _, _ = transform.Expr(expr, func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+				getField, ok := e.(*expression.GetField)
+				if !ok || strings.ToLower(getField.Name()) != nameLowercase {
+					return e, transform.SameTree, nil
+				}
+				return expression.NewGetFieldWithTable(newIdx, column.Type, getField.Table(), column.Name, column.Nullable), transform.NewTree, nil
+			})
 		}
 	}
 
@@ -1090,6 +1447,22 @@ func (t *FilteredTable) Projections() []string {
 type IndexedTable struct {
 	*Table
 	Idx *Index
+	// cond is the condition pushed down to the index scans of the table, evaluated on the index's entries
+	cond sql.Expression
+}
+
+var _ sql.IndexConditionPushdownTable = (*IndexedTable)(nil)
+
+// WithIndexCondition implements the sql.IndexConditionPushdownTable interface.
+func (t *IndexedTable) WithIndexCondition(cond sql.Expression) sql.IndexedTable {
+	nt := *t
+	nt.cond = cond
+	return &nt
+}
+
+// IndexCondition implements the sql.IndexConditionPushdownTable interface.
+func (t *IndexedTable) IndexCondition() sql.Expression {
+	return t.cond
 }
 
 func (t *IndexedTable) LookupPartitions(ctx *sql.Context, lookup sql.IndexLookup) (sql.PartitionIter, error) {
@@ -1125,7 +1498,7 @@ func (t *IndexedTable) LookupPartitions(ctx *sql.Context, lookup sql.IndexLookup
 		}, nil
 	}
 
-	return rangePartitionIter{child: child.(*partitionIter), ranges: filter}, nil
+	return &rangePartitionIter{keys: child.(*partitionIter).keys, ranges: filter, reverse: lookup.IsReverse}, nil
 }
 
 // PartitionRows implements the sql.PartitionRows interface.
@@ -1135,12 +1508,18 @@ func (t *IndexedTable) PartitionRows(ctx *sql.Context, partition sql.Partition)
 		return nil, err
 	}
 	if t.Idx != nil {
-		sf := make(sql.SortFields, len(t.Idx.Exprs))
-		for i, e := range t.Idx.Exprs {
-			sf[i] = sql.SortField{Column: e}
+		var sf sql.SortFields
+		if r, ok := partition.(*rangePartition); ok {
+			sf = t.Idx.sortFields(r.reverse)
+		} else {
+			sf = t.Idx.sortFields(false)
 		}
 		var sorter *expression.Sorter
 		if i, ok := iter.(*tableIter); ok {
+			if t.cond != nil {
+				i.index = t.Idx
+				i.indexCond = t.cond
+			}
 			sorter = &expression.Sorter{
 				SortFields: sf,
 				Rows:       i.rows,
@@ -1354,7 +1733,7 @@ func (t *Table) DropCheck(ctx *sql.Context, chName string) error {
 	return fmt.Errorf("check '%s' was not found on the table", chName)
 }
 
-func (t *Table) createIndex(name string, columns []sql.IndexColumn, constraint sql.IndexConstraint, comment string) (sql.Index, error) {
+func (t *Table) createIndex(ctx *sql.Context, name string, columns []sql.IndexColumn, constraint sql.IndexConstraint, comment string) (sql.Index, error) {
 	if name == "" {
 		for _, column := range columns {
 			name += column.Name + "_"
@@ -1367,7 +1746,17 @@ func (t *Table) createIndex(name string, columns []sql.IndexColumn, constraint s
 
 	exprs := make([]sql.Expression, len(columns))
 	colNames := make([]string, len(columns))
+	var functional bool
 	for i, column := range columns {
+		if column.IsFunctional() {
+			expr, err := t.indexExpression(column.Expression)
+			if err != nil {
+				return nil, err
+			}
+			exprs[i] = expr
+			functional = true
+			continue
+		}
 		idx, field := t.getField(column.Name)
 		exprs[i] = expression.NewGetFieldWithTable(idx, field.Type, t.name, field.Name, field.Nullable)
 		colNames[i] = column.Name
@@ -1388,8 +1777,23 @@ func (t *Table) createIndex(name string, columns []sql.IndexColumn, constraint s
 		}
 	}
 
+	var descending []bool
+	for i, column := range columns {
+		if column.Descending {
+			if descending == nil {
+				descending = make([]bool, len(columns))
+			}
+			descending[i] = true
+		}
+	}
+
 	if constraint == sql.IndexConstraint_Unique {
-		err := t.errIfDuplicateEntryExist(colNames, name)
+		var err error
+		if functional {
+			err = t.errIfDuplicateKeyExist(ctx, exprs)
+		} else {
+			err = t.errIfDuplicateEntryExist(colNames, name)
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -1406,6 +1810,7 @@ func (t *Table) createIndex(name string, columns []sql.IndexColumn, constraint s
 		Spatial:    constraint == sql.IndexConstraint_Spatial,
 		CommentStr: comment,
 		PrefixLens: prefixLengths,
+		Descending: descending,
 	}, nil
 }
 
@@ -1435,6 +1840,48 @@ func (t *Table) errIfDuplicateEntryExist(cols []string, idxName string) error {
 	return nil
 }
 
+// throws an error if any two or more rows share the same values for the index expressions |exprs|.
+func (t *Table) errIfDuplicateKeyExist(ctx *sql.Context, exprs []sql.Expression) error {
+	unique := make(map[uint64]struct{})
+	for _, partition := range t.partitions {
+		for _, row := range partition {
+			key, err := evalIndexKey(ctx, exprs, row)
+			if err != nil {
+				return err
+			}
+			if hasNulls(key) {
+				continue
+			}
+			h, err := sql.HashOf(key)
+			if err != nil {
+				return err
+			}
+			if _, ok := unique[h]; ok {
+				return sql.NewUniqueKeyErr(fmt.Sprint(key), false, nil)
+			}
+			unique[h] = struct{}{}
+		}
+	}
+	return nil
+}
+
+// indexExpression returns the expression of a functional key part given with its field references bound to the
+// columns of this table.
+func (t *Table) indexExpression(e sql.Expression) (sql.Expression, error) {
+	e, _, err := transform.Expr(e, func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+		gf, ok := e.(*expression.GetField)
+		if !ok {
+			return e, transform.SameTree, nil
+		}
+		idx, field := t.getField(gf.Name())
+		if field == nil {
+			return nil, transform.SameTree, errColumnNotFound.New(gf.Name())
+		}
+		return expression.NewGetFieldWithTable(idx, field.Type, t.name, field.Name, field.Nullable), transform.NewTree, nil
+	})
+	return e, err
+}
+
 func hasNulls(row sql.Row) bool {
 	for _, v := range row {
 		if v == nil {
@@ -1469,7 +1916,7 @@ func (t *Table) CreateIndex(ctx *sql.Context, idx sql.IndexDef) error {
 		t.indexes = make(map[string]sql.Index)
 	}
 
-	index, err := t.createIndex(idx.Name, idx.Columns, idx.Constraint, idx.Comment)
+	index, err := t.createIndex(ctx, idx.Name, idx.Columns, idx.Constraint, idx.Comment)
 	if err != nil {
 		return err
 	}
@@ -1502,7 +1949,61 @@ func (t *Table) RenameIndex(ctx *sql.Context, fromIndexName string, toIndexName
 
 // ModifyStoredCollation implements sql.CollationAlterableTable
 func (t *Table) ModifyStoredCollation(ctx *sql.Context, collation sql.CollationID) error {
-	return fmt.Errorf("converting the collations of columns is not yet supported")
+	// Only columns using the table's character set are converted, columns declared with a different character set
+	// keep it
+	charset := t.collation.CharacterSet()
+	newCols := make(map[int]*sql.Column)
+	for i, col := range t.schema.Schema {
+		typ, ok := col.Type.(sql.TypeWithCollation)
+		if !ok || typ.Collation().CharacterSet() != charset {
+			continue
+		}
+		newType, err := typ.WithNewCollation(collation)
+		if err != nil {
+			return err
+		}
+		newCol := col.Copy()
+		newCol.Type = newType
+		newCols[i] = newCol
+	}
+
+	// Convert every stored value to the new character set before changing anything, so that a value that can't be
+	// converted leaves the table unchanged
+	newPartitions := make(map[string][]sql.Row, len(t.partitions))
+	rowNum := 0
+	for _, key := range t.partitionKeys {
+		k := string(key)
+		p := t.partitions[k]
+		newP := make([]sql.Row, len(p))
+		for j, row := range p {
+			rowNum++
+			newRow := row.Copy()
+			for i := range t.schema.Schema {
+				newCol, ok := newCols[i]
+				if !ok {
+					continue
+				}
+				newVal, err := types.ConvertForColumnChange(ctx, newCol, row[i], rowNum)
+				if err != nil {
+					return err
+				}
+				newRow[i] = newVal
+			}
+			newP[j] = newRow
+		}
+		newPartitions[k] = newP
+	}
+	t.partitions = newPartitions
+
+	for i := range t.schema.Schema {
+		if newCol, ok := newCols[i]; ok {
+			if err := t.ModifyColumn(ctx, newCol.Name, newCol, nil); err != nil {
+				return err
+			}
+		}
+	}
+	t.collation = collation
+	return nil
 }
 
 // ModifyDefaultCollation implements sql.CollationAlterableTable
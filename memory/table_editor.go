@@ -17,6 +17,7 @@ package memory
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/types"
@@ -32,7 +33,9 @@ type tableEditor struct {
 	// array of key ordinals for each unique index defined on the table
 	uniqueIdxCols [][]int
 	prefixLengths [][]uint16
-	fkTable       *Table
+	// key expressions for each unique index with functional key parts defined on the table
+	uniqueIdxExprs [][]sql.Expression
+	fkTable        *Table
 }
 
 var _ sql.Table = (*tableEditor)(nil)
@@ -150,6 +153,10 @@ func (t *tableEditor) Insert(ctx *sql.Context, row sql.Row) error {
 		}
 	}
 
+	if err := t.checkUniqueIdxExprs(ctx, row); err != nil {
+		return err
+	}
+
 	err = t.ea.Insert(row)
 	if err != nil {
 		return err
@@ -242,6 +249,10 @@ func (t *tableEditor) Update(ctx *sql.Context, oldRow sql.Row, newRow sql.Row) e
 		}
 	}
 
+	if err := t.checkUniqueIdxExprs(ctx, newRow); err != nil {
+		return err
+	}
+
 	err = t.ea.Insert(newRow)
 	if err != nil {
 		return err
@@ -322,38 +333,64 @@ func (t *tableEditor) pkColsDiffer(row, row2 sql.Row) bool {
 	return !columnsMatch(pkColIdxes, nil, row, row2)
 }
 
+// checkUniqueIdxExprs returns a unique key error if the row given has the same key as an existing row in any unique
+// index with functional key parts.
+func (t *tableEditor) checkUniqueIdxExprs(ctx *sql.Context, row sql.Row) error {
+	for _, exprs := range t.uniqueIdxExprs {
+		key, err := evalIndexKey(ctx, exprs, row)
+		if err != nil {
+			return err
+		}
+		if hasNulls(key) {
+			continue
+		}
+		existing, found, err := t.ea.GetByExprs(ctx, key, exprs)
+		if err != nil {
+			return err
+		}
+		if found {
+			return sql.NewUniqueKeyErr(fmt.Sprint(key), false, existing)
+		}
+	}
+	return nil
+}
+
+// evalIndexKey returns the values of the index expressions given for the row provided.
+func evalIndexKey(ctx *sql.Context, exprs []sql.Expression, row sql.Row) (sql.Row, error) {
+	key := make(sql.Row, len(exprs))
+	for i, e := range exprs {
+		v, err := e.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		key[i] = v
+	}
+	return key, nil
+}
+
+// Returns whether the index expressions given evaluate to |key| for the row provided
+func keyMatches(ctx *sql.Context, exprs []sql.Expression, key sql.Row, row sql.Row) (bool, error) {
+	for i, e := range exprs {
+		v, err := e.Eval(ctx, row)
+		if err != nil {
+			return false, err
+		}
+		cmp, err := e.Type().Compare(v, key[i])
+		if err != nil || cmp != 0 {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
 // Returns whether the values for the columns given match in the two rows provided
 func columnsMatch(colIndexes []int, prefixLengths []uint16, row sql.Row, row2 sql.Row) bool {
 	for i, idx := range colIndexes {
 		v1 := row[idx]
 		v2 := row2[idx]
 		if len(prefixLengths) > i && prefixLengths[i] > 0 {
-			prefixLength := prefixLengths[i]
-			switch v := v1.(type) {
-			case string:
-				if prefixLength > uint16(len(v)) {
-					prefixLength = uint16(len(v))
-				}
-				v1 = v[:prefixLength]
-			case []byte:
-				if prefixLength > uint16(len(v)) {
-					prefixLength = uint16(len(v))
-				}
-				v1 = v[:prefixLength]
-			}
-			prefixLength = prefixLengths[i]
-			switch v := v2.(type) {
-			case string:
-				if prefixLength > uint16(len(v)) {
-					prefixLength = uint16(len(v))
-				}
-				v2 = v[:prefixLength]
-			case []byte:
-				if prefixLength > uint16(len(v)) {
-					prefixLength = uint16(len(v))
-				}
-				v2 = v[:prefixLength]
-			}
+			v1 = truncateToPrefix(v1, prefixLengths[i])
+			v2 = truncateToPrefix(v2, prefixLengths[i])
 		}
 		if v, ok := v1.([]byte); ok {
 			v1 = string(v)
@@ -381,6 +418,8 @@ type tableEditAccumulator interface {
 	// accumulator.
 	ApplyEdits(ctx *sql.Context) error
 	GetByCols(value sql.Row, cols []int, prefixLengths []uint16) (sql.Row, bool, error)
+	// GetByExprs finds a row for which the index expressions |exprs| evaluate to |key|.
+	GetByExprs(ctx *sql.Context, key sql.Row, exprs []sql.Expression) (sql.Row, bool, error)
 	// Clear wipes all of the stored inserts and deletes that may or may not have been applied.
 	Clear()
 }
@@ -481,20 +520,57 @@ func (pke *pkTableEditAccumulator) GetByCols(value sql.Row, cols []int, prefixLe
 	return nil, false, nil
 }
 
+// GetByExprs implements the tableEditAccumulator interface.
+func (pke *pkTableEditAccumulator) GetByExprs(ctx *sql.Context, key sql.Row, exprs []sql.Expression) (sql.Row, bool, error) {
+	// If we have this row in any delete, bail.
+	for _, r := range pke.deletes {
+		if ok, err := keyMatches(ctx, exprs, key, r); err != nil || ok {
+			return nil, false, err
+		}
+	}
+
+	for _, r := range pke.adds {
+		if ok, err := keyMatches(ctx, exprs, key, r); err != nil || ok {
+			return r, ok, err
+		}
+	}
+
+	for _, partition := range pke.table.partitions {
+		for _, partitionRow := range partition {
+			if ok, err := keyMatches(ctx, exprs, key, partitionRow); err != nil || ok {
+				return partitionRow, ok, err
+			}
+		}
+	}
+
+	return nil, false, nil
+}
+
 // ApplyEdits implements the tableEditAccumulator interface.
 func (pke *pkTableEditAccumulator) ApplyEdits(ctx *sql.Context) error {
+	if len(pke.deletes) > 0 || len(pke.adds) > 0 {
+		pke.table.updateTime = time.Now()
+		pke.table.rowsModified += uint64(rowsChanged(len(pke.adds), len(pke.deletes)))
+	}
+
 	for _, val := range pke.deletes {
 		err := pke.deleteHelper(ctx, pke.table, val)
 		if err != nil {
 			return err
 		}
 	}
+	pke.table.rowCounts.Deleted += uint64(len(pke.deletes))
 
 	for _, val := range pke.adds {
-		err := pke.insertHelper(ctx, pke.table, val)
+		replaced, err := pke.insertHelper(ctx, pke.table, val)
 		if err != nil {
 			return err
 		}
+		if replaced {
+			pke.table.rowCounts.Updated++
+		} else {
+			pke.table.rowCounts.Inserted++
+		}
 	}
 
 	pke.table.sortRows()
@@ -562,8 +638,9 @@ func (pke *pkTableEditAccumulator) deleteHelper(ctx *sql.Context, table *Table,
 	return nil
 }
 
-// insertHelper inserts the given row into the given table.
-func (pke *pkTableEditAccumulator) insertHelper(ctx *sql.Context, table *Table, row sql.Row) error {
+// insertHelper inserts the given row into the given table, returning whether it replaced a row with the same primary
+// key.
+func (pke *pkTableEditAccumulator) insertHelper(ctx *sql.Context, table *Table, row sql.Row) (bool, error) {
 	key := string(table.partitionKeys[table.insertPartIdx])
 	table.insertPartIdx++
 	if table.insertPartIdx == len(table.partitionKeys) {
@@ -589,11 +666,10 @@ func (pke *pkTableEditAccumulator) insertHelper(ctx *sql.Context, table *Table,
 
 	if savedPartitionRowIndex > -1 {
 		table.partitions[savedPartitionIndex][savedPartitionRowIndex] = row
-	} else {
-		table.partitions[key] = append(table.partitions[key], row)
+		return true, nil
 	}
-
-	return nil
+	table.partitions[key] = append(table.partitions[key], row)
+	return false, nil
 }
 
 // keylessTableEditAccumulator manages updates for a keyless table.
@@ -680,8 +756,58 @@ func (k *keylessTableEditAccumulator) GetByCols(value sql.Row, cols []int, prefi
 	return nil, false, nil
 }
 
+// GetByExprs implements the tableEditAccumulator interface.
+func (k *keylessTableEditAccumulator) GetByExprs(ctx *sql.Context, key sql.Row, exprs []sql.Expression) (sql.Row, bool, error) {
+	deleteCount := 0
+	for _, r := range k.deletes {
+		ok, err := keyMatches(ctx, exprs, key, r)
+		if err != nil {
+			return nil, false, err
+		}
+		if ok {
+			deleteCount++
+		}
+	}
+
+	for _, partition := range k.table.partitions {
+		for _, partitionRow := range partition {
+			ok, err := keyMatches(ctx, exprs, key, partitionRow)
+			if err != nil {
+				return nil, false, err
+			}
+			if ok {
+				if deleteCount == 0 {
+					return partitionRow, true, nil
+				}
+				deleteCount--
+			}
+		}
+	}
+
+	for _, r := range k.adds {
+		ok, err := keyMatches(ctx, exprs, key, r)
+		if err != nil {
+			return nil, false, err
+		}
+		if ok {
+			if deleteCount == 0 {
+				return r, true, nil
+			}
+			deleteCount--
+		}
+	}
+
+	return nil, false, nil
+}
+
 // ApplyEdits implements the tableEditAccumulator interface.
 func (k *keylessTableEditAccumulator) ApplyEdits(ctx *sql.Context) error {
+	if len(k.deletes) > 0 || len(k.adds) > 0 {
+		k.table.updateTime = time.Now()
+		k.table.rowsModified += uint64(rowsChanged(len(k.adds), len(k.deletes)))
+		k.table.countKeylessRowsChanged(len(k.adds), len(k.deletes))
+	}
+
 	for _, val := range k.deletes {
 		err := k.deleteHelper(ctx, k.table, val)
 		if err != nil {
@@ -760,3 +886,25 @@ func formatRow(r sql.Row, idxs []int) string {
 	b.WriteString("]")
 	return b.String()
 }
+
+// rowsChanged returns the number of rows changed by a batch of edits with the numbers of added and deleted rows given.
+// Updates are a delete and an add of the same row, so they count once.
+func rowsChanged(adds, deletes int) int {
+	if adds > deletes {
+		return adds
+	}
+	return deletes
+}
+
+// countKeylessRowsChanged adds the rows changed by a batch of edits to a keyless table to its counts by kind. Rows of
+// keyless tables are updated by deleting them and adding them back, so each pair of a deleted and an added row counts
+// as an updated row.
+func (t *Table) countKeylessRowsChanged(adds, deletes int) {
+	updates := adds
+	if deletes < updates {
+		updates = deletes
+	}
+	t.rowCounts.Inserted += uint64(adds - updates)
+	t.rowCounts.Updated += uint64(updates)
+	t.rowCounts.Deleted += uint64(deletes - updates)
+}
@@ -0,0 +1,100 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+func newVerifyTestTable(t *testing.T, ctx *sql.Context) *Table {
+	table := NewTable("t", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "pk", Type: types.Int64, Source: "t", PrimaryKey: true},
+		{Name: "u", Type: types.Int64, Source: "t", Nullable: true},
+		{Name: "v", Type: types.Text, Source: "t"},
+	}), nil)
+	require.NoError(t, table.CreateIndex(ctx, sql.IndexDef{
+		Name:       "u",
+		Columns:    []sql.IndexColumn{{Name: "u"}},
+		Constraint: sql.IndexConstraint_Unique,
+	}))
+	for _, row := range []sql.Row{{int64(1), int64(10), "a"}, {int64(2), nil, "b"}, {int64(3), nil, "c"}} {
+		require.NoError(t, table.Insert(ctx, row))
+	}
+	return table
+}
+
+// onlyPartition returns the key of the partition the rows of a test table are in.
+func onlyPartition(t *testing.T, table *Table) string {
+	require.Len(t, table.partitionKeys, 1)
+	return string(table.partitionKeys[0])
+}
+
+func TestVerify(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+
+	t.Run("consistent table", func(t *testing.T) {
+		table := newVerifyTestTable(t, ctx)
+		problems, err := table.Verify(ctx)
+		require.NoError(t, err)
+		require.Empty(t, problems)
+	})
+
+	t.Run("duplicate keys", func(t *testing.T) {
+		table := newVerifyTestTable(t, ctx)
+		key := onlyPartition(t, table)
+		table.partitions[key] = append(table.partitions[key], sql.Row{int64(1), int64(10), "d"})
+		problems, err := table.Verify(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []string{
+			"Duplicate entry '[1]' for key 'PRIMARY'",
+			"Duplicate entry '[10]' for key 'u'",
+		}, problems)
+	})
+
+	t.Run("index on the wrong column", func(t *testing.T) {
+		table := newVerifyTestTable(t, ctx)
+		idx := table.indexes["u"].(*Index)
+		idx.Exprs = []sql.Expression{expression.NewGetFieldWithTable(2, types.Int64, "t", "u", true)}
+		problems, err := table.Verify(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []string{"Index 'u' refers to column 'u' at position 3, which doesn't hold it"}, problems)
+	})
+
+	t.Run("malformed rows", func(t *testing.T) {
+		table := newVerifyTestTable(t, ctx)
+		key := onlyPartition(t, table)
+		table.partitions[key] = append(table.partitions[key], sql.Row{int64(4), nil, nil}, sql.Row{int64(5)})
+		problems, err := table.Verify(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []string{
+			"Row 4 has NULL in NOT NULL column 'v'",
+			"Row 5 has 1 values, but the table has 3 columns",
+		}, problems)
+	})
+
+	t.Run("unlisted partition", func(t *testing.T) {
+		table := newVerifyTestTable(t, ctx)
+		table.partitions["lost"] = []sql.Row{{int64(6), nil, "e"}}
+		problems, err := table.Verify(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []string{"Partition 'lost' isn't listed, so its rows can't be read"}, problems)
+	})
+}
@@ -144,6 +144,8 @@ func (g *AggGen) genAggNewBuffer(define AggDef) {
 	fmt.Fprintf(g.w, "    if err != nil {\n")
 	fmt.Fprintf(g.w, "        return nil, err\n")
 	fmt.Fprintf(g.w, "    }\n")
-	fmt.Fprintf(g.w, "    return New%sBuffer(child), nil\n", define.Name)
+	fmt.Fprintf(g.w, "    return newUnaryAggBuffer(child, func(child sql.Expression) sql.AggregationBuffer {\n")
+	fmt.Fprintf(g.w, "        return New%sBuffer(child)\n", define.Name)
+	fmt.Fprintf(g.w, "    }), nil\n")
 	fmt.Fprintf(g.w, "}\n\n")
 }
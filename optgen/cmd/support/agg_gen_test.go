@@ -90,7 +90,9 @@ func TestAggGen(t *testing.T) {
             if err != nil {
                 return nil, err
             }
-            return NewTestBuffer(child), nil
+            return newUnaryAggBuffer(child, func(child sql.Expression) sql.AggregationBuffer {
+                return NewTestBuffer(child)
+            }), nil
         }
 
         func (a *Test) NewWindowFunction() (sql.WindowFunction, error) {
@@ -0,0 +1,167 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"io"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+	"github.com/dolthub/go-mysql-server/sql/parse"
+)
+
+// paginationToken is the content of the continuation tokens returned by Engine.QueryWithPagination.
+type paginationToken struct {
+	// Query is a hash of the query the token was returned for
+	Query uint64 `json:"q"`
+	// After are the values of the columns the query is ordered by in the last row returned, in their wire format, with
+	// nil for NULL values
+	After [][]byte `json:"a"`
+}
+
+// QueryWithPagination executes a query and returns the schema of its results and up to |pageSize| rows of them,
+// along with a continuation token to pass to get the next page of rows, which is empty once all the rows have been
+// returned. The first page is returned for an empty token.
+//
+// Each page is a new execution of the query, returning the rows ordered after the last row of the previous page, so
+// that rows changed between pages are returned according to where they're ordered when the next page is read. Only
+// queries on a single table, ordered by columns that are unique in it and included in the results, and with no LIMIT,
+// can be paginated. sql.ErrQueryNotResumable is returned for other queries.
+func (e *Engine) QueryWithPagination(ctx *sql.Context, query string, pageSize int, token string) (sql.Schema, []sql.Row, string, error) {
+	if pageSize <= 0 {
+		return nil, nil, "", sql.ErrInvalidArgument.New(fmt.Sprintf("page size %d", pageSize))
+	}
+
+	parsed, err := parse.Parse(ctx, query)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	keyset, err := e.Analyzer.Keyset(ctx, parsed)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	var after []interface{}
+	if token != "" {
+		after, err = decodePaginationToken(query, keyset, token)
+		if err != nil {
+			return nil, nil, "", err
+		}
+	}
+
+	// One more row than the page holds is read to know whether there's a next page
+	schema, iter, err := e.QueryNodeWithBindings(ctx, "", keyset.Query(after, int64(pageSize)+1), nil)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	positions, err := keyset.Positions(schema)
+	if err != nil {
+		iter.Close(ctx)
+		return nil, nil, "", err
+	}
+
+	var rows []sql.Row
+	for {
+		row, err := iter.Next(ctx)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			iter.Close(ctx)
+			return nil, nil, "", err
+		}
+		rows = append(rows, row)
+	}
+	if err = iter.Close(ctx); err != nil {
+		return nil, nil, "", err
+	}
+
+	if len(rows) <= pageSize {
+		return schema, rows, "", nil
+	}
+	rows = rows[:pageSize]
+	token, err = encodePaginationToken(ctx, query, keyset, positions, rows[pageSize-1])
+	if err != nil {
+		return nil, nil, "", err
+	}
+	return schema, rows, token, nil
+}
+
+func hashPaginatedQuery(query string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(query))
+	return h.Sum64()
+}
+
+// encodePaginationToken returns the continuation token for the rows of the query given ordered after the row given.
+func encodePaginationToken(ctx *sql.Context, query string, keyset *analyzer.Keyset, positions []int, row sql.Row) (string, error) {
+	t := paginationToken{Query: hashPaginatedQuery(query)}
+	for i, col := range keyset.Columns {
+		if row[positions[i]] == nil {
+			t.After = append(t.After, nil)
+			continue
+		}
+		v, err := col.Type.SQL(ctx, nil, row[positions[i]])
+		if err != nil {
+			return "", err
+		}
+		// The wire format of an empty value may be nil, which is NULL in the token
+		raw := v.Raw()
+		if raw == nil {
+			raw = []byte{}
+		}
+		t.After = append(t.After, raw)
+	}
+	b, err := json.Marshal(t)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// decodePaginationToken returns the values of the columns the query given is ordered by in the row the continuation
+// token given resumes after.
+func decodePaginationToken(query string, keyset *analyzer.Keyset, token string) ([]interface{}, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, sql.ErrInvalidPaginationToken.New(err.Error())
+	}
+	var t paginationToken
+	if err = json.Unmarshal(b, &t); err != nil {
+		return nil, sql.ErrInvalidPaginationToken.New(err.Error())
+	}
+	if t.Query != hashPaginatedQuery(query) {
+		return nil, sql.ErrInvalidPaginationToken.New("it was returned for another query")
+	}
+	if len(t.After) != len(keyset.Columns) {
+		return nil, sql.ErrInvalidPaginationToken.New("it doesn't match the query's ORDER BY")
+	}
+
+	after := make([]interface{}, len(t.After))
+	for i, col := range keyset.Columns {
+		if t.After[i] == nil {
+			continue
+		}
+		after[i], _, err = col.Type.Convert(string(t.After[i]))
+		if err != nil {
+			return nil, sql.ErrInvalidPaginationToken.New(err.Error())
+		}
+	}
+	return after, nil
+}
@@ -50,9 +50,15 @@ func (pl *ProcessList) Processes() []sql.Process {
 	for _, proc := range pl.procs {
 		p := *proc
 		var progress = make(map[string]sql.TableProgress, len(p.Progress))
-		for n, p := range p.Progress {
-			progress[n] = p
+		for n, tp := range p.Progress {
+			partitions := make(map[string]sql.PartitionProgress, len(tp.PartitionsProgress))
+			for pn, pp := range tp.PartitionsProgress {
+				partitions[pn] = pp
+			}
+			tp.PartitionsProgress = partitions
+			progress[n] = tp
 		}
+		p.Progress = progress
 		result = append(result, p)
 	}
 
@@ -80,6 +86,8 @@ func (pl *ProcessList) ConnectionReady(sess sql.Session) {
 		Host:       sess.Client().Address,
 		User:       sess.Client().User,
 		StartedAt:  time.Now(),
+
+		ConnectAttributes: sess.Client().Attributes,
 	}
 }
 
@@ -121,6 +129,8 @@ func (pl *ProcessList) BeginQuery(
 	p.StartedAt = time.Now()
 	p.Kill = cancel
 	p.Progress = make(map[string]sql.TableProgress)
+	p.RowsExamined = 0
+	p.RowsSent = 0
 
 	pl.byQueryPid[ctx.Pid()] = ctx.Session.ID()
 
@@ -291,6 +301,42 @@ func (pl *ProcessList) RemovePartitionProgress(pid uint64, tableName, partitionN
 	delete(tablePg.PartitionsProgress, partitionName)
 }
 
+// UpdateRowsExamined adds |delta| to the number of rows examined by the process
+// with the given pid.
+func (pl *ProcessList) UpdateRowsExamined(pid uint64, delta int64) {
+	pl.mu.Lock()
+	defer pl.mu.Unlock()
+
+	id, ok := pl.byQueryPid[pid]
+	if !ok {
+		return
+	}
+	p, ok := pl.procs[id]
+	if !ok {
+		return
+	}
+
+	p.RowsExamined += delta
+}
+
+// UpdateRowsSent adds |delta| to the number of rows sent by the process with
+// the given pid.
+func (pl *ProcessList) UpdateRowsSent(pid uint64, delta int64) {
+	pl.mu.Lock()
+	defer pl.mu.Unlock()
+
+	id, ok := pl.byQueryPid[pid]
+	if !ok {
+		return
+	}
+	p, ok := pl.procs[id]
+	if !ok {
+		return
+	}
+
+	p.RowsSent += delta
+}
+
 // Kill terminates all queries for a given connection id.
 func (pl *ProcessList) Kill(connID uint32) {
 	pl.mu.Lock()
@@ -0,0 +1,43 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import "github.com/dolthub/go-mysql-server/sql"
+
+// ReadWriteRouter is a sql.StatementRouter that routes the statements that only read data to one provider, such as
+// that of a read replica, and all other statements to another, such as that of the replica's primary. Set it as the
+// StatementRouter of the Config of an engine to use it.
+type ReadWriteRouter struct {
+	// Read is the provider of the statements that only read data
+	Read sql.DatabaseProvider
+	// Write is the provider of the statements that write data, change schemas, and begin and end transactions
+	Write sql.DatabaseProvider
+}
+
+var _ sql.StatementRouter = (*ReadWriteRouter)(nil)
+
+// NewReadWriteRouter returns a ReadWriteRouter routing reads to the read provider given and all other statements to
+// the write provider given.
+func NewReadWriteRouter(read, write sql.DatabaseProvider) *ReadWriteRouter {
+	return &ReadWriteRouter{Read: read, Write: write}
+}
+
+// RouteStatement implements sql.StatementRouter
+func (r *ReadWriteRouter) RouteStatement(ctx *sql.Context, class sql.StatementClass) (sql.DatabaseProvider, error) {
+	if class == sql.StatementClassRead {
+		return r.Read, nil
+	}
+	return r.Write, nil
+}
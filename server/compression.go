@@ -0,0 +1,292 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+
+	"github.com/dolthub/vitess/go/mysql"
+	"github.com/dolthub/vitess/go/netutil"
+)
+
+const (
+	// capabilityClientCompress is the CLIENT_COMPRESS capability flag, with which clients ask for the packets of their
+	// connection to be compressed.
+	capabilityClientCompress = 1 << 5
+	// minCompressLength is the length of the smallest payload that's compressed. Smaller payloads are sent
+	// uncompressed, as MySQL does.
+	minCompressLength = 50
+	// maxCompressedPayload is the length of the largest payload of a compressed packet.
+	maxCompressedPayload = 1<<24 - 1
+	// compressionAlgorithm is the name of the compression algorithm of compressed connections, as reported in their
+	// sql.ConnectionProperties.
+	compressionAlgorithm = "zlib"
+)
+
+// compressListener is a net.Listener whose connections are compressed with the compressed MySQL protocol when their
+// clients ask for it with CLIENT_COMPRESS.
+type compressListener struct {
+	net.Listener
+}
+
+// newCompressListener returns a listener of the connections of the listener given, which supports compression.
+func newCompressListener(l net.Listener) net.Listener {
+	return compressListener{Listener: l}
+}
+
+// Accept implements net.Listener
+func (l compressListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	return &compressConn{Conn: conn}, nil
+}
+
+// compressState is the state of the negotiation of the compression of a compressConn.
+type compressState byte
+
+const (
+	// compressNegotiating is the state of connections whose handshake hasn't ended yet
+	compressNegotiating compressState = iota
+	// compressOff is the state of connections whose client didn't ask for compression, whose data is passed through
+	compressOff
+	// compressOn is the state of compressed connections
+	compressOn
+)
+
+// compressConn is a server connection of the MySQL protocol that advertises CLIENT_COMPRESS in its initial handshake,
+// and that compresses the packets sent and decompresses the packets received once the handshake ends if the client
+// asked for it. The packets of the handshake are never compressed. Connections upgraded to TLS are compressed above the
+// encryption, as their handshakeConn hides the upgrade.
+type compressConn struct {
+	net.Conn
+
+	mu    sync.Mutex
+	state compressState
+	// seq is the sequence number of the next compressed packet sent, which follows that of the last one received
+	seq byte
+
+	// written are the bytes of the packets sent during the handshake that haven't been written yet, as they're only
+	// written in whole packets
+	written []byte
+	// handshakeWritten is whether the initial handshake packet was sent
+	handshakeWritten bool
+	// read are the bytes of the first packet received, until it's read in full
+	read []byte
+	// capabilitiesRead is whether the capabilities of the client were read from its first packet
+	capabilitiesRead bool
+	// clientCompress is whether the client asked for compression in its handshake response
+	clientCompress bool
+
+	// decompressed are the bytes decompressed from the last packets received that haven't been read yet
+	decompressed bytes.Buffer
+	// zbuf is the buffer packets are compressed in
+	zbuf bytes.Buffer
+	zw   *zlib.Writer
+}
+
+// compressed returns whether the compressed MySQL protocol is spoken over the connection.
+func (c *compressConn) compressed() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.state == compressOn
+}
+
+func (c *compressConn) getState() compressState {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.state
+}
+
+// Read implements net.Conn
+func (c *compressConn) Read(p []byte) (int, error) {
+	switch c.getState() {
+	case compressOn:
+		return c.readCompressed(p)
+	case compressOff:
+		return c.Conn.Read(p)
+	}
+
+	n, err := c.Conn.Read(p)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.state == compressNegotiating && !c.capabilitiesRead {
+		// The capabilities of the client are the first 4 bytes of its handshake response, after its header
+		c.read = append(c.read, p[:n]...)
+		if len(c.read) >= 8 && len(c.read) >= 4+packetLength(c.read) {
+			capabilities := binary.LittleEndian.Uint32(c.read[4:8])
+			c.read = nil
+			c.capabilitiesRead = true
+			if capabilities&capabilityClientCompress == 0 {
+				c.state = compressOff
+			} else {
+				c.clientCompress = true
+			}
+		}
+	}
+	return n, err
+}
+
+// readCompressed reads the data decompressed from the compressed packets received.
+func (c *compressConn) readCompressed(p []byte) (int, error) {
+	for c.decompressed.Len() == 0 {
+		var header [7]byte
+		if _, err := io.ReadFull(c.Conn, header[:]); err != nil {
+			return 0, err
+		}
+		length := int(header[0]) | int(header[1])<<8 | int(header[2])<<16
+		uncompressedLength := int(header[4]) | int(header[5])<<8 | int(header[6])<<16
+		c.mu.Lock()
+		c.seq = header[3] + 1
+		c.mu.Unlock()
+
+		payload := make([]byte, length)
+		if _, err := io.ReadFull(c.Conn, payload); err != nil {
+			return 0, err
+		}
+		// Payloads with an uncompressed length of 0 weren't compressed
+		if uncompressedLength == 0 {
+			c.decompressed.Write(payload)
+			continue
+		}
+		zr, err := zlib.NewReader(bytes.NewReader(payload))
+		if err != nil {
+			return 0, err
+		}
+		n, err := io.Copy(&c.decompressed, zr)
+		if err != nil {
+			return 0, err
+		}
+		if err = zr.Close(); err != nil {
+			return 0, err
+		}
+		if int(n) != uncompressedLength {
+			return 0, fmt.Errorf("invalid compressed packet: decompressed %d bytes, expected %d", n, uncompressedLength)
+		}
+	}
+	return c.decompressed.Read(p)
+}
+
+// Write implements net.Conn
+func (c *compressConn) Write(p []byte) (int, error) {
+	switch c.getState() {
+	case compressOn:
+		if err := c.writeCompressed(p); err != nil {
+			return 0, err
+		}
+		return len(p), nil
+	case compressOff:
+		return c.Conn.Write(p)
+	}
+
+	c.written = append(c.written, p...)
+	for len(c.written) >= 4 {
+		length := packetLength(c.written)
+		if len(c.written) < 4+length {
+			break
+		}
+		packet := c.written[: 4+length : 4+length]
+		c.written = c.written[4+length:]
+
+		c.mu.Lock()
+		first := !c.handshakeWritten
+		// The handshake ends with the OK packet sent once the client is authenticated
+		authenticated := c.clientCompress && length > 0 && packet[4] == mysql.OKPacket
+		c.handshakeWritten = true
+		c.mu.Unlock()
+
+		if first {
+			advertiseCapability(packet[4:], capabilityClientCompress)
+		}
+		if _, err := c.Conn.Write(packet); err != nil {
+			return 0, err
+		}
+		if authenticated {
+			c.mu.Lock()
+			c.state = compressOn
+			c.seq = 0
+			c.mu.Unlock()
+			rest := c.written
+			c.written = nil
+			if len(rest) > 0 {
+				if err := c.writeCompressed(rest); err != nil {
+					return 0, err
+				}
+			}
+			break
+		}
+	}
+	return len(p), nil
+}
+
+// writeCompressed writes the data given in compressed packets. Payloads shorter than minCompressLength, and those that
+// don't get shorter when compressed, are sent uncompressed.
+func (c *compressConn) writeCompressed(p []byte) error {
+	for len(p) > 0 {
+		chunk := p
+		if len(chunk) > maxCompressedPayload {
+			chunk = chunk[:maxCompressedPayload]
+		}
+		p = p[len(chunk):]
+
+		payload, uncompressedLength := chunk, 0
+		if len(chunk) >= minCompressLength {
+			c.zbuf.Reset()
+			if c.zw == nil {
+				c.zw = zlib.NewWriter(&c.zbuf)
+			} else {
+				c.zw.Reset(&c.zbuf)
+			}
+			if _, err := c.zw.Write(chunk); err != nil {
+				return err
+			}
+			if err := c.zw.Close(); err != nil {
+				return err
+			}
+			if c.zbuf.Len() < len(chunk) {
+				payload, uncompressedLength = c.zbuf.Bytes(), len(chunk)
+			}
+		}
+
+		c.mu.Lock()
+		seq := c.seq
+		c.seq++
+		c.mu.Unlock()
+		packet := make([]byte, 7, 7+len(payload))
+		packet[0], packet[1], packet[2] = byte(len(payload)), byte(len(payload)>>8), byte(len(payload)>>16)
+		packet[3] = seq
+		packet[4], packet[5], packet[6] = byte(uncompressedLength), byte(uncompressedLength>>8), byte(uncompressedLength>>16)
+		if _, err := c.Conn.Write(append(packet, payload...)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// compressedConn returns the compressConn of the connection given, if it's one, possibly wrapped with timeouts.
+func compressedConn(conn net.Conn) (*compressConn, bool) {
+	if wrap, ok := conn.(netutil.ConnWithTimeouts); ok {
+		conn = wrap.Conn
+	}
+	cc, ok := conn.(*compressConn)
+	return cc, ok
+}
@@ -0,0 +1,135 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/dolthub/vitess/go/mysql"
+)
+
+// handshakeResponseHeaderLength is the length of the capabilities, max packet size, character set and filler that
+// begin a handshake response.
+const handshakeResponseHeaderLength = 32
+
+// packetLength returns the payload length in the header of the packet given, which must be at least 4 bytes long.
+func packetLength(packet []byte) int {
+	return int(packet[0]) | int(packet[1])<<8 | int(packet[2])<<16
+}
+
+// parseConnectAttributes returns the connection attributes of the payload given of a handshake response, or nil if it
+// has none or is malformed.
+func parseConnectAttributes(payload []byte) map[string]string {
+	if len(payload) < handshakeResponseHeaderLength {
+		return nil
+	}
+	capabilities := binary.LittleEndian.Uint32(payload)
+	if capabilities&mysql.CapabilityClientConnAttr == 0 {
+		return nil
+	}
+	data := payload[handshakeResponseHeaderLength:]
+
+	// The user name
+	var ok bool
+	if data, ok = skipNullString(data); !ok {
+		return nil
+	}
+	// The auth response
+	switch {
+	case capabilities&mysql.CapabilityClientPluginAuthLenencClientData != 0:
+		if _, data, ok = readLenEncBytes(data); !ok {
+			return nil
+		}
+	case capabilities&mysql.CapabilityClientSecureConnection != 0:
+		if len(data) < 1 || len(data) < 1+int(data[0]) {
+			return nil
+		}
+		data = data[1+int(data[0]):]
+	default:
+		if data, ok = skipNullString(data); !ok {
+			return nil
+		}
+	}
+	if capabilities&mysql.CapabilityClientConnectWithDB != 0 {
+		if data, ok = skipNullString(data); !ok {
+			return nil
+		}
+	}
+	if capabilities&mysql.CapabilityClientPluginAuth != 0 {
+		if data, ok = skipNullString(data); !ok {
+			return nil
+		}
+	}
+
+	attrs, _, ok := readLenEncBytes(data)
+	if !ok || len(attrs) == 0 {
+		return nil
+	}
+	attributes := make(map[string]string)
+	for len(attrs) > 0 {
+		var key, value []byte
+		if key, attrs, ok = readLenEncBytes(attrs); !ok {
+			return nil
+		}
+		if value, attrs, ok = readLenEncBytes(attrs); !ok {
+			return nil
+		}
+		attributes[string(key)] = string(value)
+	}
+	return attributes
+}
+
+// skipNullString returns the data given after its first null-terminated string.
+func skipNullString(data []byte) ([]byte, bool) {
+	i := bytes.IndexByte(data, 0)
+	if i < 0 {
+		return nil, false
+	}
+	return data[i+1:], true
+}
+
+// readLenEncBytes returns the length-encoded string at the start of the data given, and the data after it.
+func readLenEncBytes(data []byte) ([]byte, []byte, bool) {
+	if len(data) < 1 {
+		return nil, nil, false
+	}
+	var length uint64
+	var size int
+	switch data[0] {
+	case 0xfc:
+		size = 3
+	case 0xfd:
+		size = 4
+	case 0xfe:
+		size = 9
+	case 0xfb, 0xff:
+		return nil, nil, false
+	default:
+		length = uint64(data[0])
+		size = 1
+	}
+	if len(data) < size {
+		return nil, nil, false
+	}
+	for i := size - 1; i > 0; i-- {
+		length = length<<8 | uint64(data[i])
+	}
+	data = data[size:]
+	if uint64(len(data)) < length {
+		return nil, nil, false
+	}
+	return data[:length], data[length:], true
+}
@@ -16,6 +16,7 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
 	"sync"
 	"time"
 
@@ -27,15 +28,16 @@ import (
 	"github.com/dolthub/go-mysql-server/sql/mysql_db"
 )
 
-// SessionBuilder creates sessions given a MySQL connection and a server address.
-type SessionBuilder func(ctx context.Context, conn *mysql.Conn, addr string) (sql.Session, error)
+// SessionBuilder creates sessions given a MySQL connection, a server address and the properties of the connection.
+// The session manager sets the connection properties of the sessions built, so builders don't need to.
+type SessionBuilder func(ctx context.Context, conn *mysql.Conn, addr string, props sql.ConnectionProperties) (sql.Session, error)
 
 // DoneFunc is a function that must be executed when the session is used and
 // it can be disposed.
 type DoneFunc func()
 
 // DefaultSessionBuilder is a SessionBuilder that returns a base session.
-func DefaultSessionBuilder(ctx context.Context, c *mysql.Conn, addr string) (sql.Session, error) {
+func DefaultSessionBuilder(ctx context.Context, c *mysql.Conn, addr string, props sql.ConnectionProperties) (sql.Session, error) {
 	host := ""
 	user := ""
 	mysqlConnectionUser, ok := c.UserData.(mysql_db.MysqlConnectionUser)
@@ -44,7 +46,53 @@ func DefaultSessionBuilder(ctx context.Context, c *mysql.Conn, addr string) (sql
 		user = mysqlConnectionUser.User
 	}
 	client := sql.Client{Address: host, User: user, Capabilities: c.Capabilities}
-	return sql.NewBaseSessionWithClientServer(addr, client, c.ConnectionID), nil
+	if hc, ok := handshakeConnOf(c.Conn); ok {
+		client.Attributes = hc.connectAttributes()
+	}
+	session := sql.NewBaseSessionWithClientServer(addr, client, c.ConnectionID)
+	session.SetConnectionProperties(props)
+	return session, nil
+}
+
+// protocolVersion is the version of the client/server protocol spoken by the server.
+const protocolVersion = 10
+
+// tlsVersionNames are the names of the versions of TLS, as reported by MySQL.
+var tlsVersionNames = map[uint16]string{
+	tls.VersionTLS10: "TLSv1",
+	tls.VersionTLS11: "TLSv1.1",
+	tls.VersionTLS12: "TLSv1.2",
+	tls.VersionTLS13: "TLSv1.3",
+}
+
+// NewConnectionProperties returns the properties of the connection given, negotiated during its handshake.
+func NewConnectionProperties(c *mysql.Conn) sql.ConnectionProperties {
+	props := sql.ConnectionProperties{
+		ProtocolVersion: protocolVersion,
+	}
+	if c.CharacterSet != 0 {
+		props.Collation = sql.CollationID(c.CharacterSet)
+		props.CharacterSet = props.Collation.CharacterSet()
+	}
+	if user, ok := c.UserData.(mysql_db.MysqlConnectionUser); ok {
+		props.AuthMethod = user.AuthMethod
+	}
+	if cc, ok := compressedConn(c.Conn); ok && cc.compressed() {
+		props.Compression = compressionAlgorithm
+	}
+	if hc, ok := handshakeConnOf(c.Conn); ok {
+		if state, ok := hc.tlsState(); ok {
+			props.TLS = true
+			props.TLSVersion = tlsVersionNames[state.Version]
+			props.TLSCipher = tls.CipherSuiteName(state.CipherSuite)
+		}
+	} else if tlsConn, ok := c.Conn.(*tls.Conn); ok {
+		state := tlsConn.ConnectionState()
+		props.TLS = true
+		props.TLSVersion = tlsVersionNames[state.Version]
+		props.TLSCipher = tls.CipherSuiteName(state.CipherSuite)
+	}
+	return props
 }
 
 // SessionManager is in charge of creating new sessions for the given
@@ -61,6 +109,15 @@ type SessionManager struct {
 	sessions    map[uint32]sql.Session
 	connections map[uint32]*mysql.Conn
 	lastPid     uint64
+	// defaultVars are the session variables set in every new session
+	defaultVars map[string]interface{}
+	// mysqlDb holds the users whose session variables are set in their new sessions, after defaultVars
+	mysqlDb *mysql_db.MySQLDb
+	// rowChanges is the feed the row changes of queries are reported to
+	rowChanges *sql.RowChangeFeed
+	// requireSecureTransport is whether connections must use TLS regardless of the require_secure_transport system
+	// variable
+	requireSecureTransport bool
 }
 
 // NewSessionManager creates a SessionManager with the given SessionBuilder.
@@ -109,12 +166,21 @@ func (s *SessionManager) AddConn(conn *mysql.Conn) {
 func (s *SessionManager) NewSession(ctx context.Context, conn *mysql.Conn) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	session, err := s.builder(ctx, conn, s.addr)
+	props := NewConnectionProperties(conn)
+	if !props.TLS && s.secureTransportRequired(conn) {
+		return sql.ErrSecureTransportRequired.New()
+	}
+	session, err := s.builder(ctx, conn, s.addr, props)
 	if err != nil {
 		return err
 	}
 
 	session.SetConnectionId(conn.ConnectionID)
+	session.SetConnectionProperties(props)
+
+	if err = s.setDefaultSessionVariables(ctx, session); err != nil {
+		return err
+	}
 
 	s.sessions[conn.ConnectionID] = session
 
@@ -132,6 +198,50 @@ func (s *SessionManager) NewSession(ctx context.Context, conn *mysql.Conn) error
 	return err
 }
 
+// secureTransportRequired returns whether the connection given must use TLS, either because the server was configured
+// to require it or because the require_secure_transport system variable is on. As in MySQL, connections over unix
+// sockets are considered secure.
+func (s *SessionManager) secureTransportRequired(conn *mysql.Conn) bool {
+	if addr := conn.RemoteAddr(); addr != nil && addr.Network() == "unix" {
+		return false
+	}
+	if s.requireSecureTransport {
+		return true
+	}
+	_, val, ok := sql.SystemVariables.GetGlobal("require_secure_transport")
+	return ok && val == int8(1)
+}
+
+// setDefaultSessionVariables sets the default session variables of the server in the new session given, followed by
+// those of its user, so that the user's override the server's.
+func (s *SessionManager) setDefaultSessionVariables(ctx context.Context, session sql.Session) error {
+	sqlCtx := sql.NewContext(ctx, sql.WithSession(session))
+	for name, val := range s.defaultVars {
+		if err := session.SetSessionVariable(sqlCtx, name, val); err != nil {
+			return err
+		}
+	}
+
+	if s.mysqlDb == nil || !s.mysqlDb.Enabled {
+		return nil
+	}
+	client := session.Client()
+	user := s.mysqlDb.GetUser(client.User, client.Address, false)
+	if user == nil {
+		return nil
+	}
+	userVars, err := user.SessionVariables()
+	if err != nil {
+		return err
+	}
+	for name, val := range userVars {
+		if err := session.SetSessionVariable(sqlCtx, name, val); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (s *SessionManager) SetDB(conn *mysql.Conn, db string) error {
 	sess, err := s.getOrCreateSession(context.Background(), conn)
 	if err != nil {
@@ -220,6 +330,7 @@ func (s *SessionManager) NewContextWithQuery(conn *mysql.Conn, query string) (*s
 		sql.WithQuery(query),
 		sql.WithMemoryManager(s.memory),
 		sql.WithProcessList(s.processlist),
+		sql.WithRowChangeFeed(s.rowChanges),
 		sql.WithRootSpan(span),
 		sql.WithServices(sql.Services{
 			KillConnection: s.KillConnection,
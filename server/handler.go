@@ -37,8 +37,10 @@ import (
 	"github.com/dolthub/go-mysql-server/internal/sockstate"
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/analyzer"
+	"github.com/dolthub/go-mysql-server/sql/digest"
 	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/parse"
+	"github.com/dolthub/go-mysql-server/sql/plan"
 	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
@@ -72,6 +74,7 @@ type Handler struct {
 	maxLoggedQueryLen int
 	encodeLoggedQuery bool
 	sel               ServerEventListener
+	slowQueryLogger   SlowQueryLogger
 }
 
 var _ mysql.Handler = (*Handler)(nil)
@@ -88,8 +91,12 @@ func (h *Handler) NewConnection(c *mysql.Conn) {
 	logrus.WithField(sql.ConnectionIdLogField, c.ConnectionID).WithField("DisableClientMultiStatements", c.DisableClientMultiStatements).Infof("NewConnection")
 }
 
+// ComInitDB implements mysql.Handler. It's first called once the client is authenticated, creating its session.
 func (h *Handler) ComInitDB(c *mysql.Conn, schemaName string) error {
-	return h.sm.SetDB(c, schemaName)
+	if err := h.sm.SetDB(c, schemaName); err != nil {
+		return sql.CastSQLError(err)
+	}
+	return nil
 }
 
 // ComPrepare parses, partially analyzes, and caches a prepared statement's plan
@@ -118,7 +125,19 @@ func (h *Handler) ComPrepare(c *mysql.Conn, query string) ([]*query.Field, error
 }
 
 func (h *Handler) ComStmtExecute(c *mysql.Conn, prepare *mysql.PrepareData, callback func(*sqltypes.Result) error) error {
-	_, err := h.errorWrappedDoQuery(c, prepare.PrepareStmt, MultiStmtModeOff, prepare.BindVars, func(res *sqltypes.Result, more bool) error {
+	return h.ComStmtExecuteWithAttributes(c, prepare, nil, callback)
+}
+
+// ComStmtExecuteWithAttributes executes a prepared statement like ComStmtExecute, with the query attributes sent by a
+// client with the CLIENT_QUERY_ATTRIBUTES capability. The attributes are the named parameters that follow the
+// statement's own parameters in COM_STMT_EXECUTE, keyed by name.
+func (h *Handler) ComStmtExecuteWithAttributes(
+	c *mysql.Conn,
+	prepare *mysql.PrepareData,
+	attributes map[string]*query.BindVariable,
+	callback func(*sqltypes.Result) error,
+) error {
+	_, err := h.errorWrappedDoQuery(c, prepare.PrepareStmt, MultiStmtModeOff, true, prepare.BindVars, queryAttributesFromBindVars(attributes), func(res *sqltypes.Result, more bool) error {
 		return callback(res)
 	})
 	return err
@@ -160,7 +179,7 @@ func (h *Handler) ComMultiQuery(
 	query string,
 	callback func(*sqltypes.Result, bool) error,
 ) (string, error) {
-	return h.errorWrappedDoQuery(c, query, MultiStmtModeOn, nil, callback)
+	return h.errorWrappedDoQuery(c, query, MultiStmtModeOn, false, nil, nil, callback)
 }
 
 // ComQuery executes a SQL query on the SQLe engine.
@@ -169,7 +188,24 @@ func (h *Handler) ComQuery(
 	query string,
 	callback func(*sqltypes.Result, bool) error,
 ) error {
-	_, err := h.errorWrappedDoQuery(c, query, MultiStmtModeOff, nil, callback)
+	_, err := h.errorWrappedDoQuery(c, query, MultiStmtModeOff, false, nil, nil, callback)
+	return err
+}
+
+// ComQueryWithAttributes executes a query like ComQuery, given the payload of the COM_QUERY packet sent by a client
+// with the CLIENT_QUERY_ATTRIBUTES capability following the command byte, in which the query is preceded by its
+// query attributes. The attributes are available to the query with MYSQL_QUERY_ATTRIBUTE_STRING, and to
+// integrators with sql.Context.QueryAttributes.
+func (h *Handler) ComQueryWithAttributes(
+	c *mysql.Conn,
+	payload []byte,
+	callback func(*sqltypes.Result, bool) error,
+) error {
+	attributes, query, err := ParseQueryAttributes(payload)
+	if err != nil {
+		return sql.CastSQLError(err)
+	}
+	_, err = h.errorWrappedDoQuery(c, query, MultiStmtModeOff, false, nil, attributes, callback)
 	return err
 }
 
@@ -285,15 +321,43 @@ func (h *Handler) doQuery(
 	c *mysql.Conn,
 	query string,
 	mode MultiStmtMode,
+	binary bool,
 	bindings map[string]*query.BindVariable,
+	attributes map[string]string,
 	callback func(*sqltypes.Result, bool) error,
-) (string, error) {
+) (remainder string, err error) {
 	ctx, err := h.sm.NewContext(c)
 	if err != nil {
 		return "", err
 	}
+	if attributes != nil {
+		ctx = ctx.WithQueryAttributes(attributes)
+	}
+
+	maxPacket, err := maxAllowedPacket(ctx)
+	if err != nil {
+		return "", err
+	}
+	if err = checkPacketSizes(query, bindings, maxPacket); err != nil {
+		return "", err
+	}
+
+	wasInTransaction, err := plan.InTransaction(ctx)
+	if err != nil {
+		return "", err
+	}
+	ctx.Session.ClearStateChanged()
+	// A statement that fails can still end the transaction, so the status flags are updated before the error is sent
+	flagsCtx := ctx
+	defer func() {
+		if err == nil {
+			return
+		}
+		if flagsErr := setConnStatusFlags(flagsCtx, c, wasInTransaction); flagsErr != nil {
+			flagsCtx.GetLogger().WithError(flagsErr).Warn("error setting status flags")
+		}
+	}()
 
-	var remainder string
 	var parsed sql.Node
 	if mode == MultiStmtModeOn {
 		var prequery string
@@ -344,6 +408,16 @@ func (h *Handler) doQuery(
 		}
 	}
 
+	// The rows a CALL statement returns are those of the last SELECT statement of the procedure, while MySQL sends a
+	// result set for each of them. They're written as the procedure runs, and the CALL statement itself then returns
+	// no rows. Vitess doesn't keep the CLIENT_MULTI_RESULTS capability of clients, which the client libraries of
+	// protocol 4.1 all set, so it's assumed.
+	var resultSets *resultSetWriter
+	if _, ok := parsed.(*plan.Call); ok {
+		resultSets = &resultSetWriter{c: c, binary: binary, maxPacket: maxPacket}
+		ctx = ctx.WithResultSetWriter(resultSets)
+	}
+
 	oCtx := ctx
 	eg, ctx := ctx.NewErrgroup()
 
@@ -362,13 +436,16 @@ func (h *Handler) doQuery(
 		return remainder, err
 	}
 
-	var rowChan chan sql.Row
+	var rowChan chan wireRow
+
+	rowChan = make(chan wireRow, 512)
 
-	rowChan = make(chan sql.Row, 512)
+	// Rows are converted to wire format before the next one is read, so the iterator can reuse them
+	sql.ReuseRows(rowIter)
 
 	wg := sync.WaitGroup{}
 	wg.Add(2)
-	// Read rows off the row iterator and send them to the row channel.
+	// Read rows off the row iterator, convert them to wire format and send them to the row channel.
 	eg.Go(func() error {
 		defer wg.Done()
 		defer close(rowChan)
@@ -384,8 +461,15 @@ func (h *Handler) doQuery(
 				if err != nil {
 					return err
 				}
+				var outputRow wireRow
+				if types.IsOkResult(row) {
+					okResult := row[0].(types.OkResult)
+					outputRow.okResult = &okResult
+				} else if outputRow.values, err = rowToSQL(ctx, schema, row); err != nil {
+					return err
+				}
 				select {
-				case rowChan <- row:
+				case rowChan <- outputRow:
 				case <-ctx.Done():
 					return nil
 				}
@@ -413,8 +497,7 @@ func (h *Handler) doQuery(
 	var r *sqltypes.Result
 	var processedAtLeastOneBatch bool
 
-	// reads rows from the channel, converts them to wire format,
-	// and calls |callback| to give them to vitess.
+	// reads rows from the channel and calls |callback| to give them to vitess.
 	eg.Go(func() error {
 		defer cancelF()
 		defer wg.Done()
@@ -439,21 +522,20 @@ func (h *Handler) doQuery(
 				if !ok {
 					return nil
 				}
-				if types.IsOkResult(row) {
+				if row.okResult != nil {
 					if len(r.Rows) > 0 {
 						panic("Got OkResult mixed with RowResult")
 					}
-					r = resultFromOkResult(row[0].(types.OkResult))
+					r = resultFromOkResult(*row.okResult)
 					continue
 				}
 
-				outputRow, err := rowToSQL(ctx, schema, row)
-				if err != nil {
-					return err
+				if rowSize(row.values) > maxPacket {
+					return sql.ErrPacketTooLarge.New()
 				}
 
-				ctx.GetLogger().Tracef("spooling result row %s", outputRow)
-				r.Rows = append(r.Rows, outputRow)
+				ctx.GetLogger().Tracef("spooling result row %s", row.values)
+				r.Rows = append(r.Rows, row.values)
 				r.RowsAffected++
 			case <-timer.C:
 				if h.readTimeout != 0 {
@@ -485,7 +567,11 @@ func (h *Handler) doQuery(
 	// errGroup context is now canceled
 	ctx = oCtx
 
-	if err = setConnStatusFlags(ctx, c); err != nil {
+	if h.slowQueryLogger != nil {
+		h.logSlowQuery(ctx, c, query, time.Since(start))
+	}
+
+	if err = setConnStatusFlags(ctx, c, wasInTransaction); err != nil {
 		return remainder, err
 	}
 
@@ -502,6 +588,11 @@ func (h *Handler) doQuery(
 
 	ctx.GetLogger().Debugf("Query finished in %d ms", time.Since(start).Milliseconds())
 
+	// The result of a CALL statement that wrote result sets is the OK packet that follows them
+	if resultSets != nil && resultSets.written > 0 {
+		return remainder, callback(&sqltypes.Result{}, more)
+	}
+
 	// processedAtLeastOneBatch means we already called callback() at least
 	// once, so no need to call it if RowsAffected == 0.
 	if r != nil && (r.RowsAffected == 0 && processedAtLeastOneBatch) {
@@ -511,8 +602,87 @@ func (h *Handler) doQuery(
 	return remainder, callback(r, more)
 }
 
+// wireRow is a row of the results of a query converted to wire format, or the OkResult of a statement returning one.
+type wireRow struct {
+	values   []sqltypes.Value
+	okResult *types.OkResult
+}
+
+// maxAllowedPacket returns the max_allowed_packet system variable of the session, which is the size in bytes of the
+// largest statement or parameter accepted from clients and of the largest result row sent to them.
+func maxAllowedPacket(ctx *sql.Context) (int, error) {
+	val, err := ctx.GetSessionVariable(ctx, "max_allowed_packet")
+	if err != nil {
+		return 0, err
+	}
+	return int(val.(int64)), nil
+}
+
+// checkPacketSizes returns sql.ErrPacketTooLarge if the query or one of the values bound to the parameters given are
+// larger than max_allowed_packet bytes. The values sent in several parts with COM_STMT_SEND_LONG_DATA are bound
+// whole.
+func checkPacketSizes(query string, bindings map[string]*query.BindVariable, maxPacket int) error {
+	if len(query) > maxPacket {
+		return sql.ErrPacketTooLarge.New()
+	}
+	for _, b := range bindings {
+		if len(b.Value) > maxPacket {
+			return sql.ErrPacketTooLarge.New()
+		}
+	}
+	return nil
+}
+
+// rowSize returns the size of the values of the row given as sent to clients.
+func rowSize(row []sqltypes.Value) int {
+	size := 0
+	for _, v := range row {
+		size += v.Len()
+	}
+	return size
+}
+
+// logSlowQuery passes the query given to the slow query logger if its duration exceeds the long_query_time system
+// variable of the session.
+func (h *Handler) logSlowQuery(ctx *sql.Context, c *mysql.Conn, query string, duration time.Duration) {
+	longQueryTime, err := ctx.GetSessionVariable(ctx, "long_query_time")
+	if err != nil {
+		ctx.GetLogger().WithError(err).Warn("error reading long_query_time")
+		return
+	}
+	threshold, ok := longQueryTime.(float64)
+	if !ok || duration.Seconds() <= threshold {
+		return
+	}
+
+	entry := SlowQueryLogEntry{
+		Query:    query,
+		User:     ctx.Session.Client().User,
+		Duration: duration,
+	}
+	if d, err := digest.FromQuery(query); err == nil {
+		entry.Digest = d.Hash
+		entry.DigestText = d.Text
+	}
+	// The process of the connection keeps the row counts of its last query until the next one begins
+	for _, p := range ctx.ProcessList.Processes() {
+		if p.Connection == c.ConnectionID {
+			entry.RowsExamined = p.RowsExamined
+			entry.RowsSent = p.RowsSent
+			break
+		}
+	}
+	h.slowQueryLogger.LogSlowQuery(entry)
+}
+
+// serverSessionStateChanged is the SERVER_SESSION_STATE_CHANGED status flag, which vitess doesn't define.
+const serverSessionStateChanged = 0x4000
+
+// setConnStatusFlags sets the status flags of the responses to the statement just executed: whether autocommit is on,
+// whether a transaction is open, and whether the state of the session changed, given whether a transaction was open
+// before the statement.
 // See https://dev.mysql.com/doc/internals/en/status-flags.html
-func setConnStatusFlags(ctx *sql.Context, c *mysql.Conn) error {
+func setConnStatusFlags(ctx *sql.Context, c *mysql.Conn, wasInTransaction bool) error {
 	ok, err := isSessionAutocommit(ctx)
 	if err != nil {
 		return err
@@ -523,12 +693,22 @@ func setConnStatusFlags(ctx *sql.Context, c *mysql.Conn) error {
 		c.StatusFlags &= ^uint16(mysql.ServerStatusAutocommit)
 	}
 
-	if t := ctx.GetTransaction(); t != nil {
+	inTransaction, err := plan.InTransaction(ctx)
+	if err != nil {
+		return err
+	}
+	if inTransaction {
 		c.StatusFlags |= uint16(mysql.ServerInTransaction)
 	} else {
 		c.StatusFlags &= ^uint16(mysql.ServerInTransaction)
 	}
 
+	if ctx.Session.StateChanged() || inTransaction != wasInTransaction {
+		c.StatusFlags |= serverSessionStateChanged
+	} else {
+		c.StatusFlags &= ^uint16(serverSessionStateChanged)
+	}
+
 	return nil
 }
 
@@ -545,7 +725,9 @@ func (h *Handler) errorWrappedDoQuery(
 	c *mysql.Conn,
 	query string,
 	mode MultiStmtMode,
+	binary bool,
 	bindings map[string]*query.BindVariable,
+	attributes map[string]string,
 	callback func(*sqltypes.Result, bool) error,
 ) (string, error) {
 	start := time.Now()
@@ -553,7 +735,7 @@ func (h *Handler) errorWrappedDoQuery(
 		h.sel.QueryStarted()
 	}
 
-	remainder, err := h.doQuery(c, query, mode, bindings, callback)
+	remainder, err := h.doQuery(c, query, mode, binary, bindings, attributes, callback)
 	if err != nil {
 		err = sql.CastSQLError(err)
 	}
@@ -620,6 +802,12 @@ func maybeGetTCPConn(conn net.Conn) (*net.TCPConn, bool) {
 	if ok {
 		conn = wrap.Conn
 	}
+	if cc, ok := conn.(*compressConn); ok {
+		conn = cc.Conn
+	}
+	if hc, ok := conn.(*handshakeConn); ok {
+		conn = hc.raw
+	}
 
 	tcp, ok := conn.(*net.TCPConn)
 	if ok {
@@ -694,22 +882,87 @@ func row2ToSQL(s sql.Schema, row sql.Row2) ([]sqltypes.Value, error) {
 func schemaToFields(ctx *sql.Context, s sql.Schema) []*query.Field {
 	fields := make([]*query.Field, len(s))
 	for i, c := range s {
-		var charset uint32 = mysql.CharacterSetUtf8
-		if types.IsBinaryType(c.Type) {
-			charset = mysql.CharacterSetBinary
-		}
-
 		fields[i] = &query.Field{
 			Name:         c.Name,
 			Type:         c.Type.Type(),
-			Charset:      charset,
+			Charset:      columnCharset(ctx, c),
 			ColumnLength: c.Type.MaxTextResponseByteLength(ctx),
+			Decimals:     columnDecimals(c),
+			Flags:        columnFlags(c),
+			Database:     c.DatabaseSource,
+			Table:        c.Source,
+			OrgTable:     c.Source,
+		}
+		if c.Origin != nil {
+			fields[i].Table = c.Origin.Table
+			fields[i].OrgTable = c.Origin.OriginalTable
+			fields[i].OrgName = c.Origin.OriginalName
+		} else if c.Source != "" {
+			// Only the columns of tables have an original name, the columns of expressions don't
+			fields[i].OrgName = c.Name
 		}
 	}
 
 	return fields
 }
 
+// columnCharset returns the collation sent to clients for the column given. As in MySQL, it's the collation of text
+// columns, or the default collation of the character_set_results system variable if their values are converted to
+// another character set, and the binary collation for other columns.
+func columnCharset(ctx *sql.Context, c *sql.Column) uint32 {
+	tc, ok := c.Type.(sql.TypeWithCollation)
+	if !ok || types.IsBinaryType(c.Type) {
+		return mysql.CharacterSetBinary
+	}
+	collation := tc.Collation()
+	if collation == sql.Collation_Unspecified {
+		collation = sql.Collation_Default
+	}
+	if results := ctx.GetCharacterSetResults(); results != sql.CharacterSet_Unspecified && results != sql.CharacterSet_binary && results != collation.CharacterSet() {
+		collation = results.DefaultCollation()
+	}
+	return uint32(collation)
+}
+
+// columnDecimals returns the number of decimals sent to clients for the column given, which is the scale of decimal
+// columns, the precision of the fractional seconds of temporal columns, and 31 for floating point columns, which
+// MySQL uses for numbers with no fixed number of decimals.
+func columnDecimals(c *sql.Column) uint32 {
+	switch c.Type.Type() {
+	case sqltypes.Decimal:
+		if dt, ok := c.Type.(sql.DecimalType); ok {
+			return uint32(dt.Scale())
+		}
+	case sqltypes.Float32, sqltypes.Float64:
+		return notFixedDecimals
+	case sqltypes.Datetime, sqltypes.Timestamp, sqltypes.Time:
+		return 6
+	}
+	return 0
+}
+
+// notFixedDecimals is the number of decimals of columns whose values have no fixed number of decimals.
+const notFixedDecimals = 31
+
+// columnFlags returns the flags of the column definition sent to clients for the column given, which are the flags of
+// its type along with its nullability and whether it's part of the primary key or auto incremented.
+func columnFlags(c *sql.Column) uint32 {
+	_, flags := sqltypes.TypeToMySQL(c.Type.Type())
+	if !c.Nullable {
+		flags |= int64(query.MySqlFlag_NOT_NULL_FLAG)
+	}
+	if c.PrimaryKey {
+		flags |= int64(query.MySqlFlag_PRI_KEY_FLAG)
+	}
+	if c.AutoIncrement {
+		flags |= int64(query.MySqlFlag_AUTO_INCREMENT_FLAG)
+	}
+	if types.IsTextBlob(c.Type) {
+		flags |= int64(query.MySqlFlag_BLOB_FLAG)
+	}
+	return uint32(flags)
+}
+
 var (
 	// QueryCounter describes a metric that accumulates number of queries monotonically.
 	QueryCounter = discard.NewCounter()
@@ -20,6 +20,7 @@ import (
 	"io/ioutil"
 	"net"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -35,6 +36,7 @@ import (
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/analyzer"
 	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/mysql_db"
 	"github.com/dolthub/go-mysql-server/sql/types"
 	"github.com/dolthub/go-mysql-server/sql/variables"
 )
@@ -159,6 +161,63 @@ func TestHandlerOutput(t *testing.T) {
 	}
 }
 
+// newTestHandler returns a handler of the engine given with the session builder of the tests.
+func newTestHandler(e *sqle.Engine) *Handler {
+	return &Handler{
+		e: e,
+		sm: NewSessionManager(
+			testSessionBuilder,
+			sql.NoopTracer,
+			func(ctx *sql.Context, db string) bool { return db == "test" },
+			sql.NewMemoryManager(nil),
+			sqle.NewProcessList(),
+			"foo",
+		),
+		readTimeout: time.Second,
+	}
+}
+
+// TestHandlerReusedRows checks that the rows the iterators of a query reuse aren't overwritten before they're sent.
+func TestHandlerReusedRows(t *testing.T) {
+	e := setupMemDB(require.New(t))
+	conn := newConn(1)
+	handler := newTestHandler(e)
+	handler.NewConnection(conn)
+	require.NoError(t, handler.ComInitDB(conn, "test"))
+
+	var rows [][]sqltypes.Value
+	err := handler.ComQuery(conn, "SELECT c1, c1 * 2 FROM test WHERE c1 % 3 = 0", func(res *sqltypes.Result, more bool) error {
+		rows = append(rows, res.Rows...)
+		return nil
+	})
+	require.NoError(t, err)
+
+	require.Len(t, rows, 337)
+	for i, row := range rows {
+		require.Equal(t, strconv.Itoa(i*3), row[0].ToString())
+		require.Equal(t, strconv.Itoa(i*6), row[1].ToString())
+	}
+}
+
+func BenchmarkHandlerRows(b *testing.B) {
+	e := setupMemDB(require.New(b))
+	conn := newConn(1)
+	handler := newTestHandler(e)
+	handler.NewConnection(conn)
+	require.NoError(b, handler.ComInitDB(conn, "test"))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		err := handler.ComQuery(conn, "SELECT c1, c1 * 2 FROM test WHERE c1 > 0", func(res *sqltypes.Result, more bool) error {
+			return nil
+		})
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
 func TestHandlerComPrepare(t *testing.T) {
 	e := setupMemDB(require.New(t))
 	dummyConn := newConn(1)
@@ -202,13 +261,13 @@ func TestHandlerComPrepare(t *testing.T) {
 			name:      "select statement returns non-nil schema",
 			statement: "select c1 from test where c1 > ?",
 			expected: []*query.Field{
-				{Name: "c1", Type: query.Type_INT32, Charset: mysql.CharacterSetUtf8, ColumnLength: 11},
+				{Name: "c1", Type: query.Type_INT32, Table: "test", OrgTable: "test", OrgName: "c1", Charset: mysql.CharacterSetBinary, ColumnLength: 11, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
 			},
 		},
 		{
 			name:        "errors are cast to SQLError",
 			statement:   "SELECT * from doesnotexist LIMIT ?",
-			expectedErr: mysql.NewSQLError(mysql.ERNoSuchTable, "", "table not found: %s", "doesnotexist"),
+			expectedErr: mysql.NewSQLError(mysql.ERNoSuchTable, mysql.SSUnknownTable, "table not found: %s", "doesnotexist"),
 		},
 	} {
 		t.Run(test.name, func(t *testing.T) {
@@ -267,7 +326,7 @@ func TestHandlerComPrepareExecute(t *testing.T) {
 				},
 			},
 			schema: []*query.Field{
-				{Name: "c1", Type: query.Type_INT32, Charset: mysql.CharacterSetUtf8, ColumnLength: 11},
+				{Name: "c1", Type: query.Type_INT32, Table: "test", OrgTable: "test", OrgName: "c1", Charset: mysql.CharacterSetBinary, ColumnLength: 11, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
 			},
 			expected: []sql.Row{
 				{0}, {1}, {2}, {3}, {4},
@@ -343,7 +402,7 @@ func TestHandlerComPrepareExecuteWithPreparedDisabled(t *testing.T) {
 				},
 			},
 			schema: []*query.Field{
-				{Name: "c1", Type: query.Type_INT32, Charset: mysql.CharacterSetUtf8, ColumnLength: 11},
+				{Name: "c1", Type: query.Type_INT32, Table: "test", OrgTable: "test", OrgName: "c1", Charset: mysql.CharacterSetBinary, ColumnLength: 11, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
 			},
 			expected: []sql.Row{
 				{0}, {1}, {2}, {3}, {4},
@@ -406,6 +465,132 @@ func (tl *TestListener) QueryCompleted(success bool, duration time.Duration) {
 	}
 }
 
+func TestHandlerQueryAttributes(t *testing.T) {
+	e := setupMemDB(require.New(t))
+	dummyConn := newConn(1)
+	handler := &Handler{
+		e: e,
+		sm: NewSessionManager(
+			testSessionBuilder,
+			sql.NoopTracer,
+			func(ctx *sql.Context, db string) bool { return db == "test" },
+			sql.NewMemoryManager(nil),
+			sqle.NewProcessList(),
+			"foo",
+		),
+	}
+	handler.NewConnection(dummyConn)
+	require.NoError(t, handler.ComInitDB(dummyConn, "test"))
+
+	const selectAttrs = "select mysql_query_attribute_string('traceparent'), mysql_query_attribute_string('n'), " +
+		"mysql_query_attribute_string('when'), mysql_query_attribute_string('none'), mysql_query_attribute_string('missing')"
+	var res [][]sqltypes.Value
+	callback := func(r *sqltypes.Result, more bool) error {
+		res = append(res, r.Rows...)
+		return nil
+	}
+	rowStrings := func() []string {
+		require.Len(t, res, 1)
+		var vals []string
+		for _, v := range res[0] {
+			if v.IsNull() {
+				vals = append(vals, "NULL")
+			} else {
+				vals = append(vals, v.ToString())
+			}
+		}
+		return vals
+	}
+
+	t.Run("COM_QUERY", func(t *testing.T) {
+		res = nil
+		payload := encodeQueryAttributes([]queryAttribute{
+			{name: "traceparent", typ: mysql.TypeVarString, value: lenEncString("00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01")},
+			{name: "n", typ: mysql.TypeLongLong, value: []byte{0xd6, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}},
+			{name: "when", typ: mysql.TypeDateTime, value: []byte{7, 0xe7, 0x07, 4, 12, 13, 14, 15}},
+			{name: "none", typ: mysql.TypeNull},
+		}, selectAttrs)
+		require.NoError(t, handler.ComQueryWithAttributes(dummyConn, payload, callback))
+		require.Equal(t, []string{"00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01", "-42", "2023-04-12 13:14:15", "NULL", "NULL"}, rowStrings())
+	})
+
+	t.Run("COM_QUERY without attributes", func(t *testing.T) {
+		res = nil
+		require.NoError(t, handler.ComQueryWithAttributes(dummyConn, encodeQueryAttributes(nil, selectAttrs), callback))
+		require.Equal(t, []string{"NULL", "NULL", "NULL", "NULL", "NULL"}, rowStrings())
+
+		// Attributes are only visible to the query they're sent with
+		res = nil
+		require.NoError(t, handler.ComQuery(dummyConn, selectAttrs, callback))
+		require.Equal(t, []string{"NULL", "NULL", "NULL", "NULL", "NULL"}, rowStrings())
+	})
+
+	t.Run("COM_STMT_EXECUTE", func(t *testing.T) {
+		res = nil
+		prepare := &mysql.PrepareData{
+			PrepareStmt: selectAttrs + ", ? + 1",
+			BindVars: map[string]*query.BindVariable{
+				"v1": {Type: query.Type_INT8, Value: []byte("5")},
+			},
+		}
+		_, err := handler.ComPrepare(dummyConn, prepare.PrepareStmt)
+		require.NoError(t, err)
+		err = handler.ComStmtExecuteWithAttributes(dummyConn, prepare, map[string]*query.BindVariable{
+			"traceparent": {Type: query.Type_VARCHAR, Value: []byte("abc")},
+			"n":           {Type: query.Type_INT64, Value: []byte("7")},
+			"none":        {Type: query.Type_NULL_TYPE},
+		}, func(r *sqltypes.Result) error {
+			return callback(r, false)
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"abc", "7", "NULL", "NULL", "NULL", "6"}, rowStrings())
+	})
+
+	t.Run("malformed attributes", func(t *testing.T) {
+		payload := encodeQueryAttributes([]queryAttribute{
+			{name: "n", typ: mysql.TypeLongLong, value: []byte{1, 2, 3}},
+		}, "")
+		err := handler.ComQueryWithAttributes(dummyConn, payload, callback)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "malformed query attributes")
+	})
+}
+
+// queryAttribute is a query attribute sent ahead of a query, with its value encoded in the binary protocol.
+type queryAttribute struct {
+	name  string
+	typ   byte
+	value []byte
+}
+
+// encodeQueryAttributes returns the payload of a COM_QUERY packet following the command byte, as sent by a client
+// with the CLIENT_QUERY_ATTRIBUTES capability.
+func encodeQueryAttributes(attrs []queryAttribute, query string) []byte {
+	payload := []byte{byte(len(attrs)), 1}
+	if len(attrs) > 0 {
+		nullBitmap := make([]byte, (len(attrs)+7)/8)
+		for i, attr := range attrs {
+			if attr.typ == mysql.TypeNull {
+				nullBitmap[i/8] |= 1 << (i % 8)
+			}
+		}
+		payload = append(payload, nullBitmap...)
+		payload = append(payload, 1)
+		for _, attr := range attrs {
+			payload = append(payload, attr.typ, 0)
+			payload = append(payload, lenEncString(attr.name)...)
+		}
+		for _, attr := range attrs {
+			payload = append(payload, attr.value...)
+		}
+	}
+	return append(payload, query...)
+}
+
+func lenEncString(s string) []byte {
+	return append([]byte{byte(len(s))}, s...)
+}
+
 func TestServerEventListener(t *testing.T) {
 	require := require.New(t)
 	e := setupMemDB(require)
@@ -413,7 +598,7 @@ func TestServerEventListener(t *testing.T) {
 	handler := &Handler{
 		e: e,
 		sm: NewSessionManager(
-			func(ctx context.Context, conn *mysql.Conn, addr string) (sql.Session, error) {
+			func(ctx context.Context, conn *mysql.Conn, addr string, props sql.ConnectionProperties) (sql.Session, error) {
 				return sql.NewBaseSessionWithClientServer(addr, sql.Client{Capabilities: conn.Capabilities}, conn.ConnectionID), nil
 			},
 			sql.NoopTracer,
@@ -486,6 +671,75 @@ func TestServerEventListener(t *testing.T) {
 	require.Equal(0, len(e.PreparedDataCache.GetSessionData(conn3.ConnectionID)))
 }
 
+type testSlowQueryLogger struct {
+	entries []SlowQueryLogEntry
+}
+
+func (l *testSlowQueryLogger) LogSlowQuery(entry SlowQueryLogEntry) {
+	l.entries = append(l.entries, entry)
+}
+
+func TestSlowQueryLogger(t *testing.T) {
+	require := require.New(t)
+	e := setupMemDB(require)
+	logger := &testSlowQueryLogger{}
+	handler := &Handler{
+		e: e,
+		sm: NewSessionManager(
+			func(ctx context.Context, conn *mysql.Conn, addr string, props sql.ConnectionProperties) (sql.Session, error) {
+				return sql.NewBaseSessionWithClientServer(addr, sql.Client{User: "root", Capabilities: conn.Capabilities}, conn.ConnectionID), nil
+			},
+			sql.NoopTracer,
+			func(ctx *sql.Context, db string) bool { return db == "test" },
+			e.MemoryManager,
+			e.ProcessList,
+			"foo",
+		),
+		slowQueryLogger: logger,
+	}
+
+	cb := func(res *sqltypes.Result, more bool) error {
+		return nil
+	}
+
+	conn := newConn(1)
+	handler.NewConnection(conn)
+	err := handler.sm.SetDB(conn, "test")
+	require.NoError(err)
+
+	// The default long_query_time is 10 seconds
+	err = handler.ComQuery(conn, "SELECT SLEEP(0.1)", cb)
+	require.NoError(err)
+	require.Empty(logger.entries)
+
+	err = handler.ComQuery(conn, "SET long_query_time = 0.05", cb)
+	require.NoError(err)
+	err = handler.ComQuery(conn, "SELECT 1", cb)
+	require.NoError(err)
+	require.Empty(logger.entries)
+
+	err = handler.ComQuery(conn, "SELECT SLEEP(0.1)", cb)
+	require.NoError(err)
+	require.Len(logger.entries, 1)
+	entry := logger.entries[0]
+	require.Equal("SELECT SLEEP(0.1)", entry.Query)
+	require.Equal("root", entry.User)
+	require.GreaterOrEqual(entry.Duration, 100*time.Millisecond)
+	require.Less(entry.Duration, 10*time.Second)
+	require.Equal(int64(1), entry.RowsSent)
+	require.Equal("SELECT `SLEEP` (?)", entry.DigestText)
+	require.Len(entry.Digest, 64)
+
+	err = handler.ComQuery(conn, "SELECT c1, SLEEP(0.05) FROM test WHERE c1 % 1000 = 1", cb)
+	require.NoError(err)
+	require.Len(logger.entries, 2)
+	entry = logger.entries[1]
+	require.GreaterOrEqual(entry.Duration, 100*time.Millisecond)
+	require.Equal(int64(1010), entry.RowsExamined)
+	require.Equal(int64(2), entry.RowsSent)
+	require.Equal("SELECT `c1` , `SLEEP` (?) FROM `test` WHERE `c1` % ? = ?", entry.DigestText)
+}
+
 func TestHandlerKill(t *testing.T) {
 	require := require.New(t)
 	e := setupMemDB(require)
@@ -493,7 +747,7 @@ func TestHandlerKill(t *testing.T) {
 	handler := &Handler{
 		e: e,
 		sm: NewSessionManager(
-			func(ctx context.Context, conn *mysql.Conn, addr string) (sql.Session, error) {
+			func(ctx context.Context, conn *mysql.Conn, addr string, props sql.ConnectionProperties) (sql.Session, error) {
 				return sql.NewBaseSessionWithClientServer(addr, sql.Client{Capabilities: conn.Capabilities}, conn.ConnectionID), nil
 			},
 			sql.NoopTracer,
@@ -541,9 +795,157 @@ func TestHandlerKill(t *testing.T) {
 	require.Len(handler.sm.sessions, 1)
 }
 
+func TestHandlerConnectionID(t *testing.T) {
+	require := require.New(t)
+	e := setupMemDB(require)
+
+	handler := &Handler{
+		e: e,
+		sm: NewSessionManager(
+			DefaultSessionBuilder,
+			sql.NoopTracer,
+			func(ctx *sql.Context, db string) bool { return db == "test" },
+			e.MemoryManager,
+			e.ProcessList,
+			"foo",
+		),
+	}
+
+	connectionID := func(conn *mysql.Conn) string {
+		var id string
+		err := handler.ComQuery(conn, "SELECT CONNECTION_ID()", func(res *sqltypes.Result, more bool) error {
+			require.Len(res.Rows, 1)
+			id = res.Rows[0][0].ToString()
+			return nil
+		})
+		require.NoError(err)
+		return id
+	}
+
+	conn1 := newConn(7)
+	handler.NewConnection(conn1)
+	require.NoError(handler.sm.SetDB(conn1, "test"))
+	conn2 := newConn(8)
+	handler.NewConnection(conn2)
+	require.NoError(handler.sm.SetDB(conn2, "test"))
+
+	require.Equal("7", connectionID(conn1))
+	require.Equal("8", connectionID(conn2))
+	require.Equal("7", connectionID(conn1))
+}
+
+func TestSessionConnectionProperties(t *testing.T) {
+	require := require.New(t)
+	e := setupMemDB(require)
+
+	var built sql.ConnectionProperties
+	sm := NewSessionManager(
+		func(ctx context.Context, conn *mysql.Conn, addr string, props sql.ConnectionProperties) (sql.Session, error) {
+			built = props
+			// The session manager sets the connection properties of sessions whose builder doesn't
+			return sql.NewBaseSessionWithClientServer(addr, sql.Client{Capabilities: conn.Capabilities}, conn.ConnectionID), nil
+		},
+		sql.NoopTracer,
+		func(ctx *sql.Context, db string) bool { return db == "test" },
+		e.MemoryManager,
+		e.ProcessList,
+		"foo",
+	)
+
+	conn := newConn(1)
+	conn.CharacterSet = uint8(sql.Collation_utf8mb4_general_ci)
+	conn.UserData = mysql_db.MysqlConnectionUser{User: "root", Host: "localhost", AuthMethod: mysql.MysqlNativePassword}
+	sm.AddConn(conn)
+	require.NoError(sm.NewSession(context.Background(), conn))
+
+	expected := sql.ConnectionProperties{
+		CharacterSet:    sql.CharacterSet_utf8mb4,
+		Collation:       sql.Collation_utf8mb4_general_ci,
+		AuthMethod:      mysql.MysqlNativePassword,
+		ProtocolVersion: 10,
+	}
+	require.Equal(expected, built)
+
+	ctx, err := sm.NewContext(conn)
+	require.NoError(err)
+	require.Equal(expected, ctx.Session.ConnectionProperties())
+}
+
+func TestSessionDefaultVariables(t *testing.T) {
+	require := require.New(t)
+	e := setupMemDB(require)
+	e.Analyzer.Catalog.MySQLDb.AddRootAccount()
+	e.Analyzer.Catalog.MySQLDb.SetPersister(&mysql_db.NoopPersister{})
+
+	handler := &Handler{
+		e: e,
+		sm: NewSessionManager(
+			DefaultSessionBuilder,
+			sql.NoopTracer,
+			func(ctx *sql.Context, db string) bool { return db == "test" },
+			e.MemoryManager,
+			e.ProcessList,
+			"foo",
+		),
+	}
+	handler.sm.defaultVars = map[string]interface{}{
+		"max_execution_time": 1000,
+		"time_zone":          "+01:00",
+	}
+	handler.sm.mysqlDb = e.Analyzer.Catalog.MySQLDb
+
+	showVariable := func(conn *mysql.Conn, name string) string {
+		var val string
+		err := handler.ComQuery(conn, fmt.Sprintf("SHOW VARIABLES LIKE '%s'", name), func(res *sqltypes.Result, more bool) error {
+			require.Len(res.Rows, 1)
+			val = res.Rows[0][1].ToString()
+			return nil
+		})
+		require.NoError(err)
+		return val
+	}
+
+	// The server's defaults apply to every user
+	rootConn := newConn(1)
+	rootConn.UserData = mysql_db.MysqlConnectionUser{User: "root", Host: "localhost"}
+	handler.NewConnection(rootConn)
+	require.NoError(handler.sm.SetDB(rootConn, "test"))
+	require.Equal("1000", showVariable(rootConn, "max_execution_time"))
+	require.Equal("+01:00", showVariable(rootConn, "time_zone"))
+
+	cb := func(res *sqltypes.Result, more bool) error {
+		return nil
+	}
+	err := handler.ComQuery(rootConn, `CREATE USER reporting@localhost ATTRIBUTE '{"session_variables": {"max_execution_time": 100}}'`, cb)
+	require.NoError(err)
+
+	// The user's defaults override the server's
+	reportingConn := newConn(2)
+	reportingConn.UserData = mysql_db.MysqlConnectionUser{User: "reporting", Host: "localhost"}
+	handler.NewConnection(reportingConn)
+	require.NoError(handler.sm.SetDB(reportingConn, "test"))
+	require.Equal("100", showVariable(reportingConn, "max_execution_time"))
+	require.Equal("+01:00", showVariable(reportingConn, "time_zone"))
+
+	// SET statements override both
+	err = handler.ComQuery(reportingConn, "SET max_execution_time = 5", cb)
+	require.NoError(err)
+	require.Equal("5", showVariable(reportingConn, "max_execution_time"))
+	require.Equal("1000", showVariable(rootConn, "max_execution_time"))
+}
+
 func TestSchemaToFields(t *testing.T) {
 	require := require.New(t)
 
+	const (
+		notNull  = uint32(query.MySqlFlag_NOT_NULL_FLAG)
+		blob     = uint32(query.MySqlFlag_BLOB_FLAG)
+		unsigned = uint32(query.MySqlFlag_UNSIGNED_FLAG)
+		binary   = uint32(query.MySqlFlag_BINARY_FLAG)
+		enum     = uint32(query.MySqlFlag_ENUM_FLAG)
+		set      = uint32(query.MySqlFlag_SET_FLAG)
+	)
+
 	schema := sql.Schema{
 		// Blob, Text, and JSON Types
 		{Name: "tinyblob", Type: types.TinyBlob},
@@ -601,57 +1003,57 @@ func TestSchemaToFields(t *testing.T) {
 
 	expected := []*query.Field{
 		// Blob, Text, and JSON Types
-		{Name: "tinyblob", Type: query.Type_BLOB, Charset: mysql.CharacterSetBinary, ColumnLength: 255},
-		{Name: "blob", Type: query.Type_BLOB, Charset: mysql.CharacterSetBinary, ColumnLength: 65_535},
-		{Name: "mediumblob", Type: query.Type_BLOB, Charset: mysql.CharacterSetBinary, ColumnLength: 16_777_215},
-		{Name: "longblob", Type: query.Type_BLOB, Charset: mysql.CharacterSetBinary, ColumnLength: 4_294_967_295},
-		{Name: "tinytext", Type: query.Type_TEXT, Charset: mysql.CharacterSetUtf8, ColumnLength: 1020},
-		{Name: "text", Type: query.Type_TEXT, Charset: mysql.CharacterSetUtf8, ColumnLength: 262_140},
-		{Name: "mediumtext", Type: query.Type_TEXT, Charset: mysql.CharacterSetUtf8, ColumnLength: 67_108_860},
-		{Name: "longtext", Type: query.Type_TEXT, Charset: mysql.CharacterSetUtf8, ColumnLength: 4_294_967_295},
-		{Name: "json", Type: query.Type_JSON, Charset: mysql.CharacterSetUtf8, ColumnLength: 4_294_967_295},
+		{Name: "tinyblob", Type: query.Type_BLOB, Charset: mysql.CharacterSetBinary, ColumnLength: 255, Flags: notNull | binary | blob},
+		{Name: "blob", Type: query.Type_BLOB, Charset: mysql.CharacterSetBinary, ColumnLength: 65_535, Flags: notNull | binary | blob},
+		{Name: "mediumblob", Type: query.Type_BLOB, Charset: mysql.CharacterSetBinary, ColumnLength: 16_777_215, Flags: notNull | binary | blob},
+		{Name: "longblob", Type: query.Type_BLOB, Charset: mysql.CharacterSetBinary, ColumnLength: 4_294_967_295, Flags: notNull | binary | blob},
+		{Name: "tinytext", Type: query.Type_TEXT, Charset: uint32(sql.Collation_Default), ColumnLength: 1020, Flags: notNull | blob},
+		{Name: "text", Type: query.Type_TEXT, Charset: uint32(sql.Collation_Default), ColumnLength: 262_140, Flags: notNull | blob},
+		{Name: "mediumtext", Type: query.Type_TEXT, Charset: uint32(sql.Collation_Default), ColumnLength: 67_108_860, Flags: notNull | blob},
+		{Name: "longtext", Type: query.Type_TEXT, Charset: uint32(sql.Collation_Default), ColumnLength: 4_294_967_295, Flags: notNull | blob},
+		{Name: "json", Type: query.Type_JSON, Charset: mysql.CharacterSetBinary, ColumnLength: 4_294_967_295, Flags: notNull},
 
 		// Geometry Types
-		{Name: "geometry", Type: query.Type_GEOMETRY, Charset: mysql.CharacterSetUtf8, ColumnLength: 4_294_967_295},
-		{Name: "point", Type: query.Type_GEOMETRY, Charset: mysql.CharacterSetUtf8, ColumnLength: 4_294_967_295},
-		{Name: "polygon", Type: query.Type_GEOMETRY, Charset: mysql.CharacterSetUtf8, ColumnLength: 4_294_967_295},
-		{Name: "linestring", Type: query.Type_GEOMETRY, Charset: mysql.CharacterSetUtf8, ColumnLength: 4_294_967_295},
+		{Name: "geometry", Type: query.Type_GEOMETRY, Charset: mysql.CharacterSetBinary, ColumnLength: 4_294_967_295, Flags: notNull},
+		{Name: "point", Type: query.Type_GEOMETRY, Charset: mysql.CharacterSetBinary, ColumnLength: 4_294_967_295, Flags: notNull},
+		{Name: "polygon", Type: query.Type_GEOMETRY, Charset: mysql.CharacterSetBinary, ColumnLength: 4_294_967_295, Flags: notNull},
+		{Name: "linestring", Type: query.Type_GEOMETRY, Charset: mysql.CharacterSetBinary, ColumnLength: 4_294_967_295, Flags: notNull},
 
 		// Integer Types
-		{Name: "uint8", Type: query.Type_UINT8, Charset: mysql.CharacterSetUtf8, ColumnLength: 3},
-		{Name: "int8", Type: query.Type_INT8, Charset: mysql.CharacterSetUtf8, ColumnLength: 4},
-		{Name: "uint16", Type: query.Type_UINT16, Charset: mysql.CharacterSetUtf8, ColumnLength: 5},
-		{Name: "int16", Type: query.Type_INT16, Charset: mysql.CharacterSetUtf8, ColumnLength: 6},
-		{Name: "uint24", Type: query.Type_UINT24, Charset: mysql.CharacterSetUtf8, ColumnLength: 8},
-		{Name: "int24", Type: query.Type_INT24, Charset: mysql.CharacterSetUtf8, ColumnLength: 9},
-		{Name: "uint32", Type: query.Type_UINT32, Charset: mysql.CharacterSetUtf8, ColumnLength: 10},
-		{Name: "int32", Type: query.Type_INT32, Charset: mysql.CharacterSetUtf8, ColumnLength: 11},
-		{Name: "uint64", Type: query.Type_UINT64, Charset: mysql.CharacterSetUtf8, ColumnLength: 20},
-		{Name: "int64", Type: query.Type_INT64, Charset: mysql.CharacterSetUtf8, ColumnLength: 20},
+		{Name: "uint8", Type: query.Type_UINT8, Charset: mysql.CharacterSetBinary, ColumnLength: 3, Flags: notNull | unsigned},
+		{Name: "int8", Type: query.Type_INT8, Charset: mysql.CharacterSetBinary, ColumnLength: 4, Flags: notNull},
+		{Name: "uint16", Type: query.Type_UINT16, Charset: mysql.CharacterSetBinary, ColumnLength: 5, Flags: notNull | unsigned},
+		{Name: "int16", Type: query.Type_INT16, Charset: mysql.CharacterSetBinary, ColumnLength: 6, Flags: notNull},
+		{Name: "uint24", Type: query.Type_UINT24, Charset: mysql.CharacterSetBinary, ColumnLength: 8, Flags: notNull | unsigned},
+		{Name: "int24", Type: query.Type_INT24, Charset: mysql.CharacterSetBinary, ColumnLength: 9, Flags: notNull},
+		{Name: "uint32", Type: query.Type_UINT32, Charset: mysql.CharacterSetBinary, ColumnLength: 10, Flags: notNull | unsigned},
+		{Name: "int32", Type: query.Type_INT32, Charset: mysql.CharacterSetBinary, ColumnLength: 11, Flags: notNull},
+		{Name: "uint64", Type: query.Type_UINT64, Charset: mysql.CharacterSetBinary, ColumnLength: 20, Flags: notNull | unsigned},
+		{Name: "int64", Type: query.Type_INT64, Charset: mysql.CharacterSetBinary, ColumnLength: 20, Flags: notNull},
 
 		// Floating Point and Decimal Types
-		{Name: "float32", Type: query.Type_FLOAT32, Charset: mysql.CharacterSetUtf8, ColumnLength: 12},
-		{Name: "float64", Type: query.Type_FLOAT64, Charset: mysql.CharacterSetUtf8, ColumnLength: 22},
-		{Name: "decimal10_0", Type: query.Type_DECIMAL, Charset: mysql.CharacterSetUtf8, ColumnLength: 11},
-		{Name: "decimal60_30", Type: query.Type_DECIMAL, Charset: mysql.CharacterSetUtf8, ColumnLength: 62},
+		{Name: "float32", Type: query.Type_FLOAT32, Charset: mysql.CharacterSetBinary, ColumnLength: 12, Decimals: 31, Flags: notNull},
+		{Name: "float64", Type: query.Type_FLOAT64, Charset: mysql.CharacterSetBinary, ColumnLength: 22, Decimals: 31, Flags: notNull},
+		{Name: "decimal10_0", Type: query.Type_DECIMAL, Charset: mysql.CharacterSetBinary, ColumnLength: 11, Flags: notNull},
+		{Name: "decimal60_30", Type: query.Type_DECIMAL, Charset: mysql.CharacterSetBinary, ColumnLength: 62, Decimals: 30, Flags: notNull},
 
 		// Char, Binary, and Bit Types
-		{Name: "varchar50", Type: query.Type_VARCHAR, Charset: mysql.CharacterSetUtf8, ColumnLength: 50 * 4},
-		{Name: "varbinary12345", Type: query.Type_VARBINARY, Charset: mysql.CharacterSetBinary, ColumnLength: 12345},
-		{Name: "binary123", Type: query.Type_BINARY, Charset: mysql.CharacterSetBinary, ColumnLength: 123},
-		{Name: "char123", Type: query.Type_CHAR, Charset: mysql.CharacterSetUtf8, ColumnLength: 123 * 4},
-		{Name: "bit12", Type: query.Type_BIT, Charset: mysql.CharacterSetUtf8, ColumnLength: 12},
+		{Name: "varchar50", Type: query.Type_VARCHAR, Charset: uint32(sql.Collation_Default), ColumnLength: 50 * 4, Flags: notNull},
+		{Name: "varbinary12345", Type: query.Type_VARBINARY, Charset: mysql.CharacterSetBinary, ColumnLength: 12345, Flags: notNull | binary},
+		{Name: "binary123", Type: query.Type_BINARY, Charset: mysql.CharacterSetBinary, ColumnLength: 123, Flags: notNull | binary},
+		{Name: "char123", Type: query.Type_CHAR, Charset: uint32(sql.Collation_Default), ColumnLength: 123 * 4, Flags: notNull},
+		{Name: "bit12", Type: query.Type_BIT, Charset: mysql.CharacterSetBinary, ColumnLength: 12, Flags: notNull | unsigned},
 
 		// Dates
-		{Name: "datetime", Type: query.Type_DATETIME, Charset: mysql.CharacterSetUtf8, ColumnLength: 26},
-		{Name: "timestamp", Type: query.Type_TIMESTAMP, Charset: mysql.CharacterSetUtf8, ColumnLength: 26},
-		{Name: "date", Type: query.Type_DATE, Charset: mysql.CharacterSetUtf8, ColumnLength: 10},
-		{Name: "time", Type: query.Type_TIME, Charset: mysql.CharacterSetUtf8, ColumnLength: 17},
-		{Name: "year", Type: query.Type_YEAR, Charset: mysql.CharacterSetUtf8, ColumnLength: 4},
+		{Name: "datetime", Type: query.Type_DATETIME, Charset: mysql.CharacterSetBinary, ColumnLength: 26, Decimals: 6, Flags: notNull | binary},
+		{Name: "timestamp", Type: query.Type_TIMESTAMP, Charset: mysql.CharacterSetBinary, ColumnLength: 26, Decimals: 6, Flags: notNull},
+		{Name: "date", Type: query.Type_DATE, Charset: mysql.CharacterSetBinary, ColumnLength: 10, Flags: notNull | binary},
+		{Name: "time", Type: query.Type_TIME, Charset: mysql.CharacterSetBinary, ColumnLength: 17, Decimals: 6, Flags: notNull | binary},
+		{Name: "year", Type: query.Type_YEAR, Charset: mysql.CharacterSetBinary, ColumnLength: 4, Flags: notNull | unsigned},
 
 		// Set and Enum Types
-		{Name: "set", Type: query.Type_SET, Charset: mysql.CharacterSetUtf8, ColumnLength: 72},
-		{Name: "enum", Type: query.Type_ENUM, Charset: mysql.CharacterSetUtf8, ColumnLength: 20},
+		{Name: "set", Type: query.Type_SET, Charset: uint32(sql.Collation_Default), ColumnLength: 72, Flags: notNull | set},
+		{Name: "enum", Type: query.Type_ENUM, Charset: uint32(sql.Collation_Default), ColumnLength: 20, Flags: notNull | enum},
 	}
 
 	require.Equal(len(schema), len(expected))
@@ -683,6 +1085,227 @@ func TestSchemaToFields(t *testing.T) {
 	}
 }
 
+// TestHandlerEmptyResultFields tests that the column definitions of results with no rows are the same as those of
+// results with rows of the same query.
+func TestHandlerEmptyResultFields(t *testing.T) {
+	handler := &Handler{
+		e: setupMemDB(require.New(t)),
+		sm: NewSessionManager(
+			testSessionBuilder,
+			sql.NoopTracer,
+			func(ctx *sql.Context, db string) bool { return db == "test" },
+			sql.NewMemoryManager(nil),
+			sqle.NewProcessList(),
+			"foo",
+		),
+		readTimeout: time.Second,
+	}
+	conn := newConn(1)
+	handler.NewConnection(conn)
+	handler.ComInitDB(conn, "test")
+
+	// fields returns the column definitions sent for the query given, and the number of rows sent
+	fields := func(t *testing.T, q string) ([]*query.Field, int) {
+		var fields []*query.Field
+		var rows int
+		err := handler.ComQuery(conn, q, func(res *sqltypes.Result, more bool) error {
+			fields = res.Fields
+			rows += len(res.Rows)
+			return nil
+		})
+		require.NoError(t, err)
+		return fields, rows
+	}
+
+	fields(t, "create table meta (pk int unsigned primary key, d decimal(10,3) not null, f double, v varchar(10), dt datetime)")
+	fields(t, "insert into meta values (1, 1.5, 2.5, 'a', '2023-01-01 00:00:00')")
+
+	expected, rows := fields(t, "select * from meta")
+	require.Equal(t, 1, rows)
+	require.Equal(t, []*query.Field{
+		{Name: "pk", Type: query.Type_UINT32, Table: "meta", OrgTable: "meta", OrgName: "pk", Charset: mysql.CharacterSetBinary, ColumnLength: 10, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG | query.MySqlFlag_PRI_KEY_FLAG | query.MySqlFlag_UNSIGNED_FLAG)},
+		{Name: "d", Type: query.Type_DECIMAL, Table: "meta", OrgTable: "meta", OrgName: "d", Charset: mysql.CharacterSetBinary, ColumnLength: 12, Decimals: 3, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
+		{Name: "f", Type: query.Type_FLOAT64, Table: "meta", OrgTable: "meta", OrgName: "f", Charset: mysql.CharacterSetBinary, ColumnLength: 22, Decimals: 31},
+		{Name: "v", Type: query.Type_VARCHAR, Table: "meta", OrgTable: "meta", OrgName: "v", Charset: uint32(sql.Collation_Default), ColumnLength: 40},
+		{Name: "dt", Type: query.Type_DATETIME, Table: "meta", OrgTable: "meta", OrgName: "dt", Charset: mysql.CharacterSetBinary, ColumnLength: 26, Decimals: 6, Flags: uint32(query.MySqlFlag_BINARY_FLAG)},
+	}, expected)
+
+	for _, q := range []string{
+		"select * from meta where 1 = 0",
+		"select * from meta limit 0",
+		"select * from meta where pk > 1",
+	} {
+		actual, rows := fields(t, q)
+		require.Zero(t, rows, q)
+		require.Equal(t, expected, actual, q)
+	}
+
+	prepared, err := handler.ComPrepare(conn, "select * from meta where pk > ?")
+	require.NoError(t, err)
+	require.Equal(t, expected, prepared)
+}
+
+// TestHandlerAliasedResultFields tests that the names and tables of the columns of results are sent as aliased by
+// queries, with the original names of the columns and of their tables.
+func TestHandlerAliasedResultFields(t *testing.T) {
+	handler := &Handler{
+		e: setupMemDB(require.New(t)),
+		sm: NewSessionManager(
+			testSessionBuilder,
+			sql.NoopTracer,
+			func(ctx *sql.Context, db string) bool { return db == "test" },
+			sql.NewMemoryManager(nil),
+			sqle.NewProcessList(),
+			"foo",
+		),
+		readTimeout: time.Second,
+	}
+	conn := newConn(1)
+	handler.NewConnection(conn)
+	handler.ComInitDB(conn, "test")
+
+	type field struct {
+		name, orgName, table, orgTable string
+	}
+	// fields returns the names and tables of the columns sent for the query given
+	fields := func(t *testing.T, q string) []field {
+		var fields []field
+		err := handler.ComQuery(conn, q, func(res *sqltypes.Result, more bool) error {
+			if fields == nil {
+				for _, f := range res.Fields {
+					fields = append(fields, field{f.Name, f.OrgName, f.Table, f.OrgTable})
+				}
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		return fields
+	}
+
+	fields(t, "create table mytable (a int primary key, b varchar(10))")
+	fields(t, "insert into mytable values (1, 'a')")
+	fields(t, "create view myview as select a, b as c from mytable")
+
+	tests := []struct {
+		query    string
+		expected []field
+	}{
+		{
+			query:    "select a, b from mytable",
+			expected: []field{{"a", "a", "mytable", "mytable"}, {"b", "b", "mytable", "mytable"}},
+		},
+		{
+			query:    "select t.a as x from mytable t",
+			expected: []field{{"x", "a", "t", "mytable"}},
+		},
+		{
+			query:    "select a as x, b from mytable",
+			expected: []field{{"x", "a", "mytable", "mytable"}, {"b", "b", "mytable", "mytable"}},
+		},
+		{
+			query:    "select * from mytable t",
+			expected: []field{{"a", "a", "t", "mytable"}, {"b", "b", "t", "mytable"}},
+		},
+		{
+			query:    "select t.b, a + 1 as y from mytable t",
+			expected: []field{{"b", "b", "t", "mytable"}, {"y", "", "", ""}},
+		},
+		{
+			query:    "select * from myview",
+			expected: []field{{"a", "a", "myview", "myview"}, {"c", "c", "myview", "myview"}},
+		},
+		{
+			query:    "select v.c as z from myview v",
+			expected: []field{{"z", "c", "v", "myview"}},
+		},
+		{
+			query:    "select * from (select a as x from mytable) dt",
+			expected: []field{{"x", "x", "dt", "dt"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			require.Equal(t, tt.expected, fields(t, tt.query))
+		})
+	}
+}
+
+// TestHandlerMaxAllowedPacket tests that statements, parameters and result rows larger than max_allowed_packet are
+// rejected.
+func TestHandlerMaxAllowedPacket(t *testing.T) {
+	handler := &Handler{
+		e: setupMemDB(require.New(t)),
+		sm: NewSessionManager(
+			testSessionBuilder,
+			sql.NoopTracer,
+			func(ctx *sql.Context, db string) bool { return db == "test" },
+			sql.NewMemoryManager(nil),
+			sqle.NewProcessList(),
+			"foo",
+		),
+		readTimeout: time.Second,
+	}
+	conn := newConn(1)
+	handler.NewConnection(conn)
+	handler.ComInitDB(conn, "test")
+
+	noop := func(res *sqltypes.Result, more bool) error { return nil }
+	requirePacketTooLarge := func(t *testing.T, err error) {
+		require.Error(t, err)
+		sqlErr, ok := err.(*mysql.SQLError)
+		require.True(t, ok)
+		require.Equal(t, mysql.ERNetPacketTooLarge, sqlErr.Number())
+		require.Equal(t, mysql.SSNetError, sqlErr.SQLState())
+	}
+
+	require.NoError(t, handler.ComQuery(conn, "create table packets (pk int primary key, v longtext)", noop))
+	require.NoError(t, handler.ComQuery(conn, "set max_allowed_packet = 1024", noop))
+
+	t.Run("statements", func(t *testing.T) {
+		require.NoError(t, handler.ComQuery(conn, fmt.Sprintf("insert into packets values (1, '%s')", strings.Repeat("a", 900)), noop))
+		err := handler.ComQuery(conn, fmt.Sprintf("insert into packets values (2, '%s')", strings.Repeat("a", 1024)), noop)
+		requirePacketTooLarge(t, err)
+	})
+
+	t.Run("prepared statement parameters", func(t *testing.T) {
+		prepare := &mysql.PrepareData{
+			PrepareStmt: "insert into packets values (?, ?)",
+			BindVars: map[string]*query.BindVariable{
+				"v1": {Type: query.Type_INT32, Value: []byte("3")},
+				"v2": {Type: query.Type_VARCHAR, Value: []byte(strings.Repeat("a", 1025))},
+			},
+		}
+		_, err := handler.ComPrepare(conn, prepare.PrepareStmt)
+		require.NoError(t, err)
+		err = handler.ComStmtExecute(conn, prepare, func(*sqltypes.Result) error { return nil })
+		requirePacketTooLarge(t, err)
+
+		prepare.BindVars["v2"].Value = []byte(strings.Repeat("a", 1000))
+		require.NoError(t, handler.ComStmtExecute(conn, prepare, func(*sqltypes.Result) error { return nil }))
+	})
+
+	t.Run("result rows", func(t *testing.T) {
+		var rows int
+		require.NoError(t, handler.ComQuery(conn, "select v from packets where pk = 1", func(res *sqltypes.Result, more bool) error {
+			rows += len(res.Rows)
+			return nil
+		}))
+		require.Equal(t, 1, rows)
+
+		err := handler.ComQuery(conn, "select concat(v, v) from packets where pk = 1", noop)
+		requirePacketTooLarge(t, err)
+	})
+
+	t.Run("count", func(t *testing.T) {
+		var res *sqltypes.Result
+		require.NoError(t, handler.ComQuery(conn, "select count(*) from packets", func(r *sqltypes.Result, more bool) error {
+			res = r
+			return nil
+		}))
+		require.Equal(t, "2", res.Rows[0][0].ToString())
+	})
+}
+
 // TestHandlerMaxTextResponseBytes tests that the handler calculates the correct max text response byte
 // metadata for TEXT types, including honoring the character_set_results session variable. This is tested
 // here, instead of in string type unit tests, because of the dependency on system variables being loaded.
@@ -1080,7 +1703,7 @@ func brokenTestServer(t *testing.T, ready chan struct{}, port string) {
 
 // This session builder is used as dummy mysql Conn is not complete and
 // causes panic when accessing remote address.
-func testSessionBuilder(ctx context.Context, c *mysql.Conn, addr string) (sql.Session, error) {
+func testSessionBuilder(ctx context.Context, c *mysql.Conn, addr string, props sql.ConnectionProperties) (sql.Session, error) {
 	return sql.NewBaseSessionWithClientServer(addr, sql.Client{Address: "127.0.0.1:34567", User: c.User, Capabilities: c.Capabilities}, c.ConnectionID), nil
 }
 
@@ -0,0 +1,305 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"sync"
+
+	"github.com/dolthub/vitess/go/mysql"
+	"github.com/dolthub/vitess/go/netutil"
+)
+
+// sslRequestLength is the length of the payload of the SSL request a client sends in place of its handshake response
+// to upgrade the connection to TLS, which is the beginning of a handshake response.
+const sslRequestLength = handshakeResponseHeaderLength
+
+// errClearTextWithoutTLS is the error of connections closed for using clear text authentication without TLS.
+var errClearTextWithoutTLS = errors.New("clear text authentication over a connection without TLS")
+
+// handshakeListener is a net.Listener whose connections are handshakeConns.
+type handshakeListener struct {
+	net.Listener
+	tlsConfig                *tls.Config
+	allowClearTextWithoutTLS bool
+}
+
+// newHandshakeListener returns a listener of the connections of the listener given, which are upgraded to TLS with
+// the config given if their clients ask for it. Clear text authentication is refused over connections that aren't
+// upgraded, unless |allowClearTextWithoutTLS| is set.
+func newHandshakeListener(l net.Listener, tlsConfig *tls.Config, allowClearTextWithoutTLS bool) net.Listener {
+	return handshakeListener{Listener: l, tlsConfig: tlsConfig, allowClearTextWithoutTLS: allowClearTextWithoutTLS}
+}
+
+// Accept implements net.Listener
+func (l handshakeListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	return &handshakeConn{
+		Conn:                     conn,
+		raw:                      conn,
+		tlsConfig:                l.tlsConfig,
+		allowClearTextWithoutTLS: l.allowClearTextWithoutTLS,
+	}, nil
+}
+
+// handshakeConn is a server connection of the MySQL protocol that keeps what vitess doesn't of the handshake of its
+// client: the connection attributes of its handshake response, and its TLS connection. Vitess upgrades connections to
+// TLS above the net.Conn it's given, so the handshake response that follows the upgrade is never seen in the clear
+// below it. A handshakeConn does the upgrade itself instead: it advertises CLIENT_SSL in the initial handshake,
+// consumes the SSL request of the client and hands vitess the rest of the handshake as if it were sent in the clear,
+// with the sequence numbers of its packets shifted to account for the SSL request. The packets that follow the
+// handshake are passed through.
+type handshakeConn struct {
+	// Conn is the connection the packets are read from and written to, which is tlsConn once the connection is
+	// upgraded
+	net.Conn
+	// raw is the connection accepted
+	raw                      net.Conn
+	tlsConfig                *tls.Config
+	allowClearTextWithoutTLS bool
+
+	mu sync.Mutex
+	// done is whether the handshake ended, after which packets are passed through
+	done bool
+	// tlsConn is the TLS connection the connection was upgraded to, if it was
+	tlsConn *tls.Conn
+	// attributes are the connection attributes of the handshake response of the client
+	attributes map[string]string
+
+	// greetingWritten is whether the initial handshake packet was sent
+	greetingWritten bool
+	// responseRead is whether the handshake response of the client was read
+	responseRead bool
+	// seqOffset is the number of packets of the handshake that vitess doesn't see, which is 1 for the SSL request of
+	// connections upgraded to TLS
+	seqOffset byte
+	// read are the bytes of the packets of the handshake read that haven't been returned yet
+	read bytes.Buffer
+	// written are the bytes of the packets of the handshake sent that haven't been written yet, as they're only
+	// written in whole packets
+	written []byte
+}
+
+// connectAttributes returns the connection attributes the client sent in its handshake response, or nil if it sent
+// none.
+func (c *handshakeConn) connectAttributes() map[string]string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.attributes
+}
+
+// tlsState returns the state of the TLS connection the connection was upgraded to, if it was.
+func (c *handshakeConn) tlsState() (tls.ConnectionState, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.tlsConn == nil {
+		return tls.ConnectionState{}, false
+	}
+	return c.tlsConn.ConnectionState(), true
+}
+
+func (c *handshakeConn) handshakeDone() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.done
+}
+
+// Read implements net.Conn
+func (c *handshakeConn) Read(p []byte) (int, error) {
+	if c.read.Len() > 0 {
+		return c.read.Read(p)
+	}
+	if c.handshakeDone() {
+		return c.Conn.Read(p)
+	}
+
+	packet, err := c.readPacket()
+	if err != nil {
+		return 0, err
+	}
+	c.mu.Lock()
+	first := !c.responseRead
+	c.mu.Unlock()
+	if first {
+		payload := packet[4:]
+		if len(payload) == sslRequestLength && c.tlsConfig != nil &&
+			binary.LittleEndian.Uint32(payload)&mysql.CapabilityClientSSL != 0 {
+			if err = c.upgrade(); err != nil {
+				return 0, err
+			}
+			if packet, err = c.readPacket(); err != nil {
+				return 0, err
+			}
+		}
+		c.mu.Lock()
+		c.responseRead = true
+		c.attributes = parseConnectAttributes(packet[4:])
+		c.mu.Unlock()
+	}
+
+	c.mu.Lock()
+	packet[3] -= c.seqOffset
+	c.mu.Unlock()
+	c.read.Write(packet)
+	return c.read.Read(p)
+}
+
+// readPacket reads a whole packet, with its header.
+func (c *handshakeConn) readPacket() ([]byte, error) {
+	var header [4]byte
+	if _, err := io.ReadFull(c.Conn, header[:]); err != nil {
+		return nil, err
+	}
+	packet := make([]byte, 4+packetLength(header[:]))
+	copy(packet, header[:])
+	if _, err := io.ReadFull(c.Conn, packet[4:]); err != nil {
+		return nil, err
+	}
+	return packet, nil
+}
+
+// upgrade upgrades the connection to TLS, following the SSL request of the client.
+func (c *handshakeConn) upgrade() error {
+	tlsConn := tls.Server(c.raw, c.tlsConfig)
+	if err := tlsConn.Handshake(); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.Conn = tlsConn
+	c.tlsConn = tlsConn
+	c.seqOffset = 1
+	return nil
+}
+
+// Write implements net.Conn
+func (c *handshakeConn) Write(p []byte) (int, error) {
+	if c.handshakeDone() {
+		return c.Conn.Write(p)
+	}
+
+	c.written = append(c.written, p...)
+	for len(c.written) >= 4 {
+		length := packetLength(c.written)
+		if len(c.written) < 4+length {
+			break
+		}
+		packet := c.written[:4+length]
+
+		c.mu.Lock()
+		first := !c.greetingWritten
+		c.greetingWritten = true
+		packet[3] += c.seqOffset
+		tlsUpgraded := c.tlsConn != nil
+		// The handshake ends with the OK or error packet sent once the client is authenticated, or isn't
+		if c.responseRead && length > 0 && (packet[4] == mysql.OKPacket || packet[4] == mysql.ErrPacket) {
+			c.done = true
+		}
+		c.mu.Unlock()
+
+		if first && c.tlsConfig != nil {
+			advertiseCapability(packet[4:], mysql.CapabilityClientSSL)
+		}
+		if !tlsUpgraded && !c.allowClearTextWithoutTLS && isClearTextAuthSwitch(packet[4:]) {
+			// Vitess is told connections may use clear text authentication, as it doesn't know of the upgrades
+			c.written = nil
+			if _, err := c.Conn.Write(clearTextRefusedPacket(packet[3])); err != nil {
+				return 0, err
+			}
+			return 0, errClearTextWithoutTLS
+		}
+
+		if _, err := c.Conn.Write(packet); err != nil {
+			return 0, err
+		}
+		c.written = c.written[4+length:]
+		if c.handshakeDone() {
+			rest := c.written
+			c.written = nil
+			if len(rest) > 0 {
+				if _, err := c.Conn.Write(rest); err != nil {
+					return 0, err
+				}
+			}
+			break
+		}
+	}
+	return len(p), nil
+}
+
+// isClearTextAuthSwitch returns whether the payload given is that of an auth switch request to an authentication
+// method that sends the password in the clear.
+func isClearTextAuthSwitch(payload []byte) bool {
+	if len(payload) == 0 || payload[0] != mysql.AuthSwitchRequestPacket {
+		return false
+	}
+	method := payload[1:]
+	if end := bytes.IndexByte(method, 0); end >= 0 {
+		method = method[:end]
+	}
+	return string(method) == mysql.MysqlClearPassword || string(method) == mysql.MysqlDialog
+}
+
+// clearTextRefusedPacket returns the error packet with the sequence number given that refuses clear text
+// authentication, as vitess sends it.
+func clearTextRefusedPacket(seq byte) []byte {
+	const message = "Cannot use clear text authentication over non-SSL connections."
+	payload := make([]byte, 0, 9+len(message))
+	payload = append(payload, mysql.ErrPacket)
+	payload = binary.LittleEndian.AppendUint16(payload, mysql.CRServerHandshakeErr)
+	payload = append(payload, '#')
+	payload = append(payload, mysql.SSUnknownSQLState...)
+	payload = append(payload, message...)
+	packet := []byte{byte(len(payload)), byte(len(payload) >> 8), byte(len(payload) >> 16), seq}
+	return append(packet, payload...)
+}
+
+// advertiseCapability sets the capability flag given, which must be one of the lower 16 flags, in the capabilities of
+// the initial handshake packet given, without its header.
+func advertiseCapability(handshake []byte, capability uint16) {
+	// The capabilities follow the protocol version, the server version, the connection ID, the first part of the salt
+	// and a filler
+	end := bytes.IndexByte(handshake, 0)
+	if end < 0 {
+		return
+	}
+	pos := end + 1 + 4 + 8 + 1
+	if len(handshake) < pos+2 {
+		return
+	}
+	capabilities := binary.LittleEndian.Uint16(handshake[pos:])
+	binary.LittleEndian.PutUint16(handshake[pos:], capabilities|capability)
+}
+
+// handshakeConnOf returns the handshakeConn of the connection given, if it has one, possibly wrapped with compression
+// and timeouts.
+func handshakeConnOf(conn net.Conn) (*handshakeConn, bool) {
+	if wrap, ok := conn.(netutil.ConnWithTimeouts); ok {
+		conn = wrap.Conn
+	}
+	if cc, ok := conn.(*compressConn); ok {
+		conn = cc.Conn
+	}
+	hc, ok := conn.(*handshakeConn)
+	return hc, ok
+}
@@ -0,0 +1,411 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/binary"
+	"strings"
+	"testing"
+
+	"github.com/dolthub/vitess/go/mysql"
+	"github.com/dolthub/vitess/go/vt/proto/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestProtocolHandshake(t *testing.T) {
+	addr := newRawTestServer(t)
+	c := dialRaw(t, addr, "root", "mydb", mysql.CapabilityClientDeprecateEOF)
+
+	assert.EqualValues(t, 10, c.handshake.protocolVersion)
+	assert.NotEmpty(t, c.handshake.serverVersion)
+	assert.Equal(t, mysql.MysqlNativePassword, c.handshake.authPluginName)
+	assert.NotZero(t, c.handshake.capabilities&mysql.CapabilityClientProtocol41)
+	assert.NotZero(t, c.handshake.capabilities&mysql.CapabilityClientDeprecateEOF)
+	assert.NotZero(t, c.capabilities&mysql.CapabilityClientDeprecateEOF)
+}
+
+func TestProtocolColumnDefinitionFlags(t *testing.T) {
+	const (
+		notNull       = uint16(query.MySqlFlag_NOT_NULL_FLAG)
+		priKey        = uint16(query.MySqlFlag_PRI_KEY_FLAG)
+		blob          = uint16(query.MySqlFlag_BLOB_FLAG)
+		unsigned      = uint16(query.MySqlFlag_UNSIGNED_FLAG)
+		binary        = uint16(query.MySqlFlag_BINARY_FLAG)
+		enum          = uint16(query.MySqlFlag_ENUM_FLAG)
+		autoIncrement = uint16(query.MySqlFlag_AUTO_INCREMENT_FLAG)
+	)
+
+	addr := newRawTestServer(t)
+	c := dialRaw(t, addr, "root", "mydb", mysql.CapabilityClientDeprecateEOF)
+	for _, q := range []string{
+		"create table t (id int unsigned auto_increment primary key, name varchar(20) not null, note text, data varbinary(10), n bigint, e enum('a', 'b'))",
+		"insert into t (name, note, data, n, e) values ('x', null, 0x01, -1, 'a')",
+	} {
+		_, err := c.query(q)
+		require.NoError(t, err, q)
+	}
+
+	res, err := c.query("select * from t")
+	require.NoError(t, err)
+	require.Len(t, res.columns, 6)
+
+	tests := []struct {
+		name  string
+		typ   byte
+		flags uint16
+	}{
+		{"id", 3, notNull | priKey | autoIncrement | unsigned},
+		{"name", 253, notNull},
+		{"note", 252, blob},
+		{"data", 253, binary},
+		{"n", 8, 0},
+		{"e", 254, enum},
+	}
+	for i, test := range tests {
+		col := res.columns[i]
+		assert.Equal(t, test.name, col.name)
+		assert.Equal(t, test.typ, col.typ, test.name)
+		assert.Equal(t, test.flags, col.flags, test.name)
+	}
+
+	one := "1"
+	require.Len(t, res.rows, 1)
+	assert.Equal(t, &one, res.rows[0][0])
+	assert.Nil(t, res.rows[0][2])
+}
+
+func TestProtocolDeprecateEOF(t *testing.T) {
+	addr := newRawTestServer(t)
+
+	t.Run("negotiated", func(t *testing.T) {
+		c := dialRaw(t, addr, "root", "mydb", mysql.CapabilityClientDeprecateEOF)
+		res, err := c.query("select 1")
+		require.NoError(t, err)
+		assert.False(t, res.eofAfterColumns)
+		assert.False(t, res.endIsEOF)
+		assert.Len(t, res.rows, 1)
+	})
+
+	t.Run("not negotiated", func(t *testing.T) {
+		c := dialRaw(t, addr, "root", "mydb", 0)
+		require.Zero(t, c.capabilities&mysql.CapabilityClientDeprecateEOF)
+		res, err := c.query("select 1")
+		require.NoError(t, err)
+		assert.True(t, res.eofAfterColumns)
+		assert.True(t, res.endIsEOF)
+		assert.Len(t, res.rows, 1)
+	})
+}
+
+// testTransactionSession is a session supporting transactions, which don't isolate anything, to test how transactions
+// are reported to clients.
+type testTransactionSession struct {
+	*sql.BaseSession
+}
+
+var _ sql.TransactionSession = testTransactionSession{}
+
+func testTransactionSessionBuilder(ctx context.Context, c *mysql.Conn, addr string, props sql.ConnectionProperties) (sql.Session, error) {
+	s, err := DefaultSessionBuilder(ctx, c, addr, props)
+	if err != nil {
+		return nil, err
+	}
+	return testTransactionSession{s.(*sql.BaseSession)}, nil
+}
+
+func (s testTransactionSession) StartTransaction(*sql.Context, sql.TransactionCharacteristic) (sql.Transaction, error) {
+	return testTransaction{}, nil
+}
+
+func (s testTransactionSession) CommitTransaction(*sql.Context, sql.Transaction) error {
+	return nil
+}
+
+func (s testTransactionSession) Rollback(*sql.Context, sql.Transaction) error {
+	return nil
+}
+
+func (s testTransactionSession) CreateSavepoint(*sql.Context, sql.Transaction, string) error {
+	return nil
+}
+
+func (s testTransactionSession) RollbackToSavepoint(*sql.Context, sql.Transaction, string) error {
+	return nil
+}
+
+func (s testTransactionSession) ReleaseSavepoint(*sql.Context, sql.Transaction, string) error {
+	return nil
+}
+
+type testTransaction struct{}
+
+func (testTransaction) String() string {
+	return "testTransaction"
+}
+
+func (testTransaction) IsReadOnly() bool {
+	return false
+}
+
+// statusFlagsStep is a statement of a script checking the status flags of the responses to its statements.
+type statusFlagsStep struct {
+	query         string
+	err           bool
+	autocommit    bool
+	inTransaction bool
+	stateChanged  bool
+}
+
+func testStatusFlags(t *testing.T, addr string, steps []statusFlagsStep) {
+	for _, capabilities := range []uint32{0, mysql.CapabilityClientDeprecateEOF} {
+		c := dialRaw(t, addr, "root", "mydb", capabilities)
+		for _, step := range steps {
+			res, err := c.query(step.query)
+			if step.err {
+				require.Error(t, err, step.query)
+				continue
+			}
+			require.NoError(t, err, step.query)
+			status := res.end.statusFlags
+			assert.Equal(t, step.autocommit, status&mysql.ServerStatusAutocommit != 0, "autocommit after %s", step.query)
+			assert.Equal(t, step.inTransaction, status&mysql.ServerInTransaction != 0, "in transaction after %s", step.query)
+			assert.Equal(t, step.stateChanged, status&serverSessionStateChanged != 0, "state changed after %s", step.query)
+		}
+	}
+}
+
+func TestProtocolStatusFlags(t *testing.T) {
+	t.Run("session without transactions", func(t *testing.T) {
+		addr := newRawTestServer(t)
+		testStatusFlags(t, addr, []statusFlagsStep{
+			{query: "create table if not exists t (i int primary key)", autocommit: true},
+			{query: "select 1", autocommit: true},
+			{query: "begin", autocommit: true, inTransaction: true, stateChanged: true},
+			{query: "select 1", autocommit: true, inTransaction: true},
+			{query: "select * from doesnotexist", err: true},
+			{query: "select 1", autocommit: true, inTransaction: true},
+			{query: "commit", autocommit: true, stateChanged: true},
+			{query: "start transaction", autocommit: true, inTransaction: true, stateChanged: true},
+			{query: "rollback", autocommit: true, stateChanged: true},
+			// DDL statements cause an implicit commit
+			{query: "begin", autocommit: true, inTransaction: true, stateChanged: true},
+			{query: "create table if not exists u (i int primary key)", autocommit: true, stateChanged: true},
+			{query: "begin", autocommit: true, inTransaction: true, stateChanged: true},
+			{query: "lock tables t read", autocommit: true, stateChanged: true},
+			{query: "unlock tables", autocommit: true},
+			{query: "set autocommit = 0", stateChanged: true},
+			{query: "select 1"},
+			{query: "set autocommit = 1", autocommit: true, stateChanged: true},
+			{query: "use mydb", autocommit: true},
+			{query: "use information_schema", autocommit: true, stateChanged: true},
+			{query: "use mydb", autocommit: true, stateChanged: true},
+		})
+	})
+
+	t.Run("session with transactions", func(t *testing.T) {
+		addr := newRawTestServerWithSessions(t, testTransactionSessionBuilder)
+		testStatusFlags(t, addr, []statusFlagsStep{
+			{query: "create table if not exists t (i int primary key)", autocommit: true},
+			{query: "select * from t", autocommit: true},
+			{query: "begin", autocommit: true, inTransaction: true, stateChanged: true},
+			{query: "select * from t", autocommit: true, inTransaction: true},
+			{query: "commit", autocommit: true, stateChanged: true},
+			// Statements implicitly start a transaction when autocommit is off
+			{query: "set autocommit = 0", inTransaction: true, stateChanged: true},
+			{query: "select * from t", inTransaction: true},
+			{query: "commit", stateChanged: true},
+			{query: "select * from t", inTransaction: true, stateChanged: true},
+			{query: "rollback", stateChanged: true},
+			{query: "select * from t", inTransaction: true, stateChanged: true},
+			{query: "create table if not exists u (i int primary key)", stateChanged: true},
+			{query: "select * from t", inTransaction: true, stateChanged: true},
+			{query: "lock tables t read", stateChanged: true},
+			{query: "unlock tables", inTransaction: true, stateChanged: true},
+			// Turning autocommit back on commits the transaction
+			{query: "set autocommit = 1", autocommit: true, stateChanged: true},
+			{query: "begin", autocommit: true, inTransaction: true, stateChanged: true},
+			{query: "create table if not exists u (i int primary key)", autocommit: true, stateChanged: true},
+			{query: "select * from t", autocommit: true},
+		})
+	})
+}
+
+func TestProtocolWarningCount(t *testing.T) {
+	addr := newRawTestServer(t)
+
+	for _, capabilities := range []uint32{0, mysql.CapabilityClientDeprecateEOF} {
+		c := dialRaw(t, addr, "root", "mydb", capabilities)
+
+		res, err := c.query("select 1")
+		require.NoError(t, err)
+		assert.Zero(t, res.end.warnings)
+
+		res, err = c.query("select 1 / 0")
+		require.NoError(t, err)
+		assert.EqualValues(t, 1, res.end.warnings)
+
+		res, err = c.query("show warnings")
+		require.NoError(t, err)
+		require.Len(t, res.rows, 1)
+		assert.Equal(t, "1365", *res.rows[0][1])
+	}
+
+	c := dialRaw(t, addr, "root", "mydb", 0)
+	for _, q := range []string{
+		"create table t (i int primary key)",
+		"insert into t values (1)",
+	} {
+		_, err := c.query(q)
+		require.NoError(t, err, q)
+	}
+	res, err := c.query("insert ignore into t values (1), (2)")
+	require.NoError(t, err)
+	assert.Nil(t, res.columns)
+	assert.EqualValues(t, 1, res.end.affectedRows)
+	assert.EqualValues(t, 1, res.end.warnings)
+}
+
+func TestProtocolErrorPacket(t *testing.T) {
+	addr := newRawTestServer(t)
+	c := dialRaw(t, addr, "root", "mydb", 0)
+
+	_, err := c.query("select * from doesnotexist")
+	require.Error(t, err)
+	rawErr, ok := err.(*rawError)
+	require.True(t, ok)
+	assert.EqualValues(t, mysql.ERNoSuchTable, rawErr.code)
+	assert.Equal(t, "42S02", rawErr.state)
+
+	// The connection can still be used after an error
+	res, err := c.query("select 1")
+	require.NoError(t, err)
+	assert.Len(t, res.rows, 1)
+}
+
+func TestProtocolCompression(t *testing.T) {
+	addr := newRawTestServer(t)
+	c := dialRaw(t, addr, "root", "mydb", mysql.CapabilityClientDeprecateEOF|capabilityClientCompress)
+	require.NotZero(t, c.handshake.capabilities&capabilityClientCompress)
+	require.NotNil(t, c.compressor)
+
+	for _, q := range []string{
+		"create table t (id int primary key, s text)",
+		"insert into t values (1, 'a'), (2, repeat('b', 1000)), (3, null)",
+	} {
+		_, err := c.query(q)
+		require.NoError(t, err, q)
+	}
+
+	// Short packets are sent uncompressed
+	c.compressor.received = nil
+	_, err := c.query("set @v = 1")
+	require.NoError(t, err)
+	require.Equal(t, []int{0}, c.compressor.received)
+
+	c.compressor.received = nil
+	res, err := c.query("select id, s from t where s is null or id = 1 or length(s) > " + strings.Repeat(" ", 100) + "999 order by id")
+	require.NoError(t, err)
+	require.Len(t, res.columns, 2)
+	require.Equal(t, "s", res.columns[1].name)
+	require.Len(t, res.rows, 3)
+	require.Equal(t, "1", *res.rows[0][0])
+	require.Equal(t, "a", *res.rows[0][1])
+	require.Equal(t, strings.Repeat("b", 1000), *res.rows[1][1])
+	require.Nil(t, res.rows[2][1])
+	require.NotEmpty(t, c.compressor.received)
+	require.NotZero(t, c.compressor.received[0])
+
+	_, err = c.query("select * from nonexistent")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "table not found")
+
+	// Clients that don't ask for compression aren't compressed
+	plain := dialRaw(t, addr, "root", "mydb", mysql.CapabilityClientDeprecateEOF)
+	require.Nil(t, plain.compressor)
+	res, err = plain.query("select count(*) from t")
+	require.NoError(t, err)
+	require.Equal(t, "3", *res.rows[0][0])
+}
+
+func TestProtocolConnectAttributes(t *testing.T) {
+	type connected struct {
+		client sql.Client
+		props  sql.ConnectionProperties
+	}
+	sessions := make(chan connected, 4)
+	sb := func(ctx context.Context, c *mysql.Conn, addr string, props sql.ConnectionProperties) (sql.Session, error) {
+		s, err := DefaultSessionBuilder(ctx, c, addr, props)
+		if err == nil {
+			sessions <- connected{client: s.Client(), props: props}
+		}
+		return s, err
+	}
+	serverConfig, clientConfig := newRawTestCertificate(t)
+	addr := newRawTestServerWithSessions(t, sb)
+	tlsAddr := newRawTestServerWithTLS(t, sb, serverConfig)
+
+	attributes := map[string]string{
+		"_client_name": "raw",
+		"program_name": "protocol_test",
+		"long":         strings.Repeat("a", 300),
+	}
+	for _, test := range []struct {
+		name         string
+		addr         string
+		capabilities uint32
+		tlsConfig    *tls.Config
+	}{
+		{name: "plain", addr: addr},
+		{name: "compressed", addr: addr, capabilities: capabilityClientCompress},
+		{name: "plain on a TLS server", addr: tlsAddr},
+		{name: "TLS", addr: tlsAddr, tlsConfig: clientConfig},
+		{name: "compressed over TLS", addr: tlsAddr, capabilities: capabilityClientCompress, tlsConfig: clientConfig},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			c := dialRawWithAttributes(t, test.addr, "root", "mydb", test.capabilities, attributes, test.tlsConfig)
+			session := <-sessions
+			require.Equal(t, attributes, session.client.Attributes)
+			require.Equal(t, test.tlsConfig != nil, session.props.TLS)
+			res, err := c.query("select 1")
+			require.NoError(t, err)
+			require.Equal(t, "1", *res.rows[0][0])
+		})
+	}
+
+	// Clients that send no attributes have none
+	dialRaw(t, addr, "root", "mydb", 0)
+	require.Nil(t, (<-sessions).client.Attributes)
+}
+
+func TestParseConnectAttributes(t *testing.T) {
+	header := func(capabilities uint32) []byte {
+		b := make([]byte, handshakeResponseHeaderLength)
+		binary.LittleEndian.PutUint32(b, capabilities)
+		return b
+	}
+	withAttrs := uint32(mysql.CapabilityClientProtocol41 | mysql.CapabilityClientSecureConnection | mysql.CapabilityClientPluginAuth | mysql.CapabilityClientConnAttr)
+	body := append([]byte("root\x00\x00mysql_native_password\x00"), 8, 3, 'k', 'e', 'y', 3, 'v', 'a', 'l')
+
+	require.Equal(t, map[string]string{"key": "val"}, parseConnectAttributes(append(header(withAttrs), body...)))
+	// Attributes of a malformed response, an SSL request or a response without CLIENT_CONNECT_ATTRS are ignored
+	require.Nil(t, parseConnectAttributes(append(header(withAttrs), body[:len(body)-2]...)))
+	require.Nil(t, parseConnectAttributes(header(withAttrs|mysql.CapabilityClientSSL)))
+	require.Nil(t, parseConnectAttributes(append(header(withAttrs&^mysql.CapabilityClientConnAttr), body...)))
+}
@@ -0,0 +1,253 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/dolthub/vitess/go/vt/proto/query"
+	"gopkg.in/src-d/go-errors.v1"
+)
+
+// ErrMalformedQueryAttributes is returned when the query attributes sent with a query can't be decoded.
+var ErrMalformedQueryAttributes = errors.NewKind("malformed query attributes: %s")
+
+// CapabilityClientQueryAttributes is CLIENT_QUERY_ATTRIBUTES, with which clients send query attributes ahead of
+// each query.
+const CapabilityClientQueryAttributes = 1 << 27
+
+// ParseQueryAttributes splits the payload of a COM_QUERY packet sent by a client with the CLIENT_QUERY_ATTRIBUTES
+// capability, following the command byte, into the query attributes that precede the query and the query itself.
+// Attributes with NULL values are omitted.
+// https://dev.mysql.com/doc/dev/mysql-server/latest/page_protocol_com_query.html
+func ParseQueryAttributes(payload []byte) (map[string]string, string, error) {
+	r := &queryAttributesReader{data: payload}
+	count := r.readLenEncInt()
+	if setCount := r.readLenEncInt(); r.err == nil && setCount != 1 {
+		return nil, "", ErrMalformedQueryAttributes.New(fmt.Sprintf("unsupported parameter set count %d", setCount))
+	}
+	if r.err != nil || count == 0 {
+		return nil, string(r.rest()), r.err
+	}
+	if count > uint64(len(payload)) {
+		return nil, "", ErrMalformedQueryAttributes.New(fmt.Sprintf("parameter count %d exceeds the packet", count))
+	}
+
+	nullBitmap := r.readBytes(int((count + 7) / 8))
+	if r.readByte() != 1 {
+		// Attributes are always sent with their types and names
+		r.fail("missing parameter types")
+	}
+
+	types := make([]query.Type, count)
+	names := make([]string, count)
+	for i := range types {
+		typ, flags := r.readByte(), r.readByte()
+		names[i] = string(r.readLenEncBytes())
+		if r.err != nil {
+			break
+		}
+		var err error
+		if types[i], err = sqltypes.MySQLToType(int64(typ), int64(flags)); err != nil {
+			r.fail(err.Error())
+		}
+	}
+
+	attrs := make(map[string]string, count)
+	for i, typ := range types {
+		if r.err != nil {
+			break
+		}
+		if nullBitmap[i/8]&(1<<(i%8)) != 0 || typ == sqltypes.Null {
+			continue
+		}
+		attrs[names[i]] = r.readValue(typ)
+	}
+	if r.err != nil {
+		return nil, "", r.err
+	}
+	return attrs, string(r.rest()), nil
+}
+
+// queryAttributesFromBindVars returns the query attributes sent as the bind variables given, which follow the
+// parameters of a prepared statement in COM_STMT_EXECUTE. Attributes with NULL values are omitted.
+func queryAttributesFromBindVars(bindVars map[string]*query.BindVariable) map[string]string {
+	if len(bindVars) == 0 {
+		return nil
+	}
+	attrs := make(map[string]string, len(bindVars))
+	for name, v := range bindVars {
+		if v == nil || v.Type == sqltypes.Null {
+			continue
+		}
+		attrs[name] = string(v.Value)
+	}
+	return attrs
+}
+
+// queryAttributesReader reads the fields of the query attributes of a COM_QUERY payload. Once a field can't be read,
+// err is set and all further fields are read as zero values.
+type queryAttributesReader struct {
+	data []byte
+	pos  int
+	err  error
+}
+
+func (r *queryAttributesReader) fail(msg string) {
+	if r.err == nil {
+		r.err = ErrMalformedQueryAttributes.New(msg)
+	}
+}
+
+func (r *queryAttributesReader) readBytes(n int) []byte {
+	if r.err != nil || n < 0 || r.pos+n > len(r.data) {
+		r.fail("unexpected end of packet")
+		return make([]byte, n)
+	}
+	b := r.data[r.pos : r.pos+n]
+	r.pos += n
+	return b
+}
+
+func (r *queryAttributesReader) readByte() byte {
+	return r.readBytes(1)[0]
+}
+
+func (r *queryAttributesReader) rest() []byte {
+	return r.data[r.pos:]
+}
+
+// readLenEncInt reads a length-encoded integer.
+func (r *queryAttributesReader) readLenEncInt() uint64 {
+	switch b := r.readByte(); b {
+	case 0xfc:
+		return uint64(binary.LittleEndian.Uint16(r.readBytes(2)))
+	case 0xfd:
+		b := r.readBytes(3)
+		return uint64(b[0]) | uint64(b[1])<<8 | uint64(b[2])<<16
+	case 0xfe:
+		return binary.LittleEndian.Uint64(r.readBytes(8))
+	case 0xfb, 0xff:
+		r.fail(fmt.Sprintf("invalid length-encoded integer prefix %#x", b))
+		return 0
+	default:
+		return uint64(b)
+	}
+}
+
+// readLenEncBytes reads a length-encoded string.
+func (r *queryAttributesReader) readLenEncBytes() []byte {
+	n := r.readLenEncInt()
+	if n > uint64(len(r.data)) {
+		r.fail("unexpected end of packet")
+		return nil
+	}
+	return r.readBytes(int(n))
+}
+
+// readValue reads a value of the type given in the binary protocol, and returns it as a string.
+func (r *queryAttributesReader) readValue(typ query.Type) string {
+	switch typ {
+	case sqltypes.Int8:
+		return strconv.FormatInt(int64(int8(r.readByte())), 10)
+	case sqltypes.Uint8:
+		return strconv.FormatUint(uint64(r.readByte()), 10)
+	case sqltypes.Int16, sqltypes.Year:
+		return strconv.FormatInt(int64(int16(binary.LittleEndian.Uint16(r.readBytes(2)))), 10)
+	case sqltypes.Uint16:
+		return strconv.FormatUint(uint64(binary.LittleEndian.Uint16(r.readBytes(2))), 10)
+	case sqltypes.Int24, sqltypes.Int32:
+		return strconv.FormatInt(int64(int32(binary.LittleEndian.Uint32(r.readBytes(4)))), 10)
+	case sqltypes.Uint24, sqltypes.Uint32:
+		return strconv.FormatUint(uint64(binary.LittleEndian.Uint32(r.readBytes(4))), 10)
+	case sqltypes.Int64:
+		return strconv.FormatInt(int64(binary.LittleEndian.Uint64(r.readBytes(8))), 10)
+	case sqltypes.Uint64:
+		return strconv.FormatUint(binary.LittleEndian.Uint64(r.readBytes(8)), 10)
+	case sqltypes.Float32:
+		return strconv.FormatFloat(float64(math.Float32frombits(binary.LittleEndian.Uint32(r.readBytes(4)))), 'g', -1, 32)
+	case sqltypes.Float64:
+		return strconv.FormatFloat(math.Float64frombits(binary.LittleEndian.Uint64(r.readBytes(8))), 'g', -1, 64)
+	case sqltypes.Date, sqltypes.Datetime, sqltypes.Timestamp:
+		return r.readDatetime(typ)
+	case sqltypes.Time:
+		return r.readTime()
+	default:
+		return string(r.readLenEncBytes())
+	}
+}
+
+// readDatetime reads a DATE, DATETIME or TIMESTAMP value, which is sent with only as many of its fields as are set.
+func (r *queryAttributesReader) readDatetime(typ query.Type) string {
+	b := r.readLenEncBytes()
+	var year uint16
+	var month, day, hour, minute, second byte
+	var micros uint32
+	switch len(b) {
+	case 11:
+		micros = binary.LittleEndian.Uint32(b[7:])
+		fallthrough
+	case 7:
+		hour, minute, second = b[4], b[5], b[6]
+		fallthrough
+	case 4:
+		year, month, day = binary.LittleEndian.Uint16(b), b[2], b[3]
+	case 0:
+	default:
+		r.fail(fmt.Sprintf("invalid %s length %d", typ, len(b)))
+	}
+
+	s := fmt.Sprintf("%04d-%02d-%02d", year, month, day)
+	if typ == sqltypes.Date {
+		return s
+	}
+	s += fmt.Sprintf(" %02d:%02d:%02d", hour, minute, second)
+	if micros > 0 {
+		s += fmt.Sprintf(".%06d", micros)
+	}
+	return s
+}
+
+// readTime reads a TIME value, which is sent with only as many of its fields as are set.
+func (r *queryAttributesReader) readTime() string {
+	b := r.readLenEncBytes()
+	var negative bool
+	var days uint32
+	var hour, minute, second byte
+	var micros uint32
+	switch len(b) {
+	case 12:
+		micros = binary.LittleEndian.Uint32(b[8:])
+		fallthrough
+	case 8:
+		negative, days, hour, minute, second = b[0] == 1, binary.LittleEndian.Uint32(b[1:]), b[5], b[6], b[7]
+	case 0:
+	default:
+		r.fail(fmt.Sprintf("invalid TIME length %d", len(b)))
+	}
+
+	s := fmt.Sprintf("%02d:%02d:%02d", days*24+uint32(hour), minute, second)
+	if micros > 0 {
+		s += fmt.Sprintf(".%06d", micros)
+	}
+	if negative {
+		s = "-" + s
+	}
+	return s
+}
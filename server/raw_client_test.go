@@ -0,0 +1,723 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"compress/zlib"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/dolthub/vitess/go/mysql"
+	"github.com/stretchr/testify/require"
+
+	sqle "github.com/dolthub/go-mysql-server"
+	"github.com/dolthub/go-mysql-server/memory"
+)
+
+// rawClientCapabilities are the capabilities the raw client always asks for.
+const rawClientCapabilities = mysql.CapabilityClientLongPassword |
+	mysql.CapabilityClientProtocol41 |
+	mysql.CapabilityClientSecureConnection |
+	mysql.CapabilityClientPluginAuth |
+	mysql.CapabilityClientTransactions
+
+// rawClient is a minimal client of the MySQL protocol. It performs the handshake and decodes the packets sent by the
+// server structurally, so that tests can assert what the server writes on the wire rather than what a driver makes of
+// it. It only authenticates users without a password.
+type rawClient struct {
+	conn net.Conn
+	seq  byte
+	// capabilities are the capabilities negotiated with the server.
+	capabilities uint32
+	handshake    rawHandshake
+	// compressor speaks the compressed protocol over conn once the handshake ends, if the client asked for it.
+	compressor *rawCompressor
+	// tlsConfig is the config the connection is upgraded to TLS with during the handshake, if it's not nil.
+	tlsConfig *tls.Config
+}
+
+// rawHandshake is the initial handshake packet sent by the server.
+type rawHandshake struct {
+	protocolVersion byte
+	serverVersion   string
+	connectionID    uint32
+	capabilities    uint32
+	characterSet    byte
+	statusFlags     uint16
+	authPluginName  string
+}
+
+// rawColumn is a column definition packet.
+type rawColumn struct {
+	schema       string
+	table        string
+	orgTable     string
+	name         string
+	orgName      string
+	characterSet uint16
+	columnLength uint32
+	typ          byte
+	flags        uint16
+	decimals     byte
+}
+
+// rawOK is an OK packet, or an EOF packet, of which only statusFlags and warnings are set.
+type rawOK struct {
+	affectedRows uint64
+	lastInsertID uint64
+	statusFlags  uint16
+	warnings     uint16
+	info         string
+}
+
+// rawResult is the response of the server to a query.
+type rawResult struct {
+	columns []rawColumn
+	// eofAfterColumns is whether the column definitions were followed by an EOF packet.
+	eofAfterColumns bool
+	// rows are the rows of the result, in the text protocol. NULL values are nil.
+	rows [][]*string
+	// end is the OK packet of statements without a result set, or the packet ending the rows of a result set.
+	end rawOK
+	// endIsEOF is whether the rows of a result set were ended by an EOF packet, rather than an OK packet.
+	endIsEOF bool
+}
+
+// rawError is an ERR packet.
+type rawError struct {
+	code    uint16
+	state   string
+	message string
+}
+
+func (e *rawError) Error() string {
+	return fmt.Sprintf("%s (errno %d) (sqlstate %s)", e.message, e.code, e.state)
+}
+
+// newRawTestServer starts a server over a memory database named mydb, and returns its address.
+func newRawTestServer(t *testing.T) string {
+	return newRawTestServerWithSessions(t, DefaultSessionBuilder)
+}
+
+// newRawTestServerWithSessions starts a server over a memory database named mydb whose sessions are built by the
+// session builder given, and returns its address.
+func newRawTestServerWithSessions(t *testing.T, sb SessionBuilder) string {
+	return newRawTestServerWithTLS(t, sb, nil)
+}
+
+// newRawTestServerWithTLS is newRawTestServerWithSessions with the TLS config given, if it's not nil.
+func newRawTestServerWithTLS(t *testing.T, sb SessionBuilder, tlsConfig *tls.Config) string {
+	port, err := getFreePort()
+	require.NoError(t, err)
+
+	e := sqle.NewDefault(memory.NewDBProvider(memory.NewDatabase("mydb")))
+	cfg := Config{
+		Protocol:  "tcp",
+		Address:   "localhost:" + port,
+		TLSConfig: tlsConfig,
+	}
+	srv, err := NewServer(cfg, e, sb, nil)
+	require.NoError(t, err)
+	go srv.Start()
+	t.Cleanup(func() {
+		require.NoError(t, srv.Close())
+	})
+	return cfg.Address
+}
+
+// dialRaw connects to the server at the address given as the user given, asking for the capabilities given on top of
+// rawClientCapabilities, and using the database given if it's not empty.
+func dialRaw(t *testing.T, addr, user, db string, capabilities uint32) *rawClient {
+	return dialRawWithAttributes(t, addr, user, db, capabilities, nil, nil)
+}
+
+// dialRawWithAttributes is dialRaw sending the connection attributes given in the handshake response, if there are
+// any, and upgrading the connection to TLS with the client config given, if it's not nil.
+func dialRawWithAttributes(t *testing.T, addr, user, db string, capabilities uint32, attributes map[string]string, tlsConfig *tls.Config) *rawClient {
+	conn, err := net.DialTimeout("tcp", addr, 5*time.Second)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+	require.NoError(t, conn.SetDeadline(time.Now().Add(30*time.Second)))
+
+	c := &rawClient{conn: conn, tlsConfig: tlsConfig}
+	require.NoError(t, c.connect(user, db, capabilities|rawClientCapabilities, attributes))
+	return c
+}
+
+// newRawTestCertificate returns the TLS config of a server with a self-signed certificate for localhost, and that of a
+// client trusting it.
+func newRawTestCertificate(t *testing.T) (serverConfig *tls.Config, clientConfig *tls.Config) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "localhost"},
+		DNSNames:              []string{"localhost"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(cert)
+	serverConfig = &tls.Config{Certificates: []tls.Certificate{{Certificate: [][]byte{der}, PrivateKey: key}}}
+	clientConfig = &tls.Config{RootCAs: roots, ServerName: "localhost"}
+	return serverConfig, clientConfig
+}
+
+func (c *rawClient) connect(user, db string, capabilities uint32, attributes map[string]string) error {
+	data, err := c.readPacket()
+	if err != nil {
+		return err
+	}
+	if c.handshake, err = parseHandshake(data); err != nil {
+		return err
+	}
+
+	if db != "" {
+		capabilities |= mysql.CapabilityClientConnectWithDB
+	}
+	if len(attributes) > 0 {
+		capabilities |= mysql.CapabilityClientConnAttr
+	}
+	if c.tlsConfig != nil {
+		capabilities |= mysql.CapabilityClientSSL
+	}
+	c.capabilities = capabilities & c.handshake.capabilities
+
+	var resp bytes.Buffer
+	_ = binary.Write(&resp, binary.LittleEndian, c.capabilities)
+	_ = binary.Write(&resp, binary.LittleEndian, uint32(1<<24))
+	resp.WriteByte(c.handshake.characterSet)
+	resp.Write(make([]byte, 23))
+	if c.capabilities&mysql.CapabilityClientSSL != 0 {
+		// The SSL request is the beginning of the handshake response, which is sent again over TLS
+		if err = c.writePacket(resp.Bytes()); err != nil {
+			return err
+		}
+		tlsConn := tls.Client(c.conn, c.tlsConfig)
+		if err = tlsConn.Handshake(); err != nil {
+			return err
+		}
+		c.conn = tlsConn
+	}
+	resp.WriteString(user)
+	resp.WriteByte(0)
+	// An empty auth response, as the user has no password
+	resp.WriteByte(0)
+	if c.capabilities&mysql.CapabilityClientConnectWithDB != 0 {
+		resp.WriteString(db)
+		resp.WriteByte(0)
+	}
+	resp.WriteString(mysql.MysqlNativePassword)
+	resp.WriteByte(0)
+	if c.capabilities&mysql.CapabilityClientConnAttr != 0 {
+		var attrs bytes.Buffer
+		for k, v := range attributes {
+			writeRawLenEncString(&attrs, k)
+			writeRawLenEncString(&attrs, v)
+		}
+		writeRawLenEncString(&resp, attrs.String())
+	}
+	if err = c.writePacket(resp.Bytes()); err != nil {
+		return err
+	}
+
+	for {
+		data, err = c.readPacket()
+		if err != nil {
+			return err
+		}
+		switch data[0] {
+		case mysql.OKPacket:
+			if c.capabilities&capabilityClientCompress != 0 {
+				c.compressor = &rawCompressor{conn: c.conn}
+			}
+			return nil
+		case mysql.ErrPacket:
+			return parseError(data)
+		case mysql.AuthSwitchRequestPacket:
+			// Switch to the method asked for, with an empty auth response again
+			if err = c.writePacket(nil); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unexpected packet during authentication: %v", data)
+		}
+	}
+}
+
+// query sends the query given with COM_QUERY, and returns the response of the server. Only the first result set of
+// queries returning several is read.
+func (c *rawClient) query(query string) (*rawResult, error) {
+	c.seq = 0
+	if c.compressor != nil {
+		c.compressor.seq = 0
+	}
+	if err := c.writePacket(append([]byte{mysql.ComQuery}, query...)); err != nil {
+		return nil, err
+	}
+
+	data, err := c.readPacket()
+	if err != nil {
+		return nil, err
+	}
+	switch data[0] {
+	case mysql.OKPacket:
+		ok, err := parseOK(data)
+		if err != nil {
+			return nil, err
+		}
+		return &rawResult{end: ok}, nil
+	case mysql.ErrPacket:
+		return nil, parseError(data)
+	}
+
+	r := newRawReader(data)
+	count, err := r.lenEncInt()
+	if err != nil {
+		return nil, err
+	}
+	result := &rawResult{}
+	for i := uint64(0); i < count; i++ {
+		if data, err = c.readPacket(); err != nil {
+			return nil, err
+		}
+		col, err := parseColumn(data)
+		if err != nil {
+			return nil, err
+		}
+		result.columns = append(result.columns, col)
+	}
+
+	if data, err = c.readPacket(); err != nil {
+		return nil, err
+	}
+	deprecateEOF := c.capabilities&mysql.CapabilityClientDeprecateEOF != 0
+	if !deprecateEOF && isEOFPacket(data) {
+		result.eofAfterColumns = true
+		if data, err = c.readPacket(); err != nil {
+			return nil, err
+		}
+	}
+
+	for {
+		switch {
+		case data[0] == mysql.ErrPacket:
+			return nil, parseError(data)
+		case deprecateEOF && data[0] == mysql.EOFPacket:
+			// With CLIENT_DEPRECATE_EOF, rows are ended by an OK packet with an EOF header
+			result.end, err = parseOK(data)
+			return result, err
+		case isEOFPacket(data):
+			result.endIsEOF = true
+			result.end, err = parseEOF(data)
+			return result, err
+		}
+		row, err := parseTextRow(data, len(result.columns))
+		if err != nil {
+			return nil, err
+		}
+		result.rows = append(result.rows, row)
+		if data, err = c.readPacket(); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// rw returns what packets are read from and written to: conn, or its compressor once it's compressed.
+func (c *rawClient) rw() io.ReadWriter {
+	if c.compressor != nil {
+		return c.compressor
+	}
+	return c.conn
+}
+
+func (c *rawClient) readPacket() ([]byte, error) {
+	var header [4]byte
+	if _, err := io.ReadFull(c.rw(), header[:]); err != nil {
+		return nil, err
+	}
+	length := int(uint32(header[0]) | uint32(header[1])<<8 | uint32(header[2])<<16)
+	if header[3] != c.seq {
+		return nil, fmt.Errorf("invalid sequence number %d, expected %d", header[3], c.seq)
+	}
+	c.seq++
+	data := make([]byte, length)
+	if _, err := io.ReadFull(c.rw(), data); err != nil {
+		return nil, err
+	}
+	if length == 0 {
+		return nil, fmt.Errorf("unexpected empty packet")
+	}
+	return data, nil
+}
+
+func (c *rawClient) writePacket(data []byte) error {
+	length := len(data)
+	packet := append([]byte{byte(length), byte(length >> 8), byte(length >> 16), c.seq}, data...)
+	c.seq++
+	_, err := c.rw().Write(packet)
+	return err
+}
+
+// rawCompressor speaks the compressed protocol over the connection of a rawClient. Each write is sent in a compressed
+// packet of its own.
+type rawCompressor struct {
+	conn net.Conn
+	seq  byte
+	// decompressed are the bytes of the packets received that haven't been read yet.
+	decompressed bytes.Buffer
+	// received are the uncompressed lengths of the compressed packets received, which are 0 for those whose payloads
+	// weren't compressed.
+	received []int
+}
+
+func (c *rawCompressor) Read(p []byte) (int, error) {
+	for c.decompressed.Len() == 0 {
+		var header [7]byte
+		if _, err := io.ReadFull(c.conn, header[:]); err != nil {
+			return 0, err
+		}
+		if header[3] != c.seq {
+			return 0, fmt.Errorf("invalid compressed sequence number %d, expected %d", header[3], c.seq)
+		}
+		c.seq++
+		length := int(header[0]) | int(header[1])<<8 | int(header[2])<<16
+		uncompressedLength := int(header[4]) | int(header[5])<<8 | int(header[6])<<16
+		c.received = append(c.received, uncompressedLength)
+		payload := make([]byte, length)
+		if _, err := io.ReadFull(c.conn, payload); err != nil {
+			return 0, err
+		}
+		if uncompressedLength == 0 {
+			c.decompressed.Write(payload)
+			continue
+		}
+		if uncompressedLength <= length {
+			return 0, fmt.Errorf("compressed payload of %d bytes isn't smaller than its %d bytes", length, uncompressedLength)
+		}
+		zr, err := zlib.NewReader(bytes.NewReader(payload))
+		if err != nil {
+			return 0, err
+		}
+		if _, err = io.Copy(&c.decompressed, zr); err != nil {
+			return 0, err
+		}
+	}
+	return c.decompressed.Read(p)
+}
+
+func (c *rawCompressor) Write(p []byte) (int, error) {
+	payload, uncompressedLength := p, 0
+	if len(p) >= minCompressLength {
+		var buf bytes.Buffer
+		zw := zlib.NewWriter(&buf)
+		_, _ = zw.Write(p)
+		_ = zw.Close()
+		payload, uncompressedLength = buf.Bytes(), len(p)
+	}
+	length := len(payload)
+	header := []byte{byte(length), byte(length >> 8), byte(length >> 16), c.seq,
+		byte(uncompressedLength), byte(uncompressedLength >> 8), byte(uncompressedLength >> 16)}
+	c.seq++
+	if _, err := c.conn.Write(append(header, payload...)); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// isEOFPacket returns whether the packet given is an EOF packet, rather than an OK packet with an EOF header.
+func isEOFPacket(data []byte) bool {
+	return data[0] == mysql.EOFPacket && len(data) < 9
+}
+
+func parseHandshake(data []byte) (rawHandshake, error) {
+	r := newRawReader(data)
+	var h rawHandshake
+	var err error
+	if h.protocolVersion, err = r.byte(); err != nil {
+		return h, err
+	}
+	if h.serverVersion, err = r.nullString(); err != nil {
+		return h, err
+	}
+	if h.connectionID, err = r.uint32(); err != nil {
+		return h, err
+	}
+	// The first part of the salt and its filler
+	if _, err = r.bytes(9); err != nil {
+		return h, err
+	}
+	lower, err := r.uint16()
+	if err != nil {
+		return h, err
+	}
+	if h.characterSet, err = r.byte(); err != nil {
+		return h, err
+	}
+	if h.statusFlags, err = r.uint16(); err != nil {
+		return h, err
+	}
+	upper, err := r.uint16()
+	if err != nil {
+		return h, err
+	}
+	h.capabilities = uint32(lower) | uint32(upper)<<16
+	saltLength, err := r.byte()
+	if err != nil {
+		return h, err
+	}
+	// The reserved bytes, and the second part of the salt
+	rest := 13
+	if int(saltLength)-8 > rest {
+		rest = int(saltLength) - 8
+	}
+	if _, err = r.bytes(10 + rest); err != nil {
+		return h, err
+	}
+	h.authPluginName, err = r.nullString()
+	return h, err
+}
+
+func parseColumn(data []byte) (rawColumn, error) {
+	r := newRawReader(data)
+	var col rawColumn
+	if _, err := r.lenEncString(); err != nil {
+		return col, err
+	}
+	for _, s := range []*string{&col.schema, &col.table, &col.orgTable, &col.name, &col.orgName} {
+		v, err := r.lenEncString()
+		if err != nil {
+			return col, err
+		}
+		*s = v
+	}
+	// The length of the fixed length fields
+	if _, err := r.lenEncInt(); err != nil {
+		return col, err
+	}
+	var err error
+	if col.characterSet, err = r.uint16(); err != nil {
+		return col, err
+	}
+	if col.columnLength, err = r.uint32(); err != nil {
+		return col, err
+	}
+	if col.typ, err = r.byte(); err != nil {
+		return col, err
+	}
+	if col.flags, err = r.uint16(); err != nil {
+		return col, err
+	}
+	col.decimals, err = r.byte()
+	return col, err
+}
+
+func parseOK(data []byte) (rawOK, error) {
+	r := newRawReader(data[1:])
+	var ok rawOK
+	var err error
+	if ok.affectedRows, err = r.lenEncInt(); err != nil {
+		return ok, err
+	}
+	if ok.lastInsertID, err = r.lenEncInt(); err != nil {
+		return ok, err
+	}
+	if ok.statusFlags, err = r.uint16(); err != nil {
+		return ok, err
+	}
+	if ok.warnings, err = r.uint16(); err != nil {
+		return ok, err
+	}
+	if r.remaining() > 0 {
+		ok.info, err = r.lenEncString()
+	}
+	return ok, err
+}
+
+func parseEOF(data []byte) (rawOK, error) {
+	r := newRawReader(data[1:])
+	var eof rawOK
+	var err error
+	if eof.warnings, err = r.uint16(); err != nil {
+		return eof, err
+	}
+	eof.statusFlags, err = r.uint16()
+	return eof, err
+}
+
+func parseError(data []byte) error {
+	r := newRawReader(data[1:])
+	code, err := r.uint16()
+	if err != nil {
+		return err
+	}
+	e := &rawError{code: code}
+	if marker, err := r.byte(); err == nil && marker == '#' {
+		state, err := r.bytes(5)
+		if err != nil {
+			return err
+		}
+		e.state = string(state)
+	}
+	e.message = string(r.data[r.pos:])
+	return e
+}
+
+func parseTextRow(data []byte, columns int) ([]*string, error) {
+	r := newRawReader(data)
+	row := make([]*string, columns)
+	for i := range row {
+		if r.remaining() > 0 && r.data[r.pos] == 0xfb {
+			r.pos++
+			continue
+		}
+		v, err := r.lenEncString()
+		if err != nil {
+			return nil, err
+		}
+		row[i] = &v
+	}
+	return row, nil
+}
+
+// rawReader decodes the fields of a packet.
+type rawReader struct {
+	data []byte
+	pos  int
+}
+
+func newRawReader(data []byte) *rawReader {
+	return &rawReader{data: data}
+}
+
+func (r *rawReader) remaining() int {
+	return len(r.data) - r.pos
+}
+
+func (r *rawReader) bytes(n int) ([]byte, error) {
+	if r.remaining() < n {
+		return nil, io.ErrUnexpectedEOF
+	}
+	b := r.data[r.pos : r.pos+n]
+	r.pos += n
+	return b, nil
+}
+
+func (r *rawReader) byte() (byte, error) {
+	b, err := r.bytes(1)
+	if err != nil {
+		return 0, err
+	}
+	return b[0], nil
+}
+
+func (r *rawReader) uint16() (uint16, error) {
+	b, err := r.bytes(2)
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint16(b), nil
+}
+
+func (r *rawReader) uint32() (uint32, error) {
+	b, err := r.bytes(4)
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint32(b), nil
+}
+
+func (r *rawReader) lenEncInt() (uint64, error) {
+	first, err := r.byte()
+	if err != nil {
+		return 0, err
+	}
+	var size int
+	switch first {
+	case 0xfc:
+		size = 2
+	case 0xfd:
+		size = 3
+	case 0xfe:
+		size = 8
+	default:
+		return uint64(first), nil
+	}
+	b, err := r.bytes(size)
+	if err != nil {
+		return 0, err
+	}
+	var v uint64
+	for i := size - 1; i >= 0; i-- {
+		v = v<<8 | uint64(b[i])
+	}
+	return v, nil
+}
+
+func (r *rawReader) lenEncString() (string, error) {
+	n, err := r.lenEncInt()
+	if err != nil {
+		return "", err
+	}
+	b, err := r.bytes(int(n))
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func (r *rawReader) nullString() (string, error) {
+	end := bytes.IndexByte(r.data[r.pos:], 0)
+	if end < 0 {
+		return "", io.ErrUnexpectedEOF
+	}
+	s := string(r.data[r.pos : r.pos+end])
+	r.pos += end + 1
+	return s, nil
+}
+
+// writeRawLenEncString writes the string given, shorter than 64KiB, as a length-encoded string.
+func writeRawLenEncString(buf *bytes.Buffer, s string) {
+	if len(s) < 251 {
+		buf.WriteByte(byte(len(s)))
+	} else {
+		buf.WriteByte(0xfc)
+		_ = binary.Write(buf, binary.LittleEndian, uint16(len(s)))
+	}
+	buf.WriteString(s)
+}
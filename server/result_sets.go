@@ -0,0 +1,98 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"io"
+	_ "unsafe" // for go:linkname
+
+	"github.com/dolthub/vitess/go/mysql"
+	"github.com/dolthub/vitess/go/sqltypes"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// Vitess sends a single result set for every call of the handler's query callback, so the result sets of the SELECT
+// statements of a procedure, which precede the one of the CALL statement running it, are written with the packet
+// writers of the connection.
+
+//go:linkname connWriteFields github.com/dolthub/vitess/go/mysql.(*Conn).writeFields
+func connWriteFields(c *mysql.Conn, result *sqltypes.Result) error
+
+//go:linkname connWriteRows github.com/dolthub/vitess/go/mysql.(*Conn).writeRows
+func connWriteRows(c *mysql.Conn, result *sqltypes.Result) error
+
+//go:linkname connWriteBinaryRows github.com/dolthub/vitess/go/mysql.(*Conn).writeBinaryRows
+func connWriteBinaryRows(c *mysql.Conn, result *sqltypes.Result) error
+
+//go:linkname connWriteEndResult github.com/dolthub/vitess/go/mysql.(*Conn).writeEndResult
+func connWriteEndResult(c *mysql.Conn, more bool, affectedRows, lastInsertID uint64, warnings uint16) error
+
+// resultSetWriter writes the result sets of the SELECT statements of a procedure to the connection of the CALL
+// statement running it as they run, each flagged with SERVER_MORE_RESULTS_EXISTS, since the result of the CALL
+// statement follows them.
+type resultSetWriter struct {
+	c *mysql.Conn
+	// binary is whether rows are written in the binary protocol of prepared statements.
+	binary    bool
+	maxPacket int
+	// written is the number of result sets written.
+	written int
+}
+
+var _ sql.ResultSetWriter = (*resultSetWriter)(nil)
+
+// WriteResultSet implements sql.ResultSetWriter.
+func (w *resultSetWriter) WriteResultSet(ctx *sql.Context, sch sql.Schema, iter sql.RowIter) error {
+	res := &sqltypes.Result{Fields: schemaToFields(ctx, sch)}
+	if err := connWriteFields(w.c, res); err != nil {
+		return err
+	}
+	w.written++
+
+	for {
+		row, err := iter.Next(ctx)
+		if err != nil && err != io.EOF {
+			return err
+		}
+		if err == nil {
+			outputRow, err := rowToSQL(ctx, sch, row)
+			if err != nil {
+				return err
+			}
+			if rowSize(outputRow) > w.maxPacket {
+				return sql.ErrPacketTooLarge.New()
+			}
+			res.Rows = append(res.Rows, outputRow)
+		}
+		if len(res.Rows) == rowsBatch || (err == io.EOF && len(res.Rows) > 0) {
+			if err := w.writeRows(res); err != nil {
+				return err
+			}
+			res.Rows = res.Rows[:0]
+		}
+		if err == io.EOF {
+			return connWriteEndResult(w.c, true, 0, 0, 0)
+		}
+	}
+}
+
+// writeRows writes the rows of the result given.
+func (w *resultSetWriter) writeRows(res *sqltypes.Result) error {
+	if w.binary {
+		return connWriteBinaryRows(w.c, res)
+	}
+	return connWriteRows(w.c, res)
+}
@@ -34,6 +34,31 @@ type ServerEventListener interface {
 	QueryCompleted(success bool, duration time.Duration)
 }
 
+// SlowQueryLogger is notified of every query that takes longer than the long_query_time system variable of the session
+// that ran it.
+type SlowQueryLogger interface {
+	LogSlowQuery(entry SlowQueryLogEntry)
+}
+
+// SlowQueryLogEntry describes a query passed to a SlowQueryLogger.
+type SlowQueryLogEntry struct {
+	// Query is the text of the query.
+	Query string
+	// User is the user that ran the query.
+	User string
+	// Duration is how long the query took to run, including sending its results to the client.
+	Duration time.Duration
+	// RowsExamined is the number of rows the query read from tables.
+	RowsExamined int64
+	// RowsSent is the number of rows the query returned.
+	RowsSent int64
+	// Digest is the hash of the normalized text of the query, which is the same for queries that only differ in their
+	// formatting, comments and literal values. It's empty if the query can't be normalized.
+	Digest string
+	// DigestText is the normalized text of the query, with its literals replaced by ?.
+	DigestText string
+}
+
 // NewDefaultServer creates a Server with the default session builder.
 func NewDefaultServer(cfg Config, e *sqle.Engine) (*Server, error) {
 	return NewServer(cfg, e, DefaultSessionBuilder, nil)
@@ -58,6 +83,7 @@ func NewServer(cfg Config, e *sqle.Engine, sb SessionBuilder, listener ServerEve
 		maxLoggedQueryLen: cfg.MaxLoggedQueryLen,
 		encodeLoggedQuery: cfg.EncodeLoggedQuery,
 		sel:               listener,
+		slowQueryLogger:   cfg.SlowQueryLogger,
 	}
 	//handler = NewHandler_(e, sm, cfg.ConnReadTimeout, cfg.DisableClientMultiStatements, cfg.MaxLoggedQueryLen, cfg.EncodeLoggedQuery, listener)
 	return newServerFromHandler(cfg, e, sm, handler)
@@ -88,6 +114,7 @@ func NewValidatingServer(
 		maxLoggedQueryLen: cfg.MaxLoggedQueryLen,
 		encodeLoggedQuery: cfg.EncodeLoggedQuery,
 		sel:               listener,
+		slowQueryLogger:   cfg.SlowQueryLogger,
 	}
 
 	handler, err := golden.NewValidatingHandler(h, mySqlConn, logrus.StandardLogger())
@@ -98,6 +125,17 @@ func NewValidatingServer(
 }
 
 func newServerFromHandler(cfg Config, e *sqle.Engine, sm *SessionManager, handler mysql.Handler) (*Server, error) {
+	sm.defaultVars = cfg.DefaultSessionVariables
+	sm.mysqlDb = e.Analyzer.Catalog.MySQLDb
+	sm.rowChanges = e.RowChanges
+	// Secure transport is required by the session manager rather than by vitess, which doesn't know of the upgrades to
+	// TLS, see handshakeConn
+	sm.requireSecureTransport = cfg.RequireSecureTransport
+
+	if cfg.RequireSecureTransport && cfg.TLSConfig == nil {
+		return nil, errors.New("secure transport is required, but no TLS configuration was given")
+	}
+
 	if cfg.ConnReadTimeout < 0 {
 		cfg.ConnReadTimeout = 0
 	}
@@ -118,15 +156,20 @@ func newServerFromHandler(cfg Config, e *sqle.Engine, sm *SessionManager, handle
 		}
 	}
 
+	// Connections are upgraded to TLS by their handshakeConn rather than by vitess, below compression as in MySQL
+	netListener := newCompressListener(newHandshakeListener(l, cfg.TLSConfig, cfg.AllowClearTextWithoutTLS))
+
 	listenerCfg := mysql.ListenerConfig{
-		Listener:                 l,
-		AuthServer:               e.Analyzer.Catalog.MySQLDb,
-		Handler:                  handler,
-		ConnReadTimeout:          cfg.ConnReadTimeout,
-		ConnWriteTimeout:         cfg.ConnWriteTimeout,
-		MaxConns:                 cfg.MaxConnections,
-		ConnReadBufferSize:       mysql.DefaultConnBufferSize,
-		AllowClearTextWithoutTLS: cfg.AllowClearTextWithoutTLS,
+		Listener:           netListener,
+		AuthServer:         e.Analyzer.Catalog.MySQLDb,
+		Handler:            handler,
+		ConnReadTimeout:    cfg.ConnReadTimeout,
+		ConnWriteTimeout:   cfg.ConnWriteTimeout,
+		MaxConns:           cfg.MaxConnections,
+		ConnReadBufferSize: mysql.DefaultConnBufferSize,
+		// Vitess doesn't know which connections are upgraded to TLS, so their handshakeConns refuse clear text
+		// authentication instead
+		AllowClearTextWithoutTLS: true,
 	}
 	vtListnr, err := mysql.NewListenerWithConfig(listenerCfg)
 	if err != nil {
@@ -136,8 +179,6 @@ func newServerFromHandler(cfg Config, e *sqle.Engine, sm *SessionManager, handle
 	if cfg.Version != "" {
 		vtListnr.ServerVersion = cfg.Version
 	}
-	vtListnr.TLSConfig = cfg.TLSConfig
-	vtListnr.RequireSecureTransport = cfg.RequireSecureTransport
 
 	return &Server{
 		Listener:   vtListnr,
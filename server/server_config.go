@@ -52,7 +52,8 @@ type Config struct {
 	MaxConnections uint64
 	// TLSConfig is the configuration for TLS on this server. If |nil|, TLS is not supported.
 	TLSConfig *tls.Config
-	// RequestSecureTransport will require incoming connections to be TLS. Requires non-|nil| TLSConfig.
+	// RequireSecureTransport will require incoming connections to be TLS. Requires non-|nil| TLSConfig. Connections
+	// are also required to be TLS while the require_secure_transport system variable is on.
 	RequireSecureTransport bool
 	// DisableClientMultiStatements will prevent processing of incoming
 	// queries as if they contain more than one query. This processing
@@ -74,6 +75,13 @@ type Config struct {
 	// If true, queries will be logged as base64 encoded strings.
 	// If false (default behavior), queries will be logged as strings, but newlines and tabs will be replaced with spaces.
 	EncodeLoggedQuery bool
+	// SlowQueryLogger, if set, is given every query that runs for longer than the long_query_time system variable of
+	// its session.
+	SlowQueryLogger SlowQueryLogger
+	// DefaultSessionVariables are the values of the session variables set in every new session, such as sql_mode or
+	// max_execution_time. The session variables of a user, given in the mysql_db.UserAttributeSessionVariables object
+	// of its attributes, override these.
+	DefaultSessionVariables map[string]interface{}
 }
 
 func (c Config) NewConfig() (Config, error) {
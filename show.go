@@ -0,0 +1,232 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/parse"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+// ShowResult is the result of a SHOW statement as Go values, returned by Engine.QueryShow. Its JSON serialization is
+// returned by Engine.QueryShowJSON.
+type ShowResult struct {
+	// Columns are the names of the columns of the statement's rows, in order
+	Columns []string `json:"columns"`
+	// Rows are the statement's rows, as maps from column names to values
+	Rows []map[string]interface{} `json:"rows"`
+	// Table is the definition of the table or view shown by SHOW CREATE TABLE and SHOW CREATE VIEW
+	Table *TableDefinition `json:"table,omitempty"`
+	// Processes are the processes shown by SHOW PROCESSLIST
+	Processes []ProcessInfo `json:"processes,omitempty"`
+	// Variables are the values of the variables shown by SHOW VARIABLES, by name
+	Variables map[string]interface{} `json:"variables,omitempty"`
+}
+
+// TableDefinition is the definition of a table or view shown by SHOW CREATE TABLE.
+type TableDefinition struct {
+	Name string `json:"name"`
+	View bool   `json:"view,omitempty"`
+	// CreateStatement is the statement shown by SHOW CREATE TABLE
+	CreateStatement string             `json:"create_statement"`
+	Columns         []ColumnDefinition `json:"columns,omitempty"`
+	PrimaryKey      []string           `json:"primary_key,omitempty"`
+	Indexes         []IndexDefinition  `json:"indexes,omitempty"`
+}
+
+// ColumnDefinition is the definition of a column of a table shown by SHOW CREATE TABLE.
+type ColumnDefinition struct {
+	Name string `json:"name"`
+	// Type is the column's type as written in the CREATE TABLE statement
+	Type     string `json:"type"`
+	Nullable bool   `json:"nullable"`
+	// Default is the expression of the column's default value, or nil if it has none
+	Default       *string `json:"default,omitempty"`
+	AutoIncrement bool    `json:"auto_increment,omitempty"`
+	Comment       string  `json:"comment,omitempty"`
+}
+
+// IndexDefinition is the definition of a secondary index of a table shown by SHOW CREATE TABLE.
+type IndexDefinition struct {
+	Name string `json:"name"`
+	// Expressions are the columns or expressions the index is on, in order
+	Expressions []string `json:"expressions"`
+	Unique      bool     `json:"unique,omitempty"`
+	Spatial     bool     `json:"spatial,omitempty"`
+	Comment     string   `json:"comment,omitempty"`
+}
+
+// ProcessInfo is a process shown by SHOW PROCESSLIST.
+type ProcessInfo struct {
+	Id      int64  `json:"id"`
+	User    string `json:"user"`
+	Host    string `json:"host"`
+	Db      string `json:"db,omitempty"`
+	Command string `json:"command"`
+	Time    int64  `json:"time"`
+	State   string `json:"state,omitempty"`
+	Info    string `json:"info,omitempty"`
+}
+
+// QueryShow executes a SHOW statement and returns its result as Go values rather than as rows: the rows as maps from
+// column names to values, and for SHOW CREATE TABLE, SHOW PROCESSLIST and SHOW VARIABLES, the table definition, the
+// processes and the variables they show. The statement is executed as by Engine.Query. sql.ErrNotShowStatement is
+// returned for statements other than SHOW statements.
+func (e *Engine) QueryShow(ctx *sql.Context, query string) (*ShowResult, error) {
+	stmt, err := sqlparser.Parse(strings.TrimSuffix(strings.TrimSpace(query), ";"))
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := stmt.(*sqlparser.Show); !ok {
+		return nil, sql.ErrNotShowStatement.New(query)
+	}
+
+	schema, iter, err := e.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := sql.RowIterToRows(ctx, schema, iter)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ShowResult{Columns: make([]string, len(schema)), Rows: make([]map[string]interface{}, len(rows))}
+	for i, col := range schema {
+		res.Columns[i] = col.Name
+	}
+	for i, row := range rows {
+		res.Rows[i] = make(map[string]interface{}, len(row))
+		for j, v := range row {
+			res.Rows[i][res.Columns[j]] = v
+		}
+	}
+
+	parsed, err := parse.Parse(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	switch parsed.(type) {
+	case *plan.ShowCreateTable:
+		if len(rows) == 1 {
+			res.Table, err = e.tableDefinition(ctx, parsed, rows[0])
+			if err != nil {
+				return nil, err
+			}
+		}
+	case *plan.ShowProcessList:
+		res.Processes = make([]ProcessInfo, len(rows))
+		for i, row := range rows {
+			res.Processes[i] = ProcessInfo{
+				Id:      row[0].(int64),
+				User:    row[1].(string),
+				Host:    row[2].(string),
+				Command: row[4].(string),
+				Time:    row[5].(int64),
+				State:   row[6].(string),
+				Info:    row[7].(string),
+			}
+			if db, ok := row[3].(string); ok {
+				res.Processes[i].Db = db
+			}
+		}
+	case *plan.ShowVariables:
+		res.Variables = make(map[string]interface{}, len(rows))
+		for _, row := range rows {
+			res.Variables[row[0].(string)] = row[1]
+		}
+	}
+	return res, nil
+}
+
+// QueryShowJSON executes a SHOW statement and returns the JSON serialization of the result returned for it by
+// Engine.QueryShow.
+func (e *Engine) QueryShowJSON(ctx *sql.Context, query string) ([]byte, error) {
+	res, err := e.QueryShow(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(res)
+}
+
+// tableDefinition returns the definition of the table shown by the SHOW CREATE TABLE statement given, whose row is
+// given.
+func (e *Engine) tableDefinition(ctx *sql.Context, parsed sql.Node, row sql.Row) (*TableDefinition, error) {
+	def := &TableDefinition{Name: row[0].(string), CreateStatement: row[1].(string)}
+
+	analyzed, err := e.Analyzer.Analyze(ctx, parsed, nil)
+	if err != nil {
+		return nil, err
+	}
+	var sct *plan.ShowCreateTable
+	transform.Inspect(analyzed, func(n sql.Node) bool {
+		if n, ok := n.(*plan.ShowCreateTable); ok {
+			sct = n
+		}
+		return sct == nil
+	})
+	if sct == nil || sct.IsView {
+		def.View = sct != nil
+		return def, nil
+	}
+
+	schema := sct.TargetSchema()
+	for _, col := range schema {
+		c := ColumnDefinition{
+			Name:          col.Name,
+			Type:          col.Type.String(),
+			Nullable:      col.Nullable,
+			AutoIncrement: col.AutoIncrement,
+			Comment:       col.Comment,
+		}
+		if col.Default != nil {
+			d := col.Default.String()
+			c.Default = &d
+		}
+		def.Columns = append(def.Columns, c)
+	}
+	if len(sct.PrimaryKeySchema.Schema) > 0 {
+		for _, i := range sct.PrimaryKeySchema.PkOrdinals {
+			def.PrimaryKey = append(def.PrimaryKey, schema[i].Name)
+		}
+	} else {
+		for _, col := range schema {
+			if col.PrimaryKey {
+				def.PrimaryKey = append(def.PrimaryKey, col.Name)
+			}
+		}
+	}
+	for _, idx := range sct.Indexes {
+		if strings.EqualFold(idx.ID(), "PRIMARY") {
+			continue
+		}
+		index := IndexDefinition{
+			Name:    idx.ID(),
+			Unique:  idx.IsUnique(),
+			Spatial: idx.IsSpatial(),
+			Comment: idx.Comment(),
+		}
+		for _, expr := range idx.Expressions() {
+			index.Expressions = append(index.Expressions, plan.GetUnqualifiedIndexExpr(expr))
+		}
+		def.Indexes = append(def.Indexes, index)
+	}
+	return def, nil
+}
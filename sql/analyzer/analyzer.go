@@ -15,6 +15,7 @@
 package analyzer
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"reflect"
@@ -35,8 +36,9 @@ const debugAnalyzerKey = "DEBUG_ANALYZER"
 
 const maxAnalysisIterations = 8
 
-// ErrMaxAnalysisIters is thrown when the analysis iterations are exceeded
-var ErrMaxAnalysisIters = errors.NewKind("exceeded max analysis iterations (%d)")
+// ErrMaxAnalysisIters is thrown when the analysis iterations are exceeded, with a description of why the analysis
+// didn't converge when it's known
+var ErrMaxAnalysisIters = errors.NewKind("exceeded max analysis iterations (%d)%s")
 
 // ErrInAnalysis is thrown for generic analyzer errors
 var ErrInAnalysis = errors.NewKind("error in analysis: %s")
@@ -128,6 +130,55 @@ func (ab *Builder) AddPostValidationRule(id RuleId, fn RuleFunc) *Builder {
 	return ab
 }
 
+// AddRuleBefore adds a new rule to the analyzer just before the rule with the anchor id given, in the same batch. It
+// panics if the analyzer has no rule with the anchor id.
+func (ab *Builder) AddRuleBefore(anchor RuleId, id RuleId, fn RuleFunc) *Builder {
+	ab.addRuleNextTo(anchor, 0, Rule{id, fn})
+
+	return ab
+}
+
+// AddRuleAfter adds a new rule to the analyzer just after the rule with the anchor id given, in the same batch. It
+// panics if the analyzer has no rule with the anchor id.
+func (ab *Builder) AddRuleAfter(anchor RuleId, id RuleId, fn RuleFunc) *Builder {
+	ab.addRuleNextTo(anchor, 1, Rule{id, fn})
+
+	return ab
+}
+
+// addRuleNextTo inserts the rule given at the offset given from the first rule with the anchor id given.
+func (ab *Builder) addRuleNextTo(anchor RuleId, offset int, rule Rule) {
+	for _, rules := range ab.ruleLists() {
+		for i, r := range *rules {
+			if r.Id != anchor {
+				continue
+			}
+			// The default rule lists are shared by every builder, so they're copied rather than modified
+			newRules := make([]Rule, 0, len(*rules)+1)
+			newRules = append(newRules, (*rules)[:i+offset]...)
+			newRules = append(newRules, rule)
+			*rules = append(newRules, (*rules)[i+offset:]...)
+			return
+		}
+	}
+	panic(fmt.Sprintf("cannot add analyzer rule %s next to rule %s, which the analyzer doesn't have", rule.Id, anchor))
+}
+
+// ruleLists returns the rules of each batch of the analyzer, in the order the batches are run.
+func (ab *Builder) ruleLists() []*[]Rule {
+	return []*[]Rule{
+		&ab.preAnalyzeRules,
+		&ab.onceBeforeRules,
+		&ab.defaultRules,
+		&ab.onceAfterRules,
+		&ab.postAnalyzeRules,
+		&ab.preValidationRules,
+		&ab.validationRules,
+		&ab.postValidationRules,
+		&ab.afterAllRules,
+	}
+}
+
 func duplicateRulesWithout(rules []Rule, excludedRuleId RuleId) []Rule {
 	newRules := make([]Rule, 0, len(rules))
 
@@ -140,6 +191,15 @@ func duplicateRulesWithout(rules []Rule, excludedRuleId RuleId) []Rule {
 	return newRules
 }
 
+// RemoveRuleById removes the rule with the id given from every batch of the analyzer.
+func (ab *Builder) RemoveRuleById(id RuleId) *Builder {
+	for _, rules := range ab.ruleLists() {
+		*rules = duplicateRulesWithout(*rules, id)
+	}
+
+	return ab
+}
+
 // RemoveOnceBeforeRule removes a default rule from the analyzer which would occur before other rules
 func (ab *Builder) RemoveOnceBeforeRule(id RuleId) *Builder {
 	ab.onceBeforeRules = duplicateRulesWithout(ab.onceBeforeRules, id)
@@ -301,6 +361,17 @@ func NewDefault(provider sql.DatabaseProvider) *Analyzer {
 	return NewBuilder(provider).Build()
 }
 
+// RuleIds returns the ids of the rules of the analyzer, in the order they're run.
+func (a *Analyzer) RuleIds() []RuleId {
+	var ids []RuleId
+	for _, b := range a.Batches {
+		for _, r := range b.Rules {
+			ids = append(ids, r.Id)
+		}
+	}
+	return ids
+}
+
 // Log prints an INFO message to stdout with the given message and args
 // if the analyzer is in debug mode.
 func (a *Analyzer) Log(msg string, args ...interface{}) {
@@ -331,15 +402,7 @@ func (a *Analyzer) LogNode(n sql.Node) {
 func (a *Analyzer) LogDiff(prev, next sql.Node) {
 	if a.Debug && a.Verbose {
 		if !reflect.DeepEqual(next, prev) {
-			diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
-				A:        difflib.SplitLines(sql.DebugString(prev)),
-				B:        difflib.SplitLines(sql.DebugString(next)),
-				FromFile: "Prev",
-				FromDate: "",
-				ToFile:   "Next",
-				ToDate:   "",
-				Context:  1,
-			})
+			diff, err := planDiff(prev, next)
 			if err != nil {
 				panic(err)
 			}
@@ -352,6 +415,19 @@ func (a *Analyzer) LogDiff(prev, next sql.Node) {
 	}
 }
 
+// planDiff returns the unified diff of the debug strings of the plans given.
+func planDiff(prev, next sql.Node) (string, error) {
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(sql.DebugString(prev)),
+		B:        difflib.SplitLines(sql.DebugString(next)),
+		FromFile: "Prev",
+		FromDate: "",
+		ToFile:   "Next",
+		ToDate:   "",
+		Context:  1,
+	})
+}
+
 // PushDebugContext pushes the given context string onto the context stack, to use when logging debug messages.
 func (a *Analyzer) PushDebugContext(msg string) {
 	if a != nil && a.Debug {
@@ -384,8 +460,10 @@ func NewProcRuleSelector(sel RuleSelector) RuleSelector {
 		case optimizeJoinsId,
 			pruneTablesId,
 			transformJoinApplyId,
+			flattenScalarSubqueriesId,
 
 			// once after default rules should only be run once
+			applyDefaultSelectLimitId,
 			AutocommitId,
 			TrackProcessId,
 			parallelizeId,
@@ -420,6 +498,7 @@ func NewFinalizeSubquerySel(sel RuleSelector) RuleSelector {
 			finalizeSubqueriesId,
 			hoistOutOfScopeFiltersId,
 			cacheSubqueryResultsId,
+			applyDefaultSelectLimitId,
 			TrackProcessId:
 			return false
 		}
@@ -435,6 +514,7 @@ func NewFinalizeUnionSel(sel RuleSelector) RuleSelector {
 			resolveSubqueryExprsId,
 			resolveSubqueriesId,
 			resolveUnionsId,
+			applyDefaultSelectLimitId,
 			parallelizeId:
 			return false
 		}
@@ -445,7 +525,33 @@ func NewFinalizeUnionSel(sel RuleSelector) RuleSelector {
 func newInsertSourceSelector(sel RuleSelector) RuleSelector {
 	return func(id RuleId) bool {
 		switch id {
-		case transformJoinApplyId:
+		case transformJoinApplyId,
+			flattenScalarSubqueriesId,
+			applyDefaultSelectLimitId:
+			return false
+		}
+		return sel(id)
+	}
+}
+
+// newSessionRuleSelector returns a selector of the rules selected by the selector given that aren't disabled by the
+// analyzer_disabled_rules session variable, a comma separated list of the names of rules to skip when debugging the
+// analyzer.
+func newSessionRuleSelector(ctx *sql.Context, sel RuleSelector) RuleSelector {
+	val, err := ctx.GetSessionVariable(ctx, "analyzer_disabled_rules")
+	if err != nil {
+		return sel
+	}
+	s, _ := val.(string)
+	if strings.TrimSpace(s) == "" {
+		return sel
+	}
+	disabled := make(map[string]struct{})
+	for _, name := range strings.Split(s, ",") {
+		disabled[strings.ToLower(strings.TrimSpace(name))] = struct{}{}
+	}
+	return func(id RuleId) bool {
+		if _, ok := disabled[strings.ToLower(id.String())]; ok {
 			return false
 		}
 		return sel(id)
@@ -481,6 +587,7 @@ func prePrepareRuleSelector(id RuleId) bool {
 		validateOperandsId,
 
 		// OnceAfterAll
+		applyDefaultSelectLimitId,
 		TrackProcessId,
 		parallelizeId:
 		return false
@@ -508,6 +615,7 @@ func postPrepareRuleSelector(id RuleId) bool {
 		parseColumnDefaultsId,
 		assignCatalogId,
 		resolveColumnDefaultsId,
+		resolveDescribeQueryId,
 		resolveTableFunctionsId,
 		validatePrivilegesId,
 
@@ -524,6 +632,7 @@ func postPrepareRuleSelector(id RuleId) bool {
 
 		// OnceAfterDefault
 		pushdownFiltersId,
+		indexMergeId,
 		subqueryIndexesId,
 		stripTableNameInDefaultsId,
 		resolvePreparedInsertId,
@@ -537,6 +646,8 @@ func postPrepareRuleSelector(id RuleId) bool {
 		//validateUnionSchemasMatchId, // TODO: we never validate UnionSchemasMatchId :)
 
 		// OnceAfterAll
+		pushdownIndexConditionsId,
+		applyDefaultSelectLimitId,
 		parallelizeId,
 		TrackProcessId:
 		return true
@@ -562,9 +673,11 @@ func postPrepareInsertSourceRuleSelector(id RuleId) bool {
 		resolveColumnsId,
 
 		pushdownFiltersId,
+		indexMergeId,
 		subqueryIndexesId,
 		resolveInsertRowsId,
 
+		pushdownIndexConditionsId,
 		AutocommitId,
 		TrackProcessId,
 		parallelizeId,
@@ -602,7 +715,7 @@ func (a *Analyzer) analyzeWithSelector(ctx *sql.Context, n sql.Node, scope *Scop
 	span, ctx := ctx.Span("analyze")
 
 	if scope.RecursionDepth() > maxBatchRecursion {
-		return n, transform.SameTree, ErrMaxAnalysisIters.New(maxBatchRecursion)
+		return n, transform.SameTree, ErrMaxAnalysisIters.New(maxBatchRecursion, "")
 	}
 
 	var (
@@ -610,6 +723,14 @@ func (a *Analyzer) analyzeWithSelector(ctx *sql.Context, n sql.Node, scope *Scop
 		allSame = transform.SameTree
 		err     error
 	)
+	ruleSelector = newSessionRuleSelector(ctx, ruleSelector)
+	if a.Debug {
+		if _, ok := ctx.Value(ruleIterationsKey{}).(*ruleIterations); !ok {
+			iters := &ruleIterations{applied: make(map[RuleId]int), changed: make(map[RuleId]int)}
+			ctx = ctx.WithContext(context.WithValue(ctx.Context, ruleIterationsKey{}, iters))
+			defer iters.log(a)
+		}
+	}
 	a.Log("starting analysis of node of type: %T", n)
 	for _, batch := range a.Batches {
 		if batchSelector(batch.Desc) {
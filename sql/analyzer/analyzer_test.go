@@ -15,8 +15,10 @@
 package analyzer
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"os"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -76,6 +78,66 @@ func TestMaxIterations(t *testing.T) {
 	require.Equal(maxAnalysisIterations, count)
 }
 
+func TestMaxIterationsDiagnostics(t *testing.T) {
+	db := memory.NewDatabase("mydb")
+	table := memory.NewTable("t", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "i", Type: types.Int32, Source: "t", PrimaryKey: true},
+	}), db.GetForeignKeyCollection())
+	db.AddTable("t", table)
+	provider := sql.NewDatabaseProvider(db)
+
+	query := func() sql.Node {
+		return plan.NewLimit(expression.NewLiteral(int8(1), types.Int8), plan.NewUnresolvedTable("t", ""))
+	}
+
+	// flipLimit changes the limit of the query between 1 and 2 each time it's applied
+	flipLimit := func(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+		return transform.Node(n, func(n sql.Node) (sql.Node, transform.TreeIdentity, error) {
+			l, ok := n.(*plan.Limit)
+			if !ok {
+				return n, transform.SameTree, nil
+			}
+			limit := int8(2)
+			if l.Limit.(*expression.Literal).Value() == int8(2) {
+				limit = 1
+			}
+			return plan.NewLimit(expression.NewLiteral(limit, types.Int8), l.Child), transform.NewTree, nil
+		})
+	}
+
+	t.Run("oscillating rule", func(t *testing.T) {
+		a := NewBuilder(provider).AddPostAnalyzeRule(-1, flipLimit).Build()
+		ctx := sql.NewEmptyContext()
+		ctx.SetCurrentDatabase("mydb")
+		_, err := a.Analyze(ctx, query(), nil)
+		require.Error(t, err)
+		require.True(t, ErrMaxAnalysisIters.Is(err))
+		msg := err.Error()
+		require.Contains(t, msg, "exceeded max analysis iterations (8): the rules of batch post-analyzer didn't converge")
+		require.Contains(t, msg, "rules changing the plan in its last iterations: RuleId(-1)")
+		require.Contains(t, msg, "the plan repeats every 2 iterations")
+		require.Contains(t, msg, "-Limit(2)\n+Limit(1)")
+		require.Contains(t, msg, "-Limit(1)\n+Limit(2)")
+	})
+
+	t.Run("normal query", func(t *testing.T) {
+		var buf bytes.Buffer
+		log.SetOutput(&buf)
+		defer log.SetOutput(os.Stderr)
+
+		ctx := sql.NewEmptyContext()
+		ctx.SetCurrentDatabase("mydb")
+		expected, err := NewDefault(provider).Analyze(ctx, query(), nil)
+		require.NoError(t, err)
+		require.Empty(t, buf.String())
+
+		analyzed, err := NewBuilder(provider).WithDebug().Build().Analyze(ctx, query(), nil)
+		require.NoError(t, err)
+		require.Equal(t, sql.DebugString(expected), sql.DebugString(analyzed))
+		require.Contains(t, buf.String(), "rule resolveTables applied 1 times, changed the plan 1 times")
+	})
+}
+
 func TestAddRule(t *testing.T) {
 	require := require.New(t)
 
@@ -156,6 +218,136 @@ func TestRemoveAfterAllRule(t *testing.T) {
 	require.Equal(countRules(a.Batches), defRulesCount-1)
 }
 
+func TestRemoveRuleById(t *testing.T) {
+	require := require.New(t)
+
+	a := NewBuilder(nil).RemoveRuleById(pushdownFiltersId).Build()
+
+	defRulesCount := countRules(NewDefault(nil).Batches)
+
+	require.Equal(countRules(a.Batches), defRulesCount-1)
+	require.NotContains(a.RuleIds(), pushdownFiltersId)
+}
+
+func TestAddRuleNextToAnchor(t *testing.T) {
+	require := require.New(t)
+
+	const before, after RuleId = -1, -2
+	a := NewBuilder(nil).
+		AddRuleBefore(pushdownFiltersId, before, pushdownFilters).
+		AddRuleAfter(pushdownFiltersId, after, pushdownFilters).
+		Build()
+
+	ids := a.RuleIds()
+	var i int
+	for i = range ids {
+		if ids[i] == pushdownFiltersId {
+			break
+		}
+	}
+	require.Equal([]RuleId{before, pushdownFiltersId, after}, ids[i-1:i+2])
+	require.Equal(countRules(NewDefault(nil).Batches)+2, len(ids))
+	require.NotContains(NewDefault(nil).RuleIds(), before)
+
+	require.Panics(func() {
+		NewBuilder(nil).AddRuleAfter(-3, after, pushdownFilters)
+	})
+}
+
+func TestConfiguredRulesPlans(t *testing.T) {
+	db := memory.NewDatabase("mydb")
+	table := memory.NewTable("t", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "i", Type: types.Int32, Source: "t", PrimaryKey: true},
+		{Name: "j", Type: types.Int32, Source: "t"},
+	}), db.GetForeignKeyCollection())
+	table.EnablePrimaryKeyIndexes()
+	db.AddTable("t", table)
+	provider := sql.NewDatabaseProvider(db)
+
+	query := func() sql.Node {
+		return plan.NewLimit(
+			expression.NewLiteral(int8(1), types.Int8),
+			plan.NewFilter(
+				expression.NewEquals(
+					expression.NewUnresolvedColumn("i"),
+					expression.NewLiteral(int8(1), types.Int8),
+				),
+				plan.NewUnresolvedTable("t", ""),
+			),
+		)
+	}
+	hasNode := func(n sql.Node, match func(sql.Node) bool) bool {
+		found := false
+		transform.Inspect(n, func(n sql.Node) bool {
+			found = found || match(n)
+			return !found
+		})
+		return found
+	}
+	isIndexedTableAccess := func(n sql.Node) bool {
+		_, ok := n.(*plan.IndexedTableAccess)
+		return ok
+	}
+	isLimit := func(n sql.Node) bool {
+		_, ok := n.(*plan.Limit)
+		return ok
+	}
+	analyze := func(t *testing.T, a *Analyzer, ctx *sql.Context) sql.Node {
+		ctx.SetCurrentDatabase("mydb")
+		analyzed, err := a.Analyze(ctx, query(), nil)
+		require.NoError(t, err)
+		return analyzed
+	}
+
+	t.Run("default rules", func(t *testing.T) {
+		analyzed := analyze(t, NewDefault(provider), sql.NewEmptyContext())
+		require.True(t, hasNode(analyzed, isIndexedTableAccess))
+		require.True(t, hasNode(analyzed, isLimit))
+	})
+
+	t.Run("removed rule", func(t *testing.T) {
+		a := NewBuilder(provider).RemoveRuleById(pushdownFiltersId).Build()
+		analyzed := analyze(t, a, sql.NewEmptyContext())
+		require.False(t, hasNode(analyzed, isIndexedTableAccess))
+	})
+
+	t.Run("custom rewriting rule", func(t *testing.T) {
+		removeLimits := func(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+			return transform.Node(n, func(n sql.Node) (sql.Node, transform.TreeIdentity, error) {
+				if l, ok := n.(*plan.Limit); ok {
+					return l.Child, transform.NewTree, nil
+				}
+				return n, transform.SameTree, nil
+			})
+		}
+		a := NewBuilder(provider).AddRuleAfter(pushdownFiltersId, -1, removeLimits).Build()
+		analyzed := analyze(t, a, sql.NewEmptyContext())
+		require.False(t, hasNode(analyzed, isLimit))
+		require.True(t, hasNode(analyzed, isIndexedTableAccess))
+	})
+
+	t.Run("rule disabled by session", func(t *testing.T) {
+		ctx := sql.NewEmptyContext()
+		require.NoError(t, ctx.SetSessionVariable(ctx, "analyzer_disabled_rules", "PushdownFilters, parallelize"))
+		analyzed := analyze(t, NewDefault(provider), ctx)
+		require.False(t, hasNode(analyzed, isIndexedTableAccess))
+	})
+
+	t.Run("explain fired rules", func(t *testing.T) {
+		ctx := sql.NewEmptyContext()
+		ctx.SetCurrentDatabase("mydb")
+		analyzed, err := NewDefault(provider).Analyze(ctx, plan.NewDescribeQuery("rules", query()), nil)
+		require.NoError(t, err)
+		var d *plan.DescribeQuery
+		require.True(t, hasNode(analyzed, func(n sql.Node) bool {
+			d, _ = n.(*plan.DescribeQuery)
+			return d != nil
+		}))
+		require.Contains(t, d.FiredRules, "once-before: resolveTables")
+		require.Contains(t, d.FiredRules, "once-after: pushdownFilters")
+	})
+}
+
 func countRules(batches []*Batch) int {
 	var count int
 	for _, b := range batches {
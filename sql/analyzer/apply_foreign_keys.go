@@ -460,7 +460,7 @@ func (cache *foreignKeyCache) AddUpdater(ctx *sql.Context, tbl sql.ForeignKeyTab
 	}
 	editor := foreignKeyTableUpdater{
 		tbl:     tbl,
-		updater: tbl.GetForeignKeyEditor(ctx),
+		updater: plan.NewRowChangeForeignKeyEditor(ctx, dbName, tbl.Name(), tbl.GetForeignKeyEditor(ctx)),
 	}
 	cache.updaterCache[fkTableName] = editor
 	return editor.updater, nil
@@ -498,7 +498,7 @@ func (cache *foreignKeyCache) GetUpdater(ctx *sql.Context, a *Analyzer, dbName s
 	}
 	editor := foreignKeyTableUpdater{
 		tbl:     fkTbl,
-		updater: fkTbl.GetForeignKeyEditor(ctx),
+		updater: plan.NewRowChangeForeignKeyEditor(ctx, dbName, fkTbl.Name(), fkTbl.GetForeignKeyEditor(ctx)),
 	}
 	cache.updaterCache[fkTableName] = editor
 	return editor.tbl, editor.updater, nil
@@ -19,6 +19,7 @@ import (
 	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 	"github.com/dolthub/go-mysql-server/sql/transform"
+	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
 func applyHashIn(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
@@ -31,7 +32,9 @@ func applyHashIn(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel Ru
 		e, same, err := transform.Expr(filter.Expression, func(expr sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
 			if e, ok := expr.(*expression.InTuple); ok &&
 				hasSingleOutput(e.Left()) &&
-				isStatic(e.Right()) {
+				isStatic(e.Right()) &&
+				!comparesStringsWithNumbers(e) &&
+				!comparesRowsWithNulls(e) {
 				newe, err := expression.NewHashInTuple(ctx, e.Left(), e.Right())
 				if err != nil {
 					return nil, transform.SameTree, err
@@ -66,6 +69,70 @@ func hasSingleOutput(e sql.Expression) bool {
 	})
 }
 
+// comparesStringsWithNumbers checks if any element of the IN list is a number compared with a string, or vice versa,
+// including the elements of rows compared with a row constructor. Those are compared as floating point values, which
+// hashing on the left type cannot do.
+func comparesStringsWithNumbers(e *expression.InTuple) bool {
+	right, ok := e.Right().(expression.Tuple)
+	if !ok {
+		return false
+	}
+	for _, el := range right {
+		if typesCompareAsDoubles(e.Left().Type(), el.Type()) {
+			return true
+		}
+	}
+	return false
+}
+
+func typesCompareAsDoubles(left, right sql.Type) bool {
+	lt, lok := left.(types.TupleType)
+	rt, rok := right.(types.TupleType)
+	if lok && rok && len(lt) == len(rt) {
+		for i := range lt {
+			if typesCompareAsDoubles(lt[i], rt[i]) {
+				return true
+			}
+		}
+		return false
+	}
+	return expression.CompareAsDoubles(left, right)
+}
+
+// comparesRowsWithNulls checks if a row constructor is compared with rows of the IN list, and either side has NULL
+// elements. Comparing such rows is NULL unless other elements differ, which hashing cannot tell.
+func comparesRowsWithNulls(e *expression.InTuple) bool {
+	if !types.IsTuple(e.Left().Type()) {
+		return false
+	}
+	if hasNullElements(e.Left().Type()) {
+		return true
+	}
+	right, ok := e.Right().(expression.Tuple)
+	if !ok {
+		return false
+	}
+	for _, el := range right {
+		if hasNullElements(el.Type()) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasNullElements(t sql.Type) bool {
+	tt, ok := t.(types.TupleType)
+	if !ok {
+		return t == types.Null
+	}
+	for _, el := range tt {
+		if hasNullElements(el) {
+			return true
+		}
+	}
+	return false
+}
+
 // isStatic checks if an expression is static
 func isStatic(e sql.Expression) bool {
 	return !transform.InspectExpr(e, func(expr sql.Expression) bool {
@@ -478,7 +478,7 @@ func TestApplyHashIn(t *testing.T) {
 						"char",
 					),
 					expression.NewTuple(
-						expression.NewLiteral(int64(0), types.Int64),
+						expression.NewLiteral("0", types.LongText),
 					),
 				),
 				child,
@@ -486,6 +486,33 @@ func TestApplyHashIn(t *testing.T) {
 			expected: plan.NewFilter(
 				mustNewHashInTuple(
 					ctx,
+					expression.NewConvert(
+						expression.NewGetField(0, types.Int64, "foo", false),
+						"char",
+					),
+					expression.NewTuple(
+						expression.NewLiteral("0", types.LongText),
+					),
+				),
+				child,
+			),
+		},
+		{
+			name: "skip string compared with number",
+			node: plan.NewFilter(
+				expression.NewInTuple(
+					expression.NewConvert(
+						expression.NewGetField(0, types.Int64, "foo", false),
+						"char",
+					),
+					expression.NewTuple(
+						expression.NewLiteral(int64(0), types.Int64),
+					),
+				),
+				child,
+			),
+			expected: plan.NewFilter(
+				expression.NewInTuple(
 					expression.NewConvert(
 						expression.NewGetField(0, types.Int64, "foo", false),
 						"char",
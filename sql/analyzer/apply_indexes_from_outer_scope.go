@@ -214,6 +214,10 @@ IndexExpressions:
 	for i, idxExpr := range idxPrefixExpressions {
 		for j := range joinExprs {
 			if idxExpr == normalizedJoinExprStrs[j] {
+				// strings compared with numbers match values that sort differently in the index
+				if expression.CompareAsDoubles(joinExprs[j].colExpr.Type(), joinExprs[j].comparand.Type()) {
+					return nil, nil, nil
+				}
 				keyExprs[i] = joinExprs[j].comparand
 				nullmask[i] = joinExprs[j].matchnull
 				continue IndexExpressions
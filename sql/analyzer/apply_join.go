@@ -96,6 +96,10 @@ func transformJoinApply(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope,
 					sq, _ = e.Right.(*plan.Subquery)
 					l = e.Left
 					joinF = expression.NewEquals(nil, nil)
+					if op == plan.JoinTypeAnti && sq != nil && comparesNullableRows(l, sq) {
+						// anti joins return rows whose join condition is NULL, which NOT IN doesn't
+						sq = nil
+					}
 				case expression.Comparer:
 					sq, _ = e.Right().(*plan.Subquery)
 					l = e.Left()
@@ -180,6 +184,26 @@ func transformJoinApply(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope,
 	return ret, transform.TreeIdentity(applyId == 0), nil
 }
 
+// comparesNullableRows returns whether the row constructor given is compared with the rows of the subquery given,
+// and either may have NULL elements.
+func comparesNullableRows(left sql.Expression, sq *plan.Subquery) bool {
+	tup, ok := left.(expression.Tuple)
+	if !ok {
+		return false
+	}
+	for _, e := range tup {
+		if e.IsNullable() {
+			return true
+		}
+	}
+	for _, c := range sq.Query.Schema() {
+		if c.Nullable {
+			return true
+		}
+	}
+	return false
+}
+
 // simplifySubqExpr converts a subquery expression into a *plan.TableAlias
 // for scopes with only tables and getField projections or the original
 // node failing simplification.
@@ -56,7 +56,7 @@ func TestAssignCatalog(t *testing.T) {
 	require.Equal(a.Catalog, di.Catalog)
 	require.Equal("foo", di.CurrentDatabase)
 
-	node, _, err = f.Apply(ctx, a, plan.NewShowProcessList(), nil, DefaultRuleSelector)
+	node, _, err = f.Apply(ctx, a, plan.NewShowProcessList(false), nil, DefaultRuleSelector)
 	require.NoError(err)
 
 	pl, ok := node.(*plan.ShowProcessList)
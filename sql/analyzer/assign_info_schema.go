@@ -15,6 +15,9 @@
 package analyzer
 
 import (
+	"sort"
+	"strings"
+
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 	"github.com/dolthub/go-mysql-server/sql/transform"
@@ -32,7 +35,13 @@ func loadInfoSchema(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel
 				return nil, transform.SameTree, err
 			}
 
-			x.IndexesToShow = filterGeneratedIndexes(tableIndexes)
+			stats, err := a.Catalog.Statistics(ctx)
+			if err != nil {
+				return nil, transform.SameTree, err
+			}
+
+			x.IndexesToShow = sortIndexesToShow(x.Child, filterGeneratedIndexes(tableIndexes))
+			x.Stats = stats
 			return x, transform.NewTree, nil
 		case *plan.ShowCreateTable:
 			if !x.IsView {
@@ -78,6 +87,37 @@ func filterGeneratedIndexes(indexes []sql.Index) []sql.Index {
 	return newIndexes
 }
 
+// sortIndexesToShow sorts the indexes of the table given in the order MySQL shows them in: the primary key first, then
+// the unique indexes of non-nullable columns, the other unique indexes, and the remaining indexes last. Indexes of the
+// same kind keep their order.
+func sortIndexesToShow(node sql.Node, indexes []sql.Index) []sql.Index {
+	table := getTable(node)
+	rank := func(idx sql.Index) int {
+		switch {
+		case strings.EqualFold(idx.ID(), "PRIMARY"):
+			return 0
+		case !idx.IsUnique():
+			return 3
+		case table == nil:
+			return 2
+		}
+		for _, expr := range idx.Expressions() {
+			col := plan.GetColumnFromIndexExpr(expr, table)
+			if col == nil || col.Nullable {
+				return 2
+			}
+		}
+		return 1
+	}
+
+	sorted := make([]sql.Index, len(indexes))
+	copy(sorted, indexes)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return rank(sorted[i]) < rank(sorted[j])
+	})
+	return sorted
+}
+
 // getIndexesForTable returns all indexes on the table represented by the node given. If the node isn't a
 // *(plan.ResolvedTable), returns an empty slice.
 func getIndexesForTable(ctx *sql.Context, a *Analyzer, node sql.Node) ([]sql.Index, error) {
@@ -87,12 +127,15 @@ func getIndexesForTable(ctx *sql.Context, a *Analyzer, node sql.Node) ([]sql.Ind
 	}
 
 	var tableName string
+	dbName := ctx.GetCurrentDatabase()
 	if rt, ok := node.(*plan.ResolvedTable); ok {
 		tableName = rt.Name()
+		if rt.Database != nil {
+			dbName = rt.Database.Name()
+		}
 	}
 
-	// TODO: get the DB out of the table, don't just use the current DB
-	tableIndexes := ia.IndexesByTable(ctx, ctx.GetCurrentDatabase(), tableName)
+	tableIndexes := ia.IndexesByTable(ctx, dbName, tableName)
 	return tableIndexes, nil
 }
 
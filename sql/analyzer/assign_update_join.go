@@ -77,7 +77,7 @@ func rowUpdatersByTable(ctx *sql.Context, node sql.Node, ij sql.Node) (map[strin
 			return nil, sql.ErrUnsupportedFeature.New("error: keyless tables unsupported for UPDATE JOIN")
 		}
 
-		rowUpdatersByTable[tableToBeUpdated] = updatable.Updater(ctx)
+		rowUpdatersByTable[tableToBeUpdated] = plan.NewRowChangeUpdater(ctx, resolvedTable.Database.Name(), updatable.Name(), updatable.Updater(ctx))
 	}
 
 	return rowUpdatersByTable, nil
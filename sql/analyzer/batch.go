@@ -15,8 +15,10 @@
 package analyzer
 
 import (
+	"fmt"
 	"reflect"
 	"strconv"
+	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql/transform"
 
@@ -62,7 +64,7 @@ func (b *Batch) EvalWithSelector(ctx *sql.Context, a *Analyzer, n sql.Node, scop
 	}
 	prev := n
 	a.PushDebugContext("0")
-	cur, _, err := b.evalOnce(ctx, a, n, scope, sel)
+	cur, _, err := b.evalOnce(ctx, a, n, scope, sel, nil)
 	a.PopDebugContext()
 	if err != nil {
 		return cur, transform.SameTree, err
@@ -74,20 +76,32 @@ func (b *Batch) EvalWithSelector(ctx *sql.Context, a *Analyzer, n sql.Node, scop
 		return cur, transform.TreeIdentity(nodesEq), nil
 	}
 
+	// The plans after each iteration and the rules that changed them in the last iterations describe why the batch
+	// doesn't converge when it reaches its max number of iterations
+	plans := []sql.Node{prev, cur}
+	var changed [][]RuleId
 	for i := 1; !nodesEq; {
 		a.Log("Nodes not equal, re-running batch")
 		a.LogDiff(prev, cur)
 		if i >= b.Iterations {
-			return cur, transform.SameTree, ErrMaxAnalysisIters.New(b.Iterations)
+			return cur, transform.SameTree, b.maxIterationsError(plans, changed)
 		}
 
 		prev = cur
+		var changedRules *[]RuleId
+		if i >= b.Iterations-2 {
+			changedRules = new([]RuleId)
+		}
 		a.PushDebugContext(strconv.Itoa(i))
-		cur, _, err = b.evalOnce(ctx, a, cur, scope, sel)
+		cur, _, err = b.evalOnce(ctx, a, cur, scope, sel, changedRules)
 		a.PopDebugContext()
 		if err != nil {
 			return cur, transform.SameTree, err
 		}
+		plans = append(plans, cur)
+		if changedRules != nil {
+			changed = append(changed, *changedRules)
+		}
 
 		//todo(max): Use nodesEqual until all rules can reliably report
 		// modifications. False positives, where a rule incorrectly states
@@ -100,10 +114,55 @@ func (b *Batch) EvalWithSelector(ctx *sql.Context, a *Analyzer, n sql.Node, scop
 	return cur, same, nil
 }
 
+// maxIterationsError returns the error of the batch when its rules still change the plan after its max number of
+// iterations. The error names the rules that changed the plan in its last iterations, as given, says whether the plans
+// given for each iteration cycle, and includes the last two changes of the plan.
+func (b *Batch) maxIterationsError(plans []sql.Node, changed [][]RuleId) error {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, ": the rules of batch %s didn't converge", b.Desc)
+
+	var names []string
+	seen := make(map[RuleId]struct{})
+	for _, ids := range changed {
+		for _, id := range ids {
+			if _, ok := seen[id]; !ok {
+				seen[id] = struct{}{}
+				names = append(names, id.String())
+			}
+		}
+	}
+	if len(names) > 0 {
+		fmt.Fprintf(&sb, ", rules changing the plan in its last iterations: %s", strings.Join(names, ", "))
+	}
+
+	last := len(plans) - 1
+	for i := last - 2; i >= 0; i-- {
+		if nodesEqual(plans[i], plans[last]) {
+			fmt.Fprintf(&sb, ", the plan repeats every %d iterations", last-i)
+			break
+		}
+	}
+
+	first := last - 1
+	if first < 1 {
+		first = 1
+	}
+	for i := first; i <= last; i++ {
+		diff, err := planDiff(plans[i-1], plans[i])
+		if err != nil {
+			return err
+		}
+		sb.WriteString("\n")
+		sb.WriteString(diff)
+	}
+	return ErrMaxAnalysisIters.New(b.Iterations, strings.TrimRight(sb.String(), "\n"))
+}
+
 // evalOnce returns the result of evaluating a batch of rules on the node given. In the result of an error, the result
 // of the last successful transformation is returned along with the error. If no transformation was successful, the
-// input node is returned as-is.
-func (b *Batch) evalOnce(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+// input node is returned as-is. The ids of the rules that changed the node are appended to the changed rules given, if
+// any.
+func (b *Batch) evalOnce(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel RuleSelector, changed *[]RuleId) (sql.Node, transform.TreeIdentity, error) {
 	var (
 		same    = transform.SameTree
 		allSame = transform.SameTree
@@ -120,11 +179,19 @@ func (b *Batch) evalOnce(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope
 		a.PushDebugContext(rule.Id.String())
 		next, same, err = rule.Apply(ctx, a, prev, scope, sel)
 		allSame = same && allSame
+		if a.Debug {
+			countRuleIteration(ctx, rule.Id, same)
+		}
 		if next != nil && !same {
+			recordFiredRule(ctx, b.Desc, rule.Id)
 			a.LogNode(next)
 			// We should only do this if the result has changed, but some rules currently misbehave and falsely report nothing
 			// changed
 			a.LogDiff(prev, next)
+			// Some rules report changes they didn't make, so the nodes are compared to tell the rules that did
+			if changed != nil && !nodesEqual(prev, next) {
+				*changed = append(*changed, rule.Id)
+			}
 		}
 		a.PopDebugContext()
 		if err != nil {
@@ -139,6 +206,39 @@ func (b *Batch) evalOnce(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope
 	return prev, allSame, nil
 }
 
+// ruleIterationsKey is the key of the ruleIterations of the analysis of a query in its context.
+type ruleIterationsKey struct{}
+
+// ruleIterations counts how many times each rule was applied during the analysis of a query, and how many times it
+// changed the plan, which are logged at the end of the analysis when the analyzer is in debug mode.
+type ruleIterations struct {
+	ids     []RuleId
+	applied map[RuleId]int
+	changed map[RuleId]int
+}
+
+// countRuleIteration counts an application of the rule given, if the context given counts them.
+func countRuleIteration(ctx *sql.Context, id RuleId, same transform.TreeIdentity) {
+	iters, ok := ctx.Value(ruleIterationsKey{}).(*ruleIterations)
+	if !ok {
+		return
+	}
+	if _, ok := iters.applied[id]; !ok {
+		iters.ids = append(iters.ids, id)
+	}
+	iters.applied[id]++
+	if !same {
+		iters.changed[id]++
+	}
+}
+
+// log logs the iterations of each rule applied.
+func (r *ruleIterations) log(a *Analyzer) {
+	for _, id := range r.ids {
+		a.Log("rule %s applied %d times, changed the plan %d times", id, r.applied[id], r.changed[id])
+	}
+}
+
 func nodesEqual(a, b sql.Node) bool {
 	if e, ok := a.(equaler); ok {
 		return e.Equal(b)
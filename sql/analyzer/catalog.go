@@ -29,6 +29,9 @@ import (
 type Catalog struct {
 	MySQLDb    *mysql_db.MySQLDb
 	InfoSchema sql.Database
+	SysSchema  sql.Database
+	// PerformanceSchema is the performance_schema database
+	PerformanceSchema sql.Database
 
 	Provider         sql.DatabaseProvider
 	builtInFunctions function.Registry
@@ -40,6 +43,7 @@ var _ sql.Catalog = (*Catalog)(nil)
 var _ sql.FunctionProvider = (*Catalog)(nil)
 var _ sql.TableFunctionProvider = (*Catalog)(nil)
 var _ sql.ExternalStoredProcedureProvider = (*Catalog)(nil)
+var _ sql.LogFlusher = (*Catalog)(nil)
 
 type tableLocks map[string]struct{}
 
@@ -50,14 +54,25 @@ type sessionLocks map[uint32]dbLocks
 // NewCatalog returns a new empty Catalog with the given provider
 func NewCatalog(provider sql.DatabaseProvider) *Catalog {
 	return &Catalog{
-		MySQLDb:          mysql_db.CreateEmptyMySQLDb(),
-		InfoSchema:       information_schema.NewInformationSchemaDatabase(),
-		Provider:         provider,
-		builtInFunctions: function.NewRegistry(),
-		locks:            make(sessionLocks),
+		MySQLDb:           mysql_db.CreateEmptyMySQLDb(),
+		InfoSchema:        information_schema.NewInformationSchemaDatabase(),
+		SysSchema:         information_schema.NewSysDatabase(),
+		PerformanceSchema: information_schema.NewPerformanceSchemaDatabase(),
+		Provider:          provider,
+		builtInFunctions:  function.NewRegistry(),
+		locks:             make(sessionLocks),
 	}
 }
 
+// provider returns the provider the statement of the context given was routed to, if it was, or the catalog's own
+// provider otherwise.
+func (c *Catalog) provider(ctx *sql.Context) sql.DatabaseProvider {
+	if p := ctx.DatabaseProvider(); p != nil {
+		return p
+	}
+	return c.Provider
+}
+
 // TODO: kill this
 func NewDatabaseProvider(dbs ...sql.Database) sql.DatabaseProvider {
 	return sql.NewDatabaseProvider(dbs...)
@@ -65,12 +80,12 @@ func NewDatabaseProvider(dbs ...sql.Database) sql.DatabaseProvider {
 
 func (c *Catalog) AllDatabases(ctx *sql.Context) []sql.Database {
 	var dbs []sql.Database
-	dbs = append(dbs, c.InfoSchema)
+	dbs = append(dbs, c.InfoSchema, c.SysSchema, c.PerformanceSchema)
 
 	if c.MySQLDb.Enabled {
-		dbs = append(dbs, mysql_db.NewPrivilegedDatabaseProvider(c.MySQLDb, c.Provider).AllDatabases(ctx)...)
+		dbs = append(dbs, mysql_db.NewPrivilegedDatabaseProvider(c.MySQLDb, c.provider(ctx)).AllDatabases(ctx)...)
 	} else {
-		dbs = append(dbs, c.Provider.AllDatabases(ctx)...)
+		dbs = append(dbs, c.provider(ctx).AllDatabases(ctx)...)
 	}
 
 	return dbs
@@ -81,10 +96,10 @@ func (c *Catalog) CreateDatabase(ctx *sql.Context, dbName string, collation sql.
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	if collatedDbProvider, ok := c.Provider.(sql.CollatedDatabaseProvider); ok {
+	if collatedDbProvider, ok := c.provider(ctx).(sql.CollatedDatabaseProvider); ok {
 		// If the database provider supports creation with a collation, then we call that function directly
 		return collatedDbProvider.CreateCollatedDatabase(ctx, dbName, collation)
-	} else if mut, ok := c.Provider.(sql.MutableDatabaseProvider); ok {
+	} else if mut, ok := c.provider(ctx).(sql.MutableDatabaseProvider); ok {
 		err := mut.CreateDatabase(ctx, dbName)
 		if err != nil {
 			return err
@@ -108,7 +123,7 @@ func (c *Catalog) RemoveDatabase(ctx *sql.Context, dbName string) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	mut, ok := c.Provider.(sql.MutableDatabaseProvider)
+	mut, ok := c.provider(ctx).(sql.MutableDatabaseProvider)
 	if ok {
 		return mut.DropDatabase(ctx, dbName)
 	} else {
@@ -116,14 +131,20 @@ func (c *Catalog) RemoveDatabase(ctx *sql.Context, dbName string) error {
 	}
 }
 
+// RegisterInformationSchemaTable adds a table to information_schema with the name and schema given, whose rows are
+// returned by rowIter. See information_schema.RegisterTable.
+func (c *Catalog) RegisterInformationSchemaTable(name string, schema sql.Schema, rowIter information_schema.RowIterFunc) error {
+	return information_schema.RegisterTable(c.InfoSchema, name, schema, rowIter)
+}
+
 func (c *Catalog) HasDB(ctx *sql.Context, db string) bool {
 	db = strings.ToLower(db)
-	if db == "information_schema" {
+	if db == "information_schema" || db == sql.SysDatabaseName || db == sql.PerformanceSchemaDatabaseName {
 		return true
 	} else if c.MySQLDb.Enabled {
-		return mysql_db.NewPrivilegedDatabaseProvider(c.MySQLDb, c.Provider).HasDatabase(ctx, db)
+		return mysql_db.NewPrivilegedDatabaseProvider(c.MySQLDb, c.provider(ctx)).HasDatabase(ctx, db)
 	} else {
-		return c.Provider.HasDatabase(ctx, db)
+		return c.provider(ctx).HasDatabase(ctx, db)
 	}
 }
 
@@ -131,10 +152,14 @@ func (c *Catalog) HasDB(ctx *sql.Context, db string) bool {
 func (c *Catalog) Database(ctx *sql.Context, db string) (sql.Database, error) {
 	if strings.ToLower(db) == "information_schema" {
 		return c.InfoSchema, nil
+	} else if strings.ToLower(db) == sql.SysDatabaseName {
+		return c.SysSchema, nil
+	} else if strings.ToLower(db) == sql.PerformanceSchemaDatabaseName {
+		return c.PerformanceSchema, nil
 	} else if c.MySQLDb.Enabled {
-		return mysql_db.NewPrivilegedDatabaseProvider(c.MySQLDb, c.Provider).Database(ctx, db)
+		return mysql_db.NewPrivilegedDatabaseProvider(c.MySQLDb, c.provider(ctx)).Database(ctx, db)
 	} else {
-		return c.Provider.Database(ctx, db)
+		return c.provider(ctx).Database(ctx, db)
 	}
 }
 
@@ -167,7 +192,7 @@ func (c *Catalog) UnlockTables(ctx *sql.Context, id uint32) error {
 	var errors []string
 	for db, tables := range c.locks[id] {
 		for t := range tables {
-			database, err := c.Provider.Database(ctx, db)
+			database, err := c.provider(ctx).Database(ctx, db)
 			if err != nil {
 				return err
 			}
@@ -253,7 +278,7 @@ func (c *Catalog) RegisterFunction(ctx *sql.Context, fns ...sql.Function) {
 
 // Function returns the function with the name given, or sql.ErrFunctionNotFound if it doesn't exist
 func (c *Catalog) Function(ctx *sql.Context, name string) (sql.Function, error) {
-	if fp, ok := c.Provider.(sql.FunctionProvider); ok {
+	if fp, ok := c.provider(ctx).(sql.FunctionProvider); ok {
 		f, err := fp.Function(ctx, name)
 		if err != nil && !sql.ErrFunctionNotFound.Is(err) {
 			return nil, err
@@ -267,7 +292,7 @@ func (c *Catalog) Function(ctx *sql.Context, name string) (sql.Function, error)
 
 // ExternalStoredProcedure implements sql.ExternalStoredProcedureProvider
 func (c *Catalog) ExternalStoredProcedure(ctx *sql.Context, name string, numOfParams int) (*sql.ExternalStoredProcedureDetails, error) {
-	if espp, ok := c.Provider.(sql.ExternalStoredProcedureProvider); ok {
+	if espp, ok := c.provider(ctx).(sql.ExternalStoredProcedureProvider); ok {
 		esp, err := espp.ExternalStoredProcedure(ctx, name, numOfParams)
 		if err != nil {
 			return nil, err
@@ -281,7 +306,7 @@ func (c *Catalog) ExternalStoredProcedure(ctx *sql.Context, name string, numOfPa
 
 // ExternalStoredProcedures implements sql.ExternalStoredProcedureProvider
 func (c *Catalog) ExternalStoredProcedures(ctx *sql.Context, name string) ([]sql.ExternalStoredProcedureDetails, error) {
-	if espp, ok := c.Provider.(sql.ExternalStoredProcedureProvider); ok {
+	if espp, ok := c.provider(ctx).(sql.ExternalStoredProcedureProvider); ok {
 		esps, err := espp.ExternalStoredProcedures(ctx, name)
 		if err != nil {
 			return nil, err
@@ -293,9 +318,17 @@ func (c *Catalog) ExternalStoredProcedures(ctx *sql.Context, name string) ([]sql
 	return nil, nil
 }
 
+// FlushLogs implements sql.LogFlusher
+func (c *Catalog) FlushLogs(ctx *sql.Context, kind sql.LogKind) error {
+	if lf, ok := c.provider(ctx).(sql.LogFlusher); ok {
+		return lf.FlushLogs(ctx, kind)
+	}
+	return nil
+}
+
 // TableFunction implements the TableFunctionProvider interface
 func (c *Catalog) TableFunction(ctx *sql.Context, name string) (sql.TableFunction, error) {
-	if fp, ok := c.Provider.(sql.TableFunctionProvider); ok {
+	if fp, ok := c.provider(ctx).(sql.TableFunctionProvider); ok {
 		tf, err := fp.TableFunction(ctx, name)
 		if err != nil {
 			return nil, err
@@ -36,9 +36,11 @@ func TestAllDatabases(t *testing.T) {
 	c := NewCatalog(sql.NewDatabaseProvider(dbs...))
 
 	databases := c.AllDatabases(sql.NewEmptyContext())
-	require.Equal(4, len(databases))
+	require.Equal(6, len(databases))
 	require.Equal("information_schema", databases[0].Name())
-	require.Equal(dbs, databases[1:])
+	require.Equal("sys", databases[1].Name())
+	require.Equal("performance_schema", databases[2].Name())
+	require.Equal(dbs, databases[3:])
 }
 
 func TestCatalogDatabase(t *testing.T) {
@@ -49,18 +49,15 @@ func validateCreateTableChecks(ctx *sql.Context, a *Analyzer, n *plan.CreateTabl
 		return nil, transform.SameTree, err
 	}
 
-	transform.InspectExpressions(n, func(e sql.Expression) bool {
+	inspectCheck := func(e sql.Expression) bool {
 		if err != nil {
 			return false
 		}
 
 		switch e := e.(type) {
-		case *expression.Wrapper, nil:
-			// column defaults, no need to inspect these
+		case nil:
 			return false
 		default:
-			// check expressions, must be validated
-			// TODO: would be better to wrap these in something else to be able to identify them better
 			err = checkExpressionValid(e)
 			if err != nil {
 				return false
@@ -77,10 +74,13 @@ func validateCreateTableChecks(ctx *sql.Context, a *Analyzer, n *plan.CreateTabl
 
 			return true
 		}
-	})
-
-	if err != nil {
-		return nil, transform.SameTree, err
+	}
+	// Only the check expressions need to be validated, not column defaults or functional index expressions
+	for _, ch := range n.ChDefs {
+		sql.Inspect(ch.Expr, inspectCheck)
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
 	}
 
 	return n, transform.SameTree, nil
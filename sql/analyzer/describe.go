@@ -15,6 +15,9 @@
 package analyzer
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 	"github.com/dolthub/go-mysql-server/sql/transform"
@@ -27,10 +30,50 @@ func resolveDescribeQuery(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scop
 		return n, transform.SameTree, nil
 	}
 
+	var fired *firedRules
+	if d.Format == "rules" {
+		fired = &firedRules{seen: make(map[string]struct{})}
+		ctx = ctx.WithContext(context.WithValue(ctx.Context, firedRulesKey{}, fired))
+	}
+
 	q, _, err := a.analyzeWithSelector(ctx, d.Query(), scope, SelectAllBatches, sel)
 	if err != nil {
 		return nil, transform.SameTree, err
 	}
 
-	return d.WithQuery(StripPassthroughNodes(q)), transform.NewTree, nil
+	nd := d.WithQuery(StripPassthroughNodes(q)).(*plan.DescribeQuery)
+	if fired != nil {
+		nd.FiredRules = fired.rules
+	}
+	if d.Format == "estimated_tree" {
+		nd.EstimatedTree, err = explainTree(ctx, a, nd.Query())
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
+	}
+	return nd, transform.NewTree, nil
+}
+
+// firedRulesKey is the key of the firedRules of the analysis of a query in its context.
+type firedRulesKey struct{}
+
+// firedRules are the rules that changed the plan of a query during its analysis, recorded for EXPLAIN FORMAT=rules.
+type firedRules struct {
+	rules []string
+	seen  map[string]struct{}
+}
+
+// recordFiredRule records that the rule given of the batch given changed the plan being analyzed, if the context given
+// records the rules fired. Each rule of a batch is only recorded the first time it fires.
+func recordFiredRule(ctx *sql.Context, batch string, id RuleId) {
+	fired, ok := ctx.Value(firedRulesKey{}).(*firedRules)
+	if !ok {
+		return
+	}
+	rule := fmt.Sprintf("%s: %s", batch, id)
+	if _, ok := fired.seen[rule]; ok {
+		return
+	}
+	fired.seen[rule] = struct{}{}
+	fired.rules = append(fired.rules, rule)
 }
@@ -0,0 +1,303 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+const (
+	// defaultTableRows is the number of rows estimated for tables without statistics, like the carder does.
+	defaultTableRows = 1000
+	// explainTreeIndent is the indentation of each level of the tree of EXPLAIN FORMAT=TREE.
+	explainTreeIndent = "    "
+)
+
+// estimatedNode is a node of a plan described by EXPLAIN FORMAT=TREE, with its estimated cost and number of rows.
+type estimatedNode struct {
+	description string
+	cost        float64
+	rows        float64
+	children    []*estimatedNode
+}
+
+// explainTree returns the lines of the plan given as printed by EXPLAIN FORMAT=TREE, which describes each node of the
+// plan like MySQL does, followed by its estimated cost and number of rows.
+func explainTree(ctx *sql.Context, a *Analyzer, n sql.Node) ([]string, error) {
+	stats, err := a.Catalog.Statistics(ctx)
+	if err != nil {
+		return nil, err
+	}
+	root, err := estimateNode(ctx, n, stats)
+	if err != nil {
+		return nil, err
+	}
+	var lines []string
+	var write func(e *estimatedNode, depth int)
+	write = func(e *estimatedNode, depth int) {
+		rows := strconv.FormatFloat(math.Round(e.rows*100)/100, 'f', -1, 64)
+		lines = append(lines, fmt.Sprintf("%s-> %s (cost=%.2f rows=%s)", strings.Repeat(explainTreeIndent, depth), e.description, e.cost, rows))
+		for _, c := range e.children {
+			write(c, depth+1)
+		}
+	}
+	write(root, 0)
+	return lines, nil
+}
+
+// estimateNode returns the estimated node of the node given, using the coster's cost factors.
+func estimateNode(ctx *sql.Context, n sql.Node, stats sql.StatsReader) (*estimatedNode, error) {
+	switch n := n.(type) {
+	case *plan.ResolvedTable:
+		rows, err := estimateTableRows(ctx, n, stats)
+		if err != nil {
+			return nil, err
+		}
+		return &estimatedNode{
+			description: "Table scan on " + sql.QuoteIdentifierIfNeeded(n.Name()),
+			cost:        rows * seqIOCostFactor,
+			rows:        rows,
+		}, nil
+	case *plan.IndexedTableAccess:
+		return estimateIndexLookup(ctx, n, n.Name(), stats)
+	case *plan.TableAlias:
+		switch child := n.Child.(type) {
+		case *plan.ResolvedTable:
+			e, err := estimateNode(ctx, child, stats)
+			if err != nil {
+				return nil, err
+			}
+			e.description = "Table scan on " + sql.QuoteIdentifierIfNeeded(n.Name())
+			return e, nil
+		case *plan.IndexedTableAccess:
+			return estimateIndexLookup(ctx, child, n.Name(), stats)
+		}
+	case *plan.EmptyTable:
+		return &estimatedNode{description: "Zero rows"}, nil
+	}
+
+	children := make([]*estimatedNode, len(n.Children()))
+	var childCost, childRows float64
+	for i, child := range n.Children() {
+		e, err := estimateNode(ctx, child, stats)
+		if err != nil {
+			return nil, err
+		}
+		children[i] = e
+		childCost += e.cost
+		childRows += e.rows
+	}
+
+	e := &estimatedNode{
+		description: describeNode(n),
+		cost:        childCost + childRows*cpuCostFactor,
+		rows:        childRows,
+		children:    children,
+	}
+	switch n := n.(type) {
+	case *plan.JoinNode:
+		estimateJoin(e, n.Op)
+	case *plan.Filter:
+		e.rows = childRows * optimisticJoinSel
+	case *plan.Limit:
+		if limit, ok := literalLimit(n.Limit); ok && limit < e.rows {
+			e.rows = limit
+		}
+	case *plan.TopN:
+		if limit, ok := literalLimit(n.Limit); ok && limit < e.rows {
+			e.rows = limit
+		}
+	case *plan.GroupBy:
+		if len(n.GroupByExprs) == 0 {
+			e.rows = 1
+		}
+	}
+	return e, nil
+}
+
+// estimateJoin estimates the cost and the number of rows of the join of the estimated node given, whose children are
+// its left and right sides, like the coster and the carder do.
+func estimateJoin(e *estimatedNode, op plan.JoinType) {
+	left, right := e.children[0], e.children[1]
+	l, r := left.rows, right.rows
+	switch {
+	case op.IsLookup():
+		// The right side of a lookup join is looked up once for each row of the left side
+		e.cost = left.cost + l*(right.cost+randIOCostFactor)
+		e.rows = l * r
+	case op.IsHash():
+		e.cost = left.cost + right.cost + l*cpuCostFactor + r*(seqIOCostFactor+memCostFactor)
+		e.rows = optimisticJoinSel * l * r
+	case op.IsMerge():
+		e.cost = left.cost + right.cost + l*cpuCostFactor
+		e.rows = optimisticJoinSel * l * r
+	default:
+		e.cost = left.cost + l*right.cost + l*r*cpuCostFactor
+		if op.IsDegenerate() {
+			e.cost *= degeneratePenalty
+			e.rows = l * r
+		} else {
+			e.rows = optimisticJoinSel * l * r
+		}
+	}
+	if op.IsPartial() {
+		e.rows = optimisticJoinSel * l
+	}
+	if op.IsLeftOuter() && e.rows < l {
+		e.rows = l
+	}
+}
+
+// estimateIndexLookup returns the estimated node of an index lookup into the table given, under the name given.
+func estimateIndexLookup(ctx *sql.Context, n *plan.IndexedTableAccess, name string, stats sql.StatsReader) (*estimatedNode, error) {
+	rows, err := estimateTableRows(ctx, n.ResolvedTable, stats)
+	if err != nil {
+		return nil, err
+	}
+	description := "Index lookup on " + sql.QuoteIdentifierIfNeeded(name)
+	idx := n.Index()
+	if idx != nil {
+		description += " using " + sql.QuoteIdentifierIfNeeded(idx.ID())
+	}
+	if idx != nil && idx.IsUnique() {
+		rows = 1
+	} else if rows *= optimisticJoinSel; rows < 1 {
+		rows = 1
+	}
+	return &estimatedNode{
+		description: description,
+		cost:        rows * randIOCostFactor,
+		rows:        rows,
+	}, nil
+}
+
+// estimateTableRows returns the number of rows of the table given, from its statistics if it has any.
+func estimateTableRows(ctx *sql.Context, n *plan.ResolvedTable, stats sql.StatsReader) (float64, error) {
+	var db string
+	if n.Database != nil {
+		db = n.Database.Name()
+	}
+	t := n.Table
+	if w, ok := t.(sql.TableWrapper); ok {
+		t = w.Underlying()
+	}
+	card, ok, err := stats.RowCount(ctx, db, t.Name())
+	if err != nil || !ok {
+		return defaultTableRows, nil
+	}
+	return float64(card), nil
+}
+
+// literalLimit returns the value of the limit given, if it's a literal.
+func literalLimit(limit sql.Expression) (float64, bool) {
+	lit, ok := limit.(*expression.Literal)
+	if !ok {
+		return 0, false
+	}
+	v, _, err := types.Float64.Convert(lit.Value())
+	if err != nil {
+		return 0, false
+	}
+	return v.(float64), true
+}
+
+// describeNode returns the description of the node given in the tree of EXPLAIN FORMAT=TREE.
+func describeNode(n sql.Node) string {
+	switch n := n.(type) {
+	case *plan.JoinNode:
+		description := describeJoin(n.Op)
+		if n.Filter != nil {
+			cond := n.Filter.String()
+			if !strings.HasPrefix(cond, "(") {
+				cond = "(" + cond + ")"
+			}
+			description += " " + cond
+		}
+		return description
+	case *plan.HashLookup:
+		return "Hash"
+	case *plan.Filter:
+		return fmt.Sprintf("Filter: %s", n.Expression)
+	case *plan.Project:
+		return fmt.Sprintf("Project: %s", joinExpressions(n.Projections))
+	case *plan.Sort:
+		return fmt.Sprintf("Sort: %s", joinSortFields(n.SortFields))
+	case *plan.TopN:
+		return fmt.Sprintf("Sort: %s, limit input to %s row(s) per chunk", joinSortFields(n.Fields), n.Limit)
+	case *plan.Limit:
+		return fmt.Sprintf("Limit: %s row(s)", n.Limit)
+	case *plan.GroupBy:
+		if len(n.GroupByExprs) == 0 {
+			return "Aggregate"
+		}
+		return fmt.Sprintf("Group aggregate: %s", joinExpressions(n.GroupByExprs))
+	case *plan.SubqueryAlias:
+		return "Table scan on " + sql.QuoteIdentifierIfNeeded(n.Name())
+	}
+	// Other nodes are described by the first line of their string
+	description, _, _ := strings.Cut(n.String(), "\n")
+	return strings.TrimSpace(description)
+}
+
+// describeJoin returns the description of a join of the type given, like MySQL's.
+func describeJoin(op plan.JoinType) string {
+	kind := "inner"
+	switch {
+	case op.IsLeftOuter():
+		kind = "left"
+	case op.IsRightOuter():
+		kind = "right"
+	case op.IsFullOuter():
+		kind = "full outer"
+	case op.IsSemi():
+		kind = "semi"
+	case op.IsAnti():
+		kind = "anti"
+	case op.IsDegenerate():
+		kind = "cross"
+	}
+	switch {
+	case op.IsHash():
+		return strings.ToUpper(kind[:1]) + kind[1:] + " hash join"
+	case op.IsMerge():
+		return strings.ToUpper(kind[:1]) + kind[1:] + " merge join"
+	default:
+		return "Nested loop " + kind + " join"
+	}
+}
+
+func joinExpressions(exprs []sql.Expression) string {
+	strs := make([]string, len(exprs))
+	for i, e := range exprs {
+		strs[i] = e.String()
+	}
+	return strings.Join(strs, ", ")
+}
+
+func joinSortFields(fields sql.SortFields) string {
+	strs := make([]string, len(fields))
+	for i, f := range fields {
+		strs[i] = f.String()
+	}
+	return strings.Join(strs, ", ")
+}
@@ -0,0 +1,329 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function/aggregation"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+// scalarSubqueryMatch describes a correlated scalar subquery that can be rewritten as a left join against a grouped
+// derived table.
+type scalarSubqueryMatch struct {
+	// outer are the outer scope sides of the correlation equalities
+	outer []sql.Expression
+	// inner are the subquery sides of the correlation equalities, matched positionally with outer
+	inner []sql.Expression
+	// filters are the remaining uncorrelated filters of the subquery
+	filters []sql.Expression
+	// aggs are the aggregations the subquery computes
+	aggs []sql.Expression
+	// result is the single projected expression of the subquery, defined over the aggregations
+	result sql.Expression
+	// source is the uncorrelated relation beneath the subquery filter
+	source sql.Node
+}
+
+// flattenScalarSubqueries converts correlated scalar subqueries in the select list of a GROUP BY query into left joins
+// against grouped derived tables, so that the subquery is evaluated once per distinct correlation key rather than
+// once per group. Only subqueries correlated through equalities and computing aggregates that are NULL over an empty
+// input are converted; the rest are left for cacheSubqueryResults to memoize.
+// TODO: flatten subqueries in nested scopes; field indexes of the derived table are only correct for the root scope
+func flattenScalarSubqueries(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	if !scope.IsEmpty() {
+		return n, transform.SameTree, nil
+	}
+	switch n.(type) {
+	case *plan.DeleteFrom, *plan.InsertInto, *plan.Update:
+		return n, transform.SameTree, nil
+	}
+
+	var subqId int
+	return transform.Node(n, func(n sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		gb, ok := n.(*plan.GroupBy)
+		if !ok || len(gb.GroupByExprs) == 0 {
+			return n, transform.SameTree, nil
+		}
+
+		var groupBys []string
+		for _, e := range gb.GroupByExprs {
+			groupBys = append(groupBys, e.String())
+		}
+
+		// A sort of the grouped rows stays above the joins with the derived tables, which only append columns to the
+		// rows it sorts. The joins are planned by the join order builder, so their other side must be a relation it
+		// can plan.
+		child := gb.Child
+		sort, sorted := child.(*plan.Sort)
+		if sorted {
+			child = sort.Child
+		}
+		if !isJoinableRelation(child) {
+			return n, transform.SameTree, nil
+		}
+
+		scopeLen := len(scope.newScopeFromSubqueryExpression(gb).Schema())
+		grouping := gb.GroupByExprs
+		selected := make([]sql.Expression, len(gb.SelectedExprs))
+		same := transform.SameTree
+		for i, e := range gb.SelectedExprs {
+			newE, sameE, err := transform.Expr(e, func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+				sq, ok := e.(*plan.Subquery)
+				if !ok {
+					return e, transform.SameTree, nil
+				}
+				m := matchScalarSubquery(sq, scopeLen)
+				if m == nil || !expressionReferencesOnlyGroupBys(groupBys, [2]int{}, expression.JoinAnd(m.outer...)) {
+					return e, transform.SameTree, nil
+				}
+
+				name := fmt.Sprintf("scalarSubq%d", subqId)
+				subqId++
+
+				newChild, value, err := flattenScalarSubquery(a, scope, child, name, sq, m)
+				if err != nil {
+					return nil, transform.SameTree, err
+				}
+				child = newChild
+				// The subquery result is functionally dependent on the grouping columns it is correlated with, so
+				// grouping by it as well leaves the groups unchanged.
+				grouping = append(grouping, value)
+				return value, transform.NewTree, nil
+			})
+			if err != nil {
+				return nil, transform.SameTree, err
+			}
+			if !sameE {
+				same = transform.NewTree
+				if _, ok := e.(*plan.Subquery); ok {
+					// preserve the name of the column in the result schema
+					newE = expression.NewAlias(e.String(), newE)
+				}
+			}
+			selected[i] = newE
+		}
+		if same {
+			return n, transform.SameTree, nil
+		}
+		if sorted {
+			child = plan.NewSort(sort.SortFields, child)
+		}
+
+		selected, _, err := FixFieldIndexesOnExpressions(scope, a, child.Schema(), selected...)
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
+		grouping, _, err = FixFieldIndexesOnExpressions(scope, a, child.Schema(), grouping...)
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
+		return plan.NewGroupBy(selected, grouping, child), transform.NewTree, nil
+	})
+}
+
+// flattenScalarSubquery joins |child| with a derived table computing the result of |sq| for each correlation key,
+// returning the new relation and an expression referencing the subquery result in it.
+func flattenScalarSubquery(a *Analyzer, scope *Scope, child sql.Node, name string, sq *plan.Subquery, m *scalarSubqueryMatch) (sql.Node, sql.Expression, error) {
+	var source = m.source
+	if len(m.filters) > 0 {
+		source = plan.NewFilter(expression.JoinAnd(m.filters...), source)
+	}
+
+	// The derived table groups the subquery source by the inner correlation expressions, projecting those
+	// expressions followed by the aggregations.
+	selected := make([]sql.Expression, 0, len(m.inner)+len(m.aggs))
+	for i, e := range m.inner {
+		selected = append(selected, expression.NewAlias(fmt.Sprintf("corr%d", i), e))
+	}
+	selected = append(selected, m.aggs...)
+	grouped := plan.NewGroupBy(selected, m.inner, source)
+	derived, _, err := FixFieldIndexesForNode(a, nil, grouped)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sqa := plan.NewSubqueryAlias(name, sq.QueryString, derived)
+	sch := sqa.Schema()
+	joinSch := append(append(sql.Schema{}, child.Schema()...), sch...)
+
+	var conds []sql.Expression
+	for i, e := range m.outer {
+		c := sch[i]
+		conds = append(conds, expression.NewEquals(e, expression.NewGetFieldWithTable(0, c.Type, name, c.Name, c.Nullable)))
+	}
+	cond, _, err := FixFieldIndexes(scope, a, joinSch, expression.JoinAnd(conds...))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The result expression of the subquery is rewritten to reference the aggregation columns of the derived table
+	aggCols := sch[len(m.inner):]
+	value, _, err := transform.Expr(m.result, func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+		gf, ok := e.(*expression.GetField)
+		if !ok {
+			return e, transform.SameTree, nil
+		}
+		for _, c := range aggCols {
+			if c.Name == gf.Name() {
+				return expression.NewGetFieldWithTable(0, c.Type, name, c.Name, true), transform.NewTree, nil
+			}
+		}
+		return nil, transform.SameTree, ErrFieldMissing.New(gf.Name())
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if a, ok := value.(*expression.Alias); ok {
+		value = a.Child
+	}
+
+	var comment string
+	if c, ok := child.(sql.CommentedNode); ok {
+		comment = c.Comment()
+	}
+	return plan.NewJoin(child, sqa, plan.JoinTypeLeftOuter, cond).WithComment(comment), value, nil
+}
+
+// isJoinableRelation returns whether the node given is a relation the join order builder can plan as a side of a
+// join: a table, a table alias, a derived table, or a join or filter of those.
+func isJoinableRelation(n sql.Node) bool {
+	switch n := n.(type) {
+	case *plan.Filter:
+		return isJoinableRelation(n.Child)
+	case *plan.JoinNode:
+		return isJoinableRelation(n.Left()) && isJoinableRelation(n.Right())
+	case *plan.ResolvedTable, *plan.TableAlias, *plan.SubqueryAlias:
+		return true
+	default:
+		return false
+	}
+}
+
+// matchScalarSubquery returns the decomposition of the subquery given if it can be flattened, or nil otherwise. A
+// flattenable subquery has the shape
+//
+//	Project(result) -> GroupBy(aggs; no grouping) -> Filter(inner = outer AND ...) -> uncorrelated source
+//
+// where |result| is NULL whenever every aggregation is NULL, so that the NULL rows produced by the left join for
+// missing correlation keys match the result the subquery would produce over an empty input.
+func matchScalarSubquery(sq *plan.Subquery, scopeLen int) *scalarSubqueryMatch {
+	m := &scalarSubqueryMatch{}
+	n := StripPassthroughNodes(sq.Query)
+	nested := false
+	transform.InspectExpressions(n, func(e sql.Expression) bool {
+		nested = nested || containsSubquery(e)
+		return !nested
+	})
+	if nested {
+		// field indexes of nested subqueries are relative to the scope row of the subquery
+		return nil
+	}
+	if p, ok := n.(*plan.Project); ok {
+		if len(p.Projections) != 1 {
+			return nil
+		}
+		m.result = p.Projections[0]
+		n = p.Child
+	}
+
+	gb, ok := n.(*plan.GroupBy)
+	if !ok || len(gb.GroupByExprs) > 0 || len(gb.SelectedExprs) == 0 {
+		return nil
+	}
+	for _, e := range gb.SelectedExprs {
+		switch e.(type) {
+		case *aggregation.Max, *aggregation.Min, *aggregation.Sum, *aggregation.Avg:
+		default:
+			return nil
+		}
+		if !exprIsCacheable(e, scopeLen) {
+			return nil
+		}
+	}
+	m.aggs = gb.SelectedExprs
+	if m.result == nil {
+		if len(gb.SelectedExprs) != 1 {
+			return nil
+		}
+		c := gb.Schema()[0]
+		m.result = expression.NewGetField(0, c.Type, c.Name, c.Nullable)
+	}
+	if !isNullOnNullAggs(m.result) {
+		return nil
+	}
+
+	f, ok := gb.Child.(*plan.Filter)
+	if !ok || !nodeIsCacheable(f.Child, scopeLen) {
+		return nil
+	}
+	m.source = f.Child
+
+	for _, e := range splitConjunction(f.Expression) {
+		if exprIsCacheable(e, scopeLen) {
+			m.filters = append(m.filters, e)
+			continue
+		}
+		eq, ok := e.(*expression.Equals)
+		if !ok {
+			return nil
+		}
+		l, r := eq.Left(), eq.Right()
+		lOuter, rOuter := referencesOnlyOuterScope(l, scopeLen), referencesOnlyOuterScope(r, scopeLen)
+		switch {
+		case lOuter && exprIsCacheable(r, scopeLen):
+			m.outer, m.inner = append(m.outer, l), append(m.inner, r)
+		case rOuter && exprIsCacheable(l, scopeLen):
+			m.outer, m.inner = append(m.outer, r), append(m.inner, l)
+		default:
+			return nil
+		}
+	}
+	if len(m.outer) == 0 {
+		return nil
+	}
+	return m
+}
+
+// referencesOnlyOuterScope returns whether the expression given references at least one column, and only columns of
+// the scope below |scopeLen|.
+func referencesOnlyOuterScope(e sql.Expression, scopeLen int) bool {
+	found := false
+	valid := !transform.InspectExpr(e, func(e sql.Expression) bool {
+		switch e := e.(type) {
+		case *expression.GetField:
+			found = true
+			return e.Index() >= scopeLen
+		case *plan.Subquery, *deferredColumn, sql.NonDeterministicExpression:
+			return true
+		}
+		return false
+	})
+	return valid && found
+}
+
+// isNullOnNullAggs returns whether the subquery result expression given is a plain reference to an aggregation, which
+// makes it NULL when the aggregation input is empty.
+func isNullOnNullAggs(e sql.Expression) bool {
+	if a, ok := e.(*expression.Alias); ok {
+		e = a.Child
+	}
+	_, ok := e.(*expression.GetField)
+	return ok
+}
@@ -77,6 +77,10 @@ func hoistSelectExists(
 	scope *Scope,
 	sel RuleSelector,
 ) (sql.Node, transform.TreeIdentity, error) {
+	// The target of a DELETE has to be its only table, so it can't be joined with the subquery
+	if _, ok := n.(*plan.DeleteFrom); ok {
+		return n, transform.SameTree, nil
+	}
 	aliasDisambig := newAliasDisambiguator(n, scope)
 
 	return transform.Node(n, func(n sql.Node) (sql.Node, transform.TreeIdentity, error) {
@@ -0,0 +1,171 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+// pushdownIndexConditions moves the predicates of a filter directly above a static index lookup that only reference
+// columns of the lookup's index down to its index scan, like MySQL's index condition pushdown, so that the entries of
+// the index that don't match them are skipped before the rest of their rows are read. Only predicates on columns the
+// ranges of the lookup don't restrict are pushed down, as the others are already used by the lookup. This is only
+// done for tables
+// that implement sql.IndexConditionPushdownTable, when the index_condition_pushdown flag of optimizer_switch is on.
+// The predicates are pushed down after the plan is validated, since the validation rules don't see them once they're
+// part of a table.
+func pushdownIndexConditions(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	if !n.Resolved() || !optimizerSwitchEnabled(ctx, "index_condition_pushdown") {
+		return n, transform.SameTree, nil
+	}
+
+	return transform.Node(n, func(n sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		filter, ok := n.(*plan.Filter)
+		if !ok {
+			return n, transform.SameTree, nil
+		}
+
+		var ita *plan.IndexedTableAccess
+		var alias *plan.TableAlias
+		switch child := filter.Child.(type) {
+		case *plan.IndexedTableAccess:
+			ita = child
+		case *plan.TableAlias:
+			if ita, ok = child.Child.(*plan.IndexedTableAccess); !ok {
+				return n, transform.SameTree, nil
+			}
+			alias = child
+		default:
+			return n, transform.SameTree, nil
+		}
+		if _, ok := ita.Table.(sql.IndexConditionPushdownTable); !ok || !ita.IsStatic() || ita.Index().IsSpatial() {
+			return n, transform.SameTree, nil
+		}
+
+		name := ita.Name()
+		if alias != nil {
+			name = alias.Name()
+		}
+		positions := indexEntryPositions(ita.Index(), name)
+		restricted := restrictedIndexColumns(plan.GetIndexLookup(ita))
+
+		var pushed, remaining []sql.Expression
+		for _, e := range splitConjunction(filter.Expression) {
+			cond, cols, ok := indexEntryExpression(e, positions)
+			if !ok || anyRestricted(cols, restricted) {
+				remaining = append(remaining, e)
+				continue
+			}
+			pushed = append(pushed, cond)
+		}
+		if len(pushed) == 0 {
+			return n, transform.SameTree, nil
+		}
+		a.Log("pushed down %d filters of table %q to the scan of index %s", len(pushed), name, ita.Index().ID())
+
+		conds := pushed
+		if cond := ita.IndexCondition(); cond != nil {
+			conds = append([]sql.Expression{cond}, pushed...)
+		}
+		var child sql.Node
+		child, err := ita.WithIndexCondition(expression.JoinAnd(conds...))
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
+		if alias != nil {
+			if child, err = alias.WithChildren(child); err != nil {
+				return nil, transform.SameTree, err
+			}
+		}
+		if len(remaining) == 0 {
+			return child, transform.NewTree, nil
+		}
+		return plan.NewFilter(expression.JoinAnd(remaining...), child), transform.NewTree, nil
+	})
+}
+
+// indexEntryPositions returns the position of each of the columns of the index given in its entries, keyed by the
+// lower case name of the column qualified by the table name given. Columns indexed by a prefix of their values are
+// left out, since their values aren't in the entries.
+func indexEntryPositions(idx sql.Index, tableName string) map[string]int {
+	positions := make(map[string]int)
+	prefixLengths := idx.PrefixLengths()
+	for i, e := range idx.Expressions() {
+		if i < len(prefixLengths) && prefixLengths[i] > 0 {
+			continue
+		}
+		// Index expressions of columns are their names qualified by the name of the table, not its alias. Functional
+		// key parts can't be matched with the fields of predicates.
+		if strings.ContainsAny(e, "( ") {
+			continue
+		}
+		col := e[strings.LastIndex(e, ".")+1:]
+		positions[strings.ToLower(tableName+"."+col)] = i
+	}
+	return positions
+}
+
+// restrictedIndexColumns returns the positions of the columns of the index of the lookup given that any of its ranges
+// restricts the values of.
+func restrictedIndexColumns(lookup sql.IndexLookup) map[int]bool {
+	restricted := make(map[int]bool)
+	for _, rang := range lookup.Ranges {
+		for i, rce := range rang {
+			if rce.Type() != sql.RangeType_All {
+				restricted[i] = true
+			}
+		}
+	}
+	return restricted
+}
+
+// anyRestricted returns whether any of the index column positions given is restricted.
+func anyRestricted(cols []int, restricted map[int]bool) bool {
+	for _, c := range cols {
+		if restricted[c] {
+			return true
+		}
+	}
+	return false
+}
+
+// indexEntryExpression returns the predicate given with its fields indexed by their positions in the entries of an
+// index, and those positions, or false if it can't be evaluated on the entries alone.
+func indexEntryExpression(e sql.Expression, positions map[string]int) (sql.Expression, []int, bool) {
+	if !isDeterministic(e) || exprHasBindVar(e) {
+		return nil, nil, false
+	}
+	var cols []int
+	inIndex := true
+	e, _, _ = transform.Expr(e, func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+		gf, ok := e.(*expression.GetField)
+		if !ok {
+			return e, transform.SameTree, nil
+		}
+		pos, ok := positions[strings.ToLower(gf.Table()+"."+gf.Name())]
+		if !ok {
+			inIndex = false
+			return e, transform.SameTree, nil
+		}
+		cols = append(cols, pos)
+		return gf.WithIndex(pos), transform.NewTree, nil
+	})
+	return e, cols, inIndex && len(cols) > 0
+}
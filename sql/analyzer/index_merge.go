@@ -0,0 +1,197 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+// applyIndexMerge replaces a table beneath a filter on a disjunction with an index merge of the table, when each of
+// the disjuncts can be read with a lookup on one of its indexes, but not all on the same one. Disjunctions whose
+// lookups are all on the same index are already read with a single lookup by pushdownFilters. Index merges are only
+// used when the index_merge and index_merge_union flags of optimizer_switch are on.
+// TODO: index merge intersections of the lookups of conjunctions
+func applyIndexMerge(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	// Rows of subqueries are prefixed with the outer scope's row, which the predicates of the merge don't account for
+	if !scope.IsEmpty() || !n.Resolved() {
+		return n, transform.SameTree, nil
+	}
+	if !optimizerSwitchEnabled(ctx, "index_merge") || !optimizerSwitchEnabled(ctx, "index_merge_union") {
+		return n, transform.SameTree, nil
+	}
+
+	// Updates and deletes edit the rows they read, and the later lookups of a merge could read the edited rows again
+	var edits bool
+	transform.Inspect(n, func(n sql.Node) bool {
+		switch n.(type) {
+		case *plan.Update, *plan.DeleteFrom:
+			edits = true
+		}
+		return !edits
+	})
+	if edits {
+		return n, transform.SameTree, nil
+	}
+
+	tableAliases, err := getTableAliases(n, scope)
+	if err != nil {
+		return nil, transform.SameTree, err
+	}
+
+	return transform.Node(n, func(n sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		filter, ok := n.(*plan.Filter)
+		if !ok {
+			return n, transform.SameTree, nil
+		}
+
+		var rt *plan.ResolvedTable
+		var alias *plan.TableAlias
+		switch child := filter.Child.(type) {
+		case *plan.ResolvedTable:
+			rt = child
+		case *plan.TableAlias:
+			if rt, ok = child.Child.(*plan.ResolvedTable); !ok {
+				return n, transform.SameTree, nil
+			}
+			alias = child
+		default:
+			return n, transform.SameTree, nil
+		}
+
+		merge, err := getIndexMerge(ctx, filter, rt, tableAliases)
+		if err != nil || merge == nil {
+			return n, transform.SameTree, err
+		}
+
+		var child sql.Node = merge
+		if alias != nil {
+			if child, err = alias.WithChildren(merge); err != nil {
+				return nil, transform.SameTree, err
+			}
+		}
+		n, err = filter.WithChildren(child)
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
+		return n, transform.NewTree, nil
+	})
+}
+
+// getIndexMerge returns an index merge of the table given for the first of the conjuncts of the filter given that is a
+// disjunction with a lookup on an index of the table for each of its disjuncts, or nil if there's no such conjunct. A
+// negated conjunction is the disjunction of the negations of its conjuncts.
+func getIndexMerge(ctx *sql.Context, filter *plan.Filter, rt *plan.ResolvedTable, tableAliases TableAliases) (*plan.IndexMerge, error) {
+	ia, err := newIndexAnalyzerForNode(ctx, filter.Child)
+	if err != nil {
+		return nil, err
+	}
+	defer ia.releaseUsedIndexes()
+
+	name := filter.Child.(sql.Nameable).Name()
+	for _, e := range splitConjunction(filter.Expression) {
+		disjuncts := negatedConjuncts(e)
+		if disjuncts == nil {
+			disjuncts = splitDisjunction(e)
+		}
+		if len(disjuncts) < 2 {
+			continue
+		}
+
+		lookups := make([]*plan.IndexedTableAccess, 0, len(disjuncts))
+		indexes := make(map[string]struct{})
+		for _, d := range disjuncts {
+			// Each predicate is evaluated again for the rows of the lookups after its own
+			if !isDeterministic(d) {
+				break
+			}
+			result, err := getIndexes(ctx, ia, convertIsNullForIndexes(ctx, d), tableAliases)
+			if err != nil {
+				return nil, err
+			}
+			l, ok := result[name]
+			if !ok || l.lookup.IsEmpty() {
+				break
+			}
+			ita, err := plan.NewStaticIndexedAccessForResolvedTable(rt, l.lookup)
+			if plan.ErrInvalidLookupForIndexedTable.Is(err) {
+				break
+			} else if err != nil {
+				return nil, err
+			}
+			lookups = append(lookups, ita)
+			indexes[strings.ToLower(l.lookup.Index.ID())] = struct{}{}
+		}
+
+		if len(lookups) == len(disjuncts) && len(indexes) > 1 {
+			return plan.NewIndexMerge(lookups, disjuncts), nil
+		}
+	}
+	return nil, nil
+}
+
+// negatedConjuncts returns the negation of each of the conjuncts of the expression given if it's a negated conjunction,
+// which is the disjunction of them, or nil otherwise.
+func negatedConjuncts(e sql.Expression) []sql.Expression {
+	not, ok := e.(*expression.Not)
+	if !ok {
+		return nil
+	}
+	if _, ok := not.Child.(*expression.And); !ok {
+		return nil
+	}
+	conjuncts := splitConjunction(not.Child)
+	negated := make([]sql.Expression, len(conjuncts))
+	for i, c := range conjuncts {
+		negated[i] = expression.NewNot(c)
+	}
+	return negated
+}
+
+// isDeterministic returns whether the expression given always evaluates to the same value for the same row.
+func isDeterministic(e sql.Expression) bool {
+	deterministic := true
+	sql.Inspect(e, func(e sql.Expression) bool {
+		switch e := e.(type) {
+		case *plan.Subquery:
+			deterministic = false
+		case sql.NonDeterministicExpression:
+			deterministic = !e.IsNonDeterministic()
+		}
+		return deterministic
+	})
+	return deterministic
+}
+
+// optimizerSwitchEnabled returns whether the flag of the optimizer_switch system variable given is on. Flags that
+// aren't in the variable's value are on, like those of the optimizer features supported.
+func optimizerSwitchEnabled(ctx *sql.Context, flag string) bool {
+	val, err := ctx.GetSessionVariable(ctx, "optimizer_switch")
+	if err != nil {
+		return true
+	}
+	s, _ := val.(string)
+	for _, setting := range strings.Split(s, ",") {
+		name, value, ok := strings.Cut(setting, "=")
+		if ok && strings.EqualFold(strings.TrimSpace(name), flag) {
+			return !strings.EqualFold(strings.TrimSpace(value), "off")
+		}
+	}
+	return true
+}
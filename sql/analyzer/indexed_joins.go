@@ -591,6 +591,10 @@ IndexExpressions:
 		for j, col := range joinColExprs {
 			// check same column name
 			if strings.ToLower(idxExprs[i]) == strings.ToLower(normalizeExpression(tableAliases, col.col).String()) {
+				// strings compared with numbers match values that sort differently in the index
+				if expression.CompareAsDoubles(col.colExpr.Type(), col.comparand.Type()) {
+					return nil, nil
+				}
 				// get field into left table
 				keyExprs[i] = joinColExprs[j].comparand
 				nullmask[i] = joinColExprs[j].matchnull
@@ -666,8 +670,14 @@ func addHashJoins(m *Memo) error {
 
 		var innerExpr, outerExpr []sql.Expression
 		for _, f := range join.filter {
-			switch f := f.(type) {
-			case *expression.Equals:
+			switch f.(type) {
+			case *expression.Equals, *expression.NullSafeEquals:
+				// NULL keys hash like any other value, and the join filter rejects them for plain equality
+				f := f.(expression.Comparer)
+				// strings compared with numbers can be equal without hashing to the same key
+				if expression.CompareAsDoubles(f.Left().Type(), f.Right().Type()) {
+					return nil
+				}
 				if exprMapsToSource(f.Left(), join.left, m.tableProps) &&
 					exprMapsToSource(f.Right(), join.right, m.tableProps) {
 					innerExpr = append(innerExpr, f.Left())
@@ -751,9 +761,9 @@ func addMergeJoins(m *Memo) error {
 		for i, f := range join.filter {
 			var l, r sql.Expression
 			switch f := f.(type) {
-			case *expression.Equals:
-				l = f.Left()
-				r = f.Right()
+			case *expression.Equals, *expression.NullSafeEquals:
+				l = f.(expression.Comparer).Left()
+				r = f.(expression.Comparer).Right()
 			default:
 				continue
 			}
@@ -781,6 +791,11 @@ func addMergeJoins(m *Memo) error {
 				continue
 			}
 
+			// strings compared with numbers are not ordered the same way as their indexes
+			if expression.CompareAsDoubles(l.Type(), r.Type()) {
+				continue
+			}
+
 			if tab, ok := aliases[ltc.table]; ok {
 				ltc = tableCol{table: strings.ToLower(tab.Name()), col: ltc.col}
 			}
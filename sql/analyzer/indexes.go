@@ -16,6 +16,7 @@ package analyzer
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
@@ -55,6 +56,11 @@ func getIndexes(
 	e sql.Expression,
 	tableAliases TableAliases,
 ) (indexLookupsByTable, error) {
+	// Comparisons of row constructors are matched to indexes on their elements
+	if expanded := expandRowComparison(e); expanded != nil {
+		return getIndexes(ctx, ia, expanded, tableAliases)
+	}
+
 	var result = make(indexLookupsByTable)
 	switch e := e.(type) {
 	case *expression.Or:
@@ -191,12 +197,12 @@ func getIndexes(
 			return result, err
 		}
 
-		getField := expression.ExtractGetField(e)
-		if getField == nil {
+		table := extractTableName(e)
+		if table == "" {
 			return result, nil
 		}
 
-		result[getField.Table()] = lookup
+		result[table] = lookup
 	case *expression.IsNull:
 		return getIndexes(ctx, ia, expression.NewNullSafeEquals(e.Child, expression.NewLiteral(nil, types.Null)), tableAliases)
 	case *expression.Not:
@@ -374,13 +380,13 @@ func getComparisonIndexLookup(
 		return nil, nil
 	}
 
-	gf := expression.ExtractGetField(left)
-	if gf == nil {
+	table := extractTableName(left)
+	if table == "" {
 		return nil, nil
 	}
 
 	normalizedExpressions := normalizeExpressions(tableAliases, left)
-	idx := ia.MatchingIndex(ctx, ctx.GetCurrentDatabase(), gf.Table(), normalizedExpressions...)
+	idx := ia.MatchingIndex(ctx, ctx.GetCurrentDatabase(), table, normalizedExpressions...)
 
 	if idx == nil {
 		return nil, nil
@@ -435,6 +441,122 @@ func getComparisonIndexLookup(
 	}, nil
 }
 
+// expandRowComparison returns the comparisons of the elements of row constructors that are equivalent to the
+// comparison of the rows given, or nil if it doesn't compare a row of columns with constant rows. (a, b) = (1, 2)
+// becomes a = 1 AND b = 2, (a, b) IN ((1, 2), (3, 4)) becomes (a = 1 AND b = 2) OR (a = 3 AND b = 4), and
+// (a, b) > (1, 2) becomes a > 1 OR (a = 1 AND b > 2). The comparisons of the elements are only used to find index
+// lookups: the original filter is kept, since comparing rows with NULL elements isn't always the same.
+func expandRowComparison(e sql.Expression) sql.Expression {
+	switch e := e.(type) {
+	case *expression.InTuple, *expression.HashInTuple:
+		cmp := e.(expression.Comparer)
+		left, ok := cmp.Left().(expression.Tuple)
+		if !ok || isEvaluable(left) || !isEvaluable(cmp.Right()) {
+			return nil
+		}
+		right, ok := cmp.Right().(expression.Tuple)
+		if !ok {
+			return nil
+		}
+		var rows []sql.Expression
+		for _, el := range right {
+			row, ok := el.(expression.Tuple)
+			if !ok || len(row) != len(left) {
+				return nil
+			}
+			rows = append(rows, expandRowEquality(left, row, false))
+		}
+		return expression.JoinOr(rows...)
+	case *expression.Equals,
+		*expression.NullSafeEquals,
+		*expression.LessThan,
+		*expression.GreaterThan,
+		*expression.LessThanOrEqual,
+		*expression.GreaterThanOrEqual:
+		cmp := e.(expression.Comparer)
+		if isEvaluable(cmp.Left()) {
+			_, _, cmp = swapTermsOfExpression(cmp)
+		}
+		left, lok := cmp.Left().(expression.Tuple)
+		right, rok := cmp.Right().(expression.Tuple)
+		if !lok || !rok || len(left) != len(right) || isEvaluable(left) || !isEvaluable(right) {
+			return nil
+		}
+
+		switch cmp.(type) {
+		case *expression.Equals:
+			return expandRowEquality(left, right, false)
+		case *expression.NullSafeEquals:
+			return expandRowEquality(left, right, true)
+		}
+
+		// Rows are ordered by the first elements that differ, so each disjunct matches the rows whose leading elements
+		// equal those of the constant row up to the element they're ordered by. Only the last element is compared
+		// inclusively for <= and >=.
+		var disjuncts []sql.Expression
+		for i := range left {
+			op := cmp
+			if i < len(left)-1 {
+				switch cmp.(type) {
+				case *expression.LessThanOrEqual:
+					op = expression.NewLessThan(nil, nil)
+				case *expression.GreaterThanOrEqual:
+					op = expression.NewGreaterThan(nil, nil)
+				}
+			}
+			last, err := op.WithChildren(left[i], right[i])
+			if err != nil {
+				return nil
+			}
+			conjuncts := []sql.Expression{expandRowEquality(left[:i], right[:i], false), last}
+			if i == 0 {
+				conjuncts = conjuncts[1:]
+			}
+			disjuncts = append(disjuncts, expression.JoinAnd(conjuncts...))
+		}
+		return expression.JoinOr(disjuncts...)
+	default:
+		return nil
+	}
+}
+
+// expandRowEquality returns the conjunction of the equalities of the elements of the rows given, compared with <=>
+// if nullSafe is set.
+func expandRowEquality(left, right expression.Tuple, nullSafe bool) sql.Expression {
+	eqs := make([]sql.Expression, len(left))
+	for i := range left {
+		if nullSafe {
+			eqs[i] = expression.NewNullSafeEquals(left[i], right[i])
+		} else {
+			eqs[i] = expression.NewEquals(left[i], right[i])
+		}
+	}
+	return expression.JoinAnd(eqs...)
+}
+
+// extractTableName returns the name of the table whose columns the expression given references, or an empty string if
+// it references no columns or the columns of more than one table. Unlike expression.ExtractGetField, an expression of
+// several columns of the same table, such as the expression of a functional index, is permitted.
+func extractTableName(e sql.Expression) string {
+	var table string
+	multipleTables := false
+	sql.Inspect(e, func(e sql.Expression) bool {
+		if gf, ok := e.(*expression.GetField); ok {
+			if table == "" {
+				table = gf.Table()
+			} else if !strings.EqualFold(table, gf.Table()) {
+				multipleTables = true
+				return false
+			}
+		}
+		return true
+	})
+	if multipleTables {
+		return ""
+	}
+	return table
+}
+
 // Returns an equivalent expression to the one given with the left and right terms reversed. The new left and right side
 // of the expression are returned as well.
 func swapTermsOfExpression(e expression.Comparer) (left sql.Expression, right sql.Expression, newExpr expression.Comparer) {
@@ -493,8 +615,8 @@ func getNegatedIndexes(
 		_, nullsafe := not.Child.(*expression.NullSafeEquals)
 		var lookup sql.IndexLookup
 
-		if nullsafe && value == nil {
-			lookup, err = sql.NewIndexBuilder(idx).IsNotNull(ctx, normalizedExpressions[0].String()).Build(ctx)
+		if nullsafe {
+			lookup, err = sql.NewIndexBuilder(idx).NullSafeNotEquals(ctx, normalizedExpressions[0].String(), value).Build(ctx)
 		} else {
 			lookup, err = sql.NewIndexBuilder(idx).NotEquals(ctx, normalizedExpressions[0].String(), value).Build(ctx)
 		}
@@ -548,7 +670,7 @@ func getNegatedIndexes(
 					idxBuilder = idxBuilder.NotEquals(ctx, normalizedExpressions[0].String(), val)
 				}
 				lookup, err := idxBuilder.Build(ctx)
-				if err != nil {
+				if err != nil || lookup.IsEmpty() {
 					return nil, err
 				}
 
@@ -826,8 +948,8 @@ func getMultiColumnIndexForExpressions(
 					}
 					_, nullsafe := expr.comparison.(*expression.Not).Child.(*expression.NullSafeEquals)
 					expressions = append(expressions, selectedExpr)
-					if val == nil && nullsafe {
-						indexBuilder = indexBuilder.IsNotNull(ctx, expr.col.String())
+					if nullsafe {
+						indexBuilder = indexBuilder.NullSafeNotEquals(ctx, expr.col.String(), val)
 					} else {
 						indexBuilder = indexBuilder.NotEquals(ctx, expr.col.String(), val)
 					}
@@ -15,6 +15,7 @@
 package analyzer
 
 import (
+	"strconv"
 	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql/transform"
@@ -48,12 +49,51 @@ func setInsertColumns(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, s
 				colNames[i] = col.Name
 			}
 			ii.ColumnNames = colNames
+
+			onDupExprs, err := withRowAliasColumns(ii.OnDupExprs, colNames)
+			if err != nil {
+				return nil, transform.SameTree, err
+			}
+			ii.OnDupExprs = onDupExprs
 		}
 
 		return ii, transform.NewTree, nil
 	})
 }
 
+// withRowAliasColumns returns the ON DUPLICATE KEY UPDATE expressions given, with the columns named for the position
+// of a column of the destination, which stand for the column aliases of a row alias, replaced with the columns given.
+func withRowAliasColumns(onDupExprs []sql.Expression, columns []string) ([]sql.Expression, error) {
+	var newExprs []sql.Expression
+	for i, e := range onDupExprs {
+		newExpr, same, err := transform.Expr(e, func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+			uc, ok := e.(*expression.UnresolvedColumn)
+			if !ok || uc.Table() != "" || !strings.HasPrefix(strings.ToUpper(uc.Name()), plan.RowAliasColumnName) {
+				return e, transform.SameTree, nil
+			}
+			pos, err := strconv.Atoi(uc.Name()[len(plan.RowAliasColumnName):])
+			if err != nil || pos < 1 || pos > len(columns) {
+				return nil, transform.SameTree, plan.ErrInsertIntoMismatchValueCount.New()
+			}
+			return expression.NewUnresolvedColumn(columns[pos-1]), transform.NewTree, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		if same == transform.NewTree && newExprs == nil {
+			newExprs = make([]sql.Expression, len(onDupExprs))
+			copy(newExprs, onDupExprs)
+		}
+		if newExprs != nil {
+			newExprs[i] = newExpr
+		}
+	}
+	if newExprs == nil {
+		return onDupExprs, nil
+	}
+	return newExprs, nil
+}
+
 func resolveInsertRows(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
 	if _, ok := n.(*plan.TriggerExecutor); ok {
 		return n, transform.SameTree, nil
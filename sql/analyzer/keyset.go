@@ -0,0 +1,275 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// Keyset is the ordering of a query that can be resumed after any row of its results, by filtering out the rows that
+// aren't ordered after it. That's the case for queries reading a single table, ordered by columns of the table that
+// are unique in it, and with no limit of their own.
+type Keyset struct {
+	sort    *plan.Sort
+	project *plan.Project
+	filter  sql.Expression
+	table   sql.Node
+	// tableName is the name the query gives the table, which is its alias if it has one
+	tableName string
+	// Columns are the columns of the table the query is ordered by, in order
+	Columns []*sql.Column
+	fields  []sql.SortField
+}
+
+// Keyset returns the keyset of the parsed query given, or sql.ErrQueryNotResumable if the query can't be resumed
+// after its rows.
+func (a *Analyzer) Keyset(ctx *sql.Context, parsed sql.Node) (*Keyset, error) {
+	sort, ok := parsed.(*plan.Sort)
+	if !ok {
+		if _, ok := parsed.(*plan.Limit); ok {
+			return nil, sql.ErrQueryNotResumable.New("it has a LIMIT")
+		}
+		return nil, sql.ErrQueryNotResumable.New("it isn't a SELECT from a single table with an ORDER BY")
+	}
+	project, ok := sort.Child.(*plan.Project)
+	if !ok {
+		return nil, sql.ErrQueryNotResumable.New("it isn't a SELECT from a single table with an ORDER BY")
+	}
+
+	k := &Keyset{sort: sort, project: project, table: project.Child}
+	if filter, ok := k.table.(*plan.Filter); ok {
+		k.filter = filter.Expression
+		k.table = filter.Child
+	}
+	var rt *plan.UnresolvedTable
+	switch n := k.table.(type) {
+	case *plan.UnresolvedTable:
+		rt = n
+		k.tableName = n.Name()
+	case *plan.TableAlias:
+		rt, ok = n.Child.(*plan.UnresolvedTable)
+		k.tableName = n.Name()
+	default:
+		ok = false
+	}
+	if !ok {
+		return nil, sql.ErrQueryNotResumable.New("it isn't a SELECT from a single table with an ORDER BY")
+	}
+
+	dbName := rt.Database()
+	if dbName == "" {
+		dbName = ctx.GetCurrentDatabase()
+	}
+	var table sql.Table
+	var err error
+	if rt.AsOf() != nil {
+		var asOf interface{}
+		asOf, err = rt.AsOf().Eval(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		table, _, err = a.Catalog.TableAsOf(ctx, dbName, rt.Name(), asOf)
+	} else {
+		table, _, err = a.Catalog.Table(ctx, dbName, rt.Name())
+	}
+	if err != nil {
+		return nil, err
+	}
+	schema := table.Schema()
+
+	ordered := make(map[string]bool)
+	for _, field := range sort.SortFields {
+		uc, ok := field.Column.(*expression.UnresolvedColumn)
+		if !ok || (uc.Table() != "" && !strings.EqualFold(uc.Table(), k.tableName)) {
+			return nil, sql.ErrQueryNotResumable.New(fmt.Sprintf("it's ordered by %s, which isn't a column of its table", field.Column))
+		}
+		idx := schema.IndexOfColName(uc.Name())
+		if idx < 0 {
+			return nil, sql.ErrQueryNotResumable.New(fmt.Sprintf("it's ordered by %s, which isn't a column of its table", field.Column))
+		}
+		k.Columns = append(k.Columns, schema[idx])
+		k.fields = append(k.fields, field)
+		ordered[strings.ToLower(schema[idx].Name)] = true
+	}
+
+	if !orderedByUniqueKey(ctx, table, ordered) {
+		return nil, sql.ErrQueryNotResumable.New("the columns it's ordered by aren't unique in its table")
+	}
+	return k, nil
+}
+
+// orderedByUniqueKey returns whether the columns given include all the columns of the primary key or of a unique
+// index on non-nullable columns of the table given.
+func orderedByUniqueKey(ctx *sql.Context, table sql.Table, ordered map[string]bool) bool {
+	var pkCols []string
+	for _, col := range table.Schema() {
+		if col.PrimaryKey {
+			pkCols = append(pkCols, col.Name)
+		}
+	}
+	if len(pkCols) > 0 && includesColumns(ordered, pkCols) {
+		return true
+	}
+
+	indexed, ok := table.(sql.IndexAddressable)
+	if !ok {
+		return false
+	}
+	indexes, err := indexed.GetIndexes(ctx)
+	if err != nil {
+		return false
+	}
+	schema := table.Schema()
+	for _, idx := range indexes {
+		if !idx.IsUnique() || idx.IsSpatial() || hasPrefixLength(idx) {
+			continue
+		}
+		var cols []string
+		nullable := false
+		for _, expr := range idx.Expressions() {
+			name := expr[strings.LastIndexByte(expr, '.')+1:]
+			if i := schema.IndexOfColName(name); i < 0 || schema[i].Nullable {
+				nullable = true
+				break
+			}
+			cols = append(cols, name)
+		}
+		if !nullable && includesColumns(ordered, cols) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasPrefixLength returns whether the index given only indexes a prefix of one of its columns, so that it's only the
+// prefixes that are unique.
+func hasPrefixLength(idx sql.Index) bool {
+	for _, prefixLength := range idx.PrefixLengths() {
+		if prefixLength > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func includesColumns(ordered map[string]bool, cols []string) bool {
+	for _, col := range cols {
+		if !ordered[strings.ToLower(col)] {
+			return false
+		}
+	}
+	return true
+}
+
+// Positions returns the positions in the result schema given of the columns the query is ordered by, or
+// sql.ErrQueryNotResumable if one of them isn't in the results.
+func (k *Keyset) Positions(schema sql.Schema) ([]int, error) {
+	positions := make([]int, len(k.Columns))
+	for i, col := range k.Columns {
+		positions[i] = -1
+		for j, resultCol := range schema {
+			if strings.EqualFold(resultCol.Name, col.Name) && strings.EqualFold(resultCol.Source, k.tableName) {
+				positions[i] = j
+				break
+			}
+		}
+		if positions[i] < 0 {
+			return nil, sql.ErrQueryNotResumable.New(fmt.Sprintf("its results don't include the column %s it's ordered by", col.Name))
+		}
+	}
+	return positions, nil
+}
+
+// Query returns the query limited to the first |limit| rows ordered after the values given for its keyset columns, or
+// to its first |limit| rows if |after| is nil.
+func (k *Keyset) Query(after []interface{}, limit int64) sql.Node {
+	var n sql.Node = k.table
+	var filters []sql.Expression
+	if k.filter != nil {
+		filters = append(filters, k.filter)
+	}
+	if after != nil {
+		filters = append(filters, k.keysetFilter(after))
+	}
+	if len(filters) > 0 {
+		n = plan.NewFilter(expression.JoinAnd(filters...), n)
+	}
+	n = plan.NewProject(k.project.Projections, n)
+	n = plan.NewSort(k.sort.SortFields, n)
+	return plan.NewLimit(expression.NewLiteral(limit, types.Int64), n)
+}
+
+// keysetFilter returns the filter for the rows ordered after the values given, which for ORDER BY a, b DESC is
+// (a > va) OR (a = va AND b < vb). NULL values are ordered before the others, or after them for a descending
+// order, so that for ORDER BY a the rows after a NULL va are those with (a IS NOT NULL).
+func (k *Keyset) keysetFilter(after []interface{}) sql.Expression {
+	var filters []sql.Expression
+	for i := range k.Columns {
+		var equals []sql.Expression
+		for j := 0; j < i; j++ {
+			equals = append(equals, k.equals(j, after[j]))
+		}
+		if cmp := k.orderedAfter(i, after[i]); cmp != nil {
+			filters = append(filters, expression.JoinAnd(append(equals, cmp)...))
+		}
+	}
+	if len(filters) == 0 {
+		return expression.NewLiteral(false, types.Boolean)
+	}
+	return expression.JoinOr(filters...)
+}
+
+// equals returns the filter for the rows with the value given for the i-th column of the keyset.
+func (k *Keyset) equals(i int, value interface{}) sql.Expression {
+	if value == nil {
+		return expression.NewIsNull(k.column(i))
+	}
+	return expression.NewEquals(k.column(i), expression.NewLiteral(value, k.Columns[i].Type))
+}
+
+// orderedAfter returns the filter for the rows with values of the i-th column of the keyset ordered after the value
+// given, or nil if there are none.
+func (k *Keyset) orderedAfter(i int, value interface{}) sql.Expression {
+	field := k.fields[i]
+	nullsFirst := (field.NullOrdering == sql.NullsFirst) == (field.Order == sql.Ascending)
+	if value == nil {
+		if nullsFirst {
+			return expression.NewNot(expression.NewIsNull(k.column(i)))
+		}
+		return nil
+	}
+
+	literal := expression.NewLiteral(value, k.Columns[i].Type)
+	var cmp sql.Expression
+	if field.Order == sql.Descending {
+		cmp = expression.NewLessThan(k.column(i), literal)
+	} else {
+		cmp = expression.NewGreaterThan(k.column(i), literal)
+	}
+	if !nullsFirst {
+		cmp = expression.NewOr(cmp, expression.NewIsNull(k.column(i)))
+	}
+	return cmp
+}
+
+func (k *Keyset) column(i int) sql.Expression {
+	return expression.NewUnresolvedQualifiedColumn(k.tableName, k.Columns[i].Name)
+}
@@ -89,7 +89,48 @@ func parallelize(ctx *sql.Context, a *Analyzer, node sql.Node, scope *Scope, sel
 		return node, transform.SameTree, nil
 	}
 
-	return transform.Node(node, removeRedundantExchanges)
+	node, _, err = transform.Node(node, removeRedundantExchanges)
+	if err != nil {
+		return nil, transform.SameTree, err
+	}
+	node, _, err = orderExchanges(node, false)
+	return node, transform.NewTree, err
+}
+
+// orderExchanges marks the exchanges below nodes sorting their input as
+// ordered, so that rows tied on the sort keys are returned in the same order
+// for every execution, and in the same order as without parallelism.
+func orderExchanges(node sql.Node, sorted bool) (sql.Node, transform.TreeIdentity, error) {
+	switch n := node.(type) {
+	case *plan.Exchange:
+		if !sorted || n.Ordered {
+			return node, transform.SameTree, nil
+		}
+		return n.WithOrdered(true), transform.NewTree, nil
+	case *plan.Sort, *plan.TopN, *plan.Window:
+		sorted = true
+	}
+
+	children := node.Children()
+	var newChildren []sql.Node
+	for i, child := range children {
+		newChild, same, err := orderExchanges(child, sorted)
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
+		if !same {
+			if newChildren == nil {
+				newChildren = make([]sql.Node, len(children))
+				copy(newChildren, children)
+			}
+			newChildren[i] = newChild
+		}
+	}
+	if newChildren == nil {
+		return node, transform.SameTree, nil
+	}
+	node, err := node.WithChildren(newChildren...)
+	return node, transform.NewTree, err
 }
 
 // removeRedundantExchanges removes all the exchanges except for the topmost
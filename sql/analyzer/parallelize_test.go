@@ -73,6 +73,37 @@ func TestParallelize(t *testing.T) {
 	require.Equal(expected, result)
 }
 
+func TestParallelizeOrdersExchangesBelowSorts(t *testing.T) {
+	require := require.New(t)
+	table := memory.NewTable("t", sql.PrimaryKeySchema{}, nil)
+	rule := getRuleFrom(OnceAfterAll, parallelizeId)
+	sortFields := sql.SortFields{{Column: expression.NewGetField(0, types.Int64, "a", false)}}
+	filter := plan.NewFilter(
+		expression.NewLiteral(1, types.Int64),
+		plan.NewResolvedTable(table, nil, nil),
+	)
+
+	var node, expected sql.Node
+	node = plan.NewProject(nil, plan.NewSort(sortFields, plan.NewProject(nil, filter)))
+	expected = plan.NewProject(nil, plan.NewSort(sortFields, plan.NewExchange(2, plan.NewProject(nil, filter)).WithOrdered(true)))
+	result, _, err := rule.Apply(sql.NewEmptyContext(), &Analyzer{Parallelism: 2}, node, nil, DefaultRuleSelector)
+	require.NoError(err)
+	require.Equal(expected, result)
+
+	node = plan.NewTopN(sortFields, expression.NewLiteral(1, types.Int64), filter)
+	expected = plan.NewTopN(sortFields, expression.NewLiteral(1, types.Int64), plan.NewExchange(2, filter).WithOrdered(true))
+	result, _, err = rule.Apply(sql.NewEmptyContext(), &Analyzer{Parallelism: 2}, node, nil, DefaultRuleSelector)
+	require.NoError(err)
+	require.Equal(expected, result)
+
+	// Without a sort, the rows of partitions are returned as soon as they're read
+	node = plan.NewLimit(expression.NewLiteral(1, types.Int64), filter)
+	expected = plan.NewLimit(expression.NewLiteral(1, types.Int64), plan.NewExchange(2, filter))
+	result, _, err = rule.Apply(sql.NewEmptyContext(), &Analyzer{Parallelism: 2}, node, nil, DefaultRuleSelector)
+	require.NoError(err)
+	require.Equal(expected, result)
+}
+
 func TestParallelizeCreateIndex(t *testing.T) {
 	require := require.New(t)
 	table := memory.NewTable("t", sql.PrimaryKeySchema{}, nil)
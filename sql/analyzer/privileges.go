@@ -45,7 +45,8 @@ func validatePrivileges(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope,
 	if plan.IsDualTable(getTable(n)) {
 		return n, transform.SameTree, nil
 	}
-	if rt := getResolvedTable(n); rt != nil && rt.Database.Name() == sql.InformationSchemaDatabaseName {
+	if rt := getResolvedTable(n); rt != nil && (rt.Database.Name() == sql.InformationSchemaDatabaseName ||
+		rt.Database.Name() == sql.SysDatabaseName || rt.Database.Name() == sql.PerformanceSchemaDatabaseName) {
 		return n, transform.SameTree, nil
 	}
 	if !n.CheckPrivileges(ctx, a.Catalog.MySQLDb) {
@@ -55,9 +55,15 @@ func trackProcess(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel R
 				return n, transform.SameTree, nil
 			}
 
+			onRowsExamined := func(delta int64) {
+				processList.UpdateRowsExamined(ctx.Pid(), delta)
+			}
+
+			// Progress is only tracked for the first occurrence of a table, but rows are examined by all of them
 			name := n.Table.Name()
 			if _, ok := seen[name]; ok {
-				return n, transform.SameTree, nil
+				n, err := n.WithTable(newProcessTable(n.Table, nil, nil, nil, onRowsExamined))
+				return n, transform.NewTree, err
 			}
 
 			var total int64 = -1
@@ -89,15 +95,7 @@ func trackProcess(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel R
 				}
 			}
 
-			var t sql.Table
-			switch table := n.Table.(type) {
-			case sql.DriverIndexableTable:
-				t = plan.NewProcessIndexableTable(table, onPartitionDone, onPartitionStart, onRowNext)
-			default:
-				t = plan.NewProcessTable(table, onPartitionDone, onPartitionStart, onRowNext)
-			}
-
-			n, err := n.WithTable(t)
+			n, err := n.WithTable(newProcessTable(n.Table, onPartitionDone, onPartitionStart, onRowNext, onRowsExamined))
 			return n, transform.NewTree, err
 		default:
 			return n, transform.SameTree, nil
@@ -141,3 +139,18 @@ func trackProcess(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel R
 		}
 	}), transform.NewTree, nil
 }
+
+// newProcessTable wraps the table given in a plan.ProcessTable, or a plan.ProcessIndexableTable if it's a
+// sql.DriverIndexableTable, that calls the notify functions given.
+func newProcessTable(table sql.Table, onPartitionDone, onPartitionStart, onRowNext plan.NamedNotifyFunc, onRowsExamined plan.RowCountNotifyFunc) sql.Table {
+	switch table := table.(type) {
+	case sql.DriverIndexableTable:
+		t := plan.NewProcessIndexableTable(table, onPartitionDone, onPartitionStart, onRowNext)
+		t.OnRowsExamined = onRowsExamined
+		return t
+	default:
+		t := plan.NewProcessTable(table, onPartitionDone, onPartitionStart, onRowNext)
+		t.OnRowsExamined = onRowsExamined
+		return t
+	}
+}
@@ -71,6 +71,11 @@ func deleteToTruncate(ctx *sql.Context, a *Analyzer, deletePlan *plan.DeleteFrom
 	}
 	tblName := strings.ToLower(tbl.Name())
 
+	// Row change listeners are told about every row a DELETE removes, which TRUNCATE doesn't do
+	if ctx.RowChangeFeed().HasListeners() {
+		return deletePlan, transform.SameTree, nil
+	}
+
 	// auto_increment behaves differently for TRUNCATE and DELETE
 	for _, col := range tbl.Schema() {
 		if col.AutoIncrement {
@@ -119,12 +124,18 @@ func deleteToTruncate(ctx *sql.Context, a *Analyzer, deletePlan *plan.DeleteFrom
 		}
 	}
 
+	// Only tables with a fast path are worth converting, as other tables are truncated by deleting their rows anyway
+	if _, err := plan.GetTruncatable(tbl); err != nil {
+		return deletePlan, transform.SameTree, nil
+	}
 	if ok, err := validateTruncate(ctx, currentDb, tbl); ok {
 		// We only check err if ok is true, as some errors won't apply to us attempting to convert from a DELETE
 		if err != nil {
 			return nil, transform.SameTree, err
 		}
-		return plan.NewTruncate(ctx.GetCurrentDatabase(), tbl), transform.NewTree, nil
+		truncate := plan.NewTruncate(ctx.GetCurrentDatabase(), tbl)
+		truncate.ReportRemoved = true
+		return truncate, transform.NewTree, nil
 	}
 	return deletePlan, transform.SameTree, nil
 }
@@ -135,11 +146,16 @@ func deleteToTruncate(ctx *sql.Context, a *Analyzer, deletePlan *plan.DeleteFrom
 // not support TRUNCATE). If true is returned along with an error, then the error is not expected to happen under
 // normal circumstances and should be dealt with.
 func validateTruncate(ctx *sql.Context, db sql.Database, tbl sql.Node) (bool, error) {
-	truncatable, err := plan.GetTruncatable(tbl)
-	if err != nil {
-		return false, err // false as any caller besides Truncate would not care for this error
+	var table sql.Table
+	if truncatable, err := plan.GetTruncatable(tbl); err == nil {
+		table = truncatable
+	} else if deletable, err := plan.GetDeletable(tbl); err == nil {
+		// Tables without a fast path are truncated by deleting all of their rows
+		table = deletable
+	} else {
+		return false, plan.ErrTruncateNotSupported.New() // false as any caller besides Truncate would not care for this error
 	}
-	tableName := strings.ToLower(truncatable.Name())
+	tableName := strings.ToLower(table.Name())
 
 	tableNames, err := db.GetTableNames(ctx)
 	if err != nil {
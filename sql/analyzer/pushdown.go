@@ -233,6 +233,10 @@ func transformPushdownFilters(ctx *sql.Context, a *Analyzer, n sql.Node, scope *
 					// rules for lookup expressions.
 					return node, transform.SameTree, nil
 				}
+				if node.IndexCondition() != nil {
+					// The predicates pushed down to the index scan are no longer in the filter
+					return node, transform.SameTree, nil
+				}
 				lookup, ok := indexes[node.Name()]
 				if !ok || lookup.expr == nil {
 					return node, transform.SameTree, nil
@@ -801,6 +805,9 @@ func getIndexesByTable(ctx *sql.Context, a *Analyzer, node sql.Node, scope *Scop
 	return indexes, nil
 }
 
+// replacePkSort replaces a Sort over a table with an indexed access of the table, if the sort fields are a prefix of the
+// expressions of one of its indexes and match the index's sort direction in either direction. The primary key is
+// preferred over other indexes.
 func replacePkSort(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
 	return transform.NodeWithCtx(n, nil, func(tc transform.Context) (sql.Node, transform.TreeIdentity, error) {
 		n := tc.Node
@@ -811,22 +818,18 @@ func replacePkSort(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel
 			return n, transform.SameTree, nil
 		}
 
-		// Must be sorting by ascending
-		for _, field := range s.SortFields {
-			if field.Order != sql.Ascending {
-				return n, transform.SameTree, nil
-			}
-		}
-
 		// Check for any alias projections
 		var rs *plan.ResolvedTable
+		var ita *plan.IndexedTableAccess
 		aliasMap := make(map[string]string)
 		pj, ok := s.UnaryNode.Child.(*plan.Project)
 		var decoratingParent sql.Node
 		if ok {
 			n := pj.Child
 			if rs, ok = n.(*plan.ResolvedTable); !ok {
-				return s, transform.SameTree, nil
+				if ita, ok = n.(*plan.IndexedTableAccess); !ok || !ita.IsStatic() {
+					return s, transform.SameTree, nil
+				}
 			}
 			// Extract aliases
 			for _, expr := range pj.Expressions() {
@@ -836,13 +839,25 @@ func replacePkSort(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel
 			}
 		} else {
 			n := s.Child
-			// Otherwise, sorts immediate child must be ResolvedTable
+			// A filter of a static lookup doesn't change the order of its rows
+			if f, ok := n.(*plan.Filter); ok {
+				if _, ok := f.Child.(*plan.IndexedTableAccess); ok {
+					decoratingParent = f
+					n = f.Child
+				}
+			}
+			// Otherwise, sorts immediate child must be ResolvedTable, or a static lookup on it
 			if rs, ok = n.(*plan.ResolvedTable); !ok {
-				return s, transform.SameTree, nil
+				if ita, ok = n.(*plan.IndexedTableAccess); !ok || !ita.IsStatic() {
+					return s, transform.SameTree, nil
+				}
 			}
 		}
+		if ita != nil {
+			rs = ita.ResolvedTable
+		}
 
-		// Extract primary key columns from index to maintain order
+		// Extract index columns from the table to maintain order
 		table := rs.Table
 		if w, ok := table.(sql.TableWrapper); ok {
 			table = w.Underlying()
@@ -856,25 +871,6 @@ func replacePkSort(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel
 			return nil, transform.SameTree, err
 		}
 
-		// Extract primary index
-		var pkIndex sql.Index
-		for _, idx := range idxs {
-			oi, ok := idx.(sql.OrderedIndex)
-			if !ok || oi.Order() != sql.IndexOrderAsc {
-				continue
-			}
-			if idx.ID() == "PRIMARY" {
-				pkIndex = idx
-				break
-			}
-		}
-		if pkIndex == nil {
-			return s, transform.SameTree, nil
-		}
-
-		// Get primary key column names; these are qualified
-		pkColNames := pkIndex.Expressions()
-
 		// Extract SortField Column Names
 		var sfColNames []string
 		for _, field := range s.SortFields {
@@ -890,28 +886,75 @@ func replacePkSort(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel
 			}
 		}
 
-		// SortField is definitely not a prefix to PrimaryKey
-		if len(sfColNames) > len(pkColNames) {
-			return s, transform.SameTree, nil
+		// A static lookup already reads its index in order, so it only needs to be read in reverse if the sort fields
+		// are descending. Only lookups of a single range are read in order from start to end.
+		if ita != nil {
+			reverse, ok := indexMatchesSortFields(ita.Index(), s.SortFields, sfColNames)
+			if !ok {
+				return s, transform.SameTree, nil
+			}
+			lookup, err := ita.GetLookup(ctx, nil)
+			if err != nil {
+				return nil, transform.SameTree, err
+			}
+			if len(lookup.Ranges) != 1 || lookup.IsReverse {
+				return s, transform.SameTree, nil
+			}
+			lookup.IsReverse = reverse
+			var newNode sql.Node
+			newNode, err = plan.NewStaticIndexedAccessForResolvedTable(rs, lookup)
+			if err != nil {
+				return nil, transform.SameTree, err
+			}
+			if decoratingParent != nil {
+				newNode, err = decoratingParent.WithChildren(newNode)
+				if err != nil {
+					return nil, transform.SameTree, err
+				}
+			}
+			if pj != nil {
+				resNode, err := pj.WithChildren(newNode)
+				return resNode, transform.NewTree, err
+			}
+			return newNode, transform.NewTree, nil
 		}
 
-		// Check if SortField is a prefix to PrimaryKey
-		for i := 0; i < len(sfColNames); i++ {
-			// Stop when column names stop matching
-			if sfColNames[i] != pkColNames[i] {
-				return s, transform.SameTree, nil
+		// Look for an index matching the sort fields, starting with the primary key
+		var sortIndex sql.Index
+		var reverse bool
+		for _, idx := range idxs {
+			if idx.ID() == "PRIMARY" {
+				if reverse, ok = indexMatchesSortFields(idx, s.SortFields, sfColNames); ok {
+					sortIndex = idx
+				}
+				break
 			}
 		}
+		if sortIndex == nil {
+			for _, idx := range idxs {
+				if idx.ID() == "PRIMARY" {
+					continue
+				}
+				if reverse, ok = indexMatchesSortFields(idx, s.SortFields, sfColNames); ok {
+					sortIndex = idx
+					break
+				}
+			}
+		}
+		if sortIndex == nil {
+			return s, transform.SameTree, nil
+		}
 
-		// Create lookup based off of PrimaryKey
-		indexBuilder := sql.NewIndexBuilder(pkIndex)
+		// Create lookup based off of the index
+		indexBuilder := sql.NewIndexBuilder(sortIndex)
 		lookup, err := indexBuilder.Build(ctx)
 		if err != nil {
 			return nil, transform.SameTree, err
 		}
-		if !pkIndex.CanSupport(lookup.Ranges...) {
+		if !sortIndex.CanSupport(lookup.Ranges...) {
 			return n, transform.SameTree, nil
 		}
+		lookup.IsReverse = reverse
 		newNode, err := plan.NewStaticIndexedAccessForResolvedTable(rs, lookup)
 		if err != nil {
 			return nil, transform.SameTree, err
@@ -937,6 +980,62 @@ func replacePkSort(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel
 	})
 }
 
+// indexMatchesSortFields returns whether reading the index given returns rows in the order of the sort fields given,
+// whose column names are |sfColNames|, and whether the index must be read in reverse to do so. The sort fields must be
+// a prefix of the index's expressions, and either all of them must match the sort direction of their index column or
+// none of them must. Only a sql.ColumnOrderedIndex can be read in reverse or have descending columns.
+func indexMatchesSortFields(idx sql.Index, sortFields sql.SortFields, sfColNames []string) (reverse bool, ok bool) {
+	oi, ok := idx.(sql.OrderedIndex)
+	if !ok || oi.Order() != sql.IndexOrderAsc || idx.IsSpatial() {
+		return false, false
+	}
+	for _, l := range idx.PrefixLengths() {
+		if l > 0 {
+			return false, false
+		}
+	}
+
+	// Get index column names; these are qualified
+	idxColNames := idx.Expressions()
+
+	// SortField is definitely not a prefix to the index
+	if len(sfColNames) > len(idxColNames) {
+		return false, false
+	}
+
+	// Check if SortField is a prefix to the index
+	for i := 0; i < len(sfColNames); i++ {
+		// Stop when column names stop matching
+		if sfColNames[i] != idxColNames[i] {
+			return false, false
+		}
+	}
+
+	colOrders := make([]sql.IndexOrder, len(idxColNames))
+	coi, isColumnOrdered := idx.(sql.ColumnOrderedIndex)
+	if isColumnOrdered {
+		colOrders = coi.ColumnOrders()
+	} else {
+		for i := range colOrders {
+			colOrders[i] = sql.IndexOrderAsc
+		}
+	}
+
+	forward, backward := true, true
+	for i, field := range sortFields {
+		sameDirection := (field.Order == sql.Descending) == (colOrders[i] == sql.IndexOrderDesc)
+		forward = forward && sameDirection
+		backward = backward && !sameDirection
+	}
+	if forward {
+		return false, true
+	}
+	if backward && isColumnOrdered {
+		return true, true
+	}
+	return false, false
+}
+
 // convertIsNullForIndexes converts all nested IsNull(col) expressions to Equals(col, nil) expressions, as they are
 // equivalent as far as the index interfaces are concerned.
 func convertIsNullForIndexes(ctx *sql.Context, e sql.Expression) sql.Expression {
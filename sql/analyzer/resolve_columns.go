@@ -280,6 +280,14 @@ func qualifyColumns(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel
 			return n, transform.SameTree, nil
 		}
 
+		// The ORDER BY of a union binds to its result columns rather than to the columns of its tables
+		if u, ok := n.(*plan.Union); ok {
+			if err := checkUnionSortFieldTables(u); err != nil {
+				return originalNode, transform.SameTree, err
+			}
+			return n, transform.SameTree, nil
+		}
+
 		// Don't qualify unresolved JSON tables, wait for joins
 		if jt, ok := n.(*plan.JSONTable); ok {
 			if !jt.Resolved() {
@@ -331,6 +339,31 @@ func qualifyColumns(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel
 	})
 }
 
+// checkUnionSortFieldTables returns an error if the ORDER BY of the union given references a column qualified with
+// the name of one of the union's tables, which aren't visible to it.
+func checkUnionSortFieldTables(u *plan.Union) error {
+	if len(u.SortFields) == 0 {
+		return nil
+	}
+	tables := getTablesByName(u)
+	var err error
+	for _, sf := range u.SortFields {
+		sql.Inspect(sf.Column, func(e sql.Expression) bool {
+			uc, ok := e.(*expression.UnresolvedColumn)
+			if !ok || uc.Table() == "" || err != nil {
+				return err == nil
+			}
+			for name := range tables {
+				if strings.EqualFold(name, uc.Table()) {
+					err = sql.ErrTableNameNotAllowedInUnionOrderBy.New(uc.Table())
+				}
+			}
+			return err == nil
+		})
+	}
+	return err
+}
+
 // identifyGroupingAliasReferences finds any aliases defined in the projected expressions of
 // the specified GroupBy node, looks for references to those aliases in the grouping expressions
 // of the same GroupBy node, and transforms them to an AliasReference expression. This is
@@ -915,10 +948,18 @@ func indexColumns(_ *sql.Context, _ *Analyzer, n sql.Node, scope *Scope) (map[ta
 	case *plan.ModifyColumn:
 		tbl := node.Table
 		indexSchemaForDefaults(node.NewColumn(), node.Order(), tbl.Schema())
-	case *plan.RecursiveCte, *plan.Union:
+	case *plan.RecursiveCte:
 		// opaque nodes have derived schemas
 		// TODO also subquery aliases?
-		indexChildNode(node.(sql.BinaryNode).Left())
+		indexChildNode(node.Left())
+	case *plan.Union:
+		// The ORDER BY of a union binds to its result columns, which are named after those of its left side but don't
+		// belong to any of its tables
+		for _, col := range node.Schema() {
+			unqualified := *col
+			unqualified.Source = ""
+			indexColumn(&unqualified)
+		}
 	case *plan.InsertInto:
 		// should index columns in InsertInto.Source
 		aliasedTables := make(map[sql.Node]bool)
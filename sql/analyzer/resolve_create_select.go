@@ -12,7 +12,10 @@ func resolveCreateSelect(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope
 		return n, transform.SameTree, nil
 	}
 
-	analyzedSelect, err := a.Analyze(ctx, ct.Select(), scope)
+	// the rows copied into the new table aren't limited by sql_select_limit
+	analyzedSelect, _, err := a.analyzeWithSelector(ctx, ct.Select(), scope, SelectAllBatches, func(id RuleId) bool {
+		return id != applyDefaultSelectLimitId
+	})
 	if err != nil {
 		return nil, transform.SameTree, err
 	}
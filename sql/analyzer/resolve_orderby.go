@@ -297,6 +297,10 @@ func replaceSortFieldsWithAliasReferences(sort *plan.Sort, expressionToAliasName
 
 func resolveOrderByLiterals(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
 	return transform.Node(n, func(n sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		if u, ok := n.(*plan.Union); ok {
+			return resolveUnionOrderByLiterals(a, u)
+		}
+
 		sort, ok := n.(*plan.Sort)
 		if !ok {
 			return n, transform.SameTree, nil
@@ -318,6 +322,26 @@ func resolveOrderByLiterals(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Sc
 	})
 }
 
+// resolveUnionOrderByLiterals resolves the column numbers in the ORDER BY of the union given to its result columns,
+// which don't belong to any of its tables.
+func resolveUnionOrderByLiterals(a *Analyzer, u *plan.Union) (sql.Node, transform.TreeIdentity, error) {
+	if len(u.SortFields) == 0 || !u.Left().Resolved() || !u.Right().Resolved() {
+		return u, transform.SameTree, nil
+	}
+
+	schema := u.Schema()
+	for _, col := range schema {
+		col.Source = ""
+	}
+	fields, same, err := resolveSortFields(a, u.SortFields, schema)
+	if err != nil || same {
+		return u, transform.SameTree, err
+	}
+	nu := *u
+	nu.SortFields = fields
+	return &nu, transform.NewTree, nil
+}
+
 func resolveSortFields(a *Analyzer, sfs sql.SortFields, schema sql.Schema) (sql.SortFields, transform.TreeIdentity, error) {
 	ret := make([]sql.SortField, len(sfs))
 	same := transform.SameTree
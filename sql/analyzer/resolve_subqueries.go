@@ -129,7 +129,11 @@ func flattenTableAliases(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope
 		switch n := n.(type) {
 		case *plan.TableAlias:
 			if sa, isSA := n.Children()[0].(*plan.SubqueryAlias); isSA {
-				return sa.WithName(n.Name()), transform.NewTree, nil
+				ret := sa.WithName(n.Name())
+				if ret.OriginalName == "" {
+					ret.OriginalName = sa.Name()
+				}
+				return ret, transform.NewTree, nil
 			}
 			if ta, isTA := n.Children()[0].(*plan.TableAlias); isTA {
 				return ta.WithName(n.Name()), transform.NewTree, nil
@@ -193,12 +197,18 @@ func analyzeSubqueryAlias(ctx *sql.Context, a *Analyzer, sqa *plan.SubqueryAlias
 		child, same, err = a.analyzeThroughBatch(ctx, sqa.Child, subScope, "default-rules", sel)
 	}
 	if err != nil {
+		if sqa.ViewDatabase != "" && isInvalidViewError(err) {
+			return nil, same, sql.ErrInvalidView.New(sqa.ViewDatabase, sqa.Name())
+		}
 		return nil, same, err
 	}
 
 	if len(sqa.Columns) > 0 {
 		schemaLen := schemaLength(child)
 		if schemaLen != len(sqa.Columns) {
+			if sqa.ViewDatabase != "" {
+				return nil, transform.SameTree, sql.ErrInvalidView.New(sqa.ViewDatabase, sqa.Name())
+			}
 			return nil, transform.SameTree, sql.ErrColumnCountMismatch.New()
 		}
 	}
@@ -209,6 +219,16 @@ func analyzeSubqueryAlias(ctx *sql.Context, a *Analyzer, sqa *plan.SubqueryAlias
 	return newn, transform.NewTree, err
 }
 
+// isInvalidViewError returns whether the error given, returned while analyzing the definition of a view, is because
+// the definition references tables, columns or functions that no longer exist.
+func isInvalidViewError(err error) bool {
+	return sql.ErrTableNotFound.Is(err) ||
+		sql.ErrColumnNotFound.Is(err) ||
+		sql.ErrTableColumnNotFound.Is(err) ||
+		sql.ErrFunctionNotFound.Is(err) ||
+		sql.ErrDatabaseNotFound.Is(err)
+}
+
 // StripPassthroughNodes strips all top-level passthrough nodes meant to apply only to top-level queries (query
 // tracking, transaction logic, etc) from the node tree given and return the first non-passthrough child element. This
 // is useful for when we invoke the analyzer recursively when e.g. analyzing subqueries or triggers
@@ -279,6 +299,51 @@ func nodeIsCacheable(n sql.Node, lowestAllowedIdx int) bool {
 	return cacheable
 }
 
+// correlatedScopeIndexes returns the sorted, distinct indexes of the outer scope columns below |lowestAllowedIdx| that
+// the node given references, and whether the node is otherwise cacheable. Such a node always produces the same results
+// for the same values of those columns.
+func correlatedScopeIndexes(n sql.Node, lowestAllowedIdx int) ([]int, bool) {
+	var idxs sql.FastIntSet
+	cacheable := true
+	var inspectNode func(n sql.Node)
+	var inspectExpr func(e sql.Expression) bool
+	inspectExpr = func(e sql.Expression) bool {
+		switch e := e.(type) {
+		case *expression.GetField:
+			if e.Index() < lowestAllowedIdx {
+				idxs.Add(e.Index())
+			}
+		case *plan.Subquery:
+			if !e.CanCacheResults() {
+				inspectNode(e.Query)
+			}
+		case *deferredColumn, sql.NonDeterministicExpression:
+			cacheable = false
+		}
+		return cacheable
+	}
+	inspectNode = func(n sql.Node) {
+		transform.Inspect(n, func(node sql.Node) bool {
+			if er, ok := node.(sql.Expressioner); ok {
+				for _, e := range er.Expressions() {
+					sql.Inspect(e, inspectExpr)
+				}
+			} else if sqa, ok := node.(*plan.SubqueryAlias); ok {
+				if sqa.OuterScopeVisibility {
+					inspectNode(sqa.Child)
+				}
+				return false
+			}
+			return cacheable
+		})
+	}
+	inspectNode(n)
+	if !cacheable || idxs.Len() == 0 {
+		return nil, false
+	}
+	return idxs.Ordered(), true
+}
+
 // cacheSubqueryResults determines whether it's safe to cache the results for subqueries (expressions and aliases), and marks the
 // subquery as cacheable if so. Caching subquery results is safe in the case that no outer scope columns are referenced,
 // if all expressions in the subquery are deterministic, and if the subquery isn't inside a trigger block.
@@ -331,6 +396,8 @@ func cacheSubqueryResultsHelper(ctx *sql.Context, a *Analyzer, node sql.Node, sc
 				}
 				if nodeIsCacheable(sq.Query, len(subScope.Schema())) {
 					return sq.WithCachedResults(), transform.NewTree, nil
+				} else if idxs, ok := correlatedScopeIndexes(sq.Query, len(subScope.Schema())); ok {
+					return sq.WithCorrelatedCache(idxs), transform.NewTree, nil
 				} else if !same {
 					return sq, transform.NewTree, nil
 				}
@@ -79,7 +79,7 @@ func TestResolveSubqueries(t *testing.T) {
 									plan.NewResolvedTable(bar.WithProjections(make([]string, 0)), db, nil)),
 							),
 						), "select MAX(a) from (select a from bar) sqa1",
-					).WithExecBuilder(rowexec.DefaultBuilder),
+					).WithExecBuilder(rowexec.DefaultBuilder).WithCorrelatedCache([]int{0}),
 				},
 				plan.NewResolvedTable(foo.WithProjections([]string{"a"}), db, nil),
 			),
@@ -517,6 +517,26 @@ func TestCacheSubqueryResults(t *testing.T) {
 				},
 				plan.NewResolvedTable(table, nil, nil),
 			),
+			expected: plan.NewProject(
+				[]sql.Expression{
+					uc("i"),
+					plan.NewSubquery(
+						plan.NewProject(
+							[]sql.Expression{
+								gf(3, "mytable2", "y"),
+							},
+							plan.NewFilter(
+								gt(
+									gf(1, "mytable", "x"),
+									gf(2, "mytable2", "i"),
+								),
+								plan.NewResolvedTable(table2, nil, nil),
+							),
+						),
+						"").WithExecBuilder(rowexec.DefaultBuilder).WithCorrelatedCache([]int{1}),
+				},
+				plan.NewResolvedTable(table, nil, nil),
+			),
 		},
 		{
 			name: "cacheable",
@@ -562,7 +582,7 @@ func TestCacheSubqueryResults(t *testing.T) {
 			),
 		},
 		{
-			name: "not cacheable, outer scope referenced",
+			name: "correlated, outer scope referenced",
 			node: plan.NewProject(
 				[]sql.Expression{
 					gf(0, "mytable", "i"),
@@ -583,6 +603,26 @@ func TestCacheSubqueryResults(t *testing.T) {
 				},
 				plan.NewResolvedTable(table, nil, nil),
 			),
+			expected: plan.NewProject(
+				[]sql.Expression{
+					gf(0, "mytable", "i"),
+					plan.NewSubquery(
+						plan.NewProject(
+							[]sql.Expression{
+								gf(3, "mytables", "x"),
+							},
+							plan.NewFilter(
+								gt(
+									gf(0, "mytable", "i"),
+									gf(3, "mytable2", "x"),
+								),
+								plan.NewResolvedTable(table2, nil, nil),
+							),
+						),
+						"").WithExecBuilder(rowexec.DefaultBuilder).WithCorrelatedCache([]int{0}),
+				},
+				plan.NewResolvedTable(table, nil, nil),
+			),
 		},
 		{
 			name: "not cacheable, non-deterministic expression",
@@ -158,7 +158,7 @@ func resolveTable(ctx *sql.Context, t sql.UnresolvedTable, a *Analyzer) (sql.Nod
 				comment = cn.Comment()
 			}
 			a.Log("table resolved: %q as of %s", rt.Name(), asOf)
-			return plan.NewResolvedTable(rt, database, asOf).WithComment(comment), nil
+			return plan.NewResolvedTable(sampleTable(t, rt), database, asOf).WithComment(comment), nil
 		}
 	}
 
@@ -176,7 +176,7 @@ func resolveTable(ctx *sql.Context, t sql.UnresolvedTable, a *Analyzer) (sql.Nod
 	if cn, ok := t.(sql.CommentedNode); ok {
 		comment = cn.Comment()
 	}
-	resolvedTableNode := plan.NewResolvedTable(rt, database, nil).WithComment(comment).(*plan.ResolvedTable)
+	resolvedTableNode := plan.NewResolvedTable(sampleTable(t, rt), database, nil).WithComment(comment).(*plan.ResolvedTable)
 
 	a.Log("table resolved: %s", t.Name())
 	if asofBindVar {
@@ -185,6 +185,15 @@ func resolveTable(ctx *sql.Context, t sql.UnresolvedTable, a *Analyzer) (sql.Nod
 	return resolvedTableNode, nil
 }
 
+// sampleTable returns the table given wrapped in a plan.SampledTable if the unresolved table given has a TABLESAMPLE
+// clause, or the table given otherwise.
+func sampleTable(t sql.UnresolvedTable, rt sql.Table) sql.Table {
+	if ut, ok := t.(*plan.UnresolvedTable); ok && ut.Sample() != nil {
+		return plan.NewSampledTable(rt, *ut.Sample())
+	}
+	return rt
+}
+
 // setTargetSchemas fills in the target schema for any nodes in the tree that operate on a table node but also want to
 // store supplementary schema information. This is useful for lazy resolution of column default values.
 func setTargetSchemas(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
@@ -256,7 +265,16 @@ func reresolveTables(ctx *sql.Context, a *Analyzer, node sql.Node, scope *Scope,
 			if plan.IsDualTable(n) {
 				to = n
 			} else {
-				to, err = resolveTable(ctx, plan.NewUnresolvedTableAsOf(n.Name(), db, asof), a)
+				ut := plan.NewUnresolvedTableAsOf(n.Name(), db, asof)
+				// The tables of analyzed trigger bodies are wrapped to track the process
+				table := n.Table
+				if tw, ok := table.(sql.TableWrapper); ok {
+					table = tw.Underlying()
+				}
+				if st, ok := table.(*plan.SampledTable); ok {
+					ut = ut.WithSample(&st.Sample)
+				}
+				to, err = resolveTable(ctx, ut, a)
 				if err != nil {
 					return nil, transform.SameTree, err
 				}
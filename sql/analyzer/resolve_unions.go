@@ -15,7 +15,10 @@
 package analyzer
 
 import (
+	"fmt"
 	"reflect"
+	"strconv"
+	"strings"
 
 	"gopkg.in/src-d/go-errors.v1"
 
@@ -112,8 +115,13 @@ var (
 	)
 )
 
-// mergeUnionSchemas determines the narrowest possible shared schema types between the two sides of a union, and
-// applies projections the two sides to convert column types as necessary.
+// mergeUnionSchemas aggregates the column types of the two sides of a union into the types of its result columns, and
+// projects the sides whose types differ to convert them. As in MySQL, the result types hold the values of both sides:
+// numbers are widened to the largest precision and scale, and to signed or DECIMAL types when signedness differs,
+// strings to the longest length, and anything else mixed with strings to strings. String columns take the collation of
+// the side with the lowest coercibility, and it's an error to mix different collations that can't be resolved.
+// Unions of more than two selects are nested, so types are aggregated across all of them.
+// https://dev.mysql.com/doc/refman/8.0/en/union.html#union-result-set
 func mergeUnionSchemas(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
 	if !n.Resolved() {
 		return n, transform.SameTree, nil
@@ -125,64 +133,149 @@ func mergeUnionSchemas(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope,
 				return nil, transform.SameTree, ErrUnionSchemasDifferentLength.New(len(ls), len(rs))
 			}
 			les, res := make([]sql.Expression, len(ls)), make([]sql.Expression, len(rs))
-			hasdiff := false
+			ldiff, rdiff := false, false
 			for i := range ls {
 				les[i] = expression.NewGetFieldWithTable(i, ls[i].Type, ls[i].Source, ls[i].Name, ls[i].Nullable)
 				res[i] = expression.NewGetFieldWithTable(i, rs[i].Type, rs[i].Source, rs[i].Name, rs[i].Nullable)
-				if reflect.DeepEqual(ls[i].Type, rs[i].Type) {
-					continue
-				}
 				if types.IsDeferredType(ls[i].Type) || types.IsDeferredType(rs[i].Type) {
 					continue
 				}
-				hasdiff = true
 
-				// try to get optimal type to convert both into
-				convertTo := getConvertToType(ls[i].Type, rs[i].Type)
+				typ, err := unionColumnType(ctx, u, i)
+				if err != nil {
+					return nil, transform.SameTree, err
+				}
 
-				// TODO: Principled type coercion...
-				les[i] = expression.NewConvert(les[i], convertTo)
-				res[i] = expression.NewConvert(res[i], convertTo)
+				// Preserve schema names across the conversion. Columns of NULLs don't need converting, and they may be
+				// procedure parameters whose types aren't known yet.
+				if !reflect.DeepEqual(ls[i].Type, typ) && ls[i].Type != types.Null {
+					ldiff = true
+					les[i] = expression.NewAlias(ls[i].Name, expression.NewImplicitConvert(les[i], typ))
+				}
+				if !reflect.DeepEqual(rs[i].Type, typ) && rs[i].Type != types.Null {
+					rdiff = true
+					res[i] = expression.NewAlias(rs[i].Name, expression.NewImplicitConvert(res[i], typ))
+				}
+			}
+			if !ldiff && !rdiff {
+				return n, transform.SameTree, nil
+			}
 
-				// Preserve schema names across the conversion.
-				les[i] = expression.NewAlias(ls[i].Name, les[i])
-				res[i] = expression.NewAlias(rs[i].Name, res[i])
+			left, right := u.Left(), u.Right()
+			if ldiff {
+				left = plan.NewProject(les, left)
 			}
-			if hasdiff {
-				n, err := u.WithChildren(
-					plan.NewProject(les, u.Left()),
-					plan.NewProject(res, u.Right()),
-				)
-				if err != nil {
-					return nil, transform.SameTree, err
+			if rdiff {
+				right = plan.NewProject(res, right)
+			}
+			n, err := u.WithChildren(left, right)
+			if err != nil {
+				return nil, transform.SameTree, err
+			}
+
+			// The ORDER BY was resolved against the result columns before their types were aggregated
+			schema := n.Schema()
+			n, _, err = transform.OneNodeExpressions(n, func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+				gf, ok := e.(*expression.GetField)
+				if !ok || gf.Table() != "" || gf.Index() >= len(schema) {
+					return e, transform.SameTree, nil
 				}
-				return n, transform.NewTree, nil
+				col := schema[gf.Index()]
+				return expression.NewGetField(gf.Index(), col.Type, gf.Name(), col.Nullable), transform.NewTree, nil
+			})
+			if err != nil {
+				return nil, transform.SameTree, err
 			}
+			return n, transform.NewTree, nil
 		}
 		return n, transform.SameTree, nil
 	})
 }
 
-// getConvertToType returns which type the both left and right values should be converted to.
-// If neither sql.Type represent number, then converted to string. Otherwise, we try to get
-// the appropriate type to avoid any precision loss.
-func getConvertToType(l, r sql.Type) string {
-	if !types.IsNumber(l) || !types.IsNumber(r) {
-		return expression.ConvertToChar
+// unionColumnType returns the type of the i-th result column of the union given, aggregated from the types of its
+// sides. String columns of different collations take the collation of the side with the lowest coercibility.
+func unionColumnType(ctx *sql.Context, u *plan.Union, i int) (sql.Type, error) {
+	lt, rt := u.Left().Schema()[i].Type, u.Right().Schema()[i].Type
+	typ := types.GeneralizeTypes(lt, rt)
+
+	st, ok := typ.(sql.TypeWithCollation)
+	if !ok || !types.IsTextOnly(typ) {
+		return typ, nil
+	}
+	_, lok := lt.(sql.TypeWithCollation)
+	_, rok := rt.(sql.TypeWithCollation)
+	if !lok || !rok {
+		return typ, nil
 	}
 
-	if types.IsDecimal(l) || types.IsDecimal(r) {
-		return expression.ConvertToDecimal
+	lcoll, lcoer := unionColumnCoercibility(ctx, u.Left(), i)
+	rcoll, rcoer := unionColumnCoercibility(ctx, u.Right(), i)
+	collation, err := aggregateUnionCollations(lcoll, lcoer, rcoll, rcoer)
+	if err != nil || collation == st.Collation() {
+		return typ, err
 	}
-	if types.IsUnsigned(l) && types.IsUnsigned(r) {
-		return expression.ConvertToUnsigned
+	return st.WithNewCollation(collation)
+}
+
+// unionColumnCoercibility returns the collation and coercibility of the i-th column of a side of a union, taken from
+// the expression that projects it when there is one. Other columns, such as those of tables, are implicit.
+func unionColumnCoercibility(ctx *sql.Context, n sql.Node, i int) (sql.CollationID, byte) {
+	for {
+		switch nn := n.(type) {
+		case sql.Projector:
+			if exprs := nn.ProjectedExprs(); i < len(exprs) {
+				return sql.GetCoercibility(ctx, exprs[i])
+			}
+		case *plan.Sort, *plan.TopN, *plan.Limit, *plan.Offset, *plan.Distinct, *plan.OrderedDistinct,
+			*plan.Having, *plan.Filter:
+			n = nn.Children()[0]
+			continue
+		}
+		if ct, ok := n.Schema()[i].Type.(sql.TypeWithCollation); ok {
+			return ct.Collation(), 2
+		}
+		return sql.Collation_binary, 5
 	}
-	if types.IsSigned(l) && types.IsSigned(r) {
-		return expression.ConvertToSigned
+}
+
+// aggregateUnionCollations returns the collation of a union column whose sides have the collations and
+// coercibilities given. Different collations of the same coercibility are resolved to the binary collation of a
+// shared character set, to utf8mb4 over utf8mb3, and to Unicode over other character sets, and are an illegal mix
+// otherwise.
+func aggregateUnionCollations(lcoll sql.CollationID, lcoer byte, rcoll sql.CollationID, rcoer byte) (sql.CollationID, error) {
+	if lcoll == rcoll || lcoer != rcoer {
+		collation, _ := sql.ResolveCoercibility(lcoll, lcoer, rcoll, rcoer)
+		return collation, nil
 	}
-	if types.IsInteger(l) && types.IsInteger(r) {
-		return expression.ConvertToSigned
+
+	lcs, rcs := lcoll.CharacterSet(), rcoll.CharacterSet()
+	switch {
+	case lcs == rcs:
+		if strings.HasSuffix(lcoll.Name(), "_bin") {
+			return lcoll, nil
+		} else if strings.HasSuffix(rcoll.Name(), "_bin") {
+			return rcoll, nil
+		}
+	case lcs == sql.CharacterSet_utf8mb4 && rcs == sql.CharacterSet_utf8mb3:
+		return lcoll, nil
+	case lcs == sql.CharacterSet_utf8mb3 && rcs == sql.CharacterSet_utf8mb4:
+		return rcoll, nil
+	case lcs.MaxLength() == 1 && rcs.MaxLength() > 1:
+		return rcoll, nil
+	case lcs.MaxLength() > 1 && rcs.MaxLength() == 1:
+		return lcoll, nil
 	}
+	return sql.Collation_Unspecified, sql.ErrCollationIllegalMix.New(
+		fmt.Sprintf("%s,%s", lcoll.Name(), coercibilityName(lcoer)),
+		fmt.Sprintf("%s,%s", rcoll.Name(), coercibilityName(rcoer)))
+}
+
+// coercibilityNames are the names MySQL gives coercibility values in errors.
+var coercibilityNames = []string{"EXPLICIT", "NONE", "IMPLICIT", "SYSCONST", "COERCIBLE", "NUMERIC", "IGNORABLE"}
 
-	return expression.ConvertToChar
+func coercibilityName(coercibility byte) string {
+	if int(coercibility) < len(coercibilityNames) {
+		return coercibilityNames[coercibility]
+	}
+	return strconv.Itoa(int(coercibility))
 }
@@ -15,9 +15,9 @@
 package analyzer
 
 import (
-	"errors"
 	"testing"
 
+	"github.com/dolthub/vitess/go/sqltypes"
 	"github.com/stretchr/testify/require"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -70,10 +70,10 @@ func TestMergeUnionSchemas(t *testing.T) {
 				plan.NewResolvedTable(plan.NewResolvedDualTable(), nil, nil),
 			), false, nil, nil),
 			nil,
-			errors.New("this is an error"),
+			ErrUnionSchemasDifferentLength.New(1, 2),
 		},
 		{
-			"Mismatched Types Coerced to Strings",
+			"Mismatched Types Widened",
 			plan.NewUnion(plan.NewProject(
 				[]sql.Expression{expression.NewLiteral(int64(1), types.Int64)},
 				plan.NewResolvedTable(plan.NewResolvedDualTable(), nil, nil),
@@ -82,25 +82,61 @@ func TestMergeUnionSchemas(t *testing.T) {
 				plan.NewResolvedTable(plan.NewResolvedDualTable(), nil, nil),
 			), false, nil, nil),
 			plan.NewUnion(plan.NewProject(
+				[]sql.Expression{expression.NewLiteral(int64(1), types.Int64)},
+				plan.NewResolvedTable(plan.NewResolvedDualTable(), nil, nil),
+			), plan.NewProject(
 				[]sql.Expression{
-					expression.NewAlias("1", expression.NewConvert(
-						expression.NewGetField(0, types.Int64, "1", false), "signed")),
+					expression.NewAlias("3", expression.NewImplicitConvert(
+						expression.NewGetField(0, types.Int32, "3", false), types.Int64)),
 				},
 				plan.NewProject(
-					[]sql.Expression{expression.NewLiteral(int64(1), types.Int64)},
+					[]sql.Expression{expression.NewLiteral(int32(3), types.Int32)},
 					plan.NewResolvedTable(plan.NewResolvedDualTable(), nil, nil),
 				),
+			), false, nil, nil),
+			nil,
+		},
+		{
+			"Numbers and Strings Converted to Strings",
+			plan.NewUnion(plan.NewProject(
+				[]sql.Expression{expression.NewLiteral(int8(1), types.Int8)},
+				plan.NewResolvedTable(plan.NewResolvedDualTable(), nil, nil),
 			), plan.NewProject(
+				[]sql.Expression{expression.NewLiteral("abc", types.MustCreateStringWithDefaults(sqltypes.VarChar, 3))},
+				plan.NewResolvedTable(plan.NewResolvedDualTable(), nil, nil),
+			), false, nil, nil),
+			plan.NewUnion(plan.NewProject(
 				[]sql.Expression{
-					expression.NewAlias("3", expression.NewConvert(
-						expression.NewGetField(0, types.Int32, "3", false), "signed")),
+					expression.NewAlias("1", expression.NewImplicitConvert(
+						expression.NewGetField(0, types.Int8, "1", false), types.MustCreateStringWithDefaults(sqltypes.VarChar, 4))),
 				},
 				plan.NewProject(
-					[]sql.Expression{expression.NewLiteral(int32(3), types.Int32)},
+					[]sql.Expression{expression.NewLiteral(int8(1), types.Int8)},
 					plan.NewResolvedTable(plan.NewResolvedDualTable(), nil, nil),
 				),
+			), plan.NewProject(
+				[]sql.Expression{
+					expression.NewAlias("'abc'", expression.NewImplicitConvert(
+						expression.NewGetField(0, types.MustCreateStringWithDefaults(sqltypes.VarChar, 3), "'abc'", false), types.MustCreateStringWithDefaults(sqltypes.VarChar, 4))),
+				},
+				plan.NewProject(
+					[]sql.Expression{expression.NewLiteral("abc", types.MustCreateStringWithDefaults(sqltypes.VarChar, 3))},
+					plan.NewResolvedTable(plan.NewResolvedDualTable(), nil, nil),
+				),
+			), false, nil, nil),
+			nil,
+		},
+		{
+			"Illegal Mix of Collations is error",
+			plan.NewUnion(plan.NewProject(
+				[]sql.Expression{expression.NewGetField(0, types.MustCreateString(sqltypes.VarChar, 10, sql.Collation_utf8mb4_general_ci), "a", false)},
+				plan.NewResolvedTable(plan.NewResolvedDualTable(), nil, nil),
+			), plan.NewProject(
+				[]sql.Expression{expression.NewGetField(0, types.MustCreateString(sqltypes.VarChar, 10, sql.Collation_utf8mb4_0900_ai_ci), "b", false)},
+				plan.NewResolvedTable(plan.NewResolvedDualTable(), nil, nil),
 			), false, nil, nil),
 			nil,
+			sql.ErrCollationIllegalMix.New("utf8mb4_general_ci,IMPLICIT", "utf8mb4_0900_ai_ci,IMPLICIT"),
 		},
 	}
 	for _, c := range testCases {
@@ -113,6 +149,7 @@ func TestMergeUnionSchemas(t *testing.T) {
 				require.Equal(c.out, out)
 			} else {
 				require.Error(err)
+				require.Equal(c.err.Error(), err.Error())
 			}
 		})
 	}
@@ -229,10 +229,13 @@ func resolveSystemOrUserVariable(ctx *sql.Context, a *Analyzer, col column) (sql
 	case sqlparser.SetScope_None:
 		return nil, transform.SameTree, nil
 	case sqlparser.SetScope_Global:
-		_, _, ok := sql.SystemVariables.GetGlobal(varName)
+		sysVar, _, ok := sql.SystemVariables.GetGlobal(varName)
 		if !ok {
 			return nil, transform.SameTree, sql.ErrUnknownSystemVariable.New(varName)
 		}
+		if sysVar.Scope == sql.SystemVariableScope_Session {
+			return nil, transform.SameTree, sql.ErrSystemVariableScope.New(varName, "SESSION")
+		}
 		a.Log("resolved column %s to global system variable", col)
 		return expression.NewSystemVar(varName, sql.SystemVariableScope_Global), transform.NewTree, nil
 	case sqlparser.SetScope_Persist:
@@ -244,6 +247,15 @@ func resolveSystemOrUserVariable(ctx *sql.Context, a *Analyzer, col column) (sql
 		if err != nil {
 			return nil, transform.SameTree, err
 		}
+		// Variables without a session value can only be read with an unqualified reference, like @@max_connections,
+		// which returns their global value.
+		if sysVar, _, ok := sql.SystemVariables.GetGlobal(varName); ok && sysVar.Scope == sql.SystemVariableScope_Global {
+			if hasExplicitSessionScope(col) {
+				return nil, transform.SameTree, sql.ErrSystemVariableScope.New(varName, "GLOBAL")
+			}
+			a.Log("resolved column %s to global system variable", col)
+			return expression.NewSystemVar(varName, sql.SystemVariableScope_Global), transform.NewTree, nil
+		}
 		a.Log("resolved column %s to session system variable", col)
 		// "character_set_database" and "collation_database" are special system variables, in that they're set whenever
 		// the current database is changed. Rather than attempting to synchronize the session variables of all
@@ -281,6 +293,17 @@ func resolveSystemOrUserVariable(ctx *sql.Context, a *Analyzer, col column) (sql
 	}
 }
 
+// hasExplicitSessionScope returns whether the variable reference given is qualified with the session scope, like
+// @@session.sql_mode or @@local.sql_mode, rather than being unqualified, like @@sql_mode.
+func hasExplicitSessionScope(col column) bool {
+	name := col.Name()
+	if col.Table() != "" {
+		name = col.Table() + "." + name
+	}
+	name = strings.ToLower(name)
+	return strings.HasPrefix(name, "@@session.") || strings.HasPrefix(name, "@@local.")
+}
+
 // getSetVal evaluates the right hand side of a SetField expression and returns an evaluated value as appropriate
 func getSetVal(ctx *sql.Context, varName string, e sql.Expression) (sql.Expression, error) {
 	if _, ok := e.(*expression.DefaultColumn); ok {
@@ -44,7 +44,7 @@ func TestResolveSetVariables(t *testing.T) {
 			expected: plan.NewSet(
 				[]sql.Expression{
 					expression.NewSetField(expression.NewSystemVar("auto_increment_increment", sql.SystemVariableScope_Session), expression.NewLiteral(int64(1), types.Int64)),
-					expression.NewSetField(expression.NewSystemVar("sql_select_limit", sql.SystemVariableScope_Session), expression.NewLiteral(int64(math.MaxInt32), types.Int64)),
+					expression.NewSetField(expression.NewSystemVar("sql_select_limit", sql.SystemVariableScope_Session), expression.NewLiteral(uint64(math.MaxUint64), types.Uint64)),
 				},
 			),
 		},
@@ -59,7 +59,7 @@ func TestResolveSetVariables(t *testing.T) {
 			expected: plan.NewSet(
 				[]sql.Expression{
 					expression.NewSetField(expression.NewSystemVar("auto_increment_increment", sql.SystemVariableScope_Session), expression.NewLiteral(int64(1), types.Int64)),
-					expression.NewSetField(expression.NewSystemVar("sql_select_limit", sql.SystemVariableScope_Session), expression.NewLiteral(int64(math.MaxInt32), types.Int64)),
+					expression.NewSetField(expression.NewSystemVar("sql_select_limit", sql.SystemVariableScope_Session), expression.NewLiteral(uint64(math.MaxUint64), types.Uint64)),
 				},
 			),
 		},
@@ -74,7 +74,7 @@ func TestResolveSetVariables(t *testing.T) {
 			expected: plan.NewSet(
 				[]sql.Expression{
 					expression.NewSetField(expression.NewSystemVar("auto_increment_INCREMENT", sql.SystemVariableScope_Session), expression.NewLiteral(int64(1), types.Int64)),
-					expression.NewSetField(expression.NewSystemVar("sql_select_LIMIT", sql.SystemVariableScope_Session), expression.NewLiteral(int64(math.MaxInt32), types.Int64)),
+					expression.NewSetField(expression.NewSystemVar("sql_select_LIMIT", sql.SystemVariableScope_Session), expression.NewLiteral(uint64(math.MaxUint64), types.Uint64)),
 				},
 			),
 		},
@@ -41,6 +41,7 @@ func resolveViews(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel R
 		}
 
 		var view *sql.View
+		var err error
 
 		if dbName != "" {
 			db, err := a.Catalog.Database(ctx, dbName)
@@ -61,17 +62,15 @@ func resolveViews(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel R
 					return nil, transform.SameTree, verr
 				}
 				if vdok {
-					query, qerr := parse.Parse(ctx, viewDef.TextDefinition)
-					if qerr != nil {
-						return nil, transform.SameTree, qerr
+					view, err = parseViewDefinition(ctx, viewName, viewDef)
+					if err != nil {
+						return nil, transform.SameTree, err
 					}
-					view = plan.NewSubqueryAlias(viewName, viewDef.TextDefinition, query).AsView(viewDef.CreateViewStatement)
 				}
 			}
 		}
 
 		// If we didn't find the view from the database directly, use the in-session registry
-		var err error
 		if view == nil {
 			view, ok = ctx.GetViewRegistry().View(dbName, viewName)
 			if !ok {
@@ -103,10 +102,31 @@ func resolveViews(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel R
 		if err != nil {
 			return nil, transform.SameTree, err
 		}
+		if sqa, ok := n.(*plan.SubqueryAlias); ok {
+			sqa.ViewDatabase = dbName
+		}
 		return n, transform.NewTree, nil
 	})
 }
 
+// parseViewDefinition returns the view with the definition given. The view is parsed from its CREATE VIEW statement,
+// which has all of its clauses, such as its column list, or from its select statement if there's no CREATE VIEW
+// statement.
+func parseViewDefinition(ctx *sql.Context, viewName string, viewDef sql.ViewDefinition) (*sql.View, error) {
+	if viewDef.CreateViewStatement != "" {
+		if node, err := parse.Parse(ctx, viewDef.CreateViewStatement); err == nil {
+			if cv, ok := node.(*plan.CreateView); ok {
+				return cv.Definition.AsView(viewDef.CreateViewStatement), nil
+			}
+		}
+	}
+	query, err := parse.Parse(ctx, viewDef.TextDefinition)
+	if err != nil {
+		return nil, err
+	}
+	return plan.NewSubqueryAlias(viewName, viewDef.TextDefinition, query).AsView(viewDef.CreateViewStatement), nil
+}
+
 // applyAsOfToView transforms the nodes in the view's execution plan to apply the asOf expression to every
 // individual table involved in the view.
 func applyAsOfToView(n sql.Node, a *Analyzer, asOf sql.Expression) (sql.Node, transform.TreeIdentity, error) {
@@ -76,7 +76,9 @@ func TestResolveViews(t *testing.T) {
 	var notAnalyzed sql.Node = plan.NewUnresolvedTable("myview1", "")
 	analyzed, _, err := f.Apply(ctx, a, notAnalyzed, nil, DefaultRuleSelector)
 	require.NoError(t, err)
-	require.Equal(t, viewDefinition, analyzed)
+	expectedView := *viewDefinition
+	expectedView.ViewDatabase = "mydb"
+	require.Equal(t, &expectedView, analyzed)
 	expectedViewDefinition := plan.NewSubqueryAlias(
 		"myview1", "select i from mytable",
 		plan.NewProject(
@@ -84,6 +86,7 @@ func TestResolveViews(t *testing.T) {
 			plan.NewUnresolvedTableAsOf("mytable", "", expression.NewLiteral("2019-01-01", types.LongText)),
 		),
 	)
+	expectedViewDefinition.ViewDatabase = "mydb"
 	var notAnalyzedAsOf sql.Node = plan.NewUnresolvedTableAsOf("myview1", "", expression.NewLiteral("2019-01-01", types.LongText))
 	analyzed, _, err = f.Apply(ctx, a, notAnalyzedAsOf, nil, DefaultRuleSelector)
 	require.NoError(t, err)
@@ -97,6 +100,7 @@ func TestResolveViews(t *testing.T) {
 			plan.NewUnion(plan.NewUnresolvedTableAsOf("mytable1", "", expression.NewLiteral("2019-01-01", types.LongText)), plan.NewUnresolvedTableAsOf("mytable2", "", expression.NewLiteral("2019-01-01", types.LongText)), false, nil, nil),
 		),
 	)
+	expectedViewDefinition.ViewDatabase = "mydb"
 	notAnalyzedAsOf = plan.NewUnresolvedTableAsOf("myview2", "", expression.NewLiteral("2019-01-01", types.LongText))
 	analyzed, _, err = f.Apply(ctx, a, notAnalyzedAsOf, nil, DefaultRuleSelector)
 	require.NoError(t, err)
@@ -6,8 +6,7 @@ type RuleId int
 
 const (
 	// once before
-	applyDefaultSelectLimitId      RuleId = iota // applyDefaultSelectLimit
-	validateOffsetAndLimitId                     // validateOffsetAndLimit
+	validateOffsetAndLimitId       RuleId = iota // validateOffsetAndLimit
 	validateStarExpressionsId                    // validateStarExpressions
 	validateCreateTableId                        // validateCreateTable
 	validateExprSemId                            // validateExprSem
@@ -83,6 +82,7 @@ const (
 	// after default
 	hoistOutOfScopeFiltersId     // hoistOutOfScopeFilters
 	transformJoinApplyId         // transformJoinApply
+	flattenScalarSubqueriesId    // flattenScalarSubqueries
 	hoistSelectExistsId          // hoistSelectExists
 	finalizeSubqueriesId         // finalizeSubqueries
 	finalizeUnionsId             // finalizeUnions
@@ -97,6 +97,7 @@ const (
 	foldEmptyJoinsId             // foldEmptyJoins
 	optimizeJoinsId              // optimizeJoins
 	pushdownFiltersId            // pushdownFilters
+	indexMergeId                 // indexMerge
 	subqueryIndexesId            // subqueryIndexes
 	pruneTablesId                // pruneTables
 	setJoinScopeLenId            // setJoinScopeLen
@@ -130,8 +131,10 @@ const (
 	validateDeleteFromId        // validateDeleteFrom
 
 	// after all
+	pushdownIndexConditionsId     // pushdownIndexConditions
 	cacheSubqueryResultsId        // cacheSubqueryResults
 	cacheSubqueryAliasesInJoinsId // cacheSubqueryAliasesInJoins
+	applyDefaultSelectLimitId     // applyDefaultSelectLimit
 	AutocommitId                  // addAutocommitNode
 	TrackProcessId                // trackProcess
 	parallelizeId                 // parallelize
@@ -21,7 +21,6 @@ import (
 // OnceBeforeDefault contains the rules to be applied just once before the
 // DefaultRules.
 var OnceBeforeDefault = []Rule{
-	{applyDefaultSelectLimitId, applyDefaultSelectLimit},
 	{applyBinlogReplicaControllerId, applyBinlogReplicaController},
 	{validateOffsetAndLimitId, validateLimitAndOffset},
 	{validateCreateTableId, validateCreateTable},
@@ -104,6 +103,7 @@ var DefaultRules = []Rule{
 var OnceAfterDefault = []Rule{
 	{hoistOutOfScopeFiltersId, hoistOutOfScopeFilters},
 	{transformJoinApplyId, transformJoinApply},
+	{flattenScalarSubqueriesId, flattenScalarSubqueries},
 	{hoistSelectExistsId, hoistSelectExists},
 	{finalizeUnionsId, finalizeUnions},
 	{loadTriggersId, loadTriggers},
@@ -115,6 +115,7 @@ var OnceAfterDefault = []Rule{
 	{optimizeJoinsId, constructJoinPlan},
 	{pushdownFiltersId, pushdownFilters},
 	{pruneColumnsId, pruneColumns},
+	{indexMergeId, applyIndexMerge},
 	{finalizeSubqueriesId, finalizeSubqueries},
 	{subqueryIndexesId, applyIndexesFromOuterScope},
 	{replaceSortPkId, replacePkSort},
@@ -150,8 +151,10 @@ var DefaultValidationRules = []Rule{
 // OnceAfterAll contains the rules to be applied just once after all other
 // rules have been applied.
 var OnceAfterAll = []Rule{
+	{pushdownIndexConditionsId, pushdownIndexConditions},
 	{cacheSubqueryResultsId, cacheSubqueryResults},
 	{cacheSubqueryAliasesInJoinsId, cacheSubqueryAliasesInJoins},
+	{applyDefaultSelectLimitId, applyDefaultSelectLimit},
 	{AutocommitId, addAutocommitNode},
 	{TrackProcessId, trackProcess},
 	{parallelizeId, parallelize},
@@ -15,7 +15,7 @@
 package analyzer
 
 import (
-	"fmt"
+	"math"
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
@@ -24,9 +24,11 @@ import (
 	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
-// applyDefaultSelectLimit wraps the root node in a Limit clause
-// if 1) `sql_select_limit` is non-default and 2) there is no
-// user-provided Limit already applied to the root node.
+// applyDefaultSelectLimit wraps the plan of a top level SELECT statement in a Limit of the number of rows given by the
+// sql_select_limit system variable, unless the statement has a LIMIT clause of its own. It runs once the plan is
+// otherwise complete, so that it applies the same way to unions, common table expressions and prepared statements.
+// Nested queries, like subqueries, the source of INSERT ... SELECT and the statements of stored routines, are never
+// limited: their analysis doesn't select this rule.
 func applyDefaultSelectLimit(
 	ctx *sql.Context,
 	a *Analyzer,
@@ -37,18 +39,34 @@ func applyDefaultSelectLimit(
 	if !scope.IsEmpty() || scope.RecursionDepth() > 0 {
 		return n, transform.SameTree, nil
 	}
-	ok, val := sql.HasDefaultValue(ctx, ctx.Session, "sql_select_limit")
-	if ok {
-		// we only apply limit if the default has been modified
+	limit, ok, err := selectLimit(ctx)
+	if err != nil {
+		return nil, transform.SameTree, err
+	}
+	if !ok {
 		return n, transform.SameTree, nil
 	}
-	limit := expression.NewLiteral(mustCastNumToInt64(val), types.Int64)
-	ret, same := applyLimit(n, limit)
+	ret, same := applyLimit(n, expression.NewLiteral(limit, types.Int64))
 	return ret, same, nil
 }
 
+// selectLimit returns the value of the sql_select_limit system variable, and false if it doesn't limit the number of
+// rows of SELECT statements, like its default value of 18446744073709551615.
+func selectLimit(ctx *sql.Context) (int64, bool, error) {
+	val, err := ctx.GetSessionVariable(ctx, "sql_select_limit")
+	if err != nil {
+		return 0, false, err
+	}
+	limit, ok := val.(uint64)
+	if !ok || limit > math.MaxInt64 {
+		return 0, false, nil
+	}
+	return int64(limit), true, nil
+}
+
+// applyLimit wraps the plan given in a Limit of the number of rows given if it's the plan of a SELECT statement without
+// a LIMIT clause.
 func applyLimit(n sql.Node, limit sql.Expression) (sql.Node, transform.TreeIdentity) {
-	var child sql.Node
 	switch n := n.(type) {
 	case *plan.Limit:
 		return n, transform.SameTree
@@ -57,47 +75,20 @@ func applyLimit(n sql.Node, limit sql.Expression) (sql.Node, transform.TreeIdent
 			return n, transform.SameTree
 		}
 		return n.WithLimit(limit), transform.NewTree
-	case *plan.With:
-		child = n.Child
-	case *plan.Describe:
-		child = n.Child
-	case *plan.Sort, *plan.GroupBy, *plan.Project, *plan.SubqueryAlias,
-		*plan.Window:
-		ret := plan.NewLimit(limit, n)
+	case *plan.TransactionCommittingNode, *plan.QueryProcess:
+		// prepared statements are cached with these nodes already applied
+		c, same := applyLimit(n.Children()[0], limit)
+		if same {
+			return n, transform.SameTree
+		}
+		ret, _ := n.WithChildren(c)
 		return ret, transform.NewTree
+	case *plan.Project, *plan.Sort, *plan.TopN, *plan.GroupBy, *plan.Having, *plan.Window, *plan.Distinct,
+		*plan.OrderedDistinct, *plan.Offset, *plan.Filter, *plan.SubqueryAlias, *plan.RecursiveCte, *plan.JoinNode,
+		*plan.TableAlias, *plan.ResolvedTable, *plan.IndexedTableAccess, *plan.ValueDerivedTable, *plan.JSONTable,
+		*plan.EmptyTable:
+		return plan.NewLimit(limit, n), transform.NewTree
 	default:
 		return n, transform.SameTree
 	}
-	c, same := applyLimit(child, limit)
-	if !same {
-		ret, _ := n.WithChildren(c)
-		return ret, transform.NewTree
-	}
-	return n, transform.SameTree
-}
-
-func mustCastNumToInt64(x interface{}) int64 {
-	switch v := x.(type) {
-	case int8:
-		return int64(v)
-	case int16:
-		return int64(v)
-	case int32:
-		return int64(v)
-	case uint8:
-		return int64(v)
-	case uint16:
-		return int64(v)
-	case uint32:
-		return int64(v)
-	case int64:
-		return int64(v)
-	case uint64:
-		i64 := int64(v)
-		if v == uint64(i64) {
-			return i64
-		}
-	}
-
-	panic(fmt.Sprintf("failed to convert to int64: %v", x))
 }
@@ -17,12 +17,14 @@ package analyzer
 import (
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 	"github.com/dolthub/go-mysql-server/sql/transform"
 )
 
 // insertTopNNodes replaces Limit(Sort(...)) and Limit(Offset(Sort(...))) with
-// a TopN node.
+// a TopN node. A Limit(Sort(...)) ordering by RAND() gets a ReservoirSample node
+// instead, which picks the rows to return without sorting.
 func insertTopNNodes(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
 	var updateCalcFoundRows bool
 	return transform.NodeWithCtx(n, nil, func(tc transform.Context) (sql.Node, transform.TreeIdentity, error) {
@@ -49,6 +51,10 @@ func insertTopNNodes(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, se
 				}
 				return tc.Node, transform.SameTree, nil
 			}
+			if !l.CalcFoundRows && isOrderByRand(childSort.SortFields) {
+				node, err := l.WithChildren(plan.NewReservoirSample(l.Limit, childSort.UnaryNode.Child))
+				return node, transform.NewTree, err
+			}
 			topn := plan.NewTopN(childSort.SortFields, l.Limit, childSort.UnaryNode.Child)
 			topn = topn.WithCalcFoundRows(l.CalcFoundRows)
 			node, err := l.WithCalcFoundRows(false).WithChildren(topn)
@@ -57,3 +63,13 @@ func insertTopNNodes(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, se
 		return tc.Node, transform.SameTree, nil
 	})
 }
+
+// isOrderByRand returns whether the sort fields given order rows randomly, as ORDER BY RAND() does. RAND() with a
+// seed orders rows deterministically, so it doesn't count.
+func isOrderByRand(fields sql.SortFields) bool {
+	if len(fields) != 1 {
+		return false
+	}
+	r, ok := fields[0].Column.(*function.Rand)
+	return ok && r.Child == nil
+}
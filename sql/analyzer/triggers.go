@@ -138,12 +138,14 @@ func applyTriggers(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel
 
 	var affectedTables []string
 	var triggerEvent plan.TriggerEvent
+	isReplace := false
 	db := ctx.GetCurrentDatabase()
 	transform.Inspect(n, func(n sql.Node) bool {
 		switch n := n.(type) {
 		case *plan.InsertInto:
 			affectedTables = append(affectedTables, getTableName(n))
 			triggerEvent = plan.InsertTrigger
+			isReplace = n.IsReplace
 			if n.Database() != nil && n.Database().Name() != "" {
 				db = n.Database().Name()
 			}
@@ -195,7 +197,10 @@ func applyTriggers(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel
 			}
 
 			triggerTable := getTableName(ct.Table)
-			if stringContains(affectedTables, triggerTable) && triggerEventsMatch(triggerEvent, ct.TriggerEvent) {
+			// A REPLACE deletes the rows the inserted rows conflict with, so it fires delete triggers as well
+			eventsMatch := triggerEventsMatch(triggerEvent, ct.TriggerEvent) ||
+				isReplace && triggerEventsMatch(plan.DeleteTrigger, ct.TriggerEvent)
+			if stringContains(affectedTables, triggerTable) && eventsMatch {
 				if block, ok := ct.Body.(*plan.BeginEndBlock); ok {
 					ct.Body = plan.NewTriggerBeginEndBlock(block)
 				}
@@ -208,7 +213,12 @@ func applyTriggers(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel
 		return n, transform.SameTree, nil
 	}
 
-	triggers := orderTriggersAndReverseAfter(affectedTriggers)
+	var triggers []*plan.CreateTrigger
+	if isReplace {
+		triggers = orderReplaceTriggers(affectedTriggers)
+	} else {
+		triggers = orderTriggersAndReverseAfter(affectedTriggers)
+	}
 	originalNode := n
 	same := transform.SameTree
 	allSame := transform.SameTree
@@ -249,7 +259,14 @@ func applyTrigger(ctx *sql.Context, a *Analyzer, originalNode, n sql.Node, scope
 
 		switch n := c.Node.(type) {
 		case *plan.InsertInto:
-			if trigger.TriggerTime == sqlparser.BeforeStr {
+			if trigger.TriggerEvent == sqlparser.DeleteStr {
+				// Delete triggers of a REPLACE wrap the insert whether they are before or after triggers. The insert runs
+				// them for each row it deletes, before inserting the row replacing it.
+				return plan.NewTriggerExecutor(n, triggerLogic, plan.DeleteTrigger, plan.TriggerTime(trigger.TriggerTime), sql.TriggerDefinition{
+					Name:            trigger.TriggerName,
+					CreateStatement: trigger.CreateTriggerString,
+				}), transform.NewTree, nil
+			} else if trigger.TriggerTime == sqlparser.BeforeStr {
 				triggerExecutor := plan.NewTriggerExecutor(n.Source, triggerLogic, plan.InsertTrigger, plan.TriggerTime(trigger.TriggerTime), sql.TriggerDefinition{
 					Name:            trigger.TriggerName,
 					CreateStatement: trigger.CreateTriggerString,
@@ -390,6 +407,31 @@ func orderTriggersAndReverseAfter(triggers []*plan.CreateTrigger) []*plan.Create
 	return append(beforeTriggers, afterTriggers...)
 }
 
+// orderReplaceTriggers orders the insert and delete triggers of a REPLACE to be applied to it. Like MySQL, the before
+// insert triggers of a row run first, then the before and after delete triggers of each row it replaces, then its after
+// insert triggers. The delete triggers are applied last so they wrap the after insert triggers, and in reverse, so the
+// insert finds them nested in the order they run.
+func orderReplaceTriggers(triggers []*plan.CreateTrigger) []*plan.CreateTrigger {
+	var insertTriggers, deleteTriggers []*plan.CreateTrigger
+	for _, trigger := range triggers {
+		if trigger.TriggerEvent == sqlparser.DeleteStr {
+			deleteTriggers = append(deleteTriggers, trigger)
+		} else {
+			insertTriggers = append(insertTriggers, trigger)
+		}
+	}
+
+	beforeDelete, afterDelete := plan.OrderTriggers(deleteTriggers)
+	ordered := orderTriggersAndReverseAfter(insertTriggers)
+	for i := len(afterDelete) - 1; i >= 0; i-- {
+		ordered = append(ordered, afterDelete[i])
+	}
+	for i := len(beforeDelete) - 1; i >= 0; i-- {
+		ordered = append(ordered, beforeDelete[i])
+	}
+	return ordered
+}
+
 func triggerEventsMatch(event plan.TriggerEvent, event2 string) bool {
 	return strings.ToLower((string)(event)) == strings.ToLower(event2)
 }
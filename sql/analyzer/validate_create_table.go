@@ -44,7 +44,9 @@ func validateCreateTable(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope
 	keyedColumns := make(map[string]bool)
 	for _, index := range ct.TableSpec().IdxDefs {
 		for _, col := range index.Columns {
-			keyedColumns[col.Name] = true
+			if !col.IsFunctional() {
+				keyedColumns[col.Name] = true
+			}
 		}
 	}
 
@@ -393,6 +395,9 @@ func validateAlterIndex(ctx *sql.Context, initialSch, sch sql.Schema, ai *plan.A
 
 	switch ai.Action {
 	case plan.IndexAction_Create:
+		if err := validateFunctionalKeyPartConstraint(ai.Columns, ai.Constraint); err != nil {
+			return nil, err
+		}
 		badColName, ok := missingIdxColumn(ai.Columns, sch, tableName)
 		if !ok {
 			return nil, sql.ErrKeyColumnDoesNotExist.New(badColName)
@@ -461,10 +466,15 @@ func validatePrefixLength(ctx *sql.Context, schCol *sql.Column, idxCol sql.Index
 		return sql.ErrInvalidIndexPrefix.New(schCol.Name)
 	}
 
-	// Get prefix key length in bytes, so times 4 for varchar, text, and varchar
+	// Get prefix key length in bytes, which for character strings depends on the maximum length of a character in the
+	// column's character set
 	prefixByteLength := idxCol.Length
 	if types.IsTextOnly(schCol.Type) {
-		prefixByteLength = 4 * idxCol.Length
+		charLength := int64(4)
+		if collatedType, ok := schCol.Type.(sql.TypeWithCollation); ok {
+			charLength = collatedType.Collation().CharacterSet().MaxLength()
+		}
+		prefixByteLength = charLength * idxCol.Length
 	}
 
 	// Prefix length is longer than max
@@ -489,6 +499,9 @@ func validatePrefixLength(ctx *sql.Context, schCol *sql.Column, idxCol sql.Index
 // validateIndexType prevents creating invalid indexes
 func validateIndexType(ctx *sql.Context, cols []sql.IndexColumn, sch sql.Schema) error {
 	for _, idxCol := range cols {
+		if idxCol.IsFunctional() {
+			continue
+		}
 		schCol := sch[sch.IndexOfColName(idxCol.Name)]
 		err := validatePrefixLength(ctx, schCol, idxCol)
 		if err != nil {
@@ -498,10 +511,54 @@ func validateIndexType(ctx *sql.Context, cols []sql.IndexColumn, sch sql.Schema)
 	return nil
 }
 
+// validateFunctionalKeyPartConstraint validates that an index with the constraint given may have functional key parts.
+// Functional key parts can't be a part of primary keys or spatial indexes.
+func validateFunctionalKeyPartConstraint(cols []sql.IndexColumn, constraint sql.IndexConstraint) error {
+	for _, col := range cols {
+		if !col.IsFunctional() {
+			continue
+		}
+		switch constraint {
+		case sql.IndexConstraint_Primary:
+			return sql.ErrFunctionalIndexPrimaryKey.New()
+		case sql.IndexConstraint_Spatial:
+			return sql.ErrFunctionalIndexSpatial.New()
+		}
+	}
+	return nil
+}
+
+// validateFunctionalKeyPartExpressions validates that the expressions of the functional key parts given are
+// deterministic. Functions must be resolved before this can be determined.
+func validateFunctionalKeyPartExpressions(cols []sql.IndexColumn) error {
+	for _, col := range cols {
+		if !col.IsFunctional() {
+			continue
+		}
+		disallowed := false
+		sql.Inspect(col.Expression, func(e sql.Expression) bool {
+			switch e := e.(type) {
+			case *plan.Subquery:
+				disallowed = true
+			case sql.NonDeterministicExpression:
+				disallowed = e.IsNonDeterministic()
+			}
+			return !disallowed
+		})
+		if disallowed {
+			return sql.ErrFunctionalIndexDisallowedFunction.New(col.Expression.String())
+		}
+	}
+	return nil
+}
+
 // missingIdxColumn takes in a set of IndexColumns and returns false, along with the offending column name, if
 // an index Column is not in an index.
 func missingIdxColumn(cols []sql.IndexColumn, sch sql.Schema, tableName string) (string, bool) {
 	for _, c := range cols {
+		if c.IsFunctional() {
+			continue
+		}
 		if ok := sch.Contains(c.Name, tableName); !ok {
 			return c.Name, false
 		}
@@ -605,7 +662,13 @@ func validateIndexes(ctx *sql.Context, tableSpec *plan.TableSpec) error {
 		if idx.Constraint == sql.IndexConstraint_Primary {
 			hasPkIndexDef = true
 		}
+		if err := validateFunctionalKeyPartConstraint(idx.Columns, idx.Constraint); err != nil {
+			return err
+		}
 		for _, idxCol := range idx.Columns {
+			if idxCol.IsFunctional() {
+				continue
+			}
 			schCol, ok := lwrNames[strings.ToLower(idxCol.Name)]
 			if !ok {
 				return sql.ErrUnknownIndexColumn.New(idxCol.Name, idx.IndexName)
@@ -687,6 +750,9 @@ func validatePrimaryKey(ctx *sql.Context, initialSch, sch sql.Schema, ai *plan.A
 	tableName := getTableName(ai.Table)
 	switch ai.Action {
 	case plan.PrimaryKeyAction_Create:
+		if err := validateFunctionalKeyPartConstraint(ai.Columns, sql.IndexConstraint_Primary); err != nil {
+			return nil, err
+		}
 		badColName, ok := missingIdxColumn(ai.Columns, sch, tableName)
 		if !ok {
 			return nil, sql.ErrKeyColumnDoesNotExist.New(badColName)
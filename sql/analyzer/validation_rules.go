@@ -297,9 +297,13 @@ func validateGroupBy(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, se
 			groupBys = append(groupBys, expr.String())
 		}
 
+		// Subquery expressions can only see the grouped relation through outer scope references, which occupy the
+		// index range after the scope of the group by node itself.
+		scopeLen := len(scope.Schema())
+		outerRefs := [2]int{scopeLen, scopeLen + len(gb.Child.Schema())}
 		for _, expr := range gb.SelectedExprs {
 			if _, ok := expr.(sql.Aggregation); !ok {
-				if !expressionReferencesOnlyGroupBys(groupBys, expr) {
+				if !expressionReferencesOnlyGroupBys(groupBys, outerRefs, expr) {
 					err = analyzererrors.ErrValidationGroupBy.New(expr.String())
 					return false
 				}
@@ -311,11 +315,16 @@ func validateGroupBy(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, se
 	return n, transform.SameTree, err
 }
 
-func expressionReferencesOnlyGroupBys(groupBys []string, expr sql.Expression) bool {
+func expressionReferencesOnlyGroupBys(groupBys []string, outerRefs [2]int, expr sql.Expression) bool {
 	valid := true
 	sql.Inspect(expr, func(expr sql.Expression) bool {
 		switch expr := expr.(type) {
-		case nil, sql.Aggregation, *expression.Literal:
+		case nil, sql.Aggregation, *expression.Literal, *expression.SystemVar:
+			return false
+		case *plan.Subquery:
+			if !subqueryReferencesOnlyGroupBys(groupBys, outerRefs, expr) {
+				valid = false
+			}
 			return false
 		case *expression.Alias, sql.FunctionExpression:
 			if stringContains(groupBys, expr.String()) {
@@ -342,6 +351,28 @@ func expressionReferencesOnlyGroupBys(groupBys []string, expr sql.Expression) bo
 	return valid
 }
 
+// subqueryReferencesOnlyGroupBys returns whether every reference the subquery given makes to the grouped relation,
+// whose columns are found in the index range |outerRefs|, is to a grouping expression. References made from nested
+// subqueries are checked as well.
+func subqueryReferencesOnlyGroupBys(groupBys []string, outerRefs [2]int, sq *plan.Subquery) bool {
+	valid := true
+	transform.InspectExpressions(sq.Query, func(e sql.Expression) bool {
+		switch e := e.(type) {
+		case *expression.GetField:
+			if e.Index() >= outerRefs[0] && e.Index() < outerRefs[1] && !stringContains(groupBys, e.String()) {
+				valid = false
+			}
+		case *plan.Subquery:
+			if !subqueryReferencesOnlyGroupBys(groupBys, outerRefs, e) {
+				valid = false
+			}
+			return false
+		}
+		return valid
+	})
+	return valid
+}
+
 func validateSchemaSource(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
 	span, ctx := ctx.Span("validate_schema_source")
 	defer span.End()
@@ -362,6 +393,23 @@ func validateIndexCreation(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Sco
 	span, ctx := ctx.Span("validate_index_creation")
 	defer span.End()
 
+	switch n := n.(type) {
+	case *plan.AlterIndex:
+		if n.Action == plan.IndexAction_Create {
+			if err := validateFunctionalKeyPartExpressions(n.Columns); err != nil {
+				return nil, transform.SameTree, err
+			}
+		}
+		return n, transform.SameTree, nil
+	case *plan.CreateTable:
+		for _, idxDef := range n.IdxDefs {
+			if err := validateFunctionalKeyPartExpressions(idxDef.Columns); err != nil {
+				return nil, transform.SameTree, err
+			}
+		}
+		return n, transform.SameTree, nil
+	}
+
 	ci, ok := n.(*plan.CreateIndex)
 	if !ok {
 		return n, transform.SameTree, nil
@@ -416,6 +464,10 @@ func validateUnionSchemasMatch(ctx *sql.Context, a *Analyzer, n sql.Node, scope
 				return false
 			}
 			for i := range ls {
+				// columns of NULLs aren't converted to the type of the other side
+				if ls[i].Type == types.Null || rs[i].Type == types.Null {
+					continue
+				}
 				if !reflect.DeepEqual(ls[i].Type, rs[i].Type) {
 					firstmismatch = []string{
 						ls[i].Type.String(),
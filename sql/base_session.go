@@ -25,9 +25,10 @@ import (
 // BaseSession is the basic session implementation. Integrators should typically embed this type into their custom
 // session implementations to get base functionality.
 type BaseSession struct {
-	id     uint32
-	addr   string
-	client Client
+	id        uint32
+	addr      string
+	client    Client
+	connProps ConnectionProperties
 
 	// TODO(andy): in principle, we shouldn't
 	//   have concurrent access to the session.
@@ -45,10 +46,13 @@ type BaseSession struct {
 	warnings         []*Warning
 	warncnt          uint16
 	locks            map[string]bool
+	handlers         map[string]*Handler
 	queriedDb        string
 	lastQueryInfo    map[string]int64
 	tx               Transaction
 	ignoreAutocommit bool
+	stateChanged     bool
+	attributes       map[interface{}]interface{}
 
 	// When the MySQL database updates any tables related to privileges, it increments its counter. We then update our
 	// privilege set if our counter doesn't equal the database's counter.
@@ -89,6 +93,20 @@ func (s *BaseSession) GetIgnoreAutoCommit() bool {
 	return s.ignoreAutocommit
 }
 
+// StateChanged implements the Session interface.
+func (s *BaseSession) StateChanged() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.stateChanged
+}
+
+// ClearStateChanged implements the Session interface.
+func (s *BaseSession) ClearStateChanged() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stateChanged = false
+}
+
 var _ Session = (*BaseSession)(nil)
 
 func (s *BaseSession) SetTransactionDatabase(dbName string) {
@@ -115,13 +133,54 @@ func (s *BaseSession) SetClient(c Client) {
 	return
 }
 
-// GetAllSessionVariables implements the Session interface.
+// ConnectionProperties implements the Session interface.
+func (s *BaseSession) ConnectionProperties() ConnectionProperties {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.connProps
+}
+
+// SetConnectionProperties implements the Session interface.
+func (s *BaseSession) SetConnectionProperties(props ConnectionProperties) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.connProps = props
+}
+
+// GetAttribute implements the Session interface.
+func (s *BaseSession) GetAttribute(key interface{}) (interface{}, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	v, ok := s.attributes[key]
+	return v, ok
+}
+
+// SetAttribute implements the Session interface.
+func (s *BaseSession) SetAttribute(key interface{}, value interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.attributes == nil {
+		s.attributes = make(map[interface{}]interface{})
+	}
+	s.attributes[key] = value
+}
+
+// GetAllSessionVariables implements the Session interface. Variables that only exist globally, or that were added
+// after the session started, have their global value.
 func (s *BaseSession) GetAllSessionVariables() map[string]interface{} {
 	m := make(map[string]interface{})
+	if SystemVariables != nil {
+		m = SystemVariables.GetAllGlobalVariables()
+	}
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
 	for k, v := range s.systemVars {
+		if v.Var.Scope == SystemVariableScope_Global {
+			if _, ok := m[k]; ok {
+				continue
+			}
+		}
 		m[k] = v.Val
 	}
 	return m
@@ -141,7 +200,10 @@ func (s *BaseSession) SetSessionVariable(ctx *Context, sysVarName string, value
 			if !ok {
 				return ErrUnknownSystemVariable.New(sysVarName)
 			}
-			return s.setSessVar(ctx, sv, value)
+			if !sv.Dynamic {
+				return ErrSystemVariableReadOnly.New(sysVarName)
+			}
+			return s.setChangedSessVar(ctx, sv, value)
 		} else {
 			return ErrUnknownSystemVariable.New(sysVarName)
 		}
@@ -150,7 +212,18 @@ func (s *BaseSession) SetSessionVariable(ctx *Context, sysVarName string, value
 	if !sysVar.Var.Dynamic {
 		return ErrSystemVariableReadOnly.New(sysVarName)
 	}
-	return s.setSessVar(ctx, sysVar.Var, value)
+	return s.setChangedSessVar(ctx, sysVar.Var, value)
+}
+
+// setChangedSessVar sets the system variable given like setSessVar, and records that the state of the session changed.
+func (s *BaseSession) setChangedSessVar(ctx *Context, sysVar SystemVariable, value interface{}) error {
+	if err := s.setSessVar(ctx, sysVar, value); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stateChanged = true
+	return nil
 }
 
 // InitSessionVariable implements the Session interface and is used to initialize variables (Including read-only variables)
@@ -281,6 +354,9 @@ func (s *BaseSession) GetCurrentDatabase() string {
 func (s *BaseSession) SetCurrentDatabase(dbName string) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
+	if dbName != s.currentDB {
+		s.stateChanged = true
+	}
 	s.currentDB = dbName
 	logger := s.logger
 	if logger == nil {
@@ -427,6 +503,30 @@ func (s *BaseSession) GetLastQueryInfo(key string) int64 {
 	return s.lastQueryInfo[key]
 }
 
+// SetHandler implements the Session interface.
+func (s *BaseSession) SetHandler(name string, handler *Handler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.handlers == nil {
+		s.handlers = make(map[string]*Handler)
+	}
+	s.handlers[strings.ToLower(name)] = handler
+}
+
+// GetHandler implements the Session interface.
+func (s *BaseSession) GetHandler(name string) *Handler {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.handlers[strings.ToLower(name)]
+}
+
+// DelHandler implements the Session interface.
+func (s *BaseSession) DelHandler(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.handlers, strings.ToLower(name))
+}
+
 func (s *BaseSession) GetTransaction() Transaction {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
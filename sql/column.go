@@ -20,6 +20,9 @@ import (
 	"strings"
 )
 
+// MaxColumnCommentLength is the maximum length of a column comment, in characters.
+const MaxColumnCommentLength = 1024
+
 // Column is the definition of a table column.
 // As SQL:2016 puts it:
 //
@@ -47,6 +50,20 @@ type Column struct {
 	Comment string
 	// Extra contains any additional information to put in the `extra` column under `information_schema.columns`.
 	Extra string
+	// Origin is the column of a table the values of this column come from when this column is an alias of it, or
+	// comes from an alias of its table. It's nil otherwise.
+	Origin *ColumnOrigin
+}
+
+// ColumnOrigin is the column of a table the values of a column of results come from, as described to clients in the
+// metadata of results.
+type ColumnOrigin struct {
+	// Table is the name the query gives the table, which is its alias if it has one
+	Table string
+	// OriginalTable is the name of the table
+	OriginalTable string
+	// OriginalName is the name of the column in the table
+	OriginalName string
 }
 
 // Check ensures the value is correct for this column.
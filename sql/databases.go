@@ -22,6 +22,10 @@ import (
 const (
 	// InformationSchemaDatabaseName is the name of the information schema database.
 	InformationSchemaDatabaseName = "information_schema"
+	// SysDatabaseName is the name of the sys schema database.
+	SysDatabaseName = "sys"
+	// PerformanceSchemaDatabaseName is the name of the performance schema database.
+	PerformanceSchemaDatabaseName = "performance_schema"
 )
 
 // DatabaseProvider is the fundamental interface to integrate with the engine. It provides access to all databases in
@@ -61,6 +65,49 @@ type TableFunctionProvider interface {
 	TableFunction(ctx *Context, name string) (TableFunction, error)
 }
 
+// StatementClass is the class of a statement, by whether it reads or writes data, as given to a StatementRouter.
+type StatementClass byte
+
+const (
+	// StatementClassRead is the class of statements that only read data, such as SELECT and SHOW statements
+	StatementClassRead StatementClass = iota
+	// StatementClassWrite is the class of statements that write data, such as INSERT, UPDATE and DELETE statements,
+	// including those that also read data, such as INSERT ... SELECT
+	StatementClassWrite
+	// StatementClassDDL is the class of statements that change schemas, such as CREATE TABLE and DROP DATABASE
+	StatementClassDDL
+	// StatementClassTransaction is the class of statements that begin and end transactions, such as START TRANSACTION,
+	// COMMIT and ROLLBACK
+	StatementClassTransaction
+)
+
+// String implements fmt.Stringer
+func (c StatementClass) String() string {
+	switch c {
+	case StatementClassRead:
+		return "read"
+	case StatementClassWrite:
+		return "write"
+	case StatementClassDDL:
+		return "ddl"
+	case StatementClassTransaction:
+		return "transaction"
+	default:
+		return "unknown"
+	}
+}
+
+// StatementRouter chooses the DatabaseProvider each statement is analyzed and executed against, such as to serve reads
+// from a replica and writes from its primary. It's consulted by the engine for each statement after it's parsed and
+// before it's analyzed. Once a statement that writes data or changes schemas runs in a transaction, the engine routes
+// the rest of the transaction's statements to the provider that statement was routed to without consulting the
+// router, so transactions read their own writes and are committed where they made them.
+type StatementRouter interface {
+	// RouteStatement returns the provider the statement of the class given, of the session of the context given, is
+	// analyzed and executed against, or nil for the engine's own provider.
+	RouteStatement(ctx *Context, class StatementClass) (DatabaseProvider, error)
+}
+
 // Database represents the database. Its primary job is to provide access to all tables.
 type Database interface {
 	Nameable
@@ -284,6 +331,21 @@ func GetTableInsensitive(tblName string, tables map[string]Table) (Table, bool)
 	return nil, false
 }
 
+// LowerCaseTableNames returns the value of the lower_case_table_names system variable, which sets how databases that
+// support it store and compare the names of tables: 0 stores them as given and compares them case-sensitively, 1
+// stores them in lower case and compares them case-insensitively, and 2 stores them as given and compares them
+// case-insensitively.
+func LowerCaseTableNames() int64 {
+	if SystemVariables != nil {
+		if _, val, ok := SystemVariables.GetGlobal("lower_case_table_names"); ok {
+			if mode, ok := val.(int64); ok {
+				return mode
+			}
+		}
+	}
+	return 2
+}
+
 // GetTableNameInsensitive implements a case-insensitive search of a slice of table names. It looks for exact matches
 // first.  If no exact matches are found then any table matching the name case insensitively should be returned.  If
 // there is more than one table that matches a case-insensitive comparison the resolution strategy is not defined.
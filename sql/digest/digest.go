@@ -0,0 +1,239 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package digest normalizes statements the way the statement digests of MySQL's performance_schema do, so that
+// statements that only differ in their formatting, comments or literal values are grouped together.
+package digest
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// ErrInvalidStatement is returned when a statement can't be tokenized to compute its digest.
+var ErrInvalidStatement = errors.NewKind("unable to compute the digest of statement: invalid token %q at position %d")
+
+const (
+	// literalPlaceholder replaces literals in the normalized text of statements.
+	literalPlaceholder = "?"
+	// singleValueRow replaces rows and lists of a single literal.
+	singleValueRow = "(?)"
+	// multiValueRow replaces rows and lists of several literals.
+	multiValueRow = "(...)"
+	// rowListSuffix follows the first of a list of rows, which replaces the whole list.
+	rowListSuffix = "/* , ... */"
+)
+
+// Digest is the normalized form of a statement.
+type Digest struct {
+	// Text is the normalized text of the statement: its comments are removed, its keywords upper cased, its
+	// identifiers quoted, its tokens separated by single spaces, and its literals replaced by ?. Lists of literals are
+	// replaced by (...), and lists of rows of literals, such as the VALUES of an INSERT, by their first row followed by
+	// /* , ... */, like in the DIGEST_TEXT column of performance_schema.
+	Text string
+	// Hash is the hex-encoded SHA-256 hash of Text, like the DIGEST column of performance_schema.
+	Hash string
+	// Literals are the literal values of the statement, in the order they appear in it, as written in SQL.
+	Literals []string
+}
+
+// kind is the kind of a token of a normalized statement.
+type kind byte
+
+const (
+	kindOther kind = iota
+	// kindOperand tokens are the identifiers and keywords after which + and - are binary operators.
+	kindOperand
+	kindLiteral
+	kindComma
+	kindOpenParen
+	kindCloseParen
+	kindRow
+)
+
+type token struct {
+	text string
+	kind kind
+}
+
+// operators are the texts of the tokens the tokenizer returns without their values.
+var operators = map[int]string{
+	sqlparser.AND:                     "&&",
+	sqlparser.OR:                      "||",
+	sqlparser.LE:                      "<=",
+	sqlparser.GE:                      ">=",
+	sqlparser.NE:                      "!=",
+	sqlparser.NULL_SAFE_EQUAL:         "<=>",
+	sqlparser.SHIFT_LEFT:              "<<",
+	sqlparser.SHIFT_RIGHT:             ">>",
+	sqlparser.JSON_EXTRACT_OP:         "->",
+	sqlparser.JSON_UNQUOTE_EXTRACT_OP: "->>",
+}
+
+// FromQuery returns the digest of the query given.
+func FromQuery(query string) (Digest, error) {
+	var toks []token
+	var literals []string
+	addLiteral := func(lit string) {
+		literals = append(literals, lit)
+		toks = append(toks, token{literalPlaceholder, kindLiteral})
+	}
+
+	tokenizer := sqlparser.NewStringTokenizer(query)
+	for {
+		typ, val := tokenizer.Scan()
+		switch typ {
+		case 0:
+			return newDigest(collapseRows(collapseLists(toks)), literals), nil
+		case sqlparser.LEX_ERROR:
+			return Digest{}, ErrInvalidStatement.New(string(val), tokenizer.Position)
+		case sqlparser.COMMENT, ';':
+		case sqlparser.STRING:
+			addLiteral(sqlparser.String(sqlparser.NewStrVal(val)))
+		case sqlparser.INTEGRAL, sqlparser.FLOAT, sqlparser.HEXNUM:
+			lit := string(val)
+			// The sign of a number is part of its literal, rather than a unary operator
+			if n := len(toks); n > 0 && (toks[n-1].text == "-" || toks[n-1].text == "+") && (n == 1 || !isOperand(toks[n-2])) {
+				lit = toks[n-1].text + lit
+				toks = toks[:n-1]
+			}
+			addLiteral(lit)
+		case sqlparser.HEX:
+			addLiteral("X'" + string(val) + "'")
+		case sqlparser.BIT_LITERAL:
+			addLiteral("B'" + string(val) + "'")
+		case sqlparser.VALUE_ARG, sqlparser.LIST_ARG:
+			// Placeholders aren't literals, but are normalized like them
+			toks = append(toks, token{literalPlaceholder, kindLiteral})
+		case sqlparser.ID:
+			toks = append(toks, token{formatIdentifier(string(val)), kindOperand})
+		case '(':
+			toks = append(toks, token{"(", kindOpenParen})
+		case ')':
+			toks = append(toks, token{")", kindCloseParen})
+		case ',':
+			toks = append(toks, token{",", kindComma})
+		default:
+			toks = append(toks, otherToken(typ, val))
+		}
+	}
+}
+
+// isOperand returns whether a + or - following the token given is a binary operator.
+func isOperand(tok token) bool {
+	return tok.kind == kindOperand || tok.kind == kindLiteral || tok.kind == kindCloseParen || tok.kind == kindRow
+}
+
+// FromStatement returns the digest of the parsed statement given. It's the digest of the statement formatted by the
+// parser, which makes implicit parts of some statements explicit, such as the ASC of ORDER BY clauses, so it may differ
+// from the digest of the query the statement was parsed from.
+func FromStatement(stmt sqlparser.Statement) (Digest, error) {
+	return FromQuery(sqlparser.String(stmt))
+}
+
+func newDigest(toks []token, literals []string) Digest {
+	texts := make([]string, len(toks))
+	for i, tok := range toks {
+		texts[i] = tok.text
+	}
+	text := strings.Join(texts, " ")
+	hash := sha256.Sum256([]byte(text))
+	return Digest{
+		Text:     text,
+		Hash:     hex.EncodeToString(hash[:]),
+		Literals: literals,
+	}
+}
+
+// formatIdentifier returns the identifier given quoted, unless it's a user or system variable.
+func formatIdentifier(id string) string {
+	if strings.HasPrefix(id, "@") {
+		return id
+	}
+	return sql.QuoteIdentifier(id)
+}
+
+// otherToken returns the normalized token of a keyword or an operator.
+func otherToken(typ int, val []byte) token {
+	if val == nil {
+		if op, ok := operators[typ]; ok {
+			return token{op, kindOther}
+		}
+		return token{string(rune(typ)), kindOther}
+	}
+
+	tok := token{strings.ToUpper(string(val)), kindOther}
+	switch typ {
+	case sqlparser.NULL, sqlparser.TRUE, sqlparser.FALSE:
+		tok.kind = kindOperand
+	}
+	return tok
+}
+
+// collapseLists replaces the lists of literals in parentheses of the tokens given by a single token.
+func collapseLists(toks []token) []token {
+	var collapsed []token
+	for i := 0; i < len(toks); i++ {
+		if toks[i].kind != kindOpenParen {
+			collapsed = append(collapsed, toks[i])
+			continue
+		}
+		end, values := i+1, 0
+		for end < len(toks) && toks[end].kind == kindLiteral {
+			values++
+			if end+1 < len(toks) && toks[end+1].kind == kindComma {
+				end += 2
+			} else {
+				end++
+			}
+		}
+		if values == 0 || end >= len(toks) || toks[end].kind != kindCloseParen || toks[end-1].kind != kindLiteral {
+			collapsed = append(collapsed, toks[i])
+			continue
+		}
+		row := token{singleValueRow, kindRow}
+		if values > 1 {
+			row.text = multiValueRow
+		}
+		collapsed = append(collapsed, row)
+		i = end
+	}
+	return collapsed
+}
+
+// collapseRows replaces the lists of rows of literals of the tokens given by their first row followed by a comment.
+func collapseRows(toks []token) []token {
+	var collapsed []token
+	for i := 0; i < len(toks); i++ {
+		collapsed = append(collapsed, toks[i])
+		if toks[i].kind != kindRow {
+			continue
+		}
+		end := i
+		for end+2 < len(toks) && toks[end+1].kind == kindComma && toks[end+2].kind == kindRow {
+			end += 2
+		}
+		if end > i {
+			collapsed = append(collapsed, token{rowListSuffix, kindOther})
+			i = end
+		}
+	}
+	return collapsed
+}
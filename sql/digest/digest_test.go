@@ -0,0 +1,194 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package digest
+
+import (
+	"testing"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromQuery(t *testing.T) {
+	tests := []struct {
+		query    string
+		text     string
+		literals []string
+	}{
+		{
+			query:    "select * from mytable where i = 1",
+			text:     "SELECT * FROM `mytable` WHERE `i` = ?",
+			literals: []string{"1"},
+		},
+		{
+			query:    "SELECT a, b FROM `my table` WHERE s = 'abc' AND f > 1.5e3",
+			text:     "SELECT `a` , `b` FROM `my table` WHERE `s` = ? AND `f` > ?",
+			literals: []string{"'abc'", "1.5e3"},
+		},
+		{
+			query:    "select * from t where i in (1, 2, 3) and j in (4)",
+			text:     "SELECT * FROM `t` WHERE `i` IN (...) AND `j` IN (?)",
+			literals: []string{"1", "2", "3", "4"},
+		},
+		{
+			query:    "insert into t values (1, 'a'), (2, 'b'), (3, 'c')",
+			text:     "INSERT INTO `t` VALUES (...) /* , ... */",
+			literals: []string{"1", "'a'", "2", "'b'", "3", "'c'"},
+		},
+		{
+			query:    "insert into t (i) values (1), (2)",
+			text:     "INSERT INTO `t` ( `i` ) VALUES (?) /* , ... */",
+			literals: []string{"1", "2"},
+		},
+		{
+			query:    "select -1, a - 1, (-2.5), a+1 from t",
+			text:     "SELECT ? , `a` - ? , (?) , `a` + ? FROM `t`",
+			literals: []string{"-1", "1", "-2.5", "1"},
+		},
+		{
+			query:    "select x'0A', 0x0b, b'101', null, true from t where a <=> b and c != d",
+			text:     "SELECT ? , ? , ? , NULL , TRUE FROM `t` WHERE `a` <=> `b` AND `c` != `d`",
+			literals: []string{"X'0A'", "0x0b", "B'101'"},
+		},
+		{
+			query: "select * from t where i = ? and j in (?, ?)",
+			text:  "SELECT * FROM `t` WHERE `i` = ? AND `j` IN (...)",
+		},
+		{
+			query: "select @a, @@max_allowed_packet",
+			text:  "SELECT @a , @@max_allowed_packet",
+		},
+		{
+			query:    "select count(*), sum(i + 1) from t group by j;",
+			text:     "SELECT COUNT ( * ) , SUM ( `i` + ? ) FROM `t` GROUP BY `j`",
+			literals: []string{"1"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.query, func(t *testing.T) {
+			d, err := FromQuery(test.query)
+			require.NoError(t, err)
+			assert.Equal(t, test.text, d.Text)
+			assert.Equal(t, test.literals, d.Literals)
+			assert.Len(t, d.Hash, 64)
+		})
+	}
+}
+
+func TestFromQueryStability(t *testing.T) {
+	variants := [][]string{
+		{
+			"select * from mytable where i = 1",
+			"SELECT * FROM mytable WHERE i = 2",
+			"select\n\t*\nfrom   mytable\nwhere i=3",
+			"select * /* a comment */ from mytable where i = 'x' -- another comment",
+			"select * from `mytable` where `i` = -4;",
+			"/* leading comment */ select * from mytable # trailing comment\n where i = 5.5",
+		},
+		{
+			"insert into t values (1, 2)",
+			"insert into t values ('a', 'b')",
+		},
+		{
+			"insert into t values (1, 2), (3, 4)",
+			"INSERT INTO t VALUES ('a','b'),('c','d'),('e','f')",
+		},
+		{
+			"select * from t where i in (1, 2)",
+			"select * from t where i in (1, 2, 3, 4, 5)",
+			"select * from t where i in (?, ?)",
+		},
+	}
+
+	for _, queries := range variants {
+		t.Run(queries[0], func(t *testing.T) {
+			expected, err := FromQuery(queries[0])
+			require.NoError(t, err)
+			for _, query := range queries[1:] {
+				d, err := FromQuery(query)
+				require.NoError(t, err)
+				assert.Equal(t, expected.Text, d.Text, query)
+				assert.Equal(t, expected.Hash, d.Hash, query)
+			}
+		})
+	}
+}
+
+func TestFromQueryCollisions(t *testing.T) {
+	queries := []string{
+		"select * from mytable where i = 1",
+		"select * from mytable where j = 1",
+		"select * from mytable where i > 1",
+		"select * from mytable where i = 1 and j = 2",
+		"select * from othertable where i = 1",
+		"select * from Mytable where i = 1",
+		"select i from mytable where i = 1",
+		"select * from mytable where i in (1)",
+		"select * from mytable where i in (1, 2)",
+		"select * from mytable where i = 1 limit 1",
+		"select * from mytable",
+		"delete from mytable where i = 1",
+		"update mytable set i = 1",
+		"insert into mytable values (1)",
+		"insert into mytable values (1, 2)",
+		"insert into mytable values (1), (2)",
+		"select `mytable.i` from mytable",
+		"select mytable.i from mytable",
+		"select a - 1 from mytable",
+		"select a, -1 from mytable",
+	}
+
+	hashes := make(map[string]string)
+	for _, query := range queries {
+		d, err := FromQuery(query)
+		require.NoError(t, err)
+		if other, ok := hashes[d.Hash]; ok {
+			t.Errorf("queries %q and %q have the same digest %s", other, query, d.Text)
+		}
+		hashes[d.Hash] = query
+	}
+}
+
+func TestFromStatement(t *testing.T) {
+	queries := []string{
+		"select a, b from mytable where a = 'x' and b in (1, 2) order by a limit 10",
+		"SELECT a,b FROM mytable WHERE a='y' AND b IN (3,4,5) ORDER BY a ASC LIMIT 1",
+		"select a, b /* comment */ from `mytable` where a = 'z' and b in (6, 7) order by a limit 2",
+	}
+
+	var expected Digest
+	for i, query := range queries {
+		stmt, err := sqlparser.Parse(query)
+		require.NoError(t, err)
+		d, err := FromStatement(stmt)
+		require.NoError(t, err)
+		if i == 0 {
+			expected = d
+			continue
+		}
+		assert.Equal(t, expected.Text, d.Text, query)
+		assert.Equal(t, expected.Hash, d.Hash, query)
+	}
+	assert.Equal(t, "SELECT `a` , `b` FROM `mytable` WHERE `a` = ? AND `b` IN (...) ORDER BY `a` ASC LIMIT ?", expected.Text)
+	assert.Equal(t, []string{"'x'", "1", "2", "10"}, expected.Literals)
+}
+
+func TestFromQueryInvalid(t *testing.T) {
+	_, err := FromQuery("select 'unterminated")
+	require.Error(t, err)
+	require.True(t, ErrInvalidStatement.Is(err))
+}
@@ -70,6 +70,10 @@ var (
 	// ErrAmbiguousColumnInOrderBy is returned when an order by column is ambiguous
 	ErrAmbiguousColumnInOrderBy = errors.NewKind("Column %q in order clause is ambiguous")
 
+	// ErrTableNameNotAllowedInUnionOrderBy is returned when the ORDER BY of a union references a column of one of
+	// its tables rather than one of its result columns
+	ErrTableNameNotAllowedInUnionOrderBy = errors.NewKind("Table '%s' from one of the SELECTs cannot be used in global ORDER clause")
+
 	// ErrColumnExists is returned when an ALTER TABLE statement would create a duplicate column
 	ErrColumnExists = errors.NewKind("Column %q already exists")
 
@@ -256,6 +260,10 @@ var (
 	// ErrSystemVariableGlobalOnly is returned when attempting to set a GLOBAL-only variable using SET SESSION.
 	ErrSystemVariableGlobalOnly = errors.NewKind(`Variable '%s' is a GLOBAL variable and should be set with SET GLOBAL`)
 
+	// ErrSystemVariableScope is returned when reading a system variable in a scope in which it doesn't exist, such as
+	// @@session.max_connections.
+	ErrSystemVariableScope = errors.NewKind(`Variable '%s' is a %s variable`)
+
 	// ErrUserVariableNoDefault is returned when attempting to set the default value on a user variable.
 	ErrUserVariableNoDefault = errors.NewKind(`User variable '%s' does not have a default value`)
 
@@ -551,6 +559,9 @@ var (
 	// ErrReadOnlyTransaction is returned when a write query is executed in a READ ONLY transaction.
 	ErrReadOnlyTransaction = errors.NewKind("cannot execute statement in a READ ONLY transaction")
 
+	// ErrSecureTransportRequired is returned when a client connects without TLS while require_secure_transport is on.
+	ErrSecureTransportRequired = errors.NewKind("Connections using insecure transport are prohibited while --require_secure_transport=ON.")
+
 	// ErrLockDeadlock is the go-mysql-server equivalent of ER_LOCK_DEADLOCK. Transactions throwing this error
 	// are automatically rolled back. Clients receiving this error must retry the transaction.
 	ErrLockDeadlock = errors.NewKind("serialization failure: %s, try restarting transaction.")
@@ -561,6 +572,10 @@ var (
 	// ErrViewDoesNotExist is returned when a DROP VIEW statement drops a view that does not exist
 	ErrViewDoesNotExist = errors.NewKind("the view %s.%s does not exist")
 
+	// ErrInvalidView is returned when a query references a view whose definition references tables, columns or
+	// functions that no longer exist
+	ErrInvalidView = errors.NewKind("View '%s.%s' references invalid table(s) or column(s) or function(s) or definer/invoker of view lack rights to use them")
+
 	// ErrSessionDoesNotSupportPersistence is thrown when a feature is not already supported
 	ErrSessionDoesNotSupportPersistence = errors.NewKind("session does not support persistence")
 
@@ -716,9 +731,40 @@ var (
 	// ErrKeyTooLong is returned for an index on a blob or text column that is longer than 3072 bytes
 	ErrKeyTooLong = errors.NewKind("specified key was too long; max key length is 3072 bytes")
 
+	// ErrQueryNotResumable is returned when paginating a query whose results can't be resumed after any of its rows
+	ErrQueryNotResumable = errors.NewKind("query can't be paginated, because %s")
+
+	// ErrInvalidPaginationToken is returned for a continuation token that wasn't returned for the query being paginated
+	ErrInvalidPaginationToken = errors.NewKind("invalid pagination token: %s")
+
+	// ErrNotShowStatement is returned when a statement other than a SHOW statement is passed to Engine.QueryShow
+	ErrNotShowStatement = errors.NewKind("not a SHOW statement: %s")
+
+	// ErrPacketTooLarge is returned for a statement, a parameter of a prepared statement or a result row larger than
+	// the max_allowed_packet system variable
+	ErrPacketTooLarge = errors.NewKind("Got a packet bigger than 'max_allowed_packet' bytes")
+
+	// ErrTooLongFieldComment is returned for a column comment longer than MaxColumnCommentLength characters
+	ErrTooLongFieldComment = errors.NewKind("Comment for field '%s' is too long (max = %d)")
+
 	// ErrKeyZero is returned for an index on a blob or text column that is 0 in length
 	ErrKeyZero = errors.NewKind("key part '%s' length cannot be 0")
 
+	// ErrFunctionalIndexOnColumn is returned for a functional key part that only references a column
+	ErrFunctionalIndexOnColumn = errors.NewKind("functional index on a column is not supported. Consider using a regular index instead")
+
+	// ErrFunctionalIndexPrimaryKey is returned for a primary key with a functional key part
+	ErrFunctionalIndexPrimaryKey = errors.NewKind("the primary key cannot be a functional index")
+
+	// ErrFunctionalIndexSpatial is returned for a spatial index with a functional key part
+	ErrFunctionalIndexSpatial = errors.NewKind("spatial functional index is not supported")
+
+	// ErrFunctionalIndexPrefix is returned for a functional key part with a prefix length
+	ErrFunctionalIndexPrefix = errors.NewKind("functional key part '%s' cannot have a prefix length")
+
+	// ErrFunctionalIndexDisallowedFunction is returned for a functional key part with a non-deterministic expression
+	ErrFunctionalIndexDisallowedFunction = errors.NewKind("expression of functional index '%s' contains a disallowed function")
+
 	// ErrDatabaseWriteLocked is returned when a database is locked in read-only mode to avoid
 	// conflicts with an active server
 	ErrDatabaseWriteLocked = errors.NewKind("database is locked to writes")
@@ -772,6 +818,15 @@ var (
 	// ErrValueOutOfRange is returned when a value is out of range for a type.
 	ErrValueOutOfRange = errors.NewKind("%v out of range for %v")
 
+	// ErrValueOutOfRangeForColumn is returned when a value of a row is out of range for the type of its column.
+	ErrValueOutOfRangeForColumn = errors.NewKind("Out of range value for column '%s' at row %d")
+
+	// ErrDataTruncatedForColumn is returned when a value of a row is too long for the type of its column.
+	ErrDataTruncatedForColumn = errors.NewKind("Data truncated for column '%s' at row %d")
+
+	// ErrIncorrectValueForColumn is returned when a value of a row can't be converted to the type of its column.
+	ErrIncorrectValueForColumn = errors.NewKind("Incorrect %s value: '%v' for column '%s' at row %d")
+
 	ErrConvertingToSet   = errors.NewKind("value %v is not valid for this set")
 	ErrDuplicateEntrySet = errors.NewKind("duplicate entry: %v")
 	ErrInvalidSetValue   = errors.NewKind("value %v was not found in the set")
@@ -787,6 +842,15 @@ var (
 
 	// ErrDroppedJoinFilters is returned when we removed filters from a join, but failed to re-insert them
 	ErrDroppedJoinFilters = errors.NewKind("dropped filters from join, but failed to re-insert them")
+
+	// ErrUnknownHandler is returned when a HANDLER statement names a handler that isn't open in the session.
+	ErrUnknownHandler = errors.NewKind("Unknown table '%s' in HANDLER")
+
+	// ErrHandlerKeyDoesNotExist is returned when HANDLER ... READ names an index the handler's table doesn't have.
+	ErrHandlerKeyDoesNotExist = errors.NewKind("Key '%s' doesn't exist in table '%s'")
+
+	// ErrHandlerTooManyKeyParts is returned when HANDLER ... READ gives more key values than the index has columns.
+	ErrHandlerTooManyKeyParts = errors.NewKind("Too many key parts specified; max %d parts allowed")
 )
 
 // CastSQLError returns a *mysql.SQLError with the error code and in some cases, also a SQL state, populated for the
@@ -815,6 +879,7 @@ func CastSQLError(err error) *mysql.SQLError {
 	switch {
 	case ErrTableNotFound.Is(err):
 		code = mysql.ERNoSuchTable
+		sqlState = mysql.SSUnknownTable
 	case ErrDatabaseExists.Is(err):
 		code = mysql.ERDbCreateExists
 	case ErrExpectedSingleRow.Is(err):
@@ -847,12 +912,41 @@ func CastSQLError(err error) *mysql.SQLError {
 		code = mysql.ERKeyColumnDoesNotExist
 	case ErrCantDropFieldOrKey.Is(err):
 		code = mysql.ERCantDropFieldOrKey
+	case ErrInvalidView.Is(err):
+		code = 1356 // TODO: Needs to be added to vitess
 	case ErrReadOnlyTransaction.Is(err):
 		code = 1792 // TODO: Needs to be added to vitess
 	case ErrCantDropIndex.Is(err):
 		code = 1553 // TODO: Needs to be added to vitess
-	case ErrInvalidValue.Is(err):
+	case ErrInvalidValue.Is(err), ErrIncorrectValueForColumn.Is(err):
 		code = mysql.ERTruncatedWrongValueForField
+	case ErrValueOutOfRangeForColumn.Is(err):
+		code = mysql.ERWarnDataOutOfRange
+	case ErrDataTruncatedForColumn.Is(err):
+		code = mysql.ERWarnDataTruncated
+	case ErrTooLongFieldComment.Is(err):
+		code = 1629 // TODO: Needs to be added to vitess
+	case ErrDuplicateAliasOrTable.Is(err):
+		code = mysql.ERNonUniqTable
+	case ErrUnknownHandler.Is(err):
+		code = mysql.ERUnknownTable
+	case ErrHandlerKeyDoesNotExist.Is(err):
+		code = mysql.ERKeyDoesNotExist
+	case ErrHandlerTooManyKeyParts.Is(err):
+		code = mysql.ERTooManyKeyParts
+	case ErrTableNameNotAllowedInUnionOrderBy.Is(err):
+		code = mysql.ERTableNameNotAllowedHere
+	case ErrCollationIllegalMix.Is(err):
+		code = mysql.ERCantAggregate2Collations
+	case ErrInvalidSystemVariableValue.Is(err):
+		code = mysql.ERWrongValueForVar
+	case ErrSystemVariableReadOnly.Is(err), ErrSystemVariableScope.Is(err):
+		code = mysql.ERIncorrectGlobalLocalVar
+	case ErrPacketTooLarge.Is(err):
+		code = mysql.ERNetPacketTooLarge
+		sqlState = mysql.SSNetError
+	case ErrSecureTransportRequired.Is(err):
+		code = 3159 // TODO: Needs to be added to vitess
 	case ErrLockDeadlock.Is(err):
 		// ER_LOCK_DEADLOCK signals that the transaction was rolled back
 		// due to a deadlock between concurrent transactions.
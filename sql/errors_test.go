@@ -17,6 +17,9 @@ func TestSQLErrorCast(t *testing.T) {
 		code int
 	}{
 		{ErrTableNotFound.New("table not found err"), mysql.ERNoSuchTable},
+		{ErrInvalidSystemVariableValue.New("sort_buffer_size", "abc"), mysql.ERWrongValueForVar},
+		{ErrSystemVariableReadOnly.New("version"), mysql.ERIncorrectGlobalLocalVar},
+		{ErrSystemVariableScope.New("max_connections", "GLOBAL"), mysql.ERIncorrectGlobalLocalVar},
 		{ErrInvalidType.New("unhandled mysql error"), mysql.ERUnknownError},
 		{fmt.Errorf("generic error"), mysql.ERUnknownError},
 		{nil, mysql.ERUnknownError},
@@ -119,6 +119,9 @@ func (a *Arithmetic) DebugString() string {
 
 // IsNullable implements the sql.Expression interface.
 func (a *Arithmetic) IsNullable() bool {
+	if isInterval(a.Left) || isInterval(a.Right) {
+		return true
+	}
 	if a.Type() == types.Timestamp || a.Type() == types.Datetime {
 		return true
 	}
@@ -139,14 +142,15 @@ func (a *Arithmetic) Type() sql.Type {
 	}
 
 	// applies for + and - ops
-	if isInterval(a.Left) || isInterval(a.Right) {
-		return types.Datetime
+	if i, ok := a.Left.(*Interval); ok {
+		return DateOffsetType(rTyp, i)
 	}
-
-	if types.IsTime(lTyp) && types.IsTime(rTyp) {
-		return types.Int64
+	if i, ok := a.Right.(*Interval); ok {
+		return DateOffsetType(lTyp, i)
 	}
 
+	lTyp, rTyp = numericContextType(lTyp), numericContextType(rTyp)
+
 	if !types.IsNumber(lTyp) || !types.IsNumber(rTyp) {
 		return types.Float64
 	}
@@ -187,6 +191,10 @@ func (a *Arithmetic) WithChildren(children ...sql.Expression) (sql.Expression, e
 
 // Eval implements the Expression interface.
 func (a *Arithmetic) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	if isInterval(a.Left) || isInterval(a.Right) {
+		return a.evalDateOffset(ctx, row)
+	}
+
 	lval, rval, err := a.evalLeftRight(ctx, row)
 	if err != nil {
 		return nil, err
@@ -213,6 +221,31 @@ func (a *Arithmetic) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	return nil, errUnableToEval.New(lval, a.Op, rval)
 }
 
+// evalDateOffset evaluates the addition of an interval to a date, or its subtraction from a date, the same way as
+// DATE_ADD and DATE_SUB.
+func (a *Arithmetic) evalDateOffset(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	date, interval := a.Left, a.Right
+	if i, ok := a.Left.(*Interval); ok {
+		date, interval = a.Right, i
+	}
+	i, ok := interval.(*Interval)
+	op := strings.ToLower(a.Op)
+	if !ok || op != sqlparser.PlusStr && (op != sqlparser.MinusStr || date != a.Left) {
+		return nil, errUnableToEval.New(a.Left, a.Op, a.Right)
+	}
+
+	val, err := date.Eval(ctx, row)
+	if err != nil || val == nil {
+		return nil, err
+	}
+	delta, err := i.EvalDelta(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+
+	return EvalDateOffset(ctx, a.Type(), val, i, delta, op == sqlparser.MinusStr)
+}
+
 func (a *Arithmetic) evalLeftRight(ctx *sql.Context, row sql.Row) (interface{}, interface{}, error) {
 	var lval, rval interface{}
 	var err error
@@ -247,17 +280,17 @@ func (a *Arithmetic) evalLeftRight(ctx *sql.Context, row sql.Row) (interface{},
 func (a *Arithmetic) convertLeftRight(ctx *sql.Context, left interface{}, right interface{}) (interface{}, interface{}, error) {
 	typ := a.Type()
 
-	lIsTimeType := types.IsTime(a.Left.Type())
-	rIsTimeType := types.IsTime(a.Right.Type())
+	lOrigType := a.Left.Type()
+	rOrigType := a.Right.Type()
 
 	if i, ok := left.(*TimeDelta); ok {
 		left = i
 	} else {
 		// these are the types we specifically want to capture from we get from Type()
 		if types.IsInteger(typ) || types.IsFloat(typ) || types.IsTime(typ) {
-			left = convertValueToType(ctx, typ, left, lIsTimeType)
+			left = convertValueToType(ctx, typ, left, lOrigType)
 		} else {
-			left = convertToDecimalValue(left, lIsTimeType)
+			left = convertToDecimalValue(left, lOrigType)
 		}
 	}
 
@@ -266,15 +299,24 @@ func (a *Arithmetic) convertLeftRight(ctx *sql.Context, left interface{}, right
 	} else {
 		// these are the types we specifically want to capture from we get from Type()
 		if types.IsInteger(typ) || types.IsFloat(typ) || types.IsTime(typ) {
-			right = convertValueToType(ctx, typ, right, rIsTimeType)
+			right = convertValueToType(ctx, typ, right, rOrigType)
 		} else {
-			right = convertToDecimalValue(right, rIsTimeType)
+			right = convertToDecimalValue(right, rOrigType)
 		}
 	}
 
 	return left, right, nil
 }
 
+// numericContextType returns the type that values of the type given take when used in a numeric context, such as an
+// operand of an arithmetic operator. Temporal values are used as integers, see convertTemporalToNumber.
+func numericContextType(t sql.Type) sql.Type {
+	if types.IsTime(t) || types.IsTimespan(t) {
+		return types.Int64
+	}
+	return t
+}
+
 func isInterval(expr sql.Expression) bool {
 	_, ok := expr.(*Interval)
 	return ok
@@ -331,13 +373,11 @@ func isOutermostArithmeticOp(e sql.Expression, d, dScale int32) bool {
 
 // convertValueToType returns given value converted into the given type. If the value is
 // invalid and cannot be converted to the given type, it returns nil, and it should be
-// interpreted as value of 0. For time types, all the numbers are parsed up to seconds only.
-// E.g: `2022-11-10 12:14:36` is parsed into `20221110121436` and `2022-03-24` is parsed into `20220324`.
-func convertValueToType(ctx *sql.Context, typ sql.Type, val interface{}, isTimeType bool) interface{} {
+// interpreted as value of 0. Values of temporal types are first converted to their numeric
+// form, see convertTemporalToNumber.
+func convertValueToType(ctx *sql.Context, typ sql.Type, val interface{}, valType sql.Type) interface{} {
 	var cval interface{}
-	if isTimeType {
-		val = convertTimeTypeToString(val)
-	}
+	val = convertTemporalToNumber(val, valType)
 
 	cval, _, err := typ.Convert(val)
 	if err != nil {
@@ -348,24 +388,55 @@ func convertValueToType(ctx *sql.Context, typ sql.Type, val interface{}, isTimeT
 	return cval
 }
 
-// convertTimeTypeToString returns string value parsed from either time.Time or string
-// representation. all the numbers are parsed up to seconds only. The location can be
-// different between two time.Time values, so we set it to default UTC location before
-// parsing. E.g:
-// `2022-11-10 12:14:36` is parsed into `20221110121436`
-// `2022-03-24` is parsed into `20220324`.
-func convertTimeTypeToString(val interface{}) interface{} {
-	if t, ok := val.(time.Time); ok {
-		val = t.In(time.UTC).Format("2006-01-02 15:04:05")
+// convertTemporalToNumber returns the value used for a DATE, DATETIME, TIMESTAMP or TIME value in a numeric context,
+// which depends on the type the value has. Dates become YYYYMMDD, datetimes and timestamps become YYYYMMDDhhmmss, and
+// times become hhmmss, with any fractional seconds of a time kept as the fractional part of a decimal. E.g:
+// `2022-11-10 12:14:36` is converted into `20221110121436`, `2022-03-24` into `20220324` and `-10:30:00.5` into
+// `-103000.5`. Values of any other type are returned unchanged.
+func convertTemporalToNumber(val interface{}, valType sql.Type) interface{} {
+	if types.IsTimespan(valType) {
+		ts, err := types.Time.ConvertToTimespan(val)
+		if err != nil {
+			return val
+		}
+		return timespanToNumber(ts)
 	}
-	if t, ok := val.(string); ok {
-		nums := timeTypeRegex.FindAllString(t, -1)
-		val = strings.Join(nums, "")
+	if !types.IsTime(valType) {
+		return val
 	}
 
+	switch v := val.(type) {
+	case time.Time:
+		// The location can be different between two time.Time values, so it's set to UTC before formatting
+		v = v.In(time.UTC)
+		if types.IsDateType(valType) {
+			return int64(v.Year()*10000 + int(v.Month())*100 + v.Day())
+		}
+		date := int64(v.Year()*10000 + int(v.Month())*100 + v.Day())
+		return date*1000000 + int64(v.Hour()*10000+v.Minute()*100+v.Second())
+	case string:
+		nums := timeTypeRegex.FindAllString(v, -1)
+		return strings.Join(nums, "")
+	}
 	return val
 }
 
+// timespanToNumber returns the numeric form hhmmss of the time given, as an int64 if it has no fractional seconds and
+// as a decimal otherwise.
+func timespanToNumber(ts types.Timespan) interface{} {
+	micros := ts.AsMicroseconds()
+	sign := int64(1)
+	if micros < 0 {
+		sign, micros = -1, -micros
+	}
+	secs := micros / 1000000
+	num := sign * (secs/3600*10000 + secs/60%60*100 + secs%60)
+	if frac := micros % 1000000; frac != 0 {
+		return decimal.New(num, 0).Add(decimal.New(sign*frac, -6))
+	}
+	return num
+}
+
 func plus(lval, rval interface{}) (interface{}, error) {
 	switch l := lval.(type) {
 	case uint8:
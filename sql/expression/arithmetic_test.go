@@ -64,7 +64,7 @@ func TestPlus(t *testing.T) {
 func TestPlusInterval(t *testing.T) {
 	require := require.New(t)
 
-	expected := time.Date(2018, time.May, 2, 0, 0, 0, 0, time.UTC)
+	expected := "2018-05-02"
 	op := NewPlus(
 		NewLiteral("2018-05-01", types.LongText),
 		NewInterval(NewLiteral(int64(1), types.Int64), "DAY"),
@@ -120,7 +120,7 @@ func TestMinus(t *testing.T) {
 func TestMinusInterval(t *testing.T) {
 	require := require.New(t)
 
-	expected := time.Date(2018, time.May, 1, 0, 0, 0, 0, time.UTC)
+	expected := "2018-05-01"
 	op := NewMinus(
 		NewLiteral("2018-05-02", types.LongText),
 		NewInterval(NewLiteral(int64(1), types.Int64), "DAY"),
@@ -131,6 +131,134 @@ func TestMinusInterval(t *testing.T) {
 	require.Equal(expected, result)
 }
 
+func TestCompoundUnitInterval(t *testing.T) {
+	testCases := []struct {
+		op       sql.Expression
+		expected interface{}
+	}{
+		{
+			NewMinus(
+				NewLiteral("2018-05-02 10:00:00", types.LongText),
+				NewInterval(NewLiteral("1:30", types.LongText), "HOUR_MINUTE"),
+			),
+			"2018-05-02 08:30:00",
+		},
+		{
+			NewPlus(
+				NewInterval(NewLiteral("1:30", types.LongText), "HOUR_MINUTE"),
+				NewLiteral("2018-05-02 10:00:00", types.LongText),
+			),
+			"2018-05-02 11:30:00",
+		},
+		{
+			NewPlus(
+				NewLiteral("2018-05-02", types.LongText),
+				NewInterval(NewLiteral("1-2", types.LongText), "YEAR_MONTH"),
+			),
+			"2019-07-02",
+		},
+		{
+			NewMinus(
+				NewLiteral("2018-05-02", types.LongText),
+				NewInterval(NewLiteral("1 12", types.LongText), "DAY_HOUR"),
+			),
+			"2018-04-30 12:00:00",
+		},
+		{
+			NewGreaterThan(
+				NewLiteral("2018-05-02 09:00:00", types.LongText),
+				NewMinus(
+					NewLiteral("2018-05-02 10:00:00", types.LongText),
+					NewInterval(NewLiteral("1:30", types.LongText), "HOUR_MINUTE"),
+				),
+			),
+			true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.op.String(), func(t *testing.T) {
+			result, err := tt.op.Eval(sql.NewEmptyContext(), nil)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestTemporalNumericContext(t *testing.T) {
+	date := time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)
+	datetime := time.Date(2020, 1, 2, 10, 30, 0, 0, time.UTC)
+	testCases := []struct {
+		op       sql.Expression
+		typ      sql.Type
+		expected interface{}
+	}{
+		{
+			NewPlus(NewLiteral(date, types.Date), NewLiteral(0, types.Int8)),
+			types.Int64,
+			int64(20200102),
+		},
+		{
+			NewMinus(NewLiteral(date, types.Date), NewLiteral(1, types.Int8)),
+			types.Int64,
+			int64(20200101),
+		},
+		{
+			NewPlus(NewLiteral(datetime, types.Datetime), NewLiteral(0, types.Int8)),
+			types.Int64,
+			int64(20200102103000),
+		},
+		{
+			NewMinus(NewLiteral(datetime, types.Datetime), NewLiteral(date, types.Date)),
+			types.Int64,
+			int64(20200102103000 - 20200102),
+		},
+		{
+			NewPlus(NewLiteral(mustTimespan("10:30:00"), types.Time), NewLiteral(0, types.Int8)),
+			types.Int64,
+			int64(103000),
+		},
+		{
+			NewMult(NewLiteral(mustTimespan("-10:30:00"), types.Time), NewLiteral(2, types.Int8)),
+			types.Int64,
+			int64(-206000),
+		},
+		{
+			NewMinus(NewLiteral(mustTimespan("10:30:00"), types.Time), NewLiteral(mustTimespan("09:00:00"), types.Time)),
+			types.Int64,
+			int64(13000),
+		},
+		{
+			NewPlus(NewLiteral("10:30:00", types.LongText), NewLiteral(0, types.Int8)),
+			types.Float64,
+			float64(10),
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.op.String(), func(t *testing.T) {
+			require.Equal(t, tt.typ, tt.op.Type())
+			result, err := tt.op.Eval(sql.NewEmptyContext(), nil)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestTimespanToNumber(t *testing.T) {
+	require.Equal(t, int64(103000), timespanToNumber(mustTimespan("10:30:00")))
+	require.Equal(t, int64(-8385959), timespanToNumber(mustTimespan("-838:59:59")))
+	require.Equal(t, "-103000.5", timespanToNumber(mustTimespan("-10:30:00.5")).(decimal.Decimal).String())
+}
+
+func mustTimespan(s string) types.Timespan {
+	ts, err := types.Time.ConvertToTimespan(s)
+	if err != nil {
+		panic(err)
+	}
+	return ts
+}
+
 func TestMult(t *testing.T) {
 	var testCases = []struct {
 		name        string
@@ -67,9 +67,43 @@ func (b *Between) Resolved() bool {
 	return b.Val.Resolved() && b.Lower.Resolved() && b.Upper.Resolved()
 }
 
+// compareType returns the type used to compare the value with its bounds. This is the type of the value, unless one
+// of the bounds is temporal and the value isn't, in which case the comparison is done between temporal values (such
+// as for a string literal between two DATE columns).
+func (b *Between) compareType() sql.Type {
+	if b.comparesAsDoubles() {
+		return types.Float64
+	}
+	typ := b.Val.Type()
+	if !types.IsTime(typ) && !types.IsTimespan(typ) {
+		for _, bound := range []sql.Expression{b.Lower, b.Upper} {
+			if bt := bound.Type(); types.IsTime(bt) || types.IsTimespan(bt) {
+				typ = bt
+				break
+			}
+		}
+	}
+	return typ.Promote()
+}
+
+// comparesAsDoubles returns whether the value is a string and one of the bounds a number, or vice versa, in which
+// case all of them are compared as floating point values.
+func (b *Between) comparesAsDoubles() bool {
+	typ := b.Val.Type()
+	return CompareAsDoubles(typ, b.Lower.Type()) || CompareAsDoubles(typ, b.Upper.Type())
+}
+
 // Eval implements the Expression interface.
 func (b *Between) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
-	typ := b.Val.Type().Promote()
+	typ := b.compareType()
+	asDoubles := b.comparesAsDoubles()
+	convert := func(v interface{}) (interface{}, error) {
+		if asDoubles {
+			return ConvertToDoubleForComparison(ctx, v), nil
+		}
+		v, _, err := typ.Convert(v)
+		return v, err
+	}
 
 	val, err := b.Val.Eval(ctx, row)
 	if err != nil {
@@ -80,7 +114,7 @@ func (b *Between) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, nil
 	}
 
-	val, _, err = typ.Convert(val)
+	val, err = convert(val)
 	if err != nil {
 		return nil, err
 	}
@@ -91,7 +125,7 @@ func (b *Between) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	}
 
 	if lower != nil {
-		lower, _, err = typ.Convert(lower)
+		lower, err = convert(lower)
 		if err != nil {
 			return nil, err
 		}
@@ -103,7 +137,7 @@ func (b *Between) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	}
 
 	if upper != nil {
-		upper, _, err = typ.Convert(upper)
+		upper, err = convert(upper)
 		if err != nil {
 			return nil, err
 		}
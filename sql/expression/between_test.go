@@ -16,6 +16,7 @@ package expression
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -64,6 +65,61 @@ func TestBetween(t *testing.T) {
 	}
 }
 
+func TestBetweenTemporal(t *testing.T) {
+	date := time.Date(2020, 1, 15, 0, 0, 0, 0, time.UTC)
+	datetime := time.Date(2020, 1, 15, 10, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		name     string
+		b        *Between
+		expected interface{}
+	}{
+		{
+			"date between strings",
+			NewBetween(
+				NewLiteral(date, types.Date),
+				NewLiteral("2020-1-1", types.LongText),
+				NewLiteral("2020-01-31", types.LongText),
+			),
+			true,
+		},
+		{
+			"string between dates",
+			NewBetween(
+				NewLiteral("2020-1-15", types.LongText),
+				NewLiteral(date, types.Date),
+				NewLiteral(datetime, types.Datetime),
+			),
+			true,
+		},
+		{
+			"string between a date and a string",
+			NewBetween(
+				NewLiteral("2020-1-16", types.LongText),
+				NewLiteral(date, types.Date),
+				NewLiteral("2020-01-31", types.LongText),
+			),
+			true,
+		},
+		{
+			"time between strings",
+			NewBetween(
+				NewLiteral(mustTimespan("10:30:00"), types.Time),
+				NewLiteral("9:00", types.LongText),
+				NewLiteral("11:00", types.LongText),
+			),
+			true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.b.Eval(sql.NewEmptyContext(), nil)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+}
+
 func TestBetweenIsNullable(t *testing.T) {
 	testCases := []struct {
 		name     string
@@ -91,6 +91,8 @@ func (b *BitOp) Type() sql.Type {
 		return lTyp
 	}
 
+	lTyp, rTyp = numericContextType(lTyp), numericContextType(rTyp)
+
 	if types.IsText(lTyp) || types.IsText(rTyp) {
 		return types.Float64
 	}
@@ -170,8 +172,8 @@ func (b *BitOp) evalLeftRight(ctx *sql.Context, row sql.Row) (interface{}, inter
 func (b *BitOp) convertLeftRight(ctx *sql.Context, left interface{}, right interface{}) (interface{}, interface{}, error) {
 	typ := b.Type()
 
-	left = convertValueToType(ctx, typ, left, types.IsTime(b.Left.Type()))
-	right = convertValueToType(ctx, typ, right, types.IsTime(b.Right.Type()))
+	left = convertValueToType(ctx, typ, left, b.Left.Type())
+	right = convertValueToType(ctx, typ, right, b.Right.Type())
 
 	return left, right, nil
 }
@@ -43,70 +43,119 @@ func NewCase(expr sql.Expression, branches []CaseBranch, elseExpr sql.Expression
 	return &Case{expr, branches, elseExpr}
 }
 
-// From the description of operator typing here:
-// https://dev.mysql.com/doc/refman/8.0/en/flow-control-functions.html#operator_case
-func combinedCaseBranchType(left, right sql.Type) sql.Type {
-	if left == types.Null {
-		return right
+// AggregateTypes returns the type of an expression whose result is the value of any of the expressions given, such as
+// CASE, IF, IFNULL and COALESCE. Their types are generalized with types.GeneralizeTypes, and a string result takes
+// the collation of the expressions with the lowest coercibility. Nil expressions are skipped.
+func AggregateTypes(exprs ...sql.Expression) sql.Type {
+	var typ sql.Type = types.Null
+	for _, e := range exprs {
+		if e != nil {
+			typ = types.GeneralizeTypes(typ, e.Type())
+		}
 	}
-	if right == types.Null {
-		return left
+
+	st, ok := typ.(sql.TypeWithCollation)
+	if !ok || !types.IsTextOnly(typ) {
+		return typ
 	}
-	if types.IsTextOnly(left) && types.IsTextOnly(right) {
-		return types.LongText
+	collation, ok := aggregateCollations(exprs)
+	if !ok || collation == st.Collation() {
+		return typ
 	}
-	if types.IsTextBlob(left) && types.IsTextBlob(right) {
-		return types.LongBlob
+	if withCollation, err := st.WithNewCollation(collation); err == nil {
+		return withCollation
 	}
-	if types.IsTime(left) && types.IsTime(right) {
-		if left == right {
-			return left
+	return types.LongText
+}
+
+// aggregateCollations returns the collation of the string results of the expressions given, if they have different
+// collations. Types don't carry coercibility, so it's resolved from the expressions. This needs a context, which types
+// don't have either, but the coercibility of expressions only depends on the session for values without a collation
+// of their own.
+func aggregateCollations(exprs []sql.Expression) (sql.CollationID, bool) {
+	var collation sql.CollationID
+	differ := false
+	for _, e := range exprs {
+		if e == nil {
+			continue
 		}
-		return types.Datetime
-	}
-	if types.IsNumber(left) && types.IsNumber(right) {
-		if left == types.Float64 || right == types.Float64 {
-			return types.Float64
+		if ct, ok := e.Type().(sql.TypeWithCollation); ok {
+			if collation != sql.Collation_Unspecified && ct.Collation() != collation {
+				differ = true
+			}
+			collation = ct.Collation()
 		}
-		if left == types.Float32 || right == types.Float32 {
-			return types.Float32
+	}
+	if !differ {
+		return sql.Collation_Unspecified, false
+	}
+
+	collation, _ = AggregateCollationCoercibility(sql.NewEmptyContext(), exprs...)
+	return collation, true
+}
+
+// ConvertAggregateResult converts the value of the expression given to the type given by AggregateTypes for an
+// expression returning it, if their types differ. Values converted to strings are formatted as their own type formats
+// them, so that a DATE becomes '2020-04-07' rather than a DATETIME string.
+func ConvertAggregateResult(ctx *sql.Context, typ sql.Type, e sql.Expression, val interface{}) (interface{}, error) {
+	if val == nil || types.TypesEqual(typ, e.Type()) {
+		return val, nil
+	}
+	if types.IsTextOnly(typ) && !types.IsText(e.Type()) {
+		sqlVal, err := e.Type().SQL(ctx, nil, val)
+		if err != nil {
+			return nil, err
 		}
-		if types.IsDecimal(left) || types.IsDecimal(right) {
-			return types.MustCreateDecimalType(65, 10)
+		val = sqlVal.ToString()
+	}
+	ret, _, err := typ.Convert(val)
+	return ret, err
+}
+
+// AggregateCollationCoercibility returns the collation and coercibility of an expression whose result is the value of
+// any of the expressions given, resolved from those of its string results. Nil expressions are skipped.
+func AggregateCollationCoercibility(ctx *sql.Context, exprs ...sql.Expression) (collation sql.CollationID, coercibility byte) {
+	collation, coercibility = sql.Collation_binary, 6
+	found := false
+	for _, e := range exprs {
+		if e == nil {
+			continue
 		}
-		if left == types.Uint64 && types.IsSigned(right) ||
-			right == types.Uint64 && types.IsSigned(left) {
-			return types.MustCreateDecimalType(65, 10)
+		if _, ok := e.Type().(sql.TypeWithCollation); !ok {
+			continue
 		}
-		if !types.IsSigned(left) && !types.IsSigned(right) {
-			return types.Uint64
-		} else {
-			return types.Int64
+		nextCollation, nextCoercibility := sql.GetCoercibility(ctx, e)
+		if !found {
+			collation, coercibility, found = nextCollation, nextCoercibility, true
+			continue
 		}
+		collation, coercibility = sql.ResolveCoercibility(collation, coercibility, nextCollation, nextCoercibility)
 	}
-	if types.IsJSON(left) && types.IsJSON(right) {
-		return types.JSON
+	if !found {
+		return AggregateTypes(exprs...).CollationCoercibility(ctx)
 	}
-	return types.LongText
+	return collation, coercibility
 }
 
 // Type implements the sql.Expression interface.
 func (c *Case) Type() sql.Type {
-	curr := types.Null
+	return AggregateTypes(c.results()...)
+}
+
+// results returns the expressions of the values the case expression may return.
+func (c *Case) results() []sql.Expression {
+	results := make([]sql.Expression, 0, len(c.Branches)+1)
 	for _, b := range c.Branches {
-		curr = combinedCaseBranchType(curr, b.Value.Type())
+		results = append(results, b.Value)
 	}
-	if c.Else != nil {
-		curr = combinedCaseBranchType(curr, c.Else.Type())
-	}
-	return curr
+	return append(results, c.Else)
 }
 
 // CollationCoercibility implements the interface sql.CollationCoercible.
 func (c *Case) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
 	// This should be calculated during the expression's evaluation, but that's not possible with the
 	// current abstraction
-	return c.Type().CollationCoercibility(ctx)
+	return AggregateCollationCoercibility(ctx, c.results()...)
 }
 
 // IsNullable implements the sql.Expression interface.
@@ -180,8 +229,7 @@ func (c *Case) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 			if err != nil {
 				return nil, err
 			}
-			ret, _, err := t.Convert(bval)
-			return ret, err
+			return ConvertAggregateResult(ctx, t, b.Value, bval)
 		}
 	}
 
@@ -190,9 +238,7 @@ func (c *Case) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		if err != nil {
 			return nil, err
 		}
-		ret, _, err := t.Convert(val)
-		return ret, err
-
+		return ConvertAggregateResult(ctx, t, c.Else, val)
 	}
 
 	return nil, nil
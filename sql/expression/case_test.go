@@ -17,6 +17,7 @@ package expression
 import (
 	"testing"
 
+	"github.com/dolthub/vitess/go/sqltypes"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 
@@ -174,8 +175,13 @@ func TestCaseType(t *testing.T) {
 			types.Int64,
 		},
 		{
-			"unsigned promoted and unsigned",
+			"unsigned and unsigned of the same type",
 			caseExpr(NewLiteral(uint32(0), types.Uint32), NewLiteral(uint32(1), types.Uint32)),
+			types.Uint32,
+		},
+		{
+			"unsigned promoted and unsigned",
+			caseExpr(NewLiteral(uint8(0), types.Uint8), NewLiteral(uint32(1), types.Uint32)),
 			types.Uint64,
 		},
 		{
@@ -196,12 +202,27 @@ func TestCaseType(t *testing.T) {
 		{
 			"int and text to text",
 			caseExpr(NewLiteral(int64(0), types.Int64), NewLiteral("Hello, world!", types.Text)),
-			types.LongText,
+			types.Text,
 		},
 		{
 			"text and blob to blob",
 			caseExpr(NewLiteral("Hello, world!", types.Text), NewLiteral([]byte("0x480x650x6c0x6c0x6f"), types.Blob)),
-			types.LongBlob,
+			types.Blob,
+		},
+		{
+			"varchars widened to the longest",
+			caseExpr(NewLiteral("abc", types.MustCreateStringWithDefaults(sqltypes.VarChar, 3)), NewLiteral("abcdefghij", types.MustCreateStringWithDefaults(sqltypes.VarChar, 10))),
+			types.MustCreateStringWithDefaults(sqltypes.VarChar, 10),
+		},
+		{
+			"chars stay chars",
+			caseExpr(NewLiteral("abc", types.MustCreateStringWithDefaults(sqltypes.Char, 3)), NewLiteral("ab", types.MustCreateStringWithDefaults(sqltypes.Char, 2))),
+			types.MustCreateStringWithDefaults(sqltypes.Char, 3),
+		},
+		{
+			"int and varchar to varchar holding the int",
+			caseExpr(NewLiteral(int32(1), types.Int32), NewLiteral("abc", types.MustCreateStringWithDefaults(sqltypes.VarChar, 3))),
+			types.MustCreateStringWithDefaults(sqltypes.VarChar, 11),
 		},
 		{
 			"int and null to int",
@@ -216,7 +237,37 @@ func TestCaseType(t *testing.T) {
 		{
 			"uint64 and int8 to decimal",
 			caseExpr(NewLiteral(uint64(10), types.Uint64), NewLiteral(int8(0), types.Int8)),
-			decimalType,
+			types.MustCreateDecimalType(20, 0),
+		},
+		{
+			"int and decimal keeps integer digits and scale",
+			caseExpr(NewLiteral(int64(10), types.Int64), NewLiteral(decimal.NewFromInt(1), types.MustCreateDecimalType(10, 4))),
+			types.MustCreateDecimalType(23, 4),
+		},
+		{
+			"decimals keep the largest scale",
+			caseExpr(NewLiteral(decimal.NewFromInt(1), types.MustCreateDecimalType(40, 30)), NewLiteral(decimal.NewFromInt(1), types.MustCreateDecimalType(30, 2))),
+			types.MustCreateDecimalType(58, 30),
+		},
+		{
+			"date and text to text",
+			caseExpr(NewLiteral("2020-04-07", types.Date), NewLiteral("Hello, world!", types.LongText)),
+			types.LongText,
+		},
+		{
+			"null and text keeps collation",
+			caseExpr(NewLiteral(nil, types.Null), NewLiteral("Hello, world!", types.MustCreateString(sqltypes.VarChar, 20, sql.Collation_utf8mb4_general_ci))),
+			types.MustCreateString(sqltypes.VarChar, 20, sql.Collation_utf8mb4_general_ci),
+		},
+		{
+			"text of different collations resolved by coercibility",
+			caseExpr(NewLiteral("Hello", types.LongText), NewGetField(0, types.MustCreateString(sqltypes.VarChar, 20, sql.Collation_utf8mb4_general_ci), "s", true)),
+			types.CreateLongText(sql.Collation_utf8mb4_general_ci),
+		},
+		{
+			"text and utf8mb3 enum resolved by coercibility",
+			caseExpr(NewLiteral("Hello", types.LongText), NewGetField(0, types.MustCreateEnumType([]string{"a", "b"}, sql.Collation_utf8mb3_general_ci), "e", true)),
+			types.CreateLongText(sql.Collation_utf8mb3_general_ci),
 		},
 		{
 			"int and text to text",
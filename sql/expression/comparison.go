@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/dolthub/vitess/go/mysql"
 	errors "gopkg.in/src-d/go-errors.v1"
 
 	"github.com/dolthub/go-mysql-server/internal/regex"
@@ -91,6 +92,17 @@ func (c *comparison) Compare(ctx *sql.Context, row sql.Row) (int, error) {
 		return 0, ErrNilOperand.New()
 	}
 
+	if types.IsTuple(c.Left().Type()) && types.IsTuple(c.Right().Type()) {
+		cmp, null, err := compareTuples(ctx, c.Left().Type(), c.Right().Type(), left, right)
+		if err != nil {
+			return 0, err
+		}
+		if null {
+			return 0, ErrNilOperand.New()
+		}
+		return cmp, nil
+	}
+
 	if types.TypesEqual(c.Left().Type(), c.Right().Type()) {
 		return c.Left().Type().Compare(left, right)
 	}
@@ -127,7 +139,7 @@ func (c *comparison) Compare(ctx *sql.Context, row sql.Row) (int, error) {
 		}
 	}
 	if compareType == nil {
-		left, right, compareType, err = c.castLeftAndRight(left, right)
+		left, right, compareType, err = c.castLeftAndRight(ctx, left, right)
 		if err != nil {
 			return 0, err
 		}
@@ -144,6 +156,84 @@ func (c *comparison) Compare(ctx *sql.Context, row sql.Row) (int, error) {
 	return compareType.Compare(left, right)
 }
 
+// compareTuples compares the values of two row constructors or row subqueries of the types given lexicographically,
+// comparing each pair of elements the way they would be compared on their own. It returns the result of comparing
+// the first pair of non-NULL elements that differ, and whether a NULL element was met before that pair, or anywhere
+// if all non-NULL elements are equal. Ordering two rows is NULL in that case, while testing them for equality is
+// only NULL when all non-NULL elements are equal: (NULL, 1) = (2, 3) is false.
+func compareTuples(ctx *sql.Context, leftType, rightType sql.Type, left, right interface{}) (int, bool, error) {
+	l, lok := left.([]interface{})
+	r, rok := right.([]interface{})
+	if !lok || !rok {
+		return 0, false, sql.ErrInvalidOperandColumns.New(types.NumColumns(leftType), types.NumColumns(rightType))
+	}
+	lt, rt := leftType.(types.TupleType), rightType.(types.TupleType)
+	if len(l) != len(r) || len(lt) != len(l) || len(rt) != len(r) {
+		return 0, false, sql.ErrInvalidOperandColumns.New(len(l), len(r))
+	}
+
+	null := false
+	for i := range l {
+		if l[i] == nil || r[i] == nil {
+			null = true
+			continue
+		}
+		elems := newComparison(NewLiteral(l[i], lt[i]), NewLiteral(r[i], rt[i]))
+		cmp, err := elems.Compare(ctx, nil)
+		if ErrNilOperand.Is(err) {
+			null = true
+			continue
+		} else if err != nil {
+			return 0, false, err
+		}
+		if cmp != 0 {
+			return cmp, null, nil
+		}
+	}
+	return 0, null, nil
+}
+
+// compareTuplesNullSafe compares the values of two row constructors or row subqueries of the types given element by
+// element, with NULL elements equal to each other and ordered before all other values, as <=> does.
+func compareTuplesNullSafe(ctx *sql.Context, leftType, rightType sql.Type, left, right interface{}) (int, error) {
+	l, lok := left.([]interface{})
+	r, rok := right.([]interface{})
+	if !lok || !rok {
+		return 0, sql.ErrInvalidOperandColumns.New(types.NumColumns(leftType), types.NumColumns(rightType))
+	}
+	lt, rt := leftType.(types.TupleType), rightType.(types.TupleType)
+	if len(l) != len(r) || len(lt) != len(l) || len(rt) != len(r) {
+		return 0, sql.ErrInvalidOperandColumns.New(len(l), len(r))
+	}
+
+	for i := range l {
+		cmp, err := NewNullSafeEquals(NewLiteral(l[i], lt[i]), NewLiteral(r[i], rt[i])).Compare(ctx, nil)
+		if err != nil || cmp != 0 {
+			return cmp, err
+		}
+	}
+	return 0, nil
+}
+
+// TuplesEqual returns whether the values of two row constructors or row subqueries of the types given are equal: true
+// if every pair of elements is equal, false if any pair of non-NULL elements differs, and NULL otherwise.
+func TuplesEqual(ctx *sql.Context, leftType, rightType sql.Type, left, right interface{}) (interface{}, error) {
+	if left == nil || right == nil {
+		return nil, nil
+	}
+	cmp, null, err := compareTuples(ctx, leftType, rightType, left, right)
+	if err != nil {
+		return nil, err
+	}
+	if cmp != 0 {
+		return false, nil
+	}
+	if null {
+		return nil, nil
+	}
+	return true, nil
+}
+
 func (c *comparison) evalLeftAndRight(ctx *sql.Context, row sql.Row) (interface{}, interface{}, error) {
 	left, err := c.Left().Eval(ctx, row)
 	if err != nil {
@@ -158,7 +248,7 @@ func (c *comparison) evalLeftAndRight(ctx *sql.Context, row sql.Row) (interface{
 	return left, right, nil
 }
 
-func (c *comparison) castLeftAndRight(left, right interface{}) (interface{}, interface{}, sql.Type, error) {
+func (c *comparison) castLeftAndRight(ctx *sql.Context, left, right interface{}) (interface{}, interface{}, sql.Type, error) {
 	leftType := c.Left().Type()
 	rightType := c.Right().Type()
 	if types.IsTuple(leftType) && types.IsTuple(rightType) {
@@ -182,6 +272,10 @@ func (c *comparison) castLeftAndRight(left, right interface{}) (interface{}, int
 		return l, r, types.LongBlob, nil
 	}
 
+	if CompareAsDoubles(leftType, rightType) {
+		return ConvertToDoubleForComparison(ctx, left), ConvertToDoubleForComparison(ctx, right), types.Float64, nil
+	}
+
 	if types.IsNumber(leftType) || types.IsNumber(rightType) {
 		if types.IsDecimal(leftType) || types.IsDecimal(rightType) {
 			//TODO: We need to set to the actual DECIMAL type
@@ -231,6 +325,45 @@ func (c *comparison) castLeftAndRight(left, right interface{}) (interface{}, int
 	return left, right, types.LongText, nil
 }
 
+// CompareAsDoubles returns whether a comparison between values of the two types must be done on floating point values,
+// which is what MySQL does when comparing a string with a number. Converting the number to a string instead would
+// make '1.0' different from 1, and converting the string to an integer would make '1.5' equal to 1.
+func CompareAsDoubles(left, right sql.Type) bool {
+	return (types.IsTextOnly(left) && isNumericComparisonType(right)) ||
+		(isNumericComparisonType(left) && types.IsTextOnly(right))
+}
+
+// isNumericComparisonType returns whether |t| is an integer, floating point or decimal type. Types such as BIT, YEAR,
+// and boolean system variables keep their own comparison rules.
+func isNumericComparisonType(t sql.Type) bool {
+	if _, ok := t.(types.NumberTypeImpl_); ok {
+		return true
+	}
+	return types.IsDecimal(t)
+}
+
+// ConvertToDoubleForComparison converts |val| to a float64 the way MySQL does when comparing a string with a number:
+// the longest numeric prefix of a string is used, and a warning is added to |ctx| when the string had to be
+// truncated. Strings without a numeric prefix are converted to zero.
+func ConvertToDoubleForComparison(ctx *sql.Context, val interface{}) interface{} {
+	if val == nil {
+		return nil
+	}
+	f, _, err := types.Float64.Convert(val)
+	if err != nil {
+		if ctx != nil {
+			if b, ok := val.([]byte); ok {
+				val = string(b)
+			}
+			ctx.Warn(mysql.ERTruncatedWrongValue, "Truncated incorrect DOUBLE value: '%v'", val)
+		}
+		if f == nil {
+			return float64(0)
+		}
+	}
+	return f
+}
+
 func convertLeftAndRight(left, right interface{}, convertTo string) (interface{}, interface{}, error) {
 	l, err := convertValue(left, convertTo, nil)
 	if err != nil {
@@ -276,6 +409,14 @@ func (e *Equals) CollationCoercibility(ctx *sql.Context) (collation sql.Collatio
 
 // Eval implements the Expression interface.
 func (e *Equals) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	if types.IsTuple(e.Left().Type()) && types.IsTuple(e.Right().Type()) {
+		left, right, err := e.evalLeftAndRight(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		return TuplesEqual(ctx, e.Left().Type(), e.Right().Type(), left, right)
+	}
+
 	result, err := e.Compare(ctx, row)
 	if err != nil {
 		if ErrNilOperand.Is(err) {
@@ -353,12 +494,16 @@ func (e *NullSafeEquals) Compare(ctx *sql.Context, row sql.Row) (int, error) {
 		return -1, nil
 	}
 
+	if types.IsTuple(e.Left().Type()) && types.IsTuple(e.Right().Type()) {
+		return compareTuplesNullSafe(ctx, e.Left().Type(), e.Right().Type(), left, right)
+	}
+
 	if types.TypesEqual(e.Left().Type(), e.Right().Type()) {
 		return e.Left().Type().Compare(left, right)
 	}
 
 	var compareType sql.Type
-	left, right, compareType, err = e.castLeftAndRight(left, right)
+	left, right, compareType, err = e.castLeftAndRight(ctx, left, right)
 	if err != nil {
 		return 0, err
 	}
@@ -15,8 +15,10 @@
 package expression_test
 
 import (
+	"fmt"
 	"testing"
 
+	"github.com/dolthub/vitess/go/sqltypes"
 	"github.com/stretchr/testify/require"
 
 	"github.com/dolthub/go-mysql-server/internal/regex"
@@ -275,3 +277,89 @@ func eval(t *testing.T, e sql.Expression, row sql.Row) interface{} {
 	require.NoError(t, err)
 	return v
 }
+
+func TestStringNumberComparison(t *testing.T) {
+	testCases := []struct {
+		str      string
+		num      interface{}
+		numType  sql.Type
+		cmp      int
+		warnings int
+	}{
+		{"1", int64(1), types.Int64, 0, 0},
+		{"1.0", int64(1), types.Int64, 0, 0},
+		{" 1", int64(1), types.Int64, 0, 0},
+		{"1abc", int64(1), types.Int64, 0, 1},
+		{"abc", int64(0), types.Int64, 0, 1},
+		{"", int64(0), types.Int64, 0, 1},
+		{"1.5", int64(1), types.Int64, 1, 0},
+		{"-2x", int64(-2), types.Int64, 0, 1},
+		{"1e1", int64(10), types.Int64, 0, 0},
+		{"0.5", 0.5, types.Float64, 0, 0},
+		{"10", int64(9), types.Int64, 1, 0},
+		{"9", int64(10), types.Int64, -1, 0},
+	}
+
+	for _, tt := range testCases {
+		t.Run(fmt.Sprintf("'%s' cmp %v", tt.str, tt.num), func(t *testing.T) {
+			require := require.New(t)
+			ctx := sql.NewEmptyContext()
+			str := expression.NewGetField(0, types.MustCreateStringWithDefaults(sqltypes.VarChar, 20), "s", true)
+			num := expression.NewLiteral(tt.num, tt.numType)
+
+			cmp, err := expression.NewEquals(str, num).Compare(ctx, sql.NewRow(tt.str))
+			require.NoError(err)
+			require.Equal(tt.cmp, cmp)
+			require.Equal(uint16(tt.warnings), ctx.WarningCount())
+
+			in, err := expression.NewInTuple(str, expression.NewTuple(num)).Eval(ctx, sql.NewRow(tt.str))
+			require.NoError(err)
+			require.Equal(tt.cmp == 0, in)
+
+			between, err := expression.NewBetween(str, num, num).Eval(ctx, sql.NewRow(tt.str))
+			require.NoError(err)
+			require.Equal(tt.cmp == 0, between)
+		})
+	}
+}
+
+func TestRowComparison(t *testing.T) {
+	row := func(vals ...interface{}) sql.Expression {
+		elems := make([]sql.Expression, len(vals))
+		for i, v := range vals {
+			if v == nil {
+				elems[i] = expression.NewLiteral(nil, types.Null)
+			} else {
+				elems[i] = expression.NewLiteral(v, types.Int64)
+			}
+		}
+		return expression.NewTuple(elems...)
+	}
+
+	testCases := []struct {
+		name     string
+		expr     sql.Expression
+		expected interface{}
+	}{
+		{"(1, 2) = (1, 2)", expression.NewEquals(row(int64(1), int64(2)), row(int64(1), int64(2))), true},
+		{"(1, NULL) = (1, 2)", expression.NewEquals(row(int64(1), nil), row(int64(1), int64(2))), nil},
+		{"(1, NULL) = (2, 2)", expression.NewEquals(row(int64(1), nil), row(int64(2), int64(2))), false},
+		{"(NULL, 1) = (2, 3)", expression.NewEquals(row(nil, int64(1)), row(int64(2), int64(3))), false},
+		{"(1, NULL) <=> (1, NULL)", expression.NewNullSafeEquals(row(int64(1), nil), row(int64(1), nil)), true},
+		{"(1, NULL) <=> (1, 2)", expression.NewNullSafeEquals(row(int64(1), nil), row(int64(1), int64(2))), false},
+		{"(1, 2) < (1, 3)", expression.NewLessThan(row(int64(1), int64(2)), row(int64(1), int64(3))), true},
+		{"(1, NULL) < (2, 0)", expression.NewLessThan(row(int64(1), nil), row(int64(2), int64(0))), true},
+		{"(1, NULL) < (1, 3)", expression.NewLessThan(row(int64(1), nil), row(int64(1), int64(3))), nil},
+		{"(2, 1) > (1, NULL)", expression.NewGreaterThan(row(int64(2), int64(1)), row(int64(1), nil)), true},
+		{"(1, 2) IN ((1, NULL), (3, 4))", expression.NewInTuple(row(int64(1), int64(2)), expression.NewTuple(row(int64(1), nil), row(int64(3), int64(4)))), nil},
+		{"(1, 2) IN ((2, NULL), (3, 4))", expression.NewInTuple(row(int64(1), int64(2)), expression.NewTuple(row(int64(2), nil), row(int64(3), int64(4)))), false},
+		{"(1, NULL) IN ((1, 2), (1, NULL))", expression.NewInTuple(row(int64(1), nil), expression.NewTuple(row(int64(1), int64(2)), row(int64(1), nil))), nil},
+		{"(1, 2) IN ((3, 4), (1, 2))", expression.NewInTuple(row(int64(1), int64(2)), expression.NewTuple(row(int64(3), int64(4)), row(int64(1), int64(2)))), true},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, eval(t, tt.expr, nil))
+		})
+	}
+}
@@ -16,11 +16,17 @@ package expression
 
 import (
 	"encoding/hex"
+	goerrors "errors"
 	"fmt"
+	"math"
+	"math/big"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/shopspring/decimal"
 	"gopkg.in/src-d/go-errors.v1"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -45,6 +51,8 @@ const (
 	ConvertToDecimal = "decimal"
 	// ConvertToDouble is a conversion to double.
 	ConvertToDouble = "double"
+	// ConvertToFloat is a conversion to float.
+	ConvertToFloat = "float"
 	// ConvertToJSON is a conversion to json.
 	ConvertToJSON = "json"
 	// ConvertToReal is a conversion to double.
@@ -55,6 +63,8 @@ const (
 	ConvertToTime = "time"
 	// ConvertToUnsigned is a conversion to unsigned.
 	ConvertToUnsigned = "unsigned"
+	// ConvertToYear is a conversion to year.
+	ConvertToYear = "year"
 )
 
 // Convert represent a CAST(x AS T) or CONVERT(x, T) operation that casts x expression to type T.
@@ -62,6 +72,9 @@ type Convert struct {
 	UnaryExpression
 	// Type to cast
 	castToType string
+	// Length and scale of the cast type, such as CHAR(10) or DECIMAL(10, 2). Zero if not given.
+	typeLength int
+	typeScale  int
 }
 
 var _ sql.Expression = (*Convert)(nil)
@@ -75,10 +88,19 @@ func NewConvert(expr sql.Expression, castToType string) *Convert {
 	}
 }
 
+// NewConvertWithLengthAndScale creates a new Convert expression with the length and scale parameters of the cast
+// type, as given in CAST(x AS CHAR(10)) or CAST(x AS DECIMAL(10, 2)).
+func NewConvertWithLengthAndScale(expr sql.Expression, castToType string, typeLength, typeScale int) *Convert {
+	c := NewConvert(expr, castToType)
+	c.typeLength = typeLength
+	c.typeScale = typeScale
+	return c
+}
+
 // IsNullable implements the Expression interface.
 func (c *Convert) IsNullable() bool {
 	switch c.castToType {
-	case ConvertToDate, ConvertToDatetime:
+	case ConvertToDate, ConvertToDatetime, ConvertToYear:
 		return true
 	default:
 		return c.Child.IsNullable()
@@ -89,18 +111,33 @@ func (c *Convert) IsNullable() bool {
 func (c *Convert) Type() sql.Type {
 	switch c.castToType {
 	case ConvertToBinary:
+		if c.typeLength > 0 {
+			return types.MustCreateBinary(sqltypes.VarBinary, int64(c.typeLength))
+		}
 		return types.LongBlob
 	case ConvertToChar, ConvertToNChar:
+		if c.typeLength > 0 {
+			return types.MustCreateString(sqltypes.VarChar, int64(c.typeLength), sql.Collation_Default)
+		}
 		return types.LongText
 	case ConvertToDate:
 		return types.Date
 	case ConvertToDatetime:
 		return types.Datetime
 	case ConvertToDecimal:
+		if c.typeLength > 0 {
+			return types.MustCreateDecimalType(uint8(c.typeLength), uint8(c.typeScale))
+		}
 		//TODO: these values are completely arbitrary, we need to get the given precision/scale and store it
 		return types.MustCreateDecimalType(65, 10)
 	case ConvertToDouble, ConvertToReal:
 		return types.Float64
+	case ConvertToFloat:
+		// FLOAT(p) is a double precision type when p is greater than 24
+		if c.typeLength > 24 {
+			return types.Float64
+		}
+		return types.Float32
 	case ConvertToJSON:
 		return types.JSON
 	case ConvertToSigned:
@@ -109,7 +146,12 @@ func (c *Convert) Type() sql.Type {
 		return types.Time
 	case ConvertToUnsigned:
 		return types.Uint64
+	case ConvertToYear:
+		return types.Year
 	default:
+		if typ := geometryCastType(c.castToType); typ != nil {
+			return typ
+		}
 		return types.Null
 	}
 }
@@ -127,7 +169,7 @@ func (c *Convert) CollationCoercibility(ctx *sql.Context) (collation sql.Collati
 		return sql.Collation_binary, 5
 	case ConvertToDecimal:
 		return sql.Collation_binary, 5
-	case ConvertToDouble, ConvertToReal:
+	case ConvertToDouble, ConvertToReal, ConvertToFloat:
 		return sql.Collation_binary, 5
 	case ConvertToJSON:
 		return ctx.GetCharacterSet().BinaryCollation(), 2
@@ -135,7 +177,10 @@ func (c *Convert) CollationCoercibility(ctx *sql.Context) (collation sql.Collati
 		return sql.Collation_binary, 5
 	case ConvertToTime:
 		return sql.Collation_binary, 5
-	case ConvertToUnsigned:
+	case ConvertToUnsigned, ConvertToYear:
+		return sql.Collation_binary, 5
+	case ConvertToPoint, ConvertToLineString, ConvertToPolygon, ConvertToMultiPoint, ConvertToMultiLineString,
+		ConvertToMultiPolygon, ConvertToGeometryCollection:
 		return sql.Collation_binary, 5
 	default:
 		return sql.Collation_binary, 7
@@ -164,7 +209,7 @@ func (c *Convert) WithChildren(children ...sql.Expression) (sql.Expression, erro
 	if len(children) != 1 {
 		return nil, sql.ErrInvalidChildrenNumber.New(c, len(children), 1)
 	}
-	return NewConvert(children[0], c.castToType), nil
+	return NewConvertWithLengthAndScale(children[0], c.castToType, c.typeLength, c.typeScale), nil
 }
 
 // Eval implements the Expression interface.
@@ -178,8 +223,34 @@ func (c *Convert) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, nil
 	}
 
+	switch c.castToType {
+	case ConvertToSigned, ConvertToUnsigned:
+		return castToInteger(ctx, val, c.Child.Type(), c.castToType == ConvertToUnsigned)
+	case ConvertToChar, ConvertToNChar, ConvertToBinary:
+		if c.typeLength > 0 {
+			return c.castToLengthString(ctx, val)
+		}
+	case ConvertToDecimal:
+		if c.typeLength > 0 {
+			return c.castToDecimal(ctx, val)
+		}
+	case ConvertToPoint, ConvertToLineString, ConvertToPolygon, ConvertToMultiPoint, ConvertToMultiLineString,
+		ConvertToMultiPolygon, ConvertToGeometryCollection:
+		return castToGeometry(val, c.castToType, c.Child.Type())
+	case ConvertToYear:
+		if _, ok := val.(types.Timespan); ok {
+			// A TIME value is converted to a year by combining it with the current date
+			return int16(ctx.QueryTime().Year()), nil
+		}
+	}
+
+	castTo := c.castToType
+	if castTo == ConvertToFloat && c.typeLength > 24 {
+		castTo = ConvertToDouble
+	}
+
 	// Should always return nil, and a warning instead
-	casted, err := convertValue(val, c.castToType, c.Child.Type())
+	casted, err := convertValue(val, castTo, c.Child.Type())
 	if err != nil {
 		if c.castToType == ConvertToJSON {
 			return nil, ErrConvertExpression.Wrap(err, c.String(), c.castToType)
@@ -261,6 +332,16 @@ func convertValue(val interface{}, castTo string, originType sql.Type) (interfac
 			return types.Float64.Zero(), nil
 		}
 		return d, nil
+	case ConvertToFloat:
+		value, err := convertHexBlobToDecimalForNumericContext(val, originType)
+		if err != nil {
+			return nil, err
+		}
+		d, _, err := types.Float32.Convert(value)
+		if err != nil {
+			return types.Float32.Zero(), nil
+		}
+		return d, nil
 	case ConvertToJSON:
 		js, _, err := types.JSON.Convert(val)
 		if err != nil {
@@ -298,11 +379,185 @@ func convertValue(val interface{}, castTo string, originType sql.Type) (interfac
 			return uint64(num.(int64)), nil
 		}
 		return num, nil
+	case ConvertToYear:
+		y, _, err := types.Year.Convert(val)
+		if err != nil {
+			return nil, err
+		}
+		return y, nil
 	default:
 		return nil, nil
 	}
 }
 
+// castToLengthString converts |val| to a CHAR(n) or BINARY(n) string. Longer values are truncated with a warning, and
+// BINARY(n) values shorter than n bytes are padded with zero bytes.
+func (c *Convert) castToLengthString(ctx *sql.Context, val interface{}) (interface{}, error) {
+	casted, err := convertValue(val, c.castToType, c.Child.Type())
+	if err != nil || casted == nil {
+		return nil, err
+	}
+
+	if b, ok := casted.([]byte); ok {
+		if len(b) > c.typeLength {
+			ctx.Warn(1292, "Truncated incorrect BINARY(%d) value: '%s'", c.typeLength, string(b))
+			return b[:c.typeLength], nil
+		}
+		if len(b) < c.typeLength {
+			padded := make([]byte, c.typeLength)
+			copy(padded, b)
+			return padded, nil
+		}
+		return b, nil
+	}
+
+	str := casted.(string)
+	if runes := []rune(str); len(runes) > c.typeLength {
+		ctx.Warn(1292, "Truncated incorrect CHAR(%d) value: '%s'", c.typeLength, str)
+		return string(runes[:c.typeLength]), nil
+	}
+	return str, nil
+}
+
+// castToDecimal converts |val| to a DECIMAL(p, s). The value is rounded to the scale of the type, and values that do
+// not fit in the precision are clamped to the largest value of the type with a warning.
+func (c *Convert) castToDecimal(ctx *sql.Context, val interface{}) (interface{}, error) {
+	value, err := convertHexBlobToDecimalForNumericContext(val, c.Child.Type())
+	if err != nil {
+		return nil, err
+	}
+
+	dt := c.Type().(sql.DecimalType)
+	d, _, err := types.InternalDecimalType.Convert(value)
+	if err != nil {
+		ctx.Warn(1292, "Truncated incorrect DECIMAL value: '%v'", val)
+		return decimal.New(0, -int32(dt.Scale())), nil
+	}
+
+	dec := d.(decimal.Decimal).Round(int32(dt.Scale()))
+	if !dec.Abs().LessThan(dt.ExclusiveUpperBound()) {
+		ctx.Warn(1264, "Out of range value for column '%s' at row 1", c.String())
+		max := dt.ExclusiveUpperBound().Sub(decimal.New(1, -int32(dt.Scale())))
+		if dec.IsNegative() {
+			return max.Neg(), nil
+		}
+		return max, nil
+	}
+	return dec, nil
+}
+
+var (
+	maxInt64Decimal  = decimal.NewFromInt(math.MaxInt64)
+	minInt64Decimal  = decimal.NewFromInt(math.MinInt64)
+	maxUint64Decimal = decimal.NewFromBigInt(new(big.Int).SetUint64(math.MaxUint64), 0)
+)
+
+// castToInteger converts |val| for CAST(... AS SIGNED) and CAST(... AS UNSIGNED). As in MySQL, values that are not
+// integers are rounded or truncated and values that do not fit in 64 bits are clamped, with a warning rather than an
+// error. Negative values cast to UNSIGNED wrap around to their two's complement.
+func castToInteger(ctx *sql.Context, val interface{}, originType sql.Type, unsigned bool) (interface{}, error) {
+	value, err := convertHexBlobToDecimalForNumericContext(val, originType)
+	if err != nil {
+		return nil, err
+	}
+
+	var dec decimal.Decimal
+	switch v := value.(type) {
+	case string:
+		return castStringToInteger(ctx, v, unsigned), nil
+	case []byte:
+		return castStringToInteger(ctx, string(v), unsigned), nil
+	case float32, float64, decimal.Decimal:
+		d, _, err := types.InternalDecimalType.Convert(v)
+		if err != nil {
+			return nil, err
+		}
+		dec = d.(decimal.Decimal).Round(0)
+	case uint64:
+		if unsigned {
+			return v, nil
+		}
+		// Unsigned values too large for a signed integer are reinterpreted as their two's complement
+		return int64(v), nil
+	case time.Time:
+		// Temporal values are converted to their numeric YYYYMMDD or YYYYMMDDhhmmss representation
+		num := int64(v.Year()*10000 + int(v.Month())*100 + v.Day())
+		if !types.IsDateType(originType) {
+			num = num*1000000 + int64(v.Hour()*10000+v.Minute()*100+v.Second())
+		}
+		if unsigned {
+			return uint64(num), nil
+		}
+		return num, nil
+	default:
+		casted, err := convertValue(val, ConvertToSigned, originType)
+		if unsigned {
+			casted, err = convertValue(val, ConvertToUnsigned, originType)
+		}
+		return casted, err
+	}
+
+	if unsigned {
+		if dec.GreaterThan(maxUint64Decimal) {
+			ctx.Warn(1292, "Truncated incorrect INTEGER value: '%v'", val)
+			return uint64(math.MaxUint64), nil
+		}
+		if dec.LessThan(minInt64Decimal) {
+			ctx.Warn(1292, "Truncated incorrect INTEGER value: '%v'", val)
+			return uint64(1 << 63), nil
+		}
+		if dec.IsNegative() {
+			return uint64(dec.IntPart()), nil
+		}
+		return dec.BigInt().Uint64(), nil
+	}
+
+	if dec.GreaterThan(maxInt64Decimal) {
+		ctx.Warn(1292, "Truncated incorrect INTEGER value: '%v'", val)
+		return int64(math.MaxInt64), nil
+	}
+	if dec.LessThan(minInt64Decimal) {
+		ctx.Warn(1292, "Truncated incorrect INTEGER value: '%v'", val)
+		return int64(math.MinInt64), nil
+	}
+	return dec.IntPart(), nil
+}
+
+// castStringToInteger converts the leading integer of |str| for CAST(... AS SIGNED) and CAST(... AS UNSIGNED).
+// Anything after the leading integer, including a fractional part, is discarded with a warning.
+func castStringToInteger(ctx *sql.Context, str string, unsigned bool) interface{} {
+	trimmed := strings.TrimSpace(str)
+	prefix := leadingIntegerRegex.FindString(trimmed)
+	if prefix != trimmed {
+		ctx.Warn(1292, "Truncated incorrect INTEGER value: '%s'", str)
+	}
+
+	if unsigned && !strings.HasPrefix(prefix, "-") {
+		u, err := strconv.ParseUint(strings.TrimPrefix(prefix, "+"), 10, 64)
+		if goerrors.Is(err, strconv.ErrRange) {
+			ctx.Warn(1292, "Truncated incorrect INTEGER value: '%s'", str)
+			return uint64(math.MaxUint64)
+		}
+		return u
+	}
+
+	i, err := strconv.ParseInt(prefix, 10, 64)
+	if goerrors.Is(err, strconv.ErrRange) {
+		ctx.Warn(1292, "Truncated incorrect INTEGER value: '%s'", str)
+		if strings.HasPrefix(prefix, "-") {
+			i = math.MinInt64
+		} else {
+			i = math.MaxInt64
+		}
+	}
+	if unsigned {
+		return uint64(i)
+	}
+	return i
+}
+
+var leadingIntegerRegex = regexp.MustCompile(`^[+-]?\d+`)
+
 // convertHexBlobToDecimalForNumericContext converts byte array value to unsigned int value if originType is BLOB type.
 // This function is called when convertTo type is number type only. The hex literal values are parsed into blobs as
 // binary string as default, but for numeric context, the value should be a number.
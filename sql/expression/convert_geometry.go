@@ -0,0 +1,293 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"strings"
+
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// ErrInvalidCastToGeometry is returned when a value can't be cast to a spatial type.
+var ErrInvalidCastToGeometry = errors.NewKind("Invalid cast from %s to %s.")
+
+const (
+	// ConvertToPoint is a conversion to point.
+	ConvertToPoint = "point"
+	// ConvertToLineString is a conversion to linestring.
+	ConvertToLineString = "linestring"
+	// ConvertToPolygon is a conversion to polygon.
+	ConvertToPolygon = "polygon"
+	// ConvertToMultiPoint is a conversion to multipoint.
+	ConvertToMultiPoint = "multipoint"
+	// ConvertToMultiLineString is a conversion to multilinestring.
+	ConvertToMultiLineString = "multilinestring"
+	// ConvertToMultiPolygon is a conversion to multipolygon.
+	ConvertToMultiPolygon = "multipolygon"
+	// ConvertToGeometryCollection is a conversion to geometrycollection.
+	ConvertToGeometryCollection = "geometrycollection"
+)
+
+// geometryCastType returns the type of a cast to the spatial type given, or nil if the type isn't a spatial one.
+func geometryCastType(castTo string) sql.Type {
+	switch castTo {
+	case ConvertToPoint:
+		return types.PointType{}
+	case ConvertToLineString:
+		return types.LineStringType{}
+	case ConvertToPolygon:
+		return types.PolygonType{}
+	case ConvertToMultiPoint:
+		return types.MultiPointType{}
+	case ConvertToMultiLineString:
+		return types.MultiLineStringType{}
+	case ConvertToMultiPolygon:
+		return types.MultiPolygonType{}
+	case ConvertToGeometryCollection:
+		return types.GeomCollType{}
+	default:
+		return nil
+	}
+}
+
+// castToGeometry casts |val| to the spatial type given. Like MySQL, a geometry is cast to another spatial type when the
+// other type can represent it without changing its points, such as a multipoint of a single point to a point, or a
+// closed linestring to a polygon. Any other cast is an error.
+func castToGeometry(val interface{}, castTo string, originType sql.Type) (interface{}, error) {
+	g, ok := val.(types.GeometryValue)
+	if !ok {
+		return nil, ErrInvalidCastToGeometry.New(strings.ToUpper(originType.String()), strings.ToUpper(castTo))
+	}
+
+	var casted types.GeometryValue
+	switch castTo {
+	case ConvertToPoint:
+		casted = castToPoint(g)
+	case ConvertToLineString:
+		casted = castToLineString(g)
+	case ConvertToPolygon:
+		casted = castToPolygon(g)
+	case ConvertToMultiPoint:
+		casted = castToMultiPoint(g)
+	case ConvertToMultiLineString:
+		casted = castToMultiLineString(g)
+	case ConvertToMultiPolygon:
+		casted = castToMultiPolygon(g)
+	case ConvertToGeometryCollection:
+		casted = castToGeometryCollection(g)
+	}
+	if casted == nil {
+		return nil, ErrInvalidCastToGeometry.New(geometryTypeName(g), strings.ToUpper(castTo))
+	}
+	return casted, nil
+}
+
+func castToPoint(g types.GeometryValue) types.GeometryValue {
+	switch g := g.(type) {
+	case types.Point:
+		return g
+	case types.MultiPoint:
+		if len(g.Points) == 1 {
+			return g.Points[0]
+		}
+	case types.GeomColl:
+		if len(g.Geoms) == 1 {
+			return castToPoint(g.Geoms[0])
+		}
+	}
+	return nil
+}
+
+func castToLineString(g types.GeometryValue) types.GeometryValue {
+	switch g := g.(type) {
+	case types.LineString:
+		return g
+	case types.Polygon:
+		if len(g.Lines) == 1 {
+			return g.Lines[0]
+		}
+	case types.MultiPoint:
+		if len(g.Points) >= 2 {
+			return types.LineString{SRID: g.SRID, Points: g.Points}
+		}
+	case types.MultiLineString:
+		if len(g.Lines) == 1 {
+			return g.Lines[0]
+		}
+	case types.GeomColl:
+		if mp, ok := castToMultiPoint(g).(types.MultiPoint); ok {
+			return castToLineString(mp)
+		}
+		if len(g.Geoms) == 1 {
+			return castToLineString(g.Geoms[0])
+		}
+	}
+	return nil
+}
+
+func castToPolygon(g types.GeometryValue) types.GeometryValue {
+	switch g := g.(type) {
+	case types.Polygon:
+		return g
+	case types.LineString:
+		if isRing(g) {
+			return types.Polygon{SRID: g.SRID, Lines: []types.LineString{g}}
+		}
+	case types.MultiLineString:
+		for _, l := range g.Lines {
+			if !isRing(l) {
+				return nil
+			}
+		}
+		if len(g.Lines) > 0 {
+			return types.Polygon{SRID: g.SRID, Lines: g.Lines}
+		}
+	case types.MultiPolygon:
+		if len(g.Polygons) == 1 {
+			return g.Polygons[0]
+		}
+	case types.GeomColl:
+		if len(g.Geoms) == 1 {
+			return castToPolygon(g.Geoms[0])
+		}
+	}
+	return nil
+}
+
+func castToMultiPoint(g types.GeometryValue) types.GeometryValue {
+	switch g := g.(type) {
+	case types.Point:
+		return types.MultiPoint{SRID: g.SRID, Points: []types.Point{g}}
+	case types.LineString:
+		return types.MultiPoint{SRID: g.SRID, Points: g.Points}
+	case types.MultiPoint:
+		return g
+	case types.GeomColl:
+		points := make([]types.Point, len(g.Geoms))
+		for i, geom := range g.Geoms {
+			p, ok := geom.(types.Point)
+			if !ok {
+				return nil
+			}
+			points[i] = p
+		}
+		return types.MultiPoint{SRID: g.SRID, Points: points}
+	}
+	return nil
+}
+
+func castToMultiLineString(g types.GeometryValue) types.GeometryValue {
+	switch g := g.(type) {
+	case types.LineString:
+		return types.MultiLineString{SRID: g.SRID, Lines: []types.LineString{g}}
+	case types.Polygon:
+		return types.MultiLineString{SRID: g.SRID, Lines: g.Lines}
+	case types.MultiLineString:
+		return g
+	case types.GeomColl:
+		lines := make([]types.LineString, len(g.Geoms))
+		for i, geom := range g.Geoms {
+			l, ok := geom.(types.LineString)
+			if !ok {
+				return nil
+			}
+			lines[i] = l
+		}
+		return types.MultiLineString{SRID: g.SRID, Lines: lines}
+	}
+	return nil
+}
+
+func castToMultiPolygon(g types.GeometryValue) types.GeometryValue {
+	switch g := g.(type) {
+	case types.Polygon:
+		return types.MultiPolygon{SRID: g.SRID, Polygons: []types.Polygon{g}}
+	case types.MultiLineString:
+		polygons := make([]types.Polygon, len(g.Lines))
+		for i, l := range g.Lines {
+			if !isRing(l) {
+				return nil
+			}
+			polygons[i] = types.Polygon{SRID: g.SRID, Lines: []types.LineString{l}}
+		}
+		return types.MultiPolygon{SRID: g.SRID, Polygons: polygons}
+	case types.MultiPolygon:
+		return g
+	case types.GeomColl:
+		polygons := make([]types.Polygon, len(g.Geoms))
+		for i, geom := range g.Geoms {
+			p, ok := geom.(types.Polygon)
+			if !ok {
+				return nil
+			}
+			polygons[i] = p
+		}
+		return types.MultiPolygon{SRID: g.SRID, Polygons: polygons}
+	}
+	return nil
+}
+
+func castToGeometryCollection(g types.GeometryValue) types.GeometryValue {
+	var geoms []types.GeometryValue
+	switch g := g.(type) {
+	case types.GeomColl:
+		return g
+	case types.MultiPoint:
+		for _, p := range g.Points {
+			geoms = append(geoms, p)
+		}
+	case types.MultiLineString:
+		for _, l := range g.Lines {
+			geoms = append(geoms, l)
+		}
+	case types.MultiPolygon:
+		for _, p := range g.Polygons {
+			geoms = append(geoms, p)
+		}
+	default:
+		geoms = []types.GeometryValue{g}
+	}
+	return types.GeomColl{SRID: g.GetSRID(), Geoms: geoms}
+}
+
+// isRing returns whether the linestring given is closed and has enough points to be the ring of a polygon.
+func isRing(l types.LineString) bool {
+	return len(l.Points) >= 4 && l.Points[0].X == l.Points[len(l.Points)-1].X && l.Points[0].Y == l.Points[len(l.Points)-1].Y
+}
+
+// geometryTypeName returns the name of the spatial type of the geometry given, as MySQL reports it in errors.
+func geometryTypeName(g types.GeometryValue) string {
+	switch g.(type) {
+	case types.Point:
+		return "POINT"
+	case types.LineString:
+		return "LINESTRING"
+	case types.Polygon:
+		return "POLYGON"
+	case types.MultiPoint:
+		return "MULTIPOINT"
+	case types.MultiLineString:
+		return "MULTILINESTRING"
+	case types.MultiPolygon:
+		return "MULTIPOLYGON"
+	case types.GeomColl:
+		return "GEOMCOLLECTION"
+	default:
+		return "GEOMETRY"
+	}
+}
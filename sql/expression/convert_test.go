@@ -19,6 +19,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 
 	_ "github.com/dolthub/go-mysql-server/inittime"
@@ -188,3 +190,127 @@ func TestConvert(t *testing.T) {
 		})
 	}
 }
+
+func TestConvertMatrix(t *testing.T) {
+	date := time.Date(2021, time.March, 4, 5, 6, 7, 0, time.UTC)
+	tests := []struct {
+		name     string
+		val      sql.Expression
+		castTo   string
+		length   int
+		scale    int
+		typ      sql.Type
+		expected interface{}
+		warnings int
+		err      bool
+	}{
+		{"int to signed", NewLiteral(int64(-7), types.Int64), ConvertToSigned, 0, 0, types.Int64, int64(-7), 0, false},
+		{"uint to signed", NewLiteral(uint64(math.MaxUint64), types.Uint64), ConvertToSigned, 0, 0, types.Int64, int64(-1), 0, false},
+		{"float to signed", NewLiteral(1.5, types.Float64), ConvertToSigned, 0, 0, types.Int64, int64(2), 0, false},
+		{"large float to signed", NewLiteral(1e20, types.Float64), ConvertToSigned, 0, 0, types.Int64, int64(math.MaxInt64), 1, false},
+		{"small float to signed", NewLiteral(-1e20, types.Float64), ConvertToSigned, 0, 0, types.Int64, int64(math.MinInt64), 1, false},
+		{"decimal to signed", NewLiteral(decimal.RequireFromString("-2.5"), types.MustCreateDecimalType(2, 1)), ConvertToSigned, 0, 0, types.Int64, int64(-3), 0, false},
+		{"string to signed", NewLiteral(" 42", types.LongText), ConvertToSigned, 0, 0, types.Int64, int64(42), 0, false},
+		{"fractional string to signed", NewLiteral("1.9", types.LongText), ConvertToSigned, 0, 0, types.Int64, int64(1), 1, false},
+		{"large string to signed", NewLiteral("99999999999999999999", types.LongText), ConvertToSigned, 0, 0, types.Int64, int64(math.MaxInt64), 1, false},
+		{"invalid string to signed", NewLiteral("abc", types.LongText), ConvertToSigned, 0, 0, types.Int64, int64(0), 1, false},
+		{"date to signed", NewLiteral(date, types.Datetime), ConvertToSigned, 0, 0, types.Int64, int64(20210304050607), 0, false},
+		{"negative int to unsigned", NewLiteral(int64(-1), types.Int64), ConvertToUnsigned, 0, 0, types.Uint64, uint64(math.MaxUint64), 0, false},
+		{"large float to unsigned", NewLiteral(1e20, types.Float64), ConvertToUnsigned, 0, 0, types.Uint64, uint64(math.MaxUint64), 1, false},
+		{"large string to unsigned", NewLiteral("18446744073709551615", types.LongText), ConvertToUnsigned, 0, 0, types.Uint64, uint64(math.MaxUint64), 0, false},
+		{"too large string to unsigned", NewLiteral("18446744073709551616", types.LongText), ConvertToUnsigned, 0, 0, types.Uint64, uint64(math.MaxUint64), 1, false},
+		{"negative string to unsigned", NewLiteral("-1", types.LongText), ConvertToUnsigned, 0, 0, types.Uint64, uint64(math.MaxUint64), 0, false},
+		{"int to float", NewLiteral(int64(3), types.Int64), ConvertToFloat, 0, 0, types.Float32, float32(3), 0, false},
+		{"string to float", NewLiteral("1.25", types.LongText), ConvertToFloat, 0, 0, types.Float32, float32(1.25), 0, false},
+		{"string to double precision float", NewLiteral("1.25", types.LongText), ConvertToFloat, 53, 0, types.Float64, 1.25, 0, false},
+		{"invalid string to float", NewLiteral("abc", types.LongText), ConvertToFloat, 0, 0, types.Float32, float32(0), 0, false},
+		{"int to double", NewLiteral(int64(3), types.Int64), ConvertToDouble, 0, 0, types.Float64, float64(3), 0, false},
+		{"int to year", NewLiteral(int64(1999), types.Int64), ConvertToYear, 0, 0, types.Year, int16(1999), 0, false},
+		{"two digit int to year", NewLiteral(int64(5), types.Int64), ConvertToYear, 0, 0, types.Year, int16(2005), 0, false},
+		{"two digit string to year", NewLiteral("70", types.LongText), ConvertToYear, 0, 0, types.Year, int16(1970), 0, false},
+		{"zero string to year", NewLiteral("0", types.LongText), ConvertToYear, 0, 0, types.Year, int16(2000), 0, false},
+		{"date to year", NewLiteral(date, types.Datetime), ConvertToYear, 0, 0, types.Year, int16(2021), 0, false},
+		{"out of range int to year", NewLiteral(int64(3000), types.Int64), ConvertToYear, 0, 0, types.Year, nil, 1, false},
+		{"invalid string to year", NewLiteral("abc", types.LongText), ConvertToYear, 0, 0, types.Year, nil, 1, false},
+		{"int to decimal", NewLiteral(int64(12), types.Int64), ConvertToDecimal, 5, 2, types.MustCreateDecimalType(5, 2), decimal.RequireFromString("12.00"), 0, false},
+		{"float to decimal", NewLiteral(1.555, types.Float64), ConvertToDecimal, 4, 2, types.MustCreateDecimalType(4, 2), decimal.RequireFromString("1.56"), 0, false},
+		{"string to decimal", NewLiteral("-1.5", types.LongText), ConvertToDecimal, 10, 0, types.MustCreateDecimalType(10, 0), decimal.RequireFromString("-2"), 0, false},
+		{"out of range int to decimal", NewLiteral(int64(12345), types.Int64), ConvertToDecimal, 3, 0, types.MustCreateDecimalType(3, 0), decimal.RequireFromString("999"), 1, false},
+		{"out of range negative to decimal", NewLiteral(int64(-12345), types.Int64), ConvertToDecimal, 4, 1, types.MustCreateDecimalType(4, 1), decimal.RequireFromString("-999.9"), 1, false},
+		{"invalid string to decimal", NewLiteral("abc", types.LongText), ConvertToDecimal, 4, 1, types.MustCreateDecimalType(4, 1), decimal.RequireFromString("0.0"), 1, false},
+		{"int to char", NewLiteral(int64(12345), types.Int64), ConvertToChar, 0, 0, types.LongText, "12345", 0, false},
+		{"int to char with length", NewLiteral(int64(12345), types.Int64), ConvertToChar, 3, 0, types.MustCreateString(sqltypes.VarChar, 3, sql.Collation_Default), "123", 1, false},
+		{"string to char with length", NewLiteral("日本語", types.LongText), ConvertToChar, 2, 0, types.MustCreateString(sqltypes.VarChar, 2, sql.Collation_Default), "日本", 1, false},
+		{"short string to char with length", NewLiteral("ab", types.LongText), ConvertToChar, 5, 0, types.MustCreateString(sqltypes.VarChar, 5, sql.Collation_Default), "ab", 0, false},
+		{"string to binary with length", NewLiteral("ab", types.LongText), ConvertToBinary, 4, 0, types.MustCreateBinary(sqltypes.VarBinary, 4), []byte{'a', 'b', 0, 0}, 0, false},
+		{"long string to binary with length", NewLiteral("abcdef", types.LongText), ConvertToBinary, 4, 0, types.MustCreateBinary(sqltypes.VarBinary, 4), []byte("abcd"), 1, false},
+		{"date to char", NewLiteral(date, types.Datetime), ConvertToChar, 0, 0, types.LongText, "2021-03-04 05:06:07", 0, false},
+		{"string to json", NewLiteral(`{"b": 1, "a": [1, 2]}`, types.LongText), ConvertToJSON, 0, 0, types.JSON, types.MustJSON(`{"a": [1, 2], "b": 1}`), 0, false},
+		{"float to json", NewLiteral(1.5, types.Float64), ConvertToJSON, 0, 0, types.JSON, types.JSONDocument{Val: 1.5}, 0, false},
+		{"invalid string to json", NewLiteral(`{"a":`, types.LongText), ConvertToJSON, 0, 0, types.JSON, nil, 0, true},
+		{"int to date", NewLiteral(int64(1), types.Int64), ConvertToDate, 0, 0, types.Date, nil, 0, false},
+		{"invalid string to date", NewLiteral("abc", types.LongText), ConvertToDate, 0, 0, types.Date, nil, 1, false},
+		{"string to time", NewLiteral("10:11:12", types.LongText), ConvertToTime, 0, 0, types.Time, types.Timespan(36672000000), 0, false},
+		{"null to signed", NewLiteral(nil, types.Null), ConvertToSigned, 0, 0, types.Int64, nil, 0, false},
+		{"null to year", NewLiteral(nil, types.Null), ConvertToYear, 0, 0, types.Year, nil, 0, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+			ctx := sql.NewEmptyContext()
+			convert := NewConvertWithLengthAndScale(test.val, test.castTo, test.length, test.scale)
+			require.Equal(test.typ, convert.Type())
+
+			val, err := convert.Eval(ctx, nil)
+			if test.err {
+				require.Error(err)
+				return
+			}
+			require.NoError(err)
+			require.Equal(test.expected, val)
+			require.Equal(test.warnings, len(ctx.Warnings()))
+		})
+	}
+}
+
+func TestConvertUsing(t *testing.T) {
+	tests := []struct {
+		name     string
+		val      sql.Expression
+		charset  sql.CharacterSetID
+		expected interface{}
+		warnings int
+	}{
+		{"ascii to latin1", NewLiteral("abc", types.LongText), sql.CharacterSet_latin1, "abc", 0},
+		{"accented to latin1", NewLiteral("héllo", types.LongText), sql.CharacterSet_latin1, "héllo", 0},
+		{"unrepresentable to latin1", NewLiteral("日本", types.LongText), sql.CharacterSet_latin1, "??", 0},
+		{"unrepresentable to ascii", NewLiteral("héllo", types.LongText), sql.CharacterSet_ascii, "h?llo", 0},
+		{"string to utf8mb4", NewLiteral("日本", types.LongText), sql.CharacterSet_utf8mb4, "日本", 0},
+		{"int to utf8mb4", NewLiteral(int64(12), types.Int64), sql.CharacterSet_utf8mb4, "12", 0},
+		{"binary to utf8mb4", NewLiteral([]byte("abc"), types.LongBlob), sql.CharacterSet_utf8mb4, "abc", 0},
+		{"binary to latin1", NewLiteral([]byte{0x68, 0xe9}, types.LongBlob), sql.CharacterSet_latin1, "hé", 0},
+		{"invalid binary to utf8mb4", NewLiteral([]byte{0xff}, types.LongBlob), sql.CharacterSet_utf8mb4, nil, 1},
+		{"string to binary", NewLiteral("hé", types.LongText), sql.CharacterSet_binary, []byte("hé"), 0},
+		{"null", NewLiteral(nil, types.Null), sql.CharacterSet_latin1, nil, 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+			ctx := sql.NewEmptyContext()
+			convert, err := NewConvertUsing(test.val, test.charset)
+			require.NoError(err)
+			if test.charset == sql.CharacterSet_binary {
+				require.Equal(types.LongBlob, convert.Type())
+			} else {
+				require.Equal(test.charset, convert.Type().(sql.StringType).CharacterSet())
+			}
+
+			val, err := convert.Eval(ctx, nil)
+			require.NoError(err)
+			require.Equal(test.expected, val)
+			require.Equal(test.warnings, len(ctx.Warnings()))
+		})
+	}
+}
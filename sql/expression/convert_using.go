@@ -0,0 +1,122 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/encodings"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// ConvertUsing represents a CONVERT(x USING charset) operation, which transcodes the string x to the given character
+// set. Characters that do not exist in the target character set are replaced with '?'. Binary strings are interpreted
+// as being encoded in the target character set, and are NULL if they are not valid in it.
+type ConvertUsing struct {
+	UnaryExpression
+	charset sql.CharacterSetID
+}
+
+var _ sql.Expression = (*ConvertUsing)(nil)
+var _ sql.CollationCoercible = (*ConvertUsing)(nil)
+
+// NewConvertUsing creates a new ConvertUsing expression. Returns an error if the character set is not supported.
+func NewConvertUsing(expr sql.Expression, charset sql.CharacterSetID) (*ConvertUsing, error) {
+	if charset.Encoder() == nil {
+		return nil, sql.ErrCharSetNotYetImplementedTemp.New(charset.Name())
+	}
+	return &ConvertUsing{
+		UnaryExpression: UnaryExpression{Child: expr},
+		charset:         charset,
+	}, nil
+}
+
+// IsNullable implements the Expression interface.
+func (c *ConvertUsing) IsNullable() bool {
+	return true
+}
+
+// Type implements the Expression interface.
+func (c *ConvertUsing) Type() sql.Type {
+	if c.charset == sql.CharacterSet_binary {
+		return types.LongBlob
+	}
+	return types.CreateLongText(c.charset.DefaultCollation())
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (c *ConvertUsing) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return c.charset.DefaultCollation(), 2
+}
+
+// String implements the Stringer interface.
+func (c *ConvertUsing) String() string {
+	return fmt.Sprintf("convert(%v using %v)", c.Child, c.charset.Name())
+}
+
+// WithChildren implements the Expression interface.
+func (c *ConvertUsing) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(c, len(children), 1)
+	}
+	return NewConvertUsing(children[0], c.charset)
+}
+
+// Eval implements the Expression interface.
+func (c *ConvertUsing) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	val, err := c.Child.Eval(ctx, row)
+	if err != nil || val == nil {
+		return nil, err
+	}
+
+	if c.charset == sql.CharacterSet_binary {
+		return convertValue(val, ConvertToBinary, c.Child.Type())
+	}
+
+	encoder := c.charset.Encoder()
+	if b, ok := val.([]byte); ok {
+		decoded, ok := encoder.Decode(b)
+		if !ok || !utf8.Valid(decoded) {
+			ctx.Warn(1300, "Invalid %s character string: '%X'", c.charset.Name(), b)
+			return nil, nil
+		}
+		return string(decoded), nil
+	}
+
+	str, _, err := types.LongText.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+	return transcodeString(str.(string), encoder), nil
+}
+
+// transcodeString returns |str| with every character that cannot be represented by |encoder| replaced with '?'.
+func transcodeString(str string, encoder encodings.Encoder) string {
+	var sb strings.Builder
+	sb.Grow(len(str))
+	var buf [utf8.UTFMax]byte
+	for _, r := range str {
+		n := utf8.EncodeRune(buf[:], r)
+		if _, ok := encoder.EncodeRune(buf[:n]); ok && r != utf8.RuneError {
+			sb.WriteRune(r)
+		} else {
+			sb.WriteByte('?')
+		}
+	}
+	return sb.String()
+}
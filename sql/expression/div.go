@@ -111,7 +111,22 @@ func (d *Div) Type() sql.Type {
 
 	// for division operation, it's either float or decimal.Decimal type
 	// except invalid value will result it either 0 or nil
-	return floatOrDecimalType(d)
+	typ := floatOrDecimalType(d)
+
+	// the result of the outermost division is rounded to the scale of its leftmost value plus the precision
+	// increment for every division, so the type has to hold that scale
+	if dt, ok := typ.(sql.DecimalType); ok && isOutermostDiv(d, 0, d.divScale) {
+		scale := int(dt.Scale()) + int(d.divScale)*divPrecisionIncrement
+		if scale > types.DecimalTypeMaxScale {
+			scale = types.DecimalTypeMaxScale
+		}
+		precision := int(dt.Precision()) - int(dt.Scale()) + scale
+		if precision > types.DecimalTypeMaxPrecision {
+			precision = types.DecimalTypeMaxPrecision
+		}
+		return types.MustCreateDecimalType(uint8(precision), uint8(scale))
+	}
+	return typ
 }
 
 // CollationCoercibility implements the interface sql.CollationCoercible.
@@ -212,19 +227,19 @@ func (d *Div) evalLeftRight(ctx *sql.Context, row sql.Row) (interface{}, interfa
 // should be preserved.
 func (d *Div) convertLeftRight(ctx *sql.Context, left interface{}, right interface{}) (interface{}, interface{}) {
 	typ := d.Type()
-	lIsTimeType := types.IsTime(d.Left.Type())
-	rIsTimeType := types.IsTime(d.Right.Type())
+	lOrigType := d.Left.Type()
+	rOrigType := d.Right.Type()
 
 	if types.IsFloat(typ) {
-		left = convertValueToType(ctx, typ, left, lIsTimeType)
+		left = convertValueToType(ctx, typ, left, lOrigType)
 	} else {
-		left = convertToDecimalValue(left, lIsTimeType)
+		left = convertToDecimalValue(left, lOrigType)
 	}
 
 	if types.IsFloat(typ) {
-		right = convertValueToType(ctx, typ, right, rIsTimeType)
+		right = convertValueToType(ctx, typ, right, rOrigType)
 	} else {
-		right = convertToDecimalValue(right, rIsTimeType)
+		right = convertToDecimalValue(right, rOrigType)
 	}
 
 	return left, right
@@ -349,10 +364,8 @@ func floatOrDecimalType(e sql.Expression) sql.Type {
 // If the value is invalid, it returns decimal 0. This function
 // is used for 'div' or 'mod' arithmetic operation, which requires
 // the result value to have precise precision and scale.
-func convertToDecimalValue(val interface{}, isTimeType bool) interface{} {
-	if isTimeType {
-		val = convertTimeTypeToString(val)
-	}
+func convertToDecimalValue(val interface{}, valType sql.Type) interface{} {
+	val = convertTemporalToNumber(val, valType)
 
 	if _, ok := val.(decimal.Decimal); !ok {
 		p, s := GetPrecisionAndScale(val)
@@ -622,9 +635,7 @@ func (i *IntDiv) Type() sql.Type {
 		return lTyp
 	}
 
-	if types.IsTime(lTyp) && types.IsTime(rTyp) {
-		return types.Int64
-	}
+	lTyp, rTyp = numericContextType(lTyp), numericContextType(rTyp)
 
 	if types.IsText(lTyp) || types.IsText(rTyp) {
 		return types.Float64
@@ -696,19 +707,19 @@ func (i *IntDiv) evalLeftRight(ctx *sql.Context, row sql.Row) (interface{}, inte
 // should be preserved.
 func (i *IntDiv) convertLeftRight(ctx *sql.Context, left interface{}, right interface{}) (interface{}, interface{}) {
 	typ := i.Type()
-	lIsTimeType := types.IsTime(i.Left.Type())
-	rIsTimeType := types.IsTime(i.Right.Type())
+	lOrigType := i.Left.Type()
+	rOrigType := i.Right.Type()
 
 	if types.IsInteger(typ) || types.IsFloat(typ) {
-		left = convertValueToType(ctx, typ, left, lIsTimeType)
+		left = convertValueToType(ctx, typ, left, lOrigType)
 	} else {
-		left = convertToDecimalValue(left, lIsTimeType)
+		left = convertToDecimalValue(left, lOrigType)
 	}
 
 	if types.IsInteger(typ) || types.IsFloat(typ) {
-		right = convertValueToType(ctx, typ, right, rIsTimeType)
+		right = convertValueToType(ctx, typ, right, rOrigType)
 	} else {
-		right = convertToDecimalValue(right, rIsTimeType)
+		right = convertToDecimalValue(right, rOrigType)
 	}
 
 	return left, right
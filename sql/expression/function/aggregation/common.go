@@ -46,6 +46,16 @@ func (a *unaryAggBase) NewBuffer() (sql.AggregationBuffer, error) {
 	panic("unaryAggBase is a base type, type must implement NewWindowFunction")
 }
 
+// newUnaryAggBuffer returns the buffer created by |newBuffer| for a unary aggregation over the child given. If the
+// child is DISTINCT, the buffer is created over the expression made distinct, and only updated with its distinct
+// values by a distinctBuffer.
+func newUnaryAggBuffer(child sql.Expression, newBuffer func(child sql.Expression) sql.AggregationBuffer) sql.AggregationBuffer {
+	if de, ok := child.(*expression.DistinctExpression); ok {
+		return NewDistinctBuffer([]sql.Expression{de.Child}, newBuffer(de.Child))
+	}
+	return newBuffer(child)
+}
+
 // WithWindow returns a new unaryAggBase to be embedded in wrapping type
 func (a *unaryAggBase) WithWindow(window *sql.WindowDefinition) (sql.Aggregation, error) {
 	na := *a
@@ -20,6 +20,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
 func eval(t *testing.T, e sql.Expression, row sql.Row) interface{} {
@@ -50,3 +52,38 @@ func aggregate(t *testing.T, agg sql.Aggregation, rows ...sql.Row) interface{} {
 	}
 	return evalBuffer(t, buf)
 }
+
+func TestDistinctAggregations(t *testing.T) {
+	field := func() sql.Expression {
+		return expression.NewDistinctExpression(expression.NewGetField(0, types.Int32, "x", true))
+	}
+	rows := []sql.Row{{int32(1)}, {nil}, {int32(2)}, {int32(1)}, {int32(3)}, {nil}, {int32(3)}}
+
+	testCases := []struct {
+		name     string
+		agg      sql.Aggregation
+		rows     []sql.Row
+		expected interface{}
+	}{
+		{"sum", NewSum(field()), rows, float64(6)},
+		{"avg", NewAvg(field()), rows, float64(2)},
+		{"count", NewCountDistinct(expression.NewGetField(0, types.Int32, "x", true)), rows, int64(3)},
+		{"min", NewMin(field()), rows, int32(1)},
+		{"max", NewMax(field()), rows, int32(3)},
+		{"sum of nulls", NewSum(field()), []sql.Row{{nil}, {nil}}, nil},
+		{"avg of nulls", NewAvg(field()), []sql.Row{{nil}, {nil}}, nil},
+		{"count of nulls", NewCountDistinct(expression.NewGetField(0, types.Int32, "x", true)), []sql.Row{{nil}, {nil}}, int64(0)},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, aggregate(t, tt.agg, tt.rows...))
+		})
+	}
+
+	t.Run("buffers track distinct values separately", func(t *testing.T) {
+		sum := NewSum(field())
+		require.Equal(t, float64(3), aggregate(t, sum, sql.Row{int32(1)}, sql.Row{int32(2)}, sql.Row{int32(2)}))
+		require.Equal(t, float64(3), aggregate(t, sum, sql.Row{int32(1)}, sql.Row{int32(2)}))
+	})
+}
@@ -91,7 +91,7 @@ func (a *CountDistinct) NewBuffer() (sql.AggregationBuffer, error) {
 		}
 		exprs[i] = child
 	}
-	return NewCountDistinctBuffer(exprs), nil
+	return NewDistinctBuffer(exprs, NewCountBuffer(expression.NewStar())), nil
 }
 
 // WithWindow implements the Aggregation interface.
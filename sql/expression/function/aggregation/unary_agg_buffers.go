@@ -384,26 +384,32 @@ func (b *bitXorBuffer) Dispose() {
 	expression.Dispose(b.expr)
 }
 
-type countDistinctBuffer struct {
+// distinctBuffer is the buffer of an aggregation over DISTINCT values. It keeps the hashes of the values of its
+// expressions seen so far, and updates the aggregation's buffer with a row only the first time its values are seen.
+// Rows with a NULL value are skipped, since aggregations ignore NULLs.
+type distinctBuffer struct {
 	seen  map[uint64]struct{}
 	exprs []sql.Expression
+	buf   sql.AggregationBuffer
 }
 
-func NewCountDistinctBuffer(children []sql.Expression) *countDistinctBuffer {
-	return &countDistinctBuffer{make(map[uint64]struct{}), children}
+// NewDistinctBuffer returns a buffer updating the buffer given with the rows whose values of the expressions given
+// are distinct. A single Star expression makes whole rows distinct.
+func NewDistinctBuffer(exprs []sql.Expression, buf sql.AggregationBuffer) *distinctBuffer {
+	return &distinctBuffer{make(map[uint64]struct{}), exprs, buf}
 }
 
 // Update implements the AggregationBuffer interface.
-func (c *countDistinctBuffer) Update(ctx *sql.Context, row sql.Row) error {
+func (d *distinctBuffer) Update(ctx *sql.Context, row sql.Row) error {
 	var value interface{}
-	if len(c.exprs) == 0 {
+	if len(d.exprs) == 0 {
 		return fmt.Errorf("no expressions")
 	}
-	if _, ok := c.exprs[0].(*expression.Star); ok {
+	if _, ok := d.exprs[0].(*expression.Star); ok {
 		value = row
 	} else {
-		val := make([]interface{}, len(c.exprs))
-		for i, expr := range c.exprs {
+		val := make([]interface{}, len(d.exprs))
+		for i, expr := range d.exprs {
 			v, err := expr.Eval(ctx, row)
 			if err != nil {
 				return err
@@ -419,23 +425,27 @@ func (c *countDistinctBuffer) Update(ctx *sql.Context, row sql.Row) error {
 
 	hash, err := hashstructure.Hash(value, nil)
 	if err != nil {
-		return fmt.Errorf("count distinct unable to hash value: %s", err)
+		return fmt.Errorf("distinct unable to hash value: %s", err)
 	}
+	if _, ok := d.seen[hash]; ok {
+		return nil
+	}
+	d.seen[hash] = struct{}{}
 
-	c.seen[hash] = struct{}{}
-
-	return nil
+	return d.buf.Update(ctx, row)
 }
 
 // Eval implements the AggregationBuffer interface.
-func (c *countDistinctBuffer) Eval(ctx *sql.Context) (interface{}, error) {
-	return int64(len(c.seen)), nil
+func (d *distinctBuffer) Eval(ctx *sql.Context) (interface{}, error) {
+	return d.buf.Eval(ctx)
 }
 
-func (c *countDistinctBuffer) Dispose() {
-	for _, e := range c.exprs {
+// Dispose implements the Disposable interface.
+func (d *distinctBuffer) Dispose() {
+	for _, e := range d.exprs {
 		expression.Dispose(e)
 	}
+	d.buf.Dispose()
 }
 
 type countBuffer struct {
@@ -75,7 +75,9 @@ func (a *AnyValue) NewBuffer() (sql.AggregationBuffer, error) {
 	if err != nil {
 		return nil, err
 	}
-	return NewAnyValueBuffer(child), nil
+	return newUnaryAggBuffer(child, func(child sql.Expression) sql.AggregationBuffer {
+		return NewAnyValueBuffer(child)
+	}), nil
 }
 
 func (a *AnyValue) NewWindowFunction() (sql.WindowFunction, error) {
@@ -150,7 +152,9 @@ func (a *Avg) NewBuffer() (sql.AggregationBuffer, error) {
 	if err != nil {
 		return nil, err
 	}
-	return NewAvgBuffer(child), nil
+	return newUnaryAggBuffer(child, func(child sql.Expression) sql.AggregationBuffer {
+		return NewAvgBuffer(child)
+	}), nil
 }
 
 func (a *Avg) NewWindowFunction() (sql.WindowFunction, error) {
@@ -225,7 +229,9 @@ func (a *BitAnd) NewBuffer() (sql.AggregationBuffer, error) {
 	if err != nil {
 		return nil, err
 	}
-	return NewBitAndBuffer(child), nil
+	return newUnaryAggBuffer(child, func(child sql.Expression) sql.AggregationBuffer {
+		return NewBitAndBuffer(child)
+	}), nil
 }
 
 func (a *BitAnd) NewWindowFunction() (sql.WindowFunction, error) {
@@ -300,7 +306,9 @@ func (a *BitOr) NewBuffer() (sql.AggregationBuffer, error) {
 	if err != nil {
 		return nil, err
 	}
-	return NewBitOrBuffer(child), nil
+	return newUnaryAggBuffer(child, func(child sql.Expression) sql.AggregationBuffer {
+		return NewBitOrBuffer(child)
+	}), nil
 }
 
 func (a *BitOr) NewWindowFunction() (sql.WindowFunction, error) {
@@ -375,7 +383,9 @@ func (a *BitXor) NewBuffer() (sql.AggregationBuffer, error) {
 	if err != nil {
 		return nil, err
 	}
-	return NewBitXorBuffer(child), nil
+	return newUnaryAggBuffer(child, func(child sql.Expression) sql.AggregationBuffer {
+		return NewBitXorBuffer(child)
+	}), nil
 }
 
 func (a *BitXor) NewWindowFunction() (sql.WindowFunction, error) {
@@ -450,7 +460,9 @@ func (a *Count) NewBuffer() (sql.AggregationBuffer, error) {
 	if err != nil {
 		return nil, err
 	}
-	return NewCountBuffer(child), nil
+	return newUnaryAggBuffer(child, func(child sql.Expression) sql.AggregationBuffer {
+		return NewCountBuffer(child)
+	}), nil
 }
 
 func (a *Count) NewWindowFunction() (sql.WindowFunction, error) {
@@ -525,7 +537,9 @@ func (a *First) NewBuffer() (sql.AggregationBuffer, error) {
 	if err != nil {
 		return nil, err
 	}
-	return NewFirstBuffer(child), nil
+	return newUnaryAggBuffer(child, func(child sql.Expression) sql.AggregationBuffer {
+		return NewFirstBuffer(child)
+	}), nil
 }
 
 func (a *First) NewWindowFunction() (sql.WindowFunction, error) {
@@ -600,7 +614,9 @@ func (a *JsonArray) NewBuffer() (sql.AggregationBuffer, error) {
 	if err != nil {
 		return nil, err
 	}
-	return NewJsonArrayBuffer(child), nil
+	return newUnaryAggBuffer(child, func(child sql.Expression) sql.AggregationBuffer {
+		return NewJsonArrayBuffer(child)
+	}), nil
 }
 
 func (a *JsonArray) NewWindowFunction() (sql.WindowFunction, error) {
@@ -675,7 +691,9 @@ func (a *Last) NewBuffer() (sql.AggregationBuffer, error) {
 	if err != nil {
 		return nil, err
 	}
-	return NewLastBuffer(child), nil
+	return newUnaryAggBuffer(child, func(child sql.Expression) sql.AggregationBuffer {
+		return NewLastBuffer(child)
+	}), nil
 }
 
 func (a *Last) NewWindowFunction() (sql.WindowFunction, error) {
@@ -750,7 +768,9 @@ func (a *Max) NewBuffer() (sql.AggregationBuffer, error) {
 	if err != nil {
 		return nil, err
 	}
-	return NewMaxBuffer(child), nil
+	return newUnaryAggBuffer(child, func(child sql.Expression) sql.AggregationBuffer {
+		return NewMaxBuffer(child)
+	}), nil
 }
 
 func (a *Max) NewWindowFunction() (sql.WindowFunction, error) {
@@ -825,7 +845,9 @@ func (a *Min) NewBuffer() (sql.AggregationBuffer, error) {
 	if err != nil {
 		return nil, err
 	}
-	return NewMinBuffer(child), nil
+	return newUnaryAggBuffer(child, func(child sql.Expression) sql.AggregationBuffer {
+		return NewMinBuffer(child)
+	}), nil
 }
 
 func (a *Min) NewWindowFunction() (sql.WindowFunction, error) {
@@ -900,7 +922,9 @@ func (a *Sum) NewBuffer() (sql.AggregationBuffer, error) {
 	if err != nil {
 		return nil, err
 	}
-	return NewSumBuffer(child), nil
+	return newUnaryAggBuffer(child, func(child sql.Expression) sql.AggregationBuffer {
+		return NewSumBuffer(child)
+	}), nil
 }
 
 func (a *Sum) NewWindowFunction() (sql.WindowFunction, error) {
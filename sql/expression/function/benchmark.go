@@ -0,0 +1,109 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// Benchmark is a function that evaluates an expression the specified number of times and returns 0.
+// It can be useful to time how long evaluating an expression takes.
+type Benchmark struct {
+	expression.BinaryExpression
+}
+
+var _ sql.FunctionExpression = (*Benchmark)(nil)
+var _ sql.CollationCoercible = (*Benchmark)(nil)
+
+// NewBenchmark creates a new Benchmark expression.
+func NewBenchmark(count, e sql.Expression) sql.Expression {
+	return &Benchmark{expression.BinaryExpression{Left: count, Right: e}}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (b *Benchmark) FunctionName() string {
+	return "benchmark"
+}
+
+// Description implements sql.FunctionExpression
+func (b *Benchmark) Description() string {
+	return "evaluates an expression the specified number of times."
+}
+
+// Eval implements the Expression interface.
+func (b *Benchmark) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	count, err := b.Left.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+
+	if count == nil {
+		return nil, nil
+	}
+
+	count, _, err = types.Int64.Convert(count)
+	if err != nil {
+		return nil, err
+	}
+
+	n := count.(int64)
+	if n < 0 {
+		return nil, sql.ErrInvalidArgumentDetails.New("BENCHMARK", "count must not be negative")
+	}
+
+	for i := int64(0); i < n; i++ {
+		// a KILL cancels the context of the query, which must interrupt long benchmarks
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if _, err := b.Right.Eval(ctx, row); err != nil {
+			return nil, err
+		}
+	}
+
+	return int32(0), nil
+}
+
+// String implements the fmt.Stringer interface.
+func (b *Benchmark) String() string {
+	return fmt.Sprintf("%s(%s,%s)", b.FunctionName(), b.Left, b.Right)
+}
+
+// IsNullable implements the Expression interface.
+func (b *Benchmark) IsNullable() bool {
+	return b.Left.IsNullable()
+}
+
+// WithChildren implements the Expression interface.
+func (b *Benchmark) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(b, len(children), 2)
+	}
+	return NewBenchmark(children[0], children[1]), nil
+}
+
+// Type implements the Expression interface.
+func (b *Benchmark) Type() sql.Type {
+	return types.Int32
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*Benchmark) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 5
+}
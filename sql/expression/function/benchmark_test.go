@@ -0,0 +1,112 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+func TestBenchmark(t *testing.T) {
+	f := NewBenchmark(
+		expression.NewGetField(0, types.LongText, "n", true),
+		expression.NewLiteral("abc", types.LongText),
+	)
+	testCases := []struct {
+		name     string
+		row      sql.Row
+		expected interface{}
+		err      bool
+	}{
+		{"null count", sql.NewRow(nil), nil, false},
+		{"zero count", sql.NewRow(0), int32(0), false},
+		{"positive count", sql.NewRow(1000), int32(0), false},
+		{"string count", sql.NewRow("10"), int32(0), false},
+		{"negative count", sql.NewRow(-1), nil, true},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			ctx := sql.NewEmptyContext()
+
+			v, err := f.Eval(ctx, tt.row)
+			if tt.err {
+				require.Error(err)
+				require.True(sql.ErrInvalidArgumentDetails.Is(err))
+			} else {
+				require.NoError(err)
+				require.Equal(tt.expected, v)
+			}
+		})
+	}
+}
+
+func TestBenchmarkEvaluatesExpression(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	var evals int
+	e := &countingExpression{Literal: expression.NewLiteral(1, types.Int64), evals: &evals}
+	v, err := NewBenchmark(expression.NewLiteral(25, types.Int64), e).Eval(ctx, nil)
+	require.NoError(err)
+	require.Equal(int32(0), v)
+	require.Equal(25, evals)
+}
+
+func TestBenchmarkCancel(t *testing.T) {
+	require := require.New(t)
+	goCtx, cancel := context.WithCancel(context.Background())
+	ctx := sql.NewContext(goCtx)
+
+	f := NewBenchmark(
+		expression.NewLiteral(int64(1<<62), types.Int64),
+		expression.NewLiteral("abc", types.LongText),
+	)
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		cancel()
+	}()
+
+	done := make(chan error)
+	go func() {
+		_, err := f.Eval(ctx, nil)
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		require.ErrorIs(err, context.Canceled)
+	case <-time.After(5 * time.Second):
+		require.FailNow("BENCHMARK was not interrupted by the cancellation of its context")
+	}
+}
+
+// countingExpression is a literal counting how many times it's evaluated.
+type countingExpression struct {
+	*expression.Literal
+	evals *int
+}
+
+func (e *countingExpression) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	*e.evals++
+	return e.Literal.Eval(ctx, row)
+}
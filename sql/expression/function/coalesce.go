@@ -19,6 +19,7 @@ import (
 	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
 )
 
 // Coalesce returns the first non-NULL value in the list, or NULL if there are no non-NULL values.
@@ -52,26 +53,17 @@ func (c *Coalesce) Description() string {
 // The return type of Type() is the aggregated type of the argument types.
 func (c *Coalesce) Type() sql.Type {
 	for _, arg := range c.args {
-		if arg == nil {
-			continue
-		}
-		t := arg.Type()
-		if t == nil {
-			continue
+		if arg != nil && arg.Type() != nil {
+			return expression.AggregateTypes(c.args...)
 		}
-		return t
 	}
-
 	return nil
 }
 
 // CollationCoercibility implements the interface sql.CollationCoercible.
 func (c *Coalesce) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
 	// Preferably, this would be done during evaluation, but that's not possible with the current abstraction
-	if typ := c.Type(); typ != nil {
-		return typ.CollationCoercibility(ctx)
-	}
-	return sql.Collation_binary, 6
+	return expression.AggregateCollationCoercibility(ctx, c.args...)
 }
 
 // IsNullable implements the sql.Expression interface.
@@ -135,7 +127,7 @@ func (c *Coalesce) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 			continue
 		}
 
-		return val, nil
+		return expression.ConvertAggregateResult(ctx, c.Type(), arg, val)
 	}
 
 	return nil, nil
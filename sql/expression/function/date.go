@@ -16,7 +16,6 @@ package function
 
 import (
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -74,8 +73,7 @@ func (d *DateAdd) IsNullable() bool {
 
 // Type implements the sql.Expression interface.
 func (d *DateAdd) Type() sql.Type {
-	sqlType := dateOffsetType(d.Date, d.Interval)
-	return sqlType
+	return expression.DateOffsetType(d.Date.Type(), d.Interval)
 }
 
 // CollationCoercibility implements the interface sql.CollationCoercible.
@@ -91,37 +89,16 @@ func (d *DateAdd) WithChildren(children ...sql.Expression) (sql.Expression, erro
 // Eval implements the sql.Expression interface.
 func (d *DateAdd) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	val, err := d.Date.Eval(ctx, row)
-	if err != nil {
+	if err != nil || val == nil {
 		return nil, err
 	}
 
-	if val == nil {
-		return nil, nil
-	}
-
 	delta, err := d.Interval.EvalDelta(ctx, row)
 	if err != nil {
 		return nil, err
 	}
 
-	if delta == nil {
-		return nil, nil
-	}
-
-	date, _, err := types.Datetime.Convert(val)
-	if err != nil {
-		ctx.Warn(1292, err.Error())
-		return nil, nil
-	}
-
-	// return appropriate type
-	res := types.ValidateTime(delta.Add(date.(time.Time)))
-	resType := d.Type()
-	if types.IsText(resType) {
-		return res, nil
-	}
-	ret, _, err := resType.Convert(res)
-	return ret, err
+	return expression.EvalDateOffset(ctx, d.Type(), val, d.Interval, delta, false)
 }
 
 func (d *DateAdd) String() string {
@@ -178,8 +155,7 @@ func (d *DateSub) IsNullable() bool {
 
 // Type implements the sql.Expression interface.
 func (d *DateSub) Type() sql.Type {
-	sqlType := dateOffsetType(d.Date, d.Interval)
-	return sqlType
+	return expression.DateOffsetType(d.Date.Type(), d.Interval)
 }
 
 // CollationCoercibility implements the interface sql.CollationCoercible.
@@ -194,38 +170,17 @@ func (d *DateSub) WithChildren(children ...sql.Expression) (sql.Expression, erro
 
 // Eval implements the sql.Expression interface.
 func (d *DateSub) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
-	date, err := d.Date.Eval(ctx, row)
-	if err != nil {
+	val, err := d.Date.Eval(ctx, row)
+	if err != nil || val == nil {
 		return nil, err
 	}
 
-	if date == nil {
-		return nil, nil
-	}
-
-	date, _, err = types.Datetime.Convert(date)
-	if err != nil {
-		ctx.Warn(1292, err.Error())
-		return nil, nil
-	}
-
 	delta, err := d.Interval.EvalDelta(ctx, row)
 	if err != nil {
 		return nil, err
 	}
 
-	if delta == nil {
-		return nil, nil
-	}
-
-	// return appropriate type
-	res := types.ValidateTime(delta.Sub(date.(time.Time)))
-	resType := d.Type()
-	if types.IsText(resType) {
-		return res, nil
-	}
-	ret, _, err := resType.Convert(res)
-	return ret, err
+	return expression.EvalDateOffset(ctx, d.Type(), val, d.Interval, delta, true)
 }
 
 func (d *DateSub) String() string {
@@ -529,19 +484,19 @@ func (c CurrDate) Description() string {
 
 func NewCurrDate() sql.Expression {
 	return CurrDate{
-		NoArgFunc: NoArgFunc{"curdate", types.LongText},
+		NoArgFunc: NoArgFunc{"curdate", types.Date},
 	}
 }
 
 func NewCurrentDate() sql.Expression {
 	return CurrDate{
-		NoArgFunc: NoArgFunc{"current_date", types.LongText},
+		NoArgFunc: NoArgFunc{"current_date", types.Date},
 	}
 }
 
 func currDateLogic(ctx *sql.Context, _ sql.Row) (interface{}, error) {
 	t := ctx.QueryTime()
-	return fmt.Sprintf("%d-%02d-%02d", t.Year(), t.Month(), t.Day()), nil
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC), nil
 }
 
 // Eval implements sql.Expression
@@ -558,75 +513,3 @@ func (CurrDate) CollationCoercibility(ctx *sql.Context) (collation sql.Collation
 func (c CurrDate) WithChildren(children ...sql.Expression) (sql.Expression, error) {
 	return NoArgFuncWithChildren(c, children)
 }
-
-// Determines the return type of a DateAdd/DateSub expression
-// Logic is based on https://dev.mysql.com/doc/refman/8.0/en/date-and-time-functions.html#function_date-add
-func dateOffsetType(input sql.Expression, interval *expression.Interval) sql.Type {
-	if input == nil {
-		return types.Null
-	}
-	inputType := input.Type()
-
-	// result is null if expression is null
-	if inputType == types.Null {
-		return types.Null
-	}
-
-	// set type flags
-	isInputDate := inputType == types.Date
-	isInputTime := inputType == types.Time
-	isInputDatetime := inputType == types.Datetime || inputType == types.Timestamp
-
-	// result is Datetime if expression is Datetime or Timestamp
-	if isInputDatetime {
-		return types.Datetime
-	}
-
-	// determine what kind of interval we're dealing with
-	isYmdInterval := strings.Contains(interval.Unit, "YEAR") ||
-		strings.Contains(interval.Unit, "QUARTER") ||
-		strings.Contains(interval.Unit, "MONTH") ||
-		strings.Contains(interval.Unit, "WEEK") ||
-		strings.Contains(interval.Unit, "DAY")
-
-	isHmsInterval := strings.Contains(interval.Unit, "HOUR") ||
-		strings.Contains(interval.Unit, "MINUTE") ||
-		strings.Contains(interval.Unit, "SECOND")
-	isMixedInterval := isYmdInterval && isHmsInterval
-
-	// handle input of Date type
-	if isInputDate {
-		if isHmsInterval || isMixedInterval {
-			// if interval contains time components, result is Datetime
-			return types.Datetime
-		} else {
-			// otherwise result is Date
-			return types.Date
-		}
-	}
-
-	// handle input of Time type
-	if isInputTime {
-		if isYmdInterval || isMixedInterval {
-			// if interval contains date components, result is Datetime
-			return types.Datetime
-		} else {
-			// otherwise result is Time
-			return types.Time
-		}
-	}
-
-	// handle dynamic input type
-	if types.IsDeferredType(inputType) {
-		if isYmdInterval && !isHmsInterval {
-			// if interval contains only date components, result is Date
-			return types.Date
-		} else {
-			// otherwise result is Datetime
-			return types.Datetime
-		}
-	}
-
-	// default type is VARCHAR
-	return types.Text
-}
@@ -20,6 +20,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	_ "github.com/dolthub/go-mysql-server/inittime"
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/types"
@@ -48,7 +49,7 @@ func TestDateAdd(t *testing.T) {
 	)
 	require.NoError(err)
 
-	expected := time.Date(2018, time.May, 3, 0, 0, 0, 0, time.UTC)
+	expected := "2018-05-03"
 
 	result, err := f.Eval(ctx, sql.Row{"2018-05-02"})
 	require.NoError(err)
@@ -90,7 +91,7 @@ func TestDateSub(t *testing.T) {
 	)
 	require.NoError(err)
 
-	expected := time.Date(2018, time.May, 1, 0, 0, 0, 0, time.UTC)
+	expected := "2018-05-01"
 
 	result, err := f.Eval(ctx, sql.Row{"2018-05-02"})
 	require.NoError(err)
@@ -196,3 +197,101 @@ func TestFromUnixtime(t *testing.T) {
 	_, err = NewUnixTimestamp(expression.NewLiteral(1447430881, types.Int64))
 	require.NoError(err)
 }
+
+func TestDateAddSubTypes(t *testing.T) {
+	date := time.Date(2018, time.May, 2, 0, 0, 0, 0, time.UTC)
+	datetime := time.Date(2018, time.May, 2, 10, 20, 30, 0, time.UTC)
+	timespan := func(s string) interface{} {
+		ts, _, err := types.Time.Convert(s)
+		require.NoError(t, err)
+		return ts
+	}
+
+	testCases := []struct {
+		name     string
+		date     sql.Expression
+		unit     string
+		typ      sql.Type
+		add, sub interface{}
+	}{
+		{"date + day", expression.NewLiteral(date, types.Date), "DAY", types.Date,
+			time.Date(2018, time.May, 3, 0, 0, 0, 0, time.UTC), time.Date(2018, time.May, 1, 0, 0, 0, 0, time.UTC)},
+		{"date + week", expression.NewLiteral(date, types.Date), "WEEK", types.Date,
+			time.Date(2018, time.May, 9, 0, 0, 0, 0, time.UTC), time.Date(2018, time.April, 25, 0, 0, 0, 0, time.UTC)},
+		{"date + month", expression.NewLiteral(date, types.Date), "MONTH", types.Date,
+			time.Date(2018, time.June, 2, 0, 0, 0, 0, time.UTC), time.Date(2018, time.April, 2, 0, 0, 0, 0, time.UTC)},
+		{"date + quarter", expression.NewLiteral(date, types.Date), "QUARTER", types.Date,
+			time.Date(2018, time.August, 2, 0, 0, 0, 0, time.UTC), time.Date(2018, time.February, 2, 0, 0, 0, 0, time.UTC)},
+		{"date + year", expression.NewLiteral(date, types.Date), "YEAR", types.Date,
+			time.Date(2019, time.May, 2, 0, 0, 0, 0, time.UTC), time.Date(2017, time.May, 2, 0, 0, 0, 0, time.UTC)},
+		{"date + hour", expression.NewLiteral(date, types.Date), "HOUR", types.Datetime,
+			time.Date(2018, time.May, 2, 1, 0, 0, 0, time.UTC), time.Date(2018, time.May, 1, 23, 0, 0, 0, time.UTC)},
+		{"date + minute", expression.NewLiteral(date, types.Date), "MINUTE", types.Datetime,
+			time.Date(2018, time.May, 2, 0, 1, 0, 0, time.UTC), time.Date(2018, time.May, 1, 23, 59, 0, 0, time.UTC)},
+		{"date + second", expression.NewLiteral(date, types.Date), "SECOND", types.Datetime,
+			time.Date(2018, time.May, 2, 0, 0, 1, 0, time.UTC), time.Date(2018, time.May, 1, 23, 59, 59, 0, time.UTC)},
+		{"date + microsecond", expression.NewLiteral(date, types.Date), "MICROSECOND", types.Datetime,
+			time.Date(2018, time.May, 2, 0, 0, 0, 1000, time.UTC), time.Date(2018, time.May, 1, 23, 59, 59, 999999000, time.UTC)},
+		{"datetime + day", expression.NewLiteral(datetime, types.Datetime), "DAY", types.Datetime,
+			time.Date(2018, time.May, 3, 10, 20, 30, 0, time.UTC), time.Date(2018, time.May, 1, 10, 20, 30, 0, time.UTC)},
+		{"datetime + hour", expression.NewLiteral(datetime, types.Datetime), "HOUR", types.Datetime,
+			time.Date(2018, time.May, 2, 11, 20, 30, 0, time.UTC), time.Date(2018, time.May, 2, 9, 20, 30, 0, time.UTC)},
+		{"datetime + microsecond", expression.NewLiteral(datetime, types.Datetime), "MICROSECOND", types.Datetime,
+			time.Date(2018, time.May, 2, 10, 20, 30, 1000, time.UTC), time.Date(2018, time.May, 2, 10, 20, 29, 999999000, time.UTC)},
+		{"timestamp + day", expression.NewLiteral(datetime, types.Timestamp), "DAY", types.Datetime,
+			time.Date(2018, time.May, 3, 10, 20, 30, 0, time.UTC), time.Date(2018, time.May, 1, 10, 20, 30, 0, time.UTC)},
+		{"time + hour", expression.NewLiteral(timespan("10:20:30"), types.Time), "HOUR", types.Time,
+			timespan("11:20:30"), timespan("09:20:30")},
+		{"time + second", expression.NewLiteral(timespan("10:20:30"), types.Time), "SECOND", types.Time,
+			timespan("10:20:31"), timespan("10:20:29")},
+		{"string date + day", expression.NewLiteral("2018-05-02", types.LongText), "DAY", types.LongText,
+			"2018-05-03", "2018-05-01"},
+		{"string date + month", expression.NewLiteral("2018-05-02", types.LongText), "MONTH", types.LongText,
+			"2018-06-02", "2018-04-02"},
+		{"string date + hour", expression.NewLiteral("2018-05-02", types.LongText), "HOUR", types.LongText,
+			"2018-05-02 01:00:00", "2018-05-01 23:00:00"},
+		{"string date + microsecond", expression.NewLiteral("2018-05-02", types.LongText), "MICROSECOND", types.LongText,
+			"2018-05-02 00:00:00.000001", "2018-05-01 23:59:59.999999"},
+		{"string datetime + day", expression.NewLiteral("2018-05-02 10:20:30", types.LongText), "DAY", types.LongText,
+			"2018-05-03 10:20:30", "2018-05-01 10:20:30"},
+		{"string datetime + hour", expression.NewLiteral("2018-05-02 10:20:30", types.LongText), "HOUR", types.LongText,
+			"2018-05-02 11:20:30", "2018-05-02 09:20:30"},
+		{"string datetime with fraction + second", expression.NewLiteral("2018-05-02 10:20:30.123456", types.LongText), "SECOND", types.LongText,
+			"2018-05-02 10:20:31.123456", "2018-05-02 10:20:29.123456"},
+		{"null + day", expression.NewLiteral(nil, types.Null), "DAY", types.Null, nil, nil},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			ctx := sql.NewEmptyContext()
+			interval := expression.NewInterval(expression.NewLiteral(int64(1), types.Int64), tt.unit)
+
+			add, err := NewDateAdd(tt.date, interval)
+			require.NoError(err)
+			require.Equal(tt.typ, add.Type())
+			result, err := add.Eval(ctx, nil)
+			require.NoError(err)
+			require.Equal(tt.add, result)
+
+			plus := expression.NewPlus(tt.date, interval)
+			require.Equal(tt.typ, plus.Type())
+			result, err = plus.Eval(ctx, nil)
+			require.NoError(err)
+			require.Equal(tt.add, result)
+
+			sub, err := NewDateSub(tt.date, interval)
+			require.NoError(err)
+			require.Equal(tt.typ, sub.Type())
+			result, err = sub.Eval(ctx, nil)
+			require.NoError(err)
+			require.Equal(tt.sub, result)
+
+			minus := expression.NewMinus(tt.date, interval)
+			require.Equal(tt.typ, minus.Type())
+			result, err = minus.Eval(ctx, nil)
+			require.NoError(err)
+			require.Equal(tt.sub, result)
+		})
+	}
+}
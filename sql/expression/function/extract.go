@@ -128,28 +128,11 @@ func (td *Extract) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	case "MONTH":
 		return int(dateTime.Month()), nil
 	case "WEEK":
-		date, err := getDate(ctx, expression.UnaryExpression{Child: td.Right}, row)
+		mode, err := defaultWeekFormat(ctx)
 		if err != nil {
 			return nil, err
 		}
-		yyyy, ok := year(date).(int32)
-		if !ok {
-			return nil, sql.ErrInvalidArgumentDetails.New("WEEK", "invalid year")
-		}
-		mm, ok := month(date).(int32)
-		if !ok {
-			return nil, sql.ErrInvalidArgumentDetails.New("WEEK", "invalid month")
-		}
-		dd, ok := day(date).(int32)
-		if !ok {
-			return nil, sql.ErrInvalidArgumentDetails.New("WEEK", "invalid day")
-		}
-		yearForWeek, week := calcWeek(yyyy, mm, dd, weekBehaviourYear)
-		if yearForWeek < yyyy {
-			week = 0
-		} else if yearForWeek > yyyy {
-			week = 53
-		}
+		_, week := calcWeek(int32(dateTime.Year()), int32(dateTime.Month()), int32(dateTime.Day()), weekMode(mode))
 		return int(week), nil
 	case "YEAR":
 		return dateTime.Year(), nil
@@ -205,7 +188,6 @@ func (td *Extract) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return ss + mmmmmm, nil
 	case "YEAR_MONTH":
 		yyyy := dateTime.Year() * 1_00
-		dateTime.Month()
 		mm := int(dateTime.Month())
 		return yyyy + mm, nil
 	default:
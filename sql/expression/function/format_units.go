@@ -0,0 +1,231 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// unit is a unit that an amount is formatted in, once the amount is at least its size.
+type unit struct {
+	size float64
+	name string
+}
+
+// byteUnits are the units of FORMAT_BYTES, largest first.
+var byteUnits = []unit{
+	{1 << 60, "EiB"},
+	{1 << 50, "PiB"},
+	{1 << 40, "TiB"},
+	{1 << 30, "GiB"},
+	{1 << 20, "MiB"},
+	{1 << 10, "KiB"},
+}
+
+// picoTimeUnits are the units of FORMAT_PICO_TIME, largest first.
+var picoTimeUnits = []unit{
+	{86400e12, "d"},
+	{3600e12, "h"},
+	{60e12, "min"},
+	{1e12, "s"},
+	{1e9, "ms"},
+	{1e6, "us"},
+	{1e3, "ns"},
+}
+
+// sysTimeUnits are the units of sys.format_time, largest first.
+var sysTimeUnits = []unit{
+	{604800e12, "w"},
+	{86400e12, "d"},
+	{3600e12, "h"},
+	{60e12, "m"},
+	{1e12, "s"},
+	{1e9, "ms"},
+	{1e6, "us"},
+	{1e3, "ns"},
+}
+
+// FormatByteCount returns a byte count in the largest binary unit it has at least one of, the same way as
+// FORMAT_BYTES.
+func FormatByteCount(bytes float64) string {
+	return formatUnits(bytes, byteUnits, "%4d bytes")
+}
+
+// FormatPicoseconds returns a time in picoseconds in the largest unit it has at least one of, the same way as
+// FORMAT_PICO_TIME.
+func FormatPicoseconds(picoseconds float64) string {
+	return formatUnits(picoseconds, picoTimeUnits, "%3d ps")
+}
+
+// formatUnits formats an amount in the first of the units given that it has at least one of, with two decimals, or
+// as a whole number with the format given if it's smaller than all of them.
+func formatUnits(amount float64, units []unit, smallest string) string {
+	for _, u := range units {
+		if math.Abs(amount) >= u.size {
+			value := amount / u.size
+			if math.Abs(value) >= 100000 {
+				return fmt.Sprintf("%4.2e %s", value, u.name)
+			}
+			return fmt.Sprintf("%4.2f %s", value, u.name)
+		}
+	}
+	return fmt.Sprintf(smallest, int64(amount))
+}
+
+// FormatBytes is the FORMAT_BYTES function, which is also sys.format_bytes.
+type FormatBytes struct {
+	*UnaryFunc
+}
+
+var _ sql.FunctionExpression = (*FormatBytes)(nil)
+var _ sql.CollationCoercible = (*FormatBytes)(nil)
+
+// NewFormatBytes creates a new FormatBytes expression.
+func NewFormatBytes(arg sql.Expression) sql.Expression {
+	return &FormatBytes{NewUnaryFunc(arg, "FORMAT_BYTES", types.LongText)}
+}
+
+// Description implements sql.FunctionExpression
+func (f *FormatBytes) Description() string {
+	return "converts a byte count to a value with units."
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*FormatBytes) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return ctx.GetCollation(), 4
+}
+
+// Eval implements the sql.Expression interface
+func (f *FormatBytes) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	bytes, err := evalFloat64(ctx, f.UnaryFunc, row)
+	if err != nil || bytes == nil {
+		return nil, err
+	}
+	return FormatByteCount(*bytes), nil
+}
+
+// WithChildren implements the sql.Expression interface
+func (f *FormatBytes) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), 1)
+	}
+	return NewFormatBytes(children[0]), nil
+}
+
+// FormatPicoTime is the FORMAT_PICO_TIME function.
+type FormatPicoTime struct {
+	*UnaryFunc
+}
+
+var _ sql.FunctionExpression = (*FormatPicoTime)(nil)
+var _ sql.CollationCoercible = (*FormatPicoTime)(nil)
+
+// NewFormatPicoTime creates a new FormatPicoTime expression.
+func NewFormatPicoTime(arg sql.Expression) sql.Expression {
+	return &FormatPicoTime{NewUnaryFunc(arg, "FORMAT_PICO_TIME", types.LongText)}
+}
+
+// Description implements sql.FunctionExpression
+func (f *FormatPicoTime) Description() string {
+	return "converts a time in picoseconds to a value with units."
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*FormatPicoTime) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return ctx.GetCollation(), 4
+}
+
+// Eval implements the sql.Expression interface
+func (f *FormatPicoTime) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	picoseconds, err := evalFloat64(ctx, f.UnaryFunc, row)
+	if err != nil || picoseconds == nil {
+		return nil, err
+	}
+	return FormatPicoseconds(*picoseconds), nil
+}
+
+// WithChildren implements the sql.Expression interface
+func (f *FormatPicoTime) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), 1)
+	}
+	return NewFormatPicoTime(children[0]), nil
+}
+
+// FormatTime is the sys.format_time function, which formats a time in picoseconds like FORMAT_PICO_TIME, but with
+// the units of the sys schema.
+type FormatTime struct {
+	*UnaryFunc
+}
+
+var _ sql.FunctionExpression = (*FormatTime)(nil)
+var _ sql.CollationCoercible = (*FormatTime)(nil)
+
+// NewFormatTime creates a new FormatTime expression.
+func NewFormatTime(arg sql.Expression) sql.Expression {
+	return &FormatTime{NewUnaryFunc(arg, "FORMAT_TIME", types.LongText)}
+}
+
+// Description implements sql.FunctionExpression
+func (f *FormatTime) Description() string {
+	return "converts a time in picoseconds to a value with units, as the sys schema does."
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*FormatTime) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return ctx.GetCollation(), 4
+}
+
+// Eval implements the sql.Expression interface
+func (f *FormatTime) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	picoseconds, err := evalFloat64(ctx, f.UnaryFunc, row)
+	if err != nil || picoseconds == nil {
+		return nil, err
+	}
+
+	for _, u := range sysTimeUnits {
+		if *picoseconds >= u.size {
+			return fmt.Sprintf("%.2f %s", *picoseconds/u.size, u.name), nil
+		}
+	}
+	return strconv.FormatFloat(*picoseconds, 'f', -1, 64) + " ps", nil
+}
+
+// WithChildren implements the sql.Expression interface
+func (f *FormatTime) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), 1)
+	}
+	return NewFormatTime(children[0]), nil
+}
+
+// evalFloat64 evaluates the argument of a function as a DOUBLE, returning nil if it's NULL.
+func evalFloat64(ctx *sql.Context, f *UnaryFunc, row sql.Row) (*float64, error) {
+	arg, err := f.EvalChild(ctx, row)
+	if err != nil || arg == nil {
+		return nil, err
+	}
+	val, _, err := types.Float64.Convert(arg)
+	if err != nil {
+		return nil, err
+	}
+	v := val.(float64)
+	return &v, nil
+}
@@ -98,6 +98,17 @@ func compEval(
 			}
 
 		case string:
+			if returnType == types.Datetime {
+				// Strings mixed with temporal arguments are compared as datetimes
+				tval, _, err := types.Datetime.Convert(t)
+				if err != nil {
+					return nil, err
+				}
+				if i == 0 || cmp(tval.(time.Time), selectedTime) {
+					selectedTime = tval.(time.Time)
+				}
+				continue
+			}
 			if types.IsTextOnly(returnType) && (i == 0 || cmp(t, selectedString)) {
 				selectedString = t
 			}
@@ -164,8 +175,8 @@ func compRetType(args ...sql.Expression) (sql.Type, error) {
 				allInt = false
 			}
 		} else if types.IsText(argType) {
+			// strings mixed with datetimes are compared as datetimes
 			allInt = false
-			allDatetime = false
 		} else if types.IsTime(argType) {
 			allString = false
 			allInt = false
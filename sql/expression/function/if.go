@@ -18,6 +18,7 @@ import (
 	"fmt"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
@@ -77,23 +78,27 @@ func (f *If) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		}
 	}
 
+	result := f.ifFalse
 	if asBool {
-		return f.ifTrue.Eval(ctx, row)
-	} else {
-		return f.ifFalse.Eval(ctx, row)
+		result = f.ifTrue
+	}
+	val, err := result.Eval(ctx, row)
+	if err != nil {
+		return nil, err
 	}
+	return expression.ConvertAggregateResult(ctx, f.Type(), result, val)
 }
 
 // Type implements the Expression interface.
 func (f *If) Type() sql.Type {
-	return f.ifTrue.Type()
+	return expression.AggregateTypes(f.ifTrue, f.ifFalse)
 }
 
 // CollationCoercibility implements the interface sql.CollationCoercible.
 func (f *If) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
-	// We would need to evaluate the condition to return the correct result here, so we'll copy
-	// Type and just return the true result
-	return sql.GetCoercibility(ctx, f.ifTrue)
+	// We would need to evaluate the condition to return the correct result here, so we'll resolve the collation of
+	// both results like Type does
+	return expression.AggregateCollationCoercibility(ctx, f.ifTrue, f.ifFalse)
 }
 
 // IsNullable implements the Expression interface.
@@ -16,7 +16,9 @@ package function
 
 import (
 	"testing"
+	"time"
 
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -51,6 +53,37 @@ func TestIf(t *testing.T) {
 	}
 }
 
+func TestIfType(t *testing.T) {
+	decimalType := types.MustCreateDecimalType(10, 2)
+	testCases := []struct {
+		name     string
+		ifTrue   sql.Expression
+		ifFalse  sql.Expression
+		typ      sql.Type
+		expected interface{}
+	}{
+		{"null and text", lit(nil, types.Null), lit("abc", types.LongText), types.LongText, nil},
+		{"int and decimal", lit(int64(1), types.Int64), lit(decimal.NewFromInt(2), decimalType), types.MustCreateDecimalType(21, 2), decimal.RequireFromString("1.00")},
+		{"int and double", lit(int32(1), types.Int32), lit(2.5, types.Float64), types.Float64, float64(1)},
+		{"date and text", lit(time.Date(2020, 4, 7, 0, 0, 0, 0, time.UTC), types.Date), lit("abc", types.LongText), types.LongText, "2020-04-07"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			f := NewIf(lit(true, types.Boolean), tc.ifTrue, tc.ifFalse)
+			require.Equal(t, tc.typ, f.Type())
+
+			v, err := f.Eval(sql.NewEmptyContext(), nil)
+			require.NoError(t, err)
+			if d, ok := tc.expected.(decimal.Decimal); ok {
+				require.True(t, d.Equal(v.(decimal.Decimal)))
+			} else {
+				require.Equal(t, tc.expected, v)
+			}
+		})
+	}
+}
+
 func eq(left, right sql.Expression) sql.Expression {
 	return expression.NewEquals(left, right)
 }
@@ -57,36 +57,24 @@ func (f *IfNull) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, err
 	}
 	if left != nil {
-		return left, nil
+		return expression.ConvertAggregateResult(ctx, f.Type(), f.Left, left)
 	}
 
 	right, err := f.Right.Eval(ctx, row)
 	if err != nil {
 		return nil, err
 	}
-	return right, nil
+	return expression.ConvertAggregateResult(ctx, f.Type(), f.Right, right)
 }
 
 // Type implements the Expression interface.
 func (f *IfNull) Type() sql.Type {
-	if types.IsNull(f.Left) {
-		if types.IsNull(f.Right) {
-			return types.Null
-		}
-		return f.Right.Type()
-	}
-	return f.Left.Type()
+	return expression.AggregateTypes(f.Left, f.Right)
 }
 
 // CollationCoercibility implements the interface sql.CollationCoercible.
 func (f *IfNull) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
-	if types.IsNull(f.Left) {
-		if types.IsNull(f.Right) {
-			return sql.Collation_binary, 6
-		}
-		return sql.GetCoercibility(ctx, f.Right)
-	}
-	return sql.GetCoercibility(ctx, f.Left)
+	return expression.AggregateCollationCoercibility(ctx, f.Left, f.Right)
 }
 
 // IsNullable implements the Expression interface.
@@ -0,0 +1,133 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// Interval is the INTERVAL(N, N1, N2, ...) comparison function. It is unrelated to the INTERVAL expr unit syntax
+// used in date arithmetic, which is represented by expression.Interval.
+type Interval struct {
+	args []sql.Expression
+}
+
+var _ sql.FunctionExpression = (*Interval)(nil)
+var _ sql.CollationCoercible = (*Interval)(nil)
+
+// NewInterval creates a new Interval function.
+func NewInterval(args ...sql.Expression) (sql.Expression, error) {
+	if len(args) < 2 {
+		return nil, sql.ErrInvalidArgumentNumber.New("INTERVAL", "2 or more", len(args))
+	}
+	return &Interval{args}, nil
+}
+
+// FunctionName implements sql.FunctionExpression
+func (i *Interval) FunctionName() string {
+	return "interval"
+}
+
+// Description implements sql.FunctionExpression
+func (i *Interval) Description() string {
+	return "returns the index of the last argument that is less than or equal to the first argument."
+}
+
+// Type implements the sql.Expression interface.
+func (i *Interval) Type() sql.Type {
+	return types.Int64
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*Interval) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 5
+}
+
+// IsNullable implements the sql.Expression interface.
+func (i *Interval) IsNullable() bool {
+	return false
+}
+
+func (i *Interval) String() string {
+	var args = make([]string, len(i.args))
+	for j, arg := range i.args {
+		args[j] = arg.String()
+	}
+	return fmt.Sprintf("%s(%s)", i.FunctionName(), strings.Join(args, ","))
+}
+
+// WithChildren implements the sql.Expression interface.
+func (*Interval) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	return NewInterval(children...)
+}
+
+// Resolved implements the sql.Expression interface.
+func (i *Interval) Resolved() bool {
+	for _, arg := range i.args {
+		if !arg.Resolved() {
+			return false
+		}
+	}
+	return true
+}
+
+// Children implements the sql.Expression interface.
+func (i *Interval) Children() []sql.Expression {
+	return i.args
+}
+
+// Eval implements the sql.Expression interface. It returns 0 if N < N1, 1 if N < N2 and so on, or -1 if N is NULL.
+// Like MySQL, the position is found with a binary search, so the list must be sorted in ascending order for the
+// result to be meaningful. NULL list elements compare as 0.
+func (i *Interval) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	n, err := i.evalArg(ctx, row, 0)
+	if err != nil {
+		return nil, err
+	}
+	if n == nil {
+		return int64(-1), nil
+	}
+
+	list := make([]float64, len(i.args)-1)
+	for j := range list {
+		val, err := i.evalArg(ctx, row, j+1)
+		if err != nil {
+			return nil, err
+		}
+		if val != nil {
+			list[j] = val.(float64)
+		}
+	}
+
+	target := n.(float64)
+	return int64(sort.Search(len(list), func(j int) bool {
+		return list[j] > target
+	})), nil
+}
+
+// evalArg evaluates the argument at the given index as a float64, returning nil if it is NULL.
+func (i *Interval) evalArg(ctx *sql.Context, row sql.Row, idx int) (interface{}, error) {
+	val, err := i.args[idx].Eval(ctx, row)
+	if err != nil || val == nil {
+		return nil, err
+	}
+	val, _, err = types.Float64.Convert(val)
+	return val, err
+}
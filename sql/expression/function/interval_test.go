@@ -0,0 +1,71 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+func TestIntervalArgs(t *testing.T) {
+	_, err := NewInterval(expression.NewLiteral(1, types.Int64))
+	require.True(t, sql.ErrInvalidArgumentNumber.Is(err))
+}
+
+func TestInterval(t *testing.T) {
+	testCases := []struct {
+		name     string
+		args     []interface{}
+		expected int64
+	}{
+		{"below first", []interface{}{0, 1, 15, 17}, 0},
+		{"equal to first", []interface{}{1, 1, 15, 17}, 1},
+		{"middle", []interface{}{23, 1, 15, 17, 30, 44, 200}, 3},
+		{"above last", []interface{}{201, 1, 15, 17, 30, 44, 200}, 6},
+		{"equal to last", []interface{}{200, 1, 15, 17, 30, 44, 200}, 6},
+		{"single element", []interface{}{10, 100}, 0},
+		{"floats", []interface{}{22.5, 1.5, 22.5, 23}, 2},
+		{"strings", []interface{}{"10", "1", "5", "50"}, 2},
+		{"null first", []interface{}{nil, 1, 2}, -1},
+		{"null element", []interface{}{5, nil, 10}, 1},
+		{"negative", []interface{}{-5, -10, 0, 10}, 1},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			args := make([]sql.Expression, len(tt.args))
+			for i, a := range tt.args {
+				if a == nil {
+					args[i] = expression.NewLiteral(nil, types.Null)
+				} else {
+					args[i] = expression.NewLiteral(a, types.ApproximateTypeFromValue(a))
+				}
+			}
+			f, err := NewInterval(args...)
+			require.NoError(t, err)
+			require.Equal(t, types.Int64, f.Type())
+			require.False(t, f.IsNullable())
+
+			v, err := f.Eval(sql.NewEmptyContext(), nil)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, v)
+		})
+	}
+}
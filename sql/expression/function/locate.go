@@ -23,9 +23,9 @@ import (
 	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
-// Locate returns the position of the first occurrence of a substring in a string.
+// Locate returns the position of the first occurrence of a substring in a string, counted in characters.
 // If the substring is not found within the original string, this function returns 0.
-// This function performs a case-insensitive search.
+// This function performs a case-insensitive search, unless either argument is a binary string.
 type Locate struct {
 	expression.NaryExpression
 }
@@ -104,7 +104,7 @@ func (l *Locate) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, nil
 	}
 
-	substr, ok := substrVal.(string)
+	substr, ok := locateArgument(substrVal)
 	if !ok {
 		return nil, sql.ErrInvalidArgumentDetails.New("locate", "substring must be a string")
 	}
@@ -118,7 +118,7 @@ func (l *Locate) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, nil
 	}
 
-	str, ok := strVal.(string)
+	str, ok := locateArgument(strVal)
 	if !ok {
 		return nil, sql.ErrInvalidArgumentDetails.New("locate", "string must be a string")
 	}
@@ -140,22 +140,45 @@ func (l *Locate) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		}
 	}
 
-	// Edge cases that cannot be handled by strings.Index.
-	switch {
-	// Position 0 doesn't exist.
-	case position == 0 || (len(str) > 0 && position > len(str)):
-		return int32(0), nil
-	// Locate("", "") returns 1 if start is 1.
-	case len(substr) == 0 && len(str) == 0:
-		if position == 1 {
-			return int32(1), nil
-		}
-		return int32(0), nil
+	caseSensitive := isBinaryArgument(l.ChildExpressions[0], substrVal) || isBinaryArgument(l.ChildExpressions[1], strVal)
+	return int32(locateSubstring(str, substr, position, caseSensitive)), nil
+}
+
+// locateSubstring returns the 1-based position, in characters, of the first occurrence of |substr| in |str| that starts
+// at or after the character position |start|, or 0 if there is none. An empty substring is found at |start| as long as
+// |start| is no further than just past the end of |str|. The search is case-insensitive unless |caseSensitive| is set.
+// These are the semantics shared by LOCATE and INSTR.
+func locateSubstring(str, substr string, start int, caseSensitive bool) int {
+	if !caseSensitive {
+		str, substr = strings.ToLower(str), strings.ToLower(substr)
+	}
+	text, subtext := []rune(str), []rune(substr)
+	if start < 1 || start > len(text)+1 {
+		return 0
+	}
+	if i := findSubsequence(text[start-1:], subtext); i >= 0 {
+		return int(i) + start
 	}
+	return 0
+}
+
+// locateArgument returns the string value of an argument of LOCATE or INSTR, which may be a string or a binary string.
+func locateArgument(v interface{}) (string, bool) {
+	switch v := v.(type) {
+	case string:
+		return v, true
+	case []byte:
+		return string(v), true
+	default:
+		return "", false
+	}
+}
 
-	res := strings.Index(strings.ToLower(str[position-1:]), strings.ToLower(substr))
-	if res == -1 {
-		return int32(0), nil
+// isBinaryArgument returns whether the argument given of LOCATE or INSTR is a binary string, which makes the search
+// case-sensitive.
+func isBinaryArgument(e sql.Expression, v interface{}) bool {
+	if _, ok := v.([]byte); ok {
+		return true
 	}
-	return int32(res + position), nil
+	return types.IsBinaryType(e.Type())
 }
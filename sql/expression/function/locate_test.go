@@ -100,6 +100,39 @@ func TestLocate(t *testing.T) {
 			Str:      "",
 			Expected: 1,
 		},
+		{
+			Name:     "locate counts multibyte characters",
+			Substr:   "ade",
+			Str:      "façade",
+			Expected: 4,
+		},
+		{
+			Name:     "locate multibyte with start",
+			Substr:   "é",
+			Str:      "aéé",
+			Start:    intPtr(3),
+			Expected: 3,
+		},
+		{
+			Name:     "locate multibyte is case insensitive",
+			Substr:   "É",
+			Str:      "aéé",
+			Expected: 2,
+		},
+		{
+			Name:     "locate empty substring with start past the end",
+			Substr:   "",
+			Str:      "aéé",
+			Start:    intPtr(4),
+			Expected: 4,
+		},
+		{
+			Name:     "locate with start beyond the end",
+			Substr:   "",
+			Str:      "aéé",
+			Start:    intPtr(5),
+			Expected: 0,
+		},
 		{
 			Name:     "locate all empty with start > 1",
 			Substr:   "",
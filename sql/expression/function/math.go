@@ -22,19 +22,57 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/shopspring/decimal"
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/transform"
 	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
-// Rand returns a random float 0 <= x < 1. If it has an argument, that argument will be used to seed the random number
-// generator, effectively turning it into a hash on that value.
+// Rand returns a random float 0 <= x < 1. If it has an argument, that argument is used to seed MySQL's pseudo-random
+// number generator, so that seeded calls return the same numbers as in MySQL. A seed with the same value for every row
+// of a statement seeds the generator once, and each row gets the next number of its sequence. Other seeds seed it for
+// each row, effectively turning it into a hash on the seed's value.
 type Rand struct {
 	Child sql.Expression
+
+	// mu guards the generator seeded once for the statement
+	mu        sync.Mutex
+	seeded    *seededRand
+	statement randStatement
+}
+
+// randStatement identifies the statement a Rand with a constant seed was last evaluated for, so that a plan executed
+// again, as prepared statements are, starts the sequence over.
+type randStatement struct {
+	pid       uint64
+	queryTime time.Time
+}
+
+// randMaxValue is the modulus of MySQL's pseudo-random number generator
+const randMaxValue = 0x3FFFFFFF
+
+// seededRand is MySQL's pseudo-random number generator for RAND(N).
+type seededRand struct {
+	seed1, seed2 uint64
+}
+
+func newSeededRand(seed uint32) *seededRand {
+	return &seededRand{
+		seed1: uint64(seed*0x10001+55555555) % randMaxValue,
+		seed2: uint64(seed*0x10000001) % randMaxValue,
+	}
+}
+
+// next returns the next number of the generator's sequence.
+func (r *seededRand) next() float64 {
+	r.seed1 = (r.seed1*3 + r.seed2) % randMaxValue
+	r.seed2 = (r.seed1 + r.seed2 + 33) % randMaxValue
+	return float64(r.seed1) / float64(randMaxValue)
 }
 
 var _ sql.Expression = (*Rand)(nil)
@@ -75,7 +113,20 @@ func (*Rand) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID,
 
 // IsNonDeterministic implements sql.NonDeterministicExpression
 func (r *Rand) IsNonDeterministic() bool {
-	return r.Child == nil
+	return r.Child == nil || isConstantSeed(r.Child)
+}
+
+// isConstantSeed returns whether the seed given has the same value for every row of a statement.
+func isConstantSeed(seed sql.Expression) bool {
+	return !transform.InspectExpr(seed, func(e sql.Expression) bool {
+		switch e := e.(type) {
+		case *expression.GetField:
+			return true
+		case sql.NonDeterministicExpression:
+			return e.IsNonDeterministic()
+		}
+		return false
+	})
 }
 
 // IsNullable implements sql.Expression
@@ -121,23 +172,46 @@ func (r *Rand) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return rand.Float64(), nil
 	}
 
-	// For child expressions, the mysql semantics are to seed the PRNG with an int64 value of the expression given. For
-	// non-numeric types, the seed will always be 0, which means that rand() will always return the same result for all
-	// non-numeric seed arguments.
-	e, err := r.Child.Eval(ctx, row)
-	if err != nil {
-		return nil, err
+	if !isConstantSeed(r.Child) {
+		seed, err := r.seed(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		return newSeededRand(seed).next(), nil
 	}
 
-	var seed int64
-	if types.IsNumber(r.Child.Type()) {
-		e, _, err = types.Int64.Convert(e)
-		if err == nil {
-			seed = e.(int64)
+	var statement randStatement
+	if ctx != nil {
+		statement = randStatement{pid: ctx.Pid(), queryTime: ctx.QueryTime()}
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.seeded == nil || r.statement != statement {
+		seed, err := r.seed(ctx, row)
+		if err != nil {
+			return nil, err
 		}
+		r.seeded = newSeededRand(seed)
+		r.statement = statement
 	}
+	return r.seeded.next(), nil
+}
 
-	return rand.New(rand.NewSource(seed)).Float64(), nil
+// seed returns the seed of the generator, which is the integer value of the argument truncated to 32 bits, or zero
+// for NULL and values that aren't numbers.
+func (r *Rand) seed(ctx *sql.Context, row sql.Row) (uint32, error) {
+	e, err := r.Child.Eval(ctx, row)
+	if err != nil {
+		return 0, err
+	}
+	if e == nil {
+		return 0, nil
+	}
+	e, _, err = types.Int64.Convert(e)
+	if err != nil {
+		return 0, nil
+	}
+	return uint32(e.(int64)), nil
 }
 
 // Sin is the SIN function
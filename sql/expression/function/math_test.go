@@ -15,6 +15,7 @@
 package function
 
 import (
+	"context"
 	"math"
 	"testing"
 	"time"
@@ -51,39 +52,48 @@ func TestRand(t *testing.T) {
 }
 
 func TestRandWithSeed(t *testing.T) {
-	r, _ := NewRand(expression.NewLiteral(10, types.Int8))
+	r, _ := NewRand(expression.NewLiteral(3, types.Int8))
 
 	assert.Equal(t, types.Float64, r.Type())
-	assert.Equal(t, "rand(10)", r.String())
-
-	f, err := r.Eval(nil, nil)
-	require.NoError(t, err)
-	f64 := f.(float64)
-
-	assert.GreaterOrEqual(t, f64, float64(0))
-	assert.Less(t, f64, float64(1))
+	assert.Equal(t, "rand(3)", r.String())
+
+	// A constant seed returns MySQL's sequence for the seed over the rows of a statement
+	ctx := sql.NewEmptyContext()
+	for _, expected := range []float64{0.9057697559760601, 0.37307905813034536, 0.14808605345719125} {
+		f, err := r.Eval(ctx, nil)
+		require.NoError(t, err)
+		assert.Equal(t, expected, f)
+	}
 
-	f, err = r.Eval(nil, nil)
+	// and starts over for the next statement
+	ctx = sql.NewContext(context.Background(), sql.WithPid(ctx.Pid()+1))
+	f, err := r.Eval(ctx, nil)
 	require.NoError(t, err)
-	f642 := f.(float64)
+	assert.Equal(t, 0.9057697559760601, f)
 
-	assert.Equal(t, f64, f642)
+	// A seed that differs between rows seeds the generator for each row
+	r, _ = NewRand(expression.NewGetField(0, types.Int64, "i", false))
+	for _, tt := range []struct {
+		seed     interface{}
+		expected float64
+	}{
+		{int64(3), 0.9057697559760601},
+		{int64(3), 0.9057697559760601},
+		{int64(20), 0.15888261251047497},
+		{int64(0), 0.15522042769493574},
+		{nil, 0.15522042769493574},
+	} {
+		f, err := r.Eval(ctx, sql.Row{tt.seed})
+		require.NoError(t, err)
+		assert.Equal(t, tt.expected, f)
+	}
 
 	r, _ = NewRand(expression.NewLiteral("not a number", types.LongText))
 	assert.Equal(t, `rand('not a number')`, r.String())
 
 	f, err = r.Eval(nil, nil)
 	require.NoError(t, err)
-	f64 = f.(float64)
-
-	assert.GreaterOrEqual(t, f64, float64(0))
-	assert.Less(t, f64, float64(1))
-
-	f, err = r.Eval(nil, nil)
-	require.NoError(t, err)
-	f642 = f.(float64)
-
-	assert.Equal(t, f64, f642)
+	assert.Equal(t, 0.15522042769493574, f)
 }
 
 func TestRadians(t *testing.T) {
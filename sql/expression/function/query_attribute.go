@@ -0,0 +1,99 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// QueryAttributeString is the MYSQL_QUERY_ATTRIBUTE_STRING function, which returns the value of a query attribute
+// sent by the client with the query.
+// https://dev.mysql.com/doc/refman/8.0/en/query-attributes.html
+type QueryAttributeString struct {
+	expression.UnaryExpression
+}
+
+var _ sql.FunctionExpression = (*QueryAttributeString)(nil)
+var _ sql.NonDeterministicExpression = (*QueryAttributeString)(nil)
+var _ sql.CollationCoercible = (*QueryAttributeString)(nil)
+
+// NewQueryAttributeString creates a new QueryAttributeString expression.
+func NewQueryAttributeString(name sql.Expression) sql.Expression {
+	return &QueryAttributeString{expression.UnaryExpression{Child: name}}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (q *QueryAttributeString) FunctionName() string {
+	return "mysql_query_attribute_string"
+}
+
+// Description implements sql.FunctionExpression
+func (q *QueryAttributeString) Description() string {
+	return "returns the value of the query attribute with the given name, or NULL if there is none."
+}
+
+// IsNonDeterministic implements sql.NonDeterministicExpression. The attributes are sent with each query, so the
+// function can't be evaluated ahead of time.
+func (q *QueryAttributeString) IsNonDeterministic() bool {
+	return true
+}
+
+// Type implements the Expression interface.
+func (q *QueryAttributeString) Type() sql.Type {
+	return types.LongText
+}
+
+// IsNullable implements the Expression interface.
+func (q *QueryAttributeString) IsNullable() bool {
+	return true
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*QueryAttributeString) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return ctx.GetCollation(), 4
+}
+
+// Eval implements the Expression interface.
+func (q *QueryAttributeString) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	name, err := q.Child.Eval(ctx, row)
+	if err != nil || name == nil {
+		return nil, err
+	}
+	name, _, err = types.LongText.Convert(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if v, ok := ctx.QueryAttribute(name.(string)); ok {
+		return v, nil
+	}
+	return nil, nil
+}
+
+func (q *QueryAttributeString) String() string {
+	return fmt.Sprintf("%s(%s)", q.FunctionName(), q.Child)
+}
+
+// WithChildren implements the Expression interface.
+func (q *QueryAttributeString) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(q, len(children), 1)
+	}
+	return NewQueryAttributeString(children[0]), nil
+}
@@ -39,6 +39,7 @@ var BuiltIns = []sql.Function{
 	sql.Function1{Name: "asin", Fn: NewAsin},
 	sql.Function1{Name: "atan", Fn: NewAtan},
 	sql.Function1{Name: "avg", Fn: func(e sql.Expression) sql.Expression { return aggregation.NewAvg(e) }},
+	sql.Function2{Name: "benchmark", Fn: NewBenchmark},
 	sql.Function1{Name: "bin", Fn: NewBin},
 	sql.FunctionN{Name: "bin_to_uuid", Fn: NewBinToUUID},
 	sql.Function1{Name: "bit_and", Fn: func(e sql.Expression) sql.Expression { return aggregation.NewBitAnd(e) }},
@@ -86,7 +87,11 @@ var BuiltIns = []sql.Function{
 	sql.Function1{Name: "floor", Fn: NewFloor},
 	sql.Function0{Name: "found_rows", Fn: NewFoundRows},
 	sql.FunctionN{Name: "format", Fn: NewFormat},
+	sql.Function1{Name: "format_bytes", Fn: NewFormatBytes},
+	sql.Function1{Name: "format_pico_time", Fn: NewFormatPicoTime},
+	sql.Function1{Name: "format_time", Fn: NewFormatTime},
 	sql.Function1{Name: "from_base64", Fn: NewFromBase64},
+	sql.Function1{Name: "from_days", Fn: NewFromDays},
 	sql.Function1{Name: "from_unixtime", Fn: NewFromUnixtime},
 	sql.FunctionN{Name: "greatest", Fn: NewGreatest},
 	sql.Function0{Name: "group_concat", Fn: aggregation.NewEmptyGroupConcat},
@@ -99,6 +104,7 @@ var BuiltIns = []sql.Function{
 	sql.Function1{Name: "inet6_aton", Fn: NewInet6Aton},
 	sql.Function1{Name: "inet6_ntoa", Fn: NewInet6Ntoa},
 	sql.Function2{Name: "instr", Fn: NewInstr},
+	sql.FunctionN{Name: "interval", Fn: NewInterval},
 	sql.Function1{Name: "is_binary", Fn: NewIsBinary},
 	sql.Function1{Name: "is_ipv4", Fn: NewIsIPv4},
 	sql.Function1{Name: "is_ipv4_compat", Fn: NewIsIPv4Compat},
@@ -163,6 +169,7 @@ var BuiltIns = []sql.Function{
 	sql.FunctionN{Name: "mod", Fn: NewMod},
 	sql.Function1{Name: "month", Fn: NewMonth},
 	sql.Function1{Name: "monthname", Fn: NewMonthName},
+	sql.Function1{Name: "mysql_query_attribute_string", Fn: NewQueryAttributeString},
 	sql.FunctionN{Name: "now", Fn: NewNow},
 	sql.Function2{Name: "nullif", Fn: NewNullIf},
 	sql.Function2{Name: "pow", Fn: NewPower},
@@ -187,6 +194,7 @@ var BuiltIns = []sql.Function{
 	sql.Function1{Name: "rtrim", Fn: NewRightTrim},
 	sql.Function0{Name: "schema", Fn: NewDatabase},
 	sql.Function1{Name: "second", Fn: NewSecond},
+	sql.Function1{Name: "sec_to_time", Fn: NewSecToTime},
 	sql.Function1{Name: "sha", Fn: NewSHA1},
 	sql.Function1{Name: "sha1", Fn: NewSHA1},
 	sql.Function2{Name: "sha2", Fn: NewSHA2},
@@ -214,6 +222,7 @@ var BuiltIns = []sql.Function{
 	sql.Function1{Name: "st_dimension", Fn: spatial.NewDimension},
 	sql.Function2{Name: "st_equal", Fn: spatial.NewSTEquals},
 	sql.Function1{Name: "st_endpoint", Fn: spatial.NewEndPoint},
+	sql.Function1{Name: "st_exteriorring", Fn: spatial.NewExteriorRing},
 	sql.FunctionN{Name: "st_geomcollfromtext", Fn: spatial.NewGeomCollFromText},
 	sql.FunctionN{Name: "st_geomcollfromtxt", Fn: spatial.NewGeomCollFromText},
 	sql.FunctionN{Name: "st_geomcollfromwkb", Fn: spatial.NewGeomCollFromWKB},
@@ -224,6 +233,7 @@ var BuiltIns = []sql.Function{
 	sql.FunctionN{Name: "st_geomfromtext", Fn: spatial.NewGeomFromText},
 	sql.FunctionN{Name: "st_geometryfromwkb", Fn: spatial.NewGeomFromWKB},
 	sql.FunctionN{Name: "st_geomfromwkb", Fn: spatial.NewGeomFromWKB},
+	sql.Function2{Name: "st_interiorringn", Fn: spatial.NewInteriorRingN},
 	sql.Function1{Name: "st_isclosed", Fn: spatial.NewIsClosed},
 	sql.Function2{Name: "st_intersects", Fn: spatial.NewIntersects},
 	sql.FunctionN{Name: "st_length", Fn: spatial.NewSTLength},
@@ -244,7 +254,11 @@ var BuiltIns = []sql.Function{
 	sql.FunctionN{Name: "st_mpolyfromtext", Fn: spatial.NewMPolyFromText},
 	sql.FunctionN{Name: "st_multipolygonfromwkb", Fn: spatial.NewMPolyFromWKB},
 	sql.FunctionN{Name: "st_multipolygonfromtext", Fn: spatial.NewMPolyFromText},
+	sql.Function1{Name: "st_numinteriorring", Fn: spatial.NewNumInteriorRings},
+	sql.Function1{Name: "st_numinteriorrings", Fn: spatial.NewNumInteriorRings},
+	sql.Function1{Name: "st_numpoints", Fn: spatial.NewNumPoints},
 	sql.FunctionN{Name: "st_perimeter", Fn: spatial.NewPerimeter},
+	sql.Function2{Name: "st_pointn", Fn: spatial.NewPointN},
 	sql.FunctionN{Name: "st_pointfromtext", Fn: spatial.NewPointFromText},
 	sql.FunctionN{Name: "st_pointfromwkb", Fn: spatial.NewPointFromWKB},
 	sql.FunctionN{Name: "st_polyfromtext", Fn: spatial.NewPolyFromText},
@@ -270,6 +284,7 @@ var BuiltIns = []sql.Function{
 	sql.FunctionN{Name: "timestamp", Fn: NewTimestamp},
 	sql.Function3{Name: "timestampdiff", Fn: NewTimestampDiff},
 	sql.Function1{Name: "to_base64", Fn: NewToBase64},
+	sql.Function1{Name: "to_days", Fn: NewToDays},
 	sql.Function1{Name: "ucase", Fn: NewUpper},
 	sql.Function1{Name: "unhex", Fn: NewUnhex},
 	sql.FunctionN{Name: "unix_timestamp", Fn: NewUnixTimestamp},
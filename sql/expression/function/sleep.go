@@ -15,7 +15,6 @@
 package function
 
 import (
-	"context"
 	"fmt"
 	"time"
 
@@ -25,7 +24,7 @@ import (
 )
 
 // Sleep is a function that just waits for the specified number of seconds
-// and returns 0.
+// and returns 0, or returns 1 as soon as the query is killed or times out.
 // It can be useful to test timeouts or long queries.
 type Sleep struct {
 	expression.UnaryExpression
@@ -69,9 +68,10 @@ func (s *Sleep) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	t := time.NewTimer(time.Duration(child.(float64)*1000) * time.Millisecond)
 	defer t.Stop()
 
+	// As in MySQL, an interrupted SLEEP returns 1 instead of failing
 	select {
 	case <-ctx.Done():
-		return 0, context.Canceled
+		return 1, nil
 	case <-t.C:
 		return 0, nil
 	}
@@ -15,6 +15,7 @@
 package function
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -64,3 +65,18 @@ func TestSleep(t *testing.T) {
 		})
 	}
 }
+
+func TestSleepCanceled(t *testing.T) {
+	require := require.New(t)
+	f := NewSleep(expression.NewLiteral(10, types.Int64))
+
+	cancelCtx, cancel := context.WithCancel(context.Background())
+	ctx := sql.NewContext(cancelCtx)
+	time.AfterFunc(100*time.Millisecond, cancel)
+
+	t1 := time.Now()
+	v, err := f.Eval(ctx, nil)
+	require.NoError(err)
+	require.Equal(1, v)
+	require.Less(time.Since(t1).Seconds(), 1.0)
+}
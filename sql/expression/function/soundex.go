@@ -27,7 +27,8 @@ import (
 // Soundex is a function that returns the soundex of a string. Two strings that
 // sound almost the same should have identical soundex strings. A standard
 // soundex string is four characters long, but the SOUNDEX() function returns
-// an arbitrarily long string.
+// an arbitrarily long string. Like MySQL, it implements the original soundex
+// algorithm, which discards vowels before collapsing adjacent duplicate codes.
 type Soundex struct {
 	expression.UnaryExpression
 }
@@ -84,8 +85,9 @@ func (s *Soundex) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		b.WriteRune(code)
 		last = code
 	}
+	// MySQL returns an empty string for strings without letters
 	if b.Len() == 0 {
-		return "0000", nil
+		return "", nil
 	}
 	for i := len([]rune(b.String())); i < 4; i++ {
 		b.WriteRune('0')
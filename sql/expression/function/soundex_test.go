@@ -32,8 +32,8 @@ func TestSoundex(t *testing.T) {
 		expected interface{}
 	}{
 		{"text nil", types.LongText, sql.NewRow(nil), nil},
-		{"text empty", types.LongText, sql.NewRow(""), "0000"},
-		{"text ignored character", types.LongText, sql.NewRow("-"), "0000"},
+		{"text empty", types.LongText, sql.NewRow(""), ""},
+		{"text ignored character", types.LongText, sql.NewRow("-"), ""},
 		{"text runes", types.LongText, sql.NewRow("日本語"), "日000"},
 		{"text Hello ok", types.LongText, sql.NewRow("Hello"), "H400"},
 		{"text Quadratically ok", types.LongText, sql.NewRow("Quadratically"), "Q36324"},
@@ -47,9 +47,12 @@ func TestSoundex(t *testing.T) {
 		{"text Chesley ok", types.LongText, sql.NewRow("Chesley"), "C400"},
 		{"text Tachenion ok", types.LongText, sql.NewRow("Tachenion"), "T250"},
 		{"text Wilcox ok", types.LongText, sql.NewRow("Wilcox"), "W420"},
+		{"text Tymczak vowels dropped before duplicates", types.LongText, sql.NewRow("Tymczak"), "T520"},
+		{"text Ashcraft", types.LongText, sql.NewRow("Ashcraft"), "A2613"},
+		{"text leading and inner non-letters skipped", types.LongText, sql.NewRow("  Rob-ert 2"), "R163"},
 		{"binary ok", types.LongText, sql.NewRow([]byte("Harvey")), "H610"},
-		{"string one", types.LongText, sql.NewRow("1"), "0000"},
-		{"other type", types.LongText, sql.NewRow(int32(1)), "0000"},
+		{"string one", types.LongText, sql.NewRow("1"), ""},
+		{"other type", types.LongText, sql.NewRow(int32(1)), ""},
 	}
 
 	for _, tt := range testCases {
@@ -166,6 +166,157 @@ func (e *EndPoint) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	return endPoint(l), nil
 }
 
+// NumPoints is a function that returns the number of points of a LineString
+type NumPoints struct {
+	expression.UnaryExpression
+}
+
+var _ sql.FunctionExpression = (*NumPoints)(nil)
+var _ sql.CollationCoercible = (*NumPoints)(nil)
+
+// NewNumPoints creates a new NumPoints expression.
+func NewNumPoints(arg sql.Expression) sql.Expression {
+	return &NumPoints{expression.UnaryExpression{Child: arg}}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (n *NumPoints) FunctionName() string {
+	return "st_numpoints"
+}
+
+// Description implements sql.FunctionExpression
+func (n *NumPoints) Description() string {
+	return "returns the number of points of a linestring."
+}
+
+// Type implements the sql.Expression interface.
+func (n *NumPoints) Type() sql.Type {
+	return types.Int64
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*NumPoints) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 5
+}
+
+func (n *NumPoints) String() string {
+	return fmt.Sprintf("%s(%s)", n.FunctionName(), n.Child.String())
+}
+
+// WithChildren implements the Expression interface.
+func (n *NumPoints) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidArgumentNumber.New(n.FunctionName(), "1", len(children))
+	}
+	return NewNumPoints(children[0]), nil
+}
+
+// Eval implements the sql.Expression interface.
+func (n *NumPoints) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	g, err := n.Child.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+
+	if g == nil {
+		return nil, nil
+	}
+
+	if _, ok := g.(types.GeometryValue); !ok {
+		return nil, sql.ErrInvalidGISData.New(n.FunctionName())
+	}
+
+	l, ok := g.(types.LineString)
+	if !ok {
+		return nil, nil
+	}
+
+	return int64(len(l.Points)), nil
+}
+
+// PointN is a function that returns the N-th point of a LineString, counting from 1
+type PointN struct {
+	expression.BinaryExpression
+}
+
+var _ sql.FunctionExpression = (*PointN)(nil)
+var _ sql.CollationCoercible = (*PointN)(nil)
+
+// NewPointN creates a new PointN expression.
+func NewPointN(arg, n sql.Expression) sql.Expression {
+	return &PointN{expression.BinaryExpression{Left: arg, Right: n}}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (p *PointN) FunctionName() string {
+	return "st_pointn"
+}
+
+// Description implements sql.FunctionExpression
+func (p *PointN) Description() string {
+	return "returns the n-th point of a linestring."
+}
+
+// Type implements the sql.Expression interface.
+func (p *PointN) Type() sql.Type {
+	return types.PointType{}
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*PointN) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 4
+}
+
+func (p *PointN) String() string {
+	return fmt.Sprintf("%s(%s,%s)", p.FunctionName(), p.Left.String(), p.Right.String())
+}
+
+// WithChildren implements the Expression interface.
+func (p *PointN) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidArgumentNumber.New(p.FunctionName(), "2", len(children))
+	}
+	return NewPointN(children[0], children[1]), nil
+}
+
+// Eval implements the sql.Expression interface.
+func (p *PointN) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	g, err := p.Left.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := p.Right.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+
+	if g == nil || n == nil {
+		return nil, nil
+	}
+
+	if _, ok := g.(types.GeometryValue); !ok {
+		return nil, sql.ErrInvalidGISData.New(p.FunctionName())
+	}
+
+	l, ok := g.(types.LineString)
+	if !ok {
+		return nil, nil
+	}
+
+	n, _, err = types.Int64.Convert(n)
+	if err != nil {
+		return nil, err
+	}
+
+	// Points out of range are NULL, as in MySQL
+	i := n.(int64)
+	if i < 1 || i > int64(len(l.Points)) {
+		return nil, nil
+	}
+	return l.Points[i-1], nil
+}
+
 // IsClosed is a function that checks if a LineString or MultiLineString is close
 type IsClosed struct {
 	expression.UnaryExpression
@@ -118,6 +118,72 @@ func TestEndPoint(t *testing.T) {
 	})
 }
 
+func TestNumPoints(t *testing.T) {
+	tests := []struct {
+		name     string
+		arg      sql.Expression
+		expected interface{}
+	}{
+		{"two points", expression.NewLiteral(types.LineString{Points: []types.Point{{X: 1, Y: 2}, {X: 3, Y: 4}}}, types.LineStringType{}), int64(2)},
+		{"closed", expression.NewLiteral(types.LineString{Points: []types.Point{{X: 0, Y: 0}, {X: 1, Y: 1}, {X: 1, Y: 0}, {X: 0, Y: 0}}}, types.LineStringType{}), int64(4)},
+		{"null", expression.NewLiteral(nil, types.Null), nil},
+		{"non-linestring", expression.NewLiteral(types.Point{X: 1, Y: 2}, types.PointType{}), nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			v, err := NewNumPoints(tt.arg).Eval(sql.NewEmptyContext(), nil)
+			require.NoError(err)
+			require.Equal(tt.expected, v)
+		})
+	}
+
+	t.Run("non-geometry argument", func(t *testing.T) {
+		require := require.New(t)
+		_, err := NewNumPoints(expression.NewLiteral(123, types.Int8)).Eval(sql.NewEmptyContext(), nil)
+		require.Error(err)
+	})
+}
+
+func TestPointN(t *testing.T) {
+	s := types.Point{SRID: types.GeoSpatialSRID, X: 1, Y: 2}
+	m := types.Point{SRID: types.GeoSpatialSRID, X: 3, Y: 4}
+	e := types.Point{SRID: types.GeoSpatialSRID, X: 5, Y: 6}
+	l := types.LineString{SRID: types.GeoSpatialSRID, Points: []types.Point{s, m, e}}
+
+	tests := []struct {
+		name     string
+		geom     sql.Expression
+		n        sql.Expression
+		expected interface{}
+	}{
+		{"first point", expression.NewLiteral(l, types.LineStringType{}), expression.NewLiteral(1, types.Int32), s},
+		{"middle point", expression.NewLiteral(l, types.LineStringType{}), expression.NewLiteral(2, types.Int32), m},
+		{"last point", expression.NewLiteral(l, types.LineStringType{}), expression.NewLiteral("3", types.LongText), e},
+		{"zero", expression.NewLiteral(l, types.LineStringType{}), expression.NewLiteral(0, types.Int32), nil},
+		{"negative", expression.NewLiteral(l, types.LineStringType{}), expression.NewLiteral(-1, types.Int32), nil},
+		{"past the end", expression.NewLiteral(l, types.LineStringType{}), expression.NewLiteral(4, types.Int32), nil},
+		{"null linestring", expression.NewLiteral(nil, types.Null), expression.NewLiteral(1, types.Int32), nil},
+		{"null n", expression.NewLiteral(l, types.LineStringType{}), expression.NewLiteral(nil, types.Null), nil},
+		{"non-linestring argument", expression.NewLiteral(s, types.PointType{}), expression.NewLiteral(1, types.Int32), nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			v, err := NewPointN(tt.geom, tt.n).Eval(sql.NewEmptyContext(), nil)
+			require.NoError(err)
+			require.Equal(tt.expected, v)
+		})
+	}
+
+	t.Run("non-geometry argument", func(t *testing.T) {
+		require := require.New(t)
+		f := NewPointN(expression.NewLiteral(123, types.Int8), expression.NewLiteral(1, types.Int32))
+		_, err := f.Eval(sql.NewEmptyContext(), nil)
+		require.Error(err)
+	})
+}
+
 func TestIsClosed(t *testing.T) {
 	t.Run("simple case is closed", func(t *testing.T) {
 		require := require.New(t)
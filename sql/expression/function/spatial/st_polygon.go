@@ -0,0 +1,225 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spatial
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// evalPolygon evaluates the expression given, returning whether it's a polygon, or an error if it's not a geometry.
+// NULL and geometries other than polygons aren't polygons.
+func evalPolygon(ctx *sql.Context, row sql.Row, e sql.Expression, funcName string) (types.Polygon, bool, error) {
+	g, err := e.Eval(ctx, row)
+	if err != nil {
+		return types.Polygon{}, false, err
+	}
+
+	if g == nil {
+		return types.Polygon{}, false, nil
+	}
+
+	if _, ok := g.(types.GeometryValue); !ok {
+		return types.Polygon{}, false, sql.ErrInvalidGISData.New(funcName)
+	}
+
+	p, ok := g.(types.Polygon)
+	return p, ok, nil
+}
+
+// ExteriorRing is a function that returns the exterior ring of a Polygon
+type ExteriorRing struct {
+	expression.UnaryExpression
+}
+
+var _ sql.FunctionExpression = (*ExteriorRing)(nil)
+var _ sql.CollationCoercible = (*ExteriorRing)(nil)
+
+// NewExteriorRing creates a new ExteriorRing expression.
+func NewExteriorRing(arg sql.Expression) sql.Expression {
+	return &ExteriorRing{expression.UnaryExpression{Child: arg}}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (e *ExteriorRing) FunctionName() string {
+	return "st_exteriorring"
+}
+
+// Description implements sql.FunctionExpression
+func (e *ExteriorRing) Description() string {
+	return "returns the exterior ring of a polygon as a linestring."
+}
+
+// Type implements the sql.Expression interface.
+func (e *ExteriorRing) Type() sql.Type {
+	return types.LineStringType{}
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*ExteriorRing) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 4
+}
+
+func (e *ExteriorRing) String() string {
+	return fmt.Sprintf("%s(%s)", e.FunctionName(), e.Child.String())
+}
+
+// WithChildren implements the Expression interface.
+func (e *ExteriorRing) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidArgumentNumber.New(e.FunctionName(), "1", len(children))
+	}
+	return NewExteriorRing(children[0]), nil
+}
+
+// Eval implements the sql.Expression interface.
+func (e *ExteriorRing) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	p, ok, err := evalPolygon(ctx, row, e.Child, e.FunctionName())
+	if err != nil || !ok || len(p.Lines) == 0 {
+		return nil, err
+	}
+	return p.Lines[0], nil
+}
+
+// NumInteriorRings is a function that returns the number of interior rings of a Polygon
+type NumInteriorRings struct {
+	expression.UnaryExpression
+}
+
+var _ sql.FunctionExpression = (*NumInteriorRings)(nil)
+var _ sql.CollationCoercible = (*NumInteriorRings)(nil)
+
+// NewNumInteriorRings creates a new NumInteriorRings expression.
+func NewNumInteriorRings(arg sql.Expression) sql.Expression {
+	return &NumInteriorRings{expression.UnaryExpression{Child: arg}}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (n *NumInteriorRings) FunctionName() string {
+	return "st_numinteriorrings"
+}
+
+// Description implements sql.FunctionExpression
+func (n *NumInteriorRings) Description() string {
+	return "returns the number of interior rings of a polygon."
+}
+
+// Type implements the sql.Expression interface.
+func (n *NumInteriorRings) Type() sql.Type {
+	return types.Int64
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*NumInteriorRings) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 5
+}
+
+func (n *NumInteriorRings) String() string {
+	return fmt.Sprintf("%s(%s)", n.FunctionName(), n.Child.String())
+}
+
+// WithChildren implements the Expression interface.
+func (n *NumInteriorRings) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidArgumentNumber.New(n.FunctionName(), "1", len(children))
+	}
+	return NewNumInteriorRings(children[0]), nil
+}
+
+// Eval implements the sql.Expression interface.
+func (n *NumInteriorRings) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	p, ok, err := evalPolygon(ctx, row, n.Child, n.FunctionName())
+	if err != nil || !ok || len(p.Lines) == 0 {
+		return nil, err
+	}
+	return int64(len(p.Lines) - 1), nil
+}
+
+// InteriorRingN is a function that returns the N-th interior ring of a Polygon, counting from 1
+type InteriorRingN struct {
+	expression.BinaryExpression
+}
+
+var _ sql.FunctionExpression = (*InteriorRingN)(nil)
+var _ sql.CollationCoercible = (*InteriorRingN)(nil)
+
+// NewInteriorRingN creates a new InteriorRingN expression.
+func NewInteriorRingN(arg, n sql.Expression) sql.Expression {
+	return &InteriorRingN{expression.BinaryExpression{Left: arg, Right: n}}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (i *InteriorRingN) FunctionName() string {
+	return "st_interiorringn"
+}
+
+// Description implements sql.FunctionExpression
+func (i *InteriorRingN) Description() string {
+	return "returns the n-th interior ring of a polygon as a linestring."
+}
+
+// Type implements the sql.Expression interface.
+func (i *InteriorRingN) Type() sql.Type {
+	return types.LineStringType{}
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*InteriorRingN) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 4
+}
+
+func (i *InteriorRingN) String() string {
+	return fmt.Sprintf("%s(%s,%s)", i.FunctionName(), i.Left.String(), i.Right.String())
+}
+
+// WithChildren implements the Expression interface.
+func (i *InteriorRingN) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidArgumentNumber.New(i.FunctionName(), "2", len(children))
+	}
+	return NewInteriorRingN(children[0], children[1]), nil
+}
+
+// Eval implements the sql.Expression interface.
+func (i *InteriorRingN) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	p, ok, err := evalPolygon(ctx, row, i.Left, i.FunctionName())
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := i.Right.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok || n == nil {
+		return nil, nil
+	}
+
+	n, _, err = types.Int64.Convert(n)
+	if err != nil {
+		return nil, err
+	}
+
+	// The exterior ring is the first line of the polygon, and rings out of range are NULL, as in MySQL
+	idx := n.(int64)
+	if idx < 1 || idx >= int64(len(p.Lines)) {
+		return nil, nil
+	}
+	return p.Lines[idx], nil
+}
@@ -0,0 +1,124 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spatial
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// testRing returns a closed linestring of the square with the side and origin given.
+func testRing(x, y, side float64) types.LineString {
+	return types.LineString{Points: []types.Point{{X: x, Y: y}, {X: x, Y: y + side}, {X: x + side, Y: y + side}, {X: x + side, Y: y}, {X: x, Y: y}}}
+}
+
+func TestExteriorRing(t *testing.T) {
+	exterior := testRing(0, 0, 10)
+	holes := types.Polygon{Lines: []types.LineString{exterior, testRing(1, 1, 2), testRing(5, 5, 2)}}
+
+	tests := []struct {
+		name     string
+		arg      sql.Expression
+		expected interface{}
+	}{
+		{"polygon", expression.NewLiteral(types.Polygon{Lines: []types.LineString{exterior}}, types.PolygonType{}), exterior},
+		{"polygon with interior rings", expression.NewLiteral(holes, types.PolygonType{}), exterior},
+		{"null", expression.NewLiteral(nil, types.Null), nil},
+		{"non-polygon", expression.NewLiteral(exterior, types.LineStringType{}), nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			v, err := NewExteriorRing(tt.arg).Eval(sql.NewEmptyContext(), nil)
+			require.NoError(err)
+			require.Equal(tt.expected, v)
+		})
+	}
+
+	t.Run("non-geometry argument", func(t *testing.T) {
+		require := require.New(t)
+		_, err := NewExteriorRing(expression.NewLiteral(123, types.Int8)).Eval(sql.NewEmptyContext(), nil)
+		require.Error(err)
+	})
+}
+
+func TestNumInteriorRings(t *testing.T) {
+	exterior := testRing(0, 0, 10)
+
+	tests := []struct {
+		name     string
+		arg      sql.Expression
+		expected interface{}
+	}{
+		{"no interior rings", expression.NewLiteral(types.Polygon{Lines: []types.LineString{exterior}}, types.PolygonType{}), int64(0)},
+		{"two interior rings", expression.NewLiteral(types.Polygon{Lines: []types.LineString{exterior, testRing(1, 1, 2), testRing(5, 5, 2)}}, types.PolygonType{}), int64(2)},
+		{"null", expression.NewLiteral(nil, types.Null), nil},
+		{"non-polygon", expression.NewLiteral(types.Point{X: 1, Y: 2}, types.PointType{}), nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			v, err := NewNumInteriorRings(tt.arg).Eval(sql.NewEmptyContext(), nil)
+			require.NoError(err)
+			require.Equal(tt.expected, v)
+		})
+	}
+
+	t.Run("non-geometry argument", func(t *testing.T) {
+		require := require.New(t)
+		_, err := NewNumInteriorRings(expression.NewLiteral("abc", types.LongText)).Eval(sql.NewEmptyContext(), nil)
+		require.Error(err)
+	})
+}
+
+func TestInteriorRingN(t *testing.T) {
+	exterior, first, second := testRing(0, 0, 10), testRing(1, 1, 2), testRing(5, 5, 2)
+	polygon := expression.NewLiteral(types.Polygon{Lines: []types.LineString{exterior, first, second}}, types.PolygonType{})
+
+	tests := []struct {
+		name     string
+		arg      sql.Expression
+		n        sql.Expression
+		expected interface{}
+	}{
+		{"first", polygon, expression.NewLiteral(1, types.Int32), first},
+		{"second", polygon, expression.NewLiteral("2", types.LongText), second},
+		{"zero", polygon, expression.NewLiteral(0, types.Int32), nil},
+		{"past the end", polygon, expression.NewLiteral(3, types.Int32), nil},
+		{"no interior rings", expression.NewLiteral(types.Polygon{Lines: []types.LineString{exterior}}, types.PolygonType{}), expression.NewLiteral(1, types.Int32), nil},
+		{"null polygon", expression.NewLiteral(nil, types.Null), expression.NewLiteral(1, types.Int32), nil},
+		{"null n", polygon, expression.NewLiteral(nil, types.Null), nil},
+		{"non-polygon", expression.NewLiteral(exterior, types.LineStringType{}), expression.NewLiteral(1, types.Int32), nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			v, err := NewInteriorRingN(tt.arg, tt.n).Eval(sql.NewEmptyContext(), nil)
+			require.NoError(err)
+			require.Equal(tt.expected, v)
+		})
+	}
+
+	t.Run("non-geometry argument", func(t *testing.T) {
+		require := require.New(t)
+		_, err := NewInteriorRingN(expression.NewLiteral(123, types.Int8), expression.NewLiteral(1, types.Int32)).Eval(sql.NewEmptyContext(), nil)
+		require.Error(err)
+	})
+}
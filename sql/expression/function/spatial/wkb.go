@@ -233,7 +233,8 @@ func EvalGeomFromWKB(ctx *sql.Context, row sql.Row, exprs []sql.Expression, expe
 		return nil, err
 	}
 
-	order := false
+	// Like ST_ASWKB, geographic coordinates are in latitude-longitude order unless specified otherwise
+	order := srid == types.GeoSpatialSRID
 	if len(exprs) == 3 {
 		o, err := exprs[2].Eval(ctx, row)
 		if err != nil {
@@ -562,41 +562,34 @@ func (i Instr) Children() []sql.Expression {
 
 // Eval implements the Expression interface.
 func (i Instr) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
-	str, err := i.str.Eval(ctx, row)
+	strVal, err := i.str.Eval(ctx, row)
 	if err != nil {
 		return nil, err
 	}
-
-	var text []rune
-	switch str := str.(type) {
-	case string:
-		text = []rune(str)
-	case []byte:
-		text = []rune(string(str))
-	case nil:
+	if strVal == nil {
 		return nil, nil
-	default:
-		return nil, sql.ErrInvalidType.New(reflect.TypeOf(str).String())
 	}
 
-	substr, err := i.substr.Eval(ctx, row)
+	str, ok := locateArgument(strVal)
+	if !ok {
+		return nil, sql.ErrInvalidType.New(reflect.TypeOf(strVal).String())
+	}
+
+	substrVal, err := i.substr.Eval(ctx, row)
 	if err != nil {
 		return nil, err
 	}
-
-	var subtext []rune
-	switch substr := substr.(type) {
-	case string:
-		subtext = []rune(substr)
-	case []byte:
-		subtext = []rune(string(subtext))
-	case nil:
+	if substrVal == nil {
 		return nil, nil
-	default:
-		return nil, sql.ErrInvalidType.New(reflect.TypeOf(str).String())
 	}
 
-	return findSubsequence(text, subtext) + 1, nil
+	substr, ok := locateArgument(substrVal)
+	if !ok {
+		return nil, sql.ErrInvalidType.New(reflect.TypeOf(substrVal).String())
+	}
+
+	caseSensitive := isBinaryArgument(i.str, strVal) || isBinaryArgument(i.substr, substrVal)
+	return int64(locateSubstring(str, substr, 1, caseSensitive)), nil
 }
 
 func findSubsequence(text []rune, subtext []rune) int64 {
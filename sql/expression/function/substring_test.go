@@ -130,6 +130,12 @@ func TestInstr(t *testing.T) {
 		{"non match", sql.NewRow("foo", "bar"), 0, false},
 		{"substr bigger than string", sql.NewRow("foo", "foobar"), 0, false},
 		{"multiple matches", sql.NewRow("bobobo", "bo"), 1, false},
+		{"case insensitive", sql.NewRow("FooBar", "bar"), 4, false},
+		{"binary is case sensitive", sql.NewRow([]byte("FooBar"), "bar"), 0, false},
+		{"binary match", sql.NewRow([]byte("foobar"), []byte("bar")), 4, false},
+		{"multibyte match", sql.NewRow("日本語テキスト", "テ"), 4, false},
+		{"multibyte after multibyte", sql.NewRow("façade", "ade"), 4, false},
+		{"empty substr", sql.NewRow("foo", ""), 1, false},
 		{"bad string", sql.NewRow(1, "hello"), 0, true},
 		{"bad substr", sql.NewRow("foo", 1), 0, true},
 	}
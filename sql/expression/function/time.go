@@ -16,9 +16,11 @@ package function
 
 import (
 	"fmt"
+	"math"
 	"strings"
 	"time"
 
+	"github.com/dolthub/vitess/go/mysql"
 	"gopkg.in/src-d/go-errors.v1"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -507,16 +509,15 @@ var _ sql.CollationCoercible = (*YearWeek)(nil)
 
 // NewYearWeek creates a new YearWeek UDF
 func NewYearWeek(args ...sql.Expression) (sql.Expression, error) {
-	if len(args) == 0 {
-		return nil, sql.ErrInvalidArgumentNumber.New("YEARWEEK", "1 or more", 0)
+	if len(args) == 0 || len(args) > 2 {
+		return nil, sql.ErrInvalidArgumentNumber.New("YEARWEEK", "1 or 2", len(args))
 	}
 
 	yw := &YearWeek{date: args[0]}
-	if len(args) > 1 && args[1].Resolved() && types.IsInteger(args[1].Type()) {
-		yw.mode = args[1]
-	} else if len(args) > 1 && expression.IsBindVar(args[1]) {
+	if len(args) > 1 {
 		yw.mode = args[1]
 	} else {
+		// Unlike WEEK, YEARWEEK isn't affected by default_week_format
 		yw.mode = expression.NewLiteral(0, types.Int64)
 	}
 
@@ -533,7 +534,7 @@ func (d *YearWeek) Description() string {
 	return "returns year and week for a date. The year in the result may be different from the year in the date argument for the first and the last week of the year."
 }
 
-func (d *YearWeek) String() string { return fmt.Sprintf("YEARWEEK(%s, %d)", d.date, d.mode) }
+func (d *YearWeek) String() string { return fmt.Sprintf("YEARWEEK(%s, %s)", d.date, d.mode) }
 
 // Type implements the Expression interface.
 func (d *YearWeek) Type() sql.Type { return types.Int32 }
@@ -546,35 +547,17 @@ func (*YearWeek) CollationCoercibility(ctx *sql.Context) (collation sql.Collatio
 // Eval implements the Expression interface.
 func (d *YearWeek) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	date, err := getDate(ctx, expression.UnaryExpression{Child: d.date}, row)
-	if err != nil {
+	if err != nil || date == nil {
 		return nil, err
 	}
-	yyyy, ok := year(date).(int32)
-	if !ok {
-		return nil, sql.ErrInvalidArgumentDetails.New("YEARWEEK", "invalid year")
-	}
-	mm, ok := month(date).(int32)
-	if !ok {
-		return nil, sql.ErrInvalidArgumentDetails.New("YEARWEEK", "invalid month")
-	}
-	dd, ok := day(date).(int32)
-	if !ok {
-		return nil, sql.ErrInvalidArgumentDetails.New("YEARWEEK", "invalid day")
-	}
 
-	mode := int64(0)
-	val, err := d.mode.Eval(ctx, row)
+	mode, err := evalWeekMode(ctx, d.mode, row)
 	if err != nil {
 		return nil, err
 	}
-	if val != nil {
-		if i64, _, err := types.Int64.Convert(val); err == nil {
-			if mode, ok = i64.(int64); ok {
-				mode %= 8 // mode in [0, 7]
-			}
-		}
-	}
-	yyyy, week := calcWeek(yyyy, mm, dd, weekMode(mode)|weekBehaviourYear)
+
+	t := date.(time.Time)
+	yyyy, week := calcWeek(int32(t.Year()), int32(t.Month()), int32(t.Day()), weekMode(mode)|weekBehaviourYear)
 
 	return (yyyy * 100) + week, nil
 }
@@ -597,9 +580,9 @@ func (*YearWeek) WithChildren(children ...sql.Expression) (sql.Expression, error
 	return NewYearWeek(children...)
 }
 
-// Week is a function that returns year and week for a date.
-// The year in the result may be different from the year in the date argument for the first and the last week of the year.
-// Details: https://dev.mysql.com/doc/refman/5.5/en/date-and-time-functions.html#function_yearweek
+// Week is a function that returns the week number of a date. When no mode is given, the mode is the value of the
+// default_week_format system variable.
+// Details: https://dev.mysql.com/doc/refman/8.0/en/date-and-time-functions.html#function_week
 type Week struct {
 	date sql.Expression
 	mode sql.Expression
@@ -610,15 +593,13 @@ var _ sql.CollationCoercible = (*Week)(nil)
 
 // NewWeek creates a new Week UDF
 func NewWeek(args ...sql.Expression) (sql.Expression, error) {
-	if len(args) == 0 {
-		return nil, sql.ErrInvalidArgumentNumber.New("YEARWEEK", "1 or more", 0)
+	if len(args) == 0 || len(args) > 2 {
+		return nil, sql.ErrInvalidArgumentNumber.New("WEEK", "1 or 2", len(args))
 	}
 
 	w := &Week{date: args[0]}
-	if len(args) > 1 && args[1].Resolved() && types.IsInteger(args[1].Type()) {
+	if len(args) > 1 {
 		w.mode = args[1]
-	} else {
-		w.mode = expression.NewLiteral(0, types.Int64)
 	}
 
 	return w, nil
@@ -634,7 +615,12 @@ func (d *Week) Description() string {
 	return "returns the week number."
 }
 
-func (d *Week) String() string { return fmt.Sprintf("WEEK(%s, %d)", d.date, d.mode) }
+func (d *Week) String() string {
+	if d.mode == nil {
+		return fmt.Sprintf("WEEK(%s)", d.date)
+	}
+	return fmt.Sprintf("WEEK(%s, %s)", d.date, d.mode)
+}
 
 // Type implements the Expression interface.
 func (d *Week) Type() sql.Type { return types.Int32 }
@@ -647,54 +633,38 @@ func (*Week) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID,
 // Eval implements the Expression interface.
 func (d *Week) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	date, err := getDate(ctx, expression.UnaryExpression{Child: d.date}, row)
-	if err != nil {
+	if err != nil || date == nil {
 		return nil, err
 	}
 
-	yyyy, ok := year(date).(int32)
-	if !ok {
-		return nil, sql.ErrInvalidArgumentDetails.New("WEEK", "invalid year")
-	}
-	mm, ok := month(date).(int32)
-	if !ok {
-		return nil, sql.ErrInvalidArgumentDetails.New("WEEK", "invalid month")
-	}
-	dd, ok := day(date).(int32)
-	if !ok {
-		return nil, sql.ErrInvalidArgumentDetails.New("WEEK", "invalid day")
+	var mode int64
+	if d.mode != nil {
+		mode, err = evalWeekMode(ctx, d.mode, row)
+	} else {
+		mode, err = defaultWeekFormat(ctx)
 	}
-
-	mode := int64(0)
-	val, err := d.mode.Eval(ctx, row)
 	if err != nil {
 		return nil, err
 	}
-	if val != nil {
-		if i64, _, err := types.Int64.Convert(val); err == nil {
-			if mode, ok = i64.(int64); ok {
-				mode %= 8 // mode in [0, 7]
-			}
-		}
-	}
 
-	yearForWeek, week := calcWeek(yyyy, mm, dd, weekMode(mode)|weekBehaviourYear)
-
-	if yearForWeek < yyyy {
-		week = 0
-	} else if yearForWeek > yyyy {
-		week = 53
-	}
+	t := date.(time.Time)
+	_, week := calcWeek(int32(t.Year()), int32(t.Month()), int32(t.Day()), weekMode(mode))
 
 	return week, nil
 }
 
 // Resolved implements the Expression interface.
 func (d *Week) Resolved() bool {
-	return d.date.Resolved() && d.mode.Resolved()
+	return d.date.Resolved() && (d.mode == nil || d.mode.Resolved())
 }
 
 // Children implements the Expression interface.
-func (d *Week) Children() []sql.Expression { return []sql.Expression{d.date, d.mode} }
+func (d *Week) Children() []sql.Expression {
+	if d.mode == nil {
+		return []sql.Expression{d.date}
+	}
+	return []sql.Expression{d.date, d.mode}
+}
 
 // IsNullable implements the Expression interface.
 func (d *Week) IsNullable() bool {
@@ -706,6 +676,33 @@ func (*Week) WithChildren(children ...sql.Expression) (sql.Expression, error) {
 	return NewWeek(children...)
 }
 
+// evalWeekMode evaluates the mode argument of WEEK or YEARWEEK. Like MySQL, a NULL or non-numeric mode is mode 0.
+func evalWeekMode(ctx *sql.Context, mode sql.Expression, row sql.Row) (int64, error) {
+	val, err := mode.Eval(ctx, row)
+	if err != nil || val == nil {
+		return 0, err
+	}
+	i64, _, err := types.Int64.Convert(val)
+	if err != nil {
+		return 0, nil
+	}
+	return i64.(int64), nil
+}
+
+// defaultWeekFormat returns the value of the default_week_format system variable, which is the mode of WEEK and
+// EXTRACT(WEEK ...) when none is given.
+func defaultWeekFormat(ctx *sql.Context) (int64, error) {
+	val, err := ctx.GetSessionVariable(ctx, "default_week_format")
+	if err != nil {
+		return 0, err
+	}
+	i64, _, err := types.Int64.Convert(val)
+	if err != nil {
+		return 0, err
+	}
+	return i64.(int64), nil
+}
+
 // Following solution of YearWeek was taken from tidb: https://github.com/pingcap/tidb/blob/master/types/mytime.go
 type weekBehaviour int64
 
@@ -1160,7 +1157,7 @@ func (*DayName) CollationCoercibility(ctx *sql.Context) (collation sql.Collation
 
 func (d *DayName) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	val, err := d.EvalChild(ctx, row)
-	if err != nil {
+	if err != nil || val == nil {
 		return nil, err
 	}
 
@@ -1199,7 +1196,7 @@ func NewMicrosecond(arg sql.Expression) sql.Expression {
 
 func (m *Microsecond) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	val, err := m.EvalChild(ctx, row)
-	if err != nil {
+	if err != nil || val == nil {
 		return nil, err
 	}
 
@@ -1238,7 +1235,7 @@ func (*MonthName) CollationCoercibility(ctx *sql.Context) (collation sql.Collati
 
 func (d *MonthName) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	val, err := d.EvalChild(ctx, row)
-	if err != nil {
+	if err != nil || val == nil {
 		return nil, err
 	}
 
@@ -1262,7 +1259,7 @@ var _ sql.FunctionExpression = (*TimeToSec)(nil)
 var _ sql.CollationCoercible = (*TimeToSec)(nil)
 
 func NewTimeToSec(arg sql.Expression) sql.Expression {
-	return &TimeToSec{NewUnaryDatetimeFunc(arg, "TIME_TO_SEC", types.Uint64)}
+	return &TimeToSec{NewUnaryDatetimeFunc(arg, "TIME_TO_SEC", types.Int64)}
 }
 
 // Description implements sql.FunctionExpression
@@ -1270,19 +1267,43 @@ func (m *TimeToSec) Description() string {
 	return "returns the argument converted to seconds."
 }
 
+// Type implements the Expression interface. Times and strings may have fractional seconds, which are kept in the
+// result.
+func (m *TimeToSec) Type() sql.Type {
+	if childType := m.Child.Type(); types.IsTimespan(childType) || types.IsText(childType) {
+		return types.Float64
+	}
+	return types.Int64
+}
+
 // CollationCoercibility implements the interface sql.CollationCoercible.
 func (*TimeToSec) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
 	return sql.Collation_binary, 5
 }
 
 func (m *TimeToSec) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
-	val, err := m.EvalChild(ctx, row)
-	if err != nil {
+	val, err := m.Child.Eval(ctx, row)
+	if err != nil || val == nil {
 		return nil, err
 	}
 
-	t := val.(time.Time)
-	return uint64(t.Hour()*3600 + t.Minute()*60 + t.Second()), nil
+	ts, err := types.Time.ConvertToTimespan(val)
+	if err != nil {
+		// strings that aren't times may still be datetimes, whose time part is used
+		dt, _, dtErr := types.Datetime.Convert(val)
+		if dtErr != nil {
+			return nil, err
+		}
+		ts, err = types.Time.ConvertToTimespan(dt)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if types.IsFloat(m.Type()) {
+		return float64(ts.AsMicroseconds()) / float64(time.Second/time.Microsecond), nil
+	}
+	return ts.AsMicroseconds() / int64(time.Second/time.Microsecond), nil
 }
 
 func (m *TimeToSec) WithChildren(children ...sql.Expression) (sql.Expression, error) {
@@ -1292,6 +1313,49 @@ func (m *TimeToSec) WithChildren(children ...sql.Expression) (sql.Expression, er
 	return NewTimeToSec(children[0]), nil
 }
 
+// SecToTime implements the sec_to_time function
+type SecToTime struct {
+	*UnaryDatetimeFunc
+}
+
+var _ sql.FunctionExpression = (*SecToTime)(nil)
+var _ sql.CollationCoercible = (*SecToTime)(nil)
+
+func NewSecToTime(arg sql.Expression) sql.Expression {
+	return &SecToTime{NewUnaryDatetimeFunc(arg, "SEC_TO_TIME", types.Time)}
+}
+
+// Description implements sql.FunctionExpression
+func (m *SecToTime) Description() string {
+	return "converts seconds to 'hh:mm:ss' format."
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*SecToTime) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 5
+}
+
+func (m *SecToTime) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	val, err := m.Child.Eval(ctx, row)
+	if err != nil || val == nil {
+		return nil, err
+	}
+
+	secs, _, err := types.Float64.Convert(val)
+	if err != nil {
+		ctx.Warn(mysql.ERTruncatedWrongValue, "Truncated incorrect DOUBLE value: '%v'", val)
+	}
+	micros := math.Round(secs.(float64) * float64(time.Second/time.Microsecond))
+	return types.Time.MicrosecondsToTimespan(int64(micros)), nil
+}
+
+func (m *SecToTime) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(m, len(children), 1)
+	}
+	return NewSecToTime(children[0]), nil
+}
+
 // WeekOfYear implements the weekofyear function
 type WeekOfYear struct {
 	*UnaryDatetimeFunc
@@ -1316,7 +1380,7 @@ func (*WeekOfYear) CollationCoercibility(ctx *sql.Context) (collation sql.Collat
 
 func (m *WeekOfYear) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	val, err := m.EvalChild(ctx, row)
-	if err != nil {
+	if err != nil || val == nil {
 		return nil, err
 	}
 
@@ -1332,6 +1396,163 @@ func (m *WeekOfYear) WithChildren(children ...sql.Expression) (sql.Expression, e
 	return NewWeekOfYear(children[0]), nil
 }
 
+// ToDays is a function that returns the number of days from year 0 to a date.
+type ToDays struct {
+	expression.UnaryExpression
+}
+
+var _ sql.FunctionExpression = (*ToDays)(nil)
+var _ sql.CollationCoercible = (*ToDays)(nil)
+
+// NewToDays creates a new ToDays UDF.
+func NewToDays(date sql.Expression) sql.Expression {
+	return &ToDays{expression.UnaryExpression{Child: date}}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (t *ToDays) FunctionName() string {
+	return "to_days"
+}
+
+// Description implements sql.FunctionExpression
+func (t *ToDays) Description() string {
+	return "returns the date argument converted to days."
+}
+
+func (t *ToDays) String() string { return fmt.Sprintf("TO_DAYS(%s)", t.Child) }
+
+// Type implements the Expression interface.
+func (t *ToDays) Type() sql.Type { return types.Int64 }
+
+// IsNullable implements the Expression interface.
+func (t *ToDays) IsNullable() bool { return true }
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*ToDays) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 5
+}
+
+// Eval implements the Expression interface.
+func (t *ToDays) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	val, err := t.Child.Eval(ctx, row)
+	if err != nil || val == nil {
+		return nil, err
+	}
+
+	// Like MySQL, 0000-00-00 has no day number. As a time it's the same as 0000-01-01, which is day 1, so only times
+	// are taken to be the zero date when they're equal to it.
+	if isZeroDate(val) {
+		return nil, nil
+	}
+
+	date, _, err := types.Date.Convert(val)
+	if err != nil {
+		ctx.Warn(1292, err.Error())
+		return nil, nil
+	}
+
+	d := date.(time.Time)
+
+	return int64(calcDaynr(int32(d.Year()), int32(d.Month()), int32(d.Day()))), nil
+}
+
+// WithChildren implements the Expression interface.
+func (t *ToDays) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(t, len(children), 1)
+	}
+	return NewToDays(children[0]), nil
+}
+
+// FromDays is a function that returns the date of a number of days from year 0. It's the inverse of ToDays.
+type FromDays struct {
+	expression.UnaryExpression
+}
+
+var _ sql.FunctionExpression = (*FromDays)(nil)
+var _ sql.CollationCoercible = (*FromDays)(nil)
+
+// NewFromDays creates a new FromDays UDF.
+func NewFromDays(days sql.Expression) sql.Expression {
+	return &FromDays{expression.UnaryExpression{Child: days}}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (f *FromDays) FunctionName() string {
+	return "from_days"
+}
+
+// Description implements sql.FunctionExpression
+func (f *FromDays) Description() string {
+	return "returns the date of the given day number."
+}
+
+func (f *FromDays) String() string { return fmt.Sprintf("FROM_DAYS(%s)", f.Child) }
+
+// Type implements the Expression interface.
+func (f *FromDays) Type() sql.Type { return types.Date }
+
+// IsNullable implements the Expression interface.
+func (f *FromDays) IsNullable() bool { return true }
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*FromDays) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 5
+}
+
+// zeroDateStr is the zero date, 0000-00-00.
+const zeroDateStr = "0000-00-00"
+
+// isZeroDate returns whether the value given is the zero date: a string or number for 0000-00-00, or a time equal
+// to the zero date.
+func isZeroDate(val interface{}) bool {
+	switch v := val.(type) {
+	case string:
+		return strings.HasPrefix(strings.TrimSpace(v), zeroDateStr)
+	case time.Time:
+		return v.Equal(types.Date.Zero().(time.Time))
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprint(v) == "0"
+	default:
+		return false
+	}
+}
+
+// Eval implements the Expression interface.
+func (f *FromDays) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	val, err := f.Child.Eval(ctx, row)
+	if err != nil || val == nil {
+		return nil, err
+	}
+
+	days, _, err := types.Int64.Convert(val)
+	if err != nil {
+		ctx.Warn(1292, err.Error())
+		return nil, nil
+	}
+
+	// Like MySQL, days in year 0 and days far past year 9999 are 0000-00-00. It's given as a string, as a time can't
+	// be told apart from 0000-01-01.
+	n := days.(int64)
+	if n <= 365 || n >= 3652500 {
+		return zeroDateStr, nil
+	}
+
+	date := time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, int(n-int64(calcDaynr(1, 1, 1))))
+	if date.Year() > 9999 {
+		return nil, nil
+	}
+	return date, nil
+}
+
+// WithChildren implements the Expression interface.
+func (f *FromDays) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), 1)
+	}
+	return NewFromDays(children[0]), nil
+}
+
 type CurrTime struct {
 	NoArgFunc
 }
@@ -1355,19 +1576,19 @@ func (CurrTime) CollationCoercibility(ctx *sql.Context) (collation sql.Collation
 
 func NewCurrTime() sql.Expression {
 	return CurrTime{
-		NoArgFunc: NoArgFunc{"curtime", types.LongText},
+		NoArgFunc: NoArgFunc{"curtime", types.Time},
 	}
 }
 
 func NewCurrentTime() sql.Expression {
 	return CurrTime{
-		NoArgFunc: NoArgFunc{"current_time", types.LongText},
+		NoArgFunc: NoArgFunc{"current_time", types.Time},
 	}
 }
 
 func currTimeLogic(ctx *sql.Context, _ sql.Row) (interface{}, error) {
 	t := ctx.QueryTime()
-	return fmt.Sprintf("%02d:%02d:%02d", t.Hour(), t.Minute(), t.Second()), nil
+	return types.Time.ConvertToTimespan(time.Date(0, 1, 1, t.Hour(), t.Minute(), t.Second(), 0, time.UTC))
 }
 
 // Eval implements sql.Expression
@@ -313,7 +313,7 @@ func TestYearWeek(t *testing.T) {
 		expected interface{}
 		err      bool
 	}{
-		{"null date", sql.NewRow(nil), nil, true},
+		{"null date", sql.NewRow(nil), nil, false},
 		{"invalid type", sql.NewRow([]byte{0, 1, 2}), int32(1), false},
 		{"date as string", sql.NewRow(stringDate), int32(200653), false},
 	}
@@ -332,6 +332,128 @@ func TestYearWeek(t *testing.T) {
 	}
 }
 
+func TestWeekModes(t *testing.T) {
+	testCases := []struct {
+		date     string
+		week     [8]int32
+		yearWeek [8]int32
+	}{
+		{"1900-01-01", [8]int32{0, 1, 53, 1, 1, 1, 1, 1}, [8]int32{189953, 190001, 189953, 190001, 190001, 190001, 190001, 190001}},
+		{"1900-12-31", [8]int32{52, 53, 52, 1, 53, 53, 1, 53}, [8]int32{190052, 190101, 190052, 190101, 190101, 190053, 190101, 190053}},
+		{"1999-12-31", [8]int32{52, 52, 52, 52, 52, 52, 52, 52}, [8]int32{199952, 199952, 199952, 199952, 199952, 199952, 199952, 199952}},
+		{"2000-01-01", [8]int32{0, 0, 52, 52, 0, 0, 52, 52}, [8]int32{199952, 199952, 199952, 199952, 199952, 199952, 199952, 199952}},
+		{"2000-01-02", [8]int32{1, 0, 1, 52, 1, 0, 1, 52}, [8]int32{200001, 199952, 200001, 199952, 200001, 199952, 200001, 199952}},
+		{"2000-02-29", [8]int32{9, 9, 9, 9, 9, 9, 9, 9}, [8]int32{200009, 200009, 200009, 200009, 200009, 200009, 200009, 200009}},
+		{"2000-12-31", [8]int32{53, 52, 53, 52, 53, 52, 1, 52}, [8]int32{200053, 200052, 200053, 200052, 200101, 200052, 200101, 200052}},
+		{"2004-02-29", [8]int32{9, 9, 9, 9, 9, 8, 9, 8}, [8]int32{200409, 200409, 200409, 200409, 200409, 200408, 200409, 200408}},
+		{"2008-12-28", [8]int32{52, 52, 52, 52, 53, 51, 53, 51}, [8]int32{200852, 200852, 200852, 200852, 200853, 200851, 200853, 200851}},
+		{"2008-12-31", [8]int32{52, 53, 52, 1, 53, 52, 53, 52}, [8]int32{200852, 200901, 200852, 200901, 200853, 200852, 200853, 200852}},
+		{"2010-01-01", [8]int32{0, 0, 52, 53, 0, 0, 52, 52}, [8]int32{200952, 200953, 200952, 200953, 200952, 200952, 200952, 200952}},
+		{"2010-01-03", [8]int32{1, 0, 1, 53, 1, 0, 1, 52}, [8]int32{201001, 200953, 201001, 200953, 201001, 200952, 201001, 200952}},
+		{"2012-01-01", [8]int32{1, 0, 1, 52, 1, 0, 1, 52}, [8]int32{201201, 201152, 201201, 201152, 201201, 201152, 201201, 201152}},
+		{"2015-12-31", [8]int32{52, 53, 52, 53, 52, 52, 52, 52}, [8]int32{201552, 201553, 201552, 201553, 201552, 201552, 201552, 201552}},
+		{"2016-01-01", [8]int32{0, 0, 52, 53, 0, 0, 52, 52}, [8]int32{201552, 201553, 201552, 201553, 201552, 201552, 201552, 201552}},
+		{"2017-01-01", [8]int32{1, 0, 1, 52, 1, 0, 1, 52}, [8]int32{201701, 201652, 201701, 201652, 201701, 201652, 201701, 201652}},
+		{"2018-12-30", [8]int32{52, 52, 52, 52, 53, 52, 1, 52}, [8]int32{201852, 201852, 201852, 201852, 201901, 201852, 201901, 201852}},
+		{"2018-12-31", [8]int32{52, 53, 52, 1, 53, 53, 1, 53}, [8]int32{201852, 201901, 201852, 201901, 201901, 201853, 201901, 201853}},
+		{"2019-01-01", [8]int32{0, 1, 52, 1, 1, 0, 1, 53}, [8]int32{201852, 201901, 201852, 201901, 201901, 201853, 201901, 201853}},
+		{"2020-12-31", [8]int32{52, 53, 52, 53, 53, 52, 53, 52}, [8]int32{202052, 202053, 202052, 202053, 202053, 202052, 202053, 202052}},
+		{"2021-01-01", [8]int32{0, 0, 52, 53, 0, 0, 53, 52}, [8]int32{202052, 202053, 202052, 202053, 202053, 202052, 202053, 202052}},
+		{"2021-01-03", [8]int32{1, 0, 1, 53, 1, 0, 1, 52}, [8]int32{202101, 202053, 202101, 202053, 202101, 202052, 202101, 202052}},
+		{"2024-02-29", [8]int32{8, 9, 8, 9, 9, 9, 9, 9}, [8]int32{202408, 202409, 202408, 202409, 202409, 202409, 202409, 202409}},
+		{"2024-12-30", [8]int32{52, 53, 52, 1, 53, 53, 1, 53}, [8]int32{202452, 202501, 202452, 202501, 202501, 202453, 202501, 202453}},
+	}
+
+	for _, tt := range testCases {
+		for mode := 0; mode < 8; mode++ {
+			t.Run(fmt.Sprintf("%s mode %d", tt.date, mode), func(t *testing.T) {
+				require := require.New(t)
+				ctx := sql.NewEmptyContext()
+				date := expression.NewLiteral(tt.date, types.LongText)
+				m := expression.NewLiteral(int64(mode), types.Int64)
+
+				w, err := NewWeek(date, m)
+				require.NoError(err)
+				val, err := w.Eval(ctx, nil)
+				require.NoError(err)
+				require.Equal(tt.week[mode], val)
+
+				yw, err := NewYearWeek(date, m)
+				require.NoError(err)
+				val, err = yw.Eval(ctx, nil)
+				require.NoError(err)
+				require.Equal(tt.yearWeek[mode], val)
+
+				require.NoError(ctx.SetSessionVariable(ctx, "default_week_format", int64(mode)))
+				w, err = NewWeek(date)
+				require.NoError(err)
+				val, err = w.Eval(ctx, nil)
+				require.NoError(err)
+				require.Equal(tt.week[mode], val)
+
+				val, err = NewExtract(expression.NewLiteral("WEEK", types.LongText), date).Eval(ctx, nil)
+				require.NoError(err)
+				require.Equal(int(tt.week[mode]), val)
+			})
+		}
+	}
+}
+
+func TestToDaysFromDays(t *testing.T) {
+	testCases := []struct {
+		date string
+		days interface{}
+	}{
+		{"0001-01-01", int64(366)},
+		{"1900-01-01", int64(693961)},
+		{"1970-01-01", int64(719528)},
+		{"2000-02-29", int64(730544)},
+		{"2000-03-01", int64(730545)},
+		{"2007-10-07", int64(733321)},
+		{"9999-12-31", int64(3652424)},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.date, func(t *testing.T) {
+			require := require.New(t)
+			ctx := sql.NewEmptyContext()
+
+			val, err := NewToDays(expression.NewLiteral(tt.date, types.LongText)).Eval(ctx, nil)
+			require.NoError(err)
+			require.Equal(tt.days, val)
+
+			val, err = NewFromDays(expression.NewLiteral(tt.days, types.Int64)).Eval(ctx, nil)
+			require.NoError(err)
+			date, _, err := types.Date.Convert(tt.date)
+			require.NoError(err)
+			require.Equal(date, val)
+		})
+	}
+
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	val, err := NewToDays(expression.NewLiteral(nil, types.Null)).Eval(ctx, nil)
+	require.NoError(err)
+	require.Nil(val)
+
+	val, err = NewToDays(expression.NewLiteral("0000-00-00", types.LongText)).Eval(ctx, nil)
+	require.NoError(err)
+	require.Nil(val)
+
+	val, err = NewToDays(expression.NewLiteral("0000-01-01", types.LongText)).Eval(ctx, nil)
+	require.NoError(err)
+	require.Equal(int64(1), val)
+
+	val, err = NewFromDays(expression.NewLiteral(int64(365), types.Int64)).Eval(ctx, nil)
+	require.NoError(err)
+	require.Equal("0000-00-00", val)
+
+	val, err = NewFromDays(expression.NewLiteral(int64(3652500), types.Int64)).Eval(ctx, nil)
+	require.NoError(err)
+	require.Equal("0000-00-00", val)
+}
+
 func TestCalcDaynr(t *testing.T) {
 	require.EqualValues(t, calcDaynr(0, 0, 0), 0)
 	require.EqualValues(t, calcDaynr(9999, 12, 31), 3652424)
@@ -606,3 +728,69 @@ func TestTime(t *testing.T) {
 		})
 	}
 }
+
+func TestTimeToSec(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+
+	testCases := []struct {
+		name     string
+		typ      sql.Type
+		row      sql.Row
+		expected interface{}
+		err      bool
+	}{
+		{"null time", types.LongText, sql.NewRow(nil), nil, false},
+		{"time as string", types.LongText, sql.NewRow("10:30:00"), float64(37800), false},
+		{"time with fractional seconds", types.LongText, sql.NewRow("10:30:00.5"), 37800.5, false},
+		{"negative time", types.LongText, sql.NewRow("-01:00:00"), float64(-3600), false},
+		{"datetime as string", types.LongText, sql.NewRow(stringDate), float64(51316), false},
+		{"time", types.Time, sql.NewRow(types.Timespan(3600250000)), 3600.25, false},
+		{"datetime", types.Datetime, sql.NewRow(time.Date(2007, 1, 2, 14, 15, 16, 0, time.UTC)), int64(51316), false},
+		{"invalid time", types.LongText, sql.NewRow("not a time"), nil, true},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			f := NewTimeToSec(expression.NewGetField(0, tt.typ, "foo", true))
+			val, err := f.Eval(ctx, tt.row)
+			if tt.err {
+				require.Error(err)
+			} else {
+				require.NoError(err)
+				require.Equal(tt.expected, val)
+			}
+		})
+	}
+}
+
+func TestSecToTime(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	f := NewSecToTime(expression.NewGetField(0, types.LongText, "foo", true))
+
+	testCases := []struct {
+		name     string
+		row      sql.Row
+		expected interface{}
+	}{
+		{"null seconds", sql.NewRow(nil), nil},
+		{"seconds", sql.NewRow(int64(3600)), "01:00:00"},
+		{"fractional seconds", sql.NewRow(3600.5), "01:00:00.500000"},
+		{"negative seconds", sql.NewRow(int64(-90)), "-00:01:30"},
+		{"seconds as string", sql.NewRow("86399"), "23:59:59"},
+		{"out of range", sql.NewRow(int64(99999999)), "838:59:59"},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			val, err := f.Eval(ctx, tt.row)
+			require.NoError(err)
+			if v, ok := val.(types.Timespan); ok {
+				require.Equal(tt.expected, v.String())
+			} else {
+				require.Equal(tt.expected, val)
+			}
+		})
+	}
+}
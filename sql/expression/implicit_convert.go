@@ -0,0 +1,85 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// ImplicitConvert converts the values of its child to a type that was aggregated from the types of several
+// expressions, such as the columns of the selects in a UNION. Unlike Convert, which casts to one of the types named
+// in CAST, it converts to any type, keeping its lengths, precision and collation.
+type ImplicitConvert struct {
+	UnaryExpression
+	typ sql.Type
+}
+
+var _ sql.Expression = (*ImplicitConvert)(nil)
+var _ sql.CollationCoercible = (*ImplicitConvert)(nil)
+
+// NewImplicitConvert creates a new ImplicitConvert expression converting the values of the expression given to the
+// type given.
+func NewImplicitConvert(expr sql.Expression, typ sql.Type) *ImplicitConvert {
+	return &ImplicitConvert{UnaryExpression: UnaryExpression{Child: expr}, typ: typ}
+}
+
+// Type implements the Expression interface.
+func (c *ImplicitConvert) Type() sql.Type {
+	return c.typ
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (c *ImplicitConvert) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	if ct, ok := c.typ.(sql.TypeWithCollation); ok && types.IsTextOnly(c.typ) {
+		return ct.Collation(), 2
+	}
+	return sql.Collation_binary, 5
+}
+
+// Eval implements the Expression interface.
+func (c *ImplicitConvert) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	val, err := c.Child.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	return ConvertAggregateResult(ctx, c.typ, c.Child, val)
+}
+
+// WithChildren implements the Expression interface.
+func (c *ImplicitConvert) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(c, len(children), 1)
+	}
+	return NewImplicitConvert(children[0], c.typ), nil
+}
+
+func (c *ImplicitConvert) String() string {
+	return fmt.Sprintf("convert(%v, %v)", c.Child, c.typ)
+}
+
+// DebugString implements the Expression interface.
+func (c *ImplicitConvert) DebugString() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("convert")
+	children := []string{
+		fmt.Sprintf("type: %v", c.typ),
+		sql.DebugString(c.Child),
+	}
+	_ = pr.WriteChildren(children...)
+	return pr.String()
+}
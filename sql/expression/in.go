@@ -71,12 +71,17 @@ func (in *InTuple) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, nil
 	}
 
+	if types.IsTuple(in.Left().Type()) {
+		return in.evalTuples(ctx, row, left)
+	}
+
 	// The NULL handling for IN expressions is tricky. According to
 	// https://dev.mysql.com/doc/refman/8.0/en/comparison-operators.html#operator_in:
 	// To comply with the SQL standard, IN() returns NULL not only if the expression on the left hand side is NULL, but
 	// also if no match is found in the list and one of the expressions in the list is NULL.
 	rightNull := false
 
+	originalLeft := left
 	left, _, err = typ.Convert(left)
 	if err != nil {
 		return nil, err
@@ -101,6 +106,16 @@ func (in *InTuple) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 				continue
 			}
 
+			// A string compared with a number is compared as floating point values on both sides
+			if CompareAsDoubles(in.Left().Type(), el.Type()) {
+				l := ConvertToDoubleForComparison(ctx, originalLeft)
+				r := ConvertToDoubleForComparison(ctx, originalRight)
+				if l.(float64) == r.(float64) {
+					return true, nil
+				}
+				continue
+			}
+
 			right, err := convertOrTruncate(ctx, originalRight, typ)
 			if err != nil {
 				return nil, err
@@ -126,6 +141,46 @@ func (in *InTuple) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	}
 }
 
+// evalTuples evaluates the expression for the value of a row constructor on the left, which is in the list if it
+// equals any of its elements. Like for single values, the result is NULL if it equals none of them, but comparing it
+// with some of them is NULL.
+func (in *InTuple) evalTuples(ctx *sql.Context, row sql.Row, left interface{}) (interface{}, error) {
+	right, ok := in.Right().(Tuple)
+	if !ok {
+		return nil, ErrUnsupportedInOperand.New(in.Right())
+	}
+
+	leftElems := types.NumColumns(in.Left().Type())
+	for _, el := range right {
+		if types.NumColumns(el.Type()) != leftElems {
+			return nil, sql.ErrInvalidOperandColumns.New(leftElems, types.NumColumns(el.Type()))
+		}
+	}
+
+	rightNull := false
+	for _, el := range right {
+		val, err := el.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+
+		eq, err := TuplesEqual(ctx, in.Left().Type(), el.Type(), left, val)
+		if err != nil {
+			return nil, err
+		}
+		if eq == nil {
+			rightNull = true
+		} else if eq == true {
+			return true, nil
+		}
+	}
+
+	if rightNull {
+		return nil, nil
+	}
+	return false, nil
+}
+
 // WithChildren implements the Expression interface.
 func (in *InTuple) WithChildren(children ...sql.Expression) (sql.Expression, error) {
 	if len(children) != 2 {
@@ -263,6 +318,15 @@ func (hit *HashInTuple) Eval(ctx *sql.Context, row sql.Row) (interface{}, error)
 		return nil, nil
 	}
 
+	// A row with NULL elements may compare as NULL with the elements of the list, which hashing cannot tell
+	if vals, ok := leftVal.([]interface{}); ok {
+		for _, v := range vals {
+			if v == nil {
+				return hit.InTuple.Eval(ctx, row)
+			}
+		}
+	}
+
 	key, err := hashOfSimple(ctx, leftVal, hit.Left().Type())
 	if err != nil {
 		return nil, err
@@ -211,6 +211,103 @@ func textFormatParts(text string, r *regexp.Regexp) []int64 {
 	return result
 }
 
+// hasDateUnits returns whether the interval has a YEAR, QUARTER, MONTH, WEEK or DAY part.
+func (i *Interval) hasDateUnits() bool {
+	for _, unit := range []string{"YEAR", "QUARTER", "MONTH", "WEEK", "DAY"} {
+		if strings.Contains(i.Unit, unit) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasTimeUnits returns whether the interval has an HOUR, MINUTE, SECOND or MICROSECOND part.
+func (i *Interval) hasTimeUnits() bool {
+	return strings.Contains(i.Unit, "HOUR") ||
+		strings.Contains(i.Unit, "MINUTE") ||
+		strings.Contains(i.Unit, "SECOND")
+}
+
+// DateOffsetType returns the type of the result of adding the interval given to, or subtracting it from, a value of
+// the type given, as DATE_ADD, DATE_SUB and the + and - INTERVAL operators do.
+// https://dev.mysql.com/doc/refman/8.0/en/date-and-time-functions.html#function_date-add
+func DateOffsetType(input sql.Type, interval *Interval) sql.Type {
+	switch {
+	case input == nil || input == types.Null:
+		return types.Null
+	case types.IsDatetimeType(input) || types.IsTimestampType(input):
+		return types.Datetime
+	case types.IsDateType(input):
+		if interval.hasTimeUnits() {
+			return types.Datetime
+		}
+		return types.Date
+	case types.IsTimespan(input):
+		if interval.hasDateUnits() {
+			return types.Datetime
+		}
+		return types.Time
+	case types.IsDeferredType(input):
+		return types.Datetime
+	default:
+		return types.LongText
+	}
+}
+
+// EvalDateOffset returns the date given plus the delta given, or minus it if subtract is true, as a value of typ, which
+// must be the DateOffsetType of the date and the interval the delta was evaluated from. A date that isn't valid results
+// in a warning and nil.
+func EvalDateOffset(ctx *sql.Context, typ sql.Type, date interface{}, interval *Interval, delta *TimeDelta, subtract bool) (interface{}, error) {
+	if date == nil || delta == nil {
+		return nil, nil
+	}
+
+	t, _, err := types.Datetime.Convert(date)
+	if err != nil {
+		ctx.Warn(1292, err.Error())
+		return nil, nil
+	}
+
+	var res time.Time
+	if subtract {
+		res = delta.Sub(t.(time.Time))
+	} else {
+		res = delta.Add(t.(time.Time))
+	}
+	if types.ValidateTime(res) == nil {
+		return nil, nil
+	}
+
+	if types.IsText(typ) {
+		// Like MySQL, the result of a string is a string with a time part, if the string or the interval has one
+		if !interval.hasTimeUnits() && !hasTimePart(date) {
+			return res.Format(sql.DateLayout), nil
+		}
+		if res.Nanosecond() != 0 || strings.Contains(interval.Unit, "MICROSECOND") {
+			return res.Format("2006-01-02 15:04:05.000000"), nil
+		}
+		return res.Format(sql.TimestampDatetimeLayout), nil
+	}
+	ret, _, err := typ.Convert(res)
+	return ret, err
+}
+
+// hasTimePart returns whether the string or number given, as a date, has a time part, as in '2020-01-02 10:00:00' or
+// 20200102100000.
+func hasTimePart(date interface{}) bool {
+	s := strings.TrimSpace(fmt.Sprint(date))
+	if strings.ContainsAny(s, " T:") {
+		return true
+	}
+	digits := 0
+	for _, c := range s {
+		if c >= '0' && c <= '9' {
+			digits++
+		}
+	}
+	return digits > 8
+}
+
 // TimeDelta is the difference between a time and another time.
 type TimeDelta struct {
 	Years        int64
@@ -141,19 +141,19 @@ func (m *Mod) evalLeftRight(ctx *sql.Context, row sql.Row) (interface{}, interfa
 
 func (m *Mod) convertLeftRight(ctx *sql.Context, left interface{}, right interface{}) (interface{}, interface{}) {
 	typ := m.Type()
-	lIsTimeType := types.IsTime(m.Left.Type())
-	rIsTimeType := types.IsTime(m.Right.Type())
+	lOrigType := m.Left.Type()
+	rOrigType := m.Right.Type()
 
 	if types.IsFloat(typ) {
-		left = convertValueToType(ctx, typ, left, lIsTimeType)
+		left = convertValueToType(ctx, typ, left, lOrigType)
 	} else {
-		left = convertToDecimalValue(left, lIsTimeType)
+		left = convertToDecimalValue(left, lOrigType)
 	}
 
 	if types.IsFloat(typ) {
-		right = convertValueToType(ctx, typ, right, rIsTimeType)
+		right = convertValueToType(ctx, typ, right, rOrigType)
 	} else {
-		right = convertToDecimalValue(right, rIsTimeType)
+		right = convertToDecimalValue(right, rOrigType)
 	}
 
 	return left, right
@@ -29,6 +29,16 @@ type IndexColumn struct {
 	Name string
 	// Length represents the index prefix length. If zero, then no length was specified.
 	Length int64
+	// Expression is the indexed expression of a functional key part, such as LOWER(email) in
+	// CREATE INDEX idx ON t ((LOWER(email))). Name is empty for functional key parts, and Expression is nil otherwise.
+	Expression Expression
+	// Descending is true if the key part is sorted in descending order, as in CREATE INDEX idx ON t (a DESC).
+	Descending bool
+}
+
+// IsFunctional returns whether this is a functional key part, which indexes an expression rather than a column.
+func (c IndexColumn) IsFunctional() bool {
+	return c.Expression != nil
 }
 
 // IndexConstraint represents any constraints that should be applied to the index.
@@ -98,6 +108,9 @@ type IndexLookup struct {
 	IsPointLookup   bool
 	IsEmptyRange    bool
 	IsSpatialLookup bool
+	// IsReverse is true if the rows of the lookup should be returned in the reverse of the index's order. Only set for
+	// lookups on a ColumnOrderedIndex.
+	IsReverse bool
 }
 
 var emptyLookup = IndexLookup{}
@@ -145,6 +158,17 @@ type OrderedIndex interface {
 	Order() IndexOrder
 }
 
+// ColumnOrderedIndex is an extension of |OrderedIndex| for indexes that declare the sort direction of each of their
+// key parts, such as an index created with CREATE INDEX idx ON t (a DESC, b ASC). Implementors must return the rows of
+// lookups with IndexLookup.IsReverse set in the reverse of the index's order, which lets the query engine use the index
+// to satisfy ORDER BY clauses that match it in either direction. Ranges are always expressed in ascending order,
+// regardless of the direction of the key parts.
+type ColumnOrderedIndex interface {
+	OrderedIndex
+	// ColumnOrders returns the sort direction of each of the index's expressions, in the order of Expressions().
+	ColumnOrders() []IndexOrder
+}
+
 // ColumnExpressionType returns a column expression along with its Type.
 type ColumnExpressionType struct {
 	Expression string
@@ -15,6 +15,10 @@
 package sql
 
 import (
+	"math"
+	"strconv"
+
+	"github.com/shopspring/decimal"
 	"gopkg.in/src-d/go-errors.v1"
 )
 
@@ -29,6 +33,7 @@ var (
 type IndexBuilder struct {
 	idx          Index
 	isInvalid    bool
+	isUnusable   bool
 	err          error
 	colExprTypes map[string]Type
 	ranges       map[string][]RangeColumnExpr
@@ -65,6 +70,10 @@ func (b *IndexBuilder) Equals(ctx *Context, colExpr string, keys ...interface{})
 	}
 	potentialRanges := make([]RangeColumnExpr, len(keys))
 	for i, key := range keys {
+		if !indexKeyUsable(typ, key) {
+			b.isUnusable = true
+			return b
+		}
 		potentialRanges[i] = ClosedRangeColumnExpr(key, key, typ)
 	}
 	b.updateCol(ctx, colExpr, potentialRanges...)
@@ -82,6 +91,10 @@ func (b *IndexBuilder) NotEquals(ctx *Context, colExpr string, key interface{})
 		b.err = ErrInvalidColExpr.New(colExpr, b.idx.ID())
 		return b
 	}
+	if !indexKeyUsable(typ, key) {
+		b.isUnusable = true
+		return b
+	}
 	b.updateCol(ctx, colExpr, GreaterThanRangeColumnExpr(key, typ), LessThanRangeColumnExpr(key, typ))
 	if !b.isInvalid {
 		ranges, err := SimplifyRangeColumn(b.ranges[colExpr]...)
@@ -99,6 +112,36 @@ func (b *IndexBuilder) NotEquals(ctx *Context, colExpr string, key interface{})
 	return b
 }
 
+// NullSafeNotEquals represents NOT (colExpr <=> key), which unlike colExpr <> key also matches NULL when key isn't
+// NULL.
+func (b *IndexBuilder) NullSafeNotEquals(ctx *Context, colExpr string, key interface{}) *IndexBuilder {
+	if b.isInvalid {
+		return b
+	}
+	typ, ok := b.colExprTypes[colExpr]
+	if !ok {
+		b.isInvalid = true
+		b.err = ErrInvalidColExpr.New(colExpr, b.idx.ID())
+		return b
+	}
+	if key == nil {
+		b.updateCol(ctx, colExpr, NotNullRangeColumnExpr(typ))
+		return b
+	}
+	if !indexKeyUsable(typ, key) {
+		b.isUnusable = true
+		return b
+	}
+	ranges, err := AllRangeColumnExpr(typ).Subtract(ClosedRangeColumnExpr(key, key, typ))
+	if err != nil {
+		b.isInvalid = true
+		b.err = err
+		return b
+	}
+	b.updateCol(ctx, colExpr, ranges...)
+	return b
+}
+
 // GreaterThan represents colExpr > key.
 func (b *IndexBuilder) GreaterThan(ctx *Context, colExpr string, key interface{}) *IndexBuilder {
 	if b.isInvalid {
@@ -110,6 +153,10 @@ func (b *IndexBuilder) GreaterThan(ctx *Context, colExpr string, key interface{}
 		b.err = ErrInvalidColExpr.New(colExpr, b.idx.ID())
 		return b
 	}
+	if !indexKeyUsable(typ, key) {
+		b.isUnusable = true
+		return b
+	}
 	b.updateCol(ctx, colExpr, GreaterThanRangeColumnExpr(key, typ))
 	return b
 }
@@ -125,6 +172,10 @@ func (b *IndexBuilder) GreaterOrEqual(ctx *Context, colExpr string, key interfac
 		b.err = ErrInvalidColExpr.New(colExpr, b.idx.ID())
 		return b
 	}
+	if !indexKeyUsable(typ, key) {
+		b.isUnusable = true
+		return b
+	}
 	b.updateCol(ctx, colExpr, GreaterOrEqualRangeColumnExpr(key, typ))
 	return b
 }
@@ -140,6 +191,10 @@ func (b *IndexBuilder) LessThan(ctx *Context, colExpr string, key interface{}) *
 		b.err = ErrInvalidColExpr.New(colExpr, b.idx.ID())
 		return b
 	}
+	if !indexKeyUsable(typ, key) {
+		b.isUnusable = true
+		return b
+	}
 	b.updateCol(ctx, colExpr, LessThanRangeColumnExpr(key, typ))
 	return b
 }
@@ -155,6 +210,10 @@ func (b *IndexBuilder) LessOrEqual(ctx *Context, colExpr string, key interface{}
 		b.err = ErrInvalidColExpr.New(colExpr, b.idx.ID())
 		return b
 	}
+	if !indexKeyUsable(typ, key) {
+		b.isUnusable = true
+		return b
+	}
 	b.updateCol(ctx, colExpr, LessOrEqualRangeColumnExpr(key, typ))
 	return b
 }
@@ -191,9 +250,10 @@ func (b *IndexBuilder) IsNotNull(ctx *Context, colExpr string) *IndexBuilder {
 	return b
 }
 
-// Ranges returns all ranges for this index builder. If the builder is in an error state then this returns nil.
+// Ranges returns all ranges for this index builder. If the builder is in an error state, or was given a key that the
+// index cannot be used for, then this returns nil.
 func (b *IndexBuilder) Ranges(ctx *Context) RangeCollection {
-	if b.err != nil {
+	if b.err != nil || b.isUnusable {
 		return nil
 	}
 	// An invalid builder that did not error got into a state where no columns will ever match, so we return an empty range
@@ -259,9 +319,12 @@ func (b *IndexBuilder) Ranges(ctx *Context) RangeCollection {
 }
 
 // Build constructs a new IndexLookup based on the ranges that have been built internally by this builder.
+// The lookup is empty if the builder was given a key that the index cannot be used for.
 func (b *IndexBuilder) Build(ctx *Context) (IndexLookup, error) {
 	if b.err != nil {
 		return emptyLookup, b.err
+	} else if b.isUnusable {
+		return emptyLookup, nil
 	} else {
 		ranges := b.Ranges(ctx)
 		if len(ranges) == 0 {
@@ -271,6 +334,34 @@ func (b *IndexBuilder) Build(ctx *Context) (IndexLookup, error) {
 	}
 }
 
+// indexKeyUsable returns whether an index on a column of type |typ| can be used to find the rows matching |key|. MySQL
+// compares a string column with a number as floating point values, so many differently ordered strings (such as '1',
+// '1.0' and '1abc') match the same number, and a string that is not exactly a number cannot be looked up in a numeric
+// column. Those comparisons must be evaluated as filters instead.
+func indexKeyUsable(typ Type, key interface{}) bool {
+	switch t := typ.(type) {
+	case StringType:
+		switch key.(type) {
+		case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64, decimal.Decimal:
+			return false
+		}
+	case NumberType:
+		if str, ok := key.(string); ok {
+			f, err := strconv.ParseFloat(str, 64)
+			if err != nil || math.IsInf(f, 0) || math.IsNaN(f) {
+				return false
+			}
+			return t.IsFloat() || f == math.Trunc(f)
+		}
+	case DecimalType:
+		if str, ok := key.(string); ok {
+			_, err := decimal.NewFromString(str)
+			return err == nil
+		}
+	}
+	return true
+}
+
 // updateCol updates the internal columns with the given ranges by intersecting each given range with each existing
 // range. That means that each given range is treated as an OR with respect to the other given ranges. If multiple
 // ranges are to be intersected with respect to one another, multiple calls to updateCol should be made.
@@ -78,6 +78,31 @@ func TestIndexBuilderRanges(t *testing.T) {
 		assert.Equal(t, sql.RangeCollection{sql.Range{sql.EmptyRangeColumnExpr(types.Int8)}}, ranges)
 	})
 
+	t.Run("NullSafeNotEquals2=(2,Inf),[NULL,2)", func(t *testing.T) {
+		builder := sql.NewIndexBuilder(testIndex{1})
+		builder = builder.NullSafeNotEquals(ctx, "column_0", 2)
+		ranges := builder.Ranges(ctx)
+		assert.NotNil(t, ranges)
+		assert.Equal(t, sql.RangeCollection{sql.Range{sql.GreaterThanRangeColumnExpr(2, types.Int8)}, sql.Range{sql.RangeColumnExpr{LowerBound: sql.BelowNull{}, UpperBound: sql.Below{Key: 2}, Typ: types.Int8}}}, ranges)
+	})
+
+	t.Run("NullSafeNotEqualsNull=(NULL,Inf)", func(t *testing.T) {
+		builder := sql.NewIndexBuilder(testIndex{1})
+		builder = builder.NullSafeNotEquals(ctx, "column_0", nil)
+		ranges := builder.Ranges(ctx)
+		assert.NotNil(t, ranges)
+		assert.Equal(t, sql.RangeCollection{sql.Range{sql.NotNullRangeColumnExpr(types.Int8)}}, ranges)
+	})
+
+	t.Run("NullSafeNotEquals2,IsNull=[NULL,NULL]", func(t *testing.T) {
+		builder := sql.NewIndexBuilder(testIndex{1})
+		builder = builder.NullSafeNotEquals(ctx, "column_0", 2)
+		builder = builder.IsNull(ctx, "column_0")
+		ranges := builder.Ranges(ctx)
+		assert.NotNil(t, ranges)
+		assert.Equal(t, sql.RangeCollection{sql.Range{sql.NullRangeColumnExpr(types.Int8)}}, ranges)
+	})
+
 	t.Run("LT4=(NULL,4)", func(t *testing.T) {
 		builder := sql.NewIndexBuilder(testIndex{1})
 		builder = builder.LessThan(ctx, "column_0", 4)
@@ -123,6 +148,46 @@ func TestIndexBuilderRanges(t *testing.T) {
 		assert.Equal(t, sql.RangeCollection{sql.Range{sql.OpenRangeColumnExpr(2, 4, types.Int8)}, sql.Range{sql.GreaterThanRangeColumnExpr(4, types.Int8)}, sql.Range{sql.LessThanRangeColumnExpr(2, types.Int8)}}, ranges)
 	})
 
+	t.Run("EqualsString2=[2,2]", func(t *testing.T) {
+		builder := sql.NewIndexBuilder(testIndex{1})
+		builder = builder.Equals(ctx, "column_0", "2")
+		ranges := builder.Ranges(ctx)
+		assert.NotNil(t, ranges)
+		assert.Equal(t, sql.RangeCollection{sql.Range{sql.ClosedRangeColumnExpr("2", "2", types.Int8)}}, ranges)
+	})
+
+	t.Run("EqualsString1.5=Unusable", func(t *testing.T) {
+		builder := sql.NewIndexBuilder(testIndex{1})
+		builder = builder.Equals(ctx, "column_0", "1.5")
+		assert.Nil(t, builder.Ranges(ctx))
+		lookup, err := builder.Build(ctx)
+		assert.NoError(t, err)
+		assert.True(t, lookup.IsEmpty())
+	})
+
+	t.Run("GTString2abc=Unusable", func(t *testing.T) {
+		builder := sql.NewIndexBuilder(testIndex{1})
+		builder = builder.GreaterThan(ctx, "column_0", "2abc")
+		assert.Nil(t, builder.Ranges(ctx))
+	})
+
+	t.Run("StringColumnEquals0=Unusable", func(t *testing.T) {
+		builder := sql.NewIndexBuilder(testStringIndex{testIndex{1}})
+		builder = builder.Equals(ctx, "column_0", "a", int8(0))
+		assert.Nil(t, builder.Ranges(ctx))
+		lookup, err := builder.Build(ctx)
+		assert.NoError(t, err)
+		assert.True(t, lookup.IsEmpty())
+	})
+
+	t.Run("StringColumnEqualsString0=[0,0]", func(t *testing.T) {
+		builder := sql.NewIndexBuilder(testStringIndex{testIndex{1}})
+		builder = builder.Equals(ctx, "column_0", "0")
+		ranges := builder.Ranges(ctx)
+		assert.NotNil(t, ranges)
+		assert.Equal(t, sql.RangeCollection{sql.Range{sql.ClosedRangeColumnExpr("0", "0", types.LongText)}}, ranges)
+	})
+
 	t.Run("ThreeColumnCombine", func(t *testing.T) {
 		clauses := make([]sql.RangeCollection, 3)
 		clauses[0] = sql.NewIndexBuilder(testIndex{3}).GreaterOrEqual(ctx, "column_0", 99).LessThan(ctx, "column_1", 66).Ranges(ctx)
@@ -218,3 +283,18 @@ func (testIndex) PrefixLengths() []uint16 {
 }
 
 var _ sql.Index = testIndex{}
+
+// testStringIndex is a testIndex over string columns
+type testStringIndex struct {
+	testIndex
+}
+
+func (i testStringIndex) ColumnExpressionTypes() []sql.ColumnExpressionType {
+	res := i.testIndex.ColumnExpressionTypes()
+	for i := range res {
+		res[i].Type = types.LongText
+	}
+	return res
+}
+
+var _ sql.Index = testStringIndex{}
@@ -353,7 +353,7 @@ func getRowsFromDatabase(ctx *sql.Context, db sql.Database, privSet sql.Privileg
 
 	privSetDb := privSet.Database(dbName)
 	curPrivSetMap := getCurrentPrivSetMapForColumn(privSetDb.ToSlice(), privSetMap)
-	if dbName == sql.InformationSchemaDatabaseName {
+	if dbName == sql.InformationSchemaDatabaseName || dbName == sql.SysDatabaseName || dbName == sql.PerformanceSchemaDatabaseName {
 		curPrivSetMap["select"] = struct{}{}
 	}
 
@@ -21,6 +21,7 @@ import (
 	"io"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/dolthub/vitess/go/sqltypes"
@@ -111,6 +112,8 @@ const (
 	TableConstraintsExtensionsTableName = "table_constraints_extensions"
 	// TablePrivilegesTableName is the name of the TABLE_PRIVILEGES table.
 	TablePrivilegesTableName = "table_privileges"
+	// TableStatsTableName is the name of the TABLE_STATS table.
+	TableStatsTableName = "table_stats"
 	// TablesTableName is the name of the TABLES table.
 	TablesTableName = "tables"
 	// TablesExtensionsTableName is the name of TABLE_EXTENSIONS table.
@@ -146,6 +149,8 @@ var _ Database = (*informationSchemaDatabase)(nil)
 type informationSchemaDatabase struct {
 	name   string
 	tables map[string]Table
+	// mu guards tables, which integrators can add to with RegisterTable while queries are running
+	mu sync.RWMutex
 }
 
 type informationSchemaTable struct {
@@ -676,6 +681,15 @@ var tablePrivilegesSchema = Schema{
 	{Name: "IS_GRANTABLE", Type: types.MustCreateString(sqltypes.VarChar, 3, Collation_Information_Schema_Default), Default: nil, Nullable: false, Source: TablePrivilegesTableName},
 }
 
+var tableStatsSchema = Schema{
+	{Name: "TABLE_SCHEMA", Type: types.MustCreateString(sqltypes.VarChar, 64, Collation_Information_Schema_Default), Default: nil, Nullable: false, Source: TableStatsTableName},
+	{Name: "TABLE_NAME", Type: types.MustCreateString(sqltypes.VarChar, 64, Collation_Information_Schema_Default), Default: nil, Nullable: false, Source: TableStatsTableName},
+	{Name: "LAST_UPDATE", Type: types.Datetime, Default: nil, Nullable: false, Source: TableStatsTableName},
+	{Name: "N_ROWS", Type: types.Uint64, Default: nil, Nullable: false, Source: TableStatsTableName},
+	{Name: "MODIFIED_COUNTER", Type: types.Uint64, Default: nil, Nullable: true, Source: TableStatsTableName},
+	{Name: "STATS_AUTO_RECALC", Type: types.MustCreateString(sqltypes.VarChar, 7, Collation_Information_Schema_Default), Default: nil, Nullable: false, Source: TableStatsTableName},
+}
+
 var tablesSchema = Schema{
 	{Name: "TABLE_CATALOG", Type: types.MustCreateString(sqltypes.VarChar, 64, Collation_Information_Schema_Default), Default: nil, Nullable: true, Source: TablesTableName},
 	{Name: "TABLE_SCHEMA", Type: types.MustCreateString(sqltypes.VarChar, 64, Collation_Information_Schema_Default), Default: nil, Nullable: true, Source: TablesTableName},
@@ -1362,6 +1376,10 @@ func stUnitsOfMeasureRowIter(ctx *Context, cat Catalog) (RowIter, error) {
 func statisticsRowIter(ctx *Context, c Catalog) (RowIter, error) {
 	var rows []Row
 	dbs := c.AllDatabases(ctx)
+	statsTbl, err := c.Statistics(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	for _, db := range dbs {
 		tableNames, tErr := db.GetTableNames(ctx)
@@ -1398,64 +1416,72 @@ func statisticsRowIter(ctx *Context, c Catalog) (RowIter, error) {
 					}
 					indexType := index.IndexType()
 					indexComment = index.Comment()
-					// setting `VISIBLE` is not supported, so defaulting it to "YES"
 					isVisible = "YES"
+					if !plan.IsIndexVisible(ctx, index) {
+						isVisible = "NO"
+					}
 
 					// Create a Row for each column this index refers too.
 					i := 0
 					for j, expr := range index.Expressions() {
 						col := plan.GetColumnFromIndexExpr(expr, tbl)
-						if col != nil {
-							i += 1
-							var (
-								collation   string
-								nullable    string
-								cardinality int64
-								subPart     interface{}
-							)
-
-							seqInIndex := i
-							colName := strings.Replace(col.Name, "`", "", -1) // get rid of backticks
-
-							// collation is "A" for ASC ; "D" for DESC ; "NULL" for not sorted
-							collation = "A"
+						i += 1
+						var (
+							collation   string
+							nullable    string
+							cardinality interface{}
+							subPart     interface{}
+							colName     interface{}
+							expression  interface{}
+						)
+
+						seqInIndex := i
+
+						// collation is "A" for ASC ; "D" for DESC ; "NULL" for not sorted
+						collation = plan.GetIndexCollation(index, j)
+
+						// cardinality is an estimate of the number of unique values in the index.
+						cardinality, err = plan.GetIndexCardinality(ctx, statsTbl, db.Name(), tbl, index, j)
+						if err != nil {
+							return nil, err
+						}
 
-							// TODO : cardinality is an estimate of the number of unique values in the index.
+						if prefixLengths := index.PrefixLengths(); j < len(prefixLengths) && prefixLengths[j] > 0 {
+							subPart = int64(prefixLengths[j])
+						}
 
-							if j < len(index.PrefixLengths()) {
-								subPart = int64(index.PrefixLengths()[j])
-							}
+						if col != nil {
+							colName = strings.Replace(col.Name, "`", "", -1) // get rid of backticks
 
 							// if nullable, 'YES'; if not, ''
 							if col.Nullable {
 								nullable = "YES"
-							} else {
-								nullable = ""
 							}
-
-							// TODO: we currently don't support expression index such as ((i * 20))
-
-							rows = append(rows, Row{
-								"def",        // table_catalog
-								db.Name(),    // table_schema
-								tbl.Name(),   // table_name
-								nonUnique,    // non_unique		NOT NULL
-								db.Name(),    // index_schema
-								indexName,    // index_name
-								seqInIndex,   // seq_in_index	NOT NULL
-								colName,      // column_name
-								collation,    // collation
-								cardinality,  // cardinality
-								subPart,      // sub_part
-								nil,          // packed
-								nullable,     // is_nullable	NOT NULL
-								indexType,    // index_type		NOT NULL
-								comment,      // comment		NOT NULL
-								indexComment, // index_comment	NOT NULL
-								isVisible,    // is_visible		NOT NULL
-								nil,          // expression
-							})
+						} else {
+							// functional key parts have an expression rather than a column
+							expression = plan.GetUnqualifiedIndexExpr(expr)
 						}
+
+						rows = append(rows, Row{
+							"def",        // table_catalog
+							db.Name(),    // table_schema
+							tbl.Name(),   // table_name
+							nonUnique,    // non_unique		NOT NULL
+							db.Name(),    // index_schema
+							indexName,    // index_name
+							seqInIndex,   // seq_in_index	NOT NULL
+							colName,      // column_name
+							collation,    // collation
+							cardinality,  // cardinality
+							subPart,      // sub_part
+							nil,          // packed
+							nullable,     // is_nullable	NOT NULL
+							indexType,    // index_type		NOT NULL
+							comment,      // comment		NOT NULL
+							indexComment, // index_comment	NOT NULL
+							isVisible,    // is_visible		NOT NULL
+							expression,   // expression
+						})
 					}
 				}
 			}
@@ -1645,23 +1671,89 @@ func tablePrivilegesRowIter(ctx *Context, c Catalog) (RowIter, error) {
 	return RowsToRowIter(rows...), nil
 }
 
+// tableStatsStore is the statistics table of the catalog, which stores the statistics of every table analyzed.
+type tableStatsStore interface {
+	tableStats(ctx *Context, db, table string) (*TableStatistics, bool, error)
+}
+
+// tableStatsRowIter implements the sql.RowIter for the information_schema.TABLE_STATS table, which has a row for
+// every table with statistics describing how fresh they are.
+func tableStatsRowIter(ctx *Context, cat Catalog) (RowIter, error) {
+	var rows []Row
+	statsTbl, err := cat.Statistics(ctx)
+	if err != nil {
+		return nil, err
+	}
+	store, ok := statsTbl.(tableStatsStore)
+	if !ok {
+		return RowsToRowIter(), nil
+	}
+
+	for _, db := range cat.AllDatabases(ctx) {
+		if db.Name() == InformationSchemaDatabaseName {
+			continue
+		}
+
+		err := DBTableIter(ctx, db, func(t Table) (cont bool, err error) {
+			stats, ok, err := store.tableStats(ctx, db.Name(), t.Name())
+			if err != nil || !ok {
+				return err == nil, err
+			}
+
+			// modified_counter is the number of rows modified since the statistics were collected
+			var modifiedCounter interface{}
+			if mct, ok := t.(ModificationCountingTable); ok {
+				modified, err := mct.RowsModified(ctx)
+				if err != nil {
+					return false, err
+				}
+				modifiedCounter = rowsModifiedSince(stats, modified)
+			}
+
+			autoRecalc := StatsAutoRecalc_Default
+			if sart, ok := t.(StatsAutoRecalcTable); ok {
+				autoRecalc = sart.StatsAutoRecalc()
+			}
+
+			rows = append(rows, Row{
+				db.Name(),           // table_schema
+				t.Name(),            // table_name
+				stats.CreatedAt,     // last_update
+				stats.RowCount,      // n_rows
+				modifiedCounter,     // modified_counter
+				autoRecalc.String(), // stats_auto_recalc
+			})
+			return true, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return RowsToRowIter(rows...), nil
+}
+
 // tablesRowIter implements the sql.RowIter for the information_schema.TABLES table.
 func tablesRowIter(ctx *Context, cat Catalog) (RowIter, error) {
 	var rows []Row
 	var (
 		tableType      string
-		tableRows      uint64
-		avgRowLength   uint64
-		dataLength     uint64
 		engine         interface{}
 		rowFormat      interface{}
 		tableCollation interface{}
-		autoInc        interface{}
 	)
 
 	for _, db := range cat.AllDatabases(ctx) {
 		if db.Name() == InformationSchemaDatabaseName {
 			tableType = "SYSTEM VIEW"
+		} else if db.Name() == SysDatabaseName {
+			tableType = "VIEW"
+			engine = nil
+			rowFormat = nil
+		} else if db.Name() == PerformanceSchemaDatabaseName {
+			tableType = "BASE TABLE"
+			engine = "PERFORMANCE_SCHEMA"
+			rowFormat = "Dynamic"
 		} else {
 			tableType = "BASE TABLE"
 			engine = "InnoDB"
@@ -1671,6 +1763,16 @@ func tablesRowIter(ctx *Context, cat Catalog) (RowIter, error) {
 		y2k, _, _ := types.Timestamp.Convert("2000-01-01 00:00:00")
 		err := DBTableIter(ctx, db, func(t Table) (cont bool, err error) {
 			tableCollation = t.Collation().String()
+			var (
+				tableRows    uint64
+				avgRowLength uint64
+				dataLength   uint64
+				indexLength  uint64
+				autoInc      interface{}
+				// tables that don't know when they were created or modified report a fixed time for both
+				createTime = y2k
+				updateTime = y2k
+			)
 			if db.Name() != InformationSchemaDatabaseName {
 				if st, ok := t.(StatisticsTable); ok {
 					tableRows, err = st.RowCount(ctx)
@@ -1679,7 +1781,7 @@ func tablesRowIter(ctx *Context, cat Catalog) (RowIter, error) {
 					}
 
 					// TODO: correct values for avg_row_length, data_length, max_data_length are missing (current values varies on gms vs Dolt)
-					//  index_length and data_free columns are not supported yet
+					//  data_free column is not supported yet
 					//  the data length values differ from MySQL
 					// MySQL uses default page size (16384B) as data length, and it adds another page size, if table data fills the current page block.
 					// https://stackoverflow.com/questions/34211377/average-row-length-higher-than-possible has good explanation.
@@ -1693,6 +1795,33 @@ func tablesRowIter(ctx *Context, cat Catalog) (RowIter, error) {
 					}
 				}
 
+				if st, ok := t.(TableSizeStatisticsTable); ok {
+					indexLength, err = st.IndexLength(ctx)
+					if err != nil {
+						return false, err
+					}
+
+					lastUpdate, modified, err := st.UpdateTime(ctx)
+					if err != nil {
+						return false, err
+					}
+					if modified {
+						updateTime = lastUpdate
+					} else {
+						updateTime = nil
+					}
+				}
+
+				if ct, ok := t.(CreateTimeTable); ok {
+					created, known, err := ct.CreateTime(ctx)
+					if err != nil {
+						return false, err
+					}
+					if known {
+						createTime = created
+					}
+				}
+
 				if ai, ok := t.(AutoIncrementTable); ok {
 					autoInc, err = ai.PeekNextAutoIncrementValue(ctx)
 					if !errors.Is(err, ErrNoAutoIncrementCol) && err != nil {
@@ -1719,11 +1848,11 @@ func tablesRowIter(ctx *Context, cat Catalog) (RowIter, error) {
 				avgRowLength,   // avg_row_length
 				dataLength,     // data_length
 				0,              // max_data_length
-				0,              // index_length
+				indexLength,    // index_length
 				0,              // data_free
 				autoInc,        // auto_increment
-				y2k,            // create_time
-				y2k,            // update_time
+				createTime,     // create_time
+				updateTime,     // update_time
 				nil,            // check_time
 				tableCollation, // table_collation
 				nil,            // checksum
@@ -2053,7 +2182,6 @@ func viewsRowIter(ctx *Context, catalog Catalog) (RowIter, error) {
 			viewDef := view.TextDefinition
 			definer := removeBackticks(viewPlan.Definer)
 
-			// TODO: WITH CHECK OPTION is not supported yet.
 			checkOpt := viewPlan.CheckOpt
 			if checkOpt == "" {
 				checkOpt = "NONE"
@@ -2065,7 +2193,7 @@ func viewsRowIter(ctx *Context, catalog Catalog) (RowIter, error) {
 				isUpdatable = "NO"
 			}
 
-			securityType := viewPlan.Security
+			securityType := strings.ToUpper(viewPlan.Security)
 			if securityType == "" {
 				securityType = "DEFINER"
 			}
@@ -2289,6 +2417,11 @@ func NewInformationSchemaDatabase() Database {
 				schema: tablePrivilegesSchema,
 				reader: tablePrivilegesRowIter,
 			},
+			TableStatsTableName: &informationSchemaTable{
+				name:   TableStatsTableName,
+				schema: tableStatsSchema,
+				reader: tableStatsRowIter,
+			},
 			TablesTableName: &informationSchemaTable{
 				name:   TablesTableName,
 				schema: tablesSchema,
@@ -2511,11 +2644,15 @@ func (db *informationSchemaDatabase) GetTableInsensitive(ctx *Context, tblName s
 		return &ColumnsTable{}, true, nil
 	}
 
+	db.mu.RLock()
+	defer db.mu.RUnlock()
 	tbl, ok := GetTableInsensitive(tblName, db.tables)
 	return tbl, ok, nil
 }
 
 func (db *informationSchemaDatabase) GetTableNames(ctx *Context) ([]string, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
 	tblNames := make([]string, 0, len(db.tables))
 	for k := range db.tables {
 		tblNames = append(tblNames, k)
@@ -2594,6 +2731,7 @@ func NewDefaultStats() *defaultStatsTable {
 			reader: statisticsRowIter,
 		},
 		stats: make(catalogStatistics),
+		mu:    &sync.Mutex{},
 	}
 }
 
@@ -2603,31 +2741,85 @@ type catalogStatistics map[DbTable]*TableStatistics
 // defaultStatsTable is a statistics table implementation
 // with a cache to save ANALYZE results. RowCount defers to
 // the underlying table in the absence of a cached statistic.
+// Statistics of tables in a StatisticsDatabase are stored in
+// the database rather than in the cache.
 type defaultStatsTable struct {
 	*informationSchemaTable
 	stats catalogStatistics
+	mu    *sync.Mutex
 }
 
 var _ StatsReadWriter = (*defaultStatsTable)(nil)
+var _ StatsAutoRecalculator = (*defaultStatsTable)(nil)
 
 func (n *defaultStatsTable) AssignCatalog(cat Catalog) Table {
 	n.catalog = cat
 	return n
 }
 
+// statisticsDatabase returns the database named as a StatisticsDatabase, or false if it doesn't store statistics.
+func (n *defaultStatsTable) statisticsDatabase(ctx *Context, db string) (StatisticsDatabase, bool, error) {
+	database, err := n.catalog.Database(ctx, db)
+	if err != nil {
+		return nil, false, err
+	}
+	if privDb, ok := database.(mysql_db.PrivilegedDatabase); ok {
+		database = privDb.Unwrap()
+	}
+	sdb, ok := database.(StatisticsDatabase)
+	return sdb, ok, nil
+}
+
+// tableStats returns the statistics stored for the table given, or false if it has none.
+func (n *defaultStatsTable) tableStats(ctx *Context, db, table string) (*TableStatistics, bool, error) {
+	sdb, ok, err := n.statisticsDatabase(ctx, db)
+	if err != nil {
+		return nil, false, err
+	}
+	if ok {
+		return sdb.GetTableStatistics(ctx, table)
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	s, ok := n.stats[NewDbTable(db, table)]
+	return s, ok, nil
+}
+
+// setTableStats stores the statistics given for the table given.
+func (n *defaultStatsTable) setTableStats(ctx *Context, db, table string, stats *TableStatistics) error {
+	sdb, ok, err := n.statisticsDatabase(ctx, db)
+	if err != nil {
+		return err
+	}
+	if ok {
+		return sdb.SetTableStatistics(ctx, table, stats)
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.stats[NewDbTable(db, table)] = stats
+	return nil
+}
+
 func (n *defaultStatsTable) Hist(ctx *Context, db, table string) (HistogramMap, error) {
-	if s, ok := n.stats[NewDbTable(db, table)]; ok {
-		return s.Histograms, nil
-	} else {
-		err := fmt.Errorf("histogram not found for table '%s.%s'", db, table)
+	s, ok, err := n.tableStats(ctx, db, table)
+	if err != nil {
 		return nil, err
 	}
+	if !ok {
+		return nil, fmt.Errorf("histogram not found for table '%s.%s'", db, table)
+	}
+	return s.Histograms, nil
 }
 
 // RowCount returns a sql.StatisticsTable's row count, or false if the table does not
 // implement the interface, or an error if the table was not found.
 func (n *defaultStatsTable) RowCount(ctx *Context, db, table string) (uint64, bool, error) {
-	s, ok := n.stats[NewDbTable(db, table)]
+	s, ok, err := n.tableStats(ctx, db, table)
+	if err != nil {
+		return 0, false, err
+	}
 	if ok {
 		return s.RowCount, true, nil
 	}
@@ -2656,19 +2848,89 @@ func (n *defaultStatsTable) Analyze(ctx *Context, db, table string) error {
 	if err != nil {
 		return err
 	}
+	// Modifications made while the histograms are built count against the new statistics
+	tableStats.RowsModified, err = rowsModified(ctx, t)
+	if err != nil {
+		return err
+	}
 	histMap, err := NewHistogramMapFromTable(ctx, t)
 	if err != nil {
 		return err
 	}
 
 	tableStats.Histograms = histMap
+	// Histograms skip the values they can't convert to numbers, so only the largest one counts every row
 	for _, v := range histMap {
-		tableStats.RowCount = v.Count + v.NullCount
-		break
+		if count := v.Count + v.NullCount; count > tableStats.RowCount {
+			tableStats.RowCount = count
+		}
 	}
 
-	n.stats[NewDbTable(db, table)] = tableStats
-	return nil
+	return n.setTableStats(ctx, db, table, tableStats)
+}
+
+// NeedsRecalc implements sql.StatsAutoRecalculator
+func (n *defaultStatsTable) NeedsRecalc(ctx *Context, db, table string) (bool, error) {
+	stats, ok, err := n.tableStats(ctx, db, table)
+	if err != nil || !ok {
+		return false, err
+	}
+
+	t, _, err := n.catalog.Table(ctx, db, table)
+	if err != nil {
+		return false, err
+	}
+	if _, ok := t.(ModificationCountingTable); !ok || !statsAutoRecalcEnabled(t) {
+		return false, nil
+	}
+
+	modified, err := rowsModified(ctx, t)
+	if err != nil {
+		return false, err
+	}
+	changed := rowsModifiedSince(stats, modified)
+	if changed == 0 {
+		return false, nil
+	}
+
+	_, threshold, ok := SystemVariables.GetGlobal("stats_auto_recalc_threshold")
+	if !ok {
+		return false, ErrUnknownSystemVariable.New("stats_auto_recalc_threshold")
+	}
+	return float64(changed) > threshold.(float64)*float64(stats.RowCount), nil
+}
+
+// rowsModified returns the modification count of the table given, or 0 if it doesn't count modifications.
+func rowsModified(ctx *Context, t Table) (uint64, error) {
+	if mct, ok := t.(ModificationCountingTable); ok {
+		return mct.RowsModified(ctx)
+	}
+	return 0, nil
+}
+
+// rowsModifiedSince returns how many rows of a table with the modification count given were modified since the
+// statistics given were collected.
+func rowsModifiedSince(stats *TableStatistics, modified uint64) uint64 {
+	if modified < stats.RowsModified {
+		// the table was reloaded since its statistics were collected
+		return modified
+	}
+	return modified - stats.RowsModified
+}
+
+// statsAutoRecalcEnabled returns whether the statistics of the table given are recalculated automatically, which its
+// STATS_AUTO_RECALC option decides if set, and the innodb_stats_auto_recalc system variable otherwise.
+func statsAutoRecalcEnabled(t Table) bool {
+	if sart, ok := t.(StatsAutoRecalcTable); ok {
+		switch sart.StatsAutoRecalc() {
+		case StatsAutoRecalc_On:
+			return true
+		case StatsAutoRecalc_Off:
+			return false
+		}
+	}
+	_, enabled, ok := SystemVariables.GetGlobal("innodb_stats_auto_recalc")
+	return ok && enabled.(int8) == 1
 }
 
 func newUpdatableStatsTable() *updatableStatsTable {
@@ -2694,18 +2956,17 @@ func (t *updatableStatsTable) AssignCatalog(cat Catalog) Table {
 
 // Updater implements sql.UpdatableTable
 func (t *updatableStatsTable) Updater(_ *Context) RowUpdater {
-	return newStatsEditor(t.catalog, t.stats)
+	return newStatsEditor(t.defaultStatsTable)
 }
 
-func newStatsEditor(c Catalog, stats map[DbTable]*TableStatistics) RowUpdater {
-	return &statsEditor{c: c, s: stats}
+func newStatsEditor(stats *defaultStatsTable) RowUpdater {
+	return &statsEditor{s: stats}
 }
 
 // statsEditor is an internal-only object used to mock table
 // statistics for testing.
 type statsEditor struct {
-	c Catalog
-	s map[DbTable]*TableStatistics
+	s *defaultStatsTable
 }
 
 var _ RowUpdater = (*statsEditor)(nil)
@@ -2732,7 +2993,7 @@ func (s *statsEditor) Update(ctx *Context, old, new Row) error {
 		return fmt.Errorf("expected string type tableName; found type: '%T', value: '%v'", old[2], old[2])
 	}
 
-	_, _, err := s.c.Table(ctx, db, table)
+	t, _, err := s.s.catalog.Table(ctx, db, table)
 	if err != nil {
 		return err
 	}
@@ -2741,13 +3002,18 @@ func (s *statsEditor) Update(ctx *Context, old, new Row) error {
 	if !ok {
 		return fmt.Errorf("expeceted integer cardinality; found type: '%T', value: '%s'", new[9], new[9])
 	}
+	// mocked statistics only go stale with modifications made after they're set
+	modified, err := rowsModified(ctx, t)
+	if err != nil {
+		return err
+	}
 	stats := &TableStatistics{
-		RowCount:   uint64(card),
-		CreatedAt:  time.Now(),
-		Histograms: make(HistogramMap),
+		RowCount:     uint64(card),
+		CreatedAt:    time.Now(),
+		Histograms:   make(HistogramMap),
+		RowsModified: modified,
 	}
-	s.s[NewDbTable(db, table)] = stats
-	return nil
+	return s.s.setTableStats(ctx, db, table, stats)
 }
 
 func (s *statsEditor) Close(context *Context) error {
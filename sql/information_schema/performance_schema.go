@@ -0,0 +1,79 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package information_schema
+
+import (
+	"sort"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+
+	. "github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+const (
+	// SessionConnectAttrsTableName is the name of the performance_schema.session_connect_attrs table
+	SessionConnectAttrsTableName = "session_connect_attrs"
+)
+
+var sessionConnectAttrsSchema = Schema{
+	{Name: "PROCESSLIST_ID", Type: types.Uint32, Default: nil, Nullable: false, Source: SessionConnectAttrsTableName},
+	{Name: "ATTR_NAME", Type: types.MustCreateString(sqltypes.VarChar, 32, Collation_Information_Schema_Default), Default: nil, Nullable: false, Source: SessionConnectAttrsTableName},
+	{Name: "ATTR_VALUE", Type: types.MustCreateString(sqltypes.VarChar, 1024, Collation_Information_Schema_Default), Default: nil, Nullable: true, Source: SessionConnectAttrsTableName},
+	{Name: "ORDINAL_POSITION", Type: types.Int32, Default: nil, Nullable: true, Source: SessionConnectAttrsTableName},
+}
+
+// NewPerformanceSchemaDatabase creates a new performance_schema Database. Only the tables whose data the engine keeps
+// are there.
+func NewPerformanceSchemaDatabase() Database {
+	return &informationSchemaDatabase{
+		name: PerformanceSchemaDatabaseName,
+		tables: map[string]Table{
+			SessionConnectAttrsTableName: &informationSchemaTable{
+				name:   SessionConnectAttrsTableName,
+				schema: sessionConnectAttrsSchema,
+				reader: sessionConnectAttrsRowIter,
+			},
+		},
+	}
+}
+
+// sessionConnectAttrsRowIter implements the sql.RowIter for the performance_schema.session_connect_attrs table, which
+// has a row for every connection attribute sent by the clients of the connections in the process list. The order
+// attributes were sent in isn't kept, so they're numbered in the order of their names.
+func sessionConnectAttrsRowIter(ctx *Context, c Catalog) (RowIter, error) {
+	processes := ctx.ProcessList.Processes()
+	sort.Slice(processes, func(i, j int) bool {
+		return processes[i].Connection < processes[j].Connection
+	})
+
+	var rows []Row
+	for _, proc := range processes {
+		names := make([]string, 0, len(proc.ConnectAttributes))
+		for name := range proc.ConnectAttributes {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for i, name := range names {
+			rows = append(rows, Row{
+				proc.Connection,              // processlist_id
+				name,                         // attr_name
+				proc.ConnectAttributes[name], // attr_value
+				int32(i),                     // ordinal_position
+			})
+		}
+	}
+	return RowsToRowIter(rows...), nil
+}
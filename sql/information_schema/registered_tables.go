@@ -0,0 +1,69 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package information_schema
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/src-d/go-errors.v1"
+
+	. "github.com/dolthub/go-mysql-server/sql"
+)
+
+// ErrInformationSchemaTableExists is returned when a table is registered in information_schema with the name of a
+// table it already has.
+var ErrInformationSchemaTableExists = errors.NewKind("information_schema already has a table named %s")
+
+// RowIterFunc returns the rows of a table of information_schema, given the catalog of the engine.
+type RowIterFunc func(ctx *Context, cat Catalog) (RowIter, error)
+
+// RegisterTable adds a table to the information_schema database given, with the name and schema given, whose rows are
+// returned by rowIter. Integrators use this to add tables of their own, such as statistics of their storage. The
+// table is listed along with the built-in ones, and can be queried like them. A table can't be registered with the
+// name of a table the database already has, whether built in or registered, compared case-insensitively.
+//
+// Tables can be registered while the engine is serving queries. rowIter is called by the queries of all sessions
+// that read the table, which may run at the same time, so it must be safe for concurrent use.
+func RegisterTable(db Database, name string, schema Schema, rowIter RowIterFunc) error {
+	isDb, ok := db.(*informationSchemaDatabase)
+	if !ok || isDb.name != InformationSchemaDatabaseName {
+		return fmt.Errorf("cannot register table %s: %s is not information_schema", name, db.Name())
+	}
+	if name == "" {
+		return fmt.Errorf("cannot register a table without a name in information_schema")
+	}
+	if rowIter == nil {
+		return fmt.Errorf("cannot register table %s in information_schema without a row iterator", name)
+	}
+
+	name = strings.ToLower(name)
+	schema = schema.Copy()
+	for _, col := range schema {
+		col.Source = name
+	}
+
+	isDb.mu.Lock()
+	defer isDb.mu.Unlock()
+	if _, ok := GetTableInsensitive(name, isDb.tables); ok {
+		return ErrInformationSchemaTableExists.New(name)
+	}
+	isDb.tables[name] = &informationSchemaTable{
+		name:   name,
+		schema: schema,
+		reader: rowIter,
+	}
+	return nil
+}
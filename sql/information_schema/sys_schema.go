@@ -0,0 +1,288 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package information_schema
+
+import (
+	"time"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/shopspring/decimal"
+
+	. "github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression/function"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+const (
+	// SysProcessListTableName is the name of the sys.processlist view
+	SysProcessListTableName = "processlist"
+	// SysSessionTableName is the name of the sys.session view
+	SysSessionTableName = "session"
+	// SysSchemaTableStatisticsTableName is the name of the sys.schema_table_statistics view
+	SysSchemaTableStatisticsTableName = "schema_table_statistics"
+	// SysStatementsWithErrorsOrWarningsTableName is the name of the sys.statements_with_errors_or_warnings view
+	SysStatementsWithErrorsOrWarningsTableName = "statements_with_errors_or_warnings"
+)
+
+var sysProcessListSchema = sysProcessListSchemaFor(SysProcessListTableName)
+
+var sysSessionSchema = sysProcessListSchemaFor(SysSessionTableName)
+
+// sysProcessListSchemaFor returns the schema of sys.processlist, which sys.session shares, for the view given.
+func sysProcessListSchemaFor(source string) Schema {
+	return Schema{
+		{Name: "thd_id", Type: types.Uint64, Default: nil, Nullable: false, Source: source},
+		{Name: "conn_id", Type: types.Uint64, Default: nil, Nullable: true, Source: source},
+		{Name: "user", Type: types.MustCreateString(sqltypes.VarChar, 288, Collation_Information_Schema_Default), Default: nil, Nullable: true, Source: source},
+		{Name: "db", Type: types.MustCreateString(sqltypes.VarChar, 64, Collation_Information_Schema_Default), Default: nil, Nullable: true, Source: source},
+		{Name: "command", Type: types.MustCreateString(sqltypes.VarChar, 16, Collation_Information_Schema_Default), Default: nil, Nullable: true, Source: source},
+		{Name: "state", Type: types.MustCreateString(sqltypes.VarChar, 64, Collation_Information_Schema_Default), Default: nil, Nullable: true, Source: source},
+		{Name: "time", Type: types.Int64, Default: nil, Nullable: true, Source: source},
+		{Name: "current_statement", Type: types.LongText, Default: nil, Nullable: true, Source: source},
+		{Name: "statement_latency", Type: types.Text, Default: nil, Nullable: true, Source: source},
+		{Name: "progress", Type: types.MustCreateDecimalType(26, 2), Default: nil, Nullable: true, Source: source},
+		{Name: "lock_latency", Type: types.Text, Default: nil, Nullable: true, Source: source},
+		{Name: "rows_examined", Type: types.Uint64, Default: nil, Nullable: true, Source: source},
+		{Name: "rows_sent", Type: types.Uint64, Default: nil, Nullable: true, Source: source},
+		{Name: "rows_affected", Type: types.Uint64, Default: nil, Nullable: true, Source: source},
+		{Name: "tmp_tables", Type: types.Uint64, Default: nil, Nullable: true, Source: source},
+		{Name: "tmp_disk_tables", Type: types.Uint64, Default: nil, Nullable: true, Source: source},
+		{Name: "full_scan", Type: types.MustCreateString(sqltypes.VarChar, 3, Collation_Information_Schema_Default), Default: nil, Nullable: false, Source: source},
+		{Name: "last_statement", Type: types.LongText, Default: nil, Nullable: true, Source: source},
+		{Name: "last_statement_latency", Type: types.Text, Default: nil, Nullable: true, Source: source},
+		{Name: "current_memory", Type: types.Text, Default: nil, Nullable: true, Source: source},
+		{Name: "last_wait", Type: types.MustCreateString(sqltypes.VarChar, 128, Collation_Information_Schema_Default), Default: nil, Nullable: true, Source: source},
+		{Name: "last_wait_latency", Type: types.Text, Default: nil, Nullable: true, Source: source},
+		{Name: "source", Type: types.MustCreateString(sqltypes.VarChar, 64, Collation_Information_Schema_Default), Default: nil, Nullable: true, Source: source},
+		{Name: "trx_latency", Type: types.Text, Default: nil, Nullable: true, Source: source},
+		{Name: "trx_state", Type: types.MustCreateEnumType([]string{"ACTIVE", "COMMITTED", "ROLLED BACK"}, Collation_Information_Schema_Default), Default: nil, Nullable: true, Source: source},
+		{Name: "trx_autocommit", Type: types.MustCreateEnumType([]string{"YES", "NO"}, Collation_Information_Schema_Default), Default: nil, Nullable: true, Source: source},
+		{Name: "pid", Type: types.MustCreateString(sqltypes.VarChar, 1024, Collation_Information_Schema_Default), Default: nil, Nullable: true, Source: source},
+		{Name: "program_name", Type: types.MustCreateString(sqltypes.VarChar, 1024, Collation_Information_Schema_Default), Default: nil, Nullable: true, Source: source},
+	}
+}
+
+var sysSchemaTableStatisticsSchema = Schema{
+	{Name: "table_schema", Type: types.MustCreateString(sqltypes.VarChar, 64, Collation_Information_Schema_Default), Default: nil, Nullable: true, Source: SysSchemaTableStatisticsTableName},
+	{Name: "table_name", Type: types.MustCreateString(sqltypes.VarChar, 64, Collation_Information_Schema_Default), Default: nil, Nullable: true, Source: SysSchemaTableStatisticsTableName},
+	{Name: "total_latency", Type: types.Text, Default: nil, Nullable: true, Source: SysSchemaTableStatisticsTableName},
+	{Name: "rows_fetched", Type: types.Uint64, Default: nil, Nullable: true, Source: SysSchemaTableStatisticsTableName},
+	{Name: "fetch_latency", Type: types.Text, Default: nil, Nullable: true, Source: SysSchemaTableStatisticsTableName},
+	{Name: "rows_inserted", Type: types.Uint64, Default: nil, Nullable: true, Source: SysSchemaTableStatisticsTableName},
+	{Name: "insert_latency", Type: types.Text, Default: nil, Nullable: true, Source: SysSchemaTableStatisticsTableName},
+	{Name: "rows_updated", Type: types.Uint64, Default: nil, Nullable: true, Source: SysSchemaTableStatisticsTableName},
+	{Name: "update_latency", Type: types.Text, Default: nil, Nullable: true, Source: SysSchemaTableStatisticsTableName},
+	{Name: "rows_deleted", Type: types.Uint64, Default: nil, Nullable: true, Source: SysSchemaTableStatisticsTableName},
+	{Name: "delete_latency", Type: types.Text, Default: nil, Nullable: true, Source: SysSchemaTableStatisticsTableName},
+	{Name: "io_read_requests", Type: types.MustCreateDecimalType(42, 0), Default: nil, Nullable: true, Source: SysSchemaTableStatisticsTableName},
+	{Name: "io_read", Type: types.Text, Default: nil, Nullable: true, Source: SysSchemaTableStatisticsTableName},
+	{Name: "io_read_latency", Type: types.Text, Default: nil, Nullable: true, Source: SysSchemaTableStatisticsTableName},
+	{Name: "io_write_requests", Type: types.MustCreateDecimalType(42, 0), Default: nil, Nullable: true, Source: SysSchemaTableStatisticsTableName},
+	{Name: "io_write", Type: types.Text, Default: nil, Nullable: true, Source: SysSchemaTableStatisticsTableName},
+	{Name: "io_write_latency", Type: types.Text, Default: nil, Nullable: true, Source: SysSchemaTableStatisticsTableName},
+	{Name: "io_misc_requests", Type: types.MustCreateDecimalType(42, 0), Default: nil, Nullable: true, Source: SysSchemaTableStatisticsTableName},
+	{Name: "io_misc_latency", Type: types.Text, Default: nil, Nullable: true, Source: SysSchemaTableStatisticsTableName},
+}
+
+var sysStatementsWithErrorsOrWarningsSchema = Schema{
+	{Name: "query", Type: types.LongText, Default: nil, Nullable: true, Source: SysStatementsWithErrorsOrWarningsTableName},
+	{Name: "db", Type: types.MustCreateString(sqltypes.VarChar, 64, Collation_Information_Schema_Default), Default: nil, Nullable: true, Source: SysStatementsWithErrorsOrWarningsTableName},
+	{Name: "exec_count", Type: types.Uint64, Default: nil, Nullable: false, Source: SysStatementsWithErrorsOrWarningsTableName},
+	{Name: "errors", Type: types.Uint64, Default: nil, Nullable: false, Source: SysStatementsWithErrorsOrWarningsTableName},
+	{Name: "error_pct", Type: types.MustCreateDecimalType(27, 4), Default: nil, Nullable: false, Source: SysStatementsWithErrorsOrWarningsTableName},
+	{Name: "warnings", Type: types.Uint64, Default: nil, Nullable: false, Source: SysStatementsWithErrorsOrWarningsTableName},
+	{Name: "warning_pct", Type: types.MustCreateDecimalType(27, 4), Default: nil, Nullable: false, Source: SysStatementsWithErrorsOrWarningsTableName},
+	{Name: "first_seen", Type: types.Timestamp, Default: nil, Nullable: false, Source: SysStatementsWithErrorsOrWarningsTableName},
+	{Name: "last_seen", Type: types.Timestamp, Default: nil, Nullable: false, Source: SysStatementsWithErrorsOrWarningsTableName},
+	{Name: "digest", Type: types.MustCreateString(sqltypes.VarChar, 64, Collation_Information_Schema_Default), Default: nil, Nullable: true, Source: SysStatementsWithErrorsOrWarningsTableName},
+}
+
+// NewSysDatabase creates a new sys Database, whose views are assembled from the process list, the sessions and the
+// tables of the catalog. The performance schema they're built on in MySQL doesn't exist here, so columns without a
+// source are NULL, and views without one are empty.
+func NewSysDatabase() Database {
+	return &informationSchemaDatabase{
+		name: SysDatabaseName,
+		tables: map[string]Table{
+			SysProcessListTableName: &informationSchemaTable{
+				name:   SysProcessListTableName,
+				schema: sysProcessListSchema,
+				reader: sysProcessListRowIter,
+			},
+			// sys.session is sys.processlist without the background threads, which the process list doesn't have
+			SysSessionTableName: &informationSchemaTable{
+				name:   SysSessionTableName,
+				schema: sysSessionSchema,
+				reader: sysProcessListRowIter,
+			},
+			SysSchemaTableStatisticsTableName: &informationSchemaTable{
+				name:   SysSchemaTableStatisticsTableName,
+				schema: sysSchemaTableStatisticsSchema,
+				reader: sysSchemaTableStatisticsRowIter,
+			},
+			// There's no statement digest store to summarize
+			SysStatementsWithErrorsOrWarningsTableName: &informationSchemaTable{
+				name:   SysStatementsWithErrorsOrWarningsTableName,
+				schema: sysStatementsWithErrorsOrWarningsSchema,
+				reader: emptyRowIter,
+			},
+		},
+	}
+}
+
+// sysProcessListRowIter implements the sql.RowIter for the sys.processlist and sys.session views. The session
+// variables and transaction of a connection are only visible from the connection itself, so the transaction columns
+// of other connections are NULL.
+func sysProcessListRowIter(ctx *Context, c Catalog) (RowIter, error) {
+	processes := ctx.ProcessList.Processes()
+	var rows = make([]Row, len(processes))
+
+	for i, proc := range processes {
+		user := proc.User
+		if proc.Host != "" {
+			user += "@" + proc.Host
+		}
+
+		var db interface{}
+		if proc.Database != "" {
+			db = proc.Database
+		}
+
+		var currentStatement, statementLatency, progress interface{}
+		if proc.Command == ProcessCommandQuery {
+			currentStatement = proc.Query
+			statementLatency = function.FormatPicoseconds(float64(time.Since(proc.StartedAt).Nanoseconds()) * 1000)
+			progress = processProgress(proc)
+		}
+
+		var trxState, trxAutocommit interface{}
+		if proc.Connection == ctx.Session.ID() {
+			if db == nil && ctx.GetCurrentDatabase() != "" {
+				db = ctx.GetCurrentDatabase()
+			}
+			if ctx.GetTransaction() != nil {
+				trxState = "ACTIVE"
+			}
+			autocommit, err := plan.IsSessionAutocommit(ctx)
+			if err != nil {
+				return nil, err
+			}
+			trxAutocommit = "NO"
+			if autocommit {
+				trxAutocommit = "YES"
+			}
+		}
+
+		rows[i] = Row{
+			uint64(proc.Connection),   // thd_id
+			uint64(proc.Connection),   // conn_id
+			user,                      // user
+			db,                        // db
+			string(proc.Command),      // command
+			nil,                       // state
+			int64(proc.Seconds()),     // time
+			currentStatement,          // current_statement
+			statementLatency,          // statement_latency
+			progress,                  // progress
+			nil,                       // lock_latency
+			uint64(proc.RowsExamined), // rows_examined
+			uint64(proc.RowsSent),     // rows_sent
+			nil,                       // rows_affected
+			nil,                       // tmp_tables
+			nil,                       // tmp_disk_tables
+			"NO",                      // full_scan
+			nil,                       // last_statement
+			nil,                       // last_statement_latency
+			nil,                       // current_memory
+			nil,                       // last_wait
+			nil,                       // last_wait_latency
+			nil,                       // source
+			nil,                       // trx_latency
+			trxState,                  // trx_state
+			trxAutocommit,             // trx_autocommit
+			nil,                       // pid
+			nil,                       // program_name
+		}
+	}
+
+	return RowsToRowIter(rows...), nil
+}
+
+// processProgress returns the percentage of the partitions of the tables read by a running query that it's done
+// with, or nil if none of the tables know how many partitions they have.
+func processProgress(proc Process) interface{} {
+	var done, total int64
+	for _, progress := range proc.Progress {
+		if progress.Total > 0 {
+			done += progress.Done
+			total += progress.Total
+		}
+	}
+	if total == 0 {
+		return nil
+	}
+	return decimal.New(done*100, 0).DivRound(decimal.New(total, 0), 2)
+}
+
+// sysSchemaTableStatisticsRowIter implements the sql.RowIter for the sys.schema_table_statistics view, which has a
+// row for every table that counts the rows changed in it.
+func sysSchemaTableStatisticsRowIter(ctx *Context, cat Catalog) (RowIter, error) {
+	var rows []Row
+	for _, db := range cat.AllDatabases(ctx) {
+		if db.Name() == InformationSchemaDatabaseName || db.Name() == SysDatabaseName || db.Name() == PerformanceSchemaDatabaseName {
+			continue
+		}
+
+		err := DBTableIter(ctx, db, func(t Table) (cont bool, err error) {
+			rct, ok := t.(RowCountingTable)
+			if !ok {
+				return true, nil
+			}
+			counts, err := rct.RowCounts(ctx)
+			if err != nil {
+				return false, err
+			}
+
+			rows = append(rows, Row{
+				db.Name(),       // table_schema
+				t.Name(),        // table_name
+				nil,             // total_latency
+				nil,             // rows_fetched
+				nil,             // fetch_latency
+				counts.Inserted, // rows_inserted
+				nil,             // insert_latency
+				counts.Updated,  // rows_updated
+				nil,             // update_latency
+				counts.Deleted,  // rows_deleted
+				nil,             // delete_latency
+				nil,             // io_read_requests
+				nil,             // io_read
+				nil,             // io_read_latency
+				nil,             // io_write_requests
+				nil,             // io_write
+				nil,             // io_write_latency
+				nil,             // io_misc_requests
+				nil,             // io_misc_latency
+			})
+			return true, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return RowsToRowIter(rows...), nil
+}
@@ -19,3 +19,25 @@ const (
 	ConnectionDbLogField = "connectionDb"
 	ConnectTimeLogKey    = "connectTime"
 )
+
+// LogKind is a kind of log closed and reopened by FLUSH LOGS.
+type LogKind string
+
+const (
+	// AllLogs is the LogKind of FLUSH LOGS, which flushes all the logs.
+	AllLogs     LogKind = ""
+	BinaryLogs  LogKind = "BINARY LOGS"
+	EngineLogs  LogKind = "ENGINE LOGS"
+	ErrorLogs   LogKind = "ERROR LOGS"
+	GeneralLogs LogKind = "GENERAL LOGS"
+	RelayLogs   LogKind = "RELAY LOGS"
+	SlowLogs    LogKind = "SLOW LOGS"
+)
+
+// LogFlusher is an interface that allows integrators writing logs, like a general or slow query log, to close and
+// reopen them on FLUSH LOGS so that their files can be rotated. It's usually (but not always) implemented by a
+// DatabaseProvider. FLUSH LOGS succeeds without doing anything when the provider doesn't implement it.
+type LogFlusher interface {
+	// FlushLogs closes and reopens the logs of the kind given, or all the logs for AllLogs.
+	FlushLogs(ctx *Context, kind LogKind) error
+}
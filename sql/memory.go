@@ -142,6 +142,8 @@ type MemoryManager struct {
 	reporter Reporter
 	caches   map[uint64]Disposable
 	token    uint64
+	// sortBudget is the number of bytes of rows a sort may hold in memory before spilling them to disk.
+	sortBudget uint64
 }
 
 // NewMemoryManager creates a new manager with the given memory reporter. If nil is given,
@@ -162,6 +164,22 @@ func (m *MemoryManager) HasAvailable() bool {
 	return HasAvailableMemory(m.reporter)
 }
 
+// SetSortBudget sets the number of bytes of rows each sort may hold in memory before spilling them to disk. Zero, the
+// default, lets sorts hold rows in memory for as long as the memory manager has memory available.
+func (m *MemoryManager) SetSortBudget(bytes uint64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.sortBudget = bytes
+}
+
+// SortBudget returns the number of bytes of rows each sort may hold in memory before spilling them to disk, or zero if
+// there is no limit beyond the memory available.
+func (m *MemoryManager) SortBudget() uint64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.sortBudget
+}
+
 // DisposeFunc is a function to completely erase a cache and remove it from the manager.
 type DisposeFunc func()
 
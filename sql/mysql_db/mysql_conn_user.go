@@ -23,6 +23,8 @@ import (
 type MysqlConnectionUser struct {
 	User string
 	Host string
+	// AuthMethod is the authentication plugin the connection was authenticated with.
+	AuthMethod string
 }
 
 var _ mysql.Getter = MysqlConnectionUser{}
@@ -28,6 +28,7 @@ import (
 	"github.com/dolthub/vitess/go/mysql"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/in_mem_table"
 	"github.com/dolthub/go-mysql-server/sql/mysql_db/serial"
 )
 
@@ -36,6 +37,15 @@ type MySQLDbPersistence interface {
 	Persist(ctx *sql.Context, data []byte) error
 }
 
+// MySQLDbLoader is implemented by MySQLDbPersistence implementations that can read back the data they persist. When the
+// persister implements it, FLUSH PRIVILEGES reloads the MySQL Db tables from the persisted data, so that changes made to
+// it outside the engine take effect. Changes made to the tables with DML statements since they were last persisted are
+// discarded then.
+type MySQLDbLoader interface {
+	// Load returns the data last persisted, in the format passed to Persist, or nil if there's none.
+	Load(ctx *sql.Context) ([]byte, error)
+}
+
 // NoopPersister is used when nothing in mysql db should be persisted
 type NoopPersister struct{}
 
@@ -217,6 +227,38 @@ func (db *MySQLDb) LoadData(ctx *sql.Context, buf []byte) (err error) {
 	return
 }
 
+// Reload replaces the data of the MySQL Db tables with the data read back from the persister, if it implements
+// MySQLDbLoader, keeping the super users, which aren't persisted. Otherwise, it persists the tables like Persist.
+func (db *MySQLDb) Reload(ctx *sql.Context) error {
+	loader, ok := db.persister.(MySQLDbLoader)
+	if !ok {
+		return db.Persist(ctx)
+	}
+	buf, err := loader.Load(ctx)
+	if err != nil {
+		return err
+	}
+
+	var superUsers []in_mem_table.Entry
+	for _, entry := range db.user.data.ToSlice(ctx) {
+		if entry.(*User).IsSuperUser {
+			superUsers = append(superUsers, entry)
+		}
+	}
+	db.user.data.Clear()
+	db.role_edges.data.Clear()
+	db.replica_source_info.data.Clear()
+	for _, superUser := range superUsers {
+		if err = db.user.data.Put(ctx, superUser); err != nil {
+			return err
+		}
+	}
+	// Sessions refresh their privileges when the counter changes, even if there was nothing to load
+	db.updateCounter++
+
+	return db.LoadData(ctx, buf)
+}
+
 // SetPersister sets the custom persister to be used when the MySQL Db tables have been updated and need to be persisted.
 func (db *MySQLDb) SetPersister(persister MySQLDbPersistence) {
 	db.persister = persister
@@ -467,7 +509,7 @@ func (db *MySQLDb) ValidateHash(salt []byte, user string, authResponse []byte, a
 	}
 
 	if !db.Enabled {
-		return MysqlConnectionUser{User: user, Host: host}, nil
+		return MysqlConnectionUser{User: user, Host: host, AuthMethod: mysql.MysqlNativePassword}, nil
 	}
 
 	userEntry := db.GetUser(user, host, false)
@@ -483,7 +525,7 @@ func (db *MySQLDb) ValidateHash(salt []byte, user string, authResponse []byte, a
 		return nil, mysql.NewSQLError(mysql.ERAccessDeniedError, mysql.SSAccessDeniedError, "Access denied for user '%v'", user)
 	}
 
-	return MysqlConnectionUser{User: userEntry.User, Host: userEntry.Host}, nil
+	return MysqlConnectionUser{User: userEntry.User, Host: userEntry.Host, AuthMethod: mysql.MysqlNativePassword}, nil
 }
 
 // Negotiate implements the interface mysql.AuthServer. This is called when the method used is not "mysql_native_password".
@@ -521,6 +563,7 @@ func (db *MySQLDb) Negotiate(c *mysql.Conn, user string, addr net.Addr) (mysql.G
 		if !authed {
 			return nil, mysql.NewSQLError(mysql.ERAccessDeniedError, mysql.SSAccessDeniedError, "Access denied for user '%v'", user)
 		}
+		connUser.AuthMethod = userEntry.Plugin
 		return connUser, nil
 	}
 	return nil, fmt.Errorf(`the only user login interface currently supported is "mysql_native_password"`)
@@ -45,6 +45,10 @@ type User struct {
 
 var _ in_mem_table.Entry = (*User)(nil)
 
+// UserAttributeSessionVariables is the key of the user attributes, as given by CREATE USER ... ATTRIBUTE, holding the
+// object of the session variables to set in every new session of the user. These override the server's defaults.
+const UserAttributeSessionVariables = "session_variables"
+
 // NewFromRow implements the interface in_mem_table.Entry.
 func (u *User) NewFromRow(ctx *sql.Context, row sql.Row) (in_mem_table.Entry, error) {
 	if err := userTblSchema.CheckRow(row); err != nil {
@@ -171,6 +175,27 @@ func (u User) UserHostToString(quote string) string {
 	return fmt.Sprintf("%s%s%s@%s%s%s", quote, user, quote, quote, host, quote)
 }
 
+// SessionVariables returns the session variables to set in every new session of the user, read from the
+// UserAttributeSessionVariables object of its attributes. Returns nil if the user has no such attribute.
+func (u *User) SessionVariables() (map[string]interface{}, error) {
+	if u.Attributes == nil {
+		return nil, nil
+	}
+	var attributes map[string]interface{}
+	if err := json.Unmarshal([]byte(*u.Attributes), &attributes); err != nil {
+		return nil, sql.ErrInvalidJson.New(err.Error())
+	}
+	val, ok := attributes[UserAttributeSessionVariables]
+	if !ok || val == nil {
+		return nil, nil
+	}
+	vars, ok := val.(map[string]interface{})
+	if !ok {
+		return nil, sql.ErrInvalidJson.New(fmt.Sprintf("user attribute '%s' must be an object", UserAttributeSessionVariables))
+	}
+	return vars, nil
+}
+
 // rowToPrivSet returns a set of privileges for the given row.
 func (u *User) rowToPrivSet(ctx *sql.Context, row sql.Row) PrivilegeSet {
 	privSet := NewPrivilegeSet()
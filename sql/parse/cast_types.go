@@ -0,0 +1,120 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+)
+
+// castTypeName prefixes the names that rewriteCastTypes gives the types of casts the parser rejects, in place of a
+// character set name.
+const castTypeName = "GMS_CAST_AS_"
+
+// castTypes are the types of CAST and CONVERT that the parser rejects, mapped to whether they may have a precision.
+var castTypes = map[string]bool{
+	"YEAR":               false,
+	"FLOAT":              true,
+	"DOUBLE":             false,
+	"REAL":               false,
+	"POINT":              false,
+	"LINESTRING":         false,
+	"POLYGON":            false,
+	"MULTIPOINT":         false,
+	"MULTILINESTRING":    false,
+	"MULTIPOLYGON":       false,
+	"GEOMETRYCOLLECTION": false,
+}
+
+// rewriteCastTypes rewrites the types of CAST(expr AS type) and CONVERT(expr, type) that the parser rejects, such as
+// YEAR, FLOAT(p) and POINT, into CHAR with a character set the parser accepts in its place, such as
+// CHAR(p) GMS_CAST_AS_FLOAT, which castTypeName tells apart from a character set when the cast is converted. It returns
+// the edits that rewrite the types.
+// TODO: remove this once the parser supports these cast types
+func rewriteCastTypes(query string) []queryEdit {
+	if !containsKeyPartWord(query, "CAST") && !containsKeyPartWord(query, "CONVERT") {
+		return nil
+	}
+	toks := tokenizeKeyParts(query)
+
+	var edits []queryEdit
+	for i := 0; i+1 < len(toks); i++ {
+		if toks[i].val != "CAST" && toks[i].val != "CONVERT" || toks[i+1].val != "(" {
+			continue
+		}
+		end := matchingKeyPartParen(toks, i+1)
+		if end < 0 {
+			continue
+		}
+
+		// The type follows the last AS of CAST, or the first comma of CONVERT, outside any nested parentheses
+		typ := -1
+		depth := 0
+		for j := i + 1; j < end; j++ {
+			switch toks[j].val {
+			case "(":
+				depth++
+			case ")":
+				depth--
+			case "AS":
+				if depth == 1 && toks[i].val == "CAST" {
+					typ = j + 1
+				}
+			case ",":
+				if depth == 1 && toks[i].val == "CONVERT" && typ < 0 {
+					typ = j + 1
+				}
+			}
+		}
+		if typ < 0 || typ >= end {
+			continue
+		}
+		precision, ok := castTypes[toks[typ].val]
+		if !ok {
+			continue
+		}
+		length := ""
+		next := typ + 1
+		if precision && next+2 < end && toks[next].val == "(" && toks[next+2].val == ")" {
+			length = query[toks[next].start:toks[next+2].end]
+			next += 3
+		}
+		if next != end {
+			continue
+		}
+		edits = append(edits, queryEdit{
+			start: toks[typ].start,
+			end:   toks[end-1].end,
+			text:  "CHAR" + length + " " + castTypeName + toks[typ].val,
+		})
+	}
+
+	// The casts nested in another one come after it, but their types come before its type
+	sort.Slice(edits, func(i, j int) bool {
+		return edits[i].start < edits[j].start
+	})
+	return edits
+}
+
+// castType returns the type of the cast given, the type rewriteCastTypes rewrote if it did.
+func castType(typ *sqlparser.ConvertType) string {
+	castTo := strings.ToLower(typ.Type)
+	if castTo == "char" && strings.HasPrefix(strings.ToUpper(typ.Charset), castTypeName) {
+		return strings.ToLower(typ.Charset[len(castTypeName):])
+	}
+	return castTo
+}
@@ -0,0 +1,87 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// checkTableOptions are the options CHECK TABLE accepts after its tables. They select how thorough the check is in
+// MySQL, which makes no difference here.
+var checkTableOptions = map[string]bool{
+	"QUICK":    true,
+	"FAST":     true,
+	"MEDIUM":   true,
+	"EXTENDED": true,
+	"CHANGED":  true,
+}
+
+// splitCheckTable returns the CHECK TABLE statement the query given begins with, which the parser doesn't support,
+// and the remainder of the query following it if |multi| is set. It returns false if the query isn't a CHECK TABLE
+// statement.
+func splitCheckTable(query string, multi bool) (string, string, bool) {
+	if !hasLeadingKeyPartWords(query, "CHECK", "TABLE") {
+		return "", "", false
+	}
+	if multi {
+		for _, tok := range tokenizeKeyParts(query) {
+			if tok.val == ";" {
+				return strings.TrimSpace(query[:tok.start]), query[tok.end:], true
+			}
+		}
+	}
+	return query, "", true
+}
+
+// convertCheckTable converts the statement given:
+//
+//	CHECK TABLE tbl_name [, tbl_name] ... [option] ...
+//
+//	option: {FOR UPGRADE | QUICK | FAST | MEDIUM | EXTENDED | CHANGED}
+//
+// to a CheckTable node with the tables as unresolved children.
+func convertCheckTable(query string) (sql.Node, error) {
+	toks := tokenizeKeyParts(query)
+	p := &handlerParser{query: query, toks: toks, pos: 2}
+
+	var tables []sql.Node
+	for {
+		db, name, ok := p.tableName()
+		if !ok {
+			return nil, p.syntaxError()
+		}
+		tables = append(tables, plan.NewUnresolvedTable(name, db))
+		if p.peek().val != "," {
+			break
+		}
+		p.next()
+	}
+
+	for p.pos < len(toks) {
+		switch tok := p.next(); {
+		case checkTableOptions[tok.val]:
+		case tok.val == "FOR" && p.peek().val == "UPGRADE":
+			p.next()
+		default:
+			p.pos--
+			return nil, p.syntaxError()
+		}
+	}
+
+	return plan.NewCheckTable(tables), nil
+}
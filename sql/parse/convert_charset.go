@@ -0,0 +1,76 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"regexp"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+var convertToCharsetRegex = regexp.MustCompile("(?is)^(\\s*ALTER\\s+(?:IGNORE\\s+)?TABLE\\s+(?:`[^`]*`|[^\\s`])+\\s+)CONVERT\\s+TO\\s+(?:CHARACTER\\s+SET|CHARSET)\\b")
+
+var alterIgnoreRegex = regexp.MustCompile("(?is)^\\s*ALTER\\s+IGNORE\\s+TABLE\\b")
+
+// rewriteConvertToCharset rewrites ALTER TABLE ... CONVERT TO CHARACTER SET into ALTER TABLE ... CHARACTER SET, which
+// the parser accepts, and returns whether the statement was rewritten. withConvertColumns must be applied to the
+// resulting node to restore the meaning of the statement.
+// TODO: remove this once the parser supports CONVERT TO CHARACTER SET
+func rewriteConvertToCharset(query string) (string, bool) {
+	if !hasLeadingKeyPartWords(query, "ALTER") || !convertToCharsetRegex.MatchString(query) {
+		return query, false
+	}
+	return convertToCharsetRegex.ReplaceAllString(query, "${1}CHARACTER SET"), true
+}
+
+// withConvertColumns marks the node for a rewritten ALTER TABLE ... CONVERT TO CHARACTER SET statement as converting
+// the table's columns, rather than only its default collation.
+func withConvertColumns(node sql.Node) sql.Node {
+	if atc, ok := node.(*plan.AlterTableCollation); ok {
+		natc := *atc
+		natc.ConvertColumns = true
+		return &natc
+	}
+	return node
+}
+
+// isAlterIgnore returns whether the query is an ALTER IGNORE TABLE statement. The parser accepts IGNORE but drops it,
+// so withAlterIgnore must be applied to the resulting node to restore it.
+// TODO: remove this once the parser keeps IGNORE
+func isAlterIgnore(query string) bool {
+	return hasLeadingKeyPartWords(query, "ALTER") && alterIgnoreRegex.MatchString(query)
+}
+
+// withAlterIgnore marks the column changes and character set conversions of the node for an ALTER IGNORE TABLE
+// statement as replacing the values they can't convert rather than failing.
+func withAlterIgnore(node sql.Node) sql.Node {
+	node, _, _ = transform.Node(node, func(n sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		switch n := n.(type) {
+		case *plan.ModifyColumn:
+			nn := *n
+			nn.Ignore = true
+			return &nn, transform.NewTree, nil
+		case *plan.AlterTableCollation:
+			nn := *n
+			nn.Ignore = true
+			return &nn, transform.NewTree, nil
+		default:
+			return n, transform.SameTree, nil
+		}
+	})
+	return node
+}
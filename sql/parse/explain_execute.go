@@ -0,0 +1,63 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// splitExplainExecute splits the EXPLAIN [FORMAT = format] EXECUTE statement given, which the parser doesn't support,
+// into its format, its EXECUTE statement and the text preceding the EXECUTE statement. It returns false if the query
+// isn't an EXPLAIN of an EXECUTE statement.
+func splitExplainExecute(query string) (string, string, string, bool) {
+	if !hasLeadingKeyPartWords(query, "EXPLAIN") {
+		return "", "", "", false
+	}
+	// EXPLAIN FORMAT = format EXECUTE takes five tokens at most
+	toks := tokenizeKeyPartsN(query, 5)
+	if len(toks) < 3 {
+		return "", "", "", false
+	}
+	var format string
+	i := 1
+	if toks[i].val == "FORMAT" && len(toks) > i+3 && toks[i+1].val == "=" {
+		format = query[toks[i+2].start:toks[i+2].end]
+		i += 3
+	}
+	if toks[i].val != "EXECUTE" {
+		return "", "", "", false
+	}
+	return format, query[toks[i].start:], query[:toks[i].start], true
+}
+
+// parseExplainExecute parses the EXPLAIN of the EXECUTE statement given, which describes the plan of the prepared
+// statement executed for the values of its parameters.
+func parseExplainExecute(ctx *sql.Context, format, execute, prefix string, multi bool) (sql.Node, string, string, error) {
+	explainFmt, err := convertExplainFormat(format)
+	if err != nil {
+		return nil, prefix + execute, "", err
+	}
+	node, parsed, remainder, err := parse(ctx, execute, multi)
+	if err != nil {
+		return nil, prefix + parsed, remainder, err
+	}
+	if _, ok := node.(*plan.ExecuteQuery); !ok {
+		return nil, prefix + parsed, remainder, sql.ErrSyntaxError.New(fmt.Sprintf("unexpected statement after EXPLAIN: %s", parsed))
+	}
+	return plan.NewDescribeQuery(explainFmt, node), prefix + parsed, remainder, nil
+}
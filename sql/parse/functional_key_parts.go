@@ -0,0 +1,393 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+// functionalKeyPartName is the prefix of the column names rewriteFunctionalKeyParts puts in place of functional key
+// parts. A number that makes the name unique in the query follows it.
+const functionalKeyPartName = "GMS_KEY_PART_"
+
+// functionalKeyParts maps the column names rewriteFunctionalKeyParts puts in place of functional key parts, in lower
+// case, to the text of the key part expressions.
+type functionalKeyParts map[string]string
+
+// keyPartToken is a token of a query as seen by rewriteFunctionalKeyParts. Unquoted words are upper-cased, quoted
+// strings and identifiers have an empty value, and any other character is a token of its own.
+type keyPartToken struct {
+	val        string
+	start, end int
+}
+
+// rewriteFunctionalKeyParts rewrites the functional key parts of the index definitions in a CREATE TABLE, CREATE INDEX
+// or ALTER TABLE statement, such as ((LOWER(email))) in CREATE INDEX idx ON t ((LOWER(email))), into column names that
+// the parser accepts and that appear nowhere else in the query. It returns the rewritten query along with the key
+// parts it replaced, which applyFunctionalKeyParts puts back into the converted statement. Any other query is returned
+// unchanged, with no key parts.
+// TODO: remove this once the parser supports functional key parts
+func rewriteFunctionalKeyParts(query string) (string, functionalKeyParts) {
+	if !strings.Contains(query, "(") {
+		return query, nil
+	}
+	words := leadingKeyPartWords(query, 2)
+	if len(words) < 2 {
+		return query, nil
+	}
+	switch words[0] + " " + words[1] {
+	case "CREATE TABLE", "CREATE TEMPORARY", "CREATE UNIQUE", "CREATE SPATIAL", "CREATE FULLTEXT", "CREATE INDEX",
+		"ALTER TABLE":
+	default:
+		return query, nil
+	}
+	toks := tokenizeKeyParts(query)
+
+	var parts functionalKeyParts
+	next := 1
+
+	var sb strings.Builder
+	last := 0
+	for i := 1; i < len(toks); i++ {
+		switch toks[i].val {
+		case "INDEX", "KEY", "UNIQUE":
+		default:
+			continue
+		}
+		if toks[i-1].val == "FOREIGN" {
+			continue
+		}
+
+		// Skip the index type, name, USING clause and, for CREATE INDEX, the table to find the key part list
+		j := i + 1
+		if toks[i].val == "UNIQUE" && j < len(toks) && (toks[j].val == "INDEX" || toks[j].val == "KEY") {
+			j++
+		}
+		if j < len(toks) && toks[j].val != "(" && toks[j].val != "USING" && toks[j].val != "ON" {
+			j++
+		}
+		if j < len(toks) && toks[j].val == "USING" {
+			j += 2
+		}
+		if j < len(toks) && toks[j].val == "ON" {
+			j += 2
+			if j < len(toks) && toks[j].val == "." {
+				j += 2
+			}
+		}
+		if j >= len(toks) || toks[j].val != "(" {
+			continue
+		}
+
+		depth := 1
+		partStart := true
+		for j++; j < len(toks) && depth > 0; j++ {
+			switch toks[j].val {
+			case "(":
+				if depth == 1 && partStart {
+					end := matchingKeyPartParen(toks, j)
+					if end < 0 {
+						return query, nil
+					}
+					name := functionalKeyPartName + strconv.Itoa(next)
+					for containsKeyPartWord(query, name) {
+						next++
+						name = functionalKeyPartName + strconv.Itoa(next)
+					}
+					next++
+					if parts == nil {
+						parts = make(functionalKeyParts)
+					}
+					parts[strings.ToLower(name)] = strings.TrimSpace(query[toks[j].end:toks[end].start])
+					sb.WriteString(query[last:toks[j].start])
+					sb.WriteString(name)
+					last = toks[end].end
+					j = end
+				} else {
+					depth++
+				}
+				partStart = false
+			case ")":
+				depth--
+			case ",":
+				partStart = depth == 1
+			default:
+				partStart = false
+			}
+		}
+		i = j - 1
+	}
+	if last == 0 {
+		return query, nil
+	}
+	sb.WriteString(query[last:])
+	return sb.String(), parts
+}
+
+// tokenizeKeyParts splits the query given into the tokens rewriteFunctionalKeyParts inspects, skipping whitespace and
+// comments.
+func tokenizeKeyParts(query string) []keyPartToken {
+	return tokenizeKeyPartsN(query, -1)
+}
+
+// tokenizeKeyPartsN returns the first |n| tokens of the query given, like tokenizeKeyParts, or all of them if |n| is
+// negative.
+func tokenizeKeyPartsN(query string, n int) []keyPartToken {
+	var toks []keyPartToken
+	for i := 0; n < 0 || len(toks) < n; {
+		tok, ok := nextKeyPartToken(query, i)
+		if !ok {
+			return toks
+		}
+		if isKeyPartWordChar(query[tok.start]) {
+			tok.val = strings.ToUpper(tok.val)
+		}
+		toks = append(toks, tok)
+		i = tok.end
+	}
+	return toks
+}
+
+// nextKeyPartToken returns the first token at or after position |i| of the query given, like tokenizeKeyParts, but
+// with words as they're written rather than upper-cased. It returns false at the end of the query.
+func nextKeyPartToken(query string, i int) (keyPartToken, bool) {
+	for i < len(query) {
+		c := query[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '#' || strings.HasPrefix(query[i:], "-- "):
+			for i < len(query) && query[i] != '\n' {
+				i++
+			}
+		case strings.HasPrefix(query[i:], "/*"):
+			end := strings.Index(query[i+2:], "*/")
+			if end < 0 {
+				return keyPartToken{}, false
+			}
+			i += end + 4
+		case c == '\'' || c == '"' || c == '`':
+			start := i
+			for i++; i < len(query); i++ {
+				if query[i] == '\\' && c != '`' {
+					i++
+				} else if query[i] == c {
+					if i+1 < len(query) && query[i+1] == c {
+						i++
+					} else {
+						break
+					}
+				}
+			}
+			i++
+			if i > len(query) {
+				i = len(query)
+			}
+			return keyPartToken{start: start, end: i}, true
+		case isKeyPartWordChar(c):
+			start := i
+			for i < len(query) && isKeyPartWordChar(query[i]) {
+				i++
+			}
+			return keyPartToken{val: query[start:i], start: start, end: i}, true
+		default:
+			return keyPartToken{val: query[i : i+1], start: i, end: i + 1}, true
+		}
+	}
+	return keyPartToken{}, false
+}
+
+// leadingKeyPartWords returns up to |n| of the words the query given begins with, upper-cased. It stops at the first
+// token that isn't a word. This tells the kind of a statement without tokenizing all of it, which is costly for large
+// statements such as bulk inserts.
+func leadingKeyPartWords(query string, n int) []string {
+	words := make([]string, 0, n)
+	for i := 0; len(words) < n; {
+		tok, ok := nextKeyPartToken(query, i)
+		if !ok || !isKeyPartWordChar(query[tok.start]) {
+			break
+		}
+		words = append(words, strings.ToUpper(tok.val))
+		i = tok.end
+	}
+	return words
+}
+
+// containsKeyPartWord returns whether the word given, in upper case, appears in the query given as a whole word, in
+// any case. It's a cheap check of whether a query may need to be tokenized, as it doesn't tell words apart from the
+// contents of strings and comments.
+func containsKeyPartWord(query, word string) bool {
+	lower := word[0] + 'a' - 'A'
+	for i := 0; i+len(word) <= len(query); i++ {
+		if c := query[i]; c != word[0] && c != lower || !strings.EqualFold(query[i:i+len(word)], word) {
+			continue
+		}
+		if i > 0 && isKeyPartWordChar(query[i-1]) || i+len(word) < len(query) && isKeyPartWordChar(query[i+len(word)]) {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+// hasLeadingKeyPartWords returns whether the query given begins with the words given, ignoring case.
+func hasLeadingKeyPartWords(query string, words ...string) bool {
+	i := 0
+	for _, word := range words {
+		tok, ok := nextKeyPartToken(query, i)
+		if !ok || !strings.EqualFold(tok.val, word) {
+			return false
+		}
+		i = tok.end
+	}
+	return true
+}
+
+func isKeyPartWordChar(c byte) bool {
+	return c == '_' || c == '$' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= 0x80
+}
+
+// matchingKeyPartParen returns the index of the token closing the parenthesis opened at |open|, or -1 if there is none.
+func matchingKeyPartParen(toks []keyPartToken, open int) int {
+	depth := 0
+	for i := open; i < len(toks); i++ {
+		switch toks[i].val {
+		case "(":
+			depth++
+		case ")":
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// applyFunctionalKeyParts replaces the index columns of the node given that rewriteFunctionalKeyParts put in place of
+// functional key parts with the expressions of the key parts. It returns an error if a key part isn't an index column
+// of the node, such as for an index of an external driver.
+func applyFunctionalKeyParts(ctx *sql.Context, node sql.Node, parts functionalKeyParts) (sql.Node, error) {
+	applied := make(map[string]struct{}, len(parts))
+	withKeyParts := func(cols []sql.IndexColumn) ([]sql.IndexColumn, error) {
+		var newCols []sql.IndexColumn
+		for i, col := range cols {
+			name := strings.ToLower(col.Name)
+			exprStr, ok := parts[name]
+			if !ok || col.Expression != nil {
+				continue
+			}
+			expr, err := functionalKeyPartExpression(ctx, exprStr)
+			if err != nil {
+				return nil, err
+			}
+			if col.Length != 0 {
+				return nil, sql.ErrFunctionalIndexPrefix.New(expr.String())
+			}
+			if newCols == nil {
+				newCols = make([]sql.IndexColumn, len(cols))
+				copy(newCols, cols)
+			}
+			newCols[i] = sql.IndexColumn{Expression: expr, Descending: col.Descending}
+			applied[name] = struct{}{}
+		}
+		return newCols, nil
+	}
+
+	node, _, err := transform.Node(node, func(n sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		switch n := n.(type) {
+		case *plan.AlterIndex:
+			cols, err := withKeyParts(n.Columns)
+			if err != nil || cols == nil {
+				return n, transform.SameTree, err
+			}
+			nn := *n
+			nn.Columns = cols
+			return &nn, transform.NewTree, nil
+		case *plan.AlterPK:
+			cols, err := withKeyParts(n.Columns)
+			if err != nil || cols == nil {
+				return n, transform.SameTree, err
+			}
+			nn := *n
+			nn.Columns = cols
+			return &nn, transform.NewTree, nil
+		case *plan.CreateTable:
+			var idxDefs []*plan.IndexDefinition
+			for i, idxDef := range n.IdxDefs {
+				cols, err := withKeyParts(idxDef.Columns)
+				if err != nil {
+					return n, transform.SameTree, err
+				}
+				if cols == nil {
+					continue
+				}
+				if idxDefs == nil {
+					idxDefs = make([]*plan.IndexDefinition, len(n.IdxDefs))
+					copy(idxDefs, n.IdxDefs)
+				}
+				newIdxDef := *idxDef
+				newIdxDef.Columns = cols
+				idxDefs[i] = &newIdxDef
+			}
+			if idxDefs == nil {
+				return n, transform.SameTree, nil
+			}
+			nn := *n
+			nn.IdxDefs = idxDefs
+			return &nn, transform.NewTree, nil
+		default:
+			return n, transform.SameTree, nil
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for name, exprStr := range parts {
+		if _, ok := applied[name]; !ok {
+			return nil, sql.ErrUnsupportedFeature.New(fmt.Sprintf("functional key part (%s) in this statement", exprStr))
+		}
+	}
+	return node, nil
+}
+
+// functionalKeyPartExpression parses the text of a functional key part into an expression.
+func functionalKeyPartExpression(ctx *sql.Context, exprStr string) (sql.Expression, error) {
+	stmt, err := sqlparser.Parse("SELECT " + exprStr)
+	if err != nil {
+		return nil, sql.ErrSyntaxError.New(err.Error())
+	}
+	parserSelect, ok := stmt.(*sqlparser.Select)
+	if !ok || len(parserSelect.SelectExprs) != 1 {
+		return nil, sql.ErrSyntaxError.New(fmt.Sprintf("invalid functional key part: %s", exprStr))
+	}
+	aliasedExpr, ok := parserSelect.SelectExprs[0].(*sqlparser.AliasedExpr)
+	if !ok {
+		return nil, sql.ErrSyntaxError.New(fmt.Sprintf("invalid functional key part: %s", exprStr))
+	}
+	if _, ok := aliasedExpr.Expr.(*sqlparser.ColName); ok {
+		return nil, sql.ErrFunctionalIndexOnColumn.New()
+	}
+	return ExprToExpression(ctx, aliasedExpr.Expr)
+}
@@ -0,0 +1,246 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+var handlerReadModes = map[string]plan.HandlerReadMode{
+	"FIRST": plan.HandlerReadFirst,
+	"NEXT":  plan.HandlerReadNext,
+	"PREV":  plan.HandlerReadPrev,
+	"LAST":  plan.HandlerReadLast,
+	"=":     plan.HandlerReadEqual,
+	">=":    plan.HandlerReadGreaterOrEqual,
+	">":     plan.HandlerReadGreater,
+	"<=":    plan.HandlerReadLessOrEqual,
+	"<":     plan.HandlerReadLess,
+}
+
+// splitHandlerStatement returns the HANDLER statement the query given begins with, which the parser doesn't support,
+// and the remainder of the query following it if |multi| is set. It returns false if the query isn't a HANDLER
+// statement.
+func splitHandlerStatement(query string, multi bool) (string, string, bool) {
+	if !hasLeadingKeyPartWords(query, "HANDLER") {
+		return "", "", false
+	}
+	if multi {
+		for _, tok := range tokenizeKeyParts(query) {
+			if tok.val == ";" {
+				return strings.TrimSpace(query[:tok.start]), query[tok.end:], true
+			}
+		}
+	}
+	return query, "", true
+}
+
+// convertHandler converts the statement given, one of:
+//
+//	HANDLER tbl_name OPEN [[AS] alias]
+//	HANDLER tbl_name READ {FIRST | NEXT | PREV | LAST} [WHERE where_condition] [LIMIT ...]
+//	HANDLER tbl_name READ index_name {FIRST | NEXT | PREV | LAST} [WHERE where_condition] [LIMIT ...]
+//	HANDLER tbl_name READ index_name {= | <= | >= | < | >} (value1, value2, ...) [WHERE where_condition] [LIMIT ...]
+//	HANDLER tbl_name CLOSE
+//
+// The handler's table is looked up when a READ statement is converted, so that its WHERE clause can be resolved
+// against it.
+func convertHandler(ctx *sql.Context, query string) (sql.Node, error) {
+	toks := tokenizeKeyParts(query)
+	p := &handlerParser{query: query, toks: toks, pos: 1}
+
+	db, name, ok := p.tableName()
+	if !ok {
+		return nil, p.syntaxError()
+	}
+
+	switch p.next().val {
+	case "OPEN":
+		alias := name
+		if p.peek().val == "AS" {
+			p.next()
+		}
+		if p.pos < len(toks) {
+			if alias, ok = p.identifier(); !ok {
+				return nil, p.syntaxError()
+			}
+		}
+		if p.pos < len(toks) {
+			return nil, p.syntaxError()
+		}
+		return plan.NewHandlerOpen(plan.NewUnresolvedTable(name, db), alias), nil
+	case "CLOSE":
+		if p.pos < len(toks) {
+			return nil, p.syntaxError()
+		}
+		return plan.NewHandlerClose(name), nil
+	case "READ":
+		return p.read(ctx, name)
+	default:
+		return nil, p.syntaxError()
+	}
+}
+
+// handlerParser parses the tokens of a HANDLER statement.
+type handlerParser struct {
+	query string
+	toks  []keyPartToken
+	pos   int
+}
+
+func (p *handlerParser) peek() keyPartToken {
+	if p.pos >= len(p.toks) {
+		return keyPartToken{start: len(p.query), end: len(p.query)}
+	}
+	return p.toks[p.pos]
+}
+
+func (p *handlerParser) next() keyPartToken {
+	tok := p.peek()
+	p.pos++
+	return tok
+}
+
+func (p *handlerParser) syntaxError() error {
+	near := strings.TrimSpace(p.query[p.toks[len(p.toks)-1].end:])
+	if p.pos < len(p.toks) {
+		near = p.query[p.toks[p.pos].start:]
+	}
+	return sql.ErrSyntaxError.New(fmt.Sprintf("syntax error at position %d near '%s'", p.peek().start+1, near))
+}
+
+// identifier consumes a plain or backtick-quoted identifier.
+func (p *handlerParser) identifier() (string, bool) {
+	tok := p.peek()
+	text := p.query[tok.start:tok.end]
+	switch {
+	case tok.val != "" && isKeyPartWordChar(text[0]):
+	case strings.HasPrefix(text, "`") && strings.HasSuffix(text, "`") && len(text) > 1:
+		text = strings.ReplaceAll(text[1:len(text)-1], "``", "`")
+	default:
+		return "", false
+	}
+	p.pos++
+	return text, true
+}
+
+// tableName consumes a table name, qualified with its database or not.
+func (p *handlerParser) tableName() (string, string, bool) {
+	name, ok := p.identifier()
+	if !ok {
+		return "", "", false
+	}
+	if p.peek().val != "." {
+		return "", name, true
+	}
+	p.pos++
+	table, ok := p.identifier()
+	return name, table, ok
+}
+
+func (p *handlerParser) read(ctx *sql.Context, name string) (sql.Node, error) {
+	var index string
+	mode, ok := handlerReadModes[p.peek().val]
+	if !ok || mode.IsKeyed() || p.pos+1 < len(p.toks) && p.toks[p.pos+1].val != "WHERE" && p.toks[p.pos+1].val != "LIMIT" {
+		if index, ok = p.identifier(); !ok {
+			return nil, p.syntaxError()
+		}
+		op := p.next()
+		opStr := op.val
+		if (opStr == "<" || opStr == ">") && p.peek().val == "=" && p.peek().start == op.end {
+			opStr += p.next().val
+		}
+		if mode, ok = handlerReadModes[opStr]; !ok {
+			p.pos--
+			return nil, p.syntaxError()
+		}
+	} else {
+		p.pos++
+	}
+
+	var key []sql.Expression
+	if mode.IsKeyed() {
+		if p.peek().val != "(" {
+			return nil, p.syntaxError()
+		}
+		end := matchingKeyPartParen(p.toks, p.pos)
+		if end < 0 {
+			return nil, p.syntaxError()
+		}
+		stmt, err := sqlparser.Parse("SELECT " + p.query[p.toks[p.pos].end:p.toks[end].start])
+		if err != nil {
+			return nil, sql.ErrSyntaxError.New(err.Error())
+		}
+		for _, se := range stmt.(*sqlparser.Select).SelectExprs {
+			ae, ok := se.(*sqlparser.AliasedExpr)
+			if !ok {
+				return nil, p.syntaxError()
+			}
+			e, err := ExprToExpression(ctx, ae.Expr)
+			if err != nil {
+				return nil, err
+			}
+			key = append(key, e)
+		}
+		p.pos = end + 1
+	}
+
+	var where sql.Expression
+	var limit sql.Expression = expression.NewLiteral(int64(1), types.Int64)
+	var offset sql.Expression
+	if p.pos < len(p.toks) {
+		if v := p.peek().val; v != "WHERE" && v != "LIMIT" {
+			return nil, p.syntaxError()
+		}
+		stmt, err := sqlparser.Parse("SELECT * FROM dual " + p.query[p.peek().start:])
+		if err != nil {
+			return nil, sql.ErrSyntaxError.New(err.Error())
+		}
+		sel, ok := stmt.(*sqlparser.Select)
+		if !ok || len(sel.OrderBy) > 0 || len(sel.GroupBy) > 0 || sel.Having != nil || sel.Lock != "" || sel.Into != nil {
+			return nil, p.syntaxError()
+		}
+		if sel.Where != nil {
+			if where, err = ExprToExpression(ctx, sel.Where.Expr); err != nil {
+				return nil, err
+			}
+		}
+		if sel.Limit != nil {
+			if limit, err = ExprToExpression(ctx, sel.Limit.Rowcount); err != nil {
+				return nil, err
+			}
+			if sel.Limit.Offset != nil {
+				if offset, err = ExprToExpression(ctx, sel.Limit.Offset); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	h := ctx.GetHandler(name)
+	if h == nil {
+		return nil, sql.ErrUnknownHandler.New(name)
+	}
+	table := plan.NewTableAlias(name, plan.NewUnresolvedTable(h.Table, h.Database))
+	return plan.NewHandlerRead(table, name, index, mode, key, where, limit, offset), nil
+}
@@ -0,0 +1,324 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// rewriteInsertRowAlias rewrites the row alias of an INSERT statement, such as
+// INSERT INTO t (a, b) VALUES (1, 2) AS new ON DUPLICATE KEY UPDATE b = new.b, into the VALUES() function form that
+// the parser accepts, INSERT INTO t (a, b) VALUES (1, 2) ON DUPLICATE KEY UPDATE b = VALUES(b). The row alias may
+// follow the rows of VALUES or the assignments of SET. Column aliases, as in AS new(x, y), are mapped to the columns
+// of the insert column list, or of the assignments of SET, and may be referenced with or without the row alias.
+// Without either, they're mapped to the columns of the table by their position, with names of plan.RowAliasColumnName
+// that are replaced with the names of the columns once the table is resolved. A row alias that can't be rewritten,
+// such as column aliases that don't match the values of the rows, is left as it is, for the parser to reject. It
+// returns the edits that rewrite the row alias.
+// TODO: remove this once the parser supports row aliases
+func rewriteInsertRowAlias(query string) []queryEdit {
+	if !hasInsertRowAlias(query) {
+		return nil
+	}
+	toks := tokenizeKeyParts(query)
+
+	// The insert column list, if any, is the first parenthesized list before VALUES or SET
+	values := -1
+	set := false
+	var columns []string
+	for i := 1; i < len(toks) && values < 0; i++ {
+		switch toks[i].val {
+		case "VALUES", "VALUE":
+			values = i
+		case "SET":
+			values = i
+			set = true
+		case "SELECT", "TABLE":
+			return nil
+		case "PARTITION":
+			if i+1 < len(toks) && toks[i+1].val == "(" {
+				i = matchingKeyPartParen(toks, i+1)
+				if i < 0 {
+					return nil
+				}
+			}
+		case "(":
+			end := matchingKeyPartParen(toks, i)
+			if end < 0 {
+				return nil
+			}
+			if columns == nil {
+				columns = rowAliasIdentifiers(query, toks[i+1:end])
+			}
+			i = end
+		}
+	}
+	if values < 0 {
+		return nil
+	}
+
+	var as int
+	rowLen := len(columns)
+	if set {
+		if columns, as = setRowAliasColumns(query, toks, values+1); columns == nil {
+			return nil
+		}
+		rowLen = len(columns)
+	} else {
+		// Skip the rows to find the alias
+		as = values + 1
+		for as < len(toks) && toks[as].val == "(" {
+			end := matchingKeyPartParen(toks, as)
+			if end < 0 {
+				return nil
+			}
+			if as == values+1 && columns == nil {
+				rowLen = rowAliasValueCount(toks, as, end)
+			}
+			as = end + 1
+			if as < len(toks) && toks[as].val == "," {
+				as++
+			}
+		}
+		if as < len(toks) && toks[as-1].val != ")" {
+			return nil
+		}
+	}
+	if as+1 >= len(toks) || toks[as].val != "AS" {
+		return nil
+	}
+	alias := rowAliasIdentifier(query, toks[as+1])
+	if alias == "" {
+		return nil
+	}
+	aliasEnd := as + 1
+	colAliases := make(map[string]string)
+	if aliasEnd+1 < len(toks) && toks[aliasEnd+1].val == "(" {
+		end := matchingKeyPartParen(toks, aliasEnd+1)
+		if end < 0 {
+			return nil
+		}
+		names := rowAliasIdentifiers(query, toks[aliasEnd+2:end])
+		if names == nil || len(names) != rowLen {
+			return nil
+		}
+		for i, name := range names {
+			if columns != nil {
+				colAliases[strings.ToLower(name)] = columns[i]
+			} else {
+				colAliases[strings.ToLower(name)] = plan.RowAliasColumnName + strconv.Itoa(i+1)
+			}
+		}
+		aliasEnd = end
+	}
+
+	edits := []queryEdit{{start: toks[as].start, end: toks[aliasEnd].end}}
+
+	// Replace the references to the alias in the expressions of the assignments of ON DUPLICATE KEY UPDATE
+	update := aliasEnd + 1
+	if update+3 < len(toks) && toks[update].val == "ON" && toks[update+1].val == "DUPLICATE" &&
+		toks[update+2].val == "KEY" && toks[update+3].val == "UPDATE" {
+		inExpr := false
+		depth := 0
+		for i := update + 4; i < len(toks); i++ {
+			switch toks[i].val {
+			case "(":
+				depth++
+				continue
+			case ")":
+				depth--
+				continue
+			case "=":
+				if depth == 0 {
+					inExpr = true
+				}
+				continue
+			case ",":
+				if depth == 0 {
+					inExpr = false
+				}
+				continue
+			}
+			if !inExpr {
+				continue
+			}
+			name := rowAliasIdentifier(query, toks[i])
+			if name == "" || i > 0 && toks[i-1].val == "." {
+				continue
+			}
+
+			var column string
+			end := i
+			if i+2 < len(toks) && toks[i+1].val == "." && strings.EqualFold(name, alias) {
+				column = rowAliasIdentifier(query, toks[i+2])
+				if c, ok := colAliases[strings.ToLower(column)]; ok {
+					column = c
+				} else if len(colAliases) > 0 {
+					continue
+				}
+				end = i + 2
+			} else if i+1 < len(toks) && toks[i+1].val == "." || i+1 < len(toks) && toks[i+1].val == "(" {
+				continue
+			} else if c, ok := colAliases[strings.ToLower(name)]; ok {
+				column = c
+			}
+			if column == "" {
+				continue
+			}
+
+			edits = append(edits, queryEdit{
+				start: toks[i].start,
+				end:   toks[end].end,
+				text:  "VALUES(`" + strings.ReplaceAll(column, "`", "``") + "`)",
+			})
+			i = end
+		}
+	}
+
+	return edits
+}
+
+// hasInsertRowAlias returns whether the query given is an INSERT statement with AS at the top level, following a
+// parenthesized list, as a row alias follows the last row of VALUES, or anywhere after SET, as it follows the
+// assignments of SET. It scans the query without keeping its tokens, so that bulk inserts aren't tokenized in full,
+// and only if AS appears in it.
+func hasInsertRowAlias(query string) bool {
+	if !hasLeadingKeyPartWords(query, "INSERT") || !containsKeyPartWord(query, "AS") {
+		return false
+	}
+	depth := 0
+	afterList := false
+	set := false
+	for i := 0; ; {
+		tok, ok := nextKeyPartToken(query, i)
+		if !ok {
+			return false
+		}
+		i = tok.end
+		switch {
+		case tok.val == "(":
+			depth++
+		case tok.val == ")":
+			depth--
+			afterList = depth == 0
+			continue
+		case depth == 0 && strings.EqualFold(tok.val, "SET"):
+			set = true
+		case depth == 0 && strings.EqualFold(tok.val, "AS"):
+			if afterList || set {
+				return true
+			}
+		}
+		afterList = false
+	}
+}
+
+// setRowAliasColumns returns the columns of the assignments of the SET form of INSERT that begin at |start|, and the
+// index of the token that follows them, or nil if they aren't assignments of columns.
+func setRowAliasColumns(query string, toks []keyPartToken, start int) ([]string, int) {
+	var columns []string
+	i := start
+	for i+1 < len(toks) {
+		// The column may be qualified with its table
+		if i+3 < len(toks) && toks[i+1].val == "." {
+			i += 2
+		}
+		name := rowAliasIdentifier(query, toks[i])
+		if name == "" || toks[i+1].val != "=" {
+			return nil, 0
+		}
+		columns = append(columns, name)
+
+		depth := 0
+		for i += 2; i < len(toks); i++ {
+			if toks[i].val == "(" {
+				depth++
+			} else if toks[i].val == ")" {
+				depth--
+			} else if depth == 0 && (toks[i].val == "," || toks[i].val == "AS" || toks[i].val == "ON" || toks[i].val == ";") {
+				break
+			}
+		}
+		if i >= len(toks) || toks[i].val != "," {
+			return columns, i
+		}
+		i++
+	}
+	return nil, 0
+}
+
+// rowAliasValueCount returns the number of values of the row of VALUES in the parentheses from |open| to |close|.
+func rowAliasValueCount(toks []keyPartToken, open, close int) int {
+	if close == open+1 {
+		return 0
+	}
+	count := 1
+	depth := 0
+	for i := open + 1; i < close; i++ {
+		switch toks[i].val {
+		case "(":
+			depth++
+		case ")":
+			depth--
+		case ",":
+			if depth == 0 {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+// rowAliasIdentifiers returns the identifiers of the comma separated list of tokens given, or nil if there's anything
+// else in the list.
+func rowAliasIdentifiers(query string, toks []keyPartToken) []string {
+	var names []string
+	for i, tok := range toks {
+		if i%2 == 1 {
+			if tok.val != "," {
+				return nil
+			}
+			continue
+		}
+		name := rowAliasIdentifier(query, tok)
+		if name == "" {
+			return nil
+		}
+		names = append(names, name)
+	}
+	if len(toks)%2 == 0 {
+		return nil
+	}
+	return names
+}
+
+// rowAliasIdentifier returns the identifier of the token given, unquoting it if it's quoted with backticks, or an empty
+// string if the token isn't an identifier.
+func rowAliasIdentifier(query string, tok keyPartToken) string {
+	text := query[tok.start:tok.end]
+	if tok.val == "" {
+		if len(text) < 2 || text[0] != '`' || text[len(text)-1] != '`' {
+			return ""
+		}
+		return strings.ReplaceAll(text[1:len(text)-1], "``", "`")
+	}
+	if !isKeyPartWordChar(tok.val[0]) || tok.val[0] >= '0' && tok.val[0] <= '9' {
+		return ""
+	}
+	return text
+}
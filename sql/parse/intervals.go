@@ -0,0 +1,129 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+// intervalUnits are the units of interval expressions, mapped to whether they're compound units. The parser only
+// accepts the compound ones, which are reserved words, in a few places, such as event schedules and window frames.
+var intervalUnits = map[string]bool{
+	"MICROSECOND":        false,
+	"SECOND":             false,
+	"MINUTE":             false,
+	"HOUR":               false,
+	"DAY":                false,
+	"WEEK":               false,
+	"MONTH":              false,
+	"QUARTER":            false,
+	"YEAR":               false,
+	"SECOND_MICROSECOND": true,
+	"MINUTE_MICROSECOND": true,
+	"MINUTE_SECOND":      true,
+	"HOUR_MICROSECOND":   true,
+	"HOUR_SECOND":        true,
+	"HOUR_MINUTE":        true,
+	"DAY_MICROSECOND":    true,
+	"DAY_SECOND":         true,
+	"DAY_MINUTE":         true,
+	"DAY_HOUR":           true,
+	"YEAR_MONTH":         true,
+}
+
+// rewriteIntervals rewrites the uses of INTERVAL the parser rejects into ones it accepts. Calls of the INTERVAL()
+// function, such as INTERVAL(5, 1, 3), are quoted as `interval`(5, 1, 3), and compound units of interval expressions,
+// such as HOUR_MINUTE in INTERVAL '1:30' HOUR_MINUTE, are quoted as identifiers, which the parser takes as the unit.
+// The schedules of events and the bounds of window frames are left as they are, since the parser accepts compound units
+// there, but not quoted ones. It returns the edits that quote them.
+// TODO: remove this once the parser supports the INTERVAL() function and compound units in all expressions
+func rewriteIntervals(query string) []queryEdit {
+	if !containsKeyPartWord(query, "INTERVAL") {
+		return nil
+	}
+	toks := tokenizeKeyParts(query)
+
+	start := 0
+	if len(toks) > 0 && (toks[0].val == "CREATE" || toks[0].val == "ALTER") && containsKeyPartWord(query, "EVENT") {
+		for start < len(toks) && toks[start].val != "DO" {
+			start++
+		}
+	}
+
+	var edits []queryEdit
+	last := 0
+	quote := func(tok keyPartToken) {
+		if tok.start < last {
+			return
+		}
+		edits = append(edits, queryEdit{start: tok.start, end: tok.end, text: "`" + query[tok.start:tok.end] + "`"})
+		last = tok.end
+	}
+	for i := start; i < len(toks); i++ {
+		if toks[i].val != "INTERVAL" {
+			continue
+		}
+		if i+1 < len(toks) && toks[i+1].val == "(" && hasTopLevelKeyPartComma(toks, i+1) {
+			quote(toks[i])
+			continue
+		}
+
+		// The unit is the first one after the value of the interval, outside any parentheses of the value
+		depth := 0
+	unit:
+		for j := i + 1; j < len(toks); j++ {
+			switch toks[j].val {
+			case "(":
+				depth++
+			case ")":
+				depth--
+				if depth < 0 {
+					break unit
+				}
+			case ",", ";":
+				if depth == 0 {
+					break unit
+				}
+			default:
+				if compound, ok := intervalUnits[toks[j].val]; ok && depth == 0 {
+					frameBound := j+1 < len(toks) && (toks[j+1].val == "PRECEDING" || toks[j+1].val == "FOLLOWING")
+					if compound && !frameBound {
+						quote(toks[j])
+					}
+					break unit
+				}
+			}
+		}
+	}
+	return edits
+}
+
+// hasTopLevelKeyPartComma returns whether the parentheses opened at |open| enclose a comma outside any nested
+// parentheses, as the arguments of a function call with more than one argument do.
+func hasTopLevelKeyPartComma(toks []keyPartToken, open int) bool {
+	depth := 0
+	for i := open; i < len(toks); i++ {
+		switch toks[i].val {
+		case "(":
+			depth++
+		case ")":
+			depth--
+			if depth == 0 {
+				return false
+			}
+		case ",":
+			if depth == 1 {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -18,11 +18,13 @@ import (
 	"encoding/hex"
 	goerrors "errors"
 	"fmt"
+	"math"
 	"regexp"
 	"strconv"
 	"strings"
 	"time"
 	"unicode"
+	"unicode/utf8"
 
 	"github.com/dolthub/vitess/go/mysql"
 	"github.com/dolthub/vitess/go/vt/sqlparser"
@@ -48,9 +50,14 @@ var (
 
 	ErrPrimaryKeyOnNullField = errors.NewKind("All parts of PRIMARY KEY must be NOT NULL")
 
-	tableCharsetOptionRegex = regexp.MustCompile(`(?i)(DEFAULT)?\s+CHARACTER\s+SET((\s*=?\s*)|\s+)([A-Za-z0-9_]+)`)
+	tableCharsetOptionRegex = regexp.MustCompile(`(?i)(DEFAULT)?\s+(?:CHARACTER\s+SET|CHARSET)((\s*=?\s*)|\s+)([A-Za-z0-9_]+)`)
 
 	tableCollationOptionRegex = regexp.MustCompile(`(?i)(DEFAULT)?\s+COLLATE((\s*=?\s*)|\s+)([A-Za-z0-9_]+)`)
+
+	// The parser drops the character set of a DEFAULT CHARSET table option, so it's found in the query instead
+	droppedDefaultCharsetOptionRegex = regexp.MustCompile(`(?i)DEFAULT CHARSET (\s|$)`)
+
+	tableStatsAutoRecalcOptionRegex = regexp.MustCompile(`(?i)\bSTATS_AUTO_RECALC((\s*=?\s*)|\s+)([A-Za-z0-9_]+)`)
 )
 
 var describeSupportedFormats = []string{"tree"}
@@ -84,19 +91,52 @@ func parse(ctx *sql.Context, query string, multi bool) (sql.Node, string, string
 	if strings.HasSuffix(s, ";") {
 		s = s[:len(s)-1]
 	}
+	if stmt, remainder, ok := splitHandlerStatement(s, multi); ok {
+		node, err := convertHandler(ctx, stmt)
+		return node, stmt, remainder, err
+	}
+	if stmt, remainder, ok := splitResetPersist(s, multi); ok {
+		node, err := convertResetPersist(stmt)
+		return node, stmt, remainder, err
+	}
+	if stmt, remainder, ok := splitCheckTable(s, multi); ok {
+		node, err := convertCheckTable(stmt)
+		return node, stmt, remainder, err
+	}
+	if format, execute, prefix, ok := splitExplainExecute(s); ok {
+		return parseExplainExecute(ctx, format, execute, prefix, multi)
+	}
+	s, viewClauses := rewriteViewStatement(s)
+	s, keyParts := rewriteFunctionalKeyParts(s)
+	s, convertCharset := rewriteConvertToCharset(s)
+	alterIgnore := isAlterIgnore(s)
+
+	// The edits of the rewrites below are kept, so that the text the parser captures is taken from the query as written
+	q := newRewrittenQuery(s)
+	sampleEdits, samples, err := rewriteTableSamples(q.query)
+	if err != nil {
+		return nil, s, "", err
+	}
+	q.rewrite(sampleEdits)
+	q.rewrite(rewriteQueryCacheModifiers(q.query))
+	q.rewrite(rewriteIntervals(q.query))
+	q.rewrite(rewriteCastTypes(q.query))
+	q.rewrite(rewriteSoundsLike(q.query))
+	q.rewrite(rewritePositions(q.query))
+	q.rewrite(rewriteInsertRowAlias(q.query))
 
 	var stmt sqlparser.Statement
-	var err error
 	var parsed string
 	var remainder string
 
 	parsed = s
 	if !multi {
-		stmt, err = sqlparser.Parse(s)
+		stmt, err = sqlparser.Parse(q.query)
 	} else {
 		var ri int
-		stmt, ri, err = sqlparser.ParseOne(s)
-		if ri != 0 && ri < len(s) {
+		stmt, ri, err = sqlparser.ParseOne(q.query)
+		if ri != 0 && ri < len(q.query) {
+			ri = q.writtenPos(ri, false)
 			parsed = s[:ri]
 			parsed = strings.TrimSpace(parsed)
 			if strings.HasSuffix(parsed, ";") {
@@ -114,7 +154,23 @@ func parse(ctx *sql.Context, query string, multi bool) (sql.Node, string, string
 		return nil, parsed, remainder, sql.ErrSyntaxError.New(err.Error())
 	}
 
+	q.restoreWrittenText(stmt)
 	node, err := convert(ctx, stmt, s)
+	if err == nil && convertCharset {
+		node = withConvertColumns(node)
+	}
+	if err == nil && alterIgnore {
+		node = withAlterIgnore(node)
+	}
+	if err == nil && viewClauses != nil {
+		node = applyViewClauses(node, viewClauses)
+	}
+	if err == nil && samples != nil {
+		node, err = applyTableSamples(node, samples)
+	}
+	if err == nil && keyParts != nil {
+		node, err = applyFunctionalKeyParts(ctx, node, keyParts)
+	}
 
 	return node, parsed, remainder, err
 }
@@ -422,20 +478,33 @@ func convertExplain(ctx *sql.Context, n *sqlparser.Explain) (sql.Node, error) {
 		return nil, err
 	}
 
-	explainFmt := sqlparser.TreeStr
-	switch strings.ToLower(n.ExplainFormat) {
-	case "", sqlparser.TreeStr:
-	// tree format, do nothing
+	explainFmt, err := convertExplainFormat(n.ExplainFormat)
+	if err != nil {
+		return nil, err
+	}
+
+	return plan.NewDescribeQuery(explainFmt, child), nil
+}
+
+// convertExplainFormat returns the format of the DescribeQuery node of an EXPLAIN statement with the FORMAT given.
+func convertExplainFormat(format string) (string, error) {
+	switch strings.ToLower(format) {
+	case "":
+		// the plan of the engine
+		return sqlparser.TreeStr, nil
+	case sqlparser.TreeStr:
+		// MySQL's tree format, annotated with estimated costs and rows
+		return "estimated_tree", nil
 	case "debug":
-		explainFmt = "debug"
+		return "debug", nil
+	case "rules":
+		return "rules", nil
 	default:
-		return nil, errInvalidDescribeFormat.New(
-			n.ExplainFormat,
+		return "", errInvalidDescribeFormat.New(
+			format,
 			strings.Join(describeSupportedFormats, ", "),
 		)
 	}
-
-	return plan.NewDescribeQuery(explainFmt, child), nil
 }
 
 func convertPrepare(ctx *sql.Context, n *sqlparser.Prepare) (sql.Node, error) {
@@ -601,7 +670,7 @@ func convertShow(ctx *sql.Context, s *sqlparser.Show, query string) (sql.Node, e
 	showType := strings.ToLower(s.Type)
 	switch showType {
 	case "processlist":
-		return plan.NewShowProcessList(), nil
+		return plan.NewShowProcessList(s.Full), nil
 	case sqlparser.CreateTableStr, "create view":
 		var asOfExpression sql.Expression
 		if s.ShowTablesOpt != nil && s.ShowTablesOpt.AsOf != nil {
@@ -758,7 +827,19 @@ func convertShow(ctx *sql.Context, s *sqlparser.Show, query string) (sql.Node, e
 	case sqlparser.TableStatusStr:
 		return convertShowTableStatus(ctx, s)
 	case "index":
-		return plan.NewShowIndexes(plan.NewUnresolvedTable(s.Table.Name.String(), s.Table.Qualifier.String())), nil
+		db := s.Table.Qualifier.String()
+		if s.Database != "" {
+			db = s.Database
+		}
+		var node sql.Node = plan.NewShowIndexes(plan.NewUnresolvedTable(s.Table.Name.String(), db))
+		if s.ShowIndexFilterOpt != nil {
+			filter, err := ExprToExpression(ctx, s.ShowIndexFilterOpt)
+			if err != nil {
+				return nil, err
+			}
+			node = plan.NewFilter(filter, node)
+		}
+		return node, nil
 	case sqlparser.KeywordString(sqlparser.VARIABLES):
 		var filter sql.Expression
 		var like sql.Expression
@@ -775,7 +856,7 @@ func convertShow(ctx *sql.Context, s *sqlparser.Show, query string) (sql.Node, e
 						if strings.ToLower(e.String()) != "variable_name" {
 							return nil, transform.SameTree, sql.ErrUnsupportedFeature.New("WHERE clause supports only 'variable_name' column for SHOW VARIABLES")
 						}
-						return expression.NewGetField(0, types.Text, "variable_name", true), transform.NewTree, nil
+						return expression.NewGetField(0, plan.ShowVariablesNameType, "variable_name", true), transform.NewTree, nil
 					default:
 						return e, transform.SameTree, nil
 					}
@@ -786,7 +867,7 @@ func convertShow(ctx *sql.Context, s *sqlparser.Show, query string) (sql.Node, e
 			}
 			if s.Filter.Like != "" {
 				like = expression.NewLike(
-					expression.NewGetField(0, types.LongText, "variable_name", false),
+					expression.NewGetField(0, plan.ShowVariablesNameType, "variable_name", false),
 					expression.NewLiteral(s.Filter.Like, types.LongText),
 					nil,
 				)
@@ -1041,9 +1122,12 @@ func convertUnion(ctx *sql.Context, u *sqlparser.Union) (sql.Node, error) {
 
 	// TODO: CalcFoundRows?
 	distinct := u.Type != sqlparser.UnionAllStr
-	l, err := limitToLimitExpr(ctx, u.Limit)
-	if err != nil {
-		return nil, err
+	var l sql.Expression
+	if u.Limit != nil && u.Limit.Offset == nil {
+		l, err = ExprToExpression(ctx, u.Limit.Rowcount)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	var sortFields sql.SortFields
@@ -1057,10 +1141,25 @@ func convertUnion(ctx *sql.Context, u *sqlparser.Union) (sql.Node, error) {
 	if err != nil {
 		return nil, err
 	}
+
+	// A union only limits its own rows, so an offset and the limit that goes with it apply to the sorted union
+	// instead. Limit must wrap offset, and not vice-versa, so that skipped rows don't count toward the returned row
+	// count.
+	var node sql.Node = union
+	if u.Limit != nil && u.Limit.Offset != nil {
+		node, err = offsetToOffset(ctx, u.Limit.Offset, node)
+		if err != nil {
+			return nil, err
+		}
+		node, err = limitToLimit(ctx, u.Limit.Rowcount, node)
+		if err != nil {
+			return nil, err
+		}
+	}
 	if u.With != nil {
-		return ctesToWith(ctx, u.With, union)
+		return ctesToWith(ctx, u.With, node)
 	}
-	return union, nil
+	return node, nil
 }
 
 func buildUnion(left, right sql.Node, distinct bool, limit sql.Expression, sf sql.SortFields) (*plan.Union, error) {
@@ -1142,16 +1241,6 @@ func convertSelect(ctx *sql.Context, s *sqlparser.Select) (sql.Node, error) {
 	return node, nil
 }
 
-func limitToLimitExpr(ctx *sql.Context, limit *sqlparser.Limit) (sql.Expression, error) {
-	// Limit must wrap offset, and not vice-versa, so that skipped rows don't count toward the returned row count.
-	if limit != nil && limit.Offset != nil {
-		return ExprToExpression(ctx, limit.Offset)
-	} else if limit != nil {
-		return ExprToExpression(ctx, limit.Rowcount)
-	}
-	return nil, nil
-}
-
 func nodeWithLimitAndOrderBy(ctx *sql.Context, node sql.Node, orderby sqlparser.OrderBy, limit *sqlparser.Limit, calcfoundrows bool) (sql.Node, error) {
 	var err error
 
@@ -1252,6 +1341,9 @@ func convertDDL(ctx *sql.Context, query string, c *sqlparser.DDL) (sql.Node, err
 		if c.ViewSpec != nil {
 			return convertCreateView(ctx, query, c)
 		}
+		if c.TableSpec != nil {
+			c.TableSpec.Options = restoreDefaultCharsetOption(c.TableSpec.Options, query)
+		}
 		return convertCreateTable(ctx, c)
 	case sqlparser.DropStr:
 		if c.TriggerSpec != nil {
@@ -2015,8 +2107,8 @@ func gatherIndexColumns(cols []*sqlparser.IndexColumn) ([]sql.IndexColumn, error
 	out := make([]sql.IndexColumn, len(cols))
 	for i, col := range cols {
 		var length int64
-		var err error
 		if col.Length != nil && col.Length.Type == sqlparser.IntVal {
+			var err error
 			length, err = strconv.ParseInt(string(col.Length.Val), 10, 64)
 			if err != nil {
 				return nil, err
@@ -2026,8 +2118,9 @@ func gatherIndexColumns(cols []*sqlparser.IndexColumn) ([]sql.IndexColumn, error
 			}
 		}
 		out[i] = sql.IndexColumn{
-			Name:   col.Column.String(),
-			Length: length,
+			Name:       col.Column.String(),
+			Length:     length,
+			Descending: col.Order == sqlparser.DescScr,
 		}
 	}
 	return out, nil
@@ -2250,12 +2343,18 @@ func convertCreateTable(ctx *sql.Context, c *sqlparser.DDL) (sql.Node, error) {
 		return nil, err
 	}
 
+	statsAutoRecalc, err := tableStatsAutoRecalcOption(c.TableSpec.Options)
+	if err != nil {
+		return nil, err
+	}
+
 	tableSpec := &plan.TableSpec{
-		Schema:    schema,
-		IdxDefs:   idxDefs,
-		FkDefs:    fkDefs,
-		ChDefs:    chDefs,
-		Collation: collation,
+		Schema:          schema,
+		IdxDefs:         idxDefs,
+		FkDefs:          fkDefs,
+		ChDefs:          chDefs,
+		Collation:       collation,
+		StatsAutoRecalc: statsAutoRecalc,
 	}
 
 	if c.OptSelect != nil {
@@ -2271,6 +2370,24 @@ func convertCreateTable(ctx *sql.Context, c *sqlparser.DDL) (sql.Node, error) {
 		sql.UnresolvedDatabase(qualifier), c.Table.Name.String(), plan.IfNotExistsOption(c.IfNotExists), plan.TempTableOption(c.Temporary), tableSpec), nil
 }
 
+// tableStatsAutoRecalcOption returns the STATS_AUTO_RECALC option in the table options given.
+func tableStatsAutoRecalcOption(options string) (sql.StatsAutoRecalc, error) {
+	submatches := tableStatsAutoRecalcOptionRegex.FindStringSubmatch(options)
+	if len(submatches) != 4 {
+		return sql.StatsAutoRecalc_Default, nil
+	}
+	switch strings.ToUpper(submatches[3]) {
+	case "DEFAULT":
+		return sql.StatsAutoRecalc_Default, nil
+	case "0":
+		return sql.StatsAutoRecalc_Off, nil
+	case "1":
+		return sql.StatsAutoRecalc_On, nil
+	default:
+		return sql.StatsAutoRecalc_Default, sql.ErrInvalidArgumentDetails.New("STATS_AUTO_RECALC", submatches[3])
+	}
+}
+
 type namedConstraint struct {
 	name string
 }
@@ -2565,6 +2682,61 @@ func getPkOrdinals(ts *sqlparser.TableSpec) []int {
 	return []int{}
 }
 
+// restoreDefaultCharsetOption returns the table options given, with the character set of a DEFAULT CHARSET option
+// taken from the query when the parser dropped it.
+func restoreDefaultCharsetOption(options string, query string) string {
+	if !droppedDefaultCharsetOptionRegex.MatchString(options) {
+		return options
+	}
+	charset := defaultCharsetOption(query)
+	if charset == "" {
+		return options
+	}
+	loc := droppedDefaultCharsetOptionRegex.FindStringIndex(options)
+	return options[:loc[0]] + "DEFAULT CHARSET " + charset + " " + options[loc[0]+len("DEFAULT CHARSET "):]
+}
+
+// defaultCharsetOption returns the character set of the last DEFAULT CHARSET option of the CREATE TABLE statement
+// given, or an empty string if it has none. Table options follow the definitions of the table's columns and indexes,
+// and precede its partitioning and its SELECT statement, and only the words outside strings and comments of those
+// are options, so that the same text in a comment or a default value isn't taken for one.
+func defaultCharsetOption(query string) string {
+	toks := tokenizeKeyParts(query)
+	start := 0
+	for i, tok := range toks {
+		if tok.val == "(" {
+			if start = matchingKeyPartParen(toks, i) + 1; start == 0 {
+				return ""
+			}
+			break
+		}
+		if tok.val == "LIKE" || tok.val == "AS" || tok.val == "SELECT" {
+			break
+		}
+	}
+
+	var charset string
+	for i := start; i < len(toks); i++ {
+		switch toks[i].val {
+		case "(", ";", "AS", "SELECT", "PARTITION", "IGNORE", "REPLACE":
+			return charset
+		case "DEFAULT":
+			j := i + 1
+			if j >= len(toks) || toks[j].val != "CHARSET" {
+				continue
+			}
+			if j++; j < len(toks) && toks[j].val == "=" {
+				j++
+			}
+			if j < len(toks) && toks[j].val != "" && isKeyPartWordChar(toks[j].val[0]) {
+				charset = query[toks[j].start:toks[j].end]
+				i = j
+			}
+		}
+	}
+	return charset
+}
+
 // TableSpecToSchema creates a sql.Schema from a parsed TableSpec
 func TableSpecToSchema(ctx *sql.Context, tableSpec *sqlparser.TableSpec, forceInvalidCollation bool) (sql.PrimaryKeySchema, sql.CollationID, error) {
 	tableCollation := sql.Collation_Unspecified
@@ -2646,6 +2818,9 @@ func columnDefinitionToColumn(ctx *sql.Context, cd *sqlparser.ColumnDefinition,
 	if cd.Type.Comment != nil && cd.Type.Comment.Type == sqlparser.StrVal {
 		comment = string(cd.Type.Comment.Val)
 	}
+	if utf8.RuneCountInString(comment) > sql.MaxColumnCommentLength {
+		return nil, sql.ErrTooLongFieldComment.New(cd.Name.String(), sql.MaxColumnCommentLength)
+	}
 
 	defaultVal, err := convertDefaultExpression(ctx, cd.Type.Default)
 	if err != nil {
@@ -3050,8 +3225,14 @@ func convertFlush(ctx *sql.Context, f *sqlparser.Flush) (sql.Node, error) {
 	switch strings.ToLower(f.Option.Name) {
 	case "privileges":
 		return plan.NewFlushPrivileges(writesToBinlog), nil
+	case "status":
+		return plan.NewFlushStatus(), nil
+	case "logs":
+		return plan.NewFlushLogs(sql.AllLogs), nil
+	case "binary logs", "engine logs", "error logs", "general logs", "relay logs", "slow logs":
+		return plan.NewFlushLogs(sql.LogKind(strings.ToUpper(f.Option.Name))), nil
 	default:
-		return nil, fmt.Errorf("%s not supported", f.Option.Name)
+		return nil, sql.ErrUnsupportedFeature.New(fmt.Sprintf("FLUSH %s", strings.ToUpper(f.Option.Name)))
 	}
 }
 
@@ -3758,7 +3939,18 @@ func ExprToExpression(ctx *sql.Context, e sqlparser.Expr) (sql.Expression, error
 			return nil, err
 		}
 
-		return expression.NewConvert(expr, v.Type.Type), nil
+		return convertTypeToExpression(expr, v.Type)
+	case *sqlparser.ConvertUsingExpr:
+		expr, err := ExprToExpression(ctx, v.Expr)
+		if err != nil {
+			return nil, err
+		}
+
+		charset, err := sql.ParseCharacterSet(v.Type)
+		if err != nil {
+			return nil, err
+		}
+		return expression.NewConvertUsing(expr, charset)
 	case *sqlparser.RangeCond:
 		val, err := ExprToExpression(ctx, v.Left)
 		if err != nil {
@@ -4063,6 +4255,14 @@ func comparisonExprToExpression(ctx *sql.Context, c *sqlparser.ComparisonExpr) (
 		return nil, err
 	}
 
+	if operand, ok := soundsLikeOperand(c); ok {
+		right, err := ExprToExpression(ctx, operand)
+		if err != nil {
+			return nil, err
+		}
+		return expression.NewEquals(function.NewSoundex(left), function.NewSoundex(right)), nil
+	}
+
 	right, err := ExprToExpression(ctx, c.Right)
 	if err != nil {
 		return nil, err
@@ -4372,6 +4572,48 @@ func caseExprToExpression(ctx *sql.Context, e *sqlparser.CaseExpr) (sql.Expressi
 	return expression.NewCase(expr, branches, elseExpr), nil
 }
 
+// convertTypeToExpression returns the expression for CAST(expr AS type) or CONVERT(expr, type).
+func convertTypeToExpression(expr sql.Expression, typ *sqlparser.ConvertType) (sql.Expression, error) {
+	var length, scale int
+	var err error
+	if typ.Length != nil {
+		length, err = strconv.Atoi(string(typ.Length.Val))
+		if err != nil {
+			return nil, err
+		}
+	}
+	if typ.Scale != nil {
+		scale, err = strconv.Atoi(string(typ.Scale.Val))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	castTo := castType(typ)
+	if castTo == expression.ConvertToFloat && length > 53 {
+		return nil, fmt.Errorf("Too-big precision %v specified. Maximum is 53.", length)
+	}
+	if castTo == expression.ConvertToDecimal && typ.Length != nil {
+		if length > math.MaxUint8 || scale > math.MaxUint8 {
+			return nil, fmt.Errorf("Too big precision %v specified. Maximum is %v.", length, types.DecimalTypeMaxPrecision)
+		}
+		if _, err = types.CreateDecimalType(uint8(length), uint8(scale)); err != nil {
+			return nil, err
+		}
+	}
+
+	var convert sql.Expression = expression.NewConvertWithLengthAndScale(expr, castTo, length, scale)
+	if typ.Charset != "" && (castTo == expression.ConvertToChar || castTo == expression.ConvertToNChar) {
+		// CAST(expr AS CHAR CHARACTER SET charset) is the same as CONVERT(expr USING charset)
+		charset, err := sql.ParseCharacterSet(typ.Charset)
+		if err != nil {
+			return nil, err
+		}
+		return expression.NewConvertUsing(convert, charset)
+	}
+	return convert, nil
+}
+
 func intervalExprToExpression(ctx *sql.Context, e *sqlparser.IntervalExpr) (sql.Expression, error) {
 	expr, err := ExprToExpression(ctx, e.Expr)
 	if err != nil {
@@ -4438,10 +4680,6 @@ func setExprsToExpressions(ctx *sql.Context, e sqlparser.SetVarExprs) ([]sql.Exp
 		case sqlparser.SetScope_PersistOnly:
 			varToSet := expression.NewSystemVar(setExpr.Name.String(), sql.SystemVariableScope_PersistOnly)
 			res[i] = expression.NewSetField(varToSet, innerExpr)
-			// TODO reset persist
-		//case sqlparser.SetScope_ResetPersist:
-		//	varToSet := expression.NewSystemVar(setExpr.Name.String(), sql.SystemVariableScope_ResetPersist)
-		//	res[i] = expression.NewSetField(varToSet, innerExpr)
 		case sqlparser.SetScope_Session:
 			varToSet := expression.NewSystemVar(setExpr.Name.String(), sql.SystemVariableScope_Session)
 			res[i] = expression.NewSetField(varToSet, innerExpr)
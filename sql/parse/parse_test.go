@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"math"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -337,7 +338,7 @@ func TestParse(t *testing.T) {
 							IndexName:  "",
 							Using:      sql.IndexUsing_Default,
 							Constraint: sql.IndexConstraint_None,
-							Columns:    []sql.IndexColumn{{"b", 0}},
+							Columns:    []sql.IndexColumn{{Name: "b", Length: 0}},
 							Comment:    "",
 						},
 					},
@@ -367,7 +368,7 @@ func TestParse(t *testing.T) {
 						IndexName:  "idx_name",
 						Using:      sql.IndexUsing_Default,
 						Constraint: sql.IndexConstraint_None,
-						Columns:    []sql.IndexColumn{{"b", 0}},
+						Columns:    []sql.IndexColumn{{Name: "b", Length: 0}},
 						Comment:    "",
 					}},
 				},
@@ -396,7 +397,7 @@ func TestParse(t *testing.T) {
 						IndexName:  "idx_name",
 						Using:      sql.IndexUsing_Default,
 						Constraint: sql.IndexConstraint_None,
-						Columns:    []sql.IndexColumn{{"b", 0}},
+						Columns:    []sql.IndexColumn{{Name: "b", Length: 0}},
 						Comment:    "hi",
 					}},
 				},
@@ -425,7 +426,7 @@ func TestParse(t *testing.T) {
 						IndexName:  "",
 						Using:      sql.IndexUsing_Default,
 						Constraint: sql.IndexConstraint_Unique,
-						Columns:    []sql.IndexColumn{{"b", 0}},
+						Columns:    []sql.IndexColumn{{Name: "b", Length: 0}},
 						Comment:    "",
 					}},
 				},
@@ -454,7 +455,7 @@ func TestParse(t *testing.T) {
 						IndexName:  "",
 						Using:      sql.IndexUsing_Default,
 						Constraint: sql.IndexConstraint_Unique,
-						Columns:    []sql.IndexColumn{{"b", 0}},
+						Columns:    []sql.IndexColumn{{Name: "b", Length: 0}},
 						Comment:    "",
 					}},
 				},
@@ -483,7 +484,7 @@ func TestParse(t *testing.T) {
 						IndexName:  "",
 						Using:      sql.IndexUsing_Default,
 						Constraint: sql.IndexConstraint_None,
-						Columns:    []sql.IndexColumn{{"b", 0}, {"a", 0}},
+						Columns:    []sql.IndexColumn{{Name: "b", Length: 0}, {Name: "a", Length: 0}},
 						Comment:    "",
 					}},
 				},
@@ -512,13 +513,13 @@ func TestParse(t *testing.T) {
 						IndexName:  "",
 						Using:      sql.IndexUsing_Default,
 						Constraint: sql.IndexConstraint_None,
-						Columns:    []sql.IndexColumn{{"b", 0}},
+						Columns:    []sql.IndexColumn{{Name: "b", Length: 0}},
 						Comment:    "",
 					}, {
 						IndexName:  "",
 						Using:      sql.IndexUsing_Default,
 						Constraint: sql.IndexConstraint_None,
-						Columns:    []sql.IndexColumn{{"b", 0}, {"a", 0}},
+						Columns:    []sql.IndexColumn{{Name: "b", Length: 0}, {Name: "a", Length: 0}},
 						Comment:    "",
 					}},
 				},
@@ -1257,7 +1258,7 @@ CREATE TABLE t2
 				"",
 				sql.IndexUsing_BTree,
 				sql.IndexConstraint_None,
-				[]sql.IndexColumn{{"v1", 0}},
+				[]sql.IndexColumn{{Name: "v1", Length: 0}},
 				"",
 			),
 		},
@@ -1501,7 +1502,7 @@ CREATE TABLE t2
 		{
 			input: "DESCRIBE FORMAT=tree SELECT * FROM foo",
 			plan: plan.NewDescribeQuery(
-				"tree", plan.NewProject(
+				"estimated_tree", plan.NewProject(
 					[]sql.Expression{expression.NewStar()},
 					plan.NewUnresolvedTable("foo", ""),
 				)),
@@ -1509,7 +1510,7 @@ CREATE TABLE t2
 		{
 			input: "DESC FORMAT=tree SELECT * FROM foo",
 			plan: plan.NewDescribeQuery(
-				"tree", plan.NewProject(
+				"estimated_tree", plan.NewProject(
 					[]sql.Expression{expression.NewStar()},
 					plan.NewUnresolvedTable("foo", ""),
 				)),
@@ -1517,7 +1518,7 @@ CREATE TABLE t2
 		{
 			input: "EXPLAIN FORMAT=tree SELECT * FROM foo",
 			plan: plan.NewDescribeQuery(
-				"tree", plan.NewProject(
+				"estimated_tree", plan.NewProject(
 					[]sql.Expression{expression.NewStar()},
 					plan.NewUnresolvedTable("foo", "")),
 			),
@@ -1546,6 +1547,18 @@ CREATE TABLE t2
 					plan.NewUnresolvedTable("foo", "")),
 			),
 		},
+		{
+			input: "EXPLAIN EXECUTE s USING @a, @b",
+			plan: plan.NewDescribeQuery(
+				"tree", plan.NewExecuteQuery("s",
+					expression.NewUserVar("a"),
+					expression.NewUserVar("b")),
+			),
+		},
+		{
+			input: "EXPLAIN FORMAT = tree EXECUTE s",
+			plan:  plan.NewDescribeQuery("estimated_tree", plan.NewExecuteQuery("s", []sql.Expression{}...)),
+		},
 		{
 			input: `SELECT foo, bar FROM foo;`,
 			plan: plan.NewProject(
@@ -1609,6 +1622,33 @@ CREATE TABLE t2
 						expression.NewLiteral("2019-01-01", types.LongText))),
 			),
 		},
+		{
+			input: `SELECT foo FROM foo TABLESAMPLE SYSTEM (10);`,
+			plan: plan.NewProject(
+				[]sql.Expression{
+					expression.NewUnresolvedColumn("foo"),
+				},
+				plan.NewUnresolvedTable("foo", "").WithSample(&plan.TableSample{Method: plan.SampleSystem, Percent: 10}),
+			),
+		},
+		{
+			input: `SELECT foo FROM foo AS OF '2019-01-01' AS baz tablesample bernoulli ( 2.5 ) repeatable ( 42 ) WHERE foo = 1;`,
+			plan: plan.NewProject(
+				[]sql.Expression{
+					expression.NewUnresolvedColumn("foo"),
+				},
+				plan.NewFilter(
+					expression.NewEquals(
+						expression.NewUnresolvedColumn("foo"),
+						expression.NewLiteral(int8(1), types.Int8),
+					),
+					plan.NewTableAlias("baz",
+						plan.NewUnresolvedTableAsOf("foo", "",
+							expression.NewLiteral("2019-01-01", types.LongText)).
+							WithSample(&plan.TableSample{Method: plan.SampleBernoulli, Percent: 2.5, Seed: 42, Repeatable: true})),
+				),
+			),
+		},
 		{
 			input: `SELECT foo, bar FROM foo WHERE foo = bar;`,
 			plan: plan.NewProject(
@@ -2216,6 +2256,49 @@ CREATE TABLE t2
 				),
 			),
 		},
+		{
+			input: `SELECT SQL_NO_CACHE foo FROM foo;`,
+			plan: plan.NewProject(
+				[]sql.Expression{
+					expression.NewUnresolvedColumn("foo"),
+				},
+				plan.NewUnresolvedTable("foo", ""),
+			),
+		},
+		{
+			input: `SELECT DISTINCT sql_cache foo, bar FROM foo;`,
+			plan: plan.NewDistinct(
+				plan.NewProject(
+					[]sql.Expression{
+						expression.NewUnresolvedColumn("foo"),
+						expression.NewUnresolvedColumn("bar"),
+					},
+					plan.NewUnresolvedTable("foo", ""),
+				),
+			),
+		},
+		{
+			input: `SELECT DISTINCT SQL_NO_CACHE foo FROM foo WHERE foo IN (SELECT ALL SQL_CACHE bar FROM bar);`,
+			plan: plan.NewDistinct(
+				plan.NewProject(
+					[]sql.Expression{
+						expression.NewUnresolvedColumn("foo"),
+					},
+					plan.NewFilter(
+						plan.NewInSubquery(
+							expression.NewUnresolvedColumn("foo"),
+							plan.NewSubquery(plan.NewProject(
+								[]sql.Expression{
+									expression.NewUnresolvedColumn("bar"),
+								},
+								plan.NewUnresolvedTable("bar", ""),
+							), "select sql_cache bar from bar"),
+						),
+						plan.NewUnresolvedTable("foo", ""),
+					),
+				),
+			),
+		},
 		{
 			input: `SELECT * FROM foo`,
 			plan: plan.NewProject(
@@ -2823,7 +2906,7 @@ CREATE TABLE t2
 				sql.IndexUsing_BTree,
 				sql.IndexConstraint_None,
 				[]sql.IndexColumn{
-					{"bar", 0},
+					{Name: "bar", Length: 0},
 				},
 				"",
 			),
@@ -2837,7 +2920,51 @@ CREATE TABLE t2
 				sql.IndexUsing_BTree,
 				sql.IndexConstraint_None,
 				[]sql.IndexColumn{
-					{"bar", 0},
+					{Name: "bar", Length: 0},
+				},
+				"",
+			),
+		},
+		{
+			input: `CREATE INDEX idx ON foo (bar, (LOWER(baz)) DESC)`,
+			plan: plan.NewAlterCreateIndex(
+				sql.UnresolvedDatabase(""),
+				plan.NewUnresolvedTable("foo", ""),
+				"idx",
+				sql.IndexUsing_BTree,
+				sql.IndexConstraint_None,
+				[]sql.IndexColumn{
+					{Name: "bar", Length: 0},
+					{Expression: expression.NewUnresolvedFunction("lower", false, nil, expression.NewUnresolvedColumn("baz")), Descending: true},
+				},
+				"",
+			),
+		},
+		{
+			input: `CREATE INDEX idx ON foo (bar DESC, baz ASC)`,
+			plan: plan.NewAlterCreateIndex(
+				sql.UnresolvedDatabase(""),
+				plan.NewUnresolvedTable("foo", ""),
+				"idx",
+				sql.IndexUsing_BTree,
+				sql.IndexConstraint_None,
+				[]sql.IndexColumn{
+					{Name: "bar", Length: 0, Descending: true},
+					{Name: "baz", Length: 0},
+				},
+				"",
+			),
+		},
+		{
+			input: `ALTER TABLE foo ADD UNIQUE KEY ((a + b))`,
+			plan: plan.NewAlterCreateIndex(
+				sql.UnresolvedDatabase(""),
+				plan.NewUnresolvedTable("foo", ""),
+				"",
+				sql.IndexUsing_BTree,
+				sql.IndexConstraint_Unique,
+				[]sql.IndexColumn{
+					{Expression: expression.NewArithmetic(expression.NewUnresolvedColumn("a"), expression.NewUnresolvedColumn("b"), "+")},
 				},
 				"",
 			),
@@ -2876,7 +3003,7 @@ CREATE TABLE t2
 		{
 			input: `DESCRIBE FORMAT=TREE SELECT * FROM foo`,
 			plan: plan.NewDescribeQuery(
-				"tree",
+				"estimated_tree",
 				plan.NewProject(
 					[]sql.Expression{expression.NewStar()},
 					plan.NewUnresolvedTable("foo", ""),
@@ -2946,13 +3073,27 @@ CREATE TABLE t2
 			input: `SHOW KEYS IN foo`,
 			plan:  plan.NewShowIndexes(plan.NewUnresolvedTable("foo", "")),
 		},
+		{
+			input: `SHOW INDEX FROM foo FROM bar`,
+			plan:  plan.NewShowIndexes(plan.NewUnresolvedTable("foo", "bar")),
+		},
+		{
+			input: `SHOW KEYS FROM foo IN bar WHERE Key_name = 'baz'`,
+			plan: plan.NewFilter(
+				expression.NewEquals(
+					expression.NewUnresolvedColumn("Key_name"),
+					expression.NewLiteral("baz", types.LongText),
+				),
+				plan.NewShowIndexes(plan.NewUnresolvedTable("foo", "bar")),
+			),
+		},
 		{
 			input: `SHOW FULL PROCESSLIST`,
-			plan:  plan.NewShowProcessList(),
+			plan:  plan.NewShowProcessList(true),
 		},
 		{
 			input: `SHOW PROCESSLIST`,
-			plan:  plan.NewShowProcessList(),
+			plan:  plan.NewShowProcessList(false),
 		},
 		{
 			input: `SELECT @@allowed_max_packet`,
@@ -3203,7 +3344,7 @@ CREATE TABLE t2
 		{
 			input: `SHOW VARIABLES LIKE 'gtid_mode'`,
 			plan: plan.NewShowVariables(expression.NewLike(
-				expression.NewGetField(0, types.LongText, "variable_name", false),
+				expression.NewGetField(0, plan.ShowVariablesNameType, "variable_name", false),
 				expression.NewLiteral("gtid_mode", types.LongText),
 				nil,
 			), false),
@@ -3211,7 +3352,7 @@ CREATE TABLE t2
 		{
 			input: `SHOW SESSION VARIABLES LIKE 'autocommit'`,
 			plan: plan.NewShowVariables(expression.NewLike(
-				expression.NewGetField(0, types.LongText, "variable_name", false),
+				expression.NewGetField(0, plan.ShowVariablesNameType, "variable_name", false),
 				expression.NewLiteral("autocommit", types.LongText),
 				nil,
 			), false),
@@ -3220,6 +3361,34 @@ CREATE TABLE t2
 			input: `UNLOCK TABLES`,
 			plan:  plan.NewUnlockTables(),
 		},
+		{
+			input: `RESET PERSIST`,
+			plan: plan.NewSet([]sql.Expression{
+				expression.NewSetField(expression.NewSystemVar("", sql.SystemVariableScope_ResetPersist), expression.NewLiteral(nil, types.Null)),
+			}),
+		},
+		{
+			input: "RESET PERSIST IF EXISTS `Max_Connections`",
+			plan: plan.NewSet([]sql.Expression{
+				expression.NewSetField(expression.NewSystemVar("max_connections", sql.SystemVariableScope_ResetPersist), expression.NewLiteral(nil, types.Null)),
+			}),
+		},
+		{
+			input: `HANDLER foo OPEN`,
+			plan:  plan.NewHandlerOpen(plan.NewUnresolvedTable("foo", ""), "foo"),
+		},
+		{
+			input: "HANDLER mydb.`foo` OPEN AS f",
+			plan:  plan.NewHandlerOpen(plan.NewUnresolvedTable("foo", "mydb"), "f"),
+		},
+		{
+			input: `handler foo open f;`,
+			plan:  plan.NewHandlerOpen(plan.NewUnresolvedTable("foo", ""), "f"),
+		},
+		{
+			input: `HANDLER f CLOSE`,
+			plan:  plan.NewHandlerClose("f"),
+		},
 		{
 			input: `LOCK TABLES foo READ`,
 			plan: plan.NewLockTables([]*plan.TableLock{
@@ -5211,7 +5380,10 @@ var fixturesErrors = map[string]*errors.Kind{
 	`SELECT INTERVAL 1 DAY + INTERVAL 1 DAY`:                    sql.ErrUnsupportedSyntax,
 	`SELECT '2018-05-01' + (INTERVAL 1 DAY + INTERVAL 1 DAY)`:   sql.ErrUnsupportedSyntax,
 	"DESCRIBE FORMAT=pretty SELECT * FROM foo":                  errInvalidDescribeFormat,
+	"EXPLAIN FORMAT=pretty EXECUTE s":                           errInvalidDescribeFormat,
 	`CREATE TABLE test (pk int null primary key)`:               ErrPrimaryKeyOnNullField,
+	`CREATE INDEX idx ON foo ((bar))`:                           sql.ErrFunctionalIndexOnColumn,
+	`CREATE INDEX idx ON foo ((LOWER(bar))(10))`:                sql.ErrFunctionalIndexPrefix,
 	`CREATE TABLE test (pk int not null null primary key)`:      ErrPrimaryKeyOnNullField,
 	`CREATE TABLE test (pk int null, primary key(pk))`:          ErrPrimaryKeyOnNullField,
 	`CREATE TABLE test (pk int not null null, primary key(pk))`: ErrPrimaryKeyOnNullField,
@@ -5224,6 +5396,17 @@ var fixturesErrors = map[string]*errors.Kind{
 	`DROP TABLE IF EXISTS curdb.foo, otherdb.bar`:               sql.ErrUnsupportedFeature,
 	`DROP TABLE curdb.t1, t2`:                                   sql.ErrUnsupportedFeature,
 	`CREATE TABLE test (i int fulltext key)`:                    sql.ErrUnsupportedFeature,
+	`SELECT * FROM foo TABLESAMPLE BERNOULLI (101)`:             sql.ErrInvalidArgumentDetails,
+	`SELECT * FROM foo TABLESAMPLE SYSTEM (1) REPEATABLE (x)`:   sql.ErrInvalidArgumentDetails,
+	`SELECT * FROM foo TABLESAMPLE SYSTEM (1) AS f`:             ErrTableSampleNotApplicable,
+	`SELECT * FROM foo TABLESAMPLE SYSTEM (1) JOIN foo`:         ErrTableSampleNotApplicable,
+	`SELECT * FROM foo TABLESAMPLE SYSTEM (1), bar AS foo`:      ErrTableSampleNotApplicable,
+	`HANDLER foo READ FIRST`:                                    sql.ErrUnknownHandler,
+	`HANDLER foo OPEN AS f g`:                                   sql.ErrSyntaxError,
+	`HANDLER foo READ idx = 1`:                                  sql.ErrSyntaxError,
+	`HANDLER foo READ idx FIRST ORDER BY a`:                     sql.ErrSyntaxError,
+	`RESET PERSIST IF EXISTS`:                                   sql.ErrSyntaxError,
+	`RESET PERSIST max_connections net_read_timeout`:            sql.ErrSyntaxError,
 }
 
 func TestParseOne(t *testing.T) {
@@ -5265,6 +5448,10 @@ func TestParseOne(t *testing.T) {
 			"SELECT 1; SELECT 2; -- empty statement with comment\n",
 			[]string{"SELECT 1", "SELECT 2", "-- empty statement with comment"},
 		},
+		{
+			"HANDLER foo OPEN; HANDLER foo CLOSE",
+			[]string{"HANDLER foo OPEN", "HANDLER foo CLOSE"},
+		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.input, func(t *testing.T) {
@@ -5284,6 +5471,28 @@ func TestParseOne(t *testing.T) {
 	}
 }
 
+func TestParseInsertRowAlias(t *testing.T) {
+	cases := map[string]string{
+		"INSERT INTO t (a, b) VALUES (1, 2) AS new ON DUPLICATE KEY UPDATE b = new.b":                            "INSERT INTO t (a, b) VALUES (1, 2) ON DUPLICATE KEY UPDATE b = VALUES(b)",
+		"INSERT INTO t (a, b) VALUES (1, 2), (3, 4) AS `new` ON DUPLICATE KEY UPDATE b = `new`.b + NEW.a":        "INSERT INTO t (a, b) VALUES (1, 2), (3, 4) ON DUPLICATE KEY UPDATE b = VALUES(b) + VALUES(a)",
+		"INSERT INTO t (a, b) VALUES (1, 2) AS new(x, y) ON DUPLICATE KEY UPDATE b = x + new.y, a = t.a":         "INSERT INTO t (a, b) VALUES (1, 2) ON DUPLICATE KEY UPDATE b = VALUES(a) + VALUES(b), a = t.a",
+		"INSERT INTO t PARTITION (p0) (a, b) VALUES (1, 2) AS new(x, y) ON DUPLICATE KEY UPDATE b = COALESCE(y)": "INSERT INTO t PARTITION (p0) (a, b) VALUES (1, 2) ON DUPLICATE KEY UPDATE b = COALESCE(VALUES(b))",
+		"INSERT INTO t VALUES (1, 2) AS new":                                     "INSERT INTO t VALUES (1, 2)",
+		"INSERT INTO t VALUES (1, 'AS new') ON DUPLICATE KEY UPDATE b = 'new.b'": "INSERT INTO t VALUES (1, 'AS new') ON DUPLICATE KEY UPDATE b = 'new.b'",
+	}
+	for query, expected := range cases {
+		t.Run(query, func(t *testing.T) {
+			require := require.New(t)
+			ctx := sql.NewEmptyContext()
+			plan, err := Parse(ctx, query)
+			require.NoError(err)
+			expectedPlan, err := Parse(ctx, expected)
+			require.NoError(err)
+			require.Equal(expectedPlan, plan)
+		})
+	}
+}
+
 func TestParseErrors(t *testing.T) {
 	for query, expectedError := range fixturesErrors {
 		t.Run(query, func(t *testing.T) {
@@ -5509,3 +5718,582 @@ func TestParseColumnTypeString(t *testing.T) {
 		})
 	}
 }
+
+func TestLeadingKeyPartWords(t *testing.T) {
+	require.True(t, hasLeadingKeyPartWords("  /* comment */ check\n\tTABLE t", "CHECK", "TABLE"))
+	require.True(t, hasLeadingKeyPartWords("reset persist", "RESET", "PERSIST"))
+	require.False(t, hasLeadingKeyPartWords("reset", "RESET", "PERSIST"))
+	require.False(t, hasLeadingKeyPartWords("reset query cache", "RESET", "PERSIST"))
+	require.False(t, hasLeadingKeyPartWords("select 'handler'", "HANDLER"))
+	require.Equal(t, []string{"CREATE", "OR"}, leadingKeyPartWords("create or replace view v as select 1", 2))
+	require.Equal(t, []string{"CREATE"}, leadingKeyPartWords("create `t`", 2))
+
+	require.True(t, containsKeyPartWord("select sql_no_cache 1", "SQL_NO_CACHE"))
+	require.True(t, containsKeyPartWord("select * from t TableSample system (10)", "TABLESAMPLE"))
+	require.False(t, containsKeyPartWord("select tablesamples from t", "TABLESAMPLE"))
+	require.False(t, containsKeyPartWord("select my_sql_cache from t", "SQL_CACHE"))
+}
+
+func TestRewriteConvertToCharset(t *testing.T) {
+	tests := []struct {
+		query       string
+		expected    string
+		converted   bool
+		alterIgnore bool
+	}{
+		{
+			query:     "ALTER TABLE t CONVERT TO CHARACTER SET latin1",
+			expected:  "ALTER TABLE t CHARACTER SET latin1",
+			converted: true,
+		},
+		{
+			query:       "alter ignore table `my t` convert to charset utf8mb4",
+			expected:    "alter ignore table `my t` CHARACTER SET utf8mb4",
+			converted:   true,
+			alterIgnore: true,
+		},
+		{
+			query:    "ALTER TABLE t COMMENT 'CONVERT TO CHARACTER SET latin1'",
+			expected: "ALTER TABLE t COMMENT 'CONVERT TO CHARACTER SET latin1'",
+		},
+		{
+			query:    "SELECT 'ALTER IGNORE TABLE t CONVERT TO CHARSET latin1'",
+			expected: "SELECT 'ALTER IGNORE TABLE t CONVERT TO CHARSET latin1'",
+		},
+		{
+			query:    "SELECT 1 /* ALTER IGNORE TABLE t CONVERT TO CHARSET latin1 */",
+			expected: "SELECT 1 /* ALTER IGNORE TABLE t CONVERT TO CHARSET latin1 */",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.query, func(t *testing.T) {
+			rewritten, converted := rewriteConvertToCharset(test.query)
+			require.Equal(t, test.expected, rewritten)
+			require.Equal(t, test.converted, converted)
+			require.Equal(t, test.alterIgnore, isAlterIgnore(test.query))
+		})
+	}
+}
+
+func TestSplitExplainExecute(t *testing.T) {
+	format, execute, prefix, ok := splitExplainExecute("explain format = tree execute s using @a")
+	require.True(t, ok)
+	require.Equal(t, "tree", format)
+	require.Equal(t, "execute s using @a", execute)
+	require.Equal(t, "explain format = tree ", prefix)
+
+	_, execute, _, ok = splitExplainExecute("/* EXPLAIN */ EXPLAIN /* FORMAT = tree */ EXECUTE s")
+	require.True(t, ok)
+	require.Equal(t, "EXECUTE s", execute)
+
+	_, _, _, ok = splitExplainExecute("explain select 'execute s'")
+	require.False(t, ok)
+	_, _, _, ok = splitExplainExecute("select 'explain execute s'")
+	require.False(t, ok)
+	_, _, _, ok = splitExplainExecute("select 1 /* explain execute s */")
+	require.False(t, ok)
+	_, _, _, ok = splitExplainExecute("explain `execute`")
+	require.False(t, ok)
+}
+
+func TestSplitHandlerStatement(t *testing.T) {
+	stmt, remainder, ok := splitHandlerStatement("handler t read `a;b` = ('x;y') ; select 1", true)
+	require.True(t, ok)
+	require.Equal(t, "handler t read `a;b` = ('x;y')", stmt)
+	require.Equal(t, " select 1", remainder)
+
+	stmt, remainder, ok = splitHandlerStatement("/* handler */ HANDLER t /* ; */ CLOSE -- ;\n; select 1", true)
+	require.True(t, ok)
+	require.Equal(t, "/* handler */ HANDLER t /* ; */ CLOSE -- ;", stmt)
+	require.Equal(t, " select 1", remainder)
+
+	_, _, ok = splitHandlerStatement("select 'handler t close'", true)
+	require.False(t, ok)
+	_, _, ok = splitHandlerStatement("select 1 /* handler t close */", true)
+	require.False(t, ok)
+	_, _, ok = splitHandlerStatement("`handler` t close", true)
+	require.False(t, ok)
+}
+
+func TestSplitResetPersist(t *testing.T) {
+	stmt, remainder, ok := splitResetPersist("reset persist if exists `a;b` ; select 'c;d'", true)
+	require.True(t, ok)
+	require.Equal(t, "reset persist if exists `a;b`", stmt)
+	require.Equal(t, " select 'c;d'", remainder)
+
+	stmt, remainder, ok = splitResetPersist("/* reset */ RESET /* ; */ PERSIST max_connections # ;\n; select 1", true)
+	require.True(t, ok)
+	require.Equal(t, "/* reset */ RESET /* ; */ PERSIST max_connections # ;", stmt)
+	require.Equal(t, " select 1", remainder)
+
+	_, _, ok = splitResetPersist("select 'reset persist'", true)
+	require.False(t, ok)
+	_, _, ok = splitResetPersist("reset /* persist */ query cache", true)
+	require.False(t, ok)
+	_, _, ok = splitResetPersist("reset `persist`", true)
+	require.False(t, ok)
+}
+
+func TestSplitCheckTable(t *testing.T) {
+	stmt, remainder, ok := splitCheckTable("check table `a;b`, t extended; select 'c;d'", true)
+	require.True(t, ok)
+	require.Equal(t, "check table `a;b`, t extended", stmt)
+	require.Equal(t, " select 'c;d'", remainder)
+
+	stmt, remainder, ok = splitCheckTable("CHECK /* ; */ TABLE t -- ;\n; select 1", true)
+	require.True(t, ok)
+	require.Equal(t, "CHECK /* ; */ TABLE t -- ;", stmt)
+	require.Equal(t, " select 1", remainder)
+
+	_, _, ok = splitCheckTable("select 'check table t'", true)
+	require.False(t, ok)
+	_, _, ok = splitCheckTable("select 1 /* check table t */", true)
+	require.False(t, ok)
+	_, _, ok = splitCheckTable("check `table` t", true)
+	require.False(t, ok)
+}
+
+func TestRewriteViewStatement(t *testing.T) {
+	query, clauses := rewriteViewStatement("create view v (a, `b c`) as select 1, 2 with cascaded check option")
+	require.Equal(t, "create view v as select 1, 2", query)
+	require.Equal(t, []string{"a", "b c"}, clauses.columns)
+	require.Equal(t, "CASCADED", clauses.checkOpt)
+
+	query, clauses = rewriteViewStatement("alter view v as select 'with check option', '(a)' /* with local check option */")
+	require.Equal(t, "CREATE OR REPLACE view v as select 'with check option', '(a)' /* with local check option */", query)
+	require.True(t, clauses.isAlter)
+	require.Empty(t, clauses.columns)
+	require.Empty(t, clauses.checkOpt)
+
+	query, clauses = rewriteViewStatement("create view v as select 1 -- with check option")
+	require.Equal(t, "create view v as select 1 -- with check option", query)
+	require.Empty(t, clauses.checkOpt)
+
+	query, clauses = rewriteViewStatement("create view v as select '; alter view' ; select 1")
+	require.Equal(t, "create view v as select '; alter view' ; select 1", query)
+	require.Equal(t, "create view v as select '; alter view'", clauses.createViewString)
+
+	query, clauses = rewriteViewStatement("select 'create view v (a) as select 1 with check option'")
+	require.Equal(t, "select 'create view v (a) as select 1 with check option'", query)
+	require.Nil(t, clauses)
+
+	query, clauses = rewriteViewStatement("/* alter view v */ select 1")
+	require.Equal(t, "/* alter view v */ select 1", query)
+	require.Nil(t, clauses)
+}
+
+func TestRewriteFunctionalKeyParts(t *testing.T) {
+	tests := []struct {
+		query    string
+		expected string
+		parts    functionalKeyParts
+	}{
+		{
+			query:    "create index idx on t ((lower(email)), id)",
+			expected: "create index idx on t (GMS_KEY_PART_1, id)",
+			parts:    functionalKeyParts{"gms_key_part_1": "lower(email)"},
+		},
+		{
+			query:    "create table t (a varchar(10) default 'index i ((a))', key k ((upper(a))))",
+			expected: "create table t (a varchar(10) default 'index i ((a))', key k (GMS_KEY_PART_1))",
+			parts:    functionalKeyParts{"gms_key_part_1": "upper(a)"},
+		},
+		{
+			query:    "alter table t comment 'key k ((a))', add index i /* ((x)) */ ((a + 1))",
+			expected: "alter table t comment 'key k ((a))', add index i /* ((x)) */ (GMS_KEY_PART_1)",
+			parts:    functionalKeyParts{"gms_key_part_1": "a + 1"},
+		},
+		{
+			query:    "create table t (a int) comment 'index ((a))'",
+			expected: "create table t (a int) comment 'index ((a))'",
+		},
+		{
+			query:    "select 'create index i on t ((a))'",
+			expected: "select 'create index i on t ((a))'",
+		},
+		{
+			query:    "/* create index i on t ((a)) */ select (1)",
+			expected: "/* create index i on t ((a)) */ select (1)",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.query, func(t *testing.T) {
+			query, parts := rewriteFunctionalKeyParts(test.query)
+			require.Equal(t, test.expected, query)
+			if test.parts == nil {
+				require.Empty(t, parts)
+			} else {
+				require.Equal(t, test.parts, parts)
+			}
+		})
+	}
+}
+
+func TestRewriteTableSamples(t *testing.T) {
+	tests := []struct {
+		query    string
+		expected string
+		samples  int
+	}{
+		{
+			query:    "select * from t tablesample system (10) where a = 'tablesample bernoulli (5)'",
+			expected: "select * from t  where a = 'tablesample bernoulli (5)'",
+			samples:  1,
+		},
+		{
+			query:    "select * from t /* tablesample system (10) */ where a = 1",
+			expected: "select * from t /* tablesample system (10) */ where a = 1",
+		},
+		{
+			query:    "select 'x' from t -- tablesample system (10)",
+			expected: "select 'x' from t -- tablesample system (10)",
+		},
+		{
+			query:    "select `tablesample` from t",
+			expected: "select `tablesample` from t",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.query, func(t *testing.T) {
+			edits, samples, err := rewriteTableSamples(test.query)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, applyQueryEdits(test.query, edits))
+			require.Len(t, samples, test.samples)
+		})
+	}
+}
+
+func TestRewriteQueryCacheModifiers(t *testing.T) {
+	tests := []struct {
+		query    string
+		expected string
+	}{
+		{
+			query:    "select distinct sql_cache a from t -- sql_no_cache",
+			expected: "select SQL_CACHE distinct  a from t -- sql_no_cache",
+		},
+		{
+			query:    "select sql_no_cache 'sql_cache' from t",
+			expected: "select sql_no_cache 'sql_cache' from t",
+		},
+		{
+			query:    "select 'select distinct sql_cache 1' /* select distinct sql_no_cache */",
+			expected: "select 'select distinct sql_cache 1' /* select distinct sql_no_cache */",
+		},
+		{
+			query:    "select distinct `sql_cache` from t",
+			expected: "select distinct `sql_cache` from t",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.query, func(t *testing.T) {
+			require.Equal(t, test.expected, applyQueryEdits(test.query, rewriteQueryCacheModifiers(test.query)))
+		})
+	}
+}
+
+func BenchmarkParseBulkInsert(b *testing.B) {
+	var sb strings.Builder
+	sb.WriteString("INSERT INTO t (a, b, c) VALUES ")
+	for i := 0; i < 50000; i++ {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		fmt.Fprintf(&sb, "(%d, 'text of row %d', %d.5)", i, i, i)
+	}
+	query := sb.String()
+	ctx := sql.NewEmptyContext()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := Parse(ctx, query); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestHasInsertRowAlias(t *testing.T) {
+	require.True(t, hasInsertRowAlias("insert into t values (1, 2) as new on duplicate key update b = new.b"))
+	require.True(t, hasInsertRowAlias("INSERT INTO t (a, b) VALUES (1, 2), (3, 4) AS new(x, y) ON DUPLICATE KEY UPDATE b = y"))
+	require.False(t, hasInsertRowAlias("insert into t values (1, 'as'), (2, 'b') on duplicate key update b = values(b)"))
+	require.False(t, hasInsertRowAlias("insert into t values (1, cast(2 as char))"))
+	require.False(t, hasInsertRowAlias("select (1) as a"))
+	require.True(t, hasInsertRowAlias("insert into t set a = 1, b = 2 as new on duplicate key update b = new.b"))
+	require.False(t, hasInsertRowAlias("insert into t set a = 'x as y', b = 2"))
+	require.False(t, hasInsertRowAlias("insert into t values (1, 2) /* as new */ on duplicate key update b = 3"))
+	require.False(t, hasInsertRowAlias("insert into t set a = 1 -- as new"))
+}
+
+func TestRewriteInsertRowAlias(t *testing.T) {
+	tests := []struct {
+		query    string
+		expected string
+	}{
+		{
+			query:    "insert into t values (1, 2) as new on duplicate key update b = new.b",
+			expected: "insert into t values (1, 2)  on duplicate key update b = VALUES(`b`)",
+		},
+		{
+			query:    "insert into t (a, b) values (1, 2) as new(x, y) on duplicate key update b = y",
+			expected: "insert into t (a, b) values (1, 2)  on duplicate key update b = VALUES(`b`)",
+		},
+		{
+			query:    "insert into t values (1, 2) as new(x, y) on duplicate key update b = y",
+			expected: "insert into t values (1, 2)  on duplicate key update b = VALUES(`GMS_ROW_ALIAS_COLUMN_2`)",
+		},
+		{
+			query:    "insert into t set a = 1, b = 2 as new on duplicate key update b = new.b",
+			expected: "insert into t set a = 1, b = 2  on duplicate key update b = VALUES(`b`)",
+		},
+		{
+			query:    "insert into t set a = 1, b = 2 as new(x, y) on duplicate key update b = y",
+			expected: "insert into t set a = 1, b = 2  on duplicate key update b = VALUES(`b`)",
+		},
+		{
+			query:    "insert into t values ('as new', 2) on duplicate key update b = 'as new'",
+			expected: "insert into t values ('as new', 2) on duplicate key update b = 'as new'",
+		},
+		{
+			query:    "insert into t set a = 'x as new', b = 2 on duplicate key update b = 3",
+			expected: "insert into t set a = 'x as new', b = 2 on duplicate key update b = 3",
+		},
+		{
+			query:    "insert into t values (1, 2) /* as new(x, y) */ on duplicate key update b = 3",
+			expected: "insert into t values (1, 2) /* as new(x, y) */ on duplicate key update b = 3",
+		},
+		{
+			query:    "insert into t set a = 1 # as new\n, b = 2",
+			expected: "insert into t set a = 1 # as new\n, b = 2",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.query, func(t *testing.T) {
+			require.Equal(t, test.expected, applyQueryEdits(test.query, rewriteInsertRowAlias(test.query)))
+		})
+	}
+}
+
+func TestRewriteIntervals(t *testing.T) {
+	tests := []struct {
+		query    string
+		expected string
+	}{
+		{
+			query:    "SELECT INTERVAL(5, 1, 3), interval (NULL, 1)",
+			expected: "SELECT `INTERVAL`(5, 1, 3), `interval` (NULL, 1)",
+		},
+		{
+			query:    "SELECT DATE_ADD(d, INTERVAL '1:30' HOUR_MINUTE), d - INTERVAL CONCAT(a, '-', b) year_month",
+			expected: "SELECT DATE_ADD(d, INTERVAL '1:30' `HOUR_MINUTE`), d - INTERVAL CONCAT(a, '-', b) `year_month`",
+		},
+		{
+			query:    "SELECT d + INTERVAL (1 + 1) DAY, EXTRACT(DAY_HOUR FROM d + INTERVAL 1 HOUR)",
+			expected: "SELECT d + INTERVAL (1 + 1) DAY, EXTRACT(DAY_HOUR FROM d + INTERVAL 1 HOUR)",
+		},
+		{
+			query:    "CREATE EVENT e ON SCHEDULE AT NOW() + INTERVAL '1:30' HOUR_MINUTE DO SELECT INTERVAL '1:30' HOUR_MINUTE + d",
+			expected: "CREATE EVENT e ON SCHEDULE AT NOW() + INTERVAL '1:30' HOUR_MINUTE DO SELECT INTERVAL '1:30' `HOUR_MINUTE` + d",
+		},
+		{
+			query:    "SELECT row_number() OVER (ORDER BY d RANGE INTERVAL '2:30' MINUTE_SECOND PRECEDING) FROM t",
+			expected: "SELECT row_number() OVER (ORDER BY d RANGE INTERVAL '2:30' MINUTE_SECOND PRECEDING) FROM t",
+		},
+		{
+			query:    "SELECT 'INTERVAL(1, 2)', `interval`(1, 2)",
+			expected: "SELECT 'INTERVAL(1, 2)', `interval`(1, 2)",
+		},
+		{
+			query:    "SELECT d + INTERVAL 1 DAY /* INTERVAL(1, 2), INTERVAL '1:30' HOUR_MINUTE */ -- interval (3, 4)",
+			expected: "SELECT d + INTERVAL 1 DAY /* INTERVAL(1, 2), INTERVAL '1:30' HOUR_MINUTE */ -- interval (3, 4)",
+		},
+		{
+			query:    "SELECT \"INTERVAL '1:30' HOUR_MINUTE\", d - INTERVAL 1 DAY",
+			expected: "SELECT \"INTERVAL '1:30' HOUR_MINUTE\", d - INTERVAL 1 DAY",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.query, func(t *testing.T) {
+			require.Equal(t, test.expected, applyQueryEdits(test.query, rewriteIntervals(test.query)))
+		})
+	}
+}
+
+func TestRewriteCastTypes(t *testing.T) {
+	tests := []struct {
+		query    string
+		expected string
+	}{
+		{
+			query:    "SELECT CAST(d AS YEAR), convert(x, float(30)), CAST(x AS DOUBLE)",
+			expected: "SELECT CAST(d AS CHAR GMS_CAST_AS_YEAR), convert(x, CHAR(30) GMS_CAST_AS_FLOAT), CAST(x AS CHAR GMS_CAST_AS_DOUBLE)",
+		},
+		{
+			query:    "SELECT CAST(CAST(g AS POINT) AS multipoint), CONVERT(IF(a, b, c), REAL)",
+			expected: "SELECT CAST(CAST(g AS CHAR GMS_CAST_AS_POINT) AS CHAR GMS_CAST_AS_MULTIPOINT), CONVERT(IF(a, b, c), CHAR GMS_CAST_AS_REAL)",
+		},
+		{
+			query:    "SELECT CAST(x AS CHAR), CONVERT(x USING latin1), CAST(x AS YEAR(4)), 'CAST(x AS YEAR)'",
+			expected: "SELECT CAST(x AS CHAR), CONVERT(x USING latin1), CAST(x AS YEAR(4)), 'CAST(x AS YEAR)'",
+		},
+		{
+			query:    "SELECT CAST(x AS CHAR) /* CAST(x AS YEAR) */, \"CONVERT(x, DOUBLE)\" -- CONVERT(x, REAL)",
+			expected: "SELECT CAST(x AS CHAR) /* CAST(x AS YEAR) */, \"CONVERT(x, DOUBLE)\" -- CONVERT(x, REAL)",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.query, func(t *testing.T) {
+			require.Equal(t, test.expected, applyQueryEdits(test.query, rewriteCastTypes(test.query)))
+		})
+	}
+}
+
+func TestRewriteSoundsLike(t *testing.T) {
+	tests := []struct {
+		query    string
+		expected string
+	}{
+		{
+			query:    "SELECT 'Robert' SOUNDS LIKE 'Rupert'",
+			expected: "SELECT 'Robert' = GMS_SOUNDS_LIKE('Rupert')",
+		},
+		{
+			query:    "SELECT * FROM t WHERE a sounds like concat(b, 'x') COLLATE utf8mb4_bin AND c > 1",
+			expected: "SELECT * FROM t WHERE a = GMS_SOUNDS_LIKE(concat(b, 'x') COLLATE utf8mb4_bin) AND c > 1",
+		},
+		{
+			query:    "SELECT a SOUNDS LIKE t.b || -c.d+1, a SOUNDS LIKE (b SOUNDS LIKE c) FROM t",
+			expected: "SELECT a = GMS_SOUNDS_LIKE(t.b) || -c.d+1, a = GMS_SOUNDS_LIKE((b = GMS_SOUNDS_LIKE(c))) FROM t",
+		},
+		{
+			query:    "SELECT a SOUNDS LIKE CASE WHEN b THEN 'x' ELSE CASE c WHEN 1 THEN 'y' END END << 1 > 2",
+			expected: "SELECT a = GMS_SOUNDS_LIKE(CASE WHEN b THEN 'x' ELSE CASE c WHEN 1 THEN 'y' END END << 1) > 2",
+		},
+		{
+			query:    "SELECT a SOUNDS LIKE _utf8mb4'b' 'c', a SOUNDS LIKE @v, a SOUNDS LIKE j->>'$.a' FROM t",
+			expected: "SELECT a = GMS_SOUNDS_LIKE(_utf8mb4'b' 'c'), a = GMS_SOUNDS_LIKE(@v), a = GMS_SOUNDS_LIKE(j->>'$.a') FROM t",
+		},
+		{
+			// sounds is a column in these
+			query:    "SELECT sounds LIKE 'a%', `sounds` LIKE 'b%' FROM t WHERE sounds LIKE 'c%' AND NOT sounds LIKE 'd%'",
+			expected: "SELECT sounds LIKE 'a%', `sounds` LIKE 'b%' FROM t WHERE sounds LIKE 'c%' AND NOT sounds LIKE 'd%'",
+		},
+		{
+			query:    "SELECT 'a SOUNDS LIKE b', \"SOUNDS LIKE\" /* a SOUNDS LIKE b */ -- a SOUNDS LIKE b",
+			expected: "SELECT 'a SOUNDS LIKE b', \"SOUNDS LIKE\" /* a SOUNDS LIKE b */ -- a SOUNDS LIKE b",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.query, func(t *testing.T) {
+			require.Equal(t, test.expected, applyQueryEdits(test.query, rewriteSoundsLike(test.query)))
+		})
+	}
+}
+
+func TestRewritePositions(t *testing.T) {
+	tests := []struct {
+		query    string
+		expected string
+	}{
+		{
+			query:    "SELECT POSITION('b' IN 'abc'), position(concat(a, 'x') in b)",
+			expected: "SELECT LOCATE('b' , 'abc'), LOCATE(concat(a, 'x') , b)",
+		},
+		{
+			query:    "SELECT POSITION(POSITION('a' IN b) + 1 IN c IN (1, 2))",
+			expected: "SELECT LOCATE(LOCATE('a' , b) + 1 , c IN (1, 2))",
+		},
+		{
+			// position is a column in these
+			query:    "SELECT position, t.position FROM t WHERE position IN (1, 2)",
+			expected: "SELECT position, t.position FROM t WHERE position IN (1, 2)",
+		},
+		{
+			query:    "SELECT 'POSITION(a IN b)', `POSITION` /* POSITION(a IN b) */ # POSITION(a IN b)",
+			expected: "SELECT 'POSITION(a IN b)', `POSITION` /* POSITION(a IN b) */ # POSITION(a IN b)",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.query, func(t *testing.T) {
+			require.Equal(t, test.expected, applyQueryEdits(test.query, rewritePositions(test.query)))
+		})
+	}
+}
+
+func TestRestoreWrittenText(t *testing.T) {
+	tests := []struct {
+		query    string
+		expected string
+	}{
+		{
+			query:    "CREATE VIEW v AS SELECT * FROM t TABLESAMPLE SYSTEM (10)",
+			expected: "SELECT * FROM t TABLESAMPLE SYSTEM (10)",
+		},
+		{
+			query:    "CREATE PROCEDURE p() SELECT d + INTERVAL '1:30' HOUR_MINUTE FROM t TABLESAMPLE BERNOULLI (5) WHERE i > 0",
+			expected: "SELECT d + INTERVAL '1:30' HOUR_MINUTE FROM t TABLESAMPLE BERNOULLI (5) WHERE i > 0",
+		},
+		{
+			query:    "SELECT DISTINCT SQL_NO_CACHE DATE_ADD(d, INTERVAL '1:30' HOUR_MINUTE) FROM t",
+			expected: "DATE_ADD(d, INTERVAL '1:30' HOUR_MINUTE)",
+		},
+		{
+			query:    "SELECT (SELECT COUNT(*) FROM t TABLESAMPLE SYSTEM (10)), 1",
+			expected: "(SELECT COUNT(*) FROM t TABLESAMPLE SYSTEM (10))",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.query, func(t *testing.T) {
+			q := newRewrittenQuery(test.query)
+			edits, _, err := rewriteTableSamples(q.query)
+			require.NoError(t, err)
+			q.rewrite(edits)
+			q.rewrite(rewriteQueryCacheModifiers(q.query))
+			q.rewrite(rewriteIntervals(q.query))
+			stmt, err := sqlparser.Parse(q.query)
+			require.NoError(t, err)
+			q.restoreWrittenText(stmt)
+
+			switch stmt := stmt.(type) {
+			case *sqlparser.DDL:
+				require.Equal(t, test.expected, strings.TrimSpace(test.query[stmt.SubStatementPositionStart:stmt.SubStatementPositionEnd]))
+			case *sqlparser.Select:
+				require.Equal(t, test.expected, stmt.SelectExprs[0].(*sqlparser.AliasedExpr).InputExpression)
+			default:
+				t.Fatalf("unexpected statement %T", stmt)
+			}
+		})
+	}
+}
+
+func TestDefaultCharsetOption(t *testing.T) {
+	tests := []struct {
+		query    string
+		expected string
+	}{
+		{
+			query:    "CREATE TABLE t (i int) DEFAULT CHARSET=latin1",
+			expected: "latin1",
+		},
+		{
+			query:    "create table t (i int comment 'DEFAULT CHARSET utf16') default charset latin1 COMMENT 'DEFAULT CHARSET utf8mb4'",
+			expected: "latin1",
+		},
+		{
+			query:    "CREATE TABLE t (i int) DEFAULT CHARSET = utf16 /* DEFAULT CHARSET latin1 */ DEFAULT CHARSET=latin1 -- DEFAULT CHARSET utf16",
+			expected: "latin1",
+		},
+		{
+			query:    "CREATE TABLE t (i int) ENGINE=InnoDB SELECT 'DEFAULT CHARSET latin1' AS `DEFAULT CHARSET utf16`",
+			expected: "",
+		},
+		{
+			query:    "CREATE TABLE t DEFAULT CHARSET=latin1 AS SELECT 1 AS i",
+			expected: "latin1",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.query, func(t *testing.T) {
+			require.Equal(t, test.expected, defaultCharsetOption(test.query))
+		})
+	}
+}
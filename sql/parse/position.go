@@ -0,0 +1,50 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"sort"
+)
+
+// rewritePositions rewrites calls of POSITION(substr IN str), which the parser doesn't accept, into the equivalent
+// LOCATE(substr, str). It returns the edits that rewrite them.
+// TODO: remove this once the parser supports POSITION
+func rewritePositions(query string) []queryEdit {
+	if !containsKeyPartWord(query, "POSITION") {
+		return nil
+	}
+	toks := tokenizeKeyParts(query)
+
+	var edits []queryEdit
+	for i := 0; i+2 < len(toks); i++ {
+		if toks[i].val != "POSITION" || toks[i+1].val != "(" || i > 0 && toks[i-1].val == "." {
+			continue
+		}
+		// The substring is an operand of IN, which is followed by the string
+		in := bitExprEnd(query, toks, i+2)
+		if in < 0 || in >= len(toks) || toks[in].val != "IN" {
+			continue
+		}
+		edits = append(edits,
+			queryEdit{start: toks[i].start, end: toks[i].end, text: "LOCATE"},
+			queryEdit{start: toks[in].start, end: toks[in].end, text: ","})
+	}
+
+	// The calls in the substring of another one come after it, but before its IN
+	sort.Slice(edits, func(i, j int) bool {
+		return edits[i].start < edits[j].start
+	})
+	return edits
+}
@@ -0,0 +1,121 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"strings"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+)
+
+// queryEdit is a change a rewrite makes to a query before it's parsed: the text of the query between start and end is
+// replaced with text.
+type queryEdit struct {
+	start, end int
+	text       string
+}
+
+// applyQueryEdits returns the query given with the edits given, which must be in order and must not overlap, applied.
+func applyQueryEdits(query string, edits []queryEdit) string {
+	if len(edits) == 0 {
+		return query
+	}
+	var sb strings.Builder
+	last := 0
+	for _, e := range edits {
+		sb.WriteString(query[last:e.start])
+		sb.WriteString(e.text)
+		last = e.end
+	}
+	sb.WriteString(query[last:])
+	return sb.String()
+}
+
+// rewrittenQuery is a query as the rewrites that run before parsing leave it, along with the edits each of them made.
+// The parser captures text of the query it parses, such as the bodies of views and triggers, and the expressions of
+// selected columns, which must be the text of the query as written, rather than as rewritten, so that the rewrites
+// aren't visible in the definitions stored and the names of columns, and are applied again when those are parsed. The
+// edits map the positions of that text in the rewritten query back to the query as written.
+type rewrittenQuery struct {
+	written string
+	query   string
+	edits   [][]queryEdit
+}
+
+func newRewrittenQuery(query string) *rewrittenQuery {
+	return &rewrittenQuery{written: query, query: query}
+}
+
+// rewrite applies the edits of a rewrite of the query.
+func (q *rewrittenQuery) rewrite(edits []queryEdit) {
+	if len(edits) == 0 {
+		return
+	}
+	q.query = applyQueryEdits(q.query, edits)
+	q.edits = append(q.edits, edits)
+}
+
+// writtenPos returns the position in the query as written of the position given of the rewritten query. A position
+// within the text of an edit is mapped to the start of the text it replaced when |end| is false, and to its end
+// otherwise, so that text of the rewritten query that begins or ends within an edit includes all the text the edit
+// replaced. Text that ends where text was removed includes the text removed.
+func (q *rewrittenQuery) writtenPos(pos int, end bool) int {
+	for i := len(q.edits) - 1; i >= 0; i-- {
+		offset := 0
+		mapped := false
+		for _, e := range q.edits[i] {
+			start := e.start + offset
+			if pos < start {
+				break
+			}
+			if pos < start+len(e.text) || end && pos <= start+len(e.text) {
+				if end {
+					pos = e.end
+				} else {
+					pos = e.start
+				}
+				mapped = true
+				break
+			}
+			offset += len(e.text) - (e.end - e.start)
+		}
+		if !mapped {
+			pos -= offset
+		}
+	}
+	return pos
+}
+
+// restoreWrittenText sets the text the parser captured from the rewritten query in the statement given to the text
+// of the query as written.
+func (q *rewrittenQuery) restoreWrittenText(stmt sqlparser.Statement) {
+	if len(q.edits) == 0 {
+		return
+	}
+	if ddl, ok := stmt.(*sqlparser.DDL); ok && ddl.SubStatementPositionEnd > ddl.SubStatementPositionStart {
+		ddl.SubStatementPositionStart = q.writtenPos(ddl.SubStatementPositionStart, false)
+		ddl.SubStatementPositionEnd = q.writtenPos(ddl.SubStatementPositionEnd, true)
+	}
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if ae, ok := node.(*sqlparser.AliasedExpr); ok && ae.InputExpression != "" && ae.EndParsePos > ae.StartParsePos {
+			written := q.written[q.writtenPos(ae.StartParsePos, false):q.writtenPos(ae.EndParsePos, true)]
+			// The parser unquotes expressions that are a single quoted string, which the rewrites never edit
+			if written != q.query[ae.StartParsePos:ae.EndParsePos] {
+				ae.InputExpression = strings.TrimLeft(written, " \n\t")
+			}
+		}
+		return true, nil
+	}, stmt)
+}
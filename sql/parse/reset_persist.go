@@ -0,0 +1,80 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// splitResetPersist returns the RESET PERSIST statement the query given begins with, which the parser doesn't
+// support, and the remainder of the query following it if |multi| is set. It returns false if the query isn't a
+// RESET PERSIST statement.
+func splitResetPersist(query string, multi bool) (string, string, bool) {
+	if !hasLeadingKeyPartWords(query, "RESET", "PERSIST") {
+		return "", "", false
+	}
+	if multi {
+		for _, tok := range tokenizeKeyParts(query) {
+			if tok.val == ";" {
+				return strings.TrimSpace(query[:tok.start]), query[tok.end:], true
+			}
+		}
+	}
+	return query, "", true
+}
+
+// convertResetPersist converts the statement given, one of:
+//
+//	RESET PERSIST
+//	RESET PERSIST [IF EXISTS] system_var_name
+//
+// to a SET of the variable with the RESET PERSIST scope, which removes it from the persisted globals of the session.
+// An empty variable name removes all persisted globals. Removing a variable that isn't persisted does nothing, whether
+// IF EXISTS is given or not.
+func convertResetPersist(query string) (sql.Node, error) {
+	toks := tokenizeKeyParts(query)[2:]
+	if len(toks) >= 2 && toks[0].val == "IF" && toks[1].val == "EXISTS" {
+		toks = toks[2:]
+		if len(toks) == 0 {
+			return nil, sql.ErrSyntaxError.New(fmt.Sprintf("expected a system variable name after IF EXISTS: %s", query))
+		}
+	}
+
+	var name string
+	switch len(toks) {
+	case 0:
+	case 1:
+		name = query[toks[0].start:toks[0].end]
+		switch {
+		case strings.HasPrefix(name, "`") && strings.HasSuffix(name, "`") && len(name) > 1:
+			name = strings.ReplaceAll(name[1:len(name)-1], "``", "`")
+		case toks[0].val == "" || !isKeyPartWordChar(name[0]):
+			return nil, sql.ErrSyntaxError.New(fmt.Sprintf("invalid system variable name in RESET PERSIST: %s", query))
+		}
+	default:
+		return nil, sql.ErrSyntaxError.New(fmt.Sprintf("unexpected tokens after RESET PERSIST: %s", query))
+	}
+
+	varToReset := expression.NewSystemVar(strings.ToLower(name), sql.SystemVariableScope_ResetPersist)
+	return plan.NewSet([]sql.Expression{
+		expression.NewSetField(varToReset, expression.NewLiteral(nil, types.Null)),
+	}), nil
+}
@@ -0,0 +1,67 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+// selectOptions are the keywords MySQL accepts in any order between SELECT and its select expressions.
+var selectOptions = map[string]struct{}{
+	"ALL":                 {},
+	"DISTINCT":            {},
+	"DISTINCTROW":         {},
+	"HIGH_PRIORITY":       {},
+	"STRAIGHT_JOIN":       {},
+	"SQL_SMALL_RESULT":    {},
+	"SQL_BIG_RESULT":      {},
+	"SQL_BUFFER_RESULT":   {},
+	"SQL_CACHE":           {},
+	"SQL_NO_CACHE":        {},
+	"SQL_CALC_FOUND_ROWS": {},
+}
+
+// rewriteQueryCacheModifiers moves the SQL_CACHE and SQL_NO_CACHE modifiers of SELECT statements that follow other
+// select options, such as SELECT DISTINCT SQL_NO_CACHE, right after SELECT, the only place the parser accepts them.
+// It returns the edits that move them. The modifiers are accepted for compatibility, but have no effect, as there's no
+// query cache.
+// TODO: remove this once the parser accepts select options in any order
+func rewriteQueryCacheModifiers(query string) []queryEdit {
+	if !containsKeyPartWord(query, "SQL_CACHE") && !containsKeyPartWord(query, "SQL_NO_CACHE") {
+		return nil
+	}
+	toks := tokenizeKeyParts(query)
+
+	var edits []queryEdit
+	last := 0
+	for i, tok := range toks {
+		if tok.val != "SQL_CACHE" && tok.val != "SQL_NO_CACHE" {
+			continue
+		}
+		sel := i - 1
+		for sel >= 0 && toks[sel].val != "SELECT" {
+			if _, ok := selectOptions[toks[sel].val]; !ok {
+				break
+			}
+			sel--
+		}
+		// Only one of the modifiers is moved for each SELECT, the parser rejects any other
+		if sel < 0 || toks[sel].val != "SELECT" || sel == i-1 || toks[sel].end < last {
+			continue
+		}
+
+		edits = append(edits,
+			queryEdit{start: toks[sel].end, end: toks[sel].end, text: " " + tok.val},
+			queryEdit{start: tok.start, end: tok.end})
+		last = tok.end
+	}
+	return edits
+}
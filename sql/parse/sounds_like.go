@@ -0,0 +1,201 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"sort"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+)
+
+// soundsLikeName is the name of the function that rewriteSoundsLike wraps the right operand of SOUNDS LIKE in, as the
+// right operand of an equality comparison.
+const soundsLikeName = "GMS_SOUNDS_LIKE"
+
+// operandPrefixes are the words and characters after which an expression begins, so that a SOUNDS following them is
+// an identifier rather than the SOUNDS LIKE operator, as in SELECT sounds LIKE 'a%' FROM t.
+var operandPrefixes = map[string]bool{
+	"SELECT": true, "WHERE": true, "HAVING": true, "ON": true, "BY": true, "SET": true, "DISTINCT": true,
+	"ALL": true, "AND": true, "OR": true, "XOR": true, "NOT": true, "CASE": true, "WHEN": true, "THEN": true,
+	"ELSE": true, "RETURN": true, "(": true, ",": true, "=": true, "<": true, ">": true, "!": true, "+": true,
+	"-": true, "*": true, "/": true, "%": true, "^": true, "|": true, "&": true, "~": true,
+}
+
+// rewriteSoundsLike rewrites the SOUNDS LIKE operator, which the parser doesn't accept, into an equality comparison
+// with its right operand wrapped in a call of soundsLikeName, such as 'Robert' = GMS_SOUNDS_LIKE('Rupert') for
+// 'Robert' SOUNDS LIKE 'Rupert'. The comparison has the precedence of SOUNDS LIKE, and is converted to a comparison of
+// the SOUNDEX() of both operands. It returns the edits that rewrite the operator. An operator whose right operand
+// can't be told apart is left as it is, for the parser to reject.
+// TODO: remove this once the parser supports SOUNDS LIKE
+func rewriteSoundsLike(query string) []queryEdit {
+	if !containsKeyPartWord(query, "SOUNDS") {
+		return nil
+	}
+	toks := tokenizeKeyParts(query)
+
+	var edits []queryEdit
+	for i := 1; i+2 < len(toks); i++ {
+		if toks[i].val != "SOUNDS" || toks[i+1].val != "LIKE" || operandPrefixes[toks[i-1].val] {
+			continue
+		}
+		end := bitExprEnd(query, toks, i+2)
+		if end < 0 {
+			continue
+		}
+		edits = append(edits,
+			queryEdit{start: toks[i].start, end: toks[i+2].start, text: "= " + soundsLikeName + "("},
+			queryEdit{start: toks[end-1].end, end: toks[end-1].end, text: ")"})
+	}
+
+	// The operators in the right operand of another one come after it, but their operands end before its operand
+	sort.SliceStable(edits, func(i, j int) bool {
+		return edits[i].start < edits[j].start
+	})
+	return edits
+}
+
+// soundsLikeOperand returns the right operand of the SOUNDS LIKE operator that rewriteSoundsLike rewrote into the
+// comparison given, if it is one.
+func soundsLikeOperand(c *sqlparser.ComparisonExpr) (sqlparser.Expr, bool) {
+	f, ok := c.Right.(*sqlparser.FuncExpr)
+	if !ok || c.Operator != sqlparser.EqualStr || !f.Qualifier.IsEmpty() || !f.Name.EqualString(soundsLikeName) ||
+		len(f.Exprs) != 1 {
+		return nil, false
+	}
+	arg, ok := f.Exprs[0].(*sqlparser.AliasedExpr)
+	if !ok {
+		return nil, false
+	}
+	return arg.Expr, true
+}
+
+// bitExprEnd returns the index of the token following the expression that begins at |start|, which is an operand of
+// the comparison operators: an expression of the operators that bind tighter than them, such as + and |. It returns
+// -1 if there's no such expression at |start|.
+func bitExprEnd(query string, toks []keyPartToken, start int) int {
+	isString := func(i int) bool {
+		return i < len(toks) && toks[i].val == "" && query[toks[i].start] != '`'
+	}
+	adjacent := func(i int, val string) bool {
+		return i+1 < len(toks) && toks[i+1].val == val && toks[i+1].start == toks[i].end
+	}
+
+	i := start
+	for {
+		for i < len(toks) {
+			switch toks[i].val {
+			case "-", "+", "~", "!", "BINARY":
+				i++
+				continue
+			}
+			break
+		}
+		if i >= len(toks) {
+			return -1
+		}
+
+		switch {
+		case toks[i].val == "(":
+			if i = matchingKeyPartParen(toks, i); i < 0 {
+				return -1
+			}
+			i++
+		case toks[i].val == "CASE":
+			depth := 0
+			for ; i < len(toks); i++ {
+				if toks[i].val == "CASE" {
+					depth++
+				} else if toks[i].val == "END" {
+					if depth--; depth == 0 {
+						break
+					}
+				}
+			}
+			if i >= len(toks) {
+				return -1
+			}
+			i++
+		case toks[i].val == "@":
+			i++
+			if i < len(toks) && toks[i].val == "@" {
+				i++
+			}
+			if i >= len(toks) {
+				return -1
+			}
+			for i++; i+1 < len(toks) && toks[i].val == "."; i += 2 {
+			}
+		case isString(i):
+			// Adjacent strings are concatenated
+			for isString(i) {
+				i++
+			}
+		case toks[i].val == "." || toks[i].val == "" || isKeyPartWordChar(query[toks[i].start]):
+			// Identifiers, qualified or not, numbers, function calls, and literals with an introducer or a type,
+			// such as _utf8mb4'a' and DATE '2023-01-01'
+			if toks[i].val == "." {
+				i++
+			}
+			for i++; i+1 < len(toks) && toks[i].val == "."; i += 2 {
+			}
+			if i < len(toks) && toks[i].val == "(" {
+				if i = matchingKeyPartParen(toks, i); i < 0 {
+					return -1
+				}
+				i++
+			} else {
+				for isString(i) {
+					i++
+				}
+			}
+		default:
+			return -1
+		}
+
+		if i+1 < len(toks) && toks[i].val == "COLLATE" {
+			i += 2
+		}
+		if i >= len(toks) {
+			return i
+		}
+		switch toks[i].val {
+		case "+", "*", "/", "%", "^", "DIV", "MOD":
+			i++
+		case "-":
+			// The JSON operators -> and ->> are followed by a path
+			if adjacent(i, ">") {
+				i++
+				if adjacent(i, ">") {
+					i++
+				}
+			}
+			i++
+		case "|", "&":
+			// || and && are the logical operators
+			if adjacent(i, toks[i].val) {
+				return i
+			}
+			i++
+		case "<", ">":
+			// << and >> are shifts, the others are comparisons
+			if !adjacent(i, toks[i].val) {
+				return i
+			}
+			i += 2
+		default:
+			return i
+		}
+	}
+}
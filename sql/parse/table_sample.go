@@ -0,0 +1,223 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+// ErrTableSampleNotApplicable is returned when the table a TABLESAMPLE clause follows can't be told apart from the
+// other tables of the query.
+var ErrTableSampleNotApplicable = errors.NewKind("TABLESAMPLE of table %s can't be applied: %s")
+
+// tableSamples are the TABLESAMPLE clauses that rewriteTableSamples removes from a query, by the lower-cased alias, or
+// name, of the table each one follows, in the order they appear.
+type tableSamples map[string][]*plan.TableSample
+
+// rewriteTableSamples removes the TABLESAMPLE clauses of a query, such as TABLESAMPLE BERNOULLI (10) REPEATABLE (42),
+// which the parser doesn't accept. It returns the edits that remove them, and the clauses, to be set on their tables
+// with applyTableSamples. A TABLESAMPLE clause that doesn't follow a table name or alias, or isn't well formed, is left
+// as it is, for the parser to reject.
+// TODO: remove this once the parser supports TABLESAMPLE
+func rewriteTableSamples(query string) ([]queryEdit, tableSamples, error) {
+	if !containsKeyPartWord(query, "TABLESAMPLE") {
+		return nil, nil, nil
+	}
+	toks := tokenizeKeyParts(query)
+
+	var samples tableSamples
+	var edits []queryEdit
+	for i := 1; i < len(toks); i++ {
+		// Only the first statement is parsed, the others are rewritten when they're parsed in turn
+		if toks[i].val == ";" {
+			break
+		}
+		if toks[i].val != "TABLESAMPLE" || i+2 >= len(toks) {
+			continue
+		}
+		table := rowAliasIdentifier(query, toks[i-1])
+		method := toks[i+1].val
+		if table == "" || method != "SYSTEM" && method != "BERNOULLI" || toks[i+2].val != "(" {
+			continue
+		}
+		end := matchingKeyPartParen(toks, i+2)
+		if end < 0 {
+			continue
+		}
+		sample := &plan.TableSample{Method: plan.SampleSystem}
+		if method == "BERNOULLI" {
+			sample.Method = plan.SampleBernoulli
+		}
+		percent := strings.TrimSpace(query[toks[i+2].end:toks[end].start])
+		p, err := strconv.ParseFloat(percent, 64)
+		if err != nil || p < 0 || p > 100 {
+			return nil, nil, sql.ErrInvalidArgumentDetails.New("TABLESAMPLE", fmt.Sprintf("sample percentage must be between 0 and 100, got %s", percent))
+		}
+		sample.Percent = p
+		if end+2 < len(toks) && toks[end+1].val == "REPEATABLE" && toks[end+2].val == "(" {
+			seedEnd := matchingKeyPartParen(toks, end+2)
+			if seedEnd < 0 {
+				continue
+			}
+			seed := strings.TrimSpace(query[toks[end+2].end:toks[seedEnd].start])
+			sample.Seed, err = strconv.ParseInt(seed, 10, 64)
+			if err != nil {
+				return nil, nil, sql.ErrInvalidArgumentDetails.New("REPEATABLE", fmt.Sprintf("seed must be an integer, got %s", seed))
+			}
+			sample.Repeatable = true
+			end = seedEnd
+		}
+
+		if samples == nil {
+			samples = make(tableSamples)
+		}
+		key := strings.ToLower(table)
+		samples[key] = append(samples[key], sample)
+		// The clause is removed along with the space before it, so that the text of the table ends where it did
+		edits = append(edits, queryEdit{start: toks[i-1].end, end: toks[end].end, text: " "})
+		i = end
+	}
+	return edits, samples, nil
+}
+
+// applyTableSamples sets the TABLESAMPLE clauses given on the tables of the node given, matching them by the alias, or
+// name, of the tables. Clauses are rejected when there's no table with their alias, or when there are several and the
+// clauses can't tell them apart: each table with the alias must have the same clause.
+func applyTableSamples(node sql.Node, samples tableSamples) (sql.Node, error) {
+	tables := make(map[string]int)
+	_, err := transformSampledTables(node, func(alias string, t *plan.UnresolvedTable) *plan.UnresolvedTable {
+		tables[alias]++
+		return t
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for alias, clauses := range samples {
+		switch {
+		case tables[alias] == 0:
+			return nil, ErrTableSampleNotApplicable.New(alias, "there's no table with that name or alias")
+		case tables[alias] != len(clauses):
+			return nil, ErrTableSampleNotApplicable.New(alias, "other tables have the same name or alias")
+		}
+		for _, clause := range clauses[1:] {
+			if *clause != *clauses[0] {
+				return nil, ErrTableSampleNotApplicable.New(alias, "other tables with the same name or alias have a different TABLESAMPLE clause")
+			}
+		}
+	}
+
+	return transformSampledTables(node, func(alias string, t *plan.UnresolvedTable) *plan.UnresolvedTable {
+		if clauses, ok := samples[alias]; ok {
+			return t.WithSample(clauses[0])
+		}
+		return t
+	})
+}
+
+// transformSampledTables applies the function given to each table of the node given that a TABLESAMPLE clause may
+// follow, with the lower-cased alias, or name, of the table. Tables in subqueries and common table expressions are
+// included, as are the tables of the sources of inserts, the definitions of views and the bodies of triggers, but not
+// the tables inserted into or the tables triggers are defined on.
+func transformSampledTables(node sql.Node, f func(string, *plan.UnresolvedTable) *plan.UnresolvedTable) (sql.Node, error) {
+	switch n := node.(type) {
+	case *plan.TableAlias:
+		if t, ok := n.Child.(*plan.UnresolvedTable); ok {
+			return n.WithChildren(f(strings.ToLower(n.Name()), t))
+		}
+	case *plan.UnresolvedTable:
+		return f(strings.ToLower(n.Name()), n), nil
+	case *plan.With:
+		ctes := make([]*plan.CommonTableExpression, len(n.CTEs))
+		for i, cte := range n.CTEs {
+			sq, err := transformSampledTables(cte.Subquery, f)
+			if err != nil {
+				return nil, err
+			}
+			ctes[i] = plan.NewCommonTableExpression(sq.(*plan.SubqueryAlias), cte.Columns)
+		}
+		node = plan.NewWith(n.Child, ctes, n.Recursive)
+	case *plan.CreateTrigger:
+		// The child of a trigger is the table it's defined on, the tables of its body are the ones that may be sampled
+		body, err := transformSampledTables(n.Body, f)
+		if err != nil {
+			return nil, err
+		}
+		nct := *n
+		nct.Body = body
+		return &nct, nil
+	case *plan.InsertInto:
+		// The child of an insert is the table it inserts into, its source is the one that may be sampled
+		source, err := transformSampledTables(n.Source, f)
+		if err != nil {
+			return nil, err
+		}
+		return n.WithSource(source), nil
+	case *plan.CreateView:
+		// The definition of the view is its child, which must stay the same node
+		definition, err := transformSampledTables(n.Definition, f)
+		if err != nil {
+			return nil, err
+		}
+		ncv := *n
+		ncv.Definition = definition.(*plan.SubqueryAlias)
+		ncv.Child = ncv.Definition
+		return &ncv, nil
+	}
+
+	children := node.Children()
+	if len(children) > 0 {
+		newChildren := make([]sql.Node, len(children))
+		for i, child := range children {
+			var err error
+			if newChildren[i], err = transformSampledTables(child, f); err != nil {
+				return nil, err
+			}
+		}
+		var err error
+		if node, err = node.WithChildren(newChildren...); err != nil {
+			return nil, err
+		}
+	}
+
+	if ne, ok := node.(sql.Expressioner); ok {
+		exprs, identity, err := transform.Exprs(ne.Expressions(), func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+			sq, ok := e.(*plan.Subquery)
+			if !ok {
+				return e, transform.SameTree, nil
+			}
+			query, err := transformSampledTables(sq.Query, f)
+			if err != nil {
+				return nil, transform.SameTree, err
+			}
+			return sq.WithQuery(query), transform.NewTree, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		if identity == transform.NewTree {
+			return ne.WithExpressions(exprs...)
+		}
+	}
+	return node, nil
+}
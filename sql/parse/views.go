@@ -0,0 +1,166 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// viewClauses are the clauses of a CREATE VIEW or ALTER VIEW statement that rewriteViewStatement removes before
+// parsing, and that are set on the CreateView node afterwards.
+type viewClauses struct {
+	isAlter  bool
+	columns  []string
+	checkOpt string
+	// createViewString is the statement as a CREATE VIEW statement, with all of its clauses.
+	createViewString string
+}
+
+// rewriteViewStatement rewrites ALTER VIEW statements into CREATE OR REPLACE VIEW statements, and removes the column
+// list and WITH CHECK OPTION clause of CREATE VIEW statements, none of which the parser accepts. The clauses removed
+// are returned to be applied to the parsed statement with applyViewClauses, or nil if the query isn't a CREATE VIEW or
+// ALTER VIEW statement.
+// TODO: remove this once the parser supports these clauses
+func rewriteViewStatement(query string) (string, *viewClauses) {
+	if !isViewStatement(query) {
+		return query, nil
+	}
+	toks := tokenizeKeyParts(query)
+	if len(toks) < 4 {
+		return query, nil
+	}
+	for i, tok := range toks {
+		if tok.val == ";" {
+			toks = toks[:i]
+			break
+		}
+	}
+
+	view := -1
+	for i := 1; i < len(toks) && view < 0; i++ {
+		switch toks[i].val {
+		case "VIEW":
+			view = i
+		case "AS", "TABLE", "TRIGGER", "PROCEDURE", "FUNCTION", "EVENT", "INDEX":
+			return query, nil
+		}
+	}
+	if view < 0 || view+2 >= len(toks) {
+		return query, nil
+	}
+
+	clauses := &viewClauses{isAlter: toks[0].val == "ALTER"}
+	stmtEnd := toks[len(toks)-1].end
+
+	// The view name may be qualified with its database
+	nameEnd := view + 1
+	if nameEnd+2 < len(toks) && toks[nameEnd+1].val == "." {
+		nameEnd += 2
+	}
+
+	var sb strings.Builder
+	last := 0
+	if clauses.isAlter {
+		sb.WriteString(query[:toks[0].start])
+		sb.WriteString("CREATE OR REPLACE")
+		last = toks[0].end
+	}
+
+	if toks[nameEnd+1].val == "(" {
+		end := matchingKeyPartParen(toks, nameEnd+1)
+		if end < 0 {
+			return query, nil
+		}
+		for i := nameEnd + 2; i < end; i += 2 {
+			if i+1 < end && toks[i+1].val != "," {
+				return query, nil
+			}
+			clauses.columns = append(clauses.columns, unquoteViewColumn(query[toks[i].start:toks[i].end]))
+		}
+		sb.WriteString(query[last:toks[nameEnd].end])
+		last = toks[end].end
+	}
+
+	// WITH [CASCADED | LOCAL] CHECK OPTION
+	n := len(toks)
+	if n >= 3 && toks[n-1].val == "OPTION" && toks[n-2].val == "CHECK" {
+		with := n - 3
+		clauses.checkOpt = "CASCADED"
+		if toks[with].val == "CASCADED" || toks[with].val == "LOCAL" {
+			clauses.checkOpt = toks[with].val
+			with--
+		}
+		if with <= view || toks[with].val != "WITH" {
+			return query, nil
+		}
+		sb.WriteString(strings.TrimRight(query[last:toks[with].start], " \t\r\n"))
+		last = toks[n-1].end
+	}
+
+	sb.WriteString(query[last:])
+	rewritten := sb.String()
+
+	clauses.createViewString = query[:stmtEnd]
+	if clauses.isAlter {
+		clauses.createViewString = "CREATE OR REPLACE" + query[toks[0].end:stmtEnd]
+	}
+	return rewritten, clauses
+}
+
+// isViewStatement returns whether the query given may be a CREATE VIEW or ALTER VIEW statement, judging by its
+// leading words: VIEW, or one of the clauses that may precede it, must follow CREATE or ALTER.
+func isViewStatement(query string) bool {
+	words := leadingKeyPartWords(query, 2)
+	if len(words) < 2 || words[0] != "CREATE" && words[0] != "ALTER" {
+		return false
+	}
+	switch words[1] {
+	case "VIEW", "OR", "ALGORITHM", "DEFINER", "SQL":
+		return true
+	default:
+		return false
+	}
+}
+
+// unquoteViewColumn returns the name of a column in the column list of a view, without its quotes.
+func unquoteViewColumn(name string) string {
+	if len(name) >= 2 && name[0] == '`' && name[len(name)-1] == '`' {
+		return strings.ReplaceAll(name[1:len(name)-1], "``", "`")
+	}
+	return name
+}
+
+// applyViewClauses sets the clauses removed by rewriteViewStatement on the CreateView node given.
+func applyViewClauses(node sql.Node, clauses *viewClauses) sql.Node {
+	cv, ok := node.(*plan.CreateView)
+	if !ok {
+		return node
+	}
+	ncv := *cv
+	ncv.IsAlter = clauses.isAlter
+	ncv.CheckOpt = clauses.checkOpt
+	ncv.CreateViewString = clauses.createViewString
+	if len(clauses.columns) > 0 {
+		definition := *cv.Definition
+		definition.Columns = clauses.columns
+		ncv.Columns = clauses.columns
+		ncv.Definition = &definition
+		ncv.Child = &definition
+	}
+	return &ncv
+}
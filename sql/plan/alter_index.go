@@ -146,26 +146,28 @@ func (p *AlterIndex) TargetSchema() sql.Schema {
 	return p.targetSchema
 }
 
-// Expressions on the AlterIndex object are specifically column default expresions, Nothing else.
+// Expressions on the AlterIndex object are the column default expressions of the target schema, followed by the
+// expressions of any functional key parts being indexed.
 func (p *AlterIndex) Expressions() []sql.Expression {
 	newExprs := make([]sql.Expression, len(p.TargetSchema()))
 	for i, col := range p.TargetSchema() {
 		newExprs[i] = expression.WrapExpression(col.Default)
 	}
 
-	return newExprs
+	return append(newExprs, IndexColumnExpressions(p.Columns)...)
 }
 
-// WithExpressions implements the Node Interface. For AlterIndex, expressions represent  column defaults on the
-// targetSchema instance - required to be the same number of columns on the target schema.
+// WithExpressions implements the Node Interface. For AlterIndex, expressions represent column defaults on the
+// targetSchema instance - required to be the same number of columns on the target schema - followed by the
+// expressions of the functional key parts.
 func (p AlterIndex) WithExpressions(expressions ...sql.Expression) (sql.Node, error) {
 	columns := p.TargetSchema().Copy()
 
-	if len(columns) != len(expressions) {
+	if len(columns)+len(IndexColumnExpressions(p.Columns)) != len(expressions) {
 		return nil, fmt.Errorf("invariant failure: column count does not match expression count")
 	}
 
-	for i, expr := range expressions {
+	for i, expr := range expressions[:len(columns)] {
 		wrapper, ok := expr.(*expression.Wrapper)
 		if !ok {
 			return nil, fmt.Errorf("*expression.Wrapper cast failure unexpected: %v", expr)
@@ -184,6 +186,7 @@ func (p AlterIndex) WithExpressions(expressions ...sql.Expression) (sql.Node, er
 		columns[i].Default = newColDef
 	}
 
+	p.Columns = WithIndexColumnExpressions(p.Columns, expressions[len(columns):])
 	newIdx, err := p.WithTargetSchema(columns)
 	if err != nil {
 		return nil, err
@@ -231,11 +234,16 @@ func (p AlterIndex) String() string {
 		}
 		cols := make([]string, len(p.Columns))
 		for i, col := range p.Columns {
-			if col.Length == 0 {
+			if col.IsFunctional() {
+				cols[i] = fmt.Sprintf("(%s)", col.Expression)
+			} else if col.Length == 0 {
 				cols[i] = col.Name
 			} else {
 				cols[i] = fmt.Sprintf("%s(%v)", col.Name, col.Length)
 			}
+			if col.Descending {
+				cols[i] += " DESC"
+			}
 		}
 		children = append(children, fmt.Sprintf("Columns(%s)", strings.Join(cols, ", ")))
 		children = append(children, fmt.Sprintf("Comment(%s)", p.Comment))
@@ -257,7 +265,7 @@ func (p AlterIndex) String() string {
 }
 
 func (p *AlterIndex) Resolved() bool {
-	return p.Table.Resolved() && p.ddlNode.Resolved()
+	return p.Table.Resolved() && p.ddlNode.Resolved() && expression.ExpressionsResolved(IndexColumnExpressions(p.Columns)...)
 }
 
 // Children implements the sql.Node interface.
@@ -273,3 +281,45 @@ func (p *AlterIndex) ColumnNames() []string {
 	}
 	return colNames
 }
+
+// IndexColumnExpressions returns the expressions of the functional key parts among the index columns given.
+func IndexColumnExpressions(cols []sql.IndexColumn) []sql.Expression {
+	var exprs []sql.Expression
+	for _, col := range cols {
+		if col.IsFunctional() {
+			exprs = append(exprs, col.Expression)
+		}
+	}
+	return exprs
+}
+
+// WithIndexColumnExpressions returns a copy of the index columns given with the expressions of their functional key
+// parts replaced by |exprs|, in the order returned by IndexColumnExpressions.
+func WithIndexColumnExpressions(cols []sql.IndexColumn, exprs []sql.Expression) []sql.IndexColumn {
+	if len(exprs) == 0 {
+		return cols
+	}
+	newCols := make([]sql.IndexColumn, len(cols))
+	i := 0
+	for j, col := range cols {
+		if col.IsFunctional() {
+			col.Expression = exprs[i]
+			i++
+		}
+		newCols[j] = col
+	}
+	return newCols
+}
+
+// DefaultIndexName returns the name given to an index over the columns given when none is specified. This is the
+// concatenation of the column names, or functional_index for an index with functional key parts, as in MySQL.
+func DefaultIndexName(cols []sql.IndexColumn) string {
+	var sb strings.Builder
+	for _, col := range cols {
+		if col.IsFunctional() {
+			return "functional_index"
+		}
+		sb.WriteString(col.Name)
+	}
+	return sb.String()
+}
@@ -192,7 +192,7 @@ func (a *AddColumn) ValidateDefaultPosition(tblSch sql.Schema) error {
 		} else {
 			i := 1
 			for ; i < len(tblSch); i++ {
-				if tblSch[i-1].Name == a.order.AfterColumn {
+				if strings.EqualFold(tblSch[i-1].Name, a.order.AfterColumn) {
 					break
 				}
 			}
@@ -566,6 +566,9 @@ type ModifyColumn struct {
 	column       *sql.Column
 	order        *sql.ColumnOrder
 	targetSchema sql.Schema
+	// Ignore is set for ALTER IGNORE TABLE, with which the values that can't be converted to the column's new type
+	// are replaced with the closest valid ones, with warnings, rather than being errors.
+	Ignore bool
 }
 
 var _ sql.Node = (*ModifyColumn)(nil)
@@ -702,7 +705,7 @@ func (m *ModifyColumn) ValidateDefaultPosition(tblSch sql.Schema) error {
 		i := 1
 		for ; i < len(tblSch); i++ {
 			colsBeforeThis[tblSch[i].Name] = tblSch[i]
-			if tblSch[i-1].Name == m.order.AfterColumn {
+			if strings.EqualFold(tblSch[i-1].Name, m.order.AfterColumn) {
 				break
 			}
 		}
@@ -732,6 +735,12 @@ type AlterTableCollation struct {
 	ddlNode
 	Table     sql.Node
 	Collation sql.CollationID
+	// ConvertColumns is set for ALTER TABLE ... CONVERT TO CHARACTER SET, which converts the table's string columns
+	// and their stored values along with the table's default collation.
+	ConvertColumns bool
+	// Ignore is set for ALTER IGNORE TABLE, with which the values that can't be converted are replaced with the
+	// closest valid ones, with warnings, rather than being errors.
+	Ignore bool
 }
 
 var _ sql.Node = (*AlterTableCollation)(nil)
@@ -755,6 +764,10 @@ func (atc *AlterTableCollation) WithDatabase(db sql.Database) (sql.Node, error)
 
 // String implements the interface sql.Node.
 func (atc *AlterTableCollation) String() string {
+	if atc.ConvertColumns {
+		return fmt.Sprintf("alter table %s convert to character set %s collate %s",
+			atc.Table.String(), atc.Collation.CharacterSet().Name(), atc.Collation.Name())
+	}
 	return fmt.Sprintf("alter table %s collate %s", atc.Table.String(), atc.Collation.Name())
 }
 
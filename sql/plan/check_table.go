@@ -0,0 +1,88 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// CheckTable checks the tables it has as children for errors, reporting the result for each in a CHECK TABLE result
+// row set. Tables are checked by their sql.VerifiableTable implementation, if they have one.
+type CheckTable struct {
+	Tables []sql.Node
+}
+
+var _ sql.Node = (*CheckTable)(nil)
+var _ sql.CollationCoercible = (*CheckTable)(nil)
+
+// NewCheckTable creates a new CheckTable node for the tables given.
+func NewCheckTable(tables []sql.Node) *CheckTable {
+	return &CheckTable{Tables: tables}
+}
+
+// Schema implements the sql.Node interface.
+func (c *CheckTable) Schema() sql.Schema {
+	return analyzeSchema
+}
+
+// Children implements the sql.Node interface.
+func (c *CheckTable) Children() []sql.Node {
+	return c.Tables
+}
+
+// Resolved implements the sql.Node interface.
+func (c *CheckTable) Resolved() bool {
+	for _, t := range c.Tables {
+		if !t.Resolved() {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *CheckTable) String() string {
+	p := sql.NewTreePrinter()
+	_ = p.WriteNode("CheckTable")
+	children := make([]string, len(c.Tables))
+	for i, t := range c.Tables {
+		children[i] = t.String()
+	}
+	_ = p.WriteChildren(children...)
+	return p.String()
+}
+
+// WithChildren implements the sql.Node interface.
+func (c *CheckTable) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != len(c.Tables) {
+		return nil, sql.ErrInvalidChildrenNumber.New(c, len(children), len(c.Tables))
+	}
+	return NewCheckTable(children), nil
+}
+
+// CheckPrivileges implements the interface sql.Node.
+func (c *CheckTable) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	for _, t := range c.Tables {
+		if !opChecker.UserHasPrivileges(ctx,
+			sql.NewPrivilegedOperation(GetDatabaseName(t), getTableName(t), "", sql.PrivilegeType_Select)) {
+			return false
+		}
+	}
+	return true
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*CheckTable) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
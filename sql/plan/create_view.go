@@ -30,10 +30,12 @@ import (
 // explicit columns specified by the query, if any.
 type CreateView struct {
 	UnaryNode
-	database         sql.Database
-	Name             string
-	Columns          []string
-	IsReplace        bool
+	database  sql.Database
+	Name      string
+	Columns   []string
+	IsReplace bool
+	// IsAlter is true for ALTER VIEW statements, which replace a view that must already exist.
+	IsAlter          bool
 	Definition       *SubqueryAlias
 	CreateViewString string
 	Algorithm        string
@@ -90,6 +90,8 @@ type TableSpec struct {
 	ChDefs    []*sql.CheckConstraint
 	IdxDefs   []*IndexDefinition
 	Collation sql.CollationID
+	// StatsAutoRecalc is the STATS_AUTO_RECALC table option
+	StatsAutoRecalc sql.StatsAutoRecalc
 }
 
 func (c *TableSpec) WithSchema(schema sql.PrimaryKeySchema) *TableSpec {
@@ -127,9 +129,11 @@ type CreateTable struct {
 	ChDefs       sql.CheckConstraints
 	IdxDefs      []*IndexDefinition
 	Collation    sql.CollationID
-	like         sql.Node
-	temporary    TempTableOption
-	selectNode   sql.Node
+	// StatsAutoRecalc is the STATS_AUTO_RECALC table option
+	StatsAutoRecalc sql.StatsAutoRecalc
+	like            sql.Node
+	temporary       TempTableOption
+	selectNode      sql.Node
 }
 
 var _ sql.Databaser = (*CreateTable)(nil)
@@ -145,15 +149,16 @@ func NewCreateTable(db sql.Database, name string, ifn IfNotExistsOption, temp Te
 	}
 
 	return &CreateTable{
-		ddlNode:      ddlNode{db},
-		name:         name,
-		CreateSchema: tableSpec.Schema,
-		FkDefs:       tableSpec.FkDefs,
-		ChDefs:       tableSpec.ChDefs,
-		IdxDefs:      tableSpec.IdxDefs,
-		Collation:    tableSpec.Collation,
-		ifNotExists:  ifn,
-		temporary:    temp,
+		ddlNode:         ddlNode{db},
+		name:            name,
+		CreateSchema:    tableSpec.Schema,
+		FkDefs:          tableSpec.FkDefs,
+		ChDefs:          tableSpec.ChDefs,
+		IdxDefs:         tableSpec.IdxDefs,
+		Collation:       tableSpec.Collation,
+		StatsAutoRecalc: tableSpec.StatsAutoRecalc,
+		ifNotExists:     ifn,
+		temporary:       temp,
 	}
 }
 
@@ -175,15 +180,16 @@ func NewCreateTableSelect(db sql.Database, name string, selectNode sql.Node, tab
 	}
 
 	return &CreateTable{
-		ddlNode:      ddlNode{Db: db},
-		CreateSchema: tableSpec.Schema,
-		FkDefs:       tableSpec.FkDefs,
-		ChDefs:       tableSpec.ChDefs,
-		IdxDefs:      tableSpec.IdxDefs,
-		name:         name,
-		selectNode:   selectNode,
-		ifNotExists:  ifn,
-		temporary:    temp,
+		ddlNode:         ddlNode{Db: db},
+		CreateSchema:    tableSpec.Schema,
+		FkDefs:          tableSpec.FkDefs,
+		ChDefs:          tableSpec.ChDefs,
+		IdxDefs:         tableSpec.IdxDefs,
+		StatsAutoRecalc: tableSpec.StatsAutoRecalc,
+		name:            name,
+		selectNode:      selectNode,
+		ifNotExists:     ifn,
+		temporary:       temp,
 	}
 }
 
@@ -231,6 +237,12 @@ func (c *CreateTable) Resolved() bool {
 		}
 	}
 
+	for _, idxDef := range c.IdxDefs {
+		if !expression.ExpressionsResolved(IndexColumnExpressions(idxDef.Columns)...) {
+			return false
+		}
+	}
+
 	if c.like != nil {
 		if !c.like.Resolved() {
 			return false
@@ -269,7 +281,7 @@ func (c *CreateTable) CreateIndexes(ctx *sql.Context, tableNode sql.Table, idxes
 		// If the name is empty, we create a new name using the columns provided while appending an ascending integer
 		// until we get a non-colliding name if the original name (or each preceding name) already exists.
 		if indexName == "" {
-			indexName = strings.Join(idxDef.ColumnNames(), "")
+			indexName = DefaultIndexName(idxDef.Columns)
 			if _, ok = indexMap[strings.ToLower(indexName)]; ok {
 				for i := 0; true; i++ {
 					newIndexName := fmt.Sprintf("%s_%d", indexName, i)
@@ -497,6 +509,9 @@ func (c *CreateTable) Expressions() []sql.Expression {
 		exprs[i] = ch.Expr
 		i++
 	}
+	for _, idxDef := range c.IdxDefs {
+		exprs = append(exprs, IndexColumnExpressions(idxDef.Columns)...)
+	}
 	return exprs
 }
 
@@ -516,6 +531,7 @@ func (c *CreateTable) TableSpec() *TableSpec {
 	ret = ret.WithIndices(c.IdxDefs)
 	ret = ret.WithCheckConstraints(c.ChDefs)
 	ret.Collation = c.Collation
+	ret.StatsAutoRecalc = c.StatsAutoRecalc
 
 	return ret
 }
@@ -534,6 +550,9 @@ func (c *CreateTable) Temporary() TempTableOption {
 
 func (c CreateTable) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
 	length := len(c.CreateSchema.Schema) + len(c.ChDefs)
+	for _, idxDef := range c.IdxDefs {
+		length += len(IndexColumnExpressions(idxDef.Columns))
+	}
 	if len(exprs) != length {
 		return nil, sql.ErrInvalidChildrenNumber.New(c, len(exprs), length)
 	}
@@ -553,12 +572,24 @@ func (c CreateTable) WithExpressions(exprs ...sql.Expression) (sql.Node, error)
 	}
 	nc.CreateSchema = sql.NewPrimaryKeySchema(ns, c.CreateSchema.PkOrdinals...)
 
-	ncd, err := c.ChDefs.FromExpressions(exprs[i:])
+	ncd, err := c.ChDefs.FromExpressions(exprs[i : i+len(c.ChDefs)])
 	if err != nil {
 		return nil, err
 	}
+	i += len(c.ChDefs)
 
 	nc.ChDefs = ncd
+
+	if len(exprs) > i {
+		nc.IdxDefs = make([]*IndexDefinition, len(c.IdxDefs))
+		for j, idxDef := range c.IdxDefs {
+			nidx := *idxDef
+			n := len(IndexColumnExpressions(idxDef.Columns))
+			nidx.Columns = WithIndexColumnExpressions(idxDef.Columns, exprs[i:i+n])
+			i += n
+			nc.IdxDefs[j] = &nidx
+		}
+	}
 	return &nc, nil
 }
 
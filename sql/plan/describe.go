@@ -72,6 +72,12 @@ func (d Describe) String() string {
 type DescribeQuery struct {
 	UnaryNode
 	Format string
+	// FiredRules are the analyzer rules that changed the plan of the query, with the batches they're in, when the
+	// format is "rules".
+	FiredRules []string
+	// EstimatedTree are the lines of the plan of the query annotated with estimated costs and rows, like the output of
+	// MySQL's EXPLAIN FORMAT=TREE, when the format is "estimated_tree".
+	EstimatedTree []string
 }
 
 var _ sql.Node = (*DescribeQuery)(nil)
@@ -109,7 +115,7 @@ var DescribeSchema = sql.Schema{
 
 // NewDescribeQuery creates a new DescribeQuery node.
 func NewDescribeQuery(format string, child sql.Node) *DescribeQuery {
-	return &DescribeQuery{UnaryNode{Child: child}, format}
+	return &DescribeQuery{UnaryNode: UnaryNode{Child: child}, Format: format}
 }
 
 // Schema implements the Node interface.
@@ -142,5 +148,7 @@ func (d *DescribeQuery) Query() sql.Node {
 
 // WithQuery returns a copy of this node with the query node given
 func (d *DescribeQuery) WithQuery(child sql.Node) sql.Node {
-	return NewDescribeQuery(d.Format, child)
+	nd := *d
+	nd.Child = child
+	return &nd
 }
@@ -31,6 +31,11 @@ var ErrNoPartitionable = errors.NewKind("no partitionable node found in exchange
 type Exchange struct {
 	UnaryNode
 	Parallelism int
+	// Ordered is set when the rows of the partitions must be returned in
+	// the order of the partitions, like a serial scan returns them, rather
+	// than as soon as any partition produces them. This makes the order of
+	// rows tied on the keys of a sort above the exchange deterministic.
+	Ordered bool
 }
 
 var _ sql.Node = (*Exchange)(nil)
@@ -56,7 +61,11 @@ func (e *Exchange) String() string {
 
 func (e *Exchange) DebugString() string {
 	p := sql.NewTreePrinter()
-	_ = p.WriteNode("Exchange(parallelism=%d)", e.Parallelism)
+	if e.Ordered {
+		_ = p.WriteNode("Exchange(parallelism=%d, ordered)", e.Parallelism)
+	} else {
+		_ = p.WriteNode("Exchange(parallelism=%d)", e.Parallelism)
+	}
 	_ = p.WriteChildren(sql.DebugString(e.Child))
 	return p.String()
 }
@@ -67,7 +76,17 @@ func (e *Exchange) WithChildren(children ...sql.Node) (sql.Node, error) {
 		return nil, sql.ErrInvalidChildrenNumber.New(e, len(children), 1)
 	}
 
-	return NewExchange(e.Parallelism, children[0]), nil
+	ne := *e
+	ne.Child = children[0]
+	return &ne, nil
+}
+
+// WithOrdered returns a copy of this exchange returning the rows of its
+// partitions in order or not.
+func (e *Exchange) WithOrdered(ordered bool) *Exchange {
+	ne := *e
+	ne.Ordered = ordered
+	return &ne
 }
 
 // CheckPrivileges implements the interface sql.Node.
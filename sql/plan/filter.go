@@ -94,8 +94,14 @@ func (f *Filter) Expressions() []sql.Expression {
 type FilterIter struct {
 	cond      sql.Expression
 	childIter sql.RowIter
+	// childBatches reads the child's rows in batches once NextBatch is called.
+	childBatches sql.BatchRowIter
+	batch        []sql.Row
 }
 
+var _ sql.RowReuser = (*FilterIter)(nil)
+var _ sql.BatchRowIter = (*FilterIter)(nil)
+
 // NewFilterIter creates a new FilterIter.
 func NewFilterIter(
 	cond sql.Expression,
@@ -123,6 +129,42 @@ func (i *FilterIter) Next(ctx *sql.Context) (sql.Row, error) {
 	}
 }
 
+// NextBatch implements the sql.BatchRowIter interface. Each batch holds the matching rows of one of the child's
+// batches, skipping those with none.
+func (i *FilterIter) NextBatch(ctx *sql.Context) ([]sql.Row, error) {
+	if i.childBatches == nil {
+		i.childBatches = sql.NewBatchRowIter(i.childIter)
+	}
+
+	for {
+		rows, err := i.childBatches.NextBatch(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		i.batch = i.batch[:0]
+		for _, row := range rows {
+			res, err := sql.EvaluateCondition(ctx, i.cond, row)
+			if err != nil {
+				return nil, err
+			}
+
+			if sql.IsTrue(res) {
+				i.batch = append(i.batch, row)
+			}
+		}
+		if len(i.batch) > 0 {
+			return i.batch, nil
+		}
+	}
+}
+
+// ReuseRows implements the sql.RowReuser interface. Rows are passed through from the child, which reuses its rows in
+// turn.
+func (i *FilterIter) ReuseRows() {
+	sql.ReuseRows(i.childIter)
+}
+
 // Close implements the RowIter interface.
 func (i *FilterIter) Close(ctx *sql.Context) error {
 	return i.childIter.Close(ctx)
@@ -20,7 +20,8 @@ import (
 	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
-// FlushPrivileges reads privileges from mysql tables and registers any unregistered privileges found.
+// FlushPrivileges reloads the grant tables from the persisted privileges when the persister can read them back, and
+// otherwise persists the privileges of the grant tables, registering any changes made to them with DML statements.
 type FlushPrivileges struct {
 	writesToBinlog bool
 	MysqlDb        sql.Database
@@ -44,7 +45,7 @@ func (f *FlushPrivileges) RowIter(ctx *sql.Context, _ sql.Row) (sql.RowIter, err
 	if !ok {
 		return nil, sql.ErrDatabaseNotFound.New("mysql")
 	}
-	err := gts.Persist(ctx)
+	err := gts.Reload(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -101,3 +102,96 @@ func (f *FlushPrivileges) WithDatabase(db sql.Database) (sql.Node, error) {
 	fp.MysqlDb = db
 	return &fp, nil
 }
+
+// FlushLogs closes and reopens logs, so that their files can be rotated. The logs are written by integrators, which
+// implement sql.LogFlusher to flush them.
+type FlushLogs struct {
+	Kind    sql.LogKind
+	Catalog sql.Catalog
+}
+
+var _ sql.Node = (*FlushLogs)(nil)
+var _ sql.CollationCoercible = (*FlushLogs)(nil)
+
+// NewFlushLogs creates a new FlushLogs node flushing the logs of the kind given.
+func NewFlushLogs(kind sql.LogKind) *FlushLogs {
+	return &FlushLogs{Kind: kind}
+}
+
+// String implements the interface sql.Node.
+func (f *FlushLogs) String() string {
+	if f.Kind == sql.AllLogs {
+		return "FLUSH LOGS"
+	}
+	return "FLUSH " + string(f.Kind)
+}
+
+// WithChildren implements the interface sql.Node.
+func (f *FlushLogs) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), 0)
+	}
+
+	return f, nil
+}
+
+// CheckPrivileges implements the interface sql.Node.
+func (f *FlushLogs) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return opChecker.UserHasPrivileges(ctx, sql.NewPrivilegedOperation("", "", "", sql.PrivilegeType_Reload))
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*FlushLogs) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
+
+// Resolved implements the interface sql.Node.
+func (*FlushLogs) Resolved() bool { return true }
+
+// Children implements the sql.Node interface.
+func (*FlushLogs) Children() []sql.Node { return nil }
+
+// Schema implements the sql.Node interface.
+func (*FlushLogs) Schema() sql.Schema { return types.OkResultSchema }
+
+// FlushStatus resets the status counters of the session to zero.
+type FlushStatus struct{}
+
+var _ sql.Node = (*FlushStatus)(nil)
+var _ sql.CollationCoercible = (*FlushStatus)(nil)
+
+// NewFlushStatus creates a new FlushStatus node.
+func NewFlushStatus() *FlushStatus {
+	return &FlushStatus{}
+}
+
+// String implements the interface sql.Node.
+func (*FlushStatus) String() string { return "FLUSH STATUS" }
+
+// WithChildren implements the interface sql.Node.
+func (f *FlushStatus) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), 0)
+	}
+
+	return f, nil
+}
+
+// CheckPrivileges implements the interface sql.Node.
+func (f *FlushStatus) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return opChecker.UserHasPrivileges(ctx, sql.NewPrivilegedOperation("", "", "", sql.PrivilegeType_Reload))
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*FlushStatus) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
+
+// Resolved implements the interface sql.Node.
+func (*FlushStatus) Resolved() bool { return true }
+
+// Children implements the sql.Node interface.
+func (*FlushStatus) Children() []sql.Node { return nil }
+
+// Schema implements the sql.Node interface.
+func (*FlushStatus) Schema() sql.Schema { return types.OkResultSchema }
@@ -0,0 +1,287 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// HandlerOpen opens a HANDLER cursor on a table for the session in which it's executed.
+type HandlerOpen struct {
+	UnaryNode
+	// Name is the name the handler is opened under, which is the alias given or else the table's name.
+	Name string
+}
+
+var _ sql.Node = (*HandlerOpen)(nil)
+var _ sql.CollationCoercible = (*HandlerOpen)(nil)
+
+// NewHandlerOpen creates a new HandlerOpen node.
+func NewHandlerOpen(table sql.Node, name string) *HandlerOpen {
+	return &HandlerOpen{UnaryNode: UnaryNode{Child: table}, Name: name}
+}
+
+// Schema implements the sql.Node interface.
+func (h *HandlerOpen) Schema() sql.Schema { return nil }
+
+func (h *HandlerOpen) String() string {
+	p := sql.NewTreePrinter()
+	_ = p.WriteNode("HandlerOpen(%s)", h.Name)
+	_ = p.WriteChildren(h.Child.String())
+	return p.String()
+}
+
+// WithChildren implements the Node interface.
+func (h *HandlerOpen) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(h, len(children), 1)
+	}
+
+	return NewHandlerOpen(children[0], h.Name), nil
+}
+
+// CheckPrivileges implements the interface sql.Node.
+func (h *HandlerOpen) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return opChecker.UserHasPrivileges(ctx,
+		sql.NewPrivilegedOperation(GetDatabaseName(h.Child), getTableName(h.Child), "", sql.PrivilegeType_Select))
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*HandlerOpen) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
+
+// HandlerReadMode is the direction or key comparison of a HANDLER ... READ statement.
+type HandlerReadMode byte
+
+const (
+	HandlerReadFirst HandlerReadMode = iota
+	HandlerReadNext
+	HandlerReadPrev
+	HandlerReadLast
+	// The remaining modes position the cursor on the index key given.
+	HandlerReadEqual
+	HandlerReadGreaterOrEqual
+	HandlerReadGreater
+	HandlerReadLessOrEqual
+	HandlerReadLess
+)
+
+func (m HandlerReadMode) String() string {
+	switch m {
+	case HandlerReadFirst:
+		return "FIRST"
+	case HandlerReadNext:
+		return "NEXT"
+	case HandlerReadPrev:
+		return "PREV"
+	case HandlerReadLast:
+		return "LAST"
+	case HandlerReadEqual:
+		return "="
+	case HandlerReadGreaterOrEqual:
+		return ">="
+	case HandlerReadGreater:
+		return ">"
+	case HandlerReadLessOrEqual:
+		return "<="
+	case HandlerReadLess:
+		return "<"
+	default:
+		return fmt.Sprintf("HandlerReadMode(%d)", byte(m))
+	}
+}
+
+// IsKeyed returns whether the mode positions the cursor on an index key.
+func (m HandlerReadMode) IsKeyed() bool {
+	return m >= HandlerReadEqual
+}
+
+// Forward returns whether the mode reads rows in cursor order, as opposed to in reverse.
+func (m HandlerReadMode) Forward() bool {
+	switch m {
+	case HandlerReadFirst, HandlerReadNext, HandlerReadEqual, HandlerReadGreaterOrEqual, HandlerReadGreater:
+		return true
+	default:
+		return false
+	}
+}
+
+// HandlerRead reads rows from a HANDLER cursor opened with HandlerOpen, moving the cursor past them. Its child is the
+// handler's table, aliased to the handler's name, against which Where is resolved.
+type HandlerRead struct {
+	UnaryNode
+	Name string
+	// Index is the index the cursor is read in the order of, or empty to read the table in its natural order.
+	Index string
+	Mode  HandlerReadMode
+	// Key holds the values of the leading index columns compared against when Mode is keyed.
+	Key    []sql.Expression
+	Where  sql.Expression
+	Limit  sql.Expression
+	Offset sql.Expression
+}
+
+var _ sql.Node = (*HandlerRead)(nil)
+var _ sql.Expressioner = (*HandlerRead)(nil)
+var _ sql.CollationCoercible = (*HandlerRead)(nil)
+
+// NewHandlerRead creates a new HandlerRead node. The where and offset expressions may be nil.
+func NewHandlerRead(table sql.Node, name, index string, mode HandlerReadMode, key []sql.Expression, where, limit, offset sql.Expression) *HandlerRead {
+	return &HandlerRead{
+		UnaryNode: UnaryNode{Child: table},
+		Name:      name,
+		Index:     index,
+		Mode:      mode,
+		Key:       key,
+		Where:     where,
+		Limit:     limit,
+		Offset:    offset,
+	}
+}
+
+// Resolved implements the sql.Node interface.
+func (h *HandlerRead) Resolved() bool {
+	return h.Child.Resolved() && expression.ExpressionsResolved(h.Expressions()...)
+}
+
+// Expressions implements the sql.Expressioner interface.
+func (h *HandlerRead) Expressions() []sql.Expression {
+	exprs := append([]sql.Expression{h.Limit}, h.Key...)
+	if h.Where != nil {
+		exprs = append(exprs, h.Where)
+	}
+	if h.Offset != nil {
+		exprs = append(exprs, h.Offset)
+	}
+	return exprs
+}
+
+// WithExpressions implements the sql.Expressioner interface.
+func (h *HandlerRead) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
+	if len(exprs) != len(h.Expressions()) {
+		return nil, sql.ErrInvalidChildrenNumber.New(h, len(exprs), len(h.Expressions()))
+	}
+
+	nh := *h
+	nh.Limit, exprs = exprs[0], exprs[1:]
+	nh.Key, exprs = exprs[:len(h.Key)], exprs[len(h.Key):]
+	if h.Where != nil {
+		nh.Where, exprs = exprs[0], exprs[1:]
+	}
+	if h.Offset != nil {
+		nh.Offset = exprs[0]
+	}
+	return &nh, nil
+}
+
+func (h *HandlerRead) String() string {
+	p := sql.NewTreePrinter()
+	read := h.Mode.String()
+	if h.Mode.IsKeyed() {
+		keys := make([]string, len(h.Key))
+		for i, k := range h.Key {
+			keys[i] = k.String()
+		}
+		read = fmt.Sprintf("%s (%s)", read, strings.Join(keys, ", "))
+	}
+	if h.Index != "" {
+		read = h.Index + " " + read
+	}
+	_ = p.WriteNode("HandlerRead(%s %s)", h.Name, read)
+	children := []string{fmt.Sprintf("limit: %s", h.Limit)}
+	if h.Offset != nil {
+		children = append(children, fmt.Sprintf("offset: %s", h.Offset))
+	}
+	if h.Where != nil {
+		children = append(children, fmt.Sprintf("where: %s", h.Where))
+	}
+	children = append(children, h.Child.String())
+	_ = p.WriteChildren(children...)
+	return p.String()
+}
+
+// WithChildren implements the Node interface.
+func (h *HandlerRead) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(h, len(children), 1)
+	}
+
+	nh := *h
+	nh.Child = children[0]
+	return &nh, nil
+}
+
+// CheckPrivileges implements the interface sql.Node.
+func (h *HandlerRead) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return h.Child.CheckPrivileges(ctx, opChecker)
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (h *HandlerRead) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.GetCoercibility(ctx, h.Child)
+}
+
+// HandlerClose closes a HANDLER cursor opened with HandlerOpen.
+type HandlerClose struct {
+	Name string
+}
+
+var _ sql.Node = (*HandlerClose)(nil)
+var _ sql.CollationCoercible = (*HandlerClose)(nil)
+
+// NewHandlerClose creates a new HandlerClose node.
+func NewHandlerClose(name string) *HandlerClose {
+	return &HandlerClose{Name: name}
+}
+
+// Children implements the sql.Node interface.
+func (h *HandlerClose) Children() []sql.Node { return nil }
+
+// Resolved implements the sql.Node interface.
+func (h *HandlerClose) Resolved() bool { return true }
+
+// Schema implements the sql.Node interface.
+func (h *HandlerClose) Schema() sql.Schema { return nil }
+
+func (h *HandlerClose) String() string {
+	p := sql.NewTreePrinter()
+	_ = p.WriteNode("HandlerClose(%s)", h.Name)
+	return p.String()
+}
+
+// WithChildren implements the Node interface.
+func (h *HandlerClose) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(h, len(children), 0)
+	}
+
+	return h, nil
+}
+
+// CheckPrivileges implements the interface sql.Node.
+func (h *HandlerClose) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	// Closing a handler only releases session state, the privileges were checked when it was opened.
+	return true
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*HandlerClose) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
@@ -0,0 +1,137 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// IndexMerge reads the rows of a table that match any of a disjunction of predicates, using a different index lookup
+// for each of them, like MySQL's index merge union access method. Each of the lookups returns the rows matching its
+// predicate, and possibly others. A row is only returned by the lookup of the first predicate it matches, so that
+// rows matching more than one predicate are returned once. The disjunction is still filtered above this node.
+type IndexMerge struct {
+	lookups    []sql.Node
+	Predicates []sql.Expression
+}
+
+var _ sql.Node = (*IndexMerge)(nil)
+var _ sql.Nameable = (*IndexMerge)(nil)
+var _ sql.Expressioner = (*IndexMerge)(nil)
+var _ sql.CollationCoercible = (*IndexMerge)(nil)
+
+// NewIndexMerge returns a new IndexMerge node that reads the rows matching each of the predicates given with the
+// IndexedTableAccess at the same position. All of the lookups must be on the same table.
+func NewIndexMerge(lookups []*IndexedTableAccess, predicates []sql.Expression) *IndexMerge {
+	children := make([]sql.Node, len(lookups))
+	for i, l := range lookups {
+		children[i] = l
+	}
+	return &IndexMerge{
+		lookups:    children,
+		Predicates: predicates,
+	}
+}
+
+// Name implements the sql.Nameable interface.
+func (m *IndexMerge) Name() string {
+	if n, ok := m.lookups[0].(sql.Nameable); ok {
+		return n.Name()
+	}
+	return ""
+}
+
+// Resolved implements the sql.Node interface.
+func (m *IndexMerge) Resolved() bool {
+	for _, l := range m.lookups {
+		if !l.Resolved() {
+			return false
+		}
+	}
+	return expression.ExpressionsResolved(m.Predicates...)
+}
+
+// Schema implements the sql.Node interface.
+func (m *IndexMerge) Schema() sql.Schema {
+	return m.lookups[0].Schema()
+}
+
+// Children implements the sql.Node interface.
+func (m *IndexMerge) Children() []sql.Node {
+	return m.lookups
+}
+
+// WithChildren implements the sql.Node interface.
+func (m *IndexMerge) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != len(m.lookups) {
+		return nil, sql.ErrInvalidChildrenNumber.New(m, len(children), len(m.lookups))
+	}
+	nm := *m
+	nm.lookups = children
+	return &nm, nil
+}
+
+// Expressions implements the sql.Expressioner interface.
+func (m *IndexMerge) Expressions() []sql.Expression {
+	return m.Predicates
+}
+
+// WithExpressions implements the sql.Expressioner interface.
+func (m *IndexMerge) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
+	if len(exprs) != len(m.Predicates) {
+		return nil, sql.ErrInvalidChildrenNumber.New(m, len(exprs), len(m.Predicates))
+	}
+	nm := *m
+	nm.Predicates = exprs
+	return &nm, nil
+}
+
+// CheckPrivileges implements the interface sql.Node.
+func (m *IndexMerge) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	for _, l := range m.lookups {
+		if !l.CheckPrivileges(ctx, opChecker) {
+			return false
+		}
+	}
+	return true
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (m *IndexMerge) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.GetCoercibility(ctx, m.lookups[0])
+}
+
+func (m *IndexMerge) String() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("IndexMerge(union)")
+	children := make([]string, len(m.lookups))
+	for i, l := range m.lookups {
+		children[i] = l.String()
+	}
+	_ = pr.WriteChildren(children...)
+	return pr.String()
+}
+
+func (m *IndexMerge) DebugString() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("IndexMerge(union)")
+	children := make([]string, len(m.lookups))
+	for i, l := range m.lookups {
+		children[i] = sql.DebugString(l)
+	}
+	_ = pr.WriteChildren(children...)
+	return pr.String()
+}
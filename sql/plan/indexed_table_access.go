@@ -166,6 +166,26 @@ func (i *IndexedTableAccess) Index() sql.Index {
 	return i.lb.index
 }
 
+// IndexCondition returns the condition the entries of this node's index scan are filtered on, or nil if there is none.
+func (i *IndexedTableAccess) IndexCondition() sql.Expression {
+	if t, ok := i.Table.(sql.IndexConditionPushdownTable); ok {
+		return t.IndexCondition()
+	}
+	return nil
+}
+
+// WithIndexCondition returns a copy of this node whose index scan is filtered on the condition given, which is
+// evaluated on the entries of its index. The table of the node must be a sql.IndexConditionPushdownTable.
+func (i *IndexedTableAccess) WithIndexCondition(cond sql.Expression) (*IndexedTableAccess, error) {
+	t, ok := i.Table.(sql.IndexConditionPushdownTable)
+	if !ok {
+		return nil, fmt.Errorf("table %s does not support index condition pushdown", i.Name())
+	}
+	ni := *i
+	ni.Table = t.WithIndexCondition(cond)
+	return &ni, nil
+}
+
 // CanBuildIndex returns whether an index lookup on this table can be successfully built for a zero-valued key. For a
 // static lookup, no lookup needs to be built, so returns true.
 func (i *IndexedTableAccess) CanBuildIndex(ctx *sql.Context) (bool, error) {
@@ -207,11 +227,17 @@ func (i *IndexedTableAccess) getLookup2(ctx *sql.Context, row sql.Row2) (sql.Ind
 
 func (i *IndexedTableAccess) String() string {
 	pr := sql.NewTreePrinter()
-	pr.WriteNode("IndexedTableAccess(%s)", i.ResolvedTable.Name())
+	pr.WriteNode("IndexedTableAccess(%s)", formatTableName(i.ResolvedTable.Database, i.ResolvedTable.Name()))
 	var children []string
-	children = append(children, fmt.Sprintf("index: %s", formatIndexDecoratorString(i.Index())))
+	children = append(children, fmt.Sprintf("index: %s", formatIndexName(i.Index())))
 	if !i.lookup.IsEmpty() {
 		children = append(children, fmt.Sprintf("filters: %s", i.lookup.Ranges.DebugString()))
+		if i.lookup.IsReverse {
+			children = append(children, "reverse: true")
+		}
+	}
+	if cond := i.IndexCondition(); cond != nil {
+		children = append(children, fmt.Sprintf("index condition: %s", cond))
 	}
 
 	if pt, ok := i.Table.(sql.ProjectedTable); ok {
@@ -249,11 +275,17 @@ func formatIndexDecoratorString(idx sql.Index) string {
 
 func (i *IndexedTableAccess) DebugString() string {
 	pr := sql.NewTreePrinter()
-	pr.WriteNode("IndexedTableAccess(%s)", i.ResolvedTable.Name())
+	pr.WriteNode("IndexedTableAccess(%s)", formatTableName(i.ResolvedTable.Database, i.ResolvedTable.Name()))
 	var children []string
-	children = append(children, fmt.Sprintf("index: %s", formatIndexDecoratorString(i.Index())))
+	children = append(children, fmt.Sprintf("index: %s", formatIndexName(i.Index())))
 	if !i.lookup.IsEmpty() {
 		children = append(children, fmt.Sprintf("static: %s", i.lookup.Ranges.DebugString()))
+		if i.lookup.IsReverse {
+			children = append(children, "reverse: true")
+		}
+	}
+	if cond := i.IndexCondition(); cond != nil {
+		children = append(children, fmt.Sprintf("index condition: %s", sql.DebugString(cond)))
 	}
 
 	var columns []string
@@ -58,6 +58,12 @@ var IgnorableErrors = []*errors.Kind{sql.ErrInsertIntoNonNullableProvidedNull,
 	sql.ErrCheckConstraintViolated,
 }
 
+// RowAliasColumnName prefixes the names of the columns that the ON DUPLICATE KEY UPDATE expressions of an INSERT
+// without a column list use for the columns of its destination, in place of the column aliases of its row alias, as
+// the names of the columns aren't known before the destination is resolved. The position of the column in the
+// destination, starting at 1, follows it.
+const RowAliasColumnName = "GMS_ROW_ALIAS_COLUMN_"
+
 // InsertInto is the top level node for INSERT INTO statements. It has a source for rows and a destination to insert
 // them into.
 type InsertInto struct {
@@ -64,6 +64,10 @@ func (in *InSubquery) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	// However, there's a strange edge case. NULL IN (empty list) return 0, not NULL.
 	leftNull := left == nil
 
+	if right, ok := in.Right.(*Subquery); ok && types.IsTuple(typ) {
+		return in.evalTuples(ctx, row, left, right)
+	}
+
 	left, _, err = typ.Convert(left)
 	if err != nil {
 		return nil, err
@@ -126,6 +130,41 @@ func (in *InSubquery) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	}
 }
 
+// evalTuples evaluates the expression for the value of a row constructor on the left, which is in the subquery's
+// result if it equals any of its rows. Rows are compared element by element rather than hashed, since comparing rows
+// with NULL elements is NULL unless other elements differ.
+func (in *InSubquery) evalTuples(ctx *sql.Context, row sql.Row, left interface{}, right *Subquery) (interface{}, error) {
+	if types.NumColumns(in.Left.Type()) != types.NumColumns(right.Type()) {
+		return nil, sql.ErrInvalidOperandColumns.New(types.NumColumns(in.Left.Type()), types.NumColumns(right.Type()))
+	}
+
+	values, err := right.EvalMultiple(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	if len(values) == 0 {
+		return false, nil
+	}
+
+	rightNull := false
+	for _, val := range values {
+		eq, err := expression.TuplesEqual(ctx, in.Left.Type(), right.Type(), left, val)
+		if err != nil {
+			return nil, err
+		}
+		if eq == nil {
+			rightNull = true
+		} else if eq == true {
+			return true, nil
+		}
+	}
+
+	if rightNull {
+		return nil, nil
+	}
+	return false, nil
+}
+
 // WithChildren implements the Expression interface.
 func (in *InSubquery) WithChildren(children ...sql.Expression) (sql.Expression, error) {
 	if len(children) != 2 {
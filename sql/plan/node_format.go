@@ -0,0 +1,53 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// LegacyNodeFormat makes the String and DebugString methods of nodes print the names of tables, aliases and indexes
+// the way they did before they were quoted when needed, tables were qualified with their database and indexed table
+// accesses printed the names of their indexes. It's only meant to let integrators migrate plans compared in their
+// tests, and will be removed in a future release.
+var LegacyNodeFormat = false
+
+// formatIdentifier returns the name of a table, alias or index given as printed in the string of a node.
+func formatIdentifier(name string) string {
+	if LegacyNodeFormat {
+		return name
+	}
+	return sql.QuoteIdentifierIfNeeded(name)
+}
+
+// formatTableName returns the name of the table given as printed in the string of a node, qualified by the name of
+// its database if it has one.
+func formatTableName(db sql.Database, name string) string {
+	if LegacyNodeFormat {
+		return name
+	}
+	if db == nil || db.Name() == "" {
+		return formatIdentifier(name)
+	}
+	return formatIdentifier(db.Name()) + "." + formatIdentifier(name)
+}
+
+// formatIndexName returns the name of the index given followed by its expressions, as printed in the string of a node.
+func formatIndexName(idx sql.Index) string {
+	if LegacyNodeFormat {
+		return formatIndexDecoratorString(idx)
+	}
+	return formatIdentifier(idx.ID()) + " " + formatIndexDecoratorString(idx)
+}
@@ -16,6 +16,7 @@ package plan
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/types"
@@ -134,7 +135,12 @@ func (*ExecuteQuery) CollationCoercibility(ctx *sql.Context) (collation sql.Coll
 }
 
 func (p *ExecuteQuery) String() string {
-	panic("ExecuteQuery methods shouldn't be used")
+	args := make([]string, len(p.BindVars)+1)
+	args[0] = p.Name
+	for i, v := range p.BindVars {
+		args[i+1] = v.String()
+	}
+	return fmt.Sprintf("Execute(%s)", strings.Join(args, ", "))
 }
 
 // DeallocateQuery is a node that prepares the query
@@ -16,6 +16,7 @@ package plan
 
 import (
 	"fmt"
+	"io"
 
 	"github.com/dolthub/go-mysql-server/sql/transform"
 
@@ -38,6 +39,12 @@ var _ sql.CollationCoercible = (*QueryProcess)(nil)
 // NotifyFunc is a function to notify about some event.
 type NotifyFunc func()
 
+// RowCountNotifyFunc is a function to notify about a number of rows having been read.
+type RowCountNotifyFunc func(delta int64)
+
+// rowCountNotifyInterval is the number of rows a trackedRowIter reads between calls to its OnRowCount function.
+const rowCountNotifyInterval = 100
+
 // NewQueryProcess creates a new QueryProcess node.
 func NewQueryProcess(node sql.Node, notify NotifyFunc) *QueryProcess {
 	return &QueryProcess{UnaryNode{Child: node}, notify}
@@ -113,6 +120,7 @@ type ProcessIndexableTable struct {
 	OnPartitionDone  NamedNotifyFunc
 	OnPartitionStart NamedNotifyFunc
 	OnRowNext        NamedNotifyFunc
+	OnRowsExamined   RowCountNotifyFunc
 }
 
 func (t *ProcessIndexableTable) DebugString() string {
@@ -124,7 +132,7 @@ func (t *ProcessIndexableTable) DebugString() string {
 
 // NewProcessIndexableTable returns a new ProcessIndexableTable.
 func NewProcessIndexableTable(t sql.DriverIndexableTable, onPartitionDone, onPartitionStart, OnRowNext NamedNotifyFunc) *ProcessIndexableTable {
-	return &ProcessIndexableTable{t, onPartitionDone, onPartitionStart, OnRowNext}
+	return &ProcessIndexableTable{DriverIndexableTable: t, OnPartitionDone: onPartitionDone, OnPartitionStart: onPartitionStart, OnRowNext: OnRowNext}
 }
 
 // Underlying implements sql.TableWrapper interface.
@@ -175,7 +183,9 @@ func (t *ProcessIndexableTable) newPartIter(p sql.Partition, iter sql.RowIter) (
 		}
 	}
 
-	return NewTrackedRowIter(nil, iter, onNext, onDone), nil
+	trackedIter := NewTrackedRowIter(nil, iter, onNext, onDone)
+	trackedIter.OnRowCount = t.OnRowsExamined
+	return trackedIter, nil
 }
 
 var _ sql.DriverIndexableTable = (*ProcessIndexableTable)(nil)
@@ -191,11 +201,12 @@ type ProcessTable struct {
 	OnPartitionDone  NamedNotifyFunc
 	OnPartitionStart NamedNotifyFunc
 	OnRowNext        NamedNotifyFunc
+	OnRowsExamined   RowCountNotifyFunc
 }
 
 // NewProcessTable returns a new ProcessTable.
 func NewProcessTable(t sql.Table, onPartitionDone, onPartitionStart, OnRowNext NamedNotifyFunc) *ProcessTable {
-	return &ProcessTable{t, onPartitionDone, onPartitionStart, OnRowNext}
+	return &ProcessTable{Table: t, OnPartitionDone: onPartitionDone, OnPartitionStart: onPartitionStart, OnRowNext: OnRowNext}
 }
 
 // Underlying implements sql.TableWrapper interface.
@@ -212,7 +223,9 @@ func (t *ProcessTable) PartitionRows(ctx *sql.Context, p sql.Partition) (sql.Row
 
 	onDone, onNext := t.notifyFuncsForPartition(p)
 
-	return NewTrackedRowIter(nil, iter, onNext, onDone), nil
+	trackedIter := NewTrackedRowIter(nil, iter, onNext, onDone)
+	trackedIter.OnRowCount = t.OnRowsExamined
+	return trackedIter, nil
 }
 
 // notifyFuncsForPartition returns the OnDone and OnNext NotifyFuncs for the partition given
@@ -281,8 +294,14 @@ type trackedRowIter struct {
 	ShouldSetFoundRows bool
 	onDone             NotifyFunc
 	onNext             NotifyFunc
+	// OnRowCount, if set, is called with the number of rows read since its last call every rowCountNotifyInterval
+	// rows, and once more when the iterator is exhausted or closed.
+	OnRowCount  RowCountNotifyFunc
+	countedRows int64
 }
 
+var _ sql.RowReuser = (*trackedRowIter)(nil)
+
 func NewTrackedRowIter(
 	node sql.Node,
 	iter sql.RowIter,
@@ -323,6 +342,9 @@ func (i *trackedRowIter) Dispose() {
 func (i *trackedRowIter) Next(ctx *sql.Context) (sql.Row, error) {
 	row, err := i.iter.Next(ctx)
 	if err != nil {
+		if err == io.EOF {
+			i.notifyRowCount()
+		}
 		return nil, err
 	}
 
@@ -331,13 +353,31 @@ func (i *trackedRowIter) Next(ctx *sql.Context) (sql.Row, error) {
 	if i.onNext != nil {
 		i.onNext()
 	}
+	if i.OnRowCount != nil && i.numRows-i.countedRows >= rowCountNotifyInterval {
+		i.notifyRowCount()
+	}
 
 	return row, nil
 }
 
+// ReuseRows implements the sql.RowReuser interface. Rows are passed through from the child, which reuses its rows in
+// turn.
+func (i *trackedRowIter) ReuseRows() {
+	sql.ReuseRows(i.iter)
+}
+
+// notifyRowCount calls OnRowCount with the number of rows read since it was last called, if any.
+func (i *trackedRowIter) notifyRowCount() {
+	if i.OnRowCount != nil && i.numRows > i.countedRows {
+		i.OnRowCount(i.numRows - i.countedRows)
+		i.countedRows = i.numRows
+	}
+}
+
 func (i *trackedRowIter) Close(ctx *sql.Context) error {
 	err := i.iter.Close(ctx)
 
+	i.notifyRowCount()
 	i.updateSessionVars(ctx)
 
 	i.done()
@@ -458,6 +498,25 @@ func IsDDLNode(node sql.Node) bool {
 	}
 }
 
+// ClassifyStatement returns the class of the statement of the parsed node given, as given to a sql.StatementRouter.
+// Statements that may write data, such as CALL and EXECUTE, are classified as writes.
+func ClassifyStatement(node sql.Node) sql.StatementClass {
+	switch n := node.(type) {
+	case *QueryProcess, *TransactionCommittingNode, *RowUpdateAccumulator:
+		return ClassifyStatement(n.(sql.UnaryNode).Child())
+	case *PrepareQuery:
+		return ClassifyStatement(n.Child)
+	case *StartTransaction, *Commit, *Rollback, *CreateSavepoint, *RollbackSavepoint, *ReleaseSavepoint:
+		return sql.StatementClassTransaction
+	case *DeleteFrom, *InsertInto, *Update, *LockTables, *UnlockTables, *Call, *ExecuteQuery:
+		return sql.StatementClassWrite
+	}
+	if IsDDLNode(node) {
+		return sql.StatementClassDDL
+	}
+	return sql.StatementClassRead
+}
+
 func IsShowNode(node sql.Node) bool {
 	switch node.(type) {
 	case *ShowTables, *ShowCreateTable,
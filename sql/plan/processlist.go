@@ -33,13 +33,19 @@ var processListSchema = sql.Schema{
 // ShowProcessList shows a list of all current running processes.
 type ShowProcessList struct {
 	Database string
+	// Full is true for SHOW FULL PROCESSLIST, which shows the complete text of each query rather than only the first
+	// ShowProcessListInfoLength characters.
+	Full bool
 }
 
+// ShowProcessListInfoLength is the number of characters of a query's text shown by SHOW PROCESSLIST without FULL.
+const ShowProcessListInfoLength = 100
+
 var _ sql.Node = (*ShowProcessList)(nil)
 var _ sql.CollationCoercible = (*ShowProcessList)(nil)
 
 // NewShowProcessList creates a new ProcessList node.
-func NewShowProcessList() *ShowProcessList { return new(ShowProcessList) }
+func NewShowProcessList(full bool) *ShowProcessList { return &ShowProcessList{Full: full} }
 
 // Children implements the Node interface.
 func (p *ShowProcessList) Children() []sql.Node { return nil }
@@ -70,4 +76,9 @@ func (*ShowProcessList) CollationCoercibility(ctx *sql.Context) (collation sql.C
 // Schema implements the Node interface.
 func (p *ShowProcessList) Schema() sql.Schema { return processListSchema }
 
-func (p *ShowProcessList) String() string { return "ProcessList" }
+func (p *ShowProcessList) String() string {
+	if p.Full {
+		return "ProcessList(full)"
+	}
+	return "ProcessList"
+}
@@ -46,12 +46,44 @@ func NewProject(expressions []sql.Expression, child sql.Node) *Project {
 // Schema implements the Node interface.
 func (p *Project) Schema() sql.Schema {
 	var s = make(sql.Schema, len(p.Projections))
+	var childSchema sql.Schema
 	for i, e := range p.Projections {
 		s[i] = transform.ExpressionToColumn(e)
+
+		alias, aliased := e.(*expression.Alias)
+		if aliased {
+			e = alias.Child
+		}
+		gf, ok := e.(*expression.GetField)
+		if !ok || gf.Table() == "" {
+			continue
+		}
+		if childSchema == nil {
+			childSchema = p.Child.Schema()
+		}
+		s[i].Origin = columnOrigin(childSchema, gf, aliased)
 	}
 	return s
 }
 
+// columnOrigin returns the origin of the column of the schema given that the field given reads, as a column of a
+// projection. It's nil for columns that aren't aliased, and that don't come from an alias of their table.
+func columnOrigin(schema sql.Schema, gf *expression.GetField, aliased bool) *sql.ColumnOrigin {
+	for _, col := range schema {
+		if !strings.EqualFold(col.Name, gf.Name()) || !strings.EqualFold(col.Source, gf.Table()) {
+			continue
+		}
+		if col.Origin != nil {
+			return col.Origin
+		}
+		break
+	}
+	if !aliased {
+		return nil
+	}
+	return &sql.ColumnOrigin{Table: gf.Table(), OriginalTable: gf.Table(), OriginalName: gf.Name()}
+}
+
 // Resolved implements the Resolvable interface.
 func (p *Project) Resolved() bool {
 	return p.UnaryNode.Child.Resolved() &&
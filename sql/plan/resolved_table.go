@@ -69,7 +69,11 @@ func (t *ResolvedTable) String() string {
 	pr := sql.NewTreePrinter()
 	pr.WriteNode("Table")
 	table := seethroughTableWrapper(t)
-	children := []string{fmt.Sprintf("name: %s", t.Name())}
+	children := []string{fmt.Sprintf("name: %s", formatTableName(t.Database, t.Name()))}
+
+	if st, ok := table.(*SampledTable); ok {
+		children = append(children, fmt.Sprintf("sample: %s", st.Sample))
+	}
 
 	if pt, ok := table.(sql.ProjectedTable); ok {
 		projections := pt.Projections()
@@ -100,7 +104,7 @@ func (t *ResolvedTable) DebugString() string {
 	pr := sql.NewTreePrinter()
 	pr.WriteNode("Table")
 	table := seethroughTableWrapper(t)
-	children := []string{fmt.Sprintf("name: %s", t.Name())}
+	children := []string{fmt.Sprintf("name: %s", formatTableName(t.Database, t.Name()))}
 
 	var columns []string
 	if pt, ok := table.(sql.ProjectedTable); ok && pt.Projections() != nil {
@@ -116,6 +120,9 @@ func (t *ResolvedTable) DebugString() string {
 		}
 	}
 	children = append(children, fmt.Sprintf("columns: %v", columns))
+	if st, ok := table.(*SampledTable); ok {
+		children = append(children, fmt.Sprintf("sample: %s", st.Sample))
+	}
 	if t.comment != "" {
 		children = append(children, fmt.Sprintf("comment: %s", t.comment))
 	}
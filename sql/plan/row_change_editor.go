@@ -0,0 +1,167 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// rowChangeEditor is a table editor that reports the changes made through the editor it wraps to a row change feed.
+// Only the operations of the wrapped editor may be called.
+type rowChangeEditor struct {
+	feed     *sql.RowChangeFeed
+	database string
+	table    string
+	editor   sql.EditOpenerCloser
+	inserter sql.RowInserter
+	updater  sql.RowUpdater
+	deleter  sql.RowDeleter
+	closer   sql.Closer
+}
+
+var _ sql.TableEditor = (*rowChangeEditor)(nil)
+
+// rowChangeForeignKeyEditor is a rowChangeEditor for a foreign key editor, which also gives index access to its table.
+type rowChangeForeignKeyEditor struct {
+	*rowChangeEditor
+	sql.IndexAddressable
+}
+
+var _ sql.ForeignKeyEditor = rowChangeForeignKeyEditor{}
+
+// reportsRowChanges returns the row change feed of the context given if it has listeners, or nil if it doesn't.
+func reportsRowChanges(ctx *sql.Context) *sql.RowChangeFeed {
+	if feed := ctx.RowChangeFeed(); feed.HasListeners() {
+		return feed
+	}
+	return nil
+}
+
+// NewRowChangeInserter returns the inserter given wrapped to report the rows it inserts to the row change feed of the
+// context given, or the inserter itself if the feed has no listeners.
+func NewRowChangeInserter(ctx *sql.Context, database, table string, inserter sql.RowInserter) sql.RowInserter {
+	feed := reportsRowChanges(ctx)
+	if feed == nil {
+		return inserter
+	}
+	return &rowChangeEditor{feed: feed, database: database, table: table, editor: inserter, inserter: inserter, closer: inserter}
+}
+
+// NewRowChangeReplacer returns the replacer given wrapped to report the rows it inserts and deletes to the row change
+// feed of the context given, or the replacer itself if the feed has no listeners.
+func NewRowChangeReplacer(ctx *sql.Context, database, table string, replacer sql.RowReplacer) sql.RowReplacer {
+	feed := reportsRowChanges(ctx)
+	if feed == nil {
+		return replacer
+	}
+	return &rowChangeEditor{feed: feed, database: database, table: table, editor: replacer, inserter: replacer, deleter: replacer, closer: replacer}
+}
+
+// NewRowChangeUpdater returns the updater given wrapped to report the rows it updates to the row change feed of the
+// context given, or the updater itself if the feed has no listeners.
+func NewRowChangeUpdater(ctx *sql.Context, database, table string, updater sql.RowUpdater) sql.RowUpdater {
+	feed := reportsRowChanges(ctx)
+	if feed == nil {
+		return updater
+	}
+	return &rowChangeEditor{feed: feed, database: database, table: table, editor: updater, updater: updater, closer: updater}
+}
+
+// NewRowChangeDeleter returns the deleter given wrapped to report the rows it deletes to the row change feed of the
+// context given, or the deleter itself if the feed has no listeners.
+func NewRowChangeDeleter(ctx *sql.Context, database, table string, deleter sql.RowDeleter) sql.RowDeleter {
+	feed := reportsRowChanges(ctx)
+	if feed == nil {
+		return deleter
+	}
+	return &rowChangeEditor{feed: feed, database: database, table: table, editor: deleter, deleter: deleter, closer: deleter}
+}
+
+// NewRowChangeForeignKeyEditor returns the foreign key editor given wrapped to report the rows it changes to the row
+// change feed of the context given, or the editor itself if the feed has no listeners. Foreign key editors make the
+// changes of statements on tables with foreign keys, including those of referential actions.
+func NewRowChangeForeignKeyEditor(ctx *sql.Context, database, table string, editor sql.ForeignKeyEditor) sql.ForeignKeyEditor {
+	feed := reportsRowChanges(ctx)
+	if feed == nil {
+		return editor
+	}
+	return rowChangeForeignKeyEditor{
+		rowChangeEditor: &rowChangeEditor{
+			feed:     feed,
+			database: database,
+			table:    table,
+			editor:   editor,
+			inserter: editor,
+			updater:  editor,
+			deleter:  editor,
+			closer:   editor,
+		},
+		IndexAddressable: editor,
+	}
+}
+
+// StatementBegin implements the interface sql.TableEditor.
+func (r *rowChangeEditor) StatementBegin(ctx *sql.Context) {
+	r.feed.StatementBegin(ctx)
+	r.editor.StatementBegin(ctx)
+}
+
+// DiscardChanges implements the interface sql.TableEditor.
+func (r *rowChangeEditor) DiscardChanges(ctx *sql.Context, errorEncountered error) error {
+	r.feed.DiscardChanges(ctx)
+	return r.editor.DiscardChanges(ctx, errorEncountered)
+}
+
+// StatementComplete implements the interface sql.TableEditor.
+func (r *rowChangeEditor) StatementComplete(ctx *sql.Context) error {
+	if err := r.editor.StatementComplete(ctx); err != nil {
+		r.feed.DiscardChanges(ctx)
+		return err
+	}
+	r.feed.StatementComplete(ctx)
+	return nil
+}
+
+// Insert implements the interface sql.TableEditor.
+func (r *rowChangeEditor) Insert(ctx *sql.Context, row sql.Row) error {
+	if err := r.inserter.Insert(ctx, row); err != nil {
+		return err
+	}
+	r.feed.RowChanged(ctx, r.database, r.table, sql.RowInserted, nil, row)
+	return nil
+}
+
+// Update implements the interface sql.TableEditor.
+func (r *rowChangeEditor) Update(ctx *sql.Context, old sql.Row, new sql.Row) error {
+	if err := r.updater.Update(ctx, old, new); err != nil {
+		return err
+	}
+	r.feed.RowChanged(ctx, r.database, r.table, sql.RowUpdated, old, new)
+	return nil
+}
+
+// Delete implements the interface sql.TableEditor.
+func (r *rowChangeEditor) Delete(ctx *sql.Context, row sql.Row) error {
+	if err := r.deleter.Delete(ctx, row); err != nil {
+		return err
+	}
+	r.feed.RowChanged(ctx, r.database, r.table, sql.RowDeleted, row, nil)
+	return nil
+}
+
+// Close implements the interface sql.TableEditor.
+func (r *rowChangeEditor) Close(ctx *sql.Context) error {
+	return r.closer.Close(ctx)
+}
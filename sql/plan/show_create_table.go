@@ -24,7 +24,7 @@ import (
 	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
-var ErrNotView = errors.NewKind("'%' is not VIEW")
+var ErrNotView = errors.NewKind("'%s' is not VIEW")
 
 // ShowCreateTable is a node that shows the CREATE TABLE statement for a table.
 type ShowCreateTable struct {
@@ -25,6 +25,9 @@ import (
 type ShowIndexes struct {
 	UnaryNode
 	IndexesToShow []sql.Index
+	// Stats provides the table statistics used to estimate the cardinality of each index. May be nil, in which case
+	// the cardinality of every index is reported as NULL.
+	Stats sql.StatsReader
 }
 
 // NewShowIndexes creates a new ShowIndexes node. The node must represent a table.
@@ -46,6 +49,7 @@ func (n *ShowIndexes) WithChildren(children ...sql.Node) (sql.Node, error) {
 	return &ShowIndexes{
 		UnaryNode:     UnaryNode{children[0]},
 		IndexesToShow: n.IndexesToShow,
+		Stats:         n.Stats,
 	}, nil
 }
 
@@ -74,7 +78,7 @@ func (n *ShowIndexes) Schema() sql.Schema {
 		&sql.Column{Name: "Seq_in_index", Type: types.Int32},
 		&sql.Column{Name: "Column_name", Type: types.LongText, Nullable: true},
 		&sql.Column{Name: "Collation", Type: types.LongText, Nullable: true},
-		&sql.Column{Name: "Cardinality", Type: types.Int64},
+		&sql.Column{Name: "Cardinality", Type: types.Int64, Nullable: true},
 		&sql.Column{Name: "Sub_part", Type: types.Int64, Nullable: true},
 		&sql.Column{Name: "Packed", Type: types.LongText, Nullable: true},
 		&sql.Column{Name: "Null", Type: types.LongText},
@@ -85,3 +89,76 @@ func (n *ShowIndexes) Schema() sql.Schema {
 		&sql.Column{Name: "Expression", Type: types.LongText, Nullable: true},
 	}
 }
+
+// GetIndexCollation returns the value of the Collation column of SHOW INDEX and information_schema.statistics for the
+// key part of |idx| at position |pos|: "D" for a descending key part and "A" otherwise.
+func GetIndexCollation(idx sql.Index, pos int) string {
+	if coi, ok := idx.(sql.ColumnOrderedIndex); ok {
+		if orders := coi.ColumnOrders(); pos < len(orders) && orders[pos] == sql.IndexOrderDesc {
+			return "D"
+		}
+	}
+	return "A"
+}
+
+// IsIndexVisible returns whether |idx| can be used by the query engine, as reported in the Visible column of SHOW INDEX
+// and the IS_VISIBLE column of information_schema.statistics. Only driver indexes that are not ready yet are invisible.
+func IsIndexVisible(ctx *sql.Context, idx sql.Index) bool {
+	if di, ok := idx.(sql.DriverIndex); ok && len(di.Driver()) > 0 {
+		return ctx.GetIndexRegistry().CanUseIndex(di)
+	}
+	return true
+}
+
+// GetIndexCardinality returns the estimated number of distinct values of the key parts of |idx| up to and including
+// the one at position |pos|, as reported in the Cardinality column of SHOW INDEX and information_schema.statistics.
+// The last key part of a unique index has as many distinct values as the table has rows, and an empty table has none
+// at all. Otherwise, the estimate is the
+// product of the distinct counts of the key parts' columns, capped to the row count, which requires the table to have
+// been analyzed. Returns nil when no estimate is available, such as for functional key parts.
+func GetIndexCardinality(ctx *sql.Context, stats sql.StatsReader, db string, table sql.Table, idx sql.Index, pos int) (interface{}, error) {
+	if stats == nil {
+		return nil, nil
+	}
+	rowCount, ok, err := stats.RowCount(ctx, db, table.Name())
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+
+	exprs := idx.Expressions()
+	if rowCount == 0 || idx.IsUnique() && pos == len(exprs)-1 {
+		return int64(rowCount), nil
+	}
+
+	// tables that were never analyzed have no histograms
+	hists, err := stats.Hist(ctx, db, table.Name())
+	if err != nil || hists == nil {
+		return nil, nil
+	}
+
+	cardinality := uint64(1)
+	for _, expr := range exprs[:pos+1] {
+		col := GetColumnFromIndexExpr(expr, table)
+		if col == nil {
+			return nil, nil
+		}
+		hist, ok := hists[col.Name]
+		if !ok || hist.Count+hist.NullCount != rowCount {
+			// the histogram skipped values of an unsupported type, or is out of date
+			return nil, nil
+		}
+		distinct := hist.DistinctCount
+		if hist.NullCount > 0 {
+			// NULL values are grouped together, like innodb_stats_method=nulls_equal
+			distinct++
+		}
+		cardinality *= distinct
+		if cardinality > rowCount {
+			cardinality = rowCount
+		}
+	}
+	return int64(cardinality), nil
+}
@@ -71,8 +71,13 @@ func (*ShowTables) Children() []sql.Node {
 func (p *ShowTables) Schema() sql.Schema {
 	var sch sql.Schema
 	colName := fmt.Sprintf("Tables_in_%s", p.Database().Name())
+	// Table names are compared case-insensitively unless lower_case_table_names is 0, also when filtering them
+	nameType := types.LongText
+	if sql.LowerCaseTableNames() != 0 {
+		nameType = types.CreateLongText(sql.Collation_utf8mb4_0900_ai_ci)
+	}
 	sch = sql.Schema{
-		{Name: colName, Type: types.LongText},
+		{Name: colName, Type: nameType},
 	}
 	if p.Full {
 		sch = append(sch, &sql.Column{Name: "Table_type", Type: types.LongText})
@@ -16,6 +16,7 @@ package plan
 
 import (
 	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/transform"
@@ -31,9 +32,15 @@ type ShowColumns struct {
 }
 
 var VarChar25000 = types.MustCreateStringWithDefaults(sqltypes.VarChar, 25_000)
+
+// showColumnsFieldType is the type of the Field column, which compares column names case-insensitively like the
+// COLUMN_NAME column of information_schema.columns, so that SHOW COLUMNS ... LIKE and WHERE Field = ... match
+// columns regardless of case.
+var showColumnsFieldType = types.MustCreateString(sqltypes.VarChar, 25_000, sql.Collation_Information_Schema_Default)
+
 var (
 	showColumnsSchema = sql.Schema{
-		{Name: "Field", Type: VarChar25000},
+		{Name: "Field", Type: showColumnsFieldType},
 		{Name: "Type", Type: VarChar25000},
 		{Name: "Null", Type: VarChar25000},
 		{Name: "Key", Type: VarChar25000},
@@ -42,7 +49,7 @@ var (
 	}
 
 	showColumnsFullSchema = sql.Schema{
-		{Name: "Field", Type: VarChar25000},
+		{Name: "Field", Type: showColumnsFieldType},
 		{Name: "Type", Type: VarChar25000},
 		{Name: "Collation", Type: VarChar25000, Nullable: true},
 		{Name: "Null", Type: VarChar25000},
@@ -178,3 +185,27 @@ func GetColumnFromIndexExpr(expr string, table sql.Table) *sql.Column {
 
 	return nil
 }
+
+// GetUnqualifiedIndexExpr returns the index expression string given, the expression of a functional key part, with
+// the table name removed from its column references. Returns the expression string unchanged if it can't be parsed.
+func GetUnqualifiedIndexExpr(expr string) string {
+	stmt, err := sqlparser.Parse("SELECT " + expr)
+	if err != nil {
+		return expr
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok || len(sel.SelectExprs) != 1 {
+		return expr
+	}
+	aliasedExpr, ok := sel.SelectExprs[0].(*sqlparser.AliasedExpr)
+	if !ok {
+		return expr
+	}
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if col, ok := node.(*sqlparser.ColName); ok {
+			col.Qualifier = sqlparser.TableName{}
+		}
+		return true, nil
+	}, aliasedExpr.Expr)
+	return sqlparser.String(aliasedExpr.Expr)
+}
@@ -55,7 +55,7 @@ var showTableStatusSchema = sql.Schema{
 	{Name: "Max_data_length", Type: types.Uint64},
 	{Name: "Index_length", Type: types.Int64},
 	{Name: "Data_free", Type: types.Int64},
-	{Name: "Auto_increment", Type: types.Int64},
+	{Name: "Auto_increment", Type: types.Int64, Nullable: true},
 	{Name: "Create_time", Type: types.Datetime, Nullable: true},
 	{Name: "Update_time", Type: types.Datetime, Nullable: true},
 	{Name: "Check_time", Type: types.Datetime, Nullable: true},
@@ -17,11 +17,18 @@ package plan
 import (
 	"fmt"
 
+	"github.com/dolthub/vitess/go/sqltypes"
+
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
-// ShowVariables is a node that shows the global and session variables
+// ShowVariablesNameType is the type of the variable names the filter of SHOW VARIABLES is evaluated against. Like in
+// MySQL, its collation compares names case-insensitively.
+var ShowVariablesNameType = types.MustCreateString(sqltypes.Text, types.LongTextBlobMax, sql.Collation_Information_Schema_Default)
+
+// ShowVariables is a node that shows the global or session variables. Its filter is evaluated against rows of the
+// variable name and its value.
 type ShowVariables struct {
 	Filter sql.Expression
 	Global bool
@@ -21,7 +21,9 @@ import (
 	"github.com/dolthub/go-mysql-server/sql"
 )
 
-// Sort is the sort node.
+// Sort is the sort node. The sort is stable: rows with equal sort keys keep the order its child returns them in, so
+// over unchanged data the same query returns its rows in the same order every time. Exchanges below a sort return the
+// rows of their partitions in order for the same reason.
 type Sort struct {
 	UnaryNode
 	SortFields sql.SortFields
@@ -111,7 +113,9 @@ func (s *Sort) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
 }
 
 // TopN was a sort node that has a limit. It doesn't need to buffer everything,
-// but can calculate the top n on the fly.
+// but can calculate the top n on the fly. Like Sort, it breaks ties by the
+// order its child returns rows in, so it returns the same rows as a Sort
+// followed by a Limit.
 type TopN struct {
 	UnaryNode
 	Limit         sql.Expression
@@ -210,3 +214,72 @@ func (n *TopN) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
 	topn.CalcFoundRows = n.CalcFoundRows
 	return topn, nil
 }
+
+// ReservoirSample returns a uniformly random sample of |Limit| rows of its child, in random order. It replaces
+// ORDER BY RAND() LIMIT n, which would otherwise buffer and sort every row of the child.
+type ReservoirSample struct {
+	UnaryNode
+	Limit sql.Expression
+}
+
+var _ sql.Node = (*ReservoirSample)(nil)
+var _ sql.Expressioner = (*ReservoirSample)(nil)
+var _ sql.CollationCoercible = (*ReservoirSample)(nil)
+
+// NewReservoirSample creates a new ReservoirSample node.
+func NewReservoirSample(limit sql.Expression, child sql.Node) *ReservoirSample {
+	return &ReservoirSample{
+		UnaryNode: UnaryNode{Child: child},
+		Limit:     limit,
+	}
+}
+
+// Resolved implements the Resolvable interface.
+func (n *ReservoirSample) Resolved() bool {
+	return n.Limit.Resolved() && n.Child.Resolved()
+}
+
+func (n *ReservoirSample) String() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("ReservoirSample(%s)", n.Limit)
+	_ = pr.WriteChildren(n.Child.String())
+	return pr.String()
+}
+
+func (n *ReservoirSample) DebugString() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("ReservoirSample(%s)", sql.DebugString(n.Limit))
+	_ = pr.WriteChildren(sql.DebugString(n.Child))
+	return pr.String()
+}
+
+// Expressions implements the Expressioner interface.
+func (n *ReservoirSample) Expressions() []sql.Expression {
+	return []sql.Expression{n.Limit}
+}
+
+// WithExpressions implements the Expressioner interface.
+func (n *ReservoirSample) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
+	if len(exprs) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(n, len(exprs), 1)
+	}
+	return NewReservoirSample(exprs[0], n.Child), nil
+}
+
+// WithChildren implements the Node interface.
+func (n *ReservoirSample) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(n, len(children), 1)
+	}
+	return NewReservoirSample(n.Limit, children[0]), nil
+}
+
+// CheckPrivileges implements the interface sql.Node.
+func (n *ReservoirSample) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return n.Child.CheckPrivileges(ctx, opChecker)
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (n *ReservoirSample) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.GetCoercibility(ctx, n.Child)
+}
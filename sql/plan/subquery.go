@@ -17,6 +17,7 @@ package plan
 import (
 	"fmt"
 	"io"
+	"reflect"
 	"sync"
 
 	"github.com/dolthub/go-mysql-server/sql/transform"
@@ -41,6 +42,12 @@ type Subquery struct {
 	cache []interface{}
 	// Cached hash results, if any
 	hashCache sql.KeyValueCache
+	// Indexes of the outer scope columns the subquery is correlated with. When set, results are cached per distinct
+	// value of these columns.
+	correlatedIdxs []int
+	// Cached results per hash of the correlated values, if any. Values with the same hash are told apart by comparing
+	// them.
+	correlatedCache map[uint64][]correlatedResult
 	// Dispose function for the cache, if any. This would appear to violate the rule that nodes must be comparable by
 	// reflect.DeepEquals, but it's safe in practice because the function is always nil until execution.
 	disposeFunc sql.DisposeFunc
@@ -154,6 +161,22 @@ func (s *Subquery) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return s.cache[0], nil
 	}
 
+	var key uint64
+	var correlated sql.Row
+	if len(s.correlatedIdxs) > 0 {
+		var err error
+		correlated, key, err = s.correlationKey(row)
+		if err != nil {
+			return nil, err
+		}
+		s.cacheMu.Lock()
+		val, ok := s.cachedCorrelatedResult(key, correlated)
+		s.cacheMu.Unlock()
+		if ok {
+			return val, nil
+		}
+	}
+
 	rows, err := s.evalMultiple(ctx, row)
 	if err != nil {
 		return nil, err
@@ -163,6 +186,21 @@ func (s *Subquery) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, sql.ErrExpectedSingleRow.New()
 	}
 
+	if len(s.correlatedIdxs) > 0 {
+		var val interface{}
+		if len(rows) > 0 {
+			val = rows[0]
+		}
+		s.cacheMu.Lock()
+		if s.correlatedCache == nil {
+			s.correlatedCache = make(map[uint64][]correlatedResult)
+		}
+		if _, ok := s.cachedCorrelatedResult(key, correlated); !ok {
+			s.correlatedCache[key] = append(s.correlatedCache[key], correlatedResult{values: correlated, result: val})
+		}
+		s.cacheMu.Unlock()
+	}
+
 	if s.canCacheResults {
 		s.cacheMu.Lock()
 		if !s.resultsCached {
@@ -177,6 +215,34 @@ func (s *Subquery) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	return rows[0], nil
 }
 
+// correlatedResult is the result of a subquery for the outer scope values it's correlated with.
+type correlatedResult struct {
+	values sql.Row
+	result interface{}
+}
+
+// correlationKey returns the outer scope values the subquery is correlated with, and their hash.
+func (s *Subquery) correlationKey(row sql.Row) (sql.Row, uint64, error) {
+	values := make(sql.Row, len(s.correlatedIdxs))
+	for i, idx := range s.correlatedIdxs {
+		values[i] = row[idx]
+	}
+	key, err := sql.HashOf(values)
+	return values, key, err
+}
+
+// cachedCorrelatedResult returns the cached result for the correlated values with the hash given, if any. The cache
+// mutex must be held.
+func (s *Subquery) cachedCorrelatedResult(key uint64, values sql.Row) (interface{}, bool) {
+	// Equal values that DeepEqual tells apart, such as decimals with different exponents, only cause a cache miss
+	for _, r := range s.correlatedCache[key] {
+		if reflect.DeepEqual(r.values, values) {
+			return r.result, true
+		}
+	}
+	return nil, false
+}
+
 // prependRowInPlan returns a transformation function that prepends the row given to any row source in a query
 // plan. Any source of rows, as well as any node that alters the schema of its children, will be wrapped so that its
 // result rows are prepended with the row given.
@@ -551,12 +617,30 @@ func (s *Subquery) CanCacheResults() bool {
 	return s.canCacheResults
 }
 
+// WithCorrelatedCache returns the subquery with results cached per distinct value of the outer scope columns at the
+// indexes given. This is only safe when the subquery is deterministic apart from its references to these columns.
+func (s *Subquery) WithCorrelatedCache(idxs []int) *Subquery {
+	return &Subquery{
+		Query:           s.Query,
+		QueryString:     s.QueryString,
+		canCacheResults: s.canCacheResults,
+		correlatedIdxs:  idxs,
+		b:               s.b,
+	}
+}
+
+// CorrelatedCacheIndexes returns the indexes of the outer scope columns results are cached by, if any.
+func (s *Subquery) CorrelatedCacheIndexes() []int {
+	return s.correlatedIdxs
+}
+
 // Dispose implements sql.Disposable
 func (s *Subquery) Dispose() {
 	if s.disposeFunc != nil {
 		s.disposeFunc()
 		s.disposeFunc = nil
 	}
+	s.correlatedCache = nil
 	disposeNode(s.Query)
 }
 
@@ -30,6 +30,12 @@ type SubqueryAlias struct {
 	// expression and is eligible to have visibility to outer scopes of the query.
 	OuterScopeVisibility bool
 	CanCacheResults      bool
+	// ViewDatabase is the database of the view that this SubqueryAlias is the definition of, when it's a reference to
+	// a view in a query, and empty otherwise.
+	ViewDatabase string
+	// OriginalName is the name of the view or common table expression that this SubqueryAlias is a reference to, when
+	// it's referenced by an alias in a query, and empty otherwise.
+	OriginalName string
 }
 
 var _ sql.Node = (*SubqueryAlias)(nil)
@@ -60,9 +66,14 @@ func (sq *SubqueryAlias) Schema() sql.Schema {
 	for i, col := range childSchema {
 		c := *col
 		c.Source = sq.name
+		// The columns of derived tables and views come from them rather than from the tables they read
+		c.Origin = nil
 		if len(sq.Columns) > 0 {
 			c.Name = sq.Columns[i]
 		}
+		if sq.OriginalName != "" {
+			c.Origin = &sql.ColumnOrigin{Table: sq.name, OriginalTable: sq.OriginalName, OriginalName: c.Name}
+		}
 		schema[i] = &c
 	}
 	return schema
@@ -116,7 +127,7 @@ func (sq *SubqueryAlias) String() string {
 	pr := sql.NewTreePrinter()
 	_ = pr.WriteNode("SubqueryAlias")
 	children := make([]string, 4)
-	children[0] = fmt.Sprintf("name: %s", sq.name)
+	children[0] = fmt.Sprintf("name: %s", formatIdentifier(sq.name))
 	children[1] = fmt.Sprintf("outerVisibility: %t", sq.OuterScopeVisibility)
 	children[2] = fmt.Sprintf("cacheable: %t", sq.CanCacheResults)
 	children[3] = sq.Child.String()
@@ -128,7 +139,7 @@ func (sq *SubqueryAlias) DebugString() string {
 	pr := sql.NewTreePrinter()
 	_ = pr.WriteNode("SubqueryAlias")
 	children := make([]string, 4)
-	children[0] = fmt.Sprintf("name: %s", sq.name)
+	children[0] = fmt.Sprintf("name: %s", formatIdentifier(sq.name))
 	children[1] = fmt.Sprintf("outerVisibility: %t", sq.OuterScopeVisibility)
 	children[2] = fmt.Sprintf("cacheable: %t", sq.CanCacheResults)
 	children[3] = sql.DebugString(sq.Child)
@@ -0,0 +1,183 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"math/rand"
+	"strconv"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// SampleMethod is the method a TABLESAMPLE clause uses to choose the rows of a table it returns.
+type SampleMethod byte
+
+const (
+	// SampleSystem returns each partition of the table, with all of its rows, with the probability of the sample
+	// percentage.
+	SampleSystem SampleMethod = iota
+	// SampleBernoulli returns each row of the table with the probability of the sample percentage.
+	SampleBernoulli
+)
+
+func (m SampleMethod) String() string {
+	switch m {
+	case SampleSystem:
+		return "SYSTEM"
+	case SampleBernoulli:
+		return "BERNOULLI"
+	default:
+		return fmt.Sprintf("SampleMethod(%d)", m)
+	}
+}
+
+// TableSample is the TABLESAMPLE clause of a table in a FROM clause, such as TABLESAMPLE BERNOULLI (10) REPEATABLE (42).
+type TableSample struct {
+	Method SampleMethod
+	// Percent is the percentage of the partitions or rows of the table to return, between 0 and 100.
+	Percent float64
+	// Seed seeds the choice of partitions or rows when Repeatable is true. Otherwise, every execution of the query
+	// chooses different partitions or rows.
+	Seed       int64
+	Repeatable bool
+}
+
+func (s TableSample) String() string {
+	str := fmt.Sprintf("%s (%s)", s.Method, strconv.FormatFloat(s.Percent, 'f', -1, 64))
+	if s.Repeatable {
+		str += fmt.Sprintf(" REPEATABLE (%d)", s.Seed)
+	}
+	return str
+}
+
+// SampledTable is a table that returns a sample of the rows of the table it wraps, as chosen by a TABLESAMPLE clause.
+// It deliberately isn't a sql.TableWrapper, nor does it implement the optional table interfaces of the table it wraps,
+// so that the analyzer never replaces it with an index lookup or any other access that would bypass the sample.
+type SampledTable struct {
+	sql.Table
+	Sample TableSample
+}
+
+var _ sql.Table = (*SampledTable)(nil)
+
+// NewSampledTable returns a new SampledTable that samples the table given.
+func NewSampledTable(table sql.Table, sample TableSample) *SampledTable {
+	return &SampledTable{Table: table, Sample: sample}
+}
+
+func (t *SampledTable) String() string {
+	return fmt.Sprintf("%s TABLESAMPLE %s", t.Table.String(), t.Sample)
+}
+
+// Partitions implements the sql.Table interface.
+func (t *SampledTable) Partitions(ctx *sql.Context) (sql.PartitionIter, error) {
+	iter, err := t.Table.Partitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	seed := t.Sample.Seed
+	if !t.Sample.Repeatable {
+		seed = time.Now().UnixNano()
+	}
+	return &samplePartitionIter{
+		iter:   iter,
+		sample: t.Sample,
+		rand:   rand.New(rand.NewSource(seed)),
+	}, nil
+}
+
+// PartitionRows implements the sql.Table interface.
+func (t *SampledTable) PartitionRows(ctx *sql.Context, p sql.Partition) (sql.RowIter, error) {
+	sp, ok := p.(*samplePartition)
+	if !ok {
+		return t.Table.PartitionRows(ctx, p)
+	}
+	iter, err := t.Table.PartitionRows(ctx, sp.Partition)
+	if err != nil {
+		return nil, err
+	}
+	if t.Sample.Method != SampleBernoulli {
+		return iter, nil
+	}
+	return &bernoulliRowIter{
+		iter:    iter,
+		percent: t.Sample.Percent,
+		rand:    rand.New(rand.NewSource(sp.seed)),
+	}, nil
+}
+
+// samplePartition is a partition returned by a SampledTable. It carries the seed of the choice of its rows, so that
+// the rows chosen don't depend on the order in which the partitions are read.
+type samplePartition struct {
+	sql.Partition
+	seed int64
+}
+
+// samplePartitionIter returns the partitions of a SampledTable. For SYSTEM samples, it skips each partition with the
+// probability of the sample.
+type samplePartitionIter struct {
+	iter   sql.PartitionIter
+	sample TableSample
+	rand   *rand.Rand
+}
+
+var _ sql.PartitionIter = (*samplePartitionIter)(nil)
+
+func (i *samplePartitionIter) Next(ctx *sql.Context) (sql.Partition, error) {
+	for {
+		p, err := i.iter.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		// Both random numbers are drawn for every partition so that the seed of the rows of each partition doesn't
+		// depend on the partitions skipped before it
+		seed, keep := i.rand.Int63(), i.rand.Float64()*100 < i.sample.Percent
+		if i.sample.Method == SampleSystem && !keep {
+			continue
+		}
+		return &samplePartition{Partition: p, seed: seed}, nil
+	}
+}
+
+func (i *samplePartitionIter) Close(ctx *sql.Context) error {
+	return i.iter.Close(ctx)
+}
+
+// bernoulliRowIter returns each row of the iterator it wraps with a probability of percent / 100.
+type bernoulliRowIter struct {
+	iter    sql.RowIter
+	percent float64
+	rand    *rand.Rand
+}
+
+var _ sql.RowIter = (*bernoulliRowIter)(nil)
+
+func (i *bernoulliRowIter) Next(ctx *sql.Context) (sql.Row, error) {
+	for {
+		row, err := i.iter.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if i.rand.Float64()*100 < i.percent {
+			return row, nil
+		}
+	}
+}
+
+func (i *bernoulliRowIter) Close(ctx *sql.Context) error {
+	return i.iter.Close(ctx)
+}
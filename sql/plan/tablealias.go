@@ -57,6 +57,11 @@ func (t *TableAlias) Schema() sql.Schema {
 	for i, col := range childSchema {
 		colCopy := *col
 		colCopy.Source = t.name
+		colCopy.Origin = &sql.ColumnOrigin{Table: t.name, OriginalTable: col.Source, OriginalName: col.Name}
+		if col.Origin != nil {
+			colCopy.Origin.OriginalTable = col.Origin.OriginalTable
+			colCopy.Origin.OriginalName = col.Origin.OriginalName
+		}
 		copy[i] = &colCopy
 	}
 	return copy
@@ -89,14 +94,14 @@ func (t *TableAlias) CollationCoercibility(ctx *sql.Context) (collation sql.Coll
 
 func (t TableAlias) String() string {
 	pr := sql.NewTreePrinter()
-	_ = pr.WriteNode("TableAlias(%s)", t.name)
+	_ = pr.WriteNode("TableAlias(%s)", formatIdentifier(t.name))
 	_ = pr.WriteChildren(t.Child.String())
 	return pr.String()
 }
 
 func (t TableAlias) DebugString() string {
 	pr := sql.NewTreePrinter()
-	_ = pr.WriteNode("TableAlias(%s)", t.name)
+	_ = pr.WriteNode("TableAlias(%s)", formatIdentifier(t.name))
 	_ = pr.WriteChildren(sql.DebugString(t.Child))
 	return pr.String()
 }
@@ -99,6 +99,23 @@ func IsSessionAutocommit(ctx *sql.Context) (bool, error) {
 	return types.ConvertToBool(autoCommitSessionVar)
 }
 
+// InTransaction returns whether a transaction is open in the current session, as reported to clients by the
+// SERVER_STATUS_IN_TRANS status flag: either one started explicitly, which ends when it's committed or rolled back, or
+// the one started implicitly by the statements of a session with autocommit off.
+func InTransaction(ctx *sql.Context) (bool, error) {
+	if ctx.GetIgnoreAutoCommit() {
+		return true, nil
+	}
+	if ctx.GetTransaction() == nil {
+		return false, nil
+	}
+	autocommit, err := IsSessionAutocommit(ctx)
+	if err != nil {
+		return false, err
+	}
+	return !autocommit, nil
+}
+
 func ReadCommitted(ctx *sql.Context) bool {
 	if !fakeReadCommitted {
 		return false
@@ -28,6 +28,9 @@ var ErrTruncateNotSupported = errors.NewKind("table doesn't support TRUNCATE")
 type Truncate struct {
 	db string
 	UnaryNode
+	// ReportRemoved is whether the number of rows removed is reported as the rows affected. TRUNCATE TABLE always
+	// reports 0 rows affected, but a DELETE that was converted to a Truncate reports the rows that it deleted.
+	ReportRemoved bool
 }
 
 var _ sql.Node = (*Truncate)(nil)
@@ -42,6 +45,8 @@ func NewTruncate(db string, table sql.Node) *Truncate {
 	}
 }
 
+// GetTruncatable returns the sql.TruncateableTable for the node given, or ErrTruncateNotSupported if the table does not
+// implement it. Such tables are truncated by deleting their rows one by one, see GetDeletable.
 func GetTruncatable(node sql.Node) (sql.TruncateableTable, error) {
 	switch node := node.(type) {
 	case sql.TruncateableTable:
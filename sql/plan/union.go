@@ -18,6 +18,7 @@ import (
 	"fmt"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
 // Union is a node that returns everything in Left and then everything in Right
@@ -50,6 +51,10 @@ func (u *Union) Schema() sql.Schema {
 		c := *ls[i]
 		if i < len(rs) {
 			c.Nullable = ls[i].Nullable || rs[i].Nullable
+			// Columns of NULLs aren't converted to the type of the other side, whose type the column takes
+			if c.Type == types.Null {
+				c.Type = rs[i].Type
+			}
 		}
 		ret[i] = &c
 	}
@@ -31,6 +31,7 @@ type UnresolvedTable struct {
 	database string
 	asOf     sql.Expression
 	comment  string
+	sample   *TableSample
 }
 
 var _ sql.Node = (*UnresolvedTable)(nil)
@@ -42,12 +43,12 @@ var _ sql.CommentedNode = (*UnresolvedTable)(nil)
 
 // NewUnresolvedTable creates a new Unresolved table.
 func NewUnresolvedTable(name, db string) *UnresolvedTable {
-	return &UnresolvedTable{name, db, nil, ""}
+	return &UnresolvedTable{name, db, nil, "", nil}
 }
 
 // NewUnresolvedTableAsOf creates a new Unresolved table with an AS OF expression.
 func NewUnresolvedTableAsOf(name, db string, asOf sql.Expression) *UnresolvedTable {
-	return &UnresolvedTable{name, db, asOf, ""}
+	return &UnresolvedTable{name, db, asOf, "", nil}
 }
 
 func (t *UnresolvedTable) WithComment(s string) sql.Node {
@@ -100,6 +101,18 @@ func (t *UnresolvedTable) AsOf() sql.Expression {
 	return t.asOf
 }
 
+// Sample returns the TABLESAMPLE clause of this table, or nil if it has none.
+func (t *UnresolvedTable) Sample() *TableSample {
+	return t.sample
+}
+
+// WithSample returns a copy of this unresolved table with the TABLESAMPLE clause given.
+func (t *UnresolvedTable) WithSample(sample *TableSample) *UnresolvedTable {
+	t2 := *t
+	t2.sample = sample
+	return &t2
+}
+
 // CheckPrivileges implements the interface sql.Node.
 func (t *UnresolvedTable) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
 	return opChecker.UserHasPrivileges(ctx,
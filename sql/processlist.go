@@ -66,6 +66,12 @@ type ProcessList interface {
 	// RemovePartitionProgress removes an existing partition tracking progress from the
 	// process with the given pid, if it exists.
 	RemovePartitionProgress(pid uint64, tableName, partitionName string)
+
+	// UpdateRowsExamined adds |delta| to the number of rows read from tables by the process with the given pid.
+	UpdateRowsExamined(pid uint64, delta int64)
+
+	// UpdateRowsSent adds |delta| to the number of rows returned to the client by the process with the given pid.
+	UpdateRowsSent(pid uint64, delta int64)
 }
 
 type ProcessCommand string
@@ -94,6 +100,15 @@ type Process struct {
 	Query    string
 	Progress map[string]TableProgress
 	Kill     context.CancelFunc
+
+	// RowsExamined is the number of rows read from tables by the running query so far, or by the last query run if
+	// the process is idle.
+	RowsExamined int64
+	// RowsSent is the number of rows returned by the running query so far, or by the last query run if the process is
+	// idle.
+	RowsSent int64
+	// ConnectAttributes are the connection attributes sent by the client of the connection during the handshake.
+	ConnectAttributes map[string]string
 }
 
 // Done needs to be called when this process has finished.
@@ -177,3 +192,5 @@ func (e EmptyProcessList) AddPartitionProgress(pid uint64, tableName, partitionN
 }
 func (e EmptyProcessList) RemoveTableProgress(pid uint64, name string)                         {}
 func (e EmptyProcessList) RemovePartitionProgress(pid uint64, tableName, partitionName string) {}
+func (e EmptyProcessList) UpdateRowsExamined(pid uint64, delta int64)                          {}
+func (e EmptyProcessList) UpdateRowsSent(pid uint64, delta int64)                              {}
@@ -67,6 +67,31 @@ func (ranges RangeCollection) Intersect(otherRanges RangeCollection) (RangeColle
 	return newRanges, nil
 }
 
+// Difference returns the values of the calling RangeCollection that are not in the given RangeCollection, as a
+// collection of ranges that do not overlap. Returns nil if every value is in the given RangeCollection.
+func (ranges RangeCollection) Difference(otherRanges RangeCollection) (RangeCollection, error) {
+	remaining := ranges
+	for _, otherRange := range otherRanges {
+		var newRanges RangeCollection
+		for _, rang := range remaining {
+			difference, err := rang.Difference(otherRange)
+			if err != nil {
+				return nil, err
+			}
+			newRanges = append(newRanges, difference...)
+		}
+		remaining = newRanges
+	}
+	remaining, err := RemoveOverlappingRanges(remaining...)
+	if err != nil {
+		return nil, err
+	}
+	if len(remaining) == 0 {
+		return nil, nil
+	}
+	return remaining, nil
+}
+
 // String returns this RangeCollection as a string for display purposes.
 func (ranges RangeCollection) String() string {
 	sb := strings.Builder{}
@@ -365,6 +390,36 @@ func (rang Range) RemoveOverlap(otherRange Range) (RangeCollection, bool, error)
 	return ranges, true, nil
 }
 
+// Difference returns the values of the calling Range that are not in the given Range. The values are split across the
+// columns one at a time: for each column, the values outside the given Range's column are returned with the previous
+// columns limited to the given Range, so the returned ranges do not overlap. Returns the calling Range if the two
+// ranges do not overlap, and an empty collection if the calling Range is a subset of the given Range.
+func (rang Range) Difference(otherRange Range) (RangeCollection, error) {
+	if ok, err := rang.Overlaps(otherRange); err != nil {
+		return nil, err
+	} else if !ok {
+		return RangeCollection{rang}, nil
+	}
+
+	var ranges RangeCollection
+	remaining := rang
+	for i := range remaining {
+		subtracted, err := remaining[i].Subtract(otherRange[i])
+		if err != nil {
+			return nil, err
+		}
+		for _, colExpr := range subtracted {
+			ranges = append(ranges, remaining.replace(i, colExpr))
+		}
+		overlapExpr, _, err := remaining[i].Overlaps(otherRange[i])
+		if err != nil {
+			return nil, err
+		}
+		remaining = remaining.replace(i, overlapExpr)
+	}
+	return ranges, nil
+}
+
 // String returns this Range as a string for display purposes.
 func (rang Range) String() string {
 	sb := strings.Builder{}
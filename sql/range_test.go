@@ -405,6 +405,52 @@ func TestRangeOverlapNulls(t *testing.T) {
 	}
 }
 
+func TestRangeDifference(t *testing.T) {
+	_, _, _, values2, _, valuesNull := setup()
+	all := sql.AllRangeColumnExpr(rangeType)
+
+	tests := []struct {
+		ranges sql.RangeCollection
+		other  sql.RangeCollection
+	}{
+		{
+			ranges: sql.RangeCollection{r(all, all)},
+			other:  sql.RangeCollection{r(req(3), all)},
+		},
+		{
+			ranges: sql.RangeCollection{r(all, all)},
+			other:  sql.RangeCollection{r(rcc(3, 5), rlt(4))},
+		},
+		{
+			ranges: sql.RangeCollection{r(notNull(), all)},
+			other:  sql.RangeCollection{r(null(), rgt(5))},
+		},
+		{
+			ranges: sql.RangeCollection{r(null(), all), r(rgt(3), rlte(7))},
+			other:  sql.RangeCollection{r(all, req(2)), r(rcc(5, 8), rgte(6))},
+		},
+		{
+			ranges: sql.RangeCollection{r(rcc(2, 4), rcc(2, 4))},
+			other:  sql.RangeCollection{r(all, all)},
+		},
+		{
+			ranges: sql.RangeCollection{r(rlt(4), rlt(4))},
+			other:  sql.RangeCollection{r(rgt(6), rgt(6))},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%s - %s", test.ranges.DebugString(), test.other.DebugString()), func(t *testing.T) {
+			difference, err := test.ranges.Difference(test.other)
+			require.NoError(t, err)
+			for _, row := range append(values2, valuesNull...) {
+				expected := evalRanges(t, test.ranges, row) && !evalRanges(t, test.other, row)
+				assert.Equal(t, expected, evalRanges(t, difference, row), fmt.Sprintf("%v: Difference: %s", row, difference.DebugString()))
+			}
+		})
+	}
+}
+
 func setup() (x, y, z sql.Expression, values2, values3, valuesNull [][]interface{}) {
 	values2 = make([][]interface{}, 0, 100)
 	values3 = make([][]interface{}, 0, 1000)
@@ -0,0 +1,26 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+// ResultSetWriter sends the result sets of the SELECT statements run by stored procedures as they run. MySQL sends a
+// CALL statement's client a result set for every SELECT statement of the procedure, while the row iterator of the
+// CALL only returns the rows of the last one. Integrators that can send several result sets for a statement set a
+// ResultSetWriter with Context.WithResultSetWriter, and the rows of the SELECT statements are then given to it rather
+// than returned by the CALL.
+type ResultSetWriter interface {
+	// WriteResultSet sends the rows of the iterator given, which have the schema given, as a result set. The iterator
+	// is closed by the caller.
+	WriteResultSet(ctx *Context, sch Schema, iter RowIter) error
+}
@@ -0,0 +1,76 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"io"
+)
+
+// RowBatchSize is the most rows returned in a batch by the iterators that read their children's rows one at a time.
+const RowBatchSize = 1024
+
+// BatchRowIter is a RowIter that can also return its rows in batches, paying for the calls through the iterator
+// tree once per batch rather than once per row. Callers read an iterator either with Next or with NextBatch, never
+// both, and don't call NextBatch on iterators they've opted into reusing rows with ReuseRows.
+type BatchRowIter interface {
+	RowIter
+	// NextBatch returns the next rows of the iterator, at least one, or io.EOF when there are none left. The slice
+	// returned is only valid until the next call to NextBatch, but the rows in it may be kept. If reading any of the
+	// rows fails, the error is returned instead of the batch.
+	NextBatch(ctx *Context) ([]Row, error)
+}
+
+// NewBatchRowIter returns the iterator given as a BatchRowIter: itself if it implements the interface, or else an
+// adapter that reads up to RowBatchSize of its rows one at a time for each batch.
+func NewBatchRowIter(iter RowIter) BatchRowIter {
+	if b, ok := iter.(BatchRowIter); ok {
+		return b
+	}
+	return &rowBatcher{RowIter: iter}
+}
+
+// rowBatcher reads the rows of an iterator that doesn't implement BatchRowIter in batches.
+type rowBatcher struct {
+	RowIter
+	batch []Row
+	eof   bool
+}
+
+var _ BatchRowIter = (*rowBatcher)(nil)
+
+// NextBatch implements the BatchRowIter interface.
+func (b *rowBatcher) NextBatch(ctx *Context) ([]Row, error) {
+	if b.eof {
+		return nil, io.EOF
+	}
+
+	b.batch = b.batch[:0]
+	for len(b.batch) < RowBatchSize {
+		row, err := b.RowIter.Next(ctx)
+		if err == io.EOF {
+			// Iterators aren't asked for more rows once they've returned io.EOF
+			b.eof = true
+			if len(b.batch) > 0 {
+				break
+			}
+			return nil, err
+		}
+		if err != nil {
+			return nil, err
+		}
+		b.batch = append(b.batch, row)
+	}
+	return b.batch, nil
+}
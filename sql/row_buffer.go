@@ -0,0 +1,77 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"sync"
+)
+
+// RowReuser is a RowIter that can return each of its rows in the same buffer, overwriting the previous row, instead
+// of allocating a new row for each. Reusing rows is opt-in: a caller that is done with each row before it asks for
+// the next one, such as one that copies the row's values elsewhere, calls ReuseRows before the first call to Next.
+// Iterators that pass rows through from their children, or that are done with their children's rows when they
+// return their own, opt their children in as well.
+type RowReuser interface {
+	RowIter
+	// ReuseRows makes the rows returned by Next only valid until the next call to Next or Close.
+	ReuseRows()
+}
+
+// ReuseRows opts the iterator given into reusing its rows if it's a RowReuser, and does nothing otherwise.
+func ReuseRows(iter RowIter) {
+	if r, ok := iter.(RowReuser); ok {
+		r.ReuseRows()
+	}
+}
+
+var rowPool = sync.Pool{New: makeRow}
+
+func makeRow() interface{} {
+	return new(Row)
+}
+
+// RowBuffer is the row reused by a RowReuser for each of its rows. Its backing array is taken from a shared pool
+// the first time it's needed, and returned to it by Release, so that iterators share buffers across queries. The
+// zero value is ready to use.
+type RowBuffer struct {
+	row *Row
+}
+
+// Get returns the buffer's row with the length given. Its values are left over from the previous row, so callers
+// must set all of them.
+func (b *RowBuffer) Get(n int) Row {
+	if b.row == nil {
+		b.row = rowPool.Get().(*Row)
+	}
+	if cap(*b.row) < n {
+		*b.row = make(Row, n)
+	}
+	return (*b.row)[:n]
+}
+
+// Release returns the buffer's row to the shared pool. The rows returned by Get must not be used afterwards, but
+// the buffer itself may be, taking a new row from the pool.
+func (b *RowBuffer) Release() {
+	if b.row == nil {
+		return
+	}
+	row := (*b.row)[:cap(*b.row)]
+	for i := range row {
+		row[i] = nil
+	}
+	*b.row = row[:0]
+	rowPool.Put(b.row)
+	b.row = nil
+}
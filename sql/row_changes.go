@@ -0,0 +1,349 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// RowChangeOperation is the kind of change made to a row, as reported to a RowChangeListener.
+type RowChangeOperation byte
+
+const (
+	RowInserted RowChangeOperation = iota
+	RowUpdated
+	RowDeleted
+)
+
+func (o RowChangeOperation) String() string {
+	switch o {
+	case RowInserted:
+		return "insert"
+	case RowUpdated:
+		return "update"
+	case RowDeleted:
+		return "delete"
+	default:
+		return fmt.Sprintf("RowChangeOperation(%d)", byte(o))
+	}
+}
+
+// RowChangeListener receives the changes made to the rows of tables by statements, for change data capture. The
+// methods of the listeners of a RowChangeFeed are called by a single goroutine, never concurrently, in the order the
+// changes were made. The context given is the one of the statement that made the change, which may have completed.
+type RowChangeListener interface {
+	// RowChanged is called for each row inserted, updated or deleted by a statement, once the statement has completed
+	// successfully. |oldRow| is nil for insertions and |newRow| is nil for deletions. |txID| identifies the
+	// transaction the change was made in, and is never zero.
+	RowChanged(ctx *Context, database, table string, op RowChangeOperation, oldRow, newRow Row, txID uint64)
+	// TransactionCommitted is called when the transaction given is committed, after all of its row changes.
+	TransactionCommitted(ctx *Context, txID uint64)
+	// TransactionRolledBack is called when the transaction given is rolled back, after all of its row changes, which
+	// are undone.
+	TransactionRolledBack(ctx *Context, txID uint64)
+}
+
+// RowChangeOverflowPolicy is what a RowChangeFeed does with a change when its buffer is full.
+type RowChangeOverflowPolicy byte
+
+const (
+	// RowChangeOverflowBlock makes the statement reporting the change wait until there's room in the buffer.
+	RowChangeOverflowBlock RowChangeOverflowPolicy = iota
+	// RowChangeOverflowDrop discards the change, so that statements never wait on listeners. Discarded changes and
+	// transaction boundaries are counted by RowChangeFeed.Dropped.
+	RowChangeOverflowDrop
+)
+
+// rowChangeBoundary is the transaction boundary a rowChangeEvent marks, if any.
+type rowChangeBoundary byte
+
+const (
+	rowChangeNoBoundary rowChangeBoundary = iota
+	rowChangeCommit
+	rowChangeRollback
+	rowChangeFlush
+)
+
+type rowChangeEvent struct {
+	ctx      *Context
+	database string
+	table    string
+	op       RowChangeOperation
+	oldRow   Row
+	newRow   Row
+	txID     uint64
+	boundary rowChangeBoundary
+	// flushed is closed once the events before a flush event have been dispatched
+	flushed chan struct{}
+}
+
+// rowChangeSession is the state of the changes of a session that haven't been sent to the listeners.
+type rowChangeSession struct {
+	// txID is the id of the session's transaction, or zero if none of its changes have been sent yet
+	txID uint64
+	// depth is the number of editors in the current statement that have begun without completing
+	depth int
+	// pending are the changes of the current statement, sent when it completes
+	pending []rowChangeEvent
+}
+
+// RowChangeFeed dispatches the row changes made by statements, and the commits and rollbacks of the transactions
+// making them, to its listeners. Changes are buffered until their statement completes successfully, and are then
+// queued for the feed's dispatch goroutine, started with Run, so that listeners don't hold up statements unless the
+// queue is full and the overflow policy is RowChangeOverflowBlock. Transactions that change no rows aren't reported.
+type RowChangeFeed struct {
+	mu        sync.Mutex
+	listeners []RowChangeListener
+	sessions  map[uint32]*rowChangeSession
+	lastTxID  uint64
+	// sendMu keeps the events of a statement together in the queue
+	sendMu   sync.Mutex
+	events   chan rowChangeEvent
+	overflow RowChangeOverflowPolicy
+	dropped  uint64
+	done     chan struct{}
+}
+
+// NewRowChangeFeed returns a new RowChangeFeed queueing up to |bufferSize| changes for its listeners, applying the
+// overflow policy given when the queue is full.
+func NewRowChangeFeed(bufferSize int, overflow RowChangeOverflowPolicy) *RowChangeFeed {
+	return &RowChangeFeed{
+		sessions: make(map[uint32]*rowChangeSession),
+		events:   make(chan rowChangeEvent, bufferSize),
+		overflow: overflow,
+		done:     make(chan struct{}),
+	}
+}
+
+// AddListener adds a listener for the changes reported after it's added.
+func (f *RowChangeFeed) AddListener(listener RowChangeListener) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.listeners = append(f.listeners, listener)
+}
+
+// HasListeners returns whether the feed has any listeners. Changes are only recorded for feeds that do. It's false for
+// a nil feed, which is the feed of contexts created without one.
+func (f *RowChangeFeed) HasListeners() bool {
+	if f == nil {
+		return false
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.listeners) > 0
+}
+
+// Dropped returns the number of changes and transaction boundaries discarded because the queue was full.
+func (f *RowChangeFeed) Dropped() uint64 {
+	return atomic.LoadUint64(&f.dropped)
+}
+
+// Run dispatches the queued changes to the listeners until |ctx| is cancelled, and then dispatches the changes still
+// queued. Changes reported once it has returned are dropped.
+func (f *RowChangeFeed) Run(ctx context.Context) {
+	for {
+		select {
+		case ev := <-f.events:
+			f.dispatch(ev)
+		case <-ctx.Done():
+			close(f.done)
+			for {
+				select {
+				case ev := <-f.events:
+					f.dispatch(ev)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+// Flush waits until the changes reported so far have been dispatched to the listeners, which requires Run to be
+// running or to have returned. It returns immediately if the feed has no listeners.
+func (f *RowChangeFeed) Flush() {
+	if !f.HasListeners() {
+		return
+	}
+	flushed := make(chan struct{})
+	f.sendMu.Lock()
+	select {
+	case f.events <- rowChangeEvent{boundary: rowChangeFlush, flushed: flushed}:
+	case <-f.done:
+	}
+	f.sendMu.Unlock()
+	select {
+	case <-flushed:
+	case <-f.done:
+	}
+}
+
+func (f *RowChangeFeed) dispatch(ev rowChangeEvent) {
+	if ev.boundary == rowChangeFlush {
+		close(ev.flushed)
+		return
+	}
+	f.mu.Lock()
+	listeners := f.listeners
+	f.mu.Unlock()
+	for _, l := range listeners {
+		switch ev.boundary {
+		case rowChangeCommit:
+			l.TransactionCommitted(ev.ctx, ev.txID)
+		case rowChangeRollback:
+			l.TransactionRolledBack(ev.ctx, ev.txID)
+		default:
+			l.RowChanged(ev.ctx, ev.database, ev.table, ev.op, ev.oldRow, ev.newRow, ev.txID)
+		}
+	}
+}
+
+// session returns the state of the session of the context given. f.mu must be held.
+func (f *RowChangeFeed) session(ctx *Context) *rowChangeSession {
+	s, ok := f.sessions[ctx.Session.ID()]
+	if !ok {
+		s = &rowChangeSession{}
+		f.sessions[ctx.Session.ID()] = s
+	}
+	return s
+}
+
+// StatementBegin is called by a table editor reporting to the feed when it begins a statement.
+func (f *RowChangeFeed) StatementBegin(ctx *Context) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.session(ctx).depth++
+}
+
+// RowChanged records a change to a row of a table, to be sent to the listeners when the current statement of the
+// session completes.
+func (f *RowChangeFeed) RowChanged(ctx *Context, database, table string, op RowChangeOperation, oldRow, newRow Row) {
+	if oldRow != nil {
+		oldRow = oldRow.Copy()
+	}
+	if newRow != nil {
+		newRow = newRow.Copy()
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	s := f.session(ctx)
+	s.pending = append(s.pending, rowChangeEvent{
+		ctx:      ctx,
+		database: database,
+		table:    table,
+		op:       op,
+		oldRow:   oldRow,
+		newRow:   newRow,
+	})
+}
+
+// StatementComplete is called by a table editor reporting to the feed when it completes a statement. Once every
+// editor of the statement has completed, the statement's changes are queued for the listeners.
+func (f *RowChangeFeed) StatementComplete(ctx *Context) {
+	f.mu.Lock()
+	s := f.session(ctx)
+	if s.depth > 0 {
+		s.depth--
+	}
+	if s.depth > 0 || len(s.pending) == 0 {
+		f.releaseSession(ctx, s)
+		f.mu.Unlock()
+		return
+	}
+	if s.txID == 0 {
+		f.lastTxID++
+		s.txID = f.lastTxID
+	}
+	pending := s.pending
+	s.pending = nil
+	for i := range pending {
+		pending[i].txID = s.txID
+	}
+	f.mu.Unlock()
+
+	f.send(pending...)
+}
+
+// DiscardChanges is called by a table editor reporting to the feed when its statement fails. The changes of the
+// statement are discarded.
+func (f *RowChangeFeed) DiscardChanges(ctx *Context) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	s := f.session(ctx)
+	if s.depth > 0 {
+		s.depth--
+	}
+	s.pending = nil
+	f.releaseSession(ctx, s)
+}
+
+// releaseSession forgets the state of the session given if it has nothing to send. f.mu must be held.
+func (f *RowChangeFeed) releaseSession(ctx *Context, s *rowChangeSession) {
+	if s.depth == 0 && len(s.pending) == 0 && s.txID == 0 {
+		delete(f.sessions, ctx.Session.ID())
+	}
+}
+
+// TransactionCommitted reports the commit of the current transaction of the session of the context given.
+func (f *RowChangeFeed) TransactionCommitted(ctx *Context) {
+	f.endTransaction(ctx, rowChangeCommit)
+}
+
+// TransactionRolledBack reports the rollback of the current transaction of the session of the context given.
+func (f *RowChangeFeed) TransactionRolledBack(ctx *Context) {
+	f.endTransaction(ctx, rowChangeRollback)
+}
+
+func (f *RowChangeFeed) endTransaction(ctx *Context, boundary rowChangeBoundary) {
+	if f == nil {
+		return
+	}
+	f.mu.Lock()
+	s, ok := f.sessions[ctx.Session.ID()]
+	if !ok || s.txID == 0 {
+		f.mu.Unlock()
+		return
+	}
+	txID := s.txID
+	s.txID = 0
+	f.releaseSession(ctx, s)
+	f.mu.Unlock()
+
+	f.send(rowChangeEvent{ctx: ctx, txID: txID, boundary: boundary})
+}
+
+// send queues the events given according to the overflow policy of the feed.
+func (f *RowChangeFeed) send(events ...rowChangeEvent) {
+	f.sendMu.Lock()
+	defer f.sendMu.Unlock()
+	for _, ev := range events {
+		if f.overflow == RowChangeOverflowDrop {
+			select {
+			case f.events <- ev:
+			default:
+				atomic.AddUint64(&f.dropped, 1)
+			}
+			continue
+		}
+		select {
+		case f.events <- ev:
+		case <-f.done:
+			atomic.AddUint64(&f.dropped, 1)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testRowChangeListener struct {
+	changes []string
+}
+
+func (l *testRowChangeListener) RowChanged(ctx *Context, database, table string, op RowChangeOperation, oldRow, newRow Row, txID uint64) {
+	l.changes = append(l.changes, fmt.Sprintf("%d %s %s.%s %v %v", txID, op, database, table, oldRow, newRow))
+}
+
+func (l *testRowChangeListener) TransactionCommitted(ctx *Context, txID uint64) {
+	l.changes = append(l.changes, fmt.Sprintf("%d commit", txID))
+}
+
+func (l *testRowChangeListener) TransactionRolledBack(ctx *Context, txID uint64) {
+	l.changes = append(l.changes, fmt.Sprintf("%d rollback", txID))
+}
+
+func TestRowChangeFeed(t *testing.T) {
+	t.Run("changes are sent when their statement completes", func(t *testing.T) {
+		feed := NewRowChangeFeed(10, RowChangeOverflowBlock)
+		listener := &testRowChangeListener{}
+		feed.AddListener(listener)
+		runCtx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go feed.Run(runCtx)
+
+		ctx := NewEmptyContext()
+		feed.StatementBegin(ctx)
+		feed.RowChanged(ctx, "db", "t", RowInserted, nil, Row{1})
+		feed.DiscardChanges(ctx)
+		feed.TransactionCommitted(ctx)
+
+		row := Row{2}
+		feed.StatementBegin(ctx)
+		feed.StatementBegin(ctx)
+		feed.RowChanged(ctx, "db", "t", RowInserted, nil, row)
+		feed.StatementComplete(ctx)
+		// The row reported is copied
+		row[0] = 3
+		feed.Flush()
+		require.Empty(t, listener.changes)
+
+		feed.RowChanged(ctx, "db", "u", RowUpdated, Row{1}, Row{2})
+		feed.StatementComplete(ctx)
+		feed.TransactionRolledBack(ctx)
+		feed.StatementBegin(ctx)
+		feed.RowChanged(ctx, "db", "t", RowDeleted, Row{2}, nil)
+		feed.StatementComplete(ctx)
+		feed.TransactionCommitted(ctx)
+		feed.Flush()
+		require.Equal(t, []string{
+			"1 insert db.t [] [2]",
+			"1 update db.u [1] [2]",
+			"1 rollback",
+			"2 delete db.t [2] []",
+			"2 commit",
+		}, listener.changes)
+	})
+
+	t.Run("changes are dropped when the queue is full", func(t *testing.T) {
+		feed := NewRowChangeFeed(2, RowChangeOverflowDrop)
+		listener := &testRowChangeListener{}
+		feed.AddListener(listener)
+
+		ctx := NewEmptyContext()
+		feed.StatementBegin(ctx)
+		for i := 0; i < 3; i++ {
+			feed.RowChanged(ctx, "db", "t", RowInserted, nil, Row{i})
+		}
+		feed.StatementComplete(ctx)
+		feed.TransactionCommitted(ctx)
+		require.Equal(t, uint64(2), feed.Dropped())
+
+		runCtx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go feed.Run(runCtx)
+		feed.Flush()
+		require.Equal(t, []string{
+			"1 insert db.t [] [0]",
+			"1 insert db.t [] [1]",
+		}, listener.changes)
+	})
+}
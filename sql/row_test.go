@@ -15,6 +15,8 @@
 package sql
 
 import (
+	"context"
+	"errors"
 	"io"
 	"testing"
 
@@ -66,3 +68,122 @@ func TestRowsToRowIter(t *testing.T) {
 	err = iter.Close(ctx)
 	require.NoError(err)
 }
+
+// countingRowIter returns |n| rows, or infinitely many if |n| is negative, calling |onRow| before every row.
+type countingRowIter struct {
+	n      int
+	read   int
+	onRow  func(i int)
+	err    error
+	closed bool
+}
+
+func (i *countingRowIter) Next(ctx *Context) (Row, error) {
+	if i.n >= 0 && i.read >= i.n {
+		if i.err != nil {
+			return nil, i.err
+		}
+		return nil, io.EOF
+	}
+	if i.onRow != nil {
+		i.onRow(i.read)
+	}
+	i.read++
+	return NewRow(i.read), nil
+}
+
+func (i *countingRowIter) Close(*Context) error {
+	i.closed = true
+	return nil
+}
+
+func TestRowIterToRowsWithLimit(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		n         int
+		max       int
+		expected  int
+		truncated bool
+	}{
+		{name: "no limit", n: 5, max: 0, expected: 5},
+		{name: "under the limit", n: 5, max: 10, expected: 5},
+		{name: "at the limit", n: 5, max: 5, expected: 5},
+		{name: "over the limit", n: 5, max: 3, expected: 3, truncated: true},
+		{name: "empty", n: 0, max: 3, expected: 0},
+		{name: "many rows", n: 3*rowIterCheckInterval + 1, max: 2 * rowIterCheckInterval, expected: 2 * rowIterCheckInterval, truncated: true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, batched := range []bool{false, true} {
+				require := require.New(t)
+				ctx := NewEmptyContext()
+
+				iter := &countingRowIter{n: tt.n}
+				var rowIter RowIter = iter
+				if batched {
+					rowIter = NewBatchRowIter(iter)
+				}
+				rows, truncated, err := RowIterToRowsWithLimit(ctx, nil, rowIter, tt.max)
+				require.NoError(err)
+				require.Equal(tt.truncated, truncated)
+				require.Len(rows, tt.expected)
+				for i, row := range rows {
+					require.Equal(NewRow(i+1), row)
+				}
+				require.True(iter.closed)
+			}
+		})
+	}
+}
+
+func TestAppendRowIterToRows(t *testing.T) {
+	require := require.New(t)
+	ctx := NewEmptyContext()
+
+	buf := make([]Row, 0, 8)
+	rows, truncated, err := AppendRowIterToRows(ctx, buf, nil, &countingRowIter{n: 3}, 0)
+	require.NoError(err)
+	require.False(truncated)
+	require.Equal([]Row{{1}, {2}, {3}}, rows)
+	require.Same(&buf[:1][0], &rows[0], "the buffer given should be reused")
+
+	rows, truncated, err = AppendRowIterToRows(ctx, rows, nil, &countingRowIter{n: 3}, 2)
+	require.NoError(err)
+	require.True(truncated)
+	require.Equal([]Row{{1}, {2}, {3}, {1}, {2}}, rows)
+}
+
+func TestRowIterToRowsCancel(t *testing.T) {
+	require := require.New(t)
+	goCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ctx := NewContext(goCtx)
+
+	iter := &countingRowIter{n: -1, onRow: func(i int) {
+		if i == 10 {
+			cancel()
+		}
+	}}
+	rows, err := RowIterToRows(ctx, nil, iter)
+	require.ErrorIs(err, context.Canceled)
+	require.Nil(rows)
+	require.True(iter.closed)
+	require.LessOrEqual(iter.read, rowIterCheckInterval+1)
+}
+
+func TestRowIterToRowsClosesOnError(t *testing.T) {
+	for _, batched := range []bool{false, true} {
+		require := require.New(t)
+		ctx := NewEmptyContext()
+
+		expected := errors.New("read failed")
+		iter := &countingRowIter{n: 2, err: expected}
+		var rowIter RowIter = iter
+		if batched {
+			rowIter = NewBatchRowIter(iter)
+		}
+		rows, err := RowIterToRows(ctx, nil, rowIter)
+		require.ErrorIs(err, expected)
+		require.Nil(rows)
+		require.True(iter.closed)
+	}
+}
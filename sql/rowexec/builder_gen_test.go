@@ -54,6 +54,7 @@ func TestGenBuilder(t *testing.T) {
 		"Call":                      "*plan.Call",
 		"CaseStatement":             "*plan.CaseStatement",
 		"elseCaseError":             "plan.elseCaseError",
+		"CheckTable":                "*plan.CheckTable",
 		"Close":                     "*plan.Close",
 		"Concat":                    "*plan.Concat",
 		"CreateIndex":               "*plan.CreateIndex",
@@ -90,6 +91,8 @@ func TestGenBuilder(t *testing.T) {
 		"Fetch":                     "*plan.Fetch",
 		"Filter":                    "*plan.Filter",
 		"FlushPrivileges":           "*plan.FlushPrivileges",
+		"FlushLogs":                 "*plan.FlushLogs",
+		"FlushStatus":               "*plan.FlushStatus",
 		"ForeignKeyHandler":         "*plan.ForeignKeyHandler",
 		"Grant":                     "*plan.Grant",
 		"GrantRole":                 "*plan.GrantRole",
@@ -101,6 +104,7 @@ func TestGenBuilder(t *testing.T) {
 		"IfElseBlock":               "*plan.IfElseBlock",
 		"IndexedInSubqueryFilter":   "*plan.IndexedInSubqueryFilter",
 		"IndexedTableAccess":        "*plan.IndexedTableAccess",
+		"IndexMerge":                "*plan.IndexMerge",
 		"InsertInto":                "*plan.InsertInto",
 		"InsertDestination":         "*plan.InsertDestination",
 		"Into":                      "*plan.Into",
@@ -113,6 +117,9 @@ func TestGenBuilder(t *testing.T) {
 		"LoadData":                  "*plan.LoadData",
 		"LockTables":                "*plan.LockTables",
 		"UnlockTables":              "*plan.UnlockTables",
+		"HandlerOpen":               "*plan.HandlerOpen",
+		"HandlerRead":               "*plan.HandlerRead",
+		"HandlerClose":              "*plan.HandlerClose",
 		"Loop":                      "*plan.Loop",
 		"NamedWindows":              "*plan.NamedWindows",
 		"nothing":                   "plan.nothing",
@@ -162,6 +169,7 @@ func TestGenBuilder(t *testing.T) {
 		"SignalName":                "*plan.SignalName",
 		"Sort":                      "*plan.Sort",
 		"TopN":                      "*plan.TopN",
+		"ReservoirSample":           "*plan.ReservoirSample",
 		"StripRowNode":              "*plan.StripRowNode",
 		"prependNode":               "*plan.prependNode",
 		"Max1Row":                   "*plan.Max1Row",
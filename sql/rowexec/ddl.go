@@ -16,6 +16,7 @@ package rowexec
 
 import (
 	"bufio"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -151,9 +152,32 @@ func (b *BaseBuilder) buildDropConstraint(ctx *sql.Context, n *plan.DropConstrai
 
 func (b *BaseBuilder) buildCreateView(ctx *sql.Context, n *plan.CreateView, row sql.Row) (sql.RowIter, error) {
 	registry := ctx.GetViewRegistry()
+	names, err := n.Database().GetTableNames(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		if strings.ToLower(name) == strings.ToLower(n.Name) {
+			if n.IsReplace {
+				return nil, plan.ErrNotView.New(n.Database().Name() + "." + name)
+			}
+			return nil, sql.ErrTableAlreadyExists.New(n)
+		}
+	}
+
+	viewDb, isViewDb := n.Database().(sql.ViewDatabase)
+	if n.IsAlter {
+		exists, err := viewExists(ctx, n.Database(), n.Name)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, sql.ErrTableNotFound.New(n.Database().Name() + "." + n.Name)
+		}
+	}
 	if n.IsReplace {
-		if dropper, ok := n.Database().(sql.ViewDatabase); ok {
-			err := dropper.DropView(ctx, n.Name)
+		if isViewDb {
+			err := viewDb.DropView(ctx, n.Name)
 			if err != nil && !sql.ErrViewDoesNotExist.Is(err) {
 				return sql.RowsToRowIter(), err
 			}
@@ -164,27 +188,29 @@ func (b *BaseBuilder) buildCreateView(ctx *sql.Context, n *plan.CreateView, row
 			}
 		}
 	}
-	names, err := n.Database().GetTableNames(ctx)
-	if err != nil {
-		return nil, err
-	}
-	for _, name := range names {
-		if strings.ToLower(name) == strings.ToLower(n.Name) {
-			return nil, sql.ErrTableAlreadyExists.New(n)
-		}
-	}
 
 	// TODO: isUpdatable should be defined at CREATE VIEW time
 	// isUpdatable := GetIsUpdatableFromCreateView(cv)
 
-	creator, ok := n.Database().(sql.ViewDatabase)
-	if ok {
-		return sql.RowsToRowIter(), creator.CreateView(ctx, n.Name, n.Definition.TextDefinition, n.CreateViewString)
+	if isViewDb {
+		return sql.RowsToRowIter(), viewDb.CreateView(ctx, n.Name, n.Definition.TextDefinition, n.CreateViewString)
 	} else {
 		return sql.RowsToRowIter(), registry.Register(n.Database().Name(), n.View())
 	}
 }
 
+// viewExists returns whether the database given has a view with the name given, either of its own or in the view
+// registry of the session.
+func viewExists(ctx *sql.Context, db sql.Database, name string) (bool, error) {
+	if vdb, ok := db.(sql.ViewDatabase); ok {
+		_, ok, err := vdb.GetViewDefinition(ctx, name)
+		if err != nil || ok {
+			return ok, err
+		}
+	}
+	return ctx.GetViewRegistry().Exists(db.Name(), name), nil
+}
+
 func (b *BaseBuilder) buildCreateCheck(ctx *sql.Context, n *plan.CreateCheck, row sql.Row) (sql.RowIter, error) {
 	err := b.executeCreateCheck(ctx, n)
 	if err != nil {
@@ -550,7 +576,15 @@ func (b *BaseBuilder) buildCreateUser(ctx *sql.Context, n *plan.CreateUser, row
 				return nil, sql.ErrUserCreationFailure.New(err)
 			}
 		}
-		// TODO: attributes should probably not be nil, but setting it to &n.Attribute causes unexpected behavior
+		var attributes *string
+		if n.Attribute != "" {
+			var obj map[string]interface{}
+			if err := json.Unmarshal([]byte(n.Attribute), &obj); err != nil {
+				return nil, sql.ErrInvalidJson.New(err.Error())
+			}
+			attribute := n.Attribute
+			attributes = &attribute
+		}
 		// TODO: validate all of the data
 		err := userTableData.Put(ctx, &mysql_db.User{
 			User:                user.UserName.Name,
@@ -560,7 +594,7 @@ func (b *BaseBuilder) buildCreateUser(ctx *sql.Context, n *plan.CreateUser, row
 			Password:            password,
 			PasswordLastChanged: time.Now().UTC(),
 			Locked:              false,
-			Attributes:          nil,
+			Attributes:          attributes,
 			IsRole:              false,
 			Identity:            user.Identity,
 		})
@@ -950,6 +984,15 @@ func (b *BaseBuilder) buildCreateTable(ctx *sql.Context, n *plan.CreateTable, ro
 		return sql.RowsToRowIter(), sql.ErrTableCreatedNotFound.New()
 	}
 
+	if n.StatsAutoRecalc != sql.StatsAutoRecalc_Default {
+		if sart, ok := tableNode.(sql.StatsAutoRecalcTable); ok {
+			err = sart.SetStatsAutoRecalc(ctx, n.StatsAutoRecalc)
+			if err != nil {
+				return sql.RowsToRowIter(), err
+			}
+		}
+	}
+
 	var nonPrimaryIdxes []*plan.IndexDefinition
 	for _, def := range n.IdxDefs {
 		if def.Constraint != sql.IndexConstraint_Primary {
@@ -1037,6 +1080,12 @@ func (b *BaseBuilder) buildAlterTableCollation(ctx *sql.Context, n *plan.AlterTa
 		return nil, sql.ErrAlterTableCollationNotSupported.New(tbl.Name())
 	}
 
+	if n.ConvertColumns {
+		if n.Ignore {
+			ctx = types.WithIgnoredColumnChangeErrors(ctx)
+		}
+		return sql.RowsToRowIter(sql.NewRow(types.NewOkResult(0))), alterable.ModifyStoredCollation(ctx, n.Collation)
+	}
 	return sql.RowsToRowIter(sql.NewRow(types.NewOkResult(0))), alterable.ModifyDefaultCollation(ctx, n.Collation)
 }
 
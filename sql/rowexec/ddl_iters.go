@@ -206,6 +206,9 @@ func (i *modifyColumnIter) Next(ctx *sql.Context) (sql.Row, error) {
 		return nil, io.EOF
 	}
 	i.runOnce = true
+	if i.m.Ignore {
+		ctx = types.WithIgnoredColumnChangeErrors(ctx)
+	}
 
 	idx := i.m.TargetSchema().IndexOf(i.m.Column(), i.alterable.Name())
 	if idx < 0 {
@@ -469,13 +472,13 @@ func (i *modifyColumnIter) rewriteTable(ctx *sql.Context, rwt sql.RewritableTabl
 		return false, err
 	}
 
-	partitions, err := rwt.Partitions(ctx)
+	rowIter, err := newRewriteRowIter(ctx, rwt)
 	if err != nil {
 		return false, err
 	}
 
-	rowIter := sql.NewTableRowIter(ctx, rwt, partitions)
-
+	newColIdx := newSch.IndexOfColName(i.m.NewColumn().Name)
+	rowNum := 0
 	for {
 		r, err := rowIter.Next(ctx)
 		if err == io.EOF {
@@ -484,7 +487,8 @@ func (i *modifyColumnIter) rewriteTable(ctx *sql.Context, rwt sql.RewritableTabl
 			return false, err
 		}
 
-		newRow, err := projectRowWithTypes(ctx, newSch, projections, r)
+		rowNum++
+		newRow, err := projectRowWithTypes(ctx, newSch, projections, r, newColIdx, rowNum)
 		if err != nil {
 			return false, err
 		}
@@ -808,15 +812,68 @@ func (i *loggingKeyValueIter) Close(ctx *sql.Context) error {
 	return i.iter.Close(ctx)
 }
 
+// rewriteProgressRowBatch is the number of rows read from a partition between row progress updates sent to the
+// process list while a table is being rewritten.
+const rewriteProgressRowBatch = 1000
+
+// newRewriteRowIter returns a row iterator over all partitions of the table given, for use when rewriting a table
+// during a schema change. Progress is registered with the process list the same way it is for table scans, so that
+// SHOW PROCESSLIST shows how far along a long-running ALTER TABLE is.
+func newRewriteRowIter(ctx *sql.Context, tbl sql.Table) (sql.RowIter, error) {
+	partitions, err := tbl.Partitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var total int64 = -1
+	if counter, ok := tbl.(sql.PartitionCounter); ok {
+		total, err = counter.PartitionCount(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	processList := ctx.ProcessList
+	name := tbl.Name()
+	processList.AddTableProgress(ctx.Pid(), name, total)
+
+	rowsRead := make(map[string]int64)
+	onPartitionStart := func(partitionName string) {
+		processList.AddPartitionProgress(ctx.Pid(), name, partitionName, -1)
+	}
+	onPartitionDone := func(partitionName string) {
+		delete(rowsRead, partitionName)
+		processList.UpdateTableProgress(ctx.Pid(), name, 1)
+		processList.RemovePartitionProgress(ctx.Pid(), name, partitionName)
+	}
+	onRowNext := func(partitionName string) {
+		rowsRead[partitionName]++
+		if rowsRead[partitionName]%rewriteProgressRowBatch == 0 {
+			processList.UpdatePartitionProgress(ctx.Pid(), name, partitionName, rewriteProgressRowBatch)
+		}
+	}
+
+	processTable := plan.NewProcessTable(tbl, onPartitionDone, onPartitionStart, onRowNext)
+	return sql.NewTableRowIter(ctx, processTable, partitions), nil
+}
+
 // projectRowWithTypes projects the row given with the projections given and additionally converts them to the
-// corresponding types found in the schema given, using the standard type conversion logic.
-func projectRowWithTypes(ctx *sql.Context, sch sql.Schema, projections []sql.Expression, r sql.Row) (sql.Row, error) {
+// corresponding types found in the schema given, using the standard type conversion logic. The value of the modified
+// column at |modifiedIdx|, the |rowNum|th row of the table, is converted according to the sql_mode of the session.
+func projectRowWithTypes(ctx *sql.Context, sch sql.Schema, projections []sql.Expression, r sql.Row, modifiedIdx, rowNum int) (sql.Row, error) {
 	newRow, err := ProjectRow(ctx, projections, r)
 	if err != nil {
 		return nil, err
 	}
 
 	for i := range newRow {
+		if i == modifiedIdx {
+			newRow[i], err = types.ConvertForColumnChange(ctx, sch[i], newRow[i], rowNum)
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
 		converted, inRange, err := sch[i].Type.Convert(newRow[i])
 		if err != nil {
 			if sql.ErrNotMatchingSRID.Is(err) {
@@ -987,13 +1044,11 @@ func (c *createPkIter) rewriteTable(ctx *sql.Context, rwt sql.RewritableTable) e
 		return err
 	}
 
-	partitions, err := rwt.Partitions(ctx)
+	rowIter, err := newRewriteRowIter(ctx, rwt)
 	if err != nil {
 		return err
 	}
 
-	rowIter := sql.NewTableRowIter(ctx, rwt, partitions)
-
 	for {
 		r, err := rowIter.Next(ctx)
 		if err == io.EOF {
@@ -1078,13 +1133,11 @@ func (d *dropPkIter) rewriteTable(ctx *sql.Context, rwt sql.RewritableTable) err
 		return err
 	}
 
-	partitions, err := rwt.Partitions(ctx)
+	rowIter, err := newRewriteRowIter(ctx, rwt)
 	if err != nil {
 		return err
 	}
 
-	rowIter := sql.NewTableRowIter(ctx, rwt, partitions)
-
 	for {
 		r, err := rowIter.Next(ctx)
 		if err == io.EOF {
@@ -1268,13 +1321,11 @@ func (i *addColumnIter) rewriteTable(ctx *sql.Context, rwt sql.RewritableTable)
 		return false, err
 	}
 
-	partitions, err := rwt.Partitions(ctx)
+	rowIter, err := newRewriteRowIter(ctx, rwt)
 	if err != nil {
 		return false, err
 	}
 
-	rowIter := sql.NewTableRowIter(ctx, rwt, partitions)
-
 	var val uint64
 	autoIncColIdx := -1
 	if newSch.HasAutoIncrement() && !i.a.TargetSchema().HasAutoIncrement() {
@@ -1533,13 +1584,11 @@ func (i *dropColumnIter) rewriteTable(ctx *sql.Context, rwt sql.RewritableTable)
 		return false, err
 	}
 
-	partitions, err := rwt.Partitions(ctx)
+	rowIter, err := newRewriteRowIter(ctx, rwt)
 	if err != nil {
 		return false, err
 	}
 
-	rowIter := sql.NewTableRowIter(ctx, rwt, partitions)
-
 	for {
 		r, err := rowIter.Next(ctx)
 		if err == io.EOF {
@@ -1721,6 +1770,9 @@ func (b *BaseBuilder) executeAlterIndex(ctx *sql.Context, n *plan.AlterIndex) er
 			seenCols[col.Name] = false
 		}
 		for _, indexCol := range n.Columns {
+			if indexCol.IsFunctional() {
+				continue
+			}
 			if seen, ok := seenCols[indexCol.Name]; ok {
 				if !seen {
 					seenCols[indexCol.Name] = true
@@ -1747,7 +1799,7 @@ func (b *BaseBuilder) executeAlterIndex(ctx *sql.Context, n *plan.AlterIndex) er
 					indexMap[strings.ToLower(index.ID())] = struct{}{}
 				}
 			}
-			indexName = strings.Join(n.ColumnNames(), "")
+			indexName = plan.DefaultIndexName(n.Columns)
 			if _, ok := indexMap[strings.ToLower(indexName)]; ok {
 				for i := 0; true; i++ {
 					newIndexName := fmt.Sprintf("%s_%d", indexName, i)
@@ -1779,13 +1831,11 @@ func (b *BaseBuilder) executeAlterIndex(ctx *sql.Context, n *plan.AlterIndex) er
 			return err
 		}
 
-		partitions, err := rwt.Partitions(ctx)
+		rowIter, err := newRewriteRowIter(ctx, rwt)
 		if err != nil {
 			return err
 		}
 
-		rowIter := sql.NewTableRowIter(ctx, rwt, partitions)
-
 		for {
 			r, err := rowIter.Next(ctx)
 			if err == io.EOF {
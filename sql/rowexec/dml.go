@@ -16,6 +16,7 @@ package rowexec
 
 import (
 	"fmt"
+	"io"
 	"sync"
 
 	"github.com/dolthub/vitess/go/mysql"
@@ -27,6 +28,12 @@ import (
 )
 
 func (b *BaseBuilder) buildInsertInto(ctx *sql.Context, ii *plan.InsertInto, row sql.Row) (sql.RowIter, error) {
+	return b.buildInsert(ctx, ii, row, nil, nil)
+}
+
+// buildInsert builds the iterator of the InsertInto given. For a REPLACE, |replaceHandler| counts the rows it deletes,
+// if it's not nil, and |deleteTriggers| are run for each of them.
+func (b *BaseBuilder) buildInsert(ctx *sql.Context, ii *plan.InsertInto, row sql.Row, replaceHandler *replaceRowHandler, deleteTriggers []*plan.TriggerExecutor) (sql.RowIter, error) {
 	dstSchema := ii.Destination.Schema()
 
 	insertable, err := plan.GetInsertable(ii.Destination)
@@ -47,6 +54,18 @@ func (b *BaseBuilder) buildInsertInto(ctx *sql.Context, ii *plan.InsertInto, row
 			updater = insertable.(sql.UpdatableTable).Updater(ctx)
 		}
 	}
+	// The editors of tables with foreign keys report their changes themselves
+	if _, ok := insertable.(*plan.ForeignKeyHandler); !ok && ctx.RowChangeFeed().HasListeners() {
+		db := plan.GetDatabaseName(ii.Destination)
+		if replacer != nil {
+			replacer = plan.NewRowChangeReplacer(ctx, db, insertable.Name(), replacer)
+		} else {
+			inserter = plan.NewRowChangeInserter(ctx, db, insertable.Name(), inserter)
+		}
+		if updater != nil {
+			updater = plan.NewRowChangeUpdater(ctx, db, insertable.Name(), updater)
+		}
+	}
 
 	rowIter, err := b.buildNodeExec(ctx, ii.Source, row)
 	if err != nil {
@@ -55,17 +74,20 @@ func (b *BaseBuilder) buildInsertInto(ctx *sql.Context, ii *plan.InsertInto, row
 
 	insertExpressions := getInsertExpressions(ii.Source)
 	insertIter := &insertIter{
-		schema:      dstSchema,
-		tableNode:   ii.Destination,
-		inserter:    inserter,
-		replacer:    replacer,
-		updater:     updater,
-		rowSource:   rowIter,
-		updateExprs: ii.OnDupExprs,
-		insertExprs: insertExpressions,
-		checks:      ii.Checks,
-		ctx:         ctx,
-		ignore:      ii.Ignore,
+		schema:         dstSchema,
+		tableNode:      ii.Destination,
+		inserter:       inserter,
+		replacer:       replacer,
+		replaceHandler: replaceHandler,
+		deleteTriggers: deleteTriggers,
+		updater:        updater,
+		rowSource:      rowIter,
+		updateExprs:    ii.OnDupExprs,
+		insertExprs:    insertExpressions,
+		checks:         ii.Checks,
+		ctx:            ctx,
+		ignore:         ii.Ignore,
+		b:              b,
 	}
 
 	var ed sql.EditOpenerCloser
@@ -101,7 +123,10 @@ func (b *BaseBuilder) buildDeleteFrom(ctx *sql.Context, n *plan.DeleteFrom, row
 		if err != nil {
 			return nil, err
 		}
-		deleter := deletable.Deleter(ctx)
+		var deleter sql.RowDeleter = deletable.Deleter(ctx)
+		if _, ok := deletable.(*plan.ForeignKeyHandler); !ok && ctx.RowChangeFeed().HasListeners() {
+			deleter = plan.NewRowChangeDeleter(ctx, plan.GetDatabaseName(target), deletable.Name(), deleter)
+		}
 
 		// By default the sourceName in the schema is the table name, but if there is a
 		// table alias applied, then use that instead.
@@ -132,7 +157,10 @@ func (b *BaseBuilder) buildUpdate(ctx *sql.Context, n *plan.Update, row sql.Row)
 	if err != nil {
 		return nil, err
 	}
-	updater := updatable.Updater(ctx)
+	var updater sql.RowUpdater = updatable.Updater(ctx)
+	if _, ok := updatable.(*plan.ForeignKeyHandler); !ok && ctx.RowChangeFeed().HasListeners() {
+		updater = plan.NewRowChangeUpdater(ctx, plan.GetDatabaseName(n.Child), updatable.Name(), updater)
+	}
 
 	iter, err := b.buildNodeExec(ctx, n.Child, row)
 	if err != nil {
@@ -266,26 +294,81 @@ func (b *BaseBuilder) buildTriggerBeginEndBlock(ctx *sql.Context, n *plan.Trigge
 }
 
 func (b *BaseBuilder) buildTriggerExecutor(ctx *sql.Context, n *plan.TriggerExecutor, row sql.Row) (sql.RowIter, error) {
+	if ii := triggeredInsert(n); ii != nil && ii.IsReplace {
+		return b.buildReplace(ctx, n, row, nil, nil)
+	}
+
 	childIter, err := b.buildNodeExec(ctx, n.Left(), row)
 	if err != nil {
 		return nil, err
 	}
+	return b.newTriggerIter(ctx, n, childIter, false), nil
+}
 
+func (b *BaseBuilder) newTriggerIter(ctx *sql.Context, n *plan.TriggerExecutor, childIter sql.RowIter, replace bool) *triggerIter {
 	return &triggerIter{
 		child:          childIter,
 		triggerTime:    n.TriggerTime,
 		triggerEvent:   n.TriggerEvent,
 		executionLogic: n.Right(),
+		replace:        replace,
 		ctx:            ctx,
-	}, nil
+		b:              b,
+	}
+}
+
+// buildReplace builds the iterator of a REPLACE, which is the InsertInto given or the trigger executors wrapping it.
+// Delete triggers don't run for the rows a REPLACE returns, but for each row it deletes, before it inserts the row
+// replacing it, so their executors are passed on to the insert iterator in the order they're nested, along with the
+// handler counting the rows deleted, which may be nil.
+func (b *BaseBuilder) buildReplace(ctx *sql.Context, n sql.Node, row sql.Row, replaceHandler *replaceRowHandler, deleteTriggers []*plan.TriggerExecutor) (sql.RowIter, error) {
+	switch n := n.(type) {
+	case *plan.InsertInto:
+		return b.buildInsert(ctx, n, row, replaceHandler, deleteTriggers)
+	case *plan.TriggerExecutor:
+		if n.TriggerEvent == plan.DeleteTrigger {
+			return b.buildReplace(ctx, n.Left(), row, replaceHandler, append(deleteTriggers, n))
+		}
+		childIter, err := b.buildReplace(ctx, n.Left(), row, replaceHandler, deleteTriggers)
+		if err != nil {
+			return nil, err
+		}
+		return b.newTriggerIter(ctx, n, childIter, true), nil
+	default:
+		return nil, fmt.Errorf("unexpected node in REPLACE: %T", n)
+	}
 }
 
 func (b *BaseBuilder) buildInsertDestination(ctx *sql.Context, n *plan.InsertDestination, row sql.Row) (sql.RowIter, error) {
 	return b.buildNodeExec(ctx, n.Child, row)
 }
 
+// triggeredInsert returns the InsertInto node given, or the one wrapped by the trigger executors given, or nil if there
+// is none.
+func triggeredInsert(n sql.Node) *plan.InsertInto {
+	for {
+		switch node := n.(type) {
+		case *plan.InsertInto:
+			return node
+		case *plan.TriggerExecutor:
+			n = node.Left()
+		default:
+			return nil
+		}
+	}
+}
+
 func (b *BaseBuilder) buildRowUpdateAccumulator(ctx *sql.Context, n *plan.RowUpdateAccumulator, row sql.Row) (sql.RowIter, error) {
-	rowIter, err := b.buildNodeExec(ctx, n.Child(), row)
+	var replaceHandler *replaceRowHandler
+	var rowIter sql.RowIter
+	var err error
+	if n.RowUpdateType == plan.UpdateTypeReplace {
+		// Only the insert iterator knows about every row a REPLACE deletes, so it counts them with the handler directly
+		replaceHandler = &replaceRowHandler{}
+		rowIter, err = b.buildReplace(ctx, n.Child(), row, replaceHandler, nil)
+	} else {
+		rowIter, err = b.buildNodeExec(ctx, n.Child(), row)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -297,7 +380,7 @@ func (b *BaseBuilder) buildRowUpdateAccumulator(ctx *sql.Context, n *plan.RowUpd
 	case plan.UpdateTypeInsert:
 		rowHandler = &insertRowHandler{}
 	case plan.UpdateTypeReplace:
-		rowHandler = &replaceRowHandler{}
+		rowHandler = replaceHandler
 	case plan.UpdateTypeDuplicateKeyUpdate:
 		rowHandler = &onDuplicateUpdateHandler{schema: n.Child().Schema(), clientFoundRowsCapability: clientFoundRowsToggled}
 	case plan.UpdateTypeUpdate:
@@ -339,23 +422,35 @@ func (b *BaseBuilder) buildRowUpdateAccumulator(ctx *sql.Context, n *plan.RowUpd
 }
 
 func (b *BaseBuilder) buildTruncate(ctx *sql.Context, n *plan.Truncate, row sql.Row) (sql.RowIter, error) {
-	truncatable, err := plan.GetTruncatable(n.Child)
-	if err != nil {
-		return nil, err
-	}
 	//TODO: when performance schema summary tables are added, reset the columns to 0/NULL rather than remove rows
 	//TODO: close all handlers that were opened with "HANDLER OPEN"
 
-	removed, err := truncatable.Truncate(ctx)
-	if err != nil {
-		return nil, err
+	var table sql.Table
+	var removed int
+	if truncatable, err := plan.GetTruncatable(n.Child); err == nil {
+		removed, err = truncatable.Truncate(ctx)
+		if err != nil {
+			return nil, err
+		}
+		table = truncatable
+	} else {
+		deletable, err := plan.GetDeletable(n.Child)
+		if err != nil {
+			return nil, plan.ErrTruncateNotSupported.New()
+		}
+		removed, err = deleteAllRows(ctx, deletable)
+		if err != nil {
+			return nil, err
+		}
+		table = deletable
 	}
-	for _, col := range truncatable.Schema() {
+
+	for _, col := range table.Schema() {
 		if col.AutoIncrement {
-			aiTable, ok := truncatable.(sql.AutoIncrementTable)
+			aiTable, ok := table.(sql.AutoIncrementTable)
 			if ok {
 				setter := aiTable.AutoIncrementSetter(ctx)
-				err = setter.SetAutoIncrementValue(ctx, uint64(1))
+				err := setter.SetAutoIncrementValue(ctx, uint64(1))
 				if err != nil {
 					return nil, err
 				}
@@ -367,9 +462,43 @@ func (b *BaseBuilder) buildTruncate(ctx *sql.Context, n *plan.Truncate, row sql.
 			break
 		}
 	}
+
+	if !n.ReportRemoved {
+		removed = 0
+	}
 	return sql.RowsToRowIter(sql.NewRow(types.NewOkResult(removed))), nil
 }
 
+// deleteAllRows deletes every row of the table given one by one, for tables that do not implement
+// sql.TruncateableTable. It returns the number of rows deleted.
+func deleteAllRows(ctx *sql.Context, table sql.DeletableTable) (removed int, err error) {
+	deleter := table.Deleter(ctx)
+	defer func() {
+		if cerr := deleter.Close(ctx); err == nil {
+			err = cerr
+		}
+	}()
+
+	partitions, err := table.Partitions(ctx)
+	if err != nil {
+		return 0, err
+	}
+	iter := sql.NewTableRowIter(ctx, table, partitions)
+	defer iter.Close(ctx)
+	for {
+		row, err := iter.Next(ctx)
+		if err == io.EOF {
+			return removed, nil
+		} else if err != nil {
+			return removed, err
+		}
+		if err = deleter.Delete(ctx, row); err != nil {
+			return removed, err
+		}
+		removed++
+	}
+}
+
 func (b *BaseBuilder) buildUpdateSource(ctx *sql.Context, n *plan.UpdateSource, row sql.Row) (sql.RowIter, error) {
 	rowIter, err := b.buildNodeExec(ctx, n.Child, row)
 	if err != nil {
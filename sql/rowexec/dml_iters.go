@@ -157,8 +157,11 @@ type triggerIter struct {
 	executionLogic sql.Node
 	triggerTime    plan.TriggerTime
 	triggerEvent   plan.TriggerEvent
-	ctx            *sql.Context
-	b              *BaseBuilder
+	// replace is true for insert triggers on the rows of a REPLACE, which are the deleted row followed by the inserted
+	// row
+	replace bool
+	ctx     *sql.Context
+	b       *BaseBuilder
 }
 
 // prependRowInPlanForTriggerExecution returns a transformation function that prepends the row given to any row source in a query
@@ -195,48 +198,74 @@ func (t *triggerIter) Next(ctx *sql.Context) (row sql.Row, returnErr error) {
 		return nil, err
 	}
 
-	// Wrap the execution logic with the current child row before executing it.
-	logic, _, err := transform.NodeWithCtx(t.executionLogic, nil, prependRowInPlanForTriggerExecution(childRow))
+	triggerRow := childRow
+	if t.replace {
+		// Insert triggers of a REPLACE see the inserted row
+		triggerRow = childRow[len(childRow)/2:]
+	}
+
+	logic, logicRow, err := t.b.runTriggerLogic(t.ctx, t.executionLogic, triggerRow)
 	if err != nil {
 		return nil, err
 	}
 
+	// For some logic statements, we want to return the result of the logic operation as our row, e.g. a Set that alters
+	// the fields of the new row
+	if ok, returnRow := shouldUseLogicResult(logic, logicRow); ok && !t.replace {
+		return returnRow, nil
+	}
+
+	return childRow, nil
+}
+
+// runTriggerLogic runs the execution logic of a trigger for the row given. It returns the logic with the row applied to
+// it and the last row it returned.
+func (b *BaseBuilder) runTriggerLogic(ctx *sql.Context, executionLogic sql.Node, row sql.Row) (logic sql.Node, logicRow sql.Row, returnErr error) {
+	// Wrap the execution logic with the current child row before executing it.
+	logic, _, err := transform.NodeWithCtx(executionLogic, nil, prependRowInPlanForTriggerExecution(row))
+	if err != nil {
+		return nil, nil, err
+	}
+
 	// We don't do anything interesting with this subcontext yet, but it's a good idea to cancel it independently of the
 	// parent context if something goes wrong in trigger execution.
-	ctx, cancelFunc := t.ctx.NewSubContext()
+	subCtx, cancelFunc := ctx.NewSubContext()
 	defer cancelFunc()
 
-	logicIter, err := t.b.buildNodeExec(ctx, logic, childRow)
+	logicIter, err := b.buildNodeExec(subCtx, logic, row)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	defer func() {
-		err := logicIter.Close(t.ctx)
+		err := logicIter.Close(ctx)
 		if returnErr == nil {
 			returnErr = err
 		}
 	}()
 
-	var logicRow sql.Row
 	for {
-		row, err := logicIter.Next(ctx)
+		next, err := logicIter.Next(subCtx)
 		if err == io.EOF {
 			break
 		}
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
-		logicRow = row
+		logicRow = next
 	}
+	return logic, logicRow, nil
+}
 
-	// For some logic statements, we want to return the result of the logic operation as our row, e.g. a Set that alters
-	// the fields of the new row
-	if ok, returnRow := shouldUseLogicResult(logic, logicRow); ok {
-		return returnRow, nil
+// rowDeleted returns whether the first half of a row returned for a REPLACE, which is the deleted row, has any non-nil
+// value, meaning a row was deleted.
+func rowDeleted(deleted sql.Row) bool {
+	for _, v := range deleted {
+		if v != nil {
+			return true
+		}
 	}
-
-	return childRow, nil
+	return false
 }
 
 func shouldUseLogicResult(logic sql.Node, row sql.Row) (bool, sql.Row) {
@@ -317,16 +346,19 @@ func (r *replaceRowHandler) handleRowUpdate(row sql.Row) error {
 
 	// If a row was deleted as well as inserted, increment the counter again. A row was deleted if at least one column in
 	// the first half of the row is non-null.
-	for i := 0; i < len(row)/2; i++ {
-		if row[i] != nil {
-			r.rowsAffected++
-			break
-		}
+	if rowDeleted(row[:len(row)/2]) {
+		r.rowsAffected++
 	}
 
 	return nil
 }
 
+// rowDeleted counts a row deleted by the replace of a row besides the one in the row returned for it. A row
+// conflicting with rows on more than one unique key replaces all of them.
+func (r *replaceRowHandler) rowDeleted() {
+	r.rowsAffected++
+}
+
 func (r *replaceRowHandler) okResult() types.OkResult {
 	return types.NewOkResult(r.rowsAffected)
 }
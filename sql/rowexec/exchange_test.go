@@ -18,12 +18,14 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"sort"
 	"sync/atomic"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/dolthub/go-mysql-server/memory"
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/plan"
@@ -77,6 +79,163 @@ func TestExchange(t *testing.T) {
 	}
 }
 
+func TestExchangePartitionedTable(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	// Partitions hold several batches of rows each
+	table := newPartitionedBenchtable(8, 3*sql.RowBatchSize)
+	node := plan.NewProject(
+		[]sql.Expression{
+			expression.NewGetField(0, types.Int64, "id", false),
+			expression.NewGetField(1, types.Text, "name", false),
+		},
+		plan.NewFilter(
+			expression.NewEquals(
+				expression.NewMod(
+					expression.NewGetField(0, types.Int64, "id", false),
+					expression.NewLiteral(int64(2), types.Int64),
+				),
+				expression.NewLiteral(int64(0), types.Int64),
+			),
+			plan.NewResolvedTable(table, nil, nil),
+		),
+	)
+
+	iter, err := DefaultBuilder.Build(ctx, node, nil)
+	require.NoError(t, err)
+	expected, err := sql.RowIterToRows(ctx, nil, iter)
+	require.NoError(t, err)
+	require.Len(t, expected, 4*3*sql.RowBatchSize)
+
+	// Rows are returned in no particular order, and there are too many of them to compare unordered
+	sortByID := func(rows []sql.Row) []sql.Row {
+		sort.Slice(rows, func(i, j int) bool {
+			return rows[i][0].(int64) < rows[j][0].(int64)
+		})
+		return rows
+	}
+	expected = sortByID(expected)
+
+	for _, parallelism := range []int{1, 2, 3, 8, 16} {
+		t.Run(fmt.Sprintf("parallelism=%d", parallelism), func(t *testing.T) {
+			iter, err := DefaultBuilder.Build(ctx, plan.NewExchange(parallelism, node), nil)
+			require.NoError(t, err)
+			var rows []sql.Row
+			for {
+				row, err := iter.Next(ctx)
+				if err == io.EOF {
+					break
+				}
+				require.NoError(t, err)
+				rows = append(rows, row)
+			}
+			require.NoError(t, iter.Close(ctx))
+			require.Equal(t, expected, sortByID(rows))
+
+			// Batches are passed through from the workers
+			iter, err = DefaultBuilder.Build(ctx, plan.NewExchange(parallelism, node), nil)
+			require.NoError(t, err)
+			rows, err = sql.RowIterToRows(ctx, nil, iter)
+			require.NoError(t, err)
+			require.Equal(t, expected, sortByID(rows))
+		})
+	}
+}
+
+func TestOrderedExchange(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	table := newPartitionedBenchtable(8, 3*sql.RowBatchSize)
+	node := plan.NewProject(
+		[]sql.Expression{
+			expression.NewGetField(0, types.Int64, "id", false),
+			expression.NewGetField(1, types.Text, "name", false),
+		},
+		plan.NewResolvedTable(table, nil, nil),
+	)
+
+	iter, err := DefaultBuilder.Build(ctx, node, nil)
+	require.NoError(t, err)
+	expected, err := sql.RowIterToRows(ctx, nil, iter)
+	require.NoError(t, err)
+	require.Len(t, expected, 8*3*sql.RowBatchSize)
+
+	for _, parallelism := range []int{1, 2, 3, 8, 16} {
+		t.Run(fmt.Sprintf("parallelism=%d", parallelism), func(t *testing.T) {
+			exchange := plan.NewExchange(parallelism, node).WithOrdered(true)
+			// Rows are returned in the same order as without the exchange, every time
+			for i := 0; i < 3; i++ {
+				iter, err := DefaultBuilder.Build(ctx, exchange, nil)
+				require.NoError(t, err)
+				rows, err := sql.RowIterToRows(ctx, nil, iter)
+				require.NoError(t, err)
+				require.Equal(t, expected, rows)
+			}
+
+			// Closing before reading every row doesn't block
+			iter, err := DefaultBuilder.Build(ctx, exchange, nil)
+			require.NoError(t, err)
+			row, err := iter.Next(ctx)
+			require.NoError(t, err)
+			require.Equal(t, expected[0], row)
+			require.NoError(t, iter.Close(ctx))
+		})
+	}
+}
+
+func BenchmarkExchange(b *testing.B) {
+	table := newPartitionedBenchtable(16, 10000)
+	node := plan.NewProject(
+		[]sql.Expression{
+			expression.NewGetField(1, types.Text, "name", false),
+		},
+		plan.NewFilter(
+			expression.NewGreaterThan(
+				expression.NewGetField(0, types.Int64, "id", false),
+				expression.NewLiteral(int64(100), types.Int64),
+			),
+			plan.NewResolvedTable(table, nil, nil),
+		),
+	)
+
+	for _, parallelism := range []int{0, 1, 2, 4, 8} {
+		b.Run(fmt.Sprintf("parallelism=%d", parallelism), func(b *testing.B) {
+			ctx := sql.NewEmptyContext()
+			n := sql.Node(node)
+			if parallelism > 0 {
+				n = plan.NewExchange(parallelism, node)
+			}
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				iter, err := DefaultBuilder.Build(ctx, n, nil)
+				require.NoError(b, err)
+				rows, err := sql.RowIterToRows(ctx, nil, iter)
+				require.NoError(b, err)
+				require.Len(b, rows, 16*10000-101)
+			}
+		})
+	}
+}
+
+// newPartitionedBenchtable returns a memory table with the number of partitions given, and a total of the number of
+// rows given for each partition. Rows are distributed across partitions by their ids.
+func newPartitionedBenchtable(partitions, rowsPerPartition int) *memory.Table {
+	table := memory.NewPartitionedTable("test", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "id", Type: types.Int64, Source: "test"},
+		{Name: "name", Type: types.Text, Source: "test"},
+	}), nil, partitions)
+	ctx := sql.NewEmptyContext()
+	inserter := table.Inserter(ctx)
+	for i := 0; i < partitions*rowsPerPartition; i++ {
+		if err := inserter.Insert(ctx, sql.NewRow(int64(i), fmt.Sprint(i))); err != nil {
+			panic(err)
+		}
+	}
+	if err := inserter.Close(ctx); err != nil {
+		panic(err)
+	}
+	return table
+}
+
 func TestExchangeCancelled(t *testing.T) {
 	children := plan.NewProject(
 		[]sql.Expression{
@@ -136,7 +295,7 @@ func TestExchangeIterPartitionRowsPanic(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "panic")
 
-	closedCh := make(chan sql.Row)
+	closedCh := make(chan []sql.Row)
 	close(closedCh)
 	partitions <- Partition("test")
 	err = iterPartitionRows(ctx, func(*sql.Context, sql.Partition) (sql.RowIter, error) {
@@ -146,6 +305,22 @@ func TestExchangeIterPartitionRowsPanic(t *testing.T) {
 	assert.Contains(t, err.Error(), "panic")
 }
 
+func TestOrderedExchangeIterPartitionRowsPanic(t *testing.T) {
+	ctx := sql.NewContext(context.Background())
+	partitions := make(chan orderedPartition, 1)
+	p := orderedPartition{Partition: Partition("test"), rows: make(chan []sql.Row, 1)}
+	partitions <- p
+	err := iterOrderedPartitionRows(ctx, func(*sql.Context, sql.Partition) (sql.RowIter, error) {
+		return &rowIterPanic{}, nil
+	}, partitions)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "panic")
+
+	// The rows of the partition are closed, so that merging them doesn't block
+	_, ok := <-p.rows
+	assert.False(t, ok)
+}
+
 type partitionable struct {
 	sql.Node
 	partitions       int
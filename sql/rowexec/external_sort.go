@@ -0,0 +1,504 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rowexec
+
+import (
+	"bufio"
+	"container/heap"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// sortMergeFanIn is the maximum number of runs merged at once by sorts that spilled their rows to disk. Sorts with more
+// runs than this merge them in several passes.
+const sortMergeFanIn = 16
+
+// sortRun is a file holding rows sorted by a sort that spilled its rows to disk.
+type sortRun struct {
+	f *os.File
+}
+
+// newSortRun writes the sorted rows given to a new temporary file.
+func newSortRun(rows []sql.Row) (*sortRun, error) {
+	run, err := createSortRun()
+	if err != nil {
+		return nil, err
+	}
+	w := bufio.NewWriter(run.f)
+	for _, row := range rows {
+		if err := encodeSortRow(w, row); err != nil {
+			run.remove()
+			return nil, err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		run.remove()
+		return nil, err
+	}
+	return run, nil
+}
+
+func createSortRun() (*sortRun, error) {
+	f, err := os.CreateTemp("", "gms-sort-*")
+	if err != nil {
+		return nil, err
+	}
+	return &sortRun{f: f}, nil
+}
+
+// reader returns a reader of the rows of this run, from the first one.
+func (r *sortRun) reader() (*bufio.Reader, error) {
+	if _, err := r.f.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	return bufio.NewReader(r.f), nil
+}
+
+// remove closes and deletes the file of this run.
+func (r *sortRun) remove() error {
+	err := r.f.Close()
+	if rmErr := os.Remove(r.f.Name()); err == nil {
+		err = rmErr
+	}
+	return err
+}
+
+// sortMerge merges the rows of sorted runs into a single sorted stream. Rows that compare equal are returned in the
+// order of their runs, so merging runs written in input order is as stable as sorting all the rows at once.
+type sortMerge struct {
+	heap    sortMergeHeap
+	readers []*bufio.Reader
+}
+
+func newSortMerge(ctx *sql.Context, sortFields sql.SortFields, runs []*sortRun) (*sortMerge, error) {
+	m := &sortMerge{
+		heap: sortMergeHeap{
+			Sorter: expression.Sorter{SortFields: sortFields, Ctx: ctx},
+		},
+		readers: make([]*bufio.Reader, len(runs)),
+	}
+	for i, run := range runs {
+		r, err := run.reader()
+		if err != nil {
+			return nil, err
+		}
+		m.readers[i] = r
+		if err := m.push(i); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// push adds the next row of the run given to the heap, if it has one.
+func (m *sortMerge) push(run int) error {
+	row, err := decodeSortRow(m.readers[run])
+	if err == io.EOF {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	heap.Push(&m.heap, sortMergeEntry{row: row, run: run})
+	return m.heap.LastError
+}
+
+// next returns the least row of all the runs, or io.EOF when all of them have been exhausted.
+func (m *sortMerge) next() (sql.Row, error) {
+	if m.heap.Len() == 0 {
+		return nil, io.EOF
+	}
+	entry := heap.Pop(&m.heap).(sortMergeEntry)
+	if m.heap.LastError != nil {
+		return nil, m.heap.LastError
+	}
+	if err := m.push(entry.run); err != nil {
+		return nil, err
+	}
+	return entry.row, nil
+}
+
+type sortMergeEntry struct {
+	row sql.Row
+	run int
+}
+
+// sortMergeHeap implements heap.Interface over the next rows of the runs being merged, breaking ties by run.
+type sortMergeHeap struct {
+	expression.Sorter
+	runs []int
+}
+
+func (h *sortMergeHeap) Less(i, j int) bool {
+	if h.Sorter.Less(i, j) {
+		return true
+	} else if h.Sorter.Less(j, i) {
+		return false
+	}
+	return h.runs[i] < h.runs[j]
+}
+
+func (h *sortMergeHeap) Swap(i, j int) {
+	h.Sorter.Swap(i, j)
+	h.runs[i], h.runs[j] = h.runs[j], h.runs[i]
+}
+
+func (h *sortMergeHeap) Push(x interface{}) {
+	entry := x.(sortMergeEntry)
+	h.Sorter.Rows = append(h.Sorter.Rows, entry.row)
+	h.runs = append(h.runs, entry.run)
+}
+
+func (h *sortMergeHeap) Pop() interface{} {
+	n := len(h.runs)
+	entry := sortMergeEntry{row: h.Sorter.Rows[n-1], run: h.runs[n-1]}
+	h.Sorter.Rows = h.Sorter.Rows[:n-1]
+	h.runs = h.runs[:n-1]
+	return entry
+}
+
+// mergeSortRuns merges the runs given until at most sortMergeFanIn are left, merging consecutive runs so that rows
+// that compare equal keep their order. The runs merged are removed.
+func mergeSortRuns(ctx *sql.Context, sortFields sql.SortFields, runs []*sortRun) ([]*sortRun, error) {
+	for len(runs) > sortMergeFanIn {
+		var merged []*sortRun
+		for len(runs) > 0 {
+			n := sortMergeFanIn
+			if n > len(runs) {
+				n = len(runs)
+			}
+			run, err := mergeSortRunsToFile(ctx, sortFields, runs[:n])
+			if err != nil {
+				removeSortRuns(merged)
+				return runs, err
+			}
+			removeSortRuns(runs[:n])
+			runs = runs[n:]
+			merged = append(merged, run)
+		}
+		runs = merged
+		sql.SortMergePasses.Increment(ctx, 1)
+	}
+	return runs, nil
+}
+
+func mergeSortRunsToFile(ctx *sql.Context, sortFields sql.SortFields, runs []*sortRun) (*sortRun, error) {
+	m, err := newSortMerge(ctx, sortFields, runs)
+	if err != nil {
+		return nil, err
+	}
+	run, err := createSortRun()
+	if err != nil {
+		return nil, err
+	}
+	w := bufio.NewWriter(run.f)
+	for i := 0; ; i++ {
+		if i%1024 == 0 {
+			if err := ctx.Err(); err != nil {
+				run.remove()
+				return nil, err
+			}
+		}
+		row, err := m.next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			run.remove()
+			return nil, err
+		}
+		if err := encodeSortRow(w, row); err != nil {
+			run.remove()
+			return nil, err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		run.remove()
+		return nil, err
+	}
+	return run, nil
+}
+
+func removeSortRuns(runs []*sortRun) error {
+	var err error
+	for _, run := range runs {
+		if rmErr := run.remove(); err == nil {
+			err = rmErr
+		}
+	}
+	return err
+}
+
+// estimateSortRowSize returns a rough estimate of the number of bytes of memory held by the row given.
+func estimateSortRowSize(row sql.Row) uint64 {
+	size := uint64(24 + 16*len(row))
+	for _, v := range row {
+		switch v := v.(type) {
+		case string:
+			size += uint64(len(v))
+		case []byte:
+			size += uint64(len(v))
+		case decimal.Decimal, time.Time:
+			size += 24
+		}
+	}
+	return size
+}
+
+// Tags of the values of rows spilled to disk by sorts.
+const (
+	sortTagNull byte = iota
+	sortTagFalse
+	sortTagTrue
+	sortTagInt
+	sortTagInt8
+	sortTagInt16
+	sortTagInt32
+	sortTagInt64
+	sortTagUint
+	sortTagUint8
+	sortTagUint16
+	sortTagUint32
+	sortTagUint64
+	sortTagFloat32
+	sortTagFloat64
+	sortTagString
+	sortTagBytes
+	sortTagTime
+	sortTagDecimal
+	sortTagTimespan
+)
+
+// canSpillSortRow returns whether all the values of the row given can be spilled to disk. Sorts of rows with other
+// values keep all of their rows in memory.
+func canSpillSortRow(row sql.Row) bool {
+	for _, v := range row {
+		switch v.(type) {
+		case nil, bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64,
+			string, []byte, time.Time, decimal.Decimal, types.Timespan:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// encodeSortRow writes the row given as its number of values followed by each value, as a tag and a payload.
+func encodeSortRow(w *bufio.Writer, row sql.Row) error {
+	var buf [binary.MaxVarintLen64]byte
+	putUvarint := func(v uint64) {
+		w.Write(buf[:binary.PutUvarint(buf[:], v)])
+	}
+	putVarint := func(tag byte, v int64) {
+		w.WriteByte(tag)
+		w.Write(buf[:binary.PutVarint(buf[:], v)])
+	}
+	putUint := func(tag byte, v uint64) {
+		w.WriteByte(tag)
+		putUvarint(v)
+	}
+	putBytes := func(tag byte, v []byte) {
+		w.WriteByte(tag)
+		putUvarint(uint64(len(v)))
+		w.Write(v)
+	}
+
+	putUvarint(uint64(len(row)))
+	for _, v := range row {
+		switch v := v.(type) {
+		case nil:
+			w.WriteByte(sortTagNull)
+		case bool:
+			if v {
+				w.WriteByte(sortTagTrue)
+			} else {
+				w.WriteByte(sortTagFalse)
+			}
+		case int:
+			putVarint(sortTagInt, int64(v))
+		case int8:
+			putVarint(sortTagInt8, int64(v))
+		case int16:
+			putVarint(sortTagInt16, int64(v))
+		case int32:
+			putVarint(sortTagInt32, int64(v))
+		case int64:
+			putVarint(sortTagInt64, v)
+		case types.Timespan:
+			putVarint(sortTagTimespan, int64(v))
+		case uint:
+			putUint(sortTagUint, uint64(v))
+		case uint8:
+			putUint(sortTagUint8, uint64(v))
+		case uint16:
+			putUint(sortTagUint16, uint64(v))
+		case uint32:
+			putUint(sortTagUint32, uint64(v))
+		case uint64:
+			putUint(sortTagUint64, v)
+		case float32:
+			w.WriteByte(sortTagFloat32)
+			binary.LittleEndian.PutUint32(buf[:4], math.Float32bits(v))
+			w.Write(buf[:4])
+		case float64:
+			w.WriteByte(sortTagFloat64)
+			binary.LittleEndian.PutUint64(buf[:8], math.Float64bits(v))
+			w.Write(buf[:8])
+		case string:
+			w.WriteByte(sortTagString)
+			putUvarint(uint64(len(v)))
+			w.WriteString(v)
+		case []byte:
+			putBytes(sortTagBytes, v)
+		case time.Time:
+			b, err := v.MarshalBinary()
+			if err != nil {
+				return err
+			}
+			putBytes(sortTagTime, b)
+		case decimal.Decimal:
+			b, err := v.MarshalBinary()
+			if err != nil {
+				return err
+			}
+			putBytes(sortTagDecimal, b)
+		default:
+			return fmt.Errorf("cannot spill value of type %T to disk", v)
+		}
+	}
+	return nil
+}
+
+// decodeSortRow reads a row written by encodeSortRow, returning io.EOF if there are no more rows.
+func decodeSortRow(r *bufio.Reader) (sql.Row, error) {
+	n, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+	row := make(sql.Row, n)
+	for i := range row {
+		if row[i], err = decodeSortValue(r); err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+	}
+	return row, nil
+}
+
+func decodeSortValue(r *bufio.Reader) (interface{}, error) {
+	tag, err := r.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+	readBytes := func() ([]byte, error) {
+		n, err := binary.ReadUvarint(r)
+		if err != nil {
+			return nil, err
+		}
+		b := make([]byte, n)
+		_, err = io.ReadFull(r, b)
+		return b, err
+	}
+
+	switch tag {
+	case sortTagNull:
+		return nil, nil
+	case sortTagFalse:
+		return false, nil
+	case sortTagTrue:
+		return true, nil
+	case sortTagInt, sortTagInt8, sortTagInt16, sortTagInt32, sortTagInt64, sortTagTimespan:
+		v, err := binary.ReadVarint(r)
+		if err != nil {
+			return nil, err
+		}
+		switch tag {
+		case sortTagInt:
+			return int(v), nil
+		case sortTagInt8:
+			return int8(v), nil
+		case sortTagInt16:
+			return int16(v), nil
+		case sortTagInt32:
+			return int32(v), nil
+		case sortTagTimespan:
+			return types.Timespan(v), nil
+		default:
+			return v, nil
+		}
+	case sortTagUint, sortTagUint8, sortTagUint16, sortTagUint32, sortTagUint64:
+		v, err := binary.ReadUvarint(r)
+		if err != nil {
+			return nil, err
+		}
+		switch tag {
+		case sortTagUint:
+			return uint(v), nil
+		case sortTagUint8:
+			return uint8(v), nil
+		case sortTagUint16:
+			return uint16(v), nil
+		case sortTagUint32:
+			return uint32(v), nil
+		default:
+			return v, nil
+		}
+	case sortTagFloat32:
+		var buf [4]byte
+		if _, err := io.ReadFull(r, buf[:]); err != nil {
+			return nil, err
+		}
+		return math.Float32frombits(binary.LittleEndian.Uint32(buf[:])), nil
+	case sortTagFloat64:
+		var buf [8]byte
+		if _, err := io.ReadFull(r, buf[:]); err != nil {
+			return nil, err
+		}
+		return math.Float64frombits(binary.LittleEndian.Uint64(buf[:])), nil
+	case sortTagString:
+		b, err := readBytes()
+		return string(b), err
+	case sortTagBytes:
+		return readBytes()
+	case sortTagTime:
+		b, err := readBytes()
+		if err != nil {
+			return nil, err
+		}
+		var t time.Time
+		err = t.UnmarshalBinary(b)
+		return t, err
+	case sortTagDecimal:
+		b, err := readBytes()
+		if err != nil {
+			return nil, err
+		}
+		var d decimal.Decimal
+		err = d.UnmarshalBinary(b)
+		return d, err
+	default:
+		return nil, fmt.Errorf("invalid tag %d of value spilled to disk", tag)
+	}
+}
@@ -0,0 +1,285 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rowexec
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+func (b *BaseBuilder) buildHandlerOpen(ctx *sql.Context, n *plan.HandlerOpen, row sql.Row) (sql.RowIter, error) {
+	span, ctx := ctx.Span("plan.HandlerOpen")
+	defer span.End()
+
+	if ctx.GetHandler(n.Name) != nil {
+		return nil, sql.ErrDuplicateAliasOrTable.New(n.Name)
+	}
+
+	table := handlerTable(n.Child)
+	if table == nil {
+		return nil, sql.ErrTableNotFound.New(n.Name)
+	}
+	ctx.SetHandler(n.Name, &sql.Handler{
+		Database: plan.GetDatabaseName(n.Child),
+		Table:    table.Name(),
+		Pos:      -1,
+	})
+
+	return sql.RowsToRowIter(), nil
+}
+
+func (b *BaseBuilder) buildHandlerClose(ctx *sql.Context, n *plan.HandlerClose, row sql.Row) (sql.RowIter, error) {
+	if ctx.GetHandler(n.Name) == nil {
+		return nil, sql.ErrUnknownHandler.New(n.Name)
+	}
+	ctx.DelHandler(n.Name)
+	return sql.RowsToRowIter(), nil
+}
+
+// buildHandlerRead reads the rows of a HANDLER ... READ statement. The cursor is positioned over a snapshot of the
+// handler's table, taken whenever the statement positions the cursor anew: for FIRST, LAST and key reads, and for
+// NEXT and PREV when the cursor hasn't been positioned on the index read yet. NEXT and PREV otherwise continue from
+// the row last read in the snapshot.
+func (b *BaseBuilder) buildHandlerRead(ctx *sql.Context, n *plan.HandlerRead, row sql.Row) (sql.RowIter, error) {
+	span, ctx := ctx.Span("plan.HandlerRead")
+	defer span.End()
+
+	h := ctx.GetHandler(n.Name)
+	if h == nil {
+		return nil, sql.ErrUnknownHandler.New(n.Name)
+	}
+
+	limit, err := getInt64Value(ctx, n.Limit)
+	if err != nil {
+		return nil, err
+	}
+	var offset int64
+	if n.Offset != nil {
+		if offset, err = getInt64Value(ctx, n.Offset); err != nil {
+			return nil, err
+		}
+	}
+
+	cols, err := handlerIndexColumns(ctx, n)
+	if err != nil {
+		return nil, err
+	}
+	var key sql.Row
+	if n.Mode.IsKeyed() {
+		if len(n.Key) > len(cols) {
+			return nil, sql.ErrHandlerTooManyKeyParts.New(len(cols))
+		}
+		key = make(sql.Row, len(n.Key))
+		for i, e := range n.Key {
+			if key[i], err = e.Eval(ctx, row); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	schema := n.Child.Schema()
+	compareKey := func(r sql.Row) (int, error) {
+		for i, k := range key {
+			cmp, err := compareHandlerValues(schema[cols[i]].Type, r[cols[i]], k)
+			if err != nil || cmp != 0 {
+				return cmp, err
+			}
+		}
+		return 0, nil
+	}
+
+	repositioned := n.Mode != plan.HandlerReadNext && n.Mode != plan.HandlerReadPrev
+	if repositioned || h.Rows == nil || !strings.EqualFold(h.Index, n.Index) {
+		rows, err := b.handlerRows(ctx, n, cols, row)
+		if err != nil {
+			return nil, err
+		}
+		h.Index, h.Rows = n.Index, rows
+		h.Pos = -1
+		if !n.Mode.Forward() {
+			h.Pos = len(rows)
+		}
+	}
+
+	dir := 1
+	if !n.Mode.Forward() {
+		dir = -1
+	}
+	switch n.Mode {
+	case plan.HandlerReadEqual, plan.HandlerReadGreaterOrEqual, plan.HandlerReadGreater:
+		// Position the cursor before the first row whose key is at least (or more than) the key given
+		h.Pos = sort.Search(len(h.Rows), func(i int) bool {
+			cmp, cerr := compareKey(h.Rows[i])
+			if cerr != nil {
+				err = cerr
+			}
+			return cmp > 0 || cmp == 0 && n.Mode != plan.HandlerReadGreater
+		}) - 1
+	case plan.HandlerReadLessOrEqual, plan.HandlerReadLess:
+		// Position the cursor after the last row whose key is at most (or less than) the key given
+		h.Pos = sort.Search(len(h.Rows), func(i int) bool {
+			cmp, cerr := compareKey(h.Rows[i])
+			if cerr != nil {
+				err = cerr
+			}
+			return cmp > 0 || cmp == 0 && n.Mode == plan.HandlerReadLess
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var result []sql.Row
+	for int64(len(result)) < limit {
+		i := h.Pos + dir
+		if i < 0 {
+			h.Pos = -1
+			break
+		} else if i >= len(h.Rows) {
+			h.Pos = len(h.Rows)
+			break
+		}
+		if n.Mode == plan.HandlerReadEqual {
+			if cmp, err := compareKey(h.Rows[i]); err != nil {
+				return nil, err
+			} else if cmp != 0 {
+				break
+			}
+		}
+		h.Pos = i
+
+		if n.Where != nil {
+			res, err := sql.EvaluateCondition(ctx, n.Where, h.Rows[i])
+			if err != nil {
+				return nil, err
+			}
+			if !sql.IsTrue(res) {
+				continue
+			}
+		}
+		if offset > 0 {
+			offset--
+			continue
+		}
+		result = append(result, h.Rows[i])
+	}
+
+	return sql.RowsToRowIter(result...), nil
+}
+
+// handlerRows returns the rows of the table read by the HANDLER ... READ given, sorted on the index columns given
+// with NULL first, or in the table's natural order if there are none.
+func (b *BaseBuilder) handlerRows(ctx *sql.Context, n *plan.HandlerRead, cols []int, row sql.Row) ([]sql.Row, error) {
+	iter, err := b.buildNodeExec(ctx, n.Child, row)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := sql.RowIterToRows(ctx, nil, iter)
+	if err != nil || len(cols) == 0 {
+		return rows, err
+	}
+
+	schema := n.Child.Schema()
+	sort.SliceStable(rows, func(i, j int) bool {
+		for _, col := range cols {
+			cmp, cerr := compareHandlerValues(schema[col].Type, rows[i][col], rows[j][col])
+			if cerr != nil {
+				err = cerr
+				return false
+			}
+			if cmp != 0 {
+				return cmp < 0
+			}
+		}
+		return false
+	})
+	return rows, err
+}
+
+// handlerIndexColumns returns the positions in the table's schema of the columns of the index read by the
+// HANDLER ... READ given, or nil if it reads the table in its natural order.
+func handlerIndexColumns(ctx *sql.Context, n *plan.HandlerRead) ([]int, error) {
+	if n.Index == "" {
+		return nil, nil
+	}
+
+	table := handlerTable(n.Child)
+	var indexes []sql.Index
+	if table, ok := table.(sql.IndexAddressable); ok {
+		var err error
+		if indexes, err = table.GetIndexes(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	schema := n.Child.Schema()
+	for _, idx := range indexes {
+		if !strings.EqualFold(idx.ID(), n.Index) {
+			continue
+		}
+		exprs := idx.Expressions()
+		cols := make([]int, len(exprs))
+		for i, expr := range exprs {
+			colName := expr[strings.LastIndex(expr, ".")+1:]
+			if cols[i] = schema.IndexOfColName(colName); cols[i] < 0 {
+				return nil, sql.ErrUnknownIndexColumn.New(colName, idx.ID())
+			}
+		}
+		return cols, nil
+	}
+
+	// Tables that don't expose their primary key as an index can still be read in its order
+	if pkTable, ok := table.(sql.PrimaryKeyTable); ok && strings.EqualFold(n.Index, "PRIMARY") {
+		if ordinals := pkTable.PrimaryKeySchema().PkOrdinals; len(ordinals) > 0 {
+			return ordinals, nil
+		}
+	}
+	return nil, sql.ErrHandlerKeyDoesNotExist.New(n.Index, n.Name)
+}
+
+// handlerTable returns the table of the handler's node given, or nil if it has none.
+func handlerTable(n sql.Node) sql.Table {
+	var table sql.Table
+	transform.Inspect(n, func(node sql.Node) bool {
+		if rt, ok := node.(*plan.ResolvedTable); ok {
+			table = rt.Table
+			if tw, ok := table.(sql.TableWrapper); ok {
+				table = tw.Underlying()
+			}
+			return false
+		}
+		return true
+	})
+	return table
+}
+
+// compareHandlerValues compares two values of the index column type given, ordering NULL first as indexes do.
+func compareHandlerValues(typ sql.Type, a, b interface{}) (int, error) {
+	if a == nil || b == nil {
+		switch {
+		case a == nil && b == nil:
+			return 0, nil
+		case a == nil:
+			return -1, nil
+		default:
+			return 1, nil
+		}
+	}
+	return typ.Compare(a, b)
+}
@@ -33,6 +33,8 @@ type insertIter struct {
 	schema              sql.Schema
 	inserter            sql.RowInserter
 	replacer            sql.RowReplacer
+	replaceHandler      *replaceRowHandler
+	deleteTriggers      []*plan.TriggerExecutor
 	updater             sql.RowUpdater
 	rowSource           sql.RowIter
 	lastInsertIdUpdated bool
@@ -43,6 +45,7 @@ type insertIter struct {
 	tableNode           sql.Node
 	closed              bool
 	ignore              bool
+	b                   *BaseBuilder
 }
 
 func getInsertExpressions(values sql.Node) []sql.Expression {
@@ -108,7 +111,7 @@ func (i *insertIter) Next(ctx *sql.Context) (returnRow sql.Row, returnErr error)
 						row[idx] = converted
 						// Add a warning instead
 						ctx.Session.Warn(&sql.Warning{
-							Level:   "Note",
+							Level:   "Warning",
 							Code:    sql.CastSQLError(cErr).Num,
 							Message: cErr.Error(),
 						})
@@ -136,7 +139,7 @@ func (i *insertIter) Next(ctx *sql.Context) (returnRow sql.Row, returnErr error)
 			toReturn[i+len(row)] = row[i]
 		}
 		// May have multiple duplicate pk & unique errors due to multiple indexes
-		//TODO: how does this interact with triggers?
+		deleted := false
 		for {
 			if err := i.replacer.Insert(ctx, row); err != nil {
 				if !sql.ErrPrimaryKeyViolation.Is(err) && !sql.ErrUniqueKeyViolation.Is(err) {
@@ -146,12 +149,27 @@ func (i *insertIter) Next(ctx *sql.Context) (returnRow sql.Row, returnErr error)
 				}
 
 				ue := err.(*errors.Error).Cause().(sql.UniqueKeyError)
+				if err = i.runDeleteTriggers(plan.BeforeTrigger, ue.Existing); err != nil {
+					i.rowSource.Close(ctx)
+					i.rowSource = nil
+					return nil, err
+				}
 				if err = i.replacer.Delete(ctx, ue.Existing); err != nil {
 					i.rowSource.Close(ctx)
 					i.rowSource = nil
 					return nil, sql.NewWrappedInsertError(row, err)
 				}
-				// the row had to be deleted, write the values into the toReturn row
+				if err = i.runDeleteTriggers(plan.AfterTrigger, ue.Existing); err != nil {
+					i.rowSource.Close(ctx)
+					i.rowSource = nil
+					return nil, err
+				}
+				// the row had to be deleted, write the values into the toReturn row. Every row deleted after the first one
+				// is counted directly, since the returned row only has room for one.
+				if deleted && i.replaceHandler != nil {
+					i.replaceHandler.rowDeleted()
+				}
+				deleted = true
 				for i := 0; i < len(ue.Existing); i++ {
 					toReturn[i] = ue.Existing[i]
 				}
@@ -176,6 +194,19 @@ func (i *insertIter) Next(ctx *sql.Context) (returnRow sql.Row, returnErr error)
 	return row, nil
 }
 
+// runDeleteTriggers runs the delete triggers of a REPLACE with the trigger time given for a row it deletes.
+func (i *insertIter) runDeleteTriggers(triggerTime plan.TriggerTime, deleted sql.Row) error {
+	for _, trigger := range i.deleteTriggers {
+		if trigger.TriggerTime != triggerTime {
+			continue
+		}
+		if _, _, err := i.b.runTriggerLogic(i.ctx, trigger.Right(), deleted); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (i *insertIter) handleOnDuplicateKeyUpdate(ctx *sql.Context, row, rowToUpdate sql.Row) (returnRow sql.Row, returnErr error) {
 	err := i.resolveValues(ctx, row)
 	if err != nil {
@@ -330,7 +361,7 @@ func convertDataAndWarn(ctx *sql.Context, tableSchema sql.Schema, row sql.Row, c
 
 	// Add a warning instead
 	ctx.Session.Warn(&sql.Warning{
-		Level:   "Note",
+		Level:   "Warning",
 		Code:    sqlerr.Num,
 		Message: err.Error(),
 	})
@@ -346,7 +377,7 @@ func warnOnIgnorableError(ctx *sql.Context, row sql.Row, err error) error {
 
 			// Add a warning instead
 			ctx.Session.Warn(&sql.Warning{
-				Level:   "Note",
+				Level:   "Warning",
 				Code:    sqlerr.Num,
 				Message: err.Error(),
 			})
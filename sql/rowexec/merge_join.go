@@ -138,6 +138,33 @@ func (i *mergeJoinIter) sel(ctx *sql.Context, row sql.Row) (bool, error) {
 	return true, nil
 }
 
+// compare evaluates the merge condition on |row|, returning whether the left row sorts before, with, or after the
+// right row. Index scans return NULL before any other value, so a NULL-safe condition orders NULL first to keep
+// both iterators in step; NULL keys then match each other.
+func (i *mergeJoinIter) compare(ctx *sql.Context, row sql.Row) (int, error) {
+	if _, ok := i.cmp.(*expression.NullSafeEquals); !ok {
+		return i.cmp.Compare(ctx, row)
+	}
+	left, err := i.cmp.Left().Eval(ctx, row)
+	if err != nil {
+		return 0, err
+	}
+	right, err := i.cmp.Right().Eval(ctx, row)
+	if err != nil {
+		return 0, err
+	}
+	switch {
+	case left == nil && right == nil:
+		return 0, nil
+	case left == nil:
+		return -1, nil
+	case right == nil:
+		return 1, nil
+	default:
+		return i.cmp.Compare(ctx, row)
+	}
+}
+
 type mergeState uint8
 
 const (
@@ -201,7 +228,7 @@ func (i *mergeJoinIter) Next(ctx *sql.Context) (sql.Row, error) {
 				nextState = msCompare
 			}
 		case msCompare:
-			res, err = i.cmp.Compare(ctx, i.fullRow)
+			res, err = i.compare(ctx, i.fullRow)
 			if expression.ErrNilOperand.Is(err) {
 				nextState = msRejectNull
 				break
@@ -459,7 +486,7 @@ func (i *mergeJoinIter) peekMatch(ctx *sql.Context, iter sql.RowIter) (bool, sql
 
 	// check if lookahead valid
 	copySubslice(i.fullRow, peek, off)
-	res, err := i.cmp.Compare(ctx, i.fullRow)
+	res, err := i.compare(ctx, i.fullRow)
 	if expression.ErrNilOperand.Is(err) {
 		// revert change to output row if no match
 		copySubslice(i.fullRow, restore, off)
@@ -39,6 +39,8 @@ func (b *BaseBuilder) buildNodeExec(ctx *sql.Context, n sql.Node, row sql.Row) (
 		return b.buildDropColumn(ctx, n, row)
 	case *plan.AnalyzeTable:
 		return b.buildAnalyzeTable(ctx, n, row)
+	case *plan.CheckTable:
+		return b.buildCheckTable(ctx, n, row)
 	case *plan.QueryProcess:
 		return b.buildQueryProcess(ctx, n, row)
 	case *plan.ShowReplicaStatus:
@@ -109,6 +111,8 @@ func (b *BaseBuilder) buildNodeExec(ctx *sql.Context, n sql.Node, row sql.Row) (
 		return b.buildUnion(ctx, n, row)
 	case *plan.IndexedTableAccess:
 		return b.buildIndexedTableAccess(ctx, n, row)
+	case *plan.IndexMerge:
+		return b.buildIndexMerge(ctx, n, row)
 	case *plan.TableAlias:
 		return b.buildTableAlias(ctx, n, row)
 	case *plan.AddColumn:
@@ -131,6 +135,12 @@ func (b *BaseBuilder) buildNodeExec(ctx *sql.Context, n sql.Node, row sql.Row) (
 		return b.buildInto(ctx, n, row)
 	case *plan.LockTables:
 		return b.buildLockTables(ctx, n, row)
+	case *plan.HandlerOpen:
+		return b.buildHandlerOpen(ctx, n, row)
+	case *plan.HandlerRead:
+		return b.buildHandlerRead(ctx, n, row)
+	case *plan.HandlerClose:
+		return b.buildHandlerClose(ctx, n, row)
 	case *plan.Truncate:
 		return b.buildTruncate(ctx, n, row)
 	case *plan.DeclareHandler:
@@ -265,6 +275,8 @@ func (b *BaseBuilder) buildNodeExec(ctx *sql.Context, n sql.Node, row sql.Row) (
 		return b.buildInsertInto(ctx, n, row)
 	case *plan.TopN:
 		return b.buildTopN(ctx, n, row)
+	case *plan.ReservoirSample:
+		return b.buildReservoirSample(ctx, n, row)
 	case *plan.Window:
 		return b.buildWindow(ctx, n, row)
 	case *plan.DropCheck:
@@ -301,6 +313,10 @@ func (b *BaseBuilder) buildNodeExec(ctx *sql.Context, n sql.Node, row sql.Row) (
 		return b.buildDropConstraint(ctx, n, row)
 	case *plan.FlushPrivileges:
 		return b.buildFlushPrivileges(ctx, n, row)
+	case *plan.FlushLogs:
+		return b.buildFlushLogs(ctx, n, row)
+	case *plan.FlushStatus:
+		return b.buildFlushStatus(ctx, n, row)
 	case *plan.Leave:
 		return b.buildLeave(ctx, n, row)
 	case *plan.While:
@@ -158,6 +158,10 @@ func (b *BaseBuilder) buildExchange(ctx *sql.Context, n *plan.Exchange, row sql.
 		return nil, err
 	}
 
+	if n.Ordered {
+		return b.buildOrderedExchange(ctx, n, row, partitions)
+	}
+
 	// How this is structured is a little subtle. A top-level
 	// errgroup run |iterPartitions| and listens on the shutdown
 	// hook.  A different, dependent, errgroup runs
@@ -167,7 +171,7 @@ func (b *BaseBuilder) buildExchange(ctx *sql.Context, n *plan.Exchange, row sql.
 	// goroutines are completed.
 
 	partitionsCh := make(chan sql.Partition)
-	rowsCh := make(chan sql.Row, n.Parallelism*16)
+	rowsCh := make(chan []sql.Row, n.Parallelism)
 
 	eg, egCtx := ctx.NewErrgroup()
 	eg.Go(func() error {
@@ -203,6 +207,54 @@ func (b *BaseBuilder) buildExchange(ctx *sql.Context, n *plan.Exchange, row sql.
 	return &exchangeRowIter{shutdownHook: shutdownHook, waiter: waiter, rows: rowsCh}, nil
 }
 
+// buildOrderedExchange is like buildExchange, but returns the rows of the
+// partitions in the order |partitions| returns them. Every partition handed
+// to a worker gets its own channel of rows, which are sent in partition
+// order to a merging goroutine through |orderCh|. The merging goroutine
+// drains them one after the other into |rowsCh|. Workers block on the
+// channel of their partition until the partitions before it are drained,
+// so at most |n.Parallelism| partitions are buffered at once.
+func (b *BaseBuilder) buildOrderedExchange(ctx *sql.Context, n *plan.Exchange, row sql.Row, partitions sql.PartitionIter) (sql.RowIter, error) {
+	partitionsCh := make(chan orderedPartition)
+	orderCh := make(chan chan []sql.Row, n.Parallelism)
+	rowsCh := make(chan []sql.Row, n.Parallelism)
+
+	eg, egCtx := ctx.NewErrgroup()
+	eg.Go(func() error {
+		defer close(orderCh)
+		defer close(partitionsCh)
+		return iterOrderedPartitions(egCtx, partitions, partitionsCh, orderCh)
+	})
+
+	getRowIter := b.exchangeIterGen(n, row)
+	seg, segCtx := egCtx.NewErrgroup()
+	for i := 0; i < n.Parallelism; i++ {
+		seg.Go(func() error {
+			return iterOrderedPartitionRows(segCtx, getRowIter, partitionsCh)
+		})
+	}
+
+	// Unlike in buildExchange, the workers being done doesn't mean that
+	// we're EOF: the merging goroutine may still have rows to send. We're
+	// EOF once it sent every row and every worker returned |nil|.
+	eg.Go(seg.Wait)
+	eg.Go(func() error {
+		defer close(rowsCh)
+		err := mergeOrderedPartitionRows(egCtx, orderCh, rowsCh)
+		if err != nil {
+			return err
+		}
+		if err = seg.Wait(); err != nil {
+			return err
+		}
+		return io.EOF
+	})
+
+	waiter := func() error { return eg.Wait() }
+	shutdownHook := newShutdownHook(eg, egCtx)
+	return &exchangeRowIter{shutdownHook: shutdownHook, waiter: waiter, rows: rowsCh}, nil
+}
+
 func (b *BaseBuilder) buildExchangePartition(ctx *sql.Context, n *plan.ExchangePartition, row sql.Row) (sql.RowIter, error) {
 	return n.Table.PartitionRows(ctx, n.Partition)
 }
@@ -243,6 +295,9 @@ func (b *BaseBuilder) buildCachedResults(ctx *sql.Context, n *plan.CachedResults
 	return &cachedResultsIter{n, ci, cache, dispose}, nil
 }
 
+// buildBlock runs all the statements of the block, and returns the rows of the last SELECT statement, or of the last
+// statement if there are none. MySQL returns a result set for every SELECT statement of a procedure, so the rows of
+// each SELECT statement are instead given to the ResultSetWriter of the context, if it has one.
 func (b *BaseBuilder) buildBlock(ctx *sql.Context, n *plan.Block, row sql.Row) (sql.RowIter, error) {
 	var returnRows []sql.Row
 	var returnNode sql.Node
@@ -261,10 +316,13 @@ func (b *BaseBuilder) buildBlock(ctx *sql.Context, n *plan.Block, row sql.Row) (
 			}
 			subIterNode := s
 			subIterSch := s.Schema()
-			if blockSubIter, ok := subIter.(plan.BlockRowIter); ok {
+			blockSubIter, isBlockIter := subIter.(plan.BlockRowIter)
+			if isBlockIter {
 				subIterNode = blockSubIter.RepresentingNode()
 				subIterSch = blockSubIter.Schema()
 			}
+			// The SELECT statements of nested blocks are written by the blocks themselves
+			_, isBlock := s.(plan.RepresentsBlock)
 			if isSelect = plan.NodeRepresentsSelect(subIterNode); isSelect {
 				selectSeen = true
 				returnNode = subIterNode
@@ -274,6 +332,15 @@ func (b *BaseBuilder) buildBlock(ctx *sql.Context, n *plan.Block, row sql.Row) (
 				returnSch = subIterSch
 			}
 
+			if w := ctx.ResultSetWriter(); w != nil && isSelect && !isBlock && !isBlockIter {
+				err = w.WriteResultSet(ctx, subIterSch, subIter)
+				if closeErr := subIter.Close(ctx); err == nil {
+					err = closeErr
+				}
+				returnRows = nil
+				return err
+			}
+
 			for {
 				newRow, err := subIter.Next(ctx)
 				if err == io.EOF {
@@ -283,6 +350,10 @@ func (b *BaseBuilder) buildBlock(ctx *sql.Context, n *plan.Block, row sql.Row) (
 					}
 					if isSelect || !selectSeen {
 						returnRows = rowCache.Get()
+						// Loops run their iterations as they're iterated, which sets their schema
+						if !isBlockIter {
+							returnSch = s.Schema()
+						}
 					}
 					break
 				} else if err != nil {
@@ -357,10 +428,43 @@ func (b *BaseBuilder) buildQueryProcess(ctx *sql.Context, n *plan.QueryProcess,
 	trackedIter := plan.NewTrackedRowIter(n.Child(), iter, nil, n.Notify)
 	trackedIter.QueryType = qType
 	trackedIter.ShouldSetFoundRows = qType == plan.QueryTypeSelect && n.ShouldSetFoundRows()
+	trackedIter.OnRowCount = func(delta int64) {
+		ctx.ProcessList.UpdateRowsSent(ctx.Pid(), delta)
+	}
 
 	return trackedIter, nil
 }
 
+func (b *BaseBuilder) buildCheckTable(ctx *sql.Context, n *plan.CheckTable, row sql.Row) (sql.RowIter, error) {
+	var rows []sql.Row
+	for _, child := range n.Tables {
+		table := handlerTable(child)
+		if table == nil {
+			return nil, sql.ErrTableNotFound.New(child.String())
+		}
+		name := fmt.Sprintf("%s.%s", plan.GetDatabaseName(child), table.Name())
+
+		verifiable, ok := table.(sql.VerifiableTable)
+		if !ok {
+			rows = append(rows, sql.NewRow(name, "check", "note", "The storage engine for the table doesn't support check"))
+			continue
+		}
+		problems, err := verifiable.Verify(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, problem := range problems {
+			rows = append(rows, sql.NewRow(name, "check", "error", problem))
+		}
+		status := "OK"
+		if len(problems) > 0 {
+			status = "Corrupt"
+		}
+		rows = append(rows, sql.NewRow(name, "check", "status", status))
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
+
 func (b *BaseBuilder) buildAnalyzeTable(ctx *sql.Context, n *plan.AnalyzeTable, row sql.Row) (sql.RowIter, error) {
 	// Assume table is in current database
 	database := ctx.GetCurrentDatabase()
@@ -208,11 +208,12 @@ type rowIterPartitionFunc func(ctx *sql.Context, partition sql.Partition) (sql.R
 // iterPartitionRows is the parallel worker for an Exchange node. It
 // is meant to be run as a goroutine in an errgroup.Group. It will
 // values read off of |partitions|. For each value it reads, it will
-// call |getRowIter| to get a row projectIter, and will then call |Next| on
-// that row projectIter, passing every row it gets into |rows|. If it
-// receives an error at any point, it returns it. |iterPartitionRows|
-// stops iterating and returns |nil| when |partitions| is closed.
-func iterPartitionRows(ctx *sql.Context, getRowIter rowIterPartitionFunc, partitions <-chan sql.Partition, rows chan<- sql.Row) (rerr error) {
+// call |getRowIter| to get a row projectIter, and will then read that
+// row projectIter in batches, passing every batch it gets into |rows|.
+// If it receives an error at any point, it returns it.
+// |iterPartitionRows| stops iterating and returns |nil| when
+// |partitions| is closed.
+func iterPartitionRows(ctx *sql.Context, getRowIter rowIterPartitionFunc, partitions <-chan sql.Partition, rows chan<- []sql.Row) (rerr error) {
 	defer func() {
 		if r := recover(); r != nil {
 			rerr = fmt.Errorf("panic in ExchangeIterPartitionRows: %v", r)
@@ -241,30 +242,133 @@ func iterPartitionRows(ctx *sql.Context, getRowIter rowIterPartitionFunc, partit
 	}
 }
 
-func sendAllRows(ctx *sql.Context, iter sql.RowIter, rows chan<- sql.Row) (rowCount int, rerr error) {
+// sendAllRows reads |iter| in batches and sends each batch to |rows|,
+// so that the channel is used once per batch rather than once per row.
+func sendAllRows(ctx *sql.Context, iter sql.RowIter, rows chan<- []sql.Row) (rowCount int, rerr error) {
 	defer func() {
 		cerr := iter.Close(ctx)
 		if rerr == nil {
 			rerr = cerr
 		}
 	}()
+	batches := sql.NewBatchRowIter(iter)
 	for {
-		r, err := iter.Next(ctx)
+		batch, err := batches.NextBatch(ctx)
 		if err == io.EOF {
 			return rowCount, nil
 		}
 		if err != nil {
 			return rowCount, err
 		}
-		rowCount++
+		rowCount += len(batch)
+		// The batch is only valid until the next call to NextBatch
+		batch = append([]sql.Row(nil), batch...)
 		select {
-		case rows <- r:
+		case rows <- batch:
 		case <-ctx.Done():
 			return rowCount, ctx.Err()
 		}
 	}
 }
 
+// orderedPartition is a partition of an ordered exchange, along with the
+// channel its rows are sent to.
+type orderedPartition struct {
+	sql.Partition
+	rows chan []sql.Row
+}
+
+// iterOrderedPartitions is like iterPartitions, but also sends the channel
+// of the rows of every partition to |order| before handing the partition to
+// a worker, so that the rows can be merged in partition order.
+func iterOrderedPartitions(ctx *sql.Context, iter sql.PartitionIter, partitions chan<- orderedPartition, order chan<- chan []sql.Row) (rerr error) {
+	defer func() {
+		if r := recover(); r != nil {
+			rerr = fmt.Errorf("panic in iterOrderedPartitions: %v", r)
+		}
+	}()
+	defer func() {
+		cerr := iter.Close(ctx)
+		if rerr == nil {
+			rerr = cerr
+		}
+	}()
+	for {
+		p, err := iter.Next(ctx)
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		op := orderedPartition{Partition: p, rows: make(chan []sql.Row, 1)}
+		select {
+		case order <- op.rows:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		select {
+		case partitions <- op:
+		case <-ctx.Done():
+			close(op.rows)
+			return ctx.Err()
+		}
+	}
+}
+
+// iterOrderedPartitionRows is the parallel worker for an ordered Exchange
+// node. Like iterPartitionRows, but it sends the rows of each partition to
+// the partition's own channel, and closes it when done with the partition,
+// even if it fails.
+func iterOrderedPartitionRows(ctx *sql.Context, getRowIter rowIterPartitionFunc, partitions <-chan orderedPartition) (rerr error) {
+	defer func() {
+		if r := recover(); r != nil {
+			rerr = fmt.Errorf("panic in ExchangeIterOrderedPartitionRows: %v", r)
+		}
+	}()
+	for {
+		select {
+		case p, ok := <-partitions:
+			if !ok {
+				return nil
+			}
+			err := func() error {
+				defer close(p.rows)
+				span, ctx := ctx.Span("exchange.IterPartition")
+				defer span.End()
+				iter, err := getRowIter(ctx, p.Partition)
+				if err != nil {
+					return err
+				}
+				count, err := sendAllRows(ctx, iter, p.rows)
+				span.SetAttributes(attribute.Int("num_rows", count))
+				return err
+			}()
+			if err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// mergeOrderedPartitionRows sends the rows of the partitions of an ordered
+// exchange to |rows|, draining the channel of each partition in the order
+// they're read off of |order|.
+func mergeOrderedPartitionRows(ctx *sql.Context, order <-chan chan []sql.Row, rows chan<- []sql.Row) error {
+	for partitionRows := range order {
+		for batch := range partitionRows {
+			select {
+			case rows <- batch:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+	return nil
+}
+
 func (b *BaseBuilder) exchangeIterGen(e *plan.Exchange, row sql.Row) func(*sql.Context, sql.Partition) (sql.RowIter, error) {
 	return func(ctx *sql.Context, partition sql.Partition) (sql.RowIter, error) {
 		node, _, err := transform.Node(e.Child, func(n sql.Node) (sql.Node, transform.TreeIdentity, error) {
@@ -289,23 +393,46 @@ func (b *BaseBuilder) exchangeIterGen(e *plan.Exchange, row sql.Row) func(*sql.C
 type exchangeRowIter struct {
 	shutdownHook func()
 	waiter       func() error
-	rows         <-chan sql.Row
-	rows2        <-chan sql.Row2
+	rows         <-chan []sql.Row
+	// batch holds the rows of the last batch read off of |rows| that
+	// haven't been returned by |Next| yet.
+	batch []sql.Row
 }
 
-var _ sql.RowIter = (*exchangeRowIter)(nil)
+var _ sql.BatchRowIter = (*exchangeRowIter)(nil)
 
 func (i *exchangeRowIter) Next(ctx *sql.Context) (sql.Row, error) {
-	if i.rows == nil {
-		panic("Next called for a Next2 iterator")
-	}
-	r, ok := <-i.rows
-	if !ok {
-		return nil, i.waiter()
+	for len(i.batch) == 0 {
+		batch, ok := <-i.rows
+		if !ok {
+			return nil, i.waiter()
+		}
+		i.batch = batch
 	}
+	r := i.batch[0]
+	i.batch = i.batch[1:]
 	return r, nil
 }
 
+// NextBatch implements the sql.BatchRowIter interface, returning the
+// batches read by the workers as they are.
+func (i *exchangeRowIter) NextBatch(ctx *sql.Context) ([]sql.Row, error) {
+	if len(i.batch) > 0 {
+		batch := i.batch
+		i.batch = nil
+		return batch, nil
+	}
+	for {
+		batch, ok := <-i.rows
+		if !ok {
+			return nil, i.waiter()
+		}
+		if len(batch) > 0 {
+			return batch, nil
+		}
+	}
+}
+
 func (i *exchangeRowIter) Close(ctx *sql.Context) error {
 	i.shutdownHook()
 	err := i.waiter()
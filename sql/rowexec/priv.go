@@ -29,7 +29,7 @@ func (b *BaseBuilder) buildFlushPrivileges(ctx *sql.Context, n *plan.FlushPrivil
 	if !ok {
 		return nil, sql.ErrDatabaseNotFound.New("mysql")
 	}
-	err := gts.Persist(ctx)
+	err := gts.Reload(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -37,6 +37,21 @@ func (b *BaseBuilder) buildFlushPrivileges(ctx *sql.Context, n *plan.FlushPrivil
 	return sql.RowsToRowIter(sql.Row{types.NewOkResult(0)}), nil
 }
 
+func (b *BaseBuilder) buildFlushLogs(ctx *sql.Context, n *plan.FlushLogs, row sql.Row) (sql.RowIter, error) {
+	if lf, ok := n.Catalog.(sql.LogFlusher); ok {
+		if err := lf.FlushLogs(ctx, n.Kind); err != nil {
+			return nil, err
+		}
+	}
+
+	return sql.RowsToRowIter(sql.Row{types.NewOkResult(0)}), nil
+}
+
+func (b *BaseBuilder) buildFlushStatus(ctx *sql.Context, n *plan.FlushStatus, row sql.Row) (sql.RowIter, error) {
+	sql.FlushSessionStatus(ctx.Session)
+	return sql.RowsToRowIter(sql.Row{types.NewOkResult(0)}), nil
+}
+
 func (b *BaseBuilder) buildDropUser(ctx *sql.Context, n *plan.DropUser, row sql.Row) (sql.RowIter, error) {
 	mysqlDb, ok := n.MySQLDb.(*mysql_db.MySQLDb)
 	if !ok {
@@ -15,6 +15,7 @@
 package rowexec
 
 import (
+	"fmt"
 	"io"
 	"testing"
 
@@ -75,9 +76,173 @@ func TestProject(t *testing.T) {
 	require.Equal(schema.Schema, p.Schema())
 }
 
+func TestProjectReusedRows(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	child := memory.NewTable("test", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "strfield", Type: types.Text, Nullable: true, Source: "test"},
+		{Name: "floatfield", Type: types.Float64, Nullable: true, Source: "test"},
+		{Name: "intfield", Type: types.Int32, Nullable: false, Source: "test"},
+		{Name: "bigintfield", Type: types.Int64, Nullable: false, Source: "test"},
+	}), nil)
+	for i := 0; i < 100; i++ {
+		require.NoError(child.Insert(ctx, sql.NewRow(fmt.Sprint(i), float64(i), int32(i), int64(i*2))))
+	}
+
+	// The table is projected and filtered, so that the scan, filter and projection all take part in reusing rows
+	table := child.WithProjections([]string{"strfield", "intfield", "bigintfield"})
+	node := plan.NewProject([]sql.Expression{
+		expression.NewGetField(2, types.Int64, "bigintfield", false),
+		expression.NewArithmetic(
+			expression.NewGetField(1, types.Int32, "intfield", false),
+			expression.NewLiteral(int32(1), types.Int32),
+			"+",
+		),
+		expression.NewGetField(0, types.Text, "strfield", true),
+	}, plan.NewFilter(
+		expression.NewGreaterThan(
+			expression.NewGetField(1, types.Int32, "intfield", false),
+			expression.NewLiteral(int32(49), types.Int32),
+		),
+		plan.NewResolvedTable(table, nil, nil),
+	))
+
+	iter, err := DefaultBuilder.Build(ctx, node, nil)
+	require.NoError(err)
+	expected, err := sql.RowIterToRows(ctx, nil, iter)
+	require.NoError(err)
+	require.Len(expected, 50)
+
+	iter, err = DefaultBuilder.Build(ctx, node, nil)
+	require.NoError(err)
+	sql.ReuseRows(iter)
+	var rows []sql.Row
+	var prev sql.Row
+	for {
+		row, err := iter.Next(ctx)
+		if err == io.EOF {
+			break
+		}
+		require.NoError(err)
+		if prev != nil {
+			require.Same(&prev[0], &row[0], "rows should share a buffer")
+		}
+		prev = row
+		rows = append(rows, row.Copy())
+	}
+	require.NoError(iter.Close(ctx))
+	require.Equal(expected, rows)
+
+	// Rows are only reused when opted into, so rows kept by other callers are never overwritten
+	iter, err = DefaultBuilder.Build(ctx, node, nil)
+	require.NoError(err)
+	rows, err = sql.RowIterToRows(ctx, nil, iter)
+	require.NoError(err)
+	require.Equal(expected, rows)
+}
+
+func TestProjectBatches(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+
+	// Enough rows for several batches, the last of them partial
+	child := memory.NewPartitionedTable("test", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "strfield", Type: types.Text, Nullable: true, Source: "test"},
+		{Name: "intfield", Type: types.Int32, Nullable: false, Source: "test"},
+		{Name: "bigintfield", Type: types.Int64, Nullable: false, Source: "test"},
+	}), nil, 2)
+	for i := 0; i < 3*sql.RowBatchSize+10; i++ {
+		require.NoError(t, child.Insert(ctx, sql.NewRow(fmt.Sprint(i), int32(i), int64(i*2))))
+	}
+
+	project := func(child sql.Node) sql.Node {
+		return plan.NewProject([]sql.Expression{
+			expression.NewGetField(2, types.Int64, "bigintfield", false),
+			expression.NewArithmetic(
+				expression.NewGetField(1, types.Int32, "intfield", false),
+				expression.NewLiteral(int32(1), types.Int32),
+				"+",
+			),
+			expression.NewGetField(0, types.Text, "strfield", true),
+		}, plan.NewFilter(
+			expression.NewEquals(
+				expression.NewMod(
+					expression.NewGetField(1, types.Int32, "intfield", false),
+					expression.NewLiteral(int32(3), types.Int32),
+				),
+				expression.NewLiteral(int32(0), types.Int32),
+			),
+			child,
+		))
+	}
+
+	testCases := []struct {
+		name string
+		node sql.Node
+	}{
+		{
+			name: "batched table",
+			node: project(plan.NewResolvedTable(child, nil, nil)),
+		},
+		{
+			name: "child read one row at a time",
+			node: project(plan.NewSort([]sql.SortField{{
+				Column: expression.NewGetField(1, types.Int32, "intfield", false),
+				Order:  sql.Descending,
+			}}, plan.NewResolvedTable(child, nil, nil))),
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+
+			var expected []sql.Row
+			iter, err := DefaultBuilder.Build(ctx, tt.node, nil)
+			require.NoError(err)
+			for {
+				row, err := iter.Next(ctx)
+				if err == io.EOF {
+					break
+				}
+				require.NoError(err)
+				expected = append(expected, row)
+			}
+			require.NoError(iter.Close(ctx))
+			require.Len(expected, sql.RowBatchSize+4)
+
+			iter, err = DefaultBuilder.Build(ctx, tt.node, nil)
+			require.NoError(err)
+			batches := sql.NewBatchRowIter(iter)
+			var rows []sql.Row
+			for {
+				batch, err := batches.NextBatch(ctx)
+				if err == io.EOF {
+					break
+				}
+				require.NoError(err)
+				require.NotEmpty(batch)
+				rows = append(rows, batch...)
+			}
+			_, err = batches.NextBatch(ctx)
+			require.Equal(io.EOF, err)
+			require.NoError(iter.Close(ctx))
+			require.Equal(expected, rows)
+		})
+	}
+}
+
 func BenchmarkProject(b *testing.B) {
+	benchmarkProject(b, false)
+}
+
+func BenchmarkProjectReusedRows(b *testing.B) {
+	benchmarkProject(b, true)
+}
+
+func benchmarkProject(b *testing.B, reuseRows bool) {
 	require := require.New(b)
 	ctx := sql.NewEmptyContext()
+	b.ReportAllocs()
 
 	for i := 0; i < b.N; i++ {
 		d := plan.NewProject([]sql.Expression{
@@ -92,6 +257,9 @@ func BenchmarkProject(b *testing.B) {
 		iter, err := DefaultBuilder.Build(ctx, d, nil)
 		require.NoError(err)
 		require.NotNil(iter)
+		if reuseRows {
+			sql.ReuseRows(iter)
+		}
 
 		for {
 			_, err := iter.Next(ctx)
@@ -101,5 +269,72 @@ func BenchmarkProject(b *testing.B) {
 
 			require.NoError(err)
 		}
+		require.NoError(iter.Close(ctx))
+	}
+}
+
+// newLargeBenchtable returns a table with the schema of benchtable and the number of rows given.
+func newLargeBenchtable(rows int) *memory.Table {
+	t := memory.NewTable("test", sql.NewPrimaryKeySchema(benchtable.Schema()), nil)
+	ctx := sql.NewEmptyContext()
+	for i := 0; i < rows; i++ {
+		n := fmt.Sprint(i)
+		err := t.Insert(ctx, sql.NewRow(n, float64(i), int8(i%2), int32(i), int64(i), []byte(n)))
+		if err != nil {
+			panic(err)
+		}
+	}
+	return t
+}
+
+func BenchmarkProjectRows(b *testing.B) {
+	benchmarkProjectLargeTable(b, false)
+}
+
+func BenchmarkProjectBatches(b *testing.B) {
+	benchmarkProjectLargeTable(b, true)
+}
+
+func benchmarkProjectLargeTable(b *testing.B, batched bool) {
+	require := require.New(b)
+	ctx := sql.NewEmptyContext()
+	table := newLargeBenchtable(10000)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		d := plan.NewProject([]sql.Expression{
+			expression.NewGetField(0, types.Text, "strfield", true),
+			expression.NewGetField(1, types.Float64, "floatfield", true),
+			expression.NewGetField(3, types.Int32, "intfield", false),
+			expression.NewGetField(4, types.Int64, "bigintfield", false),
+		}, plan.NewResolvedTable(table, nil, nil))
+
+		iter, err := DefaultBuilder.Build(ctx, d, nil)
+		require.NoError(err)
+
+		var rows int
+		if batched {
+			batches := sql.NewBatchRowIter(iter)
+			for {
+				batch, err := batches.NextBatch(ctx)
+				if err == io.EOF {
+					break
+				}
+				require.NoError(err)
+				rows += len(batch)
+			}
+		} else {
+			for {
+				_, err := iter.Next(ctx)
+				if err == io.EOF {
+					break
+				}
+				require.NoError(err)
+				rows++
+			}
+		}
+		require.Equal(10000, rows)
+		require.NoError(iter.Close(ctx))
 	}
 }
@@ -16,10 +16,10 @@ package rowexec
 
 import (
 	"encoding/json"
-	"errors"
 	"fmt"
-	"io"
+	"math/rand"
 	"reflect"
+	"time"
 
 	"github.com/dolthub/jsonpath"
 	"github.com/shopspring/decimal"
@@ -48,6 +48,22 @@ func (b *BaseBuilder) buildTopN(ctx *sql.Context, n *plan.TopN, row sql.Row) (sq
 	return sql.NewSpanIter(span, newTopRowsIter(n.Fields, limit, n.CalcFoundRows, i, len(n.Child.Schema()))), nil
 }
 
+func (b *BaseBuilder) buildReservoirSample(ctx *sql.Context, n *plan.ReservoirSample, row sql.Row) (sql.RowIter, error) {
+	span, ctx := ctx.Span("plan.ReservoirSample")
+	i, err := b.buildNodeExec(ctx, n.Child, row)
+	if err != nil {
+		span.End()
+		return nil, err
+	}
+
+	limit, err := getInt64Value(ctx, n.Limit)
+	if err != nil {
+		return nil, err
+	}
+	rnd := rand.New(rand.NewSource(time.Now().UnixNano()))
+	return sql.NewSpanIter(span, newReservoirSampleIter(i, limit, rnd)), nil
+}
+
 func (b *BaseBuilder) buildValueDerivedTable(ctx *sql.Context, n *plan.ValueDerivedTable, row sql.Row) (sql.RowIter, error) {
 	rows := make([]sql.Row, len(n.ExpressionTuples))
 	for i, et := range n.ExpressionTuples {
@@ -442,21 +458,16 @@ func (b *BaseBuilder) populateMax1Results(ctx *sql.Context, n *plan.Max1Row, row
 	if err != nil {
 		return err
 	}
-	r1, err := i.Next(ctx)
-	if errors.Is(err, io.EOF) {
-		n.EmptyResult = true
-		return nil
-	} else if err != nil {
+	rows, truncated, err := sql.RowIterToRowsWithLimit(ctx, nil, i, 1)
+	if err != nil {
 		return err
-	}
-
-	_, err = i.Next(ctx)
-	if err == nil {
+	} else if truncated {
 		return sql.ErrExpectedSingleRow.New()
-	} else if !errors.Is(err, io.EOF) {
-		return err
+	} else if len(rows) == 0 {
+		n.EmptyResult = true
+		return nil
 	}
-	n.Result = r1
+	n.Result = rows[0]
 	return nil
 }
 
@@ -468,16 +479,15 @@ func (b *BaseBuilder) buildInto(ctx *sql.Context, n *plan.Into, row sql.Row) (sq
 	if err != nil {
 		return nil, err
 	}
-	rows, err := sql.RowIterToRows(ctx, nil, rowIter)
+	rows, truncated, err := sql.RowIterToRowsWithLimit(ctx, nil, rowIter, 1)
 	if err != nil {
 		return nil, err
 	}
 
-	rowNum := len(rows)
-	if rowNum > 1 {
+	if truncated {
 		return nil, sql.ErrMoreThanOneRow.New()
 	}
-	if rowNum == 0 {
+	if len(rows) == 0 {
 		// a warning with error code 1329 occurs (No data), and make no change to variables
 		return sql.RowsToRowIter(sql.Row{}), nil
 	}
@@ -603,7 +613,20 @@ func (b *BaseBuilder) buildIndexedTableAccess(ctx *sql.Context, n *plan.IndexedT
 		return nil, err
 	}
 
-	return sql.NewSpanIter(span, sql.NewTableRowIter(ctx, n.Table, partIter)), nil
+	// Tables read through an index aren't wrapped by the analyzer to track their rows like scanned tables, so the rows
+	// examined by index lookups are counted here
+	return sql.NewSpanIter(span, newRowsExaminedIter(sql.NewTableRowIter(ctx, n.Table, partIter))), nil
+}
+
+func (b *BaseBuilder) buildIndexMerge(ctx *sql.Context, n *plan.IndexMerge, row sql.Row) (sql.RowIter, error) {
+	span, ctx := ctx.Span("plan.IndexMerge")
+	lookups := n.Children()
+	return sql.NewSpanIter(span, &indexMergeIter{
+		predicates: n.Predicates,
+		newIter: func(ctx *sql.Context, i int) (sql.RowIter, error) {
+			return b.buildNodeExec(ctx, lookups[i], row)
+		},
+	}), nil
 }
 
 func (b *BaseBuilder) buildUnion(ctx *sql.Context, u *plan.Union, row sql.Row) (sql.RowIter, error) {
@@ -19,6 +19,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math/rand"
 	"sort"
 	"strings"
 
@@ -113,6 +114,73 @@ func (i *topRowsIter) computeTopRows(ctx *sql.Context) error {
 	return err
 }
 
+// reservoirSampleIter returns a uniformly random sample of up to |limit| rows of its child iterator, in random order.
+// Unlike a topRowsIter sorting by a random value, it doesn't evaluate any expression per row.
+type reservoirSampleIter struct {
+	childIter sql.RowIter
+	limit     int64
+	rand      *rand.Rand
+	rows      []sql.Row
+	idx       int
+}
+
+func newReservoirSampleIter(child sql.RowIter, limit int64, rnd *rand.Rand) *reservoirSampleIter {
+	return &reservoirSampleIter{
+		childIter: child,
+		limit:     limit,
+		rand:      rnd,
+		idx:       -1,
+	}
+}
+
+func (i *reservoirSampleIter) Next(ctx *sql.Context) (sql.Row, error) {
+	if i.idx == -1 {
+		if err := i.computeSample(ctx); err != nil {
+			return nil, err
+		}
+		i.idx = 0
+	}
+
+	if i.idx >= len(i.rows) {
+		return nil, io.EOF
+	}
+	row := i.rows[i.idx]
+	i.idx++
+	return row, nil
+}
+
+func (i *reservoirSampleIter) Close(ctx *sql.Context) error {
+	i.rows = nil
+	return i.childIter.Close(ctx)
+}
+
+// computeSample reads every row of the child iterator, replacing a random row of the sample with the nth row read with
+// a probability of limit / n once the sample is full (Algorithm R), then shuffles the sample.
+func (i *reservoirSampleIter) computeSample(ctx *sql.Context) error {
+	var numRows int64
+	for {
+		row, err := i.childIter.Next(ctx)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		numRows++
+
+		if int64(len(i.rows)) < i.limit {
+			i.rows = append(i.rows, row)
+		} else if j := i.rand.Int63n(numRows); j < i.limit {
+			i.rows[j] = row
+		}
+	}
+
+	i.rand.Shuffle(len(i.rows), func(a, b int) {
+		i.rows[a], i.rows[b] = i.rows[b], i.rows[a]
+	})
+	return nil
+}
+
 // getInt64Value returns the int64 literal value in the expression given, or an error with the errStr given if it
 // cannot.
 func getInt64Value(ctx *sql.Context, expr sql.Expression) (int64, error) {
@@ -323,19 +391,68 @@ func (di *orderedDistinctIter) Close(ctx *sql.Context) error {
 type projectIter struct {
 	p         []sql.Expression
 	childIter sql.RowIter
+	// buf is the row each row is projected into once the iterator is opted into reusing its rows.
+	buf *sql.RowBuffer
+	// childBatches reads the child's rows in batches once NextBatch is called.
+	childBatches sql.BatchRowIter
+	batch        []sql.Row
 }
 
+var _ sql.RowReuser = (*projectIter)(nil)
+var _ sql.BatchRowIter = (*projectIter)(nil)
+
 func (i *projectIter) Next(ctx *sql.Context) (sql.Row, error) {
 	childRow, err := i.childIter.Next(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	if i.buf != nil {
+		return projectRowInto(ctx, i.p, childRow, i.buf.Get(len(i.p)))
+	}
 	return ProjectRow(ctx, i.p, childRow)
 }
 
+// NextBatch implements the sql.BatchRowIter interface. The rows of each batch are projected into a single
+// allocation, which is kept alive by any of its rows.
+func (i *projectIter) NextBatch(ctx *sql.Context) ([]sql.Row, error) {
+	if i.childBatches == nil {
+		i.childBatches = sql.NewBatchRowIter(i.childIter)
+	}
+
+	childRows, err := i.childBatches.NextBatch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	n := len(i.p)
+	fields := make(sql.Row, len(childRows)*n)
+	i.batch = i.batch[:0]
+	for j, childRow := range childRows {
+		row, err := projectRowInto(ctx, i.p, childRow, fields[j*n:(j+1)*n:(j+1)*n])
+		if err != nil {
+			return nil, err
+		}
+		i.batch = append(i.batch, row)
+	}
+	return i.batch, nil
+}
+
+// ReuseRows implements the sql.RowReuser interface. The child's rows are only used to evaluate the projections of
+// each row, so the child reuses its rows as well.
+func (i *projectIter) ReuseRows() {
+	if i.buf == nil {
+		i.buf = &sql.RowBuffer{}
+	}
+	sql.ReuseRows(i.childIter)
+}
+
 func (i *projectIter) Close(ctx *sql.Context) error {
-	return i.childIter.Close(ctx)
+	err := i.childIter.Close(ctx)
+	if i.buf != nil {
+		i.buf.Release()
+	}
+	return err
 }
 
 // ProjectRow evaluates a set of projections.
@@ -343,25 +460,33 @@ func ProjectRow(
 	ctx *sql.Context,
 	projections []sql.Expression,
 	row sql.Row,
+) (sql.Row, error) {
+	return projectRowInto(ctx, projections, row, make(sql.Row, len(projections)))
+}
+
+// projectRowInto evaluates a set of projections into the row given, which has a field for each of them.
+func projectRowInto(
+	ctx *sql.Context,
+	projections []sql.Expression,
+	row sql.Row,
+	fields sql.Row,
 ) (sql.Row, error) {
 	var err error
 	var secondPass []int
-	var fields sql.Row
 	for i, expr := range projections {
 		// Default values that are expressions may reference other fields, thus they must evaluate after all other exprs.
 		// Also default expressions may not refer to other columns that come after them if they also have a default expr.
 		// This ensures that all columns referenced by expressions will have already been evaluated.
 		// Since literals do not reference other columns, they're evaluated on the first pass.
 		if defaultVal, ok := expr.(*sql.ColumnDefaultValue); ok && !defaultVal.IsLiteral() {
-			fields = append(fields, nil)
+			fields[i] = nil
 			secondPass = append(secondPass, i)
 			continue
 		}
-		f, fErr := expr.Eval(ctx, row)
-		if fErr != nil {
-			return nil, fErr
+		fields[i], err = expr.Eval(ctx, row)
+		if err != nil {
+			return nil, err
 		}
-		fields = append(fields, f)
 	}
 	for _, index := range secondPass {
 		fields[index], err = projections[index].Eval(ctx, fields)
@@ -369,7 +494,7 @@ func ProjectRow(
 			return nil, err
 		}
 	}
-	return sql.NewRow(fields...), nil
+	return fields, nil
 }
 
 // TODO a queue is probably more optimal
@@ -428,11 +553,12 @@ func setSystemVar(ctx *sql.Context, sysVar *expression.SystemVar, right sql.Expr
 		if !ok {
 			return sql.ErrSessionDoesNotSupportPersistence.New()
 		}
-		err = persistSess.PersistGlobal(sysVar.Name, val)
+		// Set the global value first, so that read only variables and invalid values are rejected before persisting
+		err = sql.SystemVariables.SetGlobal(sysVar.Name, val)
 		if err != nil {
 			return err
 		}
-		err = sql.SystemVariables.SetGlobal(sysVar.Name, val)
+		err = persistSess.PersistGlobal(sysVar.Name, val)
 		if err != nil {
 			return err
 		}
@@ -446,13 +572,12 @@ func setSystemVar(ctx *sql.Context, sysVar *expression.SystemVar, right sql.Expr
 			return err
 		}
 	case sql.SystemVariableScope_ResetPersist:
-		// TODO: add parser support for RESET PERSIST
 		persistSess, ok := ctx.Session.(sql.PersistableSession)
 		if !ok {
 			return sql.ErrSessionDoesNotSupportPersistence.New()
 		}
 		if sysVar.Name == "" {
-			err = persistSess.RemoveAllPersistedGlobals()
+			return persistSess.RemoveAllPersistedGlobals()
 		}
 		err = persistSess.RemovePersistedGlobal(sysVar.Name)
 		if err != nil {
@@ -727,6 +852,9 @@ type sortIter struct {
 	childIter  sql.RowIter
 	sortedRows []sql.Row
 	idx        int
+	// runs are the files holding the sorted rows, when they didn't fit in memory
+	runs  []*sortRun
+	merge *sortMerge
 }
 
 var _ sql.RowIter = (*sortIter)(nil)
@@ -748,6 +876,16 @@ func (i *sortIter) Next(ctx *sql.Context) (sql.Row, error) {
 		i.idx = 0
 	}
 
+	if i.merge != nil {
+		if i.idx%1024 == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
+		i.idx++
+		return i.merge.next()
+	}
+
 	if i.idx >= len(i.sortedRows) {
 		return nil, io.EOF
 	}
@@ -758,12 +896,41 @@ func (i *sortIter) Next(ctx *sql.Context) (sql.Row, error) {
 
 func (i *sortIter) Close(ctx *sql.Context) error {
 	i.sortedRows = nil
-	return i.childIter.Close(ctx)
+	i.merge = nil
+	err := removeSortRuns(i.runs)
+	i.runs = nil
+	if closeErr := i.childIter.Close(ctx); closeErr != nil {
+		return closeErr
+	}
+	return err
 }
 
+// computeSortedRows sorts all the rows of the child iterator. Rows are held in memory as long as they fit in the sort
+// budget of the memory manager, and the memory available. Past that, the rows held are sorted and spilled to a file
+// as a run, and the sorted rows are returned by merging all the runs.
 func (i *sortIter) computeSortedRows(ctx *sql.Context) error {
 	cache, dispose := ctx.Memory.NewRowsCache()
-	defer dispose()
+	defer func() {
+		dispose()
+	}()
+
+	budget := ctx.Memory.SortBudget()
+	spillable := true
+	var size uint64
+	spill := func() error {
+		if err := i.sortRows(ctx, cache.Get()); err != nil {
+			return err
+		}
+		run, err := newSortRun(cache.Get())
+		if err != nil {
+			return err
+		}
+		i.runs = append(i.runs, run)
+		dispose()
+		cache, dispose = ctx.Memory.NewRowsCache()
+		size = 0
+		return nil
+	}
 
 	for {
 		row, err := i.childIter.Next(ctx)
@@ -775,12 +942,55 @@ func (i *sortIter) computeSortedRows(ctx *sql.Context) error {
 			return err
 		}
 
+		spillable = spillable && canSpillSortRow(row)
 		if err := cache.Add(row); err != nil {
+			if !sql.ErrNoMemoryAvailable.Is(err) || !spillable || len(cache.Get()) == 0 {
+				return err
+			}
+			if err := spill(); err != nil {
+				return err
+			}
+			if err := cache.Add(row); err != nil {
+				return err
+			}
+		}
+
+		size += estimateSortRowSize(row)
+		if spillable && budget > 0 && size >= budget {
+			if err := spill(); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(i.runs) == 0 {
+		rows := cache.Get()
+		if err := i.sortRows(ctx, rows); err != nil {
 			return err
 		}
+		i.sortedRows = rows
+		return nil
 	}
 
-	rows := cache.Get()
+	if len(cache.Get()) > 0 {
+		if err := spill(); err != nil {
+			return err
+		}
+	}
+	runs, err := mergeSortRuns(ctx, i.sortFields, i.runs)
+	i.runs = runs
+	if err != nil {
+		return err
+	}
+	i.merge, err = newSortMerge(ctx, i.sortFields, i.runs)
+	if err != nil {
+		return err
+	}
+	sql.SortMergePasses.Increment(ctx, 1)
+	return nil
+}
+
+func (i *sortIter) sortRows(ctx *sql.Context, rows []sql.Row) error {
 	sorter := &expression.Sorter{
 		SortFields: i.sortFields,
 		Rows:       rows,
@@ -788,11 +998,7 @@ func (i *sortIter) computeSortedRows(ctx *sql.Context) error {
 		Ctx:        ctx,
 	}
 	sort.Stable(sorter)
-	if sorter.LastError != nil {
-		return sorter.LastError
-	}
-	i.sortedRows = rows
-	return nil
+	return sorter.LastError
 }
 
 // distinctIter keeps track of the hashes of all rows that have been emitted.
@@ -885,3 +1091,114 @@ func (ui *unionIter) Close(ctx *sql.Context) error {
 		return nil
 	}
 }
+
+// indexMergeIter returns the rows of the lookups of a plan.IndexMerge one after another. A row of a lookup is only
+// returned if it matches the lookup's predicate and none of the predicates before it, so that each row is returned by
+// the first lookup it matches.
+type indexMergeIter struct {
+	predicates []sql.Expression
+	// newIter returns the iterator of the lookup given.
+	newIter func(ctx *sql.Context, i int) (sql.RowIter, error)
+	idx     int
+	cur     sql.RowIter
+}
+
+func (i *indexMergeIter) Next(ctx *sql.Context) (sql.Row, error) {
+	for {
+		if i.cur == nil {
+			if i.idx == len(i.predicates) {
+				return nil, io.EOF
+			}
+			var err error
+			i.cur, err = i.newIter(ctx, i.idx)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		row, err := i.cur.Next(ctx)
+		if err == io.EOF {
+			err = i.cur.Close(ctx)
+			i.cur = nil
+			i.idx++
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		ok, err := i.firstMatch(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return row, nil
+		}
+	}
+}
+
+// firstMatch returns whether the predicate of the current lookup is the first one the row given matches.
+func (i *indexMergeIter) firstMatch(ctx *sql.Context, row sql.Row) (bool, error) {
+	for j := i.idx; j >= 0; j-- {
+		res, err := sql.EvaluateCondition(ctx, i.predicates[j], row)
+		if err != nil {
+			return false, err
+		}
+		if sql.IsTrue(res) != (j == i.idx) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (i *indexMergeIter) Close(ctx *sql.Context) error {
+	if i.cur != nil {
+		return i.cur.Close(ctx)
+	}
+	return nil
+}
+
+// rowsExaminedNotifyInterval is the number of rows a rowsExaminedIter reads between updates of the process list.
+const rowsExaminedNotifyInterval = 100
+
+// rowsExaminedIter adds the rows read from its child to the rows examined by the query's process, every
+// rowsExaminedNotifyInterval rows and when it's exhausted or closed.
+type rowsExaminedIter struct {
+	childIter sql.RowIter
+	numRows   int64
+	counted   int64
+}
+
+func newRowsExaminedIter(child sql.RowIter) *rowsExaminedIter {
+	return &rowsExaminedIter{childIter: child}
+}
+
+func (i *rowsExaminedIter) Next(ctx *sql.Context) (sql.Row, error) {
+	row, err := i.childIter.Next(ctx)
+	if err != nil {
+		if err == io.EOF {
+			i.update(ctx)
+		}
+		return nil, err
+	}
+	i.numRows++
+	if i.numRows-i.counted >= rowsExaminedNotifyInterval {
+		i.update(ctx)
+	}
+	return row, nil
+}
+
+func (i *rowsExaminedIter) update(ctx *sql.Context) {
+	if i.numRows > i.counted {
+		ctx.ProcessList.UpdateRowsExamined(ctx.Pid(), i.numRows-i.counted)
+		i.counted = i.numRows
+	}
+}
+
+func (i *rowsExaminedIter) Close(ctx *sql.Context) error {
+	i.update(ctx)
+	return i.childIter.Close(ctx)
+}
@@ -16,6 +16,7 @@ package rowexec
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"sort"
 	"strings"
@@ -54,9 +55,17 @@ func (b *BaseBuilder) buildDescribeQuery(ctx *sql.Context, n *plan.DescribeQuery
 	var formatString string
 	if n.Format == "debug" {
 		formatString = sql.DebugString(n.Child)
+	} else if n.Format == "estimated_tree" {
+		formatString = strings.Join(n.EstimatedTree, "\n")
 	} else {
 		formatString = n.Child.String()
 	}
+	if n.Format == "rules" {
+		pr := sql.NewTreePrinter()
+		_ = pr.WriteNode("AnalyzerRules")
+		_ = pr.WriteChildren(n.FiredRules...)
+		formatString += "\n" + pr.String()
+	}
 
 	for _, l := range strings.Split(formatString, "\n") {
 		if strings.TrimSpace(l) != "" {
@@ -105,6 +114,13 @@ func (b *BaseBuilder) buildShowProcessList(ctx *sql.Context, n *plan.ShowProcess
 			status = []string{"running"}
 		}
 
+		info := proc.Query
+		if !n.Full {
+			if runes := []rune(info); len(runes) > plan.ShowProcessListInfoLength {
+				info = string(runes[:plan.ShowProcessListInfoLength])
+			}
+		}
+
 		rows[i] = process{
 			id:      int64(proc.Connection),
 			user:    proc.User,
@@ -112,7 +128,7 @@ func (b *BaseBuilder) buildShowProcessList(ctx *sql.Context, n *plan.ShowProcess
 			state:   strings.Join(status, ""),
 			command: string(proc.Command),
 			host:    proc.Host,
-			info:    proc.Query,
+			info:    info,
 			db:      proc.Database,
 		}.toRow()
 	}
@@ -136,6 +152,8 @@ func (b *BaseBuilder) buildShowTableStatus(ctx *sql.Context, n *plan.ShowTableSt
 
 		var numRows uint64
 		var dataLength uint64
+		var indexLength uint64
+		var autoInc, createTime, updateTime interface{}
 
 		if st, ok := table.(sql.StatisticsTable); ok {
 			numRows, err = st.RowCount(ctx)
@@ -149,7 +167,41 @@ func (b *BaseBuilder) buildShowTableStatus(ctx *sql.Context, n *plan.ShowTableSt
 			}
 		}
 
-		rows[i] = tableToStatusRow(tName, numRows, dataLength, table.Collation())
+		if st, ok := table.(sql.TableSizeStatisticsTable); ok {
+			indexLength, err = st.IndexLength(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			t, ok, err := st.UpdateTime(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				updateTime = t
+			}
+		}
+
+		if ct, ok := table.(sql.CreateTimeTable); ok {
+			t, ok, err := ct.CreateTime(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				createTime = t
+			}
+		}
+
+		if ai, ok := table.(sql.AutoIncrementTable); ok && table.Schema().HasAutoIncrement() {
+			next, err := ai.PeekNextAutoIncrementValue(ctx)
+			if err == nil {
+				autoInc = int64(next)
+			} else if !errors.Is(err, sql.ErrNoAutoIncrementCol) {
+				return nil, err
+			}
+		}
+
+		rows[i] = tableToStatusRow(tName, numRows, dataLength, indexLength, autoInc, createTime, updateTime, table.Collation())
 	}
 
 	return sql.RowsToRowIter(rows...), nil
@@ -248,6 +300,17 @@ func (b *BaseBuilder) buildShowStatus(ctx *sql.Context, n *plan.ShowStatus, row
 		rows = append(rows, sql.Row{name, val})
 	}
 
+	for _, counter := range sql.StatusCounters() {
+		if n.Modifier == plan.ShowStatusModifier_Global {
+			rows = append(rows, sql.Row{counter.Name(), counter.GlobalValue()})
+		} else {
+			rows = append(rows, sql.Row{counter.Name(), counter.SessionValue(ctx.Session)})
+		}
+	}
+	sort.SliceStable(rows, func(i, j int) bool {
+		return strings.ToLower(rows[i][0].(string)) < strings.ToLower(rows[j][0].(string))
+	})
+
 	return sql.RowsToRowIter(rows...), nil
 }
 
@@ -437,13 +500,21 @@ func (b *BaseBuilder) buildShowCreateTrigger(ctx *sql.Context, n *plan.ShowCreat
 func (b *BaseBuilder) buildShowColumns(ctx *sql.Context, n *plan.ShowColumns, row sql.Row) (sql.RowIter, error) {
 	span, _ := ctx.Span("plan.ShowColumns")
 
+	var privSet sql.PrivilegeSet
+	if n.Full {
+		privSet, _ = ctx.GetPrivilegeSet()
+		if privSet == nil {
+			privSet = mysql_db.NewPrivilegeSet()
+		}
+	}
+
 	schema := n.TargetSchema()
 	var rows = make([]sql.Row, len(schema))
 	for i, col := range schema {
 		var row sql.Row
 		var collation interface{}
-		if types.IsTextOnly(col.Type) {
-			collation = sql.Collation_Default.String()
+		if twc, ok := col.Type.(sql.TypeWithCollation); ok && !types.IsBinaryType(col.Type) {
+			collation = twc.Collation().Name()
 		}
 
 		var null = "NO"
@@ -456,6 +527,7 @@ func (b *BaseBuilder) buildShowColumns(ctx *sql.Context, n *plan.ShowColumns, ro
 			node = exchange.Child
 		}
 		key := ""
+		privileges := ""
 		switch table := node.(type) {
 		case *plan.ResolvedTable:
 			if col.PrimaryKey {
@@ -465,8 +537,16 @@ func (b *BaseBuilder) buildShowColumns(ctx *sql.Context, n *plan.ShowColumns, ro
 			} else if isFirstColInNonUniqueKey(n, col, table) {
 				key = "MUL"
 			}
+			if n.Full {
+				var dbName string
+				if table.Database != nil {
+					dbName = table.Database.Name()
+				}
+				privileges = columnPrivileges(privSet, dbName, table.Name(), col.Name)
+			}
 		case *plan.SubqueryAlias:
-			// no key info for views
+			// no key info for views, whose columns can only be selected
+			privileges = "select"
 		default:
 			panic(fmt.Sprintf("unexpected type %T", n.Child))
 		}
@@ -496,7 +576,7 @@ func (b *BaseBuilder) buildShowColumns(ctx *sql.Context, n *plan.ShowColumns, ro
 				key,
 				defaultVal,
 				extra,
-				"", // Privileges
+				privileges,
 				col.Comment,
 			}
 		} else {
@@ -516,6 +596,34 @@ func (b *BaseBuilder) buildShowColumns(ctx *sql.Context, n *plan.ShowColumns, ro
 	return sql.NewSpanIter(span, sql.RowsToRowIter(rows...)), nil
 }
 
+// columnPrivileges returns the privileges the privilege set given has on the column given, from those that apply to
+// columns, as a sorted comma-separated list. Privileges granted on the column's table, its database or globally apply
+// to the column too.
+func columnPrivileges(privSet sql.PrivilegeSet, dbName, tableName, colName string) string {
+	privSetDb := privSet.Database(dbName)
+	privSetTbl := privSetDb.Table(tableName)
+	privSetMap := make(map[string]struct{})
+	for _, levelPrivs := range [][]sql.PrivilegeType{
+		privSet.ToSlice(),
+		privSetDb.ToSlice(),
+		privSetTbl.ToSlice(),
+		privSetTbl.Column(colName).ToSlice(),
+	} {
+		for _, pt := range levelPrivs {
+			switch pt {
+			case sql.PrivilegeType_Select, sql.PrivilegeType_Insert, sql.PrivilegeType_Update, sql.PrivilegeType_References:
+				privSetMap[strings.ToLower(pt.String())] = struct{}{}
+			}
+		}
+	}
+	privs := make([]string, 0, len(privSetMap))
+	for priv := range privSetMap {
+		privs = append(privs, priv)
+	}
+	sort.Strings(privs)
+	return strings.Join(privs, ",")
+}
+
 func (b *BaseBuilder) buildShowVariables(ctx *sql.Context, n *plan.ShowVariables, row sql.Row) (sql.RowIter, error) {
 	var rows []sql.Row
 	var sysVars map[string]interface{}
@@ -527,16 +635,23 @@ func (b *BaseBuilder) buildShowVariables(ctx *sql.Context, n *plan.ShowVariables
 	}
 
 	for k, v := range sysVars {
+		if n.Global {
+			// variables that only exist in sessions have no global value to show
+			if sysVar, _, ok := sql.SystemVariables.GetGlobal(k); ok && sysVar.Scope == sql.SystemVariableScope_Session {
+				continue
+			}
+		}
+		row := sql.NewRow(k, v)
 		if n.Filter != nil {
-			res, err := n.Filter.Eval(ctx, sql.Row{k})
+			res, err := sql.EvaluateCondition(ctx, n.Filter, row)
 			if err != nil {
 				return nil, err
 			}
-			if !res.(bool) {
+			if !sql.IsTrue(res) {
 				continue
 			}
 		}
-		rows = append(rows, sql.NewRow(k, v))
+		rows = append(rows, row)
 	}
 
 	sort.Slice(rows, func(i, j int) bool {
@@ -687,6 +802,7 @@ func (b *BaseBuilder) buildShowIndexes(ctx *sql.Context, n *plan.ShowIndexes, ro
 	return &showIndexesIter{
 		table: table,
 		idxs:  newIndexesToShow(n.IndexesToShow),
+		stats: n.Stats,
 	}, nil
 }
 
@@ -55,8 +55,8 @@ func TestShowCreateTable(t *testing.T) {
 		"CREATE TABLE `test-table` (\n  `baz` text NOT NULL,\n"+
 			"  `z``ab` int DEFAULT '0',\n"+
 			"  `bza` bigint unsigned DEFAULT '0' COMMENT 'hello',\n"+
-			"  `foo` varchar(123),\n"+
-			"  `pok` char(123),\n"+
+			"  `foo` varchar(123) DEFAULT NULL,\n"+
+			"  `pok` char(123) DEFAULT NULL,\n"+
 			"  PRIMARY KEY (`baz`,`z``ab`)\n"+
 			") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin",
 	)
@@ -102,8 +102,8 @@ func TestShowCreateTableWithNoPrimaryKey(t *testing.T) {
 		table.Name(),
 		"CREATE TABLE `test_table` (\n  `baz` text NOT NULL,\n"+
 			"  `bza` bigint unsigned DEFAULT '0' COMMENT 'hello',\n"+
-			"  `foo` varchar(123),\n"+
-			"  `pok` char(123),\n"+
+			"  `foo` varchar(123) DEFAULT NULL,\n"+
+			"  `pok` char(123) DEFAULT NULL,\n"+
 			"  `zab` int DEFAULT '0'\n"+
 			") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin",
 	)
@@ -140,8 +140,8 @@ func TestShowCreateTableWithPrimaryKey(t *testing.T) {
 		table.Name(),
 		"CREATE TABLE `test-table` (\n  `baz` text NOT NULL,\n"+
 			"  `bza` bigint unsigned DEFAULT '0' COMMENT 'hello',\n"+
-			"  `foo` varchar(123),\n"+
-			"  `pok` char(123),\n"+
+			"  `foo` varchar(123) DEFAULT NULL,\n"+
+			"  `pok` char(123) DEFAULT NULL,\n"+
 			"  `zab` int DEFAULT '0',\n"+
 			"  PRIMARY KEY (`zab`,`baz`)\n"+
 			") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin",
@@ -245,8 +245,8 @@ func TestShowCreateTableWithIndexAndForeignKeysAndChecks(t *testing.T) {
 		"CREATE TABLE `test-table` (\n  `baz` text NOT NULL,\n"+
 			"  `zab` int DEFAULT '0',\n"+
 			"  `bza` bigint unsigned DEFAULT '0' COMMENT 'hello',\n"+
-			"  `foo` varchar(123),\n"+
-			"  `pok` char(123),\n"+
+			"  `foo` varchar(123) DEFAULT NULL,\n"+
+			"  `pok` char(123) DEFAULT NULL,\n"+
 			"  PRIMARY KEY (`baz`,`zab`),\n"+
 			"  UNIQUE KEY ```qux``` (`foo`),\n"+
 			"  KEY `zug` (`pok`,`foo`) COMMENT 'test comment',\n"+
@@ -122,8 +122,8 @@ func TestShowIndexes(t *testing.T) {
 					idx.ID(),
 					i+1,
 					columnName,
+					"A",
 					nil,
-					int64(0),
 					nil,
 					nil,
 					nullable,
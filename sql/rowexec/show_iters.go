@@ -17,10 +17,12 @@ package rowexec
 import (
 	"fmt"
 	"io"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression/function"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 	"github.com/dolthub/go-mysql-server/sql/types"
 )
@@ -73,7 +75,7 @@ func (p process) toRow() sql.Row {
 }
 
 // cc here: https://dev.mysql.com/doc/refman/8.0/en/show-table-status.html
-func tableToStatusRow(table string, numRows uint64, dataLength uint64, collation sql.CollationID) sql.Row {
+func tableToStatusRow(table string, numRows, dataLength, indexLength uint64, autoInc, createTime, updateTime interface{}, collation sql.CollationID) sql.Row {
 	var avgLength float64 = 0
 	if numRows > 0 {
 		avgLength = float64(dataLength) / float64(numRows)
@@ -90,11 +92,11 @@ func tableToStatusRow(table string, numRows uint64, dataLength uint64, collation
 		uint64(avgLength),  // Avg_row_length
 		dataLength,         // Data_length
 		uint64(0),          // Max_data_length (Unused for InnoDB)
-		int64(0),           // Index_length
+		int64(indexLength), // Index_length
 		int64(0),           // Data_free
-		nil,                // Auto_increment (always null)
-		nil,                // Create_time
-		nil,                // Update_time
+		autoInc,            // Auto_increment
+		createTime,         // Create_time
+		updateTime,         // Update_time
 		nil,                // Check_time
 		collation.String(), // Collation
 		nil,                // Checksum
@@ -181,6 +183,7 @@ func (i *indexesToShow) next() (*idxToShow, error) {
 type showIndexesIter struct {
 	table *plan.ResolvedTable
 	idxs  *indexesToShow
+	stats sql.StatsReader
 }
 
 func (i *showIndexesIter) Next(ctx *sql.Context) (sql.Row, error) {
@@ -190,26 +193,34 @@ func (i *showIndexesIter) Next(ctx *sql.Context) (sql.Row, error) {
 	}
 
 	var expression, columnName interface{}
-	columnName, expression = nil, show.expression
+	columnName, expression = nil, plan.GetUnqualifiedIndexExpr(show.expression)
 	tbl := i.table
 
-	if err != nil {
-		return nil, err
-	}
-
 	nullable := ""
+	var subPart interface{}
 	if col := plan.GetColumnFromIndexExpr(show.expression, tbl); col != nil {
 		columnName, expression = col.Name, nil
 		if col.Nullable {
 			nullable = "YES"
 		}
+		if prefixLengths := show.index.PrefixLengths(); show.exPosition < len(prefixLengths) && prefixLengths[show.exPosition] > 0 {
+			subPart = int64(prefixLengths[show.exPosition])
+		}
+	}
+
+	collation := plan.GetIndexCollation(show.index, show.exPosition)
+	var dbName string
+	if tbl.Database != nil {
+		dbName = tbl.Database.Name()
+	}
+	cardinality, err := plan.GetIndexCardinality(ctx, i.stats, dbName, tbl, show.index, show.exPosition)
+	if err != nil {
+		return nil, err
 	}
 
 	visible := "YES"
-	if x, ok := show.index.(sql.DriverIndex); ok && len(x.Driver()) > 0 {
-		if !ctx.GetIndexRegistry().CanUseIndex(x) {
-			visible = "NO"
-		}
+	if !plan.IsIndexVisible(ctx, show.index) {
+		visible = "NO"
 	}
 
 	nonUnique := 0
@@ -223,14 +234,14 @@ func (i *showIndexesIter) Next(ctx *sql.Context) (sql.Row, error) {
 		show.index.ID(),        // "Key_name" string
 		show.exPosition+1,      // "Seq_in_index" int32
 		columnName,             // "Column_name" string
-		nil,                    // "Collation" string, Values [A, D, NULL]
-		int64(0),               // "Cardinality" int64 (not calculated)
-		nil,                    // "Sub_part" int64
+		collation,              // "Collation" string, Values [A, D, NULL]
+		cardinality,            // "Cardinality" int64, NULL if not estimated
+		subPart,                // "Sub_part" int64
 		nil,                    // "Packed" string
 		nullable,               // "Null" string, Values [YES, '']
 		show.index.IndexType(), // "Index_type" string
-		show.index.Comment(),   // "Comment" string
-		"",                     // "Index_comment" string
+		"",                     // "Comment" string
+		show.index.Comment(),   // "Index_comment" string
 		visible,                // "Visible" string, Values [YES, NO]
 		expression,             // "Expression" string
 	), nil
@@ -342,25 +353,16 @@ func (i *showCreateTablesIter) produceCreateTableStatement(ctx *sql.Context, tab
 	// Statement creation parts for each column
 	for i, col := range schema {
 
-		var colDefault string
-		// TODO: The columns that are rendered in defaults should be backticked
-		if col.Default != nil {
-			// TODO : string literals should have character set introducer
-			colDefault = col.Default.String()
-			if colDefault != "NULL" && col.Default.IsLiteral() && !types.IsTime(col.Default.Type()) && !types.IsText(col.Default.Type()) {
-				v, err := col.Default.Eval(ctx, nil)
-				if err != nil {
-					return "", err
-				}
-				colDefault = fmt.Sprintf("'%v'", v)
-			}
+		colDefault, hasDefault, err := columnDefaultDefinition(ctx, col)
+		if err != nil {
+			return "", err
 		}
 
 		if col.PrimaryKey && len(pkSchema.Schema) == 0 {
 			pkOrdinals = append(pkOrdinals, i)
 		}
 
-		colStmts[i] = sql.GenerateCreateTableColumnDefinition(col.Name, col.Type, col.Nullable, col.AutoIncrement, col.Default != nil, colDefault, col.Comment)
+		colStmts[i] = sql.GenerateCreateTableColumnDefinitionWithCollation(col.Name, col.Type, table.Collation(), col.Nullable, col.AutoIncrement, hasDefault, colDefault, col.Comment)
 	}
 
 	for _, i := range pkOrdinals {
@@ -371,23 +373,33 @@ func (i *showCreateTablesIter) produceCreateTableStatement(ctx *sql.Context, tab
 		colStmts = append(colStmts, sql.GenerateCreateTablePrimaryKeyDefinition(primaryKeyCols))
 	}
 
-	for _, index := range i.indexes {
+	for _, index := range sortIndexesForShow(i.indexes, table) {
 		// The primary key may or may not be declared as an index by the table. Don't print it twice if it's here.
 		if isPrimaryKeyIndex(index, table) {
 			continue
 		}
 
 		prefixLengths := index.PrefixLengths()
+		var colOrders []sql.IndexOrder
+		if coi, ok := index.(sql.ColumnOrderedIndex); ok {
+			colOrders = coi.ColumnOrders()
+		}
 		var indexCols []string
 		for i, expr := range index.Expressions() {
+			var indexDef string
 			col := plan.GetColumnFromIndexExpr(expr, table)
 			if col != nil {
-				indexDef := sql.QuoteIdentifier(col.Name)
+				indexDef = sql.QuoteIdentifier(col.Name)
 				if len(prefixLengths) > i && prefixLengths[i] != 0 {
 					indexDef += fmt.Sprintf("(%v)", prefixLengths[i])
 				}
-				indexCols = append(indexCols, indexDef)
+			} else {
+				indexDef = fmt.Sprintf("(%s)", plan.GetUnqualifiedIndexExpr(expr))
+			}
+			if len(colOrders) > i && colOrders[i] == sql.IndexOrderDesc {
+				indexDef += " DESC"
 			}
+			indexCols = append(indexCols, indexDef)
 		}
 
 		colStmts = append(colStmts, sql.GenerateCreateTableIndexDefinition(index.IsUnique(), index.IsSpatial(), index.ID(), indexCols, index.Comment()))
@@ -399,6 +411,11 @@ func (i *showCreateTablesIter) produceCreateTableStatement(ctx *sql.Context, tab
 		if err != nil {
 			return "", err
 		}
+		// MySQL lists foreign keys by name rather than in the order they were declared
+		fks = append([]sql.ForeignKeyConstraint(nil), fks...)
+		sort.SliceStable(fks, func(i, j int) bool {
+			return fks[i].Name < fks[j].Name
+		})
 		for _, fk := range fks {
 			onDelete := ""
 			if len(fk.OnDelete) > 0 && fk.OnDelete != sql.ForeignKeyReferentialAction_DefaultAction {
@@ -418,7 +435,111 @@ func (i *showCreateTablesIter) produceCreateTableStatement(ctx *sql.Context, tab
 		}
 	}
 
-	return sql.GenerateCreateTableStatement(table.Name(), colStmts, table.Collation().CharacterSet().Name(), table.Collation().Name()), nil
+	stmt := sql.GenerateCreateTableStatement(table.Name(), colStmts, table.Collation().CharacterSet().Name(), table.Collation().Name())
+	underlying := table
+	if tw, ok := underlying.(sql.TableWrapper); ok {
+		underlying = tw.Underlying()
+	}
+	if sart, ok := underlying.(sql.StatsAutoRecalcTable); ok && sart.StatsAutoRecalc() != sql.StatsAutoRecalc_Default {
+		stmt = fmt.Sprintf("%s STATS_AUTO_RECALC=%s", stmt, sart.StatsAutoRecalc())
+	}
+	return stmt, nil
+}
+
+// columnDefaultDefinition returns the value of the DEFAULT clause of the column given as MySQL renders it, and whether
+// the column has one. Nullable columns without a default have an implicit DEFAULT NULL, except for those of the BLOB,
+// TEXT, JSON and geometry types, which can't have literal defaults.
+func columnDefaultDefinition(ctx *sql.Context, col *sql.Column) (string, bool, error) {
+	if col.Default == nil {
+		if col.Nullable && !col.AutoIncrement && !types.IsTextBlob(col.Type) && !types.IsJSON(col.Type) && !types.IsGeometry(col.Type) {
+			return "NULL", true, nil
+		}
+		return "", false, nil
+	}
+
+	// TODO: The columns that are rendered in defaults should be backticked
+	if !col.Default.IsLiteral() {
+		// NOW() and its synonyms may be given without parentheses as the default of datetime and timestamp columns,
+		// which MySQL renders as CURRENT_TIMESTAMP
+		if !col.Default.IsParenthesized() && (types.IsDatetimeType(col.Type) || types.IsTimestampType(col.Type)) {
+			switch now := col.Default.Expression.(type) {
+			case *function.Now, *function.CurrTimestamp:
+				// Both are rendered as the function name followed by the precision, if any, in parentheses
+				str := now.String()
+				if precision := str[strings.Index(str, "(")+1 : len(str)-1]; precision != "" {
+					return fmt.Sprintf("CURRENT_TIMESTAMP(%s)", precision), true, nil
+				}
+				return "CURRENT_TIMESTAMP", true, nil
+			}
+		}
+		return col.Default.String(), true, nil
+	}
+
+	colDefault := col.Default.String()
+	if colDefault == "NULL" || types.IsTime(col.Default.Type()) {
+		return colDefault, true, nil
+	}
+	v, err := col.Default.Eval(ctx, nil)
+	if err != nil {
+		return "", false, err
+	}
+	if v == nil {
+		return "NULL", true, nil
+	}
+	if types.IsBit(col.Type) {
+		bits, _, err := types.Uint64.Convert(v)
+		if err != nil {
+			return "", false, err
+		}
+		return fmt.Sprintf("b'%b'", bits), true, nil
+	}
+	// The value is rendered as the column's type would return it, such as with the scale of a decimal column or as the
+	// name of an enum member
+	val, err := col.Type.SQL(ctx, nil, v)
+	if err != nil {
+		return "", false, err
+	}
+	return sql.QuoteStringLiteral(val.ToString()), true, nil
+}
+
+// sortIndexesForShow returns the indexes given in the order MySQL lists them: unique indexes first, those without
+// nullable or prefixed columns before the others, then the other indexes. The order of the indexes given is otherwise
+// kept.
+func sortIndexesForShow(indexes []sql.Index, table sql.Table) []sql.Index {
+	rank := func(index sql.Index) int {
+		if !index.IsUnique() {
+			return 4
+		}
+		nullable := false
+		for _, expr := range index.Expressions() {
+			if col := plan.GetColumnFromIndexExpr(expr, table); col == nil || col.Nullable {
+				nullable = true
+			}
+		}
+		prefixed := false
+		for _, length := range index.PrefixLengths() {
+			if length != 0 {
+				prefixed = true
+			}
+		}
+		switch {
+		case !nullable && !prefixed:
+			return 0
+		case !nullable:
+			return 1
+		case !prefixed:
+			return 2
+		default:
+			return 3
+		}
+	}
+
+	sorted := make([]sql.Index, len(indexes))
+	copy(sorted, indexes)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return rank(sorted[i]) < rank(sorted[j])
+	})
+	return sorted
 }
 
 // isPrimaryKeyIndex returns whether the index given matches the table's primary key columns. Order is not considered.
@@ -16,8 +16,12 @@ package rowexec
 
 import (
 	"fmt"
+	"io"
+	"os"
 	"testing"
+	"time"
 
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 
 	"github.com/dolthub/go-mysql-server/memory"
@@ -296,3 +300,136 @@ func TestSortDescending(t *testing.T) {
 	require.NoError(err)
 	require.Equal(expected, actual)
 }
+
+func TestSortSpillsToDisk(t *testing.T) {
+	require := require.New(t)
+	tmpDir := t.TempDir()
+	t.Setenv("TMPDIR", tmpDir)
+
+	var rows []sql.Row
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 5000; i++ {
+		var name interface{} = fmt.Sprintf("name%d", i%37)
+		if i%11 == 0 {
+			name = nil
+		}
+		rows = append(rows, sql.NewRow(
+			int64(i%97),
+			name,
+			int64(i),
+			float64(i)/3,
+			start.Add(time.Duration(i)*time.Second),
+			decimal.New(int64(i), -2),
+			[]byte{byte(i)},
+		))
+	}
+	sf := sql.SortFields{
+		{Column: expression.NewGetField(0, types.Int64, "a", false), Order: sql.Descending},
+		{Column: expression.NewGetField(1, types.Text, "b", true), Order: sql.Ascending, NullOrdering: sql.NullsLast},
+	}
+
+	ctx := sql.NewEmptyContext()
+	expected, err := sql.RowIterToRows(ctx, nil, newSortIter(sf, sql.RowsToRowIter(rows...)))
+	require.NoError(err)
+	require.Len(expected, len(rows))
+	require.Zero(sql.SortMergePasses.SessionValue(ctx.Session))
+
+	ctx = sql.NewEmptyContext()
+	ctx.Memory = sql.NewMemoryManager(nil)
+	ctx.Memory.SetSortBudget(2000)
+	iter := newSortIter(sf, sql.RowsToRowIter(rows...))
+	row, err := iter.Next(ctx)
+	require.NoError(err)
+	require.Greater(len(iter.runs), 1)
+	require.LessOrEqual(len(iter.runs), sortMergeFanIn)
+	files, err := os.ReadDir(tmpDir)
+	require.NoError(err)
+	require.Len(files, len(iter.runs))
+
+	actual := []sql.Row{row}
+	for {
+		row, err := iter.Next(ctx)
+		if err == io.EOF {
+			break
+		}
+		require.NoError(err)
+		actual = append(actual, row)
+	}
+	require.NoError(iter.Close(ctx))
+	require.Equal(expected, actual)
+	require.Greater(sql.SortMergePasses.SessionValue(ctx.Session), uint64(1))
+
+	files, err = os.ReadDir(tmpDir)
+	require.NoError(err)
+	require.Empty(files)
+}
+
+func TestSortRemovesSpilledRunsOnClose(t *testing.T) {
+	require := require.New(t)
+	tmpDir := t.TempDir()
+	t.Setenv("TMPDIR", tmpDir)
+
+	var rows []sql.Row
+	for i := 0; i < 1000; i++ {
+		rows = append(rows, sql.NewRow(int64(i%10), int64(i)))
+	}
+	sf := sql.SortFields{
+		{Column: expression.NewGetField(0, types.Int64, "a", false), Order: sql.Ascending},
+	}
+
+	ctx := sql.NewEmptyContext()
+	ctx.Memory = sql.NewMemoryManager(nil)
+	ctx.Memory.SetSortBudget(1000)
+	iter := newSortIter(sf, sql.RowsToRowIter(rows...))
+	row, err := iter.Next(ctx)
+	require.NoError(err)
+	require.Equal(sql.NewRow(int64(0), int64(0)), row)
+
+	files, err := os.ReadDir(tmpDir)
+	require.NoError(err)
+	require.NotEmpty(files)
+
+	require.NoError(iter.Close(ctx))
+	files, err = os.ReadDir(tmpDir)
+	require.NoError(err)
+	require.Empty(files)
+}
+
+func TestReservoirSample(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	schema := sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "col1", Type: types.Int64, Nullable: false},
+	})
+	child := memory.NewTable("test", schema, nil)
+	for i := 0; i < 100; i++ {
+		require.NoError(child.Insert(sql.NewEmptyContext(), sql.NewRow(int64(i))))
+	}
+	table := plan.NewResolvedTable(child, nil, nil)
+
+	rows, err := NodeToRows(ctx, plan.NewReservoirSample(expression.NewLiteral(int64(200), types.Int64), table))
+	require.NoError(err)
+	require.Len(rows, 100)
+
+	// Every row should be in about a tenth of samples of 10 rows. The bounds are more than seven standard deviations
+	// away from that.
+	const numSamples = 2000
+	picked := make(map[int64]int)
+	for i := 0; i < numSamples; i++ {
+		rows, err := NodeToRows(ctx, plan.NewReservoirSample(expression.NewLiteral(int64(10), types.Int64), table))
+		require.NoError(err)
+		require.Len(rows, 10)
+		seen := make(map[int64]bool)
+		for _, row := range rows {
+			v := row[0].(int64)
+			require.False(seen[v], "row %d sampled twice", v)
+			seen[v] = true
+			picked[v]++
+		}
+	}
+	require.Len(picked, 100)
+	for v, n := range picked {
+		require.True(n > 100 && n < 300, "row %d sampled %d times out of %d", v, n, numSamples)
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rowexec
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+const (
+	sampleTestPartitions       = 100
+	sampleTestRowsPerPartition = 100
+)
+
+func newSampleTestTable(t *testing.T) *memory.Table {
+	schema := sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "i", Type: types.Int64, Source: "test"},
+	})
+	tbl := memory.NewPartitionedTable("test", schema, nil, sampleTestPartitions)
+	ctx := sql.NewEmptyContext()
+	inserter := tbl.Inserter(ctx)
+	for i := 0; i < sampleTestPartitions*sampleTestRowsPerPartition; i++ {
+		require.NoError(t, inserter.Insert(ctx, sql.NewRow(int64(i))))
+	}
+	require.NoError(t, inserter.Close(ctx))
+	return tbl
+}
+
+func sampleRows(t *testing.T, tbl sql.Table, sample plan.TableSample) []sql.Row {
+	rows, err := NodeToRows(sql.NewEmptyContext(), plan.NewResolvedTable(plan.NewSampledTable(tbl, sample), nil, nil))
+	require.NoError(t, err)
+	return rows
+}
+
+func TestSampledTable(t *testing.T) {
+	tbl := newSampleTestTable(t)
+	numRows := sampleTestPartitions * sampleTestRowsPerPartition
+
+	for _, method := range []plan.SampleMethod{plan.SampleSystem, plan.SampleBernoulli} {
+		t.Run(method.String(), func(t *testing.T) {
+			require.Len(t, sampleRows(t, tbl, plan.TableSample{Method: method, Percent: 100}), numRows)
+			require.Len(t, sampleRows(t, tbl, plan.TableSample{Method: method, Percent: 0}), 0)
+
+			sample := plan.TableSample{Method: method, Percent: 30, Seed: 42, Repeatable: true}
+			rows := sampleRows(t, tbl, sample)
+			require.Equal(t, rows, sampleRows(t, tbl, sample), "REPEATABLE samples with the same seed differ")
+			sample.Seed = 43
+			require.NotEqual(t, rows, sampleRows(t, tbl, sample), "REPEATABLE samples with different seeds are equal")
+		})
+	}
+}
+
+func TestSampledTableBounds(t *testing.T) {
+	tbl := newSampleTestTable(t)
+
+	// The bounds are about five standard deviations away from the expected number of rows, so none of these samples
+	// should ever fall outside of them.
+	for seed := int64(0); seed < 20; seed++ {
+		t.Run(fmt.Sprintf("BERNOULLI seed %d", seed), func(t *testing.T) {
+			rows := sampleRows(t, tbl, plan.TableSample{Method: plan.SampleBernoulli, Percent: 10, Seed: seed, Repeatable: true})
+			require.GreaterOrEqual(t, len(rows), 850)
+			require.LessOrEqual(t, len(rows), 1150)
+		})
+		t.Run(fmt.Sprintf("SYSTEM seed %d", seed), func(t *testing.T) {
+			rows := sampleRows(t, tbl, plan.TableSample{Method: plan.SampleSystem, Percent: 25, Seed: seed, Repeatable: true})
+			require.Zero(t, len(rows)%sampleTestRowsPerPartition, "SYSTEM sample returned part of a partition")
+			numPartitions := len(rows) / sampleTestRowsPerPartition
+			require.GreaterOrEqual(t, numPartitions, 4)
+			require.LessOrEqual(t, numPartitions, 46)
+		})
+	}
+
+	t.Run("BERNOULLI without seed", func(t *testing.T) {
+		rows := sampleRows(t, tbl, plan.TableSample{Method: plan.SampleBernoulli, Percent: 50})
+		require.GreaterOrEqual(t, len(rows), 4750)
+		require.LessOrEqual(t, len(rows), 5250)
+	})
+}
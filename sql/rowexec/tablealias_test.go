@@ -47,8 +47,8 @@ func TestTableAlias(t *testing.T) {
 	}
 
 	require.Equal(sql.Schema{
-		{Name: "a", Source: "foo", Type: types.Text, Nullable: true},
-		{Name: "b", Source: "foo", Type: types.Text, Nullable: true},
+		{Name: "a", Source: "foo", Type: types.Text, Nullable: true, Origin: &sql.ColumnOrigin{Table: "foo", OriginalName: "a"}},
+		{Name: "b", Source: "foo", Type: types.Text, Nullable: true, Origin: &sql.ColumnOrigin{Table: "foo", OriginalName: "b"}},
 	}, alias.Schema())
 	iter, err := DefaultBuilder.Build(ctx, alias, nil)
 	require.NoError(err)
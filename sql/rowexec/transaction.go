@@ -87,6 +87,9 @@ func (b *BaseBuilder) buildCreateSavepoint(ctx *sql.Context, n *plan.CreateSavep
 func (b *BaseBuilder) buildStartTransaction(ctx *sql.Context, n *plan.StartTransaction, row sql.Row) (sql.RowIter, error) {
 	ts, ok := ctx.Session.(sql.TransactionSession)
 	if !ok {
+		ctx.RowChangeFeed().TransactionCommitted(ctx)
+		// The transaction is still tracked until it's committed or rolled back, to report it to clients
+		ctx.SetIgnoreAutoCommit(true)
 		return sql.RowsToRowIter(), nil
 	}
 
@@ -99,6 +102,7 @@ func (b *BaseBuilder) buildStartTransaction(ctx *sql.Context, n *plan.StartTrans
 		if err != nil {
 			return nil, err
 		}
+		ctx.RowChangeFeed().TransactionCommitted(ctx)
 	}
 
 	transaction, err := ts.StartTransaction(ctx, n.TransChar)
@@ -136,6 +140,8 @@ func (b *BaseBuilder) buildUnlockTables(ctx *sql.Context, n *plan.UnlockTables,
 func (b *BaseBuilder) buildCommit(ctx *sql.Context, n *plan.Commit, row sql.Row) (sql.RowIter, error) {
 	ts, ok := ctx.Session.(sql.TransactionSession)
 	if !ok {
+		ctx.RowChangeFeed().TransactionCommitted(ctx)
+		ctx.SetIgnoreAutoCommit(false)
 		return sql.RowsToRowIter(), nil
 	}
 
@@ -149,6 +155,7 @@ func (b *BaseBuilder) buildCommit(ctx *sql.Context, n *plan.Commit, row sql.Row)
 	if err != nil {
 		return nil, err
 	}
+	ctx.RowChangeFeed().TransactionCommitted(ctx)
 
 	ctx.SetIgnoreAutoCommit(false)
 	ctx.SetTransaction(nil)
@@ -185,6 +192,9 @@ func (b *BaseBuilder) buildResetReplica(ctx *sql.Context, n *plan.ResetReplica,
 func (b *BaseBuilder) buildRollback(ctx *sql.Context, n *plan.Rollback, row sql.Row) (sql.RowIter, error) {
 	ts, ok := ctx.Session.(sql.TransactionSession)
 	if !ok {
+		// Sessions without transactions can't undo the changes made, so they're reported as committed
+		ctx.RowChangeFeed().TransactionCommitted(ctx)
+		ctx.SetIgnoreAutoCommit(false)
 		return sql.RowsToRowIter(), nil
 	}
 
@@ -198,6 +208,7 @@ func (b *BaseBuilder) buildRollback(ctx *sql.Context, n *plan.Rollback, row sql.
 	if err != nil {
 		return nil, err
 	}
+	ctx.RowChangeFeed().TransactionRolledBack(ctx)
 
 	// Like Commit, Rollback ends the current transaction and a new one begins with the next statement
 	ctx.SetIgnoreAutoCommit(false)
@@ -313,5 +324,5 @@ func (b *BaseBuilder) buildTransactionCommittingNode(ctx *sql.Context, n *plan.T
 	if err != nil {
 		return nil, err
 	}
-	return transactionCommittingIter{childIter: iter}, nil
+	return transactionCommittingIter{childIter: iter, implicitCommit: causesImplicitCommit(n.Child())}, nil
 }
@@ -67,17 +67,37 @@ func getLockableTable(table sql.Table) (sql.Lockable, error) {
 	}
 }
 
+var _ sql.RowReuser = transactionCommittingIter{}
+
 // transactionCommittingIter is a simple RowIter wrapper to allow the engine to conditionally commit a transaction
 // during the Close() operation
 type transactionCommittingIter struct {
 	childIter           sql.RowIter
 	transactionDatabase string
+	// implicitCommit is whether the statement ends the current transaction, even one started explicitly
+	implicitCommit bool
+}
+
+// causesImplicitCommit returns whether the node given is a statement that causes an implicit commit of the current
+// transaction in MySQL, like DDL statements and LOCK TABLES.
+// cc. https://dev.mysql.com/doc/refman/8.0/en/implicit-commit.html
+func causesImplicitCommit(n sql.Node) bool {
+	if _, ok := n.(*plan.LockTables); ok {
+		return true
+	}
+	return plan.IsDDLNode(n)
 }
 
 func (t transactionCommittingIter) Next(ctx *sql.Context) (sql.Row, error) {
 	return t.childIter.Next(ctx)
 }
 
+// ReuseRows implements the sql.RowReuser interface. Rows are passed through from the child, which reuses its rows in
+// turn.
+func (t transactionCommittingIter) ReuseRows() {
+	sql.ReuseRows(t.childIter)
+}
+
 func (t transactionCommittingIter) Close(ctx *sql.Context) error {
 	var err error
 	if t.childIter != nil {
@@ -96,7 +116,19 @@ func (t transactionCommittingIter) Close(ctx *sql.Context) error {
 		return err
 	}
 
-	commitTransaction := ((tx != nil) && !ctx.GetIgnoreAutoCommit()) && autocommit
+	// Statements causing an implicit commit end the current transaction, even one started explicitly
+	if t.implicitCommit {
+		ctx.SetIgnoreAutoCommit(false)
+	}
+
+	// Sessions without transactions keep the changes of every statement, which are reported as committed when a
+	// transaction would be
+	endsTransaction := !ctx.GetIgnoreAutoCommit() && (autocommit || t.implicitCommit)
+	if _, ok := ctx.Session.(sql.TransactionSession); !ok && endsTransaction {
+		ctx.RowChangeFeed().TransactionCommitted(ctx)
+	}
+
+	commitTransaction := (tx != nil) && endsTransaction
 	if commitTransaction {
 		ts, ok := ctx.Session.(sql.TransactionSession)
 		if !ok {
@@ -107,6 +139,7 @@ func (t transactionCommittingIter) Close(ctx *sql.Context) error {
 		if err := ts.CommitTransaction(ctx, tx); err != nil {
 			return err
 		}
+		ctx.RowChangeFeed().TransactionCommitted(ctx)
 
 		// Clearing out the current transaction will tell us to start a new one the next time this session queries
 		ctx.SetTransaction(nil)
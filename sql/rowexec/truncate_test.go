@@ -0,0 +1,81 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rowexec
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// deleteOnlyTable hides every interface of the table it wraps except sql.DeletableTable, so that it can't be truncated
+// with sql.TruncateableTable.
+type deleteOnlyTable struct {
+	sql.DeletableTable
+}
+
+func TestTruncate(t *testing.T) {
+	sch := sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "pk", Source: "foo", Type: types.Int64, PrimaryKey: true},
+	})
+
+	for _, tt := range []struct {
+		name          string
+		fastPath      bool
+		reportRemoved bool
+		expected      int
+	}{
+		{name: "truncateable table", fastPath: true, expected: 0},
+		{name: "truncateable table reporting removed rows", fastPath: true, reportRemoved: true, expected: 3},
+		{name: "deletable table", expected: 0},
+		{name: "deletable table reporting removed rows", reportRemoved: true, expected: 3},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			ctx := sql.NewEmptyContext()
+
+			table := memory.NewPartitionedTable("foo", sch, nil, 2)
+			for i := int64(1); i <= 3; i++ {
+				require.NoError(table.Insert(ctx, sql.Row{i}))
+			}
+
+			var tbl sql.Table = table
+			if !tt.fastPath {
+				tbl = deleteOnlyTable{table}
+				_, err := plan.GetTruncatable(plan.NewResolvedTable(tbl, nil, nil))
+				require.Error(err)
+			}
+
+			truncate := plan.NewTruncate("", plan.NewResolvedTable(tbl, nil, nil))
+			truncate.ReportRemoved = tt.reportRemoved
+			iter, err := DefaultBuilder.Build(ctx, truncate, nil)
+			require.NoError(err)
+			rows, err := sql.RowIterToRows(ctx, nil, iter)
+			require.NoError(err)
+			require.Equal([]sql.Row{{types.NewOkResult(tt.expected)}}, rows)
+
+			iter, err = DefaultBuilder.Build(ctx, plan.NewResolvedTable(table, nil, nil), nil)
+			require.NoError(err)
+			rows, err = sql.RowIterToRows(ctx, nil, iter)
+			require.NoError(err)
+			require.Empty(rows)
+		})
+	}
+}
@@ -94,24 +94,84 @@ type RowIter interface {
 	Closer
 }
 
-// RowIterToRows converts a row iterator to a slice of rows.
+// RowIterToRows converts a row iterator to a slice of rows. Iterators that implement BatchRowIter are read in batches.
+// The iterator is always closed, even if reading it fails.
 func RowIterToRows(ctx *Context, sch Schema, i RowIter) ([]Row, error) {
-	var rows []Row
-	for {
+	rows, _, err := RowIterToRowsWithLimit(ctx, sch, i, 0)
+	return rows, err
+}
+
+// rowIterCheckInterval is the number of rows read between checks of the context's cancellation.
+const rowIterCheckInterval = 1024
+
+// RowIterToRowsWithLimit converts a row iterator to a slice of at most |max| rows, in the order the iterator returns
+// them. A |max| of 0 or less means no limit. It returns whether the result was truncated, which is whether the
+// iterator had more rows than |max|. The context is checked between rows, so that reading a large result is stopped
+// by the cancellation of the query or a deadline. The iterator is always closed, even if reading it fails.
+func RowIterToRowsWithLimit(ctx *Context, sch Schema, i RowIter, max int) ([]Row, bool, error) {
+	return AppendRowIterToRows(ctx, nil, sch, i, max)
+}
+
+// AppendRowIterToRows is like RowIterToRowsWithLimit, but appends the rows to |buf| and returns the extended slice,
+// so that callers reading many results can reuse the same buffer. |max| limits the number of rows appended, not the
+// length of the returned slice.
+func AppendRowIterToRows(ctx *Context, buf []Row, sch Schema, i RowIter, max int) (rows []Row, truncated bool, err error) {
+	defer func() {
+		if cerr := i.Close(ctx); err == nil {
+			err = cerr
+		}
+		if err != nil {
+			rows, truncated = nil, false
+		}
+	}()
+
+	if b, ok := i.(BatchRowIter); ok {
+		return appendBatchRowIterToRows(ctx, buf, b, max)
+	}
+
+	rows = buf
+	for n := 0; ; n++ {
+		if n%rowIterCheckInterval == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, false, err
+			}
+		}
+
 		row, err := i.Next(ctx)
 		if err == io.EOF {
-			break
+			return rows, false, nil
+		} else if err != nil {
+			return nil, false, err
 		}
 
-		if err != nil {
-			i.Close(ctx)
-			return nil, err
+		if max > 0 && n == max {
+			return rows, true, nil
 		}
-
 		rows = append(rows, row)
 	}
+}
+
+func appendBatchRowIterToRows(ctx *Context, buf []Row, i BatchRowIter, max int) ([]Row, bool, error) {
+	rows := buf
+	n := 0
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, false, err
+		}
+
+		batch, err := i.NextBatch(ctx)
+		if err == io.EOF {
+			return rows, false, nil
+		} else if err != nil {
+			return nil, false, err
+		}
 
-	return rows, i.Close(ctx)
+		if max > 0 && n+len(batch) > max {
+			return append(rows, batch[:max-n]...), true, nil
+		}
+		rows = append(rows, batch...)
+		n += len(batch)
+	}
 }
 
 func rowFromRow2(sch Schema, r Row2) Row {
@@ -0,0 +1,133 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/src-d/go-errors.v1"
+)
+
+// ErrSchemaDiffNoTable is returned by GenerateAlter when the table of the schemas can't be told from their columns.
+var ErrSchemaDiffNoTable = errors.NewKind("cannot generate ALTER TABLE statements: the columns of the schemas have no source table")
+
+// GenerateAlter returns the ALTER TABLE statements that change the table with the schema |from| into one with the
+// schema |to|, in the order they must be run. The name of the table is the source of the columns of the schemas.
+//
+// Columns are matched by name, ignoring case, so a renamed column is dropped and added again, losing its data.
+// Indexes other than the primary key, foreign keys and checks are not part of the schemas, so they're left as they
+// are.
+func GenerateAlter(from, to PrimaryKeySchema) ([]string, error) {
+	table := schemaSource(to.Schema)
+	if table == "" {
+		table = schemaSource(from.Schema)
+	}
+	if table == "" {
+		return nil, ErrSchemaDiffNoTable.New()
+	}
+	alter := "ALTER TABLE " + QuoteIdentifier(table) + " "
+
+	var stmts []string
+	pkChanged := !strings.EqualFold(strings.Join(primaryKeyColumns(from), ","), strings.Join(primaryKeyColumns(to), ","))
+	if pkChanged && len(from.PkOrdinals) > 0 {
+		stmts = append(stmts, alter+"DROP PRIMARY KEY")
+	}
+
+	// The order of the columns as the statements so far leave it, to tell which columns must be moved
+	var order []string
+	for _, col := range from.Schema {
+		if to.IndexOfColName(col.Name) < 0 {
+			stmts = append(stmts, alter+"DROP COLUMN "+QuoteIdentifier(col.Name))
+		} else {
+			order = append(order, strings.ToLower(col.Name))
+		}
+	}
+
+	for i, col := range to.Schema {
+		position := "FIRST"
+		if i > 0 {
+			position = "AFTER " + QuoteIdentifier(to.Schema[i-1].Name)
+		}
+		name := strings.ToLower(col.Name)
+
+		idx := from.IndexOfColName(col.Name)
+		if idx < 0 {
+			stmts = append(stmts, fmt.Sprintf("%sADD COLUMN %s %s", alter, columnDefinition(col), position))
+			order = append(order[:i], append([]string{name}, order[i:]...)...)
+			continue
+		}
+
+		old := from.Schema[idx]
+		moved := order[i] != name
+		if !moved && old.Name == col.Name && columnDefinitionsEqual(old, col) {
+			continue
+		}
+		stmt := alter + "MODIFY COLUMN " + columnDefinition(col)
+		if old.Name != col.Name {
+			stmt = fmt.Sprintf("%sCHANGE COLUMN %s %s", alter, QuoteIdentifier(old.Name), columnDefinition(col))
+		}
+		if moved {
+			stmt += " " + position
+			for j := i; j < len(order); j++ {
+				if order[j] == name {
+					order = append(order[:j], order[j+1:]...)
+					break
+				}
+			}
+			order = append(order[:i], append([]string{name}, order[i:]...)...)
+		}
+		stmts = append(stmts, stmt)
+	}
+
+	if pkChanged && len(to.PkOrdinals) > 0 {
+		stmts = append(stmts, fmt.Sprintf("%sADD PRIMARY KEY (%s)", alter, strings.Join(QuoteIdentifiers(primaryKeyColumns(to)), ",")))
+	}
+	return stmts, nil
+}
+
+// schemaSource returns the table the columns of the schema given come from, or an empty string if they have none.
+func schemaSource(sch Schema) string {
+	for _, col := range sch {
+		if col.Source != "" {
+			return col.Source
+		}
+	}
+	return ""
+}
+
+// primaryKeyColumns returns the names of the columns of the primary key of the schema given, in key order.
+func primaryKeyColumns(sch PrimaryKeySchema) []string {
+	cols := make([]string, len(sch.PkOrdinals))
+	for i, ord := range sch.PkOrdinals {
+		cols[i] = sch.Schema[ord].Name
+	}
+	return cols
+}
+
+// columnDefinition returns the definition of the column given in an ADD, MODIFY or CHANGE COLUMN clause.
+func columnDefinition(col *Column) string {
+	def := GenerateCreateTableColumnDefinition(col.Name, col.Type, col.Nullable, col.AutoIncrement, col.Default != nil, col.Default.String(), col.Comment)
+	return strings.TrimSpace(def)
+}
+
+// columnDefinitionsEqual returns whether the columns given have the same definition, not counting their names.
+func columnDefinitionsEqual(a, b *Column) bool {
+	return a.Type.Equals(b.Type) &&
+		a.Nullable == b.Nullable &&
+		a.AutoIncrement == b.AutoIncrement &&
+		a.Default.String() == b.Default.String() &&
+		a.Comment == b.Comment
+}
@@ -0,0 +1,116 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+func TestGenerateAlter(t *testing.T) {
+	id := &sql.Column{Name: "id", Type: types.Int64, Source: "t", PrimaryKey: true}
+	a := &sql.Column{Name: "a", Type: types.Int32, Source: "t", Nullable: true}
+	b := &sql.Column{Name: "b", Type: types.Text, Source: "t", Nullable: true}
+	c := &sql.Column{Name: "c", Type: types.Int8, Source: "t", Comment: "it's c"}
+
+	tests := []struct {
+		name     string
+		from     sql.PrimaryKeySchema
+		to       sql.PrimaryKeySchema
+		expected []string
+	}{
+		{
+			name:     "same schema",
+			from:     sql.NewPrimaryKeySchema(sql.Schema{id, a}),
+			to:       sql.NewPrimaryKeySchema(sql.Schema{id, a}),
+			expected: nil,
+		},
+		{
+			name: "added columns",
+			from: sql.NewPrimaryKeySchema(sql.Schema{id, a}),
+			to:   sql.NewPrimaryKeySchema(sql.Schema{c, id, b, a}),
+			expected: []string{
+				"ALTER TABLE `t` ADD COLUMN `c` tinyint NOT NULL COMMENT 'it''s c' FIRST",
+				"ALTER TABLE `t` ADD COLUMN `b` text AFTER `id`",
+			},
+		},
+		{
+			name: "dropped columns",
+			from: sql.NewPrimaryKeySchema(sql.Schema{id, a, b, c}),
+			to:   sql.NewPrimaryKeySchema(sql.Schema{id, c}),
+			expected: []string{
+				"ALTER TABLE `t` DROP COLUMN `a`",
+				"ALTER TABLE `t` DROP COLUMN `b`",
+			},
+		},
+		{
+			name: "modified columns",
+			from: sql.NewPrimaryKeySchema(sql.Schema{id, a, b}),
+			to: sql.NewPrimaryKeySchema(sql.Schema{
+				id,
+				&sql.Column{Name: "a", Type: types.Int64, Source: "t"},
+				&sql.Column{Name: "B", Type: types.Text, Source: "t", Nullable: true},
+			}),
+			expected: []string{
+				"ALTER TABLE `t` MODIFY COLUMN `a` bigint NOT NULL",
+				"ALTER TABLE `t` CHANGE COLUMN `b` `B` text",
+			},
+		},
+		{
+			name: "reordered columns",
+			from: sql.NewPrimaryKeySchema(sql.Schema{id, a, b, c}),
+			to:   sql.NewPrimaryKeySchema(sql.Schema{id, c, a, b}),
+			expected: []string{
+				"ALTER TABLE `t` MODIFY COLUMN `c` tinyint NOT NULL COMMENT 'it''s c' AFTER `id`",
+			},
+		},
+		{
+			name: "changed primary key",
+			from: sql.NewPrimaryKeySchema(sql.Schema{id, c}),
+			to:   sql.NewPrimaryKeySchema(sql.Schema{id, c}, 1, 0),
+			expected: []string{
+				"ALTER TABLE `t` DROP PRIMARY KEY",
+				"ALTER TABLE `t` ADD PRIMARY KEY (`c`,`id`)",
+			},
+		},
+		{
+			name: "added primary key",
+			from: sql.NewPrimaryKeySchema(sql.Schema{c}),
+			to:   sql.NewPrimaryKeySchema(sql.Schema{id, c}),
+			expected: []string{
+				"ALTER TABLE `t` ADD COLUMN `id` bigint NOT NULL FIRST",
+				"ALTER TABLE `t` ADD PRIMARY KEY (`id`)",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			stmts, err := sql.GenerateAlter(test.from, test.to)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, stmts)
+		})
+	}
+
+	t.Run("no source table", func(t *testing.T) {
+		sch := sql.NewPrimaryKeySchema(sql.Schema{{Name: "a", Type: types.Int32}})
+		_, err := sql.GenerateAlter(sch, sch)
+		require.True(t, sql.ErrSchemaDiffNoTable.Is(err))
+	})
+}
@@ -54,6 +54,30 @@ type Client struct {
 	Address string
 	// Capabilities of the client
 	Capabilities uint32
+	// Attributes are the connection attributes sent by the client during the handshake, such as _client_name or
+	// program_name. May be nil if the client sent none, or if the session builder doesn't capture them.
+	Attributes map[string]string
+}
+
+// ConnectionProperties are the properties of the client connection of a session, as negotiated with the client during
+// the handshake.
+type ConnectionProperties struct {
+	// TLS is whether the connection is encrypted with TLS.
+	TLS bool
+	// TLSVersion is the version of TLS used by the connection, such as "TLSv1.3". Empty if TLS isn't used.
+	TLSVersion string
+	// TLSCipher is the name of the cipher suite used by the connection. Empty if TLS isn't used.
+	TLSCipher string
+	// CharacterSet is the character set the client asked for during the handshake.
+	CharacterSet CharacterSetID
+	// Collation is the collation the client asked for during the handshake.
+	Collation CollationID
+	// AuthMethod is the authentication plugin the client was authenticated with, such as "mysql_native_password".
+	AuthMethod string
+	// Compression is the compression algorithm used by the connection. Empty if the connection isn't compressed.
+	Compression string
+	// ProtocolVersion is the version of the client/server protocol used by the connection.
+	ProtocolVersion uint8
 }
 
 // Session holds the session data.
@@ -102,6 +126,12 @@ type Session interface {
 	SetLastQueryInfo(key string, value int64)
 	// GetLastQueryInfo returns the session-level query info for the key given, for the query most recently executed.
 	GetLastQueryInfo(key string) int64
+	// SetHandler registers the HANDLER cursor given under the name given, which is case-insensitive
+	SetHandler(name string, handler *Handler)
+	// GetHandler returns the HANDLER cursor open under the name given, or nil if there is none
+	GetHandler(name string) *Handler
+	// DelHandler closes the HANDLER cursor open under the name given
+	DelHandler(name string)
 	// GetTransaction returns the active transaction, if any
 	GetTransaction() Transaction
 	// SetTransaction sets the session's transaction
@@ -110,6 +140,11 @@ type Session interface {
 	SetIgnoreAutoCommit(ignore bool)
 	// GetIgnoreAutoCommit returns whether this session should ignore the @@autocommit variable
 	GetIgnoreAutoCommit() bool
+	// StateChanged returns whether the state of this session tracked by clients, its current database and its system
+	// variables, changed since ClearStateChanged was last called.
+	StateChanged() bool
+	// ClearStateChanged forgets the changes to the state of this session, before a statement begins.
+	ClearStateChanged()
 	// GetLogger returns the logger for this session, useful if clients want to log messages with the same format / output
 	// as the running server. Clients should instantiate their own global logger with formatting options, and session
 	// implementations should return the logger to be used for the running server.
@@ -151,6 +186,49 @@ type Session interface {
 	SetTransactionDatabase(dbName string)
 	// GetTransactionDatabase returns the name of the database considered in scope when the current transaction began.
 	GetTransactionDatabase() string
+	// ConnectionProperties returns the properties of the client connection of this session.
+	ConnectionProperties() ConnectionProperties
+	// SetConnectionProperties sets the properties of the client connection of this session.
+	SetConnectionProperties(props ConnectionProperties)
+	// GetAttribute returns the attribute stored in this session under the key given, and whether there is one.
+	// Integrators should use SessionAttribute rather than calling it directly.
+	GetAttribute(key interface{}) (interface{}, bool)
+	// SetAttribute stores the attribute given in this session under the key given, replacing any previous one.
+	// Integrators should use SessionAttribute rather than calling it directly.
+	SetAttribute(key interface{}, value interface{})
+}
+
+// SessionAttribute is the key of an attribute of type T stored in sessions, which lets integrators hang their own
+// per-session data on sessions without wrapping them. Each SessionAttribute is a distinct key, even if it has the same
+// name as another one.
+type SessionAttribute[T any] struct {
+	name string
+}
+
+// NewSessionAttribute returns a new SessionAttribute with the name given, which is only used to describe it.
+func NewSessionAttribute[T any](name string) *SessionAttribute[T] {
+	return &SessionAttribute[T]{name: name}
+}
+
+// Name returns the name of this attribute.
+func (a *SessionAttribute[T]) Name() string {
+	return a.name
+}
+
+// Get returns the value of this attribute in the session given, and whether it was set.
+func (a *SessionAttribute[T]) Get(s Session) (T, bool) {
+	if v, ok := s.GetAttribute(a); ok {
+		if t, ok := v.(T); ok {
+			return t, true
+		}
+	}
+	var zero T
+	return zero, false
+}
+
+// Set sets the value of this attribute in the session given.
+func (a *SessionAttribute[T]) Set(s Session, value T) {
+	s.SetAttribute(a, value)
 }
 
 // PersistableSession supports serializing/deserializing global system variables/
@@ -200,6 +278,17 @@ type (
 		Message string
 		Code    int
 	}
+
+	// Handler is a cursor opened on a table with HANDLER ... OPEN. Rows holds the snapshot of the table the cursor was
+	// last positioned over, in the order of Index (or in the table's natural order if Index is empty), and Pos is the
+	// position in Rows of the row last read, which is -1 before the first row and len(Rows) past the last one.
+	Handler struct {
+		Database string
+		Table    string
+		Index    string
+		Rows     []Row
+		Pos      int
+	}
 )
 
 const (
@@ -220,9 +309,14 @@ type Context struct {
 	services    Services
 	pid         uint64
 	query       string
-	queryTime   time.Time
-	tracer      trace.Tracer
-	rootSpan    trace.Span
+	// queryAttributes are the query attributes sent by the client with the query.
+	queryAttributes map[string]string
+	queryTime       time.Time
+	tracer          trace.Tracer
+	rootSpan        trace.Span
+	rowChanges      *RowChangeFeed
+	resultSetWriter ResultSetWriter
+	provider        DatabaseProvider
 }
 
 // ContextOption is a function to configure the context.
@@ -256,6 +350,13 @@ func WithQuery(q string) ContextOption {
 	}
 }
 
+// WithQueryAttributes adds the given query attributes to the context.
+func WithQueryAttributes(attrs map[string]string) ContextOption {
+	return func(ctx *Context) {
+		ctx.queryAttributes = attrs
+	}
+}
+
 // WithMemoryManager adds the given memory manager to the context.
 func WithMemoryManager(m *MemoryManager) ContextOption {
 	return func(ctx *Context) {
@@ -276,6 +377,13 @@ func WithProcessList(p ProcessList) ContextOption {
 	}
 }
 
+// WithRowChangeFeed sets the feed the row changes made by statements are reported to.
+func WithRowChangeFeed(f *RowChangeFeed) ContextOption {
+	return func(ctx *Context) {
+		ctx.rowChanges = f
+	}
+}
+
 // WithServices sets the services for the Context
 func WithServices(services Services) ContextOption {
 	return func(ctx *Context) {
@@ -351,6 +459,27 @@ func NewEmptyContext() *Context { return NewContext(context.TODO()) }
 // Pid returns the process id associated with this context.
 func (c *Context) Pid() uint64 { return c.pid }
 
+// RowChangeFeed returns the feed the row changes made by statements are reported to, which is nil if there is none.
+func (c *Context) RowChangeFeed() *RowChangeFeed { return c.rowChanges }
+
+// ResultSetWriter returns the writer of the result sets of the SELECT statements run by stored procedures, or nil if
+// they're returned by the CALL statements running them.
+func (c *Context) ResultSetWriter() ResultSetWriter { return c.resultSetWriter }
+
+// WithResultSetWriter returns a copy of the context that sends the result sets of the SELECT statements run by stored
+// procedures to the ResultSetWriter given.
+func (c Context) WithResultSetWriter(w ResultSetWriter) *Context {
+	c.resultSetWriter = w
+	return &c
+}
+
+// DatabaseProvider returns the provider the statement of this context was routed to by the engine's StatementRouter,
+// which the catalog uses in place of its own, or nil if it wasn't routed.
+func (c *Context) DatabaseProvider() DatabaseProvider { return c.provider }
+
+// SetDatabaseProvider sets the provider the statement of this context was routed to, or clears it if nil.
+func (c *Context) SetDatabaseProvider(p DatabaseProvider) { c.provider = p }
+
 // Query returns the query string associated with this context.
 func (c *Context) Query() string { return c.query }
 
@@ -359,6 +488,23 @@ func (c Context) WithQuery(q string) *Context {
 	return &c
 }
 
+// QueryAttributes returns the query attributes sent by the client with the query, keyed by name. Attributes with
+// NULL values are omitted. Clients send query attributes with the CLIENT_QUERY_ATTRIBUTES capability, such as with
+// mysql_bind_param, to pass metadata like tracing context along with a query.
+func (c *Context) QueryAttributes() map[string]string { return c.queryAttributes }
+
+// QueryAttribute returns the value of the query attribute with the name given, and whether it was sent.
+func (c *Context) QueryAttribute(name string) (string, bool) {
+	v, ok := c.queryAttributes[name]
+	return v, ok
+}
+
+// WithQueryAttributes returns a copy of the context with the query attributes given.
+func (c Context) WithQueryAttributes(attrs map[string]string) *Context {
+	c.queryAttributes = attrs
+	return &c
+}
+
 // QueryTime returns the time.Time when the context associated with this query was created
 func (c *Context) QueryTime() time.Time {
 	return c.queryTime
@@ -481,16 +627,19 @@ func NewSpanIter(span trace.Span, iter RowIter) RowIter {
 }
 
 type spanIter struct {
-	span  trace.Span
-	iter  RowIter
-	count int
-	max   time.Duration
-	min   time.Duration
-	total time.Duration
-	done  bool
+	span trace.Span
+	iter RowIter
+	// batches reads iter in batches once NextBatch is called.
+	batches BatchRowIter
+	count   int
+	max     time.Duration
+	min     time.Duration
+	total   time.Duration
+	done    bool
 }
 
-var _ RowIter = (*spanIter)(nil)
+var _ RowReuser = (*spanIter)(nil)
+var _ BatchRowIter = (*spanIter)(nil)
 
 func (i *spanIter) updateTimings(start time.Time) {
 	elapsed := time.Since(start)
@@ -524,6 +673,29 @@ func (i *spanIter) Next(ctx *Context) (Row, error) {
 	return row, nil
 }
 
+// NextBatch implements the BatchRowIter interface. Timings are taken per batch rather than per row.
+func (i *spanIter) NextBatch(ctx *Context) ([]Row, error) {
+	if i.batches == nil {
+		i.batches = NewBatchRowIter(i.iter)
+	}
+	start := time.Now()
+
+	rows, err := i.batches.NextBatch(ctx)
+	if err == io.EOF {
+		i.finish()
+		return nil, err
+	}
+
+	if err != nil {
+		i.finishWithError(err)
+		return nil, err
+	}
+
+	i.count += len(rows)
+	i.updateTimings(start)
+	return rows, nil
+}
+
 func (i *spanIter) finish() {
 	var avg time.Duration
 	if i.count > 0 {
@@ -559,6 +731,11 @@ func (i *spanIter) finishWithError(err error) {
 	i.done = true
 }
 
+// ReuseRows implements the RowReuser interface.
+func (i *spanIter) ReuseRows() {
+	ReuseRows(i.iter)
+}
+
 func (i *spanIter) Close(ctx *Context) error {
 	if !i.done {
 		i.finish()
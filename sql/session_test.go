@@ -98,3 +98,53 @@ func TestSessionIterator(t *testing.T) {
 		counter++
 	}
 }
+
+func TestSessionConnectionProperties(t *testing.T) {
+	sess := NewBaseSession()
+	require.Equal(t, ConnectionProperties{}, sess.ConnectionProperties())
+
+	props := ConnectionProperties{
+		TLS:             true,
+		TLSVersion:      "TLSv1.3",
+		TLSCipher:       "TLS_AES_128_GCM_SHA256",
+		CharacterSet:    CharacterSet_utf8mb4,
+		Collation:       Collation_utf8mb4_0900_ai_ci,
+		AuthMethod:      "mysql_native_password",
+		ProtocolVersion: 10,
+	}
+	sess.SetConnectionProperties(props)
+	require.Equal(t, props, sess.ConnectionProperties())
+}
+
+func TestSessionAttribute(t *testing.T) {
+	counter := NewSessionAttribute[int]("counter")
+	name := NewSessionAttribute[string]("name")
+	otherCounter := NewSessionAttribute[int]("counter")
+	require.Equal(t, "counter", counter.Name())
+
+	sess := NewBaseSession()
+	_, ok := counter.Get(sess)
+	require.False(t, ok)
+
+	counter.Set(sess, 1)
+	name.Set(sess, "foo")
+	v, ok := counter.Get(sess)
+	require.True(t, ok)
+	require.Equal(t, 1, v)
+	s, ok := name.Get(sess)
+	require.True(t, ok)
+	require.Equal(t, "foo", s)
+
+	// Attributes with the same name are distinct keys
+	_, ok = otherCounter.Get(sess)
+	require.False(t, ok)
+
+	counter.Set(sess, 2)
+	v, ok = counter.Get(sess)
+	require.True(t, ok)
+	require.Equal(t, 2, v)
+
+	// Attributes aren't shared between sessions
+	_, ok = counter.Get(NewBaseSession())
+	require.False(t, ok)
+}
@@ -17,6 +17,10 @@ package sql
 import (
 	"fmt"
 	"strings"
+	"unicode"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/dolthub/vitess/go/vt/sqlparser"
 )
 
 // All functions here are used together to generate 'CREATE TABLE' statement. Each function takes what it requires
@@ -27,39 +31,84 @@ import (
 // GenerateCreateTableStatement returns 'CREATE TABLE' statement with given table names
 // and column definition statements in order and the collation and character set names for the table
 func GenerateCreateTableStatement(tblName string, colStmts []string, tblCharsetName, tblCollName string) string {
+	// As MySQL does, the collation is left out when it's the default of the character set, unless it's
+	// utf8mb4_0900_ai_ci, whose character set had another default collation in older versions
+	tableOptions := fmt.Sprintf("DEFAULT CHARSET=%s COLLATE=%s", tblCharsetName, tblCollName)
+	if cs, err := ParseCharacterSet(tblCharsetName); err == nil {
+		if cs.DefaultCollation().Name() == tblCollName && tblCollName != Collation_utf8mb4_0900_ai_ci.Name() {
+			tableOptions = fmt.Sprintf("DEFAULT CHARSET=%s", tblCharsetName)
+		}
+	}
 	return fmt.Sprintf(
-		"CREATE TABLE %s (\n%s\n) ENGINE=InnoDB DEFAULT CHARSET=%s COLLATE=%s",
+		"CREATE TABLE %s (\n%s\n) ENGINE=InnoDB %s",
 		QuoteIdentifier(tblName),
 		strings.Join(colStmts, ",\n"),
-		tblCharsetName,
-		tblCollName,
+		tableOptions,
 	)
 }
 
 // GenerateCreateTableColumnDefinition returns column definition string for 'CREATE TABLE' statement for given column.
 // This part comes first in the 'CREATE TABLE' statement.
 func GenerateCreateTableColumnDefinition(colName string, colType Type, nullable bool, autoInc bool, hasDefault bool, colDefault string, comment string) string {
-	stmt := fmt.Sprintf("  %s %s", QuoteIdentifier(colName), colType.String())
+	return GenerateCreateTableColumnDefinitionWithCollation(colName, colType, Collation_Default, nullable, autoInc, hasDefault, colDefault, comment)
+}
+
+// GenerateCreateTableColumnDefinitionWithCollation returns the column definition string for the 'CREATE TABLE'
+// statement of a table with the collation given, like GenerateCreateTableColumnDefinition. The character set and
+// collation of the column are only given when they differ from those of the table.
+func GenerateCreateTableColumnDefinitionWithCollation(colName string, colType Type, tableCollation CollationID, nullable bool, autoInc bool, hasDefault bool, colDefault string, comment string) string {
+	stmt := fmt.Sprintf("  %s %s", QuoteIdentifier(colName), columnTypeDefinition(colType, tableCollation))
 	if !nullable {
 		stmt = fmt.Sprintf("%s NOT NULL", stmt)
-	}
-	if autoInc {
-		stmt = fmt.Sprintf("%s AUTO_INCREMENT", stmt)
+	} else if colType.Type() == sqltypes.Timestamp {
+		// MySQL spells out the nullability of timestamp columns, which were NOT NULL by default in older versions
+		stmt = fmt.Sprintf("%s NULL", stmt)
 	}
 	if c, ok := colType.(SpatialColumnType); ok {
 		if s, d := c.GetSpatialTypeSRID(); d {
-			stmt = fmt.Sprintf("%s SRID %v", stmt, s)
+			stmt = fmt.Sprintf("%s /*!80003 SRID %v */", stmt, s)
 		}
 	}
 	if hasDefault {
 		stmt = fmt.Sprintf("%s DEFAULT %s", stmt, colDefault)
 	}
+	if autoInc {
+		stmt = fmt.Sprintf("%s AUTO_INCREMENT", stmt)
+	}
 	if comment != "" {
-		stmt = fmt.Sprintf("%s COMMENT '%s'", stmt, comment)
+		stmt = fmt.Sprintf("%s COMMENT %s", stmt, QuoteStringLiteral(comment))
 	}
 	return stmt
 }
 
+// columnTypeDefinition returns the definition of the column type given in a table with the collation given. Types
+// render their character set and collation relative to the server's default collation, so those are replaced with
+// both the character set and collation of the type when it differs from the table's.
+func columnTypeDefinition(colType Type, tableCollation CollationID) string {
+	def := colType.String()
+	ct, ok := colType.(interface{ Collation() CollationID })
+	if !ok {
+		return def
+	}
+	collation := ct.Collation()
+	if collation.CharacterSet() == CharacterSet_binary {
+		return def
+	}
+
+	var suffix string
+	if collation.CharacterSet() != Collation_Default.CharacterSet() {
+		suffix += " CHARACTER SET " + collation.CharacterSet().String()
+	}
+	if collation != Collation_Default {
+		suffix += " COLLATE " + collation.Name()
+	}
+	def = strings.TrimSuffix(def, suffix)
+	if collation != tableCollation {
+		def = fmt.Sprintf("%s CHARACTER SET %s COLLATE %s", def, collation.CharacterSet().String(), collation.Name())
+	}
+	return def
+}
+
 // GenerateCreateTablePrimaryKeyDefinition returns primary key definition string for 'CREATE TABLE' statement
 // for given column(s). This part comes after each column definitions.
 func GenerateCreateTablePrimaryKeyDefinition(pkCols []string) string {
@@ -80,7 +129,7 @@ func GenerateCreateTableIndexDefinition(isUnique, isSpatial bool, indexID string
 	}
 	key := fmt.Sprintf("  %s%sKEY %s (%s)", unique, spatial, QuoteIdentifier(indexID), strings.Join(indexCols, ","))
 	if comment != "" {
-		key = fmt.Sprintf("%s COMMENT '%s'", key, comment)
+		key = fmt.Sprintf("%s COMMENT %s", key, QuoteStringLiteral(comment))
 	}
 	return key
 }
@@ -117,6 +166,49 @@ func QuoteIdentifier(id string) string {
 	return fmt.Sprintf("`%s`", id)
 }
 
+// QuoteStringLiteral wraps the specified string in single quotes, escaping it as MySQL does in the output of SHOW
+// statements: single quotes are doubled, and backslashes and control characters are escaped with a backslash.
+func QuoteStringLiteral(str string) string {
+	var sb strings.Builder
+	sb.WriteByte('\'')
+	for _, c := range []byte(str) {
+		switch c {
+		case 0:
+			sb.WriteString(`\0`)
+		case '\n':
+			sb.WriteString(`\n`)
+		case '\r':
+			sb.WriteString(`\r`)
+		case '\032':
+			sb.WriteString(`\Z`)
+		case '\\':
+			sb.WriteString(`\\`)
+		case '\'':
+			sb.WriteString(`''`)
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	sb.WriteByte('\'')
+	return sb.String()
+}
+
+// QuoteIdentifierIfNeeded returns the identifier given wrapped in backticks, like QuoteIdentifier, if it can't be
+// written unquoted, because it's a keyword or has characters other than letters, digits and underscores,
+// or if it has upper case letters, which makes its case matter with case-sensitive table names. Otherwise, it's
+// returned as is.
+func QuoteIdentifierIfNeeded(id string) string {
+	if id == "" {
+		return id
+	}
+	for _, c := range id {
+		if unicode.IsUpper(c) || c == '@' {
+			return QuoteIdentifier(id)
+		}
+	}
+	return sqlparser.String(sqlparser.NewTableIdent(id))
+}
+
 // QuoteIdentifiers wraps each of the specified identifiers in backticks, escapes all occurrences of backticks in
 // the identifier, and returns a slice of the quoted identifiers.
 func QuoteIdentifiers(ids []string) []string {
@@ -0,0 +1,65 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuoteIdentifierIfNeeded(t *testing.T) {
+	tests := []struct {
+		id       string
+		expected string
+	}{
+		{"mytable", "mytable"},
+		{"my_table2", "my_table2"},
+		{"", ""},
+		{"MyTable", "`MyTable`"},
+		{"PRIMARY", "`PRIMARY`"},
+		{"select", "`select`"},
+		{"my.table", "`my.table`"},
+		{"my table", "`my table`"},
+		{"2table", "`2table`"},
+		{"my`table", "`my``table`"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.id, func(t *testing.T) {
+			require.Equal(t, test.expected, QuoteIdentifierIfNeeded(test.id))
+		})
+	}
+}
+
+func TestQuoteStringLiteral(t *testing.T) {
+	tests := []struct {
+		str      string
+		expected string
+	}{
+		{"", "''"},
+		{"abc", "'abc'"},
+		{"it's", "'it''s'"},
+		{`back\slash`, `'back\\slash'`},
+		{"new\nline", `'new\nline'`},
+		{"\r\x00\x1a", `'\r\0\Z'`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.str, func(t *testing.T) {
+			require.Equal(t, test.expected, QuoteStringLiteral(test.str))
+		})
+	}
+}
@@ -29,6 +29,93 @@ type StatisticsTable interface {
 	RowCount(ctx *Context) (uint64, error)
 }
 
+// TableSizeStatisticsTable is a StatisticsTable that can also estimate the size of its indexes and report when its data
+// was last modified, as shown by SHOW TABLE STATUS and information_schema.tables.
+type TableSizeStatisticsTable interface {
+	StatisticsTable
+	// IndexLength returns the estimated number of bytes used by the secondary indexes of this table.
+	IndexLength(ctx *Context) (uint64, error)
+	// UpdateTime returns the time this table's data was last modified, or false if it hasn't been modified since it was
+	// created or loaded.
+	UpdateTime(ctx *Context) (time.Time, bool, error)
+}
+
+// CreateTimeTable is a table that knows when it was created, as shown by SHOW TABLE STATUS and
+// information_schema.tables.
+type CreateTimeTable interface {
+	Table
+	// CreateTime returns the time this table was created, or false if it isn't known.
+	CreateTime(ctx *Context) (time.Time, bool, error)
+}
+
+// ModificationCountingTable is a table that counts the rows modified by inserts, updates and deletes, which lets the
+// engine tell how much its data has changed since its statistics were last collected.
+type ModificationCountingTable interface {
+	Table
+	// RowsModified returns the number of rows inserted, updated or deleted in this table since it was created or
+	// loaded. The count only ever grows.
+	RowsModified(ctx *Context) (uint64, error)
+}
+
+// TableRowCounts are the numbers of rows changed in a table by each kind of statement.
+type TableRowCounts struct {
+	Inserted uint64
+	Updated  uint64
+	Deleted  uint64
+}
+
+// RowCountingTable is a table that counts the rows it has had inserted, updated and deleted, which are reported in
+// sys.schema_table_statistics.
+type RowCountingTable interface {
+	Table
+	// RowCounts returns the number of rows inserted, updated and deleted in this table since it was created or
+	// loaded. The counts only ever grow.
+	RowCounts(ctx *Context) (TableRowCounts, error)
+}
+
+// StatsAutoRecalc is the STATS_AUTO_RECALC option of a table, which controls whether its statistics are recalculated
+// automatically once enough of its rows have changed.
+type StatsAutoRecalc byte
+
+const (
+	// StatsAutoRecalc_Default defers to the innodb_stats_auto_recalc system variable.
+	StatsAutoRecalc_Default StatsAutoRecalc = iota
+	StatsAutoRecalc_On
+	StatsAutoRecalc_Off
+)
+
+// String returns the value of the option as written in a CREATE TABLE statement.
+func (r StatsAutoRecalc) String() string {
+	switch r {
+	case StatsAutoRecalc_On:
+		return "1"
+	case StatsAutoRecalc_Off:
+		return "0"
+	default:
+		return "DEFAULT"
+	}
+}
+
+// StatsAutoRecalcTable is a table that stores its STATS_AUTO_RECALC option.
+type StatsAutoRecalcTable interface {
+	Table
+	// StatsAutoRecalc returns the STATS_AUTO_RECALC option of this table.
+	StatsAutoRecalc() StatsAutoRecalc
+	// SetStatsAutoRecalc sets the STATS_AUTO_RECALC option of this table.
+	SetStatsAutoRecalc(ctx *Context, recalc StatsAutoRecalc) error
+}
+
+// StatisticsDatabase is a database that stores the statistics collected for its tables, so that integrators can
+// persist them along with the rest of the database. Databases that don't implement this interface have their
+// statistics kept in memory by the engine and lost on restart.
+type StatisticsDatabase interface {
+	Database
+	// GetTableStatistics returns the statistics stored for the table named, or false if there are none.
+	GetTableStatistics(ctx *Context, table string) (*TableStatistics, bool, error)
+	// SetTableStatistics stores the statistics given for the table named, replacing any stored before.
+	SetTableStatistics(ctx *Context, table string, stats *TableStatistics) error
+}
+
 type StatsReader interface {
 	CatalogTable
 	// Hist returns a HistogramMap providing statistics for a table's columns
@@ -48,6 +135,17 @@ type StatsReadWriter interface {
 	StatsWriter
 }
 
+// StatsAutoRecalculator is a StatsReadWriter that can tell when the statistics of a table are stale enough to be
+// recalculated automatically. The engine checks the tables of every query it runs and recalculates the stale ones in
+// the background.
+type StatsAutoRecalculator interface {
+	StatsReadWriter
+	// NeedsRecalc returns whether the statistics of the table given should be recalculated: the table has statistics,
+	// automatic recalculation is enabled for it, and the share of its rows modified since its statistics were
+	// collected exceeds the stats_auto_recalc_threshold system variable.
+	NeedsRecalc(ctx *Context, db, table string) (bool, error)
+}
+
 // HistogramBucket represents a bucket in a histogram
 // inspiration pulled from MySQL and Cockroach DB
 type HistogramBucket struct {
@@ -78,6 +176,9 @@ type TableStatistics struct {
 	CreatedAt time.Time
 	// Histograms returns a map from all column names to their associated histograms.
 	Histograms HistogramMap
+	// RowsModified is the table's count of modified rows, as returned by ModificationCountingTable, at the time these
+	// statistics were generated.
+	RowsModified uint64
 }
 
 func (ts *TableStatistics) Histogram(colName string) (*Histogram, error) {
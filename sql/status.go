@@ -0,0 +1,100 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+// StatusCounter is a status variable counting events, like MySQL's Sort_merge_passes. It has a value for every session,
+// counting the events of the session, and a global value, counting the events of all sessions. Unlike system
+// variables, status variables can't be set by users.
+type StatusCounter struct {
+	name    string
+	global  uint64
+	session *SessionAttribute[uint64]
+}
+
+var statusCounters = struct {
+	mu       sync.RWMutex
+	counters map[string]*StatusCounter
+}{counters: make(map[string]*StatusCounter)}
+
+// NewStatusCounter returns a new StatusCounter with the name given, shown by SHOW STATUS. It replaces any counter with
+// the same name.
+func NewStatusCounter(name string) *StatusCounter {
+	c := &StatusCounter{name: name, session: NewSessionAttribute[uint64](name)}
+	statusCounters.mu.Lock()
+	defer statusCounters.mu.Unlock()
+	statusCounters.counters[name] = c
+	return c
+}
+
+// StatusCounters returns all status counters, ordered by name.
+func StatusCounters() []*StatusCounter {
+	statusCounters.mu.RLock()
+	defer statusCounters.mu.RUnlock()
+	counters := make([]*StatusCounter, 0, len(statusCounters.counters))
+	for _, c := range statusCounters.counters {
+		counters = append(counters, c)
+	}
+	sort.Slice(counters, func(i, j int) bool {
+		return counters[i].name < counters[j].name
+	})
+	return counters
+}
+
+// Name returns the name of this counter.
+func (c *StatusCounter) Name() string {
+	return c.name
+}
+
+// Increment adds |delta| to the value of this counter for the session of the context given, and to its global value.
+func (c *StatusCounter) Increment(ctx *Context, delta uint64) {
+	atomic.AddUint64(&c.global, delta)
+	if ctx != nil && ctx.Session != nil {
+		v, _ := c.session.Get(ctx.Session)
+		c.session.Set(ctx.Session, v+delta)
+	}
+}
+
+// SessionValue returns the value of this counter for the session given.
+func (c *StatusCounter) SessionValue(s Session) uint64 {
+	v, _ := c.session.Get(s)
+	return v
+}
+
+// ResetSessionValue sets the value of this counter for the session given to zero. Its global value is unchanged.
+func (c *StatusCounter) ResetSessionValue(s Session) {
+	c.session.Set(s, 0)
+}
+
+// FlushSessionStatus resets the values of all status counters for the session given, like FLUSH STATUS. Their global
+// values, which already count the events of the session, are unchanged.
+func FlushSessionStatus(s Session) {
+	for _, c := range StatusCounters() {
+		c.ResetSessionValue(s)
+	}
+}
+
+// GlobalValue returns the value of this counter for all sessions.
+func (c *StatusCounter) GlobalValue() uint64 {
+	return atomic.LoadUint64(&c.global)
+}
+
+// SortMergePasses counts the merge passes of sorts that spilled their rows to disk.
+var SortMergePasses = NewStatusCounter("Sort_merge_passes")
@@ -24,9 +24,13 @@ type TableRowIter struct {
 	partitions PartitionIter
 	partition  Partition
 	rows       RowIter
+	// batches reads rows in batches once NextBatch is called.
+	batches   BatchRowIter
+	reuseRows bool
 }
 
-var _ RowIter = (*TableRowIter)(nil)
+var _ RowReuser = (*TableRowIter)(nil)
+var _ BatchRowIter = (*TableRowIter)(nil)
 
 // NewTableRowIter returns a new iterator over the rows in the partitions of the table given.
 func NewTableRowIter(ctx *Context, table Table, partitions PartitionIter) *TableRowIter {
@@ -38,16 +42,70 @@ func (i *TableRowIter) Next(ctx *Context) (Row, error) {
 		return nil, ctx.Err()
 	}
 
+	if err := i.openPartition(ctx); err != nil {
+		return nil, err
+	}
+
+	row, err := i.rows.Next(ctx)
+	if err != nil && err == io.EOF {
+		if err = i.rows.Close(ctx); err != nil {
+			return nil, err
+		}
+
+		i.partition = nil
+		i.rows = nil
+		row, err = i.Next(ctx)
+	}
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+	return row, err
+}
+
+// NextBatch implements the BatchRowIter interface. Each batch holds rows of a single partition, read in batches if
+// the partition's iterator supports it.
+func (i *TableRowIter) NextBatch(ctx *Context) ([]Row, error) {
+	for {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		if err := i.openPartition(ctx); err != nil {
+			return nil, err
+		}
+		if i.batches == nil {
+			i.batches = NewBatchRowIter(i.rows)
+		}
+
+		rows, err := i.batches.NextBatch(ctx)
+		if err != io.EOF {
+			return rows, err
+		}
+		if err = i.rows.Close(ctx); err != nil {
+			return nil, err
+		}
+
+		i.partition = nil
+		i.rows = nil
+		i.batches = nil
+	}
+}
+
+// openPartition opens the next partition and its rows, unless the current one hasn't been read to the end. It
+// returns io.EOF when there are no partitions left.
+func (i *TableRowIter) openPartition(ctx *Context) error {
 	if i.partition == nil {
 		partition, err := i.partitions.Next(ctx)
 		if err != nil {
 			if err == io.EOF {
 				if e := i.partitions.Close(ctx); e != nil {
-					return nil, e
+					return e
 				}
 			}
 
-			return nil, err
+			return err
 		}
 
 		i.partition = partition
@@ -56,28 +114,24 @@ func (i *TableRowIter) Next(ctx *Context) (Row, error) {
 	if i.rows == nil {
 		rows, err := i.table.PartitionRows(ctx, i.partition)
 		if err != nil {
-			return nil, err
+			return err
 		}
 
+		if i.reuseRows {
+			ReuseRows(rows)
+		}
 		i.rows = rows
 	}
+	return nil
+}
 
-	row, err := i.rows.Next(ctx)
-	if err != nil && err == io.EOF {
-		if err = i.rows.Close(ctx); err != nil {
-			return nil, err
-		}
-
-		i.partition = nil
-		i.rows = nil
-		row, err = i.Next(ctx)
-	}
-	select {
-	case <-ctx.Done():
-		return nil, ctx.Err()
-	default:
+// ReuseRows implements the RowReuser interface. The rows of each partition are reused if the table's iterators
+// support it.
+func (i *TableRowIter) ReuseRows() {
+	i.reuseRows = true
+	if i.rows != nil {
+		ReuseRows(i.rows)
 	}
-	return row, err
 }
 
 func (i *TableRowIter) Close(ctx *Context) error {
@@ -106,6 +106,18 @@ type IndexedTable interface {
 	LookupPartitions(*Context, IndexLookup) (PartitionIter, error)
 }
 
+// IndexConditionPushdownTable is an IndexedTable that can filter the entries of its index scans on a condition over
+// the columns of the index, before the rest of each row is read, like MySQL's index condition pushdown.
+type IndexConditionPushdownTable interface {
+	IndexedTable
+	// WithIndexCondition returns a version of this table whose lookups only return the rows with index entries
+	// matching the condition given. The fields of the condition are indexed by the position of their column in the
+	// index, and none of the columns are indexed by a prefix of their values.
+	WithIndexCondition(cond Expression) IndexedTable
+	// IndexCondition returns the condition of this table's index scans, or nil if it has none.
+	IndexCondition() Expression
+}
+
 // IndexAlterableTable represents a table that supports index modification operations.
 type IndexAlterableTable interface {
 	Table
@@ -253,6 +265,15 @@ type TruncateableTable interface {
 	Truncate(*Context) (int, error)
 }
 
+// VerifiableTable is a table that can verify the consistency of its data, such as that its indexes agree with its
+// rows, for CHECK TABLE statements.
+type VerifiableTable interface {
+	Table
+	// Verify returns a description of each inconsistency found in the table's data, or none if the data is consistent.
+	// An error is returned only if the verification itself fails.
+	Verify(*Context) ([]string, error)
+}
+
 // AutoIncrementTable is a table that supports AUTO_INCREMENT. Getter and Setter methods access the table's
 // AUTO_INCREMENT sequence. These methods should only be used for tables with and AUTO_INCREMENT column in their schema.
 type AutoIncrementTable interface {
@@ -15,6 +15,7 @@
 package types
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
@@ -25,6 +26,7 @@ import (
 	"github.com/shopspring/decimal"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/encodings"
 )
 
 // ApproximateTypeFromValue returns the closest matching type to the given value. For example, an int16 will return SMALLINT.
@@ -505,6 +507,8 @@ func TypesEqual(a, b sql.Type) bool {
 	// Some types cannot be compared structurally as they contain non-comparable types (such as slices), so we handle
 	// those separately.
 	switch at := a.(type) {
+	case systemEnumType, systemSetType:
+		return a.Equals(b)
 	case EnumType:
 		aEnumType := at
 		bEnumType := b.(EnumType)
@@ -545,3 +549,333 @@ func TypesEqual(a, b sql.Type) bool {
 		return a == b
 	}
 }
+
+// GeneralizeTypes returns the type that values of both types given are converted to when they're results of the same
+// expression, such as the branches of CASE, IF, IFNULL and COALESCE. This follows MySQL's aggregation of types:
+// https://dev.mysql.com/doc/refman/8.0/en/flow-control-functions.html#operator_case
+// NULL takes the other type, types that are equal are kept, numbers are generalized to a number type that holds both without loss, dates and times
+// to DATETIME, and anything else to a string type. Strings take the collation of both types if they're compatible,
+// but since types don't carry coercibility, callers that know where the values come from should resolve collations
+// themselves.
+func GeneralizeTypes(a, b sql.Type) sql.Type {
+	if a == nil || a == Null {
+		return b
+	}
+	if b == nil || b == Null {
+		return a
+	}
+	if TypesEqual(a, b) {
+		return a
+	}
+	if IsNumber(a) && IsNumber(b) {
+		return generalizeNumberTypes(a, b)
+	}
+	if IsTime(a) && IsTime(b) {
+		return Datetime
+	}
+	if (IsTime(a) || IsTimespan(a)) && (IsTime(b) || IsTimespan(b)) {
+		return Datetime
+	}
+	if IsJSON(a) && IsJSON(b) {
+		return JSON
+	}
+	return generalizeStringTypes(a, b)
+}
+
+// generalizeStringTypes returns the type of GeneralizeTypes for two types whose values are aggregated as strings. The
+// result is long enough for the values of both types: it's a CHAR if both types are, a TEXT if either is a TEXT, and a
+// VARCHAR otherwise. Types whose values don't have a known maximum length as strings, such as JSON, make the result a
+// LONGTEXT. If either type is binary, so is the result.
+func generalizeStringTypes(a, b sql.Type) sql.Type {
+	collation := sql.Collation_binary
+	if !IsBinaryType(a) && !IsBinaryType(b) {
+		collation = sql.Collation_Default
+		aColl, aOk := typeCollation(a)
+		bColl, bOk := typeCollation(b)
+		switch {
+		case aOk && bOk:
+			collation = resolveCollations(aColl, bColl)
+		case aOk:
+			collation = aColl
+		case bOk:
+			collation = bColl
+		}
+	}
+
+	aLength, bLength := stringLength(a), stringLength(b)
+	if aLength >= 0 && bLength >= 0 {
+		length := aLength
+		if bLength > length {
+			length = bLength
+		}
+		baseType := sqltypes.VarChar
+		switch {
+		case a.Type() == sqltypes.Char && b.Type() == sqltypes.Char,
+			a.Type() == sqltypes.Binary && b.Type() == sqltypes.Binary:
+			baseType = sqltypes.Char
+		case IsTextBlob(a) || IsTextBlob(b):
+			// TEXT lengths are in bytes rather than characters
+			baseType = sqltypes.Text
+			length *= collation.CharacterSet().MaxLength()
+		}
+		if st, err := CreateString(baseType, length, collation); err == nil {
+			return st
+		}
+	}
+
+	switch collation {
+	case sql.Collation_binary:
+		return LongBlob
+	case sql.Collation_Default:
+		return LongText
+	default:
+		return CreateLongText(collation)
+	}
+}
+
+// stringLength returns the maximum number of characters of the values of the type given when they're converted to
+// strings, or -1 if it isn't known.
+func stringLength(t sql.Type) int64 {
+	if st, ok := t.(sql.StringType); ok {
+		return st.MaxCharacterLength()
+	}
+	if ct, ok := t.(sql.TypeWithCollation); ok {
+		// ENUM and SET lengths are in bytes of their character set
+		return int64(t.MaxTextResponseByteLength(nil)) / ct.Collation().CharacterSet().MaxLength()
+	}
+	if IsNumber(t) || IsTime(t) || IsTimespan(t) || t == Year {
+		return int64(t.MaxTextResponseByteLength(nil))
+	}
+	return -1
+}
+
+// generalizeNumberTypes returns the type of GeneralizeTypes for two different number types. Integers are generalized
+// to 64 bits, and to a DECIMAL when one is an unsigned BIGINT and the other is signed. A DECIMAL keeps the largest
+// number of integer digits and scale of both types, and any floating point type makes the result a DOUBLE.
+func generalizeNumberTypes(a, b sql.Type) sql.Type {
+	if IsFloat(a) || IsFloat(b) {
+		return Float64
+	}
+	if IsDecimal(a) || IsDecimal(b) ||
+		a == Uint64 && IsSigned(b) || b == Uint64 && IsSigned(a) {
+		aDigits, aScale := decimalDigits(a)
+		bDigits, bScale := decimalDigits(b)
+		digits, scale := aDigits, aScale
+		if bDigits > digits {
+			digits = bDigits
+		}
+		if bScale > scale {
+			scale = bScale
+		}
+		if scale > DecimalTypeMaxScale {
+			scale = DecimalTypeMaxScale
+		}
+		if digits+scale > DecimalTypeMaxPrecision {
+			digits = DecimalTypeMaxPrecision - scale
+		}
+		return MustCreateDecimalType(uint8(digits+scale), uint8(scale))
+	}
+	if IsUnsigned(a) && IsUnsigned(b) {
+		return Uint64
+	}
+	return Int64
+}
+
+// decimalDigits returns the number of integer digits and the scale a DECIMAL needs to hold the values of the number
+// type given.
+func decimalDigits(t sql.Type) (int, int) {
+	if dt, ok := t.(sql.DecimalType); ok {
+		return int(dt.Precision() - dt.Scale()), int(dt.Scale())
+	}
+	switch t {
+	case Int8, Uint8:
+		return 3, 0
+	case Int16, Uint16:
+		return 5, 0
+	case Int24, Uint24:
+		return 8, 0
+	case Int32, Uint32:
+		return 10, 0
+	case Int64:
+		return 19, 0
+	default:
+		return 20, 0
+	}
+}
+
+// typeCollation returns the collation of the string, enum or set type given.
+func typeCollation(t sql.Type) (sql.CollationID, bool) {
+	if ct, ok := t.(sql.TypeWithCollation); ok {
+		return ct.Collation(), true
+	}
+	return sql.Collation_Unspecified, false
+}
+
+// resolveCollations returns the collation of two string types with the same coercibility. Unlike the other Unicode
+// character sets, utf8mb4 is a superset of utf8mb3, so values of both are aggregated to utf8mb4 rather than binary.
+func resolveCollations(a, b sql.CollationID) sql.CollationID {
+	aCharset, bCharset := a.CharacterSet(), b.CharacterSet()
+	switch {
+	case aCharset == sql.CharacterSet_utf8mb4 && bCharset == sql.CharacterSet_utf8mb3:
+		return a
+	case aCharset == sql.CharacterSet_utf8mb3 && bCharset == sql.CharacterSet_utf8mb4:
+		return b
+	}
+	collation, _ := sql.ResolveCoercibility(a, 4, b, 4)
+	return collation
+}
+
+// columnChangeIgnoreKey is the key of the context value set by WithIgnoredColumnChangeErrors.
+type columnChangeIgnoreKey struct{}
+
+// WithIgnoredColumnChangeErrors returns a copy of the context given in which ConvertForColumnChange converts values as
+// it does when the sql_mode of the session isn't strict, as ALTER IGNORE TABLE does.
+func WithIgnoredColumnChangeErrors(ctx *sql.Context) *sql.Context {
+	return ctx.WithContext(context.WithValue(ctx.Context, columnChangeIgnoreKey{}, true))
+}
+
+// ConvertForColumnChange converts |val|, the value of |col| at row |rowNum| of a table, to the type of |col|, as
+// ALTER TABLE does when it changes the type of a column. A value that can't be converted exactly is an error when the
+// sql_mode of the session is strict, unless the context is one returned by WithIgnoredColumnChangeErrors. Otherwise,
+// the closest valid value is used instead and a warning is added to the session: strings are truncated to the length
+// of the column, the characters of strings that can't be encoded in the character set of the column are replaced with
+// '?', numbers are clamped to the range of the type, and any other value is replaced with the zero value of the type.
+func ConvertForColumnChange(ctx *sql.Context, col *sql.Column, val interface{}, rowNum int) (interface{}, error) {
+	converted, inRange, err := col.Type.Convert(val)
+	if err == nil && inRange {
+		str, ok := converted.(string)
+		collation, hasCollation := typeCollation(col.Type)
+		if !ok || !hasCollation {
+			return converted, nil
+		}
+		replaced, invalid := replaceUnencodable(collation.CharacterSet(), str)
+		if invalid == "" {
+			return converted, nil
+		}
+		return warnForColumnChange(ctx, sql.ErrIncorrectValueForColumn.New("string", invalid, col.Name, rowNum), replaced)
+	}
+
+	var convErr error
+	switch {
+	case err == nil:
+		convErr = sql.ErrValueOutOfRangeForColumn.New(col.Name, rowNum)
+		if converted == nil {
+			converted = col.Type.Zero()
+		}
+	case ErrLengthBeyondLimit.Is(err):
+		convErr = sql.ErrDataTruncatedForColumn.New(col.Name, rowNum)
+		converted, err = truncateForColumn(col.Type, val)
+		if err != nil {
+			return nil, err
+		}
+	case sql.ErrInvalidValue.Is(err), ErrConvertingToTime.Is(err), ErrConvertingToTimeType.Is(err),
+		ErrConvertingToYear.Is(err), ErrConvertingToDecimal.Is(err), ErrConvertingToEnum.Is(err):
+		convErr = sql.ErrIncorrectValueForColumn.New(col.Type.String(), val, col.Name, rowNum)
+		converted = col.Type.Zero()
+	case sql.ErrNotMatchingSRID.Is(err):
+		return nil, sql.ErrNotMatchingSRIDWithColName.New(col.Name, err)
+	default:
+		return nil, err
+	}
+
+	return warnForColumnChange(ctx, convErr, converted)
+}
+
+// warnForColumnChange returns the error given when the sql_mode of the session is strict and errors aren't ignored in
+// the context given, as described by ConvertForColumnChange. Otherwise, it adds the error as a warning to the session
+// and returns the value given, which replaces the value that couldn't be converted.
+func warnForColumnChange(ctx *sql.Context, convErr error, converted interface{}) (interface{}, error) {
+	if ctx.Value(columnChangeIgnoreKey{}) == nil {
+		strict, err := isStrictSqlMode(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if strict {
+			return nil, convErr
+		}
+	}
+	ctx.Warn(sql.CastSQLError(convErr).Num, "%s", convErr.Error())
+	return converted, nil
+}
+
+// replaceUnencodable returns the string given with the characters that can't be encoded in the character set given
+// replaced with '?', and the part of the string starting at the first of them as MySQL shows it in errors. The latter
+// is empty when every character can be encoded.
+func replaceUnencodable(charset sql.CharacterSetID, str string) (string, string) {
+	encoder := charset.Encoder()
+	if _, ok := encoder.Encode(encodings.StringToBytes(str)); ok {
+		return str, ""
+	}
+	var sb strings.Builder
+	invalid := ""
+	for i, r := range str {
+		if _, ok := encoder.EncodeRune([]byte(string(r))); ok {
+			sb.WriteRune(r)
+			continue
+		}
+		if invalid == "" {
+			invalid = invalidStringValue(str[i:])
+		}
+		sb.WriteByte('?')
+	}
+	return sb.String(), invalid
+}
+
+// invalidStringValue returns the start of an invalid string value as MySQL shows it in errors: its first 6 bytes, with
+// the bytes that aren't printable ASCII characters in hexadecimal, followed by an ellipsis if there are more.
+func invalidStringValue(str string) string {
+	var sb strings.Builder
+	for i := 0; i < len(str) && i < 6; i++ {
+		if b := str[i]; b >= 0x20 && b < 0x7f {
+			sb.WriteByte(b)
+		} else {
+			fmt.Fprintf(&sb, "\\x%02X", b)
+		}
+	}
+	if len(str) > 6 {
+		sb.WriteString("...")
+	}
+	return sb.String()
+}
+
+// truncateForColumn truncates the string form of |val| to the length of the string type given, and returns it
+// converted to that type.
+func truncateForColumn(t sql.Type, val interface{}) (interface{}, error) {
+	st, ok := t.(StringType)
+	if !ok {
+		return nil, ErrLengthBeyondLimit.New(val, t.String())
+	}
+	str, err := ConvertToString(val, LongText)
+	if err != nil {
+		return nil, err
+	}
+	if st.baseType == sqltypes.Text {
+		// TEXT types are limited by their byte length
+		if int64(len(str)) > st.maxByteLength {
+			str = str[:st.maxByteLength]
+		}
+	} else if st.CharacterSet().MaxLength() == 1 {
+		if int64(len(str)) > st.maxCharLength {
+			str = str[:st.maxCharLength]
+		}
+	} else if runes := []rune(str); int64(len(runes)) > st.maxCharLength {
+		str = string(runes[:st.maxCharLength])
+	}
+	converted, _, err := t.Convert(str)
+	return converted, err
+}
+
+// isStrictSqlMode returns whether the sql_mode of the session of the context given is strict, that is, whether invalid
+// data is rejected rather than adjusted.
+func isStrictSqlMode(ctx *sql.Context) (bool, error) {
+	sysVal, err := ctx.GetSessionVariable(ctx, "sql_mode")
+	if err != nil {
+		return false, err
+	}
+	sqlMode, ok := sysVal.(string)
+	if !ok {
+		return false, sql.ErrSystemVariableCodeFail.New("sql_mode", sysVal)
+	}
+	return strings.Contains(sqlMode, "STRICT_TRANS_TABLES") || strings.Contains(sqlMode, "STRICT_ALL_TABLES") ||
+		strings.Contains(sqlMode, "TRADITIONAL"), nil
+}
@@ -64,6 +64,7 @@ var (
 		"2006-01-02",
 		"2006-1-2",
 		"2006-1-2 15:4:5.999999",
+		"2006-1-2 15:4",
 		time.RFC3339,
 		time.RFC3339Nano,
 		"2006-01-02T15:04:05",
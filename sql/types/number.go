@@ -21,6 +21,7 @@ import (
 	"reflect"
 	"regexp"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/dolthub/vitess/go/sqltypes"
@@ -82,7 +83,7 @@ var (
 	numberFloat32ValueType = reflect.TypeOf(float32(0))
 	numberFloat64ValueType = reflect.TypeOf(float64(0))
 
-	numre = regexp.MustCompile(`^[ ]*[0-9]*\.?[0-9]+`)
+	numre = regexp.MustCompile(`^[+-]?([0-9]+\.?[0-9]*|\.[0-9]+)([eE][+-]?[0-9]+)?`)
 )
 
 type NumberTypeImpl_ struct {
@@ -1290,10 +1291,11 @@ func convertToFloat64(t NumberTypeImpl_, v interface{}) (float64, error) {
 		}
 		return float64(i), nil
 	case string:
-		i, err := strconv.ParseFloat(v, 64)
-		if err != nil {
-			// parse the first longest valid numbers
-			s := numre.FindString(v)
+		trimmed := strings.TrimSpace(v)
+		i, err := strconv.ParseFloat(trimmed, 64)
+		if err != nil || math.IsInf(i, 0) || math.IsNaN(i) {
+			// parse the first longest valid numbers, MySQL does not accept inf or nan
+			s := numre.FindString(trimmed)
 			i, _ = strconv.ParseFloat(s, 64)
 			return i, sql.ErrInvalidValue.New(v, t.String())
 		}
@@ -33,9 +33,10 @@ type systemSetType struct {
 var _ sql.SystemVariableType = systemSetType{}
 var _ sql.CollationCoercible = systemSetType{}
 
-// NewSystemSetType returns a new systemSetType.
+// NewSystemSetType returns a new systemSetType. Like in MySQL, members are matched case-insensitively, so values such as
+// 'ansi_quotes,no_zero_date' are accepted and normalized to the declared members.
 func NewSystemSetType(varName string, values ...string) sql.SystemVariableType {
-	return systemSetType{MustCreateSetType(values, sql.Collation_Default), varName}
+	return systemSetType{MustCreateSetType(values, sql.Collation_utf8mb4_0900_ai_ci), varName}
 }
 
 // Compare implements Type interface.
@@ -69,23 +69,26 @@ func (t systemUintType) Convert(v interface{}) (interface{}, sql.ConvertInRange,
 	// Float, string, nor nil values are accepted
 	switch value := v.(type) {
 	case int:
-		return t.Convert(uint64(value))
+		return t.Convert(int64(value))
 	case uint:
 		return t.Convert(uint64(value))
 	case int8:
-		return t.Convert(uint64(value))
+		return t.Convert(int64(value))
 	case uint8:
 		return t.Convert(uint64(value))
 	case int16:
-		return t.Convert(uint64(value))
+		return t.Convert(int64(value))
 	case uint16:
 		return t.Convert(uint64(value))
 	case int32:
-		return t.Convert(uint64(value))
+		return t.Convert(int64(value))
 	case uint32:
 		return t.Convert(uint64(value))
 	case int64:
-		return t.Convert(uint64(value))
+		// Negative values would wrap around to large ones
+		if value >= 0 {
+			return t.Convert(uint64(value))
+		}
 	case uint64:
 		if value >= t.lowerbound && value <= t.upperbound {
 			return value, sql.InRange, nil
@@ -95,7 +98,7 @@ func (t systemUintType) Convert(v interface{}) (interface{}, sql.ConvertInRange,
 	case float64:
 		// Float values aren't truly accepted, but the engine will give them when it should give ints.
 		// Therefore, if the float doesn't have a fractional portion, we treat it as an int.
-		if value == float64(uint64(value)) {
+		if value >= 0 && value == float64(uint64(value)) {
 			return t.Convert(uint64(value))
 		}
 	case decimal.Decimal:
@@ -281,6 +281,14 @@ var systemVars = map[string]sql.SystemVariable{
 		Type:              types.NewSystemStringType("admin_tls_version"),
 		Default:           "TLSv1,TLSv1.1,TLSv1.2,TLSv1.3",
 	},
+	"analyzer_disabled_rules": {
+		Name:              "analyzer_disabled_rules",
+		Scope:             sql.SystemVariableScope_Session,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              types.NewSystemStringType("analyzer_disabled_rules"),
+		Default:           "",
+	},
 	"authentication_windows_log_level": {
 		Name:              "authentication_windows_log_level",
 		Scope:             sql.SystemVariableScope_Global,
@@ -1296,7 +1304,7 @@ var systemVars = map[string]sql.SystemVariable{
 		Dynamic:           false,
 		SetVarHintApplies: false,
 		Type:              types.NewSystemIntType("lower_case_table_names", 0, 2, false),
-		Default:           int64(0),
+		Default:           int64(2),
 	},
 	"mandatory_roles": {
 		Name:              "mandatory_roles",
@@ -1674,15 +1682,16 @@ var systemVars = map[string]sql.SystemVariable{
 		Type:              types.NewSystemIntType("optimizer_search_depth", 0, 62, false),
 		Default:           int64(62),
 	},
-	// TODO: add proper support for this
-	// "optimizer_switch": {
-	//	Name: "optimizer_switch",
-	//	Scope: SystemVariableScope_Both,
-	//	Dynamic: true,
-	//	SetVarHintApplies: true,
-	//	Type: NewSystemSetType("optimizer_switch"),
-	//	Default: "",
-	// },
+	// Flags missing from the value set are taken to have their default values, rather than those they had before
+	// TODO: only index_merge, index_merge_union and index_condition_pushdown are used
+	"optimizer_switch": {
+		Name:              "optimizer_switch",
+		Scope:             sql.SystemVariableScope_Both,
+		Dynamic:           true,
+		SetVarHintApplies: true,
+		Type:              types.NewSystemStringType("optimizer_switch"),
+		Default:           "index_merge=on,index_merge_union=on,index_merge_sort_union=on,index_merge_intersection=on,engine_condition_pushdown=on,index_condition_pushdown=on,mrr=on,mrr_cost_based=on,block_nested_loop=on,batched_key_access=off,materialization=on,semijoin=on,loosescan=on,firstmatch=on,duplicateweedout=on,subquery_materialization_cost_based=on,use_index_extensions=on,condition_fanout_filter=on,derived_merge=on,use_invisible_indexes=off,skip_scan=on,hash_join=on,subquery_to_derived=off,prefer_ordering_index=on,hypergraph_optimizer=off,derived_condition_pushdown=on",
+	},
 	"optimizer_trace": {
 		Name:              "optimizer_trace",
 		Scope:             sql.SystemVariableScope_Both,
@@ -2338,8 +2347,8 @@ var systemVars = map[string]sql.SystemVariable{
 		Scope:             sql.SystemVariableScope_Both,
 		Dynamic:           true,
 		SetVarHintApplies: true,
-		Type:              types.NewSystemIntType("sql_select_limit", -9223372036854775808, 9223372036854775807, false),
-		Default:           int64(2147483647),
+		Type:              types.NewSystemUintType("sql_select_limit", 0, math.MaxUint64),
+		Default:           uint64(math.MaxUint64),
 	},
 	"sql_warnings": {
 		Name:              "sql_warnings",
@@ -2413,6 +2422,14 @@ var systemVars = map[string]sql.SystemVariable{
 		Type:              types.NewSystemStringType("ssl_key"),
 		Default:           "",
 	},
+	"stats_auto_recalc_threshold": {
+		Name:              "stats_auto_recalc_threshold",
+		Scope:             sql.SystemVariableScope_Global,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              types.NewSystemDoubleType("stats_auto_recalc_threshold", 0, 1),
+		Default:           float64(0.1),
+	},
 	"stored_program_cache": {
 		Name:              "stored_program_cache",
 		Scope:             sql.SystemVariableScope_Global,
@@ -0,0 +1,120 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+// statsRecalculator recalculates the statistics of tables in the background once enough of their rows have changed,
+// so that the queries that find them stale don't wait for the recalculation.
+type statsRecalculator struct {
+	mu *sync.Mutex
+	// tables whose statistics are being recalculated
+	pending map[sql.DbTable]struct{}
+}
+
+func newStatsRecalculator() *statsRecalculator {
+	return &statsRecalculator{
+		mu:      &sync.Mutex{},
+		pending: make(map[sql.DbTable]struct{}),
+	}
+}
+
+// recalcStaleStats starts a background recalculation of the statistics of every table read by the query plan given
+// whose statistics are stale. Errors are logged rather than returned, since they don't affect the query.
+func (e *Engine) recalcStaleStats(ctx *sql.Context, n sql.Node) {
+	stats, err := e.Analyzer.Catalog.Statistics(ctx)
+	if err != nil {
+		return
+	}
+	recalculator, ok := stats.(sql.StatsAutoRecalculator)
+	if !ok {
+		return
+	}
+
+	for _, rt := range resolvedTables(n) {
+		if rt.Database == nil || rt.Database.Name() == sql.InformationSchemaDatabaseName {
+			continue
+		}
+		db, table := rt.Database.Name(), rt.Name()
+		stale, err := recalculator.NeedsRecalc(ctx, db, table)
+		if err != nil {
+			ctx.GetLogger().Warnf("unable to check the statistics of %s.%s: %s", db, table, err)
+			continue
+		}
+		if stale {
+			e.startStatsRecalc(ctx, db, table)
+		}
+	}
+}
+
+// startStatsRecalc recalculates the statistics of the table given in a background thread, unless they are already
+// being recalculated.
+func (e *Engine) startStatsRecalc(ctx *sql.Context, db, table string) {
+	key := sql.NewDbTable(db, table)
+	e.statsRecalc.mu.Lock()
+	defer e.statsRecalc.mu.Unlock()
+	if _, ok := e.statsRecalc.pending[key]; ok {
+		return
+	}
+
+	// The recalculation runs in its own session, as the user running the query
+	client, address := ctx.Session.Client(), ctx.Session.Address()
+	err := e.BackgroundThreads.Add(fmt.Sprintf("stats recalc %s.%s", db, table), func(threadCtx context.Context) {
+		defer func() {
+			e.statsRecalc.mu.Lock()
+			defer e.statsRecalc.mu.Unlock()
+			delete(e.statsRecalc.pending, key)
+		}()
+
+		recalcCtx := sql.NewContext(threadCtx, sql.WithSession(sql.NewBaseSessionWithClientServer(address, client, 0)))
+		stats, err := e.Analyzer.Catalog.Statistics(recalcCtx)
+		if err == nil {
+			err = stats.Analyze(recalcCtx, db, table)
+		}
+		if err != nil {
+			recalcCtx.GetLogger().Warnf("unable to recalculate the statistics of %s.%s: %s", db, table, err)
+		}
+	})
+	if err != nil {
+		ctx.GetLogger().Warnf("unable to recalculate the statistics of %s.%s: %s", db, table, err)
+		return
+	}
+	e.statsRecalc.pending[key] = struct{}{}
+}
+
+// resolvedTables returns the tables read by the node given.
+func resolvedTables(n sql.Node) []*plan.ResolvedTable {
+	var tables []*plan.ResolvedTable
+	transform.Inspect(n, func(n sql.Node) bool {
+		switch n := n.(type) {
+		case *plan.ResolvedTable:
+			if !plan.IsDualTable(n) {
+				tables = append(tables, n)
+			}
+		case *plan.IndexedTableAccess:
+			tables = append(tables, n.ResolvedTable)
+		}
+		return true
+	})
+	return tables
+}